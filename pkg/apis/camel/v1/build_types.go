@@ -73,6 +73,12 @@ type BuilderTask struct {
 	Resources []ResourceSpec `json:"resources,omitempty"`
 	// the list of dependencies to use for this build
 	Dependencies []string `json:"dependencies,omitempty"`
+	// the BOMs imported before the runtime BOMs, overriding the versions of the dependencies they manage
+	Boms []string `json:"boms,omitempty"`
+	// the versions of the dependencies pinned in the dependency management, including when resolved transitively
+	ManagedVersions []string `json:"managedVersions,omitempty"`
+	// the environment variables, with format KEY=VALUE, set for the Maven commands of the build
+	Env []string `json:"env,omitempty"`
 	// the list of steps to execute (see pkg/builder/)
 	Steps []string `json:"steps,omitempty"`
 	// the configuration required by Maven for the application build phase
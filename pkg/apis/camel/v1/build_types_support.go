@@ -44,6 +44,11 @@ func NewBuildList() BuildList {
 	}
 }
 
+// S2iImageStreamName returns the name of the ImageStream the Build image is published to, when using S2I.
+func (in *Build) S2iImageStreamName() string {
+	return "camel-k-" + in.Name
+}
+
 func (buildPhase *BuildPhase) String() string {
 	return string(*buildPhase)
 }
@@ -33,6 +33,12 @@ const (
 	SecondaryPlatformAnnotation = "camel.apache.org/secondary.platform"
 	// PlatformSelectorAnnotation platform id annotation label
 	PlatformSelectorAnnotation = "camel.apache.org/platform.id"
+	// DependencyChecksumsAnnotation the content checksums of the file dependencies, as a JSON object keyed by dependency
+	DependencyChecksumsAnnotation = "camel.apache.org/dependency.checksums"
+	// DependencyTreeDigestAnnotation the digest of the fully resolved dependency tree of an integration
+	DependencyTreeDigestAnnotation = "camel.apache.org/dependency.tree.digest"
+	// KitRegistriesAnnotation the comma-separated prefixes of the registries the kit image of an integration must come from
+	KitRegistriesAnnotation = "camel.apache.org/kit.registries"
 )
 
 // BuildStrategy specifies how the Build should be executed.
@@ -110,6 +110,10 @@ type IntegrationStatus struct {
 	RuntimeVersion string `json:"runtimeVersion,omitempty"`
 	// the runtime provider targeted for this Integration
 	RuntimeProvider RuntimeProvider `json:"runtimeProvider,omitempty"`
+	// the Quarkus platform version targeted for this Integration
+	QuarkusPlatformVersion string `json:"quarkusPlatformVersion,omitempty"`
+	// the Camel version of the runtime catalog targeted for this Integration
+	CatalogVersion string `json:"catalogVersion,omitempty"`
 	// Deprecated:
 	// a list of configuration specification
 	Configuration []ConfigurationSpec `json:"configuration,omitempty"`
@@ -163,6 +167,10 @@ const (
 
 	// IntegrationConditionKitAvailable --
 	IntegrationConditionKitAvailable IntegrationConditionType = "IntegrationKitAvailable"
+	// IntegrationConditionKitMatched reports the evaluation of the existing kits against the Integration
+	IntegrationConditionKitMatched IntegrationConditionType = "IntegrationKitMatched"
+	// IntegrationConditionBetterKitAvailable reports a better kit matching the Integration, that it is not rebound to
+	IntegrationConditionBetterKitAvailable IntegrationConditionType = "BetterIntegrationKitAvailable"
 	// IntegrationConditionPlatformAvailable --
 	IntegrationConditionPlatformAvailable IntegrationConditionType = "IntegrationPlatformAvailable"
 	// IntegrationConditionDeploymentAvailable --
@@ -186,6 +194,24 @@ const (
 
 	// IntegrationConditionKitAvailableReason --
 	IntegrationConditionKitAvailableReason string = "IntegrationKitAvailable"
+	// IntegrationConditionKitMatchedReason --
+	IntegrationConditionKitMatchedReason string = "IntegrationKitMatched"
+	// IntegrationConditionKitNotMatchedReason --
+	IntegrationConditionKitNotMatchedReason string = "IntegrationKitNotMatched"
+	// IntegrationConditionBetterKitAvailableReason --
+	IntegrationConditionBetterKitAvailableReason string = "BetterIntegrationKitAvailable"
+	// IntegrationConditionNoKitsReason --
+	IntegrationConditionNoKitsReason string = "NoIntegrationKits"
+	// IntegrationConditionKitDependenciesNotMatchedReason --
+	IntegrationConditionKitDependenciesNotMatchedReason string = "IntegrationKitDependenciesNotMatched"
+	// IntegrationConditionKitTraitsNotMatchedReason --
+	IntegrationConditionKitTraitsNotMatchedReason string = "IntegrationKitTraitsNotMatched"
+	// IntegrationConditionKitVersionNotMatchedReason --
+	IntegrationConditionKitVersionNotMatchedReason string = "IntegrationKitVersionNotMatched"
+	// IntegrationConditionKitNotFoundReason --
+	IntegrationConditionKitNotFoundReason string = "IntegrationKitNotFound"
+	// IntegrationConditionKitLookupFailedReason --
+	IntegrationConditionKitLookupFailedReason string = "IntegrationKitLookupFailed"
 	// IntegrationConditionPlatformAvailableReason --
 	IntegrationConditionPlatformAvailableReason string = "IntegrationPlatformAvailable"
 	// IntegrationConditionDeploymentAvailableReason --
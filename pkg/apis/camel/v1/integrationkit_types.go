@@ -58,6 +58,8 @@ type IntegrationKitSpec struct {
 	Dependencies []string `json:"dependencies,omitempty"`
 	// the profile which is expected by this kit
 	Profile TraitProfile `json:"profile,omitempty"`
+	// the capabilities, e.g. `platform-http`, whose artifacts are provided by this kit
+	Capabilities []string `json:"capabilities,omitempty"`
 	// traits that the kit will execute
 	Traits IntegrationKitTraits `json:"traits,omitempty"`
 	// configuration used by the kit
@@ -71,6 +73,8 @@ type IntegrationKitSpec struct {
 type IntegrationKitTraits struct {
 	// The builder trait is internally used to determine the best strategy to build and configure IntegrationKits.
 	Builder *trait.BuilderTrait `property:"builder" json:"builder,omitempty"`
+	// The environment trait, holding the environment variables set for the build of the kit.
+	Environment *trait.EnvironmentTrait `property:"environment" json:"environment,omitempty"`
 	// The Quarkus trait configures the Quarkus runtime.
 	// It's enabled by default.
 	// NOTE: Compiling to a native executable, i.e. when using `package-type=native`, is only supported for kamelets, as well as YAML and XML integrations. It also requires at least 4GiB of memory, so the Pod running the native build, that is either the operator Pod, or the build Pod (depending on the build strategy configured for the platform), must have enough memory available.
@@ -94,6 +98,14 @@ type IntegrationKitStatus struct {
 	Image string `json:"image,omitempty"`
 	// actual image digest of the kit
 	Digest string `json:"digest,omitempty"`
+	// the ImageStream, referenced as `namespace/name`, the kit image is published to, if any
+	ImageStream string `json:"imageStream,omitempty"`
+	// the strategy the kit was built with
+	BuildStrategy BuildStrategy `json:"buildStrategy,omitempty"`
+	// the duration of the build of the kit
+	BuildDuration string `json:"buildDuration,omitempty"`
+	// the digest of the fully resolved dependency tree of the kit, i.e., of its artifacts
+	DependencyTreeDigest string `json:"dependencyTreeDigest,omitempty"`
 	// list of artifacts used by the kit
 	Artifacts []Artifact `json:"artifacts,omitempty"`
 	// failure reason (if any)
@@ -102,6 +114,10 @@ type IntegrationKitStatus struct {
 	RuntimeVersion string `json:"runtimeVersion,omitempty"`
 	// the runtime provider for which this kit was configured
 	RuntimeProvider RuntimeProvider `json:"runtimeProvider,omitempty"`
+	// the Quarkus platform version this kit was built with
+	QuarkusPlatformVersion string `json:"quarkusPlatformVersion,omitempty"`
+	// the Camel version of the runtime catalog this kit was built with
+	CatalogVersion string `json:"catalogVersion,omitempty"`
 	// the platform for which this kit was configured
 	Platform string `json:"platform,omitempty"`
 	// the Camel K operator version for which this kit was configured
@@ -150,6 +166,40 @@ const (
 	// IntegrationKitPriorityLabel labels the kit priority
 	IntegrationKitPriorityLabel = "camel.apache.org/kit.priority"
 
+	// IntegrationKitOperatorVersionLabel labels the version of the operator that created the kit
+	IntegrationKitOperatorVersionLabel = "camel.apache.org/operator.version"
+
+	// IntegrationKitPlatformGenerationLabel labels the generation of the IntegrationPlatform the kit is created under
+	IntegrationKitPlatformGenerationLabel = "camel.apache.org/platform.generation"
+
+	// IntegrationKitPhaseLabel labels the kit phase, as a label value, e.g. `build-running`
+	IntegrationKitPhaseLabel = "camel.apache.org/kit.phase"
+
+	// IntegrationKitFailuresAnnotation counts the failures of the Integrations using the kit
+	IntegrationKitFailuresAnnotation = "camel.apache.org/kit.failures"
+
+	// IntegrationKitReusesAnnotation counts the times Integrations have been bound to the kit once it existed
+	IntegrationKitReusesAnnotation = "camel.apache.org/kit.reuses"
+
+	// IntegrationKitManifestAnnotation references the registry manifest declaring the dependencies and traits
+	// of an external kit, that does not declare them in its spec. The manifest is fetched over HTTP(S), from a public address
+	IntegrationKitManifestAnnotation = "camel.apache.org/kit.manifest"
+
+	// IntegrationKitSBOMAnnotation records the software bill of materials of the kit image, as a CycloneDX JSON document
+	IntegrationKitSBOMAnnotation = "camel.apache.org/kit.sbom"
+
+	// IntegrationKitVulnerabilitiesAnnotation records the number of known vulnerabilities of the kit dependencies, e.g. as reported by a scanner
+	IntegrationKitVulnerabilitiesAnnotation = "camel.apache.org/kit.vulnerabilities"
+
+	// IntegrationKitAPIVersionsAnnotation declares the Integration API versions the kit supports, as a version, e.g. `v1`, or an inclusive range, e.g. `v1alpha1..v1`, whose bounds are optional
+	IntegrationKitAPIVersionsAnnotation = "camel.apache.org/kit.api.versions"
+
+	// IntegrationKitContractAnnotation records the compatibility contract the build stamps the kit with, as a JSON document summarizing what the kit guarantees
+	IntegrationKitContractAnnotation = "camel.apache.org/kit.contract"
+
+	// IntegrationKitKameletsAnnotation declares the Kamelets the kit bundles into its image, as a comma separated list of Kamelet names
+	IntegrationKitKameletsAnnotation = "camel.apache.org/kit.kamelets"
+
 	// IntegrationKitPhaseNone --
 	IntegrationKitPhaseNone IntegrationKitPhase = ""
 	// IntegrationKitPhaseInitialization --
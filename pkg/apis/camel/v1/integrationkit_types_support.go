@@ -18,7 +18,10 @@ limitations under the License.
 package v1
 
 import (
+	"errors"
+	"fmt"
 	"strconv"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -94,6 +97,40 @@ func (in *IntegrationKit) HasHigherPriorityThan(kit *IntegrationKit) bool {
 	return p1 > p2
 }
 
+// IntegrationKitPhaseLabelValue returns the value of the phase label of the kits in the given phase, e.g.
+// `build-running` for the `Build Running` phase.
+func IntegrationKitPhaseLabelValue(phase IntegrationKitPhase) string {
+	return strings.ToLower(strings.ReplaceAll(string(phase), " ", "-"))
+}
+
+// Validate returns an error describing the inconsistencies between the kit status and its spec or labels, if any.
+// A kit that has not been initialized yet is considered valid.
+func (in *IntegrationKit) Validate() error {
+	if in.Status.Phase == IntegrationKitPhaseNone || in.Status.Phase == IntegrationKitPhaseInitialization {
+		return nil
+	}
+
+	var issues []string
+	if in.Status.Version == "" {
+		issues = append(issues, "status version is empty")
+	}
+	if in.Status.ObservedGeneration != 0 && in.Status.ObservedGeneration != in.Generation {
+		issues = append(issues, fmt.Sprintf("status observed generation %d differs from generation %d", in.Status.ObservedGeneration, in.Generation))
+	}
+	if v, ok := in.Labels["camel.apache.org/runtime.version"]; ok && v != in.Status.RuntimeVersion {
+		issues = append(issues, fmt.Sprintf("status runtime version %q differs from label %q", in.Status.RuntimeVersion, v))
+	}
+	if p, ok := in.Labels["camel.apache.org/runtime.provider"]; ok && p != string(in.Status.RuntimeProvider) {
+		issues = append(issues, fmt.Sprintf("status runtime provider %q differs from label %q", in.Status.RuntimeProvider, p))
+	}
+
+	if len(issues) > 0 {
+		return errors.New(strings.Join(issues, ", "))
+	}
+
+	return nil
+}
+
 // GetCondition returns the condition with the provided type.
 func (in *IntegrationKitStatus) GetCondition(condType IntegrationKitConditionType) *IntegrationKitCondition {
 	for i := range in.Conditions {
@@ -0,0 +1,64 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIntegrationKitValidate(t *testing.T) {
+	kit := NewIntegrationKit("ns", "my-kit")
+	kit.Generation = 2
+	kit.Labels = map[string]string{
+		"camel.apache.org/runtime.version":  "1.17.0",
+		"camel.apache.org/runtime.provider": "quarkus",
+	}
+
+	// Not yet initialized
+	assert.Nil(t, kit.Validate())
+
+	kit.Status = IntegrationKitStatus{
+		Phase:              IntegrationKitPhaseReady,
+		ObservedGeneration: 2,
+		Version:            "1.10.0",
+		RuntimeVersion:     "1.17.0",
+		RuntimeProvider:    RuntimeProviderQuarkus,
+	}
+	assert.Nil(t, kit.Validate())
+
+	kit.Status.Version = ""
+	err := kit.Validate()
+	assert.NotNil(t, err)
+	assert.Equal(t, "status version is empty", err.Error())
+
+	kit.Status.ObservedGeneration = 1
+	kit.Status.RuntimeVersion = "1.16.0"
+	err = kit.Validate()
+	assert.NotNil(t, err)
+	assert.Equal(t, `status version is empty, status observed generation 1 differs from generation 2, `+
+		`status runtime version "1.16.0" differs from label "1.17.0"`, err.Error())
+}
+
+func TestIntegrationKitPhaseLabelValue(t *testing.T) {
+	assert.Equal(t, "ready", IntegrationKitPhaseLabelValue(IntegrationKitPhaseReady))
+	assert.Equal(t, "build-running", IntegrationKitPhaseLabelValue(IntegrationKitPhaseBuildRunning))
+	assert.Equal(t, "waiting-for-platform", IntegrationKitPhaseLabelValue(IntegrationKitPhaseWaitingForPlatform))
+	assert.Equal(t, "", IntegrationKitPhaseLabelValue(IntegrationKitPhaseNone))
+}
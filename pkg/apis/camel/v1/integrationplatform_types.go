@@ -151,8 +151,229 @@ type IntegrationPlatformBuildSpec struct {
 	PersistentVolumeClaim string `json:"persistentVolumeClaim,omitempty"`
 	//
 	PublishStrategyOptions map[string]string `json:"PublishStrategyOptions,omitempty"`
+	// the configuration of the matching of the Integrations against the existing IntegrationKits, so that they are reused
+	KitMatching IntegrationPlatformKitMatchingSpec `json:"kitMatching,omitempty"`
 }
 
+// IntegrationPlatformKitMatchingSpec configures how the Integrations are matched against the existing IntegrationKits,
+// so that an IntegrationKit is reused rather than built.
+type IntegrationPlatformKitMatchingSpec struct {
+	// the mode to adopt when matching an Integration against the existing IntegrationKits
+	Mode IntegrationKitMatchMode `json:"mode,omitempty"`
+	// whether an IntegrationKit providing more dependencies than the ones required by an Integration can be reused (default `true`)
+	AllowExtraDependencies *bool `json:"allowExtraDependencies,omitempty"`
+	// the number of extra dependencies an IntegrationKit can provide when extra dependencies are not allowed
+	ExtraDependenciesTolerance int `json:"extraDependenciesTolerance,omitempty"`
+	// the time until which IntegrationKits built for another runtime provider can be reused,
+	// while no IntegrationKit exists for the Integration runtime provider
+	RuntimeProviderUpgradeWindowEnd *metav1.Time `json:"runtimeProviderUpgradeWindowEnd,omitempty"`
+	// whether the IntegrationKits with a status inconsistent with their spec are excluded from matching
+	ExcludeInvalid bool `json:"excludeInvalid,omitempty"`
+	// the number of failures of the Integrations using an IntegrationKit after which the IntegrationKit
+	// is quarantined, i.e., excluded from matching (quarantine is disabled when unset)
+	QuarantineThreshold int `json:"quarantineThreshold,omitempty"`
+	// the mode to adopt when comparing the kit influencing traits of an Integration and an IntegrationKit
+	TraitMatchMode IntegrationKitTraitMatchMode `json:"traitMatchMode,omitempty"`
+	// the other IntegrationPlatforms, referenced as `namespace/name`, whose IntegrationKits can be reused
+	// when their runtime version and provider align with the ones of this IntegrationPlatform
+	ReusePlatforms []string `json:"reusePlatforms,omitempty"`
+	// whether the runtime version of an Integration can be a prefix, e.g. `1.17`, or a glob pattern, e.g. `1.17.*`,
+	// matching the runtime versions of the IntegrationKits, e.g. to reuse the IntegrationKits of an LTS line
+	RuntimeVersionPrefixMatch bool `json:"runtimeVersionPrefixMatch,omitempty"`
+	// the mode to adopt when checking that the dependencies of an Integration are provided by an IntegrationKit
+	DependencyMatchMode IntegrationKitDependencyMatchMode `json:"dependencyMatchMode,omitempty"`
+	// the mode to adopt when matching the SNAPSHOT dependencies of an Integration, as they change over time
+	// (the IntegrationKits created for other Integrations are not reused by the Integrations with SNAPSHOT dependencies when unset)
+	SnapshotMatchMode IntegrationKitSnapshotMatchMode `json:"snapshotMatchMode,omitempty"`
+	// the labels of an Integration that are part of the identity of its IntegrationKits, so that an IntegrationKit
+	// is only reused by the Integrations having the same values for these labels
+	IdentityLabels []string `json:"identityLabels,omitempty"`
+	// whether the build strategy influences the IntegrationKits, so that an IntegrationKit is only reused
+	// by the Integrations built with the same strategy
+	BuildStrategyInfluencing bool `json:"buildStrategyInfluencing,omitempty"`
+	// whether the report of the evaluation of the existing IntegrationKits, truncated, is recorded
+	// as a condition of the Integrations
+	Report bool `json:"report,omitempty"`
+	// the `group:artifact` coordinates of the Maven dependencies whose versions must match exactly, the versions
+	// of the other Maven dependencies being ignored when matching an Integration against the IntegrationKits
+	PinnedDependencies []string `json:"pinnedDependencies,omitempty"`
+	// whether the IntegrationKits that built faster, a proxy for a smaller image that is likely faster to pull,
+	// are preferred among the IntegrationKits matching an Integration
+	PreferFasterBuilds bool `json:"preferFasterBuilds,omitempty"`
+	// the IDs of the addon traits that are ignored when matching an Integration against the IntegrationKits,
+	// e.g. because they only influence the runtime
+	NonInfluencingAddons []string `json:"nonInfluencingAddons,omitempty"`
+	// the number of generations the status of an Integration can lag behind its spec for the Integration
+	// to be matched against the IntegrationKits (the generations are not checked when unset)
+	MaxStatusGenerationSkew *int64 `json:"maxStatusGenerationSkew,omitempty"`
+	// the IDs of the kit influencing traits that, when omitted by an Integration, accept any configuration
+	// of the IntegrationKits
+	PermissiveTraits []string `json:"permissiveTraits,omitempty"`
+	// whether the ready IntegrationKits that have no image are excluded from matching, the IntegrationKits
+	// that are still building being matched regardless
+	ExcludeImageless bool `json:"excludeImageless,omitempty"`
+	// the groups of interchangeable dependencies, as comma separated lists, e.g. a vendored fork and its upstream,
+	// that satisfy each other when matching an Integration against the IntegrationKits (the Maven coordinates
+	// without version match any version)
+	DependencyEquivalences []string `json:"dependencyEquivalences,omitempty"`
+	// whether only the IntegrationKits labeled with the version of the operator are matched, e.g. as the
+	// IntegrationKits built by another operator version may be incompatible
+	RequireOperatorVersion bool `json:"requireOperatorVersion,omitempty"`
+	// the semantic version constraint the operator version label of the IntegrationKits must satisfy,
+	// instead of being equal to the version of the operator, when the operator version is required
+	OperatorVersionRange string `json:"operatorVersionRange,omitempty"`
+	// whether only the IntegrationKits labeled with the generation of the IntegrationPlatform are matched, e.g. as the
+	// IntegrationKits built before a change of the IntegrationPlatform, like a new base image, may be undesirable
+	// (the IntegrationKits of the other IntegrationPlatforms are not reused then)
+	MatchPlatformGeneration bool `json:"matchPlatformGeneration,omitempty"`
+	// whether the IntegrationKits whose runtime configuration, i.e., the configuration that does not influence
+	// the build, is the closest to the one of an Integration are preferred among the IntegrationKits matching it
+	PreferClosestRuntimeConfig bool `json:"preferClosestRuntimeConfig,omitempty"`
+	// whether the IntegrationKits must have the digest of their resolved dependency tree equal to the one an Integration
+	// declares with the `camel.apache.org/dependency.tree.digest` annotation, if any
+	MatchDependencyTreeDigest bool `json:"matchDependencyTreeDigest,omitempty"`
+	// the IDs of the traits whose configurations are compared when matching an Integration against the IntegrationKits,
+	// in addition to the traits that influence the IntegrationKits
+	InfluencingTraits []string `json:"influencingTraits,omitempty"`
+	// the IDs of the traits that influence the IntegrationKits, whose configurations are nevertheless ignored
+	// when matching an Integration against the IntegrationKits
+	NonInfluencingTraits []string `json:"nonInfluencingTraits,omitempty"`
+	// the core dependencies, the other dependencies being peripheral, so that only the IntegrationKits missing a core
+	// dependency of an Integration are rejected, the peripheral dependency differences ranking the IntegrationKits
+	// (the Maven coordinates without version match any version)
+	CoreDependencies []string `json:"coreDependencies,omitempty"`
+	// how much time to wait, while a new IntegrationKit is built for an Integration that no IntegrationKit matches,
+	// before the Integration is matched again against the IntegrationKits (the Integration is matched again
+	// on every reconciliation when unset)
+	BuildRequeueBackoff *metav1.Duration `json:"buildRequeueBackoff,omitempty"`
+	// the profiles of the IntegrationKits each Integration profile can reuse, in addition to its own profile,
+	// e.g. `kubernetes: [knative]` for the Kubernetes Integrations to reuse the Knative IntegrationKits (the
+	// IntegrationKits are reused across profiles, provided they have the profile specific dependencies, when unset)
+	ProfileCompatibility map[TraitProfile][]TraitProfile `json:"profileCompatibility,omitempty"`
+	// whether the traits configurations are compared in their canonical form, i.e., round-tripped through
+	// the traits definitions, when matching an Integration against the IntegrationKits, so that only the
+	// semantically meaningful differences count, rather than their serialization
+	CanonicalTraits bool `json:"canonicalTraits,omitempty"`
+	// the fields of the traits configurations that are compared when matching an Integration against the
+	// IntegrationKits, per trait, as the JSON Pointers of the fields to include and to exclude (all the fields
+	// of the traits are compared when unset)
+	TraitFieldPaths map[string]IntegrationKitTraitFieldPaths `json:"traitFieldPaths,omitempty"`
+	// whether the dependencies are ignored when matching the Integrations run from a prebuilt container image,
+	// as configured with the container trait, against the IntegrationKits, that are then matched by image
+	IgnoreImageDependencies bool `json:"ignoreImageDependencies,omitempty"`
+	// how often the running Integrations are matched again against the IntegrationKits, for the better IntegrationKits
+	// that have appeared since, e.g. patched ones with a higher priority (the running Integrations are only matched
+	// again on their reconciliations when unset)
+	ReevaluationInterval *metav1.Duration `json:"reevaluationInterval,omitempty"`
+	// whether the running Integrations are rebound to the better IntegrationKits matching them, or the better
+	// IntegrationKits are only reported with a condition (the Integrations are rebound when unset)
+	RebindPolicy IntegrationKitRebindPolicy `json:"rebindPolicy,omitempty"`
+	// whether the IntegrationKits must also record a software bill of materials, listing the components the Integration
+	// dependencies resolve to, to match an Integration (the IntegrationKits are matched by dependencies only when unset)
+	MatchSBOM bool `json:"matchSBOM,omitempty"`
+	// the score penalty per known vulnerability of the IntegrationKit dependencies, so that the IntegrationKits
+	// with vulnerabilities score lower than the other IntegrationKits matching an Integration
+	VulnerabilityPenalty int `json:"vulnerabilityPenalty,omitempty"`
+	// the prefixes of the registries, e.g. `registry.example.com/approved`, the IntegrationKit images must come from
+	// to match an Integration, unless the Integration declares its own with the `camel.apache.org/kit.registries`
+	// annotation (the IntegrationKit images can come from any registry when unset)
+	AllowedRegistries []string `json:"allowedRegistries,omitempty"`
+	// whether the Camel versions of the runtime catalogs an Integration and the IntegrationKits target must be
+	// identical for the IntegrationKits to match the Integration, as they may resolve different components
+	MatchCatalogVersion bool `json:"matchCatalogVersion,omitempty"`
+	// whether the time spent in each step of the matching of the IntegrationKits against an Integration,
+	// e.g. the comparison of their traits, is traced and exported as metrics
+	Trace bool `json:"trace,omitempty"`
+	// whether the IntegrationKits in error are excluded from the lookup of the IntegrationKits matching an Integration,
+	// rather than being evaluated and rejected
+	ListExcludeErrors bool `json:"listExcludeErrors,omitempty"`
+	// whether the IntegrationKits to match an Integration are always listed from the API server, rather than read
+	// from the informer cache once it is synced
+	ListDisableCache bool `json:"listDisableCache,omitempty"`
+	// whether the IntegrationKits are never reused, so that every Integration builds its own IntegrationKit,
+	// e.g. to diagnose build issues
+	DisableReuse bool `json:"disableReuse,omitempty"`
+	// the score, up to 100, from which the lookup of the IntegrationKits matching an Integration returns as soon as
+	// an IntegrationKit reaches it, without evaluating the remaining IntegrationKits, trading the best IntegrationKit
+	// for a faster lookup (all the IntegrationKits are evaluated when unset)
+	EarlyReturnScore int `json:"earlyReturnScore,omitempty"`
+}
+
+// IntegrationKitMatchMode defines how an Integration is matched against the existing IntegrationKits
+// +kubebuilder:validation:Enum=full;dependencies-only
+type IntegrationKitMatchMode string
+
+const (
+	// IntegrationKitMatchModeFull requires both the kit influencing traits and the dependencies to match
+	IntegrationKitMatchModeFull IntegrationKitMatchMode = "full"
+	// IntegrationKitMatchModeDependenciesOnly only requires the dependencies to match, whatever the traits configuration
+	IntegrationKitMatchModeDependenciesOnly IntegrationKitMatchMode = "dependencies-only"
+)
+
+// IntegrationKitTraitMatchMode defines how the traits of an Integration are compared to the ones of an IntegrationKit
+// +kubebuilder:validation:Enum=exact;explicit-fields;union
+type IntegrationKitTraitMatchMode string
+
+const (
+	// IntegrationKitTraitMatchModeExact requires the traits configuration to be equal
+	IntegrationKitTraitMatchModeExact IntegrationKitTraitMatchMode = "exact"
+	// IntegrationKitTraitMatchModeExplicitFields only compares the trait fields that are explicitly set on both sides
+	IntegrationKitTraitMatchModeExplicitFields IntegrationKitTraitMatchMode = "explicit-fields"
+	// IntegrationKitTraitMatchModeUnion compares the traits that are explicitly set on either side, restricted to their
+	// fields, at any depth, that are explicitly set on both sides, the traits set on one side only being compared to
+	// the defaults
+	IntegrationKitTraitMatchModeUnion IntegrationKitTraitMatchMode = "union"
+)
+
+// IntegrationKitDependencyMatchMode defines how the dependencies of an Integration are checked against the ones of an IntegrationKit
+// +kubebuilder:validation:Enum=superset;closure
+type IntegrationKitDependencyMatchMode string
+
+const (
+	// IntegrationKitDependencyMatchModeSuperset requires the IntegrationKit dependencies to include the Integration ones
+	IntegrationKitDependencyMatchModeSuperset IntegrationKitDependencyMatchMode = "superset"
+	// IntegrationKitDependencyMatchModeClosure also accepts the Integration dependencies that are provided transitively
+	// by the artifacts of an IntegrationKit, e.g. when its dependencies were pruned to a minimal runtime closure
+	IntegrationKitDependencyMatchModeClosure IntegrationKitDependencyMatchMode = "closure"
+)
+
+// IntegrationKitTraitFieldPaths defines the fields of a trait configuration that are compared when matching an Integration
+// against the IntegrationKits, as JSON Pointers, e.g. `/enabled` or `/properties`
+type IntegrationKitTraitFieldPaths struct {
+	// the fields that are compared, all the fields being compared when empty
+	Include []string `json:"include,omitempty"`
+	// the fields that are not compared, among the included ones
+	Exclude []string `json:"exclude,omitempty"`
+}
+
+// IntegrationKitSnapshotMatchMode defines how the SNAPSHOT dependencies of an Integration are matched against the ones of an IntegrationKit
+// +kubebuilder:validation:Enum=rebuild;ignore-version
+type IntegrationKitSnapshotMatchMode string
+
+const (
+	// IntegrationKitSnapshotMatchModeRebuild does not reuse the IntegrationKits created for other Integrations, that may
+	// have been built against older SNAPSHOT dependencies, so that a new IntegrationKit is built
+	IntegrationKitSnapshotMatchModeRebuild IntegrationKitSnapshotMatchMode = "rebuild"
+	// IntegrationKitSnapshotMatchModeIgnoreVersion matches the SNAPSHOT dependencies whatever their version
+	IntegrationKitSnapshotMatchModeIgnoreVersion IntegrationKitSnapshotMatchMode = "ignore-version"
+)
+
+// IntegrationKitRebindPolicy defines whether the running Integrations are rebound to the better IntegrationKits matching them
+// +kubebuilder:validation:Enum=auto;manual
+type IntegrationKitRebindPolicy string
+
+const (
+	// IntegrationKitRebindPolicyAuto rebinds the running Integrations to the better IntegrationKits
+	IntegrationKitRebindPolicyAuto IntegrationKitRebindPolicy = "auto"
+	// IntegrationKitRebindPolicyManual reports the better IntegrationKits with a condition of the Integrations,
+	// that are left bound to their IntegrationKit
+	IntegrationKitRebindPolicyManual IntegrationKitRebindPolicy = "manual"
+)
+
+// IntegrationKitMatchPolicyConfigMap is the name of the ConfigMap that pins how the Integrations of its namespace
+// are matched against the existing IntegrationKits, overriding the IntegrationPlatform configuration
+const IntegrationKitMatchPolicyConfigMap = "camel-k-kit-match-policy"
+
 // IntegrationPlatformKameletSpec define the behavior for all the Kamelets controller by the IntegrationPlatform
 type IntegrationPlatformKameletSpec struct {
 	// remote repository used to retrieve Kamelet catalog
@@ -214,6 +435,11 @@ const (
 	IntegrationPlatformPhaseError IntegrationPlatformPhase = "Error"
 	// IntegrationPlatformPhaseDuplicate when the IntegrationPlatform is duplicated
 	IntegrationPlatformPhaseDuplicate IntegrationPlatformPhase = "Duplicate"
+
+	// IntegrationPlatformConditionKitTraitsValid --
+	IntegrationPlatformConditionKitTraitsValid IntegrationPlatformConditionType = "KitTraitsValid"
+	// IntegrationPlatformConditionKitTraitsInvalidReason --
+	IntegrationPlatformConditionKitTraitsInvalidReason string = "InvalidKitTraits"
 )
 
 // IntegrationPlatformCondition describes the state of a resource at a certain point.
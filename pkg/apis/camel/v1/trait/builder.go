@@ -27,4 +27,10 @@ type BuilderTrait struct {
 	Verbose *bool `property:"verbose" json:"verbose,omitempty"`
 	// A list of properties to be provided to the build task
 	Properties []string `property:"properties" json:"properties,omitempty"`
+	// A list of BOMs, with format `mvn:<group>:<artifact>:<version>`, imported before the runtime BOMs, so that
+	// the versions of the dependencies they manage override the runtime ones
+	Boms []string `property:"boms" json:"boms,omitempty"`
+	// A list of dependencies, with format `mvn:<group>:<artifact>:<version>`, whose versions are pinned in
+	// the dependency management, including when they are resolved transitively
+	ManagedVersions []string `property:"managed-versions" json:"managedVersions,omitempty"`
 }
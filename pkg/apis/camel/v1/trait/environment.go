@@ -31,4 +31,10 @@ type EnvironmentTrait struct {
 	// The syntax is KEY=VALUE, e.g., `MY_VAR="my value"`.
 	// These take precedence over the previously defined environment variables.
 	Vars []string `property:"vars" json:"vars,omitempty"`
+	// A list of environment variables to be set for the build of the integration kit.
+	// The syntax is KEY=VALUE, e.g., `MAVEN_OPTS="-Xmx2g"`.
+	// Unlike the other variables, that only configure the integration container, these influence the kit,
+	// so that a kit built with other variables is not reused.
+	// The routine build strategy, that runs the build within the operator, only supports `MAVEN_OPTS` and `MAVEN_ARGS`.
+	BuildVars []string `property:"build-vars" json:"buildVars,omitempty"`
 }
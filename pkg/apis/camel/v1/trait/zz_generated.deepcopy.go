@@ -62,6 +62,16 @@ func (in *BuilderTrait) DeepCopyInto(out *BuilderTrait) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Boms != nil {
+		in, out := &in.Boms, &out.Boms
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ManagedVersions != nil {
+		in, out := &in.ManagedVersions, &out.ManagedVersions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuilderTrait.
@@ -264,6 +274,11 @@ func (in *EnvironmentTrait) DeepCopyInto(out *EnvironmentTrait) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.BuildVars != nil {
+		in, out := &in.BuildVars, &out.BuildVars
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvironmentTrait.
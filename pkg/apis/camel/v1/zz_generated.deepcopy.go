@@ -243,6 +243,21 @@ func (in *BuilderTask) DeepCopyInto(out *BuilderTask) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Boms != nil {
+		in, out := &in.Boms, &out.Boms
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ManagedVersions != nil {
+		in, out := &in.ManagedVersions, &out.ManagedVersions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.Steps != nil {
 		in, out := &in.Steps, &out.Steps
 		*out = make([]string, len(*in))
@@ -765,6 +780,11 @@ func (in *IntegrationKitSpec) DeepCopyInto(out *IntegrationKitSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Capabilities != nil {
+		in, out := &in.Capabilities, &out.Capabilities
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	in.Traits.DeepCopyInto(&out.Traits)
 	if in.Configuration != nil {
 		in, out := &in.Configuration, &out.Configuration
@@ -820,6 +840,31 @@ func (in *IntegrationKitStatus) DeepCopy() *IntegrationKitStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IntegrationKitTraitFieldPaths) DeepCopyInto(out *IntegrationKitTraitFieldPaths) {
+	*out = *in
+	if in.Include != nil {
+		in, out := &in.Include, &out.Include
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Exclude != nil {
+		in, out := &in.Exclude, &out.Exclude
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IntegrationKitTraitFieldPaths.
+func (in *IntegrationKitTraitFieldPaths) DeepCopy() *IntegrationKitTraitFieldPaths {
+	if in == nil {
+		return nil
+	}
+	out := new(IntegrationKitTraitFieldPaths)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IntegrationKitTraits) DeepCopyInto(out *IntegrationKitTraits) {
 	*out = *in
@@ -828,6 +873,11 @@ func (in *IntegrationKitTraits) DeepCopyInto(out *IntegrationKitTraits) {
 		*out = new(trait.BuilderTrait)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Environment != nil {
+		in, out := &in.Environment, &out.Environment
+		*out = new(trait.EnvironmentTrait)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Quarkus != nil {
 		in, out := &in.Quarkus, &out.Quarkus
 		*out = new(trait.QuarkusTrait)
@@ -938,6 +988,7 @@ func (in *IntegrationPlatformBuildSpec) DeepCopyInto(out *IntegrationPlatformBui
 			(*out)[key] = val
 		}
 	}
+	in.KitMatching.DeepCopyInto(&out.KitMatching)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IntegrationPlatformBuildSpec.
@@ -1002,6 +1053,117 @@ func (in *IntegrationPlatformKameletSpec) DeepCopy() *IntegrationPlatformKamelet
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IntegrationPlatformKitMatchingSpec) DeepCopyInto(out *IntegrationPlatformKitMatchingSpec) {
+	*out = *in
+	if in.AllowExtraDependencies != nil {
+		in, out := &in.AllowExtraDependencies, &out.AllowExtraDependencies
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RuntimeProviderUpgradeWindowEnd != nil {
+		in, out := &in.RuntimeProviderUpgradeWindowEnd, &out.RuntimeProviderUpgradeWindowEnd
+		*out = (*in).DeepCopy()
+	}
+	if in.ReusePlatforms != nil {
+		in, out := &in.ReusePlatforms, &out.ReusePlatforms
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IdentityLabels != nil {
+		in, out := &in.IdentityLabels, &out.IdentityLabels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PinnedDependencies != nil {
+		in, out := &in.PinnedDependencies, &out.PinnedDependencies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NonInfluencingAddons != nil {
+		in, out := &in.NonInfluencingAddons, &out.NonInfluencingAddons
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaxStatusGenerationSkew != nil {
+		in, out := &in.MaxStatusGenerationSkew, &out.MaxStatusGenerationSkew
+		*out = new(int64)
+		**out = **in
+	}
+	if in.PermissiveTraits != nil {
+		in, out := &in.PermissiveTraits, &out.PermissiveTraits
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DependencyEquivalences != nil {
+		in, out := &in.DependencyEquivalences, &out.DependencyEquivalences
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.InfluencingTraits != nil {
+		in, out := &in.InfluencingTraits, &out.InfluencingTraits
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NonInfluencingTraits != nil {
+		in, out := &in.NonInfluencingTraits, &out.NonInfluencingTraits
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CoreDependencies != nil {
+		in, out := &in.CoreDependencies, &out.CoreDependencies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BuildRequeueBackoff != nil {
+		in, out := &in.BuildRequeueBackoff, &out.BuildRequeueBackoff
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.ProfileCompatibility != nil {
+		in, out := &in.ProfileCompatibility, &out.ProfileCompatibility
+		*out = make(map[TraitProfile][]TraitProfile, len(*in))
+		for key, val := range *in {
+			var outVal []TraitProfile
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = make([]TraitProfile, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.TraitFieldPaths != nil {
+		in, out := &in.TraitFieldPaths, &out.TraitFieldPaths
+		*out = make(map[string]IntegrationKitTraitFieldPaths, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.ReevaluationInterval != nil {
+		in, out := &in.ReevaluationInterval, &out.ReevaluationInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.AllowedRegistries != nil {
+		in, out := &in.AllowedRegistries, &out.AllowedRegistries
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IntegrationPlatformKitMatchingSpec.
+func (in *IntegrationPlatformKitMatchingSpec) DeepCopy() *IntegrationPlatformKitMatchingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IntegrationPlatformKitMatchingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IntegrationPlatformList) DeepCopyInto(out *IntegrationPlatformList) {
 	*out = *in
@@ -80,6 +80,36 @@ func loadCamelQuarkusCatalog(ctx *builderContext) error {
 func generateQuarkusProject(ctx *builderContext) error {
 	p := GenerateQuarkusProjectCommon(ctx.Build.Runtime.Metadata["camel-quarkus.version"], ctx.Build.Runtime.Version, ctx.Build.Runtime.Metadata["quarkus.version"])
 
+	// Import the BOMs of the build configuration first, so that they override the runtime BOMs
+	boms := make([]maven.Dependency, 0, len(ctx.Build.Boms))
+	for _, bom := range ctx.Build.Boms {
+		gav, err := maven.ParseGAV(strings.TrimPrefix(bom, "mvn:"))
+		if err != nil {
+			return err
+		}
+		boms = append(boms, maven.Dependency{
+			GroupID:    gav.GroupID,
+			ArtifactID: gav.ArtifactID,
+			Version:    gav.Version,
+			Type:       "pom",
+			Scope:      "import",
+		})
+	}
+	// Pin the versions of the build configuration, that take precedence over the ones the BOMs manage
+	managed := make([]maven.Dependency, 0, len(ctx.Build.ManagedVersions))
+	for _, dependency := range ctx.Build.ManagedVersions {
+		gav, err := maven.ParseGAV(strings.TrimPrefix(dependency, "mvn:"))
+		if err != nil {
+			return err
+		}
+		managed = append(managed, maven.Dependency{
+			GroupID:    gav.GroupID,
+			ArtifactID: gav.ArtifactID,
+			Version:    gav.Version,
+		})
+	}
+	p.DependencyManagement.Dependencies = append(append(managed, boms...), p.DependencyManagement.Dependencies...)
+
 	// Add all the properties from the build configuration
 	p.Properties.AddAll(ctx.Build.Maven.Properties)
 
@@ -156,6 +186,7 @@ func buildQuarkusRunner(ctx *builderContext) error {
 	mc.SettingsSecurity = ctx.Maven.SettingsSecurity
 	mc.LocalRepository = ctx.Build.Maven.LocalRepository
 	mc.AdditionalArguments = ctx.Build.Maven.CLIOptions
+	mc.ExtraEnv = ctx.Build.Env
 
 	if ctx.Maven.TrustStoreName != "" {
 		mc.ExtraMavenOpts = append(mc.ExtraMavenOpts,
@@ -0,0 +1,84 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+)
+
+func TestGenerateQuarkusProjectWithBoms(t *testing.T) {
+	ctx := builderContext{
+		Build: v1.BuilderTask{
+			Runtime: v1.RuntimeSpec{
+				Version: "1.15.0",
+				Metadata: map[string]string{
+					"camel-quarkus.version": "2.11.0",
+					"quarkus.version":       "2.11.2.Final",
+				},
+			},
+			Boms: []string{"mvn:org.acme:acme-bom:1.2.0"},
+		},
+	}
+
+	err := generateQuarkusProject(&ctx)
+	assert.Nil(t, err)
+
+	// The BOM overrides are imported before the runtime BOMs, so that they take precedence
+	boms := ctx.Maven.Project.DependencyManagement.Dependencies
+	assert.Len(t, boms, 3)
+	assert.Equal(t, "org.acme", boms[0].GroupID)
+	assert.Equal(t, "acme-bom", boms[0].ArtifactID)
+	assert.Equal(t, "1.2.0", boms[0].Version)
+	assert.Equal(t, "pom", boms[0].Type)
+	assert.Equal(t, "import", boms[0].Scope)
+	assert.Equal(t, "camel-quarkus-bom", boms[1].ArtifactID)
+	assert.Equal(t, "camel-k-runtime-bom", boms[2].ArtifactID)
+}
+
+func TestGenerateQuarkusProjectWithManagedVersions(t *testing.T) {
+	ctx := builderContext{
+		Build: v1.BuilderTask{
+			Runtime: v1.RuntimeSpec{
+				Version: "1.15.0",
+				Metadata: map[string]string{
+					"camel-quarkus.version": "2.11.0",
+					"quarkus.version":       "2.11.2.Final",
+				},
+			},
+			Boms:            []string{"mvn:org.acme:acme-bom:1.2.0"},
+			ManagedVersions: []string{"mvn:org.yaml:snakeyaml:1.33"},
+		},
+	}
+
+	err := generateQuarkusProject(&ctx)
+	assert.Nil(t, err)
+
+	// The pinned versions are managed explicitly, so that they apply to the transitive dependencies too
+	managed := ctx.Maven.Project.DependencyManagement.Dependencies
+	assert.Len(t, managed, 4)
+	assert.Equal(t, "org.yaml", managed[0].GroupID)
+	assert.Equal(t, "snakeyaml", managed[0].ArtifactID)
+	assert.Equal(t, "1.33", managed[0].Version)
+	assert.Empty(t, managed[0].Type)
+	assert.Empty(t, managed[0].Scope)
+	assert.Equal(t, "acme-bom", managed[1].ArtifactID)
+}
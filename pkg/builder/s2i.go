@@ -85,7 +85,7 @@ func (t *s2iTask) Do(ctx context.Context) v1.BuildStatus {
 				Output: buildv1.BuildOutput{
 					To: &corev1.ObjectReference{
 						Kind: "ImageStreamTag",
-						Name: "camel-k-" + t.build.Name + ":" + t.task.Tag,
+						Name: t.build.S2iImageStreamName() + ":" + t.task.Tag,
 					},
 				},
 			},
@@ -119,7 +119,7 @@ func (t *s2iTask) Do(ctx context.Context) v1.BuildStatus {
 			Kind:       "ImageStream",
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      "camel-k-" + t.build.Name,
+			Name:      t.build.S2iImageStreamName(),
 			Namespace: t.build.Namespace,
 			Labels:    t.build.Labels,
 		},
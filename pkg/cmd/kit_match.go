@@ -0,0 +1,127 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/kitmatch"
+	"github.com/apache/camel-k/pkg/platform"
+)
+
+func newKitMatchCmd(rootCmdOptions *RootCmdOptions) (*cobra.Command, *kitMatchCommandOptions) {
+	options := kitMatchCommandOptions{
+		RootCmdOptions: rootCmdOptions,
+	}
+
+	cmd := cobra.Command{
+		Use:     "match integration",
+		Short:   "List the Integration Kits ranked by how they match an Integration",
+		Long:    `List the Integration Kits that can be reused by an Integration, ranked by their match score, along with the reasons why they do not match.`,
+		PreRunE: decode(&options),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := options.validate(cmd, args); err != nil {
+				return err
+			}
+			if err := options.run(cmd, args); err != nil {
+				fmt.Fprintln(cmd.ErrOrStderr(), err.Error())
+			}
+
+			return nil
+		},
+	}
+
+	return &cmd, &options
+}
+
+type kitMatchCommandOptions struct {
+	*RootCmdOptions
+}
+
+func (command *kitMatchCommandOptions) validate(_ *cobra.Command, args []string) error {
+	if len(args) != 1 {
+		return errors.New("match expects an integration name argument")
+	}
+	return nil
+}
+
+func (command *kitMatchCommandOptions) run(cmd *cobra.Command, args []string) error {
+	c, err := command.GetCmdClient()
+	if err != nil {
+		return err
+	}
+
+	integration := v1.NewIntegration(command.Namespace, args[0])
+	if err := c.Get(command.Context, k8sclient.ObjectKeyFromObject(&integration), &integration); err != nil {
+		if k8serrors.IsNotFound(err) {
+			fmt.Fprintf(cmd.OutOrStdout(), "Integration '%s' does not exist.\n", args[0])
+			return nil
+		}
+		return err
+	}
+
+	pl, err := platform.GetForResource(command.Context, c, &integration)
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return err
+	}
+
+	kits := v1.NewIntegrationKitList()
+	if err := c.List(command.Context, &kits,
+		k8sclient.InNamespace(integration.GetIntegrationKitNamespace(pl)),
+		k8sclient.HasLabels{v1.IntegrationKitTypeLabel}); err != nil {
+		return err
+	}
+	// Only the platform and external kits can be reused by the integrations
+	candidates := make([]v1.IntegrationKit, 0, len(kits.Items))
+	for _, kit := range kits.Items {
+		if t := kit.Labels[v1.IntegrationKitTypeLabel]; t == v1.IntegrationKitTypePlatform || t == v1.IntegrationKitTypeExternal {
+			candidates = append(candidates, kit)
+		}
+	}
+
+	options, err := kitmatch.NewNamespaceOptions(command.Context, c, pl, integration.Namespace)
+	if err != nil {
+		return err
+	}
+	rankings, err := kitmatch.RankKits(&integration, candidates, options)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 8, 1, '\t', 0)
+	fmt.Fprintln(w, "NAME\tPHASE\tSCORE\tMATCH\tREASON")
+	for _, ranking := range rankings {
+		reason := ranking.Decision.Reason
+		if len(ranking.Decision.Details) > 0 {
+			reason += ": " + strings.Join(ranking.Decision.Details, ", ")
+		}
+		fmt.Fprintf(w, "%s\t%s\t%d\t%t\t%s\n", ranking.Kit.Name, string(ranking.Kit.Status.Phase), ranking.Score, ranking.Decision.Matched, reason)
+	}
+
+	return w.Flush()
+}
@@ -0,0 +1,112 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/util/defaults"
+	"github.com/apache/camel-k/pkg/util/test"
+)
+
+func initializeKitMatchCmdOptions(t *testing.T, objects ...runtime.Object) *cobra.Command {
+	t.Helper()
+
+	fakeClient, err := test.NewFakeClient(objects...)
+	assert.Nil(t, err)
+	options := RootCmdOptions{
+		Context: context.Background(),
+		_client: fakeClient,
+	}
+	rootCmd := kamelPreAddCommandInit(&options)
+	rootCmd.Run = test.EmptyRun
+	kitMatchCmd, _ := newKitMatchCmd(&options)
+	rootCmd.AddCommand(kitMatchCmd)
+	kamelTestPostAddCommandInit(t, rootCmd)
+
+	return rootCmd
+}
+
+func TestKitMatch(t *testing.T) {
+	kit := func(name string, kitType string, dependencies ...string) *v1.IntegrationKit {
+		return &v1.IntegrationKit{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: v1.SchemeGroupVersion.String(),
+				Kind:       v1.IntegrationKitKind,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      name,
+				Labels: map[string]string{
+					v1.IntegrationKitTypeLabel: kitType,
+				},
+			},
+			Spec: v1.IntegrationKitSpec{
+				Dependencies: dependencies,
+			},
+			Status: v1.IntegrationKitStatus{
+				Phase: v1.IntegrationKitPhaseReady,
+			},
+		}
+	}
+	integration := &v1.Integration{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1.SchemeGroupVersion.String(),
+			Kind:       v1.IntegrationKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "my-integration",
+		},
+		Status: v1.IntegrationStatus{
+			Dependencies: []string{"camel:core", "camel:http"},
+		},
+	}
+
+	pl := v1.NewIntegrationPlatform("default", "camel-k")
+	pl.Status.Version = defaults.Version
+
+	rootCmd := initializeKitMatchCmdOptions(t,
+		&pl,
+		integration,
+		kit("my-kit-1", v1.IntegrationKitTypePlatform, "camel:core"),
+		kit("my-kit-2", v1.IntegrationKitTypeExternal, "camel:core", "camel:http"),
+		kit("my-kit-3", v1.IntegrationKitTypeUser, "camel:core", "camel:http"),
+	)
+
+	output, err := test.ExecuteCommand(rootCmd, "match", "my-integration", "-n", "default")
+	assert.Nil(t, err)
+	assert.Equal(t, "NAME\t\tPHASE\tSCORE\tMATCH\tREASON\n"+
+		"my-kit-2\tReady\t100\ttrue\t\n"+
+		"my-kit-1\tReady\t39\tfalse\tIntegration and integration-kit dependencies do not match: camel:http\n", output)
+
+	output, err = test.ExecuteCommand(rootCmd, "match", "my-other-integration", "-n", "default")
+	assert.Nil(t, err)
+	assert.Equal(t, "Integration 'my-other-integration' does not exist.\n", output)
+
+	_, err = test.ExecuteCommand(rootCmd, "match", "-n", "default")
+	assert.NotNil(t, err)
+}
@@ -65,6 +65,7 @@ import (
 	"github.com/apache/camel-k/pkg/trait"
 	"github.com/apache/camel-k/pkg/util"
 	"github.com/apache/camel-k/pkg/util/defaults"
+	"github.com/apache/camel-k/pkg/util/digest"
 	"github.com/apache/camel-k/pkg/util/dsl"
 	"github.com/apache/camel-k/pkg/util/kubernetes"
 	k8slog "github.com/apache/camel-k/pkg/util/kubernetes/log"
@@ -867,6 +868,9 @@ func (o *runCmdOptions) uploadFileOrDirectory(platform *v1.IntegrationPlatform,
 			dependency := fmt.Sprintf("mvn:%s:%s:%s:%s", gav.GroupID, gav.ArtifactID, gav.Type, gav.Version)
 			o.PrintfVerboseOutf(cmd, "Added %s to the Integration's dependency list \n", dependency)
 			integration.Spec.AddDependency(dependency)
+			if err := addDependencyChecksum(integration, dependency, path); err != nil {
+				return err
+			}
 			// Upload JAR
 			return o.uploadAsMavenArtifact(gav, path, platform, integration.Namespace, options, cmd)
 		default:
@@ -877,11 +881,40 @@ func (o *runCmdOptions) uploadFileOrDirectory(platform *v1.IntegrationPlatform,
 			dependency := fmt.Sprintf("registry-mvn:%s:%s:%s:%s@%s", gav.GroupID, gav.ArtifactID, gav.Type, gav.Version, mountPath)
 			o.PrintfVerboseOutf(cmd, "Added %s to the Integration's dependency list \n", dependency)
 			integration.Spec.AddDependency(dependency)
+			if err := addDependencyChecksum(integration, dependency, path); err != nil {
+				return err
+			}
 			return o.uploadAsMavenArtifact(gav, path, platform, integration.Namespace, options, cmd)
 		}
 	})
 }
 
+// addDependencyChecksum records the checksum of the file content of the dependency, so that the integration kits
+// are matched against the uploaded content rather than the dependency coordinates.
+func addDependencyChecksum(integration *v1.Integration, dependency string, path string) error {
+	sha1, err := digest.ComputeSHA1(path)
+	if err != nil {
+		return err
+	}
+	checksums := make(map[string]string)
+	if value, ok := integration.Annotations[v1.DependencyChecksumsAnnotation]; ok {
+		if err := json.Unmarshal([]byte(value), &checksums); err != nil {
+			return err
+		}
+	}
+	checksums[dependency] = "sha1:" + sha1
+	value, err := json.Marshal(checksums)
+	if err != nil {
+		return err
+	}
+	if integration.Annotations == nil {
+		integration.Annotations = make(map[string]string)
+	}
+	integration.Annotations[v1.DependencyChecksumsAnnotation] = string(value)
+
+	return nil
+}
+
 func getMountPath(targetPath string, dirName string, path string) (string, error) {
 	// if the target path is a file then use that as the exact mount path
 	if filepath.Ext(targetPath) != "" {
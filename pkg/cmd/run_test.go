@@ -666,3 +666,22 @@ func TestResolveJsonPodTemplateWithSupplementalGroups(t *testing.T) {
 	assert.Equal(t, 1, len(integrationSpec.PodTemplate.Spec.SecurityContext.SupplementalGroups))
 	assert.Contains(t, integrationSpec.PodTemplate.Spec.SecurityContext.SupplementalGroups, int64(666))
 }
+
+func TestAddDependencyChecksum(t *testing.T) {
+	var tmpFile *os.File
+	var err error
+	if tmpFile, err = ioutil.TempFile("", "camel-k-*.jar"); err != nil {
+		t.Error(err)
+	}
+
+	assert.Nil(t, tmpFile.Close())
+	assert.Nil(t, ioutil.WriteFile(tmpFile.Name(), []byte("content"), 0o400))
+
+	integration := &v1.Integration{}
+	assert.Nil(t, addDependencyChecksum(integration, "mvn:org.apache.camel.k.external:my-lib:jar:1.0", tmpFile.Name()))
+	assert.Nil(t, addDependencyChecksum(integration, "registry-mvn:org.apache.camel.k.external:my-file:txt:1.0@/tmp/my.txt", tmpFile.Name()))
+	assert.JSONEq(t, `{
+		"mvn:org.apache.camel.k.external:my-lib:jar:1.0": "sha1:BA8G/XdAkkeNRQd09bowxdp4rMg=",
+		"registry-mvn:org.apache.camel.k.external:my-file:txt:1.0@/tmp/my.txt": "sha1:BA8G/XdAkkeNRQd09bowxdp4rMg="
+	}`, integration.Annotations[v1.DependencyChecksumsAnnotation])
+}
@@ -19,21 +19,31 @@ package integration
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/pkg/errors"
 
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/kitmatch"
 	"github.com/apache/camel-k/pkg/trait"
 	"github.com/apache/camel-k/pkg/util/digest"
 	"github.com/apache/camel-k/pkg/util/kubernetes"
 )
 
-func newBuildKitAction() Action {
-	return &buildKitAction{}
+func newBuildKitAction(kits kitReaders) Action {
+	return &buildKitAction{
+		kits: kits,
+	}
 }
 
 type buildKitAction struct {
 	baseAction
+	kits kitReaders
 }
 
 func (action *buildKitAction) Name() string {
@@ -66,12 +76,30 @@ func (action *buildKitAction) Handle(ctx context.Context, integration *v1.Integr
 		// IntegrationKit fully defined so find it
 		action.L.Debugf("Finding integration kit %s for integration %s\n", integration.Status.IntegrationKit.Name, integration.Name)
 		kit, err := kubernetes.GetIntegrationKit(ctx, action.client, integration.Status.IntegrationKit.Name, integration.Status.IntegrationKit.Namespace)
-		if err != nil {
+		if k8serrors.IsNotFound(err) {
+			// The kit may have been deleted, so let's remove the dangling reference,
+			// and fall back to the kits matching the integration
+			action.L.Info("Integration kit not found, falling back to the integration kits matching the integration", "integrationkit", integration.Status.IntegrationKit.Name, "namespace", integration.Status.IntegrationKit.Namespace)
+			kitBuilds.clear(ctrl.ObjectKeyFromObject(integration))
+			integration.Status.SetCondition(v1.IntegrationConditionKitAvailable, corev1.ConditionFalse, v1.IntegrationConditionKitNotFoundReason,
+				fmt.Sprintf("integration kit %s/%s not found, looking up the integration kits matching the integration", integration.Status.IntegrationKit.Namespace, integration.Status.IntegrationKit.Name))
+			integration.SetIntegrationKit(nil)
+			return integration, nil
+		} else if err != nil {
 			return nil, errors.Wrapf(err, "unable to find integration kit %s/%s, %s", integration.Status.IntegrationKit.Namespace, integration.Status.IntegrationKit.Name, err)
 		}
 
+		// The integration is not matched again while the kit built for it, as no kit matched it, is building
+		key := ctrl.ObjectKeyFromObject(integration)
+		if kit.Status.Phase == v1.IntegrationKitPhaseReady || kit.Status.Phase == v1.IntegrationKitPhaseError {
+			kitBuilds.clear(key)
+		} else if _, ok := kitBuilds.pending(key); ok {
+			action.L.Debug("Integration kit is building, deferring the integration kit matching", "integration", integration.Name, "integrationkit", kit.Name, "namespace", integration.Namespace)
+			return nil, nil
+		}
+
 		if kit.Labels[v1.IntegrationKitTypeLabel] == v1.IntegrationKitTypePlatform {
-			match, err := integrationMatches(integration, kit)
+			match, err := kitStillMatches(ctx, action.kits.reader(action.client), integration, kit)
 			if err != nil {
 				return nil, errors.Wrapf(err, "unable to match any integration kit with integration %s/%s", integration.Namespace, integration.Name)
 			} else if !match {
@@ -83,6 +111,7 @@ func (action *buildKitAction) Handle(ctx context.Context, integration *v1.Integr
 				// All tests & conditionals check for a nil assignment
 				//
 				action.L.Debug("No match found between integration and integrationkit. Resetting integration's integrationkit to empty", "integration", integration.Name, "integrationkit", integration.Status.IntegrationKit.Name, "namespace", integration.Namespace)
+				kitBuilds.clear(key)
 				integration.SetIntegrationKit(nil)
 				return integration, nil
 			}
@@ -100,12 +129,32 @@ func (action *buildKitAction) Handle(ctx context.Context, integration *v1.Integr
 			return integration, nil
 		}
 
+		kitBuilds.restart(key)
 		return nil, nil
 	}
 
 	action.L.Debug("No kit specified in integration status so looking up", "integration", integration.Name, "namespace", integration.Namespace)
-	existingKits, err := lookupKitsForIntegration(ctx, action.client, integration)
-	if err != nil {
+	existingKits, report, err := LookupKitsForIntegrationWithReport(ctx, action.kits.reader(action.client), integration)
+	if errors.Is(err, errPlatformNotReady) {
+		// The integration is reconciled again once the platform becomes ready
+		action.L.Info("Integration platform is not ready, deferring the integration kit lookup")
+		return nil, nil
+	} else if errors.Is(err, errStaleStatus) {
+		// The status is recomputed before the integration kits are looked up again
+		action.L.Info("Integration status is stale, deferring the integration kit lookup")
+		integration.Initialize()
+		return integration, nil
+	} else if errors.Is(err, errTransitionalVersion) {
+		// The integration is initialized again, with the operator version, before the integration kits are looked up
+		action.L.Info("Integration status version is transitional, deferring the integration kit lookup", "version", integration.Status.Version)
+		integration.Initialize()
+		return integration, nil
+	} else if category, _ := MatchErrorCategoryOf(err); category == MatchErrorPermanent {
+		// The lookup is not retried until the configuration is fixed, that reconciles the integration again
+		action.L.Error(err, "Failed to lookup kits for integration, until the configuration is fixed")
+		integration.Status.SetErrorCondition(v1.IntegrationConditionKitMatched, v1.IntegrationConditionKitLookupFailedReason, err)
+		return integration, nil
+	} else if err != nil {
 		return nil, errors.Wrapf(err, "failed to lookup kits for integration %s/%s", integration.Namespace, integration.Name)
 	}
 
@@ -114,28 +163,68 @@ func (action *buildKitAction) Handle(ctx context.Context, integration *v1.Integr
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to apply traits to integration %s/%s", integration.Namespace, integration.Name)
 	}
+	setKitMatchedCondition(integration, report, env.Platform != nil && env.Platform.Status.Build.KitMatching.Report)
 
 	action.L.Debug("Searching integration kits to assign to integration", "integration", integration.Name, "namespace", integration.Namespace)
+	// The existing kits are selected with the matching options they have been looked up with
+	options, err := kitmatch.NewNamespaceOptions(ctx, action.kits.policyReader(action.client), env.Platform, integration.Namespace)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to resolve the kit matching options for integration %s/%s", integration.Namespace, integration.Name)
+	}
+	options.CacheInfluencingTraits()
+	integrationKit, err := action.selectKit(ctx, integration, env.IntegrationKits, existingKits, options, report)
+	if err != nil {
+		return nil, err
+	}
+
+	if integrationKit != nil {
+
+		action.L.Debug("Setting integration kit for integration", "integration", integration.Name, "namespace", integration.Namespace, "integration kit", integrationKit.Name)
+		// Set the kit name so the next handle loop, will fall through the
+		// same path as integration with a user defined kit
+		integration.SetIntegrationKit(integrationKit)
+		// The reuses are only counted for analytics, so that failing to record them does not fail the binding
+		if isExistingKit(existingKits, integrationKit) {
+			if err := recordKitReuse(ctx, action.client, integrationKit); err != nil {
+				action.L.Error(err, "Failed to record the reuse of the integration kit", "integration kit", integrationKit.Name, "namespace", integrationKit.Namespace)
+			}
+		}
+		if integrationKit.Status.Phase == v1.IntegrationKitPhaseReady {
+			integration.Status.Phase = v1.IntegrationPhaseDeploying
+		} else if !isExistingKit(existingKits, integrationKit) && env.Platform != nil && env.Platform.Status.Build.KitMatching.BuildRequeueBackoff != nil {
+			// A new kit is built as no kit matched the integration
+			kitBuilds.start(ctrl.ObjectKeyFromObject(integration), env.Platform.Status.Build.KitMatching.BuildRequeueBackoff.Duration)
+		}
+	} else {
+		action.L.Debug("Not yet able to assign an integration kit to integration", "integration", integration.Name, "namespace", integration.Namespace)
+	}
+
+	return integration, nil
+}
+
+// selectKit selects the best of the existing kits matching the kits of the environment, according to the matching
+// options, or creates the kits of the environment that no existing kit matches. The selections are recorded to the audit sink.
+func (action *buildKitAction) selectKit(ctx context.Context, integration *v1.Integration, envKits []v1.IntegrationKit,
+	existingKits []v1.IntegrationKit, options kitmatch.Options, report *MatchReport) (*v1.IntegrationKit, error) {
 	var integrationKit *v1.IntegrationKit
 kits:
-	for _, kit := range env.IntegrationKits {
+	for _, kit := range envKits {
 		kit := kit
 
 		for i := range existingKits {
 			k := &existingKits[i]
 
 			action.L.Debug("Comparing existing kit with environment", "env kit", kit.Name, "existing kit", k.Name)
-			match, err := kitMatches(&kit, k)
+			match, err := kitmatch.KitMatches(integration, &kit, k, options)
 			if err != nil {
 				return nil, errors.Wrapf(err, "error occurred matches integration kits with environment for integration %s/%s", integration.Namespace, integration.Name)
 			}
 			if match {
-				if integrationKit == nil ||
-					integrationKit.Status.Phase != v1.IntegrationKitPhaseReady && k.Status.Phase == v1.IntegrationKitPhaseReady ||
-					integrationKit.Status.Phase == v1.IntegrationKitPhaseReady && k.Status.Phase == v1.IntegrationKitPhaseReady && k.HasHigherPriorityThan(integrationKit) {
+				if isBetterKit(k, integrationKit) {
 					integrationKit = k
 					action.L.Debug("Found matching kit", "integration kit", integrationKit.Name)
 				}
+				auditKitSelection(ctx, integration, k, AuditOutcomeMatched, "Existing integration kit matches the integration")
 
 				continue kits
 			} else {
@@ -147,23 +236,48 @@ kits:
 		if err := action.client.Create(ctx, &kit); err != nil {
 			return nil, errors.Wrapf(err, "failed to create new integration kit for integration %s/%s", integration.Namespace, integration.Name)
 		}
+		reason := "No existing integration kit matches the integration"
+		if report != nil {
+			reason += ": " + report.Summary(maxMatchReportLength)
+		}
+		auditKitSelection(ctx, integration, &kit, AuditOutcomeBuilt, reason)
 		if integrationKit == nil {
 			integrationKit = &kit
 		}
 	}
 
-	if integrationKit != nil {
+	return integrationKit, nil
+}
 
-		action.L.Debug("Setting integration kit for integration", "integration", integration.Name, "namespace", integration.Namespace, "integration kit", integrationKit.Name)
-		// Set the kit name so the next handle loop, will fall through the
-		// same path as integration with a user defined kit
-		integration.SetIntegrationKit(integrationKit)
-		if integrationKit.Status.Phase == v1.IntegrationKitPhaseReady {
-			integration.Status.Phase = v1.IntegrationPhaseDeploying
+// isExistingKit returns whether the kit is one of the existing kits, rather than a kit created for the integration.
+func isExistingKit(kits []v1.IntegrationKit, kit *v1.IntegrationKit) bool {
+	for i := range kits {
+		if kits[i].Namespace == kit.Namespace && kits[i].Name == kit.Name {
+			return true
 		}
-	} else {
-		action.L.Debug("Not yet able to assign an integration kit to integration", "integration", integration.Name, "namespace", integration.Namespace)
 	}
 
-	return integration, nil
+	return false
+}
+
+// setKitMatchedCondition records the reason why no kit matches the integration, before a new kit is built, as
+// a condition of the integration. The truncated match report is recorded as the condition message if enabled,
+// including when kits match.
+func setKitMatchedCondition(integration *v1.Integration, report *MatchReport, withReport bool) {
+	if report.Matched() > 0 {
+		if withReport {
+			integration.Status.SetCondition(v1.IntegrationConditionKitMatched, corev1.ConditionTrue,
+				v1.IntegrationConditionKitMatchedReason, report.Summary(maxMatchReportLength))
+		} else {
+			integration.Status.RemoveCondition(v1.IntegrationConditionKitMatched)
+		}
+		return
+	}
+
+	message := fmt.Sprintf("%d integration kit(s) evaluated, 0 matching", len(report.Evaluations))
+	if withReport {
+		message = report.Summary(maxMatchReportLength)
+	}
+	integration.Status.SetCondition(v1.IntegrationConditionKitMatched, corev1.ConditionFalse,
+		report.NotMatchedReason(), message)
 }
@@ -0,0 +1,189 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/pkg/kitmatch"
+	"github.com/apache/camel-k/pkg/util/log"
+	"github.com/apache/camel-k/pkg/util/test"
+)
+
+func TestSelectKit_DependenciesOnlyMode(t *testing.T) {
+	integration := &v1.Integration{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-integration",
+		},
+		Spec: v1.IntegrationSpec{
+			Traits: v1.Traits{
+				Builder: &traitv1.BuilderTrait{
+					Properties: []string{"build-key1=build-value1"},
+				},
+			},
+		},
+		Status: v1.IntegrationStatus{
+			Dependencies: []string{"camel-core"},
+		},
+	}
+
+	existing := v1.IntegrationKit{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1.SchemeGroupVersion.String(),
+			Kind:       v1.IntegrationKitKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-kit-1",
+			Labels: map[string]string{
+				v1.IntegrationKitTypeLabel: v1.IntegrationKitTypePlatform,
+			},
+		},
+		Spec: v1.IntegrationKitSpec{
+			Dependencies: []string{"camel-core", "camel-irc"},
+			Traits: v1.IntegrationKitTraits{
+				Builder: &traitv1.BuilderTrait{
+					Properties: []string{"build-key1=build-value2"},
+				},
+			},
+		},
+		Status: v1.IntegrationKitStatus{
+			Phase: v1.IntegrationKitPhaseReady,
+		},
+	}
+	envKit := v1.NewIntegrationKit("ns", "my-kit-2")
+	envKit.Labels = map[string]string{
+		v1.IntegrationKitTypeLabel: v1.IntegrationKitTypePlatform,
+	}
+	envKit.Spec.Dependencies = []string{"camel-core"}
+	envKit.Spec.Traits.Builder = &traitv1.BuilderTrait{
+		Properties: []string{"build-key1=build-value1"},
+	}
+
+	c, err := test.NewFakeClient(&existing)
+	assert.Nil(t, err)
+
+	a := buildKitAction{}
+	a.InjectLogger(log.Log)
+	a.InjectClient(c)
+
+	pl := &v1.IntegrationPlatform{}
+	pl.Status.Build.KitMatching.Mode = v1.IntegrationKitMatchModeDependenciesOnly
+	options := kitmatch.NewOptions(pl)
+
+	// The kit matched by the lookup is the one that is selected
+	ok, err := kitmatch.IntegrationMatches(integration, &existing, options)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+
+	selected, err := a.selectKit(context.TODO(), integration, []v1.IntegrationKit{*envKit}, []v1.IntegrationKit{existing}, options, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "my-kit-1", selected.Name)
+
+	// No new kit is built
+	created := v1.NewIntegrationKit("ns", "my-kit-2")
+	assert.NotNil(t, c.Get(context.TODO(), ctrl.ObjectKeyFromObject(created), created))
+}
+
+func TestSelectKit_UnionTraitMatchMode(t *testing.T) {
+	integration := &v1.Integration{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-integration",
+		},
+		Spec: v1.IntegrationSpec{
+			Traits: v1.Traits{
+				Builder: &traitv1.BuilderTrait{
+					Properties: []string{"build-key1=build-value1"},
+				},
+			},
+		},
+		Status: v1.IntegrationStatus{
+			Dependencies: []string{"camel-core"},
+		},
+	}
+
+	existing := v1.IntegrationKit{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1.SchemeGroupVersion.String(),
+			Kind:       v1.IntegrationKitKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-kit-1",
+			Labels: map[string]string{
+				v1.IntegrationKitTypeLabel: v1.IntegrationKitTypePlatform,
+			},
+		},
+		Spec: v1.IntegrationKitSpec{
+			Dependencies: []string{"camel-core"},
+			Traits: v1.IntegrationKitTraits{
+				Builder: &traitv1.BuilderTrait{
+					Properties: []string{"build-key1=build-value1"},
+				},
+				Quarkus: &traitv1.QuarkusTrait{
+					PackageTypes: []traitv1.QuarkusPackageType{traitv1.FastJarPackageType},
+				},
+			},
+		},
+		Status: v1.IntegrationKitStatus{
+			Phase: v1.IntegrationKitPhaseReady,
+		},
+	}
+	envKit := func() v1.IntegrationKit {
+		kit := v1.NewIntegrationKit("ns", "my-kit-2")
+		kit.Labels = map[string]string{
+			v1.IntegrationKitTypeLabel: v1.IntegrationKitTypePlatform,
+		}
+		kit.Spec.Dependencies = []string{"camel-core"}
+		kit.Spec.Traits.Builder = &traitv1.BuilderTrait{
+			Properties: []string{"build-key1=build-value1"},
+		}
+		return *kit
+	}
+
+	c, err := test.NewFakeClient(&existing)
+	assert.Nil(t, err)
+
+	a := buildKitAction{}
+	a.InjectLogger(log.Log)
+	a.InjectClient(c)
+
+	pl := &v1.IntegrationPlatform{}
+	pl.Status.Build.KitMatching.TraitMatchMode = v1.IntegrationKitTraitMatchModeUnion
+
+	// The trait set on the kit only is compared to the defaults
+	selected, err := a.selectKit(context.TODO(), integration, []v1.IntegrationKit{envKit()}, []v1.IntegrationKit{existing}, kitmatch.NewOptions(pl), nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "my-kit-1", selected.Name)
+
+	// The traits are compared exactly by default, so that a new kit is built
+	selected, err = a.selectKit(context.TODO(), integration, []v1.IntegrationKit{envKit()}, []v1.IntegrationKit{existing}, kitmatch.DefaultOptions(), nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "my-kit-2", selected.Name)
+}
@@ -27,11 +27,13 @@ import (
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
@@ -45,6 +47,7 @@ import (
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
 	"github.com/apache/camel-k/pkg/client"
 	camelevent "github.com/apache/camel-k/pkg/event"
+	"github.com/apache/camel-k/pkg/kitmatch"
 	"github.com/apache/camel-k/pkg/platform"
 	"github.com/apache/camel-k/pkg/util/digest"
 	"github.com/apache/camel-k/pkg/util/kubernetes"
@@ -57,15 +60,45 @@ func Add(mgr manager.Manager) error {
 	if err != nil {
 		return err
 	}
-	return add(mgr, c, newReconciler(mgr, c))
+	policies, err := newMatchPolicyCache(mgr)
+	if err != nil {
+		return err
+	}
+	return add(mgr, c, policies, newReconciler(mgr, c, policies))
+}
+
+// newMatchPolicyCache returns the cache of the kit match policy ConfigMaps, restricted by name, so that the
+// policies are watched and read without caching all the ConfigMaps.
+func newMatchPolicyCache(mgr manager.Manager) (cache.Cache, error) {
+	policies, err := cache.New(mgr.GetConfig(), cache.Options{
+		Scheme:    mgr.GetScheme(),
+		Mapper:    mgr.GetRESTMapper(),
+		Namespace: platform.GetOperatorWatchNamespace(),
+		SelectorsByObject: cache.SelectorsByObject{
+			&corev1.ConfigMap{}: {
+				Field: fields.OneTermEqualSelector("metadata.name", v1.IntegrationKitMatchPolicyConfigMap),
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return policies, mgr.Add(policies)
 }
 
-func newReconciler(mgr manager.Manager, c client.Client) reconcile.Reconciler {
+func newReconciler(mgr manager.Manager, c client.Client, policies ctrl.Reader) reconcile.Reconciler {
 	return monitoring.NewInstrumentedReconciler(
 		&reconcileIntegration{
 			client:   c,
 			scheme:   mgr.GetScheme(),
 			recorder: mgr.GetEventRecorderFor("camel-k-integration-controller"),
+			// The kits are read from the API server while the cache is cold, or when it is disabled
+			kits: kitReaders{
+				apiReader: mgr.GetAPIReader(),
+				cache:     mgr.GetCache(),
+				policies:  policies,
+			},
 		},
 		schema.GroupVersionKind{
 			Group:   v1.SchemeGroupVersion.Group,
@@ -75,7 +108,7 @@ func newReconciler(mgr manager.Manager, c client.Client) reconcile.Reconciler {
 	)
 }
 
-func add(mgr manager.Manager, c client.Client, r reconcile.Reconciler) error {
+func add(mgr manager.Manager, c client.Client, policies cache.Cache, r reconcile.Reconciler) error {
 	b := builder.ControllerManagedBy(mgr).
 		Named("integration-controller").
 		// Watch for changes to primary resource Integration
@@ -142,7 +175,20 @@ func add(mgr manager.Manager, c client.Client, r reconcile.Reconciler) error {
 					integration := &list.Items[i]
 					log.Debug("Integration Controller: Assessing integration", "integration", integration.Name, "namespace", integration.Namespace)
 
-					if match, err := integrationMatches(integration, kit); err != nil {
+					pl, err := platform.GetForResource(context.Background(), c, integration)
+					if err != nil && !k8serrors.IsNotFound(err) {
+						log.Errorf(err, "Error retrieving platform for integration %q", integration.Name)
+
+						continue
+					}
+					// The kit is matched as when the integration looks it up, according to the match policy of its namespace
+					options, err := kitmatch.NewNamespaceOptions(context.Background(), policies, pl, integration.Namespace)
+					if err != nil {
+						log.Errorf(err, "Error resolving the kit matching options for integration %q", integration.Name)
+
+						continue
+					}
+					if match, err := kitmatch.IntegrationMatches(integration, kit, options); err != nil {
 						log.Errorf(err, "Error matching integration %q with kit %q", integration.Name, kit.Name)
 
 						continue
@@ -164,7 +210,7 @@ func add(mgr manager.Manager, c client.Client, r reconcile.Reconciler) error {
 				return requests
 			})).
 		// Watch for IntegrationPlatform phase transitioning to ready and enqueue
-		// requests for any integrations that are in phase waiting for platform
+		// requests for any integrations that are in phase waiting for platform or building kit
 		Watches(&source.Kind{Type: &v1.IntegrationPlatform{}},
 			handler.EnqueueRequestsFromMapFunc(func(a ctrl.Object) []reconcile.Request {
 				var requests []reconcile.Request
@@ -189,7 +235,7 @@ func add(mgr manager.Manager, c client.Client, r reconcile.Reconciler) error {
 					}
 
 					for _, integration := range list.Items {
-						if integration.Status.Phase == v1.IntegrationPhaseWaitingForPlatform {
+						if integration.Status.Phase == v1.IntegrationPhaseWaitingForPlatform || integration.Status.Phase == v1.IntegrationPhaseBuildingKit {
 							log.Infof("Platform %s ready, wake-up integration: %s", p.Name, integration.Name)
 							requests = append(requests, reconcile.Request{
 								NamespacedName: types.NamespacedName{
@@ -201,6 +247,32 @@ func add(mgr manager.Manager, c client.Client, r reconcile.Reconciler) error {
 					}
 				}
 
+				return requests
+			})).
+		// Watch for the kit match policy ConfigMaps, and enqueue requests for the integrations of their namespace
+		// that are looking up a kit, or running with a kit that may be re-evaluated
+		Watches(source.NewKindWithCache(&corev1.ConfigMap{}, policies),
+			handler.EnqueueRequestsFromMapFunc(func(a ctrl.Object) []reconcile.Request {
+				var requests []reconcile.Request
+
+				list := &v1.IntegrationList{}
+				if err := c.List(context.Background(), list, ctrl.InNamespace(a.GetNamespace())); err != nil {
+					log.Error(err, "Failed to list integrations")
+					return requests
+				}
+
+				for _, integration := range list.Items {
+					if integration.Status.Phase == v1.IntegrationPhaseBuildingKit || integration.Status.Phase == v1.IntegrationPhaseRunning {
+						log.Infof("Kit match policy %s changed, notify integration: %s", a.GetName(), integration.Name)
+						requests = append(requests, reconcile.Request{
+							NamespacedName: types.NamespacedName{
+								Namespace: integration.Namespace,
+								Name:      integration.Name,
+							},
+						})
+					}
+				}
+
 				return requests
 			})).
 		// Watch for the owned Deployments
@@ -254,6 +326,7 @@ type reconcileIntegration struct {
 	client   client.Client
 	scheme   *runtime.Scheme
 	recorder record.EventRecorder
+	kits     kitReaders
 }
 
 // Reconcile reads that state of the cluster for an Integration object and makes changes based on the state read
@@ -281,6 +354,8 @@ func (r *reconcileIntegration) Reconcile(ctx context.Context, request reconcile.
 			// Request object not found, could have been deleted after reconcile request.
 			// Owned objects are automatically garbage collected. For additional cleanup logic use finalizers.
 			// Return and don't requeue
+			kitBuilds.clear(request.NamespacedName)
+			kitReevaluations.clear(request.NamespacedName)
 			return reconcile.Result{}, nil
 		}
 		// Error reading the object - requeue the request.
@@ -299,8 +374,8 @@ func (r *reconcileIntegration) Reconcile(ctx context.Context, request reconcile.
 	actions := []Action{
 		NewPlatformSetupAction(),
 		NewInitializeAction(),
-		newBuildKitAction(),
-		NewMonitorAction(),
+		newBuildKitAction(r.kits),
+		NewMonitorAction(r.kits),
 	}
 
 	for _, a := range actions {
@@ -338,7 +413,17 @@ func (r *reconcileIntegration) Reconcile(ctx context.Context, request reconcile.
 		}
 	}
 
-	return reconcile.Result{}, nil
+	// The integration is matched again once the backoff of the kit built for it is over,
+	// or periodically while it is running
+	var requeueAfter time.Duration
+	if remaining, ok := kitBuilds.pending(request.NamespacedName); ok {
+		requeueAfter = remaining
+	}
+	if remaining, ok := kitReevaluations.pending(request.NamespacedName); ok && (requeueAfter == 0 || remaining < requeueAfter) {
+		requeueAfter = remaining
+	}
+
+	return reconcile.Result{RequeueAfter: requeueAfter}, nil
 }
 
 func (r *reconcileIntegration) update(ctx context.Context, base *v1.Integration, target *v1.Integration) (reconcile.Result, error) {
@@ -19,219 +19,528 @@ package integration
 
 import (
 	"context"
-	"reflect"
-
-	"k8s.io/apimachinery/pkg/api/errors"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/util/validation"
 
 	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
 
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/kitmatch"
 	"github.com/apache/camel-k/pkg/platform"
-	"github.com/apache/camel-k/pkg/trait"
 	"github.com/apache/camel-k/pkg/util"
-	"github.com/apache/camel-k/pkg/util/defaults"
+	"github.com/apache/camel-k/pkg/util/kubernetes"
 	"github.com/apache/camel-k/pkg/util/log"
 )
 
+// tracerName is the name of the tracer instrumenting the kits matching.
+const tracerName = "github.com/apache/camel-k/pkg/controller/integration"
+
+// errPlatformNotReady is returned when the kits lookup is deferred until the integration platform is ready,
+// as the matching criteria derived from its configuration may not be reliable until then.
+var errPlatformNotReady = errors.New("integration platform is not ready")
+
+// errStaleStatus is returned when the kits lookup is refused because the integration status lags too many
+// generations behind its spec, as the dependencies and runtime it records may be outdated.
+var errStaleStatus = errors.New("integration status is stale")
+
+// errTransitionalVersion is returned when the kits lookup is deferred because the integration status version is
+// blank, or still the one of another operator version, e.g. during an operator upgrade.
+var errTransitionalVersion = errors.New("integration status version is transitional")
+
+// lookupKitsForIntegration returns the kits matching the integration. The errors are MatchError instances,
+// whose category tells whether the lookup can be retried.
 func lookupKitsForIntegration(ctx context.Context, c ctrl.Reader, integration *v1.Integration, options ...ctrl.ListOption) ([]v1.IntegrationKit, error) {
+	return lookupKits(ctx, c, integration, nil, options...)
+}
+
+// LookupKitsForIntegrationWithReport returns the kits matching the integration, along with the report of the
+// evaluation of all the kits that have been listed.
+func LookupKitsForIntegrationWithReport(ctx context.Context, c ctrl.Reader, integration *v1.Integration, options ...ctrl.ListOption) ([]v1.IntegrationKit, *MatchReport, error) {
+	report := &MatchReport{}
+	kits, err := lookupKits(ctx, c, integration, report, options...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return kits, report, nil
+}
+
+// lookupKits returns the kits matching the integration, recording their evaluation in the report, if any.
+// A trace span recording the number of kits listed and matched is created when a tracer provider is configured.
+func lookupKits(ctx context.Context, c ctrl.Reader, integration *v1.Integration, report *MatchReport, options ...ctrl.ListOption) ([]v1.IntegrationKit, error) {
+	ctx, span := otel.Tracer(tracerName).Start(ctx, "lookupKitsForIntegration",
+		trace.WithAttributes(attribute.String("integration", integration.Namespace+"/"+integration.Name)))
+	defer span.End()
+
+	start := time.Now()
+	kits, err := findKitsForIntegration(ctx, c, integration, report, options...)
+	span.SetAttributes(
+		attribute.Int("kits.matched", len(kits)),
+		attribute.Int64("duration.ms", time.Since(start).Milliseconds()),
+	)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return kits, err
+}
+
+func findKitsForIntegration(ctx context.Context, c ctrl.Reader, integration *v1.Integration, report *MatchReport, options ...ctrl.ListOption) ([]v1.IntegrationKit, error) {
 	pl, err := platform.GetForResource(ctx, c, integration)
-	if err != nil && !errors.IsNotFound(err) {
-		return nil, err
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return nil, apiError(err)
+	}
+	if pl != nil && pl.Status.Phase != v1.IntegrationPlatformPhaseReady {
+		return nil, transientError(errPlatformNotReady)
+	}
+	// The kit reuse can be disabled, e.g. to diagnose build issues, so that no kit is even listed
+	if pl != nil && pl.Status.Build.KitMatching.DisableReuse {
+		log.ForIntegration(integration).Info("Integration kit reuse is disabled by the platform", "platform", pl.Name)
+		return []v1.IntegrationKit{}, nil
 	}
 
-	kitTypes, err := labels.NewRequirement(v1.IntegrationKitTypeLabel, selection.In, []string{
-		v1.IntegrationKitTypePlatform,
-		v1.IntegrationKitTypeExternal,
-	})
+	// The match policy of the integration namespace, if any, overrides the platform configuration
+	matchOptions, err := kitmatch.NewNamespaceOptions(ctx, matchPolicyReader(c), pl, integration.Namespace)
 	if err != nil {
-		return nil, err
+		return nil, configError(err)
+	}
+	// The kit influencing traits are resolved once for all the kits
+	matchOptions.CacheInfluencingTraits()
+	if pl != nil && pl.Status.Build.KitMatching.Trace {
+		matchOptions.Trace = &kitmatch.MatchTrace{}
 	}
 
-	listOptions := []ctrl.ListOption{
-		ctrl.InNamespace(integration.GetIntegrationKitNamespace(pl)),
-		ctrl.MatchingLabels{
-			"camel.apache.org/runtime.version":  integration.Status.RuntimeVersion,
-			"camel.apache.org/runtime.provider": string(integration.Status.RuntimeProvider),
-		},
-		ctrl.MatchingLabelsSelector{
-			Selector: labels.NewSelector().Add(*kitTypes),
-		},
+	// The status is recomputed, and the lookup retried, once the integration is initialized again
+	if !kitmatch.StatusGenerationMatches(integration, matchOptions.MaxStatusGenerationSkew) {
+		return nil, transientError(errStaleStatus)
+	}
+	if !kitmatch.StatusVersionSettled(integration) {
+		return nil, transientError(errTransitionalVersion)
+	}
+	if err := validateLabelValues(integration, matchOptions); err != nil {
+		return nil, permanentError(err)
+	}
+	// The duplicate dependencies are ignored by the matching, but reported once per lookup
+	if duplicates := kitmatch.DuplicateDependencies(integration.Status.Dependencies); len(duplicates) > 0 {
+		log.ForIntegration(integration).Info("Integration has duplicate dependencies, please remove them", "duplicates", duplicates)
 	}
-	listOptions = append(listOptions, options...)
 
-	list := v1.NewIntegrationKitList()
-	if err := c.List(ctx, &list, listOptions...); err != nil {
-		return nil, err
+	kitTypes, err := reusableKitTypesSelector(matchOptions.ListExcludeErrors)
+	if err != nil {
+		return nil, permanentError(err)
+	}
+
+	runtimeLabels := ctrl.MatchingLabels{}
+	// The runtime versions matching a prefix, or a glob pattern, cannot be selected by label
+	if !matchOptions.RuntimeVersionPrefixMatch {
+		runtimeLabels["camel.apache.org/runtime.version"] = integration.Status.RuntimeVersion
+	}
+	// During a runtime provider upgrade window, the kits built for other providers are looked up as well
+	if !matchOptions.AllowOtherRuntimeProviders {
+		runtimeLabels["camel.apache.org/runtime.provider"] = string(integration.Status.RuntimeProvider)
+	}
+
+	// The kits created under another generation of the platform, e.g. before its base image changed, do not match.
+	// The generations of the other platforms do not relate to the one of the integration platform, so that their
+	// kits are not reused either.
+	var namespaces []string
+	if matchOptions.PlatformGeneration != "" {
+		namespaces = []string{integration.GetIntegrationKitNamespace(pl)}
+	} else if namespaces, err = kitNamespaces(ctx, c, integration, pl); err != nil {
+		return nil, configError(err)
+	}
+
+	// The kits are always listed as v1 resources: the API server converts the kits persisted under any
+	// other served version of the CRD, so that no conversion is needed before matching them.
+	candidates := make([]v1.IntegrationKit, 0)
+	// The kits are read from the API server if any namespace cannot be read from the cache
+	source := KitReadSourceCache
+	for _, namespace := range namespaces {
+		listOptions := []ctrl.ListOption{
+			ctrl.InNamespace(namespace),
+			runtimeLabels,
+			kitTypes,
+		}
+		listOptions = append(listOptions, options...)
+
+		kits, read, err := listKits(ctx, c, matchOptions.ListDisableCache, listOptions...)
+		kitLookups.record(err)
+		observeKitRead(read)
+		if err != nil {
+			return nil, apiError(err)
+		}
+		if read == KitReadSourceLive {
+			source = KitReadSourceLive
+		}
+		candidates = append(candidates, kits...)
 	}
 
+	trace.SpanFromContext(ctx).SetAttributes(
+		attribute.Int("kits.listed", len(candidates)),
+		attribute.String("kits.source", string(source)),
+	)
+	report.source(source)
+
+	// The kits are evaluated in a stable order when the lookup can return early, so that the same kit is returned
+	// whatever the order the kits are listed in
+	if matchOptions.EarlyReturnScore > 0 {
+		sortKits(candidates)
+	}
+
+	// The manifests referenced by the external kits are fetched once for all the kits
+	manifests := fetchKitManifests(ctx, candidates)
+
 	kits := make([]v1.IntegrationKit, 0)
-	for i := range list.Items {
-		kit := &list.Items[i]
-		match, err := integrationMatches(integration, kit)
+	for i := range candidates {
+		kit := &candidates[i]
+		// The kits in error can be excluded from the lookup, as if they were not listed, including the kits not
+		// labeled with their phase yet
+		if matchOptions.ListExcludeErrors && kit.Status.Phase == v1.IntegrationKitPhaseError {
+			continue
+		}
+		if isQuarantined(kit, matchOptions.QuarantineThreshold) {
+			log.ForIntegrationKit(kit).Debug("Integration kit is quarantined", "failures", kitFailures(kit))
+			report.add(kit, kitmatch.Mismatch("Integration kit is quarantined"))
+			continue
+		}
+		// The external kits referencing a manifest are matched against the dependencies and traits it declares
+		kit, err := resolveKitManifest(kit, manifests)
 		if err != nil {
-			return nil, err
-		} else if !match {
+			log.ForIntegrationKit(&candidates[i]).Info("Integration kit manifest cannot be fetched", "error", err.Error())
+			report.add(&candidates[i], kitmatch.Mismatch("Integration kit manifest cannot be fetched", err.Error()))
+			continue
+		}
+		if err := kit.Validate(); err != nil {
+			log.ForIntegrationKit(kit).Info("Integration kit status is inconsistent", "error", err.Error())
+		}
+		// The matching fails on the configuration of the traits, e.g. when they cannot be converted
+		decision, err := kitmatch.Match(integration, kit, matchOptions)
+		if err != nil {
+			return nil, permanentError(err)
+		}
+		report.add(kit, decision)
+		if !decision.Matched {
 			continue
 		}
 		kits = append(kits, *kit)
+		// The lookup can return as soon as a kit is good enough, rather than evaluating all the kits for the best one
+		if matchOptions.EarlyReturnScore > 0 {
+			score, err := kitmatch.MatchedScore(integration, kit, matchOptions)
+			if err != nil {
+				return nil, permanentError(err)
+			}
+			if score >= matchOptions.EarlyReturnScore {
+				log.ForIntegrationKit(kit).Debug("Integration kit is good enough, skipping the remaining kits", "score", score, "skipped", len(candidates)-i-1)
+				break
+			}
+		}
+	}
+	if matchOptions.Trace != nil {
+		observeMatchTrace(matchOptions.Trace)
+		report.trace(matchOptions.Trace)
 	}
 
+	kits = preferRuntimeProvider(kits, integration.Status.RuntimeProvider)
+
+	// The list order is not guaranteed by the API server, so let's sort the kits
+	// to get a deterministic selection across reconciliations.
+	sortKits(kits)
+	if matchOptions.PreferFasterBuilds {
+		sortKitsByBuildDuration(kits)
+	}
+	if matchOptions.PreferClosestRuntimeConfig {
+		if err := sortKitsByRuntimeConfigDistance(integration, kits); err != nil {
+			return nil, permanentError(err)
+		}
+	}
+	// The kits missing, or adding, the fewest peripheral dependencies are ranked first
+	if len(matchOptions.CoreDependencies) > 0 {
+		sortKitsByPeripheralDependencyDistance(integration, kits, matchOptions)
+	}
+	// The kits with known vulnerabilities still match, but their penalty lowers their score
+	if matchOptions.VulnerabilityPenalty > 0 {
+		if err := sortKitsByMatchedScore(integration, kits, matchOptions); err != nil {
+			return nil, permanentError(err)
+		}
+	}
+	report.rank(kits)
+
 	return kits, nil
 }
 
-// integrationMatches returns whether the v1.IntegrationKit meets the requirements of the v1.Integration.
-func integrationMatches(integration *v1.Integration, kit *v1.IntegrationKit) (bool, error) {
-	ilog := log.ForIntegration(integration)
-
-	ilog.Debug("Matching integration", "integration", integration.Name, "integration-kit", kit.Name, "namespace", integration.Namespace)
-	if !statusMatches(integration, kit, &ilog) {
-		return false, nil
+// FindBestKit returns the best kit matching the integration, or nil if none matches.
+// A ready kit is preferred over a kit that is not, then the kit with the highest priority.
+func FindBestKit(ctx context.Context, c ctrl.Reader, integration *v1.Integration) (*v1.IntegrationKit, error) {
+	kits, err := lookupKitsForIntegration(ctx, c, integration)
+	if err != nil {
+		return nil, err
 	}
 
-	// When a platform kit is created it inherits the traits from the integrations and as
-	// some traits may influence the build thus the artifacts present on the container image,
-	// we need to take traits into account when looking up for compatible kits.
-	//
-	// It could also happen that an integration is updated and a trait is modified, if we do
-	// not include traits in the lookup, we may use a kit that does not have all the
-	// characteristics required by the integration.
-	//
-	// A kit can be used only if it contains a subset of the traits and related configurations
-	// declared on integration.
-	if match, err := hasMatchingTraits(integration.Spec.Traits, kit.Spec.Traits); !match || err != nil {
-		ilog.Debug("Integration and integration-kit traits do not match", "integration", integration.Name, "integration-kit", kit.Name, "namespace", integration.Namespace)
-		return false, err
-	}
-	if !util.StringSliceContains(kit.Spec.Dependencies, integration.Status.Dependencies) {
-		ilog.Debug("Integration and integration-kit dependencies do not match", "integration", integration.Name, "integration-kit", kit.Name, "namespace", integration.Namespace)
-		return false, nil
+	var best *v1.IntegrationKit
+	for i := range kits {
+		if isBetterKit(&kits[i], best) {
+			best = &kits[i]
+		}
 	}
 
-	ilog.Debug("Matched Integration and integration-kit", "integration", integration.Name, "integration-kit", kit.Name, "namespace", integration.Namespace)
-	return true, nil
+	return best, nil
 }
 
-func statusMatches(integration *v1.Integration, kit *v1.IntegrationKit, ilog *log.Logger) bool {
-	if kit.Status.Phase == v1.IntegrationKitPhaseError {
-		ilog.Debug("Integration kit has a phase of Error", "integration-kit", kit.Name, "namespace", integration.Namespace)
-		return false
+// isBetterKit returns whether the kit is a better candidate than the current one, that may be nil.
+// A ready kit is preferred over a kit that is not, then the kit with the highest priority.
+func isBetterKit(kit *v1.IntegrationKit, current *v1.IntegrationKit) bool {
+	if current == nil {
+		return true
 	}
-	if kit.Status.Version != integration.Status.Version {
-		ilog.Debug("Integration and integration-kit versions do not match", "integration", integration.Name, "integration-kit", kit.Name, "namespace", integration.Namespace)
-		return false
+	ready := kit.Status.Phase == v1.IntegrationKitPhaseReady
+	if currentReady := current.Status.Phase == v1.IntegrationKitPhaseReady; ready != currentReady {
+		return ready
 	}
-	if kit.Status.RuntimeProvider != integration.Status.RuntimeProvider {
-		ilog.Debug("Integration and integration-kit runtime providers do not match", "integration", integration.Name, "integration-kit", kit.Name, "namespace", integration.Namespace)
-		return false
+
+	return ready && kit.HasHigherPriorityThan(current)
+}
+
+// KitSelectionStrategy returns the strategy kits are selected by, among the kits matching an integration, so that
+// the kits that are never selected can be analysed. A ready kit is preferred, then the kit with the highest priority,
+// then the kit that built faster if configured, then the oldest kit.
+func KitSelectionStrategy(options kitmatch.Options) kitmatch.SelectionStrategy {
+	return func(kit *v1.IntegrationKit, other *v1.IntegrationKit) bool {
+		if isBetterKit(kit, other) != isBetterKit(other, kit) {
+			return isBetterKit(kit, other)
+		}
+		if options.PreferFasterBuilds {
+			if duration, otherDuration := buildDuration(kit), buildDuration(other); duration != otherDuration {
+				return duration < otherDuration
+			}
+		}
+		// The kits are sorted by creation time, then by name, before being selected
+		if !kit.CreationTimestamp.Equal(&other.CreationTimestamp) {
+			return kit.CreationTimestamp.Before(&other.CreationTimestamp)
+		}
+
+		return kit.Name < other.Name
 	}
-	if kit.Status.RuntimeVersion != integration.Status.RuntimeVersion {
-		ilog.Debug("Integration and integration-kit runtime versions do not match", "integration", integration.Name, "integration-kit", kit.Name, "namespace", integration.Namespace)
-		return false
+}
+
+// kitStillMatches returns whether the kit still matches the integration, according to the matching options
+// configured on the integration platform, and the match policy of the integration namespace.
+func kitStillMatches(ctx context.Context, c ctrl.Reader, integration *v1.Integration, kit *v1.IntegrationKit) (bool, error) {
+	pl, err := platform.GetForResource(ctx, c, integration)
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return false, err
 	}
-	if len(integration.Status.Dependencies) != len(kit.Spec.Dependencies) {
-		ilog.Debug("Integration and integration-kit have different number of dependencies", "integration", integration.Name, "integration-kit", kit.Name, "namespace", integration.Namespace)
+	options, err := kitmatch.NewNamespaceOptions(ctx, matchPolicyReader(c), pl, integration.Namespace)
+	if err != nil {
+		return false, err
 	}
 
-	return true
+	return kitmatch.IntegrationMatches(integration, kit, options)
 }
 
-// kitMatches returns whether the two v1.IntegrationKit match.
-func kitMatches(kit1 *v1.IntegrationKit, kit2 *v1.IntegrationKit) (bool, error) {
-	version := kit1.Status.Version
-	if version == "" {
-		// Defaults with the version that is going to be set during the kit initialization
-		version = defaults.Version
+// kitNamespaces returns the namespaces where the kits are looked up, i.e., the integration kit namespace,
+// and the namespaces of the platforms whose kits can be reused, provided their runtime aligns with the integration platform.
+func kitNamespaces(ctx context.Context, c ctrl.Reader, integration *v1.Integration, pl *v1.IntegrationPlatform) ([]string, error) {
+	namespaces := []string{integration.GetIntegrationKitNamespace(pl)}
+	if pl == nil {
+		return namespaces, nil
 	}
-	if version != kit2.Status.Version {
-		return false, nil
+
+	for _, ref := range pl.Status.Build.KitMatching.ReusePlatforms {
+		parts := strings.SplitN(ref, "/", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, fmt.Errorf("invalid platform reference %q, must be namespace/name", ref)
+		}
+		other, err := kubernetes.GetIntegrationPlatform(ctx, c, parts[1], parts[0])
+		if k8serrors.IsNotFound(err) {
+			log.Debug("Integration platform to reuse kits from not found", "platform", ref)
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		if other.Status.Build.RuntimeVersion != pl.Status.Build.RuntimeVersion ||
+			other.Status.Build.RuntimeProvider != pl.Status.Build.RuntimeProvider {
+			log.Debug("Integration platform runtime does not align, its kits cannot be reused", "platform", ref)
+			continue
+		}
+		util.StringSliceUniqueAdd(&namespaces, other.Namespace)
 	}
-	if len(kit1.Spec.Dependencies) != len(kit2.Spec.Dependencies) {
-		return false, nil
+
+	return namespaces, nil
+}
+
+// CountKitsByPhase returns the number of kits, that can be reused by integrations, per phase in the given namespace.
+func CountKitsByPhase(ctx context.Context, c ctrl.Reader, namespace string) (map[v1.IntegrationKitPhase]int, error) {
+	kitTypes, err := reusableKitTypesSelector(false)
+	if err != nil {
+		return nil, err
 	}
-	if match, err := hasMatchingTraits(kit1.Spec.Traits, kit2.Spec.Traits); !match || err != nil {
-		return false, err
+
+	list := v1.NewIntegrationKitList()
+	if err := c.List(ctx, &list, ctrl.InNamespace(namespace), kitTypes); err != nil {
+		return nil, err
 	}
-	if !util.StringSliceContains(kit1.Spec.Dependencies, kit2.Spec.Dependencies) {
-		return false, nil
+
+	counts := make(map[v1.IntegrationKitPhase]int)
+	for _, kit := range list.Items {
+		counts[kit.Status.Phase]++
 	}
 
-	return true, nil
+	return counts, nil
 }
 
-func hasMatchingTraits(traits interface{}, kitTraits interface{}) (bool, error) {
-	traitMap, err := trait.ToTraitMap(traits)
+// reusableKitTypesSelector selects the kits that can be reused by integrations, i.e., the platform and external kits,
+// optionally excluding the kits labeled as in error. The kits not labeled with their phase yet are still selected.
+func reusableKitTypesSelector(excludeErrors bool) (ctrl.MatchingLabelsSelector, error) {
+	kitTypes, err := labels.NewRequirement(v1.IntegrationKitTypeLabel, selection.In, []string{
+		v1.IntegrationKitTypePlatform,
+		v1.IntegrationKitTypeExternal,
+	})
 	if err != nil {
-		return false, err
+		return ctrl.MatchingLabelsSelector{}, err
 	}
-	kitTraitMap, err := trait.ToTraitMap(kitTraits)
-	if err != nil {
-		return false, err
+	selector := labels.NewSelector().Add(*kitTypes)
+	if excludeErrors {
+		phases, err := labels.NewRequirement(v1.IntegrationKitPhaseLabel, selection.NotIn, []string{
+			v1.IntegrationKitPhaseLabelValue(v1.IntegrationKitPhaseError),
+		})
+		if err != nil {
+			return ctrl.MatchingLabelsSelector{}, err
+		}
+		selector = selector.Add(*phases)
 	}
-	catalog := trait.NewCatalog(nil)
 
-	for _, t := range catalog.AllTraits() {
-		if t == nil || !t.InfluencesKit() {
-			// We don't store the trait configuration if the trait cannot influence the kit behavior
-			continue
-		}
-		id := string(t.ID())
-		it, ok1 := findTrait(traitMap, id)
-		kt, ok2 := findTrait(kitTraitMap, id)
+	return ctrl.MatchingLabelsSelector{
+		Selector: selector,
+	}, nil
+}
 
-		if !ok1 && !ok2 {
-			continue
-		}
-		if !ok1 || !ok2 {
-			return false, nil
+// preferRuntimeProvider returns the kits built for the given runtime provider if any,
+// otherwise the kits built for other providers are returned as fallbacks.
+func preferRuntimeProvider(kits []v1.IntegrationKit, provider v1.RuntimeProvider) []v1.IntegrationKit {
+	preferred := make([]v1.IntegrationKit, 0, len(kits))
+	for _, kit := range kits {
+		if kit.Status.RuntimeProvider == provider {
+			preferred = append(preferred, kit)
 		}
-		if ct, ok := t.(trait.ComparableTrait); ok {
-			// if it's match trait use its matches method to determine the match
-			if match, err := matchesComparableTrait(ct, it, kt); !match || err != nil {
-				return false, err
-			}
-		} else {
-			if !matchesTrait(it, kt) {
-				return false, nil
-			}
+	}
+	if len(preferred) == 0 {
+		return kits
+	}
+
+	return preferred
+}
+
+// sortKits sorts the kits by creation time, then by name.
+func sortKits(kits []v1.IntegrationKit) {
+	sort.SliceStable(kits, func(i, j int) bool {
+		ti := kits[i].CreationTimestamp
+		tj := kits[j].CreationTimestamp
+		if !ti.Equal(&tj) {
+			return ti.Before(&tj)
 		}
+		return kits[i].Name < kits[j].Name
+	})
+}
+
+// sortKitsByBuildDuration sorts the kits by build duration, keeping the order of the kits that built as fast,
+// the kits whose build duration is unknown being ranked last.
+func sortKitsByBuildDuration(kits []v1.IntegrationKit) {
+	durations := make(map[string]time.Duration, len(kits))
+	for i := range kits {
+		durations[kits[i].Namespace+"/"+kits[i].Name] = buildDuration(&kits[i])
 	}
 
-	return true, nil
+	sort.SliceStable(kits, func(i, j int) bool {
+		return durations[kits[i].Namespace+"/"+kits[i].Name] < durations[kits[j].Namespace+"/"+kits[j].Name]
+	})
 }
 
-func findTrait(traitsMap map[string]map[string]interface{}, id string) (map[string]interface{}, bool) {
-	if trait, ok := traitsMap[id]; ok {
-		return trait, true
+// buildDuration returns the build duration of the kit, or the maximum duration when it is unknown.
+func buildDuration(kit *v1.IntegrationKit) time.Duration {
+	duration, err := time.ParseDuration(kit.Status.BuildDuration)
+	if err != nil {
+		return math.MaxInt64
 	}
 
-	if addons, ok := traitsMap["addons"]; ok {
-		if addon, ok := addons[id]; ok {
-			if trait, ok := addon.(map[string]interface{}); ok {
-				return trait, true
-			}
+	return duration
+}
+
+// sortKitsByRuntimeConfigDistance sorts the kits by the distance between their runtime configuration and the integration
+// one, keeping the order of the kits that are as close.
+func sortKitsByRuntimeConfigDistance(integration *v1.Integration, kits []v1.IntegrationKit) error {
+	distances := make(map[string]int, len(kits))
+	for i := range kits {
+		distance, err := kitmatch.RuntimeConfigDistance(integration, &kits[i])
+		if err != nil {
+			return err
 		}
+		distances[kits[i].Namespace+"/"+kits[i].Name] = distance
 	}
 
-	return nil, false
+	sort.SliceStable(kits, func(i, j int) bool {
+		return distances[kits[i].Namespace+"/"+kits[i].Name] < distances[kits[j].Namespace+"/"+kits[j].Name]
+	})
+
+	return nil
 }
 
-func matchesComparableTrait(ct trait.ComparableTrait, it map[string]interface{}, kt map[string]interface{}) (bool, error) {
-	t1 := reflect.New(reflect.TypeOf(ct).Elem()).Interface()
-	if err := trait.ToTrait(it, &t1); err != nil {
-		return false, err
+// sortKitsByPeripheralDependencyDistance sorts the kits by the number of peripheral dependencies that differ between
+// them and the integration, keeping the order of the kits that are as close.
+func sortKitsByPeripheralDependencyDistance(integration *v1.Integration, kits []v1.IntegrationKit, options kitmatch.Options) {
+	distances := make(map[string]int, len(kits))
+	for i := range kits {
+		distances[kits[i].Namespace+"/"+kits[i].Name] = kitmatch.PeripheralDependencyDistance(integration, &kits[i], options)
 	}
 
-	t2 := reflect.New(reflect.TypeOf(ct).Elem()).Interface()
-	if err := trait.ToTrait(kt, &t2); err != nil {
-		return false, err
+	sort.SliceStable(kits, func(i, j int) bool {
+		return distances[kits[i].Namespace+"/"+kits[i].Name] < distances[kits[j].Namespace+"/"+kits[j].Name]
+	})
+}
+
+// sortKitsByMatchedScore sorts the kits by descending score, keeping the order of the kits that score as high.
+func sortKitsByMatchedScore(integration *v1.Integration, kits []v1.IntegrationKit, options kitmatch.Options) error {
+	scores := make(map[string]int, len(kits))
+	for i := range kits {
+		score, err := kitmatch.MatchedScore(integration, &kits[i], options)
+		if err != nil {
+			return err
+		}
+		scores[kits[i].Namespace+"/"+kits[i].Name] = score
 	}
 
-	return t2.(trait.ComparableTrait).Matches(t1.(trait.Trait)), nil
+	sort.SliceStable(kits, func(i, j int) bool {
+		return scores[kits[i].Namespace+"/"+kits[i].Name] > scores[kits[j].Namespace+"/"+kits[j].Name]
+	})
+
+	return nil
 }
 
-func matchesTrait(it map[string]interface{}, kt map[string]interface{}) bool {
-	// perform exact match on the two trait maps
-	return reflect.DeepEqual(it, kt)
+// validateLabelValues checks that the integration runtime version and provider, used to select the kits,
+// are valid label values. The runtime version is not used to select the kits when it can be a glob pattern.
+func validateLabelValues(integration *v1.Integration, options kitmatch.Options) error {
+	if errs := validation.IsValidLabelValue(integration.Status.RuntimeVersion); len(errs) > 0 && !options.RuntimeVersionPrefixMatch {
+		return fmt.Errorf("invalid runtime version %q for integration %s/%s: %s",
+			integration.Status.RuntimeVersion, integration.Namespace, integration.Name, strings.Join(errs, ", "))
+	}
+	if errs := validation.IsValidLabelValue(string(integration.Status.RuntimeProvider)); len(errs) > 0 {
+		return fmt.Errorf("invalid runtime provider %q for integration %s/%s: %s",
+			integration.Status.RuntimeProvider, integration.Namespace, integration.Name, strings.Join(errs, ", "))
+	}
+
+	return nil
 }
@@ -0,0 +1,76 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"context"
+	"time"
+
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/util/defaults"
+)
+
+// AuditOutcome is the outcome of the selection of a kit for an integration.
+type AuditOutcome string
+
+const (
+	// AuditOutcomeMatched is the outcome of an existing kit selected for the integration.
+	AuditOutcomeMatched AuditOutcome = "Matched"
+	// AuditOutcomeBuilt is the outcome of a new kit created, and thus built, for the integration.
+	AuditOutcomeBuilt AuditOutcome = "Built"
+)
+
+// AuditRecord is the record of the selection of a kit for an integration.
+type AuditRecord struct {
+	Timestamp time.Time
+	// the operator that selected the kit
+	OperatorID  string
+	Integration ctrl.ObjectKey
+	// the selected kit
+	Kit     ctrl.ObjectKey
+	Outcome AuditOutcome
+	Reason  string
+}
+
+// AuditSink receives the records of the kits selected for the integrations, e.g. to write them to
+// an external audit system.
+type AuditSink interface {
+	// Record records the selection of a kit, it must not block the reconciliation of the integration
+	Record(ctx context.Context, record AuditRecord)
+}
+
+// KitAuditSink is the sink the kit selections are recorded to, that discards them by default.
+var KitAuditSink AuditSink = noopAuditSink{}
+
+type noopAuditSink struct{}
+
+func (noopAuditSink) Record(context.Context, AuditRecord) {}
+
+// auditKitSelection records the selection of the kit for the integration to the audit sink.
+func auditKitSelection(ctx context.Context, integration *v1.Integration, kit *v1.IntegrationKit, outcome AuditOutcome, reason string) {
+	KitAuditSink.Record(ctx, AuditRecord{
+		Timestamp:   time.Now(),
+		OperatorID:  defaults.OperatorID(),
+		Integration: ctrl.ObjectKeyFromObject(integration),
+		Kit:         ctrl.ObjectKeyFromObject(kit),
+		Outcome:     outcome,
+		Reason:      reason,
+	})
+}
@@ -0,0 +1,134 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/kitmatch"
+	"github.com/apache/camel-k/pkg/util/log"
+	"github.com/apache/camel-k/pkg/util/test"
+)
+
+// recordingAuditSink keeps the records of the kit selections in memory.
+type recordingAuditSink struct {
+	records []AuditRecord
+}
+
+func (s *recordingAuditSink) Record(ctx context.Context, record AuditRecord) {
+	s.records = append(s.records, record)
+}
+
+func TestSelectKit_AuditSink(t *testing.T) {
+	kit := func(name string, dependencies ...string) v1.IntegrationKit {
+		return v1.IntegrationKit{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: v1.SchemeGroupVersion.String(),
+				Kind:       v1.IntegrationKitKind,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "ns",
+				Name:      name,
+				Labels: map[string]string{
+					v1.IntegrationKitTypeLabel: v1.IntegrationKitTypePlatform,
+				},
+			},
+			Spec: v1.IntegrationKitSpec{
+				Dependencies: dependencies,
+			},
+		}
+	}
+	integration := &v1.Integration{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-integration",
+		},
+		Status: v1.IntegrationStatus{
+			Dependencies: []string{"camel-core"},
+		},
+	}
+
+	existing := kit("my-kit-1", "camel-core")
+	existing.Status.Phase = v1.IntegrationKitPhaseReady
+
+	defer func(sink AuditSink) {
+		KitAuditSink = sink
+	}(KitAuditSink)
+	sink := &recordingAuditSink{}
+	KitAuditSink = sink
+
+	c, err := test.NewFakeClient(&existing)
+	assert.Nil(t, err)
+
+	a := buildKitAction{}
+	a.InjectLogger(log.Log)
+	a.InjectClient(c)
+
+	// The native kit of the integration is not matched by the existing fast-jar kit
+	native := kit("my-kit-3", "camel-core")
+	native.Labels[v1.IntegrationKitLayoutLabel] = v1.IntegrationKitLayoutNative
+	envKits := []v1.IntegrationKit{kit("my-kit-2", "camel-core"), native}
+	report := &MatchReport{}
+	selected, err := a.selectKit(context.TODO(), integration, envKits, []v1.IntegrationKit{existing}, kitmatch.DefaultOptions(), report)
+	assert.Nil(t, err)
+	assert.Equal(t, "my-kit-1", selected.Name)
+
+	assert.Len(t, sink.records, 2)
+	for _, record := range sink.records {
+		assert.False(t, record.Timestamp.IsZero())
+		assert.Equal(t, ctrl.ObjectKey{Namespace: "ns", Name: "my-integration"}, record.Integration)
+	}
+	assert.Equal(t, AuditOutcomeMatched, sink.records[0].Outcome)
+	assert.Equal(t, ctrl.ObjectKey{Namespace: "ns", Name: "my-kit-1"}, sink.records[0].Kit)
+	assert.Equal(t, "Existing integration kit matches the integration", sink.records[0].Reason)
+	assert.Equal(t, AuditOutcomeBuilt, sink.records[1].Outcome)
+	assert.Equal(t, ctrl.ObjectKey{Namespace: "ns", Name: "my-kit-3"}, sink.records[1].Kit)
+	assert.Contains(t, sink.records[1].Reason, "No existing integration kit matches the integration")
+
+	created := v1.NewIntegrationKit("ns", "my-kit-3")
+	assert.Nil(t, c.Get(context.TODO(), ctrl.ObjectKeyFromObject(created), created))
+}
+
+func TestSelectKit_NoopAuditSink(t *testing.T) {
+	c, err := test.NewFakeClient()
+	assert.Nil(t, err)
+
+	a := buildKitAction{}
+	a.InjectLogger(log.Log)
+	a.InjectClient(c)
+
+	integration := &v1.Integration{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-integration",
+		},
+	}
+	envKit := v1.NewIntegrationKit("ns", "my-kit")
+
+	selected, err := a.selectKit(context.TODO(), integration, []v1.IntegrationKit{*envKit}, nil, kitmatch.DefaultOptions(), nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "my-kit", selected.Name)
+}
@@ -0,0 +1,85 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"sync"
+	"time"
+
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// kitBuildBackoff tracks the integrations a new kit is built for, as no kit matched them, so that they are not
+// matched again against the kits on every reconciliation while the kit is building.
+type kitBuildBackoff struct {
+	mu      sync.Mutex
+	entries map[ctrl.ObjectKey]kitBuildBackoffEntry
+}
+
+type kitBuildBackoffEntry struct {
+	backoff time.Duration
+	next    time.Time
+}
+
+var kitBuilds = &kitBuildBackoff{entries: make(map[ctrl.ObjectKey]kitBuildBackoffEntry)}
+
+// start starts the backoff of the integration, once a new kit is built for it.
+func (b *kitBuildBackoff) start(key ctrl.ObjectKey, backoff time.Duration) {
+	if backoff <= 0 {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.entries[key] = kitBuildBackoffEntry{backoff: backoff, next: time.Now().Add(backoff)}
+}
+
+// restart restarts the backoff of the integration, if started, once it has been matched again while the kit
+// is still building.
+func (b *kitBuildBackoff) restart(key ctrl.ObjectKey) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if entry, ok := b.entries[key]; ok {
+		entry.next = time.Now().Add(entry.backoff)
+		b.entries[key] = entry
+	}
+}
+
+// pending returns the remaining time until the integration can be matched again, if its backoff is not over.
+func (b *kitBuildBackoff) pending(key ctrl.ObjectKey) (time.Duration, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	entry, ok := b.entries[key]
+	if !ok {
+		return 0, false
+	}
+	remaining := time.Until(entry.next)
+
+	return remaining, remaining > 0
+}
+
+// clear clears the backoff of the integration, e.g. once its kit is ready.
+func (b *kitBuildBackoff) clear(key ctrl.ObjectKey) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	delete(b.entries, key)
+}
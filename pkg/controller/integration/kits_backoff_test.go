@@ -0,0 +1,140 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/util/digest"
+	"github.com/apache/camel-k/pkg/util/log"
+	"github.com/apache/camel-k/pkg/util/test"
+)
+
+func TestKitBuildBackoff(t *testing.T) {
+	backoffs := &kitBuildBackoff{entries: make(map[ctrl.ObjectKey]kitBuildBackoffEntry)}
+	key := ctrl.ObjectKey{Namespace: "ns", Name: "my-integration"}
+
+	backoffs.start(key, 0)
+	_, ok := backoffs.pending(key)
+	assert.False(t, ok)
+
+	backoffs.start(key, time.Minute)
+	remaining, ok := backoffs.pending(key)
+	assert.True(t, ok)
+	assert.True(t, remaining > 0 && remaining <= time.Minute)
+
+	// The backoff is over, but still started, until the integration is matched again
+	backoffs.entries[key] = kitBuildBackoffEntry{backoff: time.Minute, next: time.Now().Add(-time.Second)}
+	_, ok = backoffs.pending(key)
+	assert.False(t, ok)
+	backoffs.restart(key)
+	_, ok = backoffs.pending(key)
+	assert.True(t, ok)
+
+	backoffs.clear(key)
+	_, ok = backoffs.pending(key)
+	assert.False(t, ok)
+	backoffs.restart(key)
+	_, ok = backoffs.pending(key)
+	assert.False(t, ok)
+}
+
+func TestBuildKitAction_KitBuildBackoff(t *testing.T) {
+	kit := &v1.IntegrationKit{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1.SchemeGroupVersion.String(),
+			Kind:       v1.IntegrationKitKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-kit",
+			Labels: map[string]string{
+				v1.IntegrationKitTypeLabel: v1.IntegrationKitTypePlatform,
+			},
+		},
+		Spec: v1.IntegrationKitSpec{
+			Dependencies: []string{"camel-core"},
+		},
+		Status: v1.IntegrationKitStatus{
+			Phase: v1.IntegrationKitPhaseBuildRunning,
+		},
+	}
+	integration := &v1.Integration{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1.SchemeGroupVersion.String(),
+			Kind:       v1.IntegrationKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-integration",
+		},
+		Status: v1.IntegrationStatus{
+			Phase:        v1.IntegrationPhaseBuildingKit,
+			Dependencies: []string{"camel-core", "camel-http"},
+		},
+	}
+	integration.SetIntegrationKit(kit)
+	hash, err := digest.ComputeForIntegration(integration)
+	assert.Nil(t, err)
+	integration.Status.Digest = hash
+
+	defer func(backoffs *kitBuildBackoff) {
+		kitBuilds = backoffs
+	}(kitBuilds)
+	kitBuilds = &kitBuildBackoff{entries: make(map[ctrl.ObjectKey]kitBuildBackoffEntry)}
+	key := ctrl.ObjectKeyFromObject(integration)
+	kitBuilds.start(key, time.Minute)
+
+	c, err := test.NewFakeClient(kit)
+	assert.Nil(t, err)
+	a := buildKitAction{}
+	a.InjectLogger(log.Log)
+	a.InjectClient(c)
+
+	// The kit no longer matches the integration, but the integration is not matched again while the kit is building
+	target, err := a.Handle(context.TODO(), integration.DeepCopy())
+	assert.Nil(t, err)
+	assert.Nil(t, target)
+	_, ok := kitBuilds.pending(key)
+	assert.True(t, ok)
+
+	// The backoff is cleared once the kit is ready
+	integration.Status.Dependencies = []string{"camel-core"}
+	hash, err = digest.ComputeForIntegration(integration)
+	assert.Nil(t, err)
+	integration.Status.Digest = hash
+	kit.Status.Phase = v1.IntegrationKitPhaseReady
+	assert.Nil(t, c.Update(context.TODO(), kit))
+
+	target, err = a.Handle(context.TODO(), integration.DeepCopy())
+	assert.Nil(t, err)
+	assert.NotNil(t, target)
+	assert.Equal(t, v1.IntegrationPhaseDeploying, target.Status.Phase)
+	_, ok = kitBuilds.pending(key)
+	assert.False(t, ok)
+	assert.Empty(t, kitBuilds.entries)
+}
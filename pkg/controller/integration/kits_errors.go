@@ -0,0 +1,97 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"errors"
+	"net"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// MatchErrorCategory classifies the errors of the kits lookup, so that the callers can decide whether to retry
+// the lookup, or to report the error until the configuration is fixed.
+type MatchErrorCategory string
+
+const (
+	// MatchErrorTransient classifies the errors of the infrastructure, e.g. the API server being unavailable,
+	// or of the state the lookup waits for, e.g. the platform being ready, that may not occur again
+	MatchErrorTransient MatchErrorCategory = "Transient"
+	// MatchErrorPermanent classifies the errors of the configuration, e.g. an invalid match policy, or traits
+	// that cannot be converted, that occur again until it is fixed
+	MatchErrorPermanent MatchErrorCategory = "Permanent"
+)
+
+// MatchError is an error of the kits lookup, along with its category.
+type MatchError struct {
+	Category MatchErrorCategory
+	Err      error
+}
+
+func (e *MatchError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *MatchError) Unwrap() error {
+	return e.Err
+}
+
+// MatchErrorCategoryOf returns the category of the error of the kits lookup, or false if the error is not
+// a MatchError.
+func MatchErrorCategoryOf(err error) (MatchErrorCategory, bool) {
+	var matchError *MatchError
+	if !errors.As(err, &matchError) {
+		return "", false
+	}
+
+	return matchError.Category, true
+}
+
+// transientError wraps the error as a transient MatchError.
+func transientError(err error) error {
+	return &MatchError{Category: MatchErrorTransient, Err: err}
+}
+
+// permanentError wraps the error as a permanent MatchError.
+func permanentError(err error) error {
+	return &MatchError{Category: MatchErrorPermanent, Err: err}
+}
+
+// apiError wraps the error of a request to the API server as a MatchError, the requests the API server refuses
+// as invalid or forbidden, e.g. until the operator permissions are fixed, being permanent, and the other errors,
+// e.g. timeouts, being transient.
+func apiError(err error) error {
+	if k8serrors.IsBadRequest(err) || k8serrors.IsInvalid(err) || k8serrors.IsForbidden(err) ||
+		k8serrors.IsUnauthorized(err) || k8serrors.IsMethodNotSupported(err) || k8serrors.IsNotAcceptable(err) {
+		return permanentError(err)
+	}
+
+	return transientError(err)
+}
+
+// configError wraps the error of a step depending on the configuration, that may also request the API server,
+// as a MatchError. The errors that are neither API nor network errors are permanent.
+func configError(err error) error {
+	var status k8serrors.APIStatus
+	var netError net.Error
+	if errors.As(err, &status) || errors.As(err, &netError) {
+		return apiError(err)
+	}
+
+	return permanentError(err)
+}
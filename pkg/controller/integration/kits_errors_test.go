@@ -0,0 +1,274 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/kitmatch"
+	"github.com/apache/camel-k/pkg/util/digest"
+	"github.com/apache/camel-k/pkg/util/log"
+	"github.com/apache/camel-k/pkg/util/test"
+)
+
+// failingListReader fails to list the kits with the given error.
+type failingListReader struct {
+	ctrl.Reader
+	err error
+}
+
+func (r failingListReader) List(ctx context.Context, list ctrl.ObjectList, opts ...ctrl.ListOption) error {
+	if _, ok := list.(*v1.IntegrationKitList); ok {
+		return r.err
+	}
+	return r.Reader.List(ctx, list, opts...)
+}
+
+func TestMatchErrorCategory(t *testing.T) {
+	resource := schema.GroupResource{Group: v1.SchemeGroupVersion.Group, Resource: "integrationkits"}
+	testCases := []struct {
+		name     string
+		err      error
+		category MatchErrorCategory
+	}{
+		{"server timeout", apiError(k8serrors.NewServerTimeout(resource, "list", 1)), MatchErrorTransient},
+		{"too many requests", apiError(k8serrors.NewTooManyRequests("slow down", 1)), MatchErrorTransient},
+		{"forbidden", apiError(k8serrors.NewForbidden(resource, "", errors.New("no RBAC"))), MatchErrorPermanent},
+		{"bad request", apiError(k8serrors.NewBadRequest("invalid selector")), MatchErrorPermanent},
+		{"configuration", configError(errors.New("invalid platform reference")), MatchErrorPermanent},
+		{"configuration API request", configError(k8serrors.NewServiceUnavailable("unavailable")), MatchErrorTransient},
+		{"configuration network", configError(&net.OpError{Op: "dial", Err: errors.New("connection refused")}), MatchErrorTransient},
+		{"wrapped", pkgerrors.Wrap(permanentError(errors.New("invalid")), "lookup failed"), MatchErrorPermanent},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			category, ok := MatchErrorCategoryOf(tc.err)
+			assert.True(t, ok)
+			assert.Equal(t, tc.category, category)
+		})
+	}
+
+	_, ok := MatchErrorCategoryOf(errors.New("other"))
+	assert.False(t, ok)
+}
+
+func TestLookupKitForIntegration_ErrorCategories(t *testing.T) {
+	newIntegration := func() *v1.Integration {
+		return &v1.Integration{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: v1.SchemeGroupVersion.String(),
+				Kind:       v1.IntegrationKind,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "ns",
+				Name:      "my-integration",
+			},
+			Status: v1.IntegrationStatus{
+				Dependencies: []string{"camel-core"},
+			},
+		}
+	}
+	newPlatform := func() *v1.IntegrationPlatform {
+		pl := v1.NewIntegrationPlatform("ns", "camel-k")
+		pl.Status.Phase = v1.IntegrationPlatformPhaseReady
+		return &pl
+	}
+	kit := &v1.IntegrationKit{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1.SchemeGroupVersion.String(),
+			Kind:       v1.IntegrationKitKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-kit",
+			Labels: map[string]string{
+				v1.IntegrationKitTypeLabel: v1.IntegrationKitTypePlatform,
+			},
+		},
+		Spec: v1.IntegrationKitSpec{
+			Dependencies: []string{"camel-core"},
+		},
+		Status: v1.IntegrationKitStatus{
+			Phase: v1.IntegrationKitPhaseReady,
+		},
+	}
+	resource := schema.GroupResource{Group: v1.SchemeGroupVersion.Group, Resource: "integrationkits"}
+
+	testCases := []struct {
+		name        string
+		integration func(*v1.Integration)
+		platform    func(*v1.IntegrationPlatform)
+		objects     []runtime.Object
+		listError   error
+		category    MatchErrorCategory
+	}{
+		{
+			name: "platform not ready",
+			platform: func(pl *v1.IntegrationPlatform) {
+				pl.Status.Phase = v1.IntegrationPlatformPhaseCreating
+			},
+			category: MatchErrorTransient,
+		},
+		{
+			name: "invalid match policy",
+			objects: []runtime.Object{
+				&corev1.ConfigMap{
+					TypeMeta: metav1.TypeMeta{
+						APIVersion: "v1",
+						Kind:       "ConfigMap",
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "ns",
+						Name:      v1.IntegrationKitMatchPolicyConfigMap,
+					},
+					Data: map[string]string{
+						kitmatch.PolicyStrictnessKey: "lenient",
+					},
+				},
+			},
+			category: MatchErrorPermanent,
+		},
+		{
+			name: "stale status",
+			integration: func(integration *v1.Integration) {
+				integration.Generation = 3
+				integration.Status.ObservedGeneration = 1
+			},
+			platform: func(pl *v1.IntegrationPlatform) {
+				skew := int64(0)
+				pl.Status.Build.KitMatching.MaxStatusGenerationSkew = &skew
+			},
+			category: MatchErrorTransient,
+		},
+		{
+			name: "invalid label value",
+			integration: func(integration *v1.Integration) {
+				integration.Status.RuntimeVersion = "1.17.0 SNAPSHOT"
+			},
+			category: MatchErrorPermanent,
+		},
+		{
+			name: "invalid platform reference",
+			platform: func(pl *v1.IntegrationPlatform) {
+				pl.Status.Build.KitMatching.ReusePlatforms = []string{"camel-k"}
+			},
+			category: MatchErrorPermanent,
+		},
+		{
+			name:      "kits list timeout",
+			listError: k8serrors.NewServerTimeout(resource, "list", 1),
+			category:  MatchErrorTransient,
+		},
+		{
+			name:      "kits list forbidden",
+			listError: k8serrors.NewForbidden(resource, "", errors.New("no RBAC")),
+			category:  MatchErrorPermanent,
+		},
+		{
+			name: "traits conversion",
+			integration: func(integration *v1.Integration) {
+				integration.Spec.Traits.Addons = map[string]v1.AddonTrait{
+					"my-addon": {RawMessage: v1.RawMessage("{")},
+				}
+			},
+			objects:  []runtime.Object{kit},
+			category: MatchErrorPermanent,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			integration := newIntegration()
+			if tc.integration != nil {
+				tc.integration(integration)
+			}
+			pl := newPlatform()
+			if tc.platform != nil {
+				tc.platform(pl)
+			}
+			c, err := test.NewFakeClient(append([]runtime.Object{pl}, tc.objects...)...)
+			assert.Nil(t, err)
+			var reader ctrl.Reader = c
+			if tc.listError != nil {
+				reader = failingListReader{Reader: c, err: tc.listError}
+			}
+
+			_, err = lookupKitsForIntegration(context.TODO(), reader, integration)
+			assert.NotNil(t, err)
+			category, ok := MatchErrorCategoryOf(err)
+			assert.True(t, ok)
+			assert.Equal(t, tc.category, category)
+		})
+	}
+}
+
+func TestBuildKitAction_PermanentLookupError(t *testing.T) {
+	integration := &v1.Integration{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1.SchemeGroupVersion.String(),
+			Kind:       v1.IntegrationKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-integration",
+		},
+		Status: v1.IntegrationStatus{
+			Phase:          v1.IntegrationPhaseBuildingKit,
+			RuntimeVersion: "1.17.0 SNAPSHOT",
+			Dependencies:   []string{"camel-core"},
+		},
+	}
+	hash, err := digest.ComputeForIntegration(integration)
+	assert.Nil(t, err)
+	integration.Status.Digest = hash
+
+	pl := v1.NewIntegrationPlatform("ns", "camel-k")
+	pl.Status.Phase = v1.IntegrationPlatformPhaseReady
+	c, err := test.NewFakeClient(&pl)
+	assert.Nil(t, err)
+
+	a := buildKitAction{}
+	a.InjectLogger(log.Log)
+	a.InjectClient(c)
+
+	target, err := a.Handle(context.TODO(), integration.DeepCopy())
+	assert.Nil(t, err)
+	assert.NotNil(t, target)
+	condition := target.Status.GetCondition(v1.IntegrationConditionKitMatched)
+	assert.NotNil(t, condition)
+	assert.Equal(t, corev1.ConditionFalse, condition.Status)
+	assert.Equal(t, v1.IntegrationConditionKitLookupFailedReason, condition.Reason)
+	assert.Contains(t, condition.Message, "invalid runtime version")
+
+}
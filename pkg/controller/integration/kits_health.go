@@ -0,0 +1,83 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"errors"
+	"net"
+	"sync"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/apache/camel-k/pkg/util/log"
+)
+
+// maxKitLookupFailures is the number of consecutive failures of the kit lookups to reach the API server after which
+// the kit matching is reported as unhealthy.
+const maxKitLookupFailures = 3
+
+// kitLookupHealth tracks the consecutive failures of the kit lookups to reach the API server, and reports the health
+// of the kit matching as a metric. The other failures, like missing permissions in a namespace, are specific to the
+// integrations, and reset the failures as they prove the API server is reachable.
+//
+// The health is deliberately not reported by the health endpoint of the operator, as it only serves the liveness
+// probe, and restarting the operator does not restore the connectivity to the API server.
+type kitLookupHealth struct {
+	mu          sync.Mutex
+	maxFailures int
+	failures    int
+}
+
+var kitLookups = &kitLookupHealth{maxFailures: maxKitLookupFailures}
+
+// record records the outcome of a kit lookup, a lookup reaching the API server resetting the failures.
+func (h *kitLookupHealth) record(err error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if err == nil || !isConnectivityError(err) {
+		h.failures = 0
+	} else {
+		h.failures++
+		if h.failures == h.maxFailures {
+			log.Error(err, "Integration kit lookups consecutively fail to reach the API server", "failures", h.failures)
+		}
+	}
+
+	if h.healthy() {
+		kitLookupHealthy.Set(1)
+	} else {
+		kitLookupHealthy.Set(0)
+	}
+}
+
+// healthy returns whether the kit lookups have failed to reach the API server less than the maximum number of
+// times in a row.
+func (h *kitLookupHealth) healthy() bool {
+	return h.failures < h.maxFailures
+}
+
+// isConnectivityError returns whether the error is caused by the API server not being reachable, or unavailable.
+func isConnectivityError(err error) bool {
+	if k8serrors.IsServiceUnavailable(err) || k8serrors.IsServerTimeout(err) || k8serrors.IsTimeout(err) {
+		return true
+	}
+	var netError net.Error
+
+	return errors.As(err, &netError)
+}
@@ -0,0 +1,98 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/util/test"
+)
+
+func TestKitLookupHealth(t *testing.T) {
+	integration := &v1.Integration{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1.SchemeGroupVersion.String(),
+			Kind:       v1.IntegrationKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-integration",
+		},
+	}
+
+	defer func(lookups *kitLookupHealth) {
+		kitLookups = lookups
+		kitLookupHealthy.Set(1)
+	}(kitLookups)
+	kitLookups = &kitLookupHealth{maxFailures: maxKitLookupFailures}
+
+	c, err := test.NewFakeClient()
+	assert.Nil(t, err)
+	unreachable := failingListReader{Reader: c, err: &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}}
+	resource := schema.GroupResource{Group: v1.SchemeGroupVersion.Group, Resource: "integrationkits"}
+	forbidden := failingListReader{Reader: c, err: k8serrors.NewForbidden(resource, "", errors.New("forbidden"))}
+
+	_, err = lookupKitsForIntegration(context.TODO(), c, integration)
+	assert.Nil(t, err)
+	assert.Equal(t, float64(1), testutil.ToFloat64(kitLookupHealthy))
+
+	for i := 1; i < maxKitLookupFailures; i++ {
+		_, err = lookupKitsForIntegration(context.TODO(), unreachable, integration)
+		assert.NotNil(t, err)
+		// The kit matching is healthy until the maximum number of consecutive failures
+		assert.Equal(t, float64(1), testutil.ToFloat64(kitLookupHealthy))
+	}
+	_, err = lookupKitsForIntegration(context.TODO(), unreachable, integration)
+	assert.NotNil(t, err)
+	assert.Equal(t, float64(0), testutil.ToFloat64(kitLookupHealthy))
+
+	// The kit matching recovers on the next lookup reaching the API server, even if it fails
+	_, err = lookupKitsForIntegration(context.TODO(), forbidden, integration)
+	assert.NotNil(t, err)
+	assert.Equal(t, float64(1), testutil.ToFloat64(kitLookupHealthy))
+
+	// The failures that do not relate to the API server connectivity are not counted
+	for i := 0; i < maxKitLookupFailures; i++ {
+		_, err = lookupKitsForIntegration(context.TODO(), forbidden, integration)
+		assert.NotNil(t, err)
+	}
+	assert.Equal(t, float64(1), testutil.ToFloat64(kitLookupHealthy))
+}
+
+func TestIsConnectivityError(t *testing.T) {
+	resource := schema.GroupResource{Group: v1.SchemeGroupVersion.Group, Resource: "integrationkits"}
+
+	assert.True(t, isConnectivityError(&net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}))
+	assert.True(t, isConnectivityError(k8serrors.NewServiceUnavailable("unavailable")))
+	assert.True(t, isConnectivityError(k8serrors.NewServerTimeout(resource, "list", 1)))
+	assert.False(t, isConnectivityError(k8serrors.NewForbidden(resource, "", errors.New("forbidden"))))
+	assert.False(t, isConnectivityError(k8serrors.NewNotFound(resource, "my-kit")))
+	assert.False(t, isConnectivityError(errors.New("failure")))
+}
@@ -0,0 +1,342 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"reflect"
+	"sync"
+	"syscall"
+	"time"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+)
+
+const (
+	// kitManifestTTL is the duration the fetched kit manifests are cached for
+	kitManifestTTL = 10 * time.Minute
+	// kitManifestFailureTTL is the duration the failed fetches of kit manifests are cached for
+	kitManifestFailureTTL = 30 * time.Second
+	// kitManifestFetchTimeout bounds the duration of the fetch of a kit manifest
+	kitManifestFetchTimeout = 10 * time.Second
+	// kitManifestMaxSize bounds the size of a kit manifest, in bytes
+	kitManifestMaxSize = 1 << 20
+	// kitManifestCacheSize bounds the number of kit manifests that are cached
+	kitManifestCacheSize = 256
+)
+
+// kitManifestsTimeout bounds the duration of the fetch of all the manifests referenced by the kits of a lookup.
+var kitManifestsTimeout = 30 * time.Second
+
+// KitManifest is the metadata of an external kit, as declared by the manifest published to its registry.
+type KitManifest struct {
+	// the dependencies provided by the kit
+	Dependencies []string `json:"dependencies,omitempty"`
+	// the traits the kit has been built with
+	Traits v1.IntegrationKitTraits `json:"traits,omitempty"`
+}
+
+// ManifestFetcher fetches the manifests of the external kits, referenced by URL.
+type ManifestFetcher interface {
+	// Fetch returns the manifest at the given URL
+	Fetch(ctx context.Context, url string) (*KitManifest, error)
+}
+
+// KitManifestFetcher is the fetcher the manifests of the external kits are fetched with, over HTTP by default.
+var KitManifestFetcher ManifestFetcher = httpManifestFetcher{client: newKitManifestClient()}
+
+// kitManifestDeniedNetworks are the networks the kit manifests cannot be fetched from, so that the annotation
+// of a kit cannot be used to reach the operator's own network, e.g. the cloud metadata endpoints or the API server.
+var kitManifestDeniedNetworks = parseCIDRs(
+	"0.0.0.0/8",
+	"10.0.0.0/8",
+	"100.64.0.0/10",
+	"127.0.0.0/8",
+	"169.254.0.0/16",
+	"172.16.0.0/12",
+	"192.168.0.0/16",
+	"::/128",
+	"::1/128",
+	"fc00::/7",
+	"fe80::/10",
+)
+
+func parseCIDRs(cidrs ...string) []*net.IPNet {
+	networks := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		networks = append(networks, network)
+	}
+
+	return networks
+}
+
+// newKitManifestClient returns the HTTP client the kit manifests are fetched with. The addresses are checked
+// once resolved, when dialing, so that neither a host name nor a redirect can reach a denied network. The manifests
+// are fetched without proxy, so that the checked address is the one of the registry.
+func newKitManifestClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout: kitManifestFetchTimeout,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			ip := net.ParseIP(host)
+			if ip == nil || ip.IsMulticast() {
+				return fmt.Errorf("kit manifest address %s is not allowed", host)
+			}
+			for _, network := range kitManifestDeniedNetworks {
+				if network.Contains(ip) {
+					return fmt.Errorf("kit manifest address %s is not allowed", host)
+				}
+			}
+
+			return nil
+		},
+	}
+
+	return &http.Client{
+		Timeout: kitManifestFetchTimeout,
+		Transport: &http.Transport{
+			DialContext: dialer.DialContext,
+		},
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= 3 {
+				return errors.New("too many redirects")
+			}
+
+			return validateKitManifestURL(req.URL)
+		},
+	}
+}
+
+// validateKitManifestURL checks that the kit manifest is fetched over HTTP(S), from an explicit host.
+func validateKitManifestURL(u *url.URL) error {
+	if u.Scheme != "https" && u.Scheme != "http" {
+		return fmt.Errorf("unsupported scheme %q for kit manifest %s", u.Scheme, u.Redacted())
+	}
+	if u.Hostname() == "" {
+		return fmt.Errorf("missing host for kit manifest %s", u.Redacted())
+	}
+
+	return nil
+}
+
+type httpManifestFetcher struct {
+	client *http.Client
+}
+
+func (f httpManifestFetcher) Fetch(ctx context.Context, manifestURL string) (*KitManifest, error) {
+	u, err := url.Parse(manifestURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid kit manifest URL: %w", err)
+	}
+	if err := validateKitManifestURL(u); err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %q fetching kit manifest %s", resp.Status, manifestURL)
+	}
+
+	// The manifest exceeding the maximum size is truncated, so that it fails to decode
+	manifest := KitManifest{}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, kitManifestMaxSize)).Decode(&manifest); err != nil {
+		return nil, fmt.Errorf("invalid kit manifest %s: %w", manifestURL, err)
+	}
+
+	return &manifest, nil
+}
+
+// kitManifestCache caches the fetched kit manifests by URL, and the failed fetches for a shorter time, so that
+// an unreachable registry is not requested again on each lookup. The least recently fetched entries are evicted
+// once the cache is full.
+type kitManifestCache struct {
+	lock       sync.Mutex
+	ttl        time.Duration
+	failureTTL time.Duration
+	size       int
+	entries    map[string]kitManifestEntry
+}
+
+type kitManifestEntry struct {
+	manifest *KitManifest
+	err      error
+	fetched  time.Time
+}
+
+var kitManifests = newKitManifestCache(kitManifestTTL)
+
+func newKitManifestCache(ttl time.Duration) *kitManifestCache {
+	return &kitManifestCache{
+		ttl:        ttl,
+		failureTTL: kitManifestFailureTTL,
+		size:       kitManifestCacheSize,
+		entries:    make(map[string]kitManifestEntry),
+	}
+}
+
+// expired returns whether the entry has to be fetched again.
+func (c *kitManifestCache) expired(entry kitManifestEntry, now time.Time) bool {
+	if entry.err != nil {
+		return now.Sub(entry.fetched) >= c.failureTTL
+	}
+
+	return now.Sub(entry.fetched) >= c.ttl
+}
+
+// get returns the manifest at the given URL, fetching it if it is not cached or has expired.
+func (c *kitManifestCache) get(ctx context.Context, fetcher ManifestFetcher, url string) (*KitManifest, error) {
+	c.lock.Lock()
+	entry, ok := c.entries[url]
+	c.lock.Unlock()
+	if ok && !c.expired(entry, time.Now()) {
+		return entry.manifest, entry.err
+	}
+
+	manifest, err := fetcher.Fetch(ctx, url)
+
+	c.lock.Lock()
+	c.put(url, kitManifestEntry{manifest: manifest, err: err, fetched: time.Now()})
+	c.lock.Unlock()
+
+	return manifest, err
+}
+
+// put caches the entry, evicting the expired entries, then the least recently fetched one, if the cache is full.
+// It must be called with the lock held.
+func (c *kitManifestCache) put(url string, entry kitManifestEntry) {
+	if _, ok := c.entries[url]; !ok && len(c.entries) >= c.size {
+		for u, e := range c.entries {
+			if c.expired(e, entry.fetched) {
+				delete(c.entries, u)
+			}
+		}
+		for len(c.entries) >= c.size {
+			oldest := ""
+			for u, e := range c.entries {
+				if oldest == "" || e.fetched.Before(c.entries[oldest].fetched) {
+					oldest = u
+				}
+			}
+			delete(c.entries, oldest)
+		}
+	}
+	c.entries[url] = entry
+}
+
+// fetchedKitManifest is the result of the fetch of a kit manifest.
+type fetchedKitManifest struct {
+	manifest *KitManifest
+	err      error
+}
+
+// kitManifestURL returns the URL of the manifest the kit references, or empty if it is not an external kit
+// referencing a manifest.
+func kitManifestURL(kit *v1.IntegrationKit) string {
+	if kit.Labels[v1.IntegrationKitTypeLabel] != v1.IntegrationKitTypeExternal {
+		return ""
+	}
+
+	return kit.Annotations[v1.IntegrationKitManifestAnnotation]
+}
+
+// fetchKitManifests fetches the manifests referenced by the kits before they are matched, once per URL and
+// concurrently, within the lookup deadline, so that an unresponsive registry does not hold the lookup for each
+// kit in turn.
+func fetchKitManifests(ctx context.Context, kits []v1.IntegrationKit) map[string]fetchedKitManifest {
+	urls := make(map[string]bool)
+	for i := range kits {
+		if url := kitManifestURL(&kits[i]); url != "" {
+			urls[url] = true
+		}
+	}
+	manifests := make(map[string]fetchedKitManifest, len(urls))
+	if len(urls) == 0 {
+		return manifests
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, kitManifestsTimeout)
+	defer cancel()
+
+	var lock sync.Mutex
+	var wg sync.WaitGroup
+	for url := range urls {
+		url := url
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			manifest, err := kitManifests.get(ctx, KitManifestFetcher, url)
+			lock.Lock()
+			manifests[url] = fetchedKitManifest{manifest: manifest, err: err}
+			lock.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	return manifests
+}
+
+// resolveKitManifest completes the spec of the external kit referencing a manifest with the dependencies
+// and traits the fetched manifest declares, so that it can be matched as any other kit. The kit is returned as is
+// if it references no manifest, otherwise a copy is returned.
+func resolveKitManifest(kit *v1.IntegrationKit, manifests map[string]fetchedKitManifest) (*v1.IntegrationKit, error) {
+	url := kitManifestURL(kit)
+	if url == "" {
+		return kit, nil
+	}
+
+	fetched, ok := manifests[url]
+	if !ok {
+		return nil, fmt.Errorf("kit manifest %s not fetched", url)
+	}
+	if fetched.err != nil {
+		return nil, fetched.err
+	}
+	manifest := fetched.manifest
+
+	resolved := kit.DeepCopy()
+	// The spec declared by the kit takes precedence over its manifest
+	if len(resolved.Spec.Dependencies) == 0 {
+		resolved.Spec.Dependencies = append([]string(nil), manifest.Dependencies...)
+	}
+	if reflect.DeepEqual(resolved.Spec.Traits, v1.IntegrationKitTraits{}) {
+		manifest.Traits.DeepCopyInto(&resolved.Spec.Traits)
+	}
+
+	return resolved, nil
+}
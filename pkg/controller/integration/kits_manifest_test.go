@@ -0,0 +1,347 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/pkg/util/test"
+)
+
+// fakeManifestFetcher serves the kit manifests by URL, counting the fetches.
+type fakeManifestFetcher struct {
+	lock      sync.Mutex
+	manifests map[string]*KitManifest
+	fetches   map[string]int
+}
+
+func (f *fakeManifestFetcher) Fetch(ctx context.Context, url string) (*KitManifest, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.fetches[url]++
+	manifest, ok := f.manifests[url]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+
+	return manifest, nil
+}
+
+func TestLookupKitForIntegration_ExternalKitManifest(t *testing.T) {
+	kit := func(name string, manifest string) *v1.IntegrationKit {
+		return &v1.IntegrationKit{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: v1.SchemeGroupVersion.String(),
+				Kind:       v1.IntegrationKitKind,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "ns",
+				Name:      name,
+				Labels: map[string]string{
+					v1.IntegrationKitTypeLabel:          v1.IntegrationKitTypeExternal,
+					"camel.apache.org/runtime.version":  "1.2.3",
+					"camel.apache.org/runtime.provider": string(v1.RuntimeProviderQuarkus),
+				},
+				Annotations: map[string]string{
+					v1.IntegrationKitManifestAnnotation: manifest,
+				},
+			},
+			Status: v1.IntegrationKitStatus{
+				Phase:           v1.IntegrationKitPhaseReady,
+				Image:           "registry.example.com/kits/" + name,
+				RuntimeVersion:  "1.2.3",
+				RuntimeProvider: v1.RuntimeProviderQuarkus,
+			},
+		}
+	}
+
+	fetcher := &fakeManifestFetcher{
+		manifests: map[string]*KitManifest{
+			"https://registry.example.com/kits/my-kit-1.json": {
+				Dependencies: []string{"camel-core", "camel-irc"},
+			},
+			"https://registry.example.com/kits/my-kit-2.json": {
+				Dependencies: []string{"camel-core"},
+			},
+		},
+		fetches: make(map[string]int),
+	}
+	defer func(fetcher ManifestFetcher, cache *kitManifestCache) {
+		KitManifestFetcher = fetcher
+		kitManifests = cache
+	}(KitManifestFetcher, kitManifests)
+	KitManifestFetcher = fetcher
+	kitManifests = newKitManifestCache(kitManifestTTL)
+
+	c, err := test.NewFakeClient(
+		kit("my-kit-1", "https://registry.example.com/kits/my-kit-1.json"),
+		kit("my-kit-2", "https://registry.example.com/kits/my-kit-2.json"),
+		kit("my-kit-3", "https://registry.example.com/kits/my-kit-3.json"),
+	)
+	assert.Nil(t, err)
+
+	integration := &v1.Integration{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1.SchemeGroupVersion.String(),
+			Kind:       v1.IntegrationKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-integration",
+		},
+		Status: v1.IntegrationStatus{
+			Phase:           v1.IntegrationPhaseBuildingKit,
+			RuntimeVersion:  "1.2.3",
+			RuntimeProvider: v1.RuntimeProviderQuarkus,
+			Dependencies:    []string{"camel-core", "camel-irc"},
+		},
+	}
+
+	kits, report, err := LookupKitsForIntegrationWithReport(context.TODO(), c, integration)
+	assert.Nil(t, err)
+	assert.Len(t, kits, 1)
+	assert.Equal(t, "my-kit-1", kits[0].Name)
+	// The matching kit is completed with the dependencies declared by its manifest
+	assert.Equal(t, []string{"camel-core", "camel-irc"}, kits[0].Spec.Dependencies)
+
+	reasons := make(map[string]string)
+	for _, evaluation := range report.Evaluations {
+		reasons[evaluation.Kit] = evaluation.Reason
+	}
+	assert.Equal(t, "Integration kit manifest cannot be fetched", reasons["ns/my-kit-3"])
+	assert.NotEmpty(t, reasons["ns/my-kit-2"])
+
+	// The fetched manifests are cached, as the failed fetches
+	_, err = lookupKitsForIntegration(context.TODO(), c, integration)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, fetcher.fetches["https://registry.example.com/kits/my-kit-1.json"])
+	assert.Equal(t, 1, fetcher.fetches["https://registry.example.com/kits/my-kit-2.json"])
+	assert.Equal(t, 1, fetcher.fetches["https://registry.example.com/kits/my-kit-3.json"])
+
+	// The failed fetches are retried once expired, sooner than the fetched manifests
+	for url, entry := range kitManifests.entries {
+		entry.fetched = entry.fetched.Add(-kitManifestFailureTTL)
+		kitManifests.entries[url] = entry
+	}
+	_, err = lookupKitsForIntegration(context.TODO(), c, integration)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, fetcher.fetches["https://registry.example.com/kits/my-kit-1.json"])
+	assert.Equal(t, 1, fetcher.fetches["https://registry.example.com/kits/my-kit-2.json"])
+	assert.Equal(t, 2, fetcher.fetches["https://registry.example.com/kits/my-kit-3.json"])
+}
+
+func TestResolveKitManifest(t *testing.T) {
+	fetcher := &fakeManifestFetcher{
+		manifests: map[string]*KitManifest{
+			"https://registry.example.com/kits/my-kit.json": {
+				Dependencies: []string{"camel-core", "camel-irc"},
+				Traits: v1.IntegrationKitTraits{
+					Quarkus: &traitv1.QuarkusTrait{PackageTypes: []traitv1.QuarkusPackageType{traitv1.NativePackageType}},
+				},
+			},
+		},
+		fetches: make(map[string]int),
+	}
+	defer func(fetcher ManifestFetcher, cache *kitManifestCache) {
+		KitManifestFetcher = fetcher
+		kitManifests = cache
+	}(KitManifestFetcher, kitManifests)
+	KitManifestFetcher = fetcher
+	kitManifests = newKitManifestCache(time.Minute)
+
+	kit := &v1.IntegrationKit{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-kit",
+			Labels: map[string]string{
+				v1.IntegrationKitTypeLabel: v1.IntegrationKitTypeExternal,
+			},
+			Annotations: map[string]string{
+				v1.IntegrationKitManifestAnnotation: "https://registry.example.com/kits/my-kit.json",
+			},
+		},
+		Spec: v1.IntegrationKitSpec{
+			Dependencies: []string{"camel-core"},
+		},
+	}
+
+	resolved, err := resolveKitManifest(kit, fetchKitManifests(context.TODO(), []v1.IntegrationKit{*kit}))
+	assert.Nil(t, err)
+	// The spec declared by the kit takes precedence over its manifest
+	assert.Equal(t, []string{"camel-core"}, resolved.Spec.Dependencies)
+	assert.NotNil(t, resolved.Spec.Traits.Quarkus)
+	// The kit itself is left untouched
+	assert.Nil(t, kit.Spec.Traits.Quarkus)
+
+	// The manifest is only fetched once it has expired
+	kitManifests.entries["https://registry.example.com/kits/my-kit.json"] = kitManifestEntry{
+		manifest: fetcher.manifests["https://registry.example.com/kits/my-kit.json"],
+		fetched:  time.Now().Add(-2 * time.Minute),
+	}
+	_, err = resolveKitManifest(kit, fetchKitManifests(context.TODO(), []v1.IntegrationKit{*kit}))
+	assert.Nil(t, err)
+	assert.Equal(t, 2, fetcher.fetches["https://registry.example.com/kits/my-kit.json"])
+
+	// The manifest of the kits that are not external is ignored
+	kit.Labels[v1.IntegrationKitTypeLabel] = v1.IntegrationKitTypePlatform
+	resolved, err = resolveKitManifest(kit, fetchKitManifests(context.TODO(), []v1.IntegrationKit{*kit}))
+	assert.Nil(t, err)
+	assert.Same(t, kit, resolved)
+	assert.Equal(t, 2, fetcher.fetches["https://registry.example.com/kits/my-kit.json"])
+}
+
+// blockingManifestFetcher never serves the kit manifests, until the fetch is cancelled.
+type blockingManifestFetcher struct{}
+
+func (f blockingManifestFetcher) Fetch(ctx context.Context, url string) (*KitManifest, error) {
+	<-ctx.Done()
+
+	return nil, ctx.Err()
+}
+
+func TestFetchKitManifests_Timeout(t *testing.T) {
+	defer func(fetcher ManifestFetcher, cache *kitManifestCache, timeout time.Duration) {
+		KitManifestFetcher = fetcher
+		kitManifests = cache
+		kitManifestsTimeout = timeout
+	}(KitManifestFetcher, kitManifests, kitManifestsTimeout)
+	KitManifestFetcher = blockingManifestFetcher{}
+	kitManifests = newKitManifestCache(kitManifestTTL)
+	kitManifestsTimeout = 100 * time.Millisecond
+
+	kit := func(name string) v1.IntegrationKit {
+		return v1.IntegrationKit{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "ns",
+				Name:      name,
+				Labels: map[string]string{
+					v1.IntegrationKitTypeLabel: v1.IntegrationKitTypeExternal,
+				},
+				Annotations: map[string]string{
+					v1.IntegrationKitManifestAnnotation: "https://registry.example.com/kits/" + name + ".json",
+				},
+			},
+		}
+	}
+	kits := []v1.IntegrationKit{kit("my-kit-1"), kit("my-kit-2"), kit("my-kit-3")}
+
+	// The manifests are fetched concurrently, within a single deadline for the lookup
+	start := time.Now()
+	manifests := fetchKitManifests(context.TODO(), kits)
+	assert.Less(t, int64(time.Since(start)), int64(time.Second))
+	assert.Len(t, manifests, 3)
+	for i := range kits {
+		_, err := resolveKitManifest(&kits[i], manifests)
+		assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	}
+}
+
+func TestKitManifestFetcher_Timeout(t *testing.T) {
+	fetcher, ok := KitManifestFetcher.(httpManifestFetcher)
+	assert.True(t, ok)
+	assert.Equal(t, kitManifestFetchTimeout, fetcher.client.Timeout)
+}
+
+func TestKitManifestCache_Eviction(t *testing.T) {
+	fetcher := &fakeManifestFetcher{
+		manifests: map[string]*KitManifest{
+			"https://registry.example.com/kits/my-kit-1.json": {},
+			"https://registry.example.com/kits/my-kit-2.json": {},
+			"https://registry.example.com/kits/my-kit-3.json": {},
+		},
+		fetches: make(map[string]int),
+	}
+	cache := newKitManifestCache(kitManifestTTL)
+	cache.size = 2
+
+	for _, url := range []string{
+		"https://registry.example.com/kits/my-kit-1.json",
+		"https://registry.example.com/kits/my-kit-2.json",
+		"https://registry.example.com/kits/my-kit-3.json",
+	} {
+		_, err := cache.get(context.TODO(), fetcher, url)
+		assert.Nil(t, err)
+		// Let the entries be ordered by fetch time
+		time.Sleep(time.Millisecond)
+	}
+
+	// The least recently fetched manifest is evicted
+	assert.Len(t, cache.entries, 2)
+	assert.NotContains(t, cache.entries, "https://registry.example.com/kits/my-kit-1.json")
+
+	// The expired entries are evicted first
+	entry := cache.entries["https://registry.example.com/kits/my-kit-3.json"]
+	entry.fetched = entry.fetched.Add(-kitManifestTTL)
+	cache.entries["https://registry.example.com/kits/my-kit-3.json"] = entry
+	_, err := cache.get(context.TODO(), fetcher, "https://registry.example.com/kits/my-kit-1.json")
+	assert.Nil(t, err)
+	assert.Len(t, cache.entries, 2)
+	assert.Contains(t, cache.entries, "https://registry.example.com/kits/my-kit-2.json")
+	assert.NotContains(t, cache.entries, "https://registry.example.com/kits/my-kit-3.json")
+}
+
+func TestKitManifestFetcher_MaxSize(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"dependencies": ["` + strings.Repeat("a", kitManifestMaxSize) + `"]}`))
+	}))
+	defer server.Close()
+
+	// The guarded client is bypassed, as the test server listens on the loopback address
+	fetcher := httpManifestFetcher{client: server.Client()}
+	_, err := fetcher.Fetch(context.TODO(), server.URL)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "invalid kit manifest")
+}
+
+func TestKitManifestFetcher_DeniedURLs(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	fetcher, ok := KitManifestFetcher.(httpManifestFetcher)
+	assert.True(t, ok)
+
+	// The loopback address is denied
+	_, err := fetcher.Fetch(context.TODO(), server.URL)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "is not allowed")
+
+	// The link-local address, e.g. of the cloud metadata endpoint, is denied
+	_, err = fetcher.Fetch(context.TODO(), "http://169.254.169.254/latest/meta-data")
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "is not allowed")
+
+	// Only the HTTP(S) schemes are supported
+	_, err = fetcher.Fetch(context.TODO(), "file:///etc/passwd")
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "unsupported scheme")
+}
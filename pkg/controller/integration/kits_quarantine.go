@@ -0,0 +1,103 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"context"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/util/retry"
+
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+)
+
+// kitFailures returns the number of failures recorded for the kit.
+func kitFailures(kit *v1.IntegrationKit) int {
+	return kitAnnotationCount(kit, v1.IntegrationKitFailuresAnnotation)
+}
+
+// kitAnnotationCount returns the count recorded in the given annotation of the kit, or 0 when it is not a valid count.
+func kitAnnotationCount(kit *v1.IntegrationKit, key string) int {
+	count, err := strconv.Atoi(kit.Annotations[key])
+	if err != nil || count < 0 {
+		return 0
+	}
+
+	return count
+}
+
+// isQuarantined returns whether the kit has failed at least as many times as the quarantine threshold,
+// quarantine being disabled when the threshold is not positive.
+func isQuarantined(kit *v1.IntegrationKit, threshold int) bool {
+	if threshold <= 0 {
+		return false
+	}
+
+	return kitFailures(kit) >= threshold
+}
+
+// recordKitFailure increments the number of failures recorded for the kit.
+func recordKitFailure(ctx context.Context, c ctrl.Client, kit *v1.IntegrationKit) error {
+	return incrementKitAnnotation(ctx, c, kit, v1.IntegrationKitFailuresAnnotation)
+}
+
+// incrementKitAnnotation increments the count recorded in the given annotation of the kit. The kit is patched with
+// an optimistic lock, and read again on conflict, so that the concurrent increments are all counted.
+func incrementKitAnnotation(ctx context.Context, c ctrl.Client, kit *v1.IntegrationKit, key string) error {
+	current := kit.DeepCopy()
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		target := current.DeepCopy()
+		if target.Annotations == nil {
+			target.Annotations = make(map[string]string)
+		}
+		target.Annotations[key] = strconv.Itoa(kitAnnotationCount(current, key) + 1)
+		err := c.Patch(ctx, target, ctrl.MergeFromWithOptions(current, ctrl.MergeFromWithOptimisticLock{}))
+		if k8serrors.IsConflict(err) {
+			// The kit is read again before retrying, to increment the latest count
+			if getErr := c.Get(ctx, ctrl.ObjectKeyFromObject(kit), current); getErr != nil {
+				return getErr
+			}
+		}
+		if err != nil {
+			return err
+		}
+		*kit = *target
+
+		return nil
+	})
+}
+
+// hasImagePullFailure returns whether any of the pods fails to pull its container images.
+func hasImagePullFailure(pods []corev1.Pod) bool {
+	for _, pod := range pods {
+		var containers []corev1.ContainerStatus
+		containers = append(containers, pod.Status.InitContainerStatuses...)
+		containers = append(containers, pod.Status.ContainerStatuses...)
+		for _, container := range containers {
+			if waiting := container.State.Waiting; waiting != nil && (waiting.Reason == "ImagePullBackOff" || waiting.Reason == "ErrImagePull") {
+				return true
+			}
+		}
+	}
+
+	return false
+}
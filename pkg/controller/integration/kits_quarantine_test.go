@@ -0,0 +1,164 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/util/test"
+)
+
+func TestIsQuarantined(t *testing.T) {
+	kit := &v1.IntegrationKit{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{
+				v1.IntegrationKitFailuresAnnotation: "2",
+			},
+		},
+	}
+	assert.False(t, isQuarantined(kit, 0))
+	assert.False(t, isQuarantined(kit, 3))
+	assert.True(t, isQuarantined(kit, 2))
+
+	kit.Annotations[v1.IntegrationKitFailuresAnnotation] = "invalid"
+	assert.False(t, isQuarantined(kit, 2))
+}
+
+func TestLookupKitForIntegration_QuarantinedKit(t *testing.T) {
+	pl := v1.NewIntegrationPlatform("ns", "camel-k")
+	pl.Status.Phase = v1.IntegrationPlatformPhaseReady
+	pl.Status.Build.KitMatching.QuarantineThreshold = 2
+	kit := &v1.IntegrationKit{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1.SchemeGroupVersion.String(),
+			Kind:       v1.IntegrationKitKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-kit",
+			Labels: map[string]string{
+				v1.IntegrationKitTypeLabel:          v1.IntegrationKitTypePlatform,
+				"camel.apache.org/runtime.version":  "1.17.0",
+				"camel.apache.org/runtime.provider": string(v1.RuntimeProviderQuarkus),
+			},
+		},
+		Spec: v1.IntegrationKitSpec{
+			Dependencies: []string{"camel-core"},
+		},
+		Status: v1.IntegrationKitStatus{
+			Phase:           v1.IntegrationKitPhaseReady,
+			RuntimeVersion:  "1.17.0",
+			RuntimeProvider: v1.RuntimeProviderQuarkus,
+		},
+	}
+	integration := &v1.Integration{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1.SchemeGroupVersion.String(),
+			Kind:       v1.IntegrationKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-integration",
+		},
+		Status: v1.IntegrationStatus{
+			RuntimeVersion:  "1.17.0",
+			RuntimeProvider: v1.RuntimeProviderQuarkus,
+			Dependencies:    []string{"camel-core"},
+		},
+	}
+
+	c, err := test.NewFakeClient(&pl, kit)
+	assert.Nil(t, err)
+	// The test client mimics patches with creations, so let's patch through the underlying client
+	patcher := c.(*test.FakeClient).Client
+
+	// The first failure is below the threshold
+	assert.Nil(t, recordKitFailure(context.TODO(), patcher, kit))
+	assert.Equal(t, "1", kit.Annotations[v1.IntegrationKitFailuresAnnotation])
+	kits, err := lookupKitsForIntegration(context.TODO(), c, integration)
+	assert.Nil(t, err)
+	assert.Len(t, kits, 1)
+
+	// The kit is automatically quarantined once the threshold is reached
+	assert.Nil(t, recordKitFailure(context.TODO(), patcher, kit))
+	assert.Equal(t, "2", kit.Annotations[v1.IntegrationKitFailuresAnnotation])
+	kits, err = lookupKitsForIntegration(context.TODO(), c, integration)
+	assert.Nil(t, err)
+	assert.Empty(t, kits)
+}
+
+func TestRecordKitFailure_Concurrent(t *testing.T) {
+	kit := &v1.IntegrationKit{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1.SchemeGroupVersion.String(),
+			Kind:       v1.IntegrationKitKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-kit",
+		},
+	}
+
+	c, err := test.NewFakeClient(kit)
+	assert.Nil(t, err)
+	// The test client mimics patches with creations, so let's patch through the underlying client
+	patcher := c.(*test.FakeClient).Client
+	stored := v1.NewIntegrationKit("ns", "my-kit")
+	assert.Nil(t, patcher.Get(context.TODO(), ctrl.ObjectKeyFromObject(stored), stored))
+	stale := stored.DeepCopy()
+
+	// A concurrent failure, recorded from a stale copy of the kit, is counted as well
+	assert.Nil(t, recordKitFailure(context.TODO(), patcher, stored))
+	assert.Nil(t, recordKitFailure(context.TODO(), patcher, stale))
+	assert.Equal(t, "2", stale.Annotations[v1.IntegrationKitFailuresAnnotation])
+
+	assert.Nil(t, patcher.Get(context.TODO(), ctrl.ObjectKeyFromObject(kit), kit))
+	assert.Equal(t, 2, kitFailures(kit))
+}
+
+func TestHasImagePullFailure(t *testing.T) {
+	pod := func(reason string) corev1.Pod {
+		return corev1.Pod{
+			Status: corev1.PodStatus{
+				ContainerStatuses: []corev1.ContainerStatus{
+					{
+						State: corev1.ContainerState{
+							Waiting: &corev1.ContainerStateWaiting{
+								Reason: reason,
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	assert.False(t, hasImagePullFailure(nil))
+	assert.False(t, hasImagePullFailure([]corev1.Pod{pod("ContainerCreating")}))
+	assert.True(t, hasImagePullFailure([]corev1.Pod{pod("ContainerCreating"), pod("ImagePullBackOff")}))
+	assert.True(t, hasImagePullFailure([]corev1.Pod{pod("ErrImagePull")}))
+}
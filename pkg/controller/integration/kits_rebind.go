@@ -0,0 +1,66 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+)
+
+// kitReevaluations schedules the periodic matching of the running integrations against the kits, so that they are
+// rebound to the better kits that have appeared since, even when they are not reconciled otherwise.
+var kitReevaluations = &kitBuildBackoff{entries: make(map[ctrl.ObjectKey]kitBuildBackoffEntry)}
+
+// scheduleKitReevaluation schedules the next matching of the integration against the kits, if it is running and
+// a re-evaluation interval is configured on the platform, that may be nil.
+func scheduleKitReevaluation(integration *v1.Integration, pl *v1.IntegrationPlatform) {
+	key := ctrl.ObjectKeyFromObject(integration)
+	if integration.Status.Phase != v1.IntegrationPhaseRunning || pl == nil || pl.Status.Build.KitMatching.ReevaluationInterval == nil {
+		kitReevaluations.clear(key)
+		return
+	}
+
+	kitReevaluations.start(key, pl.Status.Build.KitMatching.ReevaluationInterval.Duration)
+}
+
+// rebindKit binds the integration to the preferred kit, if any, according to the rebind policy configured on the
+// platform, that may be nil. With the manual policy, the preferred kit is only reported with a condition.
+// It returns whether the integration has been bound to the preferred kit.
+func rebindKit(integration *v1.Integration, preferred *v1.IntegrationKit, pl *v1.IntegrationPlatform) bool {
+	if preferred == nil {
+		integration.Status.RemoveCondition(v1.IntegrationConditionBetterKitAvailable)
+		return false
+	}
+
+	if pl != nil && pl.Status.Build.KitMatching.RebindPolicy == v1.IntegrationKitRebindPolicyManual {
+		integration.Status.SetCondition(v1.IntegrationConditionBetterKitAvailable, corev1.ConditionTrue,
+			v1.IntegrationConditionBetterKitAvailableReason,
+			fmt.Sprintf("integration kit %s/%s matches the integration better", preferred.Namespace, preferred.Name))
+		return false
+	}
+
+	integration.Status.RemoveCondition(v1.IntegrationConditionBetterKitAvailable)
+	integration.SetIntegrationKit(preferred)
+
+	return true
+}
@@ -0,0 +1,182 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/util/test"
+)
+
+func TestRebindKit(t *testing.T) {
+	now := time.Now()
+	kit := func(name string, created time.Time, priority string) *v1.IntegrationKit {
+		return &v1.IntegrationKit{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: v1.SchemeGroupVersion.String(),
+				Kind:       v1.IntegrationKitKind,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:         "ns",
+				Name:              name,
+				CreationTimestamp: metav1.NewTime(created),
+				Labels: map[string]string{
+					v1.IntegrationKitTypeLabel:          v1.IntegrationKitTypePlatform,
+					v1.IntegrationKitPriorityLabel:      priority,
+					"camel.apache.org/runtime.version":  "1.17.0",
+					"camel.apache.org/runtime.provider": string(v1.RuntimeProviderQuarkus),
+				},
+			},
+			Spec: v1.IntegrationKitSpec{
+				Dependencies: []string{"camel-core"},
+			},
+			Status: v1.IntegrationKitStatus{
+				Phase:           v1.IntegrationKitPhaseReady,
+				RuntimeVersion:  "1.17.0",
+				RuntimeProvider: v1.RuntimeProviderQuarkus,
+			},
+		}
+	}
+	newIntegration := func(current *v1.IntegrationKit) *v1.Integration {
+		integration := &v1.Integration{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: v1.SchemeGroupVersion.String(),
+				Kind:       v1.IntegrationKind,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "ns",
+				Name:      "my-integration",
+			},
+			Status: v1.IntegrationStatus{
+				Phase:           v1.IntegrationPhaseRunning,
+				RuntimeVersion:  "1.17.0",
+				RuntimeProvider: v1.RuntimeProviderQuarkus,
+				Dependencies:    []string{"camel-core"},
+			},
+		}
+		integration.SetIntegrationKit(current)
+
+		return integration
+	}
+	current := kit("my-kit-current", now.Add(-time.Hour), "0")
+	patched := kit("my-kit-patched", now, "1")
+
+	testCases := []struct {
+		name   string
+		policy v1.IntegrationKitRebindPolicy
+		kit    string
+		better bool
+	}{
+		{
+			name: "default policy",
+			kit:  "my-kit-patched",
+		},
+		{
+			name:   "auto policy",
+			policy: v1.IntegrationKitRebindPolicyAuto,
+			kit:    "my-kit-patched",
+		},
+		{
+			name:   "manual policy",
+			policy: v1.IntegrationKitRebindPolicyManual,
+			kit:    "my-kit-current",
+			better: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			pl := v1.NewIntegrationPlatform("ns", "camel-k")
+			pl.Status.Build.KitMatching.RebindPolicy = tc.policy
+
+			// No better kit matches the integration
+			c, err := test.NewFakeClient(current.DeepCopy())
+			assert.Nil(t, err)
+			integration := newIntegration(current)
+			preferred, err := findPreferredKit(context.TODO(), c, integration, current)
+			assert.Nil(t, err)
+			assert.False(t, rebindKit(integration, preferred, &pl))
+			assert.Equal(t, "my-kit-current", integration.Status.IntegrationKit.Name)
+			assert.Nil(t, integration.Status.GetCondition(v1.IntegrationConditionBetterKitAvailable))
+
+			// A patched kit, with a higher priority, appears
+			c, err = test.NewFakeClient(current.DeepCopy(), patched.DeepCopy())
+			assert.Nil(t, err)
+			preferred, err = findPreferredKit(context.TODO(), c, integration, current)
+			assert.Nil(t, err)
+			assert.Equal(t, !tc.better, rebindKit(integration, preferred, &pl))
+			assert.Equal(t, tc.kit, integration.Status.IntegrationKit.Name)
+			if tc.better {
+				condition := integration.Status.GetCondition(v1.IntegrationConditionBetterKitAvailable)
+				assert.NotNil(t, condition)
+				assert.Equal(t, corev1.ConditionTrue, condition.Status)
+				assert.Equal(t, "integration kit ns/my-kit-patched matches the integration better", condition.Message)
+			} else {
+				assert.Nil(t, integration.Status.GetCondition(v1.IntegrationConditionBetterKitAvailable))
+			}
+		})
+	}
+}
+
+func TestScheduleKitReevaluation(t *testing.T) {
+	integration := &v1.Integration{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-integration",
+		},
+		Status: v1.IntegrationStatus{
+			Phase: v1.IntegrationPhaseRunning,
+		},
+	}
+	key := ctrl.ObjectKeyFromObject(integration)
+	defer kitReevaluations.clear(key)
+
+	pl := v1.NewIntegrationPlatform("ns", "camel-k")
+
+	// The running integrations are only matched again on their reconciliations by default
+	scheduleKitReevaluation(integration, &pl)
+	_, ok := kitReevaluations.pending(key)
+	assert.False(t, ok)
+
+	pl.Status.Build.KitMatching.ReevaluationInterval = &metav1.Duration{Duration: time.Hour}
+	scheduleKitReevaluation(integration, &pl)
+	remaining, ok := kitReevaluations.pending(key)
+	assert.True(t, ok)
+	assert.True(t, remaining > 59*time.Minute && remaining <= time.Hour)
+
+	// The integrations that are not running anymore are not matched again periodically
+	integration.Status.Phase = v1.IntegrationPhaseError
+	scheduleKitReevaluation(integration, &pl)
+	_, ok = kitReevaluations.pending(key)
+	assert.False(t, ok)
+
+	integration.Status.Phase = v1.IntegrationPhaseRunning
+	scheduleKitReevaluation(integration, nil)
+	_, ok = kitReevaluations.pending(key)
+	assert.False(t, ok)
+}
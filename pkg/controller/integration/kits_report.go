@@ -0,0 +1,158 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"fmt"
+	"strings"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/kitmatch"
+)
+
+// maxMatchReportLength is the maximum length of the match report recorded on the integration status.
+const maxMatchReportLength = 1024
+
+// MatchReport aggregates the evaluations of all the kits listed when looking up the kits matching an integration.
+type MatchReport struct {
+	Evaluations []KitEvaluation
+	// the time spent in each step of the matching, if traced
+	Trace *kitmatch.MatchTrace
+	// where the kits of the local cluster have been read from
+	Source KitReadSource
+}
+
+// KitEvaluation is the evaluation of a kit against an integration.
+type KitEvaluation struct {
+	// the kit, referenced as `namespace/name`
+	Kit   string
+	Phase v1.IntegrationKitPhase
+	// whether the kit matches the integration
+	Matched bool
+	// the reason why the kit does not match, if any
+	Reason string
+	// the details of the mismatch, e.g. the missing dependencies
+	Details []string
+	// the rank of the matching kit in the lookup result, starting at 1, or 0 if the kit does not match
+	Rank int
+}
+
+// add records the evaluation of the kit, if the report is not nil.
+func (r *MatchReport) add(kit *v1.IntegrationKit, decision kitmatch.Decision) {
+	if r == nil {
+		return
+	}
+
+	r.Evaluations = append(r.Evaluations, KitEvaluation{
+		Kit:     kit.Namespace + "/" + kit.Name,
+		Phase:   kit.Status.Phase,
+		Matched: decision.Matched,
+		Reason:  decision.Reason,
+		Details: decision.Details,
+	})
+}
+
+// trace records the time spent in each step of the matching, if the report is not nil.
+func (r *MatchReport) trace(trace *kitmatch.MatchTrace) {
+	if r == nil {
+		return
+	}
+
+	r.Trace = trace
+}
+
+// source records where the kits have been read from, if the report is not nil.
+func (r *MatchReport) source(source KitReadSource) {
+	if r == nil {
+		return
+	}
+
+	r.Source = source
+}
+
+// rank records the rank of the matching kits, in the order they are returned by the lookup.
+func (r *MatchReport) rank(kits []v1.IntegrationKit) {
+	if r == nil {
+		return
+	}
+
+	for rank, kit := range kits {
+		for i := range r.Evaluations {
+			if r.Evaluations[i].Kit == kit.Namespace+"/"+kit.Name {
+				r.Evaluations[i].Rank = rank + 1
+			}
+		}
+	}
+}
+
+// Matched returns the number of matching kits.
+func (r *MatchReport) Matched() int {
+	matched := 0
+	for _, e := range r.Evaluations {
+		if e.Matched {
+			matched++
+		}
+	}
+
+	return matched
+}
+
+// notMatchedReasons maps the categories of the mismatch reasons to the reasons of the condition of the integration.
+var notMatchedReasons = map[kitmatch.Category]string{
+	kitmatch.CategoryDependencies: v1.IntegrationConditionKitDependenciesNotMatchedReason,
+	kitmatch.CategoryTraits:       v1.IntegrationConditionKitTraitsNotMatchedReason,
+	kitmatch.CategoryVersion:      v1.IntegrationConditionKitVersionNotMatchedReason,
+	kitmatch.CategoryOther:        v1.IntegrationConditionKitNotMatchedReason,
+}
+
+// NotMatchedReason returns the reason why no kit matches, classified across all the evaluated kits,
+// i.e., whether no kit exists or the category most of the kits are rejected for.
+func (r *MatchReport) NotMatchedReason() string {
+	reasons := make([]string, 0, len(r.Evaluations))
+	for _, e := range r.Evaluations {
+		if !e.Matched {
+			reasons = append(reasons, e.Reason)
+		}
+	}
+	category, ok := kitmatch.ClassifyAll(reasons)
+	if !ok {
+		return v1.IntegrationConditionNoKitsReason
+	}
+
+	return notMatchedReasons[category]
+}
+
+// Summary returns a human-readable summary of the report, truncated to the given length if positive.
+func (r *MatchReport) Summary(length int) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d integration kit(s) evaluated, %d matching", len(r.Evaluations), r.Matched())
+	for _, e := range r.Evaluations {
+		if e.Matched {
+			fmt.Fprintf(&b, "; %s: matching (rank %d)", e.Kit, e.Rank)
+		} else {
+			fmt.Fprintf(&b, "; %s: %s", e.Kit, e.Reason)
+		}
+	}
+
+	summary := b.String()
+	if length > 0 && len(summary) > length {
+		summary = summary[:length-3] + "..."
+	}
+
+	return summary
+}
@@ -0,0 +1,335 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/kitmatch"
+	"github.com/apache/camel-k/pkg/util/test"
+)
+
+func TestLookupKitsForIntegrationWithReport(t *testing.T) {
+	now := time.Now()
+	kit := func(name string, created time.Time, phase v1.IntegrationKitPhase, dependencies ...string) *v1.IntegrationKit {
+		return &v1.IntegrationKit{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: v1.SchemeGroupVersion.String(),
+				Kind:       v1.IntegrationKitKind,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:         "ns",
+				Name:              name,
+				CreationTimestamp: metav1.NewTime(created),
+				Labels: map[string]string{
+					v1.IntegrationKitTypeLabel: v1.IntegrationKitTypePlatform,
+				},
+			},
+			Spec: v1.IntegrationKitSpec{
+				Dependencies: dependencies,
+			},
+			Status: v1.IntegrationKitStatus{
+				Phase: phase,
+			},
+		}
+	}
+	quarantined := kit("my-kit-quarantined", now, v1.IntegrationKitPhaseReady, "camel-core")
+	quarantined.Annotations = map[string]string{
+		v1.IntegrationKitFailuresAnnotation: "3",
+	}
+
+	pl := v1.NewIntegrationPlatform("ns", "camel-k")
+	pl.Status.Phase = v1.IntegrationPlatformPhaseReady
+	pl.Status.Build.KitMatching.QuarantineThreshold = 3
+
+	c, err := test.NewFakeClient(
+		&pl,
+		kit("my-kit-newer", now.Add(time.Hour), v1.IntegrationKitPhaseReady, "camel-core"),
+		kit("my-kit-older", now, v1.IntegrationKitPhaseBuildRunning, "camel-core", "camel-irc"),
+		kit("my-kit-error", now, v1.IntegrationKitPhaseError, "camel-core"),
+		kit("my-kit-missing", now, v1.IntegrationKitPhaseReady, "camel-irc"),
+		quarantined,
+	)
+	assert.Nil(t, err)
+
+	kits, report, err := LookupKitsForIntegrationWithReport(context.TODO(), c, &v1.Integration{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1.SchemeGroupVersion.String(),
+			Kind:       v1.IntegrationKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-integration",
+		},
+		Status: v1.IntegrationStatus{
+			Dependencies: []string{"camel-core"},
+		},
+	})
+	assert.Nil(t, err)
+	assert.Len(t, kits, 2)
+	assert.Len(t, report.Evaluations, 5)
+	assert.Equal(t, 2, report.Matched())
+
+	evaluations := make(map[string]KitEvaluation)
+	for _, e := range report.Evaluations {
+		evaluations[e.Kit] = e
+	}
+	assert.Equal(t, KitEvaluation{
+		Kit:     "ns/my-kit-older",
+		Phase:   v1.IntegrationKitPhaseBuildRunning,
+		Matched: true,
+		Rank:    1,
+	}, evaluations["ns/my-kit-older"])
+	assert.Equal(t, KitEvaluation{
+		Kit:     "ns/my-kit-newer",
+		Phase:   v1.IntegrationKitPhaseReady,
+		Matched: true,
+		Rank:    2,
+	}, evaluations["ns/my-kit-newer"])
+	assert.Equal(t, KitEvaluation{
+		Kit:    "ns/my-kit-error",
+		Phase:  v1.IntegrationKitPhaseError,
+		Reason: "Integration kit has a phase of Error",
+	}, evaluations["ns/my-kit-error"])
+	assert.Equal(t, KitEvaluation{
+		Kit:     "ns/my-kit-missing",
+		Phase:   v1.IntegrationKitPhaseReady,
+		Reason:  "Integration and integration-kit dependencies do not match",
+		Details: []string{"camel-core"},
+	}, evaluations["ns/my-kit-missing"])
+	assert.Equal(t, KitEvaluation{
+		Kit:    "ns/my-kit-quarantined",
+		Phase:  v1.IntegrationKitPhaseReady,
+		Reason: "Integration kit is quarantined",
+	}, evaluations["ns/my-kit-quarantined"])
+
+	summary := report.Summary(0)
+	assert.True(t, strings.HasPrefix(summary, "5 integration kit(s) evaluated, 2 matching; "))
+	assert.Contains(t, summary, "ns/my-kit-older: matching (rank 1)")
+	assert.Contains(t, summary, "ns/my-kit-error: Integration kit has a phase of Error")
+
+	truncated := report.Summary(64)
+	assert.Len(t, truncated, 64)
+	assert.True(t, strings.HasSuffix(truncated, "..."))
+}
+
+func TestLookupKitsForIntegrationWithReport_Trace(t *testing.T) {
+	kit := func(name string, phase v1.IntegrationKitPhase, dependencies ...string) *v1.IntegrationKit {
+		return &v1.IntegrationKit{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: v1.SchemeGroupVersion.String(),
+				Kind:       v1.IntegrationKitKind,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "ns",
+				Name:      name,
+				Labels: map[string]string{
+					v1.IntegrationKitTypeLabel: v1.IntegrationKitTypePlatform,
+				},
+			},
+			Spec: v1.IntegrationKitSpec{
+				Dependencies: dependencies,
+			},
+			Status: v1.IntegrationKitStatus{
+				Phase: phase,
+			},
+		}
+	}
+	integration := &v1.Integration{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1.SchemeGroupVersion.String(),
+			Kind:       v1.IntegrationKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-integration",
+		},
+		Status: v1.IntegrationStatus{
+			Dependencies: []string{"camel-core"},
+		},
+	}
+
+	pl := v1.NewIntegrationPlatform("ns", "camel-k")
+	pl.Status.Phase = v1.IntegrationPlatformPhaseReady
+	objects := func() []runtime.Object {
+		return []runtime.Object{
+			&pl,
+			kit("my-kit", v1.IntegrationKitPhaseReady, "camel-core"),
+			kit("my-kit-error", v1.IntegrationKitPhaseError, "camel-core"),
+			kit("my-kit-missing", v1.IntegrationKitPhaseReady, "camel-irc"),
+		}
+	}
+
+	c, err := test.NewFakeClient(objects()...)
+	assert.Nil(t, err)
+	_, report, err := LookupKitsForIntegrationWithReport(context.TODO(), c, integration)
+	assert.Nil(t, err)
+	assert.Nil(t, report.Trace)
+
+	pl.Status.Build.KitMatching.Trace = true
+
+	c, err = test.NewFakeClient(objects()...)
+	assert.Nil(t, err)
+	_, report, err = LookupKitsForIntegrationWithReport(context.TODO(), c, integration)
+	assert.Nil(t, err)
+	assert.NotNil(t, report.Trace)
+	// The kit in error does not reach the traits step
+	assert.Equal(t, 3, report.Trace.Steps[kitmatch.MatchStepStatus].Count)
+	assert.Equal(t, 2, report.Trace.Steps[kitmatch.MatchStepTraits].Count)
+	assert.Equal(t, 2, report.Trace.Steps[kitmatch.MatchStepDependencies].Count)
+}
+
+func TestSetKitMatchedCondition(t *testing.T) {
+	integration := &v1.Integration{}
+
+	setKitMatchedCondition(integration, &MatchReport{
+		Evaluations: []KitEvaluation{
+			{Kit: "ns/my-kit", Reason: "Integration kit has a phase of Error"},
+		},
+	}, true)
+	condition := integration.Status.GetCondition(v1.IntegrationConditionKitMatched)
+	assert.NotNil(t, condition)
+	assert.Equal(t, corev1.ConditionFalse, condition.Status)
+	assert.Equal(t, v1.IntegrationConditionKitNotMatchedReason, condition.Reason)
+	assert.Equal(t, "1 integration kit(s) evaluated, 0 matching; ns/my-kit: Integration kit has a phase of Error", condition.Message)
+
+	setKitMatchedCondition(integration, &MatchReport{
+		Evaluations: []KitEvaluation{
+			{Kit: "ns/my-kit", Matched: true, Rank: 1},
+		},
+	}, true)
+	condition = integration.Status.GetCondition(v1.IntegrationConditionKitMatched)
+	assert.NotNil(t, condition)
+	assert.Equal(t, corev1.ConditionTrue, condition.Status)
+	assert.Equal(t, v1.IntegrationConditionKitMatchedReason, condition.Reason)
+}
+
+func TestSetKitMatchedCondition_NotMatchedReason(t *testing.T) {
+	kit := func(name string, runtimeVersion string, dependencies ...string) *v1.IntegrationKit {
+		return &v1.IntegrationKit{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: v1.SchemeGroupVersion.String(),
+				Kind:       v1.IntegrationKitKind,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "ns",
+				Name:      name,
+				Labels: map[string]string{
+					v1.IntegrationKitTypeLabel:          v1.IntegrationKitTypePlatform,
+					"camel.apache.org/runtime.provider": string(v1.RuntimeProviderQuarkus),
+				},
+			},
+			Spec: v1.IntegrationKitSpec{
+				Dependencies: dependencies,
+			},
+			Status: v1.IntegrationKitStatus{
+				Phase:           v1.IntegrationKitPhaseReady,
+				RuntimeVersion:  runtimeVersion,
+				RuntimeProvider: v1.RuntimeProviderQuarkus,
+			},
+		}
+	}
+	integration := func() *v1.Integration {
+		return &v1.Integration{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: v1.SchemeGroupVersion.String(),
+				Kind:       v1.IntegrationKind,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "ns",
+				Name:      "my-integration",
+			},
+			Status: v1.IntegrationStatus{
+				RuntimeVersion:  "1.17.0",
+				RuntimeProvider: v1.RuntimeProviderQuarkus,
+				Dependencies:    []string{"camel-core", "camel-http"},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name    string
+		kits    []runtime.Object
+		reason  string
+		message string
+	}{
+		{
+			name:    "no kits",
+			reason:  v1.IntegrationConditionNoKitsReason,
+			message: "0 integration kit(s) evaluated, 0 matching",
+		},
+		{
+			name: "dependencies",
+			kits: []runtime.Object{
+				kit("my-kit-1", "1.17.0", "camel-core"),
+				kit("my-kit-2", "1.17.0", "camel-http"),
+			},
+			reason:  v1.IntegrationConditionKitDependenciesNotMatchedReason,
+			message: "2 integration kit(s) evaluated, 0 matching",
+		},
+		{
+			name: "version",
+			kits: []runtime.Object{
+				kit("my-kit-1", "1.17.0", "camel-core"),
+				kit("my-kit-2", "1.16.0", "camel-core", "camel-http"),
+				kit("my-kit-3", "1.16.0", "camel-core", "camel-http"),
+			},
+			reason:  v1.IntegrationConditionKitVersionNotMatchedReason,
+			message: "3 integration kit(s) evaluated, 0 matching",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			pl := v1.NewIntegrationPlatform("ns", "camel-k")
+			pl.Status.Phase = v1.IntegrationPlatformPhaseReady
+			// The runtime versions are not used to select the kits, so that the kits of other versions are evaluated
+			pl.Status.Build.KitMatching.RuntimeVersionPrefixMatch = true
+
+			c, err := test.NewFakeClient(append(tc.kits, &pl)...)
+			assert.Nil(t, err)
+			it := integration()
+			kits, report, err := LookupKitsForIntegrationWithReport(context.TODO(), c, it)
+			assert.Nil(t, err)
+			assert.Empty(t, kits)
+
+			setKitMatchedCondition(it, report, false)
+			condition := it.Status.GetCondition(v1.IntegrationConditionKitMatched)
+			assert.NotNil(t, condition)
+			assert.Equal(t, corev1.ConditionFalse, condition.Status)
+			assert.Equal(t, tc.reason, condition.Reason)
+			assert.Equal(t, tc.message, condition.Message)
+
+			// The condition is removed once a kit matches, unless the report is recorded
+			setKitMatchedCondition(it, &MatchReport{
+				Evaluations: []KitEvaluation{{Kit: "ns/my-kit", Matched: true, Rank: 1}},
+			}, false)
+			assert.Nil(t, it.Status.GetCondition(v1.IntegrationConditionKitMatched))
+		})
+	}
+}
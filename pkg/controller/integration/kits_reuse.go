@@ -0,0 +1,36 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"context"
+
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+)
+
+// kitReuses returns the number of times the kit has been reused, as recorded on the kit.
+func kitReuses(kit *v1.IntegrationKit) int {
+	return kitAnnotationCount(kit, v1.IntegrationKitReusesAnnotation)
+}
+
+// recordKitReuse increments the number of times the kit has been reused.
+func recordKitReuse(ctx context.Context, c ctrl.Client, kit *v1.IntegrationKit) error {
+	return incrementKitAnnotation(ctx, c, kit, v1.IntegrationKitReusesAnnotation)
+}
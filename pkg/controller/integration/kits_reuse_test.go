@@ -0,0 +1,75 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/util/test"
+)
+
+func TestRecordKitReuse(t *testing.T) {
+	kit := &v1.IntegrationKit{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1.SchemeGroupVersion.String(),
+			Kind:       v1.IntegrationKitKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-kit",
+			Labels: map[string]string{
+				v1.IntegrationKitTypeLabel: v1.IntegrationKitTypePlatform,
+			},
+		},
+	}
+	assert.Equal(t, 0, kitReuses(kit))
+
+	c, err := test.NewFakeClient(kit)
+	assert.Nil(t, err)
+	// The test client mimics patches with creations, so let's patch through the underlying client
+	patcher := c.(*test.FakeClient).Client
+	stored := v1.NewIntegrationKit("ns", "my-kit")
+	assert.Nil(t, patcher.Get(context.TODO(), ctrl.ObjectKeyFromObject(stored), stored))
+
+	// The counter increments each time an integration is bound to the kit
+	assert.Nil(t, recordKitReuse(context.TODO(), patcher, stored))
+	assert.Equal(t, "1", stored.Annotations[v1.IntegrationKitReusesAnnotation])
+	assert.Nil(t, recordKitReuse(context.TODO(), patcher, stored))
+	assert.Equal(t, "2", stored.Annotations[v1.IntegrationKitReusesAnnotation])
+
+	// A concurrent reuse, recorded from a stale copy of the kit, is counted as well
+	stale := v1.NewIntegrationKit("ns", "my-kit")
+	assert.Nil(t, patcher.Get(context.TODO(), ctrl.ObjectKeyFromObject(stale), stale))
+	assert.Nil(t, recordKitReuse(context.TODO(), patcher, stored))
+	assert.Nil(t, recordKitReuse(context.TODO(), patcher, stale))
+	assert.Equal(t, "4", stale.Annotations[v1.IntegrationKitReusesAnnotation])
+
+	assert.Nil(t, patcher.Get(context.TODO(), ctrl.ObjectKeyFromObject(kit), kit))
+	assert.Equal(t, 4, kitReuses(kit))
+
+	kit.Annotations[v1.IntegrationKitReusesAnnotation] = "invalid"
+	assert.Equal(t, 0, kitReuses(kit))
+}
@@ -0,0 +1,172 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"context"
+	"time"
+
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+)
+
+// KitSource provides candidate kits for the integrations, e.g. from the cache of a kits catalog shared by
+// a federation of clusters.
+type KitSource interface {
+	// ListKits returns the kits selected by the list options, i.e., the namespace and the labels of the kits
+	ListKits(ctx context.Context, options ...ctrl.ListOption) ([]v1.IntegrationKit, error)
+}
+
+// KitSources contains the sources of kits that are looked up in addition to the local cluster,
+// none by default.
+var KitSources []KitSource
+
+// AddToKitSources registers a KitSource.
+func AddToKitSources(source KitSource) {
+	KitSources = append(KitSources, source)
+}
+
+// KitReadSource is where the kits of the local cluster are read from during a lookup.
+type KitReadSource string
+
+const (
+	// KitReadSourceCache is the informer cache the client reads from
+	KitReadSourceCache KitReadSource = "cache"
+	// KitReadSourceLive is the API server
+	KitReadSourceLive KitReadSource = "live"
+)
+
+// kitCacheSyncTimeout bounds the wait for the informer cache to sync, before the kits are read from the API server.
+var kitCacheSyncTimeout = time.Second
+
+// cacheSyncer waits for an informer cache to sync, as the cache of the manager does.
+type cacheSyncer interface {
+	WaitForCacheSync(ctx context.Context) bool
+}
+
+// kitReaders are the readers the kits of the local cluster are read with, besides the client of the actions.
+type kitReaders struct {
+	// apiReader reads the kits from the API server, while the informer cache is cold or when it is disabled
+	apiReader ctrl.Reader
+	// cache is the informer cache the client reads the kits from
+	cache cacheSyncer
+	// policies reads the match policy ConfigMaps, from a cache restricted to them, so that the other ConfigMaps
+	// are not cached
+	policies ctrl.Reader
+}
+
+// kitReader reads the kits with the client of an action, from its informer cache once it is synced,
+// or from the API server otherwise.
+type kitReader struct {
+	ctrl.Reader
+	kitReaders
+}
+
+// reader returns the reader the kits are looked up with, along with the given client.
+func (r kitReaders) reader(c ctrl.Reader) ctrl.Reader {
+	if r.apiReader == nil {
+		return c
+	}
+
+	return kitReader{Reader: c, kitReaders: r}
+}
+
+// policyReader returns the reader the match policy ConfigMaps are read with, along with the given client.
+func (r kitReaders) policyReader(c ctrl.Reader) ctrl.Reader {
+	if r.policies == nil {
+		return c
+	}
+
+	return r.policies
+}
+
+// matchPolicyReader returns the reader the match policy ConfigMaps are read with, when the kits are looked up
+// with the given reader.
+func matchPolicyReader(c ctrl.Reader) ctrl.Reader {
+	if r, ok := c.(kitReader); ok {
+		return r.policyReader(c)
+	}
+
+	return c
+}
+
+// cacheSynced returns whether the informer cache is synced, waiting for it up to the sync timeout.
+func (r kitReaders) cacheSynced(ctx context.Context) bool {
+	if r.cache == nil {
+		return true
+	}
+	ctx, cancel := context.WithTimeout(ctx, kitCacheSyncTimeout)
+	defer cancel()
+
+	return r.cache.WaitForCacheSync(ctx)
+}
+
+// listKits returns the kits of the local cluster, merged with the kits provided by the other sources.
+// The local kits take precedence over the kits with the same namespace and name from the other sources.
+// The local kits are read from the informer cache of the client once it is synced, unless the cache is disabled.
+func listKits(ctx context.Context, c ctrl.Reader, disableCache bool, options ...ctrl.ListOption) ([]v1.IntegrationKit, KitReadSource, error) {
+	kits, source, err := listLocalKits(ctx, c, disableCache, options...)
+	if err != nil {
+		return nil, source, err
+	}
+	if len(KitSources) == 0 {
+		return kits, source, nil
+	}
+
+	names := make(map[ctrl.ObjectKey]bool, len(kits))
+	for i := range kits {
+		names[ctrl.ObjectKeyFromObject(&kits[i])] = true
+	}
+	for _, kitSource := range KitSources {
+		sourceKits, err := kitSource.ListKits(ctx, options...)
+		if err != nil {
+			return nil, source, err
+		}
+		for i := range sourceKits {
+			key := ctrl.ObjectKeyFromObject(&sourceKits[i])
+			if names[key] {
+				continue
+			}
+			names[key] = true
+			kits = append(kits, sourceKits[i])
+		}
+	}
+
+	return kits, source, nil
+}
+
+// listLocalKits returns the kits of the local cluster, from the informer cache of the client if synced and the cache
+// is not disabled, or from the API server otherwise, along with where they are read from. The informer cache is
+// the index the kits are looked up from: it is updated incrementally from the events of the kits, and is safe for
+// concurrent reads, so that no other index of the kits is maintained.
+func listLocalKits(ctx context.Context, c ctrl.Reader, disableCache bool, options ...ctrl.ListOption) ([]v1.IntegrationKit, KitReadSource, error) {
+	reader, source := c, KitReadSourceCache
+	if r, ok := c.(kitReader); ok {
+		reader = r.Reader
+		if disableCache || !r.cacheSynced(ctx) {
+			reader, source = r.apiReader, KitReadSourceLive
+		}
+	}
+	list := v1.NewIntegrationKitList()
+	if err := reader.List(ctx, &list, options...); err != nil {
+		return nil, source, err
+	}
+
+	return list.Items, source, nil
+}
@@ -0,0 +1,232 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/util/test"
+)
+
+// fakeCacheSyncer is an informer cache, that is synced or never syncs.
+type fakeCacheSyncer struct {
+	synced bool
+}
+
+func (c *fakeCacheSyncer) WaitForCacheSync(ctx context.Context) bool {
+	if !c.synced {
+		<-ctx.Done()
+	}
+
+	return c.synced
+}
+
+// fakeKitCatalog is a remote kits catalog, that filters its kits by namespace and labels.
+type fakeKitCatalog struct {
+	kits []v1.IntegrationKit
+}
+
+func (c *fakeKitCatalog) ListKits(ctx context.Context, options ...ctrl.ListOption) ([]v1.IntegrationKit, error) {
+	listOptions := &ctrl.ListOptions{}
+	listOptions.ApplyOptions(options)
+
+	kits := make([]v1.IntegrationKit, 0)
+	for _, kit := range c.kits {
+		if listOptions.Namespace != "" && kit.Namespace != listOptions.Namespace {
+			continue
+		}
+		if listOptions.LabelSelector != nil && !listOptions.LabelSelector.Matches(labels.Set(kit.Labels)) {
+			continue
+		}
+		kits = append(kits, kit)
+	}
+
+	return kits, nil
+}
+
+func TestLookupKitForIntegration_KitSources(t *testing.T) {
+	kit := func(name string, dependencies ...string) v1.IntegrationKit {
+		return v1.IntegrationKit{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: v1.SchemeGroupVersion.String(),
+				Kind:       v1.IntegrationKitKind,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "ns",
+				Name:      name,
+				Labels: map[string]string{
+					v1.IntegrationKitTypeLabel:          v1.IntegrationKitTypePlatform,
+					"camel.apache.org/runtime.version":  "1.17.0",
+					"camel.apache.org/runtime.provider": string(v1.RuntimeProviderQuarkus),
+				},
+			},
+			Spec: v1.IntegrationKitSpec{
+				Dependencies: dependencies,
+			},
+			Status: v1.IntegrationKitStatus{
+				Phase:           v1.IntegrationKitPhaseReady,
+				RuntimeVersion:  "1.17.0",
+				RuntimeProvider: v1.RuntimeProviderQuarkus,
+			},
+		}
+	}
+	integration := &v1.Integration{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1.SchemeGroupVersion.String(),
+			Kind:       v1.IntegrationKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-integration",
+		},
+		Status: v1.IntegrationStatus{
+			RuntimeVersion:  "1.17.0",
+			RuntimeProvider: v1.RuntimeProviderQuarkus,
+			Dependencies:    []string{"camel-core"},
+		},
+	}
+
+	local := kit("my-kit-1", "camel-core")
+	remote := kit("my-kit-2", "camel-core")
+	remoteOtherNamespace := kit("my-kit-3", "camel-core")
+	remoteOtherNamespace.Namespace = "other"
+	remoteNotMatching := kit("my-kit-4", "camel-http")
+	// The local kit takes precedence over the remote one with the same name
+	remoteShadowed := kit("my-kit-1", "camel-http")
+
+	defer func(sources []KitSource) {
+		KitSources = sources
+	}(KitSources)
+	KitSources = nil
+
+	c, err := test.NewFakeClient(&local)
+	assert.Nil(t, err)
+
+	kits, err := lookupKitsForIntegration(context.TODO(), c, integration)
+	assert.Nil(t, err)
+	assert.Len(t, kits, 1)
+
+	AddToKitSources(&fakeKitCatalog{
+		kits: []v1.IntegrationKit{remote, remoteOtherNamespace, remoteNotMatching, remoteShadowed},
+	})
+
+	kits, err = lookupKitsForIntegration(context.TODO(), c, integration)
+	assert.Nil(t, err)
+	assert.Len(t, kits, 2)
+	assert.Equal(t, "my-kit-1", kits[0].Name)
+	assert.Equal(t, []string{"camel-core"}, kits[0].Spec.Dependencies)
+	assert.Equal(t, "my-kit-2", kits[1].Name)
+}
+
+func TestLookupKitForIntegration_KitReadSource(t *testing.T) {
+	kit := func(name string) *v1.IntegrationKit {
+		return &v1.IntegrationKit{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: v1.SchemeGroupVersion.String(),
+				Kind:       v1.IntegrationKitKind,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "ns",
+				Name:      name,
+				Labels: map[string]string{
+					v1.IntegrationKitTypeLabel: v1.IntegrationKitTypePlatform,
+				},
+			},
+			Spec: v1.IntegrationKitSpec{
+				Dependencies: []string{"camel-core"},
+			},
+			Status: v1.IntegrationKitStatus{
+				Phase: v1.IntegrationKitPhaseReady,
+			},
+		}
+	}
+	integration := &v1.Integration{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1.SchemeGroupVersion.String(),
+			Kind:       v1.IntegrationKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-integration",
+		},
+		Status: v1.IntegrationStatus{
+			Dependencies: []string{"camel-core"},
+		},
+	}
+	lookup := func(c ctrl.Reader) ([]string, KitReadSource) {
+		kits, report, err := LookupKitsForIntegrationWithReport(context.TODO(), c, integration)
+		assert.Nil(t, err)
+		names := make([]string, 0, len(kits))
+		for _, kit := range kits {
+			names = append(names, kit.Name)
+		}
+		return names, report.Source
+	}
+
+	defer func(timeout time.Duration) {
+		kitCacheSyncTimeout = timeout
+	}(kitCacheSyncTimeout)
+	kitCacheSyncTimeout = 10 * time.Millisecond
+
+	live, err := test.NewFakeClient(kit("my-live-kit"))
+	assert.Nil(t, err)
+	cache := &fakeCacheSyncer{}
+	readers := kitReaders{
+		apiReader: live,
+		cache:     cache,
+	}
+
+	pl := v1.NewIntegrationPlatform("ns", "camel-k")
+	pl.Status.Phase = v1.IntegrationPlatformPhaseReady
+	c, err := test.NewFakeClient(&pl, kit("my-cached-kit"))
+	assert.Nil(t, err)
+
+	// The kits are read from the API server while the cache is cold
+	names, source := lookup(readers.reader(c))
+	assert.Equal(t, []string{"my-live-kit"}, names)
+	assert.Equal(t, KitReadSourceLive, source)
+
+	// And from the cache of the client once it is synced
+	cache.synced = true
+	names, source = lookup(readers.reader(c))
+	assert.Equal(t, []string{"my-cached-kit"}, names)
+	assert.Equal(t, KitReadSourceCache, source)
+
+	// The client reads the kits when no API reader is configured
+	names, source = lookup(kitReaders{}.reader(c))
+	assert.Equal(t, []string{"my-cached-kit"}, names)
+	assert.Equal(t, KitReadSourceCache, source)
+
+	// Unless the cache is disabled by the platform
+	pl.Status.Build.KitMatching.ListDisableCache = true
+	c, err = test.NewFakeClient(&pl, kit("my-cached-kit"))
+	assert.Nil(t, err)
+	names, source = lookup(readers.reader(c))
+	assert.Equal(t, []string{"my-live-kit"}, names)
+	assert.Equal(t, KitReadSourceLive, source)
+}
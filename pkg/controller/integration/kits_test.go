@@ -19,15 +19,29 @@ package integration
 
 import (
 	"context"
+	"sort"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/oteltest"
+
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/utils/pointer"
 
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
 	traitv1 "github.com/apache/camel-k/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/pkg/kitmatch"
+	"github.com/apache/camel-k/pkg/util/defaults"
+	"github.com/apache/camel-k/pkg/util/digest"
 	"github.com/apache/camel-k/pkg/util/log"
 	"github.com/apache/camel-k/pkg/util/test"
 )
@@ -242,8 +256,42 @@ func TestLookupKitForIntegration_DiscardKitsWithIncompatibleTraits(t *testing.T)
 	assert.Equal(t, "my-kit-3", kits[0].Name)
 }
 
-func TestHasMatchingTraits_KitNoTraitShouldNotBePicked(t *testing.T) {
-	integration := &v1.Integration{
+func TestLookupKitForIntegration_SortedKits(t *testing.T) {
+	now := time.Now()
+	kit := func(name string, created time.Time) *v1.IntegrationKit {
+		return &v1.IntegrationKit{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: v1.SchemeGroupVersion.String(),
+				Kind:       v1.IntegrationKitKind,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:         "ns",
+				Name:              name,
+				CreationTimestamp: metav1.NewTime(created),
+				Labels: map[string]string{
+					v1.IntegrationKitTypeLabel: v1.IntegrationKitTypePlatform,
+				},
+			},
+			Spec: v1.IntegrationKitSpec{
+				Dependencies: []string{
+					"camel-core",
+				},
+			},
+			Status: v1.IntegrationKitStatus{
+				Phase: v1.IntegrationKitPhaseReady,
+			},
+		}
+	}
+
+	c, err := test.NewFakeClient(
+		kit("my-kit-a", now.Add(2*time.Hour)),
+		kit("my-kit-b", now),
+		kit("my-kit-c", now.Add(time.Hour)),
+		kit("my-kit-d", now),
+	)
+	assert.Nil(t, err)
+
+	kits, err := lookupKitsForIntegration(context.TODO(), c, &v1.Integration{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: v1.SchemeGroupVersion.String(),
 			Kind:       v1.IntegrationKind,
@@ -252,37 +300,146 @@ func TestHasMatchingTraits_KitNoTraitShouldNotBePicked(t *testing.T) {
 			Namespace: "ns",
 			Name:      "my-integration",
 		},
-		Spec: v1.IntegrationSpec{
-			Traits: v1.Traits{
-				Builder: &traitv1.BuilderTrait{
-					Trait: traitv1.Trait{
-						Enabled: pointer.Bool(true),
-					},
-				},
+		Status: v1.IntegrationStatus{
+			Dependencies: []string{
+				"camel-core",
 			},
 		},
+	})
+
+	assert.Nil(t, err)
+	assert.Len(t, kits, 4)
+	assert.Equal(t, "my-kit-b", kits[0].Name)
+	assert.Equal(t, "my-kit-d", kits[1].Name)
+	assert.Equal(t, "my-kit-c", kits[2].Name)
+	assert.Equal(t, "my-kit-a", kits[3].Name)
+}
+
+func TestLookupKitForIntegration_InvalidLabelValues(t *testing.T) {
+	c, err := test.NewFakeClient()
+	assert.Nil(t, err)
+
+	integration := func(version string, provider v1.RuntimeProvider) *v1.Integration {
+		return &v1.Integration{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "ns",
+				Name:      "my-integration",
+			},
+			Status: v1.IntegrationStatus{
+				RuntimeVersion:  version,
+				RuntimeProvider: provider,
+			},
+		}
 	}
 
-	kit := &v1.IntegrationKit{
+	_, err = lookupKitsForIntegration(context.TODO(), c, integration("1.17.0", v1.RuntimeProviderQuarkus))
+	assert.Nil(t, err)
+
+	_, err = lookupKitsForIntegration(context.TODO(), c, integration("1.17.0 SNAPSHOT", v1.RuntimeProviderQuarkus))
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), `invalid runtime version "1.17.0 SNAPSHOT" for integration ns/my-integration`)
+
+	_, err = lookupKitsForIntegration(context.TODO(), c, integration("1.17.0", "quarkus/native"))
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), `invalid runtime provider "quarkus/native" for integration ns/my-integration`)
+}
+
+func TestLookupKitForIntegration_RuntimeProviderUpgradeWindow(t *testing.T) {
+	kit := func(name string, provider v1.RuntimeProvider) *v1.IntegrationKit {
+		return &v1.IntegrationKit{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: v1.SchemeGroupVersion.String(),
+				Kind:       v1.IntegrationKitKind,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "ns",
+				Name:      name,
+				Labels: map[string]string{
+					v1.IntegrationKitTypeLabel:          v1.IntegrationKitTypePlatform,
+					"camel.apache.org/runtime.version":  "1.17.0",
+					"camel.apache.org/runtime.provider": string(provider),
+				},
+			},
+			Spec: v1.IntegrationKitSpec{
+				Dependencies: []string{"camel-core"},
+			},
+			Status: v1.IntegrationKitStatus{
+				Phase:           v1.IntegrationKitPhaseReady,
+				RuntimeVersion:  "1.17.0",
+				RuntimeProvider: provider,
+			},
+		}
+	}
+	platform := func(windowEnd time.Time) *v1.IntegrationPlatform {
+		pl := v1.NewIntegrationPlatform("ns", "camel-k")
+		pl.Status.Phase = v1.IntegrationPlatformPhaseReady
+		pl.Status.Build.KitMatching.RuntimeProviderUpgradeWindowEnd = &metav1.Time{Time: windowEnd}
+		return &pl
+	}
+	integration := &v1.Integration{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: v1.SchemeGroupVersion.String(),
-			Kind:       v1.IntegrationKitKind,
+			Kind:       v1.IntegrationKind,
 		},
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace: "ns",
-			Name:      "my-kit",
+			Name:      "my-integration",
+		},
+		Status: v1.IntegrationStatus{
+			RuntimeVersion:  "1.17.0",
+			RuntimeProvider: "new-provider",
+			Dependencies:    []string{"camel-core"},
 		},
 	}
 
-	a := buildKitAction{}
-	a.InjectLogger(log.Log)
+	// In the upgrade window, the old provider kit is used as a fallback
+	c, err := test.NewFakeClient(platform(time.Now().Add(time.Hour)), kit("old-kit", v1.RuntimeProviderQuarkus))
+	assert.Nil(t, err)
+	kits, err := lookupKitsForIntegration(context.TODO(), c, integration)
+	assert.Nil(t, err)
+	assert.Len(t, kits, 1)
+	assert.Equal(t, "old-kit", kits[0].Name)
+
+	// In the upgrade window, the new provider kit is preferred
+	c, err = test.NewFakeClient(platform(time.Now().Add(time.Hour)), kit("old-kit", v1.RuntimeProviderQuarkus), kit("new-kit", "new-provider"))
+	assert.Nil(t, err)
+	kits, err = lookupKitsForIntegration(context.TODO(), c, integration)
+	assert.Nil(t, err)
+	assert.Len(t, kits, 1)
+	assert.Equal(t, "new-kit", kits[0].Name)
 
-	ok, err := hasMatchingTraits(integration.Spec.Traits, kit.Spec.Traits)
+	// After the upgrade window, the provider must strictly match
+	c, err = test.NewFakeClient(platform(time.Now().Add(-time.Hour)), kit("old-kit", v1.RuntimeProviderQuarkus))
+	assert.Nil(t, err)
+	kits, err = lookupKitsForIntegration(context.TODO(), c, integration)
 	assert.Nil(t, err)
-	assert.False(t, ok)
+	assert.Empty(t, kits)
 }
 
-func TestHasMatchingTraits_KitSameTraitShouldBePicked(t *testing.T) {
+func TestLookupKitForIntegration_PlatformNotReady(t *testing.T) {
+	kit := &v1.IntegrationKit{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1.SchemeGroupVersion.String(),
+			Kind:       v1.IntegrationKitKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-kit",
+			Labels: map[string]string{
+				v1.IntegrationKitTypeLabel:          v1.IntegrationKitTypePlatform,
+				"camel.apache.org/runtime.version":  "1.17.0",
+				"camel.apache.org/runtime.provider": string(v1.RuntimeProviderQuarkus),
+			},
+		},
+		Spec: v1.IntegrationKitSpec{
+			Dependencies: []string{"camel-core"},
+		},
+		Status: v1.IntegrationKitStatus{
+			Phase:           v1.IntegrationKitPhaseReady,
+			RuntimeVersion:  "1.17.0",
+			RuntimeProvider: v1.RuntimeProviderQuarkus,
+		},
+	}
 	integration := &v1.Integration{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: v1.SchemeGroupVersion.String(),
@@ -292,47 +449,1588 @@ func TestHasMatchingTraits_KitSameTraitShouldBePicked(t *testing.T) {
 			Namespace: "ns",
 			Name:      "my-integration",
 		},
-		Spec: v1.IntegrationSpec{
-			Traits: v1.Traits{
-				Builder: &traitv1.BuilderTrait{
-					Trait: traitv1.Trait{
-						Enabled: pointer.Bool(true),
-					},
-					Properties: []string{
-						"build-key1=build-value1",
-					},
+		Status: v1.IntegrationStatus{
+			RuntimeVersion:  "1.17.0",
+			RuntimeProvider: v1.RuntimeProviderQuarkus,
+			Dependencies:    []string{"camel-core"},
+		},
+	}
+
+	pl := v1.NewIntegrationPlatform("ns", "camel-k")
+	pl.Status.Phase = v1.IntegrationPlatformPhaseCreating
+
+	c, err := test.NewFakeClient(&pl, kit)
+	assert.Nil(t, err)
+	kits, err := lookupKitsForIntegration(context.TODO(), c, integration)
+	assert.ErrorIs(t, err, errPlatformNotReady)
+	assert.Nil(t, kits)
+
+	pl.Status.Phase = v1.IntegrationPlatformPhaseReady
+
+	c, err = test.NewFakeClient(&pl, kit)
+	assert.Nil(t, err)
+	kits, err = lookupKitsForIntegration(context.TODO(), c, integration)
+	assert.Nil(t, err)
+	assert.Len(t, kits, 1)
+}
+
+func TestCountKitsByPhase(t *testing.T) {
+	kit := func(namespace string, name string, kitType string, phase v1.IntegrationKitPhase) *v1.IntegrationKit {
+		return &v1.IntegrationKit{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: v1.SchemeGroupVersion.String(),
+				Kind:       v1.IntegrationKitKind,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      name,
+				Labels: map[string]string{
+					v1.IntegrationKitTypeLabel: kitType,
 				},
 			},
-		},
+			Status: v1.IntegrationKitStatus{
+				Phase: phase,
+			},
+		}
 	}
 
+	c, err := test.NewFakeClient(
+		kit("ns", "ready-1", v1.IntegrationKitTypePlatform, v1.IntegrationKitPhaseReady),
+		kit("ns", "ready-2", v1.IntegrationKitTypeExternal, v1.IntegrationKitPhaseReady),
+		kit("ns", "building", v1.IntegrationKitTypePlatform, v1.IntegrationKitPhaseBuildRunning),
+		kit("ns", "error", v1.IntegrationKitTypePlatform, v1.IntegrationKitPhaseError),
+		// User kits cannot be reused
+		kit("ns", "user", v1.IntegrationKitTypeUser, v1.IntegrationKitPhaseReady),
+		kit("other", "other-ready", v1.IntegrationKitTypePlatform, v1.IntegrationKitPhaseReady),
+	)
+	assert.Nil(t, err)
+
+	counts, err := CountKitsByPhase(context.TODO(), c, "ns")
+	assert.Nil(t, err)
+	assert.Equal(t, map[v1.IntegrationKitPhase]int{
+		v1.IntegrationKitPhaseReady:        2,
+		v1.IntegrationKitPhaseBuildRunning: 1,
+		v1.IntegrationKitPhaseError:        1,
+	}, counts)
+
+	counts, err = CountKitsByPhase(context.TODO(), c, "empty")
+	assert.Nil(t, err)
+	assert.Empty(t, counts)
+}
+
+func TestLookupKitForIntegration_CrossPlatformReuse(t *testing.T) {
+	newPlatform := func(namespace string, runtimeVersion string, reuse ...string) *v1.IntegrationPlatform {
+		pl := v1.NewIntegrationPlatform(namespace, "camel-k")
+		pl.Status.Phase = v1.IntegrationPlatformPhaseReady
+		pl.Status.Build.RuntimeVersion = runtimeVersion
+		pl.Status.Build.RuntimeProvider = v1.RuntimeProviderQuarkus
+		pl.Status.Build.KitMatching.ReusePlatforms = reuse
+		return &pl
+	}
 	kit := &v1.IntegrationKit{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: v1.SchemeGroupVersion.String(),
 			Kind:       v1.IntegrationKitKind,
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Namespace: "ns",
+			Namespace: "ns-b",
 			Name:      "my-kit",
+			Labels: map[string]string{
+				v1.IntegrationKitTypeLabel:          v1.IntegrationKitTypePlatform,
+				"camel.apache.org/runtime.version":  "1.17.0",
+				"camel.apache.org/runtime.provider": string(v1.RuntimeProviderQuarkus),
+			},
 		},
 		Spec: v1.IntegrationKitSpec{
-			Traits: v1.IntegrationKitTraits{
-				Builder: &traitv1.BuilderTrait{
-					Trait: traitv1.Trait{
-						Enabled: pointer.Bool(true),
-					},
-					Properties: []string{
-						"build-key1=build-value1",
-					},
+			Dependencies: []string{"camel-core"},
+		},
+		Status: v1.IntegrationKitStatus{
+			Phase:           v1.IntegrationKitPhaseReady,
+			RuntimeVersion:  "1.17.0",
+			RuntimeProvider: v1.RuntimeProviderQuarkus,
+		},
+	}
+	integration := &v1.Integration{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1.SchemeGroupVersion.String(),
+			Kind:       v1.IntegrationKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns-a",
+			Name:      "my-integration",
+		},
+		Status: v1.IntegrationStatus{
+			RuntimeVersion:  "1.17.0",
+			RuntimeProvider: v1.RuntimeProviderQuarkus,
+			Dependencies:    []string{"camel-core"},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		platforms []runtime.Object
+		kits      int
+	}{
+		{
+			name:      "reuse not configured",
+			platforms: []runtime.Object{newPlatform("ns-a", "1.17.0"), newPlatform("ns-b", "1.17.0")},
+			kits:      0,
+		},
+		{
+			name:      "reuse allowed",
+			platforms: []runtime.Object{newPlatform("ns-a", "1.17.0", "ns-b/camel-k"), newPlatform("ns-b", "1.17.0")},
+			kits:      1,
+		},
+		{
+			name:      "runtimes do not align",
+			platforms: []runtime.Object{newPlatform("ns-a", "1.17.0", "ns-b/camel-k"), newPlatform("ns-b", "1.18.0")},
+			kits:      0,
+		},
+		{
+			name:      "platform not found",
+			platforms: []runtime.Object{newPlatform("ns-a", "1.17.0", "ns-c/camel-k"), newPlatform("ns-b", "1.17.0")},
+			kits:      0,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			c, err := test.NewFakeClient(append(tc.platforms, kit.DeepCopy())...)
+			assert.Nil(t, err)
+			kits, err := lookupKitsForIntegration(context.TODO(), c, integration)
+			assert.Nil(t, err)
+			assert.Len(t, kits, tc.kits)
+		})
+	}
+
+	// Invalid platform references are reported
+	c, err := test.NewFakeClient(newPlatform("ns-a", "1.17.0", "camel-k"))
+	assert.Nil(t, err)
+	_, err = lookupKitsForIntegration(context.TODO(), c, integration)
+	assert.NotNil(t, err)
+}
+
+func TestLookupKitForIntegration_RuntimeVersionPrefixMatch(t *testing.T) {
+	kit := func(name string, runtimeVersion string) *v1.IntegrationKit {
+		return &v1.IntegrationKit{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: v1.SchemeGroupVersion.String(),
+				Kind:       v1.IntegrationKitKind,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "ns",
+				Name:      name,
+				Labels: map[string]string{
+					v1.IntegrationKitTypeLabel:          v1.IntegrationKitTypePlatform,
+					"camel.apache.org/runtime.version":  runtimeVersion,
+					"camel.apache.org/runtime.provider": string(v1.RuntimeProviderQuarkus),
 				},
 			},
+			Spec: v1.IntegrationKitSpec{
+				Dependencies: []string{"camel-core"},
+			},
+			Status: v1.IntegrationKitStatus{
+				Phase:           v1.IntegrationKitPhaseReady,
+				RuntimeVersion:  runtimeVersion,
+				RuntimeProvider: v1.RuntimeProviderQuarkus,
+			},
+		}
+	}
+	integration := &v1.Integration{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1.SchemeGroupVersion.String(),
+			Kind:       v1.IntegrationKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-integration",
+		},
+		Status: v1.IntegrationStatus{
+			RuntimeVersion:  "1.17",
+			RuntimeProvider: v1.RuntimeProviderQuarkus,
+			Dependencies:    []string{"camel-core"},
 		},
 	}
 
-	a := buildKitAction{}
-	a.InjectLogger(log.Log)
+	pl := v1.NewIntegrationPlatform("ns", "camel-k")
+	pl.Status.Phase = v1.IntegrationPlatformPhaseReady
+
+	c, err := test.NewFakeClient(&pl, kit("my-kit-1", "1.17.1"), kit("my-kit-2", "1.18.0"))
+	assert.Nil(t, err)
+	kits, err := lookupKitsForIntegration(context.TODO(), c, integration)
+	assert.Nil(t, err)
+	assert.Empty(t, kits)
+
+	pl.Status.Build.KitMatching.RuntimeVersionPrefixMatch = true
+
+	c, err = test.NewFakeClient(&pl, kit("my-kit-1", "1.17.1"), kit("my-kit-2", "1.18.0"))
+	assert.Nil(t, err)
+	kits, err = lookupKitsForIntegration(context.TODO(), c, integration)
+	assert.Nil(t, err)
+	assert.Len(t, kits, 1)
+	assert.Equal(t, "my-kit-1", kits[0].Name)
+}
+
+func TestLookupKitForIntegration_TraceSpan(t *testing.T) {
+	kit := func(name string, dependencies ...string) *v1.IntegrationKit {
+		return &v1.IntegrationKit{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: v1.SchemeGroupVersion.String(),
+				Kind:       v1.IntegrationKitKind,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "ns",
+				Name:      name,
+				Labels: map[string]string{
+					v1.IntegrationKitTypeLabel: v1.IntegrationKitTypePlatform,
+				},
+			},
+			Spec: v1.IntegrationKitSpec{
+				Dependencies: dependencies,
+			},
+			Status: v1.IntegrationKitStatus{
+				Phase: v1.IntegrationKitPhaseReady,
+			},
+		}
+	}
+
+	sr := new(oteltest.SpanRecorder)
+	tp := otel.GetTracerProvider()
+	otel.SetTracerProvider(oteltest.NewTracerProvider(oteltest.WithSpanRecorder(sr)))
+	defer otel.SetTracerProvider(tp)
+
+	c, err := test.NewFakeClient(
+		kit("my-kit-1", "camel-core"),
+		kit("my-kit-2", "camel-core", "camel-irc"),
+		kit("my-kit-3", "camel-timer"),
+	)
+	assert.Nil(t, err)
 
-	ok, err := hasMatchingTraits(integration.Spec.Traits, kit.Spec.Traits)
+	kits, err := lookupKitsForIntegration(context.TODO(), c, &v1.Integration{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1.SchemeGroupVersion.String(),
+			Kind:       v1.IntegrationKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-integration",
+		},
+		Status: v1.IntegrationStatus{
+			Dependencies: []string{"camel-core"},
+		},
+	})
 	assert.Nil(t, err)
-	assert.True(t, ok)
+	assert.Len(t, kits, 2)
+
+	spans := sr.Completed()
+	assert.Len(t, spans, 1)
+	assert.Equal(t, "lookupKitsForIntegration", spans[0].Name())
+	attributes := spans[0].Attributes()
+	assert.Equal(t, attribute.StringValue("ns/my-integration"), attributes["integration"])
+	assert.Equal(t, attribute.IntValue(3), attributes["kits.listed"])
+	assert.Equal(t, attribute.IntValue(2), attributes["kits.matched"])
+	assert.Contains(t, attributes, attribute.Key("duration.ms"))
+}
+
+func TestFindBestKit(t *testing.T) {
+	now := time.Now()
+	kit := func(name string, created time.Time, priority string, phase v1.IntegrationKitPhase) *v1.IntegrationKit {
+		return &v1.IntegrationKit{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: v1.SchemeGroupVersion.String(),
+				Kind:       v1.IntegrationKitKind,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:         "ns",
+				Name:              name,
+				CreationTimestamp: metav1.NewTime(created),
+				Labels: map[string]string{
+					v1.IntegrationKitTypeLabel:     v1.IntegrationKitTypePlatform,
+					v1.IntegrationKitPriorityLabel: priority,
+				},
+			},
+			Spec: v1.IntegrationKitSpec{
+				Dependencies: []string{"camel-core"},
+			},
+			Status: v1.IntegrationKitStatus{
+				Phase: phase,
+			},
+		}
+	}
+	integration := &v1.Integration{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1.SchemeGroupVersion.String(),
+			Kind:       v1.IntegrationKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-integration",
+		},
+		Status: v1.IntegrationStatus{
+			Dependencies: []string{"camel-core"},
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		kits     []runtime.Object
+		expected string
+	}{
+		{
+			name: "no matching kit",
+		},
+		{
+			name: "one matching kit",
+			kits: []runtime.Object{
+				kit("my-kit", now, "0", v1.IntegrationKitPhaseBuildRunning),
+			},
+			expected: "my-kit",
+		},
+		{
+			name: "many matching kits",
+			kits: []runtime.Object{
+				kit("my-kit-building", now.Add(-time.Hour), "1", v1.IntegrationKitPhaseBuildRunning),
+				kit("my-kit-ready", now, "0", v1.IntegrationKitPhaseReady),
+				kit("my-kit-ready-native", now.Add(time.Hour), "1", v1.IntegrationKitPhaseReady),
+				kit("my-kit-ready-native-newer", now.Add(2*time.Hour), "1", v1.IntegrationKitPhaseReady),
+			},
+			expected: "my-kit-ready-native",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			c, err := test.NewFakeClient(tc.kits...)
+			assert.Nil(t, err)
+
+			kit, err := FindBestKit(context.TODO(), c, integration)
+			assert.Nil(t, err)
+			if tc.expected == "" {
+				assert.Nil(t, kit)
+			} else {
+				assert.NotNil(t, kit)
+				assert.Equal(t, tc.expected, kit.Name)
+			}
+		})
+	}
+}
+
+func TestLookupKitForIntegration_PreferFasterBuilds(t *testing.T) {
+	now := time.Now()
+	kit := func(name string, created time.Time, duration string) *v1.IntegrationKit {
+		return &v1.IntegrationKit{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: v1.SchemeGroupVersion.String(),
+				Kind:       v1.IntegrationKitKind,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:         "ns",
+				Name:              name,
+				CreationTimestamp: metav1.NewTime(created),
+				Labels: map[string]string{
+					v1.IntegrationKitTypeLabel: v1.IntegrationKitTypePlatform,
+				},
+			},
+			Spec: v1.IntegrationKitSpec{
+				Dependencies: []string{"camel-core"},
+			},
+			Status: v1.IntegrationKitStatus{
+				Phase:         v1.IntegrationKitPhaseReady,
+				BuildDuration: duration,
+			},
+		}
+	}
+	integration := &v1.Integration{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1.SchemeGroupVersion.String(),
+			Kind:       v1.IntegrationKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-integration",
+		},
+		Status: v1.IntegrationStatus{
+			Dependencies: []string{"camel-core"},
+		},
+	}
+
+	pl := v1.NewIntegrationPlatform("ns", "camel-k")
+	pl.Status.Phase = v1.IntegrationPlatformPhaseReady
+	objects := func() []runtime.Object {
+		return []runtime.Object{
+			&pl,
+			kit("my-kit-unknown", now.Add(-2*time.Hour), ""),
+			kit("my-kit-slow", now.Add(-time.Hour), "2m30s"),
+			kit("my-kit-fast", now, "45.5s"),
+		}
+	}
+
+	c, err := test.NewFakeClient(objects()...)
+	assert.Nil(t, err)
+	kits, err := lookupKitsForIntegration(context.TODO(), c, integration)
+	assert.Nil(t, err)
+	assert.Len(t, kits, 3)
+	assert.Equal(t, "my-kit-unknown", kits[0].Name)
+	assert.Equal(t, "my-kit-slow", kits[1].Name)
+	assert.Equal(t, "my-kit-fast", kits[2].Name)
+
+	pl.Status.Build.KitMatching.PreferFasterBuilds = true
+
+	c, err = test.NewFakeClient(objects()...)
+	assert.Nil(t, err)
+	kits, err = lookupKitsForIntegration(context.TODO(), c, integration)
+	assert.Nil(t, err)
+	assert.Len(t, kits, 3)
+	assert.Equal(t, "my-kit-fast", kits[0].Name)
+	assert.Equal(t, "my-kit-slow", kits[1].Name)
+	assert.Equal(t, "my-kit-unknown", kits[2].Name)
+
+	best, err := FindBestKit(context.TODO(), c, integration)
+	assert.Nil(t, err)
+	assert.NotNil(t, best)
+	assert.Equal(t, "my-kit-fast", best.Name)
+}
+
+func TestLookupKitForIntegration_VulnerabilityPenalty(t *testing.T) {
+	kit := func(name string, vulnerabilities string) *v1.IntegrationKit {
+		kit := &v1.IntegrationKit{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: v1.SchemeGroupVersion.String(),
+				Kind:       v1.IntegrationKitKind,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "ns",
+				Name:      name,
+				Labels: map[string]string{
+					v1.IntegrationKitTypeLabel: v1.IntegrationKitTypePlatform,
+				},
+			},
+			Spec: v1.IntegrationKitSpec{
+				Dependencies: []string{"camel-core"},
+			},
+			Status: v1.IntegrationKitStatus{
+				Phase: v1.IntegrationKitPhaseReady,
+			},
+		}
+		if vulnerabilities != "" {
+			kit.Annotations = map[string]string{
+				v1.IntegrationKitVulnerabilitiesAnnotation: vulnerabilities,
+			}
+		}
+		return kit
+	}
+	integration := &v1.Integration{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1.SchemeGroupVersion.String(),
+			Kind:       v1.IntegrationKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-integration",
+		},
+		Status: v1.IntegrationStatus{
+			Dependencies: []string{"camel-core"},
+		},
+	}
+
+	pl := v1.NewIntegrationPlatform("ns", "camel-k")
+	pl.Status.Phase = v1.IntegrationPlatformPhaseReady
+	objects := func() []runtime.Object {
+		return []runtime.Object{
+			&pl,
+			kit("my-kit-a", "12"),
+			kit("my-kit-b", "2"),
+			kit("my-kit-c", ""),
+		}
+	}
+
+	c, err := test.NewFakeClient(objects()...)
+	assert.Nil(t, err)
+	best, err := FindBestKit(context.TODO(), c, integration)
+	assert.Nil(t, err)
+	assert.NotNil(t, best)
+	assert.Equal(t, "my-kit-a", best.Name)
+
+	pl.Status.Build.KitMatching.VulnerabilityPenalty = 1
+
+	c, err = test.NewFakeClient(objects()...)
+	assert.Nil(t, err)
+	kits, err := lookupKitsForIntegration(context.TODO(), c, integration)
+	assert.Nil(t, err)
+	assert.Len(t, kits, 3)
+	assert.Equal(t, "my-kit-c", kits[0].Name)
+	assert.Equal(t, "my-kit-b", kits[1].Name)
+	assert.Equal(t, "my-kit-a", kits[2].Name)
+
+	best, err = FindBestKit(context.TODO(), c, integration)
+	assert.Nil(t, err)
+	assert.NotNil(t, best)
+	assert.Equal(t, "my-kit-c", best.Name)
+
+	// The penalty lowers the score of the kits, rather than ranking them after the kits without vulnerabilities
+	distant := kit("my-kit-d", "")
+	distant.Spec.Configuration = []v1.ConfigurationSpec{
+		{Type: "property", Value: "key1=value1"},
+		{Type: "property", Value: "key2=value2"},
+		{Type: "property", Value: "key3=value3"},
+	}
+	c, err = test.NewFakeClient(&pl, kit("my-kit-b", "2"), distant)
+	assert.Nil(t, err)
+	kits, err = lookupKitsForIntegration(context.TODO(), c, integration)
+	assert.Nil(t, err)
+	assert.Len(t, kits, 2)
+	assert.Equal(t, "my-kit-b", kits[0].Name)
+	assert.Equal(t, "my-kit-d", kits[1].Name)
+}
+
+func TestLookupKitForIntegration_EarlyReturnScore(t *testing.T) {
+	kit := func(name string, vulnerabilities string, dependencies ...string) *v1.IntegrationKit {
+		kit := &v1.IntegrationKit{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: v1.SchemeGroupVersion.String(),
+				Kind:       v1.IntegrationKitKind,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "ns",
+				Name:      name,
+				Labels: map[string]string{
+					v1.IntegrationKitTypeLabel: v1.IntegrationKitTypePlatform,
+				},
+			},
+			Spec: v1.IntegrationKitSpec{
+				Dependencies: dependencies,
+			},
+			Status: v1.IntegrationKitStatus{
+				Phase: v1.IntegrationKitPhaseReady,
+			},
+		}
+		if vulnerabilities != "" {
+			kit.Annotations = map[string]string{
+				v1.IntegrationKitVulnerabilitiesAnnotation: vulnerabilities,
+			}
+		}
+		return kit
+	}
+	integration := &v1.Integration{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1.SchemeGroupVersion.String(),
+			Kind:       v1.IntegrationKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-integration",
+		},
+		Status: v1.IntegrationStatus{
+			Dependencies: []string{"camel-core"},
+		},
+	}
+
+	pl := v1.NewIntegrationPlatform("ns", "camel-k")
+	pl.Status.Phase = v1.IntegrationPlatformPhaseReady
+	pl.Status.Build.KitMatching.VulnerabilityPenalty = 1
+	objects := func() []runtime.Object {
+		return []runtime.Object{
+			&pl,
+			// The kits that do not match never end the lookup, whatever their score
+			kit("my-kit-0", "", "camel-log"),
+			kit("my-kit-a", "12", "camel-core"),
+			kit("my-kit-b", "2", "camel-core"),
+			kit("my-kit-c", "", "camel-core"),
+		}
+	}
+	evaluated := func(report *MatchReport) []string {
+		kits := make([]string, 0, len(report.Evaluations))
+		for _, e := range report.Evaluations {
+			kits = append(kits, e.Kit)
+		}
+		sort.Strings(kits)
+		return kits
+	}
+
+	c, err := test.NewFakeClient(objects()...)
+	assert.Nil(t, err)
+	kits, report, err := LookupKitsForIntegrationWithReport(context.TODO(), c, integration)
+	assert.Nil(t, err)
+	assert.Len(t, kits, 3)
+	assert.Equal(t, []string{"ns/my-kit-0", "ns/my-kit-a", "ns/my-kit-b", "ns/my-kit-c"}, evaluated(report))
+
+	// The lookup returns as soon as a kit is good enough, skipping the best kit
+	pl.Status.Build.KitMatching.EarlyReturnScore = 95
+
+	c, err = test.NewFakeClient(objects()...)
+	assert.Nil(t, err)
+	kits, report, err = LookupKitsForIntegrationWithReport(context.TODO(), c, integration)
+	assert.Nil(t, err)
+	assert.Len(t, kits, 2)
+	assert.Equal(t, "my-kit-b", kits[0].Name)
+	assert.Equal(t, "my-kit-a", kits[1].Name)
+	assert.Equal(t, []string{"ns/my-kit-0", "ns/my-kit-a", "ns/my-kit-b"}, evaluated(report))
+
+	// The kits are evaluated by creation time, whatever the order they are listed in
+	older := objects()
+	for _, o := range older[1:] {
+		o.(*v1.IntegrationKit).CreationTimestamp = metav1.Now()
+	}
+	older[3].(*v1.IntegrationKit).CreationTimestamp = metav1.NewTime(time.Now().Add(-time.Hour))
+
+	c, err = test.NewFakeClient(older...)
+	assert.Nil(t, err)
+	kits, report, err = LookupKitsForIntegrationWithReport(context.TODO(), c, integration)
+	assert.Nil(t, err)
+	assert.Len(t, kits, 1)
+	assert.Equal(t, "my-kit-b", kits[0].Name)
+	assert.Equal(t, []string{"ns/my-kit-b"}, evaluated(report))
+
+	// A score no kit reaches evaluates all the kits
+	pl.Status.Build.KitMatching.EarlyReturnScore = kitmatch.MaxScore + 1
+
+	c, err = test.NewFakeClient(objects()...)
+	assert.Nil(t, err)
+	kits, err = lookupKitsForIntegration(context.TODO(), c, integration)
+	assert.Nil(t, err)
+	assert.Len(t, kits, 3)
+	assert.Equal(t, "my-kit-c", kits[0].Name)
+}
+
+func TestLookupKitForIntegration_ListExcludeErrors(t *testing.T) {
+	kit := func(name string, phase v1.IntegrationKitPhase, labeled bool) *v1.IntegrationKit {
+		kit := &v1.IntegrationKit{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: v1.SchemeGroupVersion.String(),
+				Kind:       v1.IntegrationKitKind,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "ns",
+				Name:      name,
+				Labels: map[string]string{
+					v1.IntegrationKitTypeLabel: v1.IntegrationKitTypePlatform,
+				},
+			},
+			Spec: v1.IntegrationKitSpec{
+				Dependencies: []string{"camel-core"},
+			},
+			Status: v1.IntegrationKitStatus{
+				Phase: phase,
+			},
+		}
+		if labeled {
+			kit.Labels[v1.IntegrationKitPhaseLabel] = v1.IntegrationKitPhaseLabelValue(phase)
+		}
+		return kit
+	}
+	integration := &v1.Integration{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1.SchemeGroupVersion.String(),
+			Kind:       v1.IntegrationKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-integration",
+		},
+		Status: v1.IntegrationStatus{
+			Dependencies: []string{"camel-core"},
+		},
+	}
+
+	pl := v1.NewIntegrationPlatform("ns", "camel-k")
+	pl.Status.Phase = v1.IntegrationPlatformPhaseReady
+	objects := func() []runtime.Object {
+		return []runtime.Object{
+			&pl,
+			kit("my-kit-ready", v1.IntegrationKitPhaseReady, true),
+			kit("my-kit-building", v1.IntegrationKitPhaseBuildRunning, true),
+			kit("my-kit-error", v1.IntegrationKitPhaseError, true),
+			kit("my-kit-error-unlabeled", v1.IntegrationKitPhaseError, false),
+		}
+	}
+	evaluated := func(report *MatchReport) []string {
+		kits := make([]string, 0, len(report.Evaluations))
+		for _, e := range report.Evaluations {
+			kits = append(kits, e.Kit)
+		}
+		sort.Strings(kits)
+		return kits
+	}
+
+	c, err := test.NewFakeClient(objects()...)
+	assert.Nil(t, err)
+	kits, report, err := LookupKitsForIntegrationWithReport(context.TODO(), c, integration)
+	assert.Nil(t, err)
+	assert.Len(t, kits, 2)
+	assert.Equal(t, []string{"ns/my-kit-building", "ns/my-kit-error", "ns/my-kit-error-unlabeled", "ns/my-kit-ready"}, evaluated(report))
+
+	pl.Status.Build.KitMatching.ListExcludeErrors = true
+
+	c, err = test.NewFakeClient(objects()...)
+	assert.Nil(t, err)
+	kits, report, err = LookupKitsForIntegrationWithReport(context.TODO(), c, integration)
+	assert.Nil(t, err)
+	assert.Len(t, kits, 2)
+	// The kits in error are excluded, whether they are labeled with their phase or not yet
+	assert.Equal(t, []string{"ns/my-kit-building", "ns/my-kit-ready"}, evaluated(report))
+
+	// The kits labeled as in error are not even listed, unlike the kits not labeled with their phase yet
+	selector, err := reusableKitTypesSelector(true)
+	assert.Nil(t, err)
+	list := v1.NewIntegrationKitList()
+	assert.Nil(t, c.List(context.TODO(), &list, ctrl.InNamespace("ns"), selector))
+	listed := make([]string, 0, len(list.Items))
+	for _, kit := range list.Items {
+		listed = append(listed, kit.Name)
+	}
+	assert.ElementsMatch(t, []string{"my-kit-building", "my-kit-error-unlabeled", "my-kit-ready"}, listed)
+
+	// The kits in error are still excluded when the lookup is restricted by a caller selector
+	caller, err := labels.Parse(v1.IntegrationKitTypeLabel + "=" + v1.IntegrationKitTypePlatform)
+	assert.Nil(t, err)
+	kits, err = lookupKitsForIntegration(context.TODO(), c, integration, ctrl.MatchingLabelsSelector{Selector: caller})
+	assert.Nil(t, err)
+	assert.Len(t, kits, 2)
+	for _, kit := range kits {
+		assert.NotEqual(t, v1.IntegrationKitPhaseError, kit.Status.Phase)
+	}
+}
+
+func TestLookupKitForIntegration_DisableKitReuse(t *testing.T) {
+	integration := &v1.Integration{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1.SchemeGroupVersion.String(),
+			Kind:       v1.IntegrationKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-integration",
+		},
+		Status: v1.IntegrationStatus{
+			Dependencies: []string{"camel-core"},
+		},
+	}
+
+	pl := v1.NewIntegrationPlatform("ns", "camel-k")
+	pl.Status.Phase = v1.IntegrationPlatformPhaseReady
+	pl.Status.Build.KitMatching.DisableReuse = true
+	objects := func() []runtime.Object {
+		return []runtime.Object{
+			&pl,
+			&v1.IntegrationKit{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: v1.SchemeGroupVersion.String(),
+					Kind:       v1.IntegrationKitKind,
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "ns",
+					Name:      "my-kit",
+					Labels: map[string]string{
+						v1.IntegrationKitTypeLabel: v1.IntegrationKitTypePlatform,
+					},
+				},
+				Spec: v1.IntegrationKitSpec{
+					Dependencies: []string{"camel-core"},
+				},
+				Status: v1.IntegrationKitStatus{
+					Phase: v1.IntegrationKitPhaseReady,
+				},
+			},
+		}
+	}
+
+	c, err := test.NewFakeClient(objects()...)
+	assert.Nil(t, err)
+	kits, report, err := LookupKitsForIntegrationWithReport(context.TODO(), c, integration)
+	assert.Nil(t, err)
+	assert.Empty(t, kits)
+	assert.Empty(t, report.Evaluations)
+	best, err := FindBestKit(context.TODO(), c, integration)
+	assert.Nil(t, err)
+	assert.Nil(t, best)
+
+	pl.Status.Build.KitMatching.DisableReuse = false
+
+	c, err = test.NewFakeClient(objects()...)
+	assert.Nil(t, err)
+	kits, err = lookupKitsForIntegration(context.TODO(), c, integration)
+	assert.Nil(t, err)
+	assert.Len(t, kits, 1)
+	assert.Equal(t, "my-kit", kits[0].Name)
+}
+
+func TestLookupKitForIntegration_StatusGenerationSkew(t *testing.T) {
+	kit := &v1.IntegrationKit{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1.SchemeGroupVersion.String(),
+			Kind:       v1.IntegrationKitKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-kit",
+			Labels: map[string]string{
+				v1.IntegrationKitTypeLabel:          v1.IntegrationKitTypePlatform,
+				"camel.apache.org/runtime.version":  "1.17.0",
+				"camel.apache.org/runtime.provider": string(v1.RuntimeProviderQuarkus),
+			},
+		},
+		Spec: v1.IntegrationKitSpec{
+			Dependencies: []string{"camel-core"},
+		},
+		Status: v1.IntegrationKitStatus{
+			Phase:           v1.IntegrationKitPhaseReady,
+			RuntimeVersion:  "1.17.0",
+			RuntimeProvider: v1.RuntimeProviderQuarkus,
+		},
+	}
+	integration := func(generation int64, observedGeneration int64) *v1.Integration {
+		return &v1.Integration{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: v1.SchemeGroupVersion.String(),
+				Kind:       v1.IntegrationKind,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:  "ns",
+				Name:       "my-integration",
+				Generation: generation,
+			},
+			Status: v1.IntegrationStatus{
+				ObservedGeneration: observedGeneration,
+				RuntimeVersion:     "1.17.0",
+				RuntimeProvider:    v1.RuntimeProviderQuarkus,
+				Dependencies:       []string{"camel-core"},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name        string
+		maxSkew     *int64
+		integration *v1.Integration
+		stale       bool
+	}{
+		{
+			name:        "in sync",
+			maxSkew:     pointer.Int64(0),
+			integration: integration(3, 3),
+		},
+		{
+			name:        "skewed",
+			maxSkew:     pointer.Int64(0),
+			integration: integration(4, 3),
+			stale:       true,
+		},
+		{
+			name:        "skewed within the limit",
+			maxSkew:     pointer.Int64(1),
+			integration: integration(4, 3),
+		},
+		{
+			name:        "skewed without limit",
+			integration: integration(10, 3),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			pl := v1.NewIntegrationPlatform("ns", "camel-k")
+			pl.Status.Phase = v1.IntegrationPlatformPhaseReady
+			pl.Status.Build.KitMatching.MaxStatusGenerationSkew = tc.maxSkew
+
+			c, err := test.NewFakeClient(&pl, kit)
+			assert.Nil(t, err)
+			kits, err := lookupKitsForIntegration(context.TODO(), c, tc.integration)
+			if tc.stale {
+				assert.ErrorIs(t, err, errStaleStatus)
+				assert.Nil(t, kits)
+			} else {
+				assert.Nil(t, err)
+				assert.Len(t, kits, 1)
+			}
+		})
+	}
+}
+
+func TestLookupKitForIntegration_TransitionalVersion(t *testing.T) {
+	kit := &v1.IntegrationKit{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1.SchemeGroupVersion.String(),
+			Kind:       v1.IntegrationKitKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-kit",
+			Labels: map[string]string{
+				v1.IntegrationKitTypeLabel: v1.IntegrationKitTypePlatform,
+			},
+		},
+		Spec: v1.IntegrationKitSpec{
+			Dependencies: []string{"camel-core"},
+		},
+		Status: v1.IntegrationKitStatus{
+			Phase:   v1.IntegrationKitPhaseReady,
+			Version: "1.10.0",
+		},
+	}
+	initialized := metav1.Now()
+	integration := func(version string, timestamp *metav1.Time) *v1.Integration {
+		return &v1.Integration{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: v1.SchemeGroupVersion.String(),
+				Kind:       v1.IntegrationKind,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "ns",
+				Name:      "my-integration",
+			},
+			Status: v1.IntegrationStatus{
+				Phase:                   v1.IntegrationPhaseBuildingKit,
+				Version:                 version,
+				InitializationTimestamp: timestamp,
+				Dependencies:            []string{"camel-core"},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name         string
+		integration  *v1.Integration
+		transitional bool
+	}{
+		{
+			name:        "operator version",
+			integration: integration(defaults.Version, &initialized),
+		},
+		{
+			name:         "version of the operator being upgraded",
+			integration:  integration("1.10.0", &initialized),
+			transitional: true,
+		},
+		{
+			name:         "blank version once initialized",
+			integration:  integration("", &initialized),
+			transitional: true,
+		},
+		{
+			name:        "blank version before initialization",
+			integration: integration("", nil),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			pl := v1.NewIntegrationPlatform("ns", "camel-k")
+			pl.Status.Phase = v1.IntegrationPlatformPhaseReady
+
+			// The kit of the previous operator version is not matched against while the version is transitional
+			kit := kit.DeepCopy()
+			kit.Status.Version = tc.integration.Status.Version
+			c, err := test.NewFakeClient(&pl, kit)
+			assert.Nil(t, err)
+			kits, err := lookupKitsForIntegration(context.TODO(), c, tc.integration)
+			if !tc.transitional {
+				assert.Nil(t, err)
+				assert.Len(t, kits, 1)
+			} else {
+				assert.ErrorIs(t, err, errTransitionalVersion)
+				assert.Nil(t, kits)
+
+				// The integration is initialized again rather than bound to a kit
+				hash, err := digest.ComputeForIntegration(tc.integration)
+				assert.Nil(t, err)
+				tc.integration.Status.Digest = hash
+				a := buildKitAction{}
+				a.InjectLogger(log.Log)
+				a.InjectClient(c)
+				target, err := a.Handle(context.TODO(), tc.integration.DeepCopy())
+				assert.Nil(t, err)
+				assert.NotNil(t, target)
+				assert.Equal(t, v1.IntegrationPhaseInitialization, target.Status.Phase)
+				assert.Empty(t, target.Status.Version)
+				assert.Nil(t, target.Status.IntegrationKit)
+			}
+		})
+	}
+}
+
+func TestLookupKitForIntegration_OperatorVersion(t *testing.T) {
+	kit := func(name string, operatorVersion string) *v1.IntegrationKit {
+		kit := &v1.IntegrationKit{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: v1.SchemeGroupVersion.String(),
+				Kind:       v1.IntegrationKitKind,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "ns",
+				Name:      name,
+				Labels: map[string]string{
+					v1.IntegrationKitTypeLabel:          v1.IntegrationKitTypePlatform,
+					"camel.apache.org/runtime.version":  "1.17.0",
+					"camel.apache.org/runtime.provider": string(v1.RuntimeProviderQuarkus),
+				},
+			},
+			Spec: v1.IntegrationKitSpec{
+				Dependencies: []string{"camel-core"},
+			},
+			Status: v1.IntegrationKitStatus{
+				Phase:           v1.IntegrationKitPhaseReady,
+				RuntimeVersion:  "1.17.0",
+				RuntimeProvider: v1.RuntimeProviderQuarkus,
+			},
+		}
+		if operatorVersion != "" {
+			kit.Labels[v1.IntegrationKitOperatorVersionLabel] = operatorVersion
+		}
+		return kit
+	}
+	integration := &v1.Integration{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1.SchemeGroupVersion.String(),
+			Kind:       v1.IntegrationKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-integration",
+		},
+		Status: v1.IntegrationStatus{
+			RuntimeVersion:  "1.17.0",
+			RuntimeProvider: v1.RuntimeProviderQuarkus,
+			Dependencies:    []string{"camel-core"},
+		},
+	}
+
+	testCases := []struct {
+		name         string
+		required     bool
+		versionRange string
+		kits         []string
+	}{
+		{
+			name: "not required",
+			kits: []string{"my-kit-1", "my-kit-2", "my-kit-3", "my-kit-4"},
+		},
+		{
+			name:     "same version",
+			required: true,
+			kits:     []string{"my-kit-1"},
+		},
+		{
+			name:         "compatible range",
+			required:     true,
+			versionRange: ">= 1.8.0, < 1.10.0",
+			kits:         []string{"my-kit-2", "my-kit-3"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			pl := v1.NewIntegrationPlatform("ns", "camel-k")
+			pl.Status.Phase = v1.IntegrationPlatformPhaseReady
+			pl.Status.Build.KitMatching.RequireOperatorVersion = tc.required
+			pl.Status.Build.KitMatching.OperatorVersionRange = tc.versionRange
+
+			c, err := test.NewFakeClient(&pl,
+				kit("my-kit-1", defaults.Version),
+				kit("my-kit-2", "1.8.0"),
+				kit("my-kit-3", "1.9.2"),
+				// created by an operator version predating the label
+				kit("my-kit-4", ""),
+			)
+			assert.Nil(t, err)
+			kits, err := lookupKitsForIntegration(context.TODO(), c, integration)
+			assert.Nil(t, err)
+
+			names := make([]string, 0, len(kits))
+			for _, k := range kits {
+				names = append(names, k.Name)
+			}
+			assert.ElementsMatch(t, tc.kits, names)
+		})
+	}
+}
+
+func TestLookupKitForIntegration_PlatformGeneration(t *testing.T) {
+	kit := func(namespace string, name string, generation string) *v1.IntegrationKit {
+		kit := &v1.IntegrationKit{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: v1.SchemeGroupVersion.String(),
+				Kind:       v1.IntegrationKitKind,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      name,
+				Labels: map[string]string{
+					v1.IntegrationKitTypeLabel:          v1.IntegrationKitTypePlatform,
+					"camel.apache.org/runtime.version":  "1.17.0",
+					"camel.apache.org/runtime.provider": string(v1.RuntimeProviderQuarkus),
+				},
+			},
+			Spec: v1.IntegrationKitSpec{
+				Dependencies: []string{"camel-core"},
+			},
+			Status: v1.IntegrationKitStatus{
+				Phase:           v1.IntegrationKitPhaseReady,
+				RuntimeVersion:  "1.17.0",
+				RuntimeProvider: v1.RuntimeProviderQuarkus,
+			},
+		}
+		if generation != "" {
+			kit.Labels[v1.IntegrationKitPlatformGenerationLabel] = generation
+		}
+		return kit
+	}
+	integration := &v1.Integration{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1.SchemeGroupVersion.String(),
+			Kind:       v1.IntegrationKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-integration",
+		},
+		Status: v1.IntegrationStatus{
+			RuntimeVersion:  "1.17.0",
+			RuntimeProvider: v1.RuntimeProviderQuarkus,
+			Dependencies:    []string{"camel-core"},
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		required bool
+		kits     []string
+	}{
+		{
+			name: "not required",
+			kits: []string{"my-kit-1", "my-kit-2", "my-kit-3", "other-kit"},
+		},
+		{
+			name:     "same generation",
+			required: true,
+			kits:     []string{"my-kit-1"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			pl := v1.NewIntegrationPlatform("ns", "camel-k")
+			pl.Generation = 2
+			pl.Status.Phase = v1.IntegrationPlatformPhaseReady
+			pl.Status.Build.KitMatching.MatchPlatformGeneration = tc.required
+			pl.Status.Build.KitMatching.ReusePlatforms = []string{"other/camel-k"}
+			other := v1.NewIntegrationPlatform("other", "camel-k")
+			other.Generation = 2
+			other.Status.Phase = v1.IntegrationPlatformPhaseReady
+
+			c, err := test.NewFakeClient(&pl, &other,
+				kit("ns", "my-kit-1", "2"),
+				// created before the platform changed
+				kit("ns", "my-kit-2", "1"),
+				// created by an operator version predating the label
+				kit("ns", "my-kit-3", ""),
+				// created under another platform, whose generation does not relate
+				kit("other", "other-kit", "2"),
+			)
+			assert.Nil(t, err)
+			names := func(kits []v1.IntegrationKit) []string {
+				names := make([]string, 0, len(kits))
+				for _, k := range kits {
+					names = append(names, k.Name)
+				}
+				return names
+			}
+			kits, err := lookupKitsForIntegration(context.TODO(), c, integration)
+			assert.Nil(t, err)
+			assert.ElementsMatch(t, tc.kits, names(kits))
+
+			// The generation is enforced whatever the selector the lookup is restricted by
+			kits, err = lookupKitsForIntegration(context.TODO(), c, integration, ctrl.MatchingLabelsSelector{Selector: labels.Everything()})
+			assert.Nil(t, err)
+			assert.ElementsMatch(t, tc.kits, names(kits))
+
+			// The kit of the integration no longer matches once the platform generation changes
+			match, err := kitStillMatches(context.TODO(), c, integration, kit("ns", "my-kit-2", "1"))
+			assert.Nil(t, err)
+			assert.Equal(t, !tc.required, match)
+		})
+	}
+}
+
+func TestLookupKitForIntegration_PreferClosestRuntimeConfig(t *testing.T) {
+	pl := v1.NewIntegrationPlatform("ns", "camel-k")
+	pl.Status.Phase = v1.IntegrationPlatformPhaseReady
+
+	now := metav1.Now()
+	kit := func(name string, configuration ...v1.ConfigurationSpec) *v1.IntegrationKit {
+		return &v1.IntegrationKit{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: v1.SchemeGroupVersion.String(),
+				Kind:       v1.IntegrationKitKind,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:         "ns",
+				Name:              name,
+				CreationTimestamp: now,
+				Labels: map[string]string{
+					v1.IntegrationKitTypeLabel:          v1.IntegrationKitTypePlatform,
+					"camel.apache.org/runtime.version":  "1.17.0",
+					"camel.apache.org/runtime.provider": string(v1.RuntimeProviderQuarkus),
+				},
+			},
+			Spec: v1.IntegrationKitSpec{
+				Dependencies:  []string{"camel-core"},
+				Configuration: configuration,
+			},
+			Status: v1.IntegrationKitStatus{
+				Phase:           v1.IntegrationKitPhaseReady,
+				RuntimeVersion:  "1.17.0",
+				RuntimeProvider: v1.RuntimeProviderQuarkus,
+			},
+		}
+	}
+	integration := &v1.Integration{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1.SchemeGroupVersion.String(),
+			Kind:       v1.IntegrationKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-integration",
+		},
+		Spec: v1.IntegrationSpec{
+			Configuration: []v1.ConfigurationSpec{
+				{Type: "property", Value: "log.level=INFO"},
+				{Type: "env", Value: "TZ=UTC"},
+			},
+		},
+		Status: v1.IntegrationStatus{
+			RuntimeVersion:  "1.17.0",
+			RuntimeProvider: v1.RuntimeProviderQuarkus,
+			Dependencies:    []string{"camel-core"},
+		},
+	}
+	// The kits are build equivalent, only their runtime configurations differ
+	objects := func() []runtime.Object {
+		return []runtime.Object{
+			&pl,
+			kit("my-kit-1", v1.ConfigurationSpec{Type: "property", Value: "log.level=DEBUG"}),
+			kit("my-kit-2",
+				v1.ConfigurationSpec{Type: "property", Value: "log.level=INFO"},
+				v1.ConfigurationSpec{Type: "env", Value: "TZ=UTC"},
+			),
+			kit("my-kit-3", v1.ConfigurationSpec{Type: "property", Value: "log.level=INFO"}),
+		}
+	}
+
+	c, err := test.NewFakeClient(objects()...)
+	assert.Nil(t, err)
+	kits, err := lookupKitsForIntegration(context.TODO(), c, integration)
+	assert.Nil(t, err)
+	assert.Len(t, kits, 3)
+	assert.Equal(t, "my-kit-1", kits[0].Name)
+
+	pl.Status.Build.KitMatching.PreferClosestRuntimeConfig = true
+
+	c, err = test.NewFakeClient(objects()...)
+	assert.Nil(t, err)
+	kits, err = lookupKitsForIntegration(context.TODO(), c, integration)
+	assert.Nil(t, err)
+	assert.Len(t, kits, 3)
+	assert.Equal(t, "my-kit-2", kits[0].Name)
+	assert.Equal(t, "my-kit-3", kits[1].Name)
+	assert.Equal(t, "my-kit-1", kits[2].Name)
+
+	best, err := FindBestKit(context.TODO(), c, integration)
+	assert.Nil(t, err)
+	assert.NotNil(t, best)
+	assert.Equal(t, "my-kit-2", best.Name)
+}
+
+func TestLookupKitForIntegration_CoreDependencies(t *testing.T) {
+	pl := v1.NewIntegrationPlatform("ns", "camel-k")
+	pl.Status.Phase = v1.IntegrationPlatformPhaseReady
+	pl.Status.Build.KitMatching.CoreDependencies = []string{"camel-core"}
+
+	now := metav1.Now()
+	kit := func(name string, dependencies ...string) *v1.IntegrationKit {
+		return &v1.IntegrationKit{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: v1.SchemeGroupVersion.String(),
+				Kind:       v1.IntegrationKitKind,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:         "ns",
+				Name:              name,
+				CreationTimestamp: now,
+				Labels: map[string]string{
+					v1.IntegrationKitTypeLabel:          v1.IntegrationKitTypePlatform,
+					"camel.apache.org/runtime.version":  "1.17.0",
+					"camel.apache.org/runtime.provider": string(v1.RuntimeProviderQuarkus),
+				},
+			},
+			Spec: v1.IntegrationKitSpec{
+				Dependencies: dependencies,
+			},
+			Status: v1.IntegrationKitStatus{
+				Phase:           v1.IntegrationKitPhaseReady,
+				RuntimeVersion:  "1.17.0",
+				RuntimeProvider: v1.RuntimeProviderQuarkus,
+			},
+		}
+	}
+	integration := &v1.Integration{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1.SchemeGroupVersion.String(),
+			Kind:       v1.IntegrationKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-integration",
+		},
+		Status: v1.IntegrationStatus{
+			RuntimeVersion:  "1.17.0",
+			RuntimeProvider: v1.RuntimeProviderQuarkus,
+			Dependencies:    []string{"camel-core", "camel-http", "camel-log"},
+		},
+	}
+
+	c, err := test.NewFakeClient(
+		&pl,
+		kit("my-kit-1", "camel-http", "camel-log"),
+		kit("my-kit-2", "camel-core"),
+		kit("my-kit-3", "camel-core", "camel-http"),
+		kit("my-kit-4", "camel-core", "camel-http", "camel-log", "camel-timer"),
+	)
+	assert.Nil(t, err)
+
+	// The kit missing the core dependency is rejected, the others are ranked by their peripheral dependencies
+	kits, err := lookupKitsForIntegration(context.TODO(), c, integration)
+	assert.Nil(t, err)
+	assert.Len(t, kits, 3)
+	assert.Equal(t, "my-kit-3", kits[0].Name)
+	assert.Equal(t, "my-kit-4", kits[1].Name)
+	assert.Equal(t, "my-kit-2", kits[2].Name)
+}
+
+func TestLookupKitForIntegration_NamespaceMatchPolicy(t *testing.T) {
+	policy := func(namespace string, strictness string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: "v1",
+				Kind:       "ConfigMap",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      v1.IntegrationKitMatchPolicyConfigMap,
+			},
+			Data: map[string]string{
+				kitmatch.PolicyStrictnessKey: strictness,
+			},
+		}
+	}
+	kit := func(namespace string) *v1.IntegrationKit {
+		return &v1.IntegrationKit{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: v1.SchemeGroupVersion.String(),
+				Kind:       v1.IntegrationKitKind,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      "my-kit",
+				Labels: map[string]string{
+					v1.IntegrationKitTypeLabel:          v1.IntegrationKitTypePlatform,
+					"camel.apache.org/runtime.version":  "1.17.0",
+					"camel.apache.org/runtime.provider": string(v1.RuntimeProviderQuarkus),
+				},
+			},
+			Spec: v1.IntegrationKitSpec{
+				Dependencies: []string{"camel-core", "camel-http"},
+			},
+			Status: v1.IntegrationKitStatus{
+				Phase:           v1.IntegrationKitPhaseReady,
+				RuntimeVersion:  "1.17.0",
+				RuntimeProvider: v1.RuntimeProviderQuarkus,
+			},
+		}
+	}
+	integration := func(namespace string) *v1.Integration {
+		return &v1.Integration{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: v1.SchemeGroupVersion.String(),
+				Kind:       v1.IntegrationKind,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      "my-integration",
+			},
+			Status: v1.IntegrationStatus{
+				RuntimeVersion:  "1.17.0",
+				RuntimeProvider: v1.RuntimeProviderQuarkus,
+				Dependencies:    []string{"camel-core"},
+			},
+		}
+	}
+
+	c, err := test.NewFakeClient(
+		policy("ns-a", kitmatch.PolicyStrict),
+		policy("ns-b", kitmatch.PolicyLoose),
+		kit("ns-a"),
+		kit("ns-b"),
+		kit("ns-c"),
+	)
+	assert.Nil(t, err)
+
+	// The strict policy rejects the kit providing extra dependencies
+	kits, report, err := LookupKitsForIntegrationWithReport(context.TODO(), c, integration("ns-a"))
+	assert.Nil(t, err)
+	assert.Len(t, kits, 0)
+	assert.Len(t, report.Evaluations, 1)
+
+	// The loose policy accepts it
+	kits, err = lookupKitsForIntegration(context.TODO(), c, integration("ns-b"))
+	assert.Nil(t, err)
+	assert.Len(t, kits, 1)
+
+	// The namespaces without policy use the default options
+	kits, err = lookupKitsForIntegration(context.TODO(), c, integration("ns-c"))
+	assert.Nil(t, err)
+	assert.Len(t, kits, 1)
+
+	// The policies are read with the policies reader, rather than the client the kits are read with
+	policies, err := test.NewFakeClient(policy("ns-c", kitmatch.PolicyStrict))
+	assert.Nil(t, err)
+	readers := kitReaders{apiReader: c, policies: policies}
+	kits, err = lookupKitsForIntegration(context.TODO(), readers.reader(c), integration("ns-c"))
+	assert.Nil(t, err)
+	assert.Len(t, kits, 0)
+
+	// An invalid policy fails the lookup
+	c, err = test.NewFakeClient(policy("ns-a", "lenient"), kit("ns-a"))
+	assert.Nil(t, err)
+	_, err = lookupKitsForIntegration(context.TODO(), c, integration("ns-a"))
+	assert.NotNil(t, err)
+}
+
+func TestKitSelectionStrategy_ShadowedKits(t *testing.T) {
+	now := time.Now()
+	kit := func(name string, age time.Duration, priority string, dependencies ...string) v1.IntegrationKit {
+		return v1.IntegrationKit{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:         "ns",
+				Name:              name,
+				CreationTimestamp: metav1.NewTime(now.Add(-age)),
+				Labels: map[string]string{
+					v1.IntegrationKitPriorityLabel: priority,
+				},
+			},
+			Spec: v1.IntegrationKitSpec{
+				Dependencies: dependencies,
+			},
+			Status: v1.IntegrationKitStatus{
+				Phase:           v1.IntegrationKitPhaseReady,
+				RuntimeVersion:  "1.17.0",
+				RuntimeProvider: v1.RuntimeProviderQuarkus,
+			},
+		}
+	}
+
+	kits := []v1.IntegrationKit{
+		kit("my-kit-1", 3*time.Hour, "0", "camel:core"),
+		kit("my-kit-2", 2*time.Hour, "0", "camel:core"),
+		kit("my-kit-3", time.Hour, "0", "camel:core", "camel:log"),
+	}
+
+	// The oldest kit is selected among the equivalent kits
+	shadowed, err := kitmatch.ShadowedKits(kits, kitmatch.DefaultOptions(), KitSelectionStrategy(kitmatch.DefaultOptions()))
+	assert.Nil(t, err)
+	assert.Len(t, shadowed, 1)
+	assert.Equal(t, "my-kit-2", shadowed[0].Name)
+
+	// Unless a newer equivalent kit has a higher priority
+	kits[1].Labels[v1.IntegrationKitPriorityLabel] = "10"
+	shadowed, err = kitmatch.ShadowedKits(kits, kitmatch.DefaultOptions(), KitSelectionStrategy(kitmatch.DefaultOptions()))
+	assert.Nil(t, err)
+	assert.Len(t, shadowed, 1)
+	assert.Equal(t, "my-kit-1", shadowed[0].Name)
+
+	// Or built faster, when the faster builds are preferred
+	kits[1].Labels[v1.IntegrationKitPriorityLabel] = "0"
+	kits[0].Status.BuildDuration = "2m"
+	kits[1].Status.BuildDuration = "1m"
+	options := kitmatch.DefaultOptions()
+	options.PreferFasterBuilds = true
+	shadowed, err = kitmatch.ShadowedKits(kits, options, KitSelectionStrategy(options))
+	assert.Nil(t, err)
+	assert.Len(t, shadowed, 1)
+	assert.Equal(t, "my-kit-1", shadowed[0].Name)
+}
+
+func TestBuildKitAction_DanglingKitReference(t *testing.T) {
+	integration := &v1.Integration{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1.SchemeGroupVersion.String(),
+			Kind:       v1.IntegrationKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-integration",
+		},
+		Spec: v1.IntegrationSpec{
+			IntegrationKit: &corev1.ObjectReference{
+				Namespace: "ns",
+				Name:      "my-deleted-kit",
+			},
+		},
+		Status: v1.IntegrationStatus{
+			Phase:        v1.IntegrationPhaseBuildingKit,
+			Dependencies: []string{"camel-core"},
+		},
+	}
+	integration.SetIntegrationKit(v1.NewIntegrationKit("ns", "my-deleted-kit"))
+	hash, err := digest.ComputeForIntegration(integration)
+	assert.Nil(t, err)
+	integration.Status.Digest = hash
+
+	pl := v1.NewIntegrationPlatform("ns", "camel-k")
+	pl.Status.Phase = v1.IntegrationPlatformPhaseReady
+	c, err := test.NewFakeClient(
+		&pl,
+		&v1.IntegrationKit{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: v1.SchemeGroupVersion.String(),
+				Kind:       v1.IntegrationKitKind,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "ns",
+				Name:      "my-kit",
+				Labels: map[string]string{
+					v1.IntegrationKitTypeLabel: v1.IntegrationKitTypePlatform,
+				},
+			},
+			Spec: v1.IntegrationKitSpec{
+				Dependencies: []string{"camel-core"},
+			},
+			Status: v1.IntegrationKitStatus{
+				Phase: v1.IntegrationKitPhaseReady,
+			},
+		},
+	)
+	assert.Nil(t, err)
+
+	a := buildKitAction{}
+	a.InjectLogger(log.Log)
+	a.InjectClient(c)
+
+	// The dangling reference is removed, rather than failing the reconciliation until the kit exists again
+	target, err := a.Handle(context.TODO(), integration.DeepCopy())
+	assert.Nil(t, err)
+	assert.NotNil(t, target)
+	assert.Nil(t, target.Status.IntegrationKit)
+	assert.Equal(t, v1.IntegrationPhaseBuildingKit, target.Status.Phase)
+	condition := target.Status.GetCondition(v1.IntegrationConditionKitAvailable)
+	assert.NotNil(t, condition)
+	assert.Equal(t, corev1.ConditionFalse, condition.Status)
+	assert.Equal(t, v1.IntegrationConditionKitNotFoundReason, condition.Reason)
+	assert.Contains(t, condition.Message, "ns/my-deleted-kit")
+
+	// The integration then falls back to the kits matching it
+	kits, err := lookupKitsForIntegration(context.TODO(), c, target)
+	assert.Nil(t, err)
+	assert.Len(t, kits, 1)
+	assert.Equal(t, "my-kit", kits[0].Name)
 }
@@ -23,6 +23,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/metrics"
 
 	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/apache/camel-k/pkg/kitmatch"
 )
 
 var timeToFirstReadiness = prometheus.NewHistogram(
@@ -39,7 +41,53 @@ var timeToFirstReadiness = prometheus.NewHistogram(
 	},
 )
 
+var kitMatchStepDuration = prometheus.NewHistogramVec(
+	prometheus.HistogramOpts{
+		Name: "camel_k_integration_kit_match_step_seconds",
+		Help: "Camel K time spent in each step of the matching of the integration kits during a lookup",
+		Buckets: []float64{
+			0.001,
+			0.01,
+			0.1,
+			0.5,
+			1,
+		},
+	},
+	[]string{"step"},
+)
+
+var kitReads = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "camel_k_integration_kit_reads_total",
+		Help: "Camel K reads of the integration kits during a lookup, from the informer cache or the API server",
+	},
+	[]string{"source"},
+)
+
+var kitLookupHealthy = prometheus.NewGauge(
+	prometheus.GaugeOpts{
+		Name: "camel_k_integration_kit_lookup_healthy",
+		Help: "Camel K health of the integration kit lookups, 0 after consecutive failures to reach the API server",
+	},
+)
+
 func init() {
 	// Register custom metrics with the global prometheus registry
 	metrics.Registry.MustRegister(timeToFirstReadiness)
+	metrics.Registry.MustRegister(kitMatchStepDuration)
+	metrics.Registry.MustRegister(kitReads)
+	metrics.Registry.MustRegister(kitLookupHealthy)
+	kitLookupHealthy.Set(1)
+}
+
+// observeMatchTrace observes the time spent in each step of the matching during a kit lookup.
+func observeMatchTrace(trace *kitmatch.MatchTrace) {
+	for step, timing := range trace.Steps {
+		kitMatchStepDuration.WithLabelValues(string(step)).Observe(timing.Duration.Seconds())
+	}
+}
+
+// observeKitRead counts a read of the kits from the given source during a kit lookup.
+func observeKitRead(source KitReadSource) {
+	kitReads.WithLabelValues(string(source)).Inc()
 }
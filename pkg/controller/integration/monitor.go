@@ -37,6 +37,7 @@ import (
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/platform"
 	"github.com/apache/camel-k/pkg/trait"
 	"github.com/apache/camel-k/pkg/util/digest"
 	"github.com/apache/camel-k/pkg/util/kubernetes"
@@ -45,12 +46,15 @@ import (
 // The key used for propagating error details from Camel health to MicroProfile Health (See CAMEL-17138).
 const runtimeHealthCheckErrorMessage = "error.message"
 
-func NewMonitorAction() Action {
-	return &monitorAction{}
+func NewMonitorAction(kits kitReaders) Action {
+	return &monitorAction{
+		kits: kits,
+	}
 }
 
 type monitorAction struct {
 	baseAction
+	kits kitReaders
 }
 
 func (action *monitorAction) Name() string {
@@ -89,28 +93,31 @@ func (action *monitorAction) Handle(ctx context.Context, integration *v1.Integra
 		return nil, fmt.Errorf("unable to find integration kit %s/%s: %w", integration.Status.IntegrationKit.Namespace, integration.Status.IntegrationKit.Name, err)
 	}
 
-	// Check if an IntegrationKit with higher priority is ready
-	priority, ok := kit.Labels[v1.IntegrationKitPriorityLabel]
-	if !ok {
-		priority = "0"
-	}
-	withHigherPriority, err := labels.NewRequirement(v1.IntegrationKitPriorityLabel, selection.GreaterThan, []string{priority})
-	if err != nil {
-		return nil, err
-	}
-	kits, err := lookupKitsForIntegration(ctx, action.client, integration, ctrl.MatchingLabelsSelector{
-		Selector: labels.NewSelector().Add(*withHigherPriority),
-	})
-	if err != nil {
+	pl, err := platform.GetForResource(ctx, action.client, integration)
+	if err != nil && !k8serrors.IsNotFound(err) {
 		return nil, err
 	}
-	priorityReadyKit, err := findHighestPriorityReadyKit(kits)
-	if err != nil {
+
+	// Check if the IntegrationKit should be replaced, e.g. by a ready IntegrationKit with higher priority
+	preferredKit, err := findPreferredKit(ctx, action.kits.reader(action.client), integration, kit)
+	if errors.Is(err, errPlatformNotReady) {
+		// Keep the current kit until the platform is ready
+		action.L.Debug("Integration platform is not ready, skipping the lookup of integration kits with higher priority")
+	} else if errors.Is(err, errStaleStatus) {
+		// Keep the current kit until the status is up to date
+		action.L.Debug("Integration status is stale, skipping the lookup of integration kits with higher priority")
+	} else if errors.Is(err, errTransitionalVersion) {
+		// Keep the current kit until the integration is initialized again with the operator version
+		action.L.Debug("Integration status version is transitional, skipping the lookup of integration kits with higher priority")
+	} else if err != nil {
 		return nil, err
+	} else if rebindKit(integration, preferredKit, pl) {
+		if err := recordKitReuse(ctx, action.client, preferredKit); err != nil {
+			action.L.Error(err, "Failed to record the reuse of the integration kit", "integration kit", preferredKit.Name, "namespace", preferredKit.Namespace)
+		}
 	}
-	if priorityReadyKit != nil {
-		integration.SetIntegrationKit(priorityReadyKit)
-	}
+	// The running integration is matched again against the kits periodically, if configured
+	scheduleKitReevaluation(integration, pl)
 
 	// Run traits that are enabled for the phase
 	environment, err := trait.Apply(ctx, action.client, integration, kit)
@@ -151,6 +158,7 @@ func (action *monitorAction) Handle(ctx context.Context, integration *v1.Integra
 	integration.Status.Replicas = &podCount
 
 	// Reconcile Integration phase and ready condition
+	phase := integration.Status.Phase
 	if integration.Status.Phase == v1.IntegrationPhaseDeploying {
 		integration.Status.Phase = v1.IntegrationPhaseRunning
 	}
@@ -159,6 +167,14 @@ func (action *monitorAction) Handle(ctx context.Context, integration *v1.Integra
 		return nil, err
 	}
 
+	// Record the kit image failure, so that the kit can be quarantined if it keeps failing. Failing to record it
+	// must not prevent the integration phase from being updated.
+	if phase != v1.IntegrationPhaseError && integration.Status.Phase == v1.IntegrationPhaseError && hasImagePullFailure(pendingPods.Items) {
+		if err := recordKitFailure(ctx, action.client, kit); err != nil {
+			action.L.Error(err, "Failed to record the failure of the integration kit", "integration kit", kit.Name, "namespace", kit.Namespace)
+		}
+	}
+
 	return integration, nil
 }
 
@@ -364,6 +380,42 @@ func (action *monitorAction) probeReadiness(ctx context.Context, environment *tr
 	return nil
 }
 
+// findPreferredKit returns the kit the integration should be bound to instead of the current one, if any.
+// The current kit is kept while it still matches the integration, so that the integration is not re-bound
+// to another equivalent kit, unless a kit with a higher priority is ready. The integrations bound to a user,
+// or external, kit, as well as the integrations the user binds to a kit, are never re-bound.
+func findPreferredKit(ctx context.Context, c ctrl.Reader, integration *v1.Integration, current *v1.IntegrationKit) (*v1.IntegrationKit, error) {
+	if integration.Spec.IntegrationKit != nil || current.Labels[v1.IntegrationKitTypeLabel] != v1.IntegrationKitTypePlatform {
+		return nil, nil
+	}
+
+	match, err := kitStillMatches(ctx, c, integration, current)
+	if err != nil {
+		return nil, err
+	}
+
+	var options []ctrl.ListOption
+	if match {
+		priority, ok := current.Labels[v1.IntegrationKitPriorityLabel]
+		if !ok {
+			priority = "0"
+		}
+		withHigherPriority, err := labels.NewRequirement(v1.IntegrationKitPriorityLabel, selection.GreaterThan, []string{priority})
+		if err != nil {
+			return nil, err
+		}
+		options = append(options, ctrl.MatchingLabelsSelector{
+			Selector: labels.NewSelector().Add(*withHigherPriority),
+		})
+	}
+	kits, err := lookupKitsForIntegration(ctx, c, integration, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return findHighestPriorityReadyKit(kits)
+}
+
 func findHighestPriorityReadyKit(kits []v1.IntegrationKit) (*v1.IntegrationKit, error) {
 	if len(kits) == 0 {
 		return nil, nil
@@ -378,7 +430,7 @@ func findHighestPriorityReadyKit(kits []v1.IntegrationKit) (*v1.IntegrationKit,
 		if err != nil {
 			return nil, err
 		}
-		if p > priority {
+		if kit == nil || p > priority {
 			kit = &kits[i]
 			priority = p
 		}
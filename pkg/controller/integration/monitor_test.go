@@ -0,0 +1,173 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/util/test"
+)
+
+func TestFindPreferredKit(t *testing.T) {
+	now := time.Now()
+	kit := func(name string, created time.Time, priority string, phase v1.IntegrationKitPhase) *v1.IntegrationKit {
+		return &v1.IntegrationKit{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: v1.SchemeGroupVersion.String(),
+				Kind:       v1.IntegrationKitKind,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:         "ns",
+				Name:              name,
+				CreationTimestamp: metav1.NewTime(created),
+				Labels: map[string]string{
+					v1.IntegrationKitTypeLabel:          v1.IntegrationKitTypePlatform,
+					v1.IntegrationKitPriorityLabel:      priority,
+					"camel.apache.org/runtime.version":  "1.17.0",
+					"camel.apache.org/runtime.provider": string(v1.RuntimeProviderQuarkus),
+				},
+			},
+			Spec: v1.IntegrationKitSpec{
+				Dependencies: []string{"camel-core"},
+			},
+			Status: v1.IntegrationKitStatus{
+				Phase:           phase,
+				RuntimeVersion:  "1.17.0",
+				RuntimeProvider: v1.RuntimeProviderQuarkus,
+			},
+		}
+	}
+	integration := &v1.Integration{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1.SchemeGroupVersion.String(),
+			Kind:       v1.IntegrationKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-integration",
+		},
+		Status: v1.IntegrationStatus{
+			RuntimeVersion:  "1.17.0",
+			RuntimeProvider: v1.RuntimeProviderQuarkus,
+			Dependencies:    []string{"camel-core"},
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		current  *v1.IntegrationKit
+		others   []*v1.IntegrationKit
+		pinned   bool
+		expected string
+	}{
+		{
+			name:    "current kit still matches and an older equivalent kit exists",
+			current: kit("my-kit-current", now, "0", v1.IntegrationKitPhaseReady),
+			others: []*v1.IntegrationKit{
+				kit("my-kit-older", now.Add(-time.Hour), "0", v1.IntegrationKitPhaseReady),
+			},
+		},
+		{
+			name:    "current kit still matches and a ready kit with higher priority exists",
+			current: kit("my-kit-current", now, "0", v1.IntegrationKitPhaseReady),
+			others: []*v1.IntegrationKit{
+				kit("my-kit-older", now.Add(-time.Hour), "0", v1.IntegrationKitPhaseReady),
+				kit("my-kit-native", now.Add(time.Hour), "1", v1.IntegrationKitPhaseReady),
+			},
+			expected: "my-kit-native",
+		},
+		{
+			name:    "current kit still matches and a kit with higher priority is not ready",
+			current: kit("my-kit-current", now, "0", v1.IntegrationKitPhaseReady),
+			others: []*v1.IntegrationKit{
+				kit("my-kit-native", now.Add(time.Hour), "1", v1.IntegrationKitPhaseBuildRunning),
+			},
+		},
+		{
+			name:    "current kit does not match anymore",
+			current: kit("my-kit-current", now, "0", v1.IntegrationKitPhaseError),
+			others: []*v1.IntegrationKit{
+				kit("my-kit-older", now.Add(-time.Hour), "0", v1.IntegrationKitPhaseReady),
+			},
+			expected: "my-kit-older",
+		},
+		{
+			name: "current user kit does not match anymore",
+			current: func() *v1.IntegrationKit {
+				k := kit("my-kit-current", now, "0", v1.IntegrationKitPhaseError)
+				k.Labels[v1.IntegrationKitTypeLabel] = v1.IntegrationKitTypeUser
+				return k
+			}(),
+			others: []*v1.IntegrationKit{
+				kit("my-kit-older", now.Add(-time.Hour), "0", v1.IntegrationKitPhaseReady),
+			},
+		},
+		{
+			name: "current external kit and a ready kit with higher priority exists",
+			current: func() *v1.IntegrationKit {
+				k := kit("my-kit-current", now, "0", v1.IntegrationKitPhaseReady)
+				k.Labels[v1.IntegrationKitTypeLabel] = v1.IntegrationKitTypeExternal
+				return k
+			}(),
+			others: []*v1.IntegrationKit{
+				kit("my-kit-native", now.Add(time.Hour), "1", v1.IntegrationKitPhaseReady),
+			},
+		},
+		{
+			name:    "integration pinned to the current kit that does not match anymore",
+			current: kit("my-kit-current", now, "0", v1.IntegrationKitPhaseError),
+			others: []*v1.IntegrationKit{
+				kit("my-kit-older", now.Add(-time.Hour), "0", v1.IntegrationKitPhaseReady),
+			},
+			pinned: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			objects := []runtime.Object{tc.current}
+			for _, k := range tc.others {
+				objects = append(objects, k)
+			}
+			c, err := test.NewFakeClient(objects...)
+			assert.Nil(t, err)
+
+			integration := integration.DeepCopy()
+			if tc.pinned {
+				integration.Spec.IntegrationKit = &corev1.ObjectReference{Namespace: "ns", Name: tc.current.Name}
+			}
+			kit, err := findPreferredKit(context.TODO(), c, integration, tc.current)
+			assert.Nil(t, err)
+			if tc.expected == "" {
+				assert.Nil(t, kit)
+			} else {
+				assert.NotNil(t, kit)
+				assert.Equal(t, tc.expected, kit.Name)
+			}
+		})
+	}
+}
@@ -32,7 +32,9 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/kitmatch"
 	"github.com/apache/camel-k/pkg/trait"
+	"github.com/apache/camel-k/pkg/util/digest"
 	"github.com/apache/camel-k/pkg/util/kubernetes"
 )
 
@@ -181,6 +183,15 @@ func (action *buildAction) handleBuildRunning(ctx context.Context, kit *v1.Integ
 			kit.Status.Image = build.Status.Image
 		}
 
+		// Record the ImageStream the image is published to, if any
+		for _, task := range build.Spec.Tasks {
+			if task.S2i != nil {
+				kit.Status.ImageStream = build.Namespace + "/" + build.S2iImageStreamName()
+			}
+		}
+
+		kit.Status.BuildStrategy = build.Spec.Strategy
+		kit.Status.BuildDuration = build.Status.Duration
 		kit.Status.Phase = v1.IntegrationKitPhaseReady
 		kit.Status.Artifacts = make([]v1.Artifact, 0, len(build.Status.Artifacts))
 
@@ -193,6 +204,16 @@ func (action *buildAction) handleBuildRunning(ctx context.Context, kit *v1.Integ
 				Checksum: a.Checksum,
 			})
 		}
+		// Record the digest of the resolved dependency tree, that the integrations can be matched by
+		dependencyTreeDigest, err := digest.ComputeForDependencyTree(kit.Status.Artifacts)
+		if err != nil {
+			return nil, err
+		}
+		kit.Status.DependencyTreeDigest = dependencyTreeDigest
+		// Stamp the kit with what it guarantees, that the integrations are checked against before the deep comparison
+		if err := kitmatch.StampContract(kit); err != nil {
+			return nil, err
+		}
 
 		return kit, err
 	case v1.BuildPhaseError, v1.BuildPhaseInterrupted:
@@ -20,6 +20,7 @@ package integrationkit
 import (
 	"context"
 	"fmt"
+	"reflect"
 
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -311,7 +312,27 @@ func (r *reconcileIntegrationKit) update(ctx context.Context, base *v1.Integrati
 
 	target.Status.ObservedGeneration = base.Generation
 
-	err = r.client.Status().Patch(ctx, target, ctrl.MergeFrom(base))
+	// The status patch does not update the annotations the actions may have set, e.g. the kit contract
+	annotations := target.Annotations
+	if err := r.client.Status().Patch(ctx, target, ctrl.MergeFrom(base)); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	// The phase is also maintained as a label, so that the kits can be selected by phase when listed
+	labeled := target.DeepCopy()
+	labeled.Annotations = annotations
+	phase := v1.IntegrationKitPhaseLabelValue(target.Status.Phase)
+	if value, ok := target.Labels[v1.IntegrationKitPhaseLabel]; !ok || value != phase {
+		if labeled.Labels == nil {
+			labeled.Labels = make(map[string]string)
+		}
+		labeled.Labels[v1.IntegrationKitPhaseLabel] = phase
+	}
+	if !reflect.DeepEqual(labeled.ObjectMeta, target.ObjectMeta) {
+		if err := r.client.Patch(ctx, labeled, ctrl.MergeFrom(target)); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
 
-	return reconcile.Result{}, err
+	return reconcile.Result{}, nil
 }
@@ -20,7 +20,10 @@ package integrationplatform
 import (
 	"context"
 
+	corev1 "k8s.io/api/core/v1"
+
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/kitmatch"
 	platformutils "github.com/apache/camel-k/pkg/platform"
 	"github.com/apache/camel-k/pkg/util/defaults"
 )
@@ -54,5 +57,14 @@ func (action *monitorAction) Handle(ctx context.Context, platform *v1.Integratio
 		return nil, err
 	}
 
+	// The traits that are unknown to the catalog are ignored when matching the kits
+	if err := kitmatch.ValidateTraits(platform.Status.Build.KitMatching); err != nil {
+		action.L.Info("Invalid kit matching traits configuration", "error", err.Error())
+		platform.Status.SetCondition(v1.IntegrationPlatformConditionKitTraitsValid, corev1.ConditionFalse,
+			v1.IntegrationPlatformConditionKitTraitsInvalidReason, err.Error())
+	} else {
+		platform.Status.RemoveCondition(v1.IntegrationPlatformConditionKitTraitsValid)
+	}
+
 	return platform, nil
 }
@@ -0,0 +1,75 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kitmatch
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/version"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+)
+
+// apiVersionPattern matches the Kubernetes API versions, e.g. `v1` or `v1alpha1`.
+var apiVersionPattern = regexp.MustCompile(`^v\d+((alpha|beta)\d+)?$`)
+
+// integrationAPIVersion returns the version of the API the integration is declared with, e.g. `v1`, or the version
+// of this API when the integration type meta is not set, e.g. when it is read from the cache.
+func integrationAPIVersion(integration *v1.Integration) string {
+	gv, err := schema.ParseGroupVersion(integration.APIVersion)
+	if err != nil || gv.Version == "" {
+		return v1.SchemeGroupVersion.Version
+	}
+
+	return gv.Version
+}
+
+// apiVersionSupported returns whether the integration API version is supported by the kit, as declared by the kit
+// with the v1.IntegrationKitAPIVersionsAnnotation annotation, or an error if the declaration is invalid.
+// The kits that do not declare the versions they support support any version.
+func apiVersionSupported(integration *v1.Integration, kit *v1.IntegrationKit) (bool, error) {
+	declared, ok := kit.Annotations[v1.IntegrationKitAPIVersionsAnnotation]
+	if !ok {
+		return true, nil
+	}
+	lower, upper := strings.TrimSpace(declared), strings.TrimSpace(declared)
+	if i := strings.Index(declared, ".."); i >= 0 {
+		lower, upper = strings.TrimSpace(declared[:i]), strings.TrimSpace(declared[i+2:])
+	}
+	for _, bound := range []string{lower, upper} {
+		if bound != "" && !apiVersionPattern.MatchString(bound) {
+			return false, fmt.Errorf("invalid API version %q", bound)
+		}
+	}
+	if lower == "" && upper == "" {
+		return false, fmt.Errorf("empty range of API versions %q", declared)
+	}
+
+	current := integrationAPIVersion(integration)
+	if lower != "" && version.CompareKubeAwareVersionStrings(current, lower) < 0 {
+		return false, nil
+	}
+	if upper != "" && version.CompareKubeAwareVersionStrings(current, upper) > 0 {
+		return false, nil
+	}
+
+	return true, nil
+}
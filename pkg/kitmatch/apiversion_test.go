@@ -0,0 +1,131 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kitmatch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+)
+
+func TestIntegrationMatches_SupportedAPIVersions(t *testing.T) {
+	testCases := []struct {
+		name       string
+		apiVersion string
+		supported  *string
+		match      bool
+		reason     string
+	}{
+		{
+			name:       "no declared versions",
+			apiVersion: "camel.apache.org/v1",
+			match:      true,
+		},
+		{
+			name:       "declared version",
+			apiVersion: "camel.apache.org/v1",
+			supported:  pointer.String("v1"),
+			match:      true,
+		},
+		{
+			name:       "version in range",
+			apiVersion: "camel.apache.org/v1",
+			supported:  pointer.String("v1alpha1..v2"),
+			match:      true,
+		},
+		{
+			name:       "version above open range",
+			apiVersion: "camel.apache.org/v1",
+			supported:  pointer.String("v1beta1.."),
+			match:      true,
+		},
+		{
+			name:       "version above range",
+			apiVersion: "camel.apache.org/v1",
+			supported:  pointer.String("..v1beta1"),
+			match:      false,
+			reason:     "Integration-kit does not support the integration API version",
+		},
+		{
+			name:       "version below range",
+			apiVersion: "camel.apache.org/v1",
+			supported:  pointer.String("v2.."),
+			match:      false,
+			reason:     "Integration-kit does not support the integration API version",
+		},
+		{
+			name:      "version of the type meta not set",
+			supported: pointer.String("v1"),
+			match:     true,
+		},
+		{
+			name:       "invalid declared version",
+			apiVersion: "camel.apache.org/v1",
+			supported:  pointer.String("1.0..v2"),
+			match:      false,
+			reason:     "Integration-kit declares invalid supported integration API versions",
+		},
+		{
+			name:       "empty declared range",
+			apiVersion: "camel.apache.org/v1",
+			supported:  pointer.String(".."),
+			match:      false,
+			reason:     "Integration-kit declares invalid supported integration API versions",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			integration := &v1.Integration{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: tc.apiVersion,
+					Kind:       v1.IntegrationKind,
+				},
+				Status: v1.IntegrationStatus{
+					Dependencies: []string{"camel:core"},
+				},
+			}
+			kit := &v1.IntegrationKit{
+				Spec: v1.IntegrationKitSpec{
+					Dependencies: []string{"camel:core"},
+				},
+				Status: v1.IntegrationKitStatus{
+					Phase: v1.IntegrationKitPhaseReady,
+				},
+			}
+			if tc.supported != nil {
+				kit.Annotations = map[string]string{
+					v1.IntegrationKitAPIVersionsAnnotation: *tc.supported,
+				}
+			}
+
+			decision, err := Match(integration, kit, DefaultOptions())
+			assert.Nil(t, err)
+			assert.Equal(t, tc.match, decision.Matched)
+			if !tc.match {
+				assert.Equal(t, tc.reason, decision.Reason)
+				assert.Equal(t, CategoryVersion, Classify(decision.Reason))
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kitmatch
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/apache/camel-k/pkg/trait"
+	"github.com/apache/camel-k/pkg/util"
+)
+
+// ErrInvalidCatalog is returned when the trait catalog lacks kit influencing traits, e.g. when it is misconfigured,
+// rather than matching the kits whatever their traits.
+var ErrInvalidCatalog = errors.New("invalid trait catalog")
+
+// requiredInfluencingTraits are the kit influencing traits that any valid trait catalog contains.
+var requiredInfluencingTraits = []string{"builder", "quarkus"}
+
+// schedulingTraits are the traits that only configure how the integration pods are scheduled, and that are never
+// compared when matching, even when configured to be, as they cannot require the kits to be rebuilt.
+var schedulingTraits = []string{"affinity", "toleration"}
+
+// repositoryTraits are the traits that only configure where the artifacts are pulled from, and pushed to, during
+// the kit build. They are still propagated to the kits to build them, but never compared when matching, as the
+// artifacts they resolve are identified by the dependencies, which are compared on their own.
+var repositoryTraits = []string{"registry"}
+
+// isComparedTrait returns whether the trait can be compared when matching, i.e., it is neither a scheduling trait
+// nor a repository trait.
+func isComparedTrait(t trait.Trait) bool {
+	id := string(t.ID())
+	return !util.StringSliceExists(schedulingTraits, id) && !util.StringSliceExists(repositoryTraits, id)
+}
+
+// catalogTraits returns the traits of the trait catalog.
+var catalogTraits = func() []trait.Trait {
+	return trait.NewCatalog(nil).AllTraits()
+}
+
+// validateInfluencingTraits checks that the kit influencing traits resolved from the catalog contain the ones
+// that any valid catalog contains.
+func validateInfluencingTraits(traits []trait.Trait) error {
+	if len(traits) == 0 {
+		return fmt.Errorf("%w: no kit influencing trait found", ErrInvalidCatalog)
+	}
+	for _, id := range requiredInfluencingTraits {
+		found := false
+		for _, t := range traits {
+			if string(t.ID()) == id {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("%w: kit influencing trait %q not found", ErrInvalidCatalog, id)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,118 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kitmatch
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/pkg/trait"
+)
+
+func TestIntegrationMatches_BrokenCatalog(t *testing.T) {
+	integration := &v1.Integration{
+		Spec: v1.IntegrationSpec{
+			Traits: v1.Traits{
+				Builder: &traitv1.BuilderTrait{
+					Properties: []string{"key=value1"},
+				},
+			},
+		},
+	}
+	kit := &v1.IntegrationKit{
+		Spec: v1.IntegrationKitSpec{
+			Traits: v1.IntegrationKitTraits{
+				Builder: &traitv1.BuilderTrait{
+					Properties: []string{"key=value2"},
+				},
+			},
+		},
+		Status: v1.IntegrationKitStatus{
+			Phase: v1.IntegrationKitPhaseReady,
+		},
+	}
+
+	all := trait.NewCatalog(nil).AllTraits()
+	partial := make([]trait.Trait, 0, len(all))
+	for _, t := range all {
+		if t.ID() != "builder" {
+			partial = append(partial, t)
+		}
+	}
+
+	testCases := []struct {
+		name   string
+		traits []trait.Trait
+		err    string
+	}{
+		{
+			name: "empty catalog",
+			err:  "invalid trait catalog: no kit influencing trait found",
+		},
+		{
+			name:   "partial catalog",
+			traits: partial,
+			err:    `invalid trait catalog: kit influencing trait "builder" not found`,
+		},
+	}
+
+	defer func(traits func() []trait.Trait) {
+		catalogTraits = traits
+	}(catalogTraits)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			traits := tc.traits
+			catalogTraits = func() []trait.Trait {
+				return traits
+			}
+
+			// The kit is rejected rather than matched whatever its traits
+			match, err := IntegrationMatches(integration, kit, DefaultOptions())
+			assert.False(t, match)
+			assert.True(t, errors.Is(err, ErrInvalidCatalog))
+			assert.Equal(t, tc.err, err.Error())
+
+			options := DefaultOptions()
+			options.CacheInfluencingTraits()
+			_, err = IntegrationMatches(integration, kit, options)
+			assert.True(t, errors.Is(err, ErrInvalidCatalog))
+
+			match, err = HasMatchingTraits(integration.Spec.Traits, kit.Spec.Traits, v1.IntegrationKitTraitMatchModeExact)
+			assert.False(t, match)
+			assert.True(t, errors.Is(err, ErrInvalidCatalog))
+
+			_, err = MatchKey(integration)
+			assert.True(t, errors.Is(err, ErrInvalidCatalog))
+		})
+	}
+
+	// The dependencies only mode does not compare the traits
+	catalogTraits = func() []trait.Trait {
+		return nil
+	}
+	options := DefaultOptions()
+	options.Mode = v1.IntegrationKitMatchModeDependenciesOnly
+	match, err := IntegrationMatches(integration, kit, options)
+	assert.Nil(t, err)
+	assert.True(t, match)
+}
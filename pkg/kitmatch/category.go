@@ -0,0 +1,86 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kitmatch
+
+import "strings"
+
+// Category classifies the reasons why a kit does not match an integration.
+type Category string
+
+const (
+	// CategoryDependencies classifies the kits that do not provide the integration dependencies
+	CategoryDependencies Category = "Dependencies"
+	// CategoryTraits classifies the kits whose build configuration differs from the integration one
+	CategoryTraits Category = "Traits"
+	// CategoryVersion classifies the kits built for other operator or runtime versions
+	CategoryVersion Category = "Version"
+	// CategoryOther classifies the kits that do not match for any other reason, e.g. their phase
+	CategoryOther Category = "Other"
+)
+
+// categoryKeywords maps the keywords of the mismatch reasons to their category, in evaluation order,
+// so that, e.g., the camel trait runtime version is classified as a version mismatch.
+var categoryKeywords = []struct {
+	keyword  string
+	category Category
+}{
+	{"version", CategoryVersion},
+	{"runtime provider", CategoryVersion},
+	{"dependenc", CategoryDependencies},
+	{"capabilit", CategoryDependencies},
+	{"kamelet", CategoryDependencies},
+	{"traits", CategoryTraits},
+	{"build strateg", CategoryTraits},
+	{"packaging", CategoryTraits},
+	{"profile", CategoryTraits},
+}
+
+// Classify returns the category of the reason why a kit does not match an integration.
+func Classify(reason string) Category {
+	reason = strings.ToLower(reason)
+	for _, k := range categoryKeywords {
+		if strings.Contains(reason, k.keyword) {
+			return k.category
+		}
+	}
+
+	return CategoryOther
+}
+
+// categoryPrecedence orders the categories, when as many kits fall into them.
+var categoryPrecedence = []Category{CategoryDependencies, CategoryTraits, CategoryVersion, CategoryOther}
+
+// ClassifyAll returns the category of most of the given mismatch reasons, the categories being compared by
+// precedence when as many reasons fall into them, or false if there is no reason.
+func ClassifyAll(reasons []string) (Category, bool) {
+	if len(reasons) == 0 {
+		return "", false
+	}
+	counts := make(map[Category]int)
+	for _, reason := range reasons {
+		counts[Classify(reason)]++
+	}
+	result := categoryPrecedence[0]
+	for _, category := range categoryPrecedence[1:] {
+		if counts[category] > counts[result] {
+			result = category
+		}
+	}
+
+	return result, true
+}
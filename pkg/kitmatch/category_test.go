@@ -0,0 +1,70 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kitmatch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClassify(t *testing.T) {
+	testCases := map[string]Category{
+		"Integration and integration-kit dependencies do not match":                      CategoryDependencies,
+		"Integration-kit has too many extra dependencies":                                CategoryDependencies,
+		"Integration-kit is not built for the profile of the integration dependencies":   CategoryDependencies,
+		"Integration-kit is built for a profile the integration profile cannot reuse":    CategoryTraits,
+		"Integration-kit does not provide the capabilities of the integration":           CategoryDependencies,
+		"Integration and integration-kit bundled Kamelets do not match":                  CategoryDependencies,
+		"Integration and integration-kit traits do not match":                            CategoryTraits,
+		"Integration and integration-kit build strategies do not match":                  CategoryTraits,
+		"Integration and integration-kit packaging types do not match":                   CategoryTraits,
+		"Integration and integration-kit versions do not match":                          CategoryVersion,
+		"Integration and integration-kit runtime providers do not match":                 CategoryVersion,
+		"Integration-kit runtime version does not match the camel trait runtime version": CategoryVersion,
+		"Integration-kit is not labeled with a compatible operator version":              CategoryVersion,
+		"Integration kit has a phase of Error":                                           CategoryOther,
+		"Integration kit is quarantined":                                                 CategoryOther,
+	}
+
+	for reason, category := range testCases {
+		assert.Equal(t, category, Classify(reason), reason)
+	}
+}
+
+func TestClassifyAll(t *testing.T) {
+	_, ok := ClassifyAll(nil)
+	assert.False(t, ok)
+
+	category, ok := ClassifyAll([]string{
+		"Integration and integration-kit versions do not match",
+		"Integration and integration-kit traits do not match",
+		"Integration and integration-kit runtime versions do not match",
+	})
+	assert.True(t, ok)
+	assert.Equal(t, CategoryVersion, category)
+
+	// The categories are compared by precedence when as many reasons fall into them
+	category, ok = ClassifyAll([]string{
+		"Integration kit has a phase of Error",
+		"Integration and integration-kit traits do not match",
+		"Integration and integration-kit dependencies do not match",
+	})
+	assert.True(t, ok)
+	assert.Equal(t, CategoryDependencies, category)
+}
@@ -0,0 +1,64 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kitmatch
+
+import (
+	"encoding/json"
+	"strings"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+)
+
+// dependencyChecksums returns the content checksums of the file dependencies, keyed by dependency,
+// as recorded in the annotations of the integration or of the kit. Invalid checksums are ignored.
+func dependencyChecksums(annotations map[string]string) map[string]string {
+	value, ok := annotations[v1.DependencyChecksumsAnnotation]
+	if !ok {
+		return nil
+	}
+	checksums := make(map[string]string)
+	if err := json.Unmarshal([]byte(value), &checksums); err != nil {
+		return nil
+	}
+
+	return checksums
+}
+
+// checksumKey returns a key function that compares the dependencies with a content checksum by that checksum,
+// and the other dependencies by the given key. The dependencies uploaded from local files are versioned
+// identically whatever their content, so that their coordinates cannot tell two uploads apart.
+func checksumKey(key func(string) string, checksums map[string]string) func(string) string {
+	if len(checksums) == 0 {
+		return key
+	}
+
+	return func(dependency string) string {
+		checksum, ok := checksums[dependency]
+		if !ok || checksum == "" {
+			return key(dependency)
+		}
+		// The files that are not on the classpath must also be mounted at the same path
+		if strings.HasPrefix(dependency, "registry-mvn:") {
+			if i := strings.LastIndex(dependency, "@"); i > 0 {
+				return "checksum:" + checksum + dependency[i:]
+			}
+		}
+
+		return "checksum:" + checksum
+	}
+}
@@ -0,0 +1,110 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kitmatch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+)
+
+func TestIntegrationMatches_FileDependencyChecksums(t *testing.T) {
+	jar := "mvn:org.apache.camel.k.external:my-integration-lib.jar:jar:1.0"
+	file := "registry-mvn:org.apache.camel.k.external:my-integration-app.properties:properties:1.0@/etc/app.properties"
+
+	integration := &v1.Integration{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{
+				v1.DependencyChecksumsAnnotation: `{"` + jar + `": "sha1:aaa", "` + file + `": "sha1:bbb"}`,
+			},
+		},
+		Status: v1.IntegrationStatus{
+			Dependencies: []string{"camel:core", jar, file},
+		},
+	}
+	kit := func(checksums string, dependencies ...string) *v1.IntegrationKit {
+		kit := &v1.IntegrationKit{
+			Spec: v1.IntegrationKitSpec{
+				Dependencies: dependencies,
+			},
+			Status: v1.IntegrationKitStatus{
+				Phase: v1.IntegrationKitPhaseReady,
+			},
+		}
+		if checksums != "" {
+			kit.Annotations = map[string]string{
+				v1.DependencyChecksumsAnnotation: checksums,
+			}
+		}
+		return kit
+	}
+
+	testCases := []struct {
+		name  string
+		kit   *v1.IntegrationKit
+		match bool
+	}{
+		{
+			name:  "same content",
+			kit:   kit(`{"`+jar+`": "sha1:aaa", "`+file+`": "sha1:bbb"}`, "camel:core", jar, file),
+			match: true,
+		},
+		{
+			name:  "same content with other coordinates",
+			kit:   kit(`{"mvn:org.apache.camel.k.external:other-lib.jar:jar:1.0": "sha1:aaa", "`+file+`": "sha1:bbb"}`, "camel:core", "mvn:org.apache.camel.k.external:other-lib.jar:jar:1.0", file),
+			match: true,
+		},
+		{
+			name:  "different JAR content",
+			kit:   kit(`{"`+jar+`": "sha1:ccc", "`+file+`": "sha1:bbb"}`, "camel:core", jar, file),
+			match: false,
+		},
+		{
+			name:  "different file content",
+			kit:   kit(`{"`+jar+`": "sha1:aaa", "`+file+`": "sha1:ccc"}`, "camel:core", jar, file),
+			match: false,
+		},
+		{
+			name:  "same file content mounted elsewhere",
+			kit:   kit(`{"`+jar+`": "sha1:aaa", "registry-mvn:org.apache.camel.k.external:my-integration-app.properties:properties:1.0@/tmp/app.properties": "sha1:bbb"}`, "camel:core", jar, "registry-mvn:org.apache.camel.k.external:my-integration-app.properties:properties:1.0@/tmp/app.properties"),
+			match: false,
+		},
+		{
+			name:  "unknown kit content",
+			kit:   kit("", "camel:core", jar, file),
+			match: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			match, err := IntegrationMatches(integration, tc.kit, DefaultOptions())
+			assert.Nil(t, err)
+			assert.Equal(t, tc.match, match)
+		})
+	}
+}
+
+func TestDependencyChecksums_Invalid(t *testing.T) {
+	assert.Nil(t, dependencyChecksums(nil))
+	assert.Nil(t, dependencyChecksums(map[string]string{v1.DependencyChecksumsAnnotation: "invalid"}))
+}
@@ -0,0 +1,100 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kitmatch
+
+import (
+	"strings"
+	"unicode"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/util/maven"
+)
+
+// uncoveredDependencies returns the dependencies that are not provided by any of the kit artifacts,
+// i.e., neither directly nor transitively.
+func uncoveredDependencies(kit *v1.IntegrationKit, dependencies []string) []string {
+	uncovered := make([]string, 0)
+	for _, dependency := range dependencies {
+		if !providedByArtifacts(kit, dependency) {
+			uncovered = append(uncovered, dependency)
+		}
+	}
+
+	return uncovered
+}
+
+// providedByArtifacts returns whether one of the kit artifacts is the JAR of the dependency.
+// The artifacts are identified by their file name, e.g. `org.apache.camel.quarkus.camel-quarkus-core-2.10.0.jar`.
+func providedByArtifacts(kit *v1.IntegrationKit, dependency string) bool {
+	groupID, artifactID, ok := dependencyCoordinates(dependency, kit.Status.RuntimeProvider)
+	if !ok {
+		return false
+	}
+
+	prefix := groupID + "." + artifactID + "-"
+	for _, artifact := range kit.Status.Artifacts {
+		if !strings.HasPrefix(artifact.ID, prefix) {
+			continue
+		}
+		// The artifact ID must be followed by the version, so that the JAR of another artifact
+		// sharing the same prefix, e.g. `camel-quarkus-core-deployment`, is not mistaken for it
+		if version := strings.TrimPrefix(artifact.ID, prefix); version != "" && unicode.IsDigit(rune(version[0])) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// dependencyCoordinates returns the Maven group and artifact identifiers the dependency is resolved to.
+func dependencyCoordinates(dependency string, provider v1.RuntimeProvider) (string, string, bool) {
+	switch {
+	case strings.HasPrefix(dependency, "camel:"):
+		artifactID := strings.TrimPrefix(dependency, "camel:")
+		if provider == v1.RuntimeProviderQuarkus {
+			if !strings.HasPrefix(artifactID, "camel-") {
+				artifactID = "camel-quarkus-" + artifactID
+			}
+			return "org.apache.camel.quarkus", artifactID, true
+		}
+		if !strings.HasPrefix(artifactID, "camel-") {
+			artifactID = "camel-" + artifactID
+		}
+		return "org.apache.camel", artifactID, true
+	case strings.HasPrefix(dependency, "camel-k:"):
+		artifactID := strings.TrimPrefix(dependency, "camel-k:")
+		if !strings.HasPrefix(artifactID, "camel-k-") {
+			artifactID = "camel-k-" + artifactID
+		}
+		return "org.apache.camel.k", artifactID, true
+	case strings.HasPrefix(dependency, "camel-quarkus:"):
+		artifactID := strings.TrimPrefix(dependency, "camel-quarkus:")
+		if !strings.HasPrefix(artifactID, "camel-quarkus-") {
+			artifactID = "camel-quarkus-" + artifactID
+		}
+		return "org.apache.camel.quarkus", artifactID, true
+	case strings.HasPrefix(dependency, "mvn:"):
+		gav, err := maven.ParseGAV(strings.TrimPrefix(dependency, "mvn:"))
+		if err != nil {
+			return "", "", false
+		}
+		return gav.GroupID, gav.ArtifactID, true
+	}
+
+	return "", "", false
+}
@@ -0,0 +1,94 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kitmatch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+)
+
+func TestIntegrationMatches_PrunedKit(t *testing.T) {
+	integration := &v1.Integration{
+		Status: v1.IntegrationStatus{
+			RuntimeProvider: v1.RuntimeProviderQuarkus,
+			Dependencies: []string{
+				"camel:core",
+				"camel:log",
+				"camel-k:runtime",
+				"mvn:org.my:lib:1.0",
+			},
+		},
+	}
+	// The kit dependencies were pruned to the minimal runtime closure, the other
+	// integration dependencies being provided transitively
+	kit := &v1.IntegrationKit{
+		Spec: v1.IntegrationKitSpec{
+			Dependencies: []string{
+				"camel:log",
+			},
+		},
+		Status: v1.IntegrationKitStatus{
+			Phase:           v1.IntegrationKitPhaseReady,
+			RuntimeProvider: v1.RuntimeProviderQuarkus,
+			Artifacts: []v1.Artifact{
+				{ID: "org.apache.camel.quarkus.camel-quarkus-core-2.10.0.jar"},
+				{ID: "org.apache.camel.quarkus.camel-quarkus-log-2.10.0.jar"},
+				{ID: "org.apache.camel.k.camel-k-runtime-1.14.0.jar"},
+				{ID: "org.my.lib-1.0.jar"},
+			},
+		},
+	}
+
+	options := DefaultOptions()
+	match, err := IntegrationMatches(integration, kit, options)
+	assert.Nil(t, err)
+	assert.False(t, match)
+
+	options.DependencyMatchMode = v1.IntegrationKitDependencyMatchModeClosure
+	match, err = IntegrationMatches(integration, kit, options)
+	assert.Nil(t, err)
+	assert.True(t, match)
+
+	// A dependency that is not provided by any of the artifacts
+	integration.Status.Dependencies = append(integration.Status.Dependencies, "camel:timer")
+	match, err = IntegrationMatches(integration, kit, options)
+	assert.Nil(t, err)
+	assert.False(t, match)
+}
+
+func TestProvidedByArtifacts(t *testing.T) {
+	kit := &v1.IntegrationKit{
+		Status: v1.IntegrationKitStatus{
+			RuntimeProvider: v1.RuntimeProviderQuarkus,
+			Artifacts: []v1.Artifact{
+				{ID: "org.apache.camel.quarkus.camel-quarkus-core-deployment-2.10.0.jar"},
+				{ID: "org.apache.camel.quarkus.camel-quarkus-timer-2.10.0.jar"},
+				{ID: "org.my.lib-1.0-tests.jar"},
+			},
+		},
+	}
+
+	assert.True(t, providedByArtifacts(kit, "camel:timer"))
+	assert.True(t, providedByArtifacts(kit, "camel-quarkus:timer"))
+	assert.True(t, providedByArtifacts(kit, "mvn:org.my:lib:1.0"))
+	assert.False(t, providedByArtifacts(kit, "camel:core"))
+	assert.False(t, providedByArtifacts(kit, "file:lib.jar"))
+}
@@ -0,0 +1,144 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kitmatch
+
+import (
+	"encoding/json"
+	"sort"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/util"
+)
+
+// Contract is the compatibility contract the builds stamp the kits with, summarizing what a kit guarantees,
+// so that the integrations are checked against it before the kit is compared in depth.
+type Contract struct {
+	// Generation is the generation of the kit the contract has been computed for
+	Generation int64 `json:"generation"`
+	// MatchKey is the match key of the kit, equal to the one of the integrations it matches exactly
+	MatchKey string `json:"matchKey"`
+	// Profile is the profile the kit is built for, if recorded
+	Profile v1.TraitProfile `json:"profile,omitempty"`
+	// Dependencies are the dependencies the kit provides, sorted
+	Dependencies []string `json:"dependencies"`
+	// Capabilities are the capabilities the kit provides, or nil if not recorded
+	Capabilities []string `json:"capabilities"`
+}
+
+// NewContract returns the compatibility contract of the kit, as of its current generation.
+func NewContract(kit *v1.IntegrationKit) (Contract, error) {
+	key, err := KitMatchKey(kit)
+	if err != nil {
+		return Contract{}, err
+	}
+	dependencies := append([]string{}, kit.Spec.Dependencies...)
+	sort.Strings(dependencies)
+
+	return Contract{
+		Generation:   kit.Generation,
+		MatchKey:     key,
+		Profile:      kit.Spec.Profile,
+		Dependencies: dependencies,
+		Capabilities: kit.Spec.Capabilities,
+	}, nil
+}
+
+// StampContract annotates the kit with its compatibility contract, as the build completes.
+func StampContract(kit *v1.IntegrationKit) error {
+	contract, err := NewContract(kit)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(contract)
+	if err != nil {
+		return err
+	}
+	if kit.Annotations == nil {
+		kit.Annotations = make(map[string]string)
+	}
+	kit.Annotations[v1.IntegrationKitContractAnnotation] = string(data)
+
+	return nil
+}
+
+// kitContract returns the compatibility contract the kit is annotated with, or false if the kit has none,
+// if it is invalid, or if it is stale, i.e., computed for a previous generation of the kit.
+func kitContract(kit *v1.IntegrationKit) (Contract, bool) {
+	value, ok := kit.Annotations[v1.IntegrationKitContractAnnotation]
+	if !ok {
+		return Contract{}, false
+	}
+	var contract Contract
+	if err := json.Unmarshal([]byte(value), &contract); err != nil || contract.MatchKey == "" {
+		return Contract{}, false
+	}
+
+	return contract, contract.Generation == kit.Generation
+}
+
+// evaluateContract checks the requirements of the v1.Integration against the compatibility contract of the
+// v1.IntegrationKit, and returns whether the contract decides the match. The kit matches when the integration
+// requires exactly what the contract guarantees, and does not when the contract misses the integration
+// dependencies or capabilities, the other cases being left to the deep comparison, as well as the cases
+// where the match depends on more than the contract, e.g. on the content of the dependencies.
+func evaluateContract(integration *v1.Integration, kit *v1.IntegrationKit, contract Contract, options Options) (Decision, bool) {
+	if image := ContainerImage(integration); image != "" && options.IgnoreImageDependencies {
+		return Decision{}, false
+	}
+	if len(dependencyChecksums(integration.Annotations)) > 0 || len(dependencyChecksums(kit.Annotations)) > 0 {
+		return Decision{}, false
+	}
+	if contract.Capabilities != nil {
+		missing := make([]string, 0)
+		for _, capability := range RequiredCapabilities(integration) {
+			if !util.StringSliceExists(contract.Capabilities, capability) {
+				missing = append(missing, capability)
+			}
+		}
+		if len(missing) > 0 {
+			return Mismatch("Integration-kit compatibility contract does not provide the capabilities of the integration", missing...), true
+		}
+	}
+	// The dependencies pruned from a kit may still be provided transitively by its artifacts
+	if options.DependencyMatchMode != v1.IntegrationKitDependencyMatchModeClosure {
+		missing := subtractDependencies(integration.Status.Dependencies, options.dependencyKey, contract.Dependencies, options.dependencyKey)
+		if missing = options.coreDependencies(missing); len(missing) > 0 {
+			return Mismatch("Integration-kit compatibility contract does not provide the integration dependencies", missing...), true
+		}
+	}
+
+	// The kit is only accepted when nothing but the contract is compared
+	if len(options.InfluencingTraits) > 0 || options.MatchSBOM || contract.Profile != integrationProfile(integration) || !bundledKameletsMatch(integration, kit) {
+		return Decision{}, false
+	}
+	if len(snapshotDependencies(integration.Status.Dependencies)) > 0 && snapshotRequiresRebuild(integration, kit, options) {
+		return Decision{}, false
+	}
+	if options.MatchDependencyTreeDigest && integration.Annotations[v1.DependencyTreeDigestAnnotation] != "" {
+		return Decision{}, false
+	}
+	if options.Mode != v1.IntegrationKitMatchModeDependenciesOnly && !buildStrategyMatches(kit, options.BuildStrategy) {
+		return Decision{}, false
+	}
+	// The integrations whose key cannot be computed are compared in depth, that reports why
+	if key, err := MatchKey(integration); err != nil || key != contract.MatchKey {
+		return Decision{}, false
+	}
+
+	return Decision{Matched: true}, true
+}
@@ -0,0 +1,194 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kitmatch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/pkg/apis/camel/v1/trait"
+)
+
+func TestStampContract(t *testing.T) {
+	kit := &v1.IntegrationKit{
+		Spec: v1.IntegrationKitSpec{
+			Dependencies: []string{"camel:log", "camel:core"},
+			Capabilities: []string{v1.CapabilityRest},
+		},
+	}
+	kit.Generation = 2
+
+	_, ok := kitContract(kit)
+	assert.False(t, ok)
+
+	require.Nil(t, StampContract(kit))
+	contract, ok := kitContract(kit)
+	assert.True(t, ok)
+	assert.Equal(t, int64(2), contract.Generation)
+	assert.Equal(t, []string{"camel:core", "camel:log"}, contract.Dependencies)
+	assert.Equal(t, []string{v1.CapabilityRest}, contract.Capabilities)
+	key, err := KitMatchKey(kit)
+	require.Nil(t, err)
+	assert.Equal(t, key, contract.MatchKey)
+
+	// The contract is stale once the kit changes
+	kit.Generation = 3
+	_, ok = kitContract(kit)
+	assert.False(t, ok)
+
+	kit.Annotations[v1.IntegrationKitContractAnnotation] = "{"
+	_, ok = kitContract(kit)
+	assert.False(t, ok)
+}
+
+func TestIntegrationMatches_Contract(t *testing.T) {
+	newIntegration := func() *v1.Integration {
+		return &v1.Integration{
+			Spec: v1.IntegrationSpec{
+				Traits: v1.Traits{
+					Builder: &traitv1.BuilderTrait{
+						Properties: []string{"build-key1=build-value1"},
+					},
+				},
+			},
+			Status: v1.IntegrationStatus{
+				Version:      "1.10.0",
+				Dependencies: []string{"camel:core", "camel:log"},
+			},
+		}
+	}
+	newKit := func(stamp bool) *v1.IntegrationKit {
+		kit := &v1.IntegrationKit{
+			Spec: v1.IntegrationKitSpec{
+				Dependencies: []string{"camel:log", "camel:core"},
+				Traits: v1.IntegrationKitTraits{
+					Builder: &traitv1.BuilderTrait{
+						Properties: []string{"build-key1=build-value1"},
+					},
+				},
+			},
+			Status: v1.IntegrationKitStatus{
+				Phase:   v1.IntegrationKitPhaseReady,
+				Version: "1.10.0",
+			},
+		}
+		if stamp {
+			require.Nil(t, StampContract(kit))
+		}
+		return kit
+	}
+
+	tests := []struct {
+		name        string
+		integration func(*v1.Integration)
+		kit         func(*v1.IntegrationKit)
+		stamp       bool
+		match       bool
+		reason      string
+		// whether the contract decides the match, without the deep comparison
+		decided bool
+	}{
+		{
+			name:    "accepted by the contract",
+			stamp:   true,
+			match:   true,
+			decided: true,
+		},
+		{
+			name: "rejected by the contract",
+			integration: func(integration *v1.Integration) {
+				integration.Status.Dependencies = append(integration.Status.Dependencies, "camel:timer")
+			},
+			stamp:   true,
+			reason:  "Integration-kit compatibility contract does not provide the integration dependencies",
+			decided: true,
+		},
+		{
+			name: "rejected by the contract capabilities",
+			integration: func(integration *v1.Integration) {
+				integration.Status.Capabilities = []string{v1.CapabilityRest}
+			},
+			kit: func(kit *v1.IntegrationKit) {
+				kit.Spec.Capabilities = []string{}
+				require.Nil(t, StampContract(kit))
+			},
+			reason:  "Integration-kit compatibility contract does not provide the capabilities of the integration",
+			decided: true,
+		},
+		{
+			name: "compared in depth when the build inputs differ",
+			integration: func(integration *v1.Integration) {
+				integration.Spec.Traits.Builder.Properties = append(integration.Spec.Traits.Builder.Properties, "build-key2=build-value2")
+			},
+			stamp:  true,
+			reason: "Integration and integration-kit traits do not match",
+		},
+		{
+			name:  "compared in depth without contract",
+			match: true,
+		},
+		{
+			name: "compared in depth with a stale contract",
+			kit: func(kit *v1.IntegrationKit) {
+				require.Nil(t, StampContract(kit))
+				kit.Generation++
+				kit.Spec.Dependencies = append(kit.Spec.Dependencies, "camel:timer")
+			},
+			integration: func(integration *v1.Integration) {
+				integration.Status.Dependencies = append(integration.Status.Dependencies, "camel:timer")
+			},
+			match: true,
+		},
+		{
+			// The content of the dependencies is not part of the contract
+			name: "compared in depth with dependency checksums",
+			integration: func(integration *v1.Integration) {
+				integration.Annotations = map[string]string{
+					v1.DependencyChecksumsAnnotation: `{"camel:log":"sha256:aaa"}`,
+				}
+			},
+			stamp:  true,
+			reason: "Integration and integration-kit dependencies do not match",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			integration := newIntegration()
+			if test.integration != nil {
+				test.integration(integration)
+			}
+			kit := newKit(test.stamp)
+			if test.kit != nil {
+				test.kit(kit)
+			}
+			options := DefaultOptions()
+			options.Trace = &MatchTrace{}
+
+			decision, err := Match(integration, kit, options)
+			require.Nil(t, err)
+			assert.Equal(t, test.match, decision.Matched)
+			assert.Equal(t, test.reason, decision.Reason)
+			_, compared := options.Trace.Steps[MatchStepTraits]
+			assert.Equal(t, test.decided, !compared)
+		})
+	}
+}
@@ -0,0 +1,107 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kitmatch
+
+import (
+	"reflect"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/trait"
+)
+
+// Diff is the difference between the configurations of a kit influencing trait of two kits.
+type Diff struct {
+	// Whether the trait is configured by the first kit
+	InFirst bool
+	// Whether the trait is configured by the second kit
+	InSecond bool
+	// The fields that only the first kit sets, with their values
+	OnlyInFirst map[string]interface{}
+	// The fields that only the second kit sets, with their values
+	OnlyInSecond map[string]interface{}
+	// The fields that both kits set with different values
+	Changed map[string]FieldDiff
+}
+
+// FieldDiff holds the different values a trait field is set to by two kits.
+type FieldDiff struct {
+	First  interface{}
+	Second interface{}
+}
+
+// TraitDiff returns the differences between the configurations of the kit influencing traits of the kits, by trait ID.
+// The traits that both kits configure identically are omitted, as are the fields that do not influence the kits.
+func TraitDiff(kit1 *v1.IntegrationKit, kit2 *v1.IntegrationKit) (map[string]Diff, error) {
+	influencingTraits := KitInfluencingTraits()
+	if err := validateInfluencingTraits(influencingTraits); err != nil {
+		return nil, err
+	}
+	traitMap1, err := trait.ToTraitMap(kit1.Spec.Traits)
+	if err != nil {
+		return nil, err
+	}
+	traitMap2, err := trait.ToTraitMap(kit2.Spec.Traits)
+	if err != nil {
+		return nil, err
+	}
+
+	diffs := make(map[string]Diff)
+	for _, t := range influencingTraits {
+		id := string(t.ID())
+		t1, ok1 := findTrait(traitMap1, id)
+		t2, ok2 := findTrait(traitMap2, id)
+		if !ok1 && !ok2 {
+			continue
+		}
+		t1 = withoutNonInfluencingFields(id, t1)
+		t2 = withoutNonInfluencingFields(id, t2)
+		if ok1 == ok2 && matchesTrait(t1, t2) {
+			continue
+		}
+		diffs[id] = diffTrait(t1, ok1, t2, ok2)
+	}
+
+	return diffs, nil
+}
+
+// diffTrait returns the difference between the two configurations of a trait.
+func diffTrait(t1 map[string]interface{}, ok1 bool, t2 map[string]interface{}, ok2 bool) Diff {
+	diff := Diff{
+		InFirst:      ok1,
+		InSecond:     ok2,
+		OnlyInFirst:  make(map[string]interface{}),
+		OnlyInSecond: make(map[string]interface{}),
+		Changed:      make(map[string]FieldDiff),
+	}
+	for field, value := range t1 {
+		other, ok := t2[field]
+		switch {
+		case !ok:
+			diff.OnlyInFirst[field] = value
+		case !reflect.DeepEqual(value, other):
+			diff.Changed[field] = FieldDiff{First: value, Second: other}
+		}
+	}
+	for field, value := range t2 {
+		if _, ok := t1[field]; !ok {
+			diff.OnlyInSecond[field] = value
+		}
+	}
+
+	return diff
+}
@@ -0,0 +1,107 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kitmatch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"k8s.io/utils/pointer"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/pkg/apis/camel/v1/trait"
+)
+
+func TestTraitDiff(t *testing.T) {
+	kit := func(traits v1.IntegrationKitTraits) *v1.IntegrationKit {
+		return &v1.IntegrationKit{
+			Spec: v1.IntegrationKitSpec{
+				Traits: traits,
+			},
+		}
+	}
+
+	// Identical, the non influencing fields being ignored
+	diff, err := TraitDiff(
+		kit(v1.IntegrationKitTraits{
+			Builder: &traitv1.BuilderTrait{Properties: []string{"key=value"}, Verbose: pointer.Bool(true)},
+			Quarkus: &traitv1.QuarkusTrait{PackageTypes: []traitv1.QuarkusPackageType{traitv1.NativePackageType}},
+		}),
+		kit(v1.IntegrationKitTraits{
+			Builder: &traitv1.BuilderTrait{Properties: []string{"key=value"}},
+			Quarkus: &traitv1.QuarkusTrait{PackageTypes: []traitv1.QuarkusPackageType{traitv1.NativePackageType}},
+		}))
+	assert.Nil(t, err)
+	assert.Empty(t, diff)
+
+	// Partially different
+	diff, err = TraitDiff(
+		kit(v1.IntegrationKitTraits{
+			Builder: &traitv1.BuilderTrait{Properties: []string{"key=value"}, ManagedVersions: []string{"mvn:org.yaml:snakeyaml:1.33"}},
+			Quarkus: &traitv1.QuarkusTrait{PackageTypes: []traitv1.QuarkusPackageType{traitv1.NativePackageType}},
+		}),
+		kit(v1.IntegrationKitTraits{
+			Builder: &traitv1.BuilderTrait{Properties: []string{"key=other"}, Boms: []string{"mvn:org.acme:bom:1.0"}},
+			Quarkus: &traitv1.QuarkusTrait{PackageTypes: []traitv1.QuarkusPackageType{traitv1.NativePackageType}},
+		}))
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]Diff{
+		"builder": {
+			InFirst:  true,
+			InSecond: true,
+			OnlyInFirst: map[string]interface{}{
+				"managedVersions": []interface{}{"mvn:org.yaml:snakeyaml:1.33"},
+			},
+			OnlyInSecond: map[string]interface{}{
+				"boms": []interface{}{"mvn:org.acme:bom:1.0"},
+			},
+			Changed: map[string]FieldDiff{
+				"properties": {First: []interface{}{"key=value"}, Second: []interface{}{"key=other"}},
+			},
+		},
+	}, diff)
+
+	// Fully different
+	diff, err = TraitDiff(
+		kit(v1.IntegrationKitTraits{
+			Builder: &traitv1.BuilderTrait{Properties: []string{"key=value"}},
+		}),
+		kit(v1.IntegrationKitTraits{
+			Quarkus: &traitv1.QuarkusTrait{PackageTypes: []traitv1.QuarkusPackageType{traitv1.NativePackageType}},
+		}))
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]Diff{
+		"builder": {
+			InFirst: true,
+			OnlyInFirst: map[string]interface{}{
+				"properties": []interface{}{"key=value"},
+			},
+			OnlyInSecond: map[string]interface{}{},
+			Changed:      map[string]FieldDiff{},
+		},
+		"quarkus": {
+			InSecond:    true,
+			OnlyInFirst: map[string]interface{}{},
+			OnlyInSecond: map[string]interface{}{
+				"packageTypes": []interface{}{"native"},
+			},
+			Changed: map[string]FieldDiff{},
+		},
+	}, diff)
+}
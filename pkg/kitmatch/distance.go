@@ -0,0 +1,95 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kitmatch
+
+import (
+	"reflect"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/trait"
+)
+
+// RuntimeConfigDistance returns the number of runtime-only settings that differ between the integration and
+// the kit, i.e., the configuration entries that only one of them declares, and the trait fields that are ignored
+// when matching whose values differ. The kits that match with the smallest distance require the fewest overrides.
+func RuntimeConfigDistance(integration *v1.Integration, kit *v1.IntegrationKit) (int, error) {
+	distance := len(subtractConfiguration(integration.Spec.Configuration, kit.Spec.Configuration)) +
+		len(subtractConfiguration(kit.Spec.Configuration, integration.Spec.Configuration))
+
+	traitMap, err := trait.ToTraitMap(integration.Spec.Traits)
+	if err != nil {
+		return 0, err
+	}
+	kitTraitMap, err := trait.ToTraitMap(kit.Spec.Traits)
+	if err != nil {
+		return 0, err
+	}
+	for id, fields := range nonInfluencingTraitFields {
+		it, _ := findTrait(traitMap, id)
+		kt, _ := findTrait(kitTraitMap, id)
+		for _, field := range fields {
+			if !reflect.DeepEqual(it[field], kt[field]) {
+				distance++
+			}
+		}
+	}
+
+	return distance, nil
+}
+
+// subtractConfiguration returns the configuration entries that are not found in the others.
+func subtractConfiguration(configuration []v1.ConfigurationSpec, others []v1.ConfigurationSpec) []v1.ConfigurationSpec {
+	result := make([]v1.ConfigurationSpec, 0)
+	for _, c := range configuration {
+		found := false
+		for _, o := range others {
+			if reflect.DeepEqual(c, o) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			result = append(result, c)
+		}
+	}
+
+	return result
+}
+
+// PeripheralDependencyDistance returns the number of peripheral dependencies that differ between the integration
+// and the kit, i.e., the peripheral dependencies that only one of them declares, or 0 when no core dependency is
+// configured. The kits that match with the smallest distance are the closest to the integration dependencies.
+func PeripheralDependencyDistance(integration *v1.Integration, kit *v1.IntegrationKit, options Options) int {
+	if len(options.CoreDependencies) == 0 {
+		return 0
+	}
+	integrationKey := checksumKey(options.dependencyKey, dependencyChecksums(integration.Annotations))
+	kitKey := checksumKey(options.dependencyKey, dependencyChecksums(kit.Annotations))
+
+	distance := 0
+	differences := append(
+		subtractDependencies(integration.Status.Dependencies, integrationKey, kit.Spec.Dependencies, kitKey),
+		subtractDependencies(kit.Spec.Dependencies, kitKey, integration.Status.Dependencies, integrationKey)...)
+	for _, dependency := range differences {
+		if !options.isCoreDependency(dependency) {
+			distance++
+		}
+	}
+
+	return distance
+}
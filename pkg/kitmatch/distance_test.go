@@ -0,0 +1,129 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kitmatch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"k8s.io/utils/pointer"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/pkg/apis/camel/v1/trait"
+)
+
+func TestRuntimeConfigDistance(t *testing.T) {
+	integration := &v1.Integration{
+		Spec: v1.IntegrationSpec{
+			Configuration: []v1.ConfigurationSpec{
+				{Type: "property", Value: "log.level=INFO"},
+				{Type: "env", Value: "TZ=UTC"},
+			},
+			Traits: v1.Traits{
+				Builder: &traitv1.BuilderTrait{
+					Verbose: pointer.Bool(true),
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name          string
+		configuration []v1.ConfigurationSpec
+		verbose       *bool
+		distance      int
+	}{
+		{
+			name: "same runtime configuration",
+			configuration: []v1.ConfigurationSpec{
+				{Type: "env", Value: "TZ=UTC"},
+				{Type: "property", Value: "log.level=INFO"},
+			},
+			verbose:  pointer.Bool(true),
+			distance: 0,
+		},
+		{
+			name: "missing configuration entry",
+			configuration: []v1.ConfigurationSpec{
+				{Type: "property", Value: "log.level=INFO"},
+			},
+			verbose:  pointer.Bool(true),
+			distance: 1,
+		},
+		{
+			name: "other configuration entry",
+			configuration: []v1.ConfigurationSpec{
+				{Type: "property", Value: "log.level=DEBUG"},
+				{Type: "env", Value: "TZ=UTC"},
+			},
+			verbose:  pointer.Bool(true),
+			distance: 2,
+		},
+		{
+			name: "other build verbosity",
+			configuration: []v1.ConfigurationSpec{
+				{Type: "property", Value: "log.level=INFO"},
+				{Type: "env", Value: "TZ=UTC"},
+			},
+			distance: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			kit := &v1.IntegrationKit{
+				Spec: v1.IntegrationKitSpec{
+					Configuration: tc.configuration,
+					Traits: v1.IntegrationKitTraits{
+						Builder: &traitv1.BuilderTrait{
+							Verbose: tc.verbose,
+						},
+					},
+				},
+			}
+
+			distance, err := RuntimeConfigDistance(integration, kit)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.distance, distance)
+		})
+	}
+}
+
+func TestPeripheralDependencyDistance(t *testing.T) {
+	integration := &v1.Integration{
+		Status: v1.IntegrationStatus{
+			Dependencies: []string{"camel:core", "mvn:org.my:core:1.0", "camel:log", "camel:http"},
+		},
+	}
+	kit := &v1.IntegrationKit{
+		Spec: v1.IntegrationKitSpec{
+			Dependencies: []string{"camel:core", "mvn:org.my:core:2.0", "camel:log", "camel:timer", "camel:kafka"},
+		},
+	}
+
+	options := DefaultOptions()
+	assert.Equal(t, 0, PeripheralDependencyDistance(integration, kit, options))
+
+	// The core dependencies that differ are not counted, the Maven coordinates without version matching any version
+	options.CoreDependencies = []string{"camel:core", "mvn:org.my:core"}
+	assert.Equal(t, 3, PeripheralDependencyDistance(integration, kit, options))
+
+	kit.Spec.Dependencies = integration.Status.Dependencies
+	assert.Equal(t, 0, PeripheralDependencyDistance(integration, kit, options))
+}
@@ -0,0 +1,71 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kitmatch
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/apis/camel/v1alpha1"
+	"github.com/apache/camel-k/pkg/util"
+)
+
+// bundledKameletsMatch returns whether the kit bundles the Kamelets the integration lists in its kamelets trait,
+// and no other. The kits that do not declare the Kamelets they bundle load them at runtime, like the integrations.
+func bundledKameletsMatch(integration *v1.Integration, kit *v1.IntegrationKit) bool {
+	if _, ok := kit.Annotations[v1.IntegrationKitKameletsAnnotation]; !ok {
+		return true
+	}
+
+	return reflect.DeepEqual(requiredKamelets(integration), bundledKamelets(kit))
+}
+
+// bundledKamelets returns the sorted names of the Kamelets the kit bundles into its image, as declared with
+// the v1.IntegrationKitKameletsAnnotation annotation.
+func bundledKamelets(kit *v1.IntegrationKit) []string {
+	return kameletNames(kit.Annotations[v1.IntegrationKitKameletsAnnotation])
+}
+
+// requiredKamelets returns the sorted names of the Kamelets the integration lists in its kamelets trait.
+func requiredKamelets(integration *v1.Integration) []string {
+	if integration.Spec.Traits.Kamelets == nil {
+		return []string{}
+	}
+
+	return kameletNames(integration.Spec.Traits.Kamelets.List)
+}
+
+// kameletNames returns the sorted and de-duplicated names of the Kamelets in the comma separated list, without
+// the configurations they may reference, e.g. `my-source/my-config`.
+func kameletNames(list string) []string {
+	names := make([]string, 0)
+	for _, item := range strings.Split(list, ",") {
+		name := strings.Trim(item, " \t\"")
+		if i := strings.Index(name, "/"); i >= 0 {
+			name = name[:i]
+		}
+		if name != "" && v1alpha1.ValidKameletName(name) {
+			util.StringSliceUniqueAdd(&names, name)
+		}
+	}
+	sort.Strings(names)
+
+	return names
+}
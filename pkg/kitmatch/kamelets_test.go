@@ -0,0 +1,117 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kitmatch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/pkg/apis/camel/v1/trait"
+)
+
+func TestIntegrationMatches_BundledKamelets(t *testing.T) {
+	newIntegration := func(kamelets string) *v1.Integration {
+		integration := &v1.Integration{
+			Status: v1.IntegrationStatus{
+				Version:      "1.10.0",
+				Dependencies: []string{"camel:core"},
+			},
+		}
+		if kamelets != "" {
+			integration.Spec.Traits.Kamelets = &traitv1.KameletsTrait{
+				List: kamelets,
+			}
+		}
+		return integration
+	}
+	newKit := func(kamelets *string) *v1.IntegrationKit {
+		kit := &v1.IntegrationKit{
+			Spec: v1.IntegrationKitSpec{
+				Dependencies: []string{"camel:core"},
+			},
+			Status: v1.IntegrationKitStatus{
+				Phase:   v1.IntegrationKitPhaseReady,
+				Version: "1.10.0",
+			},
+		}
+		if kamelets != nil {
+			kit.Annotations = map[string]string{
+				v1.IntegrationKitKameletsAnnotation: *kamelets,
+			}
+		}
+		return kit
+	}
+	bundled := func(kamelets string) *string {
+		return &kamelets
+	}
+
+	tests := []struct {
+		name        string
+		integration string
+		kit         *string
+		match       bool
+	}{
+		{
+			name:        "kit without bundled Kamelets",
+			integration: "timer-source",
+			match:       true,
+		},
+		{
+			name:        "same bundled Kamelets",
+			integration: "timer-source,log-sink/my-config",
+			kit:         bundled(" log-sink, timer-source"),
+			match:       true,
+		},
+		{
+			name:        "different bundled Kamelets",
+			integration: "timer-source",
+			kit:         bundled("timer-source,log-sink"),
+		},
+		{
+			name: "bundled Kamelets not required",
+			kit:  bundled("timer-source"),
+		},
+		{
+			name:  "no bundled Kamelets declared",
+			kit:   bundled(""),
+			match: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			kit := newKit(test.kit)
+
+			decision, err := Match(newIntegration(test.integration), kit, DefaultOptions())
+			require.Nil(t, err)
+			assert.Equal(t, test.match, decision.Matched)
+			if !test.match {
+				assert.Equal(t, "Integration and integration-kit bundled Kamelets do not match", decision.Reason)
+			}
+
+			// The compatibility contract does not accept the kits bundling other Kamelets
+			require.Nil(t, StampContract(kit))
+			decision, err = Match(newIntegration(test.integration), kit, DefaultOptions())
+			require.Nil(t, err)
+			assert.Equal(t, test.match, decision.Matched)
+		})
+	}
+}
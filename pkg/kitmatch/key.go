@@ -0,0 +1,121 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kitmatch
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"sort"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/trait"
+)
+
+// MatchKey returns a canonical key representing everything that affects the matching of the integration
+// against the existing kits, i.e., the version, the runtime version and provider, the dependencies
+// and the kit influencing traits configuration.
+// An integration matches the kits having an equal key, though kits providing extra dependencies can match as well.
+func MatchKey(integration *v1.Integration) (string, error) {
+	return computeMatchKey(
+		integration.Status.Version,
+		integration.Status.RuntimeVersion,
+		integration.Status.RuntimeProvider,
+		integration.Status.Dependencies,
+		integration.Spec.Traits,
+	)
+}
+
+// KitMatchKey returns the canonical key of the kit, that is equal to the MatchKey of the integrations it matches exactly.
+func KitMatchKey(kit *v1.IntegrationKit) (string, error) {
+	return computeMatchKey(
+		kit.Status.Version,
+		kit.Status.RuntimeVersion,
+		kit.Status.RuntimeProvider,
+		kit.Spec.Dependencies,
+		kit.Spec.Traits,
+	)
+}
+
+func computeMatchKey(version string, runtimeVersion string, runtimeProvider v1.RuntimeProvider, dependencies []string, traits interface{}) (string, error) {
+	influencingTraits, err := influencingTraitsConfiguration(traits)
+	if err != nil {
+		return "", err
+	}
+
+	key := struct {
+		Version         string                            `json:"version"`
+		RuntimeVersion  string                            `json:"runtimeVersion"`
+		RuntimeProvider v1.RuntimeProvider                `json:"runtimeProvider"`
+		Dependencies    []string                          `json:"dependencies"`
+		Traits          map[string]map[string]interface{} `json:"traits"`
+	}{
+		Version:         version,
+		RuntimeVersion:  runtimeVersion,
+		RuntimeProvider: runtimeProvider,
+		Dependencies:    sortedCanonicalDependencies(dependencies),
+		Traits:          influencingTraits,
+	}
+
+	// The JSON encoding sorts the map keys, so that it's canonical
+	data, err := json.Marshal(key)
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.Sum256(data)
+	// Add a letter at the beginning and use URL safe encoding
+	return "v" + base64.RawURLEncoding.EncodeToString(hash[:]), nil
+}
+
+// influencingTraitsConfiguration returns the configuration of the traits that influence the kit.
+func influencingTraitsConfiguration(traits interface{}) (map[string]map[string]interface{}, error) {
+	traitMap, err := trait.ToTraitMap(traits)
+	if err != nil {
+		return nil, err
+	}
+
+	kitInfluencingTraits := KitInfluencingTraits()
+	if err := validateInfluencingTraits(kitInfluencingTraits); err != nil {
+		return nil, err
+	}
+	influencingTraits := make(map[string]map[string]interface{})
+	for _, t := range kitInfluencingTraits {
+		id := string(t.ID())
+		if config, ok := findTrait(traitMap, id); ok {
+			influencingTraits[id] = withoutNonInfluencingFields(id, config)
+		}
+	}
+
+	return influencingTraits, nil
+}
+
+// sortedCanonicalDependencies returns the sorted and de-duplicated canonical dependencies.
+func sortedCanonicalDependencies(dependencies []string) []string {
+	canonical := make([]string, 0, len(dependencies))
+	seen := make(map[string]bool, len(dependencies))
+	for _, d := range canonicalDependencies(dependencies) {
+		if !seen[d] {
+			seen[d] = true
+			canonical = append(canonical, d)
+		}
+	}
+	sort.Strings(canonical)
+
+	return canonical
+}
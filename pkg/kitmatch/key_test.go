@@ -0,0 +1,146 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kitmatch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"k8s.io/utils/pointer"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/pkg/apis/camel/v1/trait"
+)
+
+func TestMatchKey(t *testing.T) {
+	newIntegration := func() *v1.Integration {
+		return &v1.Integration{
+			Spec: v1.IntegrationSpec{
+				Traits: v1.Traits{
+					Builder: &traitv1.BuilderTrait{
+						Properties: []string{"build-key1=build-value1"},
+					},
+					// The container trait does not influence the kit
+					Container: &traitv1.ContainerTrait{
+						Name: "my-container",
+					},
+				},
+			},
+			Status: v1.IntegrationStatus{
+				Version:         "1.0.0",
+				RuntimeVersion:  "1.17.0",
+				RuntimeProvider: v1.RuntimeProviderQuarkus,
+				Dependencies:    []string{"camel:core", "mvn:org.my:lib:1.0"},
+			},
+		}
+	}
+	newKit := func() *v1.IntegrationKit {
+		return &v1.IntegrationKit{
+			Spec: v1.IntegrationKitSpec{
+				Dependencies: []string{"mvn:org.my:lib:jar:1.0", "camel:core"},
+				Traits: v1.IntegrationKitTraits{
+					Builder: &traitv1.BuilderTrait{
+						Properties: []string{"build-key1=build-value1"},
+					},
+				},
+			},
+			Status: v1.IntegrationKitStatus{
+				Phase:           v1.IntegrationKitPhaseReady,
+				Version:         "1.0.0",
+				RuntimeVersion:  "1.17.0",
+				RuntimeProvider: v1.RuntimeProviderQuarkus,
+			},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		integration func(*v1.Integration)
+		kit         func(*v1.IntegrationKit)
+		match       bool
+	}{
+		{
+			name:  "matching",
+			match: true,
+		},
+		{
+			name: "version",
+			kit: func(kit *v1.IntegrationKit) {
+				kit.Status.Version = "1.1.0"
+			},
+		},
+		{
+			name: "runtime version",
+			kit: func(kit *v1.IntegrationKit) {
+				kit.Status.RuntimeVersion = "1.18.0"
+			},
+		},
+		{
+			name: "runtime provider",
+			kit: func(kit *v1.IntegrationKit) {
+				kit.Status.RuntimeProvider = "other"
+			},
+		},
+		{
+			name: "dependencies",
+			integration: func(integration *v1.Integration) {
+				integration.Status.Dependencies = append(integration.Status.Dependencies, "camel:http")
+			},
+		},
+		{
+			name: "classifier",
+			kit: func(kit *v1.IntegrationKit) {
+				kit.Spec.Dependencies[0] = "mvn:org.my:lib:jar:tests:1.0"
+			},
+		},
+		{
+			name: "traits",
+			kit: func(kit *v1.IntegrationKit) {
+				kit.Spec.Traits.Builder.Enabled = pointer.Bool(true)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			integration := newIntegration()
+			if test.integration != nil {
+				test.integration(integration)
+			}
+			kit := newKit()
+			if test.kit != nil {
+				test.kit(kit)
+			}
+
+			match, err := IntegrationMatches(integration, kit, DefaultOptions())
+			assert.Nil(t, err)
+			assert.Equal(t, test.match, match)
+
+			key, err := MatchKey(integration)
+			assert.Nil(t, err)
+			kitKey, err := KitMatchKey(kit)
+			assert.Nil(t, err)
+			if test.match {
+				assert.Equal(t, key, kitKey)
+			} else {
+				assert.NotEqual(t, key, kitKey)
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kitmatch
+
+import (
+	"os"
+	"strings"
+	"time"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/util/log"
+)
+
+// KitMatchLogFormatEnvVariable is the operator environment variable that can be set to `json`
+// for the kit matching decisions to be emitted as structured JSON records.
+const KitMatchLogFormatEnvVariable = "KIT_MATCH_LOG_FORMAT"
+
+// decisionLog emits the kit matching decisions as JSON records, when enabled.
+var decisionLog *log.Logger
+
+func init() {
+	if strings.EqualFold(os.Getenv(KitMatchLogFormatEnvVariable), "json") {
+		l := log.NewJSON(os.Stdout).WithName("kit-matching")
+		decisionLog = &l
+	}
+}
+
+func logMatchDecision(integration *v1.Integration, kit *v1.IntegrationKit, decision Decision, duration time.Duration) {
+	if decisionLog == nil {
+		return
+	}
+
+	decisionLog.ForIntegration(integration).Info("Kit match decision",
+		"integration-kit", kit.Name,
+		"matched", decision.Matched,
+		"reason", decision.Reason,
+		"details", decision.Details,
+		"duration", duration.String(),
+	)
+}
@@ -0,0 +1,96 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kitmatch
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/util/log"
+)
+
+func TestLogMatchDecisionAsJSON(t *testing.T) {
+	var buffer bytes.Buffer
+	l := log.NewJSON(&buffer)
+	decisionLog = &l
+	defer func() {
+		decisionLog = nil
+	}()
+
+	integration := &v1.Integration{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1.SchemeGroupVersion.String(),
+			Kind:       v1.IntegrationKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-integration",
+		},
+		Status: v1.IntegrationStatus{
+			Dependencies: []string{"camel-core", "camel-irc"},
+		},
+	}
+	kit := func(name string, dependencies ...string) *v1.IntegrationKit {
+		return &v1.IntegrationKit{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "ns",
+				Name:      name,
+			},
+			Spec: v1.IntegrationKitSpec{
+				Dependencies: dependencies,
+			},
+		}
+	}
+
+	ok, err := IntegrationMatches(integration, kit("my-kit-1", "camel-core", "camel-irc"), DefaultOptions())
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	ok, err = IntegrationMatches(integration, kit("my-kit-2", "camel-core"), DefaultOptions())
+	assert.Nil(t, err)
+	assert.False(t, ok)
+
+	records := make([]map[string]interface{}, 0)
+	scanner := bufio.NewScanner(&buffer)
+	for scanner.Scan() {
+		record := make(map[string]interface{})
+		assert.Nil(t, json.Unmarshal(scanner.Bytes(), &record))
+		records = append(records, record)
+	}
+
+	assert.Len(t, records, 2)
+
+	assert.Equal(t, "Kit match decision", records[0]["msg"])
+	assert.Equal(t, "ns", records[0]["ns"])
+	assert.Equal(t, "my-integration", records[0]["name"])
+	assert.Equal(t, "my-kit-1", records[0]["integration-kit"])
+	assert.Equal(t, true, records[0]["matched"])
+	assert.Equal(t, "", records[0]["reason"])
+	assert.NotEmpty(t, records[0]["duration"])
+
+	assert.Equal(t, "my-kit-2", records[1]["integration-kit"])
+	assert.Equal(t, false, records[1]["matched"])
+	assert.Equal(t, "Integration and integration-kit dependencies do not match", records[1]["reason"])
+	assert.Equal(t, []interface{}{"camel-irc"}, records[1]["details"])
+}
@@ -0,0 +1,1016 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package kitmatch matches the integrations against the integration kits they can reuse.
+package kitmatch
+
+import (
+	"fmt"
+	"path"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/semver"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/pkg/trait"
+	"github.com/apache/camel-k/pkg/util"
+	"github.com/apache/camel-k/pkg/util/defaults"
+	"github.com/apache/camel-k/pkg/util/log"
+	"github.com/apache/camel-k/pkg/util/maven"
+)
+
+// IntegrationMatches returns whether the v1.IntegrationKit meets the requirements of the v1.Integration,
+// according to the given matching options.
+// Only the build inputs of the integration are considered, i.e., its version, runtime, dependencies
+// and kit influencing traits, so that integrations with different sources can share the same kit.
+func IntegrationMatches(integration *v1.Integration, kit *v1.IntegrationKit, options Options) (bool, error) {
+	decision, err := Match(integration, kit, options)
+	if err != nil {
+		return false, err
+	}
+
+	return decision.Matched, nil
+}
+
+// Match evaluates the kit against the integration, and logs the decision.
+func Match(integration *v1.Integration, kit *v1.IntegrationKit, options Options) (Decision, error) {
+	ilog := log.ForIntegration(integration)
+
+	ilog.Debug("Matching integration", "integration", integration.Name, "integration-kit", kit.Name, "namespace", integration.Namespace)
+	// The duplicate dependencies are ignored, so that they do not distort the comparison of the dependencies
+	if dependencies, duplicates := deduplicateDependencies(integration.Status.Dependencies); len(duplicates) > 0 {
+		ilog.Debug("Ignoring the duplicate dependencies of the integration", "integration", integration.Name, "namespace", integration.Namespace, "duplicates", duplicates)
+		deduplicated := *integration
+		deduplicated.Status.Dependencies = dependencies
+		integration = &deduplicated
+	}
+	if dependencies, duplicates := deduplicateDependencies(kit.Spec.Dependencies); len(duplicates) > 0 {
+		log.ForIntegrationKit(kit).Debug("Ignoring the duplicate dependencies of the integration kit", "integration-kit", kit.Name, "namespace", kit.Namespace, "duplicates", duplicates)
+		deduplicated := *kit
+		deduplicated.Spec.Dependencies = dependencies
+		kit = &deduplicated
+	}
+	if len(normalizeDependencies(integration.Status.Dependencies)) != len(normalizeDependencies(kit.Spec.Dependencies)) {
+		ilog.Debug("Integration and integration-kit have different number of dependencies", "integration", integration.Name, "integration-kit", kit.Name, "namespace", integration.Namespace)
+	}
+
+	start := time.Now()
+	decision, err := evaluateKit(integration, kit, options)
+	if err != nil {
+		ilog.Debug("Integration and integration-kit cannot be matched", "integration", integration.Name, "integration-kit", kit.Name, "namespace", integration.Namespace, "error", err.Error())
+		return Decision{}, err
+	}
+	logMatchDecision(integration, kit, decision, time.Since(start))
+
+	if !decision.Matched {
+		ilog.Debug(decision.Reason, "integration", integration.Name, "integration-kit", kit.Name, "namespace", integration.Namespace, "details", decision.Details)
+		return decision, nil
+	}
+
+	ilog.Debug("Matched Integration and integration-kit", "integration", integration.Name, "integration-kit", kit.Name, "namespace", integration.Namespace)
+	return decision, nil
+}
+
+// Decision is the outcome of the evaluation of a kit against an integration.
+type Decision struct {
+	Matched bool
+	// the reason why the kit does not match, if any
+	Reason string
+	// the details of the mismatch, e.g. the missing dependencies
+	Details []string
+}
+
+// Mismatch returns the decision that a kit does not match, for the given reason and details.
+func Mismatch(reason string, details ...string) Decision {
+	return Decision{
+		Reason:  reason,
+		Details: details,
+	}
+}
+
+// evaluateKit evaluates the v1.IntegrationKit against the requirements of the v1.Integration, step by step,
+// recording the time spent in each step into the trace, if any.
+func evaluateKit(integration *v1.Integration, kit *v1.IntegrationKit, options Options) (Decision, error) {
+	steps := []struct {
+		step     MatchStep
+		evaluate func(*v1.Integration, *v1.IntegrationKit, Options) (Decision, error)
+	}{
+		{MatchStepStatus, evaluateStatus},
+		{MatchStepTraits, evaluateTraits},
+		{MatchStepDependencies, evaluateDependencies},
+	}
+	for _, s := range steps {
+		start := time.Now()
+		decision, err := s.evaluate(integration, kit, options)
+		options.Trace.record(s.step, time.Since(start))
+		if err != nil || !decision.Matched {
+			return decision, err
+		}
+		// The compatibility contract of the kit, if any, may decide the match before the kit is compared in depth
+		if s.step == MatchStepStatus {
+			if contract, ok := kitContract(kit); ok {
+				start := time.Now()
+				decision, decided := evaluateContract(integration, kit, contract, options)
+				options.Trace.record(MatchStepContract, time.Since(start))
+				if decided {
+					return decision, nil
+				}
+			}
+		}
+	}
+
+	return Decision{Matched: true}, nil
+}
+
+// evaluateStatus evaluates the status and the labels of the v1.IntegrationKit against the v1.Integration ones.
+func evaluateStatus(integration *v1.Integration, kit *v1.IntegrationKit, options Options) (Decision, error) {
+	if options.ExcludeInvalid {
+		if err := kit.Validate(); err != nil {
+			return Mismatch("Integration kit status is inconsistent", err.Error()), nil
+		}
+	}
+	if match, reason := StatusMatches(integration, kit, options); !match {
+		return Mismatch(reason), nil
+	}
+	if !packagingMatches(integration, kit) {
+		return Mismatch("Integration and integration-kit packaging types do not match"), nil
+	}
+	if !identityLabelsMatch(integration.Labels, kit.Labels, options.IdentityLabels) {
+		return Mismatch("Integration and integration-kit identity labels do not match"), nil
+	}
+	if options.PlatformGeneration != "" && kit.Labels[v1.IntegrationKitPlatformGenerationLabel] != options.PlatformGeneration {
+		return Mismatch("Integration-kit is not created under the integration platform generation", options.PlatformGeneration), nil
+	}
+	if !operatorVersionMatches(kit, options.OperatorVersion) {
+		return Mismatch("Integration-kit is not labeled with a compatible operator version"), nil
+	}
+	if supported, err := apiVersionSupported(integration, kit); err != nil {
+		return Mismatch("Integration-kit declares invalid supported integration API versions", err.Error()), nil
+	} else if !supported {
+		return Mismatch("Integration-kit does not support the integration API version", integrationAPIVersion(integration)), nil
+	}
+
+	return Decision{Matched: true}, nil
+}
+
+// evaluateTraits evaluates the traits and the profile of the v1.IntegrationKit against the v1.Integration ones.
+func evaluateTraits(integration *v1.Integration, kit *v1.IntegrationKit, options Options) (Decision, error) {
+	// The BOM overrides shift the versions of all the dependencies they manage, so they are compared whatever
+	// the match mode, rather than the kit builder trait being a subset of the integration one
+	if boms := bomOverrides(integration.Spec.Traits.Builder); !reflect.DeepEqual(boms, bomOverrides(kit.Spec.Traits.Builder)) {
+		return Mismatch("Integration and integration-kit BOM overrides do not match", boms...), nil
+	}
+	// The pinned versions apply to the transitive dependencies as well, that the dependencies do not list
+	if pins := managedVersions(integration.Spec.Traits.Builder); !reflect.DeepEqual(pins, managedVersions(kit.Spec.Traits.Builder)) {
+		return Mismatch("Integration and integration-kit pinned dependency versions do not match", pins...), nil
+	}
+	// When a platform kit is created it inherits the traits from the integrations and as
+	// some traits may influence the build thus the artifacts present on the container image,
+	// we need to take traits into account when looking up for compatible kits.
+	//
+	// It could also happen that an integration is updated and a trait is modified, if we do
+	// not include traits in the lookup, we may use a kit that does not have all the
+	// characteristics required by the integration.
+	//
+	// A kit can be used only if it contains a subset of the traits and related configurations
+	// declared on integration, unless the platform is configured to only match dependencies.
+	if options.Mode != v1.IntegrationKitMatchModeDependenciesOnly {
+		influencingTraits := options.influencingTraits
+		if influencingTraits == nil {
+			influencingTraits = options.resolveInfluencingTraits()
+		}
+		// A broken catalog would skip the traits comparison, and match the kits whatever their traits
+		if err := validateInfluencingTraits(influencingTraits); err != nil {
+			return Decision{}, err
+		}
+		influencingTraits = withoutTraits(influencingTraits, schedulingTraits, repositoryTraits, options.NonInfluencingAddons, options.NonInfluencingTraits)
+		if match, err := matchInfluencingTraits(integration.Spec.Traits, kit.Spec.Traits, options.TraitMatchMode, influencingTraits, options.PermissiveTraits, options.CanonicalTraits, options.TraitFieldPaths); err != nil {
+			return Decision{}, err
+		} else if !match {
+			return Mismatch("Integration and integration-kit traits do not match"), nil
+		}
+		// The build strategy is compared along with the builder trait, when it influences the kits
+		if !buildStrategyMatches(kit, options.BuildStrategy) {
+			return Mismatch("Integration and integration-kit build strategies do not match"), nil
+		}
+	}
+	// The kits built for other profiles are only reused by the profiles configured to be compatible with them
+	if !profileCompatible(integration, kit, options.ProfileCompatibility) {
+		return Mismatch("Integration-kit is built for a profile the integration profile cannot reuse", string(kit.Spec.Profile)), nil
+	}
+
+	return Decision{Matched: true}, nil
+}
+
+// evaluateDependencies evaluates the dependencies, and what they resolve to, of the v1.IntegrationKit against
+// the v1.Integration ones.
+func evaluateDependencies(integration *v1.Integration, kit *v1.IntegrationKit, options Options) (Decision, error) {
+	// The dependencies are irrelevant for the integrations run from a prebuilt container image, that are matched
+	// against the kits by image instead, if enabled
+	if image := ContainerImage(integration); image != "" && options.IgnoreImageDependencies {
+		if kitImage(kit) != image {
+			return Mismatch("Integration-kit image does not match the integration container image", image), nil
+		}
+		return Decision{Matched: true}, nil
+	}
+	// The kits created for other integrations may have been built against older SNAPSHOT dependencies
+	if snapshots := snapshotDependencies(integration.Status.Dependencies); len(snapshots) > 0 && snapshotRequiresRebuild(integration, kit, options) {
+		return Mismatch("Integration SNAPSHOT dependencies require a new integration-kit", snapshots...), nil
+	}
+	// The versions of the Maven dependencies that are not pinned may be ignored,
+	// and the file dependencies are compared by content
+	integrationKey := checksumKey(options.dependencyKey, dependencyChecksums(integration.Annotations))
+	kitKey := checksumKey(options.dependencyKey, dependencyChecksums(kit.Annotations))
+	missing := subtractDependencies(integration.Status.Dependencies, integrationKey, kit.Spec.Dependencies, kitKey)
+	// The dependencies pruned from a kit may still be provided transitively by its artifacts
+	if options.DependencyMatchMode == v1.IntegrationKitDependencyMatchModeClosure {
+		missing = uncoveredDependencies(kit, missing)
+	}
+	// The peripheral dependencies the kit misses only lower its rank
+	missing = options.coreDependencies(missing)
+	if len(missing) > 0 {
+		return Mismatch("Integration and integration-kit dependencies do not match", missing...), nil
+	}
+	// The dependencies the traits only add for the integration profile are provided by the kits built for it
+	if conditioned := profileConditionedDependencies(integration, kit); len(conditioned) > 0 {
+		return Mismatch("Integration-kit is not built for the profile of the integration dependencies", conditioned...), nil
+	}
+	// The capabilities of the integration, e.g. the HTTP service exposure, require the kits built with their artifacts
+	if missing := missingCapabilities(integration, kit); len(missing) > 0 {
+		return Mismatch("Integration-kit does not provide the capabilities of the integration", missing...), nil
+	}
+	// The Kamelets bundled into the kit image would shadow the ones the integration loads
+	if !bundledKameletsMatch(integration, kit) {
+		return Mismatch("Integration and integration-kit bundled Kamelets do not match", bundledKamelets(kit)...), nil
+	}
+	// The dependencies may resolve to different trees, e.g. with version ranges or snapshots
+	if options.MatchDependencyTreeDigest && !dependencyTreeDigestMatches(integration, kit) {
+		return Mismatch("Integration and integration-kit dependency tree digests do not match"), nil
+	}
+	// The components of the kit image, as recorded in its SBOM, are checked beyond the declared dependencies
+	if options.MatchSBOM {
+		if decision := sbomMatches(integration, kit); !decision.Matched {
+			return decision, nil
+		}
+	}
+	if tolerance := options.MaxExtraDependencies; tolerance >= 0 {
+		if extra := subtractDependencies(kit.Spec.Dependencies, kitKey, integration.Status.Dependencies, integrationKey); len(extra) > tolerance {
+			return Mismatch("Integration-kit has too many extra dependencies", extra...), nil
+		}
+	}
+
+	return Decision{Matched: true}, nil
+}
+
+// bomOverrides returns the BOMs the builder trait imports to override the runtime BOMs, in order, or nil if none.
+func bomOverrides(builder *traitv1.BuilderTrait) []string {
+	if builder == nil || len(builder.Boms) == 0 {
+		return nil
+	}
+
+	return builder.Boms
+}
+
+// managedVersions returns the dependency versions the builder trait pins in the dependency management, sorted,
+// or nil if none.
+func managedVersions(builder *traitv1.BuilderTrait) []string {
+	if builder == nil || len(builder.ManagedVersions) == 0 {
+		return nil
+	}
+	pins := append([]string{}, builder.ManagedVersions...)
+	sort.Strings(pins)
+
+	return pins
+}
+
+// ContainerImage returns the prebuilt container image the integration runs, as configured with the container trait,
+// or empty if the integration is built from its sources.
+func ContainerImage(integration *v1.Integration) string {
+	if integration.Spec.Traits.Container == nil {
+		return ""
+	}
+
+	return integration.Spec.Traits.Container.Image
+}
+
+// kitImage returns the image of the kit, i.e., the prebuilt image of the external kits, or the image it is built to.
+func kitImage(kit *v1.IntegrationKit) string {
+	if kit.Spec.Image != "" {
+		return kit.Spec.Image
+	}
+
+	return kit.Status.Image
+}
+
+// RequiredCapabilities returns the capabilities the integration requires, as derived from its sources and traits.
+func RequiredCapabilities(integration *v1.Integration) []string {
+	capabilities := make([]string, 0, len(integration.Status.Capabilities))
+	for _, capability := range integration.Status.Capabilities {
+		util.StringSliceUniqueAdd(&capabilities, capability)
+	}
+	sort.Strings(capabilities)
+
+	return capabilities
+}
+
+// missingCapabilities returns the capabilities required by the integration that the kit does not provide.
+// The kits that have not recorded their capabilities are matched on their dependencies only.
+func missingCapabilities(integration *v1.Integration, kit *v1.IntegrationKit) []string {
+	if kit.Spec.Capabilities == nil {
+		return nil
+	}
+	missing := make([]string, 0)
+	for _, capability := range RequiredCapabilities(integration) {
+		if !util.StringSliceExists(kit.Spec.Capabilities, capability) {
+			missing = append(missing, capability)
+		}
+	}
+
+	return missing
+}
+
+// dependencyTreeDigestMatches returns whether the digest of the resolved dependency tree of the kit is equal to
+// the one the integration declares, if any. The kits that are not built yet have no digest.
+func dependencyTreeDigestMatches(integration *v1.Integration, kit *v1.IntegrationKit) bool {
+	digest, ok := integration.Annotations[v1.DependencyTreeDigestAnnotation]
+	if !ok || digest == "" {
+		return true
+	}
+
+	return kit.Status.DependencyTreeDigest == digest
+}
+
+// profileDependencies are the dependencies the traits add to the integrations for a given profile only.
+var profileDependencies = map[v1.TraitProfile][]string{
+	v1.TraitProfileKnative: {"mvn:org.apache.camel.k:camel-k-knative"},
+}
+
+// profileConditionedDependencies returns the dependencies of the integration that are specific to its profile,
+// when the kit is built for another profile. The kits that have not recorded their profile are matched
+// on their dependencies only.
+func profileConditionedDependencies(integration *v1.Integration, kit *v1.IntegrationKit) []string {
+	profile := integrationProfile(integration)
+	if kit.Spec.Profile == "" || kit.Spec.Profile == profile {
+		return nil
+	}
+	specific := canonicalDependencies(profileDependencies[profile])
+	conditioned := make([]string, 0)
+	for _, dependency := range integration.Status.Dependencies {
+		if util.StringSliceExists(specific, CanonicalDependency(dependency)) {
+			conditioned = append(conditioned, dependency)
+		}
+	}
+
+	return conditioned
+}
+
+// profileCompatible returns whether the integration can reuse the kit according to their profiles, i.e., the kit is
+// built for the integration profile, or for a profile compatible with it. The kits, or the integrations, that have not
+// recorded their profile, or any kit when no compatibility is configured, are compatible.
+func profileCompatible(integration *v1.Integration, kit *v1.IntegrationKit, compatibility map[v1.TraitProfile][]v1.TraitProfile) bool {
+	profile := integrationProfile(integration)
+	if compatibility == nil || profile == "" || kit.Spec.Profile == "" || kit.Spec.Profile == profile {
+		return true
+	}
+	for _, compatible := range compatibility[profile] {
+		if compatible == kit.Spec.Profile {
+			return true
+		}
+	}
+
+	return false
+}
+
+// integrationProfile returns the profile of the integration, as resolved in its status or set in its spec.
+func integrationProfile(integration *v1.Integration) v1.TraitProfile {
+	if integration.Status.Profile != "" {
+		return integration.Status.Profile
+	}
+
+	return integration.Spec.Profile
+}
+
+// quarkusPlatformVersionMatches returns whether the kit is built with the Quarkus platform version targeted by
+// the integration, the kits and the integrations that have not recorded it matching any version.
+func quarkusPlatformVersionMatches(integration *v1.Integration, kit *v1.IntegrationKit) bool {
+	if integration.Status.QuarkusPlatformVersion == "" || kit.Status.QuarkusPlatformVersion == "" {
+		return true
+	}
+
+	return integration.Status.QuarkusPlatformVersion == kit.Status.QuarkusPlatformVersion
+}
+
+// StatusGenerationMatches returns whether the integration status lags behind its spec by the given number
+// of generations at most, a negative skew disabling the check.
+func StatusGenerationMatches(integration *v1.Integration, maxSkew int64) bool {
+	if maxSkew < 0 {
+		return true
+	}
+
+	return integration.Generation-integration.Status.ObservedGeneration <= maxSkew
+}
+
+// StatusVersionSettled returns whether the integration status version is settled, rather than left by another
+// operator version, as while the operator is being upgraded and the integration not yet initialized again,
+// or blank once the integration has been initialized, so that the integration is not matched against stale kits.
+func StatusVersionSettled(integration *v1.Integration) bool {
+	if integration.Status.Version == "" {
+		return integration.Status.InitializationTimestamp == nil
+	}
+
+	return integration.Status.Version == defaults.Version
+}
+
+// StatusMatches returns whether the v1.IntegrationKit status is compatible with the v1.Integration one,
+// and the reason why it is not.
+func StatusMatches(integration *v1.Integration, kit *v1.IntegrationKit, options Options) (bool, string) {
+	if kit.Status.Phase == v1.IntegrationKitPhaseError {
+		return false, "Integration kit has a phase of Error"
+	}
+	if options.ExcludeImageless && kit.Status.Phase == v1.IntegrationKitPhaseReady && kit.Status.Image == "" {
+		return false, "Integration kit is ready but has no image"
+	}
+	if kit.Status.Version != integration.Status.Version {
+		return false, "Integration and integration-kit versions do not match"
+	}
+	if kit.Status.RuntimeProvider != integration.Status.RuntimeProvider && !options.AllowOtherRuntimeProviders {
+		return false, "Integration and integration-kit runtime providers do not match"
+	}
+	if !quarkusPlatformVersionMatches(integration, kit) {
+		return false, "Integration and integration-kit Quarkus platform versions do not match"
+	}
+	if options.MatchCatalogVersion && integration.Status.CatalogVersion != kit.Status.CatalogVersion {
+		return false, "Integration and integration-kit catalog versions do not match"
+	}
+	if !runtimeVersionMatches(integration.Status.RuntimeVersion, kit.Status.RuntimeVersion, options.RuntimeVersionPrefixMatch) {
+		return false, "Integration and integration-kit runtime versions do not match"
+	}
+	if !runtimeVersionOverrideMatches(integration, kit) {
+		return false, "Integration-kit runtime version does not match the camel trait runtime version"
+	}
+	if options.RequireImageStream && kit.Status.ImageStream == "" {
+		return false, "Integration requires an ImageStream-backed integration-kit"
+	}
+	if !imageFromRegistries(kitImage(kit), allowedRegistries(integration, options)) {
+		return false, "Integration-kit image does not come from an allowed registry"
+	}
+
+	return true, ""
+}
+
+// allowedRegistries returns the prefixes of the registries the kit images must come from, as declared on the
+// integration, or else as configured.
+func allowedRegistries(integration *v1.Integration, options Options) []string {
+	if value, ok := integration.Annotations[v1.KitRegistriesAnnotation]; ok {
+		registries := make([]string, 0)
+		for _, registry := range strings.Split(value, ",") {
+			if registry = strings.TrimSpace(registry); registry != "" {
+				registries = append(registries, registry)
+			}
+		}
+		return registries
+	}
+
+	return options.AllowedRegistries
+}
+
+// imageFromRegistries returns whether the image comes from one of the registries, by prefix, any registry being
+// allowed when none is. The kits that are not built yet, and have no image, are checked once built.
+func imageFromRegistries(image string, registries []string) bool {
+	if len(registries) == 0 || image == "" {
+		return true
+	}
+	for _, registry := range registries {
+		// The prefix matches whole path components, e.g. `registry.example.com` not matching `registry.example.company`
+		if strings.HasPrefix(image, strings.TrimSuffix(registry, "/")+"/") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// runtimeVersionMatches returns whether the kit runtime version matches the integration one. When prefix matching
+// is enabled, the integration runtime version can also be a prefix of the kit one, e.g. `1.17` matching `1.17.2`,
+// or a glob pattern, e.g. `1.17.*`.
+func runtimeVersionMatches(version string, kitVersion string, prefixMatch bool) bool {
+	if version == kitVersion {
+		return true
+	}
+	if !prefixMatch || version == "" {
+		return false
+	}
+	if strings.ContainsAny(version, "*?[") {
+		match, err := path.Match(version, kitVersion)
+		return err == nil && match
+	}
+
+	return strings.HasPrefix(kitVersion, strings.TrimSuffix(version, ".")+".")
+}
+
+// runtimeVersionOverrideMatches returns whether the kit runtime version satisfies the runtime version
+// the camel trait of the integration overrides, if any, as an exact version or a semantic version constraint.
+// The integration status may not reflect the override yet, e.g. until the traits are applied again.
+func runtimeVersionOverrideMatches(integration *v1.Integration, kit *v1.IntegrationKit) bool {
+	camel := integration.Spec.Traits.Camel
+	if camel == nil || camel.RuntimeVersion == "" || camel.RuntimeVersion == kit.Status.RuntimeVersion {
+		return true
+	}
+	constraint, err := semver.NewConstraint(camel.RuntimeVersion)
+	if err != nil {
+		return false
+	}
+	version, err := semver.NewVersion(kit.Status.RuntimeVersion)
+	if err != nil {
+		return false
+	}
+
+	return constraint.Check(version)
+}
+
+// operatorVersionMatches returns whether the operator version label of the kit is equal to the given version,
+// or satisfies it as a semantic version constraint. The kits without the label are created by the operator
+// versions that predate it.
+func operatorVersionMatches(kit *v1.IntegrationKit, version string) bool {
+	if version == "" {
+		return true
+	}
+	label, ok := kit.Labels[v1.IntegrationKitOperatorVersionLabel]
+	if !ok {
+		return false
+	}
+	if label == version {
+		return true
+	}
+	constraint, err := semver.NewConstraint(version)
+	if err != nil {
+		return false
+	}
+	v, err := semver.NewVersion(label)
+	if err != nil {
+		return false
+	}
+
+	return constraint.Check(v)
+}
+
+// buildStrategyMatches returns whether the kit was built with the given strategy, if any. The kits that are
+// not built yet will be built with the strategy of the platform, while the strategy of the ready kits
+// that have not recorded it is unknown.
+func buildStrategyMatches(kit *v1.IntegrationKit, strategy v1.BuildStrategy) bool {
+	if strategy == "" {
+		return true
+	}
+	if kit.Status.BuildStrategy == "" {
+		return kit.Status.Phase != v1.IntegrationKitPhaseReady
+	}
+
+	return kit.Status.BuildStrategy == strategy
+}
+
+// identityLabelsMatch returns whether the identity labels have the same values, or are missing, on both sides.
+func identityLabelsMatch(labels map[string]string, kitLabels map[string]string, identityLabels []string) bool {
+	for _, label := range identityLabels {
+		value, ok := labels[label]
+		kitValue, kitOk := kitLabels[label]
+		if ok != kitOk || value != kitValue {
+			return false
+		}
+	}
+
+	return true
+}
+
+// packagingMatches returns whether the kit is packaged with one of the types requested by the integration.
+// A natively-compiled kit and a JVM kit are not interchangeable, even if they have identical dependencies and traits.
+func packagingMatches(integration *v1.Integration, kit *v1.IntegrationKit) bool {
+	packageType := kitPackageType(kit)
+	for _, pt := range integrationPackageTypes(integration) {
+		if pt == packageType {
+			return true
+		}
+	}
+
+	return false
+}
+
+func integrationPackageTypes(integration *v1.Integration) []traitv1.QuarkusPackageType {
+	if q := integration.Spec.Traits.Quarkus; q != nil && len(q.PackageTypes) > 0 {
+		return q.PackageTypes
+	}
+
+	return []traitv1.QuarkusPackageType{traitv1.FastJarPackageType}
+}
+
+func kitPackageType(kit *v1.IntegrationKit) traitv1.QuarkusPackageType {
+	if layout := kit.Labels[v1.IntegrationKitLayoutLabel]; layout != "" {
+		return traitv1.QuarkusPackageType(layout)
+	}
+	if q := kit.Spec.Traits.Quarkus; q != nil && len(q.PackageTypes) > 0 {
+		return q.PackageTypes[0]
+	}
+
+	return traitv1.FastJarPackageType
+}
+
+// MissingDependencies returns the integration dependencies that are not provided by the kit,
+// in the order they are declared by the integration.
+func MissingDependencies(kit *v1.IntegrationKit, integration *v1.Integration) []string {
+	return subtractDependencies(integration.Status.Dependencies, CanonicalDependency, kit.Spec.Dependencies, CanonicalDependency)
+}
+
+// ExtraDependencies returns the kit dependencies that are not required by the integration,
+// in the order they are declared by the kit.
+func ExtraDependencies(kit *v1.IntegrationKit, integration *v1.Integration) []string {
+	return subtractDependencies(kit.Spec.Dependencies, CanonicalDependency, integration.Status.Dependencies, CanonicalDependency)
+}
+
+// subtractDependencies returns the dependencies that are not found in the others, the dependencies being
+// compared by the given keys, e.g. their canonical form.
+func subtractDependencies(dependencies []string, key func(string) string, others []string, otherKey func(string) string) []string {
+	dependencies = normalizeDependencies(dependencies)
+	otherKeys := make([]string, 0, len(others))
+	for _, o := range others {
+		otherKeys = append(otherKeys, otherKey(o))
+	}
+	result := make([]string, 0)
+	for _, d := range dependencies {
+		if !util.StringSliceExists(otherKeys, key(d)) {
+			result = append(result, d)
+		}
+	}
+
+	return result
+}
+
+// DuplicateDependencies returns the dependencies that duplicate a previous one, compared by their canonical form.
+func DuplicateDependencies(dependencies []string) []string {
+	_, duplicates := deduplicateDependencies(dependencies)
+
+	return duplicates
+}
+
+// deduplicateDependencies returns the dependencies without the duplicates, compared by their canonical form,
+// along with the duplicates that have been removed. The dependencies are returned as is if there is no duplicate.
+func deduplicateDependencies(dependencies []string) ([]string, []string) {
+	seen := make(map[string]bool, len(dependencies))
+	var unique, duplicates []string
+	for i, d := range dependencies {
+		key := CanonicalDependency(d)
+		if !seen[key] {
+			seen[key] = true
+			if duplicates != nil {
+				unique = append(unique, d)
+			}
+			continue
+		}
+		if duplicates == nil {
+			unique = append(make([]string, 0, len(dependencies)), dependencies[:i]...)
+		}
+		duplicates = append(duplicates, d)
+	}
+	if duplicates == nil {
+		return dependencies, nil
+	}
+
+	return unique, duplicates
+}
+
+// normalizeDependencies returns an empty slice for nil dependencies, so that nil and empty dependencies
+// are matched consistently.
+func normalizeDependencies(dependencies []string) []string {
+	if dependencies == nil {
+		return []string{}
+	}
+
+	return dependencies
+}
+
+func canonicalDependencies(dependencies []string) []string {
+	canonical := make([]string, 0, len(dependencies))
+	for _, d := range dependencies {
+		canonical = append(canonical, CanonicalDependency(d))
+	}
+
+	return canonical
+}
+
+// CanonicalDependency returns the canonical form of the dependency, so that equivalent Maven coordinates
+// compare equal, e.g. `mvn:org.my:lib:1.0` and `mvn:org.my:lib:jar:1.0`, while coordinates with different
+// classifiers do not.
+func CanonicalDependency(dependency string) string {
+	if !strings.HasPrefix(dependency, "mvn:") {
+		return dependency
+	}
+	gav, err := maven.ParseGAV(strings.TrimPrefix(dependency, "mvn:"))
+	if err != nil {
+		return dependency
+	}
+	if gav.Type == "" {
+		gav.Type = "jar"
+	}
+
+	return fmt.Sprintf("mvn:%s:%s:%s:%s:%s", gav.GroupID, gav.ArtifactID, gav.Type, gav.Classifier, gav.Version)
+}
+
+// KitMatches returns whether the existing kit2 can be used in place of kit1, a kit created for the integration.
+// The kits must have the same version, identity labels and packaging, and kit2 must meet the traits and dependencies
+// requirements of the integration according to the given matching options, as when the kits are looked up,
+// so that the kits the lookup matches are selected.
+func KitMatches(integration *v1.Integration, kit1 *v1.IntegrationKit, kit2 *v1.IntegrationKit, options Options) (bool, error) {
+	if kitVersion(kit1) != kitVersion(kit2) {
+		return false, nil
+	}
+	if !identityLabelsMatch(kit1.Labels, kit2.Labels, options.IdentityLabels) {
+		return false, nil
+	}
+	// The kits created for an integration differ by their packaging, e.g. fast-jar and native
+	if kitPackageType(kit1) != kitPackageType(kit2) {
+		return false, nil
+	}
+	// The duplicate dependencies are ignored, as when the kits are looked up
+	if dependencies, duplicates := deduplicateDependencies(integration.Status.Dependencies); len(duplicates) > 0 {
+		deduplicated := *integration
+		deduplicated.Status.Dependencies = dependencies
+		integration = &deduplicated
+	}
+	if dependencies, duplicates := deduplicateDependencies(kit2.Spec.Dependencies); len(duplicates) > 0 {
+		deduplicated := *kit2
+		deduplicated.Spec.Dependencies = dependencies
+		kit2 = &deduplicated
+	}
+	for _, evaluate := range []func(*v1.Integration, *v1.IntegrationKit, Options) (Decision, error){evaluateTraits, evaluateDependencies} {
+		if decision, err := evaluate(integration, kit2, options); err != nil || !decision.Matched {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+// kitVersion returns the version of the kit, defaulting with the version that is going to be set
+// during the kit initialization.
+func kitVersion(kit *v1.IntegrationKit) string {
+	if kit.Status.Version == "" {
+		return defaults.Version
+	}
+
+	return kit.Status.Version
+}
+
+// HasMatchingTraits returns whether the kit influencing traits match, according to the given mode.
+// In the explicit-fields mode, only the fields that are set on both sides are compared, so that
+// the defaults applied on either side are ignored. In the union mode, the traits that are set on either side are
+// compared, restricted to the fields, at any depth, that are set on both sides, the traits set on one side only
+// being compared to the defaults.
+func HasMatchingTraits(traits interface{}, kitTraits interface{}, mode v1.IntegrationKitTraitMatchMode) (bool, error) {
+	influencingTraits := KitInfluencingTraits()
+	if err := validateInfluencingTraits(influencingTraits); err != nil {
+		return false, err
+	}
+
+	return matchInfluencingTraits(traits, kitTraits, mode, influencingTraits, nil, false, nil)
+}
+
+// KitInfluencingTraits returns the traits that influence the kit, whose configurations are compared when matching.
+func KitInfluencingTraits() []trait.Trait {
+	traits := make([]trait.Trait, 0)
+	for _, t := range catalogTraits() {
+		if t != nil && t.InfluencesKit() && isComparedTrait(t) {
+			traits = append(traits, t)
+		}
+	}
+
+	return traits
+}
+
+// withoutTraits returns the traits, except the traits and addons with the given IDs.
+func withoutTraits(traits []trait.Trait, ids ...[]string) []trait.Trait {
+	filtered := make([]trait.Trait, 0, len(traits))
+traits:
+	for _, t := range traits {
+		for _, excluded := range ids {
+			if util.StringSliceExists(excluded, string(t.ID())) {
+				continue traits
+			}
+		}
+		filtered = append(filtered, t)
+	}
+
+	return filtered
+}
+
+// matchInfluencingTraits returns whether the configurations of the given kit influencing traits match,
+// so that the traits can be resolved once when matching many kits. The permissive traits omitted by
+// the integration match any configuration of the kit. The canonical configurations of the traits are compared
+// if enabled, restricted to the fields paths configured for the traits, if any.
+func matchInfluencingTraits(traits interface{}, kitTraits interface{}, mode v1.IntegrationKitTraitMatchMode, influencingTraits []trait.Trait,
+	permissiveTraits []string, canonical bool, fieldPaths map[string]v1.IntegrationKitTraitFieldPaths) (bool, error) {
+	traitMap, err := trait.ToTraitMap(traits)
+	if err != nil {
+		return false, err
+	}
+	kitTraitMap, err := trait.ToTraitMap(kitTraits)
+	if err != nil {
+		return false, err
+	}
+	// We don't store the trait configuration if the trait cannot influence the kit behavior
+	for _, t := range influencingTraits {
+		id := string(t.ID())
+		it, ok1 := findInfluencingTrait(traitMap, id)
+		kt, ok2 := findInfluencingTrait(kitTraitMap, id)
+
+		if !ok1 && !ok2 {
+			continue
+		}
+		// The integration accepts whatever the kit has configured for the permissive traits it omits
+		if !ok1 && util.StringSliceExists(permissiveTraits, id) {
+			continue
+		}
+		if !ok1 || !ok2 {
+			// The traits that are only set on one side are compared to the defaults when comparing the union
+			if mode != v1.IntegrationKitTraitMatchModeUnion {
+				return false, nil
+			}
+			if it == nil {
+				it = map[string]interface{}{}
+			}
+			if kt == nil {
+				kt = map[string]interface{}{}
+			}
+		}
+		if canonical {
+			if it, err = canonicalTrait(t, it); err != nil {
+				return false, err
+			}
+			if kt, err = canonicalTrait(t, kt); err != nil {
+				return false, err
+			}
+		}
+		// Only the configured fields of the trait participate in the matching
+		if paths, ok := fieldPaths[id]; ok {
+			if it, err = projectTrait(it, paths); err != nil {
+				return false, fmt.Errorf("invalid field paths of trait %s: %w", id, err)
+			}
+			if kt, err = projectTrait(kt, paths); err != nil {
+				return false, fmt.Errorf("invalid field paths of trait %s: %w", id, err)
+			}
+		}
+		switch {
+		case mode == v1.IntegrationKitTraitMatchModeExplicitFields:
+			it, kt = commonFields(it, kt, false)
+		case mode == v1.IntegrationKitTraitMatchModeUnion && ok1 && ok2:
+			it, kt = commonFields(it, kt, true)
+		}
+		if ct, ok := t.(trait.ComparableTrait); ok {
+			// if it's match trait use its matches method to determine the match
+			if match, err := matchesComparableTrait(ct, it, kt); !match || err != nil {
+				return false, err
+			}
+		} else {
+			if !matchesTrait(it, kt) {
+				return false, nil
+			}
+		}
+	}
+
+	return true, nil
+}
+
+// nonInfluencingTraitFields lists, per trait, the fields of the kit influencing traits that have no effect
+// on the runtime behavior of the kit, like the build verbosity, and that are ignored when matching.
+var nonInfluencingTraitFields = map[string][]string{
+	"builder": {"verbose"},
+	// Only the build variables of the environment trait are set for the kit build
+	"environment": {"containerMeta", "httpProxy", "vars"},
+}
+
+// findInfluencingTrait returns the trait configuration without the fields that do not influence the kit, and whether
+// the trait is configured, a trait only configured with such fields being as if it were not.
+func findInfluencingTrait(traitsMap map[string]map[string]interface{}, id string) (map[string]interface{}, bool) {
+	config, ok := findTrait(traitsMap, id)
+	stripped := withoutNonInfluencingFields(id, config)
+
+	return stripped, ok && (len(stripped) > 0 || len(config) == 0)
+}
+
+// withoutNonInfluencingFields returns the trait configuration without the fields that do not influence the kit.
+func withoutNonInfluencingFields(id string, config map[string]interface{}) map[string]interface{} {
+	fields, ok := nonInfluencingTraitFields[id]
+	if !ok {
+		return config
+	}
+
+	stripped := make(map[string]interface{}, len(config))
+	for field, value := range config {
+		if !util.StringSliceExists(fields, field) {
+			stripped[field] = value
+		}
+	}
+
+	return stripped
+}
+
+// commonFields returns the trait configurations restricted to the fields that are set on both of them, including the
+// fields of the nested objects if deep is true, so that only what both sides explicitly configure is compared.
+func commonFields(it map[string]interface{}, kt map[string]interface{}, deep bool) (map[string]interface{}, map[string]interface{}) {
+	commonIt := make(map[string]interface{})
+	commonKt := make(map[string]interface{})
+	for field, value := range it {
+		kitValue, ok := kt[field]
+		if !ok {
+			continue
+		}
+		if deep {
+			object, ok1 := value.(map[string]interface{})
+			kitObject, ok2 := kitValue.(map[string]interface{})
+			if ok1 && ok2 {
+				value, kitValue = commonFields(object, kitObject, deep)
+			}
+		}
+		commonIt[field] = value
+		commonKt[field] = kitValue
+	}
+
+	return commonIt, commonKt
+}
+
+// findTrait returns the configuration of the trait, or of the addon, with the given id. The configuration of a
+// trait that is declared without any field is an empty map, so that it compares equal to other empty configurations.
+func findTrait(traitsMap map[string]map[string]interface{}, id string) (map[string]interface{}, bool) {
+	// The traits map is nil when there are no traits, e.g. if serialized as null
+	if traitsMap == nil {
+		return nil, false
+	}
+
+	if trait, ok := traitsMap[id]; ok {
+		if trait == nil {
+			return map[string]interface{}{}, true
+		}
+		return trait, true
+	}
+
+	if addons, ok := traitsMap["addons"]; ok && addons != nil {
+		if addon, ok := addons[id]; ok {
+			if addon == nil {
+				return map[string]interface{}{}, true
+			}
+			if trait, ok := addon.(map[string]interface{}); ok {
+				if trait == nil {
+					return map[string]interface{}{}, true
+				}
+				return trait, true
+			}
+		}
+	}
+
+	return nil, false
+}
+
+func matchesComparableTrait(ct trait.ComparableTrait, it map[string]interface{}, kt map[string]interface{}) (bool, error) {
+	t1 := reflect.New(reflect.TypeOf(ct).Elem()).Interface()
+	if err := trait.ToTrait(it, &t1); err != nil {
+		return false, err
+	}
+
+	t2 := reflect.New(reflect.TypeOf(ct).Elem()).Interface()
+	if err := trait.ToTrait(kt, &t2); err != nil {
+		return false, err
+	}
+
+	return t2.(trait.ComparableTrait).Matches(t1.(trait.Trait)), nil
+}
+
+// canonicalTrait returns the configuration of the trait round-tripped through the trait struct, so that only
+// the semantically meaningful differences remain, and not, e.g., the unknown or empty fields, or the number types.
+func canonicalTrait(t trait.Trait, config map[string]interface{}) (map[string]interface{}, error) {
+	target := reflect.New(reflect.TypeOf(t).Elem()).Interface()
+	if err := trait.ToTrait(config, target); err != nil {
+		return nil, err
+	}
+
+	return trait.ToPropertyMap(target)
+}
+
+func matchesTrait(it map[string]interface{}, kt map[string]interface{}) bool {
+	// perform exact match on the two trait maps
+	return reflect.DeepEqual(it, kt)
+}
@@ -0,0 +1,2631 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kitmatch
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/pkg/trait"
+	"github.com/apache/camel-k/pkg/util/defaults"
+)
+
+func TestHasMatchingTraits_KitNoTraitShouldNotBePicked(t *testing.T) {
+	integration := &v1.Integration{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1.SchemeGroupVersion.String(),
+			Kind:       v1.IntegrationKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-integration",
+		},
+		Spec: v1.IntegrationSpec{
+			Traits: v1.Traits{
+				Builder: &traitv1.BuilderTrait{
+					Trait: traitv1.Trait{
+						Enabled: pointer.Bool(true),
+					},
+				},
+			},
+		},
+	}
+
+	kit := &v1.IntegrationKit{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1.SchemeGroupVersion.String(),
+			Kind:       v1.IntegrationKitKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-kit",
+		},
+	}
+
+	ok, err := HasMatchingTraits(integration.Spec.Traits, kit.Spec.Traits, v1.IntegrationKitTraitMatchModeExact)
+	assert.Nil(t, err)
+	assert.False(t, ok)
+}
+
+func TestHasMatchingTraits_KitSameTraitShouldBePicked(t *testing.T) {
+	integration := &v1.Integration{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1.SchemeGroupVersion.String(),
+			Kind:       v1.IntegrationKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-integration",
+		},
+		Spec: v1.IntegrationSpec{
+			Traits: v1.Traits{
+				Builder: &traitv1.BuilderTrait{
+					Trait: traitv1.Trait{
+						Enabled: pointer.Bool(true),
+					},
+					Properties: []string{
+						"build-key1=build-value1",
+					},
+				},
+			},
+		},
+	}
+
+	kit := &v1.IntegrationKit{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1.SchemeGroupVersion.String(),
+			Kind:       v1.IntegrationKitKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-kit",
+		},
+		Spec: v1.IntegrationKitSpec{
+			Traits: v1.IntegrationKitTraits{
+				Builder: &traitv1.BuilderTrait{
+					Trait: traitv1.Trait{
+						Enabled: pointer.Bool(true),
+					},
+					Properties: []string{
+						"build-key1=build-value1",
+					},
+				},
+			},
+		},
+	}
+
+	ok, err := HasMatchingTraits(integration.Spec.Traits, kit.Spec.Traits, v1.IntegrationKitTraitMatchModeExact)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+}
+
+func TestMissingDependencies(t *testing.T) {
+	kit := &v1.IntegrationKit{
+		Spec: v1.IntegrationKitSpec{
+			Dependencies: []string{
+				"camel-core",
+				"camel-irc",
+			},
+		},
+	}
+
+	integration := func(dependencies ...string) *v1.Integration {
+		return &v1.Integration{
+			Status: v1.IntegrationStatus{
+				Dependencies: dependencies,
+			},
+		}
+	}
+
+	assert.Empty(t, MissingDependencies(kit, integration()))
+	assert.Empty(t, MissingDependencies(kit, integration("camel-core")))
+	assert.Empty(t, MissingDependencies(kit, integration("camel-irc", "camel-core")))
+	assert.Equal(t, []string{"camel-http"}, MissingDependencies(kit, integration("camel-core", "camel-http")))
+	assert.Equal(t, []string{"camel-http", "camel-log"}, MissingDependencies(kit, integration("camel-http", "camel-irc", "camel-log")))
+	assert.Equal(t, []string{"camel-core", "camel-irc"}, MissingDependencies(&v1.IntegrationKit{}, integration("camel-core", "camel-irc")))
+}
+
+func TestIntegrationMatches_PackagingTypes(t *testing.T) {
+	integration := func(packageTypes ...traitv1.QuarkusPackageType) *v1.Integration {
+		it := &v1.Integration{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "ns",
+				Name:      "my-integration",
+			},
+			Status: v1.IntegrationStatus{
+				Dependencies: []string{"camel-core"},
+			},
+		}
+		if len(packageTypes) > 0 {
+			it.Spec.Traits.Quarkus = &traitv1.QuarkusTrait{PackageTypes: packageTypes}
+		}
+		return it
+	}
+	kit := func(layout traitv1.QuarkusPackageType) *v1.IntegrationKit {
+		return &v1.IntegrationKit{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "ns",
+				Name:      "my-kit-" + string(layout),
+				Labels: map[string]string{
+					v1.IntegrationKitLayoutLabel: string(layout),
+				},
+			},
+			Spec: v1.IntegrationKitSpec{
+				Dependencies: []string{"camel-core"},
+				Traits: v1.IntegrationKitTraits{
+					Quarkus: &traitv1.QuarkusTrait{
+						PackageTypes: []traitv1.QuarkusPackageType{layout},
+					},
+				},
+			},
+		}
+	}
+
+	nativeKit := kit(traitv1.NativePackageType)
+	jvmKit := kit(traitv1.FastJarPackageType)
+
+	// Native kit must not be reused by a JVM integration
+	ok, err := IntegrationMatches(integration(traitv1.FastJarPackageType), nativeKit, DefaultOptions())
+	assert.Nil(t, err)
+	assert.False(t, ok)
+	// Nor a JVM kit by a native integration
+	ok, err = IntegrationMatches(integration(traitv1.NativePackageType), jvmKit, DefaultOptions())
+	assert.Nil(t, err)
+	assert.False(t, ok)
+	// Same packaging matches
+	ok, err = IntegrationMatches(integration(traitv1.NativePackageType), nativeKit, DefaultOptions())
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	ok, err = IntegrationMatches(integration(traitv1.FastJarPackageType), jvmKit, DefaultOptions())
+	assert.Nil(t, err)
+	assert.True(t, ok)
+
+	assert.True(t, packagingMatches(integration(), jvmKit))
+	assert.False(t, packagingMatches(integration(), nativeKit))
+	assert.True(t, packagingMatches(integration(traitv1.FastJarPackageType, traitv1.NativePackageType), nativeKit))
+	assert.True(t, packagingMatches(integration(), &v1.IntegrationKit{}))
+}
+
+func TestIntegrationMatches_DependenciesOnlyMode(t *testing.T) {
+	integration := &v1.Integration{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-integration",
+		},
+		Spec: v1.IntegrationSpec{
+			Traits: v1.Traits{
+				Builder: &traitv1.BuilderTrait{
+					Properties: []string{
+						"build-key1=build-value1",
+					},
+				},
+			},
+		},
+		Status: v1.IntegrationStatus{
+			Dependencies: []string{"camel-core"},
+		},
+	}
+
+	kit := &v1.IntegrationKit{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-kit",
+		},
+		Spec: v1.IntegrationKitSpec{
+			Dependencies: []string{"camel-core", "camel-irc"},
+			Traits: v1.IntegrationKitTraits{
+				Builder: &traitv1.BuilderTrait{
+					Properties: []string{
+						"build-key1=build-value2",
+					},
+				},
+			},
+		},
+	}
+
+	ok, err := IntegrationMatches(integration, kit, DefaultOptions())
+	assert.Nil(t, err)
+	assert.False(t, ok)
+
+	dependenciesOnly := &v1.IntegrationPlatform{}
+	dependenciesOnly.Status.Build.KitMatching.Mode = v1.IntegrationKitMatchModeDependenciesOnly
+	ok, err = IntegrationMatches(integration, kit, NewOptions(dependenciesOnly))
+	assert.Nil(t, err)
+	assert.True(t, ok)
+
+	// Dependencies must still match
+	kit.Spec.Dependencies = []string{"camel-irc"}
+	ok, err = IntegrationMatches(integration, kit, NewOptions(dependenciesOnly))
+	assert.Nil(t, err)
+	assert.False(t, ok)
+}
+
+func TestIntegrationMatches_PlatformGeneration(t *testing.T) {
+	integration := &v1.Integration{
+		Status: v1.IntegrationStatus{
+			Dependencies: []string{"camel-core"},
+		},
+	}
+	kit := &v1.IntegrationKit{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels: map[string]string{
+				v1.IntegrationKitPlatformGenerationLabel: "1",
+			},
+		},
+		Spec: v1.IntegrationKitSpec{
+			Dependencies: []string{"camel-core"},
+		},
+	}
+
+	pl := &v1.IntegrationPlatform{}
+	pl.Generation = 2
+	ok, err := IntegrationMatches(integration, kit, NewOptions(pl))
+	assert.Nil(t, err)
+	assert.True(t, ok)
+
+	pl.Status.Build.KitMatching.MatchPlatformGeneration = true
+	ok, err = IntegrationMatches(integration, kit, NewOptions(pl))
+	assert.Nil(t, err)
+	assert.False(t, ok)
+
+	kit.Labels[v1.IntegrationKitPlatformGenerationLabel] = "2"
+	ok, err = IntegrationMatches(integration, kit, NewOptions(pl))
+	assert.Nil(t, err)
+	assert.True(t, ok)
+}
+
+func TestIntegrationMatches_ExtraDependenciesTolerance(t *testing.T) {
+	integration := &v1.Integration{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-integration",
+		},
+		Status: v1.IntegrationStatus{
+			Dependencies: []string{"camel-core", "camel-irc"},
+		},
+	}
+
+	kit := func(dependencies ...string) *v1.IntegrationKit {
+		return &v1.IntegrationKit{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "ns",
+				Name:      "my-kit",
+			},
+			Spec: v1.IntegrationKitSpec{
+				Dependencies: dependencies,
+			},
+		}
+	}
+
+	platform := func(allow *bool, tolerance int) *v1.IntegrationPlatform {
+		pl := &v1.IntegrationPlatform{}
+		pl.Status.Build.KitMatching.AllowExtraDependencies = allow
+		pl.Status.Build.KitMatching.ExtraDependenciesTolerance = tolerance
+		return pl
+	}
+
+	exact := kit("camel-core", "camel-irc")
+	oneExtra := kit("camel-core", "camel-irc", "camel-http")
+	twoExtra := kit("camel-core", "camel-irc", "camel-http", "camel-log")
+
+	assert.Equal(t, []string{}, ExtraDependencies(exact, integration))
+	assert.Equal(t, []string{"camel-http", "camel-log"}, ExtraDependencies(twoExtra, integration))
+
+	tests := []struct {
+		platform *v1.IntegrationPlatform
+		kit      *v1.IntegrationKit
+		match    bool
+	}{
+		{nil, twoExtra, true},
+		{platform(pointer.Bool(true), 0), twoExtra, true},
+		{platform(pointer.Bool(false), 0), exact, true},
+		{platform(pointer.Bool(false), 0), oneExtra, false},
+		{platform(pointer.Bool(false), 1), oneExtra, true},
+		{platform(pointer.Bool(false), 1), twoExtra, false},
+		{platform(pointer.Bool(false), 2), twoExtra, true},
+	}
+
+	for i, tc := range tests {
+		ok, err := IntegrationMatches(integration, tc.kit, NewOptions(tc.platform))
+		assert.Nil(t, err)
+		assert.Equal(t, tc.match, ok, "test %d", i)
+	}
+}
+
+func TestIntegrationMatches_ExcludeInvalidKits(t *testing.T) {
+	integration := &v1.Integration{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-integration",
+		},
+		Status: v1.IntegrationStatus{
+			Dependencies: []string{"camel-core"},
+		},
+	}
+	// The kit status version is empty while it's ready
+	kit := &v1.IntegrationKit{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-kit",
+		},
+		Spec: v1.IntegrationKitSpec{
+			Dependencies: []string{"camel-core"},
+		},
+		Status: v1.IntegrationKitStatus{
+			Phase: v1.IntegrationKitPhaseReady,
+		},
+	}
+	assert.NotNil(t, kit.Validate())
+
+	pl := &v1.IntegrationPlatform{}
+	ok, err := IntegrationMatches(integration, kit, NewOptions(pl))
+	assert.Nil(t, err)
+	assert.True(t, ok)
+
+	pl.Status.Build.KitMatching.ExcludeInvalid = true
+	ok, err = IntegrationMatches(integration, kit, NewOptions(pl))
+	assert.Nil(t, err)
+	assert.False(t, ok)
+
+	decision, err := evaluateKit(integration, kit, NewOptions(pl))
+	assert.Nil(t, err)
+	assert.Equal(t, "Integration kit status is inconsistent", decision.Reason)
+	assert.Equal(t, []string{"status version is empty"}, decision.Details)
+
+	// Consistent kits are still matched
+	kit.Status.Version = "1.10.0"
+	integration.Status.Version = "1.10.0"
+	ok, err = IntegrationMatches(integration, kit, NewOptions(pl))
+	assert.Nil(t, err)
+	assert.True(t, ok)
+}
+
+func TestCanonicalDependency(t *testing.T) {
+	assert.Equal(t, "camel:core", CanonicalDependency("camel:core"))
+	assert.Equal(t, "mvn:org.my:lib:jar::1.0", CanonicalDependency("mvn:org.my:lib:1.0"))
+	assert.Equal(t, "mvn:org.my:lib:jar::1.0", CanonicalDependency("mvn:org.my:lib:jar:1.0"))
+	assert.Equal(t, "mvn:org.my:lib:jar:tests:1.0", CanonicalDependency("mvn:org.my:lib:jar:tests:1.0"))
+}
+
+func TestMissingDependencies_Classifiers(t *testing.T) {
+	kit := &v1.IntegrationKit{
+		Spec: v1.IntegrationKitSpec{
+			Dependencies: []string{
+				"mvn:org.my:lib:1.0",
+				"mvn:org.my:other:jar:linux-x86_64:1.0",
+			},
+		},
+	}
+	integration := func(dependencies ...string) *v1.Integration {
+		return &v1.Integration{
+			Status: v1.IntegrationStatus{
+				Dependencies: dependencies,
+			},
+		}
+	}
+
+	// Equivalent coordinates, with or without the default packaging type
+	assert.Empty(t, MissingDependencies(kit, integration("mvn:org.my:lib:jar:1.0")))
+	assert.Empty(t, MissingDependencies(kit, integration("mvn:org.my:other:jar:linux-x86_64:1.0")))
+	// Classifier presence or absence
+	assert.Equal(t, []string{"mvn:org.my:lib:jar:tests:1.0"}, MissingDependencies(kit, integration("mvn:org.my:lib:jar:tests:1.0")))
+	assert.Equal(t, []string{"mvn:org.my:other:1.0"}, MissingDependencies(kit, integration("mvn:org.my:other:1.0")))
+	// Differing classifiers
+	assert.Equal(t, []string{"mvn:org.my:other:jar:osx-x86_64:1.0"}, MissingDependencies(kit, integration("mvn:org.my:other:jar:osx-x86_64:1.0")))
+
+	assert.Empty(t, ExtraDependencies(kit, integration("mvn:org.my:lib:jar:1.0", "mvn:org.my:other:jar:linux-x86_64:1.0")))
+}
+
+func TestIntegrationMatches_DifferentSources(t *testing.T) {
+	newIntegration := func(name string, source v1.SourceSpec) *v1.Integration {
+		return &v1.Integration{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "ns",
+				Name:      name,
+			},
+			Spec: v1.IntegrationSpec{
+				Sources: []v1.SourceSpec{source},
+			},
+			Status: v1.IntegrationStatus{
+				Version:         "1.0.0",
+				RuntimeVersion:  "1.17.0",
+				RuntimeProvider: v1.RuntimeProviderQuarkus,
+				Dependencies:    []string{"camel:core", "camel:timer", "camel:log"},
+			},
+		}
+	}
+	kit := &v1.IntegrationKit{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-kit",
+		},
+		Spec: v1.IntegrationKitSpec{
+			Dependencies: []string{"camel:core", "camel:timer", "camel:log"},
+		},
+		Status: v1.IntegrationKitStatus{
+			Phase:           v1.IntegrationKitPhaseReady,
+			Version:         "1.0.0",
+			RuntimeVersion:  "1.17.0",
+			RuntimeProvider: v1.RuntimeProviderQuarkus,
+		},
+	}
+
+	i1 := newIntegration("my-integration", v1.SourceSpec{
+		DataSpec: v1.DataSpec{
+			Name:    "routes.yaml",
+			Content: "- from:\n    uri: timer:tick\n    steps:\n      - to: log:info\n",
+		},
+	})
+	i2 := newIntegration("other-integration", v1.SourceSpec{
+		DataSpec: v1.DataSpec{
+			Name:    "Routes.java",
+			Content: "from(\"timer:clock\").to(\"log:debug\");",
+		},
+		Language: v1.LanguageJavaSource,
+	})
+
+	for _, integration := range []*v1.Integration{i1, i2} {
+		match, err := IntegrationMatches(integration, kit, DefaultOptions())
+		assert.Nil(t, err)
+		assert.True(t, match)
+	}
+
+	k1, err := MatchKey(i1)
+	assert.Nil(t, err)
+	k2, err := MatchKey(i2)
+	assert.Nil(t, err)
+	assert.Equal(t, k1, k2)
+}
+
+func TestHasMatchingTraits_ExplicitFieldsMode(t *testing.T) {
+	traits := v1.Traits{
+		Builder: &traitv1.BuilderTrait{
+			Trait: traitv1.Trait{
+				Enabled: pointer.Bool(true),
+			},
+			Properties: []string{"build-key1=build-value1"},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		traits         *v1.Traits
+		kitTraits      v1.IntegrationKitTraits
+		exact          bool
+		explicitFields bool
+		union          bool
+	}{
+		{
+			name: "field unset on the kit",
+			kitTraits: v1.IntegrationKitTraits{
+				Builder: &traitv1.BuilderTrait{
+					Properties: []string{"build-key1=build-value1"},
+				},
+			},
+			exact:          false,
+			explicitFields: true,
+			union:          true,
+		},
+		{
+			name: "field set on the kit only",
+			traits: &v1.Traits{
+				Builder: &traitv1.BuilderTrait{
+					Properties: []string{"build-key1=build-value1"},
+				},
+			},
+			kitTraits: v1.IntegrationKitTraits{
+				Builder: &traitv1.BuilderTrait{
+					Trait: traitv1.Trait{
+						Enabled: pointer.Bool(true),
+					},
+					Properties: []string{"build-key1=build-value1"},
+				},
+			},
+			exact:          false,
+			explicitFields: true,
+			union:          true,
+		},
+		{
+			name: "field set on both sides with different values",
+			kitTraits: v1.IntegrationKitTraits{
+				Builder: &traitv1.BuilderTrait{
+					Properties: []string{"build-key1=build-value2"},
+				},
+			},
+			exact:          false,
+			explicitFields: false,
+			union:          false,
+		},
+		{
+			name:           "trait unset on the kit",
+			kitTraits:      v1.IntegrationKitTraits{},
+			exact:          false,
+			explicitFields: false,
+			union:          false,
+		},
+		{
+			name:   "trait set on the kit only",
+			traits: &v1.Traits{},
+			kitTraits: v1.IntegrationKitTraits{
+				Builder: &traitv1.BuilderTrait{
+					Properties: []string{"build-key1=build-value1"},
+				},
+			},
+			exact:          false,
+			explicitFields: false,
+			union:          false,
+		},
+		{
+			name: "trait set on the kit only with the defaults",
+			kitTraits: v1.IntegrationKitTraits{
+				Builder: &traitv1.BuilderTrait{
+					Trait: traitv1.Trait{
+						Enabled: pointer.Bool(true),
+					},
+					Properties: []string{"build-key1=build-value1"},
+				},
+				Quarkus: &traitv1.QuarkusTrait{
+					PackageTypes: []traitv1.QuarkusPackageType{traitv1.FastJarPackageType},
+				},
+			},
+			exact:          false,
+			explicitFields: false,
+			union:          true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			traits := traits
+			if test.traits != nil {
+				traits = *test.traits
+			}
+
+			ok, err := HasMatchingTraits(traits, test.kitTraits, v1.IntegrationKitTraitMatchModeExact)
+			assert.Nil(t, err)
+			assert.Equal(t, test.exact, ok)
+
+			ok, err = HasMatchingTraits(traits, test.kitTraits, v1.IntegrationKitTraitMatchModeExplicitFields)
+			assert.Nil(t, err)
+			assert.Equal(t, test.explicitFields, ok)
+
+			ok, err = HasMatchingTraits(traits, test.kitTraits, v1.IntegrationKitTraitMatchModeUnion)
+			assert.Nil(t, err)
+			assert.Equal(t, test.union, ok)
+		})
+	}
+}
+
+func TestCommonFields(t *testing.T) {
+	it := map[string]interface{}{
+		"enabled": true,
+		"options": map[string]interface{}{
+			"level":  "debug",
+			"stream": "stdout",
+		},
+		"tags": []interface{}{"a"},
+	}
+	kt := map[string]interface{}{
+		"options": map[string]interface{}{
+			"stream": "stdout",
+			"format": "json",
+		},
+		"tags":  []interface{}{"a"},
+		"extra": "value",
+	}
+
+	// The common fields are only restricted at the top level, so that the nested objects differ
+	explicitIt, explicitKt := commonFields(it, kt, false)
+	assert.False(t, matchesTrait(explicitIt, explicitKt))
+
+	// Unless the nested objects are restricted as well
+	commonIt, commonKt := commonFields(it, kt, true)
+	assert.True(t, matchesTrait(commonIt, commonKt))
+	expected := map[string]interface{}{
+		"options": map[string]interface{}{
+			"stream": "stdout",
+		},
+		"tags": []interface{}{"a"},
+	}
+	assert.Equal(t, expected, commonIt)
+	assert.Equal(t, expected, commonKt)
+
+	// The fields set on both sides are still compared
+	kt["tags"] = []interface{}{"b"}
+	commonIt, commonKt = commonFields(it, kt, true)
+	assert.False(t, matchesTrait(commonIt, commonKt))
+}
+
+func TestMatching_NilAndEmptyDependencies(t *testing.T) {
+	combinations := []struct {
+		name                    string
+		integrationDependencies []string
+		kitDependencies         []string
+	}{
+		{name: "nil/nil", integrationDependencies: nil, kitDependencies: nil},
+		{name: "nil/empty", integrationDependencies: nil, kitDependencies: []string{}},
+		{name: "empty/nil", integrationDependencies: []string{}, kitDependencies: nil},
+		{name: "empty/empty", integrationDependencies: []string{}, kitDependencies: []string{}},
+	}
+
+	for _, c := range combinations {
+		t.Run(c.name, func(t *testing.T) {
+			integration := &v1.Integration{
+				Status: v1.IntegrationStatus{
+					Version:      defaults.Version,
+					Dependencies: c.integrationDependencies,
+				},
+			}
+			kit := &v1.IntegrationKit{
+				Spec: v1.IntegrationKitSpec{
+					Dependencies: c.kitDependencies,
+				},
+				Status: v1.IntegrationKitStatus{
+					Phase:   v1.IntegrationKitPhaseReady,
+					Version: defaults.Version,
+				},
+			}
+
+			assert.Equal(t, []string{}, MissingDependencies(kit, integration))
+			assert.Equal(t, []string{}, ExtraDependencies(kit, integration))
+
+			match, err := IntegrationMatches(integration, kit, DefaultOptions())
+			assert.Nil(t, err)
+			assert.True(t, match)
+
+			// Extra dependencies are not tolerated
+			pl := v1.NewIntegrationPlatform("ns", "camel-k")
+			pl.Status.Build.KitMatching.AllowExtraDependencies = pointer.Bool(false)
+			match, err = IntegrationMatches(integration, kit, NewOptions(&pl))
+			assert.Nil(t, err)
+			assert.True(t, match)
+
+			other := &v1.IntegrationKit{
+				Spec: v1.IntegrationKitSpec{
+					Dependencies: c.integrationDependencies,
+				},
+			}
+			match, err = KitMatches(integration, other, kit, DefaultOptions())
+			assert.Nil(t, err)
+			assert.True(t, match)
+		})
+	}
+}
+
+func TestHasMatchingTraits_NonInfluencingFields(t *testing.T) {
+	traits := v1.Traits{
+		Builder: &traitv1.BuilderTrait{
+			Properties: []string{"build-key1=build-value1"},
+		},
+	}
+	kitTraits := v1.IntegrationKitTraits{
+		Builder: &traitv1.BuilderTrait{
+			Verbose:    pointer.Bool(true),
+			Properties: []string{"build-key1=build-value1"},
+		},
+	}
+
+	// Only the build verbosity differs
+	ok, err := HasMatchingTraits(traits, kitTraits, v1.IntegrationKitTraitMatchModeExact)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+
+	traits.Builder.Verbose = pointer.Bool(false)
+	ok, err = HasMatchingTraits(traits, kitTraits, v1.IntegrationKitTraitMatchModeExact)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+
+	// The influencing fields still have to match
+	kitTraits.Builder.Properties = []string{"build-key1=build-value2"}
+	ok, err = HasMatchingTraits(traits, kitTraits, v1.IntegrationKitTraitMatchModeExact)
+	assert.Nil(t, err)
+	assert.False(t, ok)
+}
+
+func TestRuntimeVersionMatches(t *testing.T) {
+	testCases := []struct {
+		version     string
+		kitVersion  string
+		prefixMatch bool
+		match       bool
+	}{
+		{version: "1.17.0", kitVersion: "1.17.0", match: true},
+		{version: "1.17", kitVersion: "1.17.2", match: false},
+		{version: "1.17", kitVersion: "1.17.2", prefixMatch: true, match: true},
+		{version: "1.17.", kitVersion: "1.17.2", prefixMatch: true, match: true},
+		{version: "1.17", kitVersion: "1.170.0", prefixMatch: true, match: false},
+		{version: "1.17", kitVersion: "1.18.0", prefixMatch: true, match: false},
+		{version: "1.17.*", kitVersion: "1.17.2", prefixMatch: true, match: true},
+		{version: "1.17.*", kitVersion: "1.18.0", prefixMatch: true, match: false},
+		{version: "1.1?.0", kitVersion: "1.17.0", prefixMatch: true, match: true},
+		{version: "", kitVersion: "1.17.0", prefixMatch: true, match: false},
+	}
+
+	for _, tc := range testCases {
+		assert.Equal(t, tc.match, runtimeVersionMatches(tc.version, tc.kitVersion, tc.prefixMatch),
+			"version %q, kit version %q, prefix match %t", tc.version, tc.kitVersion, tc.prefixMatch)
+	}
+}
+
+func TestIntegrationMatches_CachedInfluencingTraits(t *testing.T) {
+	integration, kits := manyKits(50)
+
+	cached := DefaultOptions()
+	cached.influencingTraits = KitInfluencingTraits()
+	for i := range kits {
+		expected, err := IntegrationMatches(integration, &kits[i], DefaultOptions())
+		assert.Nil(t, err)
+		match, err := IntegrationMatches(integration, &kits[i], cached)
+		assert.Nil(t, err)
+		assert.Equal(t, expected, match, "kit %s", kits[i].Name)
+	}
+}
+
+func BenchmarkMatchManyKits(b *testing.B) {
+	integration, kits := manyKits(100)
+	b.Run("uncached", func(b *testing.B) {
+		b.ReportAllocs()
+		for n := 0; n < b.N; n++ {
+			options := DefaultOptions()
+			for i := range kits {
+				if _, err := IntegrationMatches(integration, &kits[i], options); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+	b.Run("cached", func(b *testing.B) {
+		b.ReportAllocs()
+		for n := 0; n < b.N; n++ {
+			options := DefaultOptions()
+			options.influencingTraits = KitInfluencingTraits()
+			for i := range kits {
+				if _, err := IntegrationMatches(integration, &kits[i], options); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+}
+
+// manyKits returns an integration and the given number of kits, with traits matching the integration ones or not.
+func manyKits(count int) (*v1.Integration, []v1.IntegrationKit) {
+	integration := &v1.Integration{
+		Spec: v1.IntegrationSpec{
+			Traits: v1.Traits{
+				Builder: &traitv1.BuilderTrait{
+					Properties: []string{"build-key1=build-value1"},
+				},
+			},
+		},
+		Status: v1.IntegrationStatus{
+			Dependencies: []string{"camel:core", "camel:log"},
+		},
+	}
+	kits := make([]v1.IntegrationKit, 0, count)
+	for i := 0; i < count; i++ {
+		kit := v1.IntegrationKit{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: fmt.Sprintf("my-kit-%d", i),
+			},
+			Spec: v1.IntegrationKitSpec{
+				Dependencies: []string{"camel:core", "camel:log"},
+				Traits: v1.IntegrationKitTraits{
+					Builder: &traitv1.BuilderTrait{
+						Properties: []string{fmt.Sprintf("build-key1=build-value%d", i%2+1)},
+					},
+				},
+			},
+			Status: v1.IntegrationKitStatus{
+				Phase: v1.IntegrationKitPhaseReady,
+			},
+		}
+		kits = append(kits, kit)
+	}
+
+	return integration, kits
+}
+
+func TestMatching_IdentityLabels(t *testing.T) {
+	integration := &v1.Integration{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels: map[string]string{
+				"team":        "integration",
+				"cost-center": "1234",
+			},
+		},
+		Status: v1.IntegrationStatus{
+			Version:      defaults.Version,
+			Dependencies: []string{"camel:core"},
+		},
+	}
+	kit := &v1.IntegrationKit{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels: map[string]string{
+				"team":        "integration",
+				"cost-center": "1234",
+			},
+		},
+		Spec: v1.IntegrationKitSpec{
+			Dependencies: []string{"camel:core"},
+		},
+		Status: v1.IntegrationKitStatus{
+			Phase:   v1.IntegrationKitPhaseReady,
+			Version: defaults.Version,
+		},
+	}
+
+	options := DefaultOptions()
+	options.IdentityLabels = []string{"team", "cost-center"}
+
+	match, err := IntegrationMatches(integration, kit, options)
+	assert.Nil(t, err)
+	assert.True(t, match)
+	match, err = KitMatches(integration, kit.DeepCopy(), kit, options)
+	assert.Nil(t, err)
+	assert.True(t, match)
+
+	// The identity label has changed
+	integration.Labels["cost-center"] = "5678"
+	other := kit.DeepCopy()
+	other.Labels["cost-center"] = "5678"
+
+	match, err = IntegrationMatches(integration, kit, options)
+	assert.Nil(t, err)
+	assert.False(t, match)
+	match, err = KitMatches(integration, other, kit, options)
+	assert.Nil(t, err)
+	assert.False(t, match)
+
+	// The identity label has been removed
+	delete(integration.Labels, "team")
+	match, err = IntegrationMatches(integration, other, options)
+	assert.Nil(t, err)
+	assert.False(t, match)
+
+	// The labels that are not part of the identity are ignored
+	match, err = IntegrationMatches(integration, other, DefaultOptions())
+	assert.Nil(t, err)
+	assert.True(t, match)
+}
+
+func TestKitMatches_DefaultVersion(t *testing.T) {
+	kit := func(version string) *v1.IntegrationKit {
+		return &v1.IntegrationKit{
+			Spec: v1.IntegrationKitSpec{
+				Dependencies: []string{"camel:core"},
+			},
+			Status: v1.IntegrationKitStatus{
+				Version: version,
+			},
+		}
+	}
+
+	testCases := []struct {
+		version1 string
+		version2 string
+		match    bool
+	}{
+		{version1: "", version2: "", match: true},
+		{version1: "", version2: defaults.Version, match: true},
+		{version1: defaults.Version, version2: "", match: true},
+		{version1: "", version2: "0.0.1", match: false},
+		{version1: "0.0.1", version2: "", match: false},
+	}
+
+	integration := &v1.Integration{
+		Status: v1.IntegrationStatus{
+			Dependencies: []string{"camel:core"},
+		},
+	}
+
+	for _, tc := range testCases {
+		match, err := KitMatches(integration, kit(tc.version1), kit(tc.version2), DefaultOptions())
+		assert.Nil(t, err)
+		assert.Equal(t, tc.match, match, "versions %q and %q", tc.version1, tc.version2)
+	}
+}
+
+func TestKitMatches_Options(t *testing.T) {
+	integration := &v1.Integration{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-integration",
+		},
+		Spec: v1.IntegrationSpec{
+			Traits: v1.Traits{
+				Builder: &traitv1.BuilderTrait{
+					Properties: []string{"build-key1=build-value1"},
+				},
+			},
+		},
+		Status: v1.IntegrationStatus{
+			Dependencies: []string{"camel-core"},
+		},
+	}
+
+	kit := &v1.IntegrationKit{
+		Spec: v1.IntegrationKitSpec{
+			Dependencies: []string{"camel-core"},
+			Traits: v1.IntegrationKitTraits{
+				Builder: &traitv1.BuilderTrait{
+					Properties: []string{"build-key1=build-value1"},
+				},
+			},
+		},
+	}
+	existing := &v1.IntegrationKit{
+		Spec: v1.IntegrationKitSpec{
+			Dependencies: []string{"camel-core", "camel-irc"},
+			Traits: v1.IntegrationKitTraits{
+				Builder: &traitv1.BuilderTrait{
+					Properties: []string{"build-key1=build-value2"},
+				},
+			},
+		},
+	}
+
+	match, err := KitMatches(integration, kit, existing, DefaultOptions())
+	assert.Nil(t, err)
+	assert.False(t, match)
+
+	// The kits are selected with the same rules they are looked up with
+	dependenciesOnly := &v1.IntegrationPlatform{}
+	dependenciesOnly.Status.Build.KitMatching.Mode = v1.IntegrationKitMatchModeDependenciesOnly
+	match, err = KitMatches(integration, kit, existing, NewOptions(dependenciesOnly))
+	assert.Nil(t, err)
+	assert.True(t, match)
+	ok, err := IntegrationMatches(integration, existing, NewOptions(dependenciesOnly))
+	assert.Nil(t, err)
+	assert.True(t, ok)
+
+	// The packaging of the kits must still match
+	native := kit.DeepCopy()
+	native.Labels = map[string]string{v1.IntegrationKitLayoutLabel: v1.IntegrationKitLayoutNative}
+	match, err = KitMatches(integration, native, existing, NewOptions(dependenciesOnly))
+	assert.Nil(t, err)
+	assert.False(t, match)
+}
+
+func TestHasMatchingTraits_NilMaps(t *testing.T) {
+	testCases := []struct {
+		name      string
+		traits    v1.Traits
+		kitTraits v1.IntegrationKitTraits
+		match     bool
+	}{
+		{
+			name:  "both sides without traits",
+			match: true,
+		},
+		{
+			name: "integration without traits",
+			kitTraits: v1.IntegrationKitTraits{
+				Builder: &traitv1.BuilderTrait{
+					Properties: []string{"build-key1=build-value1"},
+				},
+			},
+			match: false,
+		},
+		{
+			name: "kit without traits",
+			traits: v1.Traits{
+				Builder: &traitv1.BuilderTrait{
+					Properties: []string{"build-key1=build-value1"},
+				},
+			},
+			match: false,
+		},
+		{
+			name: "both sides with traits",
+			traits: v1.Traits{
+				Builder: &traitv1.BuilderTrait{
+					Properties: []string{"build-key1=build-value1"},
+				},
+			},
+			kitTraits: v1.IntegrationKitTraits{
+				Builder: &traitv1.BuilderTrait{
+					Properties: []string{"build-key1=build-value1"},
+				},
+			},
+			match: true,
+		},
+		{
+			name: "both sides with empty traits",
+			traits: v1.Traits{
+				Quarkus: &traitv1.QuarkusTrait{},
+			},
+			kitTraits: v1.IntegrationKitTraits{
+				Quarkus: &traitv1.QuarkusTrait{},
+			},
+			match: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ok, err := HasMatchingTraits(tc.traits, tc.kitTraits, v1.IntegrationKitTraitMatchModeExact)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.match, ok)
+		})
+	}
+}
+
+func TestFindTrait_NilMaps(t *testing.T) {
+	config, ok := findTrait(nil, "builder")
+	assert.False(t, ok)
+	assert.Nil(t, config)
+
+	// A trait or an addon declared without any field, e.g. serialized as null
+	traitsMap := map[string]map[string]interface{}{
+		"builder": nil,
+		"addons": {
+			"master": nil,
+		},
+	}
+	config, ok = findTrait(traitsMap, "builder")
+	assert.True(t, ok)
+	assert.Equal(t, map[string]interface{}{}, config)
+	config, ok = findTrait(traitsMap, "master")
+	assert.True(t, ok)
+	assert.Equal(t, map[string]interface{}{}, config)
+	config, ok = findTrait(traitsMap, "quarkus")
+	assert.False(t, ok)
+	assert.Nil(t, config)
+
+	traitsMap["addons"] = nil
+	config, ok = findTrait(traitsMap, "master")
+	assert.False(t, ok)
+	assert.Nil(t, config)
+}
+
+// kitAddonTrait is an addon trait that influences the kit.
+type kitAddonTrait struct {
+	trait.BaseTrait `property:",squash"`
+}
+
+func (t *kitAddonTrait) Configure(e *trait.Environment) (bool, error) {
+	return true, nil
+}
+
+func (t *kitAddonTrait) Apply(e *trait.Environment) error {
+	return nil
+}
+
+func (t *kitAddonTrait) InfluencesKit() bool {
+	return true
+}
+
+func TestIntegrationMatches_NonInfluencingAddons(t *testing.T) {
+	integration := &v1.Integration{
+		Spec: v1.IntegrationSpec{
+			Traits: v1.Traits{
+				Addons: map[string]v1.AddonTrait{
+					"my-addon": trait.ToAddonTrait(t, map[string]interface{}{"key": "value1"}),
+				},
+			},
+		},
+	}
+	kit := &v1.IntegrationKit{
+		Spec: v1.IntegrationKitSpec{
+			Traits: v1.IntegrationKitTraits{
+				Addons: map[string]v1.AddonTrait{
+					"my-addon": trait.ToAddonTrait(t, map[string]interface{}{"key": "value2"}),
+				},
+			},
+		},
+		Status: v1.IntegrationKitStatus{
+			Phase: v1.IntegrationKitPhaseReady,
+		},
+	}
+	addon := &kitAddonTrait{BaseTrait: trait.NewBaseTrait("my-addon", 2000)}
+
+	options := DefaultOptions()
+	options.influencingTraits = append(KitInfluencingTraits(), addon)
+	match, err := IntegrationMatches(integration, kit, options)
+	assert.Nil(t, err)
+	assert.False(t, match)
+
+	options.NonInfluencingAddons = []string{"my-addon"}
+	match, err = IntegrationMatches(integration, kit, options)
+	assert.Nil(t, err)
+	assert.True(t, match)
+}
+
+// portAddonTrait is an addon trait, that influences the kit, with a configuration.
+type portAddonTrait struct {
+	kitAddonTrait `property:",squash"`
+	Port          int      `property:"port" json:"port,omitempty"`
+	Tags          []string `property:"tags" json:"tags,omitempty"`
+}
+
+func TestIntegrationMatches_CanonicalTraits(t *testing.T) {
+	addon := &portAddonTrait{kitAddonTrait: kitAddonTrait{BaseTrait: trait.NewBaseTrait("my-addon", 2000)}}
+	newIntegration := func(config map[string]interface{}) *v1.Integration {
+		return &v1.Integration{
+			Spec: v1.IntegrationSpec{
+				Traits: v1.Traits{
+					Addons: map[string]v1.AddonTrait{
+						"my-addon": trait.ToAddonTrait(t, config),
+					},
+				},
+			},
+		}
+	}
+	kit := &v1.IntegrationKit{
+		Spec: v1.IntegrationKitSpec{
+			Traits: v1.IntegrationKitTraits{
+				Addons: map[string]v1.AddonTrait{
+					"my-addon": trait.ToAddonTrait(t, map[string]interface{}{"port": 8080}),
+				},
+			},
+		},
+		Status: v1.IntegrationKitStatus{
+			Phase: v1.IntegrationKitPhaseReady,
+		},
+	}
+
+	testCases := []struct {
+		name      string
+		config    map[string]interface{}
+		raw       bool
+		canonical bool
+	}{
+		{
+			name:      "equal configurations",
+			config:    map[string]interface{}{"port": 8080},
+			raw:       true,
+			canonical: true,
+		},
+		{
+			name:      "empty field",
+			config:    map[string]interface{}{"port": 8080, "tags": []string{}},
+			raw:       false,
+			canonical: true,
+		},
+		{
+			name:      "unknown field",
+			config:    map[string]interface{}{"port": 8080, "unknown": "value"},
+			raw:       false,
+			canonical: true,
+		},
+		{
+			name:      "null field",
+			config:    map[string]interface{}{"port": 8080, "tags": nil},
+			raw:       false,
+			canonical: true,
+		},
+		{
+			name:      "other port",
+			config:    map[string]interface{}{"port": 8081},
+			raw:       false,
+			canonical: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			options := DefaultOptions()
+			options.influencingTraits = append(KitInfluencingTraits(), addon)
+
+			match, err := IntegrationMatches(newIntegration(tc.config), kit, options)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.raw, match)
+
+			options.CanonicalTraits = true
+			match, err = IntegrationMatches(newIntegration(tc.config), kit, options)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.canonical, match)
+		})
+	}
+}
+
+func TestIntegrationMatches_TraitOverrides(t *testing.T) {
+	integration := &v1.Integration{
+		Spec: v1.IntegrationSpec{
+			Traits: v1.Traits{
+				Builder: &traitv1.BuilderTrait{
+					Properties: []string{"key=value1"},
+				},
+				Jolokia: &traitv1.JolokiaTrait{
+					Port: 8778,
+				},
+			},
+		},
+	}
+	kit := &v1.IntegrationKit{
+		Spec: v1.IntegrationKitSpec{
+			Traits: v1.IntegrationKitTraits{
+				Builder: &traitv1.BuilderTrait{
+					Properties: []string{"key=value1"},
+				},
+			},
+		},
+		Status: v1.IntegrationKitStatus{
+			Phase: v1.IntegrationKitPhaseReady,
+		},
+	}
+	options := DefaultOptions()
+
+	match, err := IntegrationMatches(integration, kit, options)
+	assert.Nil(t, err)
+	assert.True(t, match)
+
+	// The jolokia trait, that does not influence the kits, is added to the traits compared when matching
+	options.InfluencingTraits = []string{"jolokia"}
+	match, err = IntegrationMatches(integration, kit, options)
+	assert.Nil(t, err)
+	assert.False(t, match)
+
+	integration.Spec.Traits.Jolokia = nil
+	integration.Spec.Traits.Builder.Properties = []string{"key=value2"}
+	match, err = IntegrationMatches(integration, kit, options)
+	assert.Nil(t, err)
+	assert.False(t, match)
+
+	// The builder trait, that influences the kits, is removed from the traits compared when matching
+	options.NonInfluencingTraits = []string{"builder"}
+	match, err = IntegrationMatches(integration, kit, options)
+	assert.Nil(t, err)
+	assert.True(t, match)
+
+	// The cached traits are filtered as well
+	options.CacheInfluencingTraits()
+	match, err = IntegrationMatches(integration, kit, options)
+	assert.Nil(t, err)
+	assert.True(t, match)
+}
+
+func TestIntegrationMatches_SchedulingTraits(t *testing.T) {
+	integration := &v1.Integration{
+		Spec: v1.IntegrationSpec{
+			Traits: v1.Traits{
+				Builder: &traitv1.BuilderTrait{
+					Properties: []string{"key=value"},
+				},
+				Affinity: &traitv1.AffinityTrait{
+					NodeAffinityLabels: []string{"kubernetes.io/hostname in(node1)"},
+				},
+				Toleration: &traitv1.TolerationTrait{
+					Taints: []string{"node-role.kubernetes.io/master:NoSchedule"},
+				},
+			},
+		},
+	}
+	kit := &v1.IntegrationKit{
+		Spec: v1.IntegrationKitSpec{
+			Traits: v1.IntegrationKitTraits{
+				Builder: &traitv1.BuilderTrait{
+					Properties: []string{"key=value"},
+				},
+			},
+		},
+		Status: v1.IntegrationKitStatus{
+			Phase: v1.IntegrationKitPhaseReady,
+		},
+	}
+	kitTraits := v1.Traits{
+		Builder: &traitv1.BuilderTrait{
+			Properties: []string{"key=value"},
+		},
+		Affinity: &traitv1.AffinityTrait{
+			NodeAffinityLabels: []string{"kubernetes.io/hostname in(node2)"},
+		},
+	}
+
+	match, err := IntegrationMatches(integration, kit, DefaultOptions())
+	assert.Nil(t, err)
+	assert.True(t, match)
+
+	match, err = HasMatchingTraits(integration.Spec.Traits, kitTraits, v1.IntegrationKitTraitMatchModeExact)
+	assert.Nil(t, err)
+	assert.True(t, match)
+
+	// The scheduling traits are not compared, even when configured to be
+	options := DefaultOptions()
+	options.InfluencingTraits = []string{"affinity", "toleration"}
+	match, err = IntegrationMatches(integration, kit, options)
+	assert.Nil(t, err)
+	assert.True(t, match)
+
+	options.CacheInfluencingTraits()
+	match, err = IntegrationMatches(integration, kit, options)
+	assert.Nil(t, err)
+	assert.True(t, match)
+
+	// The other traits are still compared
+	integration.Spec.Traits.Builder.Properties = []string{"key=other"}
+	match, err = IntegrationMatches(integration, kit, options)
+	assert.Nil(t, err)
+	assert.False(t, match)
+}
+
+func TestIntegrationMatches_RegistryTrait(t *testing.T) {
+	integration := &v1.Integration{
+		Spec: v1.IntegrationSpec{
+			Traits: v1.Traits{
+				Registry: &traitv1.RegistryTrait{
+					Trait: traitv1.Trait{
+						Enabled: pointer.Bool(true),
+					},
+				},
+			},
+		},
+		Status: v1.IntegrationStatus{
+			Dependencies: []string{"registry-mvn:my-registry/my-org/my-artifact:1.0"},
+		},
+	}
+	kit := &v1.IntegrationKit{
+		Spec: v1.IntegrationKitSpec{
+			Dependencies: []string{"registry-mvn:my-registry/my-org/my-artifact:1.0"},
+		},
+		Status: v1.IntegrationKitStatus{
+			Phase: v1.IntegrationKitPhaseReady,
+		},
+	}
+
+	// The registry trait only configures where the artifacts are resolved from during the build
+	match, err := IntegrationMatches(integration, kit, DefaultOptions())
+	assert.Nil(t, err)
+	assert.True(t, match)
+
+	kit.Spec.Traits.Registry = &traitv1.RegistryTrait{
+		Trait: traitv1.Trait{
+			Enabled: pointer.Bool(false),
+		},
+	}
+	match, err = IntegrationMatches(integration, kit, DefaultOptions())
+	assert.Nil(t, err)
+	assert.True(t, match)
+
+	match, err = HasMatchingTraits(integration.Spec.Traits, kit.Spec.Traits, v1.IntegrationKitTraitMatchModeExact)
+	assert.Nil(t, err)
+	assert.True(t, match)
+
+	// Even when configured to be compared
+	options := DefaultOptions()
+	options.InfluencingTraits = []string{"registry"}
+	options.CacheInfluencingTraits()
+	match, err = IntegrationMatches(integration, kit, options)
+	assert.Nil(t, err)
+	assert.True(t, match)
+
+	// The artifacts resolved from the registry are matched by the dependencies
+	kit.Spec.Dependencies = []string{"registry-mvn:my-registry/my-org/other-artifact:1.0"}
+	match, err = IntegrationMatches(integration, kit, options)
+	assert.Nil(t, err)
+	assert.False(t, match)
+}
+
+func TestIntegrationMatches_ProfileCompatibility(t *testing.T) {
+	compatibility := map[v1.TraitProfile][]v1.TraitProfile{
+		v1.TraitProfileKubernetes: {v1.TraitProfileKnative},
+	}
+
+	testCases := []struct {
+		name               string
+		integrationProfile v1.TraitProfile
+		kitProfile         v1.TraitProfile
+		compatibility      map[v1.TraitProfile][]v1.TraitProfile
+		match              bool
+	}{
+		{
+			name:               "same profile",
+			integrationProfile: v1.TraitProfileKnative,
+			kitProfile:         v1.TraitProfileKnative,
+			compatibility:      compatibility,
+			match:              true,
+		},
+		{
+			name:               "kubernetes integration reusing a knative kit",
+			integrationProfile: v1.TraitProfileKubernetes,
+			kitProfile:         v1.TraitProfileKnative,
+			compatibility:      compatibility,
+			match:              true,
+		},
+		{
+			name:               "knative integration reusing a kubernetes kit",
+			integrationProfile: v1.TraitProfileKnative,
+			kitProfile:         v1.TraitProfileKubernetes,
+			compatibility:      compatibility,
+			match:              false,
+		},
+		{
+			name:               "openshift integration reusing a knative kit",
+			integrationProfile: v1.TraitProfileOpenShift,
+			kitProfile:         v1.TraitProfileKnative,
+			compatibility:      compatibility,
+			match:              false,
+		},
+		{
+			name:               "kit profile not recorded",
+			integrationProfile: v1.TraitProfileKnative,
+			compatibility:      compatibility,
+			match:              true,
+		},
+		{
+			name:               "compatibility not configured",
+			integrationProfile: v1.TraitProfileKnative,
+			kitProfile:         v1.TraitProfileKubernetes,
+			match:              true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			integration := &v1.Integration{
+				Status: v1.IntegrationStatus{
+					Dependencies: []string{"camel:core"},
+					Profile:      tc.integrationProfile,
+				},
+			}
+			kit := &v1.IntegrationKit{
+				Spec: v1.IntegrationKitSpec{
+					Dependencies: []string{"camel:core"},
+					Profile:      tc.kitProfile,
+				},
+				Status: v1.IntegrationKitStatus{
+					Phase: v1.IntegrationKitPhaseReady,
+				},
+			}
+			options := DefaultOptions()
+			options.ProfileCompatibility = tc.compatibility
+
+			decision, err := Match(integration, kit, options)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.match, decision.Matched)
+			if !tc.match {
+				assert.Equal(t, "Integration-kit is built for a profile the integration profile cannot reuse", decision.Reason)
+				assert.Equal(t, []string{string(tc.kitProfile)}, decision.Details)
+			}
+		})
+	}
+}
+
+func TestIntegrationMatches_ImageBasedIntegration(t *testing.T) {
+	integration := &v1.Integration{
+		Spec: v1.IntegrationSpec{
+			Traits: v1.Traits{
+				Container: &traitv1.ContainerTrait{
+					Image: "quay.io/my-org/my-image:1.0",
+				},
+			},
+		},
+		Status: v1.IntegrationStatus{
+			Dependencies: []string{"camel:core", "camel:log"},
+		},
+	}
+	assert.Equal(t, "quay.io/my-org/my-image:1.0", ContainerImage(integration))
+
+	testCases := []struct {
+		name         string
+		image        string
+		dependencies []string
+		ignore       bool
+		match        bool
+	}{
+		{
+			name:   "same image without dependencies",
+			image:  "quay.io/my-org/my-image:1.0",
+			ignore: true,
+			match:  true,
+		},
+		{
+			name:         "same image with other dependencies",
+			image:        "quay.io/my-org/my-image:1.0",
+			dependencies: []string{"camel:timer"},
+			ignore:       true,
+			match:        true,
+		},
+		{
+			name:         "other image with the dependencies",
+			image:        "quay.io/my-org/my-image:2.0",
+			dependencies: []string{"camel:core", "camel:log"},
+			ignore:       true,
+			match:        false,
+		},
+		{
+			name:   "dependencies not ignored",
+			image:  "quay.io/my-org/my-image:1.0",
+			ignore: false,
+			match:  false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			kit := &v1.IntegrationKit{
+				Spec: v1.IntegrationKitSpec{
+					Image:        tc.image,
+					Dependencies: tc.dependencies,
+				},
+				Status: v1.IntegrationKitStatus{
+					Phase: v1.IntegrationKitPhaseReady,
+				},
+			}
+			options := DefaultOptions()
+			options.IgnoreImageDependencies = tc.ignore
+
+			decision, err := Match(integration, kit, options)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.match, decision.Matched)
+		})
+	}
+
+	// The integrations built from their sources are still matched by dependencies
+	integration.Spec.Traits.Container.Image = ""
+	options := DefaultOptions()
+	options.IgnoreImageDependencies = true
+	decision, err := Match(integration, &v1.IntegrationKit{
+		Spec: v1.IntegrationKitSpec{
+			Dependencies: []string{"camel:core"},
+		},
+		Status: v1.IntegrationKitStatus{
+			Phase: v1.IntegrationKitPhaseReady,
+		},
+	}, options)
+	assert.Nil(t, err)
+	assert.False(t, decision.Matched)
+	assert.Equal(t, []string{"camel:log"}, decision.Details)
+}
+
+func TestIntegrationMatches_CoreDependencies(t *testing.T) {
+	integration := &v1.Integration{
+		Status: v1.IntegrationStatus{
+			Dependencies: []string{"camel:core", "mvn:org.my:core:1.0", "camel:log"},
+		},
+	}
+	kit := func(dependencies ...string) *v1.IntegrationKit {
+		return &v1.IntegrationKit{
+			Spec: v1.IntegrationKitSpec{
+				Dependencies: dependencies,
+			},
+			Status: v1.IntegrationKitStatus{
+				Phase: v1.IntegrationKitPhaseReady,
+			},
+		}
+	}
+
+	testCases := []struct {
+		name         string
+		dependencies []string
+		match        bool
+	}{
+		{
+			name:         "all dependencies",
+			dependencies: []string{"camel:core", "mvn:org.my:core:1.0", "camel:log"},
+			match:        true,
+		},
+		{
+			name:         "missing peripheral dependency",
+			dependencies: []string{"camel:core", "mvn:org.my:core:1.0"},
+			match:        true,
+		},
+		{
+			name:         "missing core dependency",
+			dependencies: []string{"camel:core", "camel:log"},
+			match:        false,
+		},
+		{
+			name:         "other core dependency version",
+			dependencies: []string{"camel:core", "mvn:org.my:core:2.0", "camel:log"},
+			match:        false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			options := DefaultOptions()
+			options.CoreDependencies = []string{"camel:core", "mvn:org.my:core"}
+			match, err := IntegrationMatches(integration, kit(tc.dependencies...), options)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.match, match)
+
+			// All the dependencies are core when none is configured
+			match, err = IntegrationMatches(integration, kit(tc.dependencies...), DefaultOptions())
+			assert.Nil(t, err)
+			assert.Equal(t, tc.name == "all dependencies", match)
+		})
+	}
+}
+
+func TestIntegrationMatches_Capabilities(t *testing.T) {
+	integration := &v1.Integration{
+		Status: v1.IntegrationStatus{
+			Dependencies: []string{"camel:core", "mvn:org.apache.camel.quarkus:camel-quarkus-platform-http"},
+			Capabilities: []string{v1.CapabilityPlatformHTTP, v1.CapabilityRest, v1.CapabilityPlatformHTTP},
+		},
+	}
+	assert.Equal(t, []string{v1.CapabilityPlatformHTTP, v1.CapabilityRest}, RequiredCapabilities(integration))
+
+	testCases := []struct {
+		name         string
+		capabilities []string
+		match        bool
+		details      []string
+	}{
+		{
+			name:         "all capabilities",
+			capabilities: []string{v1.CapabilityHealth, v1.CapabilityPlatformHTTP, v1.CapabilityRest},
+			match:        true,
+		},
+		{
+			name:         "missing platform-http capability",
+			capabilities: []string{v1.CapabilityRest},
+			match:        false,
+			details:      []string{v1.CapabilityPlatformHTTP},
+		},
+		{
+			name:  "capabilities not recorded",
+			match: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			kit := &v1.IntegrationKit{
+				Spec: v1.IntegrationKitSpec{
+					Dependencies: integration.Status.Dependencies,
+					Capabilities: tc.capabilities,
+				},
+				Status: v1.IntegrationKitStatus{
+					Phase: v1.IntegrationKitPhaseReady,
+				},
+			}
+
+			decision, err := Match(integration, kit, DefaultOptions())
+			assert.Nil(t, err)
+			assert.Equal(t, tc.match, decision.Matched)
+			if !tc.match {
+				assert.Equal(t, "Integration-kit does not provide the capabilities of the integration", decision.Reason)
+				assert.Equal(t, tc.details, decision.Details)
+			}
+		})
+	}
+}
+
+func TestIntegrationMatches_QuarkusPlatformVersion(t *testing.T) {
+	testCases := []struct {
+		name               string
+		integrationVersion string
+		kitVersion         string
+		match              bool
+	}{
+		{
+			name:               "same Quarkus platform version",
+			integrationVersion: "2.7.5.Final",
+			kitVersion:         "2.7.5.Final",
+			match:              true,
+		},
+		{
+			name:               "other Quarkus platform version",
+			integrationVersion: "2.7.5.Final",
+			kitVersion:         "2.2.3.Final",
+			match:              false,
+		},
+		{
+			name:               "kit Quarkus platform version not recorded",
+			integrationVersion: "2.7.5.Final",
+			match:              true,
+		},
+		{
+			name:       "integration Quarkus platform version not recorded",
+			kitVersion: "2.7.5.Final",
+			match:      true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			integration := &v1.Integration{
+				Status: v1.IntegrationStatus{
+					Dependencies:           []string{"camel:core"},
+					QuarkusPlatformVersion: tc.integrationVersion,
+				},
+			}
+			kit := &v1.IntegrationKit{
+				Spec: v1.IntegrationKitSpec{
+					Dependencies: []string{"camel:core"},
+				},
+				Status: v1.IntegrationKitStatus{
+					Phase:                  v1.IntegrationKitPhaseReady,
+					QuarkusPlatformVersion: tc.kitVersion,
+				},
+			}
+
+			decision, err := Match(integration, kit, DefaultOptions())
+			assert.Nil(t, err)
+			assert.Equal(t, tc.match, decision.Matched)
+			if !tc.match {
+				assert.Equal(t, "Integration and integration-kit Quarkus platform versions do not match", decision.Reason)
+				assert.Equal(t, CategoryVersion, Classify(decision.Reason))
+			}
+		})
+	}
+}
+
+func TestIntegrationMatches_AllowedRegistries(t *testing.T) {
+	testCases := []struct {
+		name       string
+		registries []string
+		annotation *string
+		image      string
+		match      bool
+	}{
+		{
+			name:       "image from an allowed registry",
+			registries: []string{"registry.example.com/approved", "quay.io/my-org/"},
+			image:      "quay.io/my-org/camel-k-kit-abc@sha256:0123",
+			match:      true,
+		},
+		{
+			name:       "image from another registry",
+			registries: []string{"registry.example.com/approved"},
+			image:      "docker.io/my-org/camel-k-kit-abc:1.0",
+			match:      false,
+		},
+		{
+			name:       "image from a registry with the same prefix",
+			registries: []string{"registry.example.com"},
+			image:      "registry.example.company/camel-k-kit-abc:1.0",
+			match:      false,
+		},
+		{
+			name:  "image from any registry",
+			image: "docker.io/my-org/camel-k-kit-abc:1.0",
+			match: true,
+		},
+		{
+			name:       "kit not built yet",
+			registries: []string{"registry.example.com/approved"},
+			match:      true,
+		},
+		{
+			name:       "integration registries",
+			registries: []string{"docker.io/my-org"},
+			annotation: pointer.String("registry.example.com/approved, registry.example.com/other"),
+			image:      "docker.io/my-org/camel-k-kit-abc:1.0",
+			match:      false,
+		},
+		{
+			name:       "integration registry allowed",
+			registries: []string{"docker.io/my-org"},
+			annotation: pointer.String("registry.example.com/approved"),
+			image:      "registry.example.com/approved/camel-k-kit-abc:1.0",
+			match:      true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			integration := &v1.Integration{
+				Status: v1.IntegrationStatus{
+					Dependencies: []string{"camel:core"},
+				},
+			}
+			if tc.annotation != nil {
+				integration.Annotations = map[string]string{
+					v1.KitRegistriesAnnotation: *tc.annotation,
+				}
+			}
+			kit := &v1.IntegrationKit{
+				Spec: v1.IntegrationKitSpec{
+					Dependencies: []string{"camel:core"},
+				},
+				Status: v1.IntegrationKitStatus{
+					Phase: v1.IntegrationKitPhaseReady,
+					Image: tc.image,
+				},
+			}
+			options := DefaultOptions()
+			options.AllowedRegistries = tc.registries
+
+			decision, err := Match(integration, kit, options)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.match, decision.Matched)
+			if !tc.match {
+				assert.Equal(t, "Integration-kit image does not come from an allowed registry", decision.Reason)
+			}
+		})
+	}
+}
+
+func TestStatusVersionSettled(t *testing.T) {
+	initialized := metav1.Now()
+	integration := &v1.Integration{}
+	assert.True(t, StatusVersionSettled(integration))
+
+	integration.Status.InitializationTimestamp = &initialized
+	assert.False(t, StatusVersionSettled(integration))
+
+	integration.Status.Version = defaults.Version
+	assert.True(t, StatusVersionSettled(integration))
+
+	integration.Status.Version = "0.0.1"
+	assert.False(t, StatusVersionSettled(integration))
+}
+
+func TestIntegrationMatches_CatalogVersion(t *testing.T) {
+	testCases := []struct {
+		name               string
+		strict             bool
+		integrationVersion string
+		kitVersion         string
+		match              bool
+	}{
+		{
+			name:               "same catalog version",
+			strict:             true,
+			integrationVersion: "3.18.2",
+			kitVersion:         "3.18.2",
+			match:              true,
+		},
+		{
+			name:               "other catalog version",
+			strict:             true,
+			integrationVersion: "3.18.2",
+			kitVersion:         "3.18.1",
+			match:              false,
+		},
+		{
+			name:               "kit catalog version not recorded",
+			strict:             true,
+			integrationVersion: "3.18.2",
+			match:              false,
+		},
+		{
+			name:               "other catalog version without strict mode",
+			integrationVersion: "3.18.2",
+			kitVersion:         "3.18.1",
+			match:              true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			integration := &v1.Integration{
+				Status: v1.IntegrationStatus{
+					Dependencies:   []string{"camel:core"},
+					CatalogVersion: tc.integrationVersion,
+				},
+			}
+			kit := &v1.IntegrationKit{
+				Spec: v1.IntegrationKitSpec{
+					Dependencies: []string{"camel:core"},
+				},
+				Status: v1.IntegrationKitStatus{
+					Phase:          v1.IntegrationKitPhaseReady,
+					CatalogVersion: tc.kitVersion,
+				},
+			}
+			options := DefaultOptions()
+			options.MatchCatalogVersion = tc.strict
+
+			decision, err := Match(integration, kit, options)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.match, decision.Matched)
+			if !tc.match {
+				assert.Equal(t, "Integration and integration-kit catalog versions do not match", decision.Reason)
+				assert.Equal(t, CategoryVersion, Classify(decision.Reason))
+			}
+		})
+	}
+}
+
+func TestIntegrationMatches_BomOverrides(t *testing.T) {
+	testCases := []struct {
+		name    string
+		mode    v1.IntegrationKitMatchMode
+		boms    []string
+		kitBoms []string
+		match   bool
+	}{
+		{
+			name:    "same BOM overrides",
+			boms:    []string{"mvn:org.acme:acme-bom:1.2.0"},
+			kitBoms: []string{"mvn:org.acme:acme-bom:1.2.0"},
+			match:   true,
+		},
+		{
+			name:    "other BOM override version",
+			boms:    []string{"mvn:org.acme:acme-bom:1.2.0"},
+			kitBoms: []string{"mvn:org.acme:acme-bom:1.1.0"},
+			match:   false,
+		},
+		{
+			name:  "kit without BOM override",
+			boms:  []string{"mvn:org.acme:acme-bom:1.2.0"},
+			match: false,
+		},
+		{
+			name:    "integration without BOM override",
+			kitBoms: []string{"mvn:org.acme:acme-bom:1.2.0"},
+			match:   false,
+		},
+		{
+			name:    "BOM overrides in another order",
+			boms:    []string{"mvn:org.acme:acme-bom:1.2.0", "mvn:org.acme:other-bom:1.0.0"},
+			kitBoms: []string{"mvn:org.acme:other-bom:1.0.0", "mvn:org.acme:acme-bom:1.2.0"},
+			match:   false,
+		},
+		{
+			name:  "kit without BOM override in dependencies only mode",
+			mode:  v1.IntegrationKitMatchModeDependenciesOnly,
+			boms:  []string{"mvn:org.acme:acme-bom:1.2.0"},
+			match: false,
+		},
+		{
+			name:  "no BOM override",
+			match: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			integration := &v1.Integration{
+				Spec: v1.IntegrationSpec{
+					Traits: v1.Traits{
+						Builder: &traitv1.BuilderTrait{
+							Boms: tc.boms,
+						},
+					},
+				},
+				Status: v1.IntegrationStatus{
+					Dependencies: []string{"camel:core"},
+				},
+			}
+			kit := &v1.IntegrationKit{
+				Spec: v1.IntegrationKitSpec{
+					Dependencies: []string{"camel:core"},
+					Traits: v1.IntegrationKitTraits{
+						Builder: &traitv1.BuilderTrait{
+							Boms: tc.kitBoms,
+						},
+					},
+				},
+				Status: v1.IntegrationKitStatus{
+					Phase: v1.IntegrationKitPhaseReady,
+				},
+			}
+			options := DefaultOptions()
+			options.Mode = tc.mode
+
+			decision, err := Match(integration, kit, options)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.match, decision.Matched)
+			if !tc.match {
+				assert.Equal(t, "Integration and integration-kit BOM overrides do not match", decision.Reason)
+			}
+		})
+	}
+}
+
+func TestIntegrationMatches_EnvironmentTrait(t *testing.T) {
+	testCases := []struct {
+		name        string
+		environment *traitv1.EnvironmentTrait
+		kit         *traitv1.EnvironmentTrait
+		match       bool
+	}{
+		{
+			name: "runtime variables only",
+			environment: &traitv1.EnvironmentTrait{
+				Vars:          []string{"RUNTIME_VAR=runtime"},
+				ContainerMeta: pointer.Bool(false),
+			},
+			match: true,
+		},
+		{
+			name: "other runtime variables",
+			environment: &traitv1.EnvironmentTrait{
+				Vars:      []string{"RUNTIME_VAR=runtime"},
+				BuildVars: []string{"MAVEN_OPTS=-Xmx2g"},
+			},
+			kit: &traitv1.EnvironmentTrait{
+				BuildVars: []string{"MAVEN_OPTS=-Xmx2g"},
+			},
+			match: true,
+		},
+		{
+			name: "same build variables, differently quoted",
+			environment: &traitv1.EnvironmentTrait{
+				BuildVars: []string{`MAVEN_OPTS="-Xmx2g"`},
+			},
+			kit: &traitv1.EnvironmentTrait{
+				BuildVars: []string{"MAVEN_OPTS=-Xmx2g"},
+			},
+			match: true,
+		},
+		{
+			name: "other build variables",
+			environment: &traitv1.EnvironmentTrait{
+				BuildVars: []string{"MAVEN_OPTS=-Xmx4g"},
+			},
+			kit: &traitv1.EnvironmentTrait{
+				BuildVars: []string{"MAVEN_OPTS=-Xmx2g"},
+			},
+			match: false,
+		},
+		{
+			name: "kit built without build variables",
+			environment: &traitv1.EnvironmentTrait{
+				Vars:      []string{"RUNTIME_VAR=runtime"},
+				BuildVars: []string{"MAVEN_OPTS=-Xmx2g"},
+			},
+			match: false,
+		},
+		{
+			name: "integration without build variables",
+			kit: &traitv1.EnvironmentTrait{
+				BuildVars: []string{"MAVEN_OPTS=-Xmx2g"},
+			},
+			match: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			integration := &v1.Integration{
+				Spec: v1.IntegrationSpec{
+					Traits: v1.Traits{
+						Environment: tc.environment,
+					},
+				},
+			}
+			kit := &v1.IntegrationKit{
+				Spec: v1.IntegrationKitSpec{
+					Traits: v1.IntegrationKitTraits{
+						Environment: tc.kit,
+					},
+				},
+				Status: v1.IntegrationKitStatus{
+					Phase: v1.IntegrationKitPhaseReady,
+				},
+			}
+
+			match, err := IntegrationMatches(integration, kit, DefaultOptions())
+			assert.Nil(t, err)
+			assert.Equal(t, tc.match, match)
+		})
+	}
+}
+
+func TestIntegrationMatches_ManagedVersions(t *testing.T) {
+	testCases := []struct {
+		name      string
+		pinned    []string
+		kitPinned []string
+		match     bool
+	}{
+		{
+			name:      "same pinned versions",
+			pinned:    []string{"mvn:com.fasterxml.jackson.core:jackson-databind:2.13.4"},
+			kitPinned: []string{"mvn:com.fasterxml.jackson.core:jackson-databind:2.13.4"},
+			match:     true,
+		},
+		{
+			name:      "same pinned versions in another order",
+			pinned:    []string{"mvn:com.fasterxml.jackson.core:jackson-databind:2.13.4", "mvn:org.yaml:snakeyaml:1.33"},
+			kitPinned: []string{"mvn:org.yaml:snakeyaml:1.33", "mvn:com.fasterxml.jackson.core:jackson-databind:2.13.4"},
+			match:     true,
+		},
+		{
+			name:      "other pinned version",
+			pinned:    []string{"mvn:com.fasterxml.jackson.core:jackson-databind:2.13.4"},
+			kitPinned: []string{"mvn:com.fasterxml.jackson.core:jackson-databind:2.13.3"},
+			match:     false,
+		},
+		{
+			name:   "kit resolved without pinned versions",
+			pinned: []string{"mvn:com.fasterxml.jackson.core:jackson-databind:2.13.4"},
+			match:  false,
+		},
+		{
+			name:      "integration without pinned versions",
+			kitPinned: []string{"mvn:com.fasterxml.jackson.core:jackson-databind:2.13.4"},
+			match:     false,
+		},
+		{
+			name:  "no pinned version",
+			match: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			integration := &v1.Integration{
+				Spec: v1.IntegrationSpec{
+					Traits: v1.Traits{
+						Builder: &traitv1.BuilderTrait{
+							ManagedVersions: tc.pinned,
+						},
+					},
+				},
+				Status: v1.IntegrationStatus{
+					Dependencies: []string{"camel:core"},
+				},
+			}
+			kit := &v1.IntegrationKit{
+				Spec: v1.IntegrationKitSpec{
+					Dependencies: []string{"camel:core"},
+					Traits: v1.IntegrationKitTraits{
+						Builder: &traitv1.BuilderTrait{
+							ManagedVersions: tc.kitPinned,
+						},
+					},
+				},
+				Status: v1.IntegrationKitStatus{
+					Phase: v1.IntegrationKitPhaseReady,
+				},
+			}
+
+			decision, err := Match(integration, kit, DefaultOptions())
+			assert.Nil(t, err)
+			assert.Equal(t, tc.match, decision.Matched)
+			if !tc.match {
+				assert.Equal(t, "Integration and integration-kit pinned dependency versions do not match", decision.Reason)
+			}
+		})
+	}
+}
+
+func TestDeduplicateDependencies(t *testing.T) {
+	dependencies := []string{"camel:core", "camel:log"}
+	unique, duplicates := deduplicateDependencies(dependencies)
+	assert.Equal(t, dependencies, unique)
+	assert.Nil(t, duplicates)
+
+	unique, duplicates = deduplicateDependencies([]string{"camel:core", "mvn:org.my:lib:1.0", "camel:core", "camel:log", "mvn:org.my:lib:jar:1.0"})
+	assert.Equal(t, []string{"camel:core", "mvn:org.my:lib:1.0", "camel:log"}, unique)
+	assert.Equal(t, []string{"camel:core", "mvn:org.my:lib:jar:1.0"}, duplicates)
+
+	assert.Nil(t, DuplicateDependencies(dependencies))
+	assert.Equal(t, []string{"camel:core"}, DuplicateDependencies([]string{"camel:core", "camel:log", "camel:core"}))
+}
+
+func TestIntegrationMatches_DuplicateDependencies(t *testing.T) {
+	integration := func(dependencies ...string) *v1.Integration {
+		return &v1.Integration{
+			Status: v1.IntegrationStatus{
+				Dependencies: dependencies,
+			},
+		}
+	}
+	kit := func(dependencies ...string) *v1.IntegrationKit {
+		return &v1.IntegrationKit{
+			Spec: v1.IntegrationKitSpec{
+				Dependencies: dependencies,
+			},
+			Status: v1.IntegrationKitStatus{
+				Phase: v1.IntegrationKitPhaseReady,
+			},
+		}
+	}
+	options := DefaultOptions()
+	options.MaxExtraDependencies = 0
+
+	testCases := []struct {
+		name        string
+		integration *v1.Integration
+		kit         *v1.IntegrationKit
+		match       bool
+	}{
+		{
+			name:        "duplicates in the integration",
+			integration: integration("camel:core", "camel:log", "camel:core"),
+			kit:         kit("camel:core", "camel:log"),
+			match:       true,
+		},
+		{
+			name:        "duplicates in the kit",
+			integration: integration("camel:core", "camel:log"),
+			kit:         kit("camel:log", "camel:core", "camel:log"),
+			match:       true,
+		},
+		{
+			name:        "duplicates on both sides",
+			integration: integration("camel:core", "camel:core"),
+			kit:         kit("camel:core", "camel:core", "camel:core"),
+			match:       true,
+		},
+		{
+			name:        "duplicates with a missing dependency",
+			integration: integration("camel:core", "camel:core", "camel:log"),
+			kit:         kit("camel:core", "camel:core"),
+			match:       false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			integrationDependencies := append([]string{}, tc.integration.Status.Dependencies...)
+			kitDependencies := append([]string{}, tc.kit.Spec.Dependencies...)
+
+			match, err := IntegrationMatches(tc.integration, tc.kit, options)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.match, match)
+			// The matched resources are left untouched
+			assert.Equal(t, integrationDependencies, tc.integration.Status.Dependencies)
+			assert.Equal(t, kitDependencies, tc.kit.Spec.Dependencies)
+		})
+	}
+}
+
+func TestIntegrationMatches_CamelTraitRuntimeVersion(t *testing.T) {
+	kit := &v1.IntegrationKit{
+		Spec: v1.IntegrationKitSpec{
+			Dependencies: []string{"camel:core"},
+		},
+		Status: v1.IntegrationKitStatus{
+			Phase:           v1.IntegrationKitPhaseReady,
+			RuntimeVersion:  "1.17.0",
+			RuntimeProvider: v1.RuntimeProviderQuarkus,
+		},
+	}
+
+	testCases := []struct {
+		name           string
+		runtimeVersion string
+		match          bool
+	}{
+		{
+			name:  "no override",
+			match: true,
+		},
+		{
+			name:           "same version",
+			runtimeVersion: "1.17.0",
+			match:          true,
+		},
+		{
+			name:           "other version",
+			runtimeVersion: "1.18.0",
+			match:          false,
+		},
+		{
+			name:           "satisfied constraint",
+			runtimeVersion: "~1.17.0",
+			match:          true,
+		},
+		{
+			name:           "unsatisfied constraint",
+			runtimeVersion: ">= 1.18",
+			match:          false,
+		},
+		{
+			name:           "invalid version",
+			runtimeVersion: "latest",
+			match:          false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// The integration status records the default runtime version of the kit
+			integration := &v1.Integration{
+				Status: v1.IntegrationStatus{
+					RuntimeVersion:  "1.17.0",
+					RuntimeProvider: v1.RuntimeProviderQuarkus,
+					Dependencies:    []string{"camel:core"},
+				},
+			}
+			if tc.runtimeVersion != "" {
+				integration.Spec.Traits.Camel = &traitv1.CamelTrait{
+					RuntimeVersion: tc.runtimeVersion,
+				}
+			}
+
+			match, err := IntegrationMatches(integration, kit, DefaultOptions())
+			assert.Nil(t, err)
+			assert.Equal(t, tc.match, match)
+		})
+	}
+}
+
+func TestIntegrationMatches_ProfileSpecificDependencies(t *testing.T) {
+	knativeDependencies := []string{"camel:core", "mvn:org.apache.camel.k:camel-k-knative"}
+
+	testCases := []struct {
+		name                    string
+		integrationProfile      v1.TraitProfile
+		integrationDependencies []string
+		kitProfile              v1.TraitProfile
+		match                   bool
+	}{
+		{
+			name:                    "same profile",
+			integrationProfile:      v1.TraitProfileKnative,
+			integrationDependencies: knativeDependencies,
+			kitProfile:              v1.TraitProfileKnative,
+			match:                   true,
+		},
+		{
+			name:                    "other profile",
+			integrationProfile:      v1.TraitProfileKnative,
+			integrationDependencies: knativeDependencies,
+			kitProfile:              v1.TraitProfileKubernetes,
+			match:                   false,
+		},
+		{
+			name:                    "kit without profile",
+			integrationProfile:      v1.TraitProfileKnative,
+			integrationDependencies: knativeDependencies,
+			match:                   true,
+		},
+		{
+			name:                    "no profile-specific dependencies",
+			integrationProfile:      v1.TraitProfileKnative,
+			integrationDependencies: []string{"camel:core"},
+			kitProfile:              v1.TraitProfileKubernetes,
+			match:                   true,
+		},
+		{
+			name:                    "dependencies specific to another profile",
+			integrationProfile:      v1.TraitProfileKubernetes,
+			integrationDependencies: knativeDependencies,
+			kitProfile:              v1.TraitProfileKnative,
+			match:                   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			integration := &v1.Integration{
+				Status: v1.IntegrationStatus{
+					Profile:      tc.integrationProfile,
+					Dependencies: tc.integrationDependencies,
+				},
+			}
+			kit := &v1.IntegrationKit{
+				Spec: v1.IntegrationKitSpec{
+					Profile:      tc.kitProfile,
+					Dependencies: knativeDependencies,
+				},
+				Status: v1.IntegrationKitStatus{
+					Phase: v1.IntegrationKitPhaseReady,
+				},
+			}
+
+			decision, err := Match(integration, kit, DefaultOptions())
+			assert.Nil(t, err)
+			assert.Equal(t, tc.match, decision.Matched)
+			if !tc.match {
+				assert.Equal(t, "Integration-kit is not built for the profile of the integration dependencies", decision.Reason)
+				assert.Equal(t, []string{"mvn:org.apache.camel.k:camel-k-knative"}, decision.Details)
+			}
+		})
+	}
+}
+
+func TestIntegrationMatches_DependencyEquivalences(t *testing.T) {
+	integration := &v1.Integration{
+		Status: v1.IntegrationStatus{
+			Dependencies: []string{"camel:core", "mvn:org.apache.camel:camel-foo:3.14.0"},
+		},
+	}
+
+	testCases := []struct {
+		name            string
+		equivalences    []string
+		kitDependencies []string
+		match           bool
+	}{
+		{
+			name:            "no equivalence",
+			kitDependencies: []string{"camel:core", "mvn:com.acme:camel-foo:3.14.0-acme"},
+			match:           false,
+		},
+		{
+			name:            "fork equivalent to upstream",
+			equivalences:    []string{"mvn:org.apache.camel:camel-foo:3.14.0, mvn:com.acme:camel-foo:3.14.0-acme"},
+			kitDependencies: []string{"camel:core", "mvn:com.acme:camel-foo:3.14.0-acme"},
+			match:           true,
+		},
+		{
+			name:            "fork equivalent to upstream whatever the versions",
+			equivalences:    []string{"mvn:org.apache.camel:camel-foo,mvn:com.acme:camel-foo"},
+			kitDependencies: []string{"camel:core", "mvn:com.acme:camel-foo:3.15.0-acme"},
+			match:           true,
+		},
+		{
+			name:            "fork of another version",
+			equivalences:    []string{"mvn:org.apache.camel:camel-foo:3.14.0,mvn:com.acme:camel-foo:3.14.0-acme"},
+			kitDependencies: []string{"camel:core", "mvn:com.acme:camel-foo:3.15.0-acme"},
+			match:           false,
+		},
+		{
+			name:            "other equivalence",
+			equivalences:    []string{"mvn:org.apache.camel:camel-bar,mvn:com.acme:camel-bar"},
+			kitDependencies: []string{"camel:core", "mvn:com.acme:camel-foo:3.14.0-acme"},
+			match:           false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			kit := &v1.IntegrationKit{
+				Spec: v1.IntegrationKitSpec{
+					Dependencies: tc.kitDependencies,
+				},
+				Status: v1.IntegrationKitStatus{
+					Phase: v1.IntegrationKitPhaseReady,
+				},
+			}
+			options := DefaultOptions()
+			options.DependencyEquivalences = tc.equivalences
+
+			match, err := IntegrationMatches(integration, kit, options)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.match, match)
+		})
+	}
+}
+
+func TestIntegrationMatches_MonitoringTraits(t *testing.T) {
+	// The monitoring ports are configured when the integrations run, so that the monitoring traits
+	// only influence the kits through the dependencies they add
+	for _, influencing := range KitInfluencingTraits() {
+		assert.NotContains(t, []string{"jolokia", "prometheus"}, string(influencing.ID()))
+	}
+
+	kit := &v1.IntegrationKit{
+		Spec: v1.IntegrationKitSpec{
+			Dependencies: []string{
+				"camel:core",
+				"mvn:org.jolokia:jolokia-jvm:jar:1.7.1",
+				"mvn:org.apache.camel.quarkus:camel-quarkus-microprofile-metrics",
+			},
+		},
+		Status: v1.IntegrationKitStatus{
+			Phase: v1.IntegrationKitPhaseReady,
+		},
+	}
+
+	testCases := []struct {
+		name         string
+		jolokiaPort  int
+		dependencies []string
+		match        bool
+	}{
+		{
+			name:         "default port",
+			dependencies: kit.Spec.Dependencies,
+			match:        true,
+		},
+		{
+			name:         "other port",
+			jolokiaPort:  9779,
+			dependencies: kit.Spec.Dependencies,
+			match:        true,
+		},
+		{
+			name:         "missing monitoring dependency",
+			jolokiaPort:  9779,
+			dependencies: append([]string{"mvn:org.apache.camel.quarkus:camel-quarkus-micrometer"}, kit.Spec.Dependencies...),
+			match:        false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			integration := &v1.Integration{
+				Spec: v1.IntegrationSpec{
+					Traits: v1.Traits{
+						Jolokia: &traitv1.JolokiaTrait{
+							Trait: traitv1.Trait{Enabled: pointer.Bool(true)},
+							Port:  tc.jolokiaPort,
+						},
+						Prometheus: &traitv1.PrometheusTrait{
+							Trait: traitv1.Trait{Enabled: pointer.Bool(true)},
+						},
+					},
+				},
+				Status: v1.IntegrationStatus{
+					Dependencies: tc.dependencies,
+				},
+			}
+
+			match, err := IntegrationMatches(integration, kit, DefaultOptions())
+			assert.Nil(t, err)
+			assert.Equal(t, tc.match, match)
+		})
+	}
+}
+
+func TestIntegrationMatches_DependencyTreeDigest(t *testing.T) {
+	kit := func(digest string) *v1.IntegrationKit {
+		return &v1.IntegrationKit{
+			Spec: v1.IntegrationKitSpec{
+				Dependencies: []string{"camel:core"},
+			},
+			Status: v1.IntegrationKitStatus{
+				Phase:                v1.IntegrationKitPhaseReady,
+				DependencyTreeDigest: digest,
+			},
+		}
+	}
+
+	testCases := []struct {
+		name      string
+		strict    bool
+		digest    string
+		kitDigest string
+		match     bool
+	}{
+		{
+			name:      "same digest",
+			strict:    true,
+			digest:    "vdigest",
+			kitDigest: "vdigest",
+			match:     true,
+		},
+		{
+			name:      "different digest",
+			strict:    true,
+			digest:    "vdigest",
+			kitDigest: "vother",
+			match:     false,
+		},
+		{
+			name:   "kit without digest",
+			strict: true,
+			digest: "vdigest",
+			match:  false,
+		},
+		{
+			name:      "integration without digest",
+			strict:    true,
+			kitDigest: "vdigest",
+			match:     true,
+		},
+		{
+			name:      "different digest without strict mode",
+			digest:    "vdigest",
+			kitDigest: "vother",
+			match:     true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			integration := &v1.Integration{
+				Status: v1.IntegrationStatus{
+					Dependencies: []string{"camel:core"},
+				},
+			}
+			if tc.digest != "" {
+				integration.Annotations = map[string]string{
+					v1.DependencyTreeDigestAnnotation: tc.digest,
+				}
+			}
+			options := DefaultOptions()
+			options.MatchDependencyTreeDigest = tc.strict
+
+			decision, err := Match(integration, kit(tc.kitDigest), options)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.match, decision.Matched)
+		})
+	}
+}
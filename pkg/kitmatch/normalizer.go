@@ -0,0 +1,52 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kitmatch
+
+// DependencyNormalizer canonicalizes the dependencies before the integration and kit dependencies are compared,
+// e.g. so that the coordinates of a custom dependency scheme compare equal to their Maven coordinates.
+type DependencyNormalizer interface {
+	// Normalize returns the normalized form of the dependency, or the dependency itself if it is already normal
+	Normalize(dependency string) string
+}
+
+// DependencyNormalizerFunc is a function that implements DependencyNormalizer.
+type DependencyNormalizerFunc func(dependency string) string
+
+// Normalize calls the function.
+func (f DependencyNormalizerFunc) Normalize(dependency string) string {
+	return f(dependency)
+}
+
+// identityNormalizer is the default normalizer, that returns the dependencies as is.
+type identityNormalizer struct{}
+
+func (identityNormalizer) Normalize(dependency string) string {
+	return dependency
+}
+
+var dependencyNormalizer DependencyNormalizer = identityNormalizer{}
+
+// RegisterDependencyNormalizer registers the normalizer applied to the integration and kit dependencies before they
+// are compared, replacing the one registered before, if any. The default normalizer is restored when nil.
+// It is meant to be called at initialization, before any kit is matched.
+func RegisterDependencyNormalizer(normalizer DependencyNormalizer) {
+	if normalizer == nil {
+		normalizer = identityNormalizer{}
+	}
+	dependencyNormalizer = normalizer
+}
@@ -0,0 +1,73 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kitmatch
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+)
+
+func TestIntegrationMatches_DependencyNormalizer(t *testing.T) {
+	// The custom `acme:` scheme collapses to the Maven coordinates of the organization artifacts
+	RegisterDependencyNormalizer(DependencyNormalizerFunc(func(dependency string) string {
+		if strings.HasPrefix(dependency, "acme:") {
+			return "mvn:com.acme:" + strings.TrimPrefix(dependency, "acme:")
+		}
+		return dependency
+	}))
+	defer RegisterDependencyNormalizer(nil)
+
+	integration := &v1.Integration{
+		Status: v1.IntegrationStatus{
+			Dependencies: []string{"camel:core", "acme:acme-client:1.2.0"},
+		},
+	}
+	kit := func(dependencies ...string) *v1.IntegrationKit {
+		return &v1.IntegrationKit{
+			Spec: v1.IntegrationKitSpec{
+				Dependencies: dependencies,
+			},
+			Status: v1.IntegrationKitStatus{
+				Phase: v1.IntegrationKitPhaseReady,
+			},
+		}
+	}
+
+	decision, err := Match(integration, kit("camel:core", "mvn:com.acme:acme-client:1.2.0"), DefaultOptions())
+	assert.Nil(t, err)
+	assert.True(t, decision.Matched)
+
+	decision, err = Match(integration, kit("camel:core", "acme:acme-client:1.2.0"), DefaultOptions())
+	assert.Nil(t, err)
+	assert.True(t, decision.Matched)
+
+	decision, err = Match(integration, kit("camel:core", "mvn:com.acme:acme-client:1.1.0"), DefaultOptions())
+	assert.Nil(t, err)
+	assert.False(t, decision.Matched)
+	assert.Equal(t, []string{"acme:acme-client:1.2.0"}, decision.Details)
+
+	// The dependencies are compared as is by default
+	RegisterDependencyNormalizer(nil)
+	decision, err = Match(integration, kit("camel:core", "mvn:com.acme:acme-client:1.2.0"), DefaultOptions())
+	assert.Nil(t, err)
+	assert.False(t, decision.Matched)
+}
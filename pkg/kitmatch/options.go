@@ -0,0 +1,367 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kitmatch
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"k8s.io/utils/pointer"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/trait"
+	"github.com/apache/camel-k/pkg/util"
+	"github.com/apache/camel-k/pkg/util/defaults"
+	"github.com/apache/camel-k/pkg/util/maven"
+)
+
+// Options configures how an integration is matched against the existing kits.
+type Options struct {
+	// Mode defines whether the kit influencing traits are compared, or only the dependencies
+	Mode v1.IntegrationKitMatchMode
+	// TraitMatchMode defines how the kit influencing traits are compared
+	TraitMatchMode v1.IntegrationKitTraitMatchMode
+	// MaxExtraDependencies is the number of dependencies a kit can provide on top of the ones required by
+	// the integration, or -1 when any number of extra dependencies is accepted
+	MaxExtraDependencies int
+	// AllowOtherRuntimeProviders allows the kits built for another runtime provider than the integration one
+	// to be used as fallbacks, while no kit exists yet for the integration runtime provider
+	AllowOtherRuntimeProviders bool
+	// ExcludeInvalid excludes the kits whose status is inconsistent with their spec
+	ExcludeInvalid bool
+	// QuarantineThreshold is the number of failures after which a kit is quarantined, or 0 when disabled
+	QuarantineThreshold int
+	// RequireImageStream only matches the kits whose image is published to an ImageStream, e.g. with S2I
+	RequireImageStream bool
+	// RuntimeVersionPrefixMatch allows the integration runtime version to be a prefix, or a glob pattern,
+	// matching the kit runtime version
+	RuntimeVersionPrefixMatch bool
+	// DependencyMatchMode defines whether the integration dependencies can be provided transitively by the kit
+	DependencyMatchMode v1.IntegrationKitDependencyMatchMode
+	// SnapshotMatchMode defines whether the integrations with SNAPSHOT dependencies reuse the kits created for
+	// other integrations, or match the SNAPSHOT dependencies whatever their version
+	SnapshotMatchMode v1.IntegrationKitSnapshotMatchMode
+	// IdentityLabels are the labels that must have the same values on the integration and the kit
+	IdentityLabels []string
+	// BuildStrategy is the strategy the kits must have been built with, or empty when the build strategy
+	// does not influence the kits
+	BuildStrategy v1.BuildStrategy
+	// PinnedDependencies are the `group:artifact` coordinates of the Maven dependencies whose versions must
+	// match, the versions of the other Maven dependencies being ignored when set
+	PinnedDependencies []string
+	// PreferFasterBuilds ranks the matching kits that built faster first
+	PreferFasterBuilds bool
+	// NonInfluencingAddons are the IDs of the addon traits that are ignored when matching
+	NonInfluencingAddons []string
+	// MaxStatusGenerationSkew is the number of generations the integration status can lag behind its spec,
+	// or -1 when the generations are not checked
+	MaxStatusGenerationSkew int64
+	// PermissiveTraits are the IDs of the kit influencing traits that, when omitted by the integration,
+	// accept any configuration of the kit
+	PermissiveTraits []string
+	// ExcludeImageless excludes the ready kits that have no image, the kits that are still building being
+	// matched so that the integration waits for their build
+	ExcludeImageless bool
+	// DependencyEquivalences are the groups of interchangeable dependencies, as comma separated lists,
+	// whose members satisfy each other, the Maven coordinates without version matching any version
+	DependencyEquivalences []string
+	// OperatorVersion is the version, or the semantic version constraint, the operator version label of the kits
+	// must match, or empty when the kits are matched whatever the operator version that created them
+	OperatorVersion string
+	// PlatformGeneration is the generation of the integration platform the kits must be labeled with,
+	// or empty when the kits are matched whatever the platform generation they are created under
+	PlatformGeneration string
+	// PreferClosestRuntimeConfig ranks the matching kits whose runtime configuration is the closest to
+	// the integration one first
+	PreferClosestRuntimeConfig bool
+	// MatchDependencyTreeDigest requires the digest of the resolved dependency tree of the kits to be equal to
+	// the one the integration declares, if any
+	MatchDependencyTreeDigest bool
+	// InfluencingTraits are the IDs of the traits that are compared when matching, in addition to the
+	// kit influencing traits
+	InfluencingTraits []string
+	// NonInfluencingTraits are the IDs of the kit influencing traits that are ignored when matching
+	NonInfluencingTraits []string
+	// CoreDependencies are the dependencies a kit must provide, the other dependencies being peripheral, or empty
+	// when all the dependencies must be provided, the Maven coordinates without version matching any version
+	CoreDependencies []string
+	// CanonicalTraits compares the configurations of the traits round-tripped through the trait structs, rather than
+	// as they are serialized
+	CanonicalTraits bool
+	// TraitFieldPaths restricts, per trait, the fields of the trait configurations that are compared to the
+	// included JSON Pointers, except the excluded ones
+	TraitFieldPaths map[string]v1.IntegrationKitTraitFieldPaths
+	// IgnoreImageDependencies matches the integrations run from a prebuilt container image against the kits by image,
+	// whatever their dependencies
+	IgnoreImageDependencies bool
+	// MatchSBOM requires the software bill of materials recorded on the kits to list the components the integration
+	// dependencies resolve to, the kits without one not matching
+	MatchSBOM bool
+	// VulnerabilityPenalty is the score penalty per known vulnerability of the kit dependencies, the kits with
+	// vulnerabilities still matching but being ranked after the kits without
+	VulnerabilityPenalty int
+	// AllowedRegistries are the prefixes of the registries the kit images must come from, the integrations
+	// declaring their own with the v1.KitRegistriesAnnotation annotation
+	AllowedRegistries []string
+	// MatchCatalogVersion requires the Camel versions of the runtime catalogs the integration and the kits target
+	// to be identical
+	MatchCatalogVersion bool
+	// ListExcludeErrors excludes the kits in error from the lookup, rather than rejecting them when matching
+	ListExcludeErrors bool
+	// ListDisableCache lists the kits from the API server, rather than reading them from the informer cache once
+	// it is synced
+	ListDisableCache bool
+	// EarlyReturnScore is the score from which the lookup returns the first matching kit reaching it, without
+	// evaluating the remaining kits, or 0 when all the kits are evaluated
+	EarlyReturnScore int
+	// Trace records the time spent in each step of the matching, if not nil
+	Trace *MatchTrace
+	// ProfileCompatibility are the profiles of the kits each integration profile can reuse, in addition to its own
+	// profile, or nil when the kits are reused across profiles provided they have the profile specific dependencies
+	ProfileCompatibility map[v1.TraitProfile][]v1.TraitProfile
+
+	// influencingTraits caches the kit influencing traits for the duration of a match operation
+	influencingTraits []trait.Trait
+}
+
+// CacheInfluencingTraits resolves the kit influencing traits once, for the duration of a match operation
+// over many kits.
+func (o *Options) CacheInfluencingTraits() {
+	o.influencingTraits = o.resolveInfluencingTraits()
+}
+
+// resolveInfluencingTraits returns the kit influencing traits, along with the traits configured to be compared
+// when matching, except the scheduling and repository traits.
+func (o Options) resolveInfluencingTraits() []trait.Trait {
+	traits := make([]trait.Trait, 0)
+	for _, t := range catalogTraits() {
+		if t != nil && isComparedTrait(t) && (t.InfluencesKit() || util.StringSliceExists(o.InfluencingTraits, string(t.ID()))) {
+			traits = append(traits, t)
+		}
+	}
+
+	return traits
+}
+
+// DefaultOptions returns the options used when no platform configures the matching.
+func DefaultOptions() Options {
+	return Options{
+		Mode:                    v1.IntegrationKitMatchModeFull,
+		TraitMatchMode:          v1.IntegrationKitTraitMatchModeExact,
+		MaxExtraDependencies:    -1,
+		DependencyMatchMode:     v1.IntegrationKitDependencyMatchModeSuperset,
+		SnapshotMatchMode:       v1.IntegrationKitSnapshotMatchModeRebuild,
+		MaxStatusGenerationSkew: -1,
+	}
+}
+
+// NewOptions returns the matching options configured on the given platform, that may be nil.
+func NewOptions(pl *v1.IntegrationPlatform) Options {
+	options := DefaultOptions()
+	if pl == nil {
+		return options
+	}
+
+	build := pl.Status.Build
+	matching := build.KitMatching
+	if matching.Mode != "" {
+		options.Mode = matching.Mode
+	}
+	if matching.TraitMatchMode != "" {
+		options.TraitMatchMode = matching.TraitMatchMode
+	}
+	if matching.DependencyMatchMode != "" {
+		options.DependencyMatchMode = matching.DependencyMatchMode
+	}
+	if matching.SnapshotMatchMode != "" {
+		options.SnapshotMatchMode = matching.SnapshotMatchMode
+	}
+	if !pointer.BoolDeref(matching.AllowExtraDependencies, true) {
+		options.MaxExtraDependencies = matching.ExtraDependenciesTolerance
+	}
+	// During a runtime provider upgrade window, the kits built for other providers can be used
+	if matching.RuntimeProviderUpgradeWindowEnd != nil {
+		options.AllowOtherRuntimeProviders = time.Now().Before(matching.RuntimeProviderUpgradeWindowEnd.Time)
+	}
+	options.ExcludeInvalid = matching.ExcludeInvalid
+	options.QuarantineThreshold = matching.QuarantineThreshold
+	// The integrations built with S2I rely on ImageStream-backed kits, rather than generic registry images
+	options.RequireImageStream = build.PublishStrategy == v1.IntegrationPlatformBuildPublishStrategyS2I
+	options.RuntimeVersionPrefixMatch = matching.RuntimeVersionPrefixMatch
+	options.IdentityLabels = matching.IdentityLabels
+	options.PinnedDependencies = matching.PinnedDependencies
+	options.PreferFasterBuilds = matching.PreferFasterBuilds
+	options.PreferClosestRuntimeConfig = matching.PreferClosestRuntimeConfig
+	options.MatchDependencyTreeDigest = matching.MatchDependencyTreeDigest
+	options.NonInfluencingAddons = matching.NonInfluencingAddons
+	options.InfluencingTraits = matching.InfluencingTraits
+	options.NonInfluencingTraits = matching.NonInfluencingTraits
+	options.CoreDependencies = matching.CoreDependencies
+	options.ProfileCompatibility = matching.ProfileCompatibility
+	options.CanonicalTraits = matching.CanonicalTraits
+	options.TraitFieldPaths = matching.TraitFieldPaths
+	options.IgnoreImageDependencies = matching.IgnoreImageDependencies
+	options.MatchSBOM = matching.MatchSBOM
+	options.VulnerabilityPenalty = matching.VulnerabilityPenalty
+	options.AllowedRegistries = matching.AllowedRegistries
+	options.MatchCatalogVersion = matching.MatchCatalogVersion
+	options.ListExcludeErrors = matching.ListExcludeErrors
+	options.ListDisableCache = matching.ListDisableCache
+	if matching.MatchPlatformGeneration {
+		options.PlatformGeneration = strconv.FormatInt(pl.Generation, 10)
+	}
+	options.EarlyReturnScore = matching.EarlyReturnScore
+	options.PermissiveTraits = matching.PermissiveTraits
+	options.ExcludeImageless = matching.ExcludeImageless
+	options.DependencyEquivalences = matching.DependencyEquivalences
+	if matching.RequireOperatorVersion {
+		options.OperatorVersion = defaults.Version
+		if matching.OperatorVersionRange != "" {
+			options.OperatorVersion = matching.OperatorVersionRange
+		}
+	}
+	if matching.MaxStatusGenerationSkew != nil {
+		options.MaxStatusGenerationSkew = *matching.MaxStatusGenerationSkew
+	}
+	if matching.BuildStrategyInfluencing {
+		options.BuildStrategy = build.BuildStrategy
+	}
+
+	return options
+}
+
+// ValidateTraits checks that the traits configured to be compared, or ignored, when matching are traits of
+// the catalog, that no trait is configured to be both compared and ignored, and that no scheduling or
+// repository trait is configured to be compared.
+func ValidateTraits(matching v1.IntegrationPlatformKitMatchingSpec) error {
+	catalog := trait.NewCatalog(nil)
+	for _, id := range append(append([]string{}, matching.InfluencingTraits...), matching.NonInfluencingTraits...) {
+		if catalog.GetTrait(id) == nil {
+			return fmt.Errorf("unknown trait %q", id)
+		}
+	}
+	for _, id := range matching.InfluencingTraits {
+		if util.StringSliceExists(matching.NonInfluencingTraits, id) {
+			return fmt.Errorf("trait %q is configured to be both compared and ignored", id)
+		}
+		if util.StringSliceExists(schedulingTraits, id) {
+			return fmt.Errorf("trait %q only configures the scheduling and cannot be compared", id)
+		}
+		if util.StringSliceExists(repositoryTraits, id) {
+			return fmt.Errorf("trait %q only configures the build repositories and cannot be compared", id)
+		}
+	}
+
+	return nil
+}
+
+// dependencyKey returns the key the dependency is matched by, once normalized, i.e., its canonical form, without
+// version for the Maven dependencies that are not pinned when some dependencies are pinned, or the key of its
+// equivalence group if any.
+func (o Options) dependencyKey(dependency string) string {
+	dependency = dependencyNormalizer.Normalize(dependency)
+	key := o.coordinatesKey(dependency)
+	for i, group := range o.DependencyEquivalences {
+		for _, member := range strings.Split(group, ",") {
+			if o.isEquivalent(strings.TrimSpace(member), dependency, key) {
+				return fmt.Sprintf("equivalence:%d", i)
+			}
+		}
+	}
+
+	return key
+}
+
+// isEquivalent returns whether the dependency, whose key is given, is the member of an equivalence group.
+// A Maven member without version matches the dependency whatever its version.
+func (o Options) isEquivalent(member string, dependency string, key string) bool {
+	if member == "" {
+		return false
+	}
+	if !strings.HasPrefix(member, "mvn:") || !strings.HasPrefix(dependency, "mvn:") {
+		return o.coordinatesKey(member) == key
+	}
+	gav, err := maven.ParseGAV(strings.TrimPrefix(member, "mvn:"))
+	if err != nil || gav.Version != "" {
+		return o.coordinatesKey(member) == key
+	}
+	other, err := maven.ParseGAV(strings.TrimPrefix(dependency, "mvn:"))
+
+	return err == nil && gav.GroupID == other.GroupID && gav.ArtifactID == other.ArtifactID && gav.Classifier == other.Classifier
+}
+
+// isCoreDependency returns whether the dependency is a core dependency, i.e., all the dependencies when
+// no core dependency is configured.
+func (o Options) isCoreDependency(dependency string) bool {
+	if len(o.CoreDependencies) == 0 {
+		return true
+	}
+	key := o.coordinatesKey(dependency)
+	for _, core := range o.CoreDependencies {
+		if o.isEquivalent(strings.TrimSpace(core), dependency, key) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// coreDependencies returns the core dependencies among the given ones.
+func (o Options) coreDependencies(dependencies []string) []string {
+	if len(o.CoreDependencies) == 0 {
+		return dependencies
+	}
+	core := make([]string, 0, len(dependencies))
+	for _, dependency := range dependencies {
+		if o.isCoreDependency(dependency) {
+			core = append(core, dependency)
+		}
+	}
+
+	return core
+}
+
+// coordinatesKey returns the canonical form of the dependency, without version for the Maven dependencies
+// that are not pinned when some dependencies are pinned, and for the SNAPSHOT dependencies when their version
+// is ignored.
+func (o Options) coordinatesKey(dependency string) string {
+	canonical := CanonicalDependency(dependency)
+	if !strings.HasPrefix(dependency, "mvn:") {
+		return canonical
+	}
+	gav, err := maven.ParseGAV(strings.TrimPrefix(dependency, "mvn:"))
+	if err != nil {
+		return canonical
+	}
+	if o.SnapshotMatchMode == v1.IntegrationKitSnapshotMatchModeIgnoreVersion && isSnapshot(gav.Version) {
+		return strings.TrimSuffix(canonical, ":"+gav.Version)
+	}
+	if len(o.PinnedDependencies) == 0 {
+		return canonical
+	}
+	coordinates := gav.GroupID + ":" + gav.ArtifactID
+	for _, pinned := range o.PinnedDependencies {
+		if strings.TrimPrefix(pinned, "mvn:") == coordinates {
+			return canonical
+		}
+	}
+
+	return strings.TrimSuffix(canonical, ":"+gav.Version)
+}
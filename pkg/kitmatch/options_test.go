@@ -0,0 +1,573 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kitmatch
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/pkg/util/defaults"
+)
+
+func TestNewOptions(t *testing.T) {
+	assert.Equal(t, DefaultOptions(), NewOptions(nil))
+	assert.Equal(t, DefaultOptions(), NewOptions(&v1.IntegrationPlatform{}))
+
+	pl := &v1.IntegrationPlatform{}
+	pl.Status.Build.KitMatching.Mode = v1.IntegrationKitMatchModeDependenciesOnly
+	pl.Status.Build.KitMatching.TraitMatchMode = v1.IntegrationKitTraitMatchModeExplicitFields
+	pl.Status.Build.KitMatching.AllowExtraDependencies = pointer.Bool(false)
+	pl.Status.Build.KitMatching.ExtraDependenciesTolerance = 2
+	pl.Status.Build.KitMatching.RuntimeProviderUpgradeWindowEnd = &metav1.Time{Time: time.Now().Add(time.Hour)}
+	pl.Status.Build.KitMatching.ExcludeInvalid = true
+	pl.Status.Build.KitMatching.QuarantineThreshold = 3
+	pl.Status.Build.KitMatching.RuntimeVersionPrefixMatch = true
+	pl.Status.Build.KitMatching.DependencyMatchMode = v1.IntegrationKitDependencyMatchModeClosure
+	pl.Status.Build.KitMatching.SnapshotMatchMode = v1.IntegrationKitSnapshotMatchModeIgnoreVersion
+	pl.Status.Build.BuildStrategy = v1.BuildStrategyPod
+	pl.Status.Build.KitMatching.BuildStrategyInfluencing = true
+	pl.Status.Build.KitMatching.NonInfluencingAddons = []string{"my-addon"}
+	pl.Status.Build.KitMatching.MaxStatusGenerationSkew = pointer.Int64(0)
+	pl.Status.Build.KitMatching.PermissiveTraits = []string{"quarkus"}
+	pl.Status.Build.KitMatching.ExcludeImageless = true
+	pl.Status.Build.KitMatching.DependencyEquivalences = []string{"mvn:org.apache.camel:camel-foo,mvn:com.acme:camel-foo"}
+	pl.Status.Build.KitMatching.PreferClosestRuntimeConfig = true
+	pl.Status.Build.KitMatching.MatchDependencyTreeDigest = true
+	pl.Status.Build.KitMatching.InfluencingTraits = []string{"jolokia"}
+	pl.Status.Build.KitMatching.NonInfluencingTraits = []string{"builder"}
+	pl.Status.Build.KitMatching.CoreDependencies = []string{"camel:core"}
+	pl.Status.Build.KitMatching.ProfileCompatibility = map[v1.TraitProfile][]v1.TraitProfile{
+		v1.TraitProfileKubernetes: {v1.TraitProfileKnative},
+	}
+	pl.Status.Build.KitMatching.CanonicalTraits = true
+	pl.Status.Build.KitMatching.TraitFieldPaths = map[string]v1.IntegrationKitTraitFieldPaths{
+		"builder": {Exclude: []string{"/properties"}},
+	}
+	pl.Status.Build.KitMatching.IgnoreImageDependencies = true
+	pl.Status.Build.KitMatching.MatchSBOM = true
+	pl.Status.Build.KitMatching.VulnerabilityPenalty = 10
+	pl.Status.Build.KitMatching.MatchCatalogVersion = true
+	pl.Status.Build.KitMatching.ListExcludeErrors = true
+	pl.Status.Build.KitMatching.ListDisableCache = true
+	pl.Status.Build.KitMatching.MatchPlatformGeneration = true
+	pl.Generation = 3
+	pl.Status.Build.KitMatching.AllowedRegistries = []string{"registry.example.com/approved"}
+
+	assert.Equal(t, Options{
+		Mode:                       v1.IntegrationKitMatchModeDependenciesOnly,
+		TraitMatchMode:             v1.IntegrationKitTraitMatchModeExplicitFields,
+		MaxExtraDependencies:       2,
+		AllowOtherRuntimeProviders: true,
+		ExcludeInvalid:             true,
+		QuarantineThreshold:        3,
+		RuntimeVersionPrefixMatch:  true,
+		DependencyMatchMode:        v1.IntegrationKitDependencyMatchModeClosure,
+		SnapshotMatchMode:          v1.IntegrationKitSnapshotMatchModeIgnoreVersion,
+		BuildStrategy:              v1.BuildStrategyPod,
+		NonInfluencingAddons:       []string{"my-addon"},
+		MaxStatusGenerationSkew:    0,
+		PermissiveTraits:           []string{"quarkus"},
+		ExcludeImageless:           true,
+		DependencyEquivalences:     []string{"mvn:org.apache.camel:camel-foo,mvn:com.acme:camel-foo"},
+		PreferClosestRuntimeConfig: true,
+		MatchDependencyTreeDigest:  true,
+		InfluencingTraits:          []string{"jolokia"},
+		NonInfluencingTraits:       []string{"builder"},
+		CoreDependencies:           []string{"camel:core"},
+		ProfileCompatibility: map[v1.TraitProfile][]v1.TraitProfile{
+			v1.TraitProfileKubernetes: {v1.TraitProfileKnative},
+		},
+		CanonicalTraits: true,
+		TraitFieldPaths: map[string]v1.IntegrationKitTraitFieldPaths{
+			"builder": {Exclude: []string{"/properties"}},
+		},
+		IgnoreImageDependencies: true,
+		AllowedRegistries:       []string{"registry.example.com/approved"},
+		ListExcludeErrors:       true,
+		ListDisableCache:        true,
+		PlatformGeneration:      "3",
+		MatchCatalogVersion:     true,
+		VulnerabilityPenalty:    10,
+		MatchSBOM:               true,
+	}, NewOptions(pl))
+
+	// Extra dependencies are allowed explicitly and the upgrade window is over
+	pl.Status.Build.KitMatching.AllowExtraDependencies = pointer.Bool(true)
+	pl.Status.Build.KitMatching.RuntimeProviderUpgradeWindowEnd = &metav1.Time{Time: time.Now().Add(-time.Hour)}
+	options := NewOptions(pl)
+	assert.Equal(t, -1, options.MaxExtraDependencies)
+	assert.False(t, options.AllowOtherRuntimeProviders)
+}
+
+func TestIntegrationMatches_OptionCombinations(t *testing.T) {
+	integration := &v1.Integration{
+		Spec: v1.IntegrationSpec{
+			Traits: v1.Traits{
+				Builder: &traitv1.BuilderTrait{
+					Trait: traitv1.Trait{
+						Enabled: pointer.Bool(true),
+					},
+					Properties: []string{"build-key1=build-value1"},
+				},
+			},
+		},
+		Status: v1.IntegrationStatus{
+			RuntimeProvider: v1.RuntimeProviderQuarkus,
+			Dependencies:    []string{"camel:core"},
+		},
+	}
+	// The kit is built for another provider, with an extra dependency,
+	// and does not explicitly enable the builder trait
+	kit := &v1.IntegrationKit{
+		Spec: v1.IntegrationKitSpec{
+			Dependencies: []string{"camel:core", "camel:http"},
+			Traits: v1.IntegrationKitTraits{
+				Builder: &traitv1.BuilderTrait{
+					Properties: []string{"build-key1=build-value1"},
+				},
+			},
+		},
+		Status: v1.IntegrationKitStatus{
+			Phase:           v1.IntegrationKitPhaseReady,
+			RuntimeProvider: "other",
+		},
+	}
+
+	tests := []struct {
+		name    string
+		options func(*Options)
+		match   bool
+	}{
+		{
+			name:  "defaults",
+			match: false,
+		},
+		{
+			name: "other providers allowed",
+			options: func(o *Options) {
+				o.AllowOtherRuntimeProviders = true
+			},
+			match: false,
+		},
+		{
+			name: "other providers allowed and explicit fields trait matching",
+			options: func(o *Options) {
+				o.AllowOtherRuntimeProviders = true
+				o.TraitMatchMode = v1.IntegrationKitTraitMatchModeExplicitFields
+			},
+			match: true,
+		},
+		{
+			name: "other providers allowed and dependencies only matching",
+			options: func(o *Options) {
+				o.AllowOtherRuntimeProviders = true
+				o.Mode = v1.IntegrationKitMatchModeDependenciesOnly
+			},
+			match: true,
+		},
+		{
+			name: "other providers allowed, dependencies only matching and no extra dependencies",
+			options: func(o *Options) {
+				o.AllowOtherRuntimeProviders = true
+				o.Mode = v1.IntegrationKitMatchModeDependenciesOnly
+				o.MaxExtraDependencies = 0
+			},
+			match: false,
+		},
+		{
+			name: "other providers allowed, dependencies only matching and invalid kits excluded",
+			options: func(o *Options) {
+				o.AllowOtherRuntimeProviders = true
+				o.Mode = v1.IntegrationKitMatchModeDependenciesOnly
+				o.ExcludeInvalid = true
+			},
+			match: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			options := DefaultOptions()
+			if test.options != nil {
+				test.options(&options)
+			}
+
+			match, err := IntegrationMatches(integration, kit, options)
+			assert.Nil(t, err)
+			assert.Equal(t, test.match, match)
+		})
+	}
+}
+
+func TestIntegrationMatches_ImageStreamBackedKits(t *testing.T) {
+	integration := &v1.Integration{
+		Status: v1.IntegrationStatus{
+			Dependencies: []string{"camel:core"},
+		},
+	}
+	registryKit := &v1.IntegrationKit{
+		Spec: v1.IntegrationKitSpec{
+			Dependencies: []string{"camel:core"},
+		},
+		Status: v1.IntegrationKitStatus{
+			Phase: v1.IntegrationKitPhaseReady,
+			Image: "quay.io/my-org/camel-k-kit-registry@sha256:0123456789",
+		},
+	}
+	imageStreamKit := &v1.IntegrationKit{
+		Spec: v1.IntegrationKitSpec{
+			Dependencies: []string{"camel:core"},
+		},
+		Status: v1.IntegrationKitStatus{
+			Phase:       v1.IntegrationKitPhaseReady,
+			Image:       "image-registry.openshift-image-registry.svc:5000/ns/camel-k-kit-s2i@sha256:0123456789",
+			ImageStream: "ns/camel-k-kit-s2i",
+		},
+	}
+
+	pl := &v1.IntegrationPlatform{}
+	pl.Status.Build.PublishStrategy = v1.IntegrationPlatformBuildPublishStrategySpectrum
+	options := NewOptions(pl)
+	assert.False(t, options.RequireImageStream)
+
+	for _, kit := range []*v1.IntegrationKit{registryKit, imageStreamKit} {
+		match, err := IntegrationMatches(integration, kit, options)
+		assert.Nil(t, err)
+		assert.True(t, match)
+	}
+
+	pl.Status.Build.PublishStrategy = v1.IntegrationPlatformBuildPublishStrategyS2I
+	options = NewOptions(pl)
+	assert.True(t, options.RequireImageStream)
+
+	match, err := IntegrationMatches(integration, registryKit, options)
+	assert.Nil(t, err)
+	assert.False(t, match)
+
+	match, err = IntegrationMatches(integration, imageStreamKit, options)
+	assert.Nil(t, err)
+	assert.True(t, match)
+}
+
+func TestIntegrationMatches_BuildStrategy(t *testing.T) {
+	integration := &v1.Integration{
+		Status: v1.IntegrationStatus{
+			Dependencies: []string{"camel:core"},
+		},
+	}
+	kit := func(strategy v1.BuildStrategy, phase v1.IntegrationKitPhase) *v1.IntegrationKit {
+		return &v1.IntegrationKit{
+			Spec: v1.IntegrationKitSpec{
+				Dependencies: []string{"camel:core"},
+			},
+			Status: v1.IntegrationKitStatus{
+				Phase:         phase,
+				BuildStrategy: strategy,
+			},
+		}
+	}
+
+	pl := &v1.IntegrationPlatform{}
+	pl.Status.Build.BuildStrategy = v1.BuildStrategyPod
+
+	testCases := []struct {
+		name        string
+		kit         *v1.IntegrationKit
+		influencing bool
+		match       bool
+	}{
+		{
+			name:  "different strategy not influencing",
+			kit:   kit(v1.BuildStrategyRoutine, v1.IntegrationKitPhaseReady),
+			match: true,
+		},
+		{
+			name:        "same strategy influencing",
+			kit:         kit(v1.BuildStrategyPod, v1.IntegrationKitPhaseReady),
+			influencing: true,
+			match:       true,
+		},
+		{
+			name:        "different strategy influencing",
+			kit:         kit(v1.BuildStrategyRoutine, v1.IntegrationKitPhaseReady),
+			influencing: true,
+			match:       false,
+		},
+		{
+			name:        "unknown strategy of a ready kit influencing",
+			kit:         kit("", v1.IntegrationKitPhaseReady),
+			influencing: true,
+			match:       false,
+		},
+		{
+			name:        "kit not built yet influencing",
+			kit:         kit("", v1.IntegrationKitPhaseBuildSubmitted),
+			influencing: true,
+			match:       true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			pl.Status.Build.KitMatching.BuildStrategyInfluencing = tc.influencing
+
+			match, err := IntegrationMatches(integration, tc.kit, NewOptions(pl))
+			assert.Nil(t, err)
+			assert.Equal(t, tc.match, match)
+		})
+	}
+}
+
+func TestIntegrationMatches_PinnedDependencies(t *testing.T) {
+	integration := &v1.Integration{
+		Status: v1.IntegrationStatus{
+			Dependencies: []string{
+				"camel:core",
+				"mvn:org.my:lib:1.1",
+				"mvn:org.my:security:2.0.1",
+			},
+		},
+	}
+	kit := func(dependencies ...string) *v1.IntegrationKit {
+		return &v1.IntegrationKit{
+			Spec: v1.IntegrationKitSpec{
+				Dependencies: dependencies,
+			},
+			Status: v1.IntegrationKitStatus{
+				Phase: v1.IntegrationKitPhaseReady,
+			},
+		}
+	}
+
+	pl := &v1.IntegrationPlatform{}
+	pl.Status.Build.KitMatching.PinnedDependencies = []string{"org.my:security"}
+	options := NewOptions(pl)
+	assert.Equal(t, []string{"org.my:security"}, options.PinnedDependencies)
+
+	testCases := []struct {
+		name  string
+		kit   *v1.IntegrationKit
+		match bool
+	}{
+		{
+			name:  "same versions",
+			kit:   kit("camel:core", "mvn:org.my:lib:1.1", "mvn:org.my:security:2.0.1"),
+			match: true,
+		},
+		{
+			name:  "loose dependency version mismatch",
+			kit:   kit("camel:core", "mvn:org.my:lib:jar:1.0", "mvn:org.my:security:2.0.1"),
+			match: true,
+		},
+		{
+			name:  "pinned dependency version mismatch",
+			kit:   kit("camel:core", "mvn:org.my:lib:1.1", "mvn:org.my:security:2.0.0"),
+			match: false,
+		},
+		{
+			name:  "loose dependency classifier mismatch",
+			kit:   kit("camel:core", "mvn:org.my:lib:jar:tests:1.1", "mvn:org.my:security:2.0.1"),
+			match: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			match, err := IntegrationMatches(integration, tc.kit, options)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.match, match)
+		})
+	}
+
+	// All the versions must match when no dependency is pinned
+	match, err := IntegrationMatches(integration, kit("camel:core", "mvn:org.my:lib:1.0", "mvn:org.my:security:2.0.1"), DefaultOptions())
+	assert.Nil(t, err)
+	assert.False(t, match)
+}
+
+func TestIntegrationMatches_PermissiveTraits(t *testing.T) {
+	integration := &v1.Integration{
+		Spec: v1.IntegrationSpec{
+			Traits: v1.Traits{
+				Builder: &traitv1.BuilderTrait{
+					Properties: []string{"build-key1=build-value1"},
+				},
+			},
+		},
+	}
+	kit := &v1.IntegrationKit{
+		Spec: v1.IntegrationKitSpec{
+			Traits: v1.IntegrationKitTraits{
+				Builder: &traitv1.BuilderTrait{
+					Properties: []string{"build-key1=build-value1"},
+				},
+				Quarkus: &traitv1.QuarkusTrait{
+					PackageTypes: []traitv1.QuarkusPackageType{traitv1.FastJarPackageType},
+				},
+			},
+		},
+		Status: v1.IntegrationKitStatus{
+			Phase: v1.IntegrationKitPhaseReady,
+		},
+	}
+
+	// The integration omits the quarkus trait that the kit configures
+	match, err := IntegrationMatches(integration, kit, DefaultOptions())
+	assert.Nil(t, err)
+	assert.False(t, match)
+
+	pl := &v1.IntegrationPlatform{}
+	pl.Status.Build.KitMatching.PermissiveTraits = []string{"quarkus"}
+	match, err = IntegrationMatches(integration, kit, NewOptions(pl))
+	assert.Nil(t, err)
+	assert.True(t, match)
+
+	// The permissive traits that the integration configures must still match
+	integration.Spec.Traits.Quarkus = &traitv1.QuarkusTrait{
+		Trait: traitv1.Trait{
+			Enabled: pointer.Bool(false),
+		},
+	}
+	match, err = IntegrationMatches(integration, kit, NewOptions(pl))
+	assert.Nil(t, err)
+	assert.False(t, match)
+
+	// The other traits are not permissive
+	integration.Spec.Traits.Quarkus = nil
+	integration.Spec.Traits.Builder = nil
+	match, err = IntegrationMatches(integration, kit, NewOptions(pl))
+	assert.Nil(t, err)
+	assert.False(t, match)
+}
+
+func TestIntegrationMatches_ExcludeImageless(t *testing.T) {
+	integration := &v1.Integration{
+		Status: v1.IntegrationStatus{
+			Dependencies: []string{"camel:core"},
+		},
+	}
+	kit := func(phase v1.IntegrationKitPhase, image string) *v1.IntegrationKit {
+		return &v1.IntegrationKit{
+			Spec: v1.IntegrationKitSpec{
+				Dependencies: []string{"camel:core"},
+			},
+			Status: v1.IntegrationKitStatus{
+				Phase: phase,
+				Image: image,
+			},
+		}
+	}
+
+	testCases := []struct {
+		name    string
+		kit     *v1.IntegrationKit
+		match   bool
+		exclude bool
+	}{
+		{
+			name:  "ready with image",
+			kit:   kit(v1.IntegrationKitPhaseReady, "my-registry/my-kit@sha256:123"),
+			match: true,
+		},
+		{
+			name:    "ready without image",
+			kit:     kit(v1.IntegrationKitPhaseReady, ""),
+			match:   false,
+			exclude: true,
+		},
+		{
+			name:  "building without image",
+			kit:   kit(v1.IntegrationKitPhaseBuildRunning, ""),
+			match: true,
+		},
+		{
+			name:  "submitted without image",
+			kit:   kit(v1.IntegrationKitPhaseBuildSubmitted, ""),
+			match: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// The kits without image are matched by default
+			match, err := IntegrationMatches(integration, tc.kit, DefaultOptions())
+			assert.Nil(t, err)
+			assert.True(t, match)
+
+			pl := &v1.IntegrationPlatform{}
+			pl.Status.Build.KitMatching.ExcludeImageless = true
+			decision, err := Match(integration, tc.kit, NewOptions(pl))
+			assert.Nil(t, err)
+			assert.Equal(t, tc.match, decision.Matched)
+			if tc.exclude {
+				assert.Equal(t, "Integration kit is ready but has no image", decision.Reason)
+			}
+		})
+	}
+}
+
+func TestNewOptions_OperatorVersion(t *testing.T) {
+	pl := v1.NewIntegrationPlatform("ns", "camel-k")
+	assert.Equal(t, "", NewOptions(&pl).OperatorVersion)
+
+	pl.Status.Build.KitMatching.RequireOperatorVersion = true
+	assert.Equal(t, defaults.Version, NewOptions(&pl).OperatorVersion)
+
+	pl.Status.Build.KitMatching.OperatorVersionRange = "~1.9.0"
+	assert.Equal(t, "~1.9.0", NewOptions(&pl).OperatorVersion)
+
+	// The range only applies when the operator version is required
+	pl.Status.Build.KitMatching.RequireOperatorVersion = false
+	assert.Equal(t, "", NewOptions(&pl).OperatorVersion)
+}
+
+func TestValidateTraits(t *testing.T) {
+	matching := v1.IntegrationPlatformKitMatchingSpec{
+		InfluencingTraits:    []string{"jolokia", "prometheus"},
+		NonInfluencingTraits: []string{"builder"},
+	}
+	assert.Nil(t, ValidateTraits(matching))
+
+	matching.NonInfluencingTraits = []string{"builder", "my-trait"}
+	err := ValidateTraits(matching)
+	assert.NotNil(t, err)
+	assert.Equal(t, `unknown trait "my-trait"`, err.Error())
+
+	matching.NonInfluencingTraits = []string{"builder", "jolokia"}
+	err = ValidateTraits(matching)
+	assert.NotNil(t, err)
+	assert.Equal(t, `trait "jolokia" is configured to be both compared and ignored`, err.Error())
+
+	matching.InfluencingTraits = []string{"jolokia", "toleration"}
+	matching.NonInfluencingTraits = []string{"builder"}
+	err = ValidateTraits(matching)
+	assert.NotNil(t, err)
+	assert.Equal(t, `trait "toleration" only configures the scheduling and cannot be compared`, err.Error())
+
+	matching.InfluencingTraits = []string{"jolokia", "registry"}
+	err = ValidateTraits(matching)
+	assert.NotNil(t, err)
+	assert.Equal(t, `trait "registry" only configures the build repositories and cannot be compared`, err.Error())
+}
@@ -0,0 +1,142 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kitmatch
+
+import (
+	"fmt"
+	"strings"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+)
+
+// parsePointer returns the reference tokens of the given JSON Pointer, as defined by RFC 6901,
+// the empty pointer referencing the whole configuration.
+func parsePointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return []string{}, nil
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("invalid JSON Pointer %q, must start with /", pointer)
+	}
+
+	tokens := strings.Split(pointer[1:], "/")
+	for i, token := range tokens {
+		// ~1 is unescaped first, so that ~01 is unescaped to ~1 rather than /
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+	}
+
+	return tokens, nil
+}
+
+// projectTrait returns the trait configuration restricted to the fields referenced by the included JSON Pointers,
+// if any, without the fields referenced by the excluded ones. The pointers address the fields of nested objects,
+// the arrays being compared as a whole, and the pointers to the fields that are not set are ignored.
+func projectTrait(config map[string]interface{}, paths v1.IntegrationKitTraitFieldPaths) (map[string]interface{}, error) {
+	projected := copyFields(config)
+	if len(paths.Include) > 0 {
+		projected = make(map[string]interface{})
+		for _, pointer := range paths.Include {
+			tokens, err := parsePointer(pointer)
+			if err != nil {
+				return nil, err
+			}
+			if len(tokens) == 0 {
+				projected = copyFields(config)
+				continue
+			}
+			if value, ok := lookupField(config, tokens); ok {
+				if object, ok := value.(map[string]interface{}); ok {
+					value = copyFields(object)
+				}
+				setField(projected, tokens, value)
+			}
+		}
+	}
+	for _, pointer := range paths.Exclude {
+		tokens, err := parsePointer(pointer)
+		if err != nil {
+			return nil, err
+		}
+		if len(tokens) == 0 {
+			return map[string]interface{}{}, nil
+		}
+		removeField(projected, tokens)
+	}
+
+	return projected, nil
+}
+
+// lookupField returns the value of the field referenced by the tokens, and whether it is set.
+func lookupField(config map[string]interface{}, tokens []string) (interface{}, bool) {
+	var value interface{} = config
+	for _, token := range tokens {
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if value, ok = object[token]; !ok {
+			return nil, false
+		}
+	}
+
+	return value, true
+}
+
+// setField sets the field referenced by the tokens, creating the parent objects that are missing.
+func setField(config map[string]interface{}, tokens []string, value interface{}) {
+	object := config
+	for _, token := range tokens[:len(tokens)-1] {
+		child, ok := object[token].(map[string]interface{})
+		if !ok {
+			child = make(map[string]interface{})
+			object[token] = child
+		}
+		object = child
+	}
+	object[tokens[len(tokens)-1]] = value
+}
+
+// removeField removes the field referenced by the tokens, and the parent objects it leaves empty.
+func removeField(config map[string]interface{}, tokens []string) {
+	if len(tokens) == 1 {
+		delete(config, tokens[0])
+		return
+	}
+	child, ok := config[tokens[0]].(map[string]interface{})
+	if !ok {
+		return
+	}
+	removeField(child, tokens[1:])
+	if len(child) == 0 {
+		delete(config, tokens[0])
+	}
+}
+
+// copyFields returns a copy of the trait configuration, whose nested objects are copied as well,
+// so that they can be projected without altering the configuration.
+func copyFields(config map[string]interface{}) map[string]interface{} {
+	copied := make(map[string]interface{}, len(config))
+	for field, value := range config {
+		if object, ok := value.(map[string]interface{}); ok {
+			value = copyFields(object)
+		}
+		copied[field] = value
+	}
+
+	return copied
+}
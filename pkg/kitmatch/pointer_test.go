@@ -0,0 +1,236 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kitmatch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/trait"
+)
+
+func TestParsePointer(t *testing.T) {
+	tokens, err := parsePointer("")
+	assert.Nil(t, err)
+	assert.Empty(t, tokens)
+
+	tokens, err = parsePointer("/properties/a~1b/c~0d/~01")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"properties", "a/b", "c~d", "~1"}, tokens)
+
+	_, err = parsePointer("properties")
+	assert.NotNil(t, err)
+}
+
+func TestProjectTrait(t *testing.T) {
+	config := map[string]interface{}{
+		"enabled": true,
+		"tags":    []interface{}{"a", "b"},
+		"options": map[string]interface{}{
+			"level": "debug",
+			"limits": map[string]interface{}{
+				"cpu":    "1",
+				"memory": "1Gi",
+			},
+		},
+	}
+
+	testCases := []struct {
+		name      string
+		paths     v1.IntegrationKitTraitFieldPaths
+		projected map[string]interface{}
+	}{
+		{
+			name:      "no paths",
+			projected: config,
+		},
+		{
+			name: "include",
+			paths: v1.IntegrationKitTraitFieldPaths{
+				Include: []string{"/enabled", "/options/limits/cpu", "/missing", "/tags/0"},
+			},
+			projected: map[string]interface{}{
+				"enabled": true,
+				"options": map[string]interface{}{
+					"limits": map[string]interface{}{
+						"cpu": "1",
+					},
+				},
+			},
+		},
+		{
+			name: "exclude",
+			paths: v1.IntegrationKitTraitFieldPaths{
+				Exclude: []string{"/options/level", "/options/limits/cpu", "/options/limits/memory", "/missing/field"},
+			},
+			projected: map[string]interface{}{
+				"enabled": true,
+				"tags":    []interface{}{"a", "b"},
+			},
+		},
+		{
+			name: "include and exclude",
+			paths: v1.IntegrationKitTraitFieldPaths{
+				Include: []string{"/options"},
+				Exclude: []string{"/options/limits/memory"},
+			},
+			projected: map[string]interface{}{
+				"options": map[string]interface{}{
+					"level": "debug",
+					"limits": map[string]interface{}{
+						"cpu": "1",
+					},
+				},
+			},
+		},
+		{
+			name: "whole configuration",
+			paths: v1.IntegrationKitTraitFieldPaths{
+				Include: []string{""},
+				Exclude: []string{"/options"},
+			},
+			projected: map[string]interface{}{
+				"enabled": true,
+				"tags":    []interface{}{"a", "b"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			projected, err := projectTrait(config, tc.paths)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.projected, projected)
+		})
+	}
+
+	// The configuration is left unaltered
+	assert.Equal(t, "1Gi", config["options"].(map[string]interface{})["limits"].(map[string]interface{})["memory"])
+
+	_, err := projectTrait(config, v1.IntegrationKitTraitFieldPaths{Exclude: []string{"options"}})
+	assert.NotNil(t, err)
+}
+
+func TestIntegrationMatches_TraitFieldPaths(t *testing.T) {
+	addon := &kitAddonTrait{BaseTrait: trait.NewBaseTrait("my-addon", 2000)}
+	newIntegration := func(config map[string]interface{}) *v1.Integration {
+		return &v1.Integration{
+			Spec: v1.IntegrationSpec{
+				Traits: v1.Traits{
+					Addons: map[string]v1.AddonTrait{
+						"my-addon": trait.ToAddonTrait(t, config),
+					},
+				},
+			},
+		}
+	}
+	kit := &v1.IntegrationKit{
+		Spec: v1.IntegrationKitSpec{
+			Traits: v1.IntegrationKitTraits{
+				Addons: map[string]v1.AddonTrait{
+					"my-addon": trait.ToAddonTrait(t, map[string]interface{}{
+						"key": "value",
+						"options": map[string]interface{}{
+							"level":  "info",
+							"stream": "stdout",
+						},
+					}),
+				},
+			},
+		},
+		Status: v1.IntegrationKitStatus{
+			Phase: v1.IntegrationKitPhaseReady,
+		},
+	}
+	config := map[string]interface{}{
+		"key": "value",
+		"options": map[string]interface{}{
+			"level":  "debug",
+			"stream": "stdout",
+		},
+	}
+
+	testCases := []struct {
+		name  string
+		paths map[string]v1.IntegrationKitTraitFieldPaths
+		match bool
+	}{
+		{
+			name:  "all fields",
+			match: false,
+		},
+		{
+			name: "other trait",
+			paths: map[string]v1.IntegrationKitTraitFieldPaths{
+				"builder": {Include: []string{"/properties"}},
+			},
+			match: false,
+		},
+		{
+			name: "included fields",
+			paths: map[string]v1.IntegrationKitTraitFieldPaths{
+				"my-addon": {Include: []string{"/key", "/options/stream"}},
+			},
+			match: true,
+		},
+		{
+			name: "included differing field",
+			paths: map[string]v1.IntegrationKitTraitFieldPaths{
+				"my-addon": {Include: []string{"/key", "/options/level"}},
+			},
+			match: false,
+		},
+		{
+			name: "excluded fields",
+			paths: map[string]v1.IntegrationKitTraitFieldPaths{
+				"my-addon": {Exclude: []string{"/options/level"}},
+			},
+			match: true,
+		},
+		{
+			name: "included and excluded fields",
+			paths: map[string]v1.IntegrationKitTraitFieldPaths{
+				"my-addon": {Include: []string{"/options"}, Exclude: []string{"/options/level"}},
+			},
+			match: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			options := DefaultOptions()
+			options.influencingTraits = append(KitInfluencingTraits(), addon)
+			options.TraitFieldPaths = tc.paths
+
+			match, err := IntegrationMatches(newIntegration(config), kit, options)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.match, match)
+		})
+	}
+
+	// The invalid pointers fail the matching
+	options := DefaultOptions()
+	options.influencingTraits = append(KitInfluencingTraits(), addon)
+	options.TraitFieldPaths = map[string]v1.IntegrationKitTraitFieldPaths{
+		"my-addon": {Include: []string{"key"}},
+	}
+	_, err := IntegrationMatches(newIntegration(config), kit, options)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,128 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kitmatch
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+)
+
+// The keys of the match policy ConfigMap.
+const (
+	// PolicyStrictnessKey configures a preset of the matching options, i.e., strict or loose
+	PolicyStrictnessKey = "strictness"
+	// PolicyMatchModeKey configures whether the traits are compared, or only the dependencies
+	PolicyMatchModeKey = "matchMode"
+	// PolicyTraitMatchModeKey configures how the traits are compared
+	PolicyTraitMatchModeKey = "traitMatchMode"
+	// PolicyDependencyMatchModeKey configures how the dependencies are checked
+	PolicyDependencyMatchModeKey = "dependencyMatchMode"
+	// PolicyMaxExtraDependenciesKey configures the number of extra dependencies a kit can provide, or -1 for any
+	PolicyMaxExtraDependenciesKey = "maxExtraDependencies"
+)
+
+// The strictness presets of the match policy.
+const (
+	// PolicyStrict only matches the kits whose traits are equal and whose dependencies are the ones of the integration
+	PolicyStrict = "strict"
+	// PolicyLoose matches the kits that provide the dependencies of the integration, even transitively,
+	// whatever their traits
+	PolicyLoose = "loose"
+)
+
+// NewNamespaceOptions returns the matching options configured on the given platform, that may be nil, overridden
+// by the match policy ConfigMap of the namespace, if any.
+func NewNamespaceOptions(ctx context.Context, c ctrl.Reader, pl *v1.IntegrationPlatform, namespace string) (Options, error) {
+	options := NewOptions(pl)
+
+	cm := corev1.ConfigMap{}
+	err := c.Get(ctx, ctrl.ObjectKey{Namespace: namespace, Name: v1.IntegrationKitMatchPolicyConfigMap}, &cm)
+	if k8serrors.IsNotFound(err) {
+		return options, nil
+	} else if err != nil {
+		return options, err
+	}
+
+	if err := ApplyPolicy(&options, cm.Data); err != nil {
+		return options, fmt.Errorf("invalid match policy %s/%s: %w", namespace, v1.IntegrationKitMatchPolicyConfigMap, err)
+	}
+
+	return options, nil
+}
+
+// ApplyPolicy overrides the matching options with the given match policy. The strictness preset is applied
+// first, the other keys overriding it.
+func ApplyPolicy(options *Options, policy map[string]string) error {
+	switch strictness := policy[PolicyStrictnessKey]; strictness {
+	case "":
+	case PolicyStrict:
+		options.Mode = v1.IntegrationKitMatchModeFull
+		options.TraitMatchMode = v1.IntegrationKitTraitMatchModeExact
+		options.DependencyMatchMode = v1.IntegrationKitDependencyMatchModeSuperset
+		options.MaxExtraDependencies = 0
+	case PolicyLoose:
+		options.Mode = v1.IntegrationKitMatchModeDependenciesOnly
+		options.TraitMatchMode = v1.IntegrationKitTraitMatchModeExplicitFields
+		options.DependencyMatchMode = v1.IntegrationKitDependencyMatchModeClosure
+		options.MaxExtraDependencies = -1
+	default:
+		return fmt.Errorf("unknown %s %q", PolicyStrictnessKey, strictness)
+	}
+
+	if mode, ok := policy[PolicyMatchModeKey]; ok {
+		switch m := v1.IntegrationKitMatchMode(mode); m {
+		case v1.IntegrationKitMatchModeFull, v1.IntegrationKitMatchModeDependenciesOnly:
+			options.Mode = m
+		default:
+			return fmt.Errorf("unknown %s %q", PolicyMatchModeKey, mode)
+		}
+	}
+	if mode, ok := policy[PolicyTraitMatchModeKey]; ok {
+		switch m := v1.IntegrationKitTraitMatchMode(mode); m {
+		case v1.IntegrationKitTraitMatchModeExact, v1.IntegrationKitTraitMatchModeExplicitFields, v1.IntegrationKitTraitMatchModeUnion:
+			options.TraitMatchMode = m
+		default:
+			return fmt.Errorf("unknown %s %q", PolicyTraitMatchModeKey, mode)
+		}
+	}
+	if mode, ok := policy[PolicyDependencyMatchModeKey]; ok {
+		switch m := v1.IntegrationKitDependencyMatchMode(mode); m {
+		case v1.IntegrationKitDependencyMatchModeSuperset, v1.IntegrationKitDependencyMatchModeClosure:
+			options.DependencyMatchMode = m
+		default:
+			return fmt.Errorf("unknown %s %q", PolicyDependencyMatchModeKey, mode)
+		}
+	}
+	if value, ok := policy[PolicyMaxExtraDependenciesKey]; ok {
+		max, err := strconv.Atoi(value)
+		if err != nil || max < -1 {
+			return fmt.Errorf("invalid %s %q", PolicyMaxExtraDependenciesKey, value)
+		}
+		options.MaxExtraDependencies = max
+	}
+
+	return nil
+}
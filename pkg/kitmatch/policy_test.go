@@ -0,0 +1,67 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kitmatch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+)
+
+func TestApplyPolicy(t *testing.T) {
+	options := DefaultOptions()
+	assert.Nil(t, ApplyPolicy(&options, map[string]string{
+		PolicyStrictnessKey: PolicyStrict,
+	}))
+	assert.Equal(t, v1.IntegrationKitMatchModeFull, options.Mode)
+	assert.Equal(t, v1.IntegrationKitTraitMatchModeExact, options.TraitMatchMode)
+	assert.Equal(t, v1.IntegrationKitDependencyMatchModeSuperset, options.DependencyMatchMode)
+	assert.Equal(t, 0, options.MaxExtraDependencies)
+
+	// The other keys override the strictness preset
+	options = DefaultOptions()
+	assert.Nil(t, ApplyPolicy(&options, map[string]string{
+		PolicyStrictnessKey:           PolicyLoose,
+		PolicyMatchModeKey:            string(v1.IntegrationKitMatchModeFull),
+		PolicyMaxExtraDependenciesKey: "2",
+	}))
+	assert.Equal(t, v1.IntegrationKitMatchModeFull, options.Mode)
+	assert.Equal(t, v1.IntegrationKitTraitMatchModeExplicitFields, options.TraitMatchMode)
+	assert.Equal(t, v1.IntegrationKitDependencyMatchModeClosure, options.DependencyMatchMode)
+	assert.Equal(t, 2, options.MaxExtraDependencies)
+
+	options = DefaultOptions()
+	assert.Nil(t, ApplyPolicy(&options, map[string]string{
+		PolicyTraitMatchModeKey: string(v1.IntegrationKitTraitMatchModeUnion),
+	}))
+	assert.Equal(t, v1.IntegrationKitTraitMatchModeUnion, options.TraitMatchMode)
+
+	for _, policy := range []map[string]string{
+		{PolicyStrictnessKey: "lenient"},
+		{PolicyMatchModeKey: "partial"},
+		{PolicyTraitMatchModeKey: "partial"},
+		{PolicyDependencyMatchModeKey: "partial"},
+		{PolicyMaxExtraDependenciesKey: "-2"},
+		{PolicyMaxExtraDependenciesKey: "many"},
+	} {
+		options = DefaultOptions()
+		assert.NotNil(t, ApplyPolicy(&options, policy), "%v", policy)
+	}
+}
@@ -0,0 +1,134 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kitmatch
+
+import (
+	"encoding/json"
+	"strings"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/util/maven"
+)
+
+// sbomComponent is a component of a CycloneDX software bill of materials, that may include other components.
+type sbomComponent struct {
+	Group      string          `json:"group,omitempty"`
+	Name       string          `json:"name"`
+	Version    string          `json:"version,omitempty"`
+	Purl       string          `json:"purl,omitempty"`
+	Components []sbomComponent `json:"components,omitempty"`
+}
+
+// sbom is a CycloneDX software bill of materials, as recorded on the kits.
+type sbom struct {
+	Components []sbomComponent `json:"components,omitempty"`
+}
+
+// sbomMatches returns whether the SBOM recorded on the kit lists the components the integration dependencies resolve
+// to, the versions of the Maven dependencies declaring one included. The dependencies that do not resolve to
+// Maven components, e.g. the file dependencies, are not checked.
+func sbomMatches(integration *v1.Integration, kit *v1.IntegrationKit) Decision {
+	data, ok := kit.Annotations[v1.IntegrationKitSBOMAnnotation]
+	if !ok || data == "" {
+		return Mismatch("Integration-kit has no SBOM")
+	}
+	var document sbom
+	if err := json.Unmarshal([]byte(data), &document); err != nil {
+		return Mismatch("Integration-kit SBOM cannot be parsed", err.Error())
+	}
+	components := make(map[string][]string)
+	indexComponents(document.Components, components)
+
+	missing := make([]string, 0)
+	for _, dependency := range integration.Status.Dependencies {
+		groupID, artifactID, ok := dependencyCoordinates(dependency, kit.Status.RuntimeProvider)
+		if !ok {
+			continue
+		}
+		versions, ok := components[groupID+":"+artifactID]
+		if !ok || !versionListed(versions, dependencyVersion(dependency)) {
+			missing = append(missing, dependency)
+		}
+	}
+	if len(missing) > 0 {
+		return Mismatch("Integration-kit SBOM does not list the components of the integration dependencies", missing...)
+	}
+
+	return Decision{Matched: true}
+}
+
+// indexComponents indexes the versions of the components, and of their nested components, by Maven coordinates.
+func indexComponents(components []sbomComponent, index map[string][]string) {
+	for _, component := range components {
+		group, name, version := component.Group, component.Name, component.Version
+		if g, n, v, ok := parseMavenPurl(component.Purl); ok {
+			group, name, version = g, n, v
+		}
+		key := group + ":" + name
+		index[key] = append(index[key], version)
+		indexComponents(component.Components, index)
+	}
+}
+
+// parseMavenPurl returns the coordinates of the Maven package URL, e.g. `pkg:maven/org.apache.camel/camel-core@3.18.0?type=jar`.
+func parseMavenPurl(purl string) (string, string, string, bool) {
+	if !strings.HasPrefix(purl, "pkg:maven/") {
+		return "", "", "", false
+	}
+	purl = strings.TrimPrefix(purl, "pkg:maven/")
+	if i := strings.IndexAny(purl, "?#"); i >= 0 {
+		purl = purl[:i]
+	}
+	version := ""
+	if i := strings.LastIndex(purl, "@"); i >= 0 {
+		purl, version = purl[:i], purl[i+1:]
+	}
+	i := strings.LastIndex(purl, "/")
+	if i < 0 {
+		return "", "", "", false
+	}
+
+	return purl[:i], purl[i+1:], version, true
+}
+
+// dependencyVersion returns the version of the Maven dependency, or empty if it declares none.
+func dependencyVersion(dependency string) string {
+	if !strings.HasPrefix(dependency, "mvn:") {
+		return ""
+	}
+	gav, err := maven.ParseGAV(strings.TrimPrefix(dependency, "mvn:"))
+	if err != nil {
+		return ""
+	}
+
+	return gav.Version
+}
+
+// versionListed returns whether the version is one of the listed versions, any version being listed when empty.
+func versionListed(versions []string, version string) bool {
+	if version == "" {
+		return true
+	}
+	for _, v := range versions {
+		if v == version {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,139 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kitmatch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+)
+
+func TestIntegrationMatches_SBOM(t *testing.T) {
+	integration := &v1.Integration{
+		Status: v1.IntegrationStatus{
+			Dependencies: []string{
+				"camel:core",
+				"mvn:org.acme:acme-client:1.2.0",
+				"file:/tmp/my-lib.jar",
+			},
+		},
+	}
+
+	testCases := []struct {
+		name   string
+		strict bool
+		sbom   string
+		match  bool
+		reason string
+	}{
+		{
+			name:   "components listed",
+			strict: true,
+			sbom: `{"bomFormat":"CycloneDX","components":[
+				{"group":"org.apache.camel","name":"camel-core","version":"3.18.0"},
+				{"group":"org.acme","name":"acme-client","version":"1.2.0"}]}`,
+			match: true,
+		},
+		{
+			name:   "components listed by package URL and nested",
+			strict: true,
+			sbom: `{"bomFormat":"CycloneDX","components":[
+				{"name":"camel-core","purl":"pkg:maven/org.apache.camel/camel-core@3.18.0?type=jar","components":[
+					{"name":"acme-client","purl":"pkg:maven/org.acme/acme-client@1.2.0"}]}]}`,
+			match: true,
+		},
+		{
+			name:   "component missing",
+			strict: true,
+			sbom: `{"bomFormat":"CycloneDX","components":[
+				{"group":"org.apache.camel","name":"camel-core","version":"3.18.0"}]}`,
+			match:  false,
+			reason: "Integration-kit SBOM does not list the components of the integration dependencies",
+		},
+		{
+			name:   "component version different",
+			strict: true,
+			sbom: `{"bomFormat":"CycloneDX","components":[
+				{"group":"org.apache.camel","name":"camel-core","version":"3.18.0"},
+				{"group":"org.acme","name":"acme-client","version":"1.1.0"}]}`,
+			match:  false,
+			reason: "Integration-kit SBOM does not list the components of the integration dependencies",
+		},
+		{
+			name:   "kit without SBOM",
+			strict: true,
+			match:  false,
+			reason: "Integration-kit has no SBOM",
+		},
+		{
+			name:   "invalid SBOM",
+			strict: true,
+			sbom:   "not a document",
+			match:  false,
+			reason: "Integration-kit SBOM cannot be parsed",
+		},
+		{
+			name:  "kit without SBOM without strict mode",
+			match: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			kit := &v1.IntegrationKit{
+				Spec: v1.IntegrationKitSpec{
+					Dependencies: integration.Status.Dependencies,
+				},
+				Status: v1.IntegrationKitStatus{
+					Phase: v1.IntegrationKitPhaseReady,
+				},
+			}
+			if tc.sbom != "" {
+				kit.ObjectMeta = metav1.ObjectMeta{
+					Annotations: map[string]string{
+						v1.IntegrationKitSBOMAnnotation: tc.sbom,
+					},
+				}
+			}
+			options := DefaultOptions()
+			options.MatchSBOM = tc.strict
+
+			decision, err := Match(integration, kit, options)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.match, decision.Matched)
+			if tc.reason != "" {
+				assert.Equal(t, tc.reason, decision.Reason)
+			}
+		})
+	}
+}
+
+func TestParseMavenPurl(t *testing.T) {
+	group, name, version, ok := parseMavenPurl("pkg:maven/org.apache.camel/camel-core@3.18.0?type=jar#sub")
+	assert.True(t, ok)
+	assert.Equal(t, "org.apache.camel", group)
+	assert.Equal(t, "camel-core", name)
+	assert.Equal(t, "3.18.0", version)
+
+	_, _, _, ok = parseMavenPurl("pkg:npm/left-pad@1.3.0")
+	assert.False(t, ok)
+}
@@ -0,0 +1,118 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kitmatch
+
+import (
+	"sort"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+)
+
+const (
+	// MaxScore is the score of a kit matching an integration exactly.
+	MaxScore = 100
+	// minMatchedScore is the lowest score of a matching kit, above the score of any kit that does not match.
+	minMatchedScore = 50
+)
+
+// mismatchScores are the scores of the kits that do not match an integration, per category of the reason why,
+// the kits only missing some dependencies being the closest to matching.
+var mismatchScores = map[Category]int{
+	CategoryDependencies: 40,
+	CategoryTraits:       20,
+	CategoryOther:        10,
+	CategoryVersion:      0,
+}
+
+// Ranking is the score of a kit against an integration, along with the decision it is derived from.
+type Ranking struct {
+	Kit      *v1.IntegrationKit
+	Score    int
+	Decision Decision
+}
+
+// Score returns the score of the kit against the integration, along with the matching decision. The matching kits
+// score MaxScore minus their distance to the integration, i.e., the runtime configuration and peripheral dependencies
+// that differ, and minus the penalty of their known vulnerabilities, and the kits that do not match score by how close they are to matching, e.g. the fewer dependencies
+// they miss.
+func Score(integration *v1.Integration, kit *v1.IntegrationKit, options Options) (int, Decision, error) {
+	decision, err := evaluateKit(integration, kit, options)
+	if err != nil {
+		return 0, Decision{}, err
+	}
+
+	if decision.Matched {
+		score, err := MatchedScore(integration, kit, options)
+		if err != nil {
+			return 0, Decision{}, err
+		}
+
+		return score, decision, nil
+	}
+
+	category := Classify(decision.Reason)
+	score := mismatchScores[category]
+	if category == CategoryDependencies {
+		// The kits missing fewer dependencies are closer to matching, though still further than any matching kit
+		score = maxInt(score-len(decision.Details), mismatchScores[CategoryTraits]+1)
+	}
+
+	return score, decision, nil
+}
+
+// MatchedScore returns the score of the kit matching the integration, i.e., MaxScore minus its distance to
+// the integration, and minus the penalty of its known vulnerabilities.
+func MatchedScore(integration *v1.Integration, kit *v1.IntegrationKit, options Options) (int, error) {
+	distance, err := RuntimeConfigDistance(integration, kit)
+	if err != nil {
+		return 0, err
+	}
+	distance += PeripheralDependencyDistance(integration, kit, options)
+	distance += VulnerabilityPenalty(kit, options)
+
+	return maxInt(MaxScore-distance, minMatchedScore), nil
+}
+
+// RankKits returns the rankings of the kits against the integration, sorted by descending score, then by name.
+func RankKits(integration *v1.Integration, kits []v1.IntegrationKit, options Options) ([]Ranking, error) {
+	rankings := make([]Ranking, 0, len(kits))
+	for i := range kits {
+		score, decision, err := Score(integration, &kits[i], options)
+		if err != nil {
+			return nil, err
+		}
+		rankings = append(rankings, Ranking{Kit: &kits[i], Score: score, Decision: decision})
+	}
+
+	sort.SliceStable(rankings, func(i, j int) bool {
+		if rankings[i].Score != rankings[j].Score {
+			return rankings[i].Score > rankings[j].Score
+		}
+		return rankings[i].Kit.Name < rankings[j].Kit.Name
+	})
+
+	return rankings, nil
+}
+
+func maxInt(a int, b int) int {
+	if a > b {
+		return a
+	}
+
+	return b
+}
@@ -0,0 +1,161 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kitmatch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+)
+
+func TestRankKits(t *testing.T) {
+	integration := &v1.Integration{
+		Spec: v1.IntegrationSpec{
+			Configuration: []v1.ConfigurationSpec{
+				{Type: "property", Value: "log.level=INFO"},
+			},
+		},
+		Status: v1.IntegrationStatus{
+			Version:      "1.10.0",
+			Dependencies: []string{"camel:core", "camel:http", "camel:log"},
+		},
+	}
+	kit := func(name string, version string, dependencies ...string) v1.IntegrationKit {
+		return v1.IntegrationKit{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+			Spec: v1.IntegrationKitSpec{
+				Dependencies: dependencies,
+			},
+			Status: v1.IntegrationKitStatus{
+				Phase:   v1.IntegrationKitPhaseReady,
+				Version: version,
+			},
+		}
+	}
+
+	exact := kit("exact", "1.10.0", "camel:core", "camel:http", "camel:log")
+	exact.Spec.Configuration = integration.Spec.Configuration
+	kits := []v1.IntegrationKit{
+		kit("other-version", "1.9.0", "camel:core", "camel:http", "camel:log"),
+		kit("missing-two", "1.10.0", "camel:core"),
+		kit("missing-one", "1.10.0", "camel:core", "camel:http"),
+		kit("closest", "1.10.0", "camel:core", "camel:http", "camel:log"),
+		exact,
+	}
+
+	rankings, err := RankKits(integration, kits, DefaultOptions())
+	assert.Nil(t, err)
+
+	names := make([]string, 0, len(rankings))
+	scores := make([]int, 0, len(rankings))
+	for _, ranking := range rankings {
+		names = append(names, ranking.Kit.Name)
+		scores = append(scores, ranking.Score)
+	}
+	assert.Equal(t, []string{"exact", "closest", "missing-one", "missing-two", "other-version"}, names)
+	assert.Equal(t, []int{MaxScore, MaxScore - 1, 39, 38, 0}, scores)
+
+	assert.True(t, rankings[0].Decision.Matched)
+	assert.True(t, rankings[1].Decision.Matched)
+	assert.False(t, rankings[2].Decision.Matched)
+	assert.Equal(t, "Integration and integration-kit dependencies do not match", rankings[2].Decision.Reason)
+	assert.Equal(t, []string{"camel:log"}, rankings[2].Decision.Details)
+	assert.Equal(t, "Integration and integration-kit versions do not match", rankings[4].Decision.Reason)
+}
+
+func TestScore_MissingDependencies(t *testing.T) {
+	integration := &v1.Integration{}
+	kit := &v1.IntegrationKit{
+		Status: v1.IntegrationKitStatus{
+			Phase: v1.IntegrationKitPhaseReady,
+		},
+	}
+	for i := 0; i < 30; i++ {
+		integration.Status.Dependencies = append(integration.Status.Dependencies, "mvn:org.my:lib"+string(rune('a'+i))+":1.0")
+	}
+
+	// The kits missing dependencies still score above the kits whose traits do not match
+	score, decision, err := Score(integration, kit, DefaultOptions())
+	assert.Nil(t, err)
+	assert.False(t, decision.Matched)
+	assert.Equal(t, mismatchScores[CategoryTraits]+1, score)
+}
+
+func TestScore_VulnerabilityPenalty(t *testing.T) {
+	integration := &v1.Integration{
+		Status: v1.IntegrationStatus{
+			Dependencies: []string{"camel:core"},
+		},
+	}
+	kit := func(name string, vulnerabilities string) v1.IntegrationKit {
+		kit := v1.IntegrationKit{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+			Spec: v1.IntegrationKitSpec{
+				Dependencies: []string{"camel:core"},
+			},
+			Status: v1.IntegrationKitStatus{
+				Phase: v1.IntegrationKitPhaseReady,
+			},
+		}
+		if vulnerabilities != "" {
+			kit.Annotations = map[string]string{
+				v1.IntegrationKitVulnerabilitiesAnnotation: vulnerabilities,
+			}
+		}
+		return kit
+	}
+	kits := []v1.IntegrationKit{
+		kit("a-vulnerable", "3"),
+		kit("b-invalid", "many"),
+		kit("c-clean", ""),
+	}
+
+	options := DefaultOptions()
+	options.VulnerabilityPenalty = 5
+	rankings, err := RankKits(integration, kits, options)
+	assert.Nil(t, err)
+	assert.Len(t, rankings, 3)
+	assert.Equal(t, "b-invalid", rankings[0].Kit.Name)
+	assert.Equal(t, MaxScore, rankings[0].Score)
+	assert.Equal(t, "c-clean", rankings[1].Kit.Name)
+	assert.Equal(t, MaxScore, rankings[1].Score)
+	// The vulnerable kit still matches, though ranked after the clean kits
+	assert.Equal(t, "a-vulnerable", rankings[2].Kit.Name)
+	assert.Equal(t, MaxScore-15, rankings[2].Score)
+	assert.True(t, rankings[2].Decision.Matched)
+
+	// The penalty never ranks a matching kit below the kits that do not match
+	options.VulnerabilityPenalty = 100
+	score, decision, err := Score(integration, &kits[0], options)
+	assert.Nil(t, err)
+	assert.True(t, decision.Matched)
+	assert.Equal(t, minMatchedScore, score)
+
+	// The vulnerabilities are ignored without penalty
+	score, _, err = Score(integration, &kits[0], DefaultOptions())
+	assert.Nil(t, err)
+	assert.Equal(t, MaxScore, score)
+}
@@ -0,0 +1,87 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kitmatch
+
+import (
+	"reflect"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+)
+
+// SelectionStrategy returns whether the kit is selected rather than the other one, when both match an integration.
+type SelectionStrategy func(kit *v1.IntegrationKit, other *v1.IntegrationKit) bool
+
+// ShadowedKits returns the kits that can never be selected for an integration, as an equivalent ready kit, i.e.,
+// matching the same integrations according to the given options, is always selected rather than them according
+// to the selection strategy. The shadowed kits can be deleted once no integration uses them anymore.
+// The kits in error, that never match, are not analysed.
+func ShadowedKits(kits []v1.IntegrationKit, options Options, prefer SelectionStrategy) ([]v1.IntegrationKit, error) {
+	keys := make([]string, len(kits))
+	for i := range kits {
+		if kits[i].Status.Phase == v1.IntegrationKitPhaseError {
+			continue
+		}
+		key, err := KitMatchKey(&kits[i])
+		if err != nil {
+			return nil, err
+		}
+		keys[i] = key
+	}
+
+	shadowed := make([]v1.IntegrationKit, 0)
+	for i := range kits {
+		if keys[i] == "" {
+			continue
+		}
+		for j := range kits {
+			if i == j || keys[j] != keys[i] || kits[j].Status.Phase != v1.IntegrationKitPhaseReady {
+				continue
+			}
+			if equivalentKits(&kits[i], &kits[j], options) && prefer(&kits[j], &kits[i]) {
+				shadowed = append(shadowed, kits[i])
+				break
+			}
+		}
+	}
+
+	return shadowed, nil
+}
+
+// equivalentKits returns whether the kits, whose match keys are equal, match the same integrations, i.e., they are
+// looked up together and everything they are matched by, besides their match keys, is equal.
+func equivalentKits(kit *v1.IntegrationKit, other *v1.IntegrationKit, options Options) bool {
+	if kit.Namespace != other.Namespace ||
+		kit.Spec.Profile != other.Spec.Profile ||
+		kit.Spec.Image != other.Spec.Image ||
+		!reflect.DeepEqual(kit.Spec.Configuration, other.Spec.Configuration) ||
+		!reflect.DeepEqual(kit.Spec.Repositories, other.Spec.Repositories) ||
+		!reflect.DeepEqual(kit.Spec.Capabilities, other.Spec.Capabilities) ||
+		kit.Status.QuarkusPlatformVersion != other.Status.QuarkusPlatformVersion ||
+		kit.Status.CatalogVersion != other.Status.CatalogVersion ||
+		kit.Status.BuildStrategy != other.Status.BuildStrategy ||
+		kit.Status.DependencyTreeDigest != other.Status.DependencyTreeDigest {
+		return false
+	}
+	for _, label := range options.IdentityLabels {
+		if kit.Labels[label] != other.Labels[label] {
+			return false
+		}
+	}
+
+	return true
+}
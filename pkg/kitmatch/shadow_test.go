@@ -0,0 +1,94 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kitmatch
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+)
+
+func TestShadowedKits(t *testing.T) {
+	now := time.Now()
+	kit := func(name string, age time.Duration, phase v1.IntegrationKitPhase, dependencies ...string) v1.IntegrationKit {
+		return v1.IntegrationKit{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:         "ns",
+				Name:              name,
+				CreationTimestamp: metav1.NewTime(now.Add(-age)),
+				Labels: map[string]string{
+					"team": "a",
+				},
+			},
+			Spec: v1.IntegrationKitSpec{
+				Dependencies: dependencies,
+			},
+			Status: v1.IntegrationKitStatus{
+				Phase:           phase,
+				RuntimeVersion:  "1.17.0",
+				RuntimeProvider: v1.RuntimeProviderQuarkus,
+			},
+		}
+	}
+	// The newest kits are selected first
+	newest := func(kit *v1.IntegrationKit, other *v1.IntegrationKit) bool {
+		return other.CreationTimestamp.Before(&kit.CreationTimestamp)
+	}
+
+	kits := []v1.IntegrationKit{
+		kit("old", 2*time.Hour, v1.IntegrationKitPhaseReady, "camel:core", "camel:log"),
+		kit("new", time.Hour, v1.IntegrationKitPhaseReady, "camel:log", "camel:core"),
+		kit("other", 3*time.Hour, v1.IntegrationKitPhaseReady, "camel:core"),
+		kit("building", 3*time.Hour, v1.IntegrationKitPhaseBuildRunning, "camel:core", "camel:log"),
+		kit("newest-building", 0, v1.IntegrationKitPhaseBuildRunning, "camel:core", "camel:log"),
+		kit("error", 3*time.Hour, v1.IntegrationKitPhaseError, "camel:core", "camel:log"),
+	}
+
+	shadowed, err := ShadowedKits(kits, DefaultOptions(), newest)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"old", "building"}, kitNames(shadowed))
+
+	// The kits with other identity labels match other integrations
+	kits[1].Labels["team"] = "b"
+	options := DefaultOptions()
+	options.IdentityLabels = []string{"team"}
+	shadowed, err = ShadowedKits(kits, options, newest)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"building"}, kitNames(shadowed))
+
+	// The kits with other capabilities match other integrations
+	kits[1].Labels["team"] = "a"
+	kits[1].Spec.Capabilities = []string{v1.CapabilityRest}
+	shadowed, err = ShadowedKits(kits, options, newest)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"building"}, kitNames(shadowed))
+}
+
+func kitNames(kits []v1.IntegrationKit) []string {
+	names := make([]string, 0, len(kits))
+	for _, kit := range kits {
+		names = append(names, kit.Name)
+	}
+
+	return names
+}
@@ -0,0 +1,51 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kitmatch
+
+import (
+	"strings"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+)
+
+// WouldMatch returns whether a kit with the proposed spec would match the integration, with the default
+// matching options, and the reason why it would not.
+func WouldMatch(integration *v1.Integration, spec v1.IntegrationKitSpec) (bool, string) {
+	return WouldMatchWithOptions(integration, spec, DefaultOptions())
+}
+
+// WouldMatchWithOptions returns whether a kit with the proposed spec would match the integration, with the
+// given matching options, and the reason why it would not. The proposed kit is assumed to be built for the
+// runtime of the integration, and has no labels.
+func WouldMatchWithOptions(integration *v1.Integration, spec v1.IntegrationKitSpec, options Options) (bool, string) {
+	kit := v1.NewIntegrationKit(integration.Namespace, "")
+	kit.Spec = *spec.DeepCopy()
+	kit.Status.Version = integration.Status.Version
+	kit.Status.RuntimeVersion = integration.Status.RuntimeVersion
+	kit.Status.RuntimeProvider = integration.Status.RuntimeProvider
+
+	decision, err := evaluateKit(integration, kit, options)
+	if err != nil {
+		return false, err.Error()
+	}
+	if !decision.Matched && len(decision.Details) > 0 {
+		return false, decision.Reason + ": " + strings.Join(decision.Details, ", ")
+	}
+
+	return decision.Matched, decision.Reason
+}
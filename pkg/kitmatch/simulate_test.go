@@ -0,0 +1,137 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kitmatch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/pkg/apis/camel/v1/trait"
+)
+
+func TestWouldMatch(t *testing.T) {
+	integration := &v1.Integration{
+		Spec: v1.IntegrationSpec{
+			Traits: v1.Traits{
+				Builder: &traitv1.BuilderTrait{
+					Properties: []string{"build-key1=build-value1"},
+				},
+			},
+		},
+		Status: v1.IntegrationStatus{
+			Version:         "1.0.0",
+			RuntimeVersion:  "1.17.0",
+			RuntimeProvider: v1.RuntimeProviderQuarkus,
+			Dependencies:    []string{"camel:core", "camel:log"},
+		},
+	}
+
+	testCases := []struct {
+		name   string
+		spec   v1.IntegrationKitSpec
+		match  bool
+		reason string
+	}{
+		{
+			name: "same dependencies and traits",
+			spec: v1.IntegrationKitSpec{
+				Dependencies: []string{"camel:core", "camel:log"},
+				Traits: v1.IntegrationKitTraits{
+					Builder: &traitv1.BuilderTrait{
+						Properties: []string{"build-key1=build-value1"},
+					},
+				},
+			},
+			match: true,
+		},
+		{
+			name: "extra dependencies",
+			spec: v1.IntegrationKitSpec{
+				Dependencies: []string{"camel:core", "camel:log", "camel:http"},
+				Traits: v1.IntegrationKitTraits{
+					Builder: &traitv1.BuilderTrait{
+						Properties: []string{"build-key1=build-value1"},
+					},
+				},
+			},
+			match: true,
+		},
+		{
+			name: "missing dependencies",
+			spec: v1.IntegrationKitSpec{
+				Dependencies: []string{"camel:core"},
+				Traits: v1.IntegrationKitTraits{
+					Builder: &traitv1.BuilderTrait{
+						Properties: []string{"build-key1=build-value1"},
+					},
+				},
+			},
+			reason: "Integration and integration-kit dependencies do not match: camel:log",
+		},
+		{
+			name: "different traits",
+			spec: v1.IntegrationKitSpec{
+				Dependencies: []string{"camel:core", "camel:log"},
+				Traits: v1.IntegrationKitTraits{
+					Builder: &traitv1.BuilderTrait{
+						Properties: []string{"build-key1=build-value2"},
+					},
+				},
+			},
+			reason: "Integration and integration-kit traits do not match",
+		},
+		{
+			name: "different packaging",
+			spec: v1.IntegrationKitSpec{
+				Dependencies: []string{"camel:core", "camel:log"},
+				Traits: v1.IntegrationKitTraits{
+					Builder: &traitv1.BuilderTrait{
+						Properties: []string{"build-key1=build-value1"},
+					},
+					Quarkus: &traitv1.QuarkusTrait{
+						PackageTypes: []traitv1.QuarkusPackageType{traitv1.NativePackageType},
+					},
+				},
+			},
+			reason: "Integration and integration-kit packaging types do not match",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			match, reason := WouldMatch(integration, tc.spec)
+			assert.Equal(t, tc.match, match)
+			assert.Equal(t, tc.reason, reason)
+
+			// The proposed kit matches as an equivalent stored kit would
+			kit := v1.NewIntegrationKit("ns", "my-kit")
+			kit.Spec = tc.spec
+			kit.Status = v1.IntegrationKitStatus{
+				Phase:           v1.IntegrationKitPhaseReady,
+				Version:         "1.0.0",
+				RuntimeVersion:  "1.17.0",
+				RuntimeProvider: v1.RuntimeProviderQuarkus,
+			}
+			stored, err := IntegrationMatches(integration, kit, DefaultOptions())
+			assert.Nil(t, err)
+			assert.Equal(t, stored, match)
+		})
+	}
+}
@@ -0,0 +1,58 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kitmatch
+
+import (
+	"strings"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/util/kubernetes"
+	"github.com/apache/camel-k/pkg/util/maven"
+)
+
+// isSnapshot returns whether the Maven version is a SNAPSHOT version, that changes over time.
+func isSnapshot(version string) bool {
+	return strings.HasSuffix(version, "-SNAPSHOT")
+}
+
+// snapshotDependencies returns the Maven dependencies with a SNAPSHOT version among the given ones.
+func snapshotDependencies(dependencies []string) []string {
+	snapshots := make([]string, 0)
+	for _, dependency := range dependencies {
+		if !strings.HasPrefix(dependency, "mvn:") {
+			continue
+		}
+		if gav, err := maven.ParseGAV(strings.TrimPrefix(dependency, "mvn:")); err == nil && isSnapshot(gav.Version) {
+			snapshots = append(snapshots, dependency)
+		}
+	}
+
+	return snapshots
+}
+
+// snapshotRequiresRebuild returns whether the kit cannot be reused by the integration with SNAPSHOT dependencies,
+// i.e., it has been created for another integration, when the SNAPSHOT dependencies force the kits to be rebuilt.
+// The kit created for the integration is still reused, so that the integration is not rebuilt over and over.
+func snapshotRequiresRebuild(integration *v1.Integration, kit *v1.IntegrationKit, options Options) bool {
+	if options.SnapshotMatchMode != v1.IntegrationKitSnapshotMatchModeRebuild {
+		return false
+	}
+	creator := kubernetes.GetCamelCreator(kit)
+
+	return creator == nil || creator.Kind != v1.IntegrationKind || creator.Namespace != integration.Namespace || creator.Name != integration.Name
+}
@@ -0,0 +1,137 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kitmatch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/util/kubernetes"
+)
+
+func TestIntegrationMatches_SnapshotDependencies(t *testing.T) {
+	newIntegration := func(dependencies ...string) *v1.Integration {
+		integration := &v1.Integration{
+			Status: v1.IntegrationStatus{
+				Version:      "1.10.0",
+				Dependencies: dependencies,
+			},
+		}
+		integration.Namespace = "ns"
+		integration.Name = "my-integration"
+		return integration
+	}
+	newKit := func(creator string, dependencies ...string) *v1.IntegrationKit {
+		kit := &v1.IntegrationKit{
+			Spec: v1.IntegrationKitSpec{
+				Dependencies: dependencies,
+			},
+			Status: v1.IntegrationKitStatus{
+				Phase:   v1.IntegrationKitPhaseReady,
+				Version: "1.10.0",
+			},
+		}
+		kit.Namespace = "ns"
+		if creator != "" {
+			kit.Labels = map[string]string{
+				kubernetes.CamelCreatorLabelKind:      v1.IntegrationKind,
+				kubernetes.CamelCreatorLabelName:      creator,
+				kubernetes.CamelCreatorLabelNamespace: "ns",
+			}
+		}
+		return kit
+	}
+
+	tests := []struct {
+		name        string
+		mode        v1.IntegrationKitSnapshotMatchMode
+		integration *v1.Integration
+		kit         *v1.IntegrationKit
+		match       bool
+		reason      string
+	}{
+		{
+			name:        "release dependencies",
+			integration: newIntegration("camel:core", "mvn:org.my:lib:1.0"),
+			kit:         newKit("other-integration", "camel:core", "mvn:org.my:lib:1.0"),
+			match:       true,
+		},
+		{
+			name:        "kit created for another integration",
+			integration: newIntegration("camel:core", "mvn:org.my:lib:1.0-SNAPSHOT"),
+			kit:         newKit("other-integration", "camel:core", "mvn:org.my:lib:1.0-SNAPSHOT"),
+			reason:      "Integration SNAPSHOT dependencies require a new integration-kit",
+		},
+		{
+			name:        "kit without creator",
+			integration: newIntegration("camel:core", "mvn:org.my:lib:1.0-SNAPSHOT"),
+			kit:         newKit("", "camel:core", "mvn:org.my:lib:1.0-SNAPSHOT"),
+			reason:      "Integration SNAPSHOT dependencies require a new integration-kit",
+		},
+		{
+			name:        "kit created for the integration",
+			integration: newIntegration("camel:core", "mvn:org.my:lib:1.0-SNAPSHOT"),
+			kit:         newKit("my-integration", "camel:core", "mvn:org.my:lib:1.0-SNAPSHOT"),
+			match:       true,
+		},
+		{
+			name:        "SNAPSHOT version ignored",
+			mode:        v1.IntegrationKitSnapshotMatchModeIgnoreVersion,
+			integration: newIntegration("camel:core", "mvn:org.my:lib:1.1-SNAPSHOT"),
+			kit:         newKit("other-integration", "camel:core", "mvn:org.my:lib:1.0-SNAPSHOT"),
+			match:       true,
+		},
+		{
+			name:        "SNAPSHOT version ignored for other artifacts",
+			mode:        v1.IntegrationKitSnapshotMatchModeIgnoreVersion,
+			integration: newIntegration("camel:core", "mvn:org.my:lib:1.0-SNAPSHOT"),
+			kit:         newKit("other-integration", "camel:core", "mvn:org.my:other-lib:1.0-SNAPSHOT"),
+			reason:      "Integration and integration-kit dependencies do not match",
+		},
+		{
+			name:        "SNAPSHOT version ignored for SNAPSHOT versions only",
+			mode:        v1.IntegrationKitSnapshotMatchModeIgnoreVersion,
+			integration: newIntegration("camel:core", "mvn:org.my:lib:1.0-SNAPSHOT"),
+			kit:         newKit("other-integration", "camel:core", "mvn:org.my:lib:1.0"),
+			reason:      "Integration and integration-kit dependencies do not match",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			options := DefaultOptions()
+			if test.mode != "" {
+				options.SnapshotMatchMode = test.mode
+			}
+
+			decision, err := Match(test.integration, test.kit, options)
+			require.Nil(t, err)
+			assert.Equal(t, test.match, decision.Matched)
+			assert.Equal(t, test.reason, decision.Reason)
+
+			// The compatibility contract does not accept the kits the SNAPSHOT dependencies force to rebuild
+			require.Nil(t, StampContract(test.kit))
+			decision, err = Match(test.integration, test.kit, options)
+			require.Nil(t, err)
+			assert.Equal(t, test.match, decision.Matched)
+		})
+	}
+}
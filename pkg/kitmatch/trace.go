@@ -0,0 +1,65 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kitmatch
+
+import (
+	"time"
+)
+
+// MatchStep is a step of the evaluation of a kit against an integration.
+type MatchStep string
+
+const (
+	// MatchStepStatus compares the kit status and labels, e.g. the versions, with the integration ones
+	MatchStepStatus MatchStep = "status"
+	// MatchStepContract checks the integration against the compatibility contract of the kit, if any
+	MatchStepContract MatchStep = "contract"
+	// MatchStepTraits compares the kit influencing traits and the profile with the integration ones
+	MatchStepTraits MatchStep = "traits"
+	// MatchStepDependencies compares the kit dependencies, and what they resolve to, with the integration ones
+	MatchStepDependencies MatchStep = "dependencies"
+)
+
+// StepTiming is the time spent in a step of the matching, across the kits that have reached it.
+type StepTiming struct {
+	// the number of kits that have reached the step
+	Count int
+	// the total time spent in the step
+	Duration time.Duration
+}
+
+// MatchTrace records the time spent in each step of the matching, across all the kits evaluated against
+// an integration, e.g. during a lookup. It is not safe for concurrent use.
+type MatchTrace struct {
+	Steps map[MatchStep]StepTiming
+}
+
+// record records the time spent in the step by a kit, if the trace is not nil.
+func (t *MatchTrace) record(step MatchStep, duration time.Duration) {
+	if t == nil {
+		return
+	}
+	if t.Steps == nil {
+		t.Steps = make(map[MatchStep]StepTiming)
+	}
+
+	timing := t.Steps[step]
+	timing.Count++
+	timing.Duration += duration
+	t.Steps[step] = timing
+}
@@ -0,0 +1,77 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kitmatch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+)
+
+func TestMatchTrace(t *testing.T) {
+	integration := &v1.Integration{
+		Status: v1.IntegrationStatus{
+			Version:      "1.10.0",
+			Dependencies: []string{"camel:core"},
+		},
+	}
+	kit := func(version string, dependencies ...string) *v1.IntegrationKit {
+		return &v1.IntegrationKit{
+			Spec: v1.IntegrationKitSpec{
+				Dependencies: dependencies,
+			},
+			Status: v1.IntegrationKitStatus{
+				Phase:   v1.IntegrationKitPhaseReady,
+				Version: version,
+			},
+		}
+	}
+
+	options := DefaultOptions()
+	options.Trace = &MatchTrace{}
+
+	// A matching kit goes through all the steps
+	decision, err := Match(integration, kit("1.10.0", "camel:core"), options)
+	assert.Nil(t, err)
+	assert.True(t, decision.Matched)
+	assert.Len(t, options.Trace.Steps, 3)
+	for _, step := range []MatchStep{MatchStepStatus, MatchStepTraits, MatchStepDependencies} {
+		assert.Equal(t, 1, options.Trace.Steps[step].Count, string(step))
+	}
+
+	// The evaluation stops at the step the kit does not match
+	decision, err = Match(integration, kit("1.9.0", "camel:core"), options)
+	assert.Nil(t, err)
+	assert.False(t, decision.Matched)
+	assert.Equal(t, 2, options.Trace.Steps[MatchStepStatus].Count)
+	assert.Equal(t, 1, options.Trace.Steps[MatchStepTraits].Count)
+	assert.Equal(t, 1, options.Trace.Steps[MatchStepDependencies].Count)
+
+	decision, err = Match(integration, kit("1.10.0", "camel:log"), options)
+	assert.Nil(t, err)
+	assert.False(t, decision.Matched)
+	assert.Equal(t, 3, options.Trace.Steps[MatchStepStatus].Count)
+	assert.Equal(t, 2, options.Trace.Steps[MatchStepTraits].Count)
+	assert.Equal(t, 2, options.Trace.Steps[MatchStepDependencies].Count)
+
+	// The matching is not traced without trace
+	_, err = Match(integration, kit("1.10.0", "camel:core"), DefaultOptions())
+	assert.Nil(t, err)
+}
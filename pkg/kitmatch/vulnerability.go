@@ -0,0 +1,44 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kitmatch
+
+import (
+	"strconv"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+)
+
+// VulnerabilityCount returns the number of known vulnerabilities of the kit dependencies, as annotated on the kit,
+// or 0 when it is not annotated or the annotation is not a positive number.
+func VulnerabilityCount(kit *v1.IntegrationKit) int {
+	count, err := strconv.Atoi(kit.Annotations[v1.IntegrationKitVulnerabilitiesAnnotation])
+	if err != nil || count < 0 {
+		return 0
+	}
+
+	return count
+}
+
+// VulnerabilityPenalty returns the score penalty of the known vulnerabilities of the kit dependencies.
+func VulnerabilityPenalty(kit *v1.IntegrationKit, options Options) int {
+	if options.VulnerabilityPenalty <= 0 {
+		return 0
+	}
+
+	return VulnerabilityCount(kit) * options.VulnerabilityPenalty
+}
@@ -117,9 +117,9 @@ var assets = func() http.FileSystem {
 		"/crd/bases/camel.apache.org_builds.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "camel.apache.org_builds.yaml",
 			modTime:          time.Time{},
-			uncompressedSize: 43400,
+			uncompressedSize: 44361,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x3d\x5d\x73\x23\x39\x6e\xef\xfe\x15\xa8\xf5\xc3\x78\xaa\x2c\x69\x77\xef\x23\x1b\xa7\x52\x29\x9f\x67\x77\xcf\x99\x0f\x3b\x23\xef\xdc\xdd\x9b\xa9\x6e\x48\xe2\xa9\x9b\xec\x90\x6c\x6b\x74\xa9\xfc\xf7\x14\x41\xb2\xd5\x92\xfa\x83\xed\x8f\x99\xcd\xad\xf8\x32\xe3\x16\x09\x02\x20\x09\x80\x20\x08\x9e\xc2\xe8\xf9\xca\xc9\x29\xbc\xe3\x09\x0a\x8d\x29\x18\x09\x66\x89\x70\x59\xb0\x64\x89\x30\x95\x73\xb3\x66\x0a\xe1\x27\x59\x8a\x94\x19\x2e\x05\x9c\x5d\x4e\x7f\x7a\x0d\xa5\x48\x51\x81\x14\x08\x52\x41\x2e\x15\x9e\x9c\x42\x22\x85\x51\x7c\x56\x1a\xa9\x20\x73\x00\x81\x2d\x14\x62\x8e\xc2\xe8\x31\xc0\x14\x91\xa0\x7f\xb8\xb9\xbb\xbe\xfa\x11\xe6\x3c\x43\x48\xb9\x76\x8d\x30\x85\x35\x37\xcb\x93\x53\x30\x4b\xae\x61\x2d\xd5\x0a\xe6\x52\x01\x4b\x53\x6e\x3b\x66\x19\x70\x31\x97\x2a\x77\x68\x28\x5c\x30\x95\x72\xb1\x80\x44\x16\x1b\xc5\x17\x4b\x03\x72\x2d\x50\xe9\x25\x2f\xc6\x27\xa7\x70\x67\xc9\x98\xfe\x14\x30\xd1\x0e\x2c\xf5\x69\x24\xfc\x4d\x96\x9e\x86\x1a\xb9\x9e\x0b\xe7\xf0\x09\x95\xb6\x9d\x7c\x3f\xfe\xf6\xe4\x14\xce\x6c\x95\x6f\xfc\x8f\xdf\xbc\xfe\x37\xd8\xc8\x12\x72\xb6\x01\x21\x0d\x94\x1a\x6b\x90\xf1\x73\x82\x85\x01\x2e\x20\x91\x79\x91\x71\x26\x12\xdc\x92\x55\xf5\x30\x06\x42\xc0\xc2\x90\x33\xc3\xb8\x00\x46\x64\x80\x9c\xd7\xab\x01\x33\x27\xa7\x27\xa7\x40\x65\x69\x4c\x71\x31\x99\xac\xd7\xeb\x31\x23\x74\xc7\x52\x2d\x26\x81\xba\xc9\xbb\xeb\xab\x1f\x3f\x4c\x7f\x1c\x11\xca\x27\xa7\xf0\x8b\xc8\x50\x6b\x50\xf8\xdf\x25\x57\x98\xc2\x6c\x03\xac\x28\x32\x9e\xb0\x59\x86\x90\xb1\xb5\x1d\x38\x1a\x1d\x1a\x74\x2e\x60\xad\xb8\xe1\x62\x71\x0e\xda\x8f\xfa\xc9\xe9\xce\xe8\x6c\xd9\x15\xd0\xe3\x7a\xa7\x82\x14\xc0\x04\x7c\x73\x39\x85\xeb\xe9\x37\xf0\xa7\xcb\xe9\xf5\xf4\xfc\xe4\x14\xfe\x72\x7d\xf7\xe7\x9b\x5f\xee\xe0\x2f\x97\x1f\x3f\x5e\x7e\xb8\xbb\xfe\x71\x0a\x37\x1f\xe1\xea\xe6\xc3\x9b\xeb\xbb\xeb\x9b\x0f\x53\xb8\xf9\x09\x2e\x3f\xfc\x0d\xde\x5e\x7f\x78\x73\x0e\xc8\xcd\x12\x15\xe0\xe7\x42\x59\xfc\xa5\x02\x6e\x19\x89\xa9\x1d\xd3\x30\x81\x02\x02\x76\x7e\xd8\xbf\x75\x81\x09\x9f\xf3\x04\x32\x26\x16\x25\x5b\x20\x2c\xe4\x03\x2a\x61\xa7\x47\x81\x2a\xe7\xda\x0e\xa7\x06\x26\xd2\x93\x53\xc8\x78\xce\x0d\xcd\x22\x7d\x48\x94\xed\xe6\x39\xd7\xd6\x09\x2b\xb8\x9f\x4e\x17\xc0\x0a\x8e\x9f\x0d\x0a\xc2\x66\xbc\xfa\x41\x8f\xb9\x9c\x3c\x7c\x77\xb2\xe2\x22\xbd\x80\xab\x52\x1b\x99\x7f\x44\x2d\x4b\x95\xe0\x1b\x9c\x73\x41\x33\xff\x24\x47\xc3\x52\x66\xd8\xc5\x09\x00\x13\x42\x7a\xe4\xed\x9f\xe0\x56\x9d\xcc\x32\x54\xa3\x05\x8a\xf1\xaa\x9c\xe1\xac\xe4\x59\x8a\x8a\x80\x87\xae\x1f\xbe\x1d\xff\x71\xfc\xdd\x09\x40\xa2\x90\x9a\xdf\xf1\x1c\xb5\x61\x79\x71\x01\xa2\xcc\xb2\x13\x80\x8c\xcd\x30\xf3\x50\x59\x51\x5c\x40\xc2\x72\xcc\x46\xab\x13\x00\xc1\x72\xbc\x00\x82\xab\xc7\xf4\xb9\x36\x09\x4f\x2c\xfb\x6d\xb3\x85\x92\x65\x68\x56\xff\xdd\xb5\x0f\xf8\x32\x83\x0b\xa9\x78\xf8\x7b\x04\x2b\x5b\xdf\xff\x3f\xa9\xfe\xef\x78\xf2\x27\xdb\x25\xfd\x9d\x71\x6d\xde\x6e\xbf\xbd\xe3\xda\xd0\xf7\x22\x2b\x15\xcb\x02\x72\xf4\x49\x2f\xa5\x32\x1f\xb6\x5d\x8e\x80\xaf\x66\xee\x17\x2e\x16\x65\xc6\x94\xaf\x7e\x02\xa0\x13\x59\xe0\x05\x50\xed\x82\x25\x68\xbf\x79\xa6\x51\xeb\x51\x4d\x00\xdd\x2a\x2e\x0c\xaa\x2b\x99\x95\xb9\xa8\x60\xa7\xa8\x13\xc5\x0b\x43\x6c\xb6\x52\x87\x40\x43\xb1\x64\x1a\x4f\xdc\xda\xfd\xbb\x96\xe2\x96\x99\xe5\x05\x8c\xb5\x61\xa6\xd4\xe3\xfa\xaf\x8e\xb9\xb7\xb5\x2f\x66\x63\x71\xb2\x2b\x4b\x2c\xda\x7a\x31\x3c\xb7\x02\x02\xd6\x4b\x9e\x2c\x69\x06\xbb\x7e\xd7\x4c\xbb\x31\xc6\xf4\xb0\xf7\x30\x93\xc6\x07\xb3\x60\x07\x97\xcb\xc5\x2e\x26\x29\x33\xf8\x18\x3c\x32\xa6\x0d\x9c\x29\x1c\xbd\xd6\x86\xa9\x46\x8c\x3c\x3f\xfc\xef\x97\x66\x07\x8f\xe9\x4e\xab\x7e\x5c\x5c\xcf\xd4\x2b\x7e\xc6\xa4\x24\x4d\x91\x96\x8a\x48\x6d\xed\x7b\xaf\x82\xeb\xfa\xcd\xee\xc7\x98\x11\x11\x65\x3e\xb3\x4a\x71\x5e\xeb\x9c\x19\x83\x79\x61\x74\x6b\xe7\x73\xc6\xb3\x52\xe1\x58\x61\x62\x45\xd6\x66\xec\x5b\xec\x8e\xc7\x2e\x14\x87\x8c\x9d\x8b\x0b\x54\x27\xdb\x6a\x0f\xdf\xb9\x49\x9e\x2c\x31\x67\x17\xbe\xb2\x2c\x50\x5c\xde\x5e\x7f\xfa\xdd\x74\xe7\x33\xec\xe2\x4f\x6b\xca\x0a\x74\x3b\x80\xae\x66\x25\x5d\xdd\xca\x82\xcb\xdb\xeb\xaa\x6d\xa1\x64\x81\xca\x54\x8b\xd8\x95\x9a\xa8\xab\x7d\xdd\xeb\xe9\x95\x45\xc6\xeb\xd7\xd4\xca\x38\x74\x9d\xfa\x45\x87\xa9\xc7\xdf\xe9\x42\x6e\x55\x98\x55\x05\x28\x4c\x7d\x3c\x42\x91\x73\xab\x73\xe4\xec\xef\x98\x98\x31\x4c\x51\x59\x30\x56\x00\x94\x59\x6a\x45\xe3\x03\x2a\x03\x96\xb7\x0b\xc1\xff\x51\xc1\xd6\xc1\xce\xc9\x98\x41\x6d\xf6\x60\xd2\x22\xb7\xf6\xc6\x03\xcb\x4a\x3c\xb7\x5a\x83\x54\xb5\x42\xdb\x0b\x94\xa2\x06\x8f\xaa\xe8\x31\xbc\x97\x0a\xc9\x3e\xb9\x20\x45\xad\x2f\x26\x93\x05\x37\x41\xc4\x27\x32\xcf\x4b\xc1\xcd\x66\x52\xb3\x91\xf4\x24\xc5\x07\xcc\x26\x9a\x2f\x46\x4c\x25\x4b\x6e\x30\x31\xa5\xc2\x09\x2b\xf8\x88\x50\x17\x24\xe6\xc7\x79\x7a\xaa\xbc\x52\xd0\xaf\x76\x70\x3d\x98\x95\xae\x90\xe8\xec\x18\x01\x2b\x46\xed\x58\x33\xdf\xd4\x51\xb1\x65\xb4\xfd\x64\xb9\xf3\xf1\xc7\xe9\x1d\x84\xae\x69\x30\xf6\xb9\x4f\x7c\xdf\x36\xd4\xdb\x21\xb0\x0c\xe3\x62\x4e\xca\xd5\x5a\x47\x4a\xe6\x04\x13\x45\x5a\x48\x2e\x0c\xfd\x91\x64\x1c\xc5\x3e\xfb\x75\x39\xcb\xb9\x71\xa6\x0b\x6a\x63\xc7\x6a\x0c\x57\xa4\xf7\x60\x86\x50\x16\x56\x02\xa4\x63\xb8\x16\x70\x65\xb5\xc5\x15\xb3\x06\xd5\x0b\x0f\x80\xe5\xb4\x1e\x59\xc6\xc6\x0d\x41\x5d\x65\xef\x57\x76\x5c\xab\xfd\x10\xf4\x67\xcb\x78\xd1\xda\x9c\x16\x98\xec\xac\x17\xb7\x62\xed\x32\x74\x06\xb1\x91\x96\x3b\x4e\xf2\x54\x22\xd3\x95\xe6\xd5\x4a\x3d\x1b\x65\xd5\xf1\x66\xff\x3b\x1c\x4a\xb7\x50\x15\xcc\x92\x99\xb0\xc2\xec\x78\xf8\x6d\x43\x81\xca\x5a\xe7\x5b\xdc\xc6\x07\x30\x51\x94\xf9\x61\x4f\x23\x50\xb2\x34\x5c\x60\xc3\x2f\x85\x4c\x0f\xbe\xb6\x70\x9c\x7e\x62\x7a\x75\x40\x63\x13\x2d\x76\x6a\x59\xd3\x5c\xce\x3d\x1f\xa9\xa5\xe7\xa1\xa7\x04\x53\x60\x1a\x0a\xa6\x0c\xc8\xf9\x01\x4c\xa8\x0d\x42\x25\xee\x0f\x49\xe6\x06\xf3\x06\x8c\xf6\x71\x62\x7a\x55\x5b\x45\x04\x9a\xcd\x2c\xc7\x13\x43\xa8\x8d\xe1\x46\x64\x1b\xb7\xdf\xa2\x2d\x42\x03\x44\x47\x7e\x6d\x64\x12\x29\xe6\x7c\x51\x2a\x37\x3e\x15\xf8\x5d\x8b\x99\xda\x24\x4b\xa9\xb1\x01\xfb\xae\xa9\xe3\x0a\xe9\x06\xb6\x6c\xfe\x71\x8f\x4a\xe6\xd8\xc5\x96\x96\xdc\x73\x52\x2f\xfe\x43\x35\xb9\x5a\xc0\xf4\x61\x41\x98\x30\x8d\xd7\x39\x5b\x60\x7b\x95\x3d\x7c\x6c\x0b\xe0\xb6\x09\x64\x6c\xe3\x35\x69\x73\xe9\x98\x73\xdb\x62\x45\x0b\x7e\x36\x6f\xb8\x8a\x46\x21\x61\xc2\xaf\xa1\x79\x99\xd9\x51\xd2\x4b\xe6\xe5\x18\x6d\x1b\x41\xd2\x6e\x88\x66\xe7\x53\xd1\xe3\x83\x98\x33\xe7\xb4\xe3\x26\xee\x58\xeb\xe2\xa9\xbd\x93\x85\x12\xdb\xb9\xad\x1c\xf6\xc2\x96\xf6\xa7\x76\x5e\x64\xcc\xd8\x25\x1d\x8d\x80\x15\x12\xa1\x91\x45\xc4\x19\x96\xc4\x8d\xa7\xe2\xa2\x70\x61\xf7\xcc\x0d\x22\xb7\x05\x97\xf5\x12\x15\xf9\x2c\x8a\x72\x96\x71\xed\x6c\xec\xda\xf0\x74\xc0\x89\x59\x37\xb6\xb0\x34\xb5\xbb\xed\xee\x4a\x7b\x68\x59\x2c\x7e\xf9\x78\x6d\x11\x63\x49\x82\xba\x6b\x7e\x42\x2c\x73\x80\x36\x88\x83\xf1\x70\x92\x2e\x67\x85\xdf\x85\x68\x23\x95\x57\x93\x57\x96\xfe\x39\x4f\xc2\xae\xa1\xab\x5c\x96\x66\x29\x15\x37\x6d\x62\x68\x30\x29\x5c\x68\x4c\x4a\xd5\x39\xf1\x0f\x08\xe2\xf3\x40\x93\x61\x5c\xa0\xaa\x66\x8c\x35\xd9\x02\x44\x38\xe3\x78\xde\x4b\x90\xb5\x84\x40\x8a\x6c\xf3\x3a\x8a\xa2\x99\x94\x19\xb2\x7d\x13\x7b\xb7\x48\xb5\x60\x82\xff\x83\x6c\x8e\xc1\xe3\x54\x51\x52\x87\xf2\x5c\xcc\xd6\x98\x28\x34\x83\x71\x72\xcd\xfc\x2a\x4b\x14\xa6\xd6\xea\x63\x99\x06\x2b\x88\x69\x22\x1d\x9a\x20\x8f\xc2\xb0\xc5\xf8\xdb\x2d\x0f\xa8\x66\x52\xc7\x4b\xca\x4c\x2e\xc8\xfd\x5a\xf7\x8d\xf6\xe2\xd0\x3d\xce\xbd\x78\x7a\xf7\xd2\x10\x9d\x8f\x8a\x4c\x9c\x33\x52\xb9\x56\xa2\xb7\x4d\xc8\x97\xd1\xf4\xb4\x8b\x7d\x66\x6d\x4f\x5c\x18\xa2\xeb\xd7\x52\xad\xc8\xc5\x04\x29\x57\x98\x18\xa9\x36\x56\x78\x96\xfa\xc9\x2a\x25\xc5\x02\x45\x8a\x22\xe9\x11\xf4\x07\x3c\xc9\xb8\xb6\xc6\xed\x0e\x00\x8f\x93\xdf\xfd\x73\x5d\x79\xca\xda\x4a\xab\x89\x3b\x90\x8a\x50\x8d\x29\xc5\xda\x25\x70\xce\x1e\xb0\x53\xf0\xb4\x28\x07\xef\xce\xd9\x71\x88\xbf\xb7\xb0\x82\x9b\xa3\x5b\x41\x3a\xd7\x39\x41\x38\x74\xef\x35\x95\x58\xd5\x9b\xb0\xe9\x70\xb9\xf5\xea\x8d\x35\xe6\xad\x4e\x4b\x2f\x68\xb0\xae\x2e\x1d\x14\x4d\x96\x8b\xfb\x7f\x9f\xd9\xe6\x29\x13\x29\xac\x70\x73\x1e\xf4\x4d\xd8\xfb\x5f\x5d\x42\xb2\x55\x9d\x67\xfa\x75\xd8\xe8\xf5\x42\x4c\xa4\x10\x68\xf7\x2d\x76\xcf\x91\x4b\x83\x9e\xcf\x0a\x0b\xa9\xb9\x21\xd7\xef\x18\xae\x0d\x19\xbf\xbe\xd7\x5e\xa0\x7f\x1d\xff\xe1\xdb\x7f\xad\x63\xa4\x9d\x5f\xe6\xf6\xed\xd5\xf4\xf4\x5f\xc0\xc9\x3e\x83\x69\xbd\x4a\x3f\xa6\x4b\xc6\x85\x1e\xc3\x25\xfc\xe7\xdb\x69\x0d\xc6\x0a\x37\x24\xf8\xc9\x47\x52\x1a\x69\xc5\x6a\xc2\xb2\xac\xcf\x2e\x80\xe0\x78\x25\x4b\x9e\x20\x34\xb2\xd2\xa1\xbe\xdd\x9e\xf5\x82\x75\xfb\x52\x1a\x00\xa6\x81\x81\x51\xa5\xde\x23\xd6\x8e\xd0\x6c\x43\xfd\x11\xbb\x23\x86\x29\xcf\x99\x48\xf5\x18\x3e\xd8\x31\xa2\x5d\x3d\xe9\x68\x29\xcd\x1e\xca\x4e\x17\xb2\x4c\xf7\x0f\x3e\xcf\x0b\xa9\x2c\x6a\x5c\x78\x17\x5b\x60\x49\x60\xea\xf8\x55\x0f\x90\xd8\x95\x63\xcb\x0a\x3b\xed\xe8\x50\x0e\x6c\xfb\x15\x56\x67\x6d\x5e\xff\xdb\x11\xc3\xcc\xce\xdb\xb9\x92\xf9\x18\xe0\x7d\x79\xe0\x18\x6c\x2e\x33\x04\x06\x0f\x2c\xe3\x69\x80\xb5\xc2\x4d\xd3\x5e\x7a\xbf\x44\x5b\x36\x10\xb1\x7f\x6a\x24\xf5\xd5\x87\xda\x46\x4a\xe1\x1c\x15\x0a\xd3\xe8\x2b\x5b\x95\x33\x54\x02\x0d\x92\xbb\x2c\x95\x89\x9e\x24\x52\x24\x58\x18\x3d\x91\x0f\xa8\x1e\x38\xae\x27\x56\x83\x71\xb1\x18\xd9\x9d\xe9\xc8\x19\x08\x7a\x42\x47\x3b\x93\x53\xfa\x27\x8a\x5f\x77\x37\x6f\x6e\x2e\xe0\x32\x4d\xfd\xe6\xd6\x6f\x7e\xe7\x1c\x33\x3b\x1b\xb7\x4e\xe4\x73\x72\x64\xf6\x5b\xb9\xb6\x94\x3c\xfd\x8f\xbe\x89\x05\x43\x79\x2e\x0b\x77\xee\x33\x98\xef\x53\xf2\xae\x6c\xac\x51\xe9\xf6\xef\x5b\xa1\x2c\x15\x70\xa3\xed\x14\x89\xa2\x2b\x2f\x35\x79\x3d\x9d\xe7\xaf\x5f\x52\x0c\x31\xe5\xa1\x52\x86\x7d\x04\x8e\x22\xf0\x8d\x32\x6f\xa1\xa6\xf1\x86\x6d\x37\xb7\x7a\x4d\x3b\xf7\x40\x8b\xe2\xea\xe5\x50\xab\x62\x6b\x53\x5c\xbd\x10\xbb\x14\x5b\x9b\xe2\xea\x05\xda\xa5\xd8\xda\x14\x57\x8c\xb8\x6c\x56\x6c\x6d\x8a\xab\x5f\x8b\x74\x2a\xb6\x36\xc5\x35\x10\xec\x8e\x62\x6b\x53\x5c\xfd\xc3\xd4\xa5\xd8\xda\x15\x57\x34\x53\xfb\x44\x7e\x84\x9d\x0c\x07\x82\x84\x66\xfc\x5b\xdc\x4c\x49\x37\x49\xe5\x95\x94\xe5\xa5\xd7\x61\x2c\x42\x26\x38\x30\xfd\x3a\x69\x88\xea\x8d\x56\xbe\x2f\xac\x7e\x9f\xa0\x80\x07\xaa\x83\x78\x25\x3c\x54\x0d\x47\x12\xfa\x15\x94\xf5\x0b\xa9\xeb\x78\x85\x3d\x78\x8c\x86\x28\xed\xa1\x6a\x3b\x92\x36\x3b\xbd\x87\x2b\xee\x61\xaa\x3b\x5e\x79\xc7\xa9\xef\x01\x0a\x3c\x6e\xa3\x6e\x4b\x92\xf1\x9b\xa2\x16\xab\xd4\x5e\x0e\x44\xc4\xd5\xbb\x6b\x3f\x94\xda\x89\x78\x92\xd4\x05\xf9\x29\x42\x98\x62\x2f\x49\xc1\xbf\xc1\xd4\xa2\xa4\x20\x44\xda\xec\xef\xaa\x91\x73\xc0\xf1\x62\x7c\x0e\xf7\xa3\x4f\xe7\xa3\x91\x90\x23\xa3\x98\xd0\x73\x54\xa3\x42\xc9\x85\x42\xad\xcf\x47\x6f\xb4\xd9\x64\x38\x4e\x64\x26\xd5\xbf\x0b\x7c\x40\x75\xdf\x2f\x5f\xa6\x88\xd5\x8a\x25\xaf\x45\x3d\x6e\x4f\xe1\x7c\xf2\xbb\xf1\x0f\xe3\xdf\xbb\x9f\x46\x98\xcf\x30\x4d\x51\x4d\x92\x8c\x8f\x97\x26\xcf\x9e\x49\x9b\x0c\x58\x3c\xb1\x83\x5a\x45\xb0\x0d\x1e\x53\xc7\xf8\x99\x3f\x33\xad\xe2\xe0\xba\x39\xb5\x28\x79\x8a\x7a\x92\x73\xc1\xdd\xff\x47\xa5\xb6\x72\xad\x06\xe0\x19\xf9\xb5\x83\x33\xe1\x7b\x69\xad\x05\x96\x98\xea\xf0\x9d\xc1\xcf\x97\x9f\xe0\xec\x67\x0a\x76\x0b\xbf\x5e\x78\x21\xd8\xe7\x68\xb7\xc5\xb1\x81\xf9\x96\xcf\xac\x94\x03\xd8\xeb\x08\xb9\xd0\x4c\x30\x5c\xc6\x62\x06\xc3\xa5\x33\x85\x08\x3e\x01\x37\xe2\xfa\x4b\x20\xf6\xd0\x14\xb8\x34\x00\x31\x3f\xfe\xcf\x8f\xda\x10\x31\xbf\x1d\xfc\x88\xca\x7e\x28\xbe\x86\x5e\xc8\x64\xc2\xb2\x8f\x61\xdb\xd4\x6b\x45\x1e\x1e\xcd\x32\xb3\x0c\xf6\x14\xc1\xda\x77\x31\xf6\x9a\x7f\xd1\x43\x10\xbf\xfa\xea\x71\xa2\xf1\x2b\x76\xc0\x5c\x68\x91\xa7\x5b\x0c\xe3\x88\x8e\x18\xc9\xfa\x8e\x76\xd0\xe0\xd4\x82\xf5\xeb\x30\x5e\x40\x36\x6f\x67\x4f\x4d\x30\xef\xcf\x82\x67\x96\xad\xfc\x31\x72\x8b\xd3\x81\xe2\x9c\xfb\xf3\xe8\x01\xc8\xc1\x17\xdb\xa0\x88\x9d\xfd\xc9\x4b\x22\xa8\x30\x43\xa6\xe3\xd8\xdd\x10\x2b\x53\x6a\x04\x6d\xe8\x0a\x43\x80\x14\x05\x68\xd8\x38\x03\x9d\x0d\x60\xb2\xd2\x65\x7e\x2b\x33\x9e\x44\xee\x73\x0f\x50\xfe\xcb\x12\x85\x9f\x94\x29\x16\x99\xdc\xb8\x6b\x22\x3a\xd6\x7e\xdd\x96\xed\xa8\x9c\x03\x37\xce\x65\x11\x40\x26\x52\x29\xd4\x85\x14\x69\xdc\x18\xec\x93\xe8\x70\x1a\xc3\xdf\x64\xa9\x2a\x9b\x9b\xb9\x98\x93\x7b\xbe\x10\x52\xe1\x7d\xec\xb6\xce\x96\xfb\x39\xe3\xd9\xfd\xb9\xdd\x33\xdd\xaf\x99\x12\xf7\x20\x05\xd0\x1d\x0c\xb1\xa0\x8d\x94\x20\x8c\x23\x0d\x8c\x5d\x5c\x7b\x65\xdc\xb6\x0c\x9c\x99\x40\x01\x8a\x76\x6a\x45\xae\x72\x38\x58\xe9\x6e\x85\x17\x34\x63\x80\x25\x86\x3f\x90\x4f\x4d\x2a\x10\x32\x9e\xd8\x61\xbb\x40\x57\x5c\x50\xec\x93\xe6\xea\xab\xbb\x25\xd7\x80\x19\xdd\xd6\x0a\xf1\x81\xa8\x61\x29\xd7\x20\xe7\x26\xc2\x0f\xba\x8f\x4e\x15\x87\xed\x43\xda\xed\x7c\x92\x49\x52\xaa\xb1\x5f\x13\x6b\x9e\x65\x43\xe6\x80\xcc\x0b\xe6\x5d\x93\x4e\xeb\xdf\xde\xbc\x7f\xf5\x4a\xd3\x15\x04\xba\xc4\x00\x67\x51\x01\x1b\xf5\x42\x77\xaf\xb6\xab\xcb\x82\x73\x3b\xb2\x10\xc1\x4b\xab\x23\xc6\xac\x0f\xc5\xbb\x0f\x9d\x0b\x79\x4c\x8a\x3a\x59\x4a\x9e\x38\x6f\xe3\x05\xdc\xb3\x6c\xcd\x36\x7a\xd8\x92\x4a\x19\xcf\x36\xf7\x70\x96\xe2\x9c\x95\x99\x79\x7d\x0e\xf7\x14\xa6\xfe\xc0\xb2\x8b\xbf\xde\xc3\x99\x0b\x5f\xf9\xeb\x10\xc2\x35\x30\x11\x2e\x11\x58\x36\xe4\x5c\x94\x06\xf5\x6b\x5a\xb7\x6e\x93\x1b\xe7\xa0\x81\xc7\x2c\xb6\x78\xb3\x16\xc8\x5a\xf5\x4b\x73\x80\x4e\x8a\xb2\x58\x6d\xd1\x82\x15\x7a\x29\xfb\x0f\x24\x5c\x69\x56\x4a\x1e\xc6\x51\x1b\x1d\xb5\x51\x63\x39\x6a\xa3\x41\xd8\x1f\xb5\xd1\x51\x1b\xfd\x36\xb5\x51\xa9\x1e\x73\x74\x61\x67\x20\x45\xa7\x7d\x81\x5d\xdc\x10\x8f\x14\x8f\xf1\x44\x95\xaa\x7f\xe1\xbd\x80\x17\x4a\xbb\xab\x6a\x83\x1c\x1c\xe1\x7a\xdb\x19\x2b\xcd\xb2\x6f\x1d\x46\xfa\x35\x86\x99\x03\x3b\xe1\x8c\x71\x33\xe5\x71\x9e\x29\x57\x06\x2f\xa5\x81\xd3\x3d\xd6\xa7\x32\x10\x8f\x82\x69\xbd\x96\xea\x65\x80\x97\x1a\x55\xbc\xa7\x65\x10\xf0\x17\x99\xe6\xc6\x70\xb1\x18\x36\xcf\x2f\xc3\x39\x75\x82\x41\x85\x5c\xd1\xc4\x7b\xcf\x0a\x2b\x92\xdd\xb1\x68\x2f\x39\x2b\xf4\xf7\x05\x7d\x38\x8c\xae\xc5\x71\x04\xbc\xfa\x6c\xa8\x21\xcb\x23\x09\x38\xbe\xc5\xcd\x47\x9c\x0f\x0f\xdc\x3a\x88\xae\xd8\x92\x1d\x63\xeb\x0d\xb5\xec\xa3\x43\x28\x5a\x82\x28\xaa\xb0\x89\x58\x43\x74\xf0\x72\x8e\x9f\xe7\x2f\x14\xf4\xf0\x95\xc2\x1e\x86\x04\x3e\x44\x83\xa4\x00\x89\x01\xa1\x0f\x8f\x18\xaf\x61\xe1\x0f\x11\x01\x10\xf5\x65\x1f\x4d\xa8\x0f\x71\x7c\x54\x14\xc4\xf0\x3d\xc7\x10\xeb\x2d\x2e\x16\x62\xa0\x1a\xd3\x21\x4c\xeb\x99\x64\x8e\x8e\x8c\xd7\xfa\xf2\x02\xa7\x25\x6a\x2b\x7a\x62\xd4\xa2\xbb\x9e\x12\xb7\x75\x14\x64\x47\x41\xb6\x57\x5e\x24\x92\xeb\xf1\xb1\x5c\xff\xef\xa4\x58\x74\xd5\x60\xb7\x4d\x31\x29\x15\x37\xc3\x4e\xef\x5f\xd4\xae\xd4\x1e\xa3\xb0\x58\x8f\x76\x66\x28\x47\x3b\x33\xa2\x1c\xc5\xf3\xd1\xce\xec\x2c\x47\x3b\xf3\x68\x67\xf6\x96\xa3\x20\xfb\x8d\x0b\xb2\xa3\x9d\xf9\x1c\x55\xa3\xaa\x7d\xd5\xa4\x42\x55\x4e\xbe\x68\x0c\x76\xae\xed\x0b\x09\x99\x14\xfe\xb4\xab\xd4\xd8\x35\xc1\x23\x0e\x12\x76\x3b\x0a\x49\x64\x29\x6b\xdc\x36\xf3\x17\xa3\x84\x94\x2c\x59\x62\x5a\xa1\xdf\x33\x5c\x2e\xa1\xce\x9a\x67\x99\x9d\x99\x39\x33\xa8\x38\xcb\x28\xf7\x21\xdd\xe8\xa3\xb4\xc7\x52\x38\xa1\x55\x0a\xd1\xbf\xec\xee\x6f\x65\x7a\xef\x85\xc5\x1a\xc3\xa9\x6c\x1a\x58\x63\xd9\x31\x2f\x4d\xa9\xb6\x21\x7e\xd0\x9b\x20\x60\xce\x1e\x28\x5c\x60\x0e\xb9\x2c\x85\x39\xa7\xac\x97\xac\xe0\x76\x15\x52\x4a\x59\x30\x8a\x71\xa3\xbb\xa5\x48\xbc\x92\x4b\x64\x4e\xf9\x89\xa3\x8e\x60\xda\xb2\xfb\xd8\x11\xe1\xba\x82\x85\xa9\xcb\x8f\xf2\xc7\xdf\x47\x2c\x38\x14\x89\xda\x14\x06\xd3\xfe\xb3\xe0\x21\xf2\xc1\xa3\x35\x90\x26\xd2\xd4\x2e\x49\x64\x22\x53\x84\xb3\x22\x63\x5c\x80\xc1\xcf\x26\x16\xbd\x28\x81\xed\xb1\x7b\x1b\x63\x5b\x34\xa7\x01\xc9\x59\x41\x92\x76\x29\xb3\x34\x64\xba\xa8\x30\x27\xe0\x2f\x80\x6f\x94\xb1\xd6\x8e\xef\x76\xc3\x7c\x88\x75\xdc\x79\xe1\x0b\xd1\x75\x67\x5b\x3c\x8a\x30\x9a\xfa\xb6\x43\x38\x33\xbc\xf0\x57\x90\xed\x74\xb1\xeb\x75\xc6\x05\x53\xbd\x19\xab\x06\x21\x4c\x42\x81\xf2\xee\x0e\x47\x97\xda\xfa\x1b\x07\xc2\xfe\x6c\xb8\x70\x47\xed\x24\xc8\x9e\x13\xcd\x38\xd3\xf1\x00\xc3\xba\x62\x0b\x79\x1d\x63\x32\x6b\x0d\xc2\xad\x78\x1c\xf7\x88\x6f\x3e\x83\x1d\xa5\xad\xcb\xe8\xf2\x79\x64\x60\xcc\x00\xfc\x14\x5b\x5f\x3d\x8f\xf0\x8a\x9d\x7f\xee\xda\xfd\x05\xcc\x36\x11\xa9\x66\x86\xde\xc3\x1b\x4e\x83\x35\x95\x29\x97\x13\x33\xcc\x6e\x98\xf0\x73\x11\xb3\x61\x8a\x46\x6c\x90\xdd\xd6\x7d\x2a\xad\x4a\x61\xf8\x00\xcb\xad\x23\x8b\x53\xc8\x51\xed\x41\x76\x52\x50\x4b\xe2\xd4\x51\x2f\x3a\x67\xe2\x16\xda\x55\xc6\x06\x26\x4f\xac\xe7\x93\x42\x61\xd4\x06\x5c\x52\xe4\xb3\x9c\x71\xf1\xba\x2b\x97\xef\x61\x19\x90\x58\xb1\x60\x33\x9e\xf1\x97\xbb\xcc\xb4\x43\xe3\x55\xe8\x6e\xe3\x72\x4d\x17\x4c\x19\x9e\x94\x19\x53\x30\x47\x46\x06\x1e\x19\x97\xf1\x5b\x16\x0b\x65\x8d\x59\x06\x2b\x21\xd7\xe4\xd8\xdd\x4f\x5e\xd6\x0b\x6b\x98\x1f\x23\x36\xb1\xda\xb6\x44\x86\xfc\x04\xf0\x2f\x7f\xd9\xd4\x95\x81\x57\x4e\x5d\x19\x1e\xa6\x3c\xfc\xfa\xa9\x2b\x4f\xbd\x84\xea\xca\x23\x42\x6c\x07\x5e\x48\x6d\xc5\x36\xfe\x5a\xea\x13\x50\x1d\x74\x45\xb5\x15\xd5\x21\x17\x55\x1f\x8d\xec\xb0\x78\xca\x81\x57\x57\x43\x93\xd8\x0b\xac\xae\x0c\x8c\x4b\x8b\x8f\xaf\x72\xa5\x39\xe1\x7b\x5b\xf9\xa2\x11\x79\x5d\x3e\x08\x13\xe1\x7d\xd8\xef\x3a\x92\x87\x43\xc2\x44\x07\xc9\xf0\x01\x58\xec\xa6\xb4\x76\x5a\x47\x83\x9c\xdb\x1d\x55\xea\xd2\x0a\x71\x1d\x65\x3c\x0c\xe8\x76\x88\xd6\xd8\x0d\xe2\x6d\x4a\xc7\x29\x10\x7d\xc6\x0b\x55\x8a\xa8\x7b\x1a\x71\x76\x0e\x1c\x93\x22\x0c\x20\xf8\x98\x14\xe1\x31\x88\x1d\x93\x22\x0c\xd0\x23\xb1\x1a\xe4\xcb\xe6\x19\xf0\x46\x76\x40\xee\xb9\x64\x64\xa1\xe4\x03\xef\x48\x22\xdd\x88\x0b\x3d\xf7\x02\x39\xbd\x97\x57\xdb\x40\x05\x58\xe7\xc0\xf1\xdc\x55\xea\x65\xc7\x7f\x95\x4c\xad\xca\x67\xcb\x59\x1f\xb9\x50\x1a\xa8\x79\x0b\x1f\x9d\xf6\x09\x30\x9e\x07\xa5\x98\x05\x32\x3a\xd8\xc3\x76\x56\x8e\xd4\xd1\xa3\x6a\x3c\x3a\x2b\xf5\x53\x1b\x35\x97\x9e\xf5\x08\x66\xdf\x17\xd4\x39\x14\xc1\xf3\xf0\x51\x96\x94\xa4\xf0\x39\xcf\x6f\xa6\xdb\xc3\x9b\xfa\x93\x3f\xbb\x3e\x90\x79\x6f\x9c\x44\xed\x79\x4e\x7a\x63\x07\xf5\x9e\x67\xc1\xdd\x37\x4b\x50\x51\xaa\xca\x98\x95\xf3\x66\xfa\xae\x7a\x64\xf1\x78\x96\xd2\x43\xd7\xf1\x2c\xe5\xd9\xf1\x3d\x9e\xa5\x7c\xed\xb3\x14\xba\xe8\x99\x60\x61\x64\xff\xd5\xb9\x3d\x8c\xaf\x6b\x4d\xe9\x4a\x77\x88\xbd\xd8\x26\xc9\x51\x31\x11\x13\x96\x72\xa9\x16\x21\x4b\x9c\x7b\x6f\x74\x35\x26\x49\xac\xdf\x49\x96\xba\xe0\x13\x92\x76\x85\xc2\x49\x21\xa3\x72\x89\x16\x4a\x26\xa8\x75\xd0\x29\xbd\x2d\xa2\x7d\x7d\x8f\xb8\x01\x16\xe3\x76\x08\x72\x78\xe0\x28\xe8\x2a\x66\x85\x27\xcb\x70\x4d\xbc\x7a\x38\xf7\x2c\xce\x7e\x22\x4d\xb0\x4d\x9d\x4c\x93\xa2\xa0\x50\x2d\xbb\xa1\x8e\xd5\xa1\x30\x8c\x39\x19\x0d\xed\x40\x72\xfd\x7c\x70\x17\x8c\x6b\x13\x2e\x9c\x98\xf5\x4c\xa4\xa8\xe9\xc8\x8c\x8b\x90\x68\x66\x03\x33\x91\x1e\x86\xe3\x61\xe1\x17\x3a\x2c\xf4\xc6\xc9\x66\x54\x7b\x97\x38\x1a\xd3\x77\xde\x4b\x13\x80\xb8\xc7\x8d\x43\xd0\x96\x35\xa9\xe2\x92\x69\xf8\xd9\x71\x86\xe3\xc5\x98\x4c\x19\x2b\xc3\xb9\x86\x6f\x0c\xe6\x45\xc6\x0c\x7e\xd3\x2f\xb7\xbf\xb6\x08\xfa\x4d\x9f\xba\x5a\x9d\xbd\x63\x9f\x87\x23\x58\x4f\x98\xab\x3c\x8b\x0a\xe4\x0b\xae\xc8\x48\xdf\xea\xd7\x38\xb5\xd5\x06\x8b\xc7\x3d\x2f\x44\x2d\xdd\x99\x34\xed\x3b\xe0\x4c\x23\x42\xb1\x5a\x4c\xfc\x53\x52\x93\xae\x71\xff\x92\xef\x0b\xf5\xb2\x6b\xc5\x04\x5f\xb5\x86\xe2\xee\x3d\x7d\xf5\x96\x2a\x6f\x5f\xbb\x74\x7f\xff\x93\x3c\x76\x69\x35\x66\x74\xef\x76\x73\xcd\x5c\x9b\x8e\x26\xb1\x7b\xc6\xc8\xc4\x38\xbb\xf3\x51\x95\x68\xa5\xac\xc7\xc2\x0a\xda\xb8\x24\x1e\xf1\x5b\xbe\x02\x95\xe6\xda\x0a\xc3\x4f\x32\x2b\x73\xbc\xca\x18\xef\x7c\x72\xf2\x10\xc9\x25\xc2\xed\xa7\xab\xca\xac\xda\x3e\xc5\xd0\xc7\x3a\x78\xe6\x77\xe8\x8e\x6f\x99\xfe\x5a\xdf\x32\x3d\xbe\x1f\x7a\x7c\x3f\xb4\x93\xa0\xe3\xfb\xa1\x0d\xa8\x1d\xdf\x0f\x6d\xa6\xe0\x6b\xbc\x1f\xaa\xbf\xe7\x91\x06\xd4\xf4\x7b\xbe\xb5\x9e\xa6\xdf\x5f\x3f\x87\xe9\xf4\x2b\xd7\x6c\x5f\x55\xb7\x18\xb6\x18\x62\xd2\xa5\xe1\x61\x26\x32\x45\xa7\x46\x21\xcb\x9f\x86\x42\xff\xdc\x29\x30\x31\xaa\x6c\x35\xab\xf6\x27\x90\xaf\x5e\x9b\x45\xfe\xcb\x3f\x89\x15\xfe\xeb\x9e\xcc\x47\x33\xed\x68\xa6\xb5\x95\xa3\x99\x76\x34\xd3\x0e\x50\xfb\x6d\x98\x69\x3d\x55\x3a\x7f\x6e\xf7\x61\x19\x9e\xa3\x2c\x1b\x38\xb3\xeb\xb4\x74\xb5\x76\xfc\x96\xf4\x44\x3b\xe4\xec\x33\xcf\xcb\xdc\x3b\xe9\xb8\x14\x90\xfa\x50\x83\xa6\xbb\xf2\x77\x55\xbb\x14\x59\x9a\x71\xe1\x5c\xf5\xee\xb2\xfe\x16\xa8\x36\x4c\x19\x42\x0d\x8a\xac\x74\xdd\x79\x14\x1a\x80\x56\x1d\xc2\xf5\xbc\x06\xa4\xde\x03\x7e\x4e\x28\x20\xf2\xbc\xf6\xbb\xd7\x82\xc0\x9b\x84\x53\xc2\x44\x82\x99\x6d\xc0\x44\x4a\x09\x23\xe8\xe9\xee\x80\x2a\x41\xb8\xb5\x5f\x7e\x62\x3c\xc3\xf4\x90\xd6\xe0\x71\x0e\xc8\xb5\x0c\x49\xc3\xc4\x68\x19\x48\x6d\x98\x29\xf7\xa4\xf0\xce\x18\x11\x4e\x53\xaa\xb5\x33\x4e\x72\x46\x29\x4b\x89\xab\x86\xb4\xd5\x9f\x0e\xde\x66\x6f\xd7\x05\x21\x0e\xae\x41\xfe\xef\x59\x4d\xdb\x67\xd3\x7c\x8b\x4a\x4a\x85\xe3\x8d\x96\x47\xe1\x5b\x5d\xb5\xbb\x39\x80\x42\xf0\x68\x75\x31\x59\x03\xdb\xbb\x5a\x1c\xaa\x9c\x31\xf8\x3b\x6b\x36\x93\xaa\x78\xa4\x8d\x4b\xe3\x0d\x0b\x14\xa8\x58\x16\x2e\x25\xd7\x0d\x54\x42\xb7\x49\x3a\xf6\xa9\xce\x90\xe9\x3b\xd2\xde\xac\x92\x98\x9f\x4d\xff\x7c\xf9\xdd\xeb\x60\x50\x74\xc7\x0d\xf4\x0a\x96\xf6\x1c\xa9\x07\x62\x2e\x1c\xa4\xfb\xd0\xb8\xb3\x9f\x2f\x3f\x91\xd9\x9b\x87\xb4\xef\xfd\x21\x5c\x52\x39\xfe\x91\x45\x64\xdb\x3a\x83\x90\xbe\x59\x54\x75\x9b\x96\xe9\xa5\x23\x24\x29\x8e\xa2\xc6\x49\x6a\x4e\x61\x3d\xd4\x70\x6f\xf2\xa1\xea\xbc\x80\xd9\x8b\x8c\x61\x6a\xd1\xae\x47\x0e\x18\xeb\xae\xd3\x61\xba\xcd\xb4\xcc\x45\xc4\xcd\xb3\x1e\x34\xba\xc2\xf4\x5a\x92\x27\x3f\x52\x3b\x74\xec\x55\x0e\x68\xad\xed\x52\x68\x11\xb9\x50\x37\xae\x5b\x56\x7d\x07\x8d\x89\x14\x2e\x58\x35\x5e\xe8\x6c\x9b\xb8\xf4\xec\x0a\x53\x2b\x7a\x43\xac\xce\x53\x04\x0f\x49\xcb\xab\x00\xbf\x3a\x9f\xf3\xc9\xc3\x82\x4c\x65\x55\x6a\x04\x60\xcd\x4b\x76\x1b\x32\x47\xb7\xf6\x9a\xd4\x63\x9f\x5c\xc9\x98\x36\x77\x8a\x09\x4d\xa8\xdc\x75\xdc\x86\xdc\x3d\x7b\x66\xda\x6b\x53\x2f\x56\x3c\x29\xa6\x02\x65\x87\x4b\xc9\x9c\x62\xff\x9c\xa2\x69\xdf\xed\x4b\x60\x82\x16\x77\x5b\x32\x9c\x4a\xf5\x31\x83\xa3\xc7\xcf\x72\x47\xee\x2f\x94\x86\x3f\x9a\xd4\x3b\x8a\x4a\xd9\x92\x4b\xd1\x7d\x81\xde\x35\xd3\x3e\xad\x7f\x83\xc2\x7e\x66\xdc\x73\xd4\xba\x63\x1b\xbb\x97\xde\x6e\x59\xe6\x4c\x8c\x14\xb2\x94\x5e\xa9\xf2\x8d\x81\x8b\x94\x64\xb2\x58\x40\x8a\x86\x71\x6b\x78\xce\x9a\x8d\x20\x8f\x96\xdd\x87\x56\xa3\xda\x46\x64\x2f\xf2\x0a\x99\x8e\x14\xb8\x77\x64\xb9\xdb\xea\x55\x6c\x6b\xc5\xf0\x57\xda\x8f\xc5\xd3\x31\x6a\xb2\x7e\x5a\x30\xf2\x26\xd0\x56\x89\x3a\x64\xce\x5d\x60\xeb\x1c\xee\x54\x89\xe7\xf0\x13\xcb\x34\x9e\xc3\x2f\x82\x6e\x85\x3e\x1a\xaf\xae\x00\x80\xc3\x63\x7f\x39\x77\x8f\x15\xf8\x58\xdc\x0a\xb7\x47\x76\xdf\xad\x07\x5a\xd7\xf1\x88\xe0\x3e\x9f\x92\x48\xf9\x02\x75\xdf\x0e\xc2\x8e\x85\xab\xe8\x24\x4d\xb3\x77\xa2\x83\xe0\xb4\x35\x79\xfd\x4e\x3f\x4b\xb9\xa6\xf0\x6c\x6b\x03\x18\x29\x57\xd5\xac\x74\x0f\xc8\x5e\x2d\x99\x58\x90\xc3\xe4\x4d\x88\x88\x9e\xc0\xf5\xf4\xa6\x81\x1b\x3f\xfc\xf1\xdb\xef\xac\x3d\x21\xe0\xea\xe3\x1b\x17\x19\x78\x53\xa0\xb8\xbc\xbd\x26\x7f\x22\x3c\xfc\xae\x4a\x99\xb5\xe0\x66\x59\xce\xc6\x89\xcc\x27\x37\x97\xd7\x13\x5f\x6d\x34\xad\x47\x4a\x4d\xb8\xd6\x25\xea\xc9\x0f\xbf\xff\xc3\x10\xb2\x51\x29\xd9\xe0\xa6\x3b\xb4\x34\x6c\xbd\xfa\x67\x38\xe3\x73\x60\xa2\x61\x77\xdf\xd1\xdb\x9c\xf1\xac\xd1\x25\xd1\xb0\x5b\xa7\x35\xef\x57\x99\x6f\xd7\xde\x67\xb7\x66\xeb\x92\x37\x7b\x0a\x5f\x2f\xa5\xdd\x1a\xda\x8d\x9b\x0f\x49\x0c\x3a\xde\x01\x69\x5f\xa5\x5d\xeb\x28\x91\x0f\xd8\xe6\x75\x6b\x20\xdd\x55\x0f\x6f\xc2\xd4\x6d\x1d\xcf\x88\x46\x40\xfd\x0e\x37\x0f\xb0\xdd\x4d\xb1\xcb\x0c\xff\x24\x8d\x28\xf3\x59\x87\x53\xd8\x11\xef\x1f\x49\xe9\xee\xf8\x3d\xfb\x1c\xd9\x77\xd8\xf6\xbb\xbe\xc9\x00\x72\x20\x3a\x0c\x87\x78\x3c\xba\xd4\xfd\xfe\x80\xf0\xad\x07\x36\x30\xa4\xf2\x45\xb4\x82\x88\x55\xf3\x51\xba\xb2\xeb\xce\xcc\x28\x20\xd5\xfd\xeb\x7b\xf6\xb9\x63\xe2\xb6\xfb\x7c\x5a\xf9\x74\x30\x69\x89\x4f\x24\xcd\xea\xeb\x75\xc9\x34\x2c\xe9\x7d\xf4\x16\x47\x56\x1c\xa3\x3a\x99\xd4\xce\xa0\x51\xdb\x9a\x1d\x55\x6b\xac\xe1\xa7\x46\x2c\x3a\x18\xd5\x72\x9c\x70\xb8\x09\xae\x8e\x10\x48\x57\xb4\x69\xc0\x46\x2a\x83\x8f\xe5\x67\x72\x26\x44\xa8\xa9\x9b\x83\x06\x21\xa6\x3a\x97\xda\x58\xf2\x51\x18\xef\x9b\xa0\x5f\x43\x0f\x0d\xfc\xaf\x84\x0f\xed\x54\xda\x5d\x51\x5c\x98\x86\x9b\x2d\x5d\xcb\x92\x7c\x5e\x3d\x94\xec\xee\x87\xa8\xc5\x10\xce\x91\xab\x0f\xd3\xcb\x18\xfb\x61\x3b\x87\xb9\x09\x0d\xdb\x1d\x6f\xad\x33\x76\x98\xe7\xed\xe0\xa3\x1b\x07\x17\x1b\xe6\x3e\x18\xa9\xec\x14\xab\x7d\x29\x67\x07\x79\xfe\xbc\x05\x0b\xff\xf3\xbf\x27\xff\x17\x00\x00\xff\xff\x1b\x14\xdb\xef\x88\xa9\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7d\x6d\x73\xe3\x38\xce\xe0\x77\xfd\x0a\xd4\xe4\x43\x27\x55\xb1\x3c\x33\xfb\x72\x73\xbe\xda\xba\xca\xa4\x7b\x76\x73\xfd\x92\xbe\x76\xa6\x77\xf7\x5b\x68\x09\xb6\xb9\x91\x48\x2d\x49\x25\xed\x7d\xea\xf9\xef\x4f\x81\x22\x65\xf9\x45\x12\xe5\x38\x33\x3d\xbb\x6e\xa5\xaa\x13\x89\x04\x41\x10\x04\x40\x10\x04\xcf\x60\x74\xbc\x7f\xd1\x19\xbc\xe3\x09\x0a\x8d\x29\x18\x09\x66\x89\x70\x55\xb0\x64\x89\x30\x95\x73\xf3\xc4\x14\xc2\x4f\xb2\x14\x29\x33\x5c\x0a\x38\xbf\x9a\xfe\x74\x01\xa5\x48\x51\x81\x14\x08\x52\x41\x2e\x15\x46\x67\x90\x48\x61\x14\x9f\x95\x46\x2a\xc8\x2a\x80\xc0\x16\x0a\x31\x47\x61\x74\x0c\x30\x45\xb4\xd0\x3f\xdc\xde\xdd\x5c\xbf\x81\x39\xcf\x10\x52\xae\xab\x4a\x98\xc2\x13\x37\xcb\xe8\x0c\xcc\x92\x6b\x78\x92\xea\x01\xe6\x52\x01\x4b\x53\x4e\x0d\xb3\x0c\xb8\x98\x4b\x95\x57\x68\x28\x5c\x30\x95\x72\xb1\x80\x44\x16\x2b\xc5\x17\x4b\x03\xf2\x49\xa0\xd2\x4b\x5e\xc4\xd1\x19\xdc\x51\x37\xa6\x3f\x79\x4c\x74\x05\xd6\xb6\x69\x24\xfc\x5d\x96\xae\x0f\x8d\xee\x3a\x2a\x5c\xc2\x67\x54\x9a\x1a\xf9\x3e\xfe\x36\x3a\x83\x73\x2a\xf2\x8d\xfb\xf8\xcd\xc5\xff\x81\x95\x2c\x21\x67\x2b\x10\xd2\x40\xa9\xb1\x01\x19\xbf\x24\x58\x18\xe0\x02\x12\x99\x17\x19\x67\x22\xc1\x75\xb7\xea\x16\x62\xb0\x08\x10\x0c\x39\x33\x8c\x0b\x60\xb6\x1b\x20\xe7\xcd\x62\xc0\x4c\x74\x16\x9d\x81\xfd\xb7\x34\xa6\x98\x8c\xc7\x4f\x4f\x4f\x31\xb3\xa3\x13\x4b\xb5\x18\xfb\xde\x8d\xdf\xdd\x5c\xbf\xf9\x30\x7d\x33\xb2\x28\x47\x67\xf0\xb3\xc8\x50\x6b\x50\xf8\xcf\x92\x2b\x4c\x61\xb6\x02\x56\x14\x19\x4f\xd8\x2c\x43\xc8\xd8\x13\x0d\x9c\x1d\x1d\x3b\xe8\x5c\xc0\x93\xe2\x86\x8b\xc5\x25\x68\x37\xea\xd1\xd9\xc6\xe8\xac\xc9\xe5\xd1\xe3\x7a\xa3\x80\x14\xc0\x04\x7c\x73\x35\x85\x9b\xe9\x37\xf0\xe3\xd5\xf4\x66\x7a\x19\x9d\xc1\x5f\x6f\xee\xfe\x72\xfb\xf3\x1d\xfc\xf5\xea\xd3\xa7\xab\x0f\x77\x37\x6f\xa6\x70\xfb\x09\xae\x6f\x3f\xbc\xbe\xb9\xbb\xb9\xfd\x30\x85\xdb\x9f\xe0\xea\xc3\xdf\xe1\xed\xcd\x87\xd7\x97\x80\xdc\x2c\x51\x01\x7e\x29\x14\xe1\x2f\x15\x70\x22\x24\xa6\x34\xa6\x9e\x81\x3c\x02\xc4\x1f\xf4\xb7\x2e\x30\xe1\x73\x9e\x40\xc6\xc4\xa2\x64\x0b\x84\x85\x7c\x44\x25\x88\x3d\x0a\x54\x39\xd7\x34\x9c\x1a\x98\x48\xa3\x33\xc8\x78\xce\x8d\xe5\x22\xbd\xdb\x29\x6a\xc6\x4f\x8c\x23\xfc\x8b\x22\x56\x70\xc7\x4e\x13\x60\x05\xc7\x2f\x06\x85\xc5\x26\x7e\xf8\x41\xc7\x5c\x8e\x1f\xbf\x8b\x1e\xb8\x48\x27\x70\x5d\x6a\x23\xf3\x4f\xa8\x65\xa9\x12\x7c\x8d\x73\x2e\x2c\xe7\x47\x39\x1a\x96\x32\xc3\x26\x11\x00\x13\x42\x3a\xe4\xe9\x4f\xa8\x66\x9d\xcc\x32\x54\xa3\x05\x8a\xf8\xa1\x9c\xe1\xac\xe4\x59\x8a\xca\x02\xf7\x4d\x3f\x7e\x1b\xff\x31\xfe\x2e\x02\x48\x14\xda\xea\x77\x3c\x47\x6d\x58\x5e\x4c\x40\x94\x59\x16\x01\x64\x6c\x86\x99\x83\xca\x8a\x62\x02\x09\xcb\x31\x1b\x3d\x44\x00\x82\xe5\x38\x01\x0b\x57\xc7\xf6\x75\x83\x09\x23\x22\x3f\x55\x5b\x28\x59\xfa\x6a\xcd\xef\x55\x7d\x07\x39\x61\x06\x17\x52\x71\xff\xf7\x08\x1e\xa8\xbc\xfb\x3d\xa9\x7f\xaf\x68\xf2\x23\x35\x69\xbf\x65\x5c\x9b\xb7\xeb\x77\xef\xb8\x36\xf6\x7d\x91\x95\x8a\x65\x1e\x39\xfb\x4a\x2f\xa5\x32\x1f\xd6\x4d\x8e\x80\x3f\xcc\xaa\x2f\x5c\x2c\xca\x8c\x29\x57\x3c\x02\xd0\x89\x2c\x70\x02\xb6\x74\xc1\x12\x4c\x23\x00\x47\x34\x8b\xe0\xa8\x21\x80\x3e\x2a\x2e\x0c\xaa\x6b\x99\x95\xb9\x27\xff\x08\x52\xd4\x89\xe2\x05\xd1\x74\x62\xa5\x8e\x05\x0d\xc5\x92\x69\xb4\x8d\x02\xfc\x43\x4b\xf1\x91\x99\xe5\x04\x62\x6d\x98\x29\x75\xdc\xfc\x4a\xc4\x99\xc0\xc7\xc6\x1b\xb3\x22\x9c\x48\x30\x8a\x45\x5b\x2b\x86\xe7\x08\xcc\xc0\xd3\x92\x27\x4b\xcb\xc1\x55\xbb\x4f\x4c\x57\x63\x8c\xe9\x6e\xeb\x9e\x93\xe2\x1d\x2e\x70\x65\x2b\x5c\xae\x16\x9b\x98\xa4\xcc\xe0\x21\x78\x64\x4c\x1b\x38\x57\x38\xba\xd0\x86\xa9\xbd\x18\x39\x7a\xb8\xef\x57\xc6\x95\xa8\xf0\x98\x6e\xd4\xea\xc7\xa5\xa2\x80\x6d\x15\xbf\x60\x52\xd2\x17\x48\x4b\x65\x19\xbe\xb5\xed\xad\x02\x55\xd3\xaf\x37\x5f\x86\x8c\x88\x28\xf3\x19\x29\xc5\x79\xa3\x71\x66\x0c\xe6\x85\xd1\xad\x8d\xcf\x19\xcf\x4a\x85\xb1\xc2\x84\x44\xd6\x2a\x76\x35\x36\xc7\x63\x13\x4a\x85\x0c\xf1\xe2\x02\x55\xb4\x2e\xf6\x48\xf3\x9b\x58\x7a\x89\xb9\x15\x16\xf4\x97\x2c\x50\x5c\x7d\xbc\xf9\xfc\xbb\xe9\xc6\x6b\xd8\xc4\xdf\xce\x33\xe0\xa4\x25\x11\xaa\x92\xb5\x74\xb5\x54\xd5\x70\xf5\xf1\xa6\xae\x5b\x28\x59\xa0\x32\xf5\x24\xae\x7e\x1a\xa2\xae\xf1\x76\xab\xa5\x57\x84\x8c\xd3\xaf\x29\xc9\x38\xac\x1a\x75\x93\x0e\x53\x87\x3f\xd1\xd1\x2a\x56\x85\xa4\x0a\x50\x98\xe6\x78\xf8\x47\xce\x49\xe7\xc8\xd9\x3f\x30\x31\x31\x4c\x51\x11\x18\xd0\x4b\x59\x66\x29\x89\xc6\x47\x54\x06\x88\xb6\x0b\xc1\xff\x55\xc3\xd6\xde\xce\xc9\x98\x41\x27\x47\xd6\x0f\x11\x56\x09\x96\xc1\x23\xcb\x4a\xbc\x24\xad\x61\xd5\xbd\x42\x6a\x05\x4a\xd1\x80\x67\x8b\xe8\x18\xde\x4b\x85\xd6\x3e\x99\x58\x45\xad\x27\xe3\xf1\x82\x1b\x2f\xe2\x13\x99\xe7\xa5\xe0\x66\x35\x6e\xd8\x48\x7a\x9c\xe2\x23\x66\x63\xcd\x17\x23\xa6\x92\x25\x37\x98\x98\x52\xe1\x98\x15\x7c\x64\x51\x17\xd4\x61\x1d\xe7\xe9\x99\x72\x4a\x41\xbf\xda\xc0\x75\x87\x2b\xab\x1f\x2b\x3a\x3b\x46\x80\xc4\x28\x8d\x35\x73\x55\xab\x8e\xae\x09\x4d\xaf\x88\x3a\x9f\xde\x4c\xef\xc0\x37\x6d\xad\x9c\x0d\xa0\xe0\xe8\xbe\xae\xa8\xd7\x43\x40\x04\xe3\x62\x6e\x95\x2b\x59\x47\x4a\xe6\x76\x98\x51\xa4\x85\xe4\xc2\xd8\x3f\x92\x8c\xa3\xd8\x26\xbf\x2e\x67\x39\x37\x95\xe9\x82\xda\xd0\x58\xc5\x70\x6d\xf5\x1e\xcc\x10\xca\x82\x24\x40\x1a\xc3\x8d\x80\x6b\xd2\x16\xd7\x4c\xe3\x8b\x0f\x00\x51\x5a\x8f\x88\xb0\x61\x43\xd0\x54\xd9\xeb\x7f\x04\x65\xe2\xa8\xd6\xf8\xe0\xf5\x67\xcb\x78\xd9\xb9\x39\x2d\x30\xd9\x98\x2f\xf6\x2d\xd0\x34\xb4\xf3\x82\x38\x7a\x86\x4e\xf2\xd4\x22\xb3\x6b\xb6\xd2\xa3\x8d\x22\x75\xbc\xda\x7e\xbf\x85\x01\x49\x37\x5f\x14\xcc\x92\x19\x3f\xc3\x68\x3c\xdc\xb2\xa1\x40\x45\xd6\xf9\x1a\xb7\x78\x07\x26\x8a\x32\xdf\x6d\x69\x04\x4a\x96\x86\x0b\x8c\x36\x5e\x5b\x19\x5b\xc8\xcd\x9e\x74\x50\x9c\x7e\x0c\xd3\x0f\x3a\xa4\x2f\xf8\xcf\x12\xc9\x34\x97\x73\x47\x47\x5b\xd3\xd1\xd0\xf5\x04\x53\x60\x1a\x0a\xa6\x0c\xc8\xf9\x0e\x4c\x68\x0c\x42\x2d\xee\x77\xbb\xcc\x0d\xe6\x7b\x30\xda\xc6\x89\xe9\x87\xc6\x2c\xb2\xa0\xd9\x8c\x28\x9e\x18\x8b\x5a\x0c\xb7\x22\x5b\x55\xeb\x2d\x12\x8b\xbb\xb4\xf2\xdd\x6f\x8c\x4c\x22\xc5\x9c\x2f\x4a\xb2\xfe\x8d\x5c\x83\xdf\xb4\x98\x6d\x9d\x64\x29\x35\xee\xc1\xbe\x8b\x75\xaa\xc7\xea\x06\xb6\xdc\xff\x71\xab\x97\xac\x22\x17\x5b\xde\x31\xfd\x70\x69\xd5\x8b\x7b\x51\x33\x57\x0b\x98\x3e\x2c\xe8\x99\x31\x8d\x37\x39\x5b\x60\x7b\x91\x2d\x7c\xa8\x06\x70\xaa\x02\x19\x5b\x39\x4d\xba\xff\xe9\xe0\xb9\xf5\x43\xa2\x05\xbf\x98\xd7\x5c\x05\xa3\x90\x30\xe1\xe6\xd0\xbc\xcc\x88\xfd\xf4\x92\x39\x39\x66\x97\x8d\x20\xed\x6a\x88\x06\x49\x47\x7b\x80\x0d\x41\x8f\x0f\x22\xce\x9c\x93\x06\xb4\x75\xac\x75\xf1\xdc\xd6\x09\x46\x70\xe3\x54\xd8\x31\xba\x65\xff\xe7\x36\x5e\x64\xcc\x90\x70\x0a\x46\x80\x84\x84\xaf\x44\x88\x58\x36\xaf\x78\xe5\xb9\xb8\x28\x5c\xd0\x9a\x79\x35\x69\x2d\xb1\x85\xcb\xd3\x12\x15\x12\x6f\x14\xe5\x2c\xe3\xba\xb2\xf5\x1b\xc3\xd3\x01\x27\x64\xde\xd0\xc3\xd2\x94\x56\xdb\xdd\x85\xb6\xd0\x22\x2c\x7e\xfe\x74\x43\x88\xb1\x24\x41\xdd\xc5\x9f\xc1\xc4\xa1\x9f\x64\x4b\x69\x06\xe0\x51\x49\xba\x9c\x15\x6e\x15\xa2\x8d\x54\x4e\x4d\x5e\x53\xff\xe7\x3c\xf1\xab\x86\xae\xe7\xaa\x34\x4b\xa9\xb8\x59\x1d\xab\x2b\x5c\x68\x4c\x4a\x85\x83\x3a\xc4\xe7\xbe\x4f\xe4\x19\x42\x55\x73\x0c\x99\x6c\x1e\x22\x9c\x73\xbc\xec\x81\x0a\xd6\x12\x02\x29\xb2\xd5\x45\x4f\xd1\x6a\x70\x66\x52\x66\xc8\x44\xd4\x51\x10\xa4\x5a\x30\xc1\xff\x65\x6d\x8e\xc1\xe3\x54\xf7\xa4\x09\xe5\x58\xc4\xd6\x98\x28\x34\x83\x71\xaa\xaa\xb9\x59\x96\x28\x4c\xc9\xea\x63\x99\x06\x12\xc4\x96\x91\xd2\xa8\x13\x62\x28\x86\x2d\xc6\xdf\xe6\xf3\x88\x6a\x26\x75\xb8\xa4\xcc\xe4\xc2\xba\x5f\x9b\xbe\xd1\xe8\x79\xe3\xdc\x8b\xa7\x73\x2f\x4d\xa2\x00\xfc\x9c\xce\x47\x45\x3a\x1f\xce\xad\xca\x25\x89\x7e\x11\x1d\x2e\xb1\x86\x6b\x7a\x9a\x4f\xc7\xd6\xf6\x33\xb9\xdf\xb0\x6b\x45\xe0\xc7\xdb\xf7\x9a\x9c\x99\x92\x5c\x1a\x30\xc3\x39\x0d\x1b\x61\xa6\x4a\x61\x9d\x39\x54\xa0\x7b\x4e\x93\x87\x40\xf1\xd4\xaf\xcf\xbc\x9f\xca\xab\xca\x14\x0b\x14\x29\x8a\x84\x57\xc2\x6f\x05\x39\x13\xdd\x1a\xa2\xd5\x3e\x1d\x48\x0d\x5f\x8c\x29\xc5\xda\xc5\xa7\xe5\x9c\x21\xf6\x11\xed\x02\x58\xb7\x1c\xa4\x5c\x61\x62\xa4\x5a\x91\xc2\x29\x6b\x4f\xd9\xc1\x08\x37\x69\x15\x8c\x0e\x11\x99\xfc\x90\x44\xf0\x4d\x62\x5b\x9c\x9c\xc7\x84\xeb\xda\xbb\xf8\x75\x90\x1d\xc5\xe3\xa0\x2e\xa2\x78\xe4\x4a\x0a\xda\xb6\x81\x47\xa6\x38\x6d\x19\xe8\xcb\xca\x20\x25\x8b\x88\x19\x78\xfb\xe6\xef\x7f\xfa\x7c\xf5\xee\xe7\x37\xdd\x0c\xab\xd1\xd4\x6e\xa4\xf7\xec\x11\xed\xbe\x48\xce\x44\x5a\xf3\xec\xd7\x45\xa9\x6a\xbe\xa4\xce\x3f\xa5\x07\x51\xad\x73\x36\x16\x5c\x08\x4c\x81\x77\x09\x67\xd8\xac\xe6\x67\x2f\x0d\xc3\x25\x70\x91\x64\xa5\x9d\xf9\x4f\x4b\xa4\xed\x2f\x2d\xb3\xc7\xad\x75\xfe\xf6\x63\x14\x13\x9a\x1b\xfe\x88\xd9\xea\x2b\x22\xf1\x23\x6e\xf9\x07\x7b\x08\xeb\xd7\xb1\x7e\xe3\x6f\xbd\xa3\x55\xb1\x94\x63\xb0\x0e\x90\xe0\xf7\xbe\x2c\x84\x5d\xff\xfc\xa1\x9a\x88\x9e\x84\x4d\x87\x1b\x1e\xaf\x5e\xd3\x6a\x9c\x8c\xd2\x74\x62\x25\xc7\xf5\x55\x05\x45\x5b\x9f\x7a\xf5\x7b\xdf\xba\xcb\xf5\x4c\xa4\xf0\x80\xab\x4b\x6f\x30\x7a\xe5\x70\x7d\x05\xc9\xda\xf6\x3d\xd7\x17\xde\x53\xd3\x0b\x31\x91\x42\x90\x5b\xcf\x3a\x0d\x72\x69\xfc\xd4\x55\x58\x48\xcd\x8d\xdd\xbb\x89\xe1\xc6\xd8\xd5\xab\x6b\xb5\x17\xe8\xdf\xe2\x3f\x7c\xfb\xbf\x9b\x18\xe9\xca\xb1\xfa\xf1\xed\xf5\xf4\xec\x7f\x39\xb1\x42\x8a\xb1\x51\xa4\x1f\xd3\x25\xe3\x42\xc7\x70\x05\xff\xef\xed\xb4\x01\xe3\x01\x57\xd6\x72\x23\x8b\x99\x95\x46\x92\x5d\x94\xb0\x2c\x5b\xf5\x43\xa4\x7d\x91\xca\x61\x52\x41\xd8\x4b\xca\x0a\xf5\xb5\x7f\xa5\x17\x6c\xe5\x58\xb2\x03\xc0\xc8\xef\x6a\x54\xa9\xb7\x3a\x4b\x23\x34\x5b\xad\x25\x65\x3f\xaa\x4e\x92\xc6\xf0\x81\xc6\xc8\xba\xe5\xa8\xb6\x92\xd2\x6c\xa1\x5c\x19\xb3\x2c\xd3\xfd\x83\x5f\x1b\x28\x5c\x38\x1f\xb9\x27\x89\x27\x6a\xfc\x2a\x6a\xad\x3d\x68\xe6\xd0\xcf\x03\x76\x2e\x84\xf7\x4e\x1e\x9a\x21\x0f\xb8\xf2\x72\xd6\x19\xf0\xe4\x3c\xc1\x8c\xf8\x76\xae\x64\x1e\x03\xbc\x2f\x77\x3c\xfb\xfb\x9f\x19\x02\x23\x17\x38\x4f\x3d\xac\x07\x5c\xc5\x51\x4f\xad\x70\xa9\x18\xe6\x00\xd9\xdb\xd5\x57\x1f\x1a\x9e\x10\x85\x73\x54\x28\xcc\x5e\x67\x37\xed\xfb\x2a\x81\x06\xed\x9e\x72\x2a\x13\x4d\x7b\x0d\x14\x8d\xa0\xc7\x64\x36\x3e\x72\x7c\x1a\x93\x39\xc5\xc5\x62\x44\x9a\x7c\x54\x59\xf8\x7a\x4c\x88\xe9\xf1\x99\xfd\x2f\x00\x3f\x80\xbb\xdb\xd7\xb7\x13\xb8\x4a\x53\xe7\x9d\x72\xde\xab\x39\xc7\x2c\xd5\x71\x63\x17\xe8\x12\xc8\x61\x7e\x19\x05\xc0\x84\x92\xa7\xff\xb7\x8f\xb1\x06\x68\x22\x67\x2f\x5b\x32\xb2\x6c\x30\xdd\xc9\xdb\xce\xe7\x2b\x5a\x15\xda\x2e\x9a\xb5\x50\x96\x0a\x68\x77\xe2\x01\xfb\x85\x09\x3d\x79\xa9\x0d\xcd\xfd\xca\x75\x9f\x06\xf7\x30\x64\x2d\x0e\xb5\x32\xec\xeb\xe0\x28\x00\xdf\xa0\xf5\x69\x53\xe3\xf5\x4e\xef\x0d\x92\xae\xf5\x9a\xb6\x8a\xad\x4d\x71\xf5\xc0\x84\x76\xc5\xd6\xa6\xb8\x7a\x21\x76\x29\xb6\x36\xc5\xd5\x0b\xb4\x4b\xb1\xb5\x29\xae\x5e\xa0\xad\x8a\xad\x4d\x71\xf5\x42\xec\x56\x6c\x6d\x8a\x6b\x20\xd8\x0d\xc5\xd6\xa6\xb8\x7a\x61\x76\x2a\xb6\x76\xc5\x15\x4c\xd4\x3e\x91\x1f\x60\x27\xef\x0a\x12\xab\x50\xde\xe2\x6a\x6a\x75\x93\x54\x4e\x49\x91\x11\xe0\x74\x18\xeb\x85\x08\x0e\x4c\xbf\x4e\x1a\xa2\x7a\x83\x95\xef\x0b\xab\xdf\x67\x28\xe0\x81\xea\x20\x5c\x09\x0f\x55\xc3\x41\x20\xe1\xd7\x50\xd6\x2f\xa4\xae\xc3\x15\xf6\xe0\x31\x1a\xa2\xb4\x87\xaa\xed\x20\x90\x76\x62\x1c\xa0\xb8\x87\xa9\xee\x70\xe5\x1d\xa6\xbe\x07\x28\xf0\xb0\x85\x3a\x3d\x49\xc6\x6f\x8b\x46\xb0\x61\xe0\x38\x90\xae\xbf\x7e\x77\xe3\xec\x2f\xf2\x45\x32\x53\x49\xea\xc2\x3a\x37\x7c\x9c\x71\x0f\x4c\xa8\x9d\x6d\x4c\x2d\x4a\xf2\x83\x68\xd2\x95\x5b\x6a\xe4\x12\x30\x5e\xc4\x97\x70\x3f\xfa\x7c\x39\x1a\x09\x39\xb2\x6e\x8f\x39\xaa\x51\xa1\xe4\x82\xf6\xb5\x2e\x47\xaf\xb5\x59\x65\x18\x27\x32\x93\xea\x4f\x02\x1f\x51\xdd\xf7\xcb\x17\x8a\x36\xf5\x33\xd6\x7a\x2d\x1a\x31\x8d\x63\x85\xf3\xf1\xef\xe2\x1f\xe2\xdf\x57\x9f\x46\x98\xcf\x30\x4d\x51\x8d\x93\x8c\xc7\x4b\x93\x67\x47\xd2\x26\x03\x26\x4f\xe8\xa0\xd6\x21\xa8\x83\xc7\xb4\x22\xfc\xcc\x05\x3d\xd4\x81\xac\xdd\x94\x5a\x94\x3c\x45\x3d\xce\xb9\xe0\xd5\xef\xa3\x52\xd3\x22\xa4\x01\xe0\x88\xf4\xda\xc0\xd9\xe2\x7b\x45\xd6\x02\x4b\x8c\x9b\xc9\xa4\x79\xff\x7c\xf5\x19\xce\xff\x6c\xa3\x55\xfd\xd7\x89\x13\x82\x7d\x3b\x65\xf4\x58\xb0\xc0\x5c\xcd\x23\x2b\x65\x0f\xf6\x26\x40\x2e\xec\xef\x30\xf8\x3e\xbd\x84\x74\xb6\x31\xbe\xcf\xc0\xcd\x52\xfd\x25\x10\x73\xee\xd7\x83\x11\x73\xe3\x7f\x7c\xd4\x86\x88\xf9\xf5\xe0\x07\x14\x76\x43\xf1\x6b\xe8\x85\x4c\x26\x2c\xfb\xe4\x97\x4d\xbd\x56\xe4\x06\xb9\x49\x39\x14\xcc\x2c\xbd\x3d\x65\x61\x6d\xbb\x18\x7b\xcd\xbf\xe0\x21\x08\x9f\x7d\xcd\x40\xef\xf0\x19\x3b\x80\x17\x76\xc8\x50\x75\x7a\x8d\x61\x1c\x1d\x69\x24\x9b\x2b\xda\xc9\x10\xac\xd6\x34\xd8\x70\xf7\xbe\x80\x6c\x5e\x73\x4f\x43\x30\x6f\x73\x41\x2f\xc8\xf0\xd1\xa5\x87\x1f\x22\xb7\xb8\x8d\x08\x98\xbb\x3d\xd5\x21\xc8\xfd\x72\x0b\x94\x66\xc0\xd4\xcb\x22\xa8\x30\x43\xa6\x51\x1f\x80\x24\x6d\x17\xd0\x5e\x87\x36\xb4\xa1\x58\x43\x0a\x02\x34\x6c\x9c\xe9\x49\x96\x98\x3c\xe8\x32\xff\x28\x33\x9e\x04\xae\x73\x77\x50\xfe\x2b\xed\xb5\x55\x4c\x99\x62\x91\xc9\x55\x75\x82\xcc\xc7\x8f\x07\x03\x6d\xcc\xc8\xd5\x25\x70\x53\xb9\x2c\x3c\xc8\x44\x2a\x85\xba\x90\x22\x0d\x1b\x83\xed\x2e\x56\x38\xc5\x74\xa6\x4c\xd5\x36\x37\x99\xdb\x46\xc2\x3d\x5f\x08\xa9\xf0\x3e\x74\x59\x47\xcf\x3d\x1d\x4a\xb8\xbf\x04\xa9\xe0\xfe\x89\x29\x71\x0f\x52\x80\x3d\x44\x25\x16\xf4\x92\x0b\x8b\x71\xaf\x36\xd9\x87\x6b\xaf\x8c\x3b\x98\x33\xe9\x07\x05\xb1\x56\x7a\xe0\x68\xbb\xe3\x0f\x85\xe5\x18\x60\x89\xe1\x8f\xe4\x40\xa2\x2e\x0b\x19\xde\xd9\x61\xab\x40\xb7\x9a\xb6\x51\xed\xcf\xe2\xd5\x57\x77\x74\x5a\x02\x33\x7b\xdc\xd2\x07\xf8\xa2\x86\xa5\x7c\x02\x39\x37\x28\x82\xc1\x7a\x74\xea\x83\x14\xee\x4c\x0a\x71\xbd\x4c\x92\x52\xc5\x6e\x4e\x3c\x71\x7b\x70\x2c\xf4\xa1\x33\x91\xcc\xb9\x26\x2b\xad\xff\xf1\xf6\xfd\xab\x57\xda\x9e\x21\xb2\xa7\x90\xe0\x3c\x28\xe2\xaa\xf9\xd8\xc3\x93\xeb\xd9\x45\xe0\xaa\x15\x99\x0f\xc1\xb7\xb3\xe3\x22\x0a\x06\xe8\xe6\xb6\x73\x21\xc7\xd6\x5e\x49\x96\x92\x27\xa4\xa1\x14\x4e\xe0\x9e\x65\x4f\x6c\xa5\x87\x4d\xa9\x94\xf1\x6c\x75\x0f\xe7\x29\xce\x59\x99\x99\x8b\x4b\xb8\xb7\xe7\x4c\x1e\x59\x36\xf9\xdb\x3d\x9c\x57\xf1\x67\x7f\x1b\x00\x92\xf6\x36\x85\x3f\x05\x44\x47\x4e\x73\x2e\x4a\x83\xfa\x82\xf8\xf5\xbe\x5a\xe4\xbe\x1a\xc8\xb4\x03\x26\x5b\xb8\x59\x4b\xcf\xc8\x4f\xcd\xa0\xd2\x03\x2c\x56\xfa\xd1\x82\x15\x7a\x29\xfb\x37\x24\xba\x94\x92\x83\x71\xd2\x46\x27\x6d\x74\xd2\x46\x27\x6d\x74\xd2\x46\x27\x6d\x74\x98\x36\x2a\xd5\x21\x5b\x17\xc4\x81\xf4\xdb\x2f\xb1\x8a\x0b\x27\xd6\x08\x78\x3f\x8d\x46\x50\xaa\x2c\x3a\x22\x15\x43\xbd\x50\xba\x3a\x6b\x3a\x89\x06\xd0\xd9\x9f\x4f\x3d\x67\xa5\x59\x5e\x1c\xc7\xaf\x31\xcc\x1c\xf0\xbb\xeb\x41\x47\x28\x9e\xe3\x99\x3a\x80\x33\x06\x0e\xd4\x10\x9f\xca\x40\x3c\x0a\xa6\xf5\x93\x54\x2f\x03\xbc\xd4\xa8\xc2\x3d\x2d\x83\x80\xbf\x08\x9b\x1b\x4a\xcc\x32\x8c\xcf\xaf\xfc\x3e\x35\x9d\xdc\xae\x54\xc8\xb5\x65\xbc\xf7\xac\x20\xab\xa9\x8a\x28\xe8\x81\x58\xed\x84\xda\xdd\x3b\x17\x0e\xa3\x1b\x71\x1c\x1e\xaf\x38\x3a\xde\xf4\x48\x3c\x8e\x6f\x71\xf5\x09\xe7\xfd\x15\x76\xa6\xf7\x76\x74\xc5\xba\xdb\x21\xb6\xde\xb0\xa9\x3c\x20\x84\xa2\x25\x88\xa2\x0e\x9b\x08\x41\x6e\x30\x33\x0e\xf3\x28\xbe\x50\xd0\xc3\xaf\x14\xf6\x30\x24\xf0\x21\x18\xa4\x8d\x67\x1c\x10\xfa\x70\xc0\x78\x0d\x0b\x7f\x08\x08\x80\x68\x4e\xfb\x40\x98\xe0\x43\x1c\x0f\x8a\x82\x18\xbe\xe6\x18\x62\xbd\x85\xc5\x42\x0c\x12\xc4\xfe\xec\xe0\xf1\x64\x8e\x0e\x8c\xd7\xfa\xe5\x05\x4e\x4b\xd4\x56\x20\x48\x68\x46\x77\x3d\x27\x6e\xeb\x80\x89\x71\x12\x64\xff\xe1\x82\xec\x90\x48\xae\xc3\x63\xb9\x7e\x73\x52\x2c\xb8\xa8\xb7\xdb\xa6\x74\x36\x9d\x9b\x5e\x79\xf2\xcb\xd9\x95\xda\x61\xe4\x27\xeb\xc9\xce\x3c\xd9\x99\x27\x3b\xf3\x64\x67\x9e\xec\xcc\x93\x9d\x79\xb2\x33\x4f\x76\xe6\xc9\xce\xfc\xed\xd8\x99\x41\xc5\xfa\xe6\x5a\x6b\x90\xdb\x31\xb2\x82\xd5\x49\x35\x27\xd1\x21\xc7\xf6\x85\x84\x4c\x0a\xb7\xdb\x55\x6a\x7c\x15\x3d\x6b\x23\x61\xb3\x21\x9f\x05\x9a\xf8\xb3\x91\xba\x8f\xd9\x8c\xb2\x94\xfd\x3b\xad\xd1\xef\x84\x0a\x2e\x23\x16\x45\xea\x10\x67\xe6\xcc\xa0\xe2\x2c\xb3\xc9\x4b\xed\x89\x3e\x8a\x8e\xa1\xf8\x2e\x97\x89\x46\xf4\x4f\xbb\xfb\x8f\x32\xbd\x77\xc2\xe2\x09\xfd\xae\x6c\xea\x49\x43\x7b\xa0\xf3\x92\x32\x99\xd6\x21\x7e\x7d\x79\x2f\x00\xe6\xec\xd1\x06\xaf\xcd\x21\x97\x25\xe5\xbb\xa0\xb4\xb5\xac\xe0\x34\x0b\x6d\x4e\x68\x30\x8a\x71\xb3\x95\x7c\xf3\x70\x25\x47\x9b\xbf\x74\x34\x24\x68\x0b\xa6\x2d\x3d\x17\xed\x6c\x73\x5d\xc3\xc2\xb4\x4a\x70\xf4\xc7\xdf\xf7\x42\xa4\xd8\x80\x44\xad\x0a\x83\xe9\x45\x74\x4c\xf9\xe0\xd0\x1a\xd8\x27\xea\x90\xcb\xf2\x9a\xc8\x14\xe1\xbc\xc8\x28\x29\xbd\xc1\x2f\xe6\x22\x3a\xa2\xc0\x76\xd8\xbd\xc5\xd5\x01\x08\xda\x25\x1b\xe5\x78\x23\x49\xbb\x94\x59\x9d\x06\xa9\xc6\xdc\x02\x7f\x01\x7c\x83\x8c\xb5\x76\x7c\x9d\x29\x90\xe0\x1e\xac\x7b\xc1\xd6\x48\xbc\x40\xbf\xee\xa8\xc6\x41\x1d\x23\x42\xdb\x06\xe1\xdc\xf0\xc2\x1d\x41\x26\x76\xa1\xf9\x3a\xe3\x82\xa9\xd5\xc5\x31\x11\xb6\x42\xc1\x66\x0c\x1f\x8e\xae\xad\xeb\x4e\x1c\x50\x18\xaf\x36\x5c\xd8\xbd\xd7\x4a\x90\x1d\x13\xcd\x30\xd3\x71\x07\xc3\xa6\x62\x73\x91\x32\x49\x48\x6a\xbc\x41\xb8\x15\x87\x51\x8f\xaa\xb9\xe4\x78\x84\x9e\xd5\x16\x5c\x87\x25\xc6\x1b\x84\x9f\x62\x4f\xd7\xc7\x11\x5e\xa1\xfc\x57\xe5\x8b\x99\xc0\x6c\x65\xf0\x98\x3d\x31\x87\x4d\x2b\x32\x95\x89\x0b\x6c\x94\x90\x91\x74\x05\x46\xb7\x85\x35\x10\xb1\x41\x76\x5b\xf7\xae\xb4\xcb\x58\x37\x89\x06\x74\x6f\x23\xec\xa1\xb6\x61\xeb\xec\x60\x0e\x64\x68\x12\xa7\xe8\xf9\x46\x40\x03\xda\x75\xc6\x06\x66\x3f\x6d\x54\x06\x14\x94\x9c\xb3\xca\x6a\x7e\x9e\x33\x2e\x2e\xba\x92\x71\x3f\x63\x04\x13\x56\xb0\x19\xcf\x78\x88\x81\x73\x58\xc8\xc8\x46\x1f\xaf\x7d\x73\x2b\x9b\x6e\xc2\xa6\xc2\xe6\x09\x5d\x9f\x01\x73\x64\xd6\xc0\xb3\xc6\x65\xf8\x92\x85\xa0\x3c\x61\x96\xc1\x83\x90\x4f\xd6\xb1\xbb\x9d\x49\xaf\x17\x56\xb8\x89\x37\x24\xcb\xdf\x20\x4b\xbd\x85\x5c\x2f\x74\xd8\xf4\xa0\x23\xa7\x87\xd0\xca\xf1\xcd\xc0\xe3\xa7\xc7\x39\x84\x3a\x70\x22\x34\x1f\x77\x0a\xf2\x99\xd8\x86\x1f\x4b\x7d\x06\xaa\x83\x8e\xa8\xb6\xa2\xea\x78\xe7\x65\x91\xf5\xf2\x39\x14\xd7\x41\x47\x57\x7d\x15\x37\x74\x81\xe5\x03\xf5\xd7\x30\x4d\xb6\xfe\xe7\x03\x74\xbf\xc2\x88\xbc\x2e\x1f\x84\x09\xf0\x3e\x1c\x48\xc3\x70\x1e\x18\x0d\x93\xe1\x03\xb0\xd8\xe8\xba\xd3\x3a\x94\x3b\x94\x56\x54\xf6\x52\x33\xba\x95\xc4\xdb\x23\xd1\x91\x9a\x1d\xa2\x35\x36\x10\xdc\x9b\x1b\x56\x20\xba\x8c\x17\xaa\x14\x41\xe7\x34\x1a\xc6\x45\x74\x14\x6d\xf5\x4b\xe8\xa9\x53\x52\x84\x53\x52\x84\xff\xec\xa4\x08\xa1\x1a\xe4\x30\xdd\x31\x80\xbc\x1b\x03\xe9\x8c\x6c\x8f\x5c\x74\x24\xb2\x14\x4a\x3e\xf2\x8e\x2c\xf0\x7b\x71\xb1\xf7\x35\x01\x2d\x91\x9a\x32\xae\x86\x75\x09\x1c\x2f\xab\x4b\x9d\x7a\xa0\x02\xfc\xff\x92\xa9\x87\x52\x47\x47\x22\x5a\xe0\x44\xd9\xd3\x9b\xb7\xf0\xc9\xe5\x6f\x77\x30\x8e\x83\x52\xc8\x04\x19\x35\xa9\x68\xd7\xb0\x9d\x85\x9b\x5a\xa9\xb3\xa0\x1f\x8f\xce\x42\xfd\xbd\x0d\xe2\xa5\xa3\x6e\xc1\x6c\xfb\x82\x3a\x80\x42\xed\x79\xf8\x24\x4b\x9b\xa4\xf0\x55\xf4\x2c\x3d\xbb\x81\xe5\x74\xbd\x79\xe3\x15\xec\xae\x0f\x64\xde\x1b\x27\xd1\xb8\x5f\xd7\x5e\x92\x85\x7a\xcb\xb3\x40\xfd\x66\x36\xd9\x22\xcd\xa9\x90\x99\xf3\x7a\xfa\xae\xbe\x25\x35\x3a\x8e\x82\x3e\xed\xa5\x9c\xf6\x52\x4e\x7b\x29\xbf\x99\xbd\x14\x3a\xa3\xa9\x28\x86\x44\x2a\x3d\x10\xe3\x9b\x46\x55\x7b\xa4\xdb\xc7\x5e\xac\x93\xe4\xa8\x3e\x95\xec\xef\x39\x90\x6a\xe1\xb3\xc4\xd9\x0d\xde\xf8\x21\xb6\x92\x58\xbf\x93\x8c\x2e\x9a\x2e\x69\xdf\x98\x6e\xbd\x52\x38\x2e\x64\x50\x2e\xd1\x42\x49\xba\x88\xca\xb1\x43\x3f\x22\x81\xab\xa7\x41\xd4\x0d\x37\x17\xa1\x96\xc3\x03\x47\x41\xd7\x31\x2b\x74\xf3\xaf\x3b\x26\xee\x61\xc1\x79\x98\xfd\x64\x35\xc1\x3a\x75\xb2\x65\x8a\xc2\x1e\x09\xa0\x05\x75\x43\x80\x45\x47\x24\x4e\x66\x87\x76\x60\x77\x1d\x3f\x90\x0b\x5a\xd4\xc1\x3e\xc0\x53\xbf\x63\xd6\xc3\x48\xbd\x8d\x11\x3b\xd2\xfd\xc5\x14\x21\xb1\x9f\x0c\xcc\x04\x7a\x18\x4e\x9b\x85\xbf\xd0\x66\xa1\x33\x4e\x56\xa3\xc6\xc5\xe2\xc1\x98\xbe\x73\x5e\x1a\x0f\xc4\x8e\x84\x76\x86\x5a\x0a\x3c\xcc\x49\xe3\x4d\x57\x38\xa7\xf4\xa3\x36\x2c\x84\xf6\xc3\xb9\x86\x6f\x28\x59\x0e\xdd\x2c\xfc\xcd\xc5\x57\x2f\x82\xfe\xa3\x77\x5d\x29\xfe\x61\xc3\x3e\xf7\x5b\xb0\xae\x63\x55\xe1\x59\x00\xeb\x42\xed\x8a\x0c\x58\x3a\x0f\xea\x58\xe0\x82\x3c\x64\xc4\xb5\xc1\xa2\x93\xd7\x76\x06\xd8\xfb\x33\x6d\x4d\x52\x13\x6e\xdd\x01\xe7\x1a\x11\x8a\x87\xc5\xd8\xe6\x82\x45\x35\xbe\x88\x9e\xc5\xe3\x81\xe4\xe8\xef\x65\x2f\xb9\x1e\x98\xe0\x0f\xad\xa1\xb8\x1b\x14\x60\xf0\xd6\x16\x5e\x5f\x57\x5b\xfd\xfd\x6f\x72\x5b\x2d\x69\xcc\xe0\xd6\x69\x71\xcd\xaa\x3a\x1d\x55\x42\x7a\x3e\x20\x31\xce\x06\x06\x46\x95\x48\x52\xd6\x61\x41\x8b\xc5\xb0\x24\x1e\xe1\x4b\xbe\x82\x5c\x1a\x9a\x84\xe1\x67\x99\x95\x39\x5e\x67\x8c\xe7\xc3\x90\x5c\x22\x7c\xfc\x7c\x5d\x9b\x55\xeb\xab\x18\xfa\x48\x17\x3c\x6e\x01\x3c\x7e\xba\x8c\xf8\x6b\xbe\x8c\xd8\x5f\x82\x1a\x8c\xc0\xe9\x02\xe0\xd3\x05\xc0\xa7\x0b\x80\x4f\x17\x00\x7f\x35\x17\x00\xeb\xef\xf9\x24\x0a\xc0\x8d\xc1\xf4\x7b\xbe\xb6\x9e\xa6\xdf\xdf\x1c\xc3\x74\xfa\xca\x35\xdb\xaf\xaa\x5b\x0c\x5b\x04\xb7\x6d\x6d\x14\x77\x31\x93\x35\x45\xa7\x46\x21\xcb\x9f\x87\x42\x3f\xef\x14\x98\x18\x55\xb6\x9a\x55\x1b\x28\x32\x7b\xd0\x94\x8a\x37\xb8\xc8\xbd\xf9\x37\xb1\xc2\x4f\x66\xda\xc9\x4c\x3b\x99\x69\x27\x33\xed\x64\xa6\xfd\x86\xcc\xb4\x9e\x22\x9d\x9f\xdb\x7d\x58\xb4\xc1\x20\xcb\x3d\x94\xd9\xa0\xc5\x5d\x55\x6a\xc3\x6f\xf9\x23\x79\xe1\x20\x67\x5f\x78\x5e\xe6\xce\x49\x47\x11\x06\xa9\x0b\x35\xd8\x77\x56\xfe\xae\xae\x97\x22\x4b\x33\x2e\xac\xef\x9a\xa2\x85\x5c\xfe\xf3\xea\xa3\x36\x4c\x19\x9b\x12\x17\x8a\xac\xac\xe6\xaa\x43\x61\x0f\xd0\xba\x41\xb8\x99\x83\xd9\xdb\x02\x7e\x49\x6c\x40\xe4\x65\xe3\xbb\x33\xe9\x80\xef\x13\x4e\x09\x13\x09\x66\x98\x56\x77\x53\x52\xc2\x08\x7b\x75\xb7\x47\xd5\xb6\xf0\x91\xde\xfc\xc4\x78\x86\x69\x1c\xb5\x79\x9c\x3d\x72\x51\x30\x63\xb4\x0c\xa4\x36\xcc\x94\x5b\x52\x78\x63\x8c\x2c\x4e\x53\x5b\x6a\x63\x9c\xe4\xcc\xa6\x2c\xb5\x54\x35\x56\x5b\xfd\xb8\x73\xfd\x7d\xbb\x2e\xf0\x71\x70\xba\x87\x43\x58\xe3\xda\x34\x57\xa3\x96\x52\x7e\x7b\xa3\xe5\xde\xfd\x56\x57\xed\x46\x03\x3e\x94\x72\x7d\x30\x99\xce\x39\x6c\x1e\x2d\xf6\x45\xce\x19\xfc\x83\xed\x37\x93\xea\x78\x24\x92\x33\x84\xd7\x02\x05\x2a\x96\xf9\x43\xc9\x4d\x03\xd5\xa2\x7b\x11\x0d\x57\x9d\x3e\xd3\xf7\xfe\xaf\x3b\x94\xf3\xc5\xe1\x7c\xfa\x97\xab\xef\x2e\xbc\x41\xe1\xb6\xe9\xa3\x03\x05\x0b\x4f\x83\x9a\xa7\x96\xfc\x46\xba\x0b\x8d\x3b\xa7\xd3\x23\x64\xf6\xe6\x3e\xed\x7b\x7f\x08\x97\x54\x15\xfd\xc8\x7c\xb2\x51\x4e\xd5\xea\xc6\xbe\x23\x8e\xd6\x17\x87\xf6\xc3\x27\x29\x0e\xea\x4d\x25\xa9\xb9\x3d\x22\x6d\x2b\x6e\x31\x1f\xaa\xce\x03\x98\xbd\xc8\x18\xa6\x16\x68\x82\x50\xa1\x36\xab\xe3\x74\x98\xae\x33\x2d\x3b\x64\xba\xb7\x76\x7b\xd0\xe8\x0a\xd3\x6b\x49\x9e\x7c\xa0\x76\xe8\x58\xab\xec\xf4\xb5\xb1\x4a\xb1\x93\x88\x98\xc0\xee\x4e\xed\x9f\xf5\x1d\x7d\x4c\xe8\x52\x9a\x96\x0b\x20\x5b\x84\xce\xba\x4a\x95\x9e\x9d\x02\xf5\xd3\x52\xf9\x58\x9d\xe7\x08\x1e\x2b\x2d\xaf\x3d\x7c\xf7\x6d\xe6\x84\x6b\x2d\x53\x59\x9d\x1a\x01\xd8\x2e\x85\xe9\x59\x87\xcc\xd9\x53\x7b\xf1\x01\x72\x25\x63\xda\xdc\xd1\x45\x93\xb6\xab\x77\x1d\xa7\x21\x37\x7a\xf0\x8e\x69\xa7\x4d\x9d\x58\x71\x5d\x31\x35\x28\x1a\x2e\x25\x73\x1b\xfb\x47\x5d\xea\x88\x71\x35\x12\x98\xb0\x93\x3b\x8e\xba\x37\x5b\x29\x7f\xfe\xe8\x70\x2e\xaf\xba\xfb\xb3\xbd\x15\x20\xb8\xab\x64\x60\x64\x8d\xee\x72\xbd\x66\x0d\x78\x62\xda\xa5\xf5\x4f\x5f\x1c\xf7\x1c\xb5\x66\x8b\x30\xa4\xaf\x60\x59\xe6\x4c\x8c\x14\xb2\x94\x36\x97\x7c\x65\xe0\x22\xa5\xc5\x09\x71\x71\x8a\x86\xf1\x4c\x03\x9b\xed\x37\x82\x1c\x5a\x4b\x6c\x8c\x6a\x7c\x28\xf2\x0a\x99\x0e\x14\xb8\x44\xf0\xaa\x78\x1d\xdb\x5a\x13\xfc\x95\x76\x63\xf1\x7c\x8c\xf6\x59\x3f\x2d\x18\x39\x13\x68\xad\x44\xab\xd1\xbf\xb4\xcc\x2d\xe7\x70\xa7\x4a\xbc\x84\x9f\x58\xa6\xf1\x12\x7e\x16\xf6\x54\xe8\xc1\x78\x75\x05\x00\x6c\xd2\x89\xb6\xfd\xe5\xbc\xba\x3a\xc7\xc5\xe2\xd6\xb8\xc5\x2f\xa1\x07\x5a\xe7\xf1\xc8\x92\xfb\x78\x4a\x22\xe5\x0b\xd4\x7d\x2b\x08\x92\x3c\x55\xc1\x4a\xd2\xec\xf7\x4e\x74\x74\xd8\x1b\xd2\x3d\xed\xd0\x55\x1f\x14\x9e\x4d\xf7\x9d\x19\x29\x1f\x6a\xae\xb4\x2a\x00\xae\x97\x4c\x2c\xac\xc3\xe4\xb5\x83\x07\x63\xb8\x99\xde\xee\x00\x05\xf8\xe1\x8f\xdf\x7e\x47\x71\x45\x02\xae\x3f\xbd\x26\xcf\x97\x86\xdb\x02\xc5\xd5\xc7\x1b\xeb\x4f\x84\xc7\xdf\xd5\x29\xb3\x16\xdc\x2c\xcb\x59\x9c\xc8\x7c\x7c\x7b\x75\x33\x76\xc5\x46\xd3\x66\xa4\xd4\x98\x6b\x5d\xa2\x1e\xff\xf0\xfb\x3f\x0c\xe9\x36\x2a\x25\x55\x4f\x9f\x89\xb6\xb6\x5c\xf3\x35\x9c\xd3\xbe\xb5\x58\x5d\x0c\x69\x8d\xee\x60\xdb\xeb\x92\xd8\x69\xcf\xcd\x79\x37\xcb\x5c\xbd\xf6\x36\xbb\x35\x5b\x97\xbc\xd9\x68\x99\x51\xe2\x1f\x5a\x1a\xd2\xc2\xcd\x85\x24\x7a\x1d\x5f\x01\xd9\x0b\xa3\xa3\xc7\xf4\xa3\x30\xa1\xc4\x66\xab\x00\x04\xaa\x86\xaa\xe2\xfe\x4e\x98\xa6\xad\xe3\x08\xb1\x17\x50\x37\x0d\xe8\x71\x00\xdb\x3e\x6f\x13\xc3\x5d\x49\x23\xca\x7c\xd6\xe1\x14\xae\x3a\xef\x2e\x49\xe9\x6e\xf8\x3d\xfb\x12\xd8\xb6\x5f\xf6\x57\x6d\xd3\x8a\xc5\x81\xd0\xc7\xc0\xa3\x4b\xdd\x6f\x21\x62\xf8\xda\x03\xeb\x6a\xaf\x7d\x11\xad\x20\x42\xd5\x7c\x2f\xeb\x74\x0b\x61\x32\xc7\x1d\x52\xdd\x5f\xdf\xb3\x2f\x7b\x0b\x74\x4a\xe4\xca\x79\x33\x89\xfa\x69\x44\x56\x01\xd1\xc9\x4a\xb3\xe6\x7c\x5d\x32\x0d\x4b\x7b\x3f\x7a\x8b\x23\x2b\x8c\x50\x9d\x44\x6a\x27\xd0\xa8\x6d\xce\x8e\xea\x39\xb6\xe7\xd3\x5e\x2c\x3a\x08\xd5\xb2\x9d\xb0\x43\xa1\xf5\x16\x82\xf5\x53\x98\x68\x40\x2f\xbd\x8f\xe5\xcf\xd6\x99\x10\xa0\xa6\x6e\x77\x2a\xf8\x98\xea\x5c\x6a\x43\xdd\xa7\x00\xfd\xc5\xfa\xab\x6f\x21\x6a\x3b\x53\xc4\x75\xe5\xd7\x8a\xa3\xb6\x31\xe4\xc2\xec\x39\xd9\xd2\x35\x2d\xad\xcf\xab\xa7\x27\x9b\xeb\x21\x5b\x63\x08\xe5\xac\xab\x0f\xd3\xab\x10\xfb\x61\xcd\xc3\xdc\xf8\x8a\xad\xbd\x6d\xe7\xd8\x56\x6c\xf6\x32\xd1\xce\xcb\x6a\x1c\xaa\xd8\xb0\xea\x85\x91\x8a\x58\xac\xf1\xa6\x9c\xf9\xd5\x60\x2d\xeb\xb5\x61\xa6\xd4\x13\xf8\xaf\xff\x8e\xfe\x67\x00\x8c\x3c\x2a\xf9\x49\xad\x00\x00"),
 		},
 		"/crd/bases/camel.apache.org_camelcatalogs.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "camel.apache.org_camelcatalogs.yaml",
@@ -131,23 +131,23 @@ var assets = func() http.FileSystem {
 		"/crd/bases/camel.apache.org_integrationkits.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "camel.apache.org_integrationkits.yaml",
 			modTime:          time.Time{},
-			uncompressedSize: 15078,
+			uncompressedSize: 18557,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x3b\x5d\x6f\xdb\x48\x92\xef\xfa\x15\x85\xf1\x43\x12\x40\xa2\x77\xee\x16\x8b\x83\x0f\xf7\xe0\x75\x92\x59\x23\x89\x9d\x8b\x34\xb3\x37\xc0\x3c\xb8\x44\x96\xa8\x1e\x91\xdd\xdc\xee\xa6\x64\xdd\xe1\xfe\xfb\xa2\xaa\x9b\x14\x25\x91\x92\xc6\x33\xc1\xbe\x84\x2f\xb1\xc8\xee\xea\xfa\xfe\xec\x5c\xc1\xe4\x8f\x7b\x46\x57\xf0\x51\xa5\xa4\x1d\x65\xe0\x0d\xf8\x25\xc1\x6d\x85\xe9\x92\x60\x6a\x16\x7e\x83\x96\xe0\xbd\xa9\x75\x86\x5e\x19\x0d\xaf\x6f\xa7\xef\xdf\x40\xad\x33\xb2\x60\x34\x81\xb1\x50\x1a\x4b\xa3\x2b\x48\x8d\xf6\x56\xcd\x6b\x6f\x2c\x14\x01\x20\x60\x6e\x89\x4a\xd2\xde\x25\x00\x53\x22\x81\xfe\xf0\x38\xbb\xbf\x7b\x07\x0b\x55\x10\x64\xca\x85\x4d\x94\xc1\x46\xf9\xe5\xe8\x0a\xfc\x52\x39\xd8\x18\xbb\x82\x85\xb1\x80\x59\xa6\xf8\x60\x2c\x40\xe9\x85\xb1\x65\x40\xc3\x52\x8e\x36\x53\x3a\x87\xd4\x54\x5b\xab\xf2\xa5\x07\xb3\xd1\x64\xdd\x52\x55\xc9\xe8\x0a\x66\x4c\xc6\xf4\x7d\x83\x89\x0b\x60\xe5\x4c\x6f\xe0\x67\x53\x47\x1a\x3a\xe4\x46\x2e\x8c\xe1\x27\xb2\x8e\x0f\xf9\xb7\xe4\x4f\xa3\x2b\x78\xcd\x4b\xbe\x8b\x1f\xbf\x7b\xf3\x9f\xb0\x35\x35\x94\xb8\x05\x6d\x3c\xd4\x8e\x3a\x90\xe9\x39\xa5\xca\x83\xd2\x90\x9a\xb2\x2a\x14\xea\x94\x76\x64\xb5\x27\x24\x20\x08\x30\x0c\x33\xf7\xa8\x34\xa0\x90\x01\x66\xd1\x5d\x06\xe8\x47\x57\xa3\x2b\x90\x67\xe9\x7d\x75\x73\x7d\xbd\xd9\x6c\x12\x14\x74\x13\x63\xf3\xeb\x86\xba\xeb\x8f\xf7\x77\xef\x1e\xa6\xef\x26\x82\xf2\xe8\x0a\x7e\xd4\x05\x39\x07\x96\xfe\x51\x2b\x4b\x19\xcc\xb7\x80\x55\x55\xa8\x14\xe7\x05\x41\x81\x1b\x16\x9c\x48\x47\x84\xae\x34\x6c\xac\xf2\x4a\xe7\x63\x70\x51\xea\xa3\xab\x3d\xe9\xec\xd8\xd5\xa0\xa7\xdc\xde\x02\xa3\x01\x35\x7c\x77\x3b\x85\xfb\xe9\x77\xf0\xd7\xdb\xe9\xfd\x74\x3c\xba\x82\xbf\xdf\xcf\xfe\xf6\xf8\xe3\x0c\xfe\x7e\xfb\xe5\xcb\xed\xc3\xec\xfe\xdd\x14\x1e\xbf\xc0\xdd\xe3\xc3\xdb\xfb\xd9\xfd\xe3\xc3\x14\x1e\xdf\xc3\xed\xc3\xcf\xf0\xe1\xfe\xe1\xed\x18\x48\xf9\x25\x59\xa0\xe7\xca\x32\xfe\xc6\x82\x62\x46\x52\xc6\x32\x6d\x14\xa8\x41\x80\xf5\x83\x7f\xbb\x8a\x52\xb5\x50\x29\x14\xa8\xf3\x1a\x73\x82\xdc\xac\xc9\x6a\x56\x8f\x8a\x6c\xa9\x1c\x8b\xd3\x01\xea\x6c\x74\x05\x85\x2a\x95\x17\x2d\x72\xc7\x44\xf1\x31\x7f\xa4\x6d\x8d\xb0\x52\x51\x9d\x6e\x00\x2b\x45\xcf\x9e\xb4\x60\x93\xac\xfe\xc3\x25\xca\x5c\xaf\xbf\x1f\xad\x94\xce\x6e\xe0\xae\x76\xde\x94\x5f\xc8\x99\xda\xa6\xf4\x96\x16\x4a\x8b\xe6\x8f\x4a\xf2\x98\xa1\xc7\x9b\x11\x00\x6a\x6d\x22\xf2\xfc\x13\x82\xd5\x99\xa2\x20\x3b\xc9\x49\x27\xab\x7a\x4e\xf3\x5a\x15\x19\x59\x01\xde\x1c\xbd\xfe\x53\xf2\x97\xe4\xfb\x11\x40\x6a\x49\xb6\xcf\x54\x49\xce\x63\x59\xdd\x80\xae\x8b\x62\x04\x50\xe0\x9c\x8a\x08\x15\xab\xea\x06\x52\x2c\xa9\x98\xac\x46\x00\x1a\x4b\xba\x01\xa5\x3d\xe5\x56\x76\xaf\x94\x77\x89\x7c\xef\x68\xe3\x88\xe5\xc0\xfb\x73\x6b\xea\x66\x7f\xf7\x7b\x00\xd4\x20\x8e\x9e\x72\x63\x55\xf3\x7b\x02\x2b\x5e\x1f\xff\x4e\xdb\xbf\x03\x73\xee\x77\x67\x7f\x50\x5e\x3e\x14\xca\xf9\x0f\x3d\x1f\x3f\x2a\x17\x16\x54\x45\x6d\xb1\x38\xc2\x5b\xbe\xb9\xa5\xb1\xfe\x61\x87\xcd\x04\xd4\x2a\x7c\x50\x3a\xaf\x0b\xb4\x87\xdb\x46\x00\x2e\x35\x15\xdd\x80\xec\xaa\x30\xa5\x6c\x04\x10\x19\x2c\x50\x26\x1d\x67\xf5\xd9\xf2\x76\x7b\x67\x8a\xba\xd4\xed\x19\x19\xb9\xd4\xaa\xca\x8b\x48\xd8\x43\x75\xce\x80\x95\xf2\x50\x2d\xd1\xd1\x28\x58\xfc\xaf\xce\xe8\xcf\xe8\x97\x37\x90\x38\x8f\xbe\x76\x49\xf7\x6b\x10\xc9\xe7\xce\x1b\xbf\x65\xec\xd8\x1e\x75\x7e\xe9\x79\xbc\xe7\xf8\xb8\x46\xe1\x92\xa0\x12\x41\xd0\xbf\x44\x49\xfe\xc2\xa2\xfc\xe5\x7a\xa5\xfc\x2f\x49\x67\x7b\xc0\x67\xb6\x7b\xf1\x12\x74\x54\x89\xf9\x30\xf9\xdd\xaf\xe1\xb8\xfb\xce\x9b\xa3\xf3\xc2\x92\xf5\xf7\x41\xac\xe9\x92\x4a\xbc\x89\x6b\x4d\x45\xfa\xf6\xf3\xfd\x4f\xff\x3e\xdd\x7b\x0d\xfb\x18\xee\xab\x15\x64\x6c\x91\xe4\xc4\x57\x6b\xf6\xda\x64\x03\xc2\xec\x57\xba\x71\x2a\x35\x7a\xa1\xf2\x3a\xec\x6c\x41\x03\x68\xa2\x2c\xb8\x5b\x5b\x8b\xaf\x7c\xea\x9c\xf0\x94\xc0\xed\xfe\x9b\x0f\xca\x3f\xb1\x8f\x45\xc8\x49\x93\x55\x69\x3c\x4d\x7e\x61\x51\x6c\x3b\xa0\xd9\xe4\x3d\x2c\xac\x29\xc5\x99\x45\xb7\x2f\x81\x97\x83\xca\xe1\x59\x63\x98\xd7\x1e\x30\xd7\xc6\x79\x95\x4a\x00\xf0\x63\x50\x5d\x64\x8d\x15\xcf\x68\x60\xce\xe0\x6a\x17\x63\x88\xde\x82\x11\x0f\xbd\x07\x0f\x36\x4b\x95\x2e\x61\x89\x2e\xf8\x63\x2c\x5b\x1c\xb2\x0e\x4c\x47\x9e\xb1\x49\xb1\xc2\xb9\x2a\x94\x57\xe4\xfa\xa9\xe6\xc8\x38\x27\x90\x53\xd9\xc9\x87\x23\xd9\x78\x01\x1d\x60\x97\x70\x74\xd4\x91\x47\x81\x5b\xb2\x63\xd8\x2c\x49\x0b\x26\x4f\x4a\xa7\x81\x0f\x58\x3c\x09\x97\x32\x30\x22\x5e\xe6\x2c\x69\x8e\x86\x59\xd2\xc2\xab\xac\xa9\xc8\xfa\xd6\x27\x85\xa7\xe3\xc2\x3b\x6f\x0f\x94\xe5\x15\xeb\x53\xcc\x1b\x1a\x4d\x61\x0c\xa2\x83\xa0\x2c\xaa\x60\x88\xf1\x8a\x43\x33\x87\x38\xc6\xec\x40\x4d\x84\xfb\x22\x33\x33\xff\x95\x52\x9f\xc0\x94\x2c\x83\x61\xa7\x55\x17\x19\x93\xbb\x26\xeb\xc1\x52\x6a\x72\xad\xfe\xb7\x85\xed\x9a\xfc\xad\x40\x4f\xd1\x09\xee\x1e\x71\x48\xac\x9f\x6b\x2c\x6a\x1a\x8b\xd6\x32\xa3\x2d\xf1\x29\x50\xeb\x0e\x3c\x59\xe2\x12\xf8\x64\x2c\x49\xde\x75\x23\x09\x88\xbb\xb9\xbe\xce\x95\x6f\x42\x57\x6a\xca\xb2\xd6\xca\x6f\xaf\x3b\xb9\x9f\xbb\xce\x68\x4d\xc5\xb5\x53\xf9\x04\x6d\xba\x54\x9e\x52\x5f\x5b\xba\xc6\x4a\x4d\x04\x75\x2d\xe1\x2b\x29\xb3\x2b\x1b\x83\x9d\x7b\xb5\x87\xeb\x91\x29\x87\x47\x22\xc1\x09\x09\x70\x30\x08\x06\x13\xb6\x06\x2a\x76\x8c\xe6\x57\xcc\x9d\x2f\xef\xa6\x33\x68\x8e\x16\x61\x1c\x72\x5f\xf8\xbe\xdb\xe8\x76\x22\x60\x86\x29\xbd\x90\xa4\x81\xb3\xbe\xc6\xe2\x48\x67\x95\x51\xda\xcb\x8f\xb4\x50\xa4\x0f\xd9\xef\xea\x79\xc9\x0a\xcc\x76\x41\xce\xb3\xac\x12\xb8\x93\x78\x2e\xba\x5e\x65\xe8\x29\x4b\xe0\x5e\xc3\x1d\xfb\xdb\x3b\xe4\x44\xf1\x2b\x0b\x80\x39\xed\x26\xcc\xd8\xcb\x44\xd0\x4d\x45\x0e\x17\x07\xae\x75\xcd\x3d\xa6\x03\x03\xf2\x62\x4e\x65\xe4\x24\x3d\x1d\x72\x99\x43\x26\x09\x21\xf3\xd9\xed\x39\xfc\x78\xa8\x1b\x7b\x8b\xa1\x71\x67\x8c\x02\xc7\x9d\xd9\xe3\xdb\xc7\x1b\xd8\x50\x63\x61\x19\x4b\x9e\x13\x94\x23\xa8\x6c\x46\xb0\xa8\x99\x9f\x60\xa9\x20\xe4\xd2\x82\x5f\xe1\xda\xd4\x96\xed\xb6\x34\xb5\xf6\x63\x09\x31\x58\x29\xce\x60\x25\x7c\x82\xb7\xa8\xfc\x01\x97\x05\x9e\xa7\xf2\x88\xb6\x23\x02\xee\xba\xf8\x4f\x2b\x4a\x3b\xda\xd9\x89\x10\xc3\x7c\xec\x0a\x85\x73\xe5\xa1\x15\xc3\xfc\x0e\x4f\x63\x37\x1f\x68\xdb\xbf\xe0\x90\xf3\x6f\x1b\x5e\x66\x37\xa0\x0d\x14\x46\xe7\x64\x45\x02\xc7\xbc\x08\xcf\x80\xee\x1d\xe3\xf0\x89\x59\xfd\x99\xcd\xee\x5f\x8e\x0a\x27\x3e\xff\x32\x24\xfc\xc5\x87\x77\x94\x86\x75\x9f\x37\x4a\x44\xee\xaa\xcd\x18\x14\xdd\x34\x7a\xb0\x1d\x0f\xc0\x6d\xec\xaf\xc4\x6a\x0c\x8e\x52\x4b\x7e\x0c\x49\x92\xbc\x98\x08\x71\xd6\x17\x51\x21\x61\x55\x5c\xbb\x37\x80\xce\xa9\x5c\x37\x81\x6f\xdf\xcc\x5f\xbb\xad\xf6\xf8\x3c\x48\x01\x3b\xf3\x35\xda\x2d\xdb\x3b\x69\xe9\x26\x98\x98\x37\xb0\x3c\x9f\xde\xbc\x8c\x96\x26\xf3\xe9\x23\x66\xd2\x4d\xb9\xf7\x3f\x08\x49\x3d\x5f\x06\xbc\x6b\xf7\x23\x5a\x8b\xdb\xd1\x21\xcb\x98\x26\xd2\x69\xaf\x29\xef\x31\x14\xa5\x96\x62\x45\x90\xc8\xd3\x6c\xe5\x9d\x1d\x57\xa9\x1c\xac\xd4\x31\x06\x83\xfe\xeb\x24\x97\x86\xf1\x96\x24\xf7\x0c\xc2\x51\xd4\xfb\x49\xb8\x03\x95\x71\x68\x5b\x28\xca\xd8\x25\xef\x2f\xb2\x94\x2b\xe7\xed\xe1\x69\x27\xd1\xac\xac\x59\xa8\xe2\x12\x64\xe2\xca\x98\x07\x73\x6a\xf9\x5c\x51\xea\xcf\xb0\xee\xc4\xd1\x96\x2a\xe3\x94\xef\xd4\xc7\x83\xe7\x7f\xc2\x35\xe9\xbd\x0d\xe0\x97\xe8\x21\x45\xdd\x26\xd1\xbb\x58\xf7\xd5\xe5\x17\xe2\xdc\x39\x9e\xc9\xa2\x80\x67\x13\x84\x37\xaa\x28\x80\x9e\x29\xad\x7b\xe2\xee\xe9\xb0\x84\x59\xd6\x36\x44\x7a\xbe\xb5\x35\xf9\xe9\xd0\x76\x80\xe3\x2d\x03\x9d\x31\xa2\x70\xe0\x36\xf7\xbd\x4c\xc8\xd5\x05\x85\x41\x4f\x23\xf4\x9e\xf4\x26\x03\xf6\x1d\x9e\xe7\xc9\xaa\x9e\x93\xd5\xe4\xc9\x4d\x04\x15\xbb\xa6\x49\xad\x57\xda\x6c\xf4\x64\xa1\xa8\xc8\x1c\x33\xb5\xd7\x7f\xc0\x71\xdd\x9d\x9a\xa2\xa0\xb4\xc5\x9e\x51\x0f\x28\xee\x93\x76\x98\x17\x5f\x84\x6f\xec\x3e\xf5\xb3\xf8\x08\x93\xb8\x3a\x9e\xae\x5c\x5b\xa5\x14\xdb\xa0\xb9\xde\x40\x46\x9e\x6c\xa9\x74\x3f\x71\x20\x22\x99\x93\xf3\xac\xab\xe8\x29\xdf\x4a\xd9\x2a\xb5\x1e\xd7\x38\x0d\x49\x74\x50\xd0\xbb\xa4\x17\xde\xb9\x04\xe8\x6c\xe2\xd9\x4b\xeb\xab\x8f\x94\x63\xba\xed\xe3\x32\x54\x68\xb1\x64\x1a\x5d\x02\x9d\xec\x60\x10\x30\x48\x4d\x3c\xc7\x74\xb5\x41\x9b\x49\xab\x19\xbd\x92\x8a\x7a\x3b\x18\x7f\x2f\xd2\xb3\xdf\xad\x69\xd0\xd4\xd4\x17\xb2\xe5\xae\xe3\xa3\xbc\x89\x9b\x39\x67\xce\x94\x93\x3f\x31\x70\x2c\x81\xdb\xa2\x38\xc1\x90\xe8\x4f\xdc\x12\x6d\xec\xc7\x73\x69\xc4\xbc\x8d\x69\x4c\xbf\xac\x77\x6c\x99\x1b\x53\x10\x0e\x19\xf0\x79\x9d\x38\xf4\x1d\x6d\x44\xdd\x6d\x8d\xcd\x94\xca\x9a\xb5\xca\x76\xb3\x16\x51\xd4\x53\xa4\xa1\x5b\x0d\x7e\x1e\x74\xde\xfb\xd4\x9d\x48\xbb\xe0\xa4\x33\x6f\x9e\x35\xd9\xb9\x71\x83\xe9\xd9\x01\xf5\xef\x82\x18\xe3\x26\x28\x4c\x9e\xc7\xdc\x2a\x58\x25\x6b\xac\xd1\xd1\x9d\xe2\xb0\x32\x72\xd1\x5c\x55\xc6\x7a\x50\x1e\x5e\x53\x92\x27\xf0\x01\xb5\x5a\x35\xd6\x5d\x99\xec\xcd\xef\x11\xec\x19\x8b\xf8\x47\x8d\x76\x55\x0f\xb0\x77\xdf\xba\xd9\x95\xfd\x77\x58\x7e\x60\xe2\xb1\x0d\xd4\x7c\xb4\xb5\xf6\xaa\xa4\x21\xac\xef\xfd\xab\x57\x6d\x5f\x8a\xa3\x76\x46\x0b\xac\x0b\x9f\xc0\xc3\xe3\xec\x1d\x97\x81\x65\xa5\x0a\x69\x64\x18\x40\xd0\xe8\xd5\x9a\x62\xd0\xe4\x3d\x43\xd9\xba\x4a\x28\x09\x5d\xb1\xda\xf1\xee\xa7\x0a\xd3\x15\xe6\x34\x61\x16\xfc\x57\x00\xf3\x34\x66\xff\x6b\x74\xb1\x6d\xd8\x1e\xba\x6f\x03\x20\xa5\x5b\x4f\xde\x8d\x39\xf3\xda\x50\x51\xf0\xbf\x3f\xdf\x7e\xfa\x28\x4e\xf7\x7f\x3e\x7d\xec\x76\x77\x5d\x02\xf7\x1e\xb0\x70\xa6\xc9\x8e\xfb\x63\x0b\x00\x7a\xe0\xaa\xda\xc3\x9f\x7f\x50\x7f\x95\x7a\x9a\x4a\x63\xb7\x63\x70\xc1\x5c\x3e\x9b\x8c\x99\xa8\x9b\x66\x4e\x64\x81\x28\xc4\x10\xf5\x92\x66\x70\x52\x16\x86\x4b\xbc\x8d\x8d\x12\xbd\xb1\x0c\x6f\x0c\x71\x8e\x14\xb4\x8a\x4f\x78\xdd\x56\x04\x03\x20\x63\x9d\x10\x76\xb4\x71\xa7\x15\x7a\xd6\xce\xa6\xaa\x02\xfd\xc2\xd8\xf2\xcd\x18\xca\xda\x79\x58\xe2\x7a\xd8\x75\x9a\x3a\x5f\x46\x82\x01\xd7\xa8\x0a\x96\xe9\x80\x4b\xff\x16\xa7\xbe\xc5\xa9\xc3\x27\x5a\x35\x17\xaf\x97\x46\xaa\xae\xe7\x8a\xdb\xe5\x28\xd7\xce\x62\x9f\x16\xe8\xfc\xe4\x57\xb4\x4f\x27\x08\x33\x16\x9e\xa2\x1b\x61\xe3\x11\xa7\xd5\xd9\xf9\x46\xfa\x9a\x29\x3a\x82\xb9\xf1\xcb\xcb\x60\xb2\x1f\x69\x81\x32\xc3\x62\xef\x8a\xb2\x31\xf8\x8d\x39\x1e\x1b\xb4\xed\xe4\x53\x40\x2d\x85\x19\x28\x43\xd9\x28\xbf\x0c\xf5\x7e\x73\x0c\x17\x22\x4b\x5c\xcb\xe4\xd8\x52\x4a\x5c\x40\x13\x98\x35\x0d\xb9\x41\x88\x09\xe8\x8e\xa2\x70\x2d\x82\xb7\x59\xc2\x6c\x9b\x08\x8b\xc3\x38\x45\xe9\x45\x51\x33\x44\x09\x0a\x27\x20\x32\x25\x45\xdd\x24\xe8\x52\x73\xd4\xd6\x92\xf6\x82\x60\xe3\x5b\x3a\x0e\x36\x9c\xd2\x57\xdf\xed\x9e\xd4\x58\x4b\xae\x32\xa1\xd3\x11\xd3\x8f\x85\xb2\xce\xef\x49\x3e\x14\x62\x73\x8a\xad\x15\x3a\x99\x9c\x98\x43\x44\xe4\xf2\x03\xcb\x59\x1b\xa0\x67\xe5\xa4\xeb\x2e\xa3\x46\x76\xc2\x25\xfa\x74\x79\x52\x3e\x87\xe0\x04\xc6\x40\xaa\x0e\x97\x64\x40\x7b\xda\xde\xa7\xe9\xc3\xb0\xff\xa0\xf4\xe9\x8c\x43\x6b\x3a\x13\x17\x56\x4c\x5f\xe2\xf2\xe8\xa2\x1d\x79\x07\x75\x15\xbb\x00\xde\xc4\x1b\x29\xb4\x37\x1b\x1d\x3a\x52\xa6\x69\x87\x0d\x84\x01\x1f\xf4\x2d\xde\x7c\x8b\x37\xbf\x81\x71\x83\x1f\x87\x86\x45\x32\xe3\x3f\x33\x2e\xc2\xd4\xd7\x58\xc4\xb5\x7b\x4b\x87\xb5\x13\xad\x57\x0b\x4c\xcf\xb6\xa4\x9a\x82\xad\x5d\xff\xf2\xce\xd9\x7e\x29\x18\xe1\xed\x0f\x6c\x4a\xf4\x64\x15\x16\x32\x6d\x6d\x8e\x84\xd7\x08\xbf\x62\x7f\xa4\x69\xdb\xb9\x5b\xb9\x14\xa5\x9b\x6b\x00\x80\xe1\xea\x59\x17\x59\xc9\x4a\xfb\x9a\xd7\xe7\x6c\x38\x5d\x52\xba\x72\x75\x79\x51\x7f\x0c\xdb\xe5\xf0\x7a\xfa\xb7\xdb\xef\xdf\xb4\xa1\xca\x68\x7f\x3c\xff\x6c\x9e\xb3\x2e\x55\x0d\x1a\xc9\x91\x3e\x34\x0d\xdf\x34\xf6\xfb\x7f\xb8\xfd\x49\x7c\x40\x29\x1e\xad\xdb\x01\x1f\x54\x73\x63\x03\xff\x34\x96\xd4\xb9\x6b\x10\x2e\x0a\x72\x1e\xf4\xc2\x21\x00\x40\x61\xd2\x93\x9e\x70\x8f\x9a\xcd\x92\x2c\x41\x68\x81\xc9\xc6\x5d\x0f\xbb\x69\x91\x3d\x7d\x36\xd9\x50\xba\x74\x7e\x44\x84\x36\xa7\xcb\xc6\x64\x32\xce\x6e\xda\xd7\x0d\x11\x0d\x32\xb1\x7e\xfd\x1a\x83\x11\xd5\x97\x68\xbc\x70\xf8\x31\x47\x47\xf7\x17\x0c\x12\xe4\xfa\x48\x98\x1f\x9c\xb3\xf6\x13\xb4\xa5\x9c\x52\x75\xee\xe4\x0d\x1e\xb7\x1b\xb4\xec\xb6\xb4\x77\x67\xaa\x8a\x74\xa7\x70\xa4\xb5\xb8\x0a\x4b\x05\x2b\x43\x1f\x6b\x7e\x97\x5b\xda\xcf\x9d\xef\x1a\x74\xe2\xa2\x79\x6c\x5b\xb0\xa7\x95\x09\x21\xb6\xc9\x75\x7f\x93\xdd\xb3\x3b\x20\x2b\x97\x59\xe5\x22\x44\x5f\x60\x39\xe7\x7e\x0a\x74\x7e\x66\x51\x3b\xd5\xdc\x50\xbc\x48\x61\x3f\xa2\xf3\xc0\x5a\xd9\x78\x9f\x48\x8a\x6f\x41\x31\x5b\xad\x29\x25\x33\xef\x09\x1e\x7b\x5c\x35\x80\x5a\x7c\xc0\x50\x68\x0c\x97\x90\x6f\x20\x43\x4f\x93\x97\x1b\x43\x20\xf7\x47\xb9\xf6\x71\x31\xa9\x33\xb9\xdc\xb3\x23\x57\x02\x7a\x43\xef\x06\x5d\x7b\x8d\xe4\x6b\xe3\x5e\x92\x73\xbd\xe6\xd5\x83\xf4\x2d\x2c\xeb\x12\xf5\x84\xcb\x21\x49\x5e\xe2\x66\x50\x3a\x13\xd7\xad\x73\xc8\xc8\xa3\x2a\x1c\xe0\xdc\xd4\xc3\x59\x98\xcc\xac\x5b\xa9\x0e\x11\x79\xc1\xd0\x1e\xdd\x85\x7e\x79\x26\xb7\xe9\x78\x79\x6b\x98\x2d\xc3\x5f\xb9\x28\x8b\xdf\x8f\x51\x5f\xf6\x33\x80\xd1\x54\x96\x76\x62\x6d\x40\x66\x1c\xca\xce\x05\xcc\x6c\x4d\x63\x78\x8f\x85\xa3\x31\xfc\x18\x12\xd4\x17\xe3\x75\xf1\xcd\x82\x59\xbc\x49\xd0\x2d\xdd\x5a\xdc\x5e\x78\xfc\xe9\x70\x31\x68\xc7\x83\x23\xf6\x97\x0e\xd2\x55\x4e\xae\x27\x74\xee\x7b\xf6\x90\x99\x86\x50\x12\x76\x34\x22\xfa\x8d\xc1\x64\x81\xaa\xa8\xed\xb9\xc0\x15\x57\x35\xba\xf9\x5a\x2d\x00\xf5\xf6\x38\x57\x39\xed\x72\x4f\x19\xc2\x41\xe0\x92\x0b\xd3\xe0\xe9\xd9\xc7\x36\xcc\xb6\xe9\xc0\x06\x20\x27\x2a\x84\x13\x02\x4e\xcd\x9a\x2e\xaa\x7c\xc3\x41\x61\x39\xa0\xf7\x54\x56\xbe\x0d\x96\xca\x35\xfc\x78\x61\xe5\x1a\x01\x5e\x58\x9c\xc5\xd5\xa0\xeb\x72\x7e\xa2\x2f\x14\x88\x17\x83\x18\x5c\x15\x41\x7d\xc2\xe7\x0b\xcf\x2e\xf1\x59\x95\x75\x19\xcf\x96\xc8\x1c\x40\x9c\x88\x68\x97\xe3\x71\x2a\x0e\x1d\x0a\x84\x03\x50\xd4\xf0\x86\x21\x61\x0e\x31\x74\xf3\x0c\x7e\x43\xfc\xb9\xc8\x89\x0f\x7b\x07\xb9\x8b\x1f\x90\x3a\xfd\xf5\xd3\xc0\xed\xa0\x33\x3d\x01\x3f\xc8\xa7\x23\xa5\x15\x3e\xb5\xf7\x91\x1b\xb3\x5d\xa2\x6b\x33\xbe\x5e\x38\x97\x31\xea\x24\x93\x86\x19\x34\x19\xb2\xd9\x49\x6b\x63\x3d\x9f\x7a\xb1\x38\xc1\xa8\x4b\x2e\xf2\xec\xb9\x4d\x29\xc1\x5e\xe6\x34\xcd\x5c\x1a\x32\xd9\x0f\x52\x14\x5f\x70\x21\xf4\xf1\x68\x03\x57\x5e\x7c\x72\x69\x9c\x5c\xad\x26\xed\x63\x8d\x1d\xda\xb0\x71\x43\x8f\x1c\x5a\x27\xb4\x9f\x53\x1f\x47\xbd\x46\xa8\x4a\xfb\xbf\xfc\x79\x80\xbc\x7e\x3b\x95\xff\xf9\x71\x86\x24\x59\xf3\x42\xfe\x35\x53\xaa\x4b\xae\x3a\xc5\xa5\x42\x76\xa8\x5d\x9a\x0b\x4e\x92\x81\xee\x66\x60\xbf\x05\x81\x58\x57\x7e\x0e\xc3\xf1\x9e\xdb\x23\xc7\xd1\x20\xec\x68\xe6\xe9\xf6\xab\xe0\xd3\x7b\xef\xff\x24\x3a\xf1\xbe\xff\x1f\x8b\xcd\xfa\x62\x34\xc2\x05\xbe\x0f\xbb\xf9\xe6\x57\xc0\xa7\xd7\xe6\x8f\x5e\x06\x73\xe9\xf4\x44\x9d\x37\x96\x3d\x42\xe7\x4d\x3d\x6f\x47\x36\x0d\x6d\x31\x13\x86\xff\xfb\xff\xd1\x3f\x03\x00\x00\xff\xff\x4b\x18\x16\xd1\xe6\x3a\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x3b\x6b\x8f\xdb\x38\x92\xdf\xfd\x2b\x0a\xe9\x0f\x9d\x06\x6c\xf5\xce\xde\x62\x71\xf0\x61\x3e\x78\x3b\x9d\x99\x46\xd2\x8f\x8b\x9d\xec\x04\x18\x60\x4c\x4b\x65\x99\xb1\x44\x6a\x49\xca\xdd\xbe\xc3\xfd\xf7\x43\xf1\x21\x4b\xb6\x64\xab\x3b\xc9\x0c\xb0\x18\xbb\x81\xc4\x22\x59\xac\x17\xeb\xc5\xd2\x19\x8c\xbe\xdd\x67\x70\x06\xef\x79\x8c\x42\x63\x02\x46\x82\x59\x21\x4c\x0a\x16\xaf\x10\xa6\x72\x69\x1e\x99\x42\x78\x2b\x4b\x91\x30\xc3\xa5\x80\xd7\x93\xe9\xdb\x0b\x28\x45\x82\x0a\xa4\x40\x90\x0a\x72\xa9\x70\x70\x06\xb1\x14\x46\xf1\x45\x69\xa4\x82\xcc\x01\x04\x96\x2a\xc4\x1c\x85\xd1\x11\xc0\x14\xd1\x42\xbf\xbb\x9f\xdd\x5c\x5d\xc3\x92\x67\x08\x09\xd7\x6e\x11\x26\xf0\xc8\xcd\x6a\x70\x06\x66\xc5\x35\x3c\x4a\xb5\x86\xa5\x54\xc0\x92\x84\xd3\xc6\x2c\x03\x2e\x96\x52\xe5\x0e\x0d\x85\x29\x53\x09\x17\x29\xc4\xb2\xd8\x2a\x9e\xae\x0c\xc8\x47\x81\x4a\xaf\x78\x11\x0d\xce\x60\x46\x64\x4c\xdf\x06\x4c\xb4\x03\x6b\xf7\x34\x12\x3e\xcb\xd2\xd3\x50\x23\xd7\x73\x61\x08\x9f\x50\x69\xda\xe4\xaf\xd1\x5f\x06\x67\xf0\x9a\xa6\xbc\xf2\x83\xaf\x2e\xfe\x0b\xb6\xb2\x84\x9c\x6d\x41\x48\x03\xa5\xc6\x1a\x64\x7c\x8a\xb1\x30\xc0\x05\xc4\x32\x2f\x32\xce\x44\x8c\x3b\xb2\xaa\x1d\x22\xb0\x08\x10\x0c\xb9\x30\x8c\x0b\x60\x96\x0c\x90\xcb\xfa\x34\x60\x66\x70\x36\x38\x03\xfb\x59\x19\x53\x8c\x2f\x2f\x1f\x1f\x1f\x23\x66\xa5\x13\x49\x95\x5e\x06\xea\x2e\xdf\xdf\x5c\x5d\xdf\x4d\xaf\x47\x16\xe5\xc1\x19\x7c\x14\x19\x6a\x0d\x0a\xff\x55\x72\x85\x09\x2c\xb6\xc0\x8a\x22\xe3\x31\x5b\x64\x08\x19\x7b\x24\xc1\x59\xe9\x58\xa1\x73\x01\x8f\x8a\x1b\x2e\xd2\x21\x68\x2f\xf5\xc1\x59\x43\x3a\x3b\x76\x05\xf4\xb8\x6e\x4c\x90\x02\x98\x80\x57\x93\x29\xdc\x4c\x5f\xc1\x3f\x26\xd3\x9b\xe9\x70\x70\x06\xff\xbc\x99\xfd\x7c\xff\x71\x06\xff\x9c\x7c\xf8\x30\xb9\x9b\xdd\x5c\x4f\xe1\xfe\x03\x5c\xdd\xdf\xbd\xb9\x99\xdd\xdc\xdf\x4d\xe1\xfe\x2d\x4c\xee\x3e\xc3\xbb\x9b\xbb\x37\x43\x40\x6e\x56\xa8\x00\x9f\x0a\x45\xf8\x4b\x05\x9c\x18\x89\x09\xc9\x34\x28\x50\x40\x80\xf4\x83\x7e\xeb\x02\x63\xbe\xe4\x31\x64\x4c\xa4\x25\x4b\x11\x52\xb9\x41\x25\x48\x3d\x0a\x54\x39\xd7\x24\x4e\x0d\x4c\x24\x83\x33\xc8\x78\xce\x8d\xd5\x22\x7d\x48\x14\x6d\x13\x0e\xc6\x37\xf8\x0c\x06\xac\xe0\x5e\x9d\xc6\xc0\x0a\x8e\x4f\x06\x85\xc5\x26\x5a\xff\xa7\x8e\xb8\xbc\xdc\xfc\x30\x58\x73\x91\x8c\xe1\xaa\xd4\x46\xe6\x1f\x50\xcb\x52\xc5\xf8\x06\x97\x5c\x58\xcd\x1f\xe4\x68\x58\xc2\x0c\x1b\x0f\x00\x98\x10\xd2\x23\x4f\x3f\xc1\x9d\x3a\x99\x65\xa8\x46\x29\x8a\x68\x5d\x2e\x70\x51\xf2\x2c\x41\x65\x81\x87\xad\x37\x7f\x89\xfe\x1e\xfd\x30\x00\x88\x15\xda\xe5\x33\x9e\xa3\x36\x2c\x2f\xc6\x20\xca\x2c\x1b\x00\x64\x6c\x81\x99\x87\xca\x8a\x62\x0c\x31\xcb\x31\x1b\xad\x07\x00\x82\xe5\x38\x06\x2e\x0c\xa6\xca\xae\x5e\x73\xa3\x23\x3b\x5e\xd3\xc6\x01\xc9\x81\xd6\xa7\x4a\x96\x61\x7d\x7d\xdc\x01\xf2\x5b\xc4\xcc\x60\x2a\x15\x0f\xbf\x47\xb0\xa6\xf9\xfe\xff\x71\xf5\x7f\xc7\x9c\x9b\xdd\xde\xef\xb8\xb1\x93\x32\xae\xcd\xbb\x96\xc1\xf7\x5c\xbb\x09\x45\x56\x2a\x96\x1d\xe0\x6d\xc7\xf4\x4a\x2a\x73\xb7\xc3\x66\x04\x9c\x08\x05\xd0\x5c\xa4\x65\xc6\xd4\xfe\xb2\x01\x80\x8e\x65\x81\x63\xb0\xab\x0a\x16\x63\x32\x00\xf0\x0c\xb6\x34\x8c\x6a\xc6\xea\x41\xd1\x72\x75\x25\xb3\x32\x0f\xa2\x1a\x41\x82\x3a\x56\xbc\x20\x44\xc7\xd6\x42\xd5\xf6\x80\x35\x37\x50\xac\x98\x46\x8b\x07\xc0\x17\x2d\xc5\x03\x33\xab\x31\x44\xda\x30\x53\xea\xa8\x3e\x4a\x9c\x1c\xc3\x43\xed\x89\xd9\x12\x76\x64\x4e\x45\xda\x77\x3f\x5a\x73\xb8\x5d\x50\xb8\xc8\xa9\x84\x13\xf4\xaf\x5e\x92\xbf\x92\xe1\xf9\xf5\x72\xcd\xcd\xaf\x51\x6d\xb9\xc3\x67\xb6\x2d\xbe\x06\x1d\x9e\xb3\xb4\x05\x1f\x4f\x7e\x7d\xd4\x6d\x77\x53\x7b\x72\xb0\x9f\x9b\xb2\x21\xa5\x27\xd9\xad\x30\xb7\x27\x88\x7e\xc9\x02\xc5\xe4\xe1\xe6\xd3\x7f\x4c\x1b\x8f\xa1\x89\x61\x53\xad\x20\xa1\x13\x89\xda\xda\x6a\x41\x56\x1b\xc9\x38\x91\xb5\x61\x22\xa9\xfb\xa9\x58\x8a\x25\x4f\x4b\xc7\xe6\x0a\x34\x80\x40\x4c\x9c\x8f\x55\xa5\xb5\x95\xf3\xda\x0e\xf3\x08\x26\xcd\x27\xef\xb8\x99\x03\xa7\xfd\x52\x14\xa8\x78\xec\x77\xb3\xbf\x58\x96\x6d\x6b\xa0\xe9\xc8\x1b\x58\x2a\x99\x5b\x63\xe6\xcd\xbe\x75\xbc\xe4\x54\xf6\xf7\x1a\xc2\xa2\x34\xc0\x52\x21\xb5\xe1\x31\x61\xc4\xcd\x10\x78\x1d\x59\xa9\xac\xb9\x97\xb0\x40\x50\x58\x6a\xef\x43\xc4\x16\xa4\xb5\xd0\x0d\x78\xf0\xb8\xe2\xf1\x0a\x56\x8c\xdc\x2c\x82\x66\x79\x85\x43\x52\x83\xa9\xd1\x10\x36\x31\x2b\xd8\x82\x67\xdc\x70\xd4\xed\x54\x93\x67\x5c\x20\x39\xd7\xc4\x06\x01\x6e\x4b\x32\x3a\xc0\x34\xb0\x1a\xc8\x05\xd3\x58\x93\x47\xc6\xb6\xa8\x86\xf0\xb8\x42\x61\x31\x99\x73\x11\x2b\x1b\x80\xb0\x6c\x6e\xb9\x94\x80\xb4\xe7\x81\x38\x8b\x82\xbc\x61\x12\x55\xf0\x0a\x25\x0b\x54\xa6\xb2\x49\xee\x5b\x33\xe1\xb5\xa7\x7b\xca\x72\x4e\xfa\xe4\xe3\x86\xa0\x29\x84\x81\x37\x10\x98\x78\x15\x24\x06\xd8\x80\x41\x21\xb9\x38\xc2\x6c\x4f\x4d\xe8\xcf\xc9\x4c\x2e\xbe\x60\x6c\x22\x98\xa2\x22\x30\xa0\x57\xb2\xcc\x12\x22\x77\x83\xca\x80\xc2\x58\xa6\x82\xff\x4f\x05\x5b\x87\xf8\x2d\x63\x06\xbd\x11\xdc\x7d\xe9\xb0\x29\xd2\xcf\x0d\xcb\x4a\x1c\x92\x37\xb4\x61\x8c\x42\xda\x05\x4a\x51\x83\x67\xa7\xe8\x08\x6e\xa5\xa2\x53\xba\x94\x63\x1b\x80\xe8\xf1\xe5\x65\xca\x4d\x70\x5d\xb1\xcc\xf3\x52\x70\xb3\xbd\xac\xc5\x7e\xfa\x32\xc1\x0d\x66\x97\x9a\xa7\x23\xa6\xe2\x15\x37\x18\x9b\x52\xe1\x25\x2b\xf8\xc8\xa2\x2e\x88\x60\x1d\xe5\xc9\x99\xf2\xce\x4e\x9f\x37\x70\x3d\x38\xca\xee\xcf\x7a\x82\x23\x12\x20\x67\x40\x62\x65\x7e\xa9\xa3\x62\xc7\x68\x7a\x44\xdc\xf9\x70\x3d\x9d\x41\xd8\xda\x46\x6f\x0d\xa0\xe0\xf9\xbe\x5b\xa8\x77\x22\x20\x86\x71\xb1\xa4\xa3\x41\x42\xac\x4e\x1c\x8a\xa4\x90\x5c\x18\xfb\x23\xce\x38\x8a\x7d\xf6\xeb\x72\x91\x93\x02\xd3\xb9\x40\x6d\x48\x56\x11\x5c\x59\x7f\x4e\x67\xac\x2c\x12\x66\x30\x89\xe0\x46\xc0\x15\xd9\xdb\x2b\xa6\xf1\xbb\x0b\x80\x38\xad\x47\xc4\xd8\x7e\x22\xa8\x87\x22\xbb\x0f\x41\x19\x7b\xae\xd5\x06\x42\x38\xd0\x21\x2f\xe2\x54\x82\x9a\x6c\x44\xa7\xc9\xec\x3a\x92\xf4\xad\x9b\x91\xfd\xb1\x96\xad\xea\xd3\x87\x80\x51\x1a\xc1\xbc\xc8\x98\xa1\x9c\x62\x44\xca\x3d\x27\xbb\x21\x29\xec\x56\x86\x2f\x59\x4c\xc6\x46\x21\x21\xb0\xe1\x49\xc3\x8e\x85\xef\x62\xeb\xb4\x60\xed\x23\x92\xfa\x97\x1b\xcc\x5b\xd0\xea\xe4\x6c\x7d\x90\x29\xc5\xea\xd6\xdd\xc7\x79\x3b\x0e\x9d\x20\xf7\xbc\x31\x19\x82\xf1\x26\x86\x93\x97\x9d\xdd\xbf\xb9\x1f\xc3\x23\x06\x7b\x92\x90\x9e\x53\x38\x76\x00\x95\x8c\x06\x2c\x4b\x3a\xbe\xa0\x30\x43\x46\x89\x14\x3d\x62\x1b\x59\x2a\x32\x65\xb9\x2c\x85\x19\x5a\x87\xca\x0a\x0e\x52\xb9\xa8\x0f\x8c\x62\xdc\xe8\xf3\xfe\x6c\x69\x10\x70\x55\xc7\x7f\x5a\x60\x5c\x3b\x8b\x35\x7f\xd8\x20\xb3\x05\x26\x54\x99\x41\xd7\x8c\x6e\xed\x72\xdf\x60\x25\xde\xe1\xb6\x7d\xc2\x3e\xe7\xdf\x04\x5e\x26\x63\x10\x12\x32\x29\x52\x54\x56\x02\x87\xbc\xe8\xa1\x0f\x75\x1c\x6e\x89\xd5\x0f\x64\x64\xfe\x70\x54\x28\xcc\xfb\xc3\x90\x30\xbd\x37\xaf\x29\x0d\xe9\x3e\x2d\x24\x9d\x6d\xa8\xcd\x10\x38\x8e\x83\x1e\x6c\x87\x1d\x70\xc3\xf9\xcb\x59\x31\x04\x8d\xb1\x42\x33\x84\x28\x8a\x5e\x4c\x84\x75\x4d\xbd\xa8\x20\xcc\xed\x6c\x72\xee\x4c\x6b\x9e\x8a\xe0\xe6\x1b\x84\xc0\x6b\xbd\x15\x86\x3d\x75\xc0\x04\xeb\xeb\x37\x4c\x6d\x21\xc1\x02\x85\xad\x9d\x48\x1f\x25\x91\x3c\xe7\x17\x2f\xa3\x25\xc4\x79\x6d\xc4\x8c\xa0\x96\x21\xd4\x3f\x23\xe7\x9b\x5b\x46\x3a\x7c\xc9\x29\xf3\xe8\x68\x42\x11\x9f\x76\x06\xcc\x66\x8e\xa4\x08\xd6\xcf\x86\xa5\xb4\xb2\x66\x2a\x7f\x27\xb3\x6e\x43\xfa\x13\x08\x9b\x55\x3d\xc4\xf5\x29\x87\x06\x9e\x90\x23\x5f\x72\x4c\xc8\x4a\x37\x27\x29\x4c\xa9\x32\xb3\xed\xc0\xa4\x15\xcd\x42\x49\x2a\x8f\xf5\x40\xc6\xcf\xf4\x51\x3f\x05\xd2\x4f\x05\xc6\xe6\x04\xeb\x8e\x6c\xad\xb0\x90\x9a\x9b\x5a\x35\xa0\x73\xff\x5b\xb6\x41\xd1\x58\x00\x66\xc5\x0c\xc4\x4c\x54\x29\xc3\xce\xd7\x7d\x77\xf9\x39\x3f\x77\x02\x67\x37\xc9\xe1\x19\x9c\xf0\x23\xcf\x32\xc0\x27\x8c\xcb\x16\xbf\x7b\xdc\x2d\xb1\x24\xa9\xca\x3f\xfb\xdf\x7a\x05\xe2\xb8\x6b\xdb\xc3\x71\x42\x40\x67\x84\x68\xdd\xd7\x1e\x5a\x19\x97\x99\x58\x14\x3a\xc0\x7a\xa6\x74\x8c\x1e\x3d\xdf\xee\xef\x69\x44\x55\x2c\x25\xd0\xa0\x1e\x59\x0b\xae\x36\x38\x2a\xc5\x5a\xc8\x47\x31\x5a\x72\xcc\x12\x3d\x06\xa3\x5a\xed\xc7\x1e\x59\x54\x65\x88\xa9\x3c\x16\x57\xd8\x13\xea\x0e\xc5\x26\x69\x7a\xf0\x02\x7c\x7d\xad\x6d\xdc\x0f\x13\x3f\xdb\xef\xce\x75\x95\x93\x65\x5b\x67\x7a\x8c\x84\x04\x0d\x95\x2c\x45\x3b\x71\x60\x35\x7b\x81\xda\x90\x45\xa6\x12\xda\x96\xbc\x81\x05\x6c\x33\xba\x40\x12\xee\x95\x2f\x74\x34\x68\x03\x77\x5c\xd3\x7a\x04\x9e\xad\xb4\x9e\xbf\xc7\x94\xc5\xdb\x36\x2e\x43\xc1\x14\xcb\x89\x46\x1d\x41\x2d\x3a\xe8\x04\x0c\xb6\x02\xb0\x60\xf1\xfa\x91\x29\x4a\x7e\xf3\x82\x19\x6e\x23\xf9\x6d\xa7\xff\xed\xa5\x67\x5f\xad\x69\x10\x2a\x08\x3d\xd9\x72\x55\xb3\x51\x46\xfa\xc5\x14\x33\x27\x5c\x53\x25\x02\x98\xe3\x58\x04\x13\x5b\x91\xed\xfa\x7a\x7b\xa2\x57\x74\x37\x63\xed\x2d\x25\x82\x52\x54\x61\x4c\x74\x82\x2d\x0b\x29\x33\x64\x6d\x01\x71\x3f\x9d\xd8\xa3\x6b\x52\x79\xd4\xdd\x52\x5f\x3a\x0a\xd9\x53\x08\x59\xac\xa2\x76\x42\x05\x30\x4c\xaf\x3b\x87\x3b\x8d\x77\x2f\x23\x7e\xda\x98\x87\xcf\x06\xd5\x42\x6a\xec\x49\xfd\xb5\x13\xa3\x5f\x04\x99\x4c\x53\x1f\x5b\x59\x62\xad\xc6\x4a\xe1\xcd\x29\xeb\x56\x46\x2a\x11\x14\x85\x54\x06\xb8\x81\xd7\x36\x41\x7d\xc7\x04\x5f\x87\xd3\x5d\xc8\xe4\xe2\x6b\x04\x7b\xe2\x44\xa0\xd8\x70\x25\x05\xd5\xca\xc6\x83\x93\x44\x93\x5d\xad\xad\x70\x7a\x3b\x84\x95\xcc\x92\x50\x66\xa9\x0d\xb7\xc2\x03\xd8\x30\xc5\x89\x77\xda\x56\x06\xc3\x6d\x8e\xaf\xd2\x2d\x83\xab\x8c\x06\x2f\x53\x52\x0b\xe7\x13\x53\xba\xa7\x1c\x77\x5a\x5c\x27\x6c\x87\xa3\x53\x68\x8f\x69\x27\x48\x38\xa4\x61\xaf\xca\x1d\x59\x57\xe0\x82\x75\x2a\x57\xbd\xbb\xfe\xfc\xe3\xa7\xc9\xfb\x8f\xd7\xdd\xc9\x07\xd8\x7a\xc5\x10\xe6\xb7\x93\x4f\xd7\x77\xbf\xdd\x3f\xcc\xa6\x3f\xbe\x1a\xfd\x92\x3f\xfd\x35\x7d\x35\x8f\xe8\xb2\x8f\xaf\xc9\x12\xa0\x2f\xce\x56\x48\x1f\x03\x69\xc3\x11\x29\xb2\x6d\x65\xa0\xf1\x00\xdd\x2a\xa2\x1c\xd2\x90\xa6\xc1\x65\x56\xa2\x88\xf1\x04\x03\xd6\xa4\x0d\x9a\xce\x3c\x33\xc0\x28\x14\xb3\x5a\x4c\x71\x8f\x59\xed\x63\x49\x5c\x10\xf2\xd8\xc9\x70\x15\xe8\xe8\x0f\xb7\x0c\x0d\x57\xf6\xa7\x33\x6c\x3a\xc3\x4a\x59\x6e\x71\xbf\x42\xd8\xc9\x1c\x67\x3c\x29\x10\xfa\xb2\x0b\xd1\xe6\x77\x93\xdb\xeb\xe9\xc3\xe4\xea\x7a\x6e\x63\x9a\xf9\xc3\xfd\x9b\xdf\xe8\xd9\xbc\x13\x28\x74\x1c\xda\xd7\x09\x2e\x59\x99\x19\x98\x93\x17\x9f\x5f\x9c\x60\xd1\x71\xc7\xf8\x6f\xe9\xed\xa9\xf4\xf9\xa0\xe4\xd3\xb6\x27\x59\x94\x57\xb0\x94\x2e\x18\xc8\x24\xc0\xfc\xe7\xd9\xec\xe1\xb7\x87\x0f\xf7\xbf\x7c\x9e\x0f\xdd\xaf\xa9\xff\x49\xb2\xeb\x84\x09\x30\xbf\xbb\x0f\x13\x7f\x07\xd9\x6d\xbe\xb5\x23\x60\x89\xbb\xcc\xeb\x84\x09\xdd\xd6\xb4\xdb\x05\x38\x43\x7f\x04\xe6\xfc\xf6\xf3\x6f\x9f\x26\x1f\x7e\x7c\x95\x6f\x5d\x0d\x85\x1c\xc0\xcc\xda\x66\xc3\xd6\x54\xae\xc6\x18\x13\xb2\xd0\x40\x3d\x10\x3e\x5f\xc7\x0d\x97\xa5\x6e\x5c\x19\xee\x7f\xdd\xa5\x55\xd2\x4e\xf3\x1f\x6d\x79\x4f\x18\xb0\x7f\x95\x4c\xad\xcb\x0e\x34\x1a\xb2\x3d\x27\xc6\xff\xb7\x9b\xbe\x67\x91\xbd\x42\x87\x41\x55\x0a\xc3\x73\xec\xa2\xfc\xc6\x9c\x9f\x57\x37\x88\x54\xf7\xf0\xca\x1a\xc1\xdd\xfd\xec\x7a\x0c\x57\x32\x2f\x78\x66\x63\x21\x09\x0c\x04\x33\x7c\x83\x3e\xe1\xa7\x35\x5d\x32\xe6\x11\x46\xee\xfe\xb2\xa4\x56\x04\x98\x17\x2c\x5e\xb3\x14\x47\xc4\x82\x1f\x1d\x98\xf9\x90\x94\xc6\xba\x6e\x1f\x32\x62\x72\x24\x28\xb1\x7d\x15\x68\xf4\x90\x2e\x4f\x1f\x31\xcb\xe8\xdf\xcf\x93\xdb\xf7\x74\x40\xe1\x97\xdb\xf7\x75\x25\xd5\x11\xdc\x18\x60\x99\x96\xa1\xb2\xd7\x9e\x17\x03\x30\x03\x74\x23\x60\xe0\x6f\x3f\xf1\x7f\xd0\x69\xc9\x31\x97\x6a\xeb\xdd\x3e\xc2\x83\x4c\x40\x95\x42\x84\x78\xd0\xb3\x80\xc2\x80\xa4\x8b\x7a\x1b\x2e\x50\x41\xc9\xb5\x01\x91\xf2\x52\x98\xc7\x8c\x54\x04\x6f\x08\x8d\x18\x91\x76\x78\x5d\x55\x33\x3b\x40\xfa\x1a\xa7\x5b\x51\xe5\xcc\x95\xd0\x93\x2a\xee\x0c\x97\x41\x17\x43\xc8\x4b\x6d\x60\xc5\x36\x5d\x9e\x0e\x85\x2c\xd3\x95\x27\x18\xd8\x86\xf1\x8c\x64\x1a\x9d\xbf\x30\x54\xfd\x33\xac\x38\x12\x56\xfc\x5b\x7a\x5d\x7f\xaa\xa9\xf0\xae\x7b\x52\x56\xb7\x5c\x7e\xb9\xdd\x4a\x57\x5d\x73\xf3\x25\xd3\x66\xf4\x85\xa9\x63\x71\x92\x54\x30\xf7\x66\xa4\xe6\x61\xab\x95\x17\xf6\x06\x3a\xa6\xb6\x8a\x85\x34\xab\x7e\x30\xc9\x8e\x54\x40\xa9\x28\xe1\xef\xdd\x30\x19\x82\x79\x94\x87\x0d\x1e\xe1\x22\xa9\xcb\xb6\xd0\x97\xe0\xd8\x6e\x35\x82\x62\xd3\x06\x3a\xa5\xd5\x36\x94\x52\xac\xd8\x86\xac\xcb\x9e\xcf\x3b\x02\xd3\x42\xa8\x28\x72\x0d\xac\xe4\x2a\x15\xb2\x64\x6b\xfd\xa8\x6f\x7c\xa9\xf2\x1c\xeb\x14\x8e\x40\x24\x4a\xb2\x32\x44\xae\x04\x3f\x2e\x95\xa2\x80\x61\xcd\x77\x39\x6d\xcd\xc0\xba\x5d\xda\x6a\xd3\xbb\x4f\x2c\x95\x42\x5d\x48\x6b\xd7\x42\xe9\x64\xc9\x95\x36\x0d\xc9\xbb\x22\xf2\x02\xfd\xb5\x10\x26\x47\x40\x1a\xb9\x8f\x08\xdd\x1a\x58\x39\x0b\x09\xf8\xc4\x35\x75\x7f\xda\x44\xcd\x1a\xe1\x9c\x99\x78\x75\x54\x3e\xfb\xe0\x2c\x8c\xaf\x89\x14\x1a\xda\xde\xa6\xe9\xdd\xb0\x7f\x9f\x30\x23\xdc\xaa\x8c\x07\x27\xd1\x27\x19\x7f\xf0\xd3\xbd\x89\xd6\x68\x34\x94\x85\xbf\xc1\x30\xd2\xf7\x0e\x63\xa3\x8b\xad\x6b\x4b\x72\xd9\x6c\xff\xf2\x63\x1b\xfd\xe9\x6f\xfe\xf4\x37\x3d\xfc\xcd\x51\xc6\x75\x0e\x76\x0c\xb8\x6e\xcc\xf1\xa0\x93\x25\x64\x18\x58\x6c\x4a\x96\xf9\xb9\x83\x7e\xda\x59\xf5\xdd\x8c\x07\x47\x59\x1e\xb2\xb3\x5d\x9f\xce\x8b\x6f\xfd\x1a\x70\x27\x1e\x5e\xb3\xd9\x24\x67\x06\x15\x67\x99\xed\x8b\x0b\x5b\xc2\x6b\x06\x5f\x58\x7b\xc0\x5d\x5d\x45\x6f\x29\x5a\xe5\x22\x34\x6c\x02\x73\x2f\x09\xd4\x91\xb5\x51\x69\x5b\x62\xdb\xcd\x25\x7f\x82\x57\x18\xaf\x75\x99\xb7\x8f\xee\x11\xc6\xaa\xe9\xf0\x7a\xfa\xf3\xe4\x87\x8b\xca\x55\x49\x61\xba\xcb\xb3\x27\x4d\x2a\x4f\x7a\x6d\x4f\x3b\x85\xcb\xea\xd8\xf7\x2a\xfc\x34\xf9\x64\x6d\x40\x6e\x2d\x5a\xc5\x32\x7e\xc4\xe3\x48\xe5\xf8\x27\xa8\xbb\x74\xd7\x15\x6a\x9f\x11\xaa\xfa\xe2\xa5\x74\x64\x32\x3e\x6a\x09\x1b\xd4\x3c\xae\x90\x5a\xf2\x0c\xa5\x60\x76\xe1\xee\xfe\x3d\x5c\xef\xcd\x1f\x64\x32\x7f\x29\x32\x86\xa9\x14\xfb\xb5\xf8\xd0\x9e\xd5\xd5\x7b\x20\x22\x20\xe3\xf3\xd7\x97\xa1\x71\xbc\xa9\x83\xb7\x05\x1a\x9d\x66\xe4\xb8\xe3\xa5\x06\xde\x9b\x1e\x4d\x10\x34\xcf\xf7\x3e\x9c\x3a\xed\x47\x68\xb3\x12\x7a\xd3\xe9\xf8\x0e\xb8\x9b\x04\x0f\x27\x97\x87\x75\xfd\x97\xec\x3d\xf5\x09\x68\x8f\xbd\x77\xf7\xbb\xa1\x65\x80\xe9\x5a\x11\xfd\x00\x00\x8a\x36\x73\x30\x02\x25\x4b\xd3\x76\x9d\x3c\x82\x42\x26\xcf\x21\x20\x66\x86\x65\x32\x6d\xed\x85\x6e\xa5\xc0\xf5\xd7\xf8\x0e\xe8\xc0\x35\xaf\x97\x01\x5a\xd5\x2f\x72\x8a\xbc\x63\x88\x51\xa0\x5c\x7b\x27\xa6\x13\xa9\x5d\xeb\xcf\x6e\x49\xd5\xbb\x5e\x14\x28\x7c\xbf\x39\xe1\x89\x1b\x7b\x65\xa7\x90\x9a\xa9\x5b\x15\xfe\xab\x9c\x4d\x33\x23\xba\x0a\xe8\xf8\x49\x0b\x5f\x8c\x22\xff\x89\x84\x2f\xab\x52\xa6\x16\xc0\xb6\x16\xc3\x20\x46\x45\x6d\xf0\x60\x1b\x91\xa3\x17\x38\x95\x8c\x69\x33\x53\x4c\x68\xcb\x19\x7a\x43\xa8\x7d\xde\x1e\x29\xef\xa9\x08\x44\xb5\xb2\xe0\x53\x3c\x29\xa6\x02\x45\x6c\xa5\x4e\x69\xca\xb7\x5a\x42\x82\xfa\x97\x9a\xdc\x84\xb5\xec\x5d\x01\x0f\xd5\x68\x98\x19\x03\xf5\x4b\x8f\x5e\x6e\xe2\x1c\xb9\x1f\x6d\xdb\x75\x6f\x52\x29\xac\xcf\x6a\xe4\x72\x5d\xa3\x97\x14\x38\xb4\x71\x7f\x6f\xdc\x73\xd4\x9a\xa5\xfd\x90\x9e\xc0\xaa\xcc\x99\x18\x51\x92\x6b\x43\x52\xbf\x18\xb8\x48\x38\x39\x0d\x91\x52\xd7\x09\xe3\x99\x06\xb6\x90\xe5\xa1\x4a\x87\x0f\xc9\x77\x27\xd5\xe8\xa5\xc8\x2b\x64\x5a\x8a\x5e\xb8\x13\xc3\xdd\xf4\xea\x60\x56\x0c\x3f\xd7\x5e\x16\x5f\x8f\x51\x5b\x4c\xdb\x81\xd1\xd4\x4e\x0d\xd6\xac\x42\x66\xe8\x8a\x09\x4b\x98\x29\x7a\xb9\xe2\x2d\xcb\x34\x0e\xe1\xa3\x4b\x3b\xa2\xef\xde\xeb\x3a\xf3\xbd\xad\xf5\x84\xbc\xc2\x2d\xfa\x1e\x41\x40\xe7\x39\xee\x6c\xfa\x7c\x61\x84\xb0\x8b\xa7\x67\x0a\xf1\x0d\x4f\x51\xb7\x84\x47\x0d\x6e\x90\x64\x12\x3b\x31\xc8\x69\x59\x66\xd9\xd6\xda\xd0\x6c\x83\x49\x3d\x46\x37\x0a\x31\xcc\xb2\xb7\xd6\x54\x81\x6f\xab\x4f\xcb\x25\xd0\xfb\x1b\x21\x05\xd0\x83\x67\xb0\x33\xe9\x83\xb5\xcf\x99\x5c\x90\xd3\x44\xff\x99\xa1\xc6\x92\xf1\xac\x54\x78\x62\x3f\x3f\x2b\x9c\xaf\xd7\x9c\x5a\xfb\xb6\x17\x83\xe7\xb9\x8d\x63\x87\xb9\x49\x1e\xbd\x6f\xa0\x0c\x18\x7c\x32\xbe\x40\xb8\x0d\x77\x03\x0e\x48\x2b\x8c\x23\x64\xd2\x1f\xbd\xb4\xb4\xc1\x5e\x35\x19\xb7\x91\x9b\x0e\xcc\x18\xcc\x0b\x53\x39\x7c\x7a\x99\xc7\xf1\xa3\x15\xd0\x29\xd7\x09\x01\x60\xd7\xf0\x3e\x33\xdc\xf6\x20\xca\x7c\x71\xa4\x62\xe9\x88\xb7\x87\x1a\xd5\xf1\x8d\x6f\xd9\x53\xcf\xbd\x73\xf6\xc4\xf3\x32\xf7\x7b\x93\x8a\x79\x10\xfa\x5b\xe0\x71\xcc\x97\xee\x21\x42\x0e\x30\x68\xb8\x5f\xed\x6f\xc8\xba\xde\xe7\x78\x8e\x0f\x3d\xa9\x3a\xc7\x2d\x1c\x05\xc8\x1e\xa9\xe3\xa3\xb7\x1d\x3d\xf7\x47\xcd\x1d\x80\xe9\xe4\x53\x93\x47\xe4\x72\x89\x4f\xd5\x3b\x8d\xe1\xd8\xd2\xdb\x96\x21\x6a\x1d\xbc\x9c\x51\x47\x99\xd4\xcd\xa0\x51\xd7\x99\x1d\x55\x67\xac\x65\xa8\x15\x8b\x23\x8c\xea\xd3\x1e\xdf\x30\x9b\x82\xed\x54\xea\x99\x46\xd3\xee\x35\x35\x0a\x59\x7e\x62\xc7\xaa\x68\xeb\x66\x0f\x41\xe1\x12\x15\xdd\x3f\x24\x54\xa7\x9d\x8b\xf0\x86\xf8\x25\xfd\x6f\x3e\xec\xb8\x42\xa8\xde\x78\xa6\x52\x42\x51\x2e\x32\xae\x57\xb6\xa5\x60\x08\xce\x14\x3f\x07\x7b\xb9\xb0\x17\x6b\xc9\x4f\xb6\xd8\xd4\x23\xbd\xbd\x3f\x58\x40\x68\x10\x69\xb9\xd4\xf6\xe5\x52\xba\xc4\x48\x77\xa3\x61\x87\x03\xb0\xb0\x33\xa1\xcd\xac\x26\x1a\x74\xa9\x24\x17\xe6\xef\x7f\x1b\x3c\xc7\xca\xd8\x77\xdf\x4f\x90\x64\xe7\xbc\x50\xfa\xe1\xf6\xf7\xc4\x16\xf5\x8b\x62\x4b\xb6\xcb\x1e\x1b\x49\x6c\xa8\x8d\x63\xf2\x1c\x04\x7c\x0f\xc3\x83\x07\xde\x3f\xcb\xae\x6e\x4a\x02\x5a\x21\xe1\xfe\x06\x99\xb5\x4f\xd6\x1f\x5c\x17\xaf\xea\x81\x4d\x48\xef\x7d\xe3\xaf\xfa\xb6\x4c\xf2\xd0\xfb\x33\x27\xa0\x13\x78\xf2\x4d\xb1\xd9\xf4\x46\xc3\x55\x42\xde\xed\x9a\x19\xbe\x03\x3e\xad\x66\xf4\xe0\xa1\x3b\xc3\xb5\x0b\x10\x6d\xa4\x22\x23\x5b\x7b\x52\x2e\xaa\xfb\xd9\x40\x9b\x36\xcc\x94\x7a\x0c\xff\xfb\x7f\x83\xff\x1f\x00\xa8\x28\x5e\x4e\x7d\x48\x00\x00"),
 		},
 		"/crd/bases/camel.apache.org_integrationplatforms.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "camel.apache.org_integrationplatforms.yaml",
 			modTime:          time.Time{},
-			uncompressedSize: 174369,
+			uncompressedSize: 212479,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\xbd\x7d\x73\xdb\x36\xb6\x30\xfe\x7f\x3e\x05\xc6\xfd\x23\x4e\x46\x92\x9b\xdd\xdb\xdd\x5e\xdf\xe9\x3c\x8f\xd7\x49\x5b\x37\x71\xec\x6b\x3b\xb9\x77\xa7\xed\x54\x10\x79\x24\x21\x22\x01\x2e\x00\xca\x51\x7f\xfb\xfb\xee\xcf\xe0\x00\x20\x29\x89\x04\x29\xc9\x6f\x6d\xc4\xce\xec\xc6\x36\x01\x1e\x1c\x1c\x9c\x37\x9c\x97\xaf\x48\xff\xee\x9e\x67\x5f\x91\x77\x2c\x02\xae\x20\x26\x5a\x10\x3d\x05\x72\x92\xd1\x68\x0a\xe4\x5a\x8c\xf5\x2d\x95\x40\xbe\x17\x39\x8f\xa9\x66\x82\x93\xc3\x93\xeb\xef\x5f\x90\x9c\xc7\x20\x89\xe0\x40\x84\x24\xa9\x90\xf0\xec\x2b\x12\x09\xae\x25\x1b\xe5\x5a\x48\x92\xd8\x09\x09\x9d\x48\x80\x14\xb8\x56\x03\x42\xae\x01\x70\xf6\xf7\x17\x37\x67\xa7\x6f\xc8\x98\x25\x40\x62\xa6\xec\x20\x88\xc9\x2d\xd3\xd3\x67\x5f\x11\x3d\x65\x8a\xdc\x0a\x39\x23\x63\x21\x09\x8d\x63\x66\x3e\x4c\x13\xc2\xf8\x58\xc8\xd4\x82\x21\x61\x42\x65\xcc\xf8\x84\x44\x22\x5b\x48\x36\x99\x6a\x22\x6e\x39\x48\x35\x65\xd9\xe0\xd9\x57\xe4\xc6\x2c\xe3\xfa\x7b\x0f\x89\xb2\xd3\xe2\x37\xb5\x20\xff\x14\xb9\x5b\x43\x65\xb9\x0e\x0b\x3d\xf2\x11\xa4\x32\x1f\xf9\xcb\xe0\xeb\x67\x5f\x91\x43\xf3\xca\x81\xfb\xe3\xc1\x8b\xff\x22\x0b\x91\x93\x94\x2e\x08\x17\x9a\xe4\x0a\x2a\x33\xc3\xe7\x08\x32\x4d\x18\x27\x91\x48\xb3\x84\x51\x1e\x41\xb9\xac\xe2\x0b\x03\x82\x00\x98\x39\xc4\x48\x53\xc6\x09\xc5\x65\x10\x31\xae\xbe\x46\xa8\x7e\xf6\xd5\xb3\xaf\x08\x3e\x53\xad\xb3\xe3\xa3\xa3\xdb\xdb\xdb\x01\x45\x70\x07\x42\x4e\x8e\xfc\xea\x8e\xde\x9d\x9d\xbe\x79\x7f\xfd\xa6\x8f\x20\x3f\xfb\x8a\x7c\xe0\x09\x28\x45\x24\xfc\x2b\x67\x12\x62\x32\x5a\x10\x9a\x65\x09\x8b\xe8\x28\x01\x92\xd0\x5b\xb3\x71\xb8\x3b\xb8\xe9\x8c\x93\x5b\xc9\x34\xe3\x93\x1e\x51\x6e\xd7\x9f\x7d\xb5\xb4\x3b\x25\xba\x3c\x78\x4c\x2d\xbd\x20\x38\xa1\x9c\x1c\x9c\x5c\x93\xb3\xeb\x03\xf2\x8f\x93\xeb\xb3\xeb\xde\xb3\xaf\xc8\xff\x9c\xdd\xfc\x78\xf1\xe1\x86\xfc\xcf\xc9\xd5\xd5\xc9\xfb\x9b\xb3\x37\xd7\xe4\xe2\x8a\x9c\x5e\xbc\x7f\x7d\x76\x73\x76\xf1\xfe\x9a\x5c\x7c\x4f\x4e\xde\xff\x93\xbc\x3d\x7b\xff\xba\x47\x80\xe9\x29\x48\x02\x9f\x33\x69\xe0\x17\x92\x30\x83\x48\x88\xcd\x9e\x7a\x02\xf2\x00\x18\xfa\x30\x3f\xab\x0c\x22\x36\x66\x11\x49\x28\x9f\xe4\x74\x02\x64\x22\xe6\x20\xb9\x21\x8f\x0c\x64\xca\x94\xd9\x4e\x45\x28\x8f\x9f\x7d\x45\x12\x96\x32\x8d\x54\xa4\xd6\x17\x65\x3e\x73\x97\x67\xeb\x19\xcd\x98\x23\xa7\x63\x42\x33\x06\x9f\x35\x70\x84\x66\x30\xfb\x56\x0d\x98\x38\x9a\xbf\x7a\x36\x63\x3c\x3e\x26\xa7\xb9\xd2\x22\xbd\x02\x25\x72\x19\xc1\x6b\x18\x33\x8e\x94\xff\x2c\x05\x4d\x63\xaa\xe9\xf1\x33\x42\x28\xe7\xc2\x01\x6f\x7e\x24\xf6\xd4\x89\x24\x01\xd9\x9f\x00\x1f\xcc\xf2\x11\x8c\x72\x96\xc4\x20\x71\x72\xff\xe9\xf9\xd7\x83\xbf\x0d\x5e\x3d\x23\x24\x92\x80\xc3\x6f\x58\x0a\x4a\xd3\x34\x3b\x26\x3c\x4f\x92\x67\x84\x24\x74\x04\x89\x9b\x95\x66\xd9\x31\x89\x68\x0a\x49\x7f\xf6\x8c\x10\x4e\x53\x38\x26\x8c\x6b\x98\x48\x1c\x9d\x25\x54\x9b\xc3\xa8\x06\xf8\x52\x85\x24\x9f\x99\xcd\x30\x93\x4c\xa4\xc8\xfd\x24\xd5\xbf\xdb\xd9\x3c\xf4\x54\xc3\x44\x48\xe6\x7f\xee\x93\x99\x79\xdf\xfd\x3b\x2a\xfe\x6d\x31\x74\x56\x02\x70\xe9\x00\xc0\xbf\x26\x4c\xe9\xb7\x4d\x6f\xbc\x63\x4a\xe3\x5b\x59\x92\x4b\x9a\xd4\x2f\x03\x5f\x50\x53\x21\xf5\xfb\x12\xb8\x3e\x61\x99\xfd\x03\xe3\x93\x3c\xa1\xb2\x76\xec\x33\x42\x54\x24\x32\x38\x26\x38\x34\xa3\x11\xc4\xcf\x08\x71\x98\xc7\xa9\xfa\x15\x2e\x76\x29\xcd\x1c\xf2\x54\x24\x79\xca\x8b\x0f\xc5\xa0\x22\xc9\x32\x8d\x7b\x65\x58\x57\xe5\x43\xc4\x7f\x89\x64\x53\xaa\xe0\x99\xe5\x07\x9f\x94\xe0\x97\x54\x4f\x8f\xc9\x40\x69\xaa\x73\x35\xa8\xfe\xd5\x6e\xd8\x65\xe5\x37\x7a\x61\x40\x34\xa7\x95\x4f\x9e\x95\xaf\xcc\x5f\xd9\x15\x46\x53\x48\xe9\xb1\x7b\x57\x64\xc0\x4f\x2e\xcf\x3e\xfe\xf5\x7a\xe9\xd7\x64\x19\xcc\x1a\x5c\x1b\x96\x60\x0e\x93\x74\x44\x6c\xd8\x23\xf2\x97\x58\xb2\xb9\x3d\xbb\xa7\x66\x4f\xc9\xdb\x62\x4a\xfc\x9a\xa4\x46\x68\x8c\x60\x4a\xe7\x4c\xc8\x01\x39\xd3\x24\x36\xf4\x0f\x76\x3a\xff\x07\xc3\x1f\x69\x92\xb8\x93\x42\xfc\x51\x51\xe4\x70\x58\x01\xe6\x2d\xd3\xc3\x5e\x65\xfe\xea\xdf\x86\x3d\x32\x7c\x6b\x20\x00\x3d\x7c\x61\xb8\x9e\x99\x7e\xc2\xe6\xc0\x2d\x55\x9a\xdd\x1b\x90\xff\x99\x02\xaf\x02\x5b\x80\x58\x99\x95\x29\xc2\xb8\xd2\x34\x49\x20\x36\x13\x0d\x27\x89\x18\xd1\x64\x48\x52\x11\x43\x0f\x65\xc4\x2d\x4b\x12\xc2\x1d\x87\xb5\x3c\x6a\x61\x58\xe4\xb0\x06\x73\xc3\xea\xd4\x9c\x00\x8d\xa6\x25\x44\xe4\x76\x0a\x12\xec\x9c\x94\xeb\x5a\xd0\xcc\x37\x46\x46\x02\x41\x64\xb8\x71\x31\x5d\x26\xcd\x1b\xba\x38\x61\xf6\xa9\x70\xa5\xca\x6f\x57\x36\xf8\xb9\xa1\x01\x27\x0a\xab\xdb\xe1\x48\x1b\x62\x47\x36\x56\x6c\x31\x23\x6d\x0c\xd7\x06\x6e\x19\xd4\xd2\xc4\x04\xf7\x8e\x13\x31\xfa\x04\x91\x1e\x90\x6b\x90\x66\x1a\x73\xe6\xf2\x24\x36\x5c\x6c\x0e\x52\x13\x09\x91\x98\x70\xf6\x7b\x31\xb7\xf2\x2a\x49\x42\x35\xb8\x83\x5c\xc5\x94\x06\x69\x54\x83\x39\x4d\x72\xe8\x19\x06\x8f\x52\x55\x82\xf9\x0a\xc9\x79\x65\x3e\x7c\x45\x0d\xc8\xb9\x90\x80\xaa\xc4\x31\xca\x54\x75\x7c\x74\x34\x61\xda\x73\xe3\x48\xa4\x69\xce\x99\x5e\x1c\x55\xd4\x19\x75\x14\xc3\x1c\x92\x23\xc5\x26\x7d\x2a\xa3\x29\xd3\x10\xe9\x5c\xc2\x11\xcd\x58\x1f\x41\xe7\xc8\x91\x07\x69\xfc\x95\x27\x7d\xf5\x7c\x09\xd6\xb5\xe3\x67\x1f\xe4\x6b\x81\x1d\x30\x5c\xcd\x90\x1a\x75\x43\xed\x2a\x4a\x44\x9b\x5f\x19\xec\x5c\xbd\xb9\xbe\x29\x4f\x9d\xd9\x8c\x55\xec\x23\xde\xcb\x81\xaa\xdc\x02\x83\x30\xc6\xc7\x28\x07\x8d\x22\x23\x45\x8a\x73\x02\x8f\x33\xc1\x1c\xb9\x45\x09\x03\xbe\x8a\x7e\x95\x8f\x52\xa6\xad\x96\x01\x4a\x9b\xbd\x1a\x90\x53\x14\x51\x86\x16\xf3\x2c\xa6\x1a\xe2\x01\x39\xe3\x96\x5c\x4f\xa9\xd1\x7d\xee\x79\x03\x0c\xa6\x55\xdf\x20\xb6\xdb\x16\x54\xa5\xeb\xea\xcb\x16\x6b\x95\x3f\x78\xe1\xd6\xb0\x5f\x35\x07\xfb\x3a\x83\x68\xe9\xf4\xc4\xa0\x50\x23\x33\x5c\x1b\xcc\xa9\x68\x92\x6a\xfe\xa9\x3f\xc1\xe6\x41\x41\xbf\xfa\xcb\x15\x90\x3c\xdf\x99\x8a\x5b\x64\x11\x66\x08\xc2\x51\xf9\xec\xd1\x32\xf7\x5c\xa5\x9d\x10\x08\xe6\xb9\xcc\x47\x09\x53\xd3\x6b\x2d\x8d\x34\x5f\x5c\x64\x15\xf5\x64\xf5\xa9\x0a\xc2\xd0\x9c\xa4\x79\xc3\x56\x5f\x58\xdb\xa4\x02\x3d\x54\xc1\x59\x4a\x27\x50\xff\x81\x25\x34\x51\x7c\x9b\x30\xf3\x3a\xd1\x53\xaa\x49\x44\x39\x12\xb1\x91\x60\x54\xd9\x3f\x27\x74\x01\xd2\x9a\x25\x49\xd2\x00\x35\x4e\xa1\x50\x86\x95\x53\x8c\xf3\x84\xb0\x71\x85\x83\x0b\x83\xd3\x39\x8b\x81\x28\x91\x02\x89\x50\xa2\x35\xcc\x58\x81\xcc\xd8\x12\x64\x9c\x4b\x54\x92\x73\xcd\x12\xa6\x17\x85\xc2\xbe\xbe\x6f\x1d\xb0\x88\x04\xe1\xb7\xae\x03\xa2\x50\xdf\x76\xaf\x9b\x65\xd0\x58\x64\x1a\x51\x82\x33\x19\x86\x44\x79\x95\xb8\x5a\x17\x55\xfb\x02\xf0\x3c\xad\x87\xa6\x4f\xa4\xc8\x35\xe3\xf5\xe3\xfa\x24\x13\xf1\x36\x78\x98\x51\xce\x66\xe2\x1f\x66\x0d\xa7\x46\x57\xed\x80\x8a\xe7\xaf\x0d\x37\x35\x2a\x6c\x7c\x4c\x3e\x28\x68\x38\x08\xa8\x27\x00\x8d\x09\x70\x63\x7c\xd5\xef\x12\x21\x6f\x11\x00\x92\xd9\x39\x4a\x1c\x47\x06\x9a\xe7\x81\x25\x8d\x84\x48\x80\xd6\xe1\x39\xa5\x73\xe0\x1d\x16\x72\x6e\xde\x33\x32\x78\xcc\x26\xb9\x53\x3a\xbd\xe6\x56\x32\x0c\x64\xe1\x47\xf8\xbf\xfd\xff\xce\xa9\x9c\xe5\x4d\x4b\x71\x96\x26\xae\xbe\xf6\x95\x30\x3f\x21\x68\x18\x5c\x43\x24\x41\x37\xfd\x3d\xb4\x15\xc6\x26\x3f\x3d\xb1\xe3\x15\x6a\xd3\xf6\xdf\xa8\x50\xa1\x11\xd8\x34\x27\x21\x33\x58\xf4\xd0\xa6\xa2\x8c\x7b\xe1\x7a\x7a\x42\x22\x03\xed\xd8\xac\x09\x0e\xd5\x8b\x02\x39\x91\xe0\xdc\x88\x55\x2d\x02\x53\x4a\x48\x85\x06\x87\x64\x09\x99\x50\x4c\xa3\xc9\x53\xf0\x08\xf7\x3d\xf2\xbf\x83\x6f\xbe\xfe\xcf\xea\xb7\x54\x2f\x30\xaf\x51\x76\x2e\xdf\x9e\x5e\x7f\xf5\x77\x62\xfd\x22\xc6\x08\xaf\x0c\x26\xd1\x94\x32\xae\x06\xe4\x84\xfc\xf4\xf6\xba\x7c\x27\xbc\x7a\xa5\x51\x3c\x2b\x42\x73\x2d\x52\xaa\x59\x44\x93\x64\x61\xcd\x46\xa7\xc9\xe2\x1b\xb5\x88\x69\x03\xd7\x93\x98\x23\xad\x92\xbb\x52\xa2\x65\xae\x56\x16\x60\x30\x3d\x5a\x04\xa6\x34\x30\x78\xda\x4d\x53\xca\x63\x35\x20\xef\x0d\xae\x91\x81\xa3\x21\x22\x84\x5e\x01\x53\x11\x2a\xeb\x79\x87\x83\x33\x51\x82\xb0\x34\x13\x52\xa3\x66\xef\xf4\x4f\x8f\x00\x8f\xa2\x41\xfd\x91\x24\x9d\xa8\xdb\xe1\x3a\xf4\x67\xb2\x6e\x13\xce\xa0\xf0\x10\x29\x4b\xd0\x66\x37\x20\x31\x14\x68\xb4\xb6\x01\x21\xe7\xf9\x9a\x8e\xbc\xfa\x8c\x80\x50\xa3\x46\xb2\xd8\xcf\x32\x83\xc5\x20\x38\xa8\x55\x10\xdb\x07\x2d\xca\xee\x4b\x7a\x6e\xec\x65\xbf\x20\x09\x63\x90\xc0\x75\xad\x7a\x38\xcb\x47\x20\x39\x68\x40\x0d\x31\x16\x91\x32\xca\x61\x04\x99\x56\x47\x62\x0e\x72\xce\xe0\xf6\xe8\x56\xc8\x19\xe3\x93\xbe\x11\x91\x7d\xab\x13\xa8\x23\x34\xa1\x8e\xbe\xc2\xff\x6b\xc1\xcb\xcd\xc5\xeb\x8b\x63\x72\x12\xc7\x44\x58\xe9\x6a\xa5\xf6\x98\x41\x62\xe8\xaa\xb4\x98\x7a\xa8\xb5\xf7\x48\xce\xe2\xff\xd3\x4c\x04\x1b\xe0\x4d\x64\x56\x23\xda\x00\x77\xd7\x4e\xab\xbb\x9d\x02\x02\xab\x4b\x26\x27\x24\x31\x3a\xb9\x21\x96\xb4\x13\x35\x58\xe5\x34\xc4\x16\xda\x25\x8d\x7d\xbc\xb7\xb1\x79\x21\x7d\x03\x57\xe3\x5f\x5b\xf4\x39\x52\x91\x0b\x81\xa3\xb5\x76\x6e\xbc\x24\xf0\xec\xbf\x1b\x93\x0f\xe0\x63\x9d\xfd\x77\x67\xf2\x81\x69\x6b\xd8\x7f\x67\x26\x1f\x98\x76\x85\xfd\x6f\xc0\xe4\x5b\x58\xef\x3a\xfb\xef\xc8\xe4\x03\xf3\xae\xb1\xff\x8e\x4c\x3e\x30\x65\x0d\xfb\xef\xcc\xe4\x1b\xa7\x65\x1a\xd2\x20\x7b\x5f\x3e\xae\x48\x81\x6f\x61\x71\x8d\xdc\x5a\x48\xc7\xb6\x0d\x4e\x1c\x57\xa7\xee\xa5\xd0\x4e\x76\x13\x2c\x1d\x44\xcb\xbd\x09\x97\xad\xc4\x4b\x67\x46\xd9\x45\xc4\x3c\x6d\x21\x73\x2f\x62\x66\x03\xfc\x75\x13\x35\xf7\x25\x6c\x3a\x8b\x9b\xae\x02\xa7\x8b\xc8\x69\x13\x3a\x9d\xc4\x8e\x7f\x89\x4a\x49\x9b\xa6\x8a\x12\x16\x74\x7d\x90\xba\x63\x77\xfa\xee\xcc\x6d\x8a\xb2\x0c\x0d\xb9\x53\x96\x01\x8f\xcb\xeb\xd8\x84\x05\x51\x6b\xb8\x87\x9c\xe4\x78\xcd\x8a\x96\xf8\x32\xbb\xec\x11\x18\x4c\x06\x3d\x32\xec\x7f\xec\xf5\xfb\x5c\xf4\xb5\xa4\x5c\x8d\x41\xf6\x33\x29\x26\x12\x94\xea\xf5\x5f\x2b\xbd\x48\x60\x10\x89\x44\xc8\xef\x38\xcc\x41\x0e\x43\x67\xf6\x1a\xa0\x38\x37\x68\x64\x56\xef\x24\x25\x8c\x8f\xfe\x3a\xf8\x76\xf0\x1f\xf6\x4f\x7d\x48\x47\x10\xc7\x20\x8f\xa2\x84\x0d\xa6\x3a\x4d\x76\xe0\xaa\x9d\x08\xbd\x7d\xab\x8a\x5b\xb8\x0d\x76\xca\x22\xd5\x9a\xc3\x95\x5b\xbc\x30\x2e\x26\x39\x8b\x41\x1d\xa5\x8c\x33\xfb\xef\x7e\xae\x0c\xff\xa8\x4c\xb0\x23\x46\xd6\x0d\xf9\x13\x23\xeb\x68\x54\x5e\xa1\x50\xf2\xc3\xc9\x47\x72\xf8\x03\x5e\xc8\xf9\xbf\x1e\x3b\x36\x13\xd2\x73\x88\x5b\x34\x75\x63\xee\x40\x34\xf9\xa9\xce\x82\x27\xb6\x7e\x61\xe4\xa4\x1d\x0e\xb2\x09\x37\xc4\x2b\xca\xad\x20\x41\x5c\xde\x15\x18\xf3\xba\x9b\x98\x4e\x60\xb8\x3d\xbc\x1b\x40\xba\xb1\xd2\x72\x03\x83\xaf\x39\xd4\xde\x3f\xd7\x4d\x44\x44\x93\x2b\xaf\x70\x07\xf4\x9e\xb5\x03\x9d\x51\x3d\xf5\x9a\x01\xce\xb2\xaa\xbd\x07\xd4\x96\x0e\x28\xed\x72\x22\x36\xf1\x86\x77\xfc\x6c\x23\xe7\x2a\xe1\x69\x5b\x56\x70\x4f\x14\x68\xcd\xf8\xa4\xab\x8c\x3b\xf1\x4a\x57\x04\x5e\x9a\x9d\xa2\x7d\x70\x4e\x33\xa3\x3d\x5c\x17\x3a\x22\x8a\xbf\x90\x65\x60\xed\x27\x55\x31\x08\x3c\x2c\xcd\x0b\xea\xc6\x96\x22\x0f\xd1\x5b\x58\x5c\xc1\x78\x13\x3b\x7c\x5d\x8d\x2f\x96\x17\x56\x7a\xbb\x32\xcc\x4e\xda\x7c\x83\x3e\x5f\x68\xf0\x6d\xfa\xf7\x06\xcc\xaa\x9b\x0e\xfe\xd4\xb5\xf0\xcd\xf5\xf0\x0e\x53\x76\xd1\xd4\x37\xc2\x74\x57\x6d\xbd\x83\xbe\xbe\x74\xe8\xea\xae\xf8\xd6\x1f\xaf\xd4\x77\x57\xda\xbb\xab\xed\xdd\xa4\x4d\xbb\xea\xde\x51\x8c\x10\x67\x8b\xde\xc5\xf9\x56\xad\x66\xfa\xc3\x1c\xee\xbb\x30\xd6\xb7\x34\xd7\xf7\xec\xe2\xcf\xce\x2e\xd6\xcc\xfb\x0e\xeb\xf9\x93\xf0\x8a\x0d\x74\xa0\x6b\x88\x72\xc9\x74\x57\xa5\xf3\x9e\x74\x21\xe5\xa0\xf0\x07\x66\xaf\x1b\x85\xa0\xda\xeb\x46\x6d\xd0\x7d\x71\xcc\x6e\xaf\x1b\xed\x75\xa3\xba\x67\xaf\x1b\xed\xd9\xc5\x5e\x37\xda\xe8\xa5\x96\x17\x32\x43\x07\x4a\x03\xd7\x1f\x45\x92\xa7\x70\x9a\x50\xd6\x10\xe6\xb7\x53\xa4\x9d\x9e\x36\xdd\x5d\x5f\x16\x10\x10\x0b\x02\x41\x18\xec\x1d\xf5\x68\xd1\x14\x85\xd7\x23\x6c\xdc\x74\xed\x83\xb9\x63\x4c\xb9\xf0\xbe\x38\x14\xaa\xd7\x48\xaa\xd9\xf2\x7a\x76\x8a\xc3\x74\x73\xdd\x55\x24\x66\x0b\xe4\x12\x26\x4c\xe9\x26\xc7\xeb\x1a\xc8\x36\x8c\xd5\x0f\x2a\x22\x29\xb2\x5c\x4d\x8f\xb2\x3c\x49\x3a\xc0\x6b\x03\x6c\x6b\xff\xd8\x2e\x5b\x68\x1c\x4b\x50\x5d\xbd\x97\x06\xe2\x0f\x57\x67\x88\xdf\x28\x02\xd5\xcc\x0d\x3b\xb0\xa2\x88\x6e\xf0\x55\xab\x76\xa7\x34\x23\xb7\x53\x16\x4d\x6d\x3c\x86\xd5\xf7\x4f\x2b\xd1\x1f\x27\xb9\x9e\x0a\xa3\xfc\xef\x02\x18\xe3\x68\x42\x04\x24\xd1\x12\x78\x6c\xec\x21\x34\x36\x08\xc8\x72\x37\x6d\x4e\x0c\xce\x45\x0e\x19\xf4\x50\x14\x05\xb8\x8e\xe0\xc9\xa2\xf9\xfe\xa9\x0b\x0f\x14\x72\x42\x39\xfb\x1d\xc9\x65\x03\xec\x16\x10\x57\xc7\xef\x82\x42\xb5\x49\xb0\x6a\x45\x35\xb1\x89\x3d\x91\x84\x18\xb8\x66\x34\xb1\xd1\x29\xb8\xd9\xcd\x42\x62\xd7\xb8\x78\x99\x73\xcd\x52\xb8\xb4\x01\xe8\xb2\xe3\xc9\x75\xa3\xf0\xc8\x0e\xc8\x3b\x36\x83\x64\xe1\xb2\x90\x5c\x34\x30\x39\xbc\x2d\x32\xbe\x1a\x80\x9f\xd2\x39\x90\x94\x32\x5e\x4c\x67\xc9\x7b\x4a\x15\x19\x01\x70\x12\x33\x65\x08\x8b\xf1\x1c\x62\xa2\x98\x31\x95\xe7\xc1\x4b\xae\x57\x83\x6f\xea\x49\xa8\x8d\x6f\xd9\xef\xd7\x66\x41\x35\xe2\xc0\x27\x5d\x5d\x39\xe0\x63\xc0\x8b\x7a\x1e\x2d\x82\x50\xb6\x80\x62\xa6\x12\x79\x03\xf5\x2c\xc1\x30\x15\xb7\x24\xcd\xa3\x29\x0e\x31\x5c\xe9\x96\x32\xa3\x57\x8c\x31\x22\xd7\xfc\x4e\xe4\x36\x26\xca\xde\x53\x67\x52\x34\x72\xad\x20\x50\x01\x0a\x8a\x92\x5c\xe9\x3a\xaa\x59\x82\xf4\x76\x4a\x35\x6a\x7b\x46\x55\x75\x43\xc8\x42\xe4\xcf\x25\x52\x12\x86\xdd\x21\x8b\xc8\x12\x43\x0d\x6f\x0b\x5d\xb5\x06\x56\x21\xc9\x45\x06\x5c\x4d\xd9\x58\xaf\xef\x75\x60\x1d\x4b\x11\xed\x2d\x00\x27\x4c\x69\x84\x75\x29\x0a\xbe\x14\x28\x2e\x96\x8d\x6a\x6d\xe4\x3d\x0a\xad\xfa\x1c\x90\x96\xdc\x1a\xdc\x19\xed\xb3\xac\x98\x6a\x4d\xff\x21\xa1\x28\x80\xa5\x25\x9c\x56\x41\xc7\xbc\xa3\x32\xdd\x8b\x50\x32\x01\x0e\x92\x45\xcb\x2b\xac\x25\x0d\x9f\x4f\xdd\xf4\x46\x9b\x98\xf5\x89\x68\x6f\x9b\x0d\xb8\x66\xf5\x8e\x0b\x92\x08\x3e\xb1\x76\x44\x83\x36\xd5\x81\x07\x7a\x18\xce\x45\xce\xf5\xa5\x60\xbc\x91\x3b\x3f\x18\x28\x37\xe6\xc5\xc7\x02\x42\x77\xfe\x78\x85\x68\x0c\x2d\x9b\x81\x6b\x07\xa3\x47\x18\x1c\x7b\x3a\x58\x34\x5b\x74\x85\x1a\xd3\x73\x12\xaf\x47\x06\x83\xc6\xd0\xf9\xd6\x45\x60\xf6\x63\xa7\x55\x60\x9e\x2a\xe6\x4a\x9a\x83\xaa\x14\x9b\x70\xef\x73\x5d\x3e\xe1\x87\x6a\xc1\x35\xfd\xdc\xb8\x82\x94\x2e\xc8\x9c\xca\x85\xe3\xf5\x86\x6f\x09\x9b\x1c\x3c\x34\xfb\x39\x6c\xd2\x5f\x5a\xd5\xe6\x66\xb3\xad\x8f\x83\x6b\xff\x80\x4b\xaa\x63\x3a\x21\x89\xdf\x1c\x44\x31\xb3\xa9\xd0\x2d\xac\x71\x19\x61\xcb\x79\xc6\x8e\x0f\x12\x97\x54\xad\xca\x7a\x04\x75\xfa\x0b\x86\xf3\x76\xe4\x79\x61\x26\x53\x0d\xb1\xee\x20\x38\x5d\x78\x76\x19\xda\x51\x58\x1e\x12\xb4\x64\x30\x07\xbf\x02\x12\x51\x4d\x13\x51\x4f\x7f\xc1\x68\xac\xb6\x4c\x50\xf7\x81\x32\x56\xa5\x9a\x1a\xda\xac\xec\xb9\xe8\x14\xc4\xbd\x73\xfb\xac\x80\x6a\x96\x91\xab\x26\x8b\xb7\x8b\xbf\x2d\x97\xac\x73\x8c\x99\xd5\x9f\x57\xd1\xe9\x52\xe9\x6d\x94\x39\x99\x30\x3d\xcd\x47\xc7\x17\x57\x3f\x1c\x5d\xbd\xb9\xbc\x38\xba\x3c\xb9\xf9\xf1\xb7\x9b\x8b\xdf\xde\x9e\x9c\xbf\x79\xf7\xe6\xe6\xfa\xb7\xef\x2f\xde\xbd\x7e\x73\x15\xf8\x64\x07\x9d\xbb\xa3\x33\xa2\x3e\x78\x28\x30\x38\x93\x62\xcc\x92\x1a\x36\xb3\x86\x06\xf7\xa6\x4b\xf6\x57\x53\xb7\x11\x18\xf2\x8f\xc9\xff\x34\xcb\x92\x05\x46\x9f\x1b\x25\x47\x4b\x5a\xef\x5d\xb6\x3a\xb0\xd1\xfc\xab\xf5\x5a\x96\x2a\x9b\xf8\x4f\x45\x53\xa1\x80\xe3\x17\x72\x95\x63\xd4\xbe\x04\xcc\x8d\x6f\x50\x45\x4e\x9d\xee\x85\x99\xa3\xe6\x17\xde\x13\x66\x29\x8f\x79\xba\xc2\x2f\xd1\xc4\x7f\x48\xa1\x76\x56\x33\xe7\x5b\x4e\x35\x9b\xc3\x46\x7a\x58\x91\x13\xdf\x82\xd3\x15\xb9\xa7\x1b\x24\x5e\x60\xef\x2c\x8a\x3b\xaa\x7a\xf6\xe5\x55\x86\xe6\xb2\x7b\x37\xce\x91\x26\x5b\x69\x76\xe1\xd3\xf9\x57\x15\xd1\x3a\x4a\x0c\x63\x0e\x93\x71\x69\x34\xbb\xa5\x32\xc6\x42\x44\x54\xb3\x11\xa6\x0a\x37\x48\xde\x0e\xe9\x98\x61\x4d\xba\x16\xa6\x1b\x83\xde\xd3\x65\x75\x9a\x4a\x9a\x82\x06\xa9\x3a\x68\xa1\xa4\xab\x57\xf2\x73\xbf\x74\x75\xf7\x51\x77\x91\x73\xe8\xe7\x7c\xc6\xc5\x2d\xef\x5b\x37\xf4\x31\xd1\xb2\x56\x72\xb6\x79\x50\xfb\x1d\x20\x6d\x81\x92\xc6\xf1\x9d\xe4\xc3\x2f\x5f\x26\x9b\x49\x11\xc5\x64\x45\x61\x5b\x16\xd7\xb6\xec\x06\x82\xd0\x8c\x65\x33\xcd\xb6\x5c\x76\x67\xfc\xaf\xdd\xe0\x14\xc1\xd1\xc4\xd6\x9f\x40\xd6\x85\x2b\x68\x66\xa1\xed\x9b\x30\x46\x6e\xd7\xc5\x4f\x79\x53\x87\xc4\x13\x37\x3e\x80\xac\xfb\x39\x46\xcf\xdf\xc1\x84\x46\xee\xbb\xab\xe6\x69\x71\x9e\x06\xa4\xc2\x01\x02\x72\x75\x63\xde\x40\x1e\xe8\x14\x12\xef\x3f\xef\x88\x96\xd3\x4a\x79\x06\x2d\xdc\x60\x22\x24\x89\x99\xc2\x7f\x52\x8b\xb1\x01\x39\x69\x2c\xd5\x40\x0a\x89\x41\xd4\x94\x4a\x57\xc9\x2d\x12\x69\x5a\x9a\xfe\xad\x41\xc0\x61\x07\x25\x17\x31\x78\xd2\x79\x57\x29\xb0\xd5\xba\xbe\xd7\x45\xf0\xbe\x02\x94\x56\x66\x26\x7b\xc0\x97\x6a\x43\x89\x38\x9c\xab\x68\x96\x05\x09\x9b\xb0\x91\xad\x7c\x37\x02\xac\xac\x13\xe7\x09\x96\x6c\xeb\xa1\xfb\x1f\x8b\xb7\xd9\xfa\x5f\xce\xba\x09\xcc\x68\x00\x79\xfc\x14\x8e\x4c\xc4\x27\xc1\x23\x4d\xd6\x38\x66\x72\x4b\x17\x66\x7b\xfb\xa8\x4f\x83\x22\x69\x9e\x68\x96\x25\xa8\xc8\x26\x2c\xa2\xca\x2b\xd8\xac\xf5\x12\x82\xd8\x3a\x4d\xa8\xa3\xd1\x14\x10\x29\xe4\x30\x86\x31\xcd\x13\x4d\x5e\x8e\x69\xa2\xe0\xe5\x8b\xdd\x68\xa7\xb2\xc4\xdd\x48\x27\x13\xb1\x22\x87\xdc\x58\x0d\x46\xad\x14\x0a\x48\x4a\x75\x34\x0d\x5f\x9b\xda\xaa\x4b\x23\x48\xdc\xed\xb9\x90\x3d\xab\x6b\x62\x29\x2f\x51\x57\x3a\xeb\x45\x5b\xe0\x52\x3d\xf9\xfa\x2a\x50\x23\x28\x77\xc7\x96\x83\x7c\x1a\x74\xc6\x35\xdb\x90\xd6\xde\xc3\x1c\xe4\x1f\x8c\xd4\x2a\xab\xfc\x32\xc8\xcd\x95\xa9\x7a\x62\x24\xd7\x56\xd4\xc8\x16\x77\xdc\x56\x8d\xf9\x87\x1d\xbe\xd7\x62\x1a\x9e\xbd\x16\x53\xf7\x74\xf1\x21\xad\x84\xba\x7a\x23\x7b\xed\x06\xc5\xd5\xbd\x2a\x92\x5b\x91\xa0\x43\x4b\xa3\x6a\xf6\xe8\x52\x60\x0e\x72\x24\x54\xd7\x1b\xf2\x37\x76\x1b\xdd\x20\x92\x88\xc9\xc4\xf9\x8d\xed\xb5\x9c\xa1\x58\xc1\x9d\xc1\x16\x64\x60\x2a\xcf\x32\x21\x35\x61\x9a\x1c\xc2\x60\x32\xf0\xf1\x29\xee\x7a\x4f\xc4\x3b\xf1\xfd\x96\x13\x81\xe5\x57\xb7\x65\x34\xf6\xaa\x74\xcf\x66\xea\x9f\x3d\x9b\xa9\x7b\xee\x93\xcd\xb4\xc7\x15\x91\x32\x2a\xe0\xd1\xf9\x4d\x97\xe8\x84\x35\x6c\xe0\x39\xb4\x35\x9b\xfb\x3e\xbc\xc2\x85\x25\x38\xcf\x74\x51\x31\xbb\xa2\x14\x85\x02\x5a\xcf\x34\x11\x73\x90\x92\xc5\x45\xf1\x4a\xab\x75\xfa\x69\x8d\xc6\xe7\x74\xd3\x6e\x08\xf6\x8e\xd0\x1d\x52\x6b\xdb\xb8\x96\x8f\x4b\xda\x9a\x73\x15\x81\x4d\xbb\x70\x2f\x9a\x6b\xd1\x75\xdf\xc4\x4a\x1d\x1e\x77\x3e\xf1\xf6\x35\xe0\x9a\xeb\x76\xa8\xf6\x6c\xf4\x4b\x63\xa3\xf0\x39\xeb\xae\xab\xd4\xae\xe9\xc8\x2f\x08\xa7\xca\x25\x90\x39\xa3\x64\x16\x0a\xd2\xf1\xcf\x35\xc8\x39\x8b\x02\x0e\xa2\x2e\x2b\x60\xcd\x55\x5b\xd7\x16\x60\xce\x30\x46\x94\x95\x01\x89\xcd\xc1\xac\xa4\x63\x44\xa4\x99\xe0\x32\x4f\x92\x4b\x91\xb0\xa8\xab\xd1\xff\x1c\xab\x0a\xe4\x49\x42\x32\x3b\xcc\xb9\x9c\xfe\x8d\xde\x80\x7f\x9f\x8d\xdf\x0b\x7d\x69\x3d\xf5\x6d\x74\x1f\x84\x0e\x1b\x18\x9c\x5e\x7e\xd8\x08\x41\x9f\x59\x9a\xa7\x84\xa6\x22\xe7\x28\x30\x4f\x2f\x3f\x14\x37\x1f\x3b\xd5\x39\x40\x68\xce\x21\xdd\xac\xf8\xc2\x3a\x40\x29\x4e\x71\x57\x30\xcd\x81\x83\x52\xdf\x53\x96\xe4\x12\x6e\xa6\x12\xd4\x54\xd4\xd5\x4a\xae\x05\xf0\xf9\x39\xe3\x08\x5d\x24\x30\x9c\x15\x5d\x10\x63\x3b\x97\x2a\x64\x68\x26\xc5\x08\xc2\xa5\x3f\xd1\xbb\xc0\x15\x8b\x41\x42\x8c\x33\x40\x4c\xe8\x58\x83\x24\x53\x3a\x37\x36\x81\xca\xa3\x08\x20\x86\x78\x40\x4e\xb2\x2c\xb1\xfa\x4b\x9b\x97\xc4\xad\xce\x42\xb0\xc4\x85\xd1\xbd\x44\xa3\xf2\x02\x79\x0a\x34\xd1\x53\xc7\x96\x9a\xa9\xce\xde\xdb\x63\x7f\x83\xbf\xfe\xa5\x05\xf3\xa8\x3b\x80\x6c\x41\xfd\x19\x67\x9a\xd1\xe4\x35\x24\xb4\xf3\xf9\x79\x9f\xa7\x23\xc0\x2a\xff\x0a\x22\xc1\x63\xe5\x70\xb5\x1c\x6e\x3c\xa5\x8a\x28\x4d\x65\xb8\x90\x9c\x0b\x8a\x5c\xc6\x95\x0d\xaf\xc5\x5b\x6f\x5b\x99\xbc\x0e\x75\xa1\x49\x1f\x19\xa9\x97\x20\x99\xe8\x4c\xc5\x3f\x8a\x5b\x22\xc6\x1a\x50\xfb\xcb\x40\x62\x87\x86\x82\x72\xab\x04\xd7\xe2\xf1\xff\x43\x10\x1c\xf6\xaa\xe8\x2a\x34\x9e\xdb\xb7\xbd\x5a\x7c\x3b\xb5\xf5\x8f\x39\x44\x9a\xf1\x89\x59\x21\xea\xb9\x88\x9b\x1f\x6f\x6e\x2e\x83\xf5\x15\x97\xd0\xb8\x09\xb2\x02\x93\x76\x44\xe3\x06\xbc\xf0\x3a\xc7\x0c\x86\x3b\xe1\x85\xca\xce\xb5\xca\x0c\x43\x7c\x4b\xac\x30\x43\x37\xc5\x38\x4f\x96\x19\xa2\x65\x92\x05\x71\x86\xa7\xdc\x8e\x1b\xb6\x69\x66\x0f\x4a\xb6\x37\xa1\xe0\x6f\xd2\x99\x45\xda\x10\xa2\x8a\x58\x62\xe1\xdc\x3d\x91\xeb\x55\x06\xd0\x09\x93\x4f\x90\x39\x86\x93\x28\xdb\x74\x45\x33\x7a\x40\xce\xf4\x73\x5b\x1f\x36\x26\xc3\x8a\x6d\x3c\x0c\x2f\xd7\x59\xc3\xbb\x95\x89\x12\xb2\xeb\xe6\xdf\x88\xb2\xb6\x2a\xa1\x24\x66\x63\x9b\xfd\x89\x73\x38\xad\xbf\x03\x6b\x29\xd7\x5e\x5c\x22\x0d\xbf\xfd\xfa\xdb\xaf\x87\xad\xbe\xc4\xf0\x3e\x64\xae\x7b\xd2\x9d\xac\x06\x6b\xf5\x16\xcc\x45\xc8\x90\x93\x74\x79\xe5\xe5\xfa\x7c\x1f\xa1\x82\x93\x0f\xa7\x5a\x67\x43\x4c\x3b\x42\x96\xdf\xa2\x67\x0d\xed\xc4\xc3\xd2\xe4\xc5\xfe\x46\x32\x6f\xb5\x6e\xda\x0a\x83\x8d\x40\xbd\xd9\xc8\xf2\xac\xc4\x77\x5d\x56\x87\xaf\xda\x6b\x76\xee\xd0\xb9\xe7\x2b\xda\x54\x49\x03\x78\x91\x38\x7c\x71\xbf\xc7\xbe\x8b\xf5\x27\x81\xc6\xec\xcf\xab\xbe\x17\xcb\xfb\x83\x49\xac\x02\xee\x27\xa2\xda\xaf\xe0\xf1\x8f\xaa\xdb\x17\xcb\x78\x24\xe5\x7e\x0b\x72\x7c\x0c\xec\x3c\x15\xf5\xbe\x2b\xba\x5a\x98\xc0\xdd\xe9\xf7\x25\x86\xfe\xa4\x0a\xfe\x1f\x9e\x5f\x3e\x21\x15\xbf\x13\x2e\x9f\x24\x93\xc4\x0e\x73\x1b\x3a\x3d\x1d\x61\xdf\x83\xd3\xd3\xc1\xb3\xb9\xdb\x73\x0d\xa4\xbb\x74\x7b\x2a\xeb\x7b\xbf\xdc\xd2\xa4\xb0\xcd\x70\x5d\xbd\x01\x3b\x95\x55\xc6\x97\x84\x74\x80\x3a\x6c\xa8\x42\x99\x51\x61\xd5\xf2\xaa\x95\xb1\xab\x8d\x51\x59\xe1\xb6\xa6\x46\xe3\x2a\xd1\xe4\xe8\x6a\x3a\xb5\x2d\x15\x2d\x8d\xd6\xc5\xee\x70\xcb\x29\x3b\x25\xad\xd7\x5f\x70\x4a\x1f\x49\x5f\x3b\x41\x87\xbb\xcd\xc8\x08\x89\xd7\x40\xe3\x84\x71\xb8\xb6\x5c\xaa\xe3\x5e\xd8\x8a\x3a\xcc\xdf\x27\x7b\xb0\x18\xf7\xdc\x6e\x29\xea\xaf\x45\x8a\xa2\xae\x88\x8c\xb0\x57\xb6\x03\xf9\x24\x46\x98\xb5\x69\xc5\x91\x66\x3c\x17\xb9\x4a\x16\x0e\xea\x76\xbd\x92\xe1\xc6\x56\x24\x99\xdd\x63\x2f\xb7\x56\x6c\xca\xbf\x7d\x1d\x28\xb1\x57\x61\x80\x7f\xfb\x8f\x9d\x08\x7f\x83\xeb\xe4\x83\x93\xa5\xcb\xe4\x18\xb2\x44\x2c\xd6\x42\x21\xa9\x42\x3a\xf8\x49\x8c\x02\x18\x41\xc5\x89\x26\x09\xb6\xdc\x73\x1a\xb6\xeb\xd0\x8d\xa8\x47\x79\x2e\x45\x4a\xa8\x51\x41\x99\x88\x6d\x7a\xb9\xca\xd3\x20\x9f\x38\x44\x0b\x7c\x68\x68\x78\xd8\x23\x43\xb3\x7f\x72\x88\xbd\x63\x86\xff\xca\xa9\xd4\xbf\x0f\x6d\x19\x0c\x1b\xfc\x04\xf1\x0b\x6c\x56\x4e\x32\xaa\x54\x78\x07\xfd\xb7\x5d\xb0\xd4\x30\x66\x12\x22\x3d\xb4\x1d\x54\xdd\xf0\xe2\xa5\x17\x03\xf2\x0b\x47\xcf\x4f\x50\x3b\x76\x09\x79\xb6\x39\x43\x92\xac\x2f\x54\xd9\xaa\x16\x45\x7c\xae\x7d\x21\x30\xa7\x59\x28\x2b\xba\x43\xba\x56\xe7\xb6\x45\x8e\x41\x49\x11\x9f\xef\x57\xf1\x2a\x1d\x9a\x97\xc3\x86\xea\xd2\x34\xc3\xaf\x8f\x5e\x91\x97\xf6\xbf\x61\xcf\x30\xbb\x04\xc8\xf0\xaf\xdf\xa4\x43\x83\xc9\xe1\x37\x5f\x2b\x9c\x91\x0b\xfd\x62\x70\xb0\x93\xad\x3e\xa2\xd1\x4c\x8c\xc7\xef\x58\xca\xba\xca\x9c\x65\x0e\xc0\x0b\x6d\xc7\x66\xe2\x2a\x7f\x0c\x53\x8a\x95\xc9\x5a\x38\x80\x39\xec\x0d\x27\xf3\x2f\x9d\xce\xe5\x8e\x8a\x49\x19\xca\xd7\xf9\x74\xda\xc6\x1c\x44\x41\x46\x25\x46\x1d\x17\xf9\x88\x45\x85\x91\x72\xd6\xe0\xf2\xa9\x2e\xda\x4b\x8f\x7c\x0f\x51\xec\x75\xcc\x38\x11\x32\x76\xad\x4a\xf5\x14\x52\xac\x15\x22\xe4\xac\x8b\xdb\xab\x20\x3f\x74\x76\xb1\xc9\x04\x99\x20\x7c\xb6\xad\x96\x93\x05\x96\xc9\x2a\x6b\xcb\x91\x93\x22\x4b\x35\x74\x32\x3d\x70\xe8\x43\x43\x56\x4c\x7d\xb6\x25\x36\xbb\x76\x73\x40\x25\x3a\x72\x40\xde\x0c\x26\x21\xd3\xcc\xdb\x26\x9e\x83\x14\x23\x7b\xf6\xd7\xe6\x34\xf5\xfd\xdf\xaa\x00\x84\x0e\x67\x01\xda\x21\xd3\xcf\x8d\x9e\x8c\x17\xf6\x3e\xca\x69\x28\xe4\xc4\x37\xf8\xb0\xad\xed\x67\xc7\x3e\xe6\x0a\xf9\x59\xf0\x1a\x6d\x24\xa9\x5c\x58\xbe\x73\xed\x99\x5b\x35\x1a\xd4\x70\xbd\x28\x97\x12\xb8\x4e\x16\xc7\x9e\x43\x06\xa6\xac\xe3\x9d\xad\x27\x3a\x5c\xa5\x4a\x70\x0b\x41\xb4\xd8\x2c\xf8\xa1\x3c\xd6\xae\xd5\xb0\x96\x40\x75\x39\x9f\x76\x29\xb6\x8d\x4d\x41\xed\x83\x75\x24\x7f\x12\xa3\x01\xf9\x88\x95\x17\x6d\xdb\x6e\x83\x99\x63\xd2\x27\x07\x27\x49\x22\x6e\x0f\x8e\x0d\x27\x16\xb7\x85\x08\x6b\xf1\xba\xc9\x9c\x57\xe0\x48\x16\xff\x65\x66\xfa\x5e\xc8\x11\x8b\x0f\x0a\x9d\xed\x05\x26\xce\x8e\x58\xac\x2a\xef\x86\x27\x55\x3d\xa2\x66\x2c\xcb\x8c\x18\xe4\xf0\x59\xe3\x87\xd8\xd8\xd0\xcc\x9c\x89\x5c\xe1\xcf\x53\xaa\xf8\xf3\xe7\x9a\x8c\x19\x67\x6a\x1a\xb4\xae\x16\xa0\x11\xb4\x2b\x6b\x88\x1d\x1c\x1b\x26\x1d\x41\xa2\x4a\xa2\x28\x8a\xe7\x18\xc6\x67\x76\xdd\x19\x6d\x21\x94\x32\x9f\x42\x49\x38\xdc\x12\xc1\x1b\xba\xc9\x92\xce\x04\xb2\x8f\x27\xfb\xa2\xe2\xc9\xc6\x34\x49\x0c\xbe\x3b\xae\xea\x83\x82\xa5\x58\x51\x2b\xd2\x58\x9a\x25\x90\x02\xd7\x4b\x95\x2c\x86\x6d\x3c\xb3\x68\x42\x7f\xe8\xd9\xdb\x8b\xa2\xca\x23\xa6\xf0\x2f\xb0\x81\xa3\x20\x29\xd5\x20\x19\x4d\xd8\xef\x6d\x46\xdc\x8a\x16\x5c\x8a\x32\xcf\x4d\x76\xc3\x96\x17\x9f\x1b\x78\x05\xdc\x77\x4b\xc9\xeb\x05\xdb\xed\x54\x24\xdd\x83\x75\xc7\x95\x2c\xb3\x52\x5f\x8f\x21\x4a\xa8\x84\x18\x65\xa2\xab\x5a\xbc\xa4\xb0\x06\xa6\x5c\x01\x88\x29\x92\x42\x34\xa5\x9c\xa9\x52\xa3\x88\x84\x34\x4a\x76\xb2\x5b\xb7\x1c\x6f\x4e\x6c\x67\x54\x5e\x94\x35\x2d\xec\xf0\x8a\x3d\x89\xb0\x17\xd6\x4a\x98\x34\x0c\x53\x65\x63\xc3\x30\x53\x86\xde\xcf\x32\x55\x16\xa3\xa9\xb1\x36\x04\x5f\x10\x09\x54\x09\x3e\x20\xe4\xdc\xbc\x16\x62\xe9\x9f\x8c\x70\x2a\x05\x9f\x2d\x0b\x82\x96\x69\xce\xb5\x55\xd6\xdd\xbd\x92\xe0\xa1\xce\x3c\x77\x65\x49\xb6\xb0\xc1\xa2\xa0\x5d\xb7\xb2\x3a\xb5\xee\x85\xd7\x95\x39\xf6\x09\x20\x0d\xcf\x5e\xd2\x6c\x8c\x38\xeb\xc8\xd8\x3e\xae\xff\xb5\x1b\xbf\xa7\xc9\x86\x67\x4f\x93\x75\xcf\x8c\xf1\xae\x2b\x3a\xb1\x46\x89\x59\xcc\xe7\x2c\x61\x11\x33\x0a\xbb\x2b\x3d\x6f\x35\x22\x85\xbe\x24\x4b\xc9\x69\xd8\xbe\xc0\xe2\x99\x23\xd0\xb7\x00\x9c\x0c\xcb\x21\xc3\x9e\x33\x6c\x3f\x89\x91\x75\xe7\xcc\x6c\x49\xa6\xbe\xf3\x66\x87\xd4\x29\x7b\x13\x6a\x0c\x33\xdf\xf7\xba\xa8\xcd\x84\x84\xe5\xad\x8b\xce\x39\x42\x1d\x44\x7b\xae\xe0\xfa\xfa\x64\x03\xe5\x11\xe9\x4e\xf6\x15\x8b\xc1\x55\xd0\xd2\x82\xe4\x59\x4c\xb5\x55\x5a\xc4\x2d\x87\xb8\x04\x3c\xe4\x68\x2c\xfc\xf1\x86\x6a\x87\x2f\xaa\x9d\xa3\x99\x5e\xc9\xba\x31\x6a\xae\xc2\xa3\x15\xbe\x93\x8c\x12\x06\x5c\x5b\xf0\x32\x97\xd7\xde\x33\x6a\xc3\xf5\xf5\x09\x61\x0a\xd3\xca\xe9\x9c\xb2\xc4\x10\xb1\x6b\xbe\x1a\x32\x78\x39\x11\x49\x5c\x55\x43\x5d\xb9\xd4\xd6\x3e\x7d\x3b\xf3\x52\x43\x4e\xbb\x71\x53\x33\xc3\x9e\x9f\x36\x3c\x7b\x7e\x5a\xf7\xf8\xa6\xc3\xdb\x69\xfa\xd5\xdc\x11\x54\xc9\x57\x14\x7d\xcb\x66\x3b\xb0\x57\x34\x19\x67\x50\x80\xd3\xf9\xf6\x27\x30\xe7\x36\xf7\x42\x3b\xf9\x9f\x5b\xc8\x10\xf8\x9c\x49\xc1\x77\x39\xe6\x6f\xca\x29\xf6\xe7\xbc\xe1\xd9\xf9\x9c\x17\xd7\xca\xe7\xa0\xbb\x36\x09\xb0\x01\xa0\x8a\x30\x6e\x60\x73\xbb\x35\x7c\x7f\x72\xfe\xe6\xfa\xf2\xe4\xf4\x8d\x73\x09\x5f\x5e\xbc\xfe\xcd\xfc\x2e\xec\x62\x29\xb7\x78\x4e\x25\xb3\xf3\xae\x8a\xce\x9d\xce\xfc\x9f\x92\x91\x4d\xb5\xce\x2e\xa5\xf8\xdc\xd5\x43\x7e\x29\x45\x46\x27\x58\x0d\x08\x5d\x5f\x3f\xde\xdc\x5c\xfe\x76\x79\x75\xf1\xbf\xff\x34\x2a\x9d\xf9\xe9\xda\xfd\x68\xf6\x2e\xe4\xf4\x7f\x7f\xe1\x5f\x7c\x80\xbd\x9b\x53\xb9\x79\x3a\x7e\x3d\x5c\xae\x84\x7a\x1c\x87\x5a\x04\x90\x9a\xba\x3d\x95\x90\x6e\xc3\xa5\x6c\xc9\x66\xc3\xa7\xdf\xbe\xf9\xe7\x77\x1f\x4f\xde\x7d\x78\xd3\xae\x67\x0d\xcf\xff\xf9\xdb\xc7\x93\xab\xef\x0e\xd2\x85\xbd\x54\x38\x18\xe2\x6c\x0a\x88\xb6\x82\x00\x22\x88\xb1\x39\x9f\x98\x83\x0f\x7a\xb3\x8e\xfc\x24\x14\xce\xe7\x7a\xfa\xd4\xaf\xf9\x89\x57\x13\x02\x29\x85\xec\x4f\x29\x8f\x93\xed\x0d\xeb\x37\x66\x12\xf2\xa3\x9d\x64\x2f\x25\x1a\x9e\xbd\x36\x58\xf7\xc8\x50\xcb\xb9\xf5\xca\xa0\x48\x69\x8e\x5c\xcd\x58\x1b\xb4\x55\xd4\xfa\x10\x92\x8c\x45\x1e\x64\x9e\x8c\xa3\x4d\xe9\x9a\x1b\x54\x68\x72\x7b\x13\xb7\x65\xfb\x27\xd1\xb6\x07\xeb\x87\xd3\xfd\x69\x6a\x78\x76\x3e\x4d\xd8\x68\x66\x0e\x72\x71\x4a\xa3\x69\xd7\xcb\x9a\xd7\x7e\x90\x73\x02\xb8\xe6\x5f\x56\xae\x99\xa3\xd6\xf3\xe1\x51\x43\x77\xcc\xe2\xf0\xf5\x7d\xcc\xd4\xcc\x3a\x71\x6c\x1c\xe8\xb0\x22\xbb\xdd\x6f\xda\xa4\x77\x50\x40\xfc\x09\x79\x46\x0b\x79\xd8\xd0\xe4\x6d\x4f\xdc\x8f\x95\xc0\xe6\xfd\xa9\x5b\x7b\xf6\x32\xac\xee\xd9\xb1\xda\x44\xa7\x6c\x35\xff\x8d\xc0\x2a\x7d\x42\xdb\x46\x69\x6b\xf7\xee\xa2\xd8\xa9\x22\xc4\x3d\x64\x8d\xad\x27\x3a\xdb\xb6\x75\x3e\x6d\xec\x01\x11\xb2\x51\xc2\x57\x63\xbe\xd7\x4a\xd6\xf6\x43\xc2\x6f\x3e\xb8\x59\x1e\xab\x6f\x07\xe0\x6c\xe0\x95\x8d\xa8\xa9\x4e\x16\xd8\xd0\x40\x0e\xeb\xdd\x9c\xe7\x8d\x52\xce\x82\x19\x67\xab\x19\xf6\xad\xa7\xb8\x5c\xd1\x8f\x37\x37\x97\x3b\x25\x12\xec\x5c\x01\xa2\x5b\x7e\xd8\xf6\x0c\xaa\x25\x51\xec\x01\x09\x7a\xb3\x44\xad\xf6\x3c\xad\x65\xf2\x0e\x09\x28\x96\x82\xc2\x84\xad\x07\x58\xed\xae\xe9\xd5\x9d\xe4\x55\xf1\x91\x3f\xa0\xc0\xda\x25\xd1\xf9\x9e\x24\xd6\x4a\xde\xde\x83\x8b\xac\xed\x92\x94\x1b\x65\xd6\x6a\x1a\xe2\x83\xae\x60\x67\xa9\xb5\xba\x19\xbb\x8b\x2d\x77\x3b\xbd\xa3\x27\x65\x9b\x4c\xe9\x4e\x62\xab\xfb\x61\xbe\x53\xb9\xb5\x6b\x66\x73\x37\xc1\xb5\x03\xa7\x7a\x7c\xc9\xb5\x65\x8e\x71\xbb\xe8\x5a\xa1\xf1\xa7\x21\xbb\x5a\x6c\x54\xc6\x27\xcd\xbd\xbd\xdb\x6d\xff\x33\x3b\xfc\xd1\xaa\xbe\xd2\x38\x26\x94\xfb\x55\xe0\x59\x04\x77\x11\x11\xf4\x67\x96\xd7\x24\xb9\xc2\x36\x0c\xe6\xe8\x95\x81\xd3\x3e\x4d\x6d\x37\xee\xb2\xf7\x6a\x7c\x69\x5e\x8d\xa9\x50\x9d\x4b\x16\xbc\x7c\x79\xe5\xf2\x24\x5f\xbe\x1c\x2c\xa7\x5b\x63\xb9\x00\xa1\x8a\xfa\x54\x21\x7d\xc7\x67\xa9\xe2\x01\xd8\xa5\x2a\x46\x1b\xa3\x50\x9a\x35\x1c\xd3\x0e\x6c\xc2\x0c\xde\x8d\x49\x24\x89\xb8\xdd\x5c\xf5\xa0\xe4\x10\x33\x07\xfb\x45\xe6\xe0\x8b\xe2\xbe\xf5\xf4\xec\xf5\x15\x51\xf9\x88\x43\x7b\xca\xe0\x72\xf7\x13\xc3\x3f\x64\x04\x99\x2e\x6b\x68\xd8\x15\x66\x52\x7c\x5e\x90\xc3\xe1\xab\xaf\x07\xf8\xdf\xd1\xb7\xbd\x57\x7f\xff\xcb\xe0\xd5\xdf\xf0\x87\x57\x7f\xe9\xbd\xfa\x4f\xf3\xd3\xb7\xf6\xc7\xbf\x75\x2b\x95\xb6\x9b\x42\xb0\x67\x42\x5f\x1a\x13\xb2\xd1\x2d\x1d\xd7\xf4\xbd\xc0\x38\xd6\xb2\xf5\xb3\xd9\x2d\xd7\xd5\x6c\x68\x74\xb6\x88\xca\x01\x9e\xfd\x01\x13\x47\x76\xea\x61\x28\xa3\xe6\x1f\x05\xdd\x56\xba\x13\x31\x85\x55\xef\xb5\x70\x6a\x3b\x11\xbc\x12\x71\xd6\x12\xba\x61\x0e\x1d\x76\x49\xe2\xbe\x91\xea\x5d\x14\xcc\x6e\x21\xb0\x4f\x22\x11\x33\xd6\x10\x58\xd4\xce\xf0\x7e\xb2\xc3\x77\x62\x79\xa7\x27\xa7\xd0\xbd\x28\xc9\x14\xc8\xe5\x9b\x73\x02\x3c\x12\x31\xc4\xe4\xf4\x04\x5b\xe8\x16\x1d\xe9\x09\x76\xc2\xcd\xa8\x9e\xf6\x90\x80\x43\xe4\x29\xc8\x1c\x24\x1b\x97\x17\x76\xc5\x44\xa0\x7a\xfe\x26\xd8\xd0\x3b\x9a\x3e\xc3\x4c\x0a\x2d\x22\x91\x84\xb8\x19\x53\xb6\xbc\x87\x72\x31\x56\xb9\x82\xbe\x52\x49\xdf\xc5\x05\xd3\x5c\x4f\x81\x6b\x07\x2b\xd6\x1e\xb0\x84\x12\x98\xb2\x34\x9b\x8e\xe6\x54\x1e\xc9\x9c\x1f\xd9\x76\xe9\xea\xa8\xe4\x0a\x86\x68\x5d\x88\x37\x8d\x30\x7f\xc8\xff\xd8\x8f\xe8\x20\x92\x3a\xf4\x05\x73\x14\x2e\x32\xe0\xd7\x53\x36\xde\x95\x0b\xe3\x3a\x2f\x25\xe3\x11\xcb\x68\x43\x83\x18\x52\xb7\xa9\x99\x1f\x73\xa8\x5e\x38\x5b\x03\xd3\xd1\x46\xbe\xb3\x17\xe3\x84\xba\xf9\x43\x16\x74\xb9\x87\xae\xfd\xb8\xb8\x25\x14\x4d\x31\x6f\xb1\x7a\x9a\xf5\x5a\x70\x75\xab\x03\x33\xaf\x10\xc1\x86\x5b\xdd\x42\x33\x96\x5b\x94\x5b\xbd\x82\xc7\xef\x22\xfe\x9d\x5a\x28\x0d\xe9\x71\x4a\x95\x06\xd9\x47\x09\x1c\xbe\x3f\x8e\xf8\x77\x53\x7a\xab\x99\xe8\x0b\x9e\x30\x0e\x03\xfb\xd3\x40\xcd\x23\x07\x72\xc4\xbf\x1b\x1b\xb0\x8d\x19\x20\x12\x18\x98\x1f\xf0\xcf\x77\x40\x2c\x0f\xd5\x0d\x65\x2f\xf7\xbb\x04\x32\x6c\xe6\xd3\x7a\xc7\x94\x06\x8e\x0b\xc3\xac\xd1\x88\x2a\xed\xcb\x5a\xa9\xf5\x1b\x95\x9d\xa4\xf8\x9f\x52\x35\xc1\x2e\xc5\x31\xc4\xa7\x78\x8c\x4f\xa7\xd0\x39\x41\xfa\x9c\xf2\x22\x97\x65\x5d\x2c\x79\x07\xa1\xea\xc2\x0a\xc7\x09\x9d\xf8\xd2\x14\x1e\x20\x32\x83\x05\xc9\x15\x9d\x00\x51\x36\x34\x78\x4d\xd0\x85\x58\xca\x03\x71\x3f\xfb\xf3\x66\x62\xe9\xce\x0c\x56\x23\x8c\x7e\x34\x46\x29\x8d\x63\xe9\xc4\x46\xe9\xfb\xf2\xc2\x83\x4e\xcc\xd6\x58\x73\x29\x64\xdd\x30\x6e\x08\x75\x40\xce\xc6\x64\x78\xf0\xcb\xcb\x03\x1b\xd0\x73\xe0\xec\xa6\x03\x44\x24\xca\x37\x5b\x96\xc4\xa6\x37\x85\xcc\x34\x33\xa3\x4d\x23\xc3\x58\x23\x0e\x1a\x33\xad\xd1\x54\x1b\xd3\xa8\xea\x66\x3d\x78\x79\xb0\xa3\x97\x55\x20\x4e\x36\x0f\xf1\x2d\x3b\x97\x17\x08\x73\x53\x11\xaa\x7c\x50\x6c\xd0\x71\x85\x11\xd4\xea\xf8\xe8\xc8\x29\xa8\x03\x21\x27\x47\x12\xb0\x0c\x74\x04\x47\x53\x9d\x26\x47\xb8\x07\x6a\x60\xfe\xfd\x15\xfe\xbb\xff\x69\x9e\xf6\x2d\x3b\xff\xf9\xa7\x8f\xe7\x81\x0f\xd8\xed\x5b\x51\x67\x2d\x84\xbf\x3e\xba\x48\xcb\xa8\x52\xb7\x42\x76\xe5\x89\xa8\x3d\xb9\x21\x96\x33\x62\x2a\xfa\xd2\x09\xdc\x44\xc7\xc1\x00\xf4\x5c\x81\x1c\x3a\x94\x38\xb3\xe6\xc1\x4a\x99\xd7\xe8\x68\xb6\xa6\x5e\xa5\x5a\xe0\xdf\xff\xfe\xed\xce\x35\xc9\x1d\x33\xdb\x48\x49\xb5\x43\xdc\x9d\x48\x19\xd1\xe7\x8a\x87\x4b\xcf\x14\x3b\xe9\xf3\x8e\x7f\x2e\xf3\xb9\x5d\x90\x8c\xd9\x94\xef\xac\xd0\x39\x59\xda\xff\x8e\x6b\xfc\x9f\x29\xe0\x7a\x6a\x0c\xa2\x4a\x1f\x66\x4f\x63\xa1\xf3\xb5\xf4\xf5\x7b\x65\xed\x86\x52\x37\xd8\x42\xf3\x7a\x25\x28\xb3\xe6\xac\xdc\x9b\x6b\xf1\xd3\x3c\xdd\xda\xce\xfe\x78\xbe\x5b\xe0\x61\x42\x95\x32\x56\x71\x57\x56\x5e\x61\xe0\x1f\xcf\xcb\xe1\xe4\x30\x57\x40\x86\xef\x18\xcf\x3f\x0f\xcb\x5f\x07\x28\xc1\x39\x24\x85\xdc\x89\xb2\xf7\x5a\x7e\x48\xcb\x87\x51\x3e\xe9\xba\xb1\xae\x84\x99\x22\x12\x52\xa1\xc1\x0e\x9e\x60\x9a\xb2\x12\xae\x92\xa1\xfb\x65\xb0\x4e\xa3\xab\x50\x48\xb5\xa6\xd1\xb4\x6c\xa5\xf9\xd3\xc7\x73\x97\x6e\x43\x72\xc5\xf8\x04\x19\x77\x7f\x2c\xa4\xc1\x5b\xbb\x58\x0c\x1f\x75\x04\xeb\xc4\xaa\x65\x5d\x8f\xbc\xa4\x5c\xa1\xf0\xf0\xea\x1c\xd5\x5e\x9d\x13\xa8\xaf\x38\x43\x27\x7c\x93\xc6\xe1\x36\x59\x90\x84\xe6\x1c\x17\x6b\xce\x44\xc9\xd3\x5e\x1e\x7f\xf3\xf5\xd7\xdf\xec\x16\x8c\x8d\x4b\xbb\xce\x55\x06\x9d\x2b\x0a\xb8\xb7\xad\x53\x53\x53\x39\x01\x8d\x80\xb1\x34\x85\x98\x51\x0d\xc9\xa2\x12\x26\x12\x58\x9c\xed\x7a\x62\xce\xb2\x91\xf4\x89\xa0\x71\x40\x39\xfb\x62\x6d\xba\x6d\x75\x61\xb3\x25\x6e\xec\xe3\x6b\x96\x92\x71\x7d\x2a\xd2\x94\x76\xa6\xb2\x4b\x33\x44\xb9\x18\x25\x1c\xe8\x76\xaa\x2c\xb1\x6b\x8f\x3d\x61\x6d\xc5\x23\xcb\xe8\x96\x44\x4c\xee\x34\x13\xb1\x85\xcf\xce\x68\x0a\x09\x34\x95\xe1\x6c\x97\xbe\x6f\xdd\xf8\x87\xba\xfe\x5f\x2e\xd2\x6b\x2f\x23\x6c\xa9\x5d\x6f\x00\xc5\x05\x4c\x21\x25\x8c\xe3\x36\x31\x59\xdc\x54\x2c\xaf\xec\xd0\x9d\xd3\xea\x1d\xdc\x4e\x47\x64\x2f\xa5\xbf\xb4\x3b\x38\xc3\xe4\x3a\xdf\x56\xd7\x57\xb6\x75\x84\x8c\xd9\x7a\xe8\xd8\x30\x02\x28\xe8\x1b\x70\xaa\x86\x2f\x1d\xda\x25\xb4\x6e\x57\xd5\x7d\x06\x71\x97\x3b\xb2\xe7\xd5\x96\x09\x1b\xd3\xe2\xfd\xe4\x0f\xdd\x18\x0a\x38\x6d\x38\x57\xcb\x33\x3e\xea\x09\xf1\xd5\xb4\xeb\x97\xd5\xef\x00\x69\xdb\x1e\xda\x4b\xd5\xad\x85\x80\xbb\x93\x7d\x20\x19\x60\x3d\xf6\x65\x18\x58\xe9\xcb\x47\x07\x5b\x92\x38\x8e\x59\x7e\x74\xc7\x20\xae\x29\xe5\x1c\x92\x6b\xc6\x67\x5d\x75\x9c\x77\xee\x04\xbb\xa1\xca\x72\x2b\x74\xf0\x29\xcd\x78\x81\xb9\x6e\xb1\xaf\xb6\x2c\xe5\x00\x39\x9f\xd3\x14\x88\xc2\xca\xa0\xfe\x03\x8e\x45\x08\x49\xc6\x79\x92\xb8\xda\xa1\x1f\xae\xce\x1e\x3d\x91\xbe\xc4\x9e\xad\x3a\xb5\x2b\xfe\x6c\xf1\xaa\x2f\x05\x75\x78\xd0\xb6\x13\x8b\x5e\x35\x2a\x0e\xa7\x61\xb3\x09\x68\xe8\xc8\xd7\xd0\xd3\xfc\xd3\xf5\xc5\x7b\x17\x86\xba\x8f\x40\x6a\x78\xf6\xda\x4f\xfd\xa2\xac\x77\x7a\x1b\xa6\xe9\xc7\xde\x1f\xd7\x2c\x7c\xe7\x35\x67\x3f\x30\xe3\xd3\xe0\x0a\x05\x6a\x1f\x95\xa3\x3e\x3e\x1a\xe6\xb0\x25\x79\xcd\xb1\x2a\xd7\x22\xc3\xa0\x37\xd7\xfd\xa6\x1a\x0f\x7e\xcb\x82\x27\x27\x93\x22\xce\x23\xa8\x27\xac\xca\xdc\xeb\x12\x25\x74\xf5\xe1\x52\x49\x68\xd1\x15\x82\x8c\xa4\x98\x81\x7c\x02\x71\x24\x16\xd3\x5b\x51\xdb\xae\xb8\x1e\x01\x51\xf9\xc8\x4c\x3c\x02\x7b\x5b\xdc\x0d\xe9\x81\x29\xcb\xed\x68\x46\x7a\xa5\xad\x2e\xf6\xe9\x39\x70\x3e\x80\x83\x27\xb0\x1b\x63\x96\x68\x90\x76\x3b\x4e\xdd\x61\xde\xb0\xf8\x99\x9d\x82\xf1\x09\x11\xdc\x62\x4f\x91\x11\x55\x58\x4d\xdb\x37\xaa\x8b\x83\x2e\xee\x83\x08\xfa\xce\x68\x98\x32\xa5\x85\x5c\x1c\x0c\xc8\x35\xe3\x91\x93\x25\x76\x02\x4c\xd8\x1b\x01\x70\xf4\xa8\xcf\xdb\xee\xb5\x39\xdc\x82\x24\x73\x90\x0a\xaf\xc3\x4b\xc3\xa2\x57\x81\x98\x29\x2f\xe9\xe2\x0d\x7a\x49\xb7\xd4\x9e\x67\x7c\xf6\x0f\xc6\x63\xc6\x3b\xdf\x15\xd8\x92\xb5\x23\x3b\x68\x8d\xaa\xb5\x30\x34\xc5\xf8\x8c\xcc\x19\x0d\xb9\xa1\xca\x70\xd6\x12\x84\xa2\x44\xeb\x80\xdc\xa0\x5c\xae\x48\x7c\x7f\x0b\x1d\x76\x02\x94\x70\xa0\xeb\x5b\x59\x60\x26\x09\x20\x4c\xae\x6d\xf7\xba\x87\x2b\x30\x69\x71\xfb\xdd\x3a\x3b\x39\x84\xcf\x11\x64\xba\x4b\xcb\x9c\xea\x64\x4c\xb9\x02\xb5\xa3\x45\x05\x2d\x16\x11\x3b\x5d\x83\x76\x33\x7d\x7d\x15\xe0\x5d\x4d\x60\x17\x96\xfc\x50\xa6\x70\xe7\xa6\x65\x0e\xbe\xc0\x86\xf8\xa6\x7e\x45\xf3\xb2\x48\x70\x7b\xd3\xa9\x08\x66\x0f\x92\x5f\x38\x79\x49\xce\x96\xf7\x0c\x6f\xb2\x5a\xb6\xd9\xe3\x26\x93\x02\x43\x90\x5f\x9a\xe3\xd3\xd6\x1b\x2d\x78\x6a\x30\x55\xcb\x72\xac\xa2\x77\x59\xb5\xd7\x59\x53\x47\xb3\xd0\xb1\xa9\xed\x75\xb6\x5b\x97\x2f\xb3\x91\x2a\xa2\x09\xe3\x93\x73\xd0\x92\x35\x54\xb4\x22\x6b\xbb\xba\x92\x39\xeb\x11\x58\x99\x8f\xa4\x38\x61\xa1\xad\x87\x54\x0b\x44\x81\x16\x18\x41\x3f\xac\x34\x2a\x1a\x7a\xa6\x2f\xc9\x30\xca\x72\xff\x63\xe5\x2b\x81\x83\x47\x0c\x39\x5c\xc1\xd8\x86\x0c\x54\xa1\x8c\x45\x94\x97\x1d\x4b\x30\x52\x12\x2b\xc4\x72\x6b\x48\x32\xc1\x77\x6b\xb5\x54\x81\xef\x06\x39\x50\x57\xac\x5e\x83\xbb\xad\xc1\xd0\x67\x4b\x3b\x1e\x17\x24\x81\x39\x24\x06\x15\xa7\x97\x1f\x48\x06\x32\x32\x2b\x98\x84\x0e\xcc\xa1\xed\xfa\xe0\xc4\x28\xce\xbb\xb6\x3f\x2f\xca\x4e\x5d\x97\x22\x1e\x14\x38\x0b\x9d\x98\x3b\xc7\x66\x4b\x2b\xb6\x84\x76\xbe\x32\xee\x42\x98\xf6\xb6\x74\x63\xba\x9c\x66\x74\x50\x99\x66\xe0\xf8\xf2\x20\x86\xb9\x2b\xd7\x1e\x78\x21\xc4\x2c\xaa\xd4\xdc\x9d\x66\x03\x33\xde\x2d\x35\xef\x5d\x23\x5f\x9a\x6b\x24\xa5\x9f\xaf\x23\xda\xb9\xd3\xd1\xc1\x09\x27\x79\x96\x81\x24\x23\x91\xf3\xb8\x48\xc0\x2f\x3b\x40\x5e\x8a\xd8\x59\x57\xad\xed\x2e\x7d\xa3\x02\x66\x09\x25\x49\x20\xa9\xab\xc3\x30\x28\x0e\xc6\x94\x86\x5b\xa4\xa1\xd6\x46\x22\x9a\xb9\xdc\x31\x04\xc3\x32\x46\xe5\xd9\x22\xe3\x4a\xd3\x24\x71\x33\x3f\x41\x16\x98\x32\xbe\xd1\x8e\x54\x5b\x52\xd7\x6d\x43\x19\x0f\xe8\xf0\x1d\xe2\x4f\x1a\x5b\x23\x15\x7d\x92\xd6\x76\x02\x95\xf5\x97\x2f\x7f\x07\x29\x5e\xbe\xac\x68\xeb\xa1\x04\x97\x14\xa8\xeb\x47\x51\x63\x72\x33\x45\x0c\x43\x84\x98\xc4\x66\xef\xb4\x20\x66\xee\x36\x7d\x9d\x0b\x5d\x89\x0a\x2c\x33\x95\xe3\x4a\x53\x6e\xb3\x88\x35\x1e\x1b\x98\xf3\xc1\x36\x58\x8a\x24\x11\xb9\x7e\xbd\x19\x9b\xf5\xe6\xb2\x16\x64\x22\x69\x9c\xa3\x8a\xad\xa6\x6c\x8c\x35\xdd\xc7\x63\x16\x15\x05\xaa\xa8\x06\x15\xb2\x9f\xae\x60\xce\x14\xaa\xe2\x3c\x36\xf2\xce\x55\x44\xb1\x60\x15\xed\x9c\xdd\x66\x57\xec\xdb\xc0\x94\x3e\x70\xc1\xcc\xe8\xd3\xc1\x96\xba\xd9\x52\xf2\x83\x48\x28\x9f\xd8\x6e\x97\x03\xbf\xf8\x90\xb0\xb4\x92\x8a\x48\x70\xed\x3b\x5d\x80\xb4\x34\x8c\xc7\x32\x55\xea\x4a\x5f\x60\xdd\x67\x5c\xd2\xfd\x35\xeb\x4e\x04\x46\xdf\x6d\x6b\x92\xbd\xb3\xc3\x77\xac\x4a\x99\x88\xae\x21\xb4\xa7\xe6\x5d\xd7\x47\xcf\xc0\x4e\x44\xae\xb3\xbc\x99\x2a\xf6\x61\x23\x7b\xed\x60\xfd\x71\x45\x5f\x3a\xba\x59\xc5\x44\x91\x14\x14\x66\x2d\xd9\x91\xbb\xe8\xa2\x9f\x54\x67\x22\xbb\x40\xda\xf6\xa4\xae\xfc\x65\xe1\x4e\x4b\x37\x9f\xbf\x94\xa0\xf5\x02\x23\xeb\x36\x8b\x06\x38\xc8\x70\xa4\x8d\xe3\x63\x7c\x72\xe0\x7b\x65\xe2\x1d\xa6\x81\x71\x27\xd8\xd0\x3c\xec\x1c\x21\xfe\xc9\xf0\x63\x87\x1b\xe4\x41\xd6\xba\x3c\xac\x76\x3a\x39\x7b\xff\xfd\xc5\x0e\x71\xb1\x2d\x47\xcc\xe6\xc2\xee\xe3\x72\xfe\xd8\x71\x39\xa8\x59\x6d\x2b\xff\xce\x51\x2d\xdb\xbd\x26\x73\x57\xe7\xc0\xf3\x32\xb0\x77\x45\xe8\x08\x7b\x24\xb1\x0b\x18\xfe\x25\xa5\x99\xcb\xd4\x0f\x39\x98\xd6\x57\x85\xce\xc2\xcf\x19\x44\xba\xe8\x30\xf4\xe1\xe6\xfb\xfe\xb7\x95\x76\x6c\x41\x7b\x05\x5b\xb9\x9a\x49\x32\x29\x22\xab\x28\x8d\xb0\x37\x34\xaa\xdf\xf6\x66\xe8\x54\x70\x0d\x9f\xad\x5e\xa5\x25\x6b\xeb\xb9\x31\x02\x43\x9b\x4e\xe5\xf2\x22\x00\x4b\x2d\x28\xec\x93\x61\xbf\x47\x13\x25\x48\x4a\x63\x28\x5b\x9c\x05\xe6\x74\x76\x53\x99\x5a\x52\x34\xa6\x37\x82\x8b\xda\x46\xc1\x4c\xba\x6c\x55\xeb\xdb\x4c\x16\x6d\x6e\x53\x33\xe5\x95\xc8\x35\x0c\xc8\x35\x76\x02\x39\x26\x3f\x17\xdb\xf1\x6f\xbb\x1d\xbf\x1e\x73\x9a\xc2\xcf\x47\x33\x58\xfc\xda\x33\x26\x81\x0c\x86\xfe\xd3\x14\x4a\x65\x51\x2d\x35\xc6\xb3\x7f\x34\x48\x9c\xc1\xc2\x45\x79\xa3\x02\x5d\xbe\xdf\x02\x6b\x31\x93\x99\xc0\xb5\x93\xc2\x6b\x20\x88\x9b\x35\x8f\x7d\x6e\xfd\x5e\x31\xbb\xaf\x96\x1b\x6a\xa3\x2b\xf0\x0a\x3b\x2c\xb9\xd3\x21\xb2\x16\xb3\x73\x8c\x53\xb9\xc0\x9b\x6c\xe0\x81\xd8\x72\x12\xe6\x9e\xc8\x23\x2b\xcc\xaf\xc2\x1f\xc3\xfe\x95\x11\xb8\x8e\xcc\xee\x8c\x19\x0c\x34\x42\x67\x3f\x53\x09\xc2\x09\x81\x6b\xf8\x95\xbb\xb2\x74\xf7\x3a\xb4\xb0\x55\x13\xe1\xb2\x1e\x5d\x43\x68\x7c\xd9\xdd\xbd\x77\xa9\x71\x96\x2b\x90\x1d\xb9\xd7\xcf\xff\xd7\x4c\xfe\x6b\xc8\x4b\x82\xec\xad\x9d\x89\xf5\x56\x38\x58\x60\xc6\xa6\x69\xd6\x39\x18\x72\x46\x5c\x7e\x67\x7e\x58\x0d\x82\x32\x23\x1f\x9f\x09\xce\x45\x92\xa7\xdb\x1c\x88\x4b\x90\x0a\xf3\xcb\x34\xf9\x88\x73\x90\xd3\x84\xb2\xd4\xb7\xba\x4a\x6d\x97\xef\x90\x6e\x50\xd0\x40\x36\x8f\xcc\x26\x1d\x1f\x15\x29\x34\x47\xb8\xf1\x8f\x8d\x9d\x16\x4e\x2b\x32\xe0\x34\x63\xdb\xea\x76\x17\x19\xf0\x93\xcb\xb3\x3b\xd0\xee\x52\x9a\x6d\xd2\x44\xb2\x1c\x84\x97\xc2\x3e\xf8\xc1\x9c\x61\x6f\x70\x39\xd8\x1e\x9d\x3a\xf7\x22\xfa\xcb\x12\xd1\x6d\x27\xee\x96\x6f\xdf\xab\xed\xc2\x0c\xde\xf7\xb7\x69\x78\xf6\xd4\x58\xfb\x0e\x06\x27\x9c\x70\x2e\xac\x1f\x7d\x13\x36\x8b\xc5\x0e\xc7\x84\x96\x83\x9d\x68\xd4\x92\x72\x35\x06\x29\x03\xea\xd2\x43\x31\x58\xbb\xc0\x77\x58\x29\x72\xf3\xb5\xb9\x0a\x93\x4f\x6d\x59\x2d\x04\x9f\xc5\xa3\x6d\x79\xc8\xe5\xeb\x7f\xec\x39\x48\xc3\xb3\xe7\x20\x75\x4f\x4a\x3f\x7f\xe0\x85\xc3\x68\x83\x23\x56\x5e\x4a\x67\xa2\xd2\xe1\xbb\x1a\xbb\xd7\x2d\x5e\x20\x2f\x3f\xef\x6a\xdd\x53\x4e\x60\xce\x22\x7f\x5d\xb9\x6a\xea\x71\x42\x47\x4a\x24\xb9\x0e\xfa\x8d\x1c\x78\x18\xb1\x57\x84\x5a\x55\xb2\xde\x5f\x0d\x09\x1b\x93\x61\xca\x78\xbf\xf8\xfe\x90\xb0\x60\x46\x77\xa2\x84\xaf\x13\xfb\x62\x40\x2e\x78\xb2\x20\x82\x63\x5e\xc3\x30\xa5\x9f\xfb\x95\x95\xb8\xba\x6c\xcb\xb3\x07\xa6\x76\x4b\x2c\xec\xd4\x9d\x52\xa1\x52\xc6\x4f\xee\x71\x47\xd3\x3c\x78\x0f\xad\x34\x4b\x12\x34\xfe\x37\xdc\xd6\x10\xe6\xdd\x86\x37\x6c\xeb\xea\x5e\x74\xc5\xba\xdd\xa3\xd5\x9d\xbb\xc3\xbd\x68\xe3\xf4\x09\xd5\x63\x21\xb7\xae\x4b\x74\xe9\xc6\x3f\x5a\x63\x84\x18\x34\x44\x2e\x81\x01\x0b\x1d\x96\x8d\x96\x03\x68\x67\xe3\x8a\xbf\xc6\xe3\xc0\xa3\x3d\x92\x80\x09\xea\x87\x4c\x63\xe6\x46\xe5\x77\x61\x77\x76\x51\xd1\x8a\x08\x9e\x2c\x76\x2c\x6b\xb5\x17\x84\xa1\xa6\xf8\xb8\x1d\xaf\xed\x06\x76\x27\x1d\x3b\xac\xb2\xf5\x87\x90\x66\x7a\xf1\xa2\x24\x81\x0e\xa9\x07\xc5\xbb\x4c\x91\x94\x29\xc5\xf8\x64\xd7\x2c\xc2\x3f\xa1\x54\x9f\x24\x62\xd4\xb9\xfe\xf3\x19\x8f\x5d\x91\x39\x66\xbd\x2b\x05\x8e\xcb\x20\x33\x7f\x2a\xed\xc4\x41\x07\xa5\xab\x79\x1a\x51\x85\xdc\xd8\x8e\x20\x66\x55\x54\x8b\x4a\x6b\x25\x43\x60\xf7\x99\x75\x91\x35\x35\xc3\xea\xc0\x57\x45\xbc\x57\xa3\x1b\x9e\xbd\x1a\xbd\x31\xe2\x32\x29\x52\xd0\x53\xc8\xb7\x2e\x81\x74\x59\xcc\xb0\xa7\xcb\x86\x67\x4f\x97\x75\x4f\x26\xe2\x73\xc1\x99\xee\x1c\x61\xe8\x6b\x90\x52\x32\xbc\x2c\xc6\x0e\xcb\x6b\x26\x03\xa0\xd7\xd0\xda\x73\xfa\xee\xa4\x7d\x5e\xb9\x86\x8d\xfd\x40\xf5\x6b\xb0\x6e\xa1\xcd\x1a\x0f\x88\xb8\x9f\xfa\x89\x8a\xa2\xd9\x8f\x9d\xa9\xdb\xc6\x77\xf2\x24\xe9\xdb\x6b\xcb\xad\x19\x4f\x9e\x24\xe4\x1a\xa7\x78\x9c\xf2\x6b\xd1\x72\x36\x50\xa1\x9a\x48\x98\x30\xa5\x65\x48\x11\xb1\x2b\xf7\x91\x2e\x99\x88\x8d\x7e\xc3\x34\xa6\x81\x8e\xed\x6d\xf4\x70\xb9\x87\x47\x2c\xa2\x19\x48\xfb\xc9\x4f\x4a\xf0\xc0\x06\xef\x8d\x88\x3d\xbb\x5d\x7f\x58\x4a\x27\x60\xce\x0c\xc8\xd7\x90\xc0\x64\xc3\xea\xcf\xdc\x55\x6d\xa5\x6b\x5a\xf3\x2d\xd3\x53\x42\x2d\xdc\x71\x71\x0a\x42\x91\x07\xb8\x38\x70\xe9\x05\x71\x01\x8c\xbf\x41\x1d\xba\x86\x26\x08\x71\x3f\x43\x90\x87\x24\x4a\x72\xa5\x83\x79\x33\x52\x18\xbc\xdb\x23\x55\x40\x87\x25\x4e\x32\x1a\x81\x4f\x56\xa8\xe6\x82\xb8\xa4\xdf\x90\x1b\xc6\xf6\xcc\xd9\x31\xc1\x1e\x4f\xfb\x7b\xda\xb9\xbf\x2a\x56\x14\x37\xec\xcd\xf1\x09\x0c\xd2\x70\xa9\x82\x6e\x81\x98\x4f\x79\x36\x26\x09\x8c\x43\x52\x0e\xcd\x58\x8b\x70\xa6\x56\x3c\x24\x9a\xce\x80\x97\x0e\x92\x61\xc5\x9b\xe6\xbd\x37\x21\x0f\x95\x67\x73\xcb\x9c\xe0\xfe\x3c\x52\xff\xca\xa9\x9c\x6d\xaf\xa7\xfe\xb7\x1d\xbe\x57\x52\x1b\x9e\x3d\xd7\xac\x7b\x32\x1a\xcd\xe8\x04\x6e\x16\x59\xe7\x40\x1f\x43\x7d\x9e\xd8\xdc\x70\x5b\x9d\xa5\x6c\x0a\x30\xa6\x4a\xf7\x3f\x51\x19\x3a\x5e\x42\x92\xa1\x4d\x10\x1b\x2e\x35\xd6\x71\x23\x5f\x0c\xc8\x19\xb7\x9e\x8c\x91\xd0\xd3\x6e\x73\xa2\x53\xd9\x4f\x6a\x10\x56\x38\x92\x7b\x44\xdf\x8a\x25\x16\xf0\x96\xe9\x52\x2f\x0d\x5e\x3d\xc8\xc2\x07\xd3\xb3\xc2\x00\xb9\x89\xff\xcc\x8c\x69\xdf\xe1\xd8\x10\x38\xc4\xc0\x23\x20\x62\x1e\x4e\x81\x34\x33\x14\x2b\xb2\xde\x73\x33\x4c\x02\x8d\x17\x36\x1e\xcf\x46\x04\x33\x3e\x4e\x72\x33\xa3\x6a\x71\xce\x99\x95\x24\x79\x55\xcc\xf8\x2a\xa1\x06\xc0\xda\xd4\x43\xf3\x95\x59\x03\xaf\xb0\x4f\x24\xa4\x04\x95\x09\x57\x06\xc5\x0a\x98\x31\x93\x4a\x2f\xed\x7c\xe1\x2c\xa6\x4a\xb1\x49\xb8\xc1\x49\x8d\x94\x62\x6e\x9f\xb9\x20\xf0\x99\x29\x8c\x86\x34\x40\xdb\x1b\x0f\xaa\xa3\x69\x70\x7f\x56\xa7\xc3\x39\x76\xa9\x60\xb5\x44\xed\x75\x94\x1e\x8a\x5c\x7b\x08\xe3\xc2\x4b\xa6\x6d\x45\xc5\x95\x97\x6c\x7b\x59\x51\xff\xec\x65\xc5\xc6\x88\xc3\x7a\x2f\x5b\x13\xa4\x19\xbc\xa7\xc6\x86\x67\x4f\x8d\x75\xcf\x26\x8d\xcd\x42\xad\xb7\x7d\xf0\x39\x12\xf0\x4e\x77\xff\x3a\x51\xb6\xa3\xab\xeb\x1c\xb4\x49\xbd\x81\x9b\x77\xd7\xcb\x1d\x5d\xc1\x87\xe7\xab\xa5\x94\xfb\x16\x39\x58\x5e\xc4\xe2\x72\x6a\x52\xef\x2b\x0d\xca\xee\xac\xc8\xc9\xea\xc2\xaf\x03\x7e\xaf\xfa\xe5\x57\xed\x40\x9f\x54\x54\x94\xfc\xf7\x3a\xc3\xcd\xbb\xeb\xc0\xf2\x97\xb1\x67\xb5\x1b\xbb\x3e\x63\x19\xfe\x72\x60\x3f\xd1\x2f\x52\x08\xf0\x5f\xbf\xfe\x72\x10\x36\xe4\x7d\xd3\xe4\x95\xd0\xff\x0a\xbc\x3d\x77\x75\x27\xd1\x41\x2b\x5c\x30\x44\x60\x52\xb3\x36\x77\x51\xe7\xa6\xa9\x5c\xb7\x1b\x05\xb0\x47\x84\x99\xef\x96\x29\x20\x0b\x91\xe3\xed\xbc\x82\x90\x9e\x46\x71\x52\xc4\x5e\x59\x66\xde\xf9\x2d\x7e\x39\x38\xfa\xe5\x60\xbd\x34\x4e\x41\x29\x41\x87\xc3\xc3\xd2\xd0\xf6\x47\xa7\xf5\xdc\xb4\x68\xcf\x8f\x77\x6e\x1e\xf9\xd4\x6c\x77\x64\x5a\xb0\xb9\xdd\x91\x31\xe0\x07\x26\xdd\xe6\xc8\xd8\x83\x11\x98\x74\xbb\x23\x63\x89\x24\x64\x41\x3c\x14\xf9\xbc\x2e\x93\x86\xb6\x16\x3d\xd5\xc4\xa3\x15\x11\x94\x49\x31\x67\x71\xab\xed\xe9\x4f\x5c\x61\x79\xd2\xa5\x59\xdc\x6f\xc7\xcc\xac\xbe\xf2\xb5\x60\xf5\xb9\xaa\x4f\x56\x02\xf0\x48\x2e\x32\x4d\x34\xc8\xd4\xc3\x8a\x2a\x05\x56\x1c\x2c\x63\x25\x1c\xc0\x2d\x95\x48\x8b\x64\xdb\x29\x9d\x3b\xc1\x2f\xb1\x62\x32\x71\xa6\xf2\x14\x68\xa2\xa7\x24\x9a\x42\x34\xf3\x75\x8e\xc2\xb7\x1d\x46\xb3\x88\x04\xe7\xe0\x63\xec\xc6\x1e\x3e\x48\x62\x43\xe5\x18\xbb\x58\xf1\x46\x78\x8d\x23\x58\x8a\x8c\x2e\xfc\x8a\x8a\x5a\x4c\x2b\xbb\x85\x79\x66\x20\xf1\x5e\xc6\x68\x35\x86\xa0\xc3\x27\x93\xc5\x76\xb0\x45\x2d\x1e\xa9\xa9\x90\xba\x28\x10\x89\x47\xe2\xd0\xfd\x34\x28\xdc\xcb\x03\x35\x8f\x5e\x84\xd3\xed\x58\x34\xb5\xb5\xf7\x94\xa1\x71\x49\x95\x96\x79\xa4\x0d\x66\x26\xc0\xc1\x9e\xb0\xa5\xc6\x8a\x7a\x25\xa6\x2d\x04\x38\xf6\xab\x6f\x3d\x93\x1b\x55\x7a\x7b\xe0\x33\x79\x4f\xfc\xbd\x5b\xde\xe6\x5e\x3b\xfa\xa3\x6b\x47\x67\xdc\xb2\x99\x37\xf1\x04\x6e\x4a\x36\x78\x29\x12\x16\x35\x78\x82\xc8\x3a\x55\x55\x2d\xa1\xa9\xb8\x35\xeb\x8d\x81\x26\x16\x1d\xcc\x7d\xc2\xd7\xbf\x0a\x6d\xbe\x2d\xbc\x8a\x85\x92\x87\x3d\x32\x7c\x6d\x6d\x43\x5b\xcb\xf1\x0a\x5c\x41\x56\x3f\xd1\x26\x65\xe1\x1e\x4d\x15\x7b\x0b\xdd\xd1\x58\xa3\x76\x1a\x1a\xfb\x43\x99\x6c\x6f\x61\xf1\xd8\x2a\xa7\x19\xbe\xe7\x45\x7f\x3c\x5e\x54\xe1\x3f\x9b\x1e\x99\x25\x0d\x6e\x91\x41\x8f\x24\x6c\x06\x64\x08\xf1\x04\x0c\x23\xc9\xa8\x52\x7a\x2a\x45\x3e\x99\xb6\xdd\x1e\x15\x6a\xe1\x70\x43\xbd\xa0\xc5\x8b\x77\xf7\x48\x6b\x71\xf3\xed\x58\x9c\xfc\x81\x8b\x92\xb7\x66\x22\x44\xc2\xe8\xab\x63\x42\x3d\x64\x21\x03\x0c\x20\xf6\x59\x82\xee\x92\x6d\x1f\xf8\xd3\xf4\xd2\xde\x11\x5c\xf7\x70\x11\xc3\x65\xf7\x26\xfb\xae\xa0\x5c\x71\x68\x84\xab\xac\x29\x5c\x91\xa7\xf7\x6e\xba\x4e\x31\x96\x63\x9a\xa8\x1d\x83\x2c\xbb\xf1\x86\xfe\x28\xd4\xc0\xa2\x3b\x8f\xf0\x4d\x28\xf6\x77\x2d\xf5\xcf\xfe\x88\xd5\x3d\x8e\x08\x37\x6d\x0f\xe4\x88\x4e\x79\x25\x0a\xbd\x14\x3f\xff\x4c\x33\x36\x91\x22\xcf\x8e\x7e\x75\xcd\x60\x8e\x7f\x9d\x31\x1e\x1f\xff\x5c\x38\x1b\x8e\x7e\x0d\xfa\x31\x9e\x44\x84\xaf\x99\x3d\xfd\xbd\x4b\x89\x97\x7d\x0d\xc6\x27\x5c\x83\x51\x8b\x04\x42\x98\x6b\x67\xad\x37\xc5\x0c\x7b\xae\xda\xf0\xec\xb9\x6a\xed\x3b\x94\xf1\xa6\xd6\xec\x6b\x6b\x32\xa4\xe7\xeb\x6b\xd9\x81\x68\xea\x58\xda\x33\xa6\x6d\x85\xc5\x0e\xdf\xc2\xe2\xe7\xef\x3e\x1a\x73\xf8\xd7\xe3\x37\xe3\x31\x44\xfa\xe7\xe3\x6b\x2c\xec\xad\x7e\x6d\xb6\xaa\x9e\x04\x5b\xd5\x92\x46\xdd\xf4\x9c\x3d\x5b\x7d\xb2\x6c\xb5\xf1\x8f\x0d\x7f\x50\x9a\xea\xd5\xc8\xe7\x95\x3c\xd9\xb5\xd0\xed\x6b\x1c\x43\x62\x18\x33\xee\x32\x54\xc4\x08\xd1\x10\xe3\x7c\x78\xef\x54\x33\x6e\xe9\x23\xcd\x54\x30\xca\x59\x52\x83\x9e\x25\xa8\xec\x7d\xce\xc2\x3b\x52\x71\xc8\x6a\xdd\x86\xa3\xe5\x90\xd3\xf5\x40\xc6\x30\x21\x5e\xe6\xa3\x84\xa9\xe9\xb5\x36\xa7\x7c\xb2\xb8\xc0\x2f\x37\x90\x6c\xe9\xb7\xb8\x6c\x25\xee\x5d\x5d\x16\x23\xaa\xe0\x2c\xa5\x93\x2e\x4e\x0b\x8a\x6f\xdb\x04\x8d\xa2\x30\x49\xc1\xa0\xa9\x6d\x4e\x48\x12\xba\x00\x69\xfd\x2f\x8d\x4c\x19\xa7\x50\xd5\x0a\x16\xb9\x82\x71\x9e\x10\x36\x46\xff\xdb\x2d\xe5\xda\xec\x84\xbf\x34\x53\x22\x05\x12\xe5\x4a\x37\x56\xce\xad\x40\x86\x5e\xb8\x71\x2e\x31\x84\x39\xd7\xc8\x3c\x88\x12\x63\x7d\x4b\x65\x43\x00\x6a\x0b\x16\x91\x20\xfc\xd6\x75\x40\x14\x3a\x1a\xdd\xeb\x78\x39\x16\x8b\xcc\x5e\x48\xe2\x4c\x98\x9e\xc2\xab\xc4\xd5\xba\xa8\xda\x17\x80\xe7\x0d\x65\x2f\xfa\xe8\x2d\x63\x0d\x4e\xd1\x3e\xc9\x44\xfd\xcd\x6a\x0b\x1e\x66\x94\xb3\x99\xf8\x87\x59\xc3\x29\x8d\xa6\x5d\x68\x66\x89\xb9\x7f\x50\xd0\x70\x10\xb0\xf9\x0c\xd0\xd8\x67\xdb\x34\x20\xe4\x2d\x02\x40\x32\x3b\x47\x89\xe3\xc8\x40\x53\x2f\x1f\xda\x04\x78\x4a\xe7\xd0\x45\x65\x3c\x37\xef\xad\xa8\x66\x5e\x31\x29\x19\x06\xd6\xa6\x3e\xc2\xff\xed\xbb\x88\xe3\x26\x47\x9d\x4d\x9c\xc4\xd5\x6f\x2b\xd8\xe8\x46\x7e\xff\xa5\xad\xc8\x15\x90\xd3\x13\x3b\x5e\xa1\x52\x72\xbd\x7c\x23\x10\x76\xa7\xf7\x7c\x73\x57\x7f\xf7\xbc\x7c\x2f\x79\xa8\x5e\x14\xc8\x71\xb7\xe9\xe1\x0b\x14\x09\xa9\xd0\xe0\x90\x2c\x21\x13\x8a\x69\x21\x59\x85\x47\xf8\x66\xb2\xff\x3b\xf8\xe6\xeb\xff\x5c\xba\x7e\x0e\x5d\x27\x50\x1e\x93\xcb\xb7\xa7\xd7\x5f\xfd\xdd\xdd\x4c\xac\xdc\x5d\x93\x68\x4a\x19\x57\x03\x72\x42\x7e\x7a\x7b\x5d\xbe\x13\x5e\xbd\xd2\xc2\xde\x3d\x2c\xfb\x4f\x7d\x52\xaf\x16\xc4\xbe\x51\x8b\x98\x36\x70\x2b\x2d\x7b\xac\x4f\x2b\x2f\x1a\xc5\x68\x99\xab\x95\x05\x18\x4c\x07\xfb\xcf\x18\x18\x3c\xed\xa6\x29\xe5\xb1\x1a\x90\xf7\x06\xd7\x45\xd3\x21\x29\x84\x5e\x01\x13\x4b\x14\x87\xe0\x4c\x94\x20\x2c\xcd\x84\x34\xe0\x30\xee\x9c\xf5\x1e\x01\x1e\x45\x01\x73\xa3\x9d\xba\x1d\xae\x43\x7f\xae\x51\xad\xb1\x06\xf0\xb8\x7a\xd5\x83\xe9\x68\x89\x77\x6c\x0f\x08\x39\x0f\xd7\x5e\x22\xb6\xa4\x16\xb5\x31\x16\x7e\x96\x19\x04\x8c\x04\xd2\x55\xa7\xb6\x81\x4a\x1b\x2c\xe9\xf9\x7b\x73\x1c\xdd\x82\xdc\xfd\x9c\x1e\x90\x73\xdf\x77\xe9\x98\x4c\xb5\xce\xd4\xf1\xd1\xd1\x5a\x16\xae\x3a\x8a\x04\x8f\x20\xd3\xea\x48\xcc\x41\xce\x19\xdc\x1e\xdd\x0a\x39\x63\x7c\xd2\x37\x22\xb2\x6f\x75\x02\x75\x84\xde\x9a\xa3\xaf\xf0\xff\x5a\xf0\x72\x73\xf1\xfa\xe2\x98\x9c\xc4\xb1\xbd\x2f\xf3\x52\xdb\xaa\xa9\x03\x42\x33\xf6\xd1\x7a\x82\x7a\x64\xc6\x78\xdc\x23\x39\x8b\xff\x4f\x33\x11\x6c\x80\x37\x5f\xd7\x79\x03\xdc\x5d\x3b\xad\xee\xd6\xa5\xe3\xeb\x92\xc9\x09\x89\x71\x38\x86\x58\x5a\x2a\x71\x11\x4b\x0d\x56\x3b\x0d\xb1\x85\x6e\xa6\x62\x9b\xc6\x4e\x50\x3c\x87\x62\xdd\x3a\x58\x15\x5e\x2e\x6c\x62\x92\x7a\x49\x50\xbd\x10\x6e\x67\xf2\x01\x7c\xac\xb3\xff\xee\x4c\x3e\x30\x6d\x0d\xfb\xef\xcc\xe4\x03\xd3\xae\xb0\xff\x0d\x98\x7c\x0b\xeb\x5d\x67\xff\x1d\x99\x7c\x60\xde\x35\xf6\xdf\x91\xc9\x07\xa6\xac\x61\xff\x9d\x99\xfc\xf6\x7e\x88\xe5\xe3\x8a\x14\x88\xc1\x0c\x86\x5b\x0b\xe9\xd8\xb6\x72\x77\xed\xc2\x5e\x44\xb6\x75\x23\xe9\x26\x58\x3a\x88\x96\x7b\x13\x2e\x5b\x89\x97\xce\x8c\xb2\x8b\x88\x79\xda\x42\xe6\x5e\xc4\xcc\x06\xf8\xeb\x26\x6a\xee\x4b\xd8\x74\x16\x37\x5d\x05\x4e\x17\x91\xd3\x26\x74\x3a\x3a\xb3\x3a\x14\x7b\x4f\x58\xd0\xf5\x41\xea\x8e\xdd\xe9\xbb\x33\xb7\x29\xae\x71\x27\x72\xa7\x2c\x03\xdf\xed\x50\x3b\x9f\x6a\x48\x7f\x18\x13\x2a\x27\x18\x3a\x62\xcb\x72\x2e\xb3\xcb\x1e\xc6\xc1\xf5\xc8\xb0\xff\xb1\xd7\xef\x73\xd1\xf7\x95\x8e\xfb\x99\x14\x13\x09\x4a\xf5\xfa\xaf\x95\x5e\x24\x30\xc0\x5e\x83\xdf\x71\x98\x83\x0c\xd4\x36\x21\xe4\x1a\xa0\x38\x37\x68\x64\x0e\x68\x66\xcc\xd4\x81\x90\x93\x23\x09\xe3\xa3\xbf\x0e\xbe\x1d\xfc\x87\xfd\x53\x1f\xd2\x11\xc4\x31\xc8\xa3\x28\x61\x83\xa9\x4e\x93\xc7\x2e\x8b\x43\x08\x7c\xd6\xc0\xd5\x06\x2e\xd3\x42\x06\x59\x73\xb8\x18\xaf\x06\x61\x5c\x4c\x72\x16\x83\x3a\x4a\x19\x67\xf6\xdf\x7d\x0c\x69\xee\x57\x26\xd8\x11\x23\xeb\x86\xfc\x89\x91\x75\x34\xd2\x85\xdb\x91\x92\x1f\x4e\x3e\x92\xc3\x1f\xa4\xc8\xb3\x63\xff\xd7\x63\xc7\x66\x42\x7a\x0e\x71\x8b\xa6\x6e\xcc\x1d\x88\x26\x3f\xd5\x59\xf0\xc4\xd6\x2f\x8c\x9c\xb4\xc3\x41\x36\xe1\x86\x78\xc3\xbb\x15\x24\x88\xcb\xbb\x02\xc3\xdf\x30\x6f\x0e\x86\xdb\xc3\xbb\x01\xa4\x1b\x2b\x2d\x37\x30\xf8\x9a\x43\xed\xfd\x73\xdd\x44\x44\x34\xb9\xf2\x0a\x77\xd7\xe8\x58\x2c\xee\x42\xf5\xd4\x6b\x06\x38\xcb\xaa\xf6\xde\x7a\x75\x16\x44\x69\x97\x13\xb1\x89\x37\xbc\xe3\x67\x1b\x39\x57\x09\x4f\xdb\xb2\x82\x7b\xa2\x40\x6b\xc6\x3b\x77\xe4\x3b\x59\x8f\xbc\xb5\x57\x4f\xe7\x34\x33\xda\xc3\x75\xa1\x23\xa2\xf8\x0b\x59\x06\xd6\x7e\x52\x15\x83\xc0\xc3\xd2\xbc\xa0\x6e\x6c\x29\xf2\x10\xbd\x85\xc5\x15\x8c\x37\xb1\xc3\xd7\xd5\xf8\x62\x79\x61\xa5\xb7\x2b\xc3\xec\xa4\xcd\x37\xe8\xf3\x85\x06\xdf\xa6\x7f\x6f\xc0\xac\xba\xe9\xe0\x4f\x5d\x0b\xdf\x5c\x0f\xef\x30\x65\x17\x4d\x7d\x23\x4c\x77\xd5\xd6\x3b\xe8\xeb\x4b\x87\xae\xee\x8a\x6f\xfd\xf1\x4a\x7d\x77\xa5\xbd\xbb\xda\xde\x4d\xda\xb4\xab\xee\x1d\xc5\x88\x2f\xff\x75\x17\xe7\xbb\xbd\x69\xe8\xc3\x1c\xee\xbb\x30\xd6\xb7\x34\xd7\xf7\xec\xe2\xcf\xce\x2e\xd6\xcc\xfb\x0e\xeb\xf9\x93\xf0\x8a\x0d\x74\xa0\x6b\x88\x72\xc9\x74\x57\xa5\xf3\x9e\x74\x21\xe5\xa0\xf0\x07\x66\xaf\x1b\x85\xa0\xda\xeb\x46\x6d\xd0\x7d\x71\xcc\x6e\xaf\x1b\xed\x75\xa3\xba\x67\xaf\x1b\xed\xd9\xc5\x5e\x37\xda\xe8\xa5\xb6\x8a\xf3\x45\xd3\x65\xdb\x73\x19\x5b\x2e\xdf\x43\xa4\x5d\x73\x29\x94\x86\xb6\xcf\xf6\x8e\x7a\xb4\x68\x8a\xc2\xeb\x11\x36\x6e\xba\xf6\xa1\xd1\x14\xef\xd2\x5d\xfc\x7f\x28\x54\xaf\x91\x54\xb3\xe5\xf5\xec\x14\x87\xe9\xe6\xba\xab\x48\xcc\x16\xc8\x37\xa8\xf3\x89\x45\x50\x31\x8c\xb5\x28\x5b\xed\x23\x29\xb2\x5c\x4d\x8f\xb0\xda\x76\x3b\xbc\x36\xc0\xb6\xf6\x8f\x1d\x72\x88\xe3\x58\x82\xea\xea\xbd\x34\x10\x7f\xb8\x3a\x43\xfc\x46\x11\xa8\x66\x6e\xd8\x81\x15\x45\x74\x83\xaf\x16\x4d\xae\x5d\xcd\x1a\x0c\x47\xb0\xfa\x7e\xa5\x5c\x0b\x39\xc9\xf5\x54\x18\xe5\x7f\x17\xc0\x7c\x49\x8d\x8e\xe0\xb9\x2e\x50\x45\xb3\xf3\x72\x37\x99\x2a\xcb\x73\x1c\x32\xe8\xa1\x28\x0a\x70\x1d\x6c\xc4\xd6\x02\x79\x98\x07\x0a\x39\xa1\x9c\xfd\xbe\x49\xfa\x83\x95\x9b\x0e\xe2\xea\xf8\x5d\x50\x18\xea\xa2\x51\x0b\x81\x53\x28\x6c\xff\xff\x48\x42\x0c\x5c\x33\x9a\xd8\xe8\x14\xdc\xec\x66\x21\xb1\x6b\x5c\xbc\xcc\xb9\x66\x29\x5c\xda\x00\xf4\x2e\x0d\xa9\x11\x67\x76\x14\x1e\xd9\x01\x79\xc7\x66\x90\x2c\x6c\x4c\x70\x51\x7f\xf8\xf0\xb6\x48\x68\x6a\x00\x1e\x8b\x59\xa5\x94\xf1\x62\x3a\x4b\xde\x53\xaa\xc8\x08\x80\x93\x98\x29\x43\x58\x8c\xe7\x10\x13\xc5\x8c\xa9\x3c\x0f\x5e\x72\xbd\x1a\x7c\x53\x4f\x42\x6d\x7c\xcb\x7e\xff\x63\xe8\xee\x6d\x0d\x07\x76\xb9\x6f\xc9\x95\x03\x3e\x06\xbc\xa8\xe7\xd1\x22\x08\x65\x0b\x28\x66\x2a\x91\x77\xe9\xc1\x32\x15\xb7\x24\xcd\xa3\x29\x0e\x31\x5c\xe9\x96\x32\xa3\x57\x8c\x31\x22\xd7\xfc\x4e\xe4\x36\x26\xca\xde\x53\x67\x52\x34\x72\xad\x20\x50\x01\x0a\x72\x3d\x20\x5a\xd2\x4e\x6e\xa7\x54\xa3\xb6\x67\x54\x55\x37\x84\x2c\x44\xfe\x5c\x22\x25\x61\xd8\x1d\xb2\x88\x2c\x31\xd4\xf0\xb6\xd0\x55\x6b\x60\x15\x12\x4b\x65\xa8\x29\x1b\xeb\xf5\xbd\x0e\xac\x23\x12\xdc\xde\xaf\xd5\xf0\xfb\x15\x68\xb1\x2e\x98\x0b\x6b\x2e\x87\x91\x14\x34\x39\xcc\xa8\xd4\x2c\xca\x13\x2a\x93\x85\x57\x6e\x6f\xa7\x50\xb7\xd7\x8c\x93\x37\x57\x57\x17\x57\x24\x9b\x52\x05\xeb\xc0\x36\x5e\xe7\xb7\x65\x12\x9d\x7a\x98\xdc\x9b\x23\x27\x0c\x7c\x16\x51\xed\x16\xd3\xb2\xa7\x11\xd5\xc4\x16\xbc\x33\xd8\xce\x04\xab\x6f\xa7\xd1\x26\x3f\x13\xaa\xf4\x8d\xa4\x5c\x21\x28\x37\xac\xd9\x7a\x59\xce\xb5\xa6\x4a\x3b\x92\xad\xa2\xd7\xb6\xdc\xc6\x7f\x42\x6c\x4b\x73\x08\x0e\x2e\xd1\xaa\x99\xfb\x09\x42\x39\x1a\x1a\x4d\xa6\x98\x0d\x75\x3c\x26\x31\xd5\xd0\x37\x9f\x6d\x78\xaf\x95\x8f\x9a\xe5\x7e\xc8\xcc\x34\x9d\x97\x8a\x29\x90\x95\xe5\x62\x3e\xa6\x5f\xef\x2d\x55\x24\xc7\xf9\x1a\x0b\x88\xdc\x19\xec\x29\x28\xd5\x98\xfa\xb4\xe6\x98\x9c\xe6\x29\xe5\x7d\x09\x34\xc6\xdc\x53\x37\x98\x30\xdb\xfa\xd1\x1c\xd6\x18\x34\x65\x46\x3e\x8d\x44\x1e\x30\x10\xa6\x50\xd9\xd5\xa6\x45\xb6\x02\x2f\x81\xaa\x66\x81\xbe\x86\x70\xfb\x7a\xd1\x40\xa0\x40\xf8\x73\xe5\xf6\x62\x77\x88\xea\xb2\xff\x1a\x20\x72\x49\x7f\x62\xbc\x0c\x4c\xcf\xf7\x1f\xbe\x91\x39\xf4\xc8\xf7\x34\x51\xd0\x23\x1f\x6c\x36\xe4\xd6\x70\xe1\x0b\x9d\xf0\xb4\xc8\xf0\xeb\xd5\x2e\x04\x05\x6c\x5b\x7e\x3e\x64\x7b\xf6\x9b\xcf\x71\x1f\xe7\xad\x13\x84\xed\xf9\x9a\x75\xa1\x20\x2d\x49\xb0\x4b\x68\xf0\xe9\xc9\x2b\x79\xe8\x05\xdf\x73\x21\xcb\x54\x6b\x63\xd6\xa1\x6d\x52\x9f\xea\xd7\x92\x42\x89\x02\xb8\xa8\x37\xc4\x54\x6b\x96\x27\xe9\x2c\x1d\x96\x52\x77\xaf\x33\x88\xaa\xe5\xc4\xa8\xad\x63\xc9\xa2\x0e\xe9\xb1\xae\xd4\x67\xd4\xf4\x46\x9b\x34\xf0\xc2\x25\x50\x94\xae\xd9\x8a\xe7\x82\x24\x82\x4f\xac\xbb\xa8\xc1\x68\xee\xc4\x29\x2c\x0c\xe7\x22\xe7\xfa\xd2\xc8\xb5\x47\x07\xe5\xa6\xeb\x91\xbc\x0f\x20\x3a\xf3\x83\xe7\x2b\x35\xe8\xb4\xe3\x0f\x4b\x64\xd3\x23\x0c\x8e\x8b\x4a\x02\xcd\x8e\xbb\xc2\x5a\xed\x39\xc3\xa6\x47\x06\x83\xc6\x0c\xa9\xd6\x45\x60\x91\xbc\x4e\xab\x28\x4b\xea\x99\x83\x8a\x9d\x5d\xfc\xd5\xda\xf2\x09\x3f\x54\x0b\xae\xe9\xe7\xc6\x15\xa4\x74\x41\xe6\x54\x2e\x9c\x4a\x6f\x24\x9e\x6b\xf2\x35\x34\xfb\x39\x6c\x32\x53\x77\xe2\x90\x0d\x6c\xb0\x6f\x97\x74\x77\x0c\x12\xfd\xc2\x6b\xef\x77\x0d\x3e\x0b\x2e\x71\x69\x37\x3c\xeb\xa9\x54\xa4\x23\x12\x12\x9f\x53\x52\xda\x25\x62\x5c\x98\x52\xbe\x49\x5c\xcd\x87\x7d\x5e\xf3\x26\xd6\xc9\xcc\x4c\x5b\x67\x89\x2f\x01\xba\x4c\x1b\xbe\xbc\x16\x44\xb9\x2e\x58\x3e\x79\x6b\x67\x42\xfd\x4d\x4b\x91\x24\xb5\x16\xf9\x68\xd1\x9d\xbd\x87\xf9\x69\x35\x69\xa8\x83\x29\xe8\x12\x8e\xca\x60\xc5\xc2\x97\x26\x41\x4b\x06\x73\xf0\x2b\x20\x11\xd5\x34\x11\xf5\x47\x2d\x18\x5f\xdc\x66\x94\xb8\x0f\x94\xd1\x97\x28\x8d\x5c\xd8\x71\x50\x47\x4c\x44\xb4\xd4\x6e\x70\x05\x54\xb3\x8c\x5c\x35\xe9\xbe\x5d\x6e\x90\x72\xd9\x50\xf5\xa8\x66\x65\xd6\x23\xb4\x8a\xce\x4a\xb1\x12\xaa\xc9\x84\xe9\x69\x3e\x3a\xbe\xb8\xfa\xe1\xe8\xea\xcd\xe5\xc5\xd1\xe5\xc9\xcd\x8f\xbf\xdd\x5c\xfc\xf6\xf6\xe4\xfc\xcd\xbb\x37\x37\xd7\xbf\x7d\x7f\xf1\xee\xf5\x9b\xab\xc0\x27\xbb\x54\xad\xec\xe6\x5e\xaf\x0f\x87\x0d\x0c\xf6\x35\x27\x7e\xb0\x25\xae\xdb\xb5\xa4\x8b\xb5\x01\x84\x59\x31\x91\x0a\xa5\x89\x84\x08\xb8\xf6\x15\xb3\x71\x1b\xdd\x80\x1a\xa8\xad\x56\x5e\x7f\x44\xd6\xf5\x4e\x6f\xff\x30\xae\xff\xf6\x1f\x0d\x6b\x44\x15\x76\xad\x4c\x2f\x5a\xdc\x2d\xeb\xf2\x11\xf1\x8c\x5b\x07\x05\x8e\x59\x55\xe5\x3c\x70\x66\xc9\x63\x91\xd7\xa4\x8b\x07\xb6\x32\x93\x62\xcc\x92\x36\x38\xb0\xe1\xac\x7d\xd3\xd6\x84\x60\x6a\xea\x68\x1e\xf3\x05\xb1\x7e\x2b\xcd\xb2\x64\x51\xd8\xec\xb6\xce\x4f\x0d\x7e\x4b\xdf\x85\x17\x36\x3e\x6b\xce\xeb\x77\xc5\xa7\xa2\xa9\x50\xc0\xf1\x0b\xb9\xca\x31\xe5\xcf\xb2\xe7\xba\x79\xd1\xdf\xe5\x1c\x37\x45\x47\x39\x7f\x8d\x66\x31\xc9\xfc\x11\xc6\x2f\xd1\xc4\x7f\x48\xa1\x6b\xa7\x66\xce\xb7\xb6\x21\xdd\x46\x4e\x9c\xa2\xfd\x5d\x0b\x4e\x57\xb4\x29\xdd\xa0\x47\x05\x8e\x89\x45\x71\x47\x03\xc2\xd5\x5d\x5a\x91\x1d\xae\x34\xc8\xc6\x05\x56\xc8\x56\xf6\x42\x98\x11\xfe\x55\x45\xb4\x8e\x12\xc3\x98\xdb\x17\x29\x7a\x4a\x45\x8a\x50\x4b\xbb\x93\x62\x3a\xcb\x0e\x1f\x33\x29\xa2\x78\xb5\x14\xf5\x5a\xe5\x38\xca\x2d\x08\xcd\x58\x6e\xac\x27\xd7\x69\x07\x76\xc4\xff\x9a\x2f\xa8\xc8\xac\xb2\x5e\x4e\x57\xb7\xda\xac\xa0\x99\x85\xb6\x6f\xc2\x18\xb9\xdd\xd6\xcd\x0c\x4f\xdc\xf8\x7d\xf1\xbd\x86\x67\x5f\x7c\xaf\xee\xe1\x22\x06\x4f\x3a\xef\xe8\x08\x92\xae\xf7\xd6\xaf\x8b\xcc\x3f\xec\x56\x3d\xc6\x99\xd4\x5a\x17\xd2\x4c\xc4\xe1\x42\x07\x66\x59\x90\xb0\x09\x33\x38\xb1\xa2\x4e\x45\x53\x88\xf3\x04\x62\x22\x78\x0f\x63\x07\xcc\xbf\x48\x82\xe0\xb5\xb7\xa1\x31\x80\x3c\x7e\xfe\x67\x26\xe2\x93\xe0\x91\x26\x6b\x1c\x33\xb9\xa5\x0b\xb3\xbd\x7d\x34\x5d\x40\x91\x34\x4f\x34\xcb\x12\xb4\x19\x12\x16\xd1\xc2\xe3\xcb\x5a\x23\x18\x48\xa5\x55\x14\x4d\x01\x91\x52\xf6\x17\x7e\x89\x05\x9b\x5f\xee\x54\xb0\x79\x69\x89\xbb\x91\x4e\x26\x62\x45\x0e\xb9\x31\xd0\x8c\x5a\x29\x14\xd8\x7e\xb7\xe1\x98\x2b\x34\xf2\xcc\x77\x5d\xe8\x9d\x90\x3d\xab\x6b\xb2\x79\x11\xfc\x3c\x61\x73\xe0\x65\x73\xf8\x17\x6d\x51\xcf\xf5\xe4\x5b\xe9\xac\x54\xec\x8e\x6d\x57\xf0\x34\xe8\x8c\x6b\xb6\x21\xad\xbd\x87\x39\xc8\x3f\x18\xa9\x55\x56\xf9\x65\x90\x9b\xb1\x30\x9e\x1e\xc9\xb5\x55\x44\xcc\x59\xd2\x2d\xe2\xa3\x56\x8d\xf9\x87\x1d\xbe\xd7\x62\x1a\x9e\xbd\x16\x53\xf7\x74\x71\xd7\xad\x5c\x47\x7b\x23\x7b\xed\x5e\xce\xf7\xce\x5b\x72\x27\x87\x96\x46\xd5\xec\xd1\xa5\xc0\x1c\xe4\x48\xd4\xf9\xa6\x6a\x57\xef\x7a\x3f\xb8\x41\x24\x11\x93\x89\xbb\x8d\xb0\xbe\x73\x43\xb1\x82\x3b\x83\x2d\xc8\xc0\x54\x9e\x65\x42\x62\x43\xa0\x43\x6c\x85\xe5\x82\x5b\x5d\x6c\x90\x88\xef\xb3\x27\x44\x64\x38\xf8\xb6\x8c\xc6\x5e\x0e\xec\xd9\x4c\xfd\xb3\x67\x33\x75\xcf\x7d\xb2\x99\xf6\xa0\x64\x52\x86\x14\x3e\x3a\xbf\xe9\x12\xda\xb8\x86\x0d\x3c\x87\x58\xdb\x75\xd6\xf7\xb1\x99\x2e\xa6\xd1\x79\xa6\x8b\xf8\x9a\x8a\x52\x14\xca\x86\x39\xd3\x44\xcc\x41\x4a\xdf\x9c\x95\x78\xad\xd3\x4f\x6b\x34\x3e\xa7\x9b\x76\x43\x70\xf3\xb5\x41\x67\x0c\xb6\x71\x2d\x1f\xd4\xbc\x35\xe7\x2a\xa2\xa2\x1f\xab\xd3\x95\x3b\x9f\x2e\x12\xab\xd1\x35\xd7\xed\x50\xed\xd9\xe8\x97\xc6\x46\x6d\x97\xa9\x5d\xd6\x74\xe4\x17\x84\x53\xe5\x12\xc8\x9c\x51\x32\x0b\x45\xf8\xfa\xc7\x35\xe3\xd9\x6d\x05\xac\xb9\xe4\xfb\xda\x02\xcc\x19\xc6\x70\xf4\x32\x9b\xa1\x39\x13\x86\x74\x4c\xa7\x30\x13\x5c\xe6\x49\xb2\x51\xdb\xd3\xe7\x58\x92\x28\x4f\x12\x92\xd9\x61\xce\xe5\xf4\x6f\xf4\x06\xfc\xfb\x6c\xfc\x5e\xe8\x4b\xeb\xa9\x6f\xa3\xfb\x20\x74\x09\x4b\x99\x3e\xbd\xfc\xb0\x11\x82\x3e\xb3\x34\x4f\x09\x4d\x45\xce\x51\x60\x9e\x5e\x7e\x28\x6e\x3e\x76\x2a\x92\x84\xd0\x9c\x43\xba\x59\xe5\xa6\x75\x80\x52\x9c\xe2\xae\x60\x9a\x03\x07\xa5\xbe\xa7\x2c\xc9\x25\xdc\x4c\x25\xa8\xa9\xa8\x6b\xb4\x50\x0b\xe0\xf3\x73\xc6\x11\xba\x48\x60\x2e\x0c\xba\x20\xc6\x76\x2e\x55\xc8\xd0\x4c\x8a\x11\x84\xeb\x86\xa3\x77\x81\x2b\x16\x83\x84\x18\x67\x80\x98\xd0\xb1\x06\x49\xa6\x74\x6e\x6c\x02\x95\x47\x11\x40\x0c\xf1\x80\x9c\x64\x59\x62\xf5\x97\x36\x2f\x89\x5b\x9d\x85\x60\x89\x0b\xa3\x7b\x89\x46\xe5\x05\xb2\x6b\x31\x6e\xd9\x52\x33\xd5\x55\xee\xec\xff\xfa\x97\x16\xcc\xd7\xdf\xdd\xaf\xa2\xfe\x8c\x33\xcd\x68\xf2\x1a\x12\xda\xf9\xfc\xbc\xcf\xd3\x11\x48\xbc\x8d\xb6\x5d\x64\x1c\xae\x96\x73\x95\xa6\x54\x11\xa5\xa9\x0c\x57\xa1\x75\x19\x15\xcb\xb8\xb2\xb9\x39\x78\xeb\x8d\x11\xdc\xb5\xa8\x0b\x4d\xfa\xc8\x48\xbd\x04\xc9\x44\x67\x2a\xfe\x51\xdc\x12\x31\xd6\x80\xda\x9f\xef\xde\x5e\x50\x6e\x95\xe0\x5a\x3c\xfe\x7f\x08\x82\xbb\x8e\xa6\x10\x4a\xc5\x5e\xc6\x8d\x7d\xdb\xab\xc5\xb7\x53\xdb\x3c\x01\xfb\xea\xf3\x89\x59\x21\xea\xb9\x88\x9b\x1f\x6f\x6e\x2e\x83\xc5\x99\x97\xd0\xb8\x09\xb2\x02\x93\x76\x44\xe3\x06\xbc\xf0\x3a\xc7\xf4\xc7\x3b\xe1\x85\xca\xce\xb5\xca\x0c\x43\x7c\x4b\xac\x30\x43\x37\xc5\x38\x4f\x96\x19\xa2\x65\x92\x05\x71\x86\xa7\xdc\x8e\x1b\xb6\x69\x66\x0f\x4a\xb6\x37\xa1\xcc\x31\xd2\x99\x45\xda\x10\xa2\x8a\x58\x62\xe1\xc4\x7f\x91\xeb\x55\x06\xd0\x09\x93\x4f\x90\x39\x86\x2b\x30\xb4\xe9\x8a\x66\xf4\x80\x9c\xe9\xe7\xb6\xb8\x7c\x4c\x86\x15\xdb\x38\xd4\x1a\x7a\xb4\xf0\xd6\xf0\x6e\x35\x26\xbb\xb7\x94\x5d\xea\xaa\x4f\x49\xcc\xc6\xb6\x74\x04\xce\x51\xf4\x96\x6d\x65\x2d\xe5\xda\x8b\x4b\xa4\xe1\xb7\x5f\x7f\xfb\x75\x7b\x7f\xd9\xf0\x3e\x18\x28\xde\x6f\xb0\x17\xc1\xd5\x60\xa1\xff\x82\xb9\x08\x19\x72\x92\x2e\xaf\xbc\x5c\x1f\x46\xc9\xc5\x15\x4e\x3e\x9c\x6a\x9d\x0d\x6d\x4b\xf6\x86\xec\xbf\x62\xc1\x53\x20\x43\x3b\xf1\xb0\x34\x79\x31\x84\x52\xe6\xad\xd6\x4d\x5b\x55\xd1\x11\xa8\x37\x1b\x59\x9e\x95\xf8\xae\xcb\xea\xf0\x55\x7b\xcd\xce\x1d\x3a\xf7\x7c\x45\x9b\x2a\x69\xc0\x35\x19\xbe\xdf\x63\xdf\xc5\xfa\x93\x40\x63\xf6\xe7\x55\xdf\x8b\xe5\xfd\xc1\x24\x56\x01\xf7\x13\x51\xed\x57\xf0\xf8\x47\xd5\xed\x8b\x65\x3c\x92\x72\xbf\x05\x39\x3e\x06\x76\x9e\x8a\x7a\xdf\x15\x5d\x2d\x4c\xe0\xee\xf4\xfb\x12\x43\x7f\x52\x05\xff\x0f\xcf\x2f\x9f\x90\x8a\xdf\x09\x97\x4f\x92\x49\xfe\x2b\x07\xb5\xa9\xd3\xd3\x11\xf6\x3d\x38\x3d\x1d\x3c\x9b\xbb\x3d\xd7\x40\xba\x4b\xb7\xa7\xeb\x9f\x7f\xb9\xa5\x49\x91\xf3\xb8\x20\x33\x37\x95\x55\xc6\x97\x84\x74\x80\x3a\x6c\xa8\x42\x99\x51\x61\xd5\xf2\xaa\x95\xb1\xab\x8d\x51\x59\xe1\xb6\xa6\x46\xe3\x2a\xd1\xe4\xe8\x6a\x3a\xb5\x2d\x15\x2d\x8d\xd6\xc5\xee\x70\xcb\x29\xb7\xef\x24\x7f\x2a\x77\xec\x21\x4f\x23\x23\x24\x5e\x03\x8d\x13\xc6\xc1\x75\xfc\xee\xb8\x17\xb6\x1c\x1f\xf3\xf7\xc9\x1e\x2c\xc6\x3d\xb7\x5b\x8a\xfa\x6b\x91\xa2\xa8\x2b\x22\x23\xec\x95\xbd\xc4\x3e\x89\x11\xe6\x02\x5b\x71\xa4\x19\xcf\x45\xae\x92\x85\x83\xba\x5d\xaf\x64\xb8\xb1\x15\x49\x66\xf7\xd8\xcb\xad\x15\x9b\xf2\x6f\x5f\x07\xea\xf3\x86\x53\xe1\x8a\x95\x74\x20\xfc\x0d\xae\x93\x0f\x4e\x96\x2e\x93\x63\xc8\x12\xb1\x58\x0b\x85\xa4\x0a\xe9\xe0\x27\x31\x0a\x60\x04\x15\x27\x9a\x24\xd8\xaf\xd7\x69\xd8\xc0\xb0\x8c\x22\xa2\x1e\xe5\xb9\x14\x29\xa1\x46\x05\x65\x22\xb6\x45\x0b\x54\x9e\x06\xf9\xc4\x21\x5a\xe0\x43\x43\xc3\xc3\x1e\x19\x9a\xfd\x93\x43\x6c\x3c\x37\xfc\x57\x4e\xa5\xfe\x7d\x68\x6b\x68\xd9\xe0\x27\x88\x5f\x10\x21\xcd\x27\xa8\x52\xe1\x1d\xf4\xdf\x76\xc1\x52\xc3\x98\x49\x88\xf4\x10\xdb\xe4\xf9\xe1\xc5\x4b\x2f\x06\xe4\x17\x8e\x9e\x9f\xa0\x76\xec\x12\xf2\x6c\x67\xa7\x24\x59\x5f\xa8\xb2\x25\xb1\x8a\xf8\x5c\xfb\x42\x60\x4e\xb3\x50\x56\xb4\x96\x86\xcf\x99\x34\xaa\x16\xf6\xd7\x33\x28\x29\xe2\xf3\xfd\x2a\x5e\xa5\x43\xf3\x72\xd8\x50\x5d\x9a\x66\xf8\xf5\xd1\x2b\xf2\xd2\xfe\x37\xec\x19\x66\x97\x00\x19\xfe\xf5\x9b\x74\x68\x30\x39\xfc\xe6\x6b\x85\x33\x72\xa1\x5f\x0c\x0e\x76\xb2\xd5\x47\x34\x9a\x89\xf1\xf8\x1d\x4b\x59\x57\x99\xb3\xcc\x01\x78\xa1\xed\xd8\xa4\x67\xe5\x8f\x61\x4a\xb1\xac\x69\x0b\x07\x30\x87\xbd\xe1\x64\xfe\xa5\xd3\xb9\xdc\x51\x31\x29\x43\xf9\x3a\x9f\x4e\xdb\xd5\x8b\x28\xc8\xa8\xc4\xa8\xe3\x22\x1f\xb1\x28\x4f\x56\xce\x1a\x5c\x3e\xd5\x84\x43\xc1\xa0\x6c\x03\x72\xf6\x3b\x76\x43\x24\x42\xc6\xae\xcf\xb9\x9e\x42\x8a\x85\xc6\x84\x9c\x75\x71\x7b\x15\xe4\x87\xce\x2e\x36\x99\x20\x13\x84\xcf\x1a\x24\x47\x8e\x32\x5a\x54\x8a\x7d\x0d\xc8\x49\x91\xa5\x1a\x3a\x99\x1e\x38\xf4\xa1\x21\x2b\xa6\x3e\xdb\x12\xa8\x11\xc5\x8e\x2a\x2a\xd1\x91\x03\xf2\x66\x30\x09\x99\x66\xde\x36\xf1\x1c\xa4\x18\xd9\xb3\xbf\x36\xa7\xa9\xef\xff\x56\x05\x20\x74\x38\x0b\xd0\x0e\x99\x7e\x6e\xf4\x64\xbc\xb0\xf7\x51\x4e\x43\x21\x27\xbe\x3b\x18\xc6\x5a\x0d\x66\xc7\x3e\xe6\x0a\xf9\x59\xf0\x1a\x6d\x24\xa9\x5c\x58\xbe\x73\xed\x99\x5b\x35\x1a\xd4\x70\xbd\x28\x97\x12\xb8\x4e\x16\xc7\x9e\x43\x06\xa6\xac\xe3\x9d\xad\x27\x3a\x5c\xe2\x52\x70\x0b\x41\xb4\xd8\x2c\xf8\xa1\x3c\xd6\x53\x71\x8b\xf6\x86\x04\xaa\xcb\xf9\xb4\x4b\xb1\x6d\xec\x28\x6e\x1f\x2c\x42\xfd\x93\x18\x0d\xc8\x47\x2c\xdb\x8c\x75\x34\x10\x33\xc7\xa4\x4f\x0e\x4e\x92\x44\xdc\x1e\x1c\x1b\x4e\x2c\x6e\x0b\x11\xd6\xe2\x75\x93\x39\xaf\xc0\x91\x2c\xfe\xcb\xcc\xf4\xbd\x90\x23\x16\x1f\x14\x3a\xdb\x0b\x4c\x9c\x1d\xb1\x58\x55\xde\x0d\x4f\xaa\x7a\x44\xcd\x58\x96\x19\x31\xc8\xe1\xb3\xc6\x0f\xb1\xb1\xa1\x99\x39\x13\xb9\xc2\x9f\xa7\x54\xf1\xe7\xcf\x35\x19\x33\xce\xd4\x34\x68\x5d\x2d\x40\x23\x68\x57\xd6\x10\x3b\x38\x36\x4c\x3a\x82\x44\x95\x44\x51\x54\xde\x33\x8c\xcf\xec\xba\x33\xda\x42\x28\x65\x3e\x85\x92\x70\xb8\x25\x82\x37\xb4\xa2\x27\x9d\x09\x64\x1f\x4f\xf6\x45\xc5\x93\x8d\x69\x92\x18\x7c\x77\x5c\xd5\x07\x57\x8f\xc1\xdb\x42\x56\xa4\xb1\x34\x4b\x20\x05\xae\x97\x8a\x86\x0c\xdb\x78\x26\xf0\xd8\x26\x01\x1f\x7a\xf6\xf6\xa2\x28\x11\x8d\x29\xfc\x0b\xec\xfe\x2c\x48\x4a\x35\x48\x46\x13\xf6\x7b\x9b\x11\xb7\xa2\x05\x97\xa2\xcc\x73\x93\xdd\xb0\xe5\xc5\xe7\x06\x5e\x01\xf7\xdd\x52\xf2\x7a\xc1\x76\x3b\x15\x49\xf7\x60\xdd\x71\x25\xcb\xac\xd4\xd7\x63\x88\x12\x2a\x21\x46\x99\xe8\x5a\x1e\x2c\x29\xac\x81\x29\x57\x00\x62\x8a\xa4\x10\x4d\x29\x67\xaa\xd4\x28\x22\x21\x8d\x92\x9d\xec\xd6\x6a\xcf\x9b\x13\xdb\x19\x95\x17\x65\x4d\x0b\x3b\xbc\x62\x4f\x22\xec\x85\xb5\x12\x26\x0d\xc3\x54\xd9\xd8\x30\xcc\x94\xa1\xf7\xb3\x4c\x95\xc5\x68\x6a\xac\x0d\xc1\x17\xae\x5a\xe1\x80\x90\x73\xf3\x5a\x88\xa5\x7f\x32\xc2\xa9\x14\x7c\xb6\x2c\x08\x5a\xa6\x39\xd7\x56\x59\x77\xf7\x4a\x82\x87\xda\xfa\xdd\x95\x25\xd9\xc2\x06\x8b\x6a\xb8\xdd\x2a\x18\xd5\xba\x17\x5e\x57\xe6\xd8\x27\x80\x34\x3c\x7b\x49\xb3\x31\xe2\xac\x23\x63\xfb\xb8\xfe\xd7\x6e\xfc\x9e\x26\x1b\x9e\x3d\x4d\xd6\x3d\x33\xc6\xbb\xae\xe8\xc4\x1a\x25\x66\x31\x9f\xb3\x84\x45\xcc\x28\xec\xae\x6f\x8d\xd5\x88\x14\xfa\x92\x2c\x25\xa7\x61\xfb\x02\x2b\x6f\x8f\x40\xdf\x02\x70\x32\x2c\x87\x0c\x7b\xce\xb0\xfd\x24\x46\xd6\x9d\x33\xb3\x25\x99\xfa\xce\x9b\x1d\x52\xa7\xec\x4d\xa8\x31\xcc\x9c\x30\x2c\x6b\x33\x21\x61\x79\xeb\xa2\x73\x8e\x50\x07\xd1\x9e\x2b\xb8\xbe\x3e\xd9\x40\x79\x44\xba\x93\x7d\xc5\x62\x70\x15\xb4\xb4\x70\x45\x97\x6d\x05\xab\x5b\x0e\x71\x09\x78\xc8\xd1\x58\xf8\xe3\x0d\xd5\x0e\x5f\x0c\xc8\x7b\x81\x93\x50\x4c\xa9\x5c\xce\xba\x31\x6a\xae\xc2\xa3\x15\xbe\x93\x8c\x12\x06\x5c\x5b\xf0\x32\x97\xd7\xde\x33\x6a\xc3\xf5\xf5\x09\x61\x0a\xd3\xca\xe9\x9c\xb2\xc4\x10\xb1\xeb\xdc\x1e\x32\x78\x39\x11\x49\x5c\x55\x43\x5d\xad\xf5\xd6\x26\xbf\x3b\xf3\x52\x43\x4e\xbb\x71\x53\x33\xc3\x9e\x9f\x36\x3c\x7b\x7e\x5a\xf7\x64\x52\x4c\x24\x28\xb5\x9d\xa6\x5f\xcd\x1d\x41\x95\x7c\x45\xd1\xb7\x6c\xb6\x03\x7b\x45\x93\x71\x06\x05\x38\x9d\x6f\x7f\x02\x73\x6e\x73\x2f\xb4\x93\xff\xb9\x85\x0c\x81\xcf\x99\x14\x7c\x97\x63\xfe\xa6\x9c\x62\x7f\xce\x1b\x9e\x9d\xcf\x79\x71\xad\x7c\x0e\xba\x6b\x87\x21\x1b\x00\xaa\x08\xe3\x06\x36\xb7\x5b\xc3\xf7\x27\xe7\x6f\xae\x2f\x4f\x4e\xdf\x38\x97\xf0\xe5\xc5\xeb\xdf\xcc\xef\xc2\x2e\x96\x72\x8b\xe7\x54\x32\x3b\xef\xaa\xe8\xdc\xe9\xcc\xff\x29\x19\xd9\x54\xeb\xec\x52\x8a\xcf\x5d\x3d\xe4\x97\x52\x64\x74\x82\xd5\x80\xd0\xf5\xf5\xe3\xcd\xcd\xe5\x6f\x97\x57\x17\xff\xfb\x4f\xa3\xd2\x99\x9f\xae\xdd\x8f\x66\xef\x42\x4e\xff\xf7\x17\xfe\xc5\x07\xd8\xbb\x39\x95\x9b\xa7\xe3\xd7\xc3\xe5\x0a\xf3\xc7\x71\xa8\xbf\x10\xa9\xa9\xdb\x53\x09\xe9\x36\x5c\xca\x16\x02\x37\x7c\xfa\xed\x9b\x7f\x7e\xf7\xf1\xe4\xdd\x87\x37\xed\x7a\xd6\xf0\xfc\x9f\xbf\x7d\x3c\xb9\xfa\xee\x20\x5d\xd8\x4b\x85\x83\x21\xce\xa6\x80\x68\x2b\x08\x20\x82\x18\x3b\xfb\x8a\x39\xf8\xa0\x37\xeb\xc8\x4f\x42\xe1\x7c\xae\x21\x60\xfd\x9a\x9f\x78\x35\x21\x90\x52\xc8\xfe\x94\xf2\x38\xd9\xde\xb0\x7e\x63\x26\x21\x3f\xda\x49\xf6\x52\xa2\xe1\xd9\x6b\x83\x75\x8f\x0c\xf5\xab\x5d\xaf\x0c\x8a\x94\xe6\xc8\xd5\x8c\xb5\x41\x5b\x45\xad\x0f\x21\x1b\xca\x3d\x97\x0f\xe3\x68\x53\xba\x96\x19\x15\x9a\xdc\xde\xc4\x6d\xd9\xfe\x49\xb4\xed\xc1\xfa\xe1\x74\x7f\x9a\x1a\x9e\x9d\x4f\x13\x76\xa9\x9b\x83\x5c\x9c\xd2\x68\xda\xf5\xb2\xe6\xb5\x1f\xe4\x9c\x00\xae\x73\xa8\x95\x6b\xe6\xa8\xf5\x7c\x78\xd4\xd0\x1d\xb3\x38\x7c\x7d\x1f\x33\x35\xb3\x4e\x1c\x1b\x07\x3a\xac\xc8\x6e\xf7\x9b\x36\xe9\x1d\x14\x10\x7f\x42\x9e\xd1\x42\x1e\x36\x34\x79\xdb\x13\xf7\x63\x25\xb0\x79\x7f\xea\xd6\x9e\xbd\x0c\xab\x7b\x76\xac\x36\xd1\x29\x5b\xcd\x7f\x23\xb0\x4a\x9f\xd0\xb6\x51\xda\xda\xbd\xbb\x28\x76\xaa\x08\x71\x0f\x59\x63\xeb\x89\xce\xb6\xe7\xad\x4f\x1b\x7b\x40\x84\x6c\x94\xf0\xd5\x98\xef\xb5\x92\xb5\xfd\x90\xf0\x9b\x0f\x6e\x96\xc7\xea\xdb\x01\x38\x1b\x78\x65\x23\x6a\xaa\x93\x05\x36\x34\x90\xc3\x7a\x37\xe7\x79\xa3\x94\xb3\x60\xc6\xd9\x6a\x86\x7d\xeb\x29\x2e\x57\xf4\xe3\xcd\xcd\xe5\x4e\x89\x04\x3b\x57\x80\xe8\x96\x1f\xb6\x3d\x83\x6a\x49\x14\x7b\x40\x82\xde\x2c\x51\xab\x3d\x4f\x6b\x99\xbc\x43\x02\x8a\xa5\xa0\x30\x61\xeb\x01\x56\xbb\x6b\x7a\x75\x27\x79\x55\x7c\xe4\x0f\x28\xb0\x76\x49\x74\xbe\x27\x89\xb5\x92\xb7\xf7\xe0\x22\x6b\xbb\x24\xe5\x46\x99\xb5\x9a\x86\xf8\xa0\x2b\xd8\x59\x6a\xad\x6e\xc6\xee\x62\xcb\xdd\x4e\xef\xe8\x49\xd9\x26\x53\xba\x93\xd8\xea\x7e\x98\xef\x54\x6e\xed\x9a\xd9\xdc\x4d\x70\xed\xc0\xa9\x1e\x5f\x72\x6d\x99\x63\xdc\x2e\xba\x56\x68\xfc\x69\xc8\xae\x16\x1b\x95\x71\xbc\xc9\xdd\xd6\xf6\x3f\xb3\xc3\x1f\xad\xea\x2b\x8d\x63\x42\xb9\x5f\x05\x9e\x45\x70\x17\x11\x41\x7f\x66\x79\x4d\x92\x2b\x6c\xc3\x60\x8e\x5e\x19\x38\xed\xd3\xd4\x76\xe3\x2e\x7b\xaf\xc6\x97\xe6\xd5\x98\x0a\xd5\xb9\x64\xc1\xcb\x97\x57\x2e\x4f\xf2\xe5\xcb\xc1\x72\xba\x35\x96\x0b\x10\xaa\xa8\x4f\x15\xd2\x77\x7c\x96\x2a\x1e\x80\x5d\xaa\x62\xb4\x31\x0a\xa5\x59\xc3\x31\xed\xc0\x26\xcc\xe0\xdd\x98\x44\x92\x88\xdb\xcd\x55\x0f\x4a\x0e\x31\x73\xb0\x5f\x64\x0e\xbe\x28\xee\x5b\x4f\xcf\x5e\x5f\x11\x95\x8f\x38\xb4\xa7\x0c\x2e\x77\x3f\x31\xfc\x43\x46\x90\xe9\xb2\x86\x86\x5d\x61\x26\xc5\xe7\x05\x39\x1c\xbe\xfa\x7a\x80\xff\x1d\x7d\xdb\x7b\xf5\xf7\xbf\x0c\x5e\xfd\x0d\x7f\x78\xf5\x97\xde\xab\xff\x34\x3f\x7d\x6b\x7f\xfc\x5b\xb7\x52\x69\xbb\x29\x04\x7b\x26\xf4\xa5\x31\x21\x1b\xdd\xd2\x71\x4d\xdf\x0b\x8c\x63\x2d\x1b\x8a\x9b\xdd\x72\x5d\xcd\x86\x46\x67\x8b\xa8\x1c\xe0\xd9\x1f\x30\x71\x64\xa7\x1e\x86\x32\x6a\xfe\x51\xd0\x6d\xa5\x3b\x11\x53\x58\xf5\x5e\x0b\xa7\xb6\x13\xc1\x2b\x11\x67\x2d\xa1\x1b\xe6\xd0\x61\x97\x24\xee\x1b\xa9\xde\x45\xc1\xec\x16\x02\xfb\x24\x12\x31\x63\x0d\x81\x45\xed\x0c\xef\x27\x3b\x7c\x27\x96\x77\x7a\x72\x0a\xdd\x8b\x92\x4c\x81\x5c\xbe\x39\x27\xc0\x23\x11\x43\x4c\x4e\x4f\xb0\x85\x2e\x1b\xfb\x4b\x5b\xec\x84\x9b\x51\x3d\xed\x21\x01\x87\xc8\x53\x90\x39\x48\x36\x2e\x2f\xec\x8a\x89\x40\xf5\xfc\x4d\xb0\xa1\x77\x34\x7d\x86\x99\x14\x5a\x44\x22\x09\x71\x33\xa6\x6c\x79\x0f\xe5\x62\xac\x72\x05\x7d\xa5\x92\xbe\x8b\x0b\xa6\xb9\x9e\x02\xd7\x0e\x56\xac\x3d\x60\x09\x25\x30\x65\x69\x36\x1d\xcd\xa9\x3c\x92\x39\x3f\xb2\x4d\xf8\xd5\x51\xc9\x15\x0c\xd1\xba\x10\x6f\x1a\x61\xfe\x90\xff\xb1\x1f\xd1\x41\x24\x75\xe8\x0b\xe6\x28\x5c\x64\xc0\xaf\xa7\x6c\xbc\x2b\x17\xc6\x75\x5e\x4a\xc6\x23\x96\xd1\x86\x06\x31\xa4\x6e\x53\x33\x3f\xe6\x50\xbd\x70\xb6\x06\xa6\xa3\x8d\x7c\x67\x2f\xc6\x09\x75\xf3\x87\x2c\xe8\x72\x0f\x5d\xa7\x77\x71\x4b\x28\x9a\x62\xde\x62\xf5\x34\xeb\xb5\xe0\xea\x56\x07\x66\x5e\x21\x82\x0d\xb7\xba\x85\x66\x2c\xb7\x28\xb7\x7a\x05\x8f\xdf\x45\xfc\x3b\xb5\x50\x1a\xd2\xe3\x94\x2a\x0d\xb2\x8f\x12\x38\x7c\x7f\x1c\xf1\xef\xa6\xf4\x56\x33\xd1\x17\x3c\x61\x1c\x06\xf6\xa7\x81\x9a\x47\x0e\xe4\x88\x7f\x37\x36\x60\x1b\x33\x40\x24\x30\x30\x3f\xe0\x9f\xef\x80\x58\x1e\xaa\x1b\xca\x5e\xee\x77\x09\x64\xd8\xcc\xa7\xf5\x8e\x29\x0d\x1c\x17\x86\x59\xa3\x11\xc5\xae\xf0\x58\xd6\x4a\xad\xdf\xa8\xec\x24\xc5\xff\x94\xaa\x09\x76\x29\x8e\x21\x3e\xc5\x63\x7c\x3a\x85\xce\x09\xd2\xe7\x94\x17\xb9\x2c\xeb\x62\xc9\x3b\x08\x55\x17\x56\x38\x4e\xe8\xc4\x97\xa6\xf0\x00\x91\x19\x2c\x48\xae\xe8\x04\x88\xb2\xa1\xc1\x6b\x82\x2e\xc4\x52\x1e\x88\xfb\xd9\x9f\x37\x13\x4b\x77\x66\xb0\x1a\x61\xf4\xa3\x31\x4a\x69\x1c\x4b\x27\x36\x4a\xdf\x97\x17\x1e\x74\x62\xb6\xc6\x9a\x4b\x21\xeb\x86\x71\x43\xa8\x03\x72\x36\x26\xc3\x83\x5f\x5e\x1e\xd8\x80\x9e\x03\x67\x37\x1d\x20\x22\x51\xbe\xd9\xb2\x24\x36\xbd\x29\x64\xa6\x99\x19\x6d\x1a\x19\xc6\x1a\x71\xd0\x98\x69\x8d\xa6\xda\x98\x46\x55\x37\xeb\xc1\xcb\x83\x1d\xbd\xac\x02\x71\xb2\x79\x88\x6f\xd9\xb9\xbc\x40\x98\x9b\x8a\x50\xe5\x83\x62\x83\x8e\x2b\x8c\xa0\x56\xc7\x47\x47\x4e\x41\x1d\x08\x39\x39\x92\x80\x65\xa0\x23\x38\x9a\xea\x34\x39\xc2\x3d\x50\x03\xf3\xef\xaf\xf0\xdf\xfd\x4f\xf3\xb4\x6f\xd9\xf9\xcf\x3f\x7d\x3c\x0f\x7c\xc0\x6e\xdf\x8a\x3a\x6b\x21\xfc\xf5\xd1\x45\x5a\x46\x95\xba\x15\xb2\x2b\x4f\x44\xed\xc9\x0d\xb1\x9c\x11\x53\xd1\x97\x4e\xe0\x26\x3a\x0e\x06\xa0\xe7\x0a\xe4\xd0\xa1\xc4\x99\x35\x0f\x56\xca\xbc\x46\x47\xb3\x35\xf5\x2a\xd5\x02\xff\xfe\xf7\x6f\x77\xae\x49\xee\x98\xd9\x46\x4a\xaa\x1d\xe2\xee\x44\xca\x88\x3e\x57\x3c\x5c\x7a\xa6\xd8\x49\x9f\x77\xfc\x73\x99\xcf\xed\x82\x64\xcc\xa6\x7c\x67\x85\xce\xc9\xd2\xfe\x77\x5c\xe3\xff\x4c\x01\xd7\x53\x63\x10\x55\xfa\x30\x7b\x1a\x0b\x9d\xaf\xa5\xaf\xdf\x2b\x6b\x37\x94\xba\xc1\x16\x9a\xd7\x2b\x41\x99\x35\x67\xe5\xde\x5c\x8b\x9f\xe6\xe9\xd6\x76\xf6\xc7\xf3\xdd\x02\x0f\x13\xaa\x94\xb1\x8a\xbb\xb2\xf2\x0a\x03\xff\x78\x5e\x0e\x27\x87\xb9\x02\x32\x7c\xc7\x78\xfe\x79\x58\xfe\x3a\x40\x09\xce\x21\x29\xe4\x4e\x94\xbd\xd7\xf2\x43\x5a\x3e\x8c\xf2\x49\xd7\x8d\x75\x25\xcc\x14\x91\x90\x0a\x0d\x76\xf0\x04\xd3\x94\x95\x70\x95\x0c\xdd\x2f\x83\x75\x1a\x5d\x85\x42\xaa\x35\x8d\xa6\x65\x2b\xcd\x9f\x3e\x9e\xbb\x74\x1b\x92\x2b\xc6\x27\xc8\xb8\xfb\x63\x21\x0d\xde\xda\xc5\x62\xf8\xa8\x23\x58\x27\x56\x2d\xeb\x7a\xe4\x25\xe5\x0a\x85\x87\x57\xe7\xa8\xf6\xea\x9c\x40\x7d\xc5\x19\x3a\xe1\x9b\x34\x0e\xb7\xc9\x82\x24\x34\xe7\xb8\x58\x73\x26\x4a\x9e\xf6\xf2\xf8\x9b\xaf\xbf\xfe\x66\xb7\x60\x6c\x5c\xda\x75\xae\x32\xe8\x5c\x51\xc0\xbd\x6d\x9d\x9a\x9a\xca\x09\x68\x04\x8c\xa5\x29\xc4\x8c\x6a\x48\x16\x95\x30\x91\xc0\xe2\x6c\xd7\x13\x73\x96\x8d\xa4\x4f\x04\x8d\x03\xca\xd9\x17\x6b\xd3\x6d\xab\x0b\x9b\x2d\x71\x63\x1f\x5f\xb3\x94\x8c\xeb\x53\x91\xa6\xb4\x33\x95\x5d\x9a\x21\xca\xc5\x28\xe1\x40\xb7\x53\x65\x89\x5d\x7b\xec\x09\x6b\x2b\x1e\x59\x46\xb7\x24\x62\x72\xa7\x99\x88\x2d\x7c\x76\x46\x53\x48\xa0\xa9\x0c\x67\xbb\xf4\x7d\xeb\xc6\x3f\xd4\xf5\xff\x72\x91\x5e\x7b\x19\x61\x4b\xed\x7a\x03\x28\x2e\x60\x0a\x29\x61\x1c\xb7\x89\xc9\xe2\xa6\x62\x79\x65\x87\xee\x9c\x56\xef\xe0\x76\x3a\x22\x7b\x29\xfd\xa5\xdd\xc1\x19\x26\xd7\xf9\xb6\xba\xbe\xb2\xad\x23\x64\xcc\xd6\x43\xc7\x86\x11\x40\x41\xdf\x80\x53\x35\x7c\xe9\xd0\x2e\xa1\x75\xbb\xaa\xee\x33\x88\xbb\xdc\x91\x3d\xaf\xb6\x4c\xd8\x98\x16\xef\x27\x7f\xe8\xc6\x50\xc0\x69\xc3\xb9\x5a\x9e\xf1\x51\x4f\x88\xaf\xa6\x5d\xbf\xac\x7e\x07\x48\xdb\xf6\xd0\x5e\xaa\x6e\x2d\x04\xdc\x9d\xec\x03\xc9\x00\xeb\xb1\x2f\xc3\xc0\x4a\x5f\x3e\x3a\xd8\x92\xc4\x71\xcc\xf2\xa3\x3b\x06\x71\x4d\x29\xe7\x90\x5c\x33\x3e\xeb\xaa\xe3\xbc\x73\x27\xd8\x0d\x55\x96\x5b\xa1\x83\x4f\x69\xc6\x0b\xcc\x75\x8b\x7d\xb5\x65\x29\x07\xc8\xf9\x9c\xa6\x40\x14\x56\x06\xf5\x1f\x70\x2c\x42\x48\x32\xce\x93\xc4\xd5\x0e\xfd\x70\x75\xf6\xe8\x89\xf4\x25\xf6\x6c\xd5\xa9\x5d\xf1\x67\x8b\x57\x7d\x29\xa8\xc3\x83\xb6\x9d\x58\xf4\xaa\x51\x71\x38\x0d\x9b\x4d\x40\x43\x47\xbe\x86\x9e\xe6\x9f\xae\x2f\xde\xbb\x30\xd4\x7d\x04\x52\xc3\xb3\xd7\x7e\xea\x17\x65\xbd\xd3\xdb\x30\x4d\x3f\xf6\xfe\xb8\x66\xe1\x3b\xaf\x39\xfb\x81\x19\x9f\x06\x57\x28\x50\xfb\xa8\x1c\xf5\xf1\xd1\x30\x87\x2d\xc9\x6b\x8e\x55\xb9\x16\x19\x06\xbd\xb9\xee\x37\xd5\x78\xf0\x5b\x16\x3c\x39\x99\x14\x71\x1e\x41\x3d\x61\x55\xe6\x5e\x97\x28\xa1\xab\x0f\x97\x4a\x42\x8b\xae\x10\x64\x24\xc5\x0c\xe4\x13\x88\x23\xb1\x98\xde\x8a\xda\x76\xc5\xf5\x08\x88\xca\x47\x66\xe2\x11\xd8\xdb\xe2\x6e\x48\x0f\x4c\x59\x6e\x47\x33\xd2\x2b\x6d\x75\xb1\x4f\xcf\x81\xf3\x01\x1c\x3c\x81\xdd\x18\xb3\x44\x83\xb4\xdb\x71\xea\x0e\xf3\x86\xc5\xcf\xec\x14\x8c\x4f\x88\xe0\x16\x7b\x8a\x8c\xa8\xc2\x6a\xda\xbe\x51\x5d\x1c\x74\x71\x1f\x44\xd0\x77\x46\xc3\x94\x29\x2d\xe4\xe2\x60\x40\xae\x19\x8f\x9c\x2c\xb1\x13\x60\xc2\xde\x08\x80\xa3\x47\x7d\xde\x76\xaf\xcd\xe1\x16\x24\x99\x83\x54\x78\x1d\x5e\x1a\x16\xbd\x0a\xc4\x4c\x79\x49\x17\x6f\xd0\x4b\xba\xa5\xf6\x3c\xe3\xb3\x7f\x30\x1e\x33\xde\xf9\xae\xc0\x96\xac\x1d\xd9\x41\x6b\x54\xad\x85\xa1\x29\xc6\x67\x64\xce\x68\xc8\x0d\x55\x86\xb3\x96\x20\x14\x25\x5a\x07\xe4\x06\xe5\x72\x45\xe2\xfb\x5b\xe8\xb0\x13\xa0\x84\x03\x5d\xdf\xca\x02\x33\x49\x00\x61\x72\x6d\xbb\xd7\x3d\x5c\x81\x49\x8b\xdb\xef\xd6\xd9\xc9\x21\x7c\x8e\x20\xd3\x5d\x5a\xe6\x54\x27\x63\xca\x15\xa8\x1d\x2d\x2a\x68\xb1\x88\xd8\xe9\x1a\xb4\x9b\xe9\xeb\xab\x00\xef\x6a\x02\xbb\xb0\xe4\x87\x32\x85\x3b\x37\x2d\x73\xf0\x05\x36\xc4\x37\xf5\x2b\x9a\x97\x45\x82\xdb\x9b\x4e\x45\x30\x7b\x90\xfc\xc2\xc9\x4b\x72\xb6\xbc\x67\x78\x93\xd5\xb2\xcd\x1e\x37\x99\x14\x18\x82\xfc\xd2\x1c\x9f\xb6\xde\x68\xc1\x53\x83\xa9\x5a\x96\x63\x15\xbd\xcb\xaa\xbd\xce\x9a\x3a\x9a\x85\x8e\x4d\x6d\xaf\xb3\xdd\xba\x7c\x99\x8d\x54\x11\x4d\x18\x9f\x9c\x83\x96\xac\xa1\xa2\x15\x59\xdb\xd5\x95\xcc\x59\x8f\xc0\xca\x7c\x24\xc5\x09\x0b\x6d\x3d\xa4\x5a\x20\x0a\xb4\xc0\x08\xfa\x61\xa5\x51\xd1\xd0\x33\x7d\x49\x86\x51\x96\xfb\x1f\x2b\x5f\x09\x1c\x3c\x62\xc8\xe1\x0a\xc6\x36\x64\xa0\x0a\x65\x2c\xa2\xbc\xec\x58\x82\x91\x92\x58\x21\x96\x5b\x43\x92\x09\xbe\x5b\xab\xa5\x0a\x7c\x37\xc8\x81\xba\x62\xf5\x1a\xdc\x6d\x0d\x86\x3e\x5b\xda\xf1\xb8\x20\x09\xcc\x21\x31\xa8\x38\xbd\xfc\x40\x32\x90\x91\x59\xc1\x24\x74\x60\x0e\x6d\xd7\x07\x27\x46\x71\xde\xb5\xfd\x79\x51\x76\xea\xba\x14\xf1\xa0\xc0\x59\xe8\xc4\xdc\x39\x36\x5b\x5a\xb1\x25\xb4\xf3\x95\x71\x17\xc2\xb4\xb7\xa5\x1b\xd3\xe5\x34\xa3\x83\xca\x34\x03\xc7\x97\x07\x31\xcc\x5d\xb9\xf6\xc0\x0b\x21\x66\x51\xa5\xe6\xee\x34\x1b\x98\xf1\x6e\xa9\x79\xef\x1a\xf9\xd2\x5c\x23\x29\xfd\x7c\x1d\xd1\xce\x9d\x8e\x0e\x4e\x38\xc9\xb3\x0c\x24\x19\x89\x9c\xc7\x45\x02\x7e\xd9\x01\xf2\x52\xc4\xce\xba\x6a\x6d\x77\xe9\x1b\x15\x30\x4b\x28\x49\x02\x49\x5d\x1d\x86\x41\x71\x30\xa6\x34\xdc\x22\x0d\xb5\x36\x12\xd1\xcc\xe5\x8e\x21\x18\x96\x31\x2a\xcf\x16\x19\x57\x9a\x26\x89\x9b\xf9\x09\xb2\xc0\x94\xf1\x8d\x76\xa4\xda\x92\xba\x6e\x1b\xca\x78\x40\x87\xef\x10\x7f\xd2\xd8\x1a\xa9\xe8\x93\xb4\xb6\x13\xa8\xac\xbf\x7c\xf9\x3b\x48\xf1\xf2\x65\x45\x5b\x0f\x25\xb8\xa4\x40\x5d\x3f\x8a\x1a\x93\x9b\x29\x62\x18\x22\xc4\x24\x36\x7b\xa7\x05\x31\x73\xb7\xe9\xeb\x5c\xe8\x4a\x54\x60\x99\xa9\x1c\x57\x9a\x72\x9b\x45\xac\xf1\xd8\xc0\x9c\x0f\xb6\xc1\x52\x24\x89\xc8\xf5\xeb\xcd\xd8\xac\x37\x97\xb5\x20\x13\x49\xe3\x1c\x55\x6c\x35\x65\x63\xac\xe9\x3e\x1e\xb3\xa8\x28\x50\x45\x35\xa8\x90\xfd\x74\x05\x73\xa6\x50\x15\xe7\xb1\x91\x77\xae\x22\x8a\x05\xab\x68\xe7\xec\x36\xbb\x62\xdf\x06\xa6\xf4\x81\x0b\x66\x46\x9f\x0e\xb6\xd4\xcd\x96\x92\x1f\x44\x42\xf9\xc4\x76\xbb\x1c\xf8\xc5\x87\x84\xa5\x95\x54\x44\x82\x6b\xdf\xe9\x02\xa4\xa5\x61\x3c\x96\xa9\x52\x57\xfa\x02\xeb\x3e\xe3\x92\xee\xaf\x59\x77\x22\x30\xfa\x6e\x5b\x93\xec\x9d\x1d\xbe\x63\x55\xca\x44\x74\x0d\xa1\x3d\x35\xef\xba\x3e\x7a\x06\x76\x22\x72\x9d\xe5\xcd\x54\xb1\x0f\x1b\xd9\x6b\x07\xeb\x8f\x2b\xfa\xd2\xd1\xcd\x2a\x26\x8a\xa4\xa0\x30\x6b\xc9\x8e\xdc\x45\x17\xfd\xa4\x3a\x13\xd9\x05\xd2\xb6\x27\x75\xe5\x2f\x0b\x77\x5a\xba\xf9\xfc\xa5\x04\xad\x17\x18\x59\xb7\x59\x34\xc0\x41\x86\x23\x6d\x1c\x1f\xe3\x93\x03\xdf\x2b\x13\xef\x30\x0d\x8c\x3b\xc1\x86\xe6\x61\xe7\x08\xf1\x4f\x86\x1f\x3b\xdc\x20\x0f\xb2\xd6\xe5\x61\xb5\xd3\xc9\xd9\xfb\xef\x2f\x76\x88\x8b\x6d\x39\x62\x36\x17\x76\x1f\x97\xf3\xc7\x8e\xcb\x41\xcd\x6a\x5b\xf9\x77\x8e\x6a\xd9\xee\x35\x99\xbb\x3a\x07\x9e\x97\x81\xbd\x2b\x42\x47\xd8\x23\x89\x5d\xc0\xf0\x2f\x29\xcd\x5c\xa6\x7e\xc8\xc1\xb4\xbe\x2a\x74\x16\x7e\xce\x20\xd2\x45\x87\xa1\x0f\x37\xdf\xf7\xbf\xad\xb4\x63\x0b\xda\x2b\xd8\xca\xd5\x4c\x92\x49\x11\x59\x45\x69\x84\xbd\xa1\x51\xfd\xb6\x37\x43\xa7\x82\x6b\xf8\x6c\xf5\x2a\x2d\x59\x5b\xcf\x8d\x11\x18\xda\x74\x2a\x97\x17\x01\x58\x6a\x41\x61\x9f\x0c\xfb\x3d\x9a\x28\x41\x52\x1a\x43\xd9\xe2\x2c\x30\xa7\xb3\x9b\xca\xd4\x92\xa2\x31\xbd\x11\x5c\xd4\x36\x0a\x66\xd2\x65\xab\x5a\xdf\x66\xb2\x68\x73\x9b\x9a\x29\xaf\x44\xae\x61\x40\xae\xb1\x13\xc8\x31\xf9\xb9\xd8\x8e\x7f\xdb\xed\xf8\xf5\x98\xd3\x14\x7e\x3e\x9a\xc1\xe2\xd7\x9e\x31\x09\x64\x30\xf4\x9f\xa6\x50\x2a\x8b\x6a\xa9\x31\x9e\xfd\xa3\x41\xe2\x0c\x16\x2e\xca\x1b\x15\xe8\xf2\xfd\x16\x58\x8b\x99\xcc\x04\xae\x9d\x14\x5e\x03\x41\xdc\xac\x79\xec\x73\xeb\xf7\x8a\xd9\x7d\xb5\xdc\x50\x1b\x5d\x81\x57\xd8\x61\xc9\x9d\x0e\x91\xb5\x98\x9d\x63\x9c\xca\x05\xde\x64\x03\x0f\xc4\x96\x93\x30\xf7\x44\x1e\x59\x61\x7e\x15\xfe\x18\xf6\xaf\x8c\xc0\x75\x64\x76\x67\xcc\x60\xa0\x11\x3a\xfb\x99\x4a\x10\x4e\x08\x5c\xc3\xaf\xdc\x95\xa5\xbb\xd7\xa1\x85\xad\x9a\x08\x97\xf5\xe8\x1a\x42\xe3\xcb\xee\xee\xbd\x4b\x8d\xb3\x5c\x81\xec\xc8\xbd\x7e\xfe\xbf\x66\xf2\x5f\x43\x5e\x12\x64\x6f\xed\x4c\xac\xb7\xc2\xc1\x02\x33\x36\x4d\xb3\xce\xc1\x90\x33\xe2\xf2\x3b\xf3\xc3\x6a\x10\x94\x19\xf9\xf8\x4c\x70\x2e\x92\x3c\xdd\xe6\x40\x5c\x82\x54\x98\x5f\xa6\xc9\x47\x9c\x83\x9c\x26\x94\xa5\xbe\xd5\x55\x6a\xbb\x7c\x87\x74\x83\x82\x06\xb2\x79\x64\x36\xe9\xf8\xa8\x48\xa1\x39\xc2\x8d\x7f\x6c\xec\xb4\x70\x5a\x91\x01\xa7\x19\xdb\x56\xb7\xbb\xc8\x80\x9f\x5c\x9e\xdd\x81\x76\x97\xd2\x6c\x93\x26\x92\xe5\x20\xbc\x14\xf6\xc1\x0f\xe6\x0c\x7b\x83\xcb\xc1\xf6\xe8\xd4\xb9\x17\xd1\x5f\x96\x88\x6e\x3b\x71\xb7\x7c\xfb\x5e\x6d\x17\x66\xf0\xbe\xbf\x4d\xc3\xb3\xa7\xc6\xda\x77\x30\x38\xe1\x84\x73\x61\xfd\xe8\x9b\xb0\x59\x2c\x76\x38\x26\xb4\x1c\xec\x44\xa3\x96\x94\xab\x31\x48\x19\x50\x97\x1e\x8a\xc1\xda\x05\xbe\xc3\x4a\x91\x9b\xaf\xcd\x55\x98\x7c\x6a\xcb\x6a\x21\xf8\x2c\x1e\x6d\xcb\x43\x2e\x5f\xff\x63\xcf\x41\x1a\x9e\x3d\x07\xa9\x7b\x52\xfa\xf9\x03\x2f\x1c\x46\x1b\x1c\xb1\xf2\x52\x3a\x13\x95\x0e\xdf\xd5\xd8\xbd\x6e\xf1\x02\x79\xf9\x79\x57\xeb\x9e\x72\x02\x73\x16\xf9\xeb\xca\x55\x53\x8f\x13\x3a\x52\x22\xc9\x75\xd0\x6f\xe4\xc0\xc3\x88\xbd\x22\xd4\xaa\x92\xf5\xfe\x6a\x48\xd8\x98\x0c\x53\xc6\xfb\xc5\xf7\x87\x84\x05\x33\xba\x13\x25\x7c\x9d\xd8\x17\x03\x72\xc1\x93\x05\x11\x1c\xf3\x1a\x86\x29\xfd\xdc\xaf\xac\xc4\xd5\x65\x5b\x9e\x3d\x30\xb5\x5b\x62\x61\xa7\xee\x94\x0a\x95\x32\x7e\x72\x8f\x3b\x9a\xe6\xc1\x7b\x68\xa5\x59\x92\xa0\xf1\xbf\xe1\xb6\x86\x30\xef\x36\xbc\x61\x5b\x57\xf7\xa2\x2b\xd6\xed\x1e\xad\xee\xdc\x1d\xee\x45\x1b\xa7\x4f\xa8\x1e\x0b\xb9\x75\x5d\xa2\x4b\x37\xfe\xd1\x1a\x23\xc4\xa0\x21\x72\x09\x0c\x58\xe8\xb0\x6c\xb4\x1c\x40\x3b\x1b\x57\xfc\x35\x1e\x07\x1e\xed\x91\x04\x4c\x50\x3f\x64\x1a\x33\x37\x2a\xbf\x0b\xbb\xb3\x8b\x8a\x56\x44\xf0\x64\xb1\x63\x59\xab\xbd\x20\x0c\x35\xc5\xc7\xed\x78\x6d\x37\xb0\x3b\xe9\xd8\x61\x95\xad\x3f\x84\x34\xd3\x8b\x17\x25\x09\x74\x48\x3d\x28\xde\x65\x8a\xa4\x4c\x29\xc6\x27\xbb\x66\x11\xfe\x09\xa5\xfa\x24\x11\xa3\xce\xf5\x9f\xcf\x78\xec\x8a\xcc\x31\xeb\x5d\x29\x70\x5c\x06\x99\xf9\x53\x69\x27\x0e\x3a\x28\x5d\xcd\xd3\x88\x2a\xe4\xc6\x76\x04\x31\xab\xa2\x5a\x54\x5a\x2b\x19\x02\xbb\xcf\xac\x8b\xac\xa9\x19\x56\x07\xbe\x2a\xe2\xbd\x1a\xdd\xf0\xec\xd5\xe8\x8d\x11\x97\x49\x91\x82\x9e\x42\xbe\x75\x09\xa4\xcb\x62\x86\x3d\x5d\x36\x3c\x7b\xba\xac\x7b\x32\x11\x9f\x0b\xce\x74\xe7\x08\x43\x5f\x83\x94\x92\xe1\x65\x31\x76\x58\x5e\x33\x19\x00\xbd\x86\xd6\x9e\xd3\x77\x27\xed\xf3\xca\x35\x6c\xec\x07\xaa\x5f\x83\x75\x0b\x6d\xd6\x78\x40\xc4\xfd\xd4\x4f\x54\x14\xcd\x7e\xec\x4c\xdd\x36\xbe\x93\x27\x49\xdf\x5e\x5b\x6e\xcd\x78\xf2\x24\x21\xd7\x38\xc5\xe3\x94\x5f\x8b\x96\xb3\x81\x0a\xd5\x44\xc2\x84\x29\x2d\x43\x8a\x88\x5d\xb9\x8f\x74\xc9\x44\x6c\xf4\x1b\xa6\x31\x0d\x74\x6c\x6f\xa3\x87\xcb\x3d\x3c\x62\x11\xcd\x40\xda\x4f\x7e\x52\x82\x07\x36\x78\x6f\x44\xec\xd9\xed\xfa\xc3\x52\x3a\x01\x73\x66\x40\xbe\x86\x04\x26\x1b\x56\x7f\xe6\xae\x6a\x2b\x5d\xd3\x9a\x6f\x99\x9e\x12\x6a\xe1\x8e\x8b\x53\x10\x8a\x3c\xc0\xc5\x81\x4b\x2f\x88\x0b\x60\xfc\x0d\xea\xd0\x35\x34\x41\x88\xfb\x19\x82\x3c\x24\x51\x92\x2b\x1d\xcc\x9b\x91\xc2\xe0\xdd\x1e\xa9\x02\x3a\x2c\x71\x92\xd1\x08\x7c\xb2\x42\x35\x17\xc4\x25\xfd\x86\xdc\x30\xb6\x67\xce\x8e\x09\xf6\x78\xda\xdf\xd3\xce\xfd\x55\xb1\xa2\xb8\x61\x6f\x8e\x4f\x60\x90\x86\x4b\x15\x74\x0b\xc4\x7c\xca\xb3\x31\x49\x60\x1c\x92\x72\x68\xc6\x5a\x84\x33\xb5\xe2\x21\xd1\x74\x06\xbc\x74\x90\x0c\x2b\xde\x34\xef\xbd\x09\x79\xa8\x3c\x9b\x5b\xe6\x04\xf7\xe7\x91\xfa\x57\x4e\xe5\x6c\x7b\x3d\xf5\xbf\xed\xf0\xbd\x92\xda\xf0\xec\xb9\x66\xdd\x93\xd1\x68\x46\x27\x70\xb3\xc8\x3a\x07\xfa\x18\xea\xf3\xc4\xe6\x86\xdb\xea\x2c\x65\x53\x80\x31\x55\xba\xff\x89\xca\xd0\xf1\x12\x92\x0c\x6d\x82\xd8\x70\xa9\xb1\x8e\x1b\xf9\x62\x40\xce\xb8\xf5\x64\x8c\x84\x9e\x76\x9b\x13\x9d\xca\x7e\x52\x83\xb0\xc2\x91\xdc\x23\xfa\x56\x2c\xb1\x80\xb7\x4c\x97\x7a\x69\xf0\xea\x41\x16\x3e\x98\x9e\x15\x06\xc8\x4d\xfc\x67\x66\x4c\xfb\x0e\xc7\x86\xc0\x21\x06\x1e\x01\x11\xf3\x70\x0a\xa4\x99\xa1\x58\x91\xf5\x9e\x9b\x61\x12\x68\xbc\xb0\xf1\x78\x36\x22\x98\xf1\x71\x92\x9b\x19\x55\x8b\x73\xce\xac\x24\xc9\xab\x62\xc6\x57\x09\x35\x00\xd6\xa6\x1e\x9a\xaf\xcc\x1a\x78\x85\x7d\x22\x21\x25\xa8\x4c\xb8\x32\x28\x56\xc0\x8c\x99\x54\x7a\x69\xe7\x0b\x67\x31\x55\x8a\x4d\xc2\x0d\x4e\x6a\xa4\x14\x73\xfb\xcc\x05\x81\xcf\x4c\x61\x34\xa4\x01\xda\xde\x78\x50\x1d\x4d\x83\xfb\xb3\x3a\x1d\xce\xb1\x4b\x05\xab\x25\x6a\xaf\xa3\xf4\x50\xe4\xda\x43\x18\x17\x5e\x32\x6d\x2b\x2a\xae\xbc\x64\xdb\xcb\x8a\xfa\x67\x2f\x2b\x36\x46\x1c\xd6\x7b\xd9\x9a\x20\xcd\xe0\x3d\x35\x36\x3c\x7b\x6a\xac\x7b\x36\x69\x6c\x16\x6a\xbd\xed\x83\xcf\x91\x80\x77\xba\xfb\xd7\x89\xb2\x1d\x5d\x5d\xe7\xa0\x4d\xea\x0d\xdc\xbc\xbb\x5e\xee\xe8\x0a\x3e\x3c\x5f\x2d\xa5\xdc\xb7\xc8\xc1\xf2\x22\x16\x97\x53\x93\x7a\x5f\x69\x50\x76\x67\x45\x4e\x56\x17\x7e\x1d\xf0\x7b\xd5\x2f\xbf\x6a\x07\xfa\xa4\xa2\xa2\xe4\xbf\xd7\x19\x6e\xde\x5d\x07\x96\xbf\x8c\x3d\xab\xdd\xd8\xf5\x19\xcb\xf0\x97\x03\xfb\x89\x7e\x91\x42\x80\xff\xfa\xf5\x97\x83\xb0\x21\xef\x9b\x26\xaf\x84\xfe\x57\xe0\xed\xb9\xab\x3b\x89\x0e\x5a\xe1\x82\x21\x02\x93\x9a\xb5\xb9\x8b\x3a\x37\x4d\xe5\xba\xdd\x28\x80\x3d\x22\xcc\x7c\xb7\x4c\x01\x59\x88\x1c\x6f\xe7\x15\x84\xf4\x34\x8a\x93\x22\xf6\xca\x32\xf3\xce\x6f\xf1\xcb\xc1\xd1\x2f\x07\xeb\xa5\x71\x0a\x4a\x09\x3a\x1c\x1e\x96\x86\xb6\x3f\x3a\xad\xe7\xa6\x45\x7b\x7e\xbc\x73\xf3\xc8\xa7\x66\xbb\x23\xd3\x82\xcd\xed\x8e\x8c\x01\x3f\x30\xe9\x36\x47\xc6\x1e\x8c\xc0\xa4\xdb\x1d\x19\x4b\x24\x21\x0b\xe2\xa1\xc8\xe7\x75\x99\x34\xb4\xb5\xe8\xa9\x26\x1e\xad\x88\xa0\x4c\x8a\x39\x8b\x5b\x6d\x4f\x7f\xe2\x0a\xcb\x93\x2e\xcd\xe2\x7e\x3b\x66\x66\xf5\x95\xaf\x05\xab\xcf\x55\x7d\xb2\x12\x80\x47\x72\x91\x69\xa2\x41\xa6\x1e\x56\x54\x29\xb0\xe2\x60\x19\x2b\xe1\x00\x6e\xa9\x44\x5a\x24\xdb\x4e\xe9\xdc\x09\x7e\x89\x15\x93\x89\x33\x95\xa7\x40\x13\x3d\x25\xd1\x14\xa2\x99\xaf\x73\x14\xbe\xed\x30\x9a\x45\x24\x38\x07\x1f\x63\x37\xf6\xf0\x41\x12\x1b\x2a\xc7\xd8\xc5\x8a\x37\xc2\x6b\x1c\xc1\x52\x64\x74\xe1\x57\x54\xd4\x62\x5a\xd9\x2d\xcc\x33\x03\x89\xf7\x32\x46\xab\x31\x04\x1d\x3e\x99\x2c\xb6\x83\x2d\x6a\xf1\x48\x4d\x85\xd4\x45\x81\x48\x3c\x12\x87\xee\xa7\x41\xe1\x5e\x1e\xa8\x79\xf4\x22\x9c\x6e\xc7\xa2\xa9\xad\xbd\xa7\x0c\x8d\x4b\xaa\xb4\xcc\x23\x6d\x30\x33\x01\x0e\xf6\x84\x2d\x35\x56\xd4\x2b\x31\x6d\x21\xc0\xb1\x5f\x7d\xeb\x99\xdc\xa8\xd2\xdb\x03\x9f\xc9\x7b\xe2\xef\xdd\xf2\x36\xf7\xda\xd1\x1f\x5d\x3b\x3a\xe3\x96\xcd\xbc\x89\x27\x70\x53\xb2\xc1\x4b\x91\xb0\xa8\xc1\x13\x44\xd6\xa9\xaa\x6a\x09\x4d\xc5\xad\x59\x6f\x0c\x34\xb1\xe8\x60\xee\x13\xbe\xfe\x55\x68\xf3\x6d\xe1\x55\x2c\x94\x3c\xec\x91\xe1\x6b\x6b\x1b\xda\x5a\x8e\x57\xe0\x0a\xb2\xfa\x89\x36\x29\x0b\xf7\x68\xaa\xd8\x5b\xe8\x8e\xc6\x1a\xb5\xd3\xd0\xd8\x1f\xca\x64\x7b\x0b\x8b\xc7\x56\x39\xcd\xf0\x3d\x2f\xfa\xe3\xf1\xa2\x0a\xff\xd9\xf4\xc8\x2c\x69\x70\x8b\x0c\x7a\x24\x61\x33\x20\x43\x88\x27\x60\x18\x49\x46\x95\xd2\x53\x29\xf2\xc9\xb4\xed\xf6\xa8\x50\x0b\x87\x1b\xea\x05\x2d\x5e\xbc\xbb\x47\x5a\x8b\x9b\x6f\xc7\xe2\xe4\x0f\x5c\x94\xbc\x35\x13\x21\x12\x46\x5f\x1d\x13\xea\x21\x0b\x19\x60\x00\xb1\xcf\x12\x74\x97\x6c\xfb\xc0\x9f\xa6\x97\xf6\x8e\xe0\xba\x87\x8b\x18\x2e\xbb\x37\xd9\x77\x05\xe5\x8a\x43\x23\x5c\x65\x4d\xe1\x8a\x3c\xbd\x77\xd3\x75\x8a\xb1\x1c\xd3\x44\xed\x18\x64\xd9\x8d\x37\xf4\x47\xa1\x06\x16\xdd\x79\x84\x6f\x42\xb1\xbf\x6b\xa9\x7f\xf6\x47\xac\xee\x71\x44\xb8\x69\x7b\x20\x47\x74\xca\x2b\x51\xe8\xa5\xf8\xf9\x67\x9a\xb1\x89\x14\x79\x76\xf4\xab\x6b\x06\x73\xfc\xeb\x8c\xf1\xf8\xf8\xe7\xc2\xd9\x70\xf4\x6b\xd0\x8f\xf1\x24\x22\x7c\xcd\xec\xe9\xef\x5d\x4a\xbc\xec\x6b\x30\x3e\xe1\x1a\x8c\x5a\x24\x10\xc2\x5c\x3b\x6b\xbd\x29\x66\xd8\x73\xd5\x86\x67\xcf\x55\x6b\xdf\xa1\x8c\x37\xb5\x66\x5f\x5b\x93\x21\x3d\x5f\x5f\xcb\x0e\x44\x53\xc7\xd2\x9e\x31\x6d\x2b\x2c\x76\xf8\x16\x16\x3f\x7f\xf7\xd1\x98\xc3\xbf\x1e\xbf\x19\x8f\x21\xd2\x3f\x1f\x5f\x63\x61\x6f\xf5\x6b\xb3\x55\xf5\x24\xd8\xaa\x96\x34\xea\xa6\xe7\xec\xd9\xea\x93\x65\xab\x81\x3f\x7a\x91\xbf\x36\x66\x09\x8f\x86\x94\x6d\xbd\xd6\xb7\x65\xa8\xbb\x1b\x8a\x5e\x36\x29\x92\xc4\xde\x5f\x30\x45\x6a\x02\xbb\x1b\x40\xaa\xa1\xdd\x5a\x58\xd7\x7e\x89\xf8\x8d\x2b\x18\x55\x5a\x48\x3a\x81\xea\x6f\xf2\xd1\x5a\x11\x49\xa5\xa9\xce\xd5\x31\xf9\xff\xfe\xff\x67\xff\x2f\x00\x00\xff\xff\x63\xb9\x55\x90\x21\xa9\x02\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\xbd\xe9\x72\x23\x37\xb2\x28\xfc\x9f\x4f\x81\xe8\xfe\x21\x69\x82\xa4\xec\xd9\xce\x7c\x3a\x31\xf1\x85\x46\x2d\x8f\x35\xbd\x48\x57\x94\x3d\x67\xe2\xde\x1b\x26\x58\x95\x24\x31\x2a\x02\x65\x00\x25\x35\x1d\xe7\xe1\x6f\x24\x96\xda\x54\x85\x02\x49\xf5\x62\x1f\xb5\x3a\xec\x16\x59\x95\x48\x24\x12\xb9\x21\x33\xf1\x9a\x4c\x9e\xef\xcf\xe8\x35\x79\xc7\x12\xe0\x0a\x52\xa2\x05\xd1\x6b\x20\xe7\x39\x4d\xd6\x40\x66\x62\xa9\x1f\xa9\x04\xf2\x9d\x28\x78\x4a\x35\x13\x9c\x1c\x9f\xcf\xbe\x3b\x21\x05\x4f\x41\x12\xc1\x81\x08\x49\x36\x42\xc2\xe8\x35\x49\x04\xd7\x92\x2d\x0a\x2d\x24\xc9\x2c\x40\x42\x57\x12\x60\x03\x5c\xab\x29\x21\x33\x00\x03\xfd\xc3\xf5\xdd\xd5\xc5\x25\x59\xb2\x0c\x48\xca\x94\x7d\x09\x52\xf2\xc8\xf4\x7a\xf4\x9a\xe8\x35\x53\xe4\x51\xc8\x7b\xb2\x14\x92\xd0\x34\x65\x38\x30\xcd\x08\xe3\x4b\x21\x37\x16\x0d\x09\x2b\x2a\x53\xc6\x57\x24\x11\xf9\x56\xb2\xd5\x5a\x13\xf1\xc8\x41\xaa\x35\xcb\xa7\xa3\xd7\xe4\x0e\xa7\x31\xfb\xce\x63\xa2\x2c\x58\x33\xa6\x16\xe4\x5f\xa2\x70\x73\xa8\x4d\xd7\x51\x61\x4c\x7e\x04\xa9\x70\x90\xdf\x4f\xbf\x19\xbd\x26\xc7\xf8\xc8\x2b\xf7\xe5\xab\x93\xff\x24\x5b\x51\x90\x0d\xdd\x12\x2e\x34\x29\x14\xd4\x20\xc3\xc7\x04\x72\x4d\x18\x27\x89\xd8\xe4\x19\xa3\x3c\x81\x6a\x5a\xe5\x08\x53\x62\x10\x40\x18\x62\xa1\x29\xe3\x84\x9a\x69\x10\xb1\xac\x3f\x46\xa8\x1e\xbd\x1e\xbd\x26\xe6\xcf\x5a\xeb\xfc\xec\xf4\xf4\xf1\xf1\x71\x4a\xcd\xea\x4c\x85\x5c\x9d\xfa\xd9\x9d\xbe\xbb\xba\xb8\xfc\x30\xbb\x9c\x18\x94\x47\xaf\xc9\x0f\x3c\x03\xa5\x88\x84\x9f\x0b\x26\x21\x25\x8b\x2d\xa1\x79\x9e\xb1\x84\x2e\x32\x20\x19\x7d\xc4\x85\x33\xab\x63\x16\x9d\x71\xf2\x28\x99\x66\x7c\x35\x26\xca\xad\xfa\xe8\x75\x63\x75\x2a\x72\x79\xf4\x98\x6a\x3c\x20\x38\xa1\x9c\xbc\x3a\x9f\x91\xab\xd9\x2b\xf2\xb7\xf3\xd9\xd5\x6c\x3c\x7a\x4d\xfe\x79\x75\xf7\xfd\xf5\x0f\x77\xe4\x9f\xe7\xb7\xb7\xe7\x1f\xee\xae\x2e\x67\xe4\xfa\x96\x5c\x5c\x7f\x78\x73\x75\x77\x75\xfd\x61\x46\xae\xbf\x23\xe7\x1f\xfe\x45\xde\x5e\x7d\x78\x33\x26\xc0\xf4\x1a\x24\x81\x8f\xb9\x44\xfc\x85\x24\x0c\x09\x09\x29\xae\xa9\x67\x20\x8f\x00\xf2\x07\xfe\xae\x72\x48\xd8\x92\x25\x24\xa3\x7c\x55\xd0\x15\x90\x95\x78\x00\xc9\x91\x3d\x72\x90\x1b\xa6\x70\x39\x15\xa1\x3c\x1d\xbd\x26\x19\xdb\x30\x6d\xb8\x48\x3d\x9d\x14\x0e\xe3\x37\xc6\x33\xfc\x19\x8d\x68\xce\x1c\x3b\x9d\x11\x9a\x33\xf8\xa8\x81\x1b\x6c\xa6\xf7\x7f\x51\x53\x26\x4e\x1f\xbe\x1d\xdd\x33\x9e\x9e\x91\x8b\x42\x69\xb1\xb9\x05\x25\x0a\x99\xc0\x1b\x58\x32\x6e\x38\x7f\xb4\x01\x4d\x53\xaa\xe9\xd9\x88\x10\xca\xb9\x70\xc8\xe3\xaf\xc4\xee\x3a\x91\x65\x20\x27\x2b\xe0\xd3\xfb\x62\x01\x8b\x82\x65\x29\x48\x03\xdc\x0f\xfd\xf0\xcd\xf4\xcf\xd3\x6f\x47\x84\x24\x12\xcc\xeb\x77\x6c\x03\x4a\xd3\x4d\x7e\x46\x78\x91\x65\x23\x42\x32\xba\x80\xcc\x41\xa5\x79\x7e\x46\x12\xba\x81\x6c\x72\x3f\x22\x84\xd3\x0d\x9c\x11\xc6\x35\xac\xa4\x79\x3b\xcf\xa8\xc6\xcd\xa8\xa6\xe6\xa1\x1a\x4b\x8e\x70\x31\x10\xc8\x4a\x8a\xc2\x03\xa9\x7f\x6f\xa1\xb9\x71\x12\xaa\x61\x25\x24\xf3\xbf\x4f\xc8\x3d\x3e\xef\xfe\x9d\x94\xff\xb6\x14\xba\xaa\x10\xb8\x71\x08\x98\x27\x33\xa6\xf4\xdb\xbe\x27\xde\x31\xa5\xcd\x53\x79\x56\x48\x9a\x75\x4f\xc3\x3c\xa0\xd6\x42\xea\x0f\x15\x72\x13\xc2\x72\xfb\x05\xe3\xab\x22\xa3\xb2\xf3\xdd\x11\x21\x2a\x11\x39\x9c\x11\xf3\x6a\x4e\x13\x48\x47\x84\x38\xca\x9b\x79\x4d\x6a\x52\xec\x46\x22\x0c\x79\x21\xb2\x62\xe3\xd7\x70\x42\x52\x50\x89\x64\x39\xe2\x7d\x66\x44\x57\x6d\x20\xe2\x47\x22\xf9\x9a\x2a\x30\x18\x11\xf2\x6f\x25\xf8\x0d\xd5\xeb\x33\x32\x55\x9a\xea\x42\x4d\xeb\xdf\x22\x89\xcf\xc8\x4d\xed\x13\xbd\x45\x14\x51\xd8\xf2\xd5\xa8\x7a\xe4\x01\x79\x02\x67\xb0\x86\x8d\x61\x30\xfc\x4d\xe4\xc0\xcf\x6f\xae\x7e\xfc\xc3\xac\xf1\x31\x69\xa2\xd9\x41\x6b\xc2\x50\xce\x02\x91\x8e\x89\x51\x3c\x1a\xf9\x92\x4a\xf6\x60\xf7\xee\x05\xae\x29\x79\x5b\x82\x34\xa3\x49\xaa\x85\x24\x0b\x58\xd3\x07\x26\xe4\x94\x5c\x69\x92\x22\xff\x83\x05\xe7\xbf\x40\xf9\x48\xb3\xcc\xed\x14\xe2\xb7\x8a\x22\xc7\xf3\x1a\x32\x6f\x99\x9e\x8f\x6b\xf0\xeb\xdf\xcd\xc7\x64\xfe\x16\x31\x00\x3d\x3f\x41\x39\x8d\xe0\x57\xec\x01\xb8\xe5\x4a\x5c\xbd\x29\xf9\xe7\x1a\x78\x1d\xd9\x12\xc5\x1a\x54\xa6\x08\xe3\x4a\xd3\x2c\x83\x14\x01\xcd\x57\x99\x58\xd0\x6c\x4e\x36\x22\x85\xb1\xd1\x11\x8f\x2c\xcb\x08\x77\x12\x16\xb7\x05\x5b\x6e\x51\x44\xce\x3b\x28\x37\xaf\x83\xe6\x04\x68\xb2\xae\x30\x22\x8f\x6b\x90\x60\x61\x52\xae\x3b\x51\x43\x2a\x2f\x50\x03\x41\x82\xd2\xb8\x04\x97\x4b\x44\x5e\x97\x3b\xcc\xfe\xad\x49\xa5\xda\xa7\xad\x05\x3e\x42\x1e\x70\xaa\xb0\xbe\x1c\x8e\xb5\x21\x75\x6c\x83\xcb\x62\x74\xa0\x04\x94\xda\xc0\xad\x80\x6a\x00\x26\xf8\x10\xe5\x44\x2c\xfe\x0d\x89\x9e\x92\x19\x48\x04\x43\xd4\x5a\x14\x59\x8a\x52\xec\x01\xa4\x26\x12\x12\xb1\xe2\xec\x97\x12\xb6\xf2\x26\x49\x46\x35\xb8\x8d\x5c\xfd\xe0\x2e\x91\x9c\x66\xe4\x81\x66\x05\x8c\x51\xc0\x1b\xcd\x2c\x01\x47\x21\x05\xaf\xc1\x33\x8f\xa8\x29\x79\x2f\x24\x6e\xaf\xa5\x38\x33\x3a\x55\x9d\x9d\x9e\xae\x98\xf6\xd2\x38\x11\x9b\x4d\xc1\x99\xde\x9e\xd6\xcc\x19\x75\x9a\xc2\x03\x64\xa7\x8a\xad\x26\x54\x26\x6b\xa6\x21\xd1\x85\x84\x53\x9a\xb3\x89\x41\x9d\xe3\x84\xd5\x74\x93\xbe\xf6\xac\xaf\x8e\x1a\xb8\x3e\xd9\x7e\xf6\xaf\x91\x6b\x81\x15\x40\xa9\x86\x9b\x8a\xba\x57\xed\x44\x2b\x42\xe3\x47\x48\x9d\xdb\xcb\xd9\x5d\xb5\xeb\x70\x31\x1a\x40\x89\xa3\x7b\xf5\xa2\xaa\x96\x00\x09\xc6\xf8\xd2\xe8\x41\x34\x64\xa4\xd8\x18\x0e\x03\x9e\xe6\x82\x39\x76\x4b\x32\x06\xbc\x4d\x7e\x55\x2c\x36\x4c\x5b\x2b\x03\x94\xc6\xb5\x9a\x92\x0b\xa3\xa2\xc8\x02\x48\x91\xa7\x54\x43\x3a\x25\x57\xdc\xb2\xeb\x05\x55\xf0\xc9\x17\x00\x29\xad\x26\x48\xd8\xb8\x25\xa8\x6b\xd7\xea\x0f\x42\x39\x73\x54\xab\x7d\xe1\x95\x5b\xcf\x7a\x75\x6c\xec\x59\x0e\x49\x43\x98\xa5\xa0\x8c\x45\x86\x52\x1b\x70\x57\x74\xbc\xd4\x18\xa1\x7b\x07\xe3\x8f\x51\xf4\xed\x0f\x5b\x28\x79\xb9\xb3\x16\x8f\xb8\x95\xcc\x2b\x06\x8f\xda\xb0\xa7\xb5\x7f\xbf\x65\xba\xcd\x3b\x21\x14\xf0\xe7\xa6\x58\x64\x4c\xad\x67\x5a\xa2\x36\xdf\x5e\xe7\x35\xf3\xa4\xfd\xa7\xae\x08\x43\x30\x03\x0b\x36\xb8\x48\xfe\x67\x41\x15\x5c\x6d\xe8\x0a\xba\x07\x68\x90\x89\x9a\xa7\x09\xc3\xc7\x89\x5e\x53\x4d\x12\xca\x0d\x13\xa3\x06\xa3\xca\x7e\x9d\xd1\x2d\x48\xeb\x96\x18\x93\xa9\xeb\xc7\x80\x50\x46\x87\x55\x20\x96\x45\x46\xd8\xb2\x26\xc1\x05\xd2\xf4\x81\xa5\x40\x94\xd8\x00\x49\x8c\x46\xeb\x81\x58\xc3\x0c\x7d\x09\xb2\x2c\xa4\x31\x92\x0b\xcd\x32\xa6\xb7\xa5\xc1\xae\x02\x44\xea\xa5\xa2\x61\x08\xbf\x74\x11\x84\x42\xd6\x51\xee\x71\x64\x28\x9a\x8a\x5c\x1b\x92\x18\x48\x28\x90\x28\xaf\xf3\xf4\xe0\xa4\x3a\x1f\x00\x5e\x6c\xba\xb1\x99\x10\x29\x0a\xcd\x38\x8c\x3a\xbe\x24\x13\x92\x8b\x74\xd4\xfa\x30\x86\x0e\xf7\x94\xb3\x7b\xf1\x37\x9c\xc3\x05\xba\x57\x11\xa4\x38\x7a\x83\xd2\x14\x4d\xd8\xf4\x8c\xfc\xa0\xa0\x67\x23\x18\x3b\x01\x68\x4a\x80\xa3\xf3\xd5\xbd\x4a\x84\xbc\x35\x08\x90\xdc\xc2\xa8\x68\x9c\x20\x36\x47\xa3\x8e\x37\xdc\x94\x16\x42\x64\x40\xbb\xe8\x7c\xcf\xf4\x7b\xaa\x93\x35\xe3\xab\x88\xe9\xe0\xca\x26\x82\x2f\xd9\xaa\x70\x86\xa7\x73\x47\x37\x0e\x86\xff\xbd\xb6\xb8\x7d\x93\xa1\x2b\x8a\xb3\x36\xaf\xc3\x47\xa6\xd0\xb3\xac\xbf\x87\x52\x06\x3d\x4d\xbb\xd5\xf4\x1a\xb6\x04\xe3\x0c\x12\xd0\x60\xec\x84\x19\x16\x41\xf8\x43\xb3\x4c\x3c\x5e\x7e\xd4\x92\xbe\x81\x1c\x78\x0a\x3c\x09\x3c\xdd\x9a\xfc\xe3\x1a\xcc\x9e\x6a\xf2\xee\x5b\xa6\xdd\x3e\x45\xfc\x31\xce\x41\xd2\x1a\x6c\xc4\xbe\x8f\xbf\xf1\x07\x67\x2f\x38\xb4\xdc\xf0\xc6\x08\x5e\xcc\xd8\x99\x93\xe3\x14\x96\xb4\xc8\x34\x99\x6b\x59\xc0\xfc\xa4\x17\xf8\xd0\xd2\x97\x04\x81\xf4\x16\x56\xc6\x4b\x8f\xa6\x05\xa2\x9d\x4b\x58\xb2\x8f\xa0\xfc\x9a\xcb\x12\xc8\x98\xc0\x74\x35\x25\x73\xf7\xc9\x76\x0a\x1f\xe9\x26\xcf\x60\x9a\x88\xcd\x29\xcd\x91\x5c\x90\x36\x0c\xf0\xf6\x4f\x8b\x87\x90\xc8\x46\x10\x28\xb2\x29\x94\xc6\xd0\x09\x38\x2b\x44\x58\xde\x6b\xd1\x6c\x4c\x0a\x1b\xdd\x68\x01\x0a\x0c\x99\x42\x92\xa1\x84\x24\x68\xae\x88\x47\x6e\x82\x32\x86\x3b\xe7\x6d\x07\xf5\xf4\x9e\xe9\x69\x35\xdd\x79\xcd\xe5\x26\xc7\xad\x11\xdf\x32\x3d\xea\x1d\xd3\x4f\x0a\x57\xb8\x9a\x13\xe5\x5b\x4f\xcc\x2d\xda\xf5\x9c\x14\x5c\x81\xee\x5f\x69\xa6\x61\x13\x58\xb9\x41\xc1\x56\x7f\x88\x4a\x49\xb7\x3d\xcf\x18\xf1\x7d\x0b\x3f\x17\x50\xc0\xdf\x68\x72\x2f\x96\xcb\x48\x7e\x41\xd3\x62\x53\x24\x6b\xa2\xd9\x06\x50\x27\x3c\x52\xa6\xc7\xe4\x71\x8d\x21\x31\x4a\x38\x3c\xb6\x68\x86\x16\x2d\x0e\x67\xf4\x46\xef\x18\xa4\xbd\x55\x8c\xac\xe0\xa2\x0d\xcc\xb0\x08\xa8\x31\x59\xc0\x12\x77\x68\x6b\x8d\x70\x30\xfb\x48\x1a\x1a\x0a\x05\x56\x43\x6c\x35\x47\x51\xe4\xb8\x1f\xae\x7b\x5b\x70\x02\x0f\x20\xfb\x08\x8c\x3f\xe8\x8f\xf0\x84\x65\xcc\x82\x88\x59\xff\x88\xe5\x6d\xe8\xf0\x2b\xbe\xcc\x0a\x94\x7d\x7c\x15\xb9\x7e\x5e\xf6\xe1\x04\x0d\xa8\x4a\xf9\x30\x07\x0c\x54\x17\x4d\x42\x9b\xdc\xcb\x76\xca\x3b\x16\x5f\xf0\x6c\xeb\x45\xde\x62\xdb\x06\xed\x99\x03\xf7\x68\x60\x04\x7c\x4b\xd1\x4d\x65\x8e\x1c\x24\x2c\x13\xca\x05\x67\x09\xcd\xee\x24\x65\x5a\xed\x41\x3a\x6d\x5e\x6c\xaa\x50\x65\xd4\x1a\x06\x83\x29\xda\xfc\x36\xe0\xc0\x64\x35\x5a\xef\x30\x04\xf7\xc6\x66\x4c\xd8\x14\xa6\x63\x34\x79\x78\x3a\xd1\x92\xe5\x39\x86\x13\xd6\x52\x14\xab\x75\x7d\xd4\xb4\x0c\x17\x2a\xdc\x79\xc0\x2b\xad\xdd\x3b\x65\xfc\x5b\x23\x7b\x88\xfb\x2b\x5d\x6d\x96\x0e\x07\x56\xb0\xa1\x5c\xe3\x1c\xb2\x2d\xd9\x00\xc5\x68\xeb\xb2\x08\x4d\x28\x65\xcb\x25\x48\xcb\x4d\x89\x28\xb8\x1e\x13\x49\x1d\xf9\xa8\xa7\x8c\x02\xc9\x68\xc6\x7e\x09\x4b\xf4\xa8\x15\x15\x12\xf6\xb0\x04\x70\x6e\x49\x5b\xd3\x8f\x11\x3b\x22\x0c\xb2\x0d\x0b\x60\x01\x2e\xc8\xcc\xf2\x35\x48\x9a\xc5\x6c\x89\x92\x84\x2d\x22\x13\x13\xa8\x46\x03\xba\x85\xc0\xd6\x05\x4f\x6a\xcf\x07\x86\x41\x8e\xb3\x01\x10\x48\x2d\xde\x15\x7a\x75\x98\xf5\xe5\x90\x94\xdf\xfb\x58\x42\x0b\xab\xc0\x48\x46\x24\xbe\xa7\x18\x3d\x4b\x84\xc0\xe3\x18\x0c\xd2\x18\xdd\x2a\x0a\xed\xe3\x44\xa5\x0e\xdf\xfa\x4f\xbe\xbc\xb2\xab\xc8\x70\xf9\x73\xc1\x1e\x68\x66\xc8\xb0\x03\x87\x98\xe8\xb6\xb1\x8e\x4c\x0c\x2a\x59\x53\xbe\x02\x34\xef\x2b\xd0\xc6\x56\xa2\xc8\xea\x9b\x0d\x25\x0a\x72\x8a\x62\x2a\xa4\x84\x30\x8e\xed\xed\x2b\x4a\x1e\x80\xa7\x02\xa5\xc6\x12\xcf\xc2\x30\xb4\x85\x3c\x52\xe4\x4a\x4b\xa0\x1b\x5c\x59\xaa\x89\xa2\x9a\xa9\xe5\xd6\x06\x0c\x2d\x7f\xe2\xee\x0f\x0c\x52\x93\x0b\xb1\xbb\xbf\x67\xa1\x03\x83\xfc\xaa\x58\xc0\xf8\x48\xef\x45\x0a\x3b\xac\x3f\x46\x79\x2b\xf7\x17\x49\x4e\x92\x35\x24\x6e\x13\x51\xed\x42\x3d\x15\x2b\x20\xab\xc4\x4b\x62\x09\x3e\x3e\xd0\xe1\x33\x84\xac\xce\x7e\xbf\x19\x7f\x26\x44\x15\x39\x48\x05\xfd\x00\x26\x24\xc9\x84\x2a\x24\x0c\x90\x36\x48\xff\x94\x29\xdc\x0b\xb7\xe8\xd4\xec\xa1\x49\x9b\x73\xb5\x3a\x94\xa3\x69\xe5\x6c\x86\x4a\x1b\xe1\x87\xdb\x3a\x6d\x7a\x07\x73\x56\x52\xe5\x01\x34\x07\x71\xbb\x4e\x0b\x92\x32\xba\xe2\x42\x79\x53\x88\x29\x55\x80\x3a\x48\x11\x01\x95\xd9\xf6\x16\x74\x21\xf9\x0c\x05\x7b\x24\x45\x90\x83\x14\x3e\x3f\x26\x45\x8e\xbc\xf6\xed\x37\xdf\x8c\xad\x13\xf1\xb8\x66\x68\x6e\xaf\x81\x64\x42\xdc\x17\x79\x87\x67\x3e\x20\xba\xfb\xa4\x80\x34\x68\x2a\x42\x15\x51\x02\x39\x51\x3d\x65\x3e\x22\x51\xda\x18\x67\x6a\xec\x85\x7d\x60\x28\xc0\x98\x35\xd5\x5e\xbd\x48\xd8\x50\xc6\x3b\xe3\x01\x5a\xd2\xd4\x3f\xb6\x00\xa5\xdb\xe3\x0e\x78\x0b\x64\x49\x95\x06\xe9\x69\x72\x8c\x47\x45\x7d\xec\xe4\x90\x82\x74\x07\x1b\x1c\x25\xfd\x0a\xfa\x50\x80\x8f\x49\x56\xa4\x36\xe0\x88\xde\xe9\x1e\x7c\x2f\x81\xa6\x0d\x6e\x46\xb2\x58\x46\x5f\xd3\x07\x20\x5c\xb8\x30\xa0\x99\x81\x1d\x2f\xa4\x4f\x0c\xaf\xf8\x95\x1e\x77\xd2\xc2\x00\x47\x70\x4a\xe3\x69\x55\x19\xc3\xb3\x76\xcd\xb0\xdf\x64\xf3\x30\x70\xbe\x87\x6d\x11\x47\x3c\xfe\x40\x33\xd6\x3a\x11\x89\x22\x5d\x7b\x5e\xc8\x96\xe6\xd4\x04\x8f\x46\x09\xe3\x89\xe0\x8a\x29\x0d\x3c\xc6\xad\x60\xd2\x84\xcd\x1b\x64\x6e\x12\xf3\xc0\xc9\xb6\x62\x54\x77\x22\x03\x89\xb9\x22\x91\x13\xc7\x85\xe4\xc5\x66\x81\x29\x38\x4b\x0b\xae\xa9\x6b\x9e\x6e\xd9\xa4\x17\x1b\x17\x5c\x33\xb1\x68\xa3\xc7\xba\xe0\x49\x64\x3e\xed\x23\x4a\x07\xed\x13\x96\xe2\x61\x8d\xde\xbe\xab\xa5\x1b\x44\xcd\xd8\xe6\x27\x3c\x35\x85\x2b\x1e\xce\xa9\xd4\x5e\x14\xfa\x61\x7a\xe1\x13\x7c\x12\x59\xa5\xd7\xd5\x89\x74\x5d\x03\x23\xd4\x5e\x57\x04\xcf\xb0\x9d\x70\x33\x6e\x2b\x4a\x20\x50\x3e\x89\x45\xf9\x09\x7e\x71\xc3\x88\xad\xb8\x90\x56\x8e\xed\xe1\x3e\xd5\x37\xe5\x13\x1e\xb2\xa0\x9d\xcc\xf5\x7b\xa9\xbd\x7f\xfb\x29\x40\x88\x2c\xb8\x0b\xa3\x91\x5c\x9a\x44\x17\x8c\x17\x72\xcc\xa1\x02\x4c\x15\xa2\x2b\x70\x36\xb7\x8d\x63\xbb\xac\x32\x1f\xdb\x76\xcf\x19\x4f\x3d\xe4\xa9\x05\x3d\xe1\x92\xdd\x74\x39\x0b\xcb\x0b\xfd\xa7\x19\xb1\x92\xc1\x87\x5b\x18\x5f\xed\x14\x86\x30\x78\xbe\x29\x83\xb5\x2e\x26\xf0\xb8\x46\x33\x26\x14\x8f\x78\x7e\x57\x61\x8c\xd9\x0b\xfe\x90\xcf\x9f\xdd\x5b\x7c\x02\x03\x19\x92\x96\xb1\xa6\x2e\xb8\x5f\x7c\x57\xa0\x6f\xf6\xc6\x9a\xb5\x81\xf3\xa1\xe0\x76\x68\x4d\xa9\x2f\xbc\x6d\x96\x88\x66\x8f\x74\xab\xcc\xa8\x41\xfd\x5b\x9e\xd6\x9f\xdf\x5c\x11\x65\x92\x2a\x9a\x51\x15\xb4\x29\xaa\x33\x7d\xcc\x7b\x90\x1b\xc0\x00\x14\x26\x78\x0a\xa4\x36\x86\xdf\x15\x51\x5b\x9e\x40\x7a\x10\xf7\x22\xb2\x97\x56\x5d\x5e\x4a\x29\xa4\x7a\x06\x1a\x31\x4e\x00\x61\x75\xe8\xe2\xca\xfc\xed\x1d\x86\xf8\x1d\xd1\x06\xdb\xc3\xd9\x4d\xd2\x59\x1b\xa8\xb2\x14\x31\x7d\xd0\xc3\x7d\xfa\xc7\x87\x5c\x0e\x22\xa1\xc1\xeb\x82\x6a\x9a\x89\x55\x67\x82\x4e\x14\x11\x4d\xb2\x85\xf7\xb4\xab\x23\x9c\x82\x9b\x00\x7d\x62\xc1\xb7\xad\x84\x81\xe9\x75\x51\x51\x53\xb9\x02\x6d\x4f\x6d\x16\x5e\xe5\x26\x34\x2b\x13\x33\x7b\x39\x3e\x5a\x6d\x1a\x69\x6e\xce\x07\x31\x4d\x05\x33\x5c\xb2\x07\x28\x43\x57\x28\xfc\x37\xb9\xe0\x98\x60\x7c\x38\xdd\x4b\x6d\xb7\xbd\x93\x00\x6f\xd8\x0a\x94\x7e\x06\x16\x36\xe4\x31\xf6\x3b\xd2\x30\x35\x60\xdd\x9a\x30\xe9\xa7\x14\x22\x7d\xa9\x46\xb7\x44\x4b\x00\x02\x3f\x17\x34\xf3\xb2\x15\x73\xb0\x5b\x0b\x59\x1e\x76\x05\x0e\xb9\x2a\x98\x53\x84\x39\xb5\x68\xcd\x47\x3d\x28\x90\x7a\xe6\xe9\x18\x53\x19\x28\xdf\x1e\x4e\x71\x9f\xef\xf2\x77\xe0\x60\x91\xdf\x91\xde\xbd\xa1\x54\x63\x4b\xd5\xb5\xff\xaa\x1c\xc2\xd1\x3e\x8e\xff\x3c\x86\x46\xfe\x38\x5d\xef\x23\x74\x9d\x67\x22\xee\xec\xc2\x9d\x45\x51\x62\x23\x83\x3b\x49\x28\x3f\xe6\x98\x64\xec\xde\x9f\xa0\x55\xf9\x12\x63\x93\xe2\x86\x79\x25\xdc\xe4\x12\xa1\x4a\xea\x3a\x99\x54\xc3\x83\xda\x80\x61\xc7\xd0\x95\xc9\xef\x4c\x5d\xb4\x75\x4e\x0e\x5f\xf0\xd9\xdf\xae\xdf\x3f\xd7\x96\xa2\x99\x12\x26\x61\x50\xa6\x84\x96\x49\x30\x64\x81\x7e\xac\x58\xe2\x96\x36\x47\x09\xc1\x53\xaa\xcc\xa5\x26\x20\xf5\x2a\x51\xd2\x1e\xb3\x69\xc8\x7a\x21\xa4\xc5\xb8\x4f\x85\x07\x46\xec\x5a\xa8\x3a\x73\xa1\x21\xd9\x18\xce\xb0\x78\x7c\x90\x62\x68\x11\x3e\xce\x4c\xb6\x70\xb5\xe3\x66\xf7\xf0\xb8\x97\xdf\x59\xed\x28\xbb\x11\x9c\xaf\xfd\xd4\x3f\x0b\xfb\x9e\x19\x5d\x61\xe6\x2f\x73\xf1\x6d\xe3\x78\x77\xe8\x0f\x97\xdf\xea\xa9\x14\x30\x41\x03\x63\x1d\x37\x25\x41\xc5\xe5\x26\x76\x0b\x69\x14\x9d\x71\x6b\x52\x6d\x72\xc1\xff\xfc\xc7\x83\x5c\xe1\xcd\xc1\x31\xe7\x01\x0b\xbd\x17\x36\x09\x66\xe6\xec\x1d\x5d\x5e\x16\xbd\x69\x71\x18\x59\xae\xf3\xf5\x04\xf9\x7a\x74\x80\xd1\xce\x05\xaf\x1d\x75\x9f\xa7\x69\x6f\xce\x61\x27\x31\x6b\xee\x12\xc5\x77\xfd\x41\x6a\xe9\xdd\x75\xfa\xaa\xf1\xc9\x0a\x01\x06\x75\xfa\x63\x01\x09\xb5\xe5\x43\xb0\xb5\x61\x85\xd2\x03\x0a\x0c\x53\xb3\xe4\xbe\xb8\x4f\xd4\x5c\x82\x67\xf0\x58\x87\xfd\x40\x3c\xdc\x16\x65\x51\x43\xd7\x4f\x87\xbf\x6b\xce\x0e\xf4\x1a\x30\x4c\xd9\x13\x83\xd8\x6b\xff\xb4\x70\xfb\xe2\xeb\xe1\x6b\x01\x9c\xe7\x70\x8b\x96\xc7\x0e\xeb\xe1\x4f\xf4\xbd\xe7\x80\x11\x13\xcc\xad\xf0\x39\xe0\x65\xa9\x81\xff\xde\x18\x59\x44\x2c\x77\x23\x93\xd5\xde\xee\xe0\x72\x5c\x66\x47\x8a\xa5\x77\xb8\xea\xe6\xad\x1f\x6a\x70\x10\x8f\x9c\xcb\x7e\xe8\xc4\x97\x55\x99\x78\xa3\x03\x96\xc2\x97\x94\x3d\xc0\x21\x2c\x7f\xcf\x2a\x56\x47\x0e\xac\x6d\x01\x37\x07\xb1\x61\x1a\xfd\xce\xc5\x36\x5a\xec\x8c\x09\x4d\x4c\x09\x22\x1e\xb5\x36\x76\x82\x1f\xb6\xb5\x18\x5f\x9c\x67\x73\xc6\x39\xa4\x7b\x04\x1a\x71\x32\x73\x73\x0c\x7f\x46\xa5\x66\x4b\x9a\xe8\x79\x23\x0d\xc1\x4d\xd8\x1e\x5b\xd7\x55\x4f\x2f\x7c\x62\x85\x8b\xe7\x17\xc7\xa9\x46\xc7\x12\xf8\x48\x13\x9d\x6d\xc7\x75\xae\x2c\xc7\xb0\x76\xf4\x4e\x23\x59\x5e\xdf\x45\x18\x7d\x9d\xcb\x27\x61\x09\xf2\x22\x13\x0a\x94\xbe\xb5\x11\x86\x0b\xc3\x77\x91\xcb\x18\x0a\xfe\xd8\xd5\x28\xe3\x16\x75\x76\x76\x89\x51\xbd\x63\x10\x1f\xf2\xad\xed\x00\xa3\x5d\x52\x01\xca\x9c\x65\x34\xd5\x0c\x7a\x6d\xe9\xd8\xd7\xaf\xe1\x11\x38\x7a\xe9\xd6\xc7\x0e\x0c\x82\xde\xf7\x53\x83\x17\xad\x4a\x4c\xa0\x05\x89\x6b\x4b\x37\x82\xaf\xc2\x51\x28\xa6\x0f\xb2\xea\xed\x58\xdf\x99\xd3\x4f\x93\xc1\xae\x9e\x81\xf8\x86\x5a\x2e\x4b\xd3\x40\x1e\x13\x4a\x72\x29\x3e\x6e\x07\xcf\x61\xd5\x06\xcb\xe2\x5c\x3c\xde\x48\x34\x24\x2c\x3a\xb3\xd9\xd6\x1f\xd2\x6a\x41\xf2\x22\xcb\xc6\x7d\xc4\x0a\x0c\xd0\x4b\xc6\xe6\x32\x1c\x48\x52\x81\xb5\xe2\x17\x18\x29\xd7\x6c\x61\x0a\x2d\xfa\x89\xba\x4b\x55\x4b\xd4\xee\x6c\xad\x93\xd1\x33\x37\x16\xa5\x5a\x0d\x97\x09\x0f\x5b\x21\x54\xb3\x9f\x90\xa0\xb6\xd4\x20\x14\x54\x72\x9e\x4c\xb3\x7e\xda\x55\x34\x1a\xd2\x9e\xd6\x4a\x50\x83\x70\xa2\x24\x49\x8c\x2c\x69\x4d\xfa\x08\x51\x73\xeb\x50\x4a\xda\xf6\xda\x63\x26\x42\x7d\xd1\xfd\x0b\x01\x44\x30\x1f\xdb\x44\x35\x9e\x9c\x55\xf8\xd4\x10\x07\xc3\xd9\xe9\x73\xac\xa9\x96\x1c\x34\xa8\x33\xf2\xbf\xef\x39\xd5\xec\x01\xfe\xef\x7c\x34\x4c\xd7\xb7\xe5\x8b\x75\x14\x4d\x18\xd4\x20\x60\x66\xf4\xd6\x02\xec\xcc\xb9\x0a\x8c\xd1\x7e\xbc\x2a\x9f\x20\x34\x91\x42\x29\x3f\x0b\x35\xae\x52\x89\x8c\xab\x51\x86\x22\xdd\x03\x81\x41\x4a\xc6\xa8\x2b\x35\x67\x9d\x58\x2f\xf9\x68\x14\x5e\xef\xde\xe2\x2c\xfc\xfb\x73\x41\x25\xe6\x90\x72\xb8\x5b\x4b\x50\x6b\xd1\x55\xcd\xd6\xc9\x1b\xcd\x48\xc4\x92\xb2\xac\x90\x95\xca\xaf\xd1\x46\x91\x42\x3d\x15\x0d\xe1\xbc\x7d\xba\x44\x01\x55\xe5\xd8\x34\x5f\x44\x51\x56\x21\x9e\xfa\x0c\xdd\x1d\xf3\x04\x08\x39\xae\x80\xb8\x4e\x0a\x66\xcb\x46\x85\x20\x62\x82\x0b\x12\x16\x8c\xa7\x37\x22\x63\xc9\x36\x92\xaa\x75\x75\x20\x0b\xde\x4e\xd2\xf1\x4c\xb6\xc0\x64\x64\x6f\xa0\x2f\x40\x6b\x90\xd1\x76\x09\x69\x1c\xfb\x6e\xc6\x44\xc8\x00\x18\x33\xa0\x3b\x71\xcf\x85\xf4\x4d\x42\x4c\x82\x2c\xb7\x22\x77\x87\x50\x5b\x13\xff\x18\x3a\x0f\xc5\x3b\x68\xa1\x45\xe0\xeb\x0d\xe5\x45\x20\xc5\x3b\x42\x70\x4a\x70\xc7\x4f\x0c\x5d\x6d\x0d\xf2\x81\x66\x91\x8b\x89\x55\x19\x62\xa9\x81\x87\x97\xb3\x11\x50\xf3\xa6\xe6\x6e\x0e\xdd\x98\x2c\xc3\xab\x58\x65\x30\xd1\x3c\x07\x93\x09\xaf\x18\x4f\x20\x14\x99\x35\xa2\x37\x77\xc8\x99\x22\x2a\xb7\xf2\x6b\xb6\x42\x1e\xcd\x25\x13\x12\xab\x1f\x8f\x83\xd3\x0b\x46\x99\x48\x6b\xf6\xc2\x27\xa1\x37\xcb\x34\xd4\x0e\x7b\x72\x60\x35\x91\x89\xf7\xd9\x8c\xe6\x45\x2f\xde\x2a\x96\x28\x3f\xe9\x2b\xb1\xeb\x1d\x8a\x10\x2d\x0b\x6e\x6a\x17\x8d\xd5\x8b\x33\x96\xa8\x22\xa8\xaa\xef\x2e\x3f\x40\x9d\xae\x07\xd9\x55\xce\xff\xbe\x6e\x46\x2a\x76\x24\x49\xfc\xa9\xcf\xce\xe1\x83\xfa\x9e\x88\x3a\xe7\xc1\x82\x3e\xeb\xfe\x79\x10\x81\x91\x3c\x3a\xee\x00\x07\xd3\xd2\xac\x7d\x99\xc1\x81\x54\x2d\x14\x94\x67\x37\x91\xd4\x0c\x1f\x00\x8d\x89\xb1\xc9\x31\x0e\x67\x98\x62\x5e\x76\xa3\x38\xc5\x7f\x05\x0b\xfc\xac\xdf\xd6\x26\x58\xb3\xd2\xd1\x47\x69\x98\x2c\x1d\x3c\x4f\x1d\x4c\x75\x77\x26\x4b\x88\x98\x34\x63\xab\x5a\x21\x9f\x91\x11\xbe\x13\x45\xc7\x8c\x46\x7b\x1b\xe3\x11\x3b\x3b\xc6\xbc\x75\xd3\xbc\x71\x33\xfb\x21\x5f\x49\x9a\xc2\x3f\x19\x4f\xc5\xe3\x65\xbb\x0d\x44\x70\xd5\x0c\xb5\x10\x5a\xe6\xac\x94\x36\xa9\xcb\xe2\xba\x92\x39\xdd\xe0\xc3\x39\x80\xb2\xb9\x4e\xbe\x8c\xef\x69\xd9\x9d\x39\x3c\x28\xd3\xd7\xea\xdf\x06\x06\x71\x68\x0c\x2f\xaf\x3f\x64\xc1\x0e\x12\x93\x20\xe6\x31\x72\xd7\x8e\xea\x84\xcd\x8d\x29\x6d\x35\x49\xff\x3b\xca\x1d\xa7\x69\x1a\xcc\xfa\xd4\xfb\x77\xf4\xa3\xae\x86\x36\xb4\x51\x8c\x7c\x99\x7f\x3b\xfd\xf6\x3f\xe6\xc6\x12\xa2\x04\xbb\xc8\x90\x9c\xa2\x4d\xc5\xbd\xff\x81\xdf\x4f\x7f\x37\x1f\x37\x4c\xa7\x36\x22\x21\x93\x4b\x2c\x03\xc7\x10\x0d\x67\xa4\xf5\x88\x8b\x6d\xbc\xbb\x9b\x91\x8c\xf1\xa1\x35\x08\xcb\x28\xc5\x69\xae\xd6\x42\x3f\x53\xb5\x45\x83\x16\xb3\x0f\xe7\x37\xb3\xef\xaf\xef\xf6\xae\xb8\xa8\xd2\x4c\xec\x79\x3d\xc1\xfe\x61\xb6\x9e\xb5\xf3\xc0\xd6\x74\xd1\x42\x63\x5f\x48\x2b\x43\xe3\x86\xa9\x0e\x1c\x03\xc5\x8f\x46\xa2\x75\xcf\x68\x20\x5f\xef\x40\x6b\xd6\x26\x54\xf6\x07\x0d\x26\x2e\x5c\x39\x71\x2c\x77\xc8\x96\xd4\x92\x26\xb0\xc7\xf6\x33\x4b\xa2\x72\x4c\xfd\xf1\x6d\x92\x94\x86\xb2\x00\xa2\xe4\x8a\x6e\x96\x8f\x48\xf9\x6c\x6e\x66\xbf\x47\x5c\x7a\x00\x95\x4c\x95\x46\x11\x73\x89\xa4\xca\x18\x50\x66\x42\x26\x47\x0c\x9b\xd7\x19\x5f\x25\x34\x9a\x22\x1b\xd0\x92\x25\xea\xa0\x3d\x65\xc6\xff\x8e\x41\x96\x62\x2b\x30\xf5\x7c\x01\xaa\x06\xfd\x9b\x44\xbc\x6b\x8e\xd9\xe8\x6d\xb3\xc4\x8f\xed\xc6\xb3\xb4\x69\x46\x60\x03\x03\xba\x1c\xd0\x27\xd9\xa9\xd5\x3e\x6f\x49\x59\xbf\x5e\x1d\xab\x1c\x92\xb8\x86\xf4\xff\x98\x5d\x7f\x20\x37\xd8\xdb\x08\xa4\x17\x83\xf3\x53\x17\x33\x9b\xa3\x20\x9e\x9f\x56\xfd\x26\x42\x91\x9e\x3c\x8a\x9a\x65\x7d\x43\xf8\xa1\x16\xd9\x6b\x04\x2d\x69\x83\xa2\xc3\xd3\x67\x5c\x8b\x2c\x33\xee\xa2\x0f\x68\x05\x0d\x0c\x12\x11\x7c\x8c\xdc\xc4\xf1\xb6\x8f\x1b\x99\x3f\x0f\x15\x6a\x14\xc8\xb2\xfa\x13\xf6\x50\xc5\x7f\x3d\x30\x0c\x9e\xf6\x60\xb9\xc3\x26\xd7\xdb\xaf\x94\x64\x11\x31\xb4\x7e\x72\x39\x11\xe8\x42\xc3\x8d\x8d\xa8\x86\x76\x5b\x00\xa7\x1d\xf6\x21\xc9\xcb\x64\x7b\xef\x45\x35\xb6\xde\xb0\x5b\xe6\xa6\x82\xb1\x59\xcb\x3b\x46\xc0\x6a\xe1\x37\x54\x55\xe4\xd5\x39\xe9\xfa\x04\x03\x23\xc5\xbb\xf7\xc1\xa5\x30\x83\x3e\x57\x31\xa9\x59\x16\x6f\xdf\x74\x9f\x15\xf7\x0e\xe0\x9b\xed\x35\xd6\x89\xa7\x3b\x04\x41\x87\x4c\x05\x73\x1a\x1a\xfc\x1e\x7b\xcd\x32\x3d\xb1\xcb\x12\x78\xb2\xe0\x07\x5a\x12\x0f\x45\x86\x79\x68\xf6\x88\xe6\x06\x38\xcd\xf4\x76\x07\xda\x9b\x0a\x4b\x92\xdb\xf7\x0c\xc3\xde\x73\x3c\x03\x68\x80\xf5\x6c\xd5\xa4\x5e\xc3\x36\x0b\x29\x1d\x5f\x50\xd4\xb1\x47\xac\xff\x5a\x1f\x0c\xed\x3c\x8b\x14\x76\xef\x71\xa9\xe8\x9d\xbe\x7a\x6c\xa0\xb5\xb9\xec\x07\x04\x95\x07\xf6\xc0\x06\xcf\xbf\xcf\x46\x83\x64\xf7\x85\xe4\x35\x81\x54\x36\x0a\xad\xfa\xd3\x99\x24\xf6\x53\xf3\xdf\xc9\xff\x2a\xa8\xbc\x2f\xfa\x26\xeb\x1a\x1b\xf7\x07\xa7\x86\xb5\x74\x42\x67\x90\x48\x88\x8d\xcd\x35\x3a\x7f\xe1\x41\xce\xc5\xb9\x7d\x5f\x99\xe6\xad\xf6\xdf\xa6\x7f\xe7\x40\x56\xfd\x3d\x6c\xc7\xbe\x30\xc9\x6f\xf6\x8b\x73\x92\x20\xb6\x4b\x9c\x13\x1c\xab\x93\x92\x38\x89\xe0\x1c\xbb\x38\x06\x82\xce\x18\xf7\xdf\x08\xed\xd3\x1e\x24\xe4\x42\x31\x6d\x3a\xec\x96\x2d\xe9\xdc\x78\xe4\xbf\xa6\x7f\xfa\xe6\xff\xab\x8f\x15\x8c\x17\xa2\xe0\xbd\x79\x7b\x31\x7b\xfd\x1f\xce\x39\xc7\x68\x7c\xed\x65\xcc\x74\x66\x5c\x4d\xc9\x39\xf9\xc7\xdb\x59\xf5\x4c\x00\xe4\x3d\x6c\x95\x46\x4e\xc7\x0e\x97\x85\x16\xd8\xdb\xdb\xf6\x13\xf1\xfe\x15\x36\x4e\xd5\xbe\xa9\xcf\x13\xc2\x0c\xa1\xeb\x59\xcc\xb1\x56\xd5\xcc\x8f\x12\x2d\x0b\xd5\x9a\x00\x52\x3a\x98\x6a\x53\x6f\x82\xb0\xd9\x50\x9e\xaa\x29\xf9\x80\xb4\x2e\x37\xb7\x14\x42\xb7\xd6\xcf\xb8\x7c\x21\x3c\x31\x8b\x99\x6d\xdc\xe9\x06\xe3\xee\x58\xc5\x13\xc0\x93\x68\x7a\x34\x3a\xcc\x06\xbd\x87\x80\x54\x7c\xc2\xe0\xc8\xc5\xf7\x98\x96\xb8\x74\x99\x61\x86\xa1\x71\x35\x20\x43\x0e\xc4\x02\x9c\x29\x21\xef\x8b\xe0\x59\x01\xfe\x35\x91\x10\x53\xd5\xeb\xa1\xdc\xc3\x76\x3a\x7a\x06\x33\x0a\xb7\xd7\x0e\x53\x3a\xc2\xf6\xcc\x7e\x42\x2e\xb6\xa9\x3b\xbb\x91\x56\xe7\xbd\xd8\x90\x34\x15\x89\xc2\x66\xb0\x98\x56\xa5\x4e\x31\x2e\xf0\xc0\xe0\xf1\x14\x1b\xd4\x33\xbe\x9a\xa0\xfc\x9e\x58\x91\xa8\x4c\x64\x54\x9d\xbe\x36\xff\x0b\x62\x46\xc8\xdd\xf5\x9b\xeb\x33\x72\x9e\xa6\x4e\xae\xbb\x26\x91\x56\x5f\x4e\x6b\x0d\x7a\xc7\x04\x7b\x99\x8e\x49\xc1\xd2\xff\xff\xe8\x39\xe8\x26\x0c\x41\x68\xb6\x03\xed\x66\xae\x89\x68\xdd\x13\x77\x42\x0e\x3b\xb4\x6b\x65\x98\x65\x13\xc5\x0d\xd6\x5f\x4c\x23\x66\x12\xf6\x7d\xcb\xb3\x84\x40\xe4\x74\x82\x78\x1d\x62\xd2\x79\xbd\x10\xd8\x5a\x0d\x42\x55\xd2\x5f\x95\xe2\x3f\x4e\xc8\xf7\xc2\x27\x1d\xe2\x3f\x5e\xc8\x07\xc0\x76\x88\xff\x68\x21\x1f\x00\xdb\x12\xff\x3b\x08\xf9\x00\xd0\x6e\xf1\x1f\x29\xe4\x03\x70\x9b\x00\x7d\x38\x6e\x58\xc8\x07\x40\x36\xd1\x54\xae\x9a\x33\x4e\xc8\x8f\xf6\x76\x40\x9b\xdb\xd5\x6c\xcd\xb7\xb0\x9d\x19\x69\x2d\xa4\x13\xdb\x48\x13\x27\xd5\xa9\x7b\x28\x24\x89\xe3\x14\x4b\x84\x6a\xf9\x64\xca\x65\x2f\xf5\x12\x2d\x28\x63\x54\xcc\xd7\xad\x64\x3e\x89\x9a\xd9\x81\x7e\x71\xaa\xe6\x53\x29\x9b\x68\x75\x13\xab\x70\x62\x54\xce\x90\xd2\x89\x52\x3b\x31\xf1\xa1\x24\x63\xc1\x4e\xdb\x4f\xe8\x8a\xba\xe9\xe2\xdd\x15\x11\x79\x2b\x04\x84\x49\x19\x3c\xad\x6e\xff\xc9\xfc\x8d\x19\xdd\x3f\x28\x3d\xe4\xaa\x30\xb7\xfa\xa0\x99\xdf\x12\x97\x36\x9e\x3a\x26\xf3\xc9\x8f\xe3\xc9\x84\x8b\x89\x96\x94\xab\x25\xc8\x49\x2e\xc5\x0a\x6f\x75\x19\x4f\xde\x28\xbd\x35\x1d\x63\x33\x21\xff\x6a\xaa\x33\xe6\xa1\x3d\x8b\xf7\xbe\xf8\x7d\x63\x9c\xcc\x7a\x25\xab\x84\xe5\xe9\x1f\xa6\x7f\x99\xfe\xd1\x7e\x35\x81\xcd\x02\xd2\x14\xe4\x69\x92\xb1\xe9\x5a\x6f\xb2\x03\xa4\x6a\x14\xa3\x0f\x2f\x55\x79\xe9\xcb\x0e\x2b\x65\x89\x6a\xdd\xe1\xda\xa5\x31\x61\x5a\xac\x0a\x96\x82\x3a\xdd\x30\xce\xec\xbf\x27\x26\xfb\x6d\x52\x03\x70\x20\x45\x1a\x78\x1a\x1c\xcf\x5d\xf2\xbd\xdb\x69\xa8\x63\xfe\x7e\xfe\x23\x39\xfe\xbb\x49\xcd\xf7\xdf\x9e\x39\x31\x13\xb2\x73\x88\xe3\x24\x9f\xce\xff\x0c\xaa\xc9\x83\xba\x0a\xee\xd8\xee\x89\x11\x8f\xfb\x73\x49\xc3\x15\x52\x64\x2f\x4c\x0c\x2d\x9f\x0b\x0d\x77\x7a\xb7\x07\x1a\x6e\x0d\x9f\x07\x91\x38\x51\x5a\x2d\x60\xf0\x31\x47\xda\x4f\x2f\x75\x33\x91\xd0\xec\xd6\x1b\xdc\x01\xbb\xa7\x41\x3e\x14\xbd\x39\xd5\x6b\x6f\x19\x18\x28\x6d\xeb\x3d\x60\xb6\x44\x90\x34\x66\x47\xec\x7a\x0a\x18\xb5\x92\x4f\x26\x6a\xa7\x55\xe1\x33\x1d\x1d\xb0\x26\x0a\x34\x56\xa8\xaa\xb3\xb8\xf1\xcf\xbd\xd1\x85\xd5\x83\x36\x92\x62\xab\x51\xde\xd3\x1c\xcf\xf5\x9c\x1d\x81\x46\x30\xaa\xbf\x5e\xa0\xc4\xfb\x4f\xaa\xe6\x10\x78\x5c\xfa\x27\x14\xb3\x08\xbe\x42\xf1\x3d\xcd\xdf\xc2\xf6\x16\x02\x5d\xb6\x9f\x4c\x6f\xf6\xd4\x8c\x2f\xa7\xd7\x8f\x55\x3c\x66\x91\xd6\x7c\x8f\x3d\x5f\x5a\xf0\x61\x54\xa2\x39\x2b\xde\x06\xff\xda\xad\xf0\xdd\xed\xf0\x08\x90\x31\x96\xfa\x4e\x94\x8e\xb5\xd6\x23\xec\xf5\xc6\xa6\x0b\x1f\x53\xf8\x3f\xde\xa8\x8f\x37\xda\xe3\xcd\xf6\x38\x6d\x33\x6c\xba\x47\x89\x2c\xfc\xab\xbc\x07\x7e\xf0\xfe\x56\x83\x6e\xfa\xe7\xd9\xdc\xcf\xe1\xac\xef\xe9\xae\xef\xc4\xc4\x2f\xe2\xe2\x57\x28\x2e\x9e\xb8\xf7\x83\x20\xc9\x6f\x45\x56\xec\x60\x03\xcd\x20\x29\x64\xb0\x0e\xf1\x73\xd8\x42\xca\x61\xe1\x37\xcc\x8b\x6d\xf4\x62\x1b\xbd\xd8\x46\x2f\xb6\xd1\x8b\x6d\xf4\x62\x1b\xbd\xd8\x46\x2f\xb6\xd1\xe7\xb4\x8d\x06\x1e\xc0\xab\x2e\xb0\x81\x2e\xd7\x3f\xe2\x75\xdb\x70\x91\x51\xd6\x93\xd6\xd8\x9f\xde\x15\x71\xb1\x63\x7f\xa5\xfe\x4d\x89\x01\xb1\x28\x10\x83\x03\xf1\xc5\x1f\x3d\x97\x3e\x62\x87\xcd\x1e\x88\xb6\x71\x2f\x53\xbe\xc5\xc3\xd1\x68\x0f\x56\xcd\x9b\xf3\x39\xe8\xda\x4f\x07\x2b\x3a\xd1\xb0\x6a\x64\xb9\x0f\xe6\xfe\xea\xbc\x48\x94\x6d\x07\x92\xf2\xbe\x3d\x9f\x49\x91\x17\x6a\x7d\x8a\xed\x47\x22\xf0\x35\x20\xd4\x68\x3f\xdd\x42\xd3\x54\xc6\xdf\x05\x81\xdb\xfd\x87\xdb\x2b\xc4\x10\xdb\x38\x0d\xde\xa9\xd0\x4b\x25\xfc\x9b\xb4\x6e\x34\x0e\x8e\x6a\xcd\xee\x0d\xcd\x5d\x41\xa1\x49\x47\xb0\xf6\xfe\x45\x95\xcc\x40\xce\x0b\xbd\x36\x55\xd7\x87\x20\xc6\xb8\x71\x21\x20\x12\x3d\xb6\x6c\x75\x6f\x2f\x57\xd3\x5e\xc1\x6e\x60\x91\x63\x06\x63\xa3\x8a\x7a\x81\x12\xd3\x63\xef\x64\x74\x88\x0c\x14\x72\x45\xb9\xbb\x9b\x6c\x07\xea\x96\x18\xd7\xdf\x3f\x84\x84\x6a\x97\x64\xd5\x9a\x69\x62\xef\x91\x4f\x24\x98\xcb\x11\x68\x66\xb3\x53\xcc\x62\xa7\xfb\xe3\x33\x20\x85\x5b\x75\xaf\x67\xa3\x28\x8c\x7d\x79\x23\x6e\xd9\x29\x79\x67\x7b\x07\x99\x6c\x60\xe2\xb2\x81\xc9\xf1\xa3\x4b\xf6\xe9\xcd\x87\x35\xad\x4e\xf0\xba\x99\xb2\x5a\xd2\xb2\xf7\x1a\x2f\x74\x06\xe0\x24\x65\x0a\x19\x8b\xf1\xc2\xf7\x24\xf0\xc7\x63\x3d\x10\xbf\x9d\xfe\xe9\x64\xb4\x07\x95\xdc\xf8\xce\x32\x89\xa4\x81\xbf\xe3\xdf\xf5\xd5\xaa\xf2\xcd\xcb\x6b\xb4\xf6\x41\x05\xe9\x20\x0a\x1d\x81\x43\xe7\xc5\x9e\xe5\x35\x9b\xf8\x19\xde\xf1\x55\x5d\x1b\x99\x4b\xd1\x2b\xb5\x82\x48\x05\x38\x28\xc9\x30\x87\x4b\x9e\x8d\x82\x98\x3e\x62\x72\x16\x5a\x7b\x68\x8b\xbb\x57\xf0\xb6\xed\x23\x59\xf5\x86\x30\x22\x22\xcf\x90\x1b\xaa\xc6\x3c\x4f\xc0\xe2\x26\x27\xd7\x39\x70\xb5\x66\xcb\x8e\x7a\x90\xc0\x3c\x7c\x26\x5a\x8f\x78\x68\x20\x8c\x39\x16\x06\xd7\xfa\x3b\x35\x85\xe2\x72\xd9\xa8\xd6\xa8\xef\x4d\x6a\x46\xf7\x95\xe3\x03\x57\xb9\xfb\x52\x70\x6c\x4a\x13\x5d\x19\xdf\x9b\x05\xd0\x98\xc2\x45\x1d\x75\xb4\x5c\xeb\x9d\xa9\xa8\x6d\xb3\xcb\x92\xe6\x0c\x47\xa1\x2e\x43\x7d\x4f\x0c\xa9\x59\x6c\xc8\x5c\xc8\x04\xde\xf6\x3b\x70\xfd\xe6\x1d\x17\x24\x13\x7c\x65\xfd\x88\x1e\x6b\x2a\xb8\xea\x4d\x1c\xde\xe3\xbd\x96\xa6\xb4\xf0\x8b\xa3\x72\x87\x9c\xfa\xa5\x90\xd0\xd1\x83\xd7\x98\x06\x79\x19\x5f\x7c\xb2\x31\xc6\x84\xc1\x99\xe7\x83\x6d\xbf\x47\x57\x9a\x31\x63\xa7\xf1\xc6\x64\x3a\x9d\xee\x3d\x09\xec\xb3\x12\x37\x0b\xc4\xdc\x3c\x6d\x36\xaa\x52\xd8\x99\xc2\xc5\x5c\x1b\x13\x21\xc7\x6a\xcb\x35\xfd\xd8\x03\x13\x1b\xd2\x6c\xc9\x03\x95\xbe\x07\x38\x5a\xd5\xb6\x2b\x0d\x99\xe3\x7a\xce\x4f\xf6\x9b\x4b\xc8\x6d\x9b\x98\x97\x3b\xbf\x30\x53\x1a\xed\xa8\xf1\xfb\x93\x28\xee\x51\x9f\x75\xd9\x2d\x0d\x5a\x36\x09\x66\xe5\x20\x7c\x84\xa4\x70\xb9\xc2\x58\xf1\xf7\xd6\x42\x32\x17\xfd\x68\x29\xb2\xee\x5e\x77\x8b\x6d\xbc\xcc\x0b\x0b\x99\x7a\x8a\xf5\xd9\x68\x90\x1d\x5c\x7a\x76\x95\xda\x51\xe6\x70\x4b\xac\xfa\x86\x07\xf0\x33\xf0\xb7\x71\x8c\x76\xce\xc6\x6a\x0c\xd8\x31\x43\x37\x40\x95\xab\x62\x44\xb4\x4b\xd2\xea\x81\x49\xca\xec\x94\x7a\xf3\x9f\x16\xaa\xc8\xe2\x45\x6f\x0b\xe6\x30\x1d\x5d\xb8\x45\xb2\xfe\x2f\x5b\x33\x73\x57\x06\xb6\xc8\x69\x2f\x52\x46\xff\x73\x43\x35\x59\x31\xbd\x2e\x16\x67\xd7\xb7\x7f\x3f\xbd\xbd\xbc\xb9\x3e\xbd\x39\xbf\xfb\xfe\xa7\xbb\xeb\x9f\xde\x9e\xbf\xbf\x7c\x77\x79\x37\xfb\xe9\xbb\xeb\x77\x6f\x2e\x6f\x03\x43\x0e\x8a\x82\x01\x9e\x0f\xf3\x7d\xf0\x65\xd7\x85\xef\x6c\x34\x48\x06\xf7\x24\xda\x35\xe4\x91\xa9\xb5\x5b\x08\x93\xf2\xff\x88\xb7\x1e\x60\x21\xdc\xd6\x64\x9f\xa3\x91\xd3\x5b\x24\x6a\x6d\x60\xb4\xfc\xbd\x58\xf0\x89\xef\x5e\x13\xfb\xde\x81\x24\xc1\x4e\x3e\xdc\x8c\x50\xa8\xc2\x64\xed\x4b\xc8\x7a\x2e\x7f\x45\x08\x17\xce\xf6\xaa\xda\xf2\xb8\x48\x58\xed\x86\x6a\xe4\x2b\x33\x12\xcd\xca\x26\x85\xc6\x81\xeb\x80\xe9\xba\x23\xee\x64\x87\x79\x05\xa8\x06\x68\xda\xd2\x7b\xba\x47\xe3\x05\xd6\x4e\xf7\xf4\x7f\xee\x34\xf5\x5c\x4d\x72\x4b\xa0\x61\x02\x6d\xc7\x45\x95\x6d\x3b\xee\xc9\x10\x64\x2f\xcb\x2e\xbc\x3b\xff\xa0\x12\xda\xc5\x89\x61\xca\xe1\x0c\x16\x34\xb9\x7f\xa4\x12\x4b\xee\x6a\x0d\x53\x7b\x34\x6f\x18\x89\x08\x4b\xba\x13\x27\xd3\x97\xa2\x61\x93\x12\xbc\x71\x79\x03\xa6\xd2\xbc\x01\xb1\x17\x60\x60\xa9\xab\x9f\x8f\x93\x2a\xd4\x3d\x31\xb6\x8b\x7c\x80\x49\xc1\x4d\xdd\xb0\x2b\x78\x3e\x23\x5a\x76\x6a\xce\xa1\x08\xea\x24\x02\xd3\x01\x2c\x69\xe0\x2a\x84\x5d\x32\x00\x1b\xd4\x35\xf7\x2b\x18\x12\xd7\xad\xfc\xa7\xf6\x0d\x9e\xcb\x98\x0b\xe0\x42\x54\x46\x30\xa3\xbd\x57\xe0\x40\xfa\x37\x99\x66\x0d\x55\x76\x35\xc9\xd1\x6e\x77\x1d\x26\x6b\x77\x42\x8c\xf6\x40\x94\x2e\xcd\x7d\xfc\x31\x71\xca\xbb\x2e\x22\x9e\xbb\xf7\x03\xc4\xfa\x34\xdb\xe8\xe8\x1d\xac\x68\xb2\xed\x6a\xdf\x52\xdb\x4f\x53\x52\x93\x00\xbd\x80\xc9\xee\xb2\xe1\x73\xed\x42\xe2\xe3\xe7\x91\x64\xb9\xb0\x4d\xbd\xbc\x19\x67\x5f\xc6\x50\x81\xeb\xdb\xea\x1b\xde\x4c\xc9\x79\xa7\x9b\xee\x7f\x9c\x12\x50\x6b\x54\xbf\xc6\x21\xc7\x32\xb3\xca\xf5\xdf\x4e\x0f\x0a\x50\x72\x91\x82\x67\x9d\x9d\x2e\x58\x7d\x53\x26\xef\x2b\x3c\x6c\x5a\x1a\x48\xca\xf5\x96\x29\xf5\x0a\xc9\x45\x1a\xae\x55\xc4\x69\x41\xc6\x56\xd8\xd2\xd0\xd9\xee\x0a\x03\x18\x05\xb6\xb6\xc5\x23\x39\x0c\xff\xe3\xbf\xdc\x6d\xa7\xce\xbb\x09\x40\x44\x44\xfa\x89\x12\x34\x91\x07\xec\x84\x38\xd3\xcd\x6d\x36\x91\x7a\xba\x46\x52\xf4\xdc\xde\xe0\x98\x88\x89\xb1\xa7\x01\x6f\x13\xc8\x34\xcb\x33\x34\xbb\x4c\xc3\x82\xb2\x5b\x49\x5c\xa7\x6d\x67\xf3\x9a\xbb\x63\x91\x28\xe4\x38\x85\x25\x2d\x32\x4d\x7e\xb7\xa4\x99\x82\xdf\x9d\x1c\xc6\x3b\xb9\x48\x9f\x87\x75\x72\x91\x2a\x72\x8c\xa7\xba\xa6\x4d\x27\xf6\x82\x8c\xe8\x2b\x53\x5e\xf1\xeb\x4e\xcf\xf1\x2a\x0f\x63\x6b\xb2\x87\x32\x7f\x69\xc5\x1e\x80\x93\xb2\x13\xe5\xc9\x50\xe2\x52\x37\xfb\x12\xb5\x16\x45\x96\xe2\x7e\x2e\x57\xc7\x36\x16\xfe\x3a\xf8\x8c\x6b\xb6\x23\xaf\x7d\xc0\x12\xaa\x5f\x19\xab\xd5\x66\xf9\x3f\x83\xdd\xb0\x77\xd7\xd7\xc7\x72\x03\xca\x16\x9d\x8c\xb8\x43\x9b\x4e\x33\xc6\xdc\x46\xe1\x9b\x40\xbd\x58\x31\x2f\x56\x4c\x94\x15\x33\xcc\x13\xad\x79\x9d\x97\x4e\x76\xf5\xaa\xb3\x3e\x5c\xa7\xd9\xd4\xef\xe9\x70\x8b\x4b\x42\x34\x55\xf7\x5f\x78\x4b\x9a\x12\xbd\x85\x50\x10\x39\xfb\x4b\xbb\x8c\xee\x25\x92\x89\xd5\xca\xc5\x8d\xcd\x64\x9b\x17\x5b\x06\x05\x98\x2a\x72\x6c\x44\x43\x98\x26\xc7\x58\xc5\xeb\xf3\x53\xdc\xf1\x9e\x48\x0f\x92\xfb\x03\x3b\xc2\xdc\x51\x7b\x36\x1a\x9c\x6e\xa7\xa0\xb1\x47\xa5\x2f\x62\xe6\x45\xcc\x7c\x1d\x62\xa6\x16\x8c\xeb\x85\x5d\x66\x05\x7c\x71\x79\x13\x93\x9d\xf0\x84\x1a\x66\x1f\xe2\xb6\x9b\xdc\x4f\x1c\x00\x9f\x96\xe0\x22\xd3\x65\x73\xf0\x9a\x51\xd4\xbf\x64\x04\xa3\xcc\x98\x29\x2a\x99\x77\x3d\xbd\xd5\xe9\xc1\xa2\x6f\xca\xf8\x0e\x04\xf6\x81\xd0\xe9\x68\x6f\x0a\x0e\x49\x2d\x9f\x97\x74\x36\x8a\xa3\x58\x43\x48\xa0\xe4\x2a\x13\x9b\x0e\x91\x5e\x78\x13\x49\xec\xba\x89\x56\x1f\x1e\xb7\x3f\xcb\xbe\x99\x07\x6d\xaa\xc6\xfc\x5e\xc4\xe8\xff\x00\x31\x0a\x1f\xf3\x78\x5b\xa5\x73\x4e\xa7\x7e\x42\x06\x14\xa6\xf3\x3d\x30\x4a\x2a\x5a\xf7\x82\xc6\xd6\x20\xf2\x81\x25\x70\xd8\x0c\x4c\x8a\x67\xe4\x04\x50\xea\x99\x8c\xb2\x2a\x21\xb1\x3f\x99\x35\x4a\xc0\xb8\xf1\x6f\x8a\x2c\xdb\xe9\xb6\xa6\x23\x44\xc5\x24\xb1\xe6\xf6\x35\x17\x72\xfa\x6f\x13\x0d\xf8\xef\xab\xe5\x07\xa1\x6f\x50\xd7\x73\x7d\x74\x08\x76\x19\xdb\x30\x7d\x71\xf3\xc3\x4e\x04\xfa\xc8\x36\xc5\x06\x1b\x54\x17\xdc\x28\xcc\x8b\x9b\x1f\xca\x93\x8f\xe9\xc1\xd8\xbc\x87\xcd\x6e\xcd\x17\x9e\x22\xb4\x31\x20\x9e\x0b\xa7\x07\xe0\xa0\xd4\x77\xf6\x1e\xb2\x5d\x2f\x33\x3b\x7a\xcf\xb8\x21\x17\xde\xb7\x8b\xc7\x82\x18\xf1\x2a\xef\x34\xf3\x3a\x34\x97\x62\x01\xe1\xd6\x9f\x26\xba\xc0\x15\xde\x9b\x82\x27\x8b\x94\x61\xd0\xd5\xde\x66\xb6\xa6\x0f\xe8\x13\xa8\x22\x49\x00\x52\x4c\xa9\x3c\xc7\xae\xa9\xa0\xc2\x10\x71\x60\x3f\x3b\x14\x17\x0b\x68\x48\x61\x13\x5e\xa2\x49\x75\x80\xbc\x06\x9a\xe1\xc1\xaf\x11\x4b\x47\x83\xb7\x7b\x30\xae\xff\xf0\xfb\x03\x1a\xd2\x56\xa4\xbf\xe2\x4c\x33\x9a\xbd\x81\x8c\x46\xef\x9f\x0f\xe5\x05\x72\x0a\xef\x7b\x4a\x95\xa3\x95\x3b\xd5\x72\xbb\x1b\x73\x45\x95\xa6\x03\x0d\xfd\x5d\x52\x64\x93\x56\x36\xbd\x16\x43\x5c\x0c\xc9\xd5\x4d\xba\x10\xd0\x2f\x4c\xd4\x1b\x90\x4c\x44\x73\xf1\xf7\xd5\x85\x63\x02\x1b\x29\xe3\x22\x57\x9c\x5b\x67\xb8\x81\x88\xff\xaf\x82\xe1\x66\xc9\x1a\x36\x10\x4b\x1b\xfb\xb4\x37\x8b\x5d\x87\x6f\xd3\xcc\x97\xf1\x15\xce\xd0\xd8\xb9\x86\x36\xdf\xdf\xdd\xdd\xf4\x4b\x23\xd2\x22\xe3\x2e\xc4\x0a\x00\x8d\x24\xe3\x0e\xb2\x70\x86\x92\x46\xa9\x67\x91\x85\x46\x6a\x29\xd5\x16\x86\xbd\x10\x89\x73\xca\x6a\xc2\xd0\x81\xc0\x42\xb1\x86\x40\x44\x31\x5b\x93\x86\x61\x90\xfb\x49\xc3\x10\xcc\xcf\xce\xb6\x77\xa1\xe4\xef\x78\x11\x59\x5d\x8e\xe9\xd4\x12\x0b\xd7\xee\x89\x42\xb7\x05\x40\x14\x25\x03\x20\xbf\x94\x00\x08\x17\x51\x0e\xd9\x8a\xf8\x36\xa6\x53\x1d\xd9\xfe\xb0\x29\x99\xb3\xca\x91\x0d\xdd\x34\xb2\xc0\x94\x50\x23\x25\x0e\x32\x55\x76\xb8\x80\xf0\x4e\x94\xae\x18\x46\x6c\x52\xb6\xb4\xc5\xe2\x06\x06\x76\xf8\x17\x2a\x46\xb4\x54\x73\x2f\x0f\x91\xe6\x7f\xf9\xe6\x2f\xdf\xcc\x4f\xa6\x07\xad\x03\x62\xf1\x61\x87\xb5\x08\xce\x06\xd7\xa2\x12\x2e\x42\xf6\xfb\x80\xa4\x35\xf3\x6a\x6d\x71\x59\xfd\x1a\x19\x49\x3e\xc7\x7a\xa4\xb9\x29\x3b\x1a\xba\xb9\x09\xf7\xc3\xdc\x02\x9e\x57\x2e\xaf\xbd\x57\xa8\x80\xc3\x56\x1c\x37\xa8\xba\xdc\xc9\xf3\xac\xe5\x77\xdd\xd4\x5f\x6f\xfb\x6b\x66\xe7\x06\xf7\x3d\x6f\xd2\xa8\xc6\x03\xe6\x20\x71\x7e\xf2\x69\xb7\x7d\x8c\xf7\x27\x81\xa6\xec\xb7\x6b\xbe\x97\xd3\xfb\x95\x69\xac\x12\xef\xaf\xc4\xb4\x6f\xd1\xf1\xd7\x6a\xdb\x97\xd3\xf8\x42\xc6\xfd\x1e\xec\xf8\x25\xa8\xf3\xb5\x98\xf7\xb1\xe4\x0a\x40\xdc\x81\x90\x11\xea\xa4\xa2\xd0\x6f\xd4\xc0\xdf\x83\x41\x43\x40\x3f\x3f\xeb\x7e\x45\x26\x7e\x14\x2d\x03\x30\xbf\x9c\x18\xf8\xb9\x00\xb5\x6b\xd0\x93\xf1\x56\x8c\xf1\xd9\x82\x9e\x0e\x9f\xdd\xc3\x9e\x8c\x7f\xc2\xb0\x27\x9e\x22\xb3\x04\x6e\xf6\x74\x29\x0a\x9e\x96\x6c\xe6\x40\x19\xd3\xbb\xa9\xa4\x7b\x01\x63\xae\x1c\xa6\x2a\xf8\x13\x58\x6f\x96\xd7\xbd\x8c\x43\x7d\x8c\xda\x0c\xf7\x75\x35\x7a\x67\x69\x5c\x8e\x58\xd7\x69\x68\xaa\xc6\xd3\x38\x39\x60\x35\x87\x4e\x39\x65\xdf\x61\x5e\xc4\x01\xa7\xf4\x99\xf4\x9d\x00\x22\xce\x36\x13\x54\x12\x6f\x80\xa6\x78\x09\xf1\xcc\x4a\xa9\xc8\xb5\xc0\xa2\x37\xb6\x64\xfe\x3c\xd9\xa3\xc5\xb8\x97\x76\x8d\xac\xbf\x5e\x98\xc4\x35\x40\xa1\x52\x1b\x41\x38\xae\xae\x03\xf9\xb7\x58\xf8\xfb\xdc\x5d\x2b\x01\x51\xa8\x6c\xeb\xb0\x1e\xb6\x2b\xf1\x6a\x3f\x55\xd7\x64\x76\x8d\xbd\xde\x6a\xf9\x94\x7f\xfe\x46\x4d\x63\x04\xe0\x9f\xff\x78\x10\xe3\xef\x70\x9c\xfc\xea\xbc\x71\x98\x9c\x42\x9e\x89\xed\x93\x54\x48\xaa\xc8\x85\x14\xfc\x1f\x62\x31\x74\x0f\x23\x16\x43\x49\x51\xf8\xab\x53\x80\x99\x26\x4f\x86\xf4\x46\x9f\x63\x25\x3b\x45\x13\x94\x89\xd4\x96\x97\xab\x62\xd3\x3b\x11\xfc\x7b\x6c\x3c\xf0\x79\x22\x05\x9f\x8f\xc9\x1c\xd7\x4f\xce\xcd\xdd\x31\xf3\x9f\x0b\x2a\xf5\x2f\x73\x63\xcb\xbb\xe4\x27\x48\x4f\x30\x55\x85\x92\x9c\x2a\x15\x5e\x41\x3f\xb6\x4b\x96\x9a\xa7\x4c\x42\xa2\xe7\xb8\x9e\xe5\xeb\xe5\x43\x27\x53\xf2\x7f\xb8\x89\xfc\x04\x20\x7a\xb9\xec\x2e\x67\xc8\xb2\xa7\x13\x55\x68\xd9\x40\x95\x9f\x6b\x29\x11\x80\x89\x13\xc5\x63\x75\x7b\xf2\x0a\x1f\xf1\xe0\xda\x5d\x91\x83\x24\x29\xf3\xf3\xfd\x2c\xbe\xdd\xcc\xf1\xe1\x00\xc4\x36\x98\xf9\x37\xa7\xdf\x92\xdf\xd9\x9f\xb9\xbf\x31\x7e\xfe\x87\x3f\x6d\xec\x5d\xba\x7f\xfa\x46\x19\x88\x5c\xe8\x93\xe9\xab\x83\x7c\x75\x4c\x8f\x12\xcb\xe5\x3b\x3c\x04\xdc\x4b\x02\xf0\xd2\xda\xb1\x95\xb8\xca\x6f\xc3\x0d\x35\x8d\x0c\x7b\x61\x92\x72\xb3\xf7\xec\xcc\xdf\x4f\x3f\x83\x61\x52\xa5\xf2\x45\xef\x4e\x93\x33\x45\x89\x02\x0c\x2b\x61\xd6\xb1\xcf\x61\xaa\x3a\x8c\x54\x50\x47\x03\xf7\x33\x73\x28\x05\x54\x52\x28\x2d\x36\xec\x17\x73\x6b\x1d\x11\x12\xd5\x9c\xb3\xde\x37\xf8\x08\x76\x86\x8c\x09\x7b\x95\xec\x67\x82\x5d\x6c\xb5\x32\x42\x10\x8b\xb2\x24\x37\x12\x05\xdb\x64\x95\xa9\x00\x78\xbd\xa0\xaf\x52\x0d\x00\x2e\x91\x93\x66\x33\xa2\x28\xa6\xbe\xda\xd2\x5e\x1a\xee\xb8\x22\xad\x26\x3f\x25\x97\xd3\x55\xff\x22\x92\xd2\x37\xf1\x12\xa4\x7c\x13\xb5\x01\x58\x01\x33\xf1\xdf\xd5\x11\x08\xc0\xac\x50\x3b\x66\xfa\x08\xed\x64\x73\x60\xef\xb3\x9c\xe6\x42\xae\xfc\x05\x1f\x26\x3f\x72\x7a\x7f\xe6\x73\xae\x12\x19\x8e\x18\x67\x6c\x21\xa9\xdc\x5a\xb9\x33\xf3\xc2\xad\xc2\xda\x5d\x8f\x5b\x48\x0c\xea\x66\xdb\x33\x2f\x21\x03\x20\xbb\x64\xe7\xf4\xd5\xfe\x56\x07\x32\x34\xb7\x18\x24\xdb\xdd\x92\x1f\xaa\x6d\x8d\x5d\x6a\xd0\xdf\xc0\xcb\xb4\x2a\x78\xda\x95\xd8\xf6\x5e\x0a\xea\xbc\x96\x25\xa1\xe4\x1f\x62\x31\x25\x3f\x9a\xae\xd4\xa6\xe3\x81\xa1\xcc\x19\x99\x90\x57\xe7\x59\x26\x1e\x5f\x9d\x11\x8a\xff\x2f\x55\xd8\x40\xd4\x4d\x16\xbc\x86\x47\xb6\xfd\x4f\x84\xf4\x9d\x90\x0b\x96\xbe\x2a\x6d\xb6\x13\x53\x38\xbb\x60\xa9\xaa\x3d\x1b\x06\xaa\xc6\x44\xdd\xb3\x3c\x47\x35\xc8\xe1\xa3\xc6\xcf\x08\x5b\x22\xcf\x3c\x30\x51\x28\xf3\xfb\x9a\x2a\x7e\x74\xa4\x09\xd6\x8f\xa8\x75\xd0\xbb\xda\x82\x36\xa8\xdd\x5a\x47\xec\xd5\x19\x0a\xe9\x04\x8b\xb2\x4a\xd4\xcb\xe6\x39\x28\xf8\x70\xd5\x9d\xd3\x16\x22\x29\xf3\x25\x94\x84\xc3\x23\x11\x1c\x8e\x0e\x64\x90\xca\x9c\x8c\x65\x8e\x97\x7c\xb2\x5f\x71\x3e\xd9\x92\x66\x19\xea\xf9\xc8\x59\x61\x7f\xc6\x7a\xae\xa8\x55\x69\x6c\x93\x67\x80\x57\x54\x95\x6e\x48\x29\xa2\x43\x32\x13\x78\x6a\x8b\x80\x8f\xbd\x78\x3b\x29\xbb\x3c\x22\x0c\xb9\xc5\xcd\xa7\x05\x56\x18\x81\x64\x34\x63\xbf\x0c\x39\x71\x2d\x2b\xb8\x52\x65\x5e\x9a\x1c\x46\x2d\xaf\x3e\x23\xa9\x85\xc9\x50\x6e\xdc\x4a\xf3\x7a\xc5\xf6\xb8\x16\x59\x7c\xb2\xee\xb2\x56\x65\x56\xd9\xeb\x29\x24\x19\x5e\xb7\x6e\x74\xa2\xeb\x5a\xdc\x30\x58\x03\x20\x5b\x08\x31\x45\x36\x90\xac\x29\x67\xaa\xb2\x28\x12\x21\xd1\xc8\xce\xb6\xd3\x43\xa4\x8a\x77\x27\xf6\x73\x2a\xaf\xab\x9e\x16\xf6\xf5\x9a\x3f\x69\x70\x2f\xbd\x95\x30\x6b\xa0\x50\x65\x4b\xac\xb6\xd8\x30\x13\xfd\xf4\x04\x48\x8d\x93\x69\x40\x51\x8e\xd1\x1a\xaa\x04\xc7\x5b\x16\xf0\xb1\x90\x48\xff\xb7\x58\xa8\x9a\xe2\xb3\x6d\x41\xd0\x58\xc3\xb4\x37\x6b\xac\xbb\x73\x25\xc1\x41\x45\x59\xac\x07\x79\x92\x03\x62\xb0\x7e\x81\xfa\xd9\x68\x90\xee\x9d\xe1\x85\x37\x35\x18\x07\x85\x19\x1a\x90\x23\x39\xe1\x45\xd3\xfc\x7a\x35\xcd\x30\x6f\x66\x62\xbb\x7f\x5e\xff\x1b\xf7\xfe\x0b\x4f\xbe\xf0\x64\x34\x4f\x12\x73\xc3\x41\xe4\x8c\x8c\x73\x82\xbe\x08\x46\x63\x32\x96\x30\x34\xd8\x6d\x35\xb3\xb3\x88\x94\x89\x25\xd9\x90\xdc\x26\xec\x5f\xe0\xb8\x64\x01\xfa\x11\xdb\xa2\xce\xab\x57\x30\x62\x86\x7e\xe1\xe4\xdf\x62\x61\xc3\x39\xf7\xb6\x25\xd3\x04\x65\x08\x4b\x20\x64\x4e\xa1\xfb\x6f\x6f\x39\xf6\xf7\x5e\x97\xbd\x99\x0c\x63\x79\xef\x22\xba\x46\x28\x42\xb5\x17\x0a\x66\xb3\xf3\x48\x12\xa2\xf1\x88\xf3\x00\x39\xc1\x83\x44\xd7\x41\x0b\xd3\x34\xf3\x14\xaf\xbb\x46\xc4\xc4\x23\x87\xb4\x42\xbc\x17\x30\xa9\xc5\xe3\x51\x92\xce\x4f\xea\x37\x47\x33\xdd\xaa\xba\x41\x33\x57\x99\xad\x15\x80\x88\xb7\x7e\x67\x0c\xb8\xb6\xe8\xe5\xae\xae\x1d\xfb\x83\x93\xd9\xec\x1c\x83\x1b\x58\x56\x4e\x1f\x28\xcb\x90\x89\xdd\xe5\xab\x01\x88\x28\x9c\xb2\xb4\x16\x51\xf1\xed\x52\xd5\x21\x7c\x1b\x25\x4b\x91\x9d\xce\x46\x83\x6b\x12\x90\xa6\x08\xe1\x45\x9e\xbe\xc8\xd3\x1d\xe4\xa9\xbf\x74\x78\x3f\x4b\xbf\x5e\x3b\x62\x4c\xf2\x96\xa1\x8f\xf2\xa1\x62\xee\x5e\x98\x26\xb9\x60\x43\xef\x4d\x1a\x98\xb9\x03\x39\xfa\xf4\x27\x00\x73\x9f\x73\xa1\x83\xe2\xcf\x03\x6c\x08\xfc\x81\x49\xc1\x0f\xd9\xe6\x97\x15\x88\x97\x7d\xfe\xa9\xf6\x79\x79\xac\xfc\x1e\x74\xec\x25\x01\x36\x7f\x14\x1b\xee\x23\x6e\x6e\xb5\xe6\x1f\xce\xdf\x5f\xce\x6e\xce\x2f\x2e\x5d\x48\xf8\xe6\xfa\xcd\x4f\xf8\x59\x38\xc4\x52\x2d\xf1\x03\x95\xcc\xc2\x6d\xab\xce\x83\xf6\xfc\x6f\x52\x90\xe1\x11\xff\x8d\x14\x1f\x63\x23\xe4\xd8\xe0\x8f\xae\xb0\x55\xa7\x31\x06\xe7\xdf\xdf\xdd\xdd\xfc\x74\x73\x7b\xfd\x5f\xff\x42\x93\x0e\x7f\x9b\xb9\x5f\x71\xed\x7a\x61\x12\x32\xff\x70\xed\x1f\xfc\x0c\x6b\xf7\x40\xe5\xee\xe5\xf8\xdd\x78\xb9\x16\xea\x69\x1a\xba\x22\xc0\x1f\x43\xd5\xcc\xcf\x6a\x83\x4c\x8d\x94\xb2\x2d\x9b\x51\x4e\xbf\xbd\xfc\xd7\x5f\x7f\x3c\x7f\xf7\xc3\xe5\xb0\x9d\x35\x7f\xff\xaf\x9f\x7e\x3c\xbf\xfd\xeb\xab\x0d\x36\x74\xce\x0a\x78\x35\x37\xd0\x30\x5a\x69\x15\x01\x24\x78\x0d\x43\x02\xa6\xe6\xdd\x25\x5a\xd9\x40\x7e\x16\x4a\xe7\x73\x77\xfa\x74\xcf\x79\xfa\x85\x5b\x09\x0c\x69\x08\x29\x85\x9c\xac\x29\x4f\xb3\xfd\x1d\xeb\x4b\x04\x42\xbe\xb7\x40\x5e\xac\xc1\x17\x6b\x70\x07\x6b\x50\xc2\x32\x72\x42\xb8\xf1\xc1\x70\x9a\x63\x57\xbc\xfd\xcc\xd4\xec\x54\xbd\x3e\x04\x9e\x78\x17\x41\xe1\xc9\xb8\xf1\x29\xdd\xe5\x06\x35\x9e\x1c\xed\xbd\x0f\x07\x96\x7f\x95\x9c\x8d\xa2\x66\xd7\xe4\x5d\xb1\x24\x7f\xbf\x78\xd9\x4d\x9f\x6a\x37\x99\x8b\x66\x1e\x40\x6e\x2f\xf0\x3e\x8f\x48\xea\xbc\xf1\x2f\xb9\x20\x80\xbb\xfc\xcb\xea\x35\x0c\xae\x8e\x7d\x7a\xd4\xdc\x6d\xb3\x34\x7c\x7c\x9f\x32\x75\x6f\x83\x38\xb6\xfc\x7d\x5e\xd3\xdd\xee\x93\x93\xfd\x19\xf3\x37\x29\x33\x06\xd8\xc3\xa6\x26\x9f\x8d\x06\x27\xdb\xb9\xe3\xbe\xaf\x25\x36\xbf\xec\xba\xe7\xdf\x75\xbf\x49\x1d\x76\x60\xb7\x89\xa8\x6a\x35\x3f\x46\x2f\x48\x57\x57\xe8\x64\x51\x74\xd9\xda\x27\x0f\x51\x1c\xd4\x11\xe2\x13\x54\x8d\x3d\x2d\x74\x46\x3f\xa2\x2a\x1b\xfb\x8c\x04\xd9\xa9\xe0\xab\xb7\xde\xab\x39\x99\xcf\x8a\x3f\x0e\xb8\x5b\x1d\xab\xbf\x0e\x00\x54\x07\xee\x25\xb3\xd7\x5c\xbf\x5e\xb0\xb5\xa8\xc9\xd3\x1a\xd6\xe7\xd9\xcf\x3b\x95\x9c\x05\x2b\xce\x7c\xf9\x8d\x87\x3c\xb8\x8b\xab\x19\x7d\x7f\x77\x77\x33\x3c\xa1\xa0\x11\x70\x60\x07\x88\xb8\xfa\xb0\xfd\x05\xd4\x40\xa1\xd8\x67\x64\xe8\xdd\x0a\xb5\x86\xeb\xb4\x3c\xdc\xe1\xe2\x39\x2c\xe5\x32\x05\x5b\x9f\x61\xb6\x87\x96\x57\x47\xe9\xab\x72\x90\xdd\xf9\xe1\x8b\x2b\xac\x43\x0a\x9d\x3f\x91\xc6\x2a\x51\xfa\x42\x2a\x6b\xbf\x22\xe5\x5e\x9d\xd5\x9a\xce\xe7\x9d\xc1\xc1\x5a\xab\x85\x7d\xc9\xf3\xfb\xab\x2d\x77\x3a\x7d\x90\xd6\xda\xaf\x52\x3a\x4a\x6d\x95\xa0\x07\x37\xf3\xb3\xea\xad\x43\x2b\x9b\xe3\x14\xd7\x01\x92\xea\xcb\x6b\xae\x12\xf9\xe7\x56\x5d\x25\xe0\xaf\x49\x77\x0d\xf8\xa8\x8c\xaf\xfa\xef\xf6\x1e\xf6\xfd\xaf\xec\xeb\xfb\x38\xff\xff\x8f\xbd\x6f\xed\x8d\xe3\xc6\x12\xfd\xae\x5f\x41\x68\x3e\x44\x36\xfa\x11\xef\xdd\x99\x64\x05\x04\xf7\x2a\x72\x32\xeb\xd8\x8e\x75\x2d\xc5\x73\x01\xc7\x40\x53\x55\xec\x6e\x46\xd5\x64\x0d\x59\x25\xb9\x07\xf7\xc7\x2f\xce\xe1\x61\x3d\x5a\x55\x2c\x76\xb7\x6c\x79\xe2\x46\x06\xbb\xb6\xa5\x3a\x24\x0f\x0f\xcf\xfb\xb1\x4b\x99\xde\xbd\xae\xaf\x3c\x4d\x61\x54\x13\x9d\x02\x2b\x75\x04\x05\x22\x22\xb3\x99\x4b\xa0\x6b\x8e\x1d\x0d\xea\xc4\x69\x5f\xa6\xb6\x1f\x77\x69\x61\xeb\xe0\xd5\xf8\x0a\xbc\x1a\x4b\x6d\x63\xd9\xc9\x37\x4f\x9f\xbe\xa5\x3a\xc9\xa7\x4f\x27\xed\x72\x6b\x60\x26\x00\xca\xd7\x48\x0f\x37\x17\xa0\x07\x30\xf9\x66\x77\xd9\x31\xc4\x28\x6c\x21\x7b\x9e\x69\x04\x9b\x80\x8f\xf7\x63\x12\x90\x26\x18\x89\xda\x86\xea\xc1\xd9\x09\x5c\x30\x1f\x57\x95\x83\x4f\xaa\x78\xeb\xf9\x8b\xe7\x6f\x99\x2d\xaf\x95\x18\x2e\x19\x6c\x4f\x3f\x01\x7e\x6b\x12\x91\x17\x75\x0f\x0d\x77\xc2\x1c\x42\xdb\xec\x64\xf6\xec\xdb\x09\xfe\x37\xfd\x7e\xf4\xec\xbb\xff\x98\x3c\xfb\x1b\xfe\xe5\xd9\x7f\x8c\x9e\xfd\x17\xfc\xed\x7b\xf7\xd7\xbf\xc5\xb5\x4a\xdb\x4f\x21\x68\xdd\xc5\x81\x09\x7d\x05\x4c\xc8\x65\xb7\x44\x9e\xe9\x67\x6d\x12\x52\xd1\x31\xc0\x8f\xb7\x45\x53\xcd\x66\x60\x5d\x26\xdc\x4c\x24\x10\xf7\x44\xea\xa9\x03\x3d\xeb\xdf\x1f\x63\x3f\x56\x74\xdb\x98\x4e\x24\x2d\xce\x39\x82\x76\x72\xa8\xb6\xc3\xc0\xb4\x3a\xe3\x6c\x20\x75\x03\x72\x43\xe1\x6b\xad\xfc\x20\xd5\x87\x68\x98\x3d\x40\x60\x7f\xe8\x4c\xdf\xc8\x9e\xc4\xa2\x61\x86\xf7\x8b\xfb\x7c\x2f\x96\x77\x7e\x76\x2e\xe2\x9b\x92\x2c\x05\xbb\xf8\xe9\x35\x13\x2a\xd1\x10\xd5\x3d\x3f\xc3\x11\xba\xd5\x44\x7a\x06\x03\x6a\x59\xce\x8b\xe5\x08\x09\xb8\x17\x2a\x66\xf9\xdd\x0a\x23\xe7\x75\xc0\xae\x02\x24\xec\xc8\x47\x82\x81\xf4\x41\xdd\x62\xb3\xdc\xe8\x42\x27\x3a\x0b\x71\x33\x69\x5d\x7b\x0f\x4b\x39\x56\xa5\x15\x63\x6b\xb3\x31\xe5\x05\xf3\xb2\x58\x0a\x55\xd0\x5e\xb1\xf7\x80\x23\x94\x00\xc8\xda\x6c\x9a\xde\x72\x33\x35\xa5\x9a\xba\x71\xe9\x76\x5a\x73\x05\x20\x5a\x48\x8d\x96\x89\xe0\x09\xd6\x0f\xf9\xbf\x8e\x13\x3e\x49\x4c\x11\xda\x34\x3c\x85\x37\xb9\x50\x97\x4b\x39\xdf\x97\x0b\xe3\x39\x2f\x8c\x54\x89\xcc\x79\xb6\xc5\xa5\xe6\xfe\x9b\x13\xfb\x84\x6c\x0d\x2c\x47\xbb\xf6\x93\xbd\x20\x38\x4f\xf0\x7b\xa1\xb2\xe6\x1d\xd2\xf8\x71\x7d\xc7\x38\x9a\x62\xde\x62\xf5\x34\xeb\xb5\xe0\xe6\x55\x07\x20\x6f\x10\xc1\x96\x57\x1d\x00\x5c\x11\x41\xc3\xe8\xdf\xc0\xe3\x0f\x89\xfa\xc1\xae\x6d\x21\x56\xa7\x2b\x0e\xf9\xdf\x63\x94\xc0\xe1\xf8\x71\xa2\x7e\x58\xf2\xbb\x42\xea\xb1\x56\x90\x4c\x3b\x71\x7f\x9b\xd8\xdb\x84\xb6\x9c\xa8\x1f\xe6\x40\xa1\x60\x06\xe8\x4c\x4c\xe0\x2f\xf8\xe3\x07\x20\x96\xcf\x35\x0d\xa5\xc5\x92\x0e\x72\xbf\x2d\xf7\xab\x44\x86\xed\x7c\x5a\xaf\xa4\x05\xc7\x1c\xf0\x05\xac\x1a\x4d\xb8\x2d\x7c\x9b\x2d\x7b\x3f\xa2\xb2\x87\x74\xfa\x93\xaa\x26\xd0\x10\x43\xa5\x22\x3d\xc7\x67\x7c\xbe\x14\xd1\x05\xd2\xaf\xb9\xaa\x6a\x59\xee\x8b\x25\xef\x20\xb4\x31\xac\x70\x9e\xf1\x85\x6f\x4d\xe1\x37\xc4\x6e\xc4\x9a\x95\x96\x2f\xa0\x92\x06\x53\x83\xef\x09\xba\x00\xc4\xcf\xc5\xfd\xdc\xdf\xb7\x13\x4b\x0f\x66\xb0\x42\xee\xc7\x7f\x83\x51\xca\xd3\xd4\x90\xd8\xa8\x7d\x5f\x5e\x78\xf0\x05\xa4\xf8\x38\x73\xa9\x17\x2e\x63\xd7\x50\xa8\x55\xe8\x09\x7b\x31\x67\xb3\xe3\xdf\x9f\x1e\xbb\x84\x9e\x63\xb2\x9b\x8e\x51\x05\x58\x40\x28\x08\x4b\xb0\xa9\xbc\x29\x64\xa6\x01\x44\xcc\x96\x05\xff\xcf\x9a\x29\x51\x60\xef\x16\x34\xd5\xe6\x3c\x69\xba\x59\x8f\x9f\x1e\xef\xe9\x65\xd5\x88\x13\x1b\x89\xb9\x3a\xc5\xb7\x9e\x5c\x5e\x21\x8c\x40\x41\x51\x33\x25\xc5\xf6\x02\x65\x40\xb5\xa8\x45\x9d\x4e\xa7\xa4\xa0\x4e\xb4\x59\x4c\x8d\xc0\xa6\xd6\x89\x98\x2e\x8b\x55\x36\xc5\x3b\xb0\x13\xf8\xf3\x5f\xf0\xcf\xe3\x3f\x6e\x57\x63\x27\x10\xde\xff\xf2\xee\x75\x60\x01\xfc\xf5\x0d\xce\x4f\x3b\xfc\xf0\xe8\x22\x0d\x9a\x51\xdd\x69\x13\xcb\x13\x81\x60\xfd\x27\x8e\x33\xc2\xcb\x69\xbf\xc0\x6d\x74\x1c\xa0\x43\x78\xcf\x66\x46\x28\x01\x1a\xb5\xa2\xd8\x8b\x92\xb6\x69\x65\xde\xa1\xa3\xb9\xf6\x81\x35\x69\x7f\xff\xdd\x77\xdf\x0f\xd3\x76\xd8\x3f\xee\x99\xd9\x36\x68\xa6\x4f\x28\x26\x52\x67\xf4\x51\xf3\x70\xfa\x93\x0d\x31\xbc\xfa\x10\xc4\x3f\xdb\x7c\x6e\x1f\x24\x63\x35\xe5\x2b\x27\x74\xce\x5a\xf7\x1f\x79\xc6\x7f\x2c\x05\x9e\xa7\xc3\x20\xf2\xae\x21\x2f\x7d\xe7\xba\x0f\xaf\xe4\xeb\x6e\xac\xfe\x49\x59\x3b\x50\xea\x16\x57\x08\xbf\x4e\xd1\x9a\x9e\xb7\xb2\xfb\x0d\x0c\xd9\xda\xb7\xab\xd3\xa3\xa8\x4d\x6e\x30\xa6\x39\xfb\xe5\xdd\xeb\xbd\x6c\xec\x24\xe3\xd6\x82\x55\x1c\x89\xa9\xb3\x06\x03\x7f\xf7\xba\xfe\x9c\x9d\x40\x28\x70\xf6\x4a\xaa\xf2\xe3\xac\xfe\xe7\x5e\xa0\xcc\xb7\x32\xd3\x66\x2f\xca\x6e\x21\xe4\xa0\xe5\x6f\x68\xf9\xe2\xba\x5c\x44\x22\xe5\x8c\x5a\x98\x59\x66\xc4\x4a\x17\x50\x10\x79\x5d\xe2\x08\xda\x11\xb3\x9a\x3a\x19\xd2\x3f\xf6\x72\x4e\xf8\x1f\x75\x28\xe4\x45\x01\x69\xce\xd5\x28\xcd\x5f\xde\xbd\xa6\x72\x1b\x56\x5a\x88\x77\x02\xe3\x1e\xcf\xb5\x81\x36\x19\xfd\xf7\x1b\xf7\xd4\x71\x5b\x67\x4e\x2d\x8b\x3c\xef\x95\xe1\xca\xc2\x1e\x2a\x75\x8e\x17\x5e\x9d\xd3\x2c\xab\x0d\x9d\x70\x24\x4d\x89\xbb\x6c\xcd\x32\x5e\x2a\x3c\x2c\x3c\xc7\x9a\xa7\x3d\x3d\xfd\xeb\xb7\xdf\xfe\x75\xb6\x17\x81\xe3\xd1\x2e\x4b\x0b\xed\x51\x22\x8f\x46\xbf\xed\x9c\x9a\x05\x37\x0b\x51\x20\x9f\x90\xab\x95\x48\x21\xfb\x23\x5b\x37\xd2\x44\x7a\x61\x32\x9a\x7a\x02\x6f\x19\x24\x7d\xa6\x79\x2a\xd2\xbd\xee\xe9\x4f\x69\xd3\x91\x7a\xb8\xb5\x2e\x0c\x57\x42\xdf\x3e\xbe\x66\x69\xa4\x2a\xce\x21\x50\x14\x4d\x65\xe0\x83\x2a\x2c\xe5\x28\xe1\x87\x74\x53\x75\x8b\x5d\xf7\xec\x99\x54\x03\x55\x7b\x75\x76\x4b\xa6\x17\x0f\x5a\x89\x38\xc0\x67\x6f\xa0\xcb\x98\xe8\x6b\xc3\x39\x2c\x7d\x5f\xd2\xf7\x9f\x2b\xfc\xdf\x6e\xd2\xeb\x82\x11\x10\x19\x64\x95\x01\x94\x56\x7b\xea\x85\xc8\xd0\xc7\x57\x2c\x85\x34\x55\xa4\xa2\x7d\xb2\x13\x7a\xa7\xcd\x18\xdc\x1e\x97\x70\x90\xd2\x41\x29\xfd\xa7\x64\x8a\x20\x40\x63\x4f\xd4\xd3\xd9\x96\x08\x19\xab\xf5\xd0\xb1\x01\x02\xa8\x17\x24\xf8\x06\x48\xd5\xa0\xbe\x97\xcd\x2c\x97\xa3\x9d\xf9\xe7\x10\x07\x11\x69\x4c\x8c\xac\x31\x8a\xe9\x74\x7b\x5a\x1c\xe6\x21\xbb\x3c\xb0\x2b\x20\x93\xf3\x9e\x77\xd5\x86\xf8\xa8\x2f\xc4\x77\xd3\xee\x3e\xd6\x38\x62\xa7\x43\x77\xe8\x82\xaa\xa7\x47\xc3\x28\xeb\x14\x02\x14\x93\xfd\x4c\x32\xc0\x79\xec\xeb\x34\xb0\xda\x97\x0f\x9b\x01\x61\x80\x1b\x69\x2c\xba\xdf\x4b\x86\xd6\x8d\x4a\x64\x97\x52\xdd\xd8\xc8\x2d\xbe\xa2\x17\x4c\x9f\x5a\xc7\xad\xd0\xc1\x67\x0b\xa9\x2a\xcc\xc5\x3c\x50\x46\x6d\x29\x27\xd8\x8e\x8e\x34\x05\x66\xb1\x33\xa8\x5f\x80\x58\x04\x14\xf2\xc2\x20\x65\xd7\x3b\xf4\xb7\xb7\x2f\x1e\xbd\x90\xbe\xc6\x9e\xeb\x3a\xb5\x2f\xfe\x5c\xf3\xaa\xaf\x05\x75\xf8\xd0\x76\x13\x8b\x5e\x35\xaa\x1e\x27\x18\xe6\x99\x28\x36\x9e\x6f\x2f\x6c\x90\x26\xec\x97\xcb\x37\xbf\x52\x1a\xea\xe4\x68\x8f\xc3\xb6\x96\x3c\x68\x3f\x5f\x81\xf6\xe3\xbd\xd3\xbb\x30\x4d\xff\xed\xa7\xe3\x9a\x7e\x85\xae\xb7\x1f\x80\xf8\x65\x70\x05\xbf\xf9\xc7\xe5\xa8\x8f\x8f\x86\x5b\xb1\x23\x79\xc1\x87\xb8\x80\xad\xa7\xdf\x34\xce\x8e\xcd\x86\x7b\x41\xa2\x2e\x93\x96\x89\xe8\x26\xac\x06\xec\xfb\x12\x25\x00\xf4\xc4\x85\x4d\x18\xaf\xa6\x42\xb0\x6b\xa3\x6f\x84\x79\xf2\xa5\x60\x7a\x27\x6a\xdb\x17\xd7\xd7\x50\x7a\x72\x0d\x80\xaf\x51\xac\xc5\x22\x3d\x00\xb2\xbe\x8e\x7e\xa4\x37\xc6\xea\xe2\x9c\x9e\x63\xf2\x01\x1c\x7f\x01\xb7\x31\x97\x59\x21\x8c\x7b\xfc\xe7\xf4\x98\xb7\x6c\x7e\xe6\x40\x80\x0b\x5a\x2b\x87\x3d\x68\xe0\x09\xfc\x00\x26\x5c\x41\xe6\xb9\xe0\x69\xd0\xc5\x7d\x9c\x88\x31\x19\x0d\x4b\x69\x0b\x6d\xd6\xc7\x13\x76\x29\x55\x42\xb2\xc4\x01\xc0\x82\xbd\x6b\x68\xc4\x0a\x1e\xf5\xdb\xa1\xb8\xb6\x12\x77\xc2\x30\x08\xf2\x43\x64\xbd\x61\x58\x8c\x1a\x3b\x96\xd6\x4b\xba\xa6\x73\x66\x3f\x61\x65\xa5\xba\xf9\x51\xaa\x54\xaa\xe8\x58\x01\xe4\xa2\x5b\xcc\x61\xe8\xe8\xfb\x0a\xfa\x17\x47\xa8\xec\x56\xf2\x5e\x88\xac\xa1\x9d\x5d\xd6\x5b\xa8\x5a\xb4\x42\x9f\x2d\x68\x15\xdd\x90\xf8\x3e\x0a\x1d\x80\xd9\xda\x07\xba\xbe\x21\x25\x06\x02\x0e\x99\xc0\x3d\xd1\xd8\x6e\xa1\x36\x91\x18\x00\xea\xd7\x1d\x86\xce\x4e\xc4\x47\xc8\x8b\x8f\x19\x99\xd3\x04\x26\x2d\x35\xa8\xbd\x5e\x37\xd0\xe2\xd4\xfd\x27\xfb\x5c\x6f\x9c\xe9\xeb\xbb\x00\x9f\x1e\x0d\xde\x7d\xd0\x04\xa6\xb4\xe4\xcf\xe5\x0e\x8d\x1e\x5a\x46\xfb\xeb\x85\x5a\xcd\x08\xac\x87\x97\x25\x5a\xb9\x48\x27\x8c\xa6\xc9\xd2\x53\x18\xbf\xf3\x94\xbd\x68\xdf\x19\x46\xb2\x02\x50\x61\x2b\x1e\x37\xb9\xd1\x98\x82\xfc\x94\x9d\x0d\xcf\x46\x0b\xc0\xa4\x52\x2d\xc7\xb1\x7c\x9d\x56\x6b\xd6\x59\xfd\xaf\xed\x89\x66\x01\xa0\xdd\xb3\xce\xf6\x9b\xf2\x05\x1e\x0a\x9b\xf0\x4c\xaa\xc5\x6b\x98\xd2\x95\xc4\xde\x6a\xa3\x7c\xa5\x89\xc0\x06\x3c\xb6\x42\x80\x95\xb6\xde\x0b\x98\x11\x0a\x0a\x8d\xf9\xf7\xb3\x6a\x48\x4f\xb2\x9e\x79\xa6\x6f\xd8\x2c\xc9\x4b\xff\xd7\xc6\x2a\x81\x87\xc7\x80\x1c\xde\x82\xd3\xdd\xc7\x37\xfd\x2e\x53\x9d\x94\xf5\xc4\x12\x70\xf5\xad\x20\xd0\x26\x95\x33\x24\xa1\x2f\xf6\x5e\xa3\x96\x1a\xfb\xbb\x42\x0e\x14\x8b\xd5\x4b\x41\xd1\x1a\x4c\x7d\x16\x69\x3d\xb0\x28\x59\xb3\x4c\xdc\x8a\x0c\x50\x01\xc3\x4e\x73\x61\x12\x38\xc1\x22\xc4\x68\x4f\xdc\xd4\x07\x12\xa3\x08\xf7\xde\xfd\x3c\xa9\x27\x75\x5d\xe8\x74\x52\xe1\x2c\x00\xf6\xe1\xb1\x19\xce\xf5\xc1\x4c\x85\x07\x24\x4c\x84\xb7\x3d\x5d\x2e\x73\x3e\x69\x80\x99\x10\x5f\x9e\xa4\xe2\x96\xda\xb5\x07\x7e\x21\xb0\x4a\xe3\x93\x27\x93\x78\x9a\x0d\x40\x7c\x58\x6a\x6e\x49\x92\x83\x6b\xe4\x2b\x70\x8d\xac\xf8\xc7\xcb\x84\x47\x4f\x3a\x3a\x3e\x53\xac\xcc\x73\x61\xd8\xb5\x2e\x55\x5a\x15\xe0\xd7\x13\x20\x2f\x74\x4a\xd6\xd5\xe0\xb8\x4b\x3f\xa8\x40\xba\x48\x47\x96\x89\xac\x02\xd8\xd0\x18\x26\xd5\xc3\x58\xf2\x90\xd5\x0a\x43\x4d\xf4\x9d\x62\x09\xcf\xa9\x76\x0c\xb7\xe1\x18\xa3\xf5\x6c\x11\x06\x4f\xf1\x2c\x23\xc8\x5f\x20\x0b\x5c\x49\xb5\xd5\x8d\x34\x47\x52\x77\x5d\x43\x9d\x0f\x48\xf8\xee\x85\xcb\x18\x24\x15\xa9\x75\x3d\x27\xe9\xde\x4d\xa0\xb2\xfe\xf4\xe9\xbf\x84\xd1\x4f\x9f\x36\xb4\xf5\x50\x81\xcb\x4a\x70\x9a\x47\xd1\x61\x72\x43\xca\x2a\x9c\x36\x65\x29\xdc\x5d\xa1\x19\xc0\x1e\xd2\xd7\xa1\x04\xaf\xce\x0a\xac\x98\x8e\x48\x1b\x43\xb9\xe1\x10\xf7\x78\x6c\x00\xe6\x67\xbb\x60\xa3\xb3\x4c\x97\xc5\xf3\xed\xd8\xac\x37\x97\x0b\xcd\x16\x86\xa7\x25\xaa\xd8\x16\xf2\x32\x81\x8f\xcc\xc1\x47\x43\x94\x9a\x41\xda\x58\x3f\x1f\x64\xec\xad\xb8\x95\x16\x0e\x07\x89\x07\xd6\xeb\x21\xb4\xad\x6a\x9c\x33\x5d\x76\xc3\xbe\x0d\x80\x24\x32\xc0\x54\x06\x5f\x0e\xd6\x9a\x66\xcb\xd9\xdf\x75\xc6\xd5\xc2\x4d\x0a\x9e\xf8\xc3\x87\x94\x60\xa7\x77\xc1\x44\x7b\x88\x51\xd2\x85\x8c\x40\x55\xc7\x0a\x09\x67\xd6\xe2\xd8\x00\xe8\xf1\x9c\xe0\x91\x26\xbb\x4b\xbf\x01\x21\x92\x69\xcc\xbe\x3b\x3d\x1a\xbc\xa9\x4e\x93\xec\x95\xfb\x7c\x2f\x53\x2c\xd1\x99\x8e\x4d\xa1\x3d\x87\xdf\xa5\x39\x7a\x2c\xd3\x0b\x68\xee\x91\x97\xc5\x00\x7a\xc2\xb2\xa2\x75\xa8\x83\x76\xf0\x15\x68\x07\xd4\xf4\x25\xee\x4c\xaf\x20\x59\x6c\x25\x2c\x56\x2d\xb9\x2f\x77\x7f\x8d\x8c\xfd\x61\xa3\x89\xec\x0d\xd2\xb6\x27\x75\xeb\x83\x85\x7b\x1d\x1d\x96\xbf\x30\xa2\x28\xd6\x98\x59\xb7\x5d\x36\xc0\x71\x8e\x5f\xba\x3c\x3e\xa9\x16\xc7\x7e\x56\x26\xc6\x30\x21\xab\x6e\xaf\xbd\xa1\x79\x18\xb9\xa3\xb3\xf4\x8f\xd2\x56\xb8\x41\x1e\x84\x9f\x57\x69\x7d\x28\x52\x5e\xfc\xfa\xf3\x9b\x27\xbb\xdf\xd6\xc0\x13\x73\xb5\xb0\xa7\x47\x83\x9b\x3d\xe4\xe5\x7c\xc1\x79\x39\xa8\x59\x9d\x1e\x0d\x23\xac\x4b\xfe\xbd\x86\x8f\xf7\x94\x7e\xb0\x7f\x1b\x79\x6b\xdf\xd4\x89\xbd\xed\xbd\x60\x99\x12\xf5\x45\x73\x3f\x59\xf1\x9c\x2a\xf5\xfb\x99\x25\xeb\x38\x15\x3a\x0b\x3f\xe6\x22\x29\xaa\x09\x43\xbf\x5d\xfd\x3c\xfe\xbe\x72\x99\x5b\x16\xb4\x57\x70\x94\x2b\x00\xc9\x8d\x86\xba\x77\xd4\xb1\x60\x36\x34\x68\x48\x14\xa8\x3b\xd7\xaa\x80\xa1\xd4\xa0\x57\xc1\x64\xfd\x81\x99\x1b\xd7\x50\x61\x66\x48\xe5\x22\x94\xae\x21\x5e\x01\x59\x27\x57\x7e\x3d\x9e\x59\x98\xb5\x9b\x8a\x7a\xc4\x59\x00\x26\xd9\x4d\x55\x0d\x49\x3d\x98\x1e\x04\x17\x87\xd1\x1b\x98\xbb\xea\xaa\x55\x9d\x6f\x33\x5b\x0f\xb9\x4d\x81\x1f\xbd\x05\xa7\xeb\x84\x5d\xe2\x24\x90\x53\xf6\xbe\xba\x8e\xff\xef\xae\xe3\xc3\x29\x44\xc4\xdf\x4f\x6f\xc4\xfa\xc3\x08\x4c\x02\x13\xda\x27\xfc\x6e\xad\x2c\x92\x4e\x4b\x37\x81\xa1\x75\x54\x4e\xa1\xae\x56\xd3\xa4\xd9\x6c\xdd\xf8\xfd\x81\xbd\xfa\x3b\xc5\xc2\x5c\x1a\x27\x85\x61\x20\x91\x7e\xf3\xe8\x51\xb8\x16\x5d\x1e\x14\xb3\xaf\x40\x31\xab\x58\xcc\xf6\xec\xb0\xfa\x94\x9d\x20\x6b\x81\x9b\x93\x8a\xc3\x3c\x04\x60\x36\x2a\x90\x5b\xce\xc2\xdc\x13\x79\x64\x0d\xbe\xc9\x1f\x03\x20\x69\xa0\x90\x5a\xd7\x6f\x0c\x08\xa3\x77\x77\xc8\xc8\x9a\x49\x38\xa1\xed\x02\xbf\xa2\x90\x25\xc5\x75\xb8\x77\x59\xb0\x4c\x53\xd5\x23\x0d\x84\x86\x02\x39\x1f\x7b\x0f\xee\x98\x5a\x6c\x41\x85\x62\x24\xf7\x7a\xff\x7f\x00\xf8\x87\x90\x97\x04\xd9\xdb\x30\x13\x1b\x6d\x70\xb0\x00\xc4\x3e\x30\xf7\x39\x18\x72\x46\xd8\x61\x3c\x3f\x6c\x26\x41\xc1\x97\x8f\xcf\x04\x6f\x75\x56\xae\x76\x79\x10\x17\x10\xe3\xb7\x40\x5b\xec\x1d\xc2\x60\xe7\x19\x97\x2b\x3f\xea\x0a\xd5\x9e\x50\x27\x50\x56\xd3\x40\x7e\x9b\xc0\x25\x9d\x4e\x29\x29\x44\x98\x29\x20\xe7\xc3\x63\x63\x67\x80\xd3\xea\x5c\x28\x9e\xcb\xd3\xa3\x41\xb4\x75\xea\x76\x50\x14\x7d\x76\xf1\xe2\x01\xb4\xbb\x15\xcf\x63\x2f\xb0\xde\x09\x7c\x84\x41\x61\x9f\xfc\x00\x6f\xd8\x1b\x5c\xb4\xb7\x47\xc6\xff\x41\x44\x7f\x6d\x22\x7a\xe8\xc5\xdd\x29\x61\x76\x7e\x6f\xf0\xf1\x03\xbc\x36\x02\x19\x89\xf6\x03\x35\xfe\xfb\x52\x23\xa3\xf4\xa8\x33\xa5\xb4\xf3\xa3\xdb\xc8\xe3\x01\x9b\x85\x54\x0d\xa8\x65\xa9\x3f\x26\xd1\x58\x40\xd1\xf6\x5c\x18\x23\xd2\x47\x67\xb0\xee\x80\xaf\xa0\xbd\xe3\x0e\x67\xa3\x0e\x93\x5f\xda\xb1\x06\x08\x3e\x4f\xaf\x4f\x8f\xa2\x8e\xd9\x7e\xae\x7a\xce\x2e\x9e\xff\x78\xe0\x20\x07\x0e\xb2\x05\x07\x59\xf1\x8f\xbf\xa9\xca\x61\x14\x79\xb6\xab\x56\x6e\x40\xae\x1b\x13\xbe\x9b\xb9\x7b\x71\xf9\x02\x65\xbd\x3c\xf5\xea\xe7\x90\x31\x2c\x13\x1f\xae\xdc\x34\xf5\x14\xe3\xd7\x56\x67\x65\xd1\x77\x51\xf0\x9f\xdf\x1e\x66\xec\x55\xa9\x56\x8d\xaa\xf7\x67\x33\x26\xe7\x6c\xb6\x92\x6a\x5c\xad\x0f\xfd\xc0\x02\x30\xd1\xc3\x46\x7d\x62\x9f\x4c\xd8\x1b\x95\xad\x99\x56\x58\xd7\x30\x5b\xf1\x8f\xe3\xc6\x49\xa8\x2f\x5b\x1b\x7a\x00\x34\x1d\xb1\xb2\x53\x27\x47\x7b\x70\xa0\x95\x54\x67\x9f\xf0\x46\x21\x12\xdc\x0b\x15\x02\xbc\x32\xcb\xe0\xbe\xb6\xbd\xd6\x00\x4c\x7f\xe1\x3d\xd7\xba\x79\x17\xb1\x58\x77\x77\xb4\x79\x73\x0f\x78\x17\x43\x9c\x3e\xe3\x05\x84\xd4\x4e\x8f\xa2\x2e\xa8\xcd\x5b\x81\xdd\xd3\xf7\x9f\x2b\x15\xf8\xde\x60\x84\x54\x14\x22\xa1\x02\x06\xa0\x95\xc6\xa0\xe5\x5e\x88\x0c\xde\x5d\xed\xaf\xf1\x38\xf0\x68\x4f\x8c\x00\x2d\x93\x9d\xc8\x02\x2b\x37\x1a\xff\x16\x00\xa9\x55\xdd\xd1\x8a\x69\x95\xad\xf7\xca\xe7\x3e\x08\xc2\xa0\x20\x74\xd7\xf1\xdc\x39\xdc\x22\x91\x73\xa5\xe9\x16\x1b\x57\x7f\x22\x56\x79\xb1\x7e\x52\x93\x40\x44\xe9\x41\xf5\xbb\xd2\xb2\x95\xb4\x90\x1a\xbe\xdf\x4d\xff\x29\xa5\xfa\x22\xd3\xd7\xd1\xfd\x9f\x5f\xa8\x94\x9a\xcc\x49\xe7\x5d\xa9\x70\x5c\x27\x99\xf9\x57\xe9\x00\x07\x1d\x94\xd4\xf3\x34\x81\x18\x92\x9e\xd3\x17\x0c\xac\x1d\x5e\xe8\xc6\x68\x25\x20\xb0\xbd\x5e\xe9\x10\x73\xed\x1b\x86\x15\xc1\x57\x75\x7a\x50\xa3\x0f\x6a\x74\xb4\x1a\x3d\x44\x89\x46\xaf\xa0\xb3\x63\xd9\x43\x30\x11\x04\x59\x41\x38\xd0\xe5\x81\x2e\xa3\xe9\x12\x22\x7b\xe9\x6b\xad\x64\xa1\xcd\x96\x3d\x48\x39\x9b\x5d\x54\xdf\xce\xea\x30\x93\xb4\x95\x2c\xf0\x9c\xbc\x17\x30\xf3\xbd\xc5\x1e\xea\x0c\x5b\xfb\x81\xba\xcf\xe0\xdc\x42\xdb\x34\xe5\x9d\xe5\x3a\x1d\xaf\x3c\xa0\xaa\x69\xf6\xe4\x0b\x77\x24\x95\x59\x36\x76\x51\xdc\xd3\xa3\x28\x7c\xb5\x9f\x37\x58\x18\x50\x5d\x7e\x89\x20\x3e\x97\x91\xd1\x2e\x37\x4c\xda\xd5\x40\x95\x6a\x62\xc4\x42\xda\xc2\x84\x14\x11\x77\x72\x5f\x21\x90\xeb\x14\x0c\x0f\x59\x40\x49\x21\x84\x91\x20\x1a\x3d\xab\x79\x06\xcc\xf0\x48\x75\x72\x23\x8c\x5b\x12\x32\x05\x67\xfb\x11\x6e\x0b\x9b\x07\x76\xfb\x15\xb0\x5b\xb9\xe2\x0b\x71\x51\x66\x99\x30\xcf\x45\x26\x16\xdb\xdc\xfc\x3f\x80\xd1\x60\xad\x2b\xe3\xf7\xb4\xe6\x3b\x59\x2c\x19\x77\xfb\x4e\x2b\x83\x29\x94\x79\x80\x3a\x8e\xa0\xf2\x82\xb4\xda\x8c\x8f\xa0\xce\x68\xa0\x09\xee\x78\x9c\xe3\x96\xa1\xa5\x70\x09\x39\x9d\x01\xb0\x46\x83\xfa\xe5\x9e\x54\xb5\x3b\x88\x8e\xdb\x1c\xda\xdf\x17\xfa\x5e\x2d\x08\x28\x88\x32\x09\xb1\x58\x9a\x99\xb3\x1f\xea\xdd\x6b\xff\x95\x47\xcf\x57\x05\x27\x26\x9c\xdb\xf3\x09\x38\x84\x2f\x15\xa4\x03\x62\x3d\xe5\x8b\x39\xcb\xc4\xbc\xff\x25\x30\x86\x66\xac\xa3\x26\x69\x37\x3c\x24\x05\xbf\x11\xaa\x76\x90\xcc\x1a\xde\x34\xef\xbd\x09\x79\xa8\x3c\x9b\x6b\x73\x82\xc9\xd1\xce\x62\x65\xe0\x71\xff\xb3\xe4\xe6\x66\x77\x3d\xf5\xff\xba\xcf\x0f\x4a\xea\x41\x49\xdd\x46\x49\xe5\xc9\x0d\x5f\x88\x2b\xe8\xae\x12\x79\x32\x78\xbc\x9e\xd8\xe8\x73\x5c\xca\xd6\x43\x01\xe6\xdc\x16\xe3\x3f\xb8\x09\x3d\x2f\x28\xfa\x85\x74\xb3\xdb\xd6\x00\x94\xea\xcb\x27\x13\xf6\x02\x2a\x0f\xad\x60\xd7\xba\x58\xc6\xc1\x44\xa7\xb2\x07\xca\x4d\xc3\x91\x3c\x62\xc5\x9d\x6e\xb1\x80\x97\xb2\xa8\xf5\xd2\x60\xe8\xc1\x54\x3e\x98\x91\x13\x06\xc8\x4d\xfc\x32\x37\xb2\xf0\x13\x8e\xc1\x3b\x20\x52\x01\x6d\x52\x60\xf6\x50\x00\x26\x42\xa8\x4e\xe4\xbc\xe7\xf0\x99\x11\x3c\x5d\xbb\x7c\x3c\x97\x11\x2c\xd5\x3c\x2b\x01\xa2\x1d\x70\xce\xc1\x49\xb2\xb2\x29\x66\x7c\x97\x50\xd8\xa0\x0f\x28\x34\x84\x83\x5b\xe5\xa6\x87\x57\x78\x7e\x60\x8c\xb0\xb9\xa6\x36\x28\x4e\xc0\xcc\xa5\xb1\x45\xeb\xe6\x2b\x67\x31\xf4\x57\x58\x84\x07\x9c\x74\x48\x29\x49\xf7\xac\x34\x13\x1f\x61\x12\xa1\x5a\xe0\xa6\x5d\xc4\x83\x17\xc9\x32\x78\x3f\x9b\xe0\x10\xc6\x3e\x1d\xac\x5a\xd4\xde\x45\xe9\xfd\xb0\x23\xa4\xc0\x03\x18\x17\x5e\x32\x9d\x1e\x0d\x6e\xbf\x53\x54\xbc\xf5\x92\xed\x20\x2b\x0e\xb2\x22\x56\x56\x0c\xd1\x24\x94\x1e\x9c\x1e\x0d\x9e\xb5\x9b\x20\xe1\xe3\x03\x35\x1e\xa8\x31\x9a\x1a\xb7\x1b\x6c\x16\x1a\xbd\xed\xe7\x3b\x63\x2b\xd9\xc9\xd1\x1e\x7c\xbd\xc8\xac\x9b\xe8\x4a\x93\x83\x22\x77\x77\x0c\x4f\xe2\xea\xd5\x65\x7b\xa2\xab\xf0\xe9\xf9\xb6\x55\x72\xdf\x0b\x92\x55\xc9\xb9\x38\x4d\xc9\xbd\xc7\x8e\xd2\xfb\xc6\x80\xb2\x6d\x0a\xf0\xb7\x3a\xf8\x65\xc0\xef\xd5\x7d\xfc\xa6\x1d\xe8\x8b\x8a\xaa\x96\xff\x5e\x67\xb8\x7a\x75\xd9\x0b\x92\x6d\x60\xcf\x69\x37\xee\x7c\xe0\x75\xfa\xfd\xd8\x2d\x31\xae\x4a\x08\xf0\x4f\x1f\x7e\x3f\x0e\x1b\xf2\x7e\x68\xf2\x46\xea\x7f\x63\xbf\x23\x70\x6d\x81\xda\x8b\x0e\x5a\x4d\xc9\x10\x01\xa0\x70\x36\xa9\x9a\x60\x1a\xe1\x76\x50\x00\x47\x4c\x03\xbc\x3b\x69\x05\x5b\xeb\x12\xa3\xf3\x56\xf4\xf3\x07\x68\x34\x07\x40\xe1\x40\x8d\x36\xf3\xe4\xb7\xf8\xfd\x78\xfa\xfb\xf1\xbd\xb6\x0d\x35\xa5\x04\xc0\x7e\x6e\x1a\xda\xfd\xe9\x0c\xbe\x9b\x01\xed\xf9\xf1\xde\xcd\x23\xbf\x9a\xdd\x9e\xcc\x00\x36\x77\x7b\x32\x50\x87\x33\x1c\xdb\xde\xea\xc9\x30\x3e\x00\x74\xb7\x27\xe3\x98\x6b\x00\xec\x67\x23\x9f\xe7\x75\xd1\xd0\xce\xa2\xa7\x59\x78\xb4\x21\x82\x72\xa3\x6f\x65\x3a\x68\x7b\xfa\x17\x57\x59\x9e\xbc\x05\x85\xfe\x75\x2e\x15\xcf\x9a\x65\x4e\xfd\xc2\x96\xb1\xdf\x9b\x3e\x59\x23\x84\x4a\xcc\x3a\x2f\x58\x21\xcc\xca\xef\x15\x15\x5c\xa8\xc4\x6d\x34\xe4\xa1\x0d\x87\x0c\xd0\x66\xb1\xed\x92\xdf\x92\xe0\x37\xd0\xeb\x50\x30\x32\x95\x97\x82\x67\x50\xe7\x06\xe3\x6b\x7d\x9f\xa3\x00\x48\x2b\x12\xd0\x2c\x12\xad\x94\xf0\x39\x76\x73\xbf\x3f\x91\xa5\x40\xe5\x98\xbb\xd8\xf0\x46\x78\x8d\x23\xe4\x25\x58\xf1\xb5\x3f\x51\xd5\x8b\x69\xe3\xb6\xb0\xce\x4c\x18\x10\x74\xa8\x11\x01\x41\x07\x20\xde\xf2\x4c\xa6\xee\x63\x87\x5a\xb8\x2e\xbb\x84\x29\x61\xbe\x41\x24\x40\x60\x27\xf4\xb7\x49\xe5\x5e\x86\xe9\xd8\x4f\x42\xb2\xd2\x8d\x16\xc3\xde\x7b\xd0\x2f\x62\x6e\xb8\x2d\x4c\x99\x14\x80\x99\x85\x50\xe0\xae\x16\x69\x93\x2e\xb0\x1c\xac\xe5\xb1\x0d\x6d\x1c\xe7\xd5\x0f\xbe\xc9\xfb\x8c\x3b\x00\xf3\x33\xbf\xc9\x4f\xc4\xdf\x1b\x04\x71\xd0\x8e\xfe\xc4\xda\xd1\x0b\xe5\xd8\xcc\x4f\xe9\x42\x5c\xd5\x6c\xf0\x42\x67\x32\x59\x47\x53\x55\xd3\x12\x5a\xea\x3b\x38\x6f\x2a\x78\xe6\x24\x9f\xa4\x25\x7c\xff\xab\xd0\xe5\x63\xd7\xd1\xd9\x19\x3c\xf7\xd9\x88\xcd\x9e\x3b\xdb\xd0\xf5\x72\x7c\x2b\xa8\x21\xab\x07\x54\x23\x38\x00\x31\xf6\x45\x7f\x2a\x04\xbf\x14\xeb\x7d\xd4\x4e\x20\xdc\x4e\xd5\xf3\x4b\x35\xd9\x5e\x8a\xf5\x63\xab\x9c\xf0\x30\x0f\xbc\xe8\xdf\x8f\x17\x35\xf8\xcf\xb6\x4f\xa6\xa5\xc1\xad\x73\x31\x62\x99\xbc\x11\x6c\x26\xd2\x85\x00\x46\x02\x2d\x9e\x8b\xa5\xd1\xe5\x62\x39\x14\x3d\xaa\xd4\xc2\x59\x00\x69\x5d\xa8\x09\xc0\xfd\x34\x48\x1b\x70\xf3\x91\xb2\x75\x7a\x34\x88\xc6\x4e\xef\xe9\x67\x6e\x4a\x3e\x58\x89\x90\x68\xd0\x57\xa1\xd0\x80\x76\xd6\x0b\x17\xc6\xcb\x8a\xd4\x57\x09\x52\x90\x6d\x3f\xb7\x60\x0b\x3b\x07\x47\xf0\x57\xe0\x08\x56\x3a\x15\x17\xda\x6c\xd9\x50\xae\x7a\x34\x9a\x3a\x6b\x6a\x6a\xf2\xf4\x2b\x81\xab\x82\xd2\xbd\x60\x19\x04\xa5\x33\xbb\x67\x92\x65\x1c\x6f\x18\xd3\x2c\x8a\xd3\xa3\xc1\x03\x06\x79\x84\x1f\x42\x71\x88\xb5\x1c\x62\x2d\xf1\xb1\x16\x22\x42\x1b\x79\x2a\x3f\x8a\x89\x88\xce\x7a\x25\x0a\x14\x10\xf6\xfe\x3d\xcf\xe5\xc2\xe8\x32\x9f\x7e\xa0\x61\x30\xa7\x1f\x6e\xa4\x4a\x4f\xdf\x57\xce\x86\xe9\x87\xa0\x1f\xe3\x8b\xc8\xf0\x05\x1d\x69\xf5\x2f\x79\xe8\xc1\xf8\xef\xdd\x83\xb1\xd0\x99\x08\x61\x6e\x98\xb5\x5e\x55\x10\x0e\x5c\xf5\xc0\x55\xb7\xe0\xaa\x30\xb2\xb0\xb0\x91\x67\x02\xa9\xee\xfb\x6b\xb9\x0f\x41\x60\x10\xf5\x8a\x51\x8b\xc5\xce\x5e\x8a\xf5\xfb\x1f\xde\x81\x6b\xee\xc3\xe9\x4f\xf3\xb9\x48\x8a\xf7\xa7\x97\xd8\xd8\xdb\x7e\x98\x7d\xd9\x6c\xb5\x30\x3c\x89\xd3\x73\x0e\x6c\xf5\x8b\x65\xab\xbd\x3f\xec\xf9\x81\x2d\x78\xb1\x99\xf9\xdc\xc2\x6b\x47\xea\xf6\x25\x7e\x03\x05\xcd\x52\x51\x85\x8a\xbe\x06\x45\x45\xa4\x30\x6a\xca\xc5\x9d\x3a\xbe\x3b\x8a\xa3\x82\xeb\x52\x66\x1d\xe8\x69\xed\xca\x65\x97\xae\xbd\x23\x15\x3f\xd9\xec\xdb\x30\x6d\xfc\xf9\xa5\xec\xe8\x02\x18\x26\xc4\x8b\xf2\x3a\x93\x76\x79\x59\xc0\x2b\x5f\xac\xdf\xe4\x81\x56\x43\xb5\xdf\xe2\x22\x08\x33\xea\x29\xf7\x5e\x21\xa1\x87\x5b\xf1\x02\xea\x17\xba\x17\x68\xa1\x89\xe3\xe4\x29\x86\xe5\x0e\x55\x63\x92\x8a\x41\xc3\x0c\x41\xf8\x71\xc6\xd7\xc2\xe0\x3b\xe6\xbd\x4c\x19\x41\xd8\x66\x07\x8b\xd2\x8a\x79\x99\x81\xb7\x01\xfc\x6f\x77\x1c\x5a\x22\xeb\x2a\x68\x66\xf5\x0a\xf2\x60\x6d\xd1\xdb\x39\xb7\xb1\x33\x74\x81\xcf\x4b\x03\xd1\x05\x56\x16\xc8\x3c\x98\xd5\xf3\xe2\x8e\x9b\x9e\x04\xd4\x01\x2c\x22\x41\xf8\xab\x8b\x40\x14\x90\x8e\xa5\x5f\x87\x63\xf0\x54\xe7\x2e\x20\x89\x90\xc0\x6e\xe3\xaa\x49\x5c\x83\x87\xea\xfc\x05\xa1\xca\x9e\xb6\x17\x63\xf4\x96\xc9\x1e\xa7\xe8\x18\x4a\x1a\x77\xc1\xc3\x0d\x57\xf2\x46\xff\x08\x67\x38\xe7\xc9\x52\x6c\xcb\xdc\x7f\xb3\xa2\xe7\x21\x30\xa9\x6c\x21\x78\x4a\x32\xbe\xfb\x96\x18\x7b\x89\x1b\x60\xb9\x83\x51\xe3\x38\x81\xdd\x7c\x73\xb4\x8b\x00\xbf\x91\xc5\x6b\x48\x4d\x8e\x93\x55\x45\x97\xe2\x08\xff\xb8\x22\x18\xfe\xef\x8d\xcb\xed\x3b\x0c\x5f\x70\x38\x35\x32\x9a\x2a\x5f\xba\xf1\x1d\x70\x99\x11\xb3\xba\x1a\x0f\xe3\x7a\x54\x1b\x01\xcf\xad\x13\x66\x98\x05\xc1\x7f\x18\xd6\xfd\xe9\x63\x61\xf8\x73\x1c\xfe\x23\x54\x12\xf8\xed\x8d\xc3\xdf\xf9\xba\xd6\x16\xed\xbe\x94\x05\xbd\x53\x38\x3e\x4e\x84\x49\x1b\xb0\x61\xf7\x6a\xc0\xe1\xaf\x81\xf1\x7b\x51\x06\x79\x7c\xed\x15\x3c\x8f\x70\x27\x6f\x14\x19\x80\xf9\x3e\x7b\xb2\x97\xee\x46\x33\xe6\x28\x93\x3a\x1e\x17\xb0\xed\xdc\x88\xb9\xfc\x28\xaa\x74\x09\x4a\xe7\x96\x58\x41\x81\x61\x35\x9f\xe0\x3d\x11\x1f\x39\xcc\xc0\x9d\x24\x7a\x35\xe5\x39\xa0\x4b\xa4\xb3\xa1\x38\xc8\x06\x92\x91\x11\x58\x6c\x1d\x04\x5e\x01\x41\x8e\x5a\x68\x58\x5e\x24\xcb\x0d\x9c\x8d\x58\xa9\x32\x61\xed\x26\xa0\xc0\x92\xa9\x48\x32\xe0\x90\x55\x8a\x42\x5d\x23\x91\x40\xcb\xf5\x09\xcf\xe1\x99\x4d\xb4\x59\x4c\x6f\x64\x31\xa9\x8f\x3b\x6b\x74\xc0\x63\x27\xf7\xb7\x1e\x58\x94\x0e\x05\x37\x5c\x9f\x89\xab\x75\x5d\xb5\x85\x0d\x4d\x4a\x05\xfd\xa2\x1e\x59\xe3\x25\x41\xf0\x56\xfc\xb3\x14\xa5\xf8\x91\x27\x37\x7a\x3e\x8f\xa4\x17\x50\x2d\x56\x65\xb2\x74\x13\xa4\x0a\xcd\xee\xb8\x2c\xa0\x75\x3b\xe4\xbd\x70\xa6\xc4\xdd\x06\xce\x20\xb4\x05\xcb\x15\x43\xc9\x0f\xed\xa7\x82\xbc\x42\xe9\x4d\x60\x54\x7d\x31\x62\xd7\x62\xae\x29\x79\xb6\xf9\x19\x74\x82\xc1\x5f\x49\x43\x4b\x01\xc3\x6a\xb1\xad\xf6\x2a\x96\x9d\xf4\xc3\xa5\xaf\xdd\x24\xe3\x60\xcd\xb1\x01\x03\x27\x91\x99\xa4\xd1\xd3\x11\xf7\x1f\x71\xbd\x2d\x19\xfe\x82\xaa\x71\x7a\x99\x7e\x2f\xef\x83\x03\x22\xa8\x5a\xf8\xb4\x4b\x7b\x9a\xc7\x47\x0e\xde\x0b\x9f\x55\xbc\x9d\xab\x8e\xcb\xc7\xb8\x26\xb1\xbc\xeb\xf5\x26\x68\x4f\x1c\xf0\x46\x03\x2b\xc0\x76\x2d\xc4\x78\xfd\x6e\xf7\x62\x96\x09\x57\x5a\x41\xb0\x08\xcd\x23\xbb\x03\xea\xc8\x12\x6f\x89\x50\xd7\x0f\x1d\x6d\x3d\x28\xcc\x75\x3e\x46\x69\xea\xd5\x7a\x97\x41\x07\xf2\x6a\xc4\xe4\x44\x4c\x68\xfc\xd5\xb8\x30\x32\xcf\xc1\x0b\xeb\x62\x8f\xcd\x55\xd1\xc4\xc0\x30\xaa\x85\x97\x27\x54\x2d\xb5\x7b\x8f\x0c\xff\x6b\xa0\x3d\x44\xfd\xb5\xac\xc6\xab\x83\x85\xad\x58\x71\xe5\xc3\x6b\x34\xed\x6d\x5e\x86\x0e\xe4\x27\x9a\x43\xa1\x18\x56\xf4\x8e\x98\xe1\x84\x3e\xee\x31\x63\x85\x91\x3c\x93\xff\x0a\x73\xf4\xa8\x1b\xd5\x46\xec\xa0\x09\xc0\xd9\x92\x4d\x49\xef\x92\xe1\x30\x90\xde\xfa\x77\x76\x2d\x00\xc5\xb9\x30\x32\x5f\x0a\xc3\xb3\x98\x27\x51\xa1\x70\x03\xc9\xbe\x61\x15\xe3\x1b\x1b\x58\x33\x3d\xdf\x60\x86\x81\x65\x80\xe2\x8c\x00\x9b\xc8\x27\xf1\xd5\xdb\x6b\xc2\x6c\x5e\x87\xe1\xea\xc6\xa7\xda\x6d\xec\x2a\xb0\x12\xb2\xc4\xd7\xfc\x56\x80\x80\xd3\x26\x85\x98\xb9\xb0\x98\x2a\x00\x33\xe5\xc8\x73\x5e\xc9\xf0\xb5\xff\x97\xc7\x17\x76\x35\x1a\x7e\xfa\x67\x29\x6f\x79\x86\x68\xd8\x82\x42\x30\x38\x80\xda\x11\x94\xf5\x99\x64\xc9\xd5\x42\xa0\xdf\xae\x02\x8d\xba\x12\x07\x52\x5f\xad\x78\x9d\x49\xd1\xbb\x06\xc3\xd9\x35\x5e\xbf\xe2\xec\x56\xa8\x54\x03\xd7\x98\x6b\x73\x83\xfd\x22\x81\x46\xca\xdc\x16\x46\xf0\xd5\x88\xe6\x3c\xf2\x42\xda\xf9\xda\xcd\xf7\x75\xf4\x39\x30\x45\xb1\xc1\x17\x62\x5f\x7f\xcf\x45\x07\x16\xf9\xb7\x22\x01\xb4\x91\x5e\xeb\x54\x6c\x71\xff\x2b\xc8\x1f\xa8\xcc\x5f\x40\xb9\xcb\xc4\x6d\x8d\xbc\x6c\x92\x02\x90\x4a\x3c\x27\x36\x75\x9a\xf0\x7d\x9b\x21\xa4\x75\xf6\xdb\xcd\xf0\xdf\x98\xd9\x32\x17\x26\x94\xfe\x33\x66\x49\xa6\x6d\x19\x18\xba\x13\x81\x7f\xf2\x61\xbf\x05\x09\xbf\x83\x24\x6d\x9f\xd5\xc9\x50\x25\x6e\x85\x21\x33\xa9\x96\x46\xf0\x8f\xeb\x26\x6e\x7a\x17\x23\x2d\xa9\xb6\x00\xda\x8b\xd0\xab\x2b\x34\x4b\x25\x5f\x28\x6d\xbd\x2a\x24\xad\x2d\x85\xdd\x4b\x10\x09\x6e\xb2\xf5\x5b\x51\x94\x46\x5d\x82\x64\x89\xc4\x08\x90\x99\x85\xdf\x1f\xb1\x32\x07\x57\xcb\xb3\x6f\xbf\x1d\x39\x23\xc2\xe5\x31\xc3\x2f\x64\x5a\xdf\x94\x79\x87\x65\x3e\xc0\xba\xfb\xb8\x80\xc1\x6d\xc2\x0c\x2b\x66\x35\x50\xa2\xbd\x4f\x7c\x90\xe2\x0a\xf5\xce\x0c\xd0\x46\x2f\x3d\xb0\x94\x80\x9c\x37\x5e\x78\xf1\x62\xc4\x8a\x4b\xd5\xe9\x0f\x28\x0c\x4f\xfd\xaf\x5d\x0b\x5b\x6c\xae\x3b\x60\x2d\x30\x28\x56\x17\xc6\xe3\xe4\x84\x67\x59\x2f\x39\xd1\xa6\x44\xba\x85\x0e\x1e\x1e\xf0\x2a\x3e\x26\x59\x99\x3a\x87\x23\x58\xa7\x3b\xd0\x3d\xa4\xeb\xb5\xa8\x19\xb7\x8b\xec\x04\xab\x00\x94\x26\x37\x20\x3c\x08\x5a\x2f\x24\x4f\x90\x56\xfc\x4d\x8f\x3a\x71\x81\xc0\x01\x1c\xb5\xe5\xf5\x3e\x3c\xa7\xd7\x0c\xdb\x4d\x46\x2c\xb8\x49\xe1\xbc\xfb\x3d\x11\x42\x9e\xc2\xe4\xff\x1d\x50\xb7\x79\x2e\x4a\x57\x04\x27\x7b\x09\xb1\xfd\x44\x2b\x3f\xee\x65\xd8\xac\x90\x06\xbb\xfb\xb6\xd0\xdc\x46\xe6\x9e\x87\xdd\xf0\x51\xb9\xe8\xac\x4a\x44\xe4\xc1\xdb\xb3\xb7\x05\xb8\xbc\x6a\x71\x26\x45\xd7\x93\x0d\xb7\xd9\xf6\xbe\x68\x94\x63\x5d\xf0\x0c\x10\x5f\xe1\x3d\x4a\x7b\xbd\x13\x99\x0a\x55\xc8\x62\xbd\x55\xb3\x36\x38\x31\x75\xeb\xbf\xa7\x0a\xd7\x34\x9c\x73\x53\x78\x56\xe8\x97\xe9\x85\xcf\xe0\x37\x81\x54\x7a\x4d\x9d\x48\xd3\x35\xb0\x42\xe3\x73\xeb\x1b\x70\x54\x66\x2b\x70\x20\x51\xf5\xd1\xb6\xfe\x80\x8f\xae\x18\xc9\x85\xd2\xc6\xf1\xb1\x1d\xcc\xa7\xe6\xa3\xbc\x47\x43\x0e\x34\xf1\x5c\xff\x96\x36\xdf\x6f\x48\x6c\x99\x92\x9a\x25\x71\x98\xfe\x0c\x12\x1d\x13\xb5\x20\xd8\x2c\x8c\x63\x8e\xa4\x73\x53\x01\x01\x25\x2e\x93\x6f\x9b\x7e\x0f\x2d\xf5\x90\xa5\x16\xb4\x84\x2b\x72\x2b\xaa\x53\x38\x5a\xe8\x8f\x66\xc4\x72\x06\xef\x6e\x91\x6a\xb1\x95\x1b\x02\xf7\xf9\xbc\x72\xd6\x92\x4f\xe0\x6e\x09\x6a\x4c\xc8\x1f\xf1\xf0\xa6\x02\x06\xf9\x7d\x90\xcf\x27\x3a\xbb\xfd\x04\x16\x42\x94\x56\xbe\xa6\x2e\xb8\x8f\xfe\x2a\xc0\x36\xa3\x02\x92\x40\x7c\x28\xf8\x1c\x36\x8e\xd4\xe7\xde\xa6\x69\x9d\x77\x7c\x6d\xd1\x22\x0c\xca\xdf\x2a\xa1\x19\x46\x71\x61\x64\xd9\xb4\xbd\x2a\xa0\x53\xd4\x69\xcf\x2e\xc1\x5e\x80\x03\x2a\x59\x52\x3f\x1f\x70\xbf\x5b\x66\xd7\x2a\x11\xe9\x5e\xd4\x0b\x9b\xfd\xc9\x89\xcb\x9f\x8c\xd1\xc6\x3e\x00\x8e\xa4\x62\x02\x60\x75\xc8\xe2\x5a\xfd\xed\x5d\x86\xf9\x17\xb1\x09\xb6\x87\xb2\xdb\xa8\x73\x3a\x50\xad\x29\x72\x15\xba\x09\xef\x72\xd9\x0b\x85\xb8\xaf\x73\x5e\xf0\x4c\x2f\xde\x51\xe2\xe1\xf6\x48\x74\x53\x64\xc9\xd2\xae\x43\x38\x34\x63\x36\x71\xe0\x37\xb5\x84\x81\xe3\x75\x61\xd1\x4d\xdf\x71\x51\x9b\x6b\x2f\x72\x13\x9e\x55\xad\x9c\x7a\x29\x3e\x5a\x6c\x22\x37\xc7\x99\xb9\x50\x68\x8a\x0d\xa4\x6e\x45\xe5\xba\x02\xe6\xbf\xca\xb5\x0a\x8e\x2e\x8c\xc6\x7b\x25\xed\xd6\x57\x46\x88\xe7\x72\x21\x6c\xb1\x03\xf6\x37\x0f\x8d\xe8\x41\xfd\x1d\x70\x98\x22\x58\xba\x13\x69\xfc\x91\x42\xa8\xaf\xc4\x28\xa4\x26\x0b\xc1\xc4\x3f\x4b\x9e\x79\xde\x0a\x6d\xb9\x36\x2e\xb2\x0a\x76\x05\x82\x5c\x35\xcc\x09\xc0\x9c\xb8\x6d\x85\xaa\x59\xea\x30\x18\xd6\x28\x71\xb5\xde\x1f\xe3\x3e\xdf\xe5\xef\xae\xf6\x76\x7b\x6a\xef\x75\xa5\xa2\xb2\xe8\xcb\x96\x8a\x65\x55\xde\x5b\x87\xb5\xe3\xe8\xcf\xef\x10\xf9\x0f\xc9\x7a\xef\xa1\xeb\x8c\x89\x50\xec\x82\x62\x51\x9c\x39\xcf\xe0\x56\x1c\xca\xaf\x49\x65\x47\x2e\x82\x56\xe7\x4b\x8c\xf0\x29\x40\x6a\x8a\x4a\x85\x95\x06\xbd\x8e\x1d\x91\x49\x3b\xbc\xa8\x73\x18\x76\x2c\x5d\xab\xfc\xa4\xea\x82\xae\xf3\x64\xff\x0b\xbf\xfc\xf1\xcd\xeb\x87\x7a\x52\x38\x6e\x07\x02\x6a\x26\x65\xbc\x4a\x82\x61\xd7\x60\xc7\xea\x39\x30\x1a\x0c\x25\x04\xa3\x54\x19\xa5\x26\x00\xf6\x6a\x56\xb2\xb9\x66\x5b\x91\xf5\x4c\xa8\xd0\xa3\x3e\x11\x1e\x58\xb1\xeb\xa2\x9a\xc4\x05\x8a\x64\x6b\x39\x24\xf1\x78\x27\xc5\xd0\x25\x7c\x74\x39\x69\xf5\x8b\xbb\xbc\x11\x77\x3b\xd9\x9d\xf5\x8b\xa2\x2a\x46\x04\x7c\x3f\x54\x31\x60\x7b\x66\x1c\xbc\x0d\x4b\x49\xfe\x6d\x34\xbc\x3b\xe4\x07\x15\xc1\x78\x2c\x05\x54\xd0\xc0\x5a\x27\x6d\x4e\x50\x53\x39\xfa\x6e\x45\x1a\x85\x67\x78\x9a\xbc\x40\x33\xf7\x6f\xff\xb9\x97\x29\xbc\xda\xdb\xe7\x3c\xa0\xa1\xf7\xc2\x66\xc1\xcc\x9c\x9d\xbd\xcb\xf3\x32\x90\xab\x3c\x6e\xd1\xf5\x18\xe8\xfa\x68\x0f\xa5\x5d\x69\xd5\x08\x75\x9f\xa5\x69\xfc\x78\xc3\x0d\x73\x89\xc3\xb7\x3e\x90\x5a\x59\x77\x9d\xb6\x6a\x7c\xb2\x42\x80\x40\x49\x7e\x5c\x8b\x84\x43\x3f\x0f\xd4\x6e\x00\x1b\x75\xb4\x3d\xb0\x4c\x43\x93\x7b\x74\x9b\xa8\x7d\x05\x0f\x60\xb1\x0e\xdb\x81\x10\xdc\xd6\x36\x84\xa0\x0e\x7b\x17\x63\x07\xc5\x52\x80\x9b\xb2\xc7\x07\xb1\xd3\xfb\xd9\xd8\xdb\xa3\xdf\x87\x6f\xb8\x4d\x96\xc3\x5b\xd0\x3c\xb6\xb8\x0f\x1f\xd1\xf7\x96\x03\x78\x51\x20\xb7\x42\xaa\xa2\xdd\xcf\xdb\xff\x1c\x95\x2c\xa6\xe7\xdb\xa1\xc9\x49\x6f\x0a\x5c\x8e\xaa\xec\x48\x3d\xf7\x06\x57\x53\xbd\xf5\x4b\x0d\x2e\xe2\x37\x47\xd9\x0f\x9d\xfb\x95\x75\x26\xde\xd1\x1e\x57\x91\x43\x79\xb8\xb5\xf2\x56\xec\x43\xf2\x37\xb2\x26\xf5\xaa\xda\xd1\xf1\x1f\x3a\x83\x5e\xc9\x02\xec\xce\xeb\x75\x34\xdb\x19\x31\x9e\x24\x22\x2f\x30\xda\xde\x7a\x09\x7e\xd9\x8d\xcb\x78\x74\x9a\xcd\xa5\x52\x22\xdd\xc1\xd1\x08\x87\x99\x61\x18\xfe\x94\x43\xd3\x18\x0e\xbd\x3c\x9a\x69\x08\x74\x60\x17\xb6\x6e\x8a\x9e\x5e\xf8\xd0\x20\x48\xdb\x8a\xe8\x88\x52\x51\xc6\x32\xf1\x91\x27\x45\xb6\x1e\x35\xa9\xb2\x5a\xc3\xe9\xd1\x5b\xad\xe4\x68\x7d\x1b\x66\xf4\x65\x5e\x1f\x36\xd6\x38\xcf\xb4\x15\xb6\x78\xeb\x3c\x0c\xe7\x48\x77\x91\xd7\x18\x52\xf3\xdd\x6d\x54\x7e\x8b\x26\x39\x53\x62\x54\xef\x1a\xac\x23\x9d\x19\x9e\x16\x4b\xb5\x70\x8d\xae\xda\x62\x06\xac\xb6\x74\x04\x8e\x30\xf8\x1b\x84\xc0\xc1\x4a\x1f\xec\xc9\x47\x23\x25\x37\x2f\x0c\xc3\xf7\x80\x18\xb8\x5b\xbe\xd2\x6a\x11\xf6\x42\xc9\x62\x2f\xad\x1e\x92\x75\x85\xf9\x19\xa3\x9f\x98\xc1\x6e\x1f\x00\xf9\x88\x2d\xca\xd2\x44\xc8\x23\x98\xa9\x69\xf4\xc7\xf5\x60\x1c\xd6\xae\x38\xcc\x8b\xa0\x60\x25\x02\x92\xe0\xcb\xbc\x11\xd9\xda\x07\x69\x0b\x8d\xf3\x67\x46\x7d\xc8\x0a\x2c\xd0\x8b\xc6\xf6\x35\xec\x89\x52\x0d\x6d\xdb\xce\x9b\x55\x5a\xfd\x48\xdd\xa6\xaa\x25\xea\x75\x6e\xdc\x13\xca\x99\x0b\xb7\xa5\x66\xf3\x18\x30\x5f\x1d\x13\x6a\xe8\x4f\x80\x50\xaa\x45\x0c\xae\xc0\x2a\x37\x1d\x75\xa0\x4f\x50\x16\xf3\x2c\x43\xd4\x4e\x1b\xfd\xca\x83\x70\xa2\x38\x49\x0c\x2f\xd9\x38\xf4\x37\x40\x95\x74\x0f\x15\xa7\xdd\xbc\x7b\xc8\x44\x68\x5e\xba\xff\x20\xb0\x11\xc8\xc7\x46\xaf\xc6\xbd\x58\x85\x4f\x0d\x21\x18\xa4\xa7\x37\x86\x00\x9d\xb2\xf7\x37\xd0\xd2\xea\x56\x04\x4a\x14\x6b\xbc\xbe\xac\x3e\x6c\x6e\x11\xdd\xa0\xb8\x01\x3c\xd1\x4b\x07\xb0\x33\xe7\x2a\xb0\xc6\xe6\xaf\xd7\xe5\x13\x8c\x27\x46\x5b\xeb\x4f\x61\x47\x75\x2a\x11\x9a\x1a\x95\x2b\x92\x7e\x21\xb0\x48\x45\x18\x4d\xa1\x46\xda\x89\xb3\x92\xbf\x39\x0a\xdf\x77\x6f\x71\x96\x9f\x26\x02\x39\xa4\x4a\x5c\x2d\x8d\xb0\x4b\xdd\x55\xcd\xd6\x49\x1b\x6d\x4f\xc4\x9c\xcb\xac\x34\x9d\x44\x62\xa9\x87\x60\x7c\x06\x15\xa3\x29\xd3\x75\x8e\x4d\xfb\x43\x90\x11\xf5\xc6\x53\x9f\xa1\xbb\x65\x9e\x00\x63\x27\x35\x10\x00\x49\x29\x53\x71\x2e\x88\x18\xe7\x82\x11\xd0\x8e\x63\xab\xd6\x6b\x4d\x71\x60\x4a\xb5\x99\xa4\xe3\x89\xec\x5a\x97\x2a\xf5\x0a\xfa\xb5\x28\x0a\x61\xa2\xf5\x12\xd6\x0a\xfb\xae\x46\x4c\x9b\x00\x18\x5c\x90\x22\xee\xb9\x36\x8d\x3e\x54\x09\xcc\x7b\xd8\xd2\xd5\xd6\xde\x7f\x0c\x9e\x87\xfc\x1d\xd0\x91\x28\xf0\xe3\x15\x57\x65\x20\xc5\x3b\x82\x71\x1a\x41\xe1\x27\x09\xa6\x76\x21\xcc\x6d\xf4\xd8\x56\xa8\xca\xd0\xf3\x42\xa8\xf0\x75\xb6\x1c\x6a\x5e\xd5\xdc\xce\xa0\x1b\xb1\x79\xf8\x16\xeb\x0c\x26\x9e\xe7\x82\x83\x52\x64\xa5\x4a\x44\xc8\x33\x8b\xac\x37\xa7\xcd\x61\x11\x15\xdd\xfc\x52\x2e\x80\x46\x73\x23\xb5\x81\xea\xc7\x93\xe0\xf1\x82\x5e\x26\xb6\x71\x7a\xed\x93\xd0\xdb\x65\x1a\x76\x8b\x37\x39\x70\x9b\x79\x7c\x0f\xa0\xd6\x63\xc4\x0f\x3d\x7b\xab\x49\xa2\xfa\x97\xbe\x12\xbb\xde\xa5\xa0\x71\x40\xa9\xb0\x76\x11\xb5\x5e\x38\xb1\x01\x11\xc1\x6d\xf3\x75\xf9\x05\x9a\x78\xdd\x4b\xaf\x22\xfb\xfb\x4d\xdb\x53\xb1\x25\x4a\xe2\xa3\x3e\x5b\xbb\x0f\x9a\x6f\x22\x2a\xce\x03\xc9\xb9\xce\xfc\xf3\x20\x02\x2b\xf9\xed\x50\x00\x07\xd2\xd2\x9c\x7e\x99\x89\x3d\xb1\x5a\x5a\x51\xc5\x6e\x22\xb1\x19\x0e\x00\x8d\xea\x96\x89\x48\x14\xb3\xba\xf1\x0d\xfc\x29\x58\xe0\xe7\xec\xb6\x4d\x84\xb5\x2b\x1d\xbd\x97\x46\x9a\xca\xc0\xf3\xd8\x81\x54\x77\x52\x59\x42\xc8\xe4\x99\x5c\x34\x0a\xf9\x90\x47\x68\x6a\x69\xdc\x71\xa2\xa3\x9d\x95\xf1\x88\x97\x1d\xa3\xde\xd2\x31\x2f\xe8\x64\xbf\xe5\x0b\xc3\x53\xf1\x0f\xa9\x52\x7d\xf7\x93\xda\x46\xf3\x41\x6c\x01\xb4\x8c\x32\x81\x37\x51\x5d\x15\xd7\x55\xc4\x49\x8b\x0f\xe7\x00\x9a\xf6\x3d\xf9\x32\xbe\xfb\x65\x77\x18\x3c\xa8\xd2\xd7\x9a\x3f\x0d\x2c\x42\xdb\x18\xbe\x5e\x1f\x64\x49\x79\x21\xc6\xc1\x9d\xc7\xf0\x5d\xb7\x2a\x31\x9b\x0b\x2c\x6d\xc5\xa4\xff\x2d\xf9\x0e\x49\x9a\x16\xb1\xde\xb7\xfe\x09\x7f\x9c\x6a\x68\x43\x0f\x05\xf9\xcb\xec\xd9\xe4\xd9\x77\x33\xd4\x84\xdc\x60\x47\x96\x73\x50\x86\x94\xb7\x3f\xe0\xe7\x93\xa7\xb3\x51\x4b\x75\xda\xdc\x48\x48\xe5\xd2\xf3\x40\x18\xa2\x65\x8c\x6c\xfc\x0a\xf9\x36\x5e\x5d\x5d\xb2\x4c\xaa\xa1\x3b\x08\xf3\x28\xab\x78\x6e\x97\xba\x78\xa0\x6a\x8b\x16\x2e\x2e\x7f\x3d\xbb\xb8\xfc\xef\x37\x57\x3b\x57\x5c\xd4\x69\x26\x2e\x5e\x8f\xb3\xd1\x5c\x3d\x6b\x67\xc0\x96\xda\x40\x22\xf5\x23\x0f\x8d\x5b\xa6\x0e\x38\x06\x8a\x1f\x91\xa3\x75\x9f\x68\x20\x5f\x6f\x4f\x6d\xd6\x25\x54\xf6\xbb\xc6\xc7\xe4\xae\x1c\x13\xc9\xed\xf3\x24\xa1\x85\x8e\xd8\xe1\xf9\xe1\x95\xd8\x1c\x52\x7f\x20\x35\x0c\xac\x7f\x5b\x88\xaa\x00\xa2\xa2\x8a\x6e\x92\x8f\x48\xf9\x6c\x3f\x66\xff\x46\x28\x3d\x80\x1b\x69\x2b\xa5\x48\x52\x22\xa9\x45\x05\x0a\x0f\x84\x39\x62\xd8\xa8\xd1\x84\x2b\xbd\xb8\x65\x2b\x51\x18\x99\xd8\xbd\xde\x14\xae\xff\x33\x34\xd2\xb9\xe0\xc5\xd2\x3e\x9c\x83\xaa\x85\xff\x36\x12\xaf\xda\x6b\xb6\x9a\xdb\xb8\xde\x83\x80\x1f\xea\x5e\xd5\xf6\xc0\x06\x16\xa4\x1c\xd0\x7b\xd9\xa9\xf5\x3b\xdf\xe0\xb2\xfe\xbe\x3a\x6e\x39\xc4\x71\x19\x3c\xf4\x5f\x2e\xdf\xfc\xca\x2e\x34\xd8\xcf\xc6\xb3\xc1\xd9\x94\x7c\x66\xae\x3d\xf8\xb4\xee\x37\x11\xf2\xf4\xe4\x51\xd8\xac\xea\x1b\xc2\xbf\xb4\x81\xf6\x06\x42\x2b\xdc\x00\xeb\xf0\xf8\x19\x35\x3c\xcb\x52\x91\xf7\x01\xb4\xa0\x81\x45\x22\x9c\x8f\x91\x8f\x38\x5e\xf7\xa1\x95\xd5\xc3\x60\xa1\x81\x81\x2c\x6b\xfe\x86\x0b\xaa\xf8\x1f\x0f\x2c\x43\xb3\xdb\x71\x3a\xef\x17\x8a\xb2\x08\x1f\x5a\x3f\xba\x88\x05\x92\x6b\xb8\xf5\x10\xed\xd0\x6b\x0b\xec\x69\x8b\x77\x08\x53\x3f\x1c\x1f\xf0\xf2\xb5\xfd\xf4\x86\xcd\x32\x3a\x0a\xf8\x66\x1d\xed\x20\x83\x85\x7e\x79\xce\xdd\x56\x17\x79\x75\x1e\xba\x79\xc0\xc0\x4a\xf1\xe6\x7d\xf0\x2a\x70\xd1\x87\x2a\x26\xc5\x6b\xf1\xfa\x4d\x77\xac\xb8\x77\x01\xd6\x15\x93\x52\xe9\x16\x4e\xd0\x21\x55\x01\xa3\xa1\xc1\x9f\xe7\x99\x4c\x64\x41\x5d\xde\x02\xbf\x59\xaa\x3d\x35\x89\xdb\x32\x83\x3c\x34\x17\xa2\xb9\x10\x8a\x67\xc5\x7a\x0b\xdc\x63\x85\x25\xcb\xdd\x77\x48\xb0\xd8\x46\xb7\x0d\xd6\x93\x55\x1b\x7b\x2d\xdd\x2c\x24\x74\x7c\x41\x51\xc7\x1b\x71\xf6\x6b\x73\x31\xd0\xf3\xdc\xa6\xa0\x7b\x0f\xa5\xa2\x77\xda\xea\xb1\x8e\xd6\xf6\xb5\xef\xe1\x54\x1e\x78\x03\x2b\x88\x7f\x9f\x1e\x0d\xa2\xdd\x17\x92\x37\x18\x52\xd5\x07\xb3\xee\x4f\x87\x49\xec\x53\xfc\xbf\x63\x1a\x70\xdb\x09\x9a\x31\x9e\x03\xb1\x05\x9c\x53\xc3\x52\x3a\xe1\x5b\x8d\x99\x68\x75\xfe\x82\x40\xce\xf9\x99\xfb\xde\x62\x0f\xcc\xcb\xf6\x00\x8a\x5e\x98\x38\x49\x66\xe4\x0b\x93\xfc\x63\x6f\x8f\xc1\x39\xb1\x4f\x2a\xe4\xd0\xf0\xa6\xf0\xbc\x0e\x23\x56\xba\xf0\x69\x0f\x46\xe4\xda\xca\x42\x1b\xd9\x68\x49\x47\xeb\xb1\xff\x37\xf9\xeb\xb7\xff\xd5\x5c\x2b\xe8\x2f\x04\xc6\x7b\xf1\xf2\xfc\xf2\x2f\xdf\x91\x71\xbe\x31\x2a\x09\x32\x9d\xa5\xb2\x13\x76\xc6\x7e\x79\x79\x59\xff\x4e\x00\xe4\x8d\x58\xdb\x02\x28\xfd\xde\x58\x7c\x6f\x5f\xc1\xbc\xfd\xc2\x37\xf5\xb9\x87\x98\xa1\xed\x7a\x12\x23\xd2\xaa\x9b\xf9\x81\x76\x5a\xda\x8d\x03\x00\xa6\x83\xa9\x36\xcd\x26\x08\xab\x15\x57\xa9\x9d\xb0\x5f\x01\xd7\xd5\xe3\x36\x5a\x17\x1b\xf7\x87\x26\x5f\x68\x9f\x90\xc5\x2c\x57\x14\xdd\x90\x8a\xc2\x2a\x1e\x01\x1e\x45\x81\xee\xb6\x71\x3a\xe8\x4d\x68\x72\xcc\x3d\x02\x07\x2a\xbe\x11\x15\xdf\xa3\xc9\x22\x70\x1b\x22\xf3\x73\x14\x26\x8c\xbd\x2e\x83\xb1\x02\xf8\x1f\x7a\x42\xb0\xaa\xd7\xcf\x27\x81\x79\x2a\x47\x0f\xa0\x46\x81\x1b\x72\x8b\x23\x7d\xf3\x2b\x3c\x47\x3a\x10\xf9\x36\x8b\x09\x7b\x0d\xd4\x05\x65\x51\xa7\x6c\x59\x14\xb9\x3d\x9d\x4e\xeb\x78\xef\x44\xea\x69\xaa\x13\x3b\x85\x70\x80\xc8\x0b\x3b\x05\xbf\xc0\xad\x14\x77\xd3\x3b\x6d\xa0\x63\xca\x18\xf8\xf7\xd8\xb1\x44\x3b\x85\x2d\xd9\xe9\x5f\xf0\xff\x05\x77\xc6\xd8\xd5\x9b\xe7\x6f\x4e\xd9\x59\x9a\x12\x5f\xa7\x26\x91\x4e\x5e\x4e\x18\xcf\x25\xb9\xab\x46\x0c\x1a\x8f\x8f\x58\x29\xd3\xff\xfd\xcd\x43\xe0\x4d\x23\x42\x78\xb6\x05\xee\x2e\xa9\x89\x68\xd3\x12\x27\x26\xa7\x0d\xa6\x6d\x03\xb1\xac\xa2\xa8\xc1\xd9\x8b\x69\xc4\x49\xc2\xb6\xef\x50\x83\x58\xf8\x6f\x1c\x1c\xad\x18\xa1\xd2\x79\xb9\x10\x78\x5a\x2d\x44\xd5\xdc\xdf\xb6\xe6\x0f\x0d\x33\xf9\x5e\xf8\xac\x83\xfd\xc7\x33\xf9\x00\xd8\x0e\xf6\x1f\xcd\xe4\x03\x60\x37\xd8\xff\x16\x4c\x3e\x00\xb4\x9b\xfd\x47\x32\xf9\x00\xdc\x36\x40\xef\x8e\x1b\x66\xf2\x01\x90\xed\x6d\x5a\xaa\xe6\x8c\x63\xf2\x47\x3b\x1b\xa0\xed\xe7\x8a\x4f\x13\x67\x67\x01\xb7\xd6\x86\xd8\xb6\xa5\xd1\x4e\x60\x1d\xd0\x2f\x85\x38\x71\x9c\x60\x89\x10\x2d\x9f\x4c\xb8\xec\x24\x5e\xa2\x19\x65\x8c\x88\xf9\xb2\x85\xcc\x27\x11\x33\x5b\xe0\x2f\x4e\xd4\x7c\x2a\x61\x13\x2d\x6e\x62\x05\x4e\x8c\xc8\x19\x12\x3a\x51\x62\x27\xc6\x3f\x94\x64\x32\xd8\x69\xfb\x1e\x5e\x41\x36\x9d\xbf\x7a\xc1\x74\xbe\xe1\x02\x82\xa4\x0c\x05\xb1\x7f\x62\x4b\x90\x55\xd8\x0b\x92\x01\x75\x73\xb3\xc0\x49\x65\x2e\xfc\xd7\x66\x97\xce\x9f\x3a\x62\xb3\xf1\xbb\xd1\x78\xac\xf4\xb8\x30\x5c\xd9\xb9\x30\xe3\xdc\xe8\x85\x11\xd6\x8e\xc6\xcf\x6d\xb1\xc6\x8e\xb1\x99\x36\x3f\x60\x75\xc6\x2c\xf4\x66\x2f\x85\xa8\xde\x0d\x1a\x99\xcd\x4a\x56\x23\xe6\xd3\xff\x35\xf9\x7e\xf2\x9f\xee\x47\x63\xb1\xba\x16\x69\x2a\xcc\x34\xc9\xe4\x64\x59\xac\xb2\x3d\xb8\x6a\x14\xa1\x0f\x5f\x95\xf8\x58\x08\xb5\x45\x9e\xc3\x55\x25\x83\x9c\x39\x5c\x7d\x6f\x27\x61\x5c\x2c\x4a\x98\x84\x3c\x5d\x49\x25\xdd\x9f\xc7\x98\xfd\x36\x6e\x00\xd8\x13\x23\xad\x7d\xe2\x1e\xcf\x28\xf9\x9e\x5e\x1a\xc8\x98\xbf\x9f\xbd\x63\x27\x7f\xc7\xd4\x7c\xff\xd3\x53\x62\x33\x21\x3d\x87\xd1\xa1\x7d\x3a\xff\x03\x88\x26\x0f\xea\x45\xcf\x8b\xfd\x1f\xf6\xae\xbc\xb9\x6d\x23\xcb\xff\xcf\x4f\xd1\xa5\xfd\x43\x47\x89\x94\x9d\xd9\x64\xb2\xdc\x4a\xd5\x72\x64\x67\xec\xd8\xb2\x54\x92\xec\xdd\xa9\x4c\x2a\x6c\x02\x4d\xb2\x23\x10\xcd\x41\x03\x92\x99\x9a\x0f\xbf\xf5\xeb\x03\x07\x45\x34\x1a\x84\x2c\xf9\x60\xec\xaa\x98\x12\xf1\xf0\xfa\xf5\xeb\x77\xf5\x3b\x5c\x0b\x23\x16\xf7\x87\x92\x86\x33\x50\x64\x2b\x4c\x14\x2d\x1f\x0a\x0d\x73\x7b\xb7\x05\x1a\x66\x0f\x1f\x06\x11\x3f\x51\x5a\x6c\xa0\xf3\x6b\x86\xb4\x9f\x5e\xea\x46\x22\xa0\xd1\xa5\x35\xb8\x1d\x76\x4f\x85\x7c\x38\xd0\x4b\x9a\xce\xad\x65\xa0\xa0\xac\x5b\xef\x0e\xb3\xc5\x83\xa4\x3e\x27\xa2\xed\x2d\xa0\xd7\x4e\xde\x5b\xa8\x5e\x56\x81\xcf\xa0\xd7\x61\x4f\x24\x4b\x51\xa1\x2a\x87\x7e\xef\x1f\x15\x59\x4b\x56\x9b\xe9\x6a\x94\x33\xba\xc4\xbd\x9e\xb1\x23\x60\x04\x43\xfd\xd5\x02\x25\xd6\x7f\x92\x25\x87\xc0\xe2\x52\xbf\x20\x9f\x4d\xb0\x15\x8a\x67\x74\xf9\x86\xad\x2e\x99\xa3\xcb\xf6\xbd\xe5\x5d\xdd\x37\xe3\xf3\xe5\xd5\x63\xe5\x8f\x99\xa7\x35\x5f\x63\xcf\xe7\x16\xbc\x1b\x15\x6f\xce\xf2\xb7\xc1\x3f\x77\x2b\xbc\xbd\x1d\xee\x01\xd2\xc7\x52\x6f\x45\x69\x5f\x6b\xdd\xc3\x5e\xaf\x1c\x3a\xf7\x35\x85\xfd\xcf\x1a\xf5\xfe\x46\xbb\xbf\xd9\xee\xa7\x6d\x9a\x4d\x77\x2f\x91\x85\xbf\xd2\x7a\xe0\x9d\xcf\xb7\x6c\x74\xd3\x1f\xe7\x70\x3f\x84\xb3\xbe\xa5\xbb\xde\x8a\x89\x77\xe2\xe2\x0b\x14\x17\xf7\xdc\xfb\x46\x90\xe4\x6b\x91\x15\x2d\x6c\xa0\x2b\x16\x64\x89\xb3\x0e\xf1\x31\x6c\x21\x69\xb0\xb0\x07\x66\x67\x1b\xed\x6c\xa3\x9d\x6d\xb4\xb3\x8d\x76\xb6\xd1\xce\x36\xda\xd9\x46\x3b\xdb\xe8\x31\x6d\xa3\x86\x2f\x60\xd4\x05\x1a\xe8\xc6\xe9\x07\x11\x65\x0b\x76\x1a\x51\x5e\x93\xd6\x58\x9f\xde\xe5\x31\xd8\xb1\xbe\x52\xff\x22\xc7\x80\x68\x14\x88\xc2\x81\xd8\xe2\x8f\x9a\xa1\x8f\xe8\xb0\x59\x03\x51\x37\xee\xe5\xd2\xb6\x78\xd8\xef\x6d\xc1\xaa\xcb\xea\x7a\x3a\x8d\xfd\x34\xb0\xbc\x13\x0d\x8b\x46\x96\xdb\x60\x6e\x47\xe7\x79\xa2\xac\x3b\x90\xe4\xf3\xf6\x6c\x26\xc5\x32\x93\xf3\x13\xb4\x1f\xf1\xc0\x57\x81\x90\xbd\xed\x74\x0b\x0d\xc3\xc4\x7f\x16\x04\x8e\xfb\xfb\xcb\xd7\xc0\x10\x6d\x9c\x1a\x67\x2a\xd4\x52\x09\x7f\x03\xda\xe2\xad\xda\xec\x5e\xd0\xa5\x29\x28\x54\xe9\x08\xda\xde\x3f\x2d\x92\x19\xc8\x28\x4b\xe7\xaa\xea\xba\x0b\x62\x3c\x56\x2e\x04\xf3\x44\x8f\x4f\xd7\xba\xb7\xe7\xbb\xc9\x65\x0e\x8b\x1c\x70\x76\xac\x54\x51\x2d\x50\xa2\x5a\xf7\x1f\xf6\xba\xc8\x40\x91\xcc\x68\x6c\x66\x93\xb5\xa0\x6e\x8e\x71\xf9\xf9\x2e\x24\x94\x6d\x92\x55\x4b\xa6\xc9\xdd\x9c\x21\x23\x3e\x61\x6a\x38\x02\x8d\x74\x76\x8a\xda\xec\x70\x7b\x7c\x1a\xa4\xf0\x5a\xdd\xeb\xb0\xe7\x85\xb1\x2d\x6f\xc4\x91\x1d\x90\xb7\xba\x77\x90\xca\x06\x26\x26\x1b\x98\x1c\xdc\x99\x64\x9f\xda\x7c\x58\xd5\xea\x04\xe3\x66\xf2\x6a\x49\xcd\xde\x73\x0c\x74\x66\x2c\xc6\xb0\x7d\x30\x16\x8f\x33\xdb\x93\xc0\x5e\x8f\xd5\x40\x7c\x3e\xf8\xfe\xb0\xb7\x05\x95\xcc\xfb\x8d\x65\xe2\x49\x03\xbd\xdc\x37\xc4\xf4\xd5\x2a\xf2\xcd\xf3\x31\x5a\xdb\xa0\x02\x3a\x88\x2c\xf5\xc0\x61\xe3\x60\xcf\x7c\xcc\x26\x7e\x86\x19\x5f\xc5\xd8\xc8\x65\x22\x6a\xa5\x96\x13\x29\x07\x07\x05\x11\x72\xb8\x92\x61\xcf\x89\xe9\x1d\x92\xb3\x60\xed\xc1\x16\x37\x8f\x90\x95\xc8\xf6\x93\xa2\x37\x84\x12\x11\xcb\x08\xdc\x50\x34\xe6\xb9\x07\x16\x87\x9c\x9c\x2f\x59\x2c\xe7\x7c\xba\xa1\x1e\xc4\xb1\x8e\xbc\x6b\x82\x6c\xc4\x16\x4c\x68\x46\x48\x14\xcd\x16\x54\x4d\x20\x39\xc0\x14\x13\x1e\x64\x11\xa6\x46\x59\xe3\xb6\xa6\xf0\x93\xc7\xe4\xe5\xe5\xe5\xf9\x25\x59\xce\xa9\x64\x87\x3d\xef\xeb\xfc\x0a\x3a\x1b\x8a\xe5\x4f\x2d\x4e\x06\xf1\x89\x29\xf0\xb3\x43\xeb\x37\x80\xc4\x00\x26\xb4\x46\xce\x92\x80\x11\x64\x96\xa8\x34\x38\x50\x7b\x89\x4a\xbb\x41\xaf\xbd\xfe\x8c\xa8\x4c\xaf\x91\x3d\xa2\xa8\x7a\xcd\xeb\xbd\x97\xca\x7a\xde\x52\xd4\x23\xf1\xc5\x1a\x79\x49\x9a\x83\xb2\x6d\x7a\xd0\xbe\x0d\x4b\xaa\x2d\x2d\x20\x4a\x17\xeb\x1a\xfa\x41\xaf\x5b\xa9\xba\xf3\x04\xd8\xe5\xbe\x5f\x02\x8c\xf7\x52\x11\x5e\x8a\x4a\xcb\xe5\xb2\xb4\xde\x3b\x8a\x71\x81\x80\x17\x7e\x72\xdc\x17\x4c\x4a\x3a\xf3\x43\x7a\x44\xe6\xd9\x82\xc6\x7d\x8c\xa6\x40\xc9\xa5\x7d\x98\xf0\x38\x44\x2a\x26\x4c\xc9\x90\xa5\x94\x43\x3f\x4d\x5c\xc3\xc5\x4c\xa9\x97\xd9\xd5\xc1\xb6\xc8\x27\x8c\x4a\x11\x7b\xe1\x0e\x82\xeb\xaf\xe7\x9d\x0f\x72\x82\xef\x4b\xb3\x17\xdd\x31\xd2\x4c\xe9\x85\xd1\x55\xde\x76\xbb\x82\xcc\xb1\xed\x4d\x78\x9d\x64\xec\x98\xfc\x4c\x23\xc9\x8e\xc9\xfb\x58\x55\x37\x6d\x8d\x97\xfa\x82\x0f\x56\xd7\xab\xa5\x7a\x7b\xa9\x89\x5c\x81\xdb\x96\xaf\x77\xf9\x9e\xfd\xfa\x73\xdc\x57\xe4\xee\xb5\xb4\x5b\xea\x53\x41\x6c\xc2\x71\x8d\x15\x58\x21\x03\x52\xe9\x40\x86\xca\x33\x25\xb9\x67\x52\x96\x69\x9a\xc2\xad\x53\x19\x78\x74\x63\x0b\xed\xb5\xd2\xb1\x93\x9a\x8e\x1f\x10\x6a\xde\x0d\x50\xfc\xb4\xc3\x69\x19\x75\x04\x28\xca\x0d\x08\xa9\xee\xa6\xce\x83\xea\x0a\x7b\xae\x66\x72\x75\xdf\x68\xd2\x06\x56\xb9\xbc\xa9\x8f\xd3\xd5\x7b\xf1\xb1\x20\x91\x88\x67\x3a\x5c\x54\xe3\x34\x37\x32\x60\x81\xc3\x19\xc6\x17\xab\x32\xd6\x27\x47\xe5\xda\xf7\x48\x7e\x0a\x24\xbc\xe5\xc1\x7e\x89\x69\xc0\xcb\xa9\x91\x0f\x15\xb6\x39\x26\x9c\x0d\x2d\x1f\xac\xea\x03\x77\xb9\xb7\x7a\x6c\x1c\x9b\x63\x32\x18\x0c\xb6\x5e\x04\xda\x69\xf9\xad\x02\x98\xab\x6f\xab\x83\x2a\x25\x1a\x10\x99\xab\xb5\xca\x42\xc8\x81\x5c\xc5\x29\xfd\x58\x03\x13\x7d\xc7\x56\xe4\x96\x26\x76\xd4\x03\x34\x9e\x6e\x3e\x46\xc6\xd8\xcf\xf1\xe1\x76\x6b\x71\x4b\xc8\x1a\x31\xd8\xc7\x85\x7c\xf6\x80\x02\x52\xc5\x85\x7b\xdb\x26\x9f\x39\x97\x58\xd9\x0d\x2b\x7a\xf0\x3e\x98\x31\x20\x7c\xc2\x22\x5b\x53\x52\xf8\x25\x62\x6a\x46\x22\xbd\x71\x35\xe9\xb2\x13\x44\x7a\x2d\xc8\x70\x03\xb0\x9b\x3c\xf1\x0a\xa2\x55\xde\xd0\x22\x9f\x7d\x64\x41\x66\x30\x45\x0d\xfb\x1b\x0d\x49\xd9\x6f\x69\x22\xa2\xcd\xdd\x5b\x27\x2b\x7f\xf1\xee\x96\xa7\xe5\xa2\xa1\x4d\xbf\x5f\x5b\x82\x29\x38\x2a\x92\x15\xf3\xaa\xa4\x04\x7d\x4c\xd8\x2d\xb3\x2b\xb0\xf3\xa5\x36\xc2\xac\x55\x39\xf7\x5e\xb8\x61\x85\xe6\x05\x45\xf6\xa5\xd2\x46\x26\xed\xb8\x06\x26\xc9\xf3\x2d\xcb\xed\xec\xd6\x50\x05\xb7\x64\xb5\x43\x05\xdc\x74\x34\x17\x08\x09\xaf\xff\xe5\xda\xca\xcc\x10\xdc\x35\x72\x72\x7d\xfc\x35\x27\x93\x19\x4f\xe7\xd9\x64\x78\x7e\xf9\xf7\x93\xcb\x97\x17\xe7\x27\x17\xa3\xeb\x57\xbf\x5f\x9f\xff\xfe\x66\x74\xf6\xf2\xed\xcb\xeb\xab\xdf\x7f\x3e\x7f\xfb\xe2\xe5\xa5\xe3\x95\x0d\x92\xa2\x81\xaf\x9b\x8e\xb8\xf3\x61\x31\x51\x83\xe8\x42\xd7\x54\xa7\x0a\x45\xce\xef\x3d\x60\x1b\x71\x2f\x84\x4c\x49\xc2\x02\x04\xd0\x8b\xb9\x2d\xf9\x1b\x7a\x75\xbd\x77\x37\x1f\x91\x41\xaf\xce\xff\xd9\x3c\xcb\xc5\x55\x11\xaf\x3c\xee\x86\x75\x19\xd6\x44\x9b\x61\x15\xa0\x50\xcf\xac\x77\x01\xb0\xc8\x61\xc9\x53\x74\x56\xed\xb5\xd8\x4a\xd3\xc2\xb7\x01\x8f\x52\xb3\x5f\x04\x45\xc8\x1d\x97\x73\xc3\xf3\xaa\x5e\xf0\x0e\x23\x93\x50\x45\xbf\xca\x7d\xf6\xda\x0e\x13\x45\xec\xc2\x2a\x1b\x5b\x35\x67\xed\xbb\xfc\x55\x01\xda\x00\xc6\xea\x0d\x99\xcc\x54\xc9\x9f\x16\xcf\x9b\xe0\x02\xc2\xa9\x09\xdc\x14\x3d\xfd\xcc\x35\x9a\x4e\xf2\xc9\x3b\x52\xaa\x37\xd1\xc8\xbe\x48\xaa\xe8\xef\x06\x98\xa6\xb5\xf2\x61\x1b\x8a\x5a\xb3\x4a\x36\xd0\x74\xcd\x9a\x4a\x6b\xec\x28\xc7\x31\x49\x6b\x86\x47\x6c\x74\x20\x4c\x43\x93\x35\xdd\x01\x17\x74\xc3\x94\xeb\x75\xef\xe0\xde\x2b\xc8\x56\xfe\x82\x5b\x10\xfe\x45\x06\x74\x13\x27\xba\x29\x87\x15\x4c\x68\x70\x73\x47\x13\xd4\xeb\x97\xba\xad\xd7\xd8\x73\x6e\x24\x3c\xfc\xb3\x8d\x38\xa9\xa6\x56\x15\x4f\x87\x2c\x69\x42\x17\x4c\xb5\xa9\xa9\x40\xac\x05\xe8\xd8\xea\xe2\xcf\xc7\x7e\x71\x4f\xde\x57\x16\x71\x72\xcb\xfa\x99\x76\xcb\x4d\xb7\x94\x21\x49\x93\x8d\xf6\x98\xdb\xc0\x83\x89\xd7\x8c\x69\x03\x96\xd4\x31\x47\xa9\x4d\xf9\x40\x85\xba\x6a\x38\x93\x22\x71\xd9\x77\xbc\x6f\x35\x23\xa9\x23\x86\xa1\xe8\xa2\x32\xc0\xf4\xb6\xde\x81\x8e\xf4\xaf\x32\xcd\x9c\x15\xa5\x59\x3a\xca\x69\xda\x53\x97\x06\x4a\xf5\xb6\x40\x94\x4e\x95\xb4\xf3\xb9\xe4\xbc\xde\x44\xc4\x91\x79\xde\x41\xac\x4f\x73\x8c\xf6\xdf\xb2\x19\x0d\x56\x9b\x7a\xbf\x95\xce\xd3\x80\x94\x24\x40\x2d\x60\xd2\x5e\x36\x3c\xd6\x29\x24\xf6\xf2\xdd\x93\x2c\xa7\xba\x23\xa8\xb5\x98\xf5\xc3\xb8\x67\x30\x4d\xdf\x6d\xb7\xbc\x01\x19\x6d\x0c\xfe\xd8\x3f\x46\x09\xc8\x39\x4d\xf2\x60\xef\x62\x51\x04\x94\x56\x83\x4e\xb7\x9b\xb1\x08\x99\x65\x9d\x56\xd3\xd9\x5f\xe4\x95\x7f\x12\x99\x2a\x53\x05\x49\x9a\xc6\x74\xb9\x5e\x21\x4b\x11\xba\x1b\x1d\x60\x59\x2c\xe2\x33\xf4\x43\x36\x6e\x92\x44\x58\x2c\x8b\x54\x67\xbb\x63\x95\x3b\x80\x7f\xe5\xb3\xe0\xe3\x86\x51\x21\x40\xa4\x9e\x28\x4e\x6f\xa4\xc1\x4e\xf0\xb3\x92\xcd\x61\x13\xa1\xa5\xab\x27\x45\x47\x7a\xfc\x73\x20\xfa\xca\x75\x61\x18\x45\x14\xa5\x7c\x19\xc1\xec\x52\xdd\x8e\xf2\x88\x6f\x89\xc2\xb5\xb0\x71\xe9\x5e\x0c\x9e\x07\x51\xc8\x41\xc8\xa6\x34\x8b\x52\x72\x34\x45\x68\xf8\xe8\xb0\x1b\xef\x2c\x45\xf8\x30\xac\xb3\x14\xa1\x24\x07\x48\x09\x53\x3d\xbe\xd1\x48\xda\xa3\x29\x1d\xd6\xa6\x78\xc2\xa4\xde\x61\x0e\x98\xb2\x35\xf9\x6d\x9e\xfc\x3c\xe3\xb7\x2c\x26\x79\x1b\xeb\xc3\xa6\xac\xe7\xcd\xec\x4b\xe4\x5c\x64\x51\x88\xf3\x9c\xef\x8e\x9e\x4a\xf0\x79\xf0\x59\x9c\xf2\x96\xbc\xf6\x0e\xf5\xd7\x5f\x18\xab\x95\x56\xf9\x6d\xb0\x1b\x1a\x7f\x7e\x7e\x2c\xd7\xa0\x6c\xe1\x64\xf8\x65\x7c\x6c\x34\x63\xd4\x28\x2b\xdb\x41\x72\x67\xc5\xec\xac\x18\x2f\x2b\xa6\x99\x27\xd6\xd6\x35\xca\x9d\xec\xe2\x51\x63\x7d\x98\x36\xf5\xd5\x70\x72\x2d\x54\x42\x52\x2a\x6f\x9e\xf8\x48\xaa\xfa\xfe\x89\xd8\x14\x9b\xda\xb8\xfa\x97\x7a\x1b\xcd\x43\x24\x12\xb3\x99\xb9\x8d\x50\xa7\xb7\x3a\x15\xdb\x29\xc0\x64\xb6\x44\x17\x3b\xc2\x53\x72\x80\x16\x20\x36\xb9\xd5\xe4\x06\x89\xb0\x93\xdc\x6f\x38\x11\x6a\xc0\xfd\xb0\xd7\xb8\xdc\x8d\x82\x46\x5f\x0e\xec\xc4\xcc\x4e\xcc\x7c\x1e\x62\xa6\x14\x8c\xab\x85\x9d\xa7\x14\x3e\xb9\xbc\xf1\x49\x6d\xbc\x47\x0d\x75\x0e\x71\xec\xfa\x37\x7d\x03\xc0\xe6\x34\x9a\xc8\x74\x9e\x5f\x53\x32\x8a\xea\xb7\x8c\x20\xca\x8c\x32\x93\x84\x5b\xd7\xd3\x5a\x9d\x16\x2c\x7c\x53\x1e\xb7\x20\xb0\x0d\x84\x0e\x7a\x5b\x53\xb0\x49\x6a\xd9\xa4\xe6\x61\xcf\x8f\x62\x15\x21\x01\xc9\x95\x67\x45\x77\x91\x5e\x18\x63\xe6\xbb\x6f\x62\xad\x89\x9f\x39\x9f\x79\xd3\xed\x4e\x87\xaa\xb2\xbe\x9d\x18\xfd\x06\xc4\x28\xfb\xb8\xf4\xb7\x55\x36\xae\xe9\xc4\x2e\x48\x81\xca\x12\x46\x6e\x39\x25\x05\xad\x6b\x41\xa3\xaf\x58\x72\xcb\x03\xd6\x6d\x05\xaa\x3e\xc4\x73\x01\x90\x7a\x2a\x1d\xbd\xa8\x66\xa8\xaf\x84\xf1\x12\x30\xe6\xfd\x17\x59\x14\xb5\x1a\xf5\xb8\x0f\x54\x54\x05\xcc\x52\x3f\x66\x42\x4e\xff\x56\xd1\x80\x7f\xbf\x9e\xbe\x13\xe9\x05\x74\x7d\x9c\xee\x77\xc1\x2e\xe2\x0b\x9e\x9e\x5e\xbc\x6f\x45\xa0\x8f\x7c\x91\x2d\x08\x5d\x20\xc9\x0a\x4e\xfa\xe9\xc5\xfb\xfc\xe6\x63\xd0\x19\x9b\x33\xb6\x68\xd7\xb9\xe9\x3e\x42\x0b\x05\xe2\xa1\x70\xba\x65\x31\x93\xf2\x67\x3d\xc4\xb4\xed\x24\xd4\xfd\x33\x1e\x2b\x72\x61\x58\x3f\xae\x05\x11\xf1\xca\x07\xa2\x5a\x1d\xba\x4c\xc4\x84\xb9\xfb\x86\xab\xe8\x42\x2c\x31\x74\x0d\x37\x8b\x94\x23\xe8\xaa\x47\xa1\xce\xe9\x2d\x7c\x02\x99\x05\x01\x63\x21\xea\x31\x46\x68\xb9\xce\xa4\x1b\x22\x5e\x6c\x57\x07\x71\x31\x61\x15\x29\xac\xc2\x4b\x34\x28\x2e\x90\xe7\x8c\x46\xb8\xf8\x55\x62\xa9\x9e\xeb\x4a\x77\xf6\x7f\xf9\xae\x43\x37\xfb\x82\xf4\xaf\x63\x9e\x72\x1a\xbd\x60\x11\xf5\x3e\x3f\xef\xf2\xe9\xb3\x12\xc3\x22\x43\x69\x68\x65\x6e\xb5\xcc\xe9\x46\xa1\x89\x4c\x69\xc3\x34\x20\x53\x51\x51\xa5\x95\xae\xcd\x41\x88\x8b\x83\x5c\x9b\x49\xe7\x02\xfa\xc4\x44\xbd\x60\x09\x17\xde\x5c\xfc\xaa\x98\x56\x2a\x30\x85\x01\x9b\x5c\x70\x6e\x99\xe1\x1a\x22\xfe\x5f\x04\xc3\x5d\x05\x73\xb6\x60\xbe\xb4\xd1\xdf\xb6\x66\xb1\x19\x0f\xa2\x26\x01\xf0\x78\x86\x15\x2a\x3b\x57\xd1\xe6\xd5\xf5\xf5\x45\xbd\x34\x22\x6b\x64\x6c\x43\x2c\x07\x50\x4f\x32\xb6\x90\x85\x57\x90\x34\x52\x3e\x88\x2c\x54\x52\x4b\xca\x75\x61\x58\x0b\x91\x18\xa7\xac\x24\x0c\x0d\x08\x14\xe2\x54\x04\x22\xc4\x6c\x49\x1a\xba\x41\x6e\x27\x0d\x5d\x30\x1f\x9d\x6d\xaf\x5d\x95\x63\xfe\x22\xb2\x98\xac\x6d\xd4\x12\x77\x17\xfe\x8b\x2c\x5d\x17\x00\x5e\x94\x74\x80\x7c\x2a\x01\xe0\xee\xc0\xd0\x64\x2b\xe2\x69\xa4\x53\xed\xeb\xe6\xf2\x21\x19\xf3\xc2\x91\x75\x8d\x29\x9b\x20\xd1\x58\x49\x89\x4e\xa6\x4a\x8b\xe9\xc5\xd7\x22\x77\xc5\x10\xb1\x09\xf9\x54\x77\x9a\x51\x30\x30\x1e\x48\x48\x1f\xd1\x52\xac\x3d\xbf\x44\x1a\xff\xf8\xec\xc7\x67\xe3\xc3\x41\xa7\x7d\x00\x16\xef\x5a\xec\x85\x73\x35\xd8\x8b\x42\xb8\x88\xa4\xde\x07\x24\x6b\x2b\x2f\xf6\x16\xdb\x6a\xf7\x48\x49\xf2\x31\x8a\x99\xc7\xaa\x66\xb9\x69\xec\x23\xce\xc3\x58\x03\x1e\x17\x2e\x2f\xf2\x09\x11\x94\xeb\xb6\xe3\x38\xa0\xf2\x65\x2b\xcf\xb3\x94\xdf\x75\x51\x7e\x7c\xdd\x5f\x53\x27\xd7\x79\xee\xe3\x2a\x8d\x4a\x3c\xa0\x2e\x12\xc7\x87\x9f\xf6\xd8\xfb\x78\x7f\xa8\x61\xe3\x5f\xaf\xf9\x9e\x2f\xef\x0b\xd3\x58\x39\xde\x9f\x89\x69\xbf\x46\xc7\x2f\xd5\xb6\xcf\x97\xf1\x44\xc6\xfd\x16\xec\xf8\x14\xd4\xf9\x5c\xcc\x7b\x5f\x72\x39\x20\xb6\x20\xa4\x87\x3a\x29\x28\xf4\x95\x1a\xf8\x5b\x30\xa8\x0b\xe8\xe3\xb3\xee\x67\x64\xe2\x7b\xd1\xd2\x01\xf3\xe9\xc4\xc0\xbf\x32\x26\xdb\x06\x3d\x79\xbc\x16\x63\x7c\xb0\xa0\xa7\xc1\xa7\x7d\xd8\x93\xc7\x9f\x30\xec\x89\x5b\x64\x1e\xb0\x8b\x2d\x5d\x8a\x2c\x0e\x73\x36\x33\xa0\x94\xe9\x5d\x55\xd2\xb5\x80\x91\x2b\x87\x54\x05\x7b\x03\x6b\xcd\xf2\xb2\x97\xd1\xd5\xc7\x28\xad\x70\x5b\x57\xa3\x76\x95\xca\xe5\xf0\x75\x9d\x9a\x96\xaa\x3c\x8d\xc3\x0e\xbb\xd9\x74\xcb\x99\xd4\x5d\xe6\x79\x5c\x70\x26\x36\x93\x7e\x23\x00\x8f\xbb\xcd\x00\x4a\xe2\x05\xa3\x61\xc4\x63\x76\xa5\xa5\x94\xe7\x5e\xa0\xbe\x90\x4f\xb9\xbd\x4f\xb6\x68\xf1\xd8\x4a\xbb\x4a\xd6\x5f\x2d\x4c\x62\x5b\xa7\x24\xba\x2d\xc9\x71\x31\x4b\xec\x0f\x31\x51\xb5\xc0\x5a\x1d\xa5\x3c\xce\x44\x26\xa3\x95\xc1\xba\xd9\xae\xc4\x5c\x60\x59\xd6\x64\x7a\x8f\xad\xde\x5a\xf3\x29\x7f\x78\x26\x07\x3e\x02\xf0\x87\xff\xac\xfd\x96\x0f\xe3\xb7\xb8\x4e\xde\x1b\x55\x2e\x93\x43\xb6\x8c\xc4\xea\x5e\x2a\x24\x95\xe4\x34\x11\xf1\x2f\x62\xd2\x34\xc4\x19\xc5\x50\x89\xc8\xec\xdc\x35\xc6\xd1\xb8\x05\xad\x21\x12\x74\x50\xd6\x4d\x5f\x28\x4c\x50\x2e\x42\xdd\xb4\x40\x66\x8b\xda\x85\xe0\xef\x81\xf2\xc0\xc7\x41\x22\xe2\xf1\x31\x19\x63\xff\x92\xb1\x1a\x3c\x37\xfe\x57\x46\x93\xf4\xcf\xb1\xb2\xe5\x4d\xf2\x13\x0b\x0f\x91\xaa\x42\xc9\x92\x4a\xe9\xde\x41\xfb\x6e\x93\x2c\x35\x0e\x79\xc2\x82\x74\x8c\xfd\xcc\x1f\xcf\xbf\x74\x38\x20\xff\x8c\x55\xe4\xc7\x01\xd1\xca\x65\x33\xd9\x29\x8a\xee\x2f\x54\xc2\xb2\x61\x45\x7e\xae\xa6\x84\x03\x26\x16\x8a\x6b\x75\x7d\xf3\xca\x3e\xe2\xe2\xda\xcc\xd7\x03\x49\xf2\xfc\x7c\xbb\x8a\xe7\x8b\x31\xbe\xec\x80\xb8\x0e\x66\xfc\xec\xe4\x39\x39\xd2\x7f\xc6\xc7\x10\xe9\x11\x23\xe3\xbf\x7c\xbf\xd0\x83\xf8\xbf\x7f\x26\x15\xc4\x58\xa4\x87\x83\xbd\x4e\xbe\x3a\xd2\xa3\xc4\x74\xfa\x16\x97\x80\x5b\x49\x80\x38\xb7\x76\x74\xd1\xb3\xb4\xc7\x70\x41\x55\x17\xe4\x5a\x98\x24\x3f\xec\x35\x27\xf3\xbb\xc1\x23\x18\x26\x45\x2a\x9f\xf7\xe9\x54\x39\x53\x94\x48\x86\xb0\x12\xb2\x8e\x6d\x0e\x53\xd1\x9e\xac\x80\x5a\x0b\x14\xcb\xa7\x29\x89\x59\x2e\xa0\x82\x4c\xa6\x62\xc1\xff\x54\x23\x6f\x89\x48\xa0\xe6\x8c\xf5\xbe\xc0\x57\xd0\x56\xda\x27\xec\x95\xb3\x9f\x0a\x76\xf1\xd9\x4c\x09\x41\x14\x65\x25\xb1\x92\x28\xe8\xb1\x99\xa7\x02\x60\x36\xb1\xad\x52\x75\x00\xce\x91\x4b\xd4\x61\x84\x28\xa6\xb6\xda\x92\x51\x18\x1c\x86\x2b\xc2\x62\xf1\x03\xf2\x72\x30\xab\xdf\x44\x5b\x97\xcc\x72\x09\x92\x3f\x09\x6d\xc0\xb4\x80\xe9\xdb\xdf\x95\x11\x70\xc0\x2c\x50\x3b\xe0\xe9\x3e\xec\x64\x75\x61\x6f\xb3\x9c\xc6\x22\x99\xd9\xe9\x60\x2a\x3f\x72\x70\x33\xb4\x39\x57\x41\xe2\x8e\x18\x47\x7c\x92\xd0\x64\xa5\xe5\xce\x95\x15\x6e\xa5\xcd\x56\x52\x2f\xc8\x12\x04\x75\xa3\xd5\xd0\x4a\x48\x07\xc8\x4d\xb2\x73\xb0\xb7\xbd\xd5\x01\x86\x8e\x35\x06\xc1\xaa\x5d\xf2\x43\x71\xac\xd1\xe2\x0e\xfe\x06\x26\x71\x16\xf0\x52\x53\x62\x5b\x3b\x51\xdc\x78\x2d\x18\xc0\xf3\x8b\x98\x0c\xc8\x07\x35\xd2\x42\xf5\xd1\x50\x9a\x67\x48\xfa\x64\x6f\x14\x45\xe2\x6e\x6f\x48\x28\xfe\x9f\xab\xb0\x86\xa8\x5b\x92\xc5\x25\x3c\xa2\xd5\x7f\x03\xd2\xcf\x22\x99\xf0\x70\x2f\xb7\xd9\x0e\x55\xe1\xec\x84\x87\xb2\xf4\x5d\x37\x50\x79\x4c\xe4\x0d\x5f\x2e\xa1\x06\x63\xf6\x31\xc5\xcf\x08\x9f\x82\x67\x6e\xb9\xc8\xa4\xfa\x3c\xa7\x32\xde\xdf\x4f\x09\xea\x47\xe4\xdc\xe9\x5d\xad\x58\xaa\x50\xbb\xd4\x8e\xd8\xde\x10\x42\x3a\x40\x51\x56\x8e\x7a\xde\x79\x0f\x82\x0f\xbb\x6e\x9c\x36\x17\x49\xb9\x2d\xa1\x24\x31\xbb\x43\x0b\xab\xfd\x8e\x0c\x52\x98\x93\xbe\xcc\xb1\xcb\x27\xfb\x82\xf3\xc9\xa6\x34\x8a\xa0\xe7\x3d\x57\xf5\xde\xf4\x63\x30\xe7\xca\xa8\x34\xbe\x58\x46\x0c\xf3\x2d\x73\x37\x24\x17\xd1\x2e\x99\xc9\xe2\x50\x17\x01\x1f\x58\xf1\x76\x98\xb7\x88\x06\x8c\x64\x85\xc3\x97\x0a\x54\x18\xb1\x84\xd3\x88\xff\xd9\xe4\xc4\xad\x59\xc1\x85\x2a\xb3\xd2\xa4\x1b\xb5\xac\xfa\xf4\xa4\x16\x92\xa1\xcc\x7b\x0b\xcd\x6b\x15\xdb\xdd\x5c\x44\xfe\xc9\xba\xd3\x52\x95\x59\x61\xaf\x87\x2c\x88\x68\xc2\x42\xa5\x13\xcd\xc8\x83\x8a\xc1\xea\x00\xb9\x86\x10\x47\x37\xcd\x60\x4e\x63\x2e\x0b\x8b\x22\x10\x09\x8c\xec\x68\x35\xe8\x22\x55\xac\x3b\xb1\x9d\x53\x79\x5e\xf4\xb4\xd0\x8f\x97\xfc\x49\x85\x7b\xee\xad\xb8\x59\x03\x42\x95\x4f\x51\x6d\xb1\xe0\x2a\xfa\x69\x09\x10\x2a\x27\x53\x81\xa2\xf1\xca\x74\x2b\xc4\x88\x26\x7c\xcd\x25\xd2\xff\x10\x13\x59\x52\x7c\xba\x2d\x08\x8c\x35\xa4\xbd\x69\x63\xdd\xdc\x2b\x89\x98\x49\x2f\x8b\xb5\x93\x27\xd9\x20\x06\xf3\x6e\xb8\xb5\xde\xff\x3d\xe6\xad\x4a\x73\x31\x25\x2f\x4a\x30\x3a\x85\x19\x2a\x90\x3d\x39\x61\xa7\x69\xbe\x5c\x4d\xd3\xcc\x9b\x91\x58\x6d\x9f\xd7\xff\xc2\x3c\xbf\xe3\xc9\x1d\x4f\x7a\xf3\x24\x51\xe3\x91\x3c\x57\xa4\x9c\x13\xf8\x22\x88\xc6\x44\x3c\xe0\x30\xd8\x75\x35\xb3\xb1\x88\xa4\x8a\x25\xe9\x90\xdc\xc2\xed\x5f\xe0\xbd\x64\xc2\xd2\x3b\xf4\x54\x1f\x17\x8f\x20\x62\x06\xbf\xb0\xff\x87\x98\xe8\x70\xce\x8d\x6e\xc9\xd4\x87\x0c\xe1\x01\x73\x99\x53\x70\xff\xd1\xab\x9e\x5a\x65\x98\xb7\xbc\xd4\x89\x11\xd6\xbb\xf0\xae\x11\xf2\x50\xed\x99\x64\x57\x57\x23\x4f\x12\xc2\x78\xc4\x3a\x58\xd2\xc7\x45\xa2\xe9\xa0\x85\x34\x4d\xd5\xc4\x59\x91\x51\xdc\xc5\x2c\x2c\x10\xaf\x05\x4c\x4a\xf1\x78\x48\xd2\xf1\xe1\x80\xbc\x43\x8b\x38\x15\x39\xe1\xe9\x5a\xd5\x0d\xcc\x5c\xa9\x8e\x96\x03\x62\x2a\x48\x10\x71\x16\xa7\x1a\xbd\xa5\xa9\x6b\xc7\x70\x11\x72\x75\x35\x42\x70\x03\x65\xe5\xf4\x96\xf2\x08\x4c\x6c\x26\xb7\x3b\x20\x42\x38\x45\x61\x29\xa2\x62\x7b\xad\xcb\x2e\x7c\xeb\x25\x4b\xc1\x4e\xc3\x5e\xe3\x9e\x38\xa4\x29\x20\xec\xe4\xe9\x4e\x9e\xb6\x90\xa7\xcb\x44\xcc\x10\xa9\xde\xce\xd2\x2f\xd7\x8e\x28\x93\x7c\xcd\xd0\x87\x7c\x28\x98\xbb\x16\xa6\x4a\x2e\x58\xd0\x1b\x95\x06\xa6\xd0\xf1\xbe\xfd\x71\xc0\xdc\xe6\x5e\xa8\x53\xfc\xb9\x81\x0d\x59\x7c\xcb\x13\x11\x77\x39\xe6\x2f\x0b\x10\xbb\x73\xfe\xa9\xce\x79\x7e\xad\x7c\xc6\x52\xdf\x09\x43\x3a\x7f\x14\xd3\x7a\x80\x9b\xd9\xad\xf1\xbb\xd1\xd9\xcb\xab\x8b\xd1\xe9\x4b\x13\x12\xbe\x38\x7f\xf1\x3b\x7e\xe6\x0e\xb1\x14\x5b\x7c\x4b\x13\xae\xe1\xae\xab\xce\x4e\x67\xfe\xab\x14\x64\xb8\xe2\xbf\x48\xc4\x47\xdf\x08\x39\x1a\xfc\xd1\x19\x5a\x75\x2a\x2b\x66\xfc\xea\xfa\xfa\xe2\xf7\x8b\xcb\xf3\xff\xfb\x07\x4c\x3a\x7c\xba\x32\x1f\xb1\x77\xb5\x30\x09\x19\xbf\x3b\xb7\x5f\x7c\x84\xbd\xbb\xa5\x49\xfb\x72\xfc\xcd\x78\x99\xc6\xfc\x61\xe8\x9a\x2f\x64\xaf\xa1\x4a\xe6\x67\x71\x40\x06\x4a\x4a\xe9\x46\xe0\x90\xd3\x6f\x5e\xfe\xe3\xa7\x0f\xa3\xb7\xef\x5f\x36\xdb\x59\xe3\xb3\x7f\xfc\xfe\x61\x74\xf9\xd3\xde\x02\x6d\xc2\xa3\x8c\xed\x8d\x15\x34\x44\x2b\xb5\x22\x60\x01\x66\x38\x05\x4c\xd5\xbc\x9b\x44\x2b\x1d\xc8\x8f\x5c\xe9\x7c\x66\x20\xe0\xe6\x35\x0f\x9e\xb8\x95\x40\x93\x86\x48\x12\x91\xf4\xe7\x34\x0e\xa3\xed\x1d\xeb\x97\x00\x42\x5e\x69\x20\x3b\x6b\x70\x67\x0d\xb6\xb0\x06\x13\x36\xf5\x5c\x10\x0e\x3e\x53\x9c\x66\xd8\x15\xa3\x53\x55\xcd\x4e\xd1\xeb\x43\x24\x35\xed\x9e\x8b\xff\x78\xac\x7c\x4a\x33\x32\xa3\xc4\x93\xbd\xad\xcf\x61\xc3\xf6\xcf\x82\x61\xcf\x6b\x75\x55\xde\x15\x53\xf2\xf7\xd3\xdd\x69\xfa\x54\xa7\x49\x4d\xa9\xbb\x65\xc9\xea\x14\x53\x62\x3c\xa9\xf3\xc2\x3e\x64\x82\x00\x66\x72\xa8\xd6\x6b\x08\xae\x1e\xdb\xf4\xa8\xb1\x39\x66\xa1\xfb\xfa\x3e\xe4\xf2\x46\x07\x71\x74\xf9\xfb\xb8\xa4\xbb\xcd\x4f\x0e\xb7\x67\xcc\xaf\x52\x66\x34\xb0\x87\x4e\x4d\x1e\xf6\x1a\x17\xbb\xf1\xc4\xbd\x2a\x25\x36\xef\x4e\xdd\xc3\x9f\xba\xaf\x52\x87\x75\xec\x36\xe1\x55\xad\x66\xdf\x51\x0b\xd2\xd4\x15\x1a\x59\xe4\x5d\xb6\xf6\xc9\x43\x14\x9d\x3a\x42\x7c\x82\xaa\xb1\xfb\x85\xce\xf0\x23\x8a\xb2\xb1\x47\x24\x48\xab\x82\xaf\xda\x7a\xaf\xea\x62\x1e\x15\x7f\xbc\xb0\x5d\x1d\xab\x1d\x07\xc0\xe4\x06\xdc\x73\x66\x2f\xb9\x7e\xb5\x60\x4b\x51\x93\xfb\x35\xac\x0f\x73\x9e\x5b\x95\x9c\x39\x2b\xce\x6c\xf9\x8d\x85\xdc\x78\x8a\x8b\x15\xbd\xba\xbe\xbe\x68\x5e\x90\xd3\x08\xe8\xd8\x01\xc2\xaf\x3e\x6c\x7b\x01\xd5\x50\x28\xf6\x88\x0c\xdd\xae\x50\xab\xb9\x4e\xcb\xc2\x6d\x2e\x9e\x43\x29\x97\x2a\xd8\x7a\x84\xd5\x76\x2d\xaf\xf6\xd2\x57\xf9\x4b\xda\xf3\xc3\x93\x2b\xac\x2e\x85\xce\x9f\x48\x63\xe5\x28\x3d\x91\xca\xda\xae\x48\xb9\x56\x67\xad\x2d\xe7\x71\x57\xd0\x59\x6b\xad\x61\x9f\xf3\xfc\xf6\x6a\xcb\xdc\x4e\x77\xd2\x5a\xdb\x55\x4a\x7b\xa9\xad\x1c\x74\xe3\x61\x7e\x50\xbd\xd5\xb5\xb2\xd9\x4f\x71\x75\x90\x54\x4f\xaf\xb9\x72\xe4\x1f\x5a\x75\xe5\x80\x3f\x27\xdd\xd5\xe0\xa3\xf2\x58\xdd\xe4\x0e\x7b\x8d\xeb\xdf\xe8\xfb\xbf\xd6\x8f\x3f\x59\xd7\x57\x1a\x86\x18\xd5\x64\x56\xa1\x2a\x75\x98\xb9\x88\xf0\xcc\x66\xce\xc0\xd7\x54\x75\x34\x28\x12\xa7\x6d\x99\x5a\x37\xe9\x52\xa1\xd6\x2e\xaa\xf1\x0d\x44\x35\xe6\x42\xfa\x8a\x93\xfd\xa3\xa3\x4b\x53\x27\x79\x74\x34\xa8\x96\x5b\x43\x98\x00\x94\xad\x91\x6e\x6e\x2e\x60\x0e\xc0\x60\x7f\x7b\xdd\xd1\x24\x28\x64\xca\x6b\x8e\xa9\x87\x98\xc0\xc3\xdd\x84\x04\xd2\x04\x3d\x49\x5b\x32\x3d\x28\x39\xc0\x06\xd3\x7e\x5e\x39\x78\x98\xdf\xb7\x9e\xbe\x7e\x71\x49\x64\x36\x89\x59\x73\xc9\x60\x75\xfa\x09\xe4\x6d\x12\xb0\x65\x5a\xf4\xd0\xd0\x2b\x5c\xe2\x6a\x9b\x1c\x8c\x9f\x3f\x1b\xa8\x3f\x27\x3f\x1e\x3f\xff\xeb\x77\x83\xe7\x3f\xa8\x0f\xcf\xbf\x3b\x7e\xfe\x5f\xf8\xf4\xa3\xfe\xf8\x83\x5f\xab\xb4\x6e\x06\x41\x65\x2f\x76\x42\xe8\x1b\x10\x42\x3a\xbb\xc5\x73\x4d\x3f\x0b\x95\xc7\x5a\x0c\x14\x87\x89\x67\xa6\x9a\x8d\xe1\x5d\x06\x34\x19\x70\x30\xf7\x80\x8b\x13\x0d\x7a\x5c\x8f\x1f\x21\x7f\xcb\xf9\xb6\x34\x9d\x88\x4b\x35\xe7\x08\xed\xe4\x94\xd9\x8e\xd1\x69\x45\xc6\x59\x43\xea\x06\x72\x43\xf1\xb4\x88\xed\x20\xd5\x87\x68\x98\xdd\xc0\x60\x7f\x88\x48\xdc\xf0\x9a\xc4\xa2\x66\x81\xf7\x8b\x7e\xbc\x93\xc8\x3b\x1d\x9d\x32\xff\xa6\x24\x73\x46\x2e\x5e\x9e\x11\x16\x07\x02\xb7\xba\xa7\x23\x35\x42\x17\x15\xc6\xea\xe4\x13\x0c\xa8\x25\x4b\x9a\xce\x8f\x15\x03\xd7\x42\x55\x59\x7e\xb7\x2c\xe1\xd3\xe2\xc2\x2e\x07\xc4\xe4\xb1\xbd\x09\x06\xeb\xc3\xdc\x22\xe3\x65\x22\x52\x11\x88\xc8\x25\xcd\xb8\xd4\xed\x3d\xa4\xc9\xb1\xca\x24\xeb\x4b\x19\xf5\x4d\x5e\x30\xcd\xd2\x39\x8b\x53\x83\xab\xea\x3d\xa0\x19\xc5\x01\xb2\x70\x9b\x4e\x6e\x69\x72\x92\x64\xf1\x89\x1e\xc2\x2f\x4f\x0a\xa9\x00\xa6\x45\x6a\x34\x0f\x18\x0d\x54\xfd\x90\xfd\xd8\x0f\xe8\x20\x48\x52\x17\xd2\x38\x0a\xe7\x4b\x16\x5f\xcd\xf9\xb4\xab\x14\x56\xeb\xbc\x48\x78\x1c\xf0\x25\x8d\x5a\x6c\xea\xd2\x3e\x73\x20\x0f\x8d\xaf\xa1\xca\xd1\x26\x76\xb2\x17\x2e\xe7\x0d\xfc\x5a\xa8\xa4\xbc\x87\x66\xd2\xbb\xb8\x23\x54\xb9\x62\xd6\x63\xb5\x3c\x6b\xad\xe0\xf2\x56\x3b\x20\xaf\x31\x41\xcb\xad\x76\x00\xce\x99\xa0\xe4\xf4\xaf\xd1\xf1\xa7\x20\xfe\x49\xae\x64\xca\x16\xc3\x05\x45\xfe\x77\x5f\x69\x60\xf7\xfd\x71\x10\xff\x34\xa7\x77\x29\x17\x7d\x11\x23\x99\x76\xa0\x3f\x0d\xe4\x6d\x60\x50\x0e\xe2\x9f\xa6\xe0\x50\xb8\x01\x22\x62\x03\x7c\x50\xbf\x7e\x00\x66\x79\xac\x69\x28\x15\x91\xb4\xd3\xfb\x55\xbd\x9f\x27\x32\xb4\x8b\x69\xbd\xe5\x12\x81\x39\xc8\x05\x55\x35\x1a\x50\x35\x15\x5e\xb5\xd9\x92\xf7\x6f\x54\x3a\x68\xa7\xaf\xd4\x34\x41\x43\x8c\x38\x64\xe1\xa9\x3a\xc6\xa7\x73\xe6\x5d\x20\x7d\x46\xe3\xbc\x96\xe5\xbe\x5a\xb2\x01\x42\xe9\x23\x0a\xa7\x11\x9d\xd9\xd6\x14\x16\x21\x72\xc3\x56\x24\x93\x74\x86\x4a\x1a\x95\x1a\x7c\x4f\xd1\x39\x20\x3e\x96\xf4\xd3\x9f\xdb\xa9\xa5\x07\x73\x58\x11\xff\x79\x05\xa7\x94\x86\x61\x62\xd4\x46\x11\xfb\xb2\xca\x83\xce\x90\xe2\xa3\xdd\xa5\x5a\xb8\x84\x4c\x50\xa8\x95\x8a\x01\x79\x3d\x25\xe3\xbd\x7f\x1e\xed\xe9\x84\x9e\x3d\xe3\x37\xed\x29\x13\x60\x86\xab\x20\x55\x82\x6d\xca\x9b\x5c\x6e\x1a\x20\xaa\x6c\x59\xc4\x7f\x56\x24\x66\xa9\xea\xdd\xa2\x5c\xb5\x29\x0d\xca\x61\xd6\xbd\xa3\xbd\x8e\x51\x56\xa1\x68\x22\x3d\x29\x57\xa4\xf8\x16\x93\xcb\x73\x82\x19\x50\x28\x6a\x36\x49\xb1\xb5\x40\x09\xb8\x56\x59\x51\xc3\x93\x13\x63\xa0\x0e\x44\x32\x3b\x49\x98\x6a\x6a\x1d\xb0\x93\x79\xba\x88\x4e\xd4\x1e\xc8\x01\xfe\xfd\x1f\xea\xdf\xfd\x3f\x6e\x17\x7d\xad\x10\x7e\xfd\xe5\xc3\x99\xe3\x05\xea\xeb\x6b\x92\xdf\x60\xf8\xdb\x93\xab\x34\x34\xa3\xba\x13\x89\xaf\x4c\x04\xc3\xda\x47\xb4\x64\xc4\xc9\xa9\x9e\xc0\x36\x36\x0e\xf8\x10\xe7\x39\x19\x1b\x92\x80\x47\x25\x4b\x3b\x71\x52\x9b\x56\xe6\x1b\x6c\x34\xdd\x3e\xb0\x60\xed\x1f\xff\xfa\xd7\x1f\x9b\x79\xdb\x1d\x1f\xb7\xc2\xac\x0d\x99\xcd\x23\xe6\x4e\xa4\xc8\xe8\x33\xcd\xc3\xcd\xbf\xa4\x4b\xe0\x15\x8b\x30\xf2\xb3\x2a\xe7\xba\x10\x59\x55\x53\xbe\xd5\x4a\x67\x54\xd9\x7f\xcf\x35\xfe\xef\x9c\xa9\xf5\x6c\x70\x88\x6c\x68\xc8\x6a\xdf\xa9\xa8\xa3\xab\x89\x75\x97\xde\xfe\x49\x45\x3b\x38\xb5\xc5\x16\xe2\xeb\xe6\xb6\xa6\xe6\xac\x6c\xbf\x03\x4d\xbe\xf6\xed\x62\xd8\xf3\x42\x72\x4d\x30\x4d\xc9\x2f\x1f\xce\x3a\xf9\xd8\x41\x44\xa5\x84\x57\xec\x49\xa9\x51\x49\x80\x7f\x38\x2b\x1e\x27\x07\xb8\x0a\x1c\xbf\xe5\x71\xf6\x71\x5c\xfc\xb8\x16\x28\xb1\xad\xcc\x44\xd2\x89\xb3\x2b\x04\xd9\x59\xf9\x6b\x56\x3e\x9b\x64\x33\x4f\xa2\x8c\x4c\x0b\x33\x49\x12\xb6\x40\xbd\xb3\x7a\x18\x23\x68\x8f\x89\x14\xa6\x93\xa1\xf9\x61\xad\xe4\xc4\x5f\xd3\xa1\x90\xa6\x29\xd2\x9c\xf3\x51\x9a\xbf\x7c\x38\x33\xe5\x36\x24\x93\xb8\xef\x84\xe0\xee\x4f\x45\x82\x36\x19\xf5\xfb\xeb\x77\xd4\x15\x5a\x23\x6d\x96\x79\xae\xf7\x3a\xa1\xb1\x04\x0e\xb9\x39\x47\x53\x6b\xce\x09\x12\x15\x8e\x8e\xfb\x26\x2d\x66\x77\xd1\x8a\x44\x34\x8b\xd5\x62\x71\x1c\x0b\x99\x76\x34\xfc\xfe\xd9\xb3\xef\xc7\x9d\x18\x5c\x2d\xed\x2a\x93\x68\xb1\xe2\xb9\x34\xf3\x6d\x9d\x2d\x97\xd2\x64\xc6\x52\x25\x27\xf8\x62\xc1\x42\x64\x7f\x44\xab\x52\x9a\x48\x2d\x4c\x62\xa6\x9e\xe0\x2c\x43\xd3\x47\x82\x86\x2c\xec\xb4\x4f\x5f\xa5\x4f\x67\xcc\xc3\xd6\xb6\x30\xb6\xc4\x3c\xfb\xf4\x96\x65\xc2\xe3\xf4\x14\x17\x45\xde\x5c\x86\x18\x54\x2a\x4d\x8e\x92\x7a\xd0\xec\x54\xd1\x62\x57\x1f\x7b\xc2\xe3\x86\xaa\xbd\x22\xbb\x25\x12\xb3\x07\xad\x44\x6c\x90\xb3\x37\xe8\x32\xc6\xea\xda\x70\x36\x6b\xdf\x37\xe6\xf9\xc7\xba\xfe\xaf\x36\xe9\xd5\x97\x11\xb8\x19\x24\xb9\x03\x14\xe6\x38\xd5\x42\x24\x2a\xc6\x97\xce\x19\x4f\xf2\x9b\x8a\xea\xca\x0e\xcc\x39\x2d\xdf\xc1\x75\xd8\x84\x9d\x96\x76\x6a\xe9\xaf\x52\x28\x42\x81\xfa\xae\xa8\xa6\xb3\xad\x61\x64\x55\xad\xa7\x02\x1b\x50\x40\xb5\x20\x11\x1b\x30\xa6\x86\xe9\x7b\x59\xce\x72\xe9\x6d\x2d\x3f\x9b\x24\x08\x0b\x7d\xee\xc8\x4a\xa3\x98\x86\xed\x79\xb1\x59\x86\x6c\x73\xc0\xae\xc1\x26\xa7\x35\xe7\xaa\x0a\xf1\x49\x4f\x88\xed\xa6\xbd\x79\x59\x7d\x0f\x4c\x9b\xf6\x50\x5f\xaa\x0e\x7b\xcd\x24\xdb\xa8\x04\xcc\x9d\xec\x23\xe9\x00\x1d\xb1\x2f\xd2\xc0\x8a\x58\x3e\x90\x81\x32\x50\x88\x94\x5e\xda\xed\x24\xa3\x75\x63\xcc\xa2\x2b\x1e\xdf\x48\x4f\x14\xdf\x9a\x13\x6c\x1e\x95\x5a\x5a\xa9\x00\x9f\x4c\x79\x9c\x53\xce\xe7\x80\x12\xd3\x96\x72\xa0\xda\xd1\x19\x4b\x81\x48\xd5\x19\xd4\xbe\xc0\x88\x08\x14\xf2\x62\x90\xb2\xee\x1d\xfa\xfe\xf2\xf5\x93\x17\xd2\x17\xd4\xd3\xed\xb2\xba\xd2\x4f\x37\xaf\xfa\x56\x48\xa7\x0e\xda\x76\x6a\xd1\x9a\x46\xf9\xe1\x84\x63\x1e\xb1\x74\xed\xf8\xd6\xc2\x86\x36\x21\xbf\x5c\x9d\xbf\x33\x69\xa8\x83\x5e\x87\xc5\x56\x5e\xb9\xb3\x7e\xbe\x01\xeb\xc7\x46\xa7\xb7\x11\x9a\xf6\xd9\x4f\x27\x35\xed\x1b\x36\x9d\x7d\x07\xc4\xcf\x43\x2a\x58\xe4\x9f\x56\xa2\x3e\x3d\x19\x6e\xd9\x96\xec\x85\x07\xd5\x0b\x64\x31\xfd\xa6\xb4\x76\xd5\x6c\xb8\x16\xa4\xb2\x65\xc2\x2c\x60\x9b\x19\xab\x04\xfb\xbe\x46\x71\x00\x3d\xd0\xd7\x26\x84\xe6\x53\x21\xc8\x24\x11\x37\x2c\x39\xfc\x5c\x28\xbd\x15\xb7\x75\xa5\xf5\x04\xa5\x27\x13\x00\x9e\x28\xb5\xe6\x4b\x74\x07\xc8\x62\x3b\xea\x89\x5e\x1a\xab\xab\xe6\xf4\xec\x99\x18\xc0\xde\x67\xb0\x1b\x53\x1e\xa5\x2c\xd1\x87\xff\xd4\x1c\xe6\x96\xcd\xcf\x34\x08\x84\xa0\x45\xac\xa9\x87\x06\x9e\x90\x07\x98\x70\x85\xcc\x73\x46\x43\x67\x88\x7b\x2f\x60\x7d\xe3\x34\xcc\xb9\x4c\x45\xb2\xda\x1b\x90\x2b\x1e\x07\x46\x97\x68\x00\xaa\x60\x6f\x82\x46\xac\x88\xa8\xdf\x36\xdd\x6b\xc7\xec\x8e\x25\x04\x97\xfc\xb8\x59\x2f\x39\x16\xc7\x25\x8c\xb9\xb4\x9a\xae\x1c\x9c\xe9\xa6\xac\x24\x8f\x6f\xfe\xc6\xe3\x90\xc7\xde\x77\x05\xc8\x45\x97\x2a\x87\x61\x43\xdf\x57\xd8\x5f\x54\x41\x25\xb7\x9c\xd6\x42\x24\x25\xeb\xec\xaa\x40\x21\x6f\xd1\x8a\x3e\x5b\x68\x15\x5d\xd2\xf8\xf6\x16\xda\x01\xb3\x82\x87\x0a\x7d\x23\x25\x06\x17\x0e\x11\x53\x38\x99\xb1\xdd\x2c\x5e\x27\xa2\x03\xa8\x7d\x6f\x33\x74\x72\xc0\x3e\x22\x2f\xde\x67\x64\x4e\x19\x18\x97\xa6\x41\xed\x64\x55\x22\x8b\x36\xf7\x0f\xbb\x6c\xaf\x9f\xeb\x6b\xbb\x00\x0f\x7b\x8d\x7b\xef\x74\x81\x4d\x5a\xf2\x63\x85\x43\xbd\x87\x96\x19\xfc\x6a\xa1\xe6\x33\x02\x8b\xe1\x65\x81\x88\xf5\x4d\x27\x46\xd3\x44\xe1\x10\xe3\x77\x8e\xc8\xeb\xea\x9e\xa9\x9b\x2c\x07\x54\xa0\x62\x69\xb3\x4c\x84\x4a\x41\x3e\x22\xa3\xe6\xd9\x68\x0e\x98\xa6\x54\x4b\x4b\x2c\x5b\xa7\x55\x99\x75\x56\xfc\xb4\x3a\xd1\xcc\x01\x74\xf3\xac\xb3\x6e\x53\xbe\x10\xa1\x90\x01\x8d\x78\x3c\x3b\xc3\x94\xae\xc0\x77\x57\x4b\xe5\x2b\x65\x02\x96\xe0\x91\x85\x02\x98\x5b\xeb\xb5\x80\x89\x21\x41\x2a\x54\xfe\xfd\x38\x1f\xd2\x13\xac\xc6\x56\xe8\x27\x64\x1c\x2c\x33\xfb\xb1\xf4\x16\xc7\xc1\x23\x60\x87\x4b\x04\xdd\xed\xfd\xa6\xc5\x32\x14\x41\x56\x4c\x2c\x41\xa8\x6f\xa1\x3a\xc4\xc6\xda\x91\x44\x5f\xec\x4e\xa3\x96\x4a\xf8\x5d\x2b\x09\xe4\x4b\xd5\x2b\x66\x6e\x6b\x54\xea\x33\x0b\x8b\x81\x45\xc1\x8a\x44\xec\x96\x45\x20\x05\x86\x9d\x2e\x59\x12\x60\x05\x33\x97\xa0\x3d\xd0\x53\x1f\x8c\x1a\x55\x70\xef\xed\xcf\x61\x31\xa9\xeb\x42\x84\x83\x9c\x66\x0e\xb0\x0f\x4f\x4d\x77\xae\x8f\xca\x54\x78\x40\xc6\x54\xf0\xda\xf3\xe5\x7c\x49\x07\x25\x30\x03\x23\x97\x07\x21\xbb\x35\xed\xda\x1d\x5f\x70\xbc\xa5\xf4\xc8\xe1\xc0\x9f\x67\x1d\x10\x1f\x96\x9b\x2b\x9a\x64\x17\x1a\xf9\x06\x42\x23\x0b\xfa\xf1\x2a\xa0\xde\x93\x8e\xf6\x46\x31\xc9\x96\x4b\x96\x90\x89\xc8\xe2\x30\x2f\xc0\x2f\x26\x40\x5e\x88\xd0\x78\x57\x8d\xe3\x2e\xed\xa0\x02\xae\x6f\x3a\xa2\x88\x45\x39\xc0\x92\xc5\x30\xc8\x0f\xc6\x9c\xba\xbc\x56\x0c\x35\x11\x77\x31\x09\xe8\xd2\xd4\x8e\x29\x34\xb4\x60\x94\x56\x2c\x62\xf0\x14\x8d\x22\x03\xf9\x33\x14\x81\x0b\x1e\xb7\xda\x91\xf2\x48\xea\x4d\xdb\x50\xe4\x03\x1a\x7a\xd7\xc2\x25\x04\x49\x45\xf1\xaa\x98\x93\x74\x6f\x27\x94\xb1\x7e\x74\xf4\x27\x4b\xc4\xd1\x51\xc9\x5a\x77\x15\xb8\x2c\x18\x35\xf3\x28\x36\xb8\xdc\x48\x59\xc5\x6a\x43\x12\x62\xef\x52\x41\x00\xbb\xc9\x5e\x47\x09\x5e\x91\x15\x98\x0b\x1d\x16\x96\x86\x72\x63\x11\xf7\x64\xac\x03\xe6\xa3\x6d\x70\x22\xa2\x48\x64\xe9\x8b\x76\x62\xd6\xba\xcb\xa9\x20\xb3\x84\x86\x99\x32\xb1\x25\xf2\x32\x21\x47\xa6\x88\xd1\x18\x4e\x8d\x90\x36\x56\x2f\x07\x09\xb9\x64\xb7\x5c\x62\x71\x48\x3c\x90\xd6\x0e\x31\x68\xe5\xe3\x9c\xcd\x66\x97\xfc\x5b\x07\x48\xc3\x06\x2a\x95\xc1\x96\x83\x55\xa6\xd9\x52\xf2\x77\x11\xd1\x78\xa6\xe7\x7c\x0e\xec\xe2\x5d\x46\xb0\xb6\xbb\x30\xd1\x1e\x77\x94\x66\x43\x8e\x61\xaa\xab\x0a\x09\xed\xd6\xaa\xb1\x01\xe8\xf1\x1c\xa8\x25\x0d\xb6\xd7\x7e\x0d\x4a\x24\x12\xb3\x59\xad\x4b\xde\xec\x92\xbd\xd5\x8f\x77\x72\xc5\x02\x11\x09\xdf\x14\xda\x53\x7c\xd7\xcc\xd1\x23\x91\x98\xa1\xb9\xc7\x32\x4b\x1b\xc8\xe3\xd6\x15\x95\x45\xed\xac\x83\x6f\xc0\x3a\x30\x4d\x5f\xfc\xd6\xf4\x16\xc9\x62\x0b\x26\x55\xd5\x92\x7e\x72\xfb\xd3\x48\xc8\x1f\xd2\x9b\xc9\xce\x15\x6f\x5b\x56\x97\xf6\xb2\xb0\xd3\xd2\xf1\xfa\x8b\x84\xa5\xe9\x4a\x65\xd6\xb5\xcb\x06\xd8\x5b\xaa\x27\x75\x1e\x1f\x8f\x67\x7b\x76\x56\xa6\xba\xc3\x44\x56\x5d\x27\xdc\x94\x7b\xe8\x89\xd1\x28\xfc\x23\x93\x39\x6d\x94\x0c\x52\x8f\xe7\x69\x7d\x4a\xa5\xbc\x7e\xf7\xf3\xf9\xe1\xf6\xbb\xd5\x70\xc4\x74\x2d\xec\xb0\xd7\x88\xec\x2e\x2f\xe7\x33\xce\xcb\x51\x96\xd5\xb0\xd7\x4c\xb0\x4d\xfa\xef\x0c\x0f\x77\xd4\x7e\xc0\x5f\x7a\xee\xda\x7e\x91\xd8\x5b\xc5\x45\x95\x29\x99\xbe\x68\xfa\x37\x0b\xba\x34\x95\xfa\xf5\xc2\x92\x6c\x58\x95\x0a\x16\x7e\x5c\xb2\x20\xcd\x27\x0c\xbd\xbf\xfe\xb9\xff\x63\x1e\x32\x97\xc4\xe9\xaf\xa8\x51\xae\x00\xb2\x4c\x04\xea\xde\x95\x8d\x85\xd9\xd0\xb0\x90\xcc\x45\xdd\xa9\x88\x53\x0c\xa5\x86\x5d\x85\xc9\xfa\x0d\x33\x37\x26\xa8\x30\x4b\x8c\xc9\x65\x48\xba\xc2\x7d\x05\xb2\x4e\xae\xed\xfb\x68\x24\x31\x6b\x37\x64\xc5\x88\x33\x07\x4c\xe3\x37\xe5\x35\x24\xc5\x60\x7a\x28\x2e\x8a\xd1\x1b\x2a\x77\x55\x57\xab\xea\xd8\x66\xb4\x6a\x0a\x9b\x42\x1e\x5d\x22\xe8\x3a\x20\x57\x6a\x12\xc8\x90\xfc\x9a\x6f\xc7\xbf\xf5\x76\xfc\x36\xc4\x8d\xf8\xaf\x27\x37\x6c\xf5\xdb\x31\x5c\x82\xc4\x85\x27\xbe\x5b\x18\x8b\xc6\xa6\x35\x3b\xa1\xae\xd6\x95\x71\x8a\xba\x5a\x61\x26\xcd\x46\xab\xd2\xf7\x1b\x70\xb5\x7b\xaa\x0a\x73\xcd\x38\x29\x75\x0d\xc4\xc2\xfd\x27\xbf\x85\xab\xf0\xe5\xce\x30\xfb\x06\x0c\xb3\x5c\xc4\xb4\x17\x87\xf9\xa3\xe4\x40\x89\x16\xec\x1c\x8f\x29\xe6\x21\x40\xd8\xc4\x8e\xdc\x72\xe2\x96\x9e\x4a\x46\x16\xe0\xcb\xf2\xd1\x01\xd2\x0c\x14\x8a\x57\xc5\x19\x03\x63\xd4\x62\xa7\x04\x59\x39\x09\xc7\x85\x2e\xe4\x95\xb9\xb2\x34\xf7\x3a\xd4\x86\x2c\x48\x24\x4c\xd5\xa3\x19\x08\x8d\x02\x39\x7b\xf7\xee\xc4\xd8\xb4\xd8\x42\x85\xa2\xa7\xf4\xfa\xf5\x7f\x00\xfc\x37\x57\x94\x44\x89\xb7\x66\x21\x76\xbc\x26\xc1\x1c\x10\xeb\xc0\xdc\x97\x60\x4a\x32\x02\x43\x7f\x79\x58\x4e\x82\xc2\x93\x4f\x2f\x04\x6f\x45\x94\x2d\xb6\x39\x10\x17\xb8\xe3\x97\xe0\x2d\xf2\x41\xc1\x20\xa7\x11\xe5\x0b\x3b\xea\x4a\x99\x3d\xae\x4e\xa0\xa4\xe0\x81\xe5\x6d\x80\x4d\x1a\x9e\x98\xa4\x10\x96\x9c\x80\x38\xbf\x3d\x35\x75\x1a\x24\xad\x58\xb2\x98\x2e\xf9\xb0\xd7\x48\xb6\x8d\xb6\x1d\x8a\xa2\x47\x17\xaf\x1f\xc0\xba\x5b\xd0\xa5\xef\x06\x16\x98\xe0\x21\x75\x29\x6c\x93\x1f\x70\x86\xad\xc3\x65\x70\x7b\x62\xfa\xef\x54\xf4\xb7\xa6\xa2\x9b\x4e\xdc\x5d\xcc\x92\xad\xcf\x1b\x1e\x7e\x80\xd3\x66\x40\x7a\x92\x7d\xc7\x8d\x5f\x2e\x37\x12\x93\x1e\x35\x8a\x63\xa1\xe3\xe8\xd2\x73\x79\x10\xb3\x48\xd5\x40\x2d\x4b\xf1\xb0\x51\x8d\x29\x8a\xb6\xa7\x2c\x49\x58\xf8\xe4\x02\x56\x2f\xf0\x2d\xda\x3b\x6e\xb1\x36\xd3\x61\xf2\x73\x5b\x56\x03\xc3\x2f\xc3\xc9\xb0\xe7\xb5\xcc\xea\x71\x15\x53\x72\xf1\xe2\x6f\x3b\x09\xb2\x93\x20\x2d\x24\xc8\x82\x7e\x7c\x1f\xe7\x01\x23\xcf\xb5\x5d\x57\x72\x03\x96\xa2\x34\xe1\xbb\x9c\xbb\xe7\x97\x2f\x90\x15\xaf\x37\xbd\xfa\x29\x32\x86\x79\x60\xaf\x2b\xd7\x5d\xbd\x98\xd0\x89\x14\x51\x96\xd6\x6d\x14\xfe\x58\xf4\x54\xc6\x5e\x9e\x6a\x55\xaa\x7a\x7f\x3e\x26\x7c\x4a\xc6\x0b\x1e\xf7\xf3\xf7\xa3\x1f\x98\x03\xa6\x8a\xb0\x99\x3e\xb1\x87\x03\x72\x1e\x47\x2b\x22\x62\x55\xd7\x30\x5e\xd0\x8f\xfd\xd2\x4a\x4c\x5f\xb6\x2a\x74\x07\x68\xb3\xc4\xdc\x4f\x1d\xf4\x3a\x48\xa0\x05\x8f\x47\x9f\x70\x47\x71\x13\x5c\x0b\x15\x17\xbc\x3c\x8a\xb0\x5f\x6d\xb7\xd5\x01\xd3\x6e\x78\xcd\xb6\xae\xef\x85\x2f\xd5\xf5\x1e\xad\xef\xdc\x03\xee\x45\x93\xa4\x8f\x68\x8a\x2b\xb5\x61\xcf\x6b\x83\xaa\xb2\x15\xe2\xde\x3c\xff\x58\xa9\xc0\xf7\x06\x23\x84\x2c\x65\x81\x29\x60\x00\xaf\x94\x06\x2d\xd7\x42\x24\x38\x77\x45\xbc\xc6\xd2\xc0\x92\x3d\x48\x18\xac\x4c\x72\xc0\x53\x55\xb9\x51\xfa\x99\x03\xa4\x88\x8b\x8e\x56\x44\xc4\xd1\xaa\x53\x3e\xf7\x57\xaa\x08\xff\x9f\xbd\xab\xe9\x6d\x1b\x67\xc2\x77\xff\x0a\x22\xa7\x16\x70\xd2\xbb\x81\xf7\xf0\x02\xe9\x21\x48\xb7\xc8\x36\xc1\xee\x21\x31\x20\x56\x1a\xcb\x5c\xcb\xa2\x97\xa4\x92\xf5\x2e\xf6\xbf\x2f\x86\x5f\xa2\x1c\x89\x92\xec\x36\x4d\x5b\xdd\x5a\xb7\x1a\x71\xa8\xf9\xe2\x0c\xe7\x99\x2f\xe5\x08\xcd\xe7\xb8\x34\x09\xb7\xe1\xa2\x63\x1e\x0b\x3e\xfd\x1b\xd8\xee\xd4\xfe\x6d\x2d\x02\x03\x5a\x0f\xfc\xff\x65\x92\x6c\x99\xc4\xab\xe1\xa7\x7d\xe9\x1f\xd2\xab\xe7\x05\xff\x3c\x18\xff\xf9\xaa\xcc\x2c\xc8\x1c\x33\xd9\x15\xbf\xc7\xf5\x25\x33\xa7\x95\x86\x70\x34\x41\x69\x31\x4f\x53\xac\x21\xf1\x95\x7d\x82\xe0\x69\x87\x2a\x1e\x8c\x56\xc2\xb3\xda\x49\x5a\xda\x67\x5c\xbb\x86\x61\x0d\xb0\xab\x3c\x9b\xc2\xe8\x29\x8c\x1e\x1c\x46\xf7\x49\xa2\xe0\x5b\x44\x76\xac\x3a\x04\x66\x80\x40\x7a\x0a\x93\x5c\x4e\x72\x39\x58\x2e\xb1\xb2\x97\xfd\xc2\x4b\xa6\xb8\x18\x89\x41\x4a\x49\x72\xe3\x9f\x4d\xea\x32\x13\x93\xde\x17\x38\x4b\xde\x49\x98\x38\x6c\xb1\x2f\xc5\xc3\xe8\x3c\x50\x3b\x0f\x26\x2d\x34\x06\x94\x37\xd9\xf1\xec\x7c\xeb\x08\x79\xd0\xec\x8b\x57\x9e\x48\xaa\x8a\xe2\xdc\x54\x71\x17\xb3\x41\xfb\xd5\x54\x6f\x3c\x61\x60\x77\xf9\xad\x26\xf1\x52\x87\x8c\x66\xbb\x61\xda\xec\x06\xf2\xa1\x89\x80\x9c\x49\x25\x62\x81\x88\xe1\xdc\x75\x08\xec\x78\x86\x07\x0f\xa6\xb0\xa5\x10\xcb\x48\x58\x8d\x4e\x6a\x9b\x81\x33\x3c\x32\x9e\x6e\x40\x98\x57\xe2\x4d\xc1\xe4\x34\xc1\x6d\xec\xe6\x64\x6e\x7f\x02\x73\xcb\xb6\x34\x87\x9b\xaa\x28\x40\x5c\x42\x01\xf9\x98\x2f\xff\x3b\x1a\x1a\xdd\xeb\x4a\xe8\xb3\xa8\xf9\x89\xa9\x35\xa1\x66\xdd\x99\x3f\x30\xc5\x6e\x1e\xe8\x18\x07\x6c\x7b\x41\xe6\x17\xe3\x2a\xa8\x89\x1d\x68\xa2\x57\x7c\xbe\xd3\x4b\x46\x48\xe1\x0a\xef\x74\x46\xc8\x0a\x8e\xe1\x97\x51\x29\xbf\x3a\xac\x8e\xcb\x1d\xc2\xdf\x2b\xfe\xac\x17\x04\x03\x44\x96\xc6\x4c\xac\x9d\x99\x73\xda\xd6\x1b\x6d\xff\x48\x07\xcf\x57\xc5\x24\x26\xf2\xed\xec\x04\x32\xe1\x5a\x05\x2d\x83\xba\x9f\xf2\x6a\x45\x0a\x58\x75\x6b\x02\x21\xfa\x18\x6b\xa4\x89\xc9\x83\x0c\x89\xa2\x1b\x28\xeb\x04\x49\x12\x64\xd3\x5c\xf6\x26\x96\xa1\x72\x66\xae\x69\x09\x2e\x66\x47\xbb\x95\x1e\xe5\xfe\xb3\xa2\x62\x73\x7c\x9c\xfa\xab\x79\x7c\x0a\x52\xa7\x20\x75\x4c\x90\x4a\xd3\x0d\xcd\xe1\x0e\xd1\x55\x06\x72\x86\xca\xeb\x84\xcd\x3e\xae\x5f\x25\xeb\xa1\x00\x2b\x2a\xd5\xf9\x1f\x54\xc4\xd4\x0b\x9b\x7e\xf1\xba\xd9\x63\x63\x00\x8a\x7f\xf2\xed\x05\xb9\xc2\xce\x43\x09\xe4\x33\x57\xeb\x61\x34\x75\x52\xd9\x11\xa5\x22\x48\x24\xcf\x89\x7a\xe2\x0d\x13\x70\xcd\x54\x1d\x97\x46\x4b\x0f\xc2\xe7\x60\xe6\xc6\x19\x68\x6b\xe2\x5e\xb3\x61\xca\x4d\x38\xc6\xec\x00\x64\x80\x30\x29\x38\x7b\x28\x42\x53\x53\xf0\x1c\x99\xec\x39\x3e\x26\x80\x66\x7b\x73\x1f\xcf\xdc\x08\x66\xe5\xaa\xa8\x90\xa2\xec\x49\xce\x21\x27\x45\x15\xba\x19\x87\x12\x8a\x0b\x74\x05\x85\xc0\x39\x98\xb7\x6c\x3a\x6c\x85\xb3\x07\x42\x80\xdc\x71\x0b\x83\x62\x1c\xcc\x8a\x09\xa9\x1a\x5f\xde\x27\x8b\x11\x5f\x21\x8f\x0f\x38\x69\xf1\x52\xcc\x7e\xe7\x92\x13\xf8\x0b\x27\x11\x96\xb9\x5e\xb4\xa9\x78\x50\x95\xae\xa3\xdf\xe7\x90\x9c\xa6\x71\x0a\x82\x55\x43\xda\xdb\x24\xbd\x9b\xf6\x00\x2f\xf0\x05\x0e\x17\xce\x33\x2d\x66\xbd\xcb\x6f\x75\x15\x9f\x9c\x67\x9b\x7c\xc5\xe4\x2b\x86\xfa\x8a\x3e\x99\xc4\xd6\x83\xc5\xac\x97\xd7\x76\x81\xc4\x87\x27\x69\x9c\xa4\x71\xb0\x34\x8e\x1b\x6c\x16\x1b\xbd\xed\xe6\x3b\x6b\x28\xd9\x8b\xd9\x09\x76\x5d\x15\xd2\x4c\x74\xb5\x93\x83\x06\xae\xee\x0c\x55\xe2\xee\xc3\x6d\x73\xa2\x2b\xb8\xeb\xf9\xb2\xd1\x72\xdf\x49\x92\xf8\xcb\xb9\x7a\x9a\x92\xd1\xc7\x96\xd6\xfb\x60\x40\xd9\x98\x06\xfc\x51\x8c\xdf\x46\xf2\x5e\xed\xec\x87\xe7\x40\xd7\x54\xe4\x21\xff\x5d\xcc\x70\xf7\xe1\xb6\x93\x24\x39\xd8\x3d\x13\xdd\x18\xfe\x30\xeb\xf4\x70\x66\x5e\x71\xee\x5b\x08\xf4\x9f\x96\x0f\x67\xf1\x83\xbc\x1b\x9a\x7c\x70\xf5\x3f\x58\xef\x1c\x53\x5b\x18\xf6\xea\x04\x2d\xb7\x97\x21\x22\x44\x91\x37\x56\x86\x64\x82\x72\x3b\x06\x80\x73\xc2\x91\xde\x13\x93\x40\xf6\xbc\xd2\xd5\x79\x09\xdd\xf6\x01\x81\xe6\x90\x28\x32\x14\xc0\xcc\xdb\xbc\xc5\xc3\xd9\xbb\x87\xb3\x67\xb0\x0d\xb5\xa4\x44\xc8\xbe\xb4\x0c\x1d\xaf\x3a\xbd\x7a\xd3\x13\x3d\x7f\x3b\xbd\xf9\xc6\x5a\x73\x9c\xca\xf4\xec\xe6\x71\x2a\x83\x7d\x38\xfd\xb5\xed\x51\x2a\x43\x68\x0f\xd1\xe3\x54\xc6\x18\xd7\x08\xd9\x17\x13\x9f\xcb\xba\x69\xe8\x68\xd7\x13\x36\x1e\x1d\xb8\xa0\x9d\xe0\x8f\x2c\xeb\x3d\x7b\x3a\x8d\xf3\x27\x4f\xda\xa0\x62\x7f\x5d\xb1\x92\x16\x61\x9b\x53\xb7\xb3\x25\xe4\x21\xcc\xc9\x0a\x80\x32\x15\xfb\x9d\x22\x0a\xc4\xd6\xad\x55\x07\xb8\xd8\x89\x1b\x00\xf2\xd8\x05\xc7\x0e\xa0\x61\xb3\xed\x9a\x3e\x5a\xc7\x2f\x10\xeb\x10\x88\x3d\x2a\xaf\x81\x16\xd8\xe7\x86\xe3\x6b\x1d\xce\x51\x84\xa4\x84\x14\x23\x8b\x94\x97\x25\xb8\x3b\x76\x2b\xb7\x3e\x28\x32\x94\x72\x7d\x77\x31\xc8\x46\xb8\x88\x23\x96\x25\xd8\xd2\xbd\xe3\xc8\x63\x31\x1d\x7c\x2d\xdd\x67\x06\x02\x1d\x9d\x8e\x88\x50\xa0\x23\x14\x1f\x69\xc1\x32\xf3\xb0\xd9\x5a\xfc\x5c\x72\x8d\x53\xc2\x1c\x40\x24\x52\x20\x6f\xec\xdf\x2e\x7c\x7a\x19\xa7\x63\xbf\x8d\xf9\x4a\x33\x5a\x4c\x63\xef\x21\x5e\xc4\x4a\x50\xa9\x44\x95\x2a\xdc\x99\x1c\x4a\x4c\x57\x43\x16\xca\x85\x6e\x07\x6b\x64\x6c\x63\x0b\xd7\xf3\xea\x7b\x75\xf2\xb9\xe1\x8e\xd0\x7c\x61\x9d\xfc\x4a\xf6\x3d\x10\x88\x29\x3a\xfa\x81\xa3\xa3\xab\xd2\x98\x99\xf7\x59\x0e\x77\xb5\x19\xbc\xe1\x05\x4b\xf7\x83\xa5\x2a\x3c\x09\xad\xf9\x13\xf2\x9b\x01\x2d\x8c\xe7\x63\xf6\x15\x0e\xff\x2a\xf6\xf1\x35\xea\x68\xf2\x7f\x54\xf7\x64\x4e\x92\x4b\x73\x36\x34\x58\x8e\x9f\xc0\x02\xb2\x3a\x42\xf5\x06\x47\x28\x0e\xd5\xe8\xaf\xb5\xc1\xd7\xb0\x3f\x25\xec\x44\xc1\x6d\x0d\x3d\x5f\xeb\x91\xed\x1a\xf6\xdf\x3a\xe4\x44\xc5\x9c\x6c\xd1\xf7\x67\x8b\x02\xfb\x33\x56\x65\x1a\x11\xdc\x7e\x07\x73\x52\xb0\x0d\x90\x04\xb2\x1c\xd0\x90\x20\xc4\xb3\x5a\x0b\x5e\xe5\xeb\xbe\xea\x91\x0f\x0b\x93\xc8\xa6\xb5\x6d\x4d\x84\xee\xd7\xd9\xb4\x9e\x34\x9f\x0d\xb6\x16\xb3\xde\x6d\x6c\xcd\x9e\xbe\x30\x28\x79\x6f\x27\x42\xca\x31\x5e\xc5\x46\x03\xbb\xb2\x4e\xba\x38\x5e\x16\x32\xd7\x25\x68\x8b\x6c\xa7\xa5\x05\x1b\xbb\x33\x25\x82\x7f\x82\x44\x70\xc9\x33\xb8\xe1\x62\x24\xa0\x9c\x57\x1a\x6e\x91\x35\xb9\x05\x79\xfa\x68\xc9\xf9\xa2\x74\x27\x59\x82\x45\xe9\x42\x9e\x78\xc9\x72\x98\x6d\x38\xb7\xb3\x28\x16\xb3\x5e\x06\xa3\x36\xc2\x0d\xa1\x98\x6a\x2d\x53\xad\x65\x78\xad\xc5\x0a\xa1\x1c\xc8\x95\x1b\xc5\x64\x85\x4e\xba\x20\x0a\x03\x10\x72\x7f\x4f\x77\x2c\x17\xbc\xda\xbd\x5b\xda\x61\x30\x8b\xe5\x86\x95\xd9\xe2\xde\x27\x1b\xde\x2d\xa3\x79\x8c\x57\x71\xc3\x17\x63\xa4\xed\xdf\x6c\xc2\x60\xfc\xbe\x31\x18\x15\x2f\x20\xb6\x73\xfd\xa6\xf5\xce\x53\x98\xac\xea\x64\x55\x47\x58\x55\x1c\x59\xa8\xe4\x40\x9e\xd0\xab\x3b\x7c\x2d\xf3\x20\x3a\x0c\x2b\xbd\x30\x6f\x98\xd8\xe4\x1a\xf6\xf7\xff\xfb\x0d\x53\x73\xcb\xc5\xfb\xd5\x0a\x52\x75\xbf\xb8\xd5\xc0\xde\x72\x99\xbc\x6e\xb3\xaa\x04\x4d\x87\xc5\x39\x93\x59\x7d\xb5\x66\x35\xf2\x8f\xce\xe5\xcf\xa2\xfb\x88\xa2\x6c\xf0\x5a\xaf\xeb\xab\xee\xf6\x51\xb4\x73\x0a\xb1\xf5\x75\x1c\x8b\x5a\xd9\x72\xb1\x7b\x36\x58\x76\x5b\xd7\xfa\xec\x47\x8c\x80\x20\x0b\x76\x14\xa7\xe2\xd1\x1c\xc2\x5f\xaa\xcf\xfe\x3a\xa9\x63\x4f\x2a\xaa\x2a\xb9\x20\xff\xfc\x3b\xfb\x6f\x00\x15\x36\x1b\x08\xff\x3d\x03\x00"),
 		},
 		"/crd/bases/camel.apache.org_integrations.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "camel.apache.org_integrations.yaml",
 			modTime:          time.Time{},
-			uncompressedSize: 470948,
+			uncompressedSize: 471262,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\xbd\x7b\x73\x1b\x37\xf6\x28\xf8\xbf\x3f\x05\x4a\x49\x5d\x49\x13\x91\xb2\x33\x73\x53\xbf\xf1\x4e\xdd\x94\x46\x92\x13\x6d\x6c\x99\x65\x29\xc9\x4d\x39\x9e\x04\xec\x06\x49\x5c\x35\x81\x1e\x00\x4d\x89\xbf\xf5\x7e\xf7\x2d\x1c\x00\xfd\xe0\xab\x0f\x5a\xa2\xe3\xcc\x36\xa6\x6a\x62\x52\xec\xd3\x78\x1c\x9c\xf7\xe3\x0b\x32\x78\xba\xf1\xec\x0b\xf2\x9a\x27\x4c\x68\x96\x12\x23\x89\x99\x31\x72\x96\xd3\x64\xc6\xc8\x8d\x9c\x98\x7b\xaa\x18\x79\x25\x0b\x91\x52\xc3\xa5\x20\x47\x67\x37\xaf\x8e\x49\x21\x52\xa6\x88\x14\x8c\x48\x45\xe6\x52\xb1\x67\x5f\x90\x44\x0a\xa3\xf8\xb8\x30\x52\x91\xcc\x01\x24\x74\xaa\x18\x9b\x33\x61\xf4\x90\x90\x1b\xc6\x00\xfa\xf5\xdb\xdb\xab\xf3\x4b\x32\xe1\x19\x23\x29\xd7\xee\x21\x96\x92\x7b\x6e\x66\xcf\xbe\x20\x66\xc6\x35\xb9\x97\xea\x8e\x4c\xa4\x22\x34\x4d\xb9\x7d\x31\xcd\x08\x17\x13\xa9\xe6\x6e\x1a\x8a\x4d\xa9\x4a\xb9\x98\x92\x44\xe6\x4b\xc5\xa7\x33\x43\xe4\xbd\x60\x4a\xcf\x78\x3e\x7c\xf6\x05\xb9\xb5\xcb\xb8\x79\x15\x66\xa2\x1d\x58\x78\xa7\x91\xe4\x17\x59\xf8\x35\xd4\x96\xeb\x77\xe1\x84\xfc\xc4\x94\xb6\x2f\xf9\x7a\xf8\xfc\xd9\x17\xe4\xc8\xfe\xe4\xc0\xff\xf1\xe0\xf8\xff\x22\x4b\x59\x90\x39\x5d\x12\x21\x0d\x29\x34\xab\x41\x66\x0f\x09\xcb\x0d\xe1\x82\x24\x72\x9e\x67\x9c\x8a\x84\x55\xcb\x2a\xdf\x30\x24\x30\x01\x0b\x43\x8e\x0d\xe5\x82\x50\x58\x06\x91\x93\xfa\xcf\x08\x35\xcf\xbe\x78\xf6\x05\x81\x31\x33\x26\x7f\x79\x7a\x7a\x7f\x7f\x3f\xa4\x30\xdd\xa1\x54\xd3\xd3\xb0\xba\xd3\xd7\x57\xe7\x97\xd7\x37\x97\x03\x98\xf2\xb3\x2f\xc8\x8f\x22\x63\x5a\x13\xc5\xfe\x5d\x70\xc5\x52\x32\x5e\x12\x9a\xe7\x19\x4f\xe8\x38\x63\x24\xa3\xf7\xf6\xe0\xe0\x74\xe0\xd0\xb9\x20\xf7\x8a\x1b\x2e\xa6\x27\x44\xfb\x53\x7f\xf6\x45\xe3\x74\xaa\xed\x0a\xd3\xe3\xba\xf1\x03\x29\x08\x15\xe4\xe0\xec\x86\x5c\xdd\x1c\x90\x7f\x9e\xdd\x5c\xdd\x9c\x3c\xfb\x82\xfc\x7c\x75\xfb\xfd\xdb\x1f\x6f\xc9\xcf\x67\xef\xde\x9d\x5d\xdf\x5e\x5d\xde\x90\xb7\xef\xc8\xf9\xdb\xeb\x8b\xab\xdb\xab\xb7\xd7\x37\xe4\xed\x2b\x72\x76\xfd\x0b\xf9\xe1\xea\xfa\xe2\x84\x30\x6e\x66\x4c\x11\xf6\x90\x2b\x3b\x7f\xa9\x08\xb7\x1b\xc9\x52\x7b\xa6\x01\x81\xc2\x04\x2c\x7e\xd8\xcf\x3a\x67\x09\x9f\xf0\x84\x64\x54\x4c\x0b\x3a\x65\x64\x2a\x17\x4c\x09\x8b\x1e\x39\x53\x73\xae\xed\x71\x6a\x42\x45\xfa\xec\x0b\x92\xf1\x39\x37\x80\x45\x7a\x7d\x51\xf6\x35\x4f\x79\xb7\x9e\xd1\x9c\x7b\x74\x7a\x49\x68\xce\xd9\x83\x61\x02\x66\x33\xbc\xfb\x2f\x3d\xe4\xf2\x74\xf1\xe2\xd9\x1d\x17\xe9\x4b\x72\x5e\x68\x23\xe7\xef\x98\x96\x85\x4a\xd8\x05\x9b\x70\x01\x98\xff\x6c\xce\x0c\x4d\xa9\xa1\x2f\x9f\x11\x42\x85\x90\x7e\xf2\xf6\x23\x71\xb7\x4e\x66\x19\x53\x83\x29\x13\xc3\xbb\x62\xcc\xc6\x05\xcf\x52\xa6\x00\x78\x78\xf5\xe2\xf9\xf0\x9b\xe1\x8b\x67\x84\x24\x8a\xc1\xe3\xb7\x7c\xce\xb4\xa1\xf3\xfc\x25\x11\x45\x96\x3d\x23\x24\xa3\x63\x96\x79\xa8\x34\xcf\x5f\x92\x84\xce\x59\x36\xb8\x7b\x46\x88\xa0\x73\xf6\x92\x70\x61\xd8\x54\xb9\x97\x0f\xe1\x8f\x35\x54\x7c\x66\x0f\xc1\x3e\x3c\x55\xb2\x08\x0f\xd7\xff\xee\xa0\x84\x59\x53\xc3\xa6\x52\xf1\xf0\x79\x40\xee\xec\xef\xfd\xbf\x93\xf2\xdf\x6e\x67\xae\xaa\x17\xc3\xb7\x19\xd7\xe6\x87\xd5\xbf\xbc\xe6\xda\xc0\x5f\xf3\xac\x50\x34\x6b\x4e\x17\xfe\xa0\x67\x52\x99\xeb\x6a\x12\x03\xc2\xdd\x13\x9a\x8b\x69\x91\x51\xd5\x78\xe6\x19\x21\x3a\x91\x39\x7b\x49\xe0\x91\x9c\x26\x2c\x7d\x46\x88\xdf\x51\x00\x31\xa8\x51\xa7\x91\xb2\xcf\xaa\x73\x99\x15\x73\x51\xbe\x20\x65\x3a\x51\x3c\x37\x70\x06\x96\x24\xd5\x5e\x40\xf2\x19\xd5\xec\x99\xbb\xde\xff\x47\x4b\x31\xa2\x66\xf6\x92\x0c\xb5\xa1\xa6\xd0\xc3\xfa\x5f\xdd\xfe\x8f\x6a\xdf\x98\xa5\x9d\x99\xbd\x7c\x62\x8a\x79\xd7\x9d\x5f\xea\xa6\x37\xd5\x7e\xf7\x03\x37\x43\xfb\xb2\xc6\x7b\x7f\x28\x9f\xc5\xbc\x55\x14\xf3\xb1\xe5\x0d\x13\x92\xcb\x54\x6f\x7d\xa9\x62\x40\x8d\x74\xe3\x4d\xef\x9a\x5f\xba\xd7\xc1\xf4\x98\x7a\x56\xfd\x6c\xf1\xc2\x1d\x5b\x32\x63\x73\xfa\xd2\xff\x58\xe6\x4c\x9c\x8d\xae\x7e\xfa\xeb\x4d\xe3\x6b\xd2\x9c\x61\x0d\x61\x2c\xfd\xb2\x37\xdf\xfd\xbe\x24\x26\x75\xb4\x21\x67\xa3\xab\x12\x4e\xae\x64\xce\x94\x29\x71\xd6\x8d\xda\xfd\xae\x7d\xbb\xf2\xd6\x43\x3b\x31\xcf\x54\x52\x7b\xb1\x99\x7b\xb5\x47\x26\x96\xfa\xb5\x38\x06\xc0\x2d\xdd\xb6\xf4\x8f\x09\x53\x21\x7d\x35\xe4\xc4\x12\x5a\x39\xfe\x3f\x2c\x31\x43\x72\xc3\x94\x05\x63\xb1\xbb\xc8\x52\x4b\x0f\x16\x4c\x19\xa2\x58\x22\xa7\x82\xff\x77\x09\x5b\x07\xe6\x9e\x51\xc3\xb4\x59\x81\x09\xc8\x6b\x99\xec\x82\x66\x05\x3b\xb1\xa4\x12\xf8\x93\x62\xf6\x2d\xa4\x10\x35\x78\xf0\x13\x3d\x24\x6f\xa4\x62\xc0\x94\x5f\x02\x77\xd2\x2f\x4f\x4f\xa7\xdc\x04\xba\x96\xc8\xf9\xbc\x10\xdc\x2c\x4f\x6b\x82\x81\x3e\x4d\xd9\x82\x65\xa7\x9a\x4f\x07\x54\x25\x33\x6e\x58\x62\x0a\xc5\x4e\x69\xce\x07\x30\x75\xe1\xc8\xcb\x3c\xfd\x42\x79\x4a\xa8\x0f\x1b\x73\x5d\xc3\x41\x37\x80\x52\xec\x38\x01\x4b\x2f\xec\x89\x53\xff\xa8\x5b\x45\xb5\xd1\xf6\x2b\xbb\x3b\xef\x2e\x6f\x6e\x49\x78\x35\x1c\xc6\xea\xee\xc3\xbe\x57\x0f\xea\xea\x08\xec\x86\x71\x31\x01\x8e\x62\x45\x02\x25\xe7\x00\x93\x89\x34\x97\x5c\x18\xf8\x90\x64\x9c\x89\xd5\xed\xd7\xc5\x78\xce\x8d\xe3\xd7\x4c\x1b\x7b\x56\x43\x72\x0e\xc4\x9e\x8c\x19\x29\xf2\x94\x1a\x96\x0e\xc9\x95\x20\xe7\x96\x38\x9e\x53\x2b\x45\xec\xf9\x00\xec\x4e\xeb\x81\xdd\x58\xdc\x11\xd4\xf9\xd4\xea\x8f\xdd\xae\xd5\xfe\x10\xd8\xc5\x96\xf3\xb2\x3b\x95\x32\x0d\xb2\x4b\xfd\xce\x06\x56\xbf\x7e\x2f\x36\x5f\x4f\xe2\x58\xe4\x84\x4f\x0b\x07\x61\xf5\x8f\xab\x78\x72\x61\xcf\xd5\xb2\xa7\xf4\x25\xf9\x51\x33\xc7\x8b\x88\x51\x94\x1b\x72\xe4\x98\x5a\xf5\xa6\x63\x7b\xa7\xe6\x54\xd0\x29\x5b\x03\x5b\x9f\x91\x83\x24\x85\x95\xf5\x2c\x76\x38\x68\x73\x59\x08\x33\x74\xb3\xab\x83\xf2\x13\x5e\x45\x3c\x3b\x76\xc0\x29\xaf\x4b\x1d\x52\xf9\x65\xf3\xc9\x0d\x90\x1b\xb0\x16\x96\x8b\x35\x21\xf9\xaf\x0e\xd7\x1e\xe5\x86\xcd\xd7\xb6\x7c\x6d\x5f\xcf\xeb\x67\x70\x93\xb3\xa4\x76\x81\x08\x25\x53\x26\x98\xe2\x49\xf3\xa8\x36\xc0\x24\x3b\x10\x60\x75\xd3\x37\xcd\x89\x94\x3b\xf2\x03\x5b\x6e\xfe\xc1\x2e\x84\x10\x92\x64\x52\x4c\x99\xb2\x72\x7f\xba\xbe\x17\x7e\x2b\x37\x5f\x8f\xf5\x39\xbc\xb1\x9b\x3d\xb2\x94\xe1\x0f\x9f\xca\xad\xfd\xe1\x1f\x35\x09\x83\x7e\x79\x0d\x69\x2c\x85\xb0\x0f\x5a\x76\xd8\x40\x9b\x13\xc2\xd9\xcb\x80\x07\xcb\x93\x2d\x70\x03\x59\x98\xd3\xfc\x84\x68\x96\x28\x66\x4e\xc8\x70\x38\xec\xbc\x08\xe0\x27\xa8\x55\x00\xe7\x07\xee\x63\x24\xa1\x5a\xf3\xa9\x08\xbc\xb9\xb1\x10\x72\xa4\x97\xc2\xd0\x87\xad\x2b\xb0\xfc\x66\x41\xd5\x92\xa4\x2c\x67\x02\xb4\x61\x29\x00\xce\xef\xf6\x3c\x7f\x3f\xee\xb6\x96\xa0\x34\x6e\x5a\xcc\x00\x1e\xde\xf8\x07\x58\xd2\x26\xca\xb2\x99\x01\xd4\xff\x48\x95\xa2\xcb\x67\xab\x5b\x66\xd7\xc4\x44\xb2\xf1\x2a\xaf\x6d\xa8\xd5\x09\x2c\x2a\x00\x7b\xb4\x7a\xe3\x1b\xba\x60\xa2\x01\xa5\xa1\x0d\x9b\xd9\xa6\xb9\xae\x6a\x1a\xf5\xb1\x95\xd0\xed\xdc\xce\xed\x0b\x9c\x64\xf2\xbe\x6d\x65\x94\x78\x41\x84\x0b\xf2\xcb\xd9\x9b\xd7\xe4\xe2\xe6\x75\xa5\xe5\xde\xcf\x78\x32\x0b\x54\x1d\x76\x41\xc9\xc2\xb0\x4d\x8c\xc3\x48\xa2\x8a\x88\x35\x35\x66\xf1\x2a\x93\xf7\x20\x3b\x09\x52\x08\x6d\x54\x01\x22\x43\xba\x26\x0a\x59\x04\xa4\xee\x04\x36\x62\x9e\x83\xe3\x56\x72\xfa\x7f\xdf\xbc\xbd\xb6\xcb\x89\xc5\x18\x42\x1e\x06\x56\xcb\x55\x82\x19\xa6\x07\xf0\x6a\xb5\x60\x83\x42\xdc\x09\x79\x2f\x06\x13\xce\xb2\x54\xbf\x24\x46\x6d\xc0\xc6\xed\xa7\xd1\x54\x82\x10\x08\xa7\xd8\x84\x29\x26\x12\x16\xac\x36\xbf\x5f\x35\x40\xfc\xee\x8f\x87\x6b\x20\x8e\x56\xbf\xd8\xb4\x54\x2b\x28\xee\xc2\xba\xdd\xfc\x6c\x9b\xf6\xb1\x71\xd6\x67\xa3\xab\xa0\x71\x84\x39\xfb\x45\x98\xe1\x76\xba\xbc\x9d\x4e\xc0\x4e\x83\x56\xd7\xfe\xee\xc3\xab\x89\x7b\x19\xc8\xde\x96\xee\x91\x9c\x33\xb7\x79\xa5\x32\x43\xb8\xd0\x86\xd1\x94\xc8\xc9\x16\xca\x45\x05\xb1\x78\xa6\x98\x7f\xe2\xc4\x6d\xa0\x17\xe9\x2b\x15\xc8\x1b\xd5\x16\x34\xe3\x29\xb1\x98\x76\xfa\x9d\xdc\x02\x12\x56\x41\x68\x92\x30\x6d\x01\x51\x03\xf6\xca\x13\xa2\x8b\x64\x46\xa8\x0e\x52\xe8\x8d\xfd\xcb\x70\x4e\x05\x9f\x30\x0d\x52\x9b\x13\xa5\xf4\xfb\xaf\x3f\x6c\xde\x3d\x42\x5e\x49\x45\xd8\x03\x9d\xe7\x19\x3b\x21\xdc\xed\x78\x79\x67\x02\xf6\x70\xed\xb6\xa3\x12\xeb\xee\xb9\x99\xf1\xcd\xd2\x0f\xb1\xfb\x26\xd3\x93\x60\x24\xb5\xcb\x35\xf4\x8e\x81\xf1\xcd\x33\x95\x8c\xdf\xb1\x97\xe4\xc0\x0a\x4a\xb5\x69\xfe\x3f\x56\x75\xfe\x7f\x0f\xb6\x40\x3d\xba\x9f\x31\xc5\xc8\x81\xfd\xd1\x81\x9b\x5c\xa9\x2f\xda\xef\x02\xbe\xd4\x64\xcf\x19\x35\xc4\x28\x3e\x9d\x32\x05\x86\x91\x4d\x03\x94\x1f\xab\x52\x1c\x83\x25\x6f\x62\x05\x86\x0a\x04\x00\xb6\xa7\xe7\x44\x3a\x96\xae\x4d\xfa\xfd\xd7\x1f\xb6\xce\xb8\xb9\x5f\x84\x8b\x94\x3d\x90\xaf\x09\x10\x42\xae\xed\x2e\x1d\x0f\xc9\x2d\x60\x07\x70\x51\xfb\xa6\x64\x26\x35\xdb\xb6\xb3\x52\x64\x4b\xbb\xe6\x19\x5d\x30\xa2\xe5\x9c\x91\x7b\x96\x65\x03\xa7\xaf\xa7\xe4\x9e\x82\x7d\x36\x1c\x9c\xa3\x75\x39\x55\x66\x27\xb6\x06\x2d\xfd\xf6\xed\xc5\xdb\x97\x6e\x66\x16\xa1\xa6\x60\x7d\xb0\xda\xdd\x84\x5b\xad\xdb\xaa\xdb\xde\x8c\x6c\xb1\x71\x4d\xe9\x0c\x43\x17\x0e\x7d\x8c\x24\xc9\x8c\x8a\x29\x23\x9e\xf0\x4f\x0a\x4b\x92\xb7\x48\x2f\x2d\xf7\x78\x5d\x75\x0e\x63\x83\x0a\xbd\x4a\x38\xfe\x30\x25\x14\xb9\x38\xb0\x18\x21\x16\x77\x5d\xc3\xf2\x9d\x8b\xab\x78\x8f\x5d\x5f\x2a\x13\x6d\x97\x96\xb0\xdc\xe8\x53\xb9\x60\x6a\xc1\xd9\xfd\xe9\xbd\x54\x77\x5c\x4c\x07\x16\x35\x07\x0e\x07\xf4\x29\xd8\x3f\x4f\xbf\x80\xff\x74\x5e\x0b\x18\x22\xb1\x0b\x82\x1f\x7f\x8a\x55\xd9\xf7\xe8\xd3\x4e\x8b\x0a\x1a\x08\x9e\x8f\x1d\xde\x04\x7b\xff\xca\xb3\xf6\x5a\x38\xa6\xeb\x8d\x68\x9e\xc6\x6e\xb9\x4c\x5c\x93\x39\x4d\x1d\x69\xa6\x62\xb9\x77\x54\xb6\x1b\x5a\x28\x3b\xa3\xe5\xc0\x9b\xed\x07\x54\xa4\xf6\xdf\x9a\x6b\x63\xbf\xef\xb4\x83\x05\x47\x5d\xdf\x1f\xaf\x2e\x3e\x0d\x82\x17\xbc\xd3\x5d\xdd\x21\xf5\xe5\x4a\x4e\x78\xb6\x01\xed\xd7\xa4\x32\xff\x4b\x22\x18\x4b\x9d\x6b\x4b\x15\xa2\x5d\xca\xda\x31\xb1\x60\xa8\x46\xbc\xbc\x32\x7d\xff\x3e\x92\xa9\xfe\x3d\xcc\x22\x98\x96\x55\x21\xc0\x27\xb5\x6b\x26\xce\xc5\x09\x56\xef\xbf\x7e\xbd\x65\x9e\x75\x8b\x78\x63\xa2\x52\x73\x53\xf3\xaa\x6c\x9d\x6c\xcd\xa9\xea\xd4\xa4\xfa\xb3\x76\xd3\xc6\x0c\x84\xd6\xbd\xeb\x40\xa5\x7d\x2a\xda\x2c\xb7\x6a\x04\x2b\xbf\xa8\x19\xc2\x36\xeb\x41\x1b\x4d\x63\x32\x67\x82\xe6\x3c\x40\xf3\x1f\x87\xa5\x8d\xa0\xc5\xd4\xf7\xd6\x39\x1f\x74\xd3\x3c\xd5\xd9\x5a\x76\x18\xbc\x80\x4d\x4b\x99\x15\x28\xa8\x31\x34\x99\xb1\xb4\xb2\x51\x03\xc5\xdb\x78\xdd\xee\x79\x96\xd9\xa3\x9c\x53\xc3\x14\xa7\x19\x58\xef\xa9\x26\xd4\x79\xab\xbd\xad\x20\x60\xa5\xc5\xd9\xdf\xbd\xa0\x72\xcf\xbc\x30\xbd\x85\xae\xf8\xc3\xb0\xd2\x87\x93\x3c\x88\x62\x19\xa3\x9a\x69\xf8\x8a\x2e\x64\x01\xf7\x00\xcc\x89\x27\xe5\xee\x4a\x55\xf3\xed\xad\x1d\x8d\xdd\xfa\x8d\x84\xa3\xcd\xa6\x97\xc8\x39\xb8\x8b\xb7\xb2\x8f\x95\xed\xe5\x95\x30\x6b\x77\xd5\x0a\x86\x1e\x02\x4b\xc9\xd1\x98\x6a\xf6\xcd\xdf\x08\x13\x89\x5a\xe6\x86\xa5\xbb\xad\x28\x63\x29\x33\x46\x37\xcb\x94\xfe\x05\x68\xa3\x90\x3f\xd0\x44\xa6\x8c\x1c\xe5\x19\x28\xf5\xec\xc1\x74\x34\xe3\x94\xef\xc7\x9a\x3a\x4b\x03\xd4\x9c\xe6\xe4\x8e\x2d\xc9\x4c\x66\x69\xf0\x8b\x94\x73\x03\x90\x8f\x9c\xd1\x3b\x36\x89\x9f\x51\xa5\x32\xed\x6d\x5e\x68\x2b\x68\x1d\x7d\xc0\x08\x79\x64\x78\xce\x13\x9a\x59\x15\x82\x3d\x18\x8b\xe9\x63\x2e\xa8\x5a\x76\x3e\x3c\xb8\x3a\xdb\xb5\xeb\x0d\x13\x82\x27\x48\x4e\xcd\x8c\x80\x9f\x51\x1b\x2e\x9c\x21\x11\xae\x76\xd7\x89\x6c\x97\xa0\x37\xb1\xc0\x9a\x18\xdd\x66\xaa\x47\xbd\x3d\x8f\xd9\x01\x58\xbb\x53\x65\x41\x29\xb2\x54\x0e\xcc\x03\x72\x87\x8e\xda\x6a\x22\xa7\xf7\xe7\x8f\xb9\xc8\xbb\xf1\x20\xf0\xfb\xf1\xd2\x6c\x93\x53\x9f\xce\x7e\x6e\xe7\x76\xe7\xb5\xb8\x94\x1a\x6a\x79\x1a\x7b\xc8\x37\x9b\xd9\x10\xaf\xee\x68\xda\xd5\x56\x88\x4c\xd8\x59\x92\x58\x8c\xbd\xde\x88\x5f\x8d\x79\x27\x10\x1a\x43\x6e\xce\xec\x84\x8b\x5a\xe4\x4f\x47\x99\x0e\x27\x74\x54\x27\xa9\x37\x98\x5a\x9d\xa1\xd9\x19\x5c\x1f\x67\x5e\xbd\xa9\x58\x7d\x3d\x38\xa0\xe9\x0b\xb0\x2b\xae\xc7\xdb\xad\xc3\x24\xb5\xc9\x8c\x19\x61\x0f\x2c\x81\xb8\x2c\x17\x5e\xc6\x14\xcc\xdc\xcd\xba\x6e\x45\xee\xf9\x6d\xcf\x6f\x7b\x7e\xeb\x23\x60\xac\x96\x2b\xd5\x16\xb4\xdf\x10\x4a\x14\x1e\x20\x54\x59\x1d\x22\x04\xaa\xa6\x56\xeb\x9f\x70\x30\xa2\xce\xec\x9d\x9d\x86\x48\x38\x17\x41\x70\x37\x7c\x07\x57\xf5\xb5\xa4\xe9\x46\x6f\xbc\x1b\x85\x76\xb7\x39\x57\xec\x34\x97\xda\xd8\x8b\xe9\xcc\xd4\x8e\x2a\x6d\xb3\x6b\x6c\xa3\x3a\xc8\x9d\xd8\x45\xba\xdd\x08\xb4\x03\xb5\x4f\x4e\x0c\x58\x56\xee\x10\x17\x84\xe4\x7d\x58\x47\x25\x45\x3a\xde\xac\x72\x96\x73\x92\xee\x88\x72\xc5\x8c\xb7\xfa\x57\xd7\xb7\xeb\xa1\x67\x70\x00\xa8\x65\xb8\xb3\xf2\x6e\xb0\xda\x51\x07\x69\xa7\xeb\x21\x83\x21\x1d\x34\xb5\xcd\xcb\xa3\xc6\xb2\x16\xc3\xe7\x9d\x17\xf9\xff\x77\x01\x2e\x84\x02\x0c\x6a\x01\xa8\x2d\x73\x79\xed\xbd\xc9\xe1\x51\x67\x7a\x25\xc1\x17\xe0\x45\x00\x7f\x42\x47\x6c\x38\xdd\xe6\x02\x22\xc0\xfc\x2c\xc5\xe2\x9a\x1c\x18\x36\xcf\x33\x6a\xd8\xc1\x36\x2a\xb5\xff\xab\xfb\x1f\x24\xcd\x5a\xee\xd1\x90\x98\x82\x68\xeb\x27\xec\x7e\x3c\x0e\xfe\xfe\xad\x4e\x15\xb2\xdd\xd5\x8c\x98\x70\x47\x19\x38\xa0\x42\x8b\x0c\x3a\x92\x69\xf9\x53\x2f\x06\xf3\xff\xee\xe2\x29\x5e\x8f\xb7\xdb\xf8\x3e\xdc\xb5\x6f\x13\x10\x09\xa1\x89\xe1\x0b\x76\xc1\x68\x9a\x71\xc1\x6e\x58\x22\x45\xba\x03\xb1\x9b\x7e\xea\x4d\xcf\x6e\x7d\xb4\x66\x30\xfd\xe6\x6f\x2d\x37\x67\xb3\xe1\x34\x8c\xca\xef\x88\x9c\xe8\x79\xf9\xc0\xf6\xdb\xbf\xfb\x42\xaf\xae\x1c\x42\xd1\x33\x16\x72\x35\x40\xf2\x5f\xf1\xb9\x2e\x65\x41\xee\xe9\x56\xd1\xcb\x2f\xd7\xd9\xbd\x9d\x27\xd9\xf9\x8c\xb7\x53\x28\xcc\x79\xba\x41\xd5\xb4\xe5\x17\x6b\x81\xcf\x6a\x5a\xcc\x5d\xbc\x96\xf4\xb1\xb0\x46\x2d\x21\x1a\x76\x08\xe1\xe2\xa9\x4c\xee\x76\x70\xc7\x30\xf8\x9c\x4e\xd9\xe1\xa1\x26\xe7\x6f\x2e\xca\x90\x0a\xee\xa3\xa5\xbd\x2b\x35\x57\x72\xc1\x53\x96\x0e\xc9\x4f\x54\x71\x3a\xce\xb6\x91\xa0\x6a\x94\x32\xae\x26\x5f\x1e\xfd\x74\xf6\xee\xb7\xeb\xb3\x37\x97\xc7\x20\xcd\xb1\x87\x9c\x8a\x94\xa5\xa4\xd0\x41\xf6\x2d\x4f\xe2\xf0\x70\xfb\x89\x87\xc1\xc4\x82\x2b\x29\xe6\xe0\x66\xb9\x9a\x80\x7b\xde\xcd\x8a\x24\x65\x60\xaf\x62\x5a\x66\x0b\x06\x0e\xfd\x88\xd9\x06\x06\xc4\x45\x5e\x98\x10\xfd\x10\xac\xbd\x85\x70\xae\xe1\x74\x48\x2e\x64\x81\xd9\x85\x2f\xbf\x84\x15\x2b\x96\x16\x89\x73\x9a\xd0\x80\x88\x5f\x9e\x78\xb9\x8d\x66\x99\xbc\xd7\xa0\x86\x32\x9d\xd0\x7c\x3b\x07\xaa\x86\x9d\x62\x7d\x5b\x9d\x23\xfe\x25\xe1\x43\x36\x24\x07\x5f\xd6\xfe\x74\xe0\x66\x9f\x2b\x69\xa7\x80\x02\xec\x57\x9d\x71\xc3\x14\xcd\xc8\x41\x1d\xda\x90\x5c\xda\x39\x82\x95\x3c\x1c\x70\x2b\x50\x98\x81\x60\x0b\xa6\x9c\xfa\xec\x8e\xff\xc4\xa7\x9a\x41\x3a\x95\x9c\x58\xe1\x04\x32\x94\x30\xe7\x55\x1e\x38\x7b\xe0\xda\x40\x36\x93\x90\x66\x63\x60\x77\xe5\x87\x6b\x85\xba\xc3\x4f\x67\xa8\xbe\xd3\xa7\x5c\x58\x5e\x34\x48\xa9\xa1\x83\x1a\x21\x39\x75\xac\x72\x90\xc8\xf9\x9c\x8a\x74\x40\xfd\xd5\x1c\x94\x68\x7d\xfa\x85\xf7\x05\x0c\x68\xf9\x2b\x2e\x06\x74\xa0\x67\x2c\xcb\xb6\x45\x46\x86\xd1\x4a\xea\xdc\x40\xc8\x30\xcd\x9f\x6e\x97\x65\xc2\xf0\x73\x8d\xa3\x4c\x97\x25\x21\x72\x6f\x18\x92\x6b\x69\x2a\xab\xc9\xce\x20\x9c\x6a\x50\x02\x7b\x53\xa7\x65\x15\xad\xba\xbc\xbe\x7d\xf7\xcb\xe8\xed\xd5\xf5\x6d\x8d\x64\x21\x90\x7b\x17\x49\xdb\x42\xb2\xda\x27\xda\x4e\xd2\x56\x49\x16\x62\xf1\x6d\x24\x6d\x8d\x64\xb5\x13\x7a\x14\x49\x5b\x21\x59\xed\x7b\x8a\x24\x69\xab\x24\xab\x15\x30\x96\xa4\xd5\x48\x56\x2b\x4c\x34\x49\xab\x91\xac\x56\xa0\x38\x92\xb6\x4a\xb2\x5a\xc1\xa2\x48\x5a\x4f\xb2\xd6\x06\x13\x8b\x28\x72\x15\x14\xd2\xda\x15\x2d\xcf\x0a\x64\x2b\xcd\x0c\x41\xd0\xab\xc6\x9d\xdf\x74\x6c\x4f\xb3\x63\x8d\xb9\x5f\x8a\xc5\x4f\x54\x35\x12\x3e\xc4\xc6\x85\xb4\x82\xb5\x92\xaa\xf3\x84\x83\x54\x5b\xca\xdf\x6d\xb3\xc6\x4b\xb8\x6e\xec\xb2\x98\xec\x58\x68\x3d\x86\x6c\xd3\xfa\xda\xa7\xe9\xc6\x9b\x42\xc3\x99\x50\x72\xfe\xdb\xd5\xc5\xe5\xf5\xed\xd5\xab\xab\xcb\x77\xb8\xa7\x23\x70\x95\xb4\x65\x2d\x34\x47\x93\x7d\x3e\x96\x1f\xb9\xb1\x85\x2b\xe5\x8a\x2d\xb8\x2c\x74\xb6\x2c\x4d\x2e\xb5\xfd\x44\xc2\xae\xae\x87\x97\x96\x2b\x45\x8a\x8a\x94\x50\xb1\x0c\x8e\xa8\xc7\x00\x47\xc8\xf6\x48\x90\x9b\xd8\x65\x1b\x3b\x44\x82\xde\xc8\x34\x77\x31\x45\x24\xdc\x5d\xac\x73\x17\x6b\x44\x82\xdf\xc2\x40\xb7\x30\x48\x24\xd0\x9d\x6c\x74\x1b\x9b\x44\xc2\xde\xcd\x4c\x4b\x66\x79\xc1\x26\xb4\xc8\x10\xdc\xd5\x0d\x23\xc9\xc1\xc1\xd6\xc4\xa4\x95\xdf\x76\xb8\xfe\xaf\x94\x9c\x77\x20\x01\xce\x6d\x59\xba\x62\x37\x11\x3c\x84\x9a\x5c\x9b\x47\x83\x1d\x79\xfd\xde\x85\xb4\x7b\x69\x98\xcd\x73\xb3\xc4\x9d\x73\x1c\xbd\x27\x65\x3a\xd8\x1b\x9a\xff\xc0\x96\x3b\xfc\x65\xeb\xa3\xb9\x29\x2c\x63\x09\xa4\x34\xde\x31\x88\x17\xa7\x3e\xf5\xf1\x0d\xcd\xb1\x18\xda\x65\xf6\x76\xdc\x6d\xf7\x3b\x22\x66\x6e\x15\x18\x3b\x67\x90\x25\xec\x22\xf0\xd3\x25\xf1\x88\x17\x06\x9e\xd3\x6e\x9c\xf4\x8e\xb0\xed\x28\xa0\x64\x2f\x41\xde\x91\x53\x70\xa1\x77\x67\x69\x4a\x24\x90\x90\x42\xb3\x49\x91\xb9\x94\x00\x3d\xac\xe5\xfa\x60\x39\x4a\x18\x77\x5c\xa4\x27\xa4\xe0\xe9\xb7\x38\x22\x12\x46\xc7\x33\x0d\xce\xb1\x47\x9c\xeb\x4d\xe9\x33\xac\xa8\x69\x79\x8f\x22\x57\x2f\x15\xe1\x46\x03\x6e\xcf\xbd\x60\xe5\x85\x8a\x0e\x9b\xb1\x2b\xfc\x60\xd3\xd8\x95\x4b\xb9\x79\x0c\xec\x4c\xd1\xbf\x6e\x49\x95\xdb\x34\x00\x9f\xba\x53\xb8\xc3\x8a\xc4\xb9\x64\x15\x7f\xfb\x72\x19\xb1\x48\x42\x74\x91\xe7\x52\x19\x5d\x56\x0d\x80\xba\x23\x27\xcd\x8f\x90\x57\x10\x83\xed\xbf\x97\x8f\xbb\x6a\x36\xef\x0f\x0f\xff\xf1\xc3\xe5\x2f\xff\xeb\xf0\xf0\xc3\xef\x27\xb5\xbf\xd6\xca\xe8\x34\x7e\x12\xb3\x80\x9c\x25\x43\x21\x53\x76\x0d\xf3\x86\x8f\xeb\x81\x4d\x51\x10\x5d\x65\x94\x99\xd4\xe6\x6a\x74\x12\x3e\xe6\x32\x5d\xfd\xa4\x91\xc2\x00\xe9\xcc\x4a\xda\xf2\x0a\xb7\x8d\x06\xa6\xfc\xd4\xcc\x35\xf4\x25\x4e\x30\x46\xa0\xe6\x78\x15\xf2\x0c\xad\x1c\x70\xaf\xb8\x31\x4c\x80\x7c\xcc\xd4\x5c\x13\x39\x39\xb1\xf7\x39\x46\xa0\x0a\xc3\x0a\x56\x8b\x17\x68\xc9\xd1\x3f\xd4\x8d\x28\xb6\x24\x4b\x6e\x1b\x4d\x67\x20\x84\x76\x4e\xbc\x6b\xdc\xde\xbd\x92\x59\x47\x73\xbb\xba\xa7\x8f\xa5\xf5\xdc\xd0\x4f\xb0\x1d\x5d\xa8\x62\xb9\x81\xfb\xa4\x8d\x21\xa8\xfe\xd5\x93\xd1\xc8\x32\x4c\x7f\x35\x83\x32\x06\x0f\x20\x35\xb1\x4a\x58\x80\x0a\x66\x50\xce\xac\x2a\x18\x73\xe4\xbe\x1c\x26\x79\x11\x43\x73\xfc\x53\x73\x36\x97\x6a\x79\x12\x3e\xb2\x7c\xc6\xe6\x56\xc5\x1a\x68\x23\x15\x9d\x46\x91\xb1\x30\x25\x98\x4a\xf5\xc9\xbd\xa2\x31\xe9\xf5\xf7\x60\xed\x06\xc4\xdb\x0e\x5c\x3e\x95\xc9\x96\x81\x9f\xb0\x74\xef\xb4\xb1\x3c\xc1\xcd\x81\xab\xbb\x46\x13\x49\xce\x1b\x99\xb0\x2f\xcb\x5b\x11\x79\x97\xad\x02\xe6\xab\xb3\x9c\x54\x81\x49\xa0\x9a\x8b\x85\x55\xc5\xb6\x24\x63\x6d\x1b\x1d\x09\x5c\xca\x17\x5c\xcb\x28\xb4\x26\x84\x8a\xe5\xdb\x88\x4b\x46\xca\x0a\x14\x6d\xbe\xfc\xdd\xcf\x46\x2f\x6e\xa3\x78\xca\x7d\xd8\x89\x2c\x4c\x5e\x18\x1f\x88\x10\x2b\x9c\x7a\x6b\xe1\x43\x2e\x75\x2d\xa9\x47\x57\x3c\x8d\x98\x6d\x99\xeb\xdb\xc6\xc1\x0b\xac\x81\xc5\x8d\x9c\x1a\xc3\x94\x78\x49\xfe\x75\xf4\xeb\x57\x1f\x07\xc7\xdf\x1e\x1d\xbd\x7f\x3e\xf8\xfb\x87\xaf\x8e\x7e\x1d\xc2\x3f\xfe\x72\xfc\xed\xf1\xc7\xf0\xe1\xab\xe3\xe3\xa3\xa3\xf7\x3f\xbc\xf9\xee\x76\x74\xf9\x81\x1f\x7f\x7c\x2f\x8a\xf9\x9d\xfb\xf4\xf1\xe8\x3d\xbb\xfc\x80\x04\x72\x7c\xfc\xed\x97\x51\xd3\x6c\x94\x7d\xe0\xc2\x0c\xa4\x1a\xb8\x83\xdc\x52\xec\x61\xfb\x08\xdb\xfc\x98\xdb\xfb\x2e\xf0\xb0\x5a\xb5\xb0\xc0\x8d\x3f\xc1\x85\xeb\xc2\x42\xc3\x44\xf7\xc9\x41\x5d\x29\x9d\xa7\xb6\xa1\x38\xa8\x18\x07\x47\x6d\xf2\x4e\x29\x39\xd4\x55\xde\xf4\x9f\xc2\x00\x13\xc4\x65\xb7\xe4\xe8\xdb\xef\x30\x10\x8a\xcf\x0d\xeb\xae\x04\x57\x0b\xc3\x41\x8d\xb5\x1f\x30\xa4\xd5\x2d\x8c\xde\x18\xd4\x1b\x83\xfc\xd8\x93\x31\xe8\xa6\x0b\x1e\xf7\x96\xa0\xce\x0f\xe0\x67\x3f\x20\xb5\x02\xb2\x4f\xf2\xf6\x08\x9f\x3a\xc6\x95\xb1\xd1\xaf\x1e\x54\x2b\x23\x49\x2e\xf3\x02\xc2\x6d\x63\xbc\x82\xdb\x9d\x8d\xc3\x40\xd8\xab\xf0\x71\x7c\x6c\x91\x93\x2b\xe6\x9b\xfd\xc1\xe4\x0c\x92\x06\x1c\x61\xb7\x2f\x68\x85\x18\x1c\x88\x8a\x39\x5d\x09\x92\xc1\x85\x2b\xd1\x63\xaf\xd7\xaa\x9f\x74\x47\xc8\x74\x18\xda\x50\x65\xb8\x98\x0e\xc9\xcf\xf6\x79\xc7\xb3\xbd\xbf\x8b\x0b\x32\x2f\x32\xc3\xf3\xac\x4c\xa4\xc3\x05\x3a\x3a\xaf\x0f\xd5\x5a\x26\x9c\x86\x68\x2c\x9f\x37\xa2\x4d\xd8\x16\x58\x0d\x54\x3f\xca\x15\x4b\x58\xca\x44\x82\x70\xb1\xff\x04\xd5\x6b\xcb\xb3\x18\x5b\x95\x94\x5c\x8a\x85\x7b\x07\x25\x69\xe1\xc2\x4f\x1c\x33\xb6\xef\x68\xc7\xe5\x95\x39\x7c\xb2\xb0\x0a\x8b\xee\xde\x0d\xb7\x52\x19\xb1\x34\x3a\x20\x48\x01\x85\xe0\x11\x39\xa9\x2c\xec\xed\xc7\x1e\x27\x20\x95\xbe\x35\xb4\x50\xb8\x26\x19\x95\x73\xab\xa4\x6c\x90\x71\xf6\xe4\x0d\x8c\x13\x45\xe2\x4a\x09\x45\x30\x9b\x3f\x4c\x04\xd9\x8b\xf8\xd1\x45\xf4\xe8\x20\x76\xc4\x8b\x1c\x7b\xf1\x3d\x75\x15\x31\xe2\xc5\x8b\x48\xd6\x9f\x2b\x36\xe1\x0f\x1d\xee\xe1\x99\xd8\x94\xe3\xe8\x33\x14\x73\x26\xb0\x6b\x34\x92\x30\x9a\xcc\x80\xc0\x86\x6c\xea\xd2\x49\xfe\x29\xa3\x9f\x9c\x36\xd4\x9d\x28\xdd\x04\x1d\xad\xa7\x48\x3d\x45\xda\x31\xf6\x40\x91\x3c\xea\x59\x22\x13\xb1\xde\xcf\x8f\x1c\xed\x41\x0f\x80\xf8\xfb\xb8\x64\x80\x8b\x5a\xe4\x3e\xdc\xb4\xe8\xca\x68\x55\xf2\xd8\x29\x40\x69\x47\xed\xdb\x7a\xbd\xc7\x8a\xb0\x1a\xe9\x62\xf0\xc8\x8c\x4f\xed\x61\x67\x6c\xc1\x32\x2f\x41\xb5\xc2\x74\x05\xaa\x20\x06\xcb\xc8\x60\x42\xb6\x7a\xaf\xbd\x92\x8a\xa7\x0d\xe1\x1e\x37\x4d\x2e\xa0\x19\x53\x26\x69\x5a\x6b\x36\xa3\xa1\xcc\x28\xb9\x60\x79\x26\x97\x73\x1f\xd4\x9b\x12\xa8\x92\x3a\x29\xb2\x1b\x66\xda\x9d\xd4\xe8\x8b\x04\x33\x1d\x15\x59\x36\x92\x19\x4f\x5a\x6d\x6c\x2b\x85\x67\xe1\x4c\xf3\x22\xcb\x48\x0e\x8f\x0f\xc9\x5b\x01\xd4\xf0\x2c\xbb\xa7\x4b\x7d\x42\xae\xd9\x82\xa9\x76\xaa\x71\x35\xb9\x96\x66\xe4\x84\xec\x2a\x86\xcf\xee\xb3\x03\x44\xf8\x84\xbc\x74\xcd\x35\x88\xa1\x53\x94\xf2\x14\xfc\xaf\x27\xf6\x8c\xea\x2f\x70\x44\xee\x9e\xeb\x2e\xda\x04\x79\x34\xf2\x7e\x01\x6f\xb2\xa4\xdb\x7d\x7e\xb2\xa3\xcc\xf8\x84\x25\xcb\x64\x53\x2d\xbf\xe6\x58\xcb\x08\x95\x42\xfb\xa2\xb3\x33\x56\xc7\x73\xbd\xd4\x86\xcd\x77\x95\x2b\x6b\x4c\xd4\xaa\x6a\x5c\x10\xc5\x74\x2e\x85\x06\x9b\x7d\x75\x25\xca\xd9\x39\x95\x58\x87\xbd\x6f\x05\x8b\x3f\x9b\x18\xc6\x9f\x4b\x6d\x6e\xac\x7e\x8d\xe1\x1b\x4d\xac\x1f\x85\x47\xa1\xda\x0a\xcd\x32\x96\x12\x3e\x9f\xb3\xd4\xea\xd3\xd9\x92\xd0\x89\x41\xfa\xce\x68\xc3\x1e\xe0\xfa\x47\x41\x2b\x12\x27\x4e\xcc\xa8\x48\x33\xa6\xc8\x84\xf2\x4c\xe3\x58\xef\xaa\x8d\x01\x02\x39\xb8\x00\x45\xdf\xb9\x87\xc1\xa8\xc0\xa0\x2a\xb3\x84\x2e\x6f\x38\xb8\x92\xb8\x1e\x2a\xf0\x78\x75\xdb\x81\x67\xd6\x30\xc6\x09\x42\x28\x90\xd5\x34\xc7\x99\x4c\xee\x34\x29\x84\xe1\x99\x5b\xb8\x94\x77\xae\xcd\x1b\xdc\x29\xbc\x99\xb9\xd3\xb5\x2c\xff\x39\x28\x71\x74\x60\x67\xa0\x4f\xbf\xa8\xfe\x04\x5f\x60\x04\x99\x58\xe9\x93\x3d\x6c\x4b\x1e\x5f\x1f\x0d\x3c\xb4\x94\xd6\x1e\x29\x04\x30\x48\x51\x0a\xa1\x13\x69\x59\x5c\x8c\x25\xd9\xd7\xf6\x1e\xd7\x82\x56\x86\xe4\xf2\x81\x25\xe5\x67\x67\xf8\xa0\xc9\x8e\x62\x15\xeb\xc3\x48\x20\x09\x58\xf7\x46\x17\xbf\x10\x32\x3b\xb1\x3e\x56\xb2\xcc\xe1\xf9\x50\x39\xc5\x83\x23\x19\x17\x71\x6e\x0d\xa8\xf5\x05\xd9\x8d\x84\x0b\x6d\x65\x81\xc6\x45\x74\xf9\x7a\x5e\x62\x8f\x33\xd6\x73\xc5\x12\x23\xd5\xb2\x8c\x05\x0f\x73\xb4\x73\x56\x52\x1a\x72\x74\x78\x7a\x18\x13\xdb\x41\xd6\xcc\xa8\x87\xae\xc5\xa2\xa3\xf6\xce\xa6\xea\xdf\x12\x07\x56\x13\xcd\xe7\x79\xb6\x84\xbd\x38\x4c\x4f\x08\x37\x21\xbc\x5c\x15\xc2\xef\x4c\x5c\x0c\x83\x4b\x01\x3d\x21\x5a\x12\xa3\x68\x59\x59\x15\xbe\x85\x92\xf6\xaa\x70\xfc\x2b\x0a\xec\xd1\xe1\xc7\xc3\x13\xc2\x4c\x72\x4c\xee\xa5\x38\x34\x70\x34\x43\x72\xeb\x0a\xa0\x85\x97\x46\x81\x5c\xca\x02\x4a\xd2\xfa\xb2\x6f\x19\x4f\xb8\xc9\x96\xc0\x1e\x88\x2c\x8c\x4b\xd1\x8f\x0c\x61\xf0\xe9\xaf\x97\x0f\xdc\xf8\x18\x45\x7b\xc7\x9f\x03\xb6\x3a\x56\x41\x68\xdc\xc2\x33\xbe\x60\xa7\x33\x46\x33\x33\x73\xe1\x42\x42\x8a\xc1\x7f\x33\x25\x21\x89\x56\xf8\xbf\xc4\x38\x24\x91\xd6\xd5\xfa\xe8\xe8\xed\xc2\xaa\x28\xab\x4f\x44\xb8\x6d\x2c\xe3\xf8\x8e\xa1\x44\x02\xb2\x4a\x4a\xbe\xbf\xbd\x1d\x7d\xc7\xcc\x0a\xc9\xb4\x10\x43\x4c\x56\x0c\xe1\xcc\x99\x9a\x48\x35\xdf\x27\xed\x9c\x49\x8d\x5e\x29\x59\x5b\xad\xd4\xc6\xd5\x33\x72\x42\x9e\x70\x95\xf3\x3b\x86\x8b\xfa\xfa\x15\xb9\x4c\xc9\xd5\x68\x08\x0d\x5c\x73\x25\xc7\x74\x9c\x2d\xa1\x1e\x87\x4f\xc2\x8c\x82\x79\x60\xa7\x78\x60\x69\x9d\x3d\x83\xef\x19\x4d\xad\x66\xe5\xbb\x60\xc4\x60\x78\x27\x74\xad\xbd\xb3\x3b\x73\x72\x95\x19\x67\x7e\xea\x65\x22\x6a\x74\x98\xaf\xc7\xbf\x21\xe0\x68\x48\xcd\x56\x2c\x77\x24\xc4\xc3\xdf\xf3\xa5\x5f\xbb\x2b\x6e\x77\xca\x42\x42\xda\x0a\xc3\xb0\xe0\x48\xc7\xb3\x9b\x7e\xad\xb0\xb6\xdd\x21\x58\xa8\x45\xa1\xc8\xd8\x80\x6e\xb1\x29\xa4\x53\x7c\x05\xd9\x64\xf5\xf4\xab\x71\xa6\x0b\x94\xb3\x77\x75\x74\x8e\xc2\x8b\x4a\x58\xad\x8f\xdd\x8b\xd8\xd6\xba\x6a\xf7\xe8\xbc\x8a\xf8\x50\x00\x82\x77\xac\x37\x1f\x89\x5f\x59\x87\x98\x2f\xd2\x89\xf3\xed\x2e\x0d\xb7\x69\xac\x07\xc3\x1b\x19\x3a\xf7\xf8\x62\xe6\xf6\x52\xc5\xc9\x2f\xd0\xbb\x73\xef\xb4\x36\x97\x38\x2d\x3e\x8c\xe8\xc0\xd8\xee\x61\xb1\x9d\x83\x62\x37\xe4\xbe\xab\x5a\x0f\x04\xc7\x2d\x55\x1c\x47\x5c\x3b\xd0\x5a\x1c\xc5\xb5\x03\x1d\x69\xf2\x06\xe3\x4c\x68\x8f\x06\x2d\x73\x5e\xd8\xb7\x7c\xf3\x3f\xff\xe7\x5f\xff\xe7\xd0\x4d\xbb\x0b\x44\x2a\xc8\xd5\xd9\xf5\xd9\x6f\x37\x3f\x9d\x43\x02\x72\x0c\x06\x3d\x59\x24\x2b\xa4\xd4\x44\x51\xc3\xa6\x5f\x01\x1e\xaf\xd7\x54\xf6\x52\x52\x2c\x41\xf3\xb2\x91\x95\xd6\x9a\x96\x51\x7b\x1d\xf7\x7c\xb7\x62\x89\xe9\x20\x06\x29\xe3\x83\xaa\x92\xfc\x46\x26\x77\x1d\xe5\xf3\xc3\xdb\xf3\x91\x7b\xbc\x26\xa2\x53\x11\x6b\xd4\xe0\x62\x21\xb3\x85\xeb\x50\x75\x7b\x3e\x82\x05\x0f\xe1\x5f\x60\x21\x02\x65\x77\x69\xdf\x11\x72\x26\xd0\x90\x9d\x13\xce\x2a\xd0\xce\x94\x46\x89\x62\x34\xe3\xda\xf0\x04\xe0\x97\xc6\xa9\x08\x09\x5f\xde\x61\x7d\x6f\x9f\x5c\x83\x38\x7c\x1b\x1c\x77\x1b\x95\x89\xc8\x3b\xd2\x88\xe9\xaf\x2b\x13\x31\x81\x98\x3d\xf7\xf1\xa3\xc9\x7d\x3c\xd7\x51\x8d\xfa\xb5\x3d\xf7\xd9\x34\x9e\x88\xfb\x7c\x4e\x74\x37\xea\xe7\xb9\x62\x37\x46\xe6\x1d\x7c\x2a\xee\xc1\x2d\x1e\x95\x31\x9b\x60\x3d\x00\x74\xab\xfb\x23\xf5\x4d\x81\x05\x24\x64\xc6\xd8\x64\x64\xc3\xc5\xe1\x22\x3a\x43\x0b\xcb\x8c\x2f\x98\x60\x5a\x9f\x82\x63\xa4\xc8\x9d\xd6\x89\x02\x3b\xa1\x3c\x2b\x14\x3b\xb1\xbb\xc6\xe6\xb0\x13\x27\x55\xc2\x8b\x2f\xc9\x0e\x5f\x32\x93\xe0\xb0\xf0\xb6\xe6\x35\xf2\x96\xd7\xb0\x9d\xab\x4d\x26\x13\x45\xf5\x0c\xa9\x1e\x43\xaf\x4d\x6e\xb4\xb3\x0d\x2b\x46\xb5\xef\x9a\x10\xb6\x97\x4b\x68\xba\x98\x53\xbd\xab\xcc\x78\x7d\x04\xb1\xc6\x4d\xd6\x01\x1e\xc9\xf4\xf0\x50\x37\x80\x4e\x15\x4d\x18\xc9\x99\xe2\x12\x07\x17\xb2\xc3\x53\x79\x2f\xc8\x98\x4d\xb9\xd0\x1e\x77\xe0\x65\x01\xc9\x2c\x5f\x64\x3b\x3a\x41\x36\x47\x28\x5b\x38\x24\xef\x1a\xa5\xdd\x7c\x7a\x5a\x22\x2b\xa2\xe8\x57\xd3\xc5\x55\x07\x01\xb7\x80\x5a\x85\xaf\xbc\xef\x11\x17\x1b\xd1\x1c\x40\xb6\x6c\xe2\xba\xcf\x0e\x87\x01\xab\xe8\xd3\xe2\xb3\xc3\xa2\x55\xf5\xfc\xb6\x09\x83\x9f\x83\x41\x5f\x2e\xdc\x1d\xe8\xee\x9f\xef\x1d\x81\xbd\x23\xb0\x77\x04\xf6\x8e\xc0\xde\x11\xd8\x3b\x02\x7b\x47\xe0\xd6\xd1\x3b\x02\x7b\x47\xa0\x1f\xbd\x23\x70\x6d\xf4\x8e\xc0\xde\x11\xd8\x3a\x7a\x47\x60\xcb\xe8\x4d\xb1\x7e\xf4\x8e\xc0\xde\x11\xe8\x46\xef\x08\x2c\x1f\xe8\x1d\x81\xbd\x23\x70\xe3\xe8\x1d\x81\x3b\x9f\xec\x1d\x81\x31\x10\x3f\x03\xee\xf3\x39\xd1\xdd\xe8\x6c\x52\xc4\x4f\x83\xf7\x6c\x64\x35\xb4\xb8\xdc\xc3\x11\x78\x07\x78\xe2\xb4\x3b\x8b\x8d\xf5\x5c\x33\x07\xb6\xfd\xc4\xce\x9b\x0e\x18\xd7\x5a\xc2\x67\x48\x79\x97\x99\x83\x0f\x39\x58\x5d\x72\xd6\x62\xfd\x11\x21\x21\x54\x9f\xe6\xd2\xfd\x5f\xe5\x8d\xa8\xb9\x21\x9c\x4e\xdb\x46\xd4\x62\x88\x39\xd6\xff\x10\xe7\x7b\xc0\x26\x0f\xfa\x5d\xc3\xf8\x1d\x62\xfc\x09\xb1\xdc\x2c\xd2\x8f\x80\xf5\x21\xc4\x94\x9a\x7b\x7a\xff\x01\xd6\x77\x10\x53\x03\x10\xe9\x37\xc0\x3a\x59\xed\x40\xf8\x0c\xe2\x4d\xf2\xad\xfe\x82\x9a\x0f\x00\x0d\x74\x87\xaf\x60\x8b\xfd\x1f\xef\xc8\x5a\xf1\x13\xec\xb0\xfd\xe3\xcf\xaa\xf4\x11\x3c\xb1\xdd\x3f\xd2\xfc\xd7\x41\x08\x89\xb3\x78\x44\x71\x34\x1f\x7e\x71\x3b\x53\x4c\xcf\x64\x86\xba\xf3\x8d\xfb\xfe\x86\x0b\x3e\x2f\xe6\xf6\x1a\x68\x7b\x65\xf9\x82\x05\xa0\x3a\xdc\x34\xd4\x32\x1d\x87\x71\x86\x4b\x0b\x8c\xa7\x4c\xb1\x14\x60\xd9\x53\x9b\x18\xa6\xc8\x8c\x82\xae\xa1\x8b\x24\x61\x2c\xc5\xfa\xc2\xeb\x6a\xe2\x5f\x87\xe5\x8c\xcb\xce\x2f\x2f\x30\x60\x6a\x5d\x80\xff\xfa\x35\xfa\x14\xb0\x52\x6a\x84\xab\x65\x1f\x6e\x96\x38\x17\x4b\x2c\x3b\x89\x51\x8c\xf6\xe4\x56\x41\xba\x54\xbc\x9b\x24\x42\xfd\x7d\x8c\x3b\x25\x9a\x12\x74\x70\xa3\xa0\x5d\x28\xa5\x5b\x04\xbd\xf8\xa7\x70\x9f\x44\xd2\xce\xbd\xb8\x4d\x9e\xcc\x65\xd2\xcd\x5d\xf2\xc8\x9a\xb4\x8f\x77\x93\x74\xd4\x8a\x3b\xb8\x47\x9e\xda\x35\xf2\xc9\xea\x5a\x47\xed\x69\xac\x2b\xa4\x83\x1b\x24\xd6\x05\x12\xe3\xfe\x40\xba\x3e\x22\xdd\x19\xd1\x47\x15\x63\x48\x8a\x32\x22\x75\x33\x20\x75\x32\x1e\x21\xdd\x16\xd5\x06\xa3\x71\x66\xa7\xd1\x68\xd5\x10\x84\x86\xba\xd1\x60\xf4\x18\x23\xd0\x93\x18\x80\xe2\x5c\x0f\x68\xb7\x43\x8c\x36\xfa\x78\x97\x43\x24\xf6\xc4\x10\x29\xb4\xb9\x2b\x8a\xd0\x70\xc1\x0d\xa7\xd9\x05\xcb\xe8\xf2\x86\x25\x52\xa4\x28\xae\xb6\x52\x16\xb0\xc4\x75\xed\x40\x78\x39\xbe\x81\xbb\x38\xd1\x87\x6a\x12\x4c\x52\x3e\x98\x39\xd8\xb7\x3c\x9b\x86\x76\x2d\x6e\xd6\xa8\x0a\x56\x24\x3e\x4a\xf6\x49\xad\x52\x64\xef\x4a\x85\x0b\x1d\xee\x7a\x78\xdf\xcb\x7b\x22\x27\x86\x09\x72\xc4\x45\x38\xbf\xe3\x9a\xaa\x10\xa7\xd9\x95\x57\xc7\x42\x78\xf1\x3c\x00\x5c\x57\xc7\x50\x20\x3f\x03\x95\x0d\x94\x50\xad\x9f\x56\x73\xf6\x40\x9f\x46\x75\xf6\xc0\x26\x45\xd6\x50\x9f\x51\x20\x9d\xda\xdd\xa4\x77\x2f\xaa\xd2\xa9\x2f\x60\x7e\xe1\x06\xa2\x20\x52\x91\x12\x9f\x1e\xf2\x79\xea\xe0\x51\x0e\xd5\x08\x67\x6a\xe5\x24\xc5\xed\x13\xce\x91\xea\x1c\xa4\x28\x88\x08\x27\x2a\xda\x39\xfa\xc9\x74\xff\xa7\x72\x88\x3e\x81\x33\xb4\x97\x5d\xb7\x3b\x3d\x7b\xd9\x75\xc7\xf8\xe3\xa5\xb8\x5a\x3e\xd1\x77\x8a\x26\x6c\xf4\x28\x91\x20\x5c\x49\x92\x16\xca\xa7\xbb\x95\x92\x41\xb8\x58\xa8\xdd\x15\x8c\xa5\xee\x36\x96\x09\x5e\x90\xed\x34\x29\xb2\x6c\x49\x8a\x5c\x8a\x9a\xc3\xb1\x50\xc8\xa4\x99\xdb\x19\x5b\x4b\x9a\xb2\xb3\xda\x34\x5b\x7c\x79\x4a\xef\xfd\xf4\x6c\x59\x15\x42\x58\xe5\xc1\xe3\xa8\xa5\x24\x56\xee\xd4\x8e\xba\xd6\xb6\x1b\xa7\xda\xf0\xa9\xdd\x4e\xcb\x1a\x21\xfb\x8b\xcf\x59\xd5\x25\xa3\x7a\xa9\x7d\xc3\x44\xaa\x84\x8f\x91\x3e\x94\x19\xcd\xca\xa6\x16\x94\xdc\xf1\x2c\xf3\xaf\x1a\x92\x1b\x66\x88\x99\x71\xed\x59\x6f\x26\x05\x96\x90\x98\x19\x15\xa1\x97\x1c\x4b\x2c\xfc\x24\x63\x54\x14\xb9\x9b\xb7\x15\x08\x96\xb2\x50\x61\xde\xb8\x33\x83\x22\x9f\xe5\x6c\xb8\x26\x82\x67\x27\xb5\xce\x5a\x3b\x11\x18\xf5\x86\xe0\xd2\x2e\x34\x0b\x79\x7f\xf7\x5c\xb3\x93\xfa\x7b\x43\xf1\x62\x1c\x44\xd7\x79\xdf\x3e\x97\x2b\xb9\xe0\xa9\x6b\xf2\x11\xd0\x01\xfa\xf4\xba\x26\x20\xf8\x80\x8b\x31\x03\x1f\x94\x60\x53\x0a\x72\xa0\x27\xf0\xce\x8b\xe8\xde\xe5\xfc\x53\x22\x85\xd6\x21\xb8\x89\x6a\x48\x26\xae\xa5\x10\x2f\x38\xb4\xc7\xad\x63\x04\x39\x12\x92\x48\x10\x2a\x0a\xc1\x0d\x0e\xbf\x8c\x24\x7a\x56\x18\x92\xca\x7b\x71\x3c\x74\x85\xa6\xb9\x26\x94\x8c\x99\x09\x5d\x9b\x43\x1f\x4e\xae\x90\xb3\x65\x82\x8e\x33\x7b\xb7\x20\x0e\xe2\x76\xe3\xc1\x93\x09\xa3\xa6\x50\x8c\x4c\xa9\x41\x52\x85\x0d\xa2\xa6\x3b\xa3\xc7\xa3\x16\xd7\xde\x5e\x3c\x21\x85\xd0\x0c\x65\x3b\xaf\xc9\xb0\xdf\xfc\xed\xe9\x65\x58\x3e\x67\xb2\x30\x4f\xae\xb0\xdf\xcf\x78\x32\x8b\xd5\x47\xf8\x9c\x69\x22\x8b\x15\xab\xc9\x0b\x0f\xba\x54\x02\x50\x20\xeb\xa7\xf7\x9f\xac\xb5\xa3\xb9\x3b\xc6\x6d\xb0\xc1\xfe\xb8\x9a\xc1\x5c\x35\x68\xa6\xf6\xfe\x5e\x5c\xdf\xfc\xf6\xfa\xec\x9f\x97\xaf\xdb\x51\xf9\x92\x26\xb3\x7a\x99\x01\x41\x28\xd0\x3f\x90\xbb\x66\x74\xc1\x08\x25\x85\xe0\xff\x2e\x5c\xbd\x7b\x72\x54\xc2\x3e\x46\x44\x24\xc5\x56\x24\x47\x4b\xb8\xd0\x13\xbe\x53\x07\x2f\xd7\x4d\xde\x05\x34\x48\xcd\xa0\x15\xc7\x8a\x24\xdb\xbe\x69\xf6\x51\x17\x2e\x0b\xd2\xf3\x8c\x59\x5a\xc6\x17\xde\x71\xeb\x4b\x8d\xd3\xb4\x0c\xd5\xb0\x68\x6e\xb1\x0d\x23\x51\xd0\x31\x84\x4c\xcc\x18\x11\xcc\x58\xac\x2f\xad\x9d\x52\xe8\x46\x4d\x88\x42\x33\x7d\x42\xc6\x05\xc2\x0c\xa8\x49\xae\xf8\x9c\x2a\x9e\x2d\xeb\x93\xb1\xa2\xc4\xb5\x0c\xfa\xee\xb2\x5a\x52\xbb\xab\xdb\x2e\xf9\xe2\xed\xe5\x0d\xb9\x7e\x7b\x4b\x72\xe5\x2a\x49\x40\x94\x07\x6c\x09\x6c\xeb\x98\x59\x88\xbe\x69\x6d\xfb\xb6\x9e\x89\xa5\x7b\xd8\xd1\x29\xae\x89\x55\x72\x19\x48\x6a\x5e\xdd\x08\xad\x0a\x0e\x9e\x0f\xe1\x7f\xed\x4e\x5e\x9a\xa6\x8a\x69\x5d\x05\xdd\x24\x6b\x61\x72\x4e\xf3\xe1\xe3\x8c\xe1\x1a\xb3\xd4\xce\xe6\x13\xf5\xf0\x2a\x43\xfb\x46\x76\x7b\x6a\x3d\xbc\x68\x89\x24\x48\xbd\x03\x2e\xb8\xc5\xdd\x2c\x0a\xe3\xa3\xfb\x78\x55\xd3\xed\xd0\x33\xa7\xe2\x5f\x41\x39\xf5\x97\x55\x8a\x4a\xa8\x44\x2a\x80\x57\xa3\x80\x02\x5e\xc2\x99\xaf\xb4\x3a\x85\x57\x38\x77\x0e\x36\x14\xeb\x39\xf9\x07\x79\x20\xff\x00\x45\xf5\x1b\x9c\xfc\x12\xcb\x6d\x62\x45\x06\x67\xa3\xb9\x1a\x75\xd8\xed\x9f\xed\xa5\xb5\x4f\xdb\xbd\x32\x92\x8c\xb9\x57\x64\xd8\x83\x61\x4a\xd0\xf6\xc6\x76\x6e\xf8\xb3\xda\x4b\x07\x26\x3b\xbd\x3d\x21\x13\x78\x84\xb0\xc8\x34\xa9\xf7\xe2\x30\x7b\x41\x27\xfb\x92\xef\xa5\x36\xd7\xfe\x62\x73\x5d\x7b\x27\xd6\xd6\x55\xce\x6c\x4e\x4d\x32\x6b\xd2\x0f\x2b\x7a\x69\x53\x5d\x52\xec\x4d\x4a\x25\xd8\x2f\x5d\x40\xe0\x8c\x23\x55\xc3\xfd\x23\x3e\x3e\xf4\xa2\x81\x17\xbb\xce\x72\xc5\xd8\x84\x36\x02\xa6\x41\x56\x72\x85\x6e\x02\xb1\x1a\x3a\x31\xcb\x4e\xd4\x23\x08\x3a\x3a\x75\xa7\x34\xe6\x6d\x64\x31\x4e\x86\x70\x4d\xed\x9d\x4f\xa8\x70\x51\xe2\x13\xa6\x94\x8b\xf2\x1c\x2f\x21\x22\x80\x27\x0c\x79\xbc\x91\xf7\x38\x57\xd2\xc8\x44\x22\x3b\x5c\x79\x6e\xff\x92\xdc\x9e\xe3\x92\x34\x9b\x51\x0f\xfe\x5d\xb0\x3f\xce\x12\x1f\x1c\x1f\x3f\x5e\x8c\x4e\x2c\x50\xec\x7d\x92\x8a\xdc\x9c\xdf\x8e\x9a\x2a\xd0\xc1\xed\xf9\xe8\x60\x0f\xbb\x14\xd3\x43\xb7\xc1\x69\xdb\x05\x97\xa7\x6f\xa2\xb5\x62\x61\xb5\x02\xdb\x60\x4e\xf3\xc1\x1d\x5b\xb6\x8a\x0a\x71\xd3\x1f\x94\xc8\x13\x3d\x21\xb7\x98\x79\x4b\x5b\x46\xc5\x68\xca\xf7\x90\xc1\xe1\x2f\x54\x05\xbf\x5b\x2a\xc7\x5c\x2e\x58\xea\xe4\xea\x00\x91\x89\x34\x97\xdc\xca\x81\xbc\xbd\x81\xeb\x96\xfc\x8f\x3e\xbf\xa3\xcf\xef\xe8\xf3\x3b\xfa\xfc\x8e\x3e\xbf\xa3\x39\x8f\x3e\xbf\x63\xe3\xe8\xf3\x3b\xfa\xfc\x8e\x3e\xbf\x63\xe7\xe8\xf3\x3b\xfa\xfc\x8e\x3e\xbf\xe3\x91\x33\xef\xf3\x3b\x76\x8f\x3e\xbf\x63\xd7\x53\x7d\x7e\x47\x9f\xdf\xd1\xe7\x77\x6c\x1f\x7d\x7e\xc7\xe7\x12\x29\xd2\xe7\x77\xf4\xf9\x1d\x7d\x7e\x47\x9f\xdf\xd1\xe7\x77\xf4\xf9\x1d\x7d\x7e\x47\x9f\xdf\xd1\xe7\x77\xf4\xf9\x1d\x7d\x7e\x47\x9f\xdf\xd1\xe7\x77\xf4\xf9\x1d\xb5\xd1\xe7\x77\x7c\x56\x5a\x3b\x9a\xbb\x87\x16\x88\x71\xb9\x0a\x87\xe7\x72\x9e\x17\x86\x91\x77\xe1\xf1\x52\x48\x71\x34\x8c\xeb\x98\xe0\xed\xc7\x07\xfa\x24\x52\x4c\xf8\xd4\x33\xe8\x53\xd7\x6f\x6f\x50\xae\x6d\x50\xeb\x3b\xf7\x94\x51\x3e\x19\x9f\xf3\xf6\x2c\x0f\xe2\x82\xfc\x7d\xd4\xc4\x28\x52\xf3\x89\x10\xec\xbb\x88\xf5\x1d\x84\xfa\x9c\x1a\xc3\x94\x78\x49\xfe\x75\xf4\xeb\x57\x1f\x07\xc7\xdf\x1e\x1d\xbd\x7f\x3e\xf8\xfb\x87\xaf\x8e\x7e\x1d\xc2\x3f\xfe\x72\xfc\xed\xf1\xc7\xf0\xe1\xab\xe3\xe3\xa3\xa3\xf7\x3f\xbc\xf9\xee\x76\x74\xf9\x81\x1f\x7f\x7c\x2f\x8a\xf9\x9d\xfb\xf4\xf1\xe8\x3d\xbb\xfc\x80\x04\x72\x7c\xfc\xed\x97\xa8\xe9\x3d\x81\xc0\xdd\xc4\xf5\xd7\x70\xca\x35\xe7\xa0\x65\x7d\x73\xfa\x00\xec\x80\xce\x65\x81\x6f\xeb\x98\xf8\x6b\x53\x62\xa6\x73\x7c\xfe\xa1\x08\x4f\x62\x95\x01\xef\xe1\xed\xf1\xfe\x3f\x1c\xef\xdf\xf9\x73\x5e\xc5\x7c\x2f\x08\x3d\x16\xf3\x03\xc3\x80\x7c\x82\xf2\x5d\x48\xc3\xad\x9c\x73\x63\x55\x07\xab\x2d\xd0\x7a\x48\x1f\x37\x0d\x33\x8d\xbb\xbb\x38\x29\x6c\xe2\x62\xc4\xb8\xae\xb7\x12\xac\x45\xe0\xcb\x20\xf3\x63\x5d\x3e\x54\x54\x36\x33\xb8\xa7\x83\x94\x4d\xb8\x60\xde\x47\xfd\xe7\xb9\xf3\xe8\x9f\x6a\x96\x14\x8a\x9b\xe5\xb9\x14\x86\x3d\xb4\x1a\xaf\x9a\xf8\x76\xd3\x7c\x98\xb8\xcd\xf2\x19\x8b\xfe\x6f\x44\xe6\xb8\xc6\x93\x2b\xe9\xa7\x65\xaf\x55\x55\x08\xd0\x67\x01\xeb\x34\x33\x4e\x49\x04\x55\xa3\x1d\xa8\x9c\x90\xd5\x49\x06\x95\xcf\x69\xb4\xff\x2e\xf8\x82\x66\x56\x89\xc7\x43\x1c\x81\xb2\x50\x07\x8a\x45\x0c\x43\xf5\x5d\x85\x15\x6c\x60\x45\xd2\x72\xcd\xa7\x61\xcb\xe0\x2b\xf6\x60\x9e\x54\xf2\x01\xb6\x35\x52\x7c\xc1\x33\x36\x65\x97\x3a\xa1\x19\x60\x65\xbc\xaa\x70\xb6\x05\x12\x9c\x9e\x92\x19\x52\x3b\x9f\x31\xe8\xb4\x4c\x83\xd9\x00\x72\x47\xa6\x94\x0b\x32\xb7\x7b\x99\x87\x17\x68\xb0\x3f\xe0\x08\x82\xd1\x24\xa7\xca\x9e\x66\xb0\x45\x38\x15\x75\x2c\x65\xe6\xe3\x80\xb3\x65\xdc\x3c\x7d\x9b\x03\x21\x7f\x13\xec\xfe\x37\x3b\x2b\x4d\x26\x19\x9d\x96\xe6\x05\xcd\xcc\x9a\x65\x13\xab\x2c\xb9\x29\x6e\xdd\x50\x68\x60\x5a\x30\x42\xb3\x7b\xba\x44\x6f\xeb\xca\x5c\x08\xd7\x2f\xc9\x8b\x63\xb8\x47\x54\x93\xf2\x3d\x29\xf9\x1a\x17\xf2\x3b\xa3\x9a\x9c\x9f\x8d\x7e\xbb\xf9\xe5\xe6\xb7\xb3\x8b\x37\x57\xd7\x78\x5a\x65\xf7\x9d\x21\x0e\x2f\xa1\x39\x1d\xf3\x8c\x63\x85\x8d\xb5\x78\xa2\x3a\x00\xb0\x4c\xa7\xe9\x69\xaa\x64\xee\xf6\xc3\xdb\xee\x50\xab\xad\x68\x72\x53\x7b\xad\xa7\x0a\xc3\x91\x4f\x1a\x2f\x45\xc1\x9e\x2a\x2a\x4c\x65\x34\xaa\x8e\x48\x15\xc2\x2a\xcd\xfb\x88\xc1\xa4\x69\xb7\x70\xfe\xb3\x34\x65\x69\xfc\x12\x1f\x17\xd9\x77\x1e\x5e\xb7\xac\x32\x92\xc9\xe8\xed\xcd\xd5\xff\xee\x32\x13\xe2\xf1\x10\xef\x32\xda\x77\x84\x36\x21\x16\x29\x3b\x9d\xc7\x3b\x9f\xad\xd3\x9f\x08\xea\x91\xbd\xc4\xcc\x97\x3c\x29\xde\xe7\xff\xae\x10\xcd\x9a\x18\x15\x2c\x32\x97\x29\x1b\x92\x51\xb0\xfa\xe3\xd8\x52\x03\x42\x45\xb4\xc0\x65\x60\xc1\x08\xc3\x69\x96\x2d\x6b\x12\x0e\xd6\xc0\x0b\x19\x31\xf5\x4c\xe6\x06\x1d\x9c\xd0\x4c\xa3\x08\x55\x1c\x07\xb0\xcc\xf0\x8d\x55\x4f\xa2\x77\xb6\x7c\x92\xa4\x4c\x48\xe3\xc5\x4f\xfb\x76\x48\xd0\x56\x32\x41\x2d\x1c\x74\xa3\x7a\xc0\x58\x83\x42\x6b\x67\x90\x0f\x0c\x00\xa9\xf3\xf8\x7d\x1b\x95\x33\x74\xd6\xd4\x42\x33\xbd\x99\x01\xe0\x48\x48\x38\x0d\x3b\x4b\xc5\x68\x0a\xd9\x67\x39\x35\x33\x0d\xb6\xf7\x39\xd5\x77\x2c\x75\x5f\x60\xfd\x67\xbc\xd4\xee\xdc\xcc\xca\x29\xdf\xda\x7d\x0c\xb6\x77\x90\x7b\x90\xaa\xd4\x98\x39\xbb\x3e\x2e\x22\x2b\x32\x9b\x95\xa6\x6f\x45\xb6\x7c\x27\xa5\x79\x55\x26\x5e\x45\x23\xce\xcf\x5e\x02\x6d\xda\x3e\x41\xdc\x81\x70\x89\x74\x60\x37\x16\xb5\x58\xb8\x32\xf5\x1c\xb0\x8b\x12\x51\xf6\x75\x61\x54\x21\xce\xf4\x77\x4a\x16\x28\x76\xb2\x26\x30\x7d\x77\x75\x01\x77\xbd\xf0\x9e\x3d\x61\xd4\x12\x12\x3f\xbd\x8f\x3f\x4e\x56\xaa\x64\xd9\x1f\xbd\xaf\x14\x3c\x83\xe5\x75\x89\x70\x93\x10\xf2\x86\x2e\x09\xcd\xb4\x0c\xc2\x35\x17\x1b\x35\x2e\xaf\x0e\x62\xa9\xe5\x58\x9a\xd9\x9a\x2e\x68\xaf\xcb\x3a\x6c\x5c\x7a\x5d\xe5\x0c\xac\x4a\x25\x71\xb1\xf6\x0a\x43\xef\x98\x26\xb9\x62\x09\x4b\x99\x48\x50\x98\xb0\x5f\x57\x11\x60\xce\xb5\x14\xf6\xfa\x44\xe3\xce\x55\xf0\x43\x3a\xd3\x4b\x93\x8a\x41\xe4\x85\x2a\x70\xca\x1a\x5c\x33\x21\xc5\x00\x2e\x4f\xa1\x99\x02\x0d\xdf\x2a\x3c\x4e\xc5\xff\xa1\x18\xb3\x8c\x19\x50\xb4\xb0\xae\x22\x9e\x52\xe3\x34\x7b\x3e\xa7\x53\x46\xa8\x29\x91\xd1\x48\xc2\x84\xb6\x64\xcc\x19\x8d\x70\x98\x93\x4a\x56\x65\x61\x52\x4d\x7e\xbc\xba\x20\xcf\xc9\x91\x9d\xf3\x31\xa0\xcf\x84\xf2\x0c\xdc\xa2\x86\x22\x5b\x0b\xae\x68\x67\x13\xb0\x80\x49\xa6\x61\xf9\x70\x4f\x88\x54\x8e\x6c\x9c\x10\x81\xa3\xb6\xba\x48\x66\x61\xfd\x56\x73\x0c\xca\xa9\x8f\xd8\x04\x73\x75\x75\xad\x70\x20\x5b\xaf\xde\xb6\x6b\x85\x34\xb5\x6d\xbc\x7a\x5b\xae\x15\x0a\xe4\x53\x5c\xbd\x0e\x44\xf8\x47\xcd\x54\x27\x1a\xfc\xe3\x9e\x68\x70\x5d\x5c\xb3\xf7\xaa\xda\x49\x2c\xb1\x74\x97\x67\xce\x0c\x4d\xa9\xa1\x9e\x7e\x57\xb9\xe9\x3d\x2a\xfd\xf1\x54\x5c\xb3\xd7\x5c\x14\x0f\x2e\x32\xab\x9b\xd1\xe4\xe6\x12\x40\x90\x24\x2c\x12\x58\x2e\xcd\xf3\x8c\x43\x02\x77\x3c\x35\x0b\x24\xac\x5e\x04\xa7\xab\x98\x0b\x24\x8c\x66\x99\xb4\xec\xc6\x4a\x64\x54\xa4\x72\xbe\x36\x69\x2b\x04\x33\x9a\xcc\xe2\x2e\xcb\x30\x46\xcc\x88\xc1\xf1\x3f\x93\x98\x11\x6b\x4a\xca\xd8\x82\x21\xcb\xec\xac\x56\x4a\xb4\x4f\x5a\x61\x38\x9c\x1e\x80\x22\x19\x1d\xb3\x0c\xd8\x31\xda\x20\xe0\xb0\xb3\xb4\xc8\x45\x84\x2c\xb8\x11\x6d\x50\x50\x32\xeb\x96\x82\xf4\x4e\x66\xcc\xc5\x46\x85\x45\x5b\x50\x7f\x8a\x35\xc3\x03\x5d\xd6\x0c\xda\x62\x63\xcd\xa0\x87\xff\x19\xd6\x5c\x20\xb9\x38\x59\x5d\xb3\x65\xff\xcd\x35\x03\xcf\xfd\xfc\xd7\x1c\x65\xfa\xd2\x2c\x49\xe4\x3c\x1f\x29\x69\xd5\xdc\x4e\xec\xc6\x83\x08\x0e\xc1\x60\x6b\xf1\xd1\x47\xb1\xf4\x1b\xe4\x86\x26\x40\xaa\x6a\x81\x99\xc8\x7d\x07\x7a\x1d\x22\x38\xff\x47\xbd\x49\xb6\xa5\x50\x27\x1d\xdc\x3a\x61\x36\x0d\x3f\xa3\x85\xee\xff\xb0\x17\xca\x2c\x13\x9a\x41\x41\x43\xfc\xf9\x90\xd5\x33\x5a\x05\x52\xc5\x9c\x3a\x27\x9d\xfd\x2e\xc2\x88\xec\xfc\xe6\x50\x1c\x14\xa0\x79\x8b\xa2\x90\x29\xab\x79\x77\x0b\x54\x19\xd7\x30\x6e\x5d\x74\x34\xc0\x0b\x61\xfe\x96\xe7\x05\x87\x6a\x5a\x7f\x0b\xde\xc8\x2c\x7d\x31\x9b\x37\x65\xe1\x45\xbb\x31\x4c\xa4\x5c\x4c\xc1\x8e\x76\x42\x14\xcb\x20\x40\x37\x06\x28\x04\xdc\x3a\x25\xf6\x10\x2c\x4c\x61\x92\x01\x71\x63\x37\x15\x64\x21\x2e\x85\x9f\x29\xd8\xfd\x82\xf4\x32\x71\xa4\x96\x6b\x72\xf0\x3a\x1c\x24\xb2\x94\xdc\xa7\xe4\x10\x07\x6e\x96\x25\x66\x39\x8b\xe8\x1d\x17\xa9\x8f\x3d\xb5\x1b\x83\xde\x90\x80\x0b\x65\xd9\x5d\x27\xbb\x42\x14\x36\x4f\xeb\x84\x01\xef\xfe\xf8\x55\x90\x72\x03\xc9\xa0\x42\xfd\x75\x94\x46\x83\xdc\x81\xfa\xe4\x9d\x13\x89\xbd\xce\x86\x06\x39\xd8\x2c\x59\x97\x26\xb7\x30\xe9\x88\xfa\x63\xc4\x77\xe6\x87\x69\xfd\x28\x00\x5f\xed\x7a\x07\x44\xc8\x15\x78\x58\xc5\x8f\x94\x5c\x2d\x1d\x62\x8b\xab\xec\x35\x61\x1a\xe9\x7c\x8a\x62\x8d\xf7\x5c\xa4\xf2\x5e\x3f\x46\x13\xfb\xd9\x81\x08\x22\x7e\x62\xaf\xb4\xe1\x62\xaa\xc3\xee\x61\x7d\x37\x34\xcb\x1a\x4e\x8b\x4d\xea\x18\x36\x20\xc7\x8e\xb2\x94\xf9\xba\xfa\xb2\x92\x5f\xf1\x9f\x6b\x8d\x8d\x65\xc6\xd3\xb9\xa6\xe7\xca\xc2\x37\x9c\x66\x37\x39\xae\xae\x20\x59\xc5\x8b\xef\xde\xdc\x9c\x35\xc1\x58\xe2\x7e\x0f\x55\xd2\xf1\xa5\x59\x09\xc0\x21\x34\x9d\x73\xad\xc1\x32\xc8\xc6\x33\x29\xef\xc8\x51\x08\x53\x9a\x72\x33\x2b\xc6\xc3\x44\xce\x6b\x11\x4b\x03\xcd\xa7\xfa\xd4\x63\xf6\xc0\xae\x08\x5f\x1e\x8e\x8b\xac\x8c\x02\x03\x2d\x5d\x18\x1d\x32\x07\x61\x32\x49\xb9\x2a\x34\x4c\x7b\xa8\xbe\xd8\xad\x0f\x9e\x58\xdf\x1e\x57\xb8\x96\xb3\x6c\x7f\x75\x98\xd6\x8f\xf6\x3a\xa2\x88\x4f\xcb\xf1\xc2\xfc\x7d\xe6\x5a\x54\xdd\x99\xcd\x7b\xeb\xf6\xcc\x09\xd9\xfb\x2b\x4c\xe5\xc4\xc5\x84\xe9\x6e\x85\xa9\xbe\xaf\x9e\x27\x29\x73\x89\x39\x4c\x5b\x69\x86\x46\x8a\xdd\x76\x34\x83\x06\xc1\xc1\x70\x08\x39\xc4\xfe\x15\x87\xf1\x3a\x95\x77\x8e\xba\xcc\x26\xab\xe5\x65\xf9\x8c\x0e\x9c\xf9\xc2\xd2\x2b\x20\x82\x5e\x10\x43\x83\x9c\x49\x21\x7d\xe2\x85\x15\x77\xa4\x80\x2b\x02\x6e\x09\xe7\x33\x85\xf3\xf4\x4c\xa1\xb6\x45\xe7\xb1\xf5\xb5\x49\xc3\x75\x0b\x39\x80\xae\x02\x4a\xb5\xa6\x7b\x6e\x66\xbe\x3b\x44\x27\xa0\x6e\x07\x14\xd3\xe0\xe8\x13\x84\x29\x25\x95\x76\x71\x57\xc1\x21\x11\x51\xa4\xc9\xf3\x1a\x08\x8a\xb3\x88\x4d\xed\xa7\x43\x5d\x8f\x70\x28\x2b\x57\x47\x01\xd5\xf6\x76\xb1\xc9\x84\x25\x90\x67\x57\x47\x3c\xc7\x37\x8e\x38\xda\xaf\x6f\x87\x90\x26\xa4\x02\xd8\x4b\xe6\x2b\x69\xcf\xf9\x83\x9d\x75\x1d\x7a\x74\x51\x74\x52\x56\xa6\xdc\x0c\xe6\x78\x48\xc8\x95\x28\x03\xf5\xf1\xc5\x40\x79\x73\x62\x21\xba\xd0\xd8\xa3\xaa\x97\x87\x47\x27\x2f\xc2\x5c\x6b\x26\x54\xab\xf3\xa8\x22\x92\xda\x60\x7d\x2d\xa4\xee\x6f\xe9\x4c\x76\xc1\xef\xe2\x01\x58\x74\x0d\x92\x97\xf3\xc3\x44\x61\xd4\x9a\xbf\x66\x97\x2f\x26\x56\x6f\x6c\xfa\x6d\x36\xfa\x64\xd0\x20\xb7\xf9\x6e\x76\x98\xbd\xd1\xb0\x77\xbb\x6f\x1e\x25\xd2\x91\x48\xb1\x2e\x42\x6b\x8c\x11\xff\xc8\x5e\x2d\x6b\xf8\x40\x7d\x57\x41\xa5\x43\x21\xf1\x9b\xda\x93\x35\xcd\xbb\xf4\xdf\x8f\x64\x4a\x66\xb4\x7d\xfb\xaa\xaa\x32\xd0\xf1\x07\xaa\x44\xf1\xff\x0e\xb2\x7f\xa5\x63\x08\xe9\x12\x2f\xb0\x55\x14\x21\x62\xcd\x55\x7d\x4e\x89\x55\x63\xb3\x10\x92\x33\xcf\x33\x2b\x8c\x36\xde\x3c\xc4\x38\x64\xe0\xf9\x5a\x65\xf2\x93\x72\xa1\x55\xf1\x73\x5f\x54\xea\x84\xfc\x1f\x0c\xb9\xa3\x3a\x44\x82\x87\xda\x37\xa3\x12\xbc\xdd\x02\x90\x12\x7c\xf7\x03\x48\x0d\x46\xb8\xcf\xbc\xad\x92\xa4\x7c\x32\x61\x21\x6a\x7d\xcc\x48\x4e\x15\x9d\x5b\x61\x48\x13\x7f\x44\x63\x36\xe5\xb8\x10\xe6\x92\x65\x1e\xea\xaa\xc8\xcb\x89\x63\xc7\xdc\x90\x39\x9f\xce\xdc\x75\x21\x14\x4a\x00\x10\x94\x3f\xce\x48\x92\x49\x9a\x12\xa0\x3c\x52\x91\x7b\xaa\xe6\x56\x42\xa3\xc9\x0c\x82\x35\xa8\x20\x69\xa1\xa0\x42\xaf\x61\x34\x5d\x0e\xb4\xa1\xa6\x1d\xac\xd5\xab\xbc\x41\x2b\xec\x5f\x5f\x3e\xbe\x2f\x1f\xdf\x97\x8f\xef\xcb\xc7\xf7\xe5\xe3\xd7\xe6\xf1\x59\x85\xc2\xf7\xe5\xe3\x3f\x8b\xd2\x75\x7d\xf9\x78\x3f\xfa\xf2\xf1\x3b\x46\x5f\x3e\xbe\x2f\x1f\xdf\x97\x8f\x7f\xe4\xcc\xfb\xf2\xf1\xbb\x47\x5f\x3e\x7e\xd7\x53\x7d\xf9\xf8\xbe\x7c\x7c\x5f\x3e\x7e\xfb\xe8\xcb\xc7\x7f\x2e\x85\xe8\xfa\xf2\xf1\x7d\xf9\xf8\xbe\x7c\x7c\x5f\x3e\xbe\x2f\x1f\xdf\x97\x8f\xef\xcb\xc7\xf7\xe5\xe3\xfb\xf2\xf1\x7d\xf9\xf8\xbe\x7c\x7c\x5f\x3e\xbe\x2f\x1f\xdf\x97\x8f\xaf\x8d\xbe\x7c\xfc\x67\xa5\xb5\x47\xc4\x91\xa5\xbc\xb5\xe8\x27\xa6\x4e\x92\x0f\xb6\x0e\x19\xfa\xad\xeb\xa1\x64\x5c\x4c\x26\x4c\x01\xc1\x87\x59\x04\x66\xb8\x5e\x07\x31\x90\xf7\x76\x6b\x93\xd3\x70\xa0\x2c\xac\x62\x34\xd5\x2e\x63\x65\x0b\x78\x54\x25\x19\x57\x6c\xb3\x16\xd1\x7c\xf9\xf6\x55\x7c\x41\x27\x7c\x6c\x2b\xcc\xf5\xad\x48\xe2\xa2\xfa\xaa\x43\xd9\x94\x05\xe6\xcf\x26\xc9\xa4\x46\x04\x77\xcd\x98\xdf\xb0\x64\x46\x85\x60\x41\xeb\xe7\x06\xac\x67\x63\xc6\x04\x91\x39\x13\x8e\x5d\x51\xa2\xb9\x98\x22\x92\xdf\xa8\x31\x34\x99\x0d\xed\x4c\x45\x38\x90\x2a\xe2\xd8\x7f\xa3\x8d\x62\x74\x1e\x62\xc8\xe7\x14\x11\x43\x63\xa7\x42\x68\xa2\xa4\xd6\x64\x5e\x64\x86\xe7\x19\xf3\x2f\x23\x9a\x41\x92\x8b\x4b\x7c\x2a\x37\x16\x83\x45\xb5\xf0\xe5\x93\x6a\xb6\x7e\xd9\xb2\x5e\xbb\x0f\x6c\x11\xed\x21\x34\x5c\x13\x36\xcf\xcd\xb2\x8c\x68\x64\x64\xc2\x95\x36\x24\xc9\x38\x08\x7d\x30\x63\x97\xed\x0d\xef\x6b\x07\xe9\xc5\x3d\xe1\x77\x4a\xfb\xad\x10\x29\x68\x52\xb9\xd1\x2e\x3e\xaf\x7a\xa1\x7b\x55\x2b\xdc\x94\x6b\xaf\x1d\xeb\x13\x42\x43\x95\x3a\x87\x48\x61\x27\x00\x95\x02\x73\x86\xb7\xb7\x82\xf5\x8f\xd4\xa6\x53\x2b\x49\x5b\x05\x60\x96\x97\x1d\xd2\x09\x30\xfb\xea\x4b\x23\xd1\xf5\x78\xeb\x10\xd6\xba\x4a\x0a\x5a\xa1\x02\x02\x0a\xb6\xb0\x77\x88\x25\xcc\x8a\x52\x74\xcb\xcd\x7f\xb2\x8b\x5f\x93\x23\xde\x30\xad\xe9\x94\x8d\x10\xee\xb6\x6d\xc6\x0e\xf0\xb8\x55\x87\x07\xe8\x96\x81\xe5\x03\xbe\x41\x51\x81\x2a\x24\xad\x29\x41\x93\xb9\x9b\x5f\x29\x13\xdf\x2b\x6e\x0c\x6b\xdf\x56\xae\x5d\x5d\x45\xf0\x8d\xaf\x96\x35\x38\x5c\x09\x7c\xf3\x9b\xd0\x7e\x56\xee\xe5\x16\xb8\xe5\x8d\x22\x75\xe1\x62\x63\x46\xc6\x8a\xb3\x09\x99\x70\x88\x55\x83\x68\xaf\x13\xa8\x8c\xd5\x7e\xb3\x9c\xd9\x4d\x6b\xa6\x60\xbd\x5e\x7d\x0c\xeb\x1e\x92\x9f\xfd\xc2\x8d\x2a\x84\xe5\x76\xed\xea\xaa\x97\xec\x21\xe7\x97\x4f\xc8\x14\xa2\xc8\x94\x53\x86\xfe\xf6\xfc\xef\xdf\x90\xf1\xd2\x4a\x1d\x20\xa8\x1b\x69\x10\xb9\x70\xe1\x10\x32\x26\xa6\xf6\xac\x1d\x09\x6c\x66\x7c\x96\x27\x04\x8d\x4e\x10\xd3\xb4\x82\xd5\xd7\x77\xe3\xa6\xa8\x75\x9a\xb2\xc5\x69\xed\xfc\x07\x99\x9c\x0e\x7d\xcf\x97\xf6\x85\x57\xd1\xbb\x6d\x42\x11\xda\xbc\xb4\xe1\xaa\xc8\x8c\x27\xcb\xa8\xcb\x12\x4a\xd7\x91\x99\xbc\x77\x6a\xf3\x06\x0c\x47\x06\xdc\x8e\xad\xce\x96\x17\x99\x0b\x52\x7e\x55\xe6\xa0\x17\x9a\xad\xe7\x3d\x6e\xbc\xe7\x98\x93\x09\xaf\x58\xad\x8d\xef\x02\x19\xc3\x94\xa5\xcf\xbd\xf0\x66\x7b\x14\x1b\x09\xf6\x11\xf2\x8a\x66\xd9\x98\x26\x77\xb7\xf2\xb5\x9c\xea\xb7\xe2\x52\x29\xa9\x9a\x6b\xc9\x28\x42\x19\x4d\x66\x85\xb8\x73\x6d\x23\xca\x0a\x1a\x72\x6a\xa5\xf8\xbc\x30\x21\x74\x7e\xc3\x86\xb7\x02\x76\x95\x28\x02\x33\x0d\x16\x8f\xea\x2d\xec\x81\x57\x26\x0b\x9f\x7e\xd6\xae\x4f\xd9\x5b\x57\x9f\x9f\x0e\x17\xc6\x6e\xfb\xd7\xcf\xff\xf6\x5f\xee\x7a\x12\xa9\xc8\x7f\x3d\x87\x20\x64\xdd\xce\x9f\x81\xd0\x02\x0f\xb1\x02\xc5\x9c\x66\x99\xd5\xc4\xeb\x17\xcb\x22\xca\xb0\x6a\x9e\xd4\x0a\x31\x5c\xa4\x27\xbb\x47\x26\xee\xca\x3c\x99\xe8\x7f\x7b\xfb\x0b\xc8\xfd\xdc\x68\x96\x4d\x4e\x5c\x06\x52\x59\x71\xf6\x10\x98\xf4\xa1\xa7\xe2\xb8\x14\xb2\xfd\x09\xe4\x0b\x99\x15\x73\x76\xc1\x16\x3c\xb6\xc9\x56\xe3\xc9\x60\x45\xcc\xb8\x86\x04\xb1\x71\x26\x93\x3b\x92\xba\x3f\x62\x6e\x7f\x88\x2a\x5b\xad\x16\xdf\xb6\x52\x64\x6c\xdc\xd6\x99\x37\xa2\xe2\xe6\x34\xcf\xb9\x98\x42\x5e\x09\xc6\x72\x4d\xef\x1b\x0b\x85\x7b\x09\x05\x33\x22\x32\x6f\xe3\x7c\x45\xee\x45\x18\xd1\x69\xc3\xba\xab\x87\xc3\x71\xe5\xf0\x6f\x17\x2f\x2f\xb1\x39\x76\x4d\x92\x54\x66\x56\x95\x7b\xe0\x78\xb2\xdd\x4b\x64\x29\x07\x8b\x00\x38\x33\x55\xa4\x8b\x06\x1f\x35\xd8\xd8\x27\x51\x7a\x46\xe6\xd4\x78\xf9\x32\xb8\x74\x28\xc9\x99\xd2\x5c\x5b\x86\xf7\x13\xe0\xd1\x79\x46\x39\x36\x92\xb2\x32\x88\x3f\xfd\x72\xf1\x7e\x94\x41\x0d\x13\x10\x3f\x46\xc5\xf5\x45\x67\x01\xb6\xc7\xe5\xb9\x6b\x0a\x85\xb9\xe3\x28\xd3\x48\xa6\xfe\x61\xe0\x43\xae\xd8\xf9\x06\x91\xbc\x9d\x30\xd5\x25\xf6\xf5\x2e\x80\x7b\x20\x4d\x3f\x55\x6b\x6e\x52\x26\xfb\x4d\x04\x69\x72\x70\x3e\x01\x41\x82\x89\x75\xa4\x47\x40\x89\xfc\x14\x9b\x44\x25\xa8\x76\x11\x14\xc9\x1d\x78\xad\x2a\x82\xd7\xc5\x86\xc4\xc5\x63\xd8\xb3\xf3\x2f\x40\x42\x3d\x7c\x79\xb8\x17\xaa\xe4\xb6\x4c\xc9\x9c\x4e\xd1\x4d\x89\x56\x76\x6e\x15\x44\xbd\x9c\x84\x15\xf8\xe1\xef\x58\x67\xb3\xaf\x24\x07\xb0\x58\x5a\x55\xc1\x81\x9a\x50\x2e\x98\x20\x1c\x8b\x40\x13\xf4\x19\xf3\x79\xb1\xf7\x74\x49\xa8\x92\x85\x48\xbd\x75\xac\x34\x61\xbe\x59\x59\xc4\xb5\x14\x58\x4f\xb6\xf7\x29\x0c\x57\xea\x55\x80\x63\x85\x0b\xf2\x62\xf8\xe2\xf9\xe7\xc4\x50\x60\x96\x2b\x0c\xe5\xba\x64\x28\xee\xae\xee\x65\xbe\xa1\x79\x40\x87\x39\xbf\xf1\xa6\x8c\xb2\x37\x00\xe8\x35\x60\x31\x84\xaf\xee\x15\x47\x08\xc3\x6e\x54\xad\xe9\x8e\x40\x7c\xb5\x9a\x46\x2d\x4f\xff\xb8\xa1\x37\x20\x61\xa2\x3b\x0d\xc4\x97\x5e\xd0\xc5\xf8\x89\x28\x9a\x27\x4a\x70\xa5\x62\x09\x5a\x3d\x65\x72\x3b\x71\x8b\x2c\xb9\x60\x24\x39\x38\x20\x47\x0e\xde\xa1\xcb\xe8\x3c\xde\x0b\xea\xf9\x4d\xbc\x7c\xc8\x91\x95\x46\x1b\x1b\x79\xf9\x90\x53\xb0\x75\xe5\xdb\x77\x14\xb9\xe4\xca\x46\x88\xda\x51\x24\xd4\x7f\xb2\x19\x5d\x30\x4d\x34\x9f\xf3\x8c\xaa\x6c\x69\xb7\xf6\xc6\xad\x99\x8c\x0b\x43\x98\x58\x70\x25\xc5\x1c\xdb\x8b\x86\x90\x05\x55\x1c\xea\xe1\x28\x06\x19\xf2\x56\xad\xfa\xf2\xe8\xa7\xb3\x77\x10\x91\x73\xec\x4b\x07\xb8\x7d\x41\x82\x2c\xb4\x2b\x80\xd3\x5c\x7b\x6d\x6a\x4f\x84\x40\x61\xe5\xf6\xb4\xc1\x6c\xe1\x3f\x47\x70\xa0\x79\x61\x0a\xd7\xc3\xe7\x21\xc9\x0a\xcd\x17\xfb\xa0\x88\x31\x32\x72\x29\xd9\x7c\xc6\x22\xb2\x4f\xef\xbe\xe0\xad\x77\x6c\x25\xfd\xbc\x42\x06\x0f\xa2\xca\xfc\x06\x17\x05\x30\xe9\xb2\xca\x45\x9c\x3d\x3d\x38\xe6\x0e\x75\x59\xa0\xb1\x1e\x5e\x72\x82\xa4\x84\xae\x7a\x83\x0b\xd6\x0d\xb5\x44\xd7\x04\x45\xa8\x13\xd3\xc5\x5a\xfb\x34\x46\x26\x0c\x3e\xb5\x62\x07\x0a\x2f\xda\x31\x22\x15\xba\xcd\x4e\xdc\x40\x82\x8b\xeb\x1b\xf7\xfb\x96\x77\xee\xdc\x04\x96\xcf\xd8\x9c\x29\x9a\x55\xd5\xba\x90\xaf\xbf\x5c\x7f\x72\xeb\x83\xad\x9a\x54\xb3\x99\x9f\xd8\x00\xdc\x45\xde\xac\x98\x2d\xe6\x2d\xd7\x6b\xcc\x08\x85\xce\x80\x86\xcd\x73\xa9\xa8\xe2\x8e\xce\x53\x41\xd8\x03\xd7\xa0\x90\xe5\xd2\xb5\xf9\x2d\x34\x53\x83\x32\xb3\x62\x27\x58\x9a\x18\xbe\x70\xdd\x1b\xa1\x95\x09\xb5\x17\x65\x5c\x4c\xa7\x5c\x4c\x87\xd5\xdc\xeb\x9e\x8e\xd6\x0a\x60\x42\x96\xbd\x8b\xad\x74\xa5\x93\x19\x4b\x0b\x08\x14\x9a\x16\x14\x3a\x31\x32\x7d\x12\x2c\xca\x4b\xef\x02\x6c\xb9\x32\xf5\xca\x31\x65\xc4\xdd\x12\x8c\xd0\x50\x17\xc5\x7e\x43\x61\x03\xc0\xc5\xe9\x5a\xf6\xc9\xdd\xc1\x1b\x4d\x4f\xa8\xdd\xc8\xf5\xe5\x92\x84\x42\xd7\x30\x07\x1b\x8a\x23\x24\xac\x65\x4f\xb9\xd1\xd5\x06\x78\x5b\x2d\x97\xa2\x8c\x92\xb3\x67\x0d\x6d\xba\x16\x3c\x81\xb7\x97\xdb\xbc\x13\x6c\xbd\x12\x1b\x5d\x12\x6f\x00\x70\x48\x31\x5e\x56\x5d\x56\x81\xac\x04\x5e\xdb\x66\xde\x71\xc1\x6f\xb7\x76\x2f\xcb\xf8\x5b\x80\x18\x22\xcf\x5c\x54\x5f\xb9\x33\xc8\xa2\x6a\xba\x18\x87\x1d\x58\x3d\x69\x9a\xe7\x8c\xaa\x7a\x3c\x26\xd4\x4b\x94\x6d\xf1\x02\x30\xad\x5a\xe8\x9a\x70\x25\x01\xcb\x00\x33\xdf\xf4\x2c\x58\x6d\x63\xee\x34\x59\xad\xd7\xb7\xe3\x97\x58\x93\x04\x55\xd3\x38\x4b\xd1\xe1\x99\x9a\x16\x73\x57\x90\x50\xae\xd4\x39\xf3\x4d\xf0\x64\x72\x87\x08\x29\x03\x3e\x74\x78\xa8\xc9\xf9\x9b\x8b\x7a\xc4\x9b\xf1\x3b\x67\x11\x27\xc4\x41\x0e\xc9\x4f\x5e\xd2\x43\xc8\x2b\xad\x92\xe0\x46\x19\x0f\x61\xda\x6a\x08\x81\xf6\x2a\x56\xf2\x67\x55\x99\xc8\xe2\x53\xb6\xf0\xb5\x74\xf1\xb3\x0d\x88\xc6\x45\x5e\x18\xcf\x40\x2a\x19\x40\x24\x33\x2a\xa6\xa0\xbc\xc8\x02\xb3\x0b\x5f\x7e\x09\x2b\x56\x2c\x2d\x12\xe7\xb4\x0a\x71\x39\xe4\x4b\x2f\x4d\x84\x14\x7b\x68\x90\xa2\x13\x9a\x63\xa4\x41\x3b\xc5\xfa\xb6\xea\xa5\x30\xf4\xe1\x25\xe1\x43\x36\x24\x07\x5f\xd6\xfe\x74\xe0\x66\x9f\x2b\x69\xa7\x80\x02\xec\x57\x9d\x71\x03\xc4\xed\xa0\x0e\x6d\x48\x2e\xed\x1c\x41\xbb\x0e\x07\xdc\xee\x70\xab\xa2\x36\xc6\xd5\xf1\x5b\x6d\x7c\x4a\x55\x9a\x41\xe6\xf1\xa4\xec\x34\x8d\x39\xaf\xf2\xc0\x81\x99\x81\xff\x4f\x48\xb3\xc9\xd2\x59\x8b\x26\x6c\x85\xda\xda\x11\x9c\x0b\x2b\xe9\x0c\x52\x6a\xe8\x00\x2a\x3e\x3b\x52\x7d\xea\x4a\x8e\x0f\x7c\x39\xa0\x01\xf5\x57\xb3\xd6\x33\xfc\x0b\x1f\x58\x3e\xa0\xe5\xaf\xb8\x18\xd0\x01\x14\xbe\x69\xf3\xbf\x23\xcd\xb1\x11\xfa\x04\x56\x4a\x47\x56\x8a\x6a\x52\xa6\xcb\xaa\xe0\x22\xbc\x61\x48\xae\xa5\xa9\x4a\xc7\x39\x95\x18\xe1\x8c\xf4\x45\x81\x2a\x5a\x56\xd1\xaa\xcb\xeb\xdb\x77\xbf\x8c\xde\x5e\x5d\xdf\xd6\x48\x16\x02\xb9\x77\x91\xb4\x2d\x24\xab\x7d\xa2\xed\x24\x6d\x95\x64\x21\x16\xdf\x46\xd2\xd6\x48\x56\x3b\xa1\x47\x91\xb4\x15\x92\x85\x71\x80\xa2\x48\xda\x2a\xc9\x6a\x05\x8c\x25\x69\x35\x92\xd5\x0a\x13\x4d\xd2\x6a\x24\x0b\xa3\x9a\x21\x48\xda\x2a\xc9\x6a\x05\x8b\x22\x69\x3d\xc9\x5a\x1b\x4c\x2c\xa2\xc8\xd5\x6b\xef\xf7\xaf\x5d\xd1\xf2\xac\x6a\x35\x74\xe2\x0c\x09\x9f\xc8\xe7\x76\x29\x16\x3f\x51\x55\x75\xfc\x06\x39\x77\xd3\x42\x10\xf6\xa8\xd0\x34\x1c\x9c\x6e\xe7\xfb\x72\xba\x75\x74\x43\x5c\xd7\xd2\x12\x37\xad\x0f\x6b\xfb\xac\x3a\xc7\x9c\xff\x76\x75\x71\x79\x7d\x7b\xf5\xea\xea\xf2\xdd\x5e\xac\xc8\x11\xf5\x73\x9a\xec\xf3\xb1\xfc\xc8\x8d\x2d\x5c\x29\x57\x6c\xc1\x65\xa1\xb3\x65\xd9\x27\xa5\xbb\xb9\x57\xaf\x5b\xb5\x20\xca\x59\x2c\xa1\x7a\x0c\x4f\xd8\x63\x80\x23\x64\xfb\x08\x9f\x44\xac\x84\x8f\x04\xbd\x91\x69\xee\x62\x8a\x58\x1f\xd1\x0e\xd6\xb9\x8b\x35\x62\x3d\x0b\x9b\x19\xe8\x16\x06\x89\x04\xba\x93\x8d\x6e\x63\x93\x58\x3f\xdc\x4e\x66\x5a\x32\xcb\x2e\x2e\x01\x64\x9e\x78\x97\xeb\xff\x4a\x49\x54\xbf\xf3\xd5\xb2\x36\xce\x16\x14\xea\x98\x6e\x22\x78\x08\x35\xb9\x36\x8f\x06\x3b\xf2\xfa\x7d\x95\x91\x69\x85\xf3\x79\x6e\x90\x05\x31\xe3\xab\xa0\x39\x2b\xf8\x1b\x9a\xff\xc0\x96\xef\x18\x3a\x19\x7e\x75\x53\x58\xc6\x12\xcb\xe1\xc8\x1d\x5b\x3a\xf7\xf3\x79\x00\x8c\x2f\x6a\xd7\xad\x86\xdb\x1d\x43\xfa\xa1\x37\xce\xdc\x2a\x30\x76\xce\x20\x4b\xd8\x45\xe0\xa7\x4b\xba\xd7\x6f\x7b\x64\xdd\xb9\xc3\x3a\xcb\xf5\x37\xda\x38\xd1\x33\x0a\x28\x89\xc8\xe4\x93\x0b\xcb\x35\xd8\xfd\xa9\xf7\xec\x0c\xac\xaa\x38\x70\xee\x05\x7d\x6a\x17\xa4\x4f\xbf\x80\xff\x44\x4e\x01\x6a\x69\x90\xb3\x34\xf5\x91\x1d\x85\x66\x93\x22\x73\xd1\x17\x7a\x48\x68\xce\x7f\x62\x4a\x47\x35\x69\x70\xe3\x8e\x8b\xf4\x84\x14\x3c\xfd\x16\x5b\x6c\xc2\x8d\x8e\x67\x2a\x43\x1a\x49\xf7\x73\xbd\x01\xd7\xd8\xb2\x41\x4d\xcb\x7b\x14\xb9\x7a\x17\x28\x0c\xb8\x1d\xac\xc1\x5e\xa8\xe8\xb0\x19\x31\xfd\x25\x48\xc7\x3a\x7f\x77\x0c\x5f\xa3\xb8\x43\xd9\x3e\xc0\xa7\xee\x14\xee\xb0\x22\x71\x2e\x2e\xa8\xac\xc3\x11\xb1\x48\x12\x4a\xc8\xe8\xb2\x07\xc5\xd0\x5e\x99\x93\xe6\x47\x9d\xd3\x84\xc5\x60\xfb\xef\xe5\xe3\xd0\x31\x55\xbf\x3f\x3c\xfc\xc7\x0f\x97\xbf\xfc\xaf\xc3\xc3\x0f\xbf\x9f\xd4\xfe\x0a\x7c\x06\x54\xcc\xe6\x4f\x62\x16\x90\xb3\x64\x28\x64\xca\xae\x61\xde\xf0\xd1\xcb\x93\x67\x49\x22\x0b\x61\xe0\x0f\x31\x10\x21\x13\x63\x38\x93\xda\x5c\x8d\x4e\xc2\xc7\x5c\xa6\xab\x9f\x34\xba\x68\x4c\x57\x56\x52\xd1\x9a\x47\x5c\x62\x0f\x21\x20\x08\x54\xd0\xa3\x51\xad\x81\xdc\x78\x65\xd1\x2c\x44\x55\x97\xf9\x5a\x02\x12\x40\x34\x91\x93\x0e\x95\x78\xdd\xb0\x82\xd5\xe2\x05\x5a\x72\xf4\x0f\x75\x23\x8a\x93\xb0\x88\x47\x6c\x28\xec\x41\xa8\xea\x0f\x77\xaf\x64\xd6\xd1\xdc\xce\x1d\x49\xd9\x06\xe5\x6c\x74\x45\x16\xee\xbc\x3e\xc1\x76\x74\xa1\x8a\xe5\x06\xee\x93\x36\x06\xff\xde\xab\x27\xa3\x91\x95\xd3\x78\xa5\xab\x4f\x0c\x1e\x40\x78\x60\x80\xe4\xb3\x8b\x74\x59\xdb\x82\x59\xdd\xe2\xc8\x7d\x39\x4c\xf2\x22\x86\xe6\xf8\xa7\xe6\x6c\x2e\xd5\xf2\x24\x7c\x2c\x3d\xa3\x03\x6d\xa4\xa2\xd3\x28\x32\x16\xa6\x04\x53\xa9\x3e\xb9\x57\x34\x26\xbd\xfe\x1e\x7c\xdd\x7f\x67\x3b\x48\x0a\x65\x25\xbe\x6c\x59\x95\x24\xdb\x3b\x6d\x2c\x4f\x30\xa6\x6d\x94\x1b\x4d\x24\xa9\x62\x27\x40\x0a\x2e\x6f\x45\xe4\x5d\xb6\x0a\x98\x8f\xc6\x3f\x29\x65\x2f\xa7\x9a\x8b\x85\x55\xc5\x50\xe5\x24\xaa\xd1\x91\xc0\xa5\x7c\xc1\xb5\x8c\x42\xeb\xc8\xba\x63\x6e\x74\xab\x3e\xd6\x7c\x36\x7a\x71\x1b\xc5\xd3\x50\xe8\xde\xa7\xfd\xb9\x82\x1c\xb1\xc2\xe9\x24\x14\x33\x82\xf4\xf6\xf2\x8e\x37\xca\xc5\x45\xc2\x3c\x78\x81\xaf\x1d\x4f\x5c\x5d\x66\xc3\x94\x78\x49\xfe\x75\xf4\xeb\x57\x1f\x07\xc7\xdf\x1e\x1d\xbd\x7f\x3e\xf8\xfb\x87\xaf\x8e\x7e\x1d\xc2\x3f\xfe\x72\xfc\xed\xf1\xc7\xf0\xe1\xab\xe3\xe3\xa3\xa3\xf7\x3f\xbc\xf9\xee\x76\x74\xf9\x81\x1f\x7f\x7c\x2f\x8a\xf9\x9d\xfb\xf4\xf1\xe8\x3d\xbb\xfc\x80\x04\x72\x7c\xfc\xed\x97\x51\xd3\x7c\x92\x32\xbe\x6e\x84\x6d\x7e\xcc\xed\x7d\x17\x78\x58\x45\xe2\x4b\x6e\xfc\x09\x2e\x5c\x17\x16\x1a\x26\xba\x4f\x0e\xaa\x59\xa2\x98\x79\x6a\x1b\x8a\x83\x1a\xd3\x14\x87\xd4\x02\x7d\x4a\x55\xe2\x4f\x61\x80\x09\xe2\xb2\x5b\x72\xf4\xed\x77\x18\x08\x01\xdc\xc3\xba\x2b\x01\xda\x67\x7a\xa8\xb1\xf6\x03\x86\x6e\x43\xe3\x46\x6f\x0c\xea\x8d\x41\x7e\xec\xc9\x18\x74\xd3\x05\x8f\x7b\x4b\x50\xe7\x07\x62\x02\xfb\xff\xa8\x60\x7d\x26\x16\x18\x57\xc6\x46\xbf\x7a\x50\xad\xea\x75\x32\x62\xbc\x82\xdb\x9d\x8d\xc3\x40\xd8\x75\xe9\xcb\xc4\xc7\x16\x39\xb9\x62\xbe\xd9\x1f\x0c\x5d\x8d\xb9\x70\x84\xdd\xbe\xa0\x15\x62\xd5\xaf\xd2\x97\x6f\x76\xa5\x6a\xd8\x82\x09\x53\x95\xfc\xac\x57\x56\x6a\x05\x09\xd1\xc6\x10\x57\xfd\xb3\x0b\x57\xbe\x73\x11\xcc\x56\x27\xe5\xa2\xaa\xa9\x55\xca\xb5\xb8\xc0\xb9\xac\x60\x84\x6a\x2d\x13\x88\xf6\x76\x05\x39\x42\xf9\x90\xb0\x2d\xb0\x1a\xe8\x3e\x19\xd3\x83\x15\x6a\x62\x56\x67\x31\x5e\x42\x6d\x26\xb1\x08\x75\x4a\xd3\xc2\x85\x9f\x38\x66\x8c\x2a\xf2\xb6\x3a\x87\x4f\x16\x56\x61\xd1\xdd\xbb\xe1\x6a\xd1\x15\x20\x3d\x04\xa3\x03\x82\x14\x50\x08\x1e\x91\x93\xca\xc2\xde\x7e\xec\x71\x02\x52\xe9\x5b\x43\x0b\x85\x6b\x92\x51\x39\xb7\x4a\xca\xc6\x27\xa9\xc5\x8b\x73\x71\xa2\x08\x56\x0c\x41\x46\x78\x56\xe3\x0f\x13\x41\xf6\x22\x7e\x74\x11\x3d\x3a\x88\x1d\xf1\x22\xc7\x5e\x7c\x4f\x5d\x45\x8c\x78\xf1\x22\x92\xf5\xe7\x8a\x4d\xf8\x43\x87\x7b\x78\x26\x2a\xfb\x12\x4f\x99\x30\x7c\xc2\x5d\xcf\xb2\x5c\xb1\x9c\xe1\x93\xd7\x25\x61\x34\x99\x01\x81\xf5\xec\xb2\x72\x92\x7f\xca\xe8\x27\xa7\x0d\x75\x27\x4a\x37\x41\x47\xeb\x29\x52\x4f\x91\x76\x8c\x3d\x50\x24\x8f\x7a\xe8\xda\xde\x6e\x7c\x7e\xe4\x68\x0f\x7a\x00\xc4\xdf\xc7\x25\x03\x5c\xd4\x22\xf7\xe1\xa6\x45\xd7\x77\xae\x72\xc8\x4e\x01\x4a\xab\xd1\x1d\x8d\x46\x00\x6e\x54\x64\x59\x87\x2a\x85\x87\x57\xb0\xa2\xbc\xc8\x32\x92\xc3\xe3\x43\xe8\x13\x2e\x27\xe4\x0c\x2a\x13\x9f\x90\x6b\xb6\x60\xaa\xfd\xce\x5c\x4d\xae\xa5\x19\x39\x11\xb3\x59\x86\xce\x01\x22\x7c\x42\x5e\x5a\xb5\x49\x1b\x62\xe8\x14\xa5\x3a\x54\x5d\xfe\xa5\x6a\xbc\xa0\x2a\x68\xd1\x41\x96\x8e\x6e\xa8\xb5\x76\x74\x5f\x84\x6c\xc3\xc1\x13\x1f\x65\x59\xf1\x3b\x52\x47\x0d\xfd\x71\x7c\x1c\x1c\xc4\x68\x32\x97\x16\xcb\x50\x69\x96\xa4\x91\x6a\xd9\xb6\x83\x31\xcc\x29\x97\xda\xdc\x58\x1d\x30\xbe\xcc\xfb\x28\x3c\x0a\x25\x81\x69\x96\xb1\xb4\xd1\x27\x00\xdf\x1d\x83\x36\xab\x01\x27\xae\xc9\xb6\xaf\x05\xcc\xc8\x8c\x8a\x34\x63\x0a\xea\x54\x22\x2a\x2f\x92\xb5\x84\x7b\x5e\x15\xb0\x2d\xcb\x16\x87\xbc\x5d\x9a\x24\x52\xa5\x58\x5e\x60\x64\xc8\xa4\x85\x85\x97\x77\x12\xe8\xfa\x9c\x0a\x3a\x75\x3d\x92\x1c\xb3\x46\x81\xac\xa6\x09\xb5\xf2\x74\xad\x46\xf2\x4c\xca\x3b\x92\xc8\x79\x9e\x01\xe6\xe3\x4d\xa1\x9d\x2e\x4f\x55\xc5\xbe\xc4\xf3\x01\xf4\x8d\x3a\xad\x15\xb8\x87\x2f\xf6\xd1\xe3\x89\x3d\xb0\xa4\x53\x8f\x27\x4b\x0f\xed\x91\x82\x93\x5d\x8a\x52\x50\x9a\x48\x7b\xcd\x62\xac\x9d\x55\xb1\x93\x92\xb4\x0d\xc9\xe5\x03\x4b\x56\x3a\x63\xbb\x8e\x5c\x78\x0e\x2c\xc1\xc2\x80\x35\xc1\x77\xf1\x5d\x20\x33\xe8\xea\x63\xa5\xe0\x05\x3c\x1f\xca\x1e\x7a\x70\x50\xea\x34\xce\xb4\x2a\x43\x06\x5e\xa8\x9a\xd8\xb8\x88\x2e\xa7\xcc\x4b\x95\x71\x06\xe5\x50\x7d\xa3\x8c\x57\x0e\x73\x84\xdc\x7a\x29\x0d\x39\x3a\x3c\x3d\x8c\x89\x3f\x20\x6b\xa6\xbe\x95\x12\xd4\xb7\xd5\x5b\xe2\xc0\x42\xd5\x9b\x1c\x4a\xb5\xb0\xe4\x30\x3d\x21\xdc\x04\xd2\xaf\x0a\xe1\x77\x26\xce\xcf\xee\xd2\x14\x4f\x88\x96\xc4\x28\x9a\x72\xaf\xc5\xc1\xb7\xd0\x31\x5c\x15\x80\x94\x71\x6e\x8a\xa3\xc3\x8f\x87\x27\x84\x99\xe4\x98\xdc\x4b\x71\x68\xe0\x68\x86\xe4\xd6\x75\x3a\x0d\x2f\x8d\x02\xb9\x94\x05\x34\x66\x72\xa8\x90\x67\x3c\xe1\x26\x5b\x02\x7b\x20\xb2\x70\x65\xf5\x67\x91\x6e\x76\x9f\xa2\x79\xf9\xc0\x4d\x28\x77\x2c\x27\xe4\xb9\xeb\x20\xe0\x3a\x86\xd3\xb8\x85\x67\x7c\xc1\x4e\x67\x8c\x66\x66\xe6\x42\x5a\x84\x14\x03\xd7\x1b\xc7\xd2\x5f\xff\x97\x18\xa7\x59\x64\x0f\x72\xd2\xdd\x23\x13\xdb\xb6\xb9\x83\x6b\xc1\x32\x8e\xef\x70\x2d\x0c\xc9\xa6\x86\xea\xdf\x35\x7a\x18\x02\x27\x33\x26\x0f\x71\x43\x31\x84\xd3\x77\x09\xdd\x27\xed\x8c\x69\x2e\x48\xd6\xfb\x9b\xae\x77\x15\x6c\x74\x0b\x8c\x25\x9f\xb5\xce\x82\xe4\x17\x59\x40\x17\x1c\x3a\xce\x96\xe4\x9e\x0a\x6f\xa9\x88\xbb\x3c\x07\x76\x8a\x07\x96\xd6\xd5\x1a\xff\x03\xc9\x60\x14\x9d\x80\x44\xba\xa2\x6b\xed\x9d\xdd\x99\x13\xf4\xe2\xf7\x6d\xde\x6b\xc9\x92\xd1\xa1\xa8\x1e\xff\x86\xae\xf5\xb8\x4f\x1f\x56\x2c\x77\x24\xc4\xc3\xdf\xf3\xa5\x5f\xbb\x2b\x6e\x77\x1a\xc5\x4c\x13\x58\x70\xa4\x73\xd4\x77\xc1\xaf\x95\x8a\xe6\xc2\x2d\xd4\xf5\x24\x8a\x02\xd7\x2d\x7e\x82\x74\x8a\x01\x20\xad\x3d\xfd\xa3\x1a\xe7\x87\xd1\x39\x52\x2c\x2a\xa9\xb2\x3e\x76\x2f\x22\xae\x9d\x7f\x18\x9d\x57\x11\xef\xae\x26\x78\xe7\x6f\xf3\x91\xf8\x95\x75\x88\x4b\x22\x9d\x38\x1f\x04\xc7\x45\x85\x6c\xaf\x07\x6c\x97\xbd\x49\x43\xcf\x51\x7b\xa9\xe2\xe4\x17\xa6\x16\x98\x24\xe7\x6a\x74\x3a\xf6\x98\x86\xb1\xa4\x4b\xf0\x66\xf7\xd0\xcd\xce\x81\x9b\x1b\xf2\xb3\x15\x11\x65\x7f\xb9\xd0\x40\x36\x96\xcb\x36\x0f\x74\x73\x13\xd9\x28\x98\x2b\x0d\x67\x37\x37\x92\x8d\x85\xd8\xb5\xe9\x2c\x79\xca\x68\x4b\x48\xfb\x88\xa2\x86\x4d\xdb\x37\x3c\x6e\x77\xc3\xaa\x14\x56\x79\xf3\x52\x52\x2c\x41\xf3\xb2\x91\x95\xd6\x9a\xf6\x4b\x7b\x1d\xf7\x7c\xb7\x62\x89\x29\xba\x0d\x2f\xe9\x14\xf8\x13\xd3\x62\x9c\xc4\xb5\x19\x47\xef\x63\xd9\x8e\x1c\xdb\x6a\x1c\x0d\xd9\x39\x8a\x10\xed\xc6\x23\x24\x7c\x5c\x5b\x72\xf2\x47\x68\x10\x4f\xd5\xa2\x9c\x3c\x4d\x9b\x72\xd2\x73\x9f\x6a\x20\xdb\x97\xf7\xdc\x67\x75\x3c\x11\xf7\xf9\x9c\xe8\x6e\xd4\xcf\x73\xc5\x6e\x8c\xcc\x3b\xf8\x54\xdc\x83\x5b\x3c\x2a\x63\x36\xc1\x7a\x00\xe8\x56\xf7\x47\x5a\x30\x5f\xa3\xf5\x6c\x74\x15\x65\x93\x91\x0d\x17\x87\x8b\x3a\x0c\x05\x5a\x33\xbe\x60\x82\x69\x7d\x0a\x8e\x91\x22\x77\x5a\x27\x0a\xac\x6f\x3f\x76\x62\x77\x8d\xcd\x73\x57\x96\xb4\x4c\xca\xf0\xcd\xd3\xe0\x4b\x66\x12\x7c\x13\xf7\xe0\x35\xf2\x96\xd7\xb0\x9d\x2b\x69\x7c\x24\x51\x54\xcf\x90\xea\xb1\x74\x1d\xc6\x7c\xa3\x3e\xc5\xa8\x96\xc2\x19\xa4\x6b\xbd\xcc\xb8\x26\x39\xd5\x1a\xc9\xf1\x82\x58\xe3\x26\xeb\x00\x8f\x64\xba\xda\x73\xb1\xde\x91\x1e\xe9\x55\x2a\x84\x49\xe5\xbd\x20\x63\x36\xe5\x42\x7b\xdc\x81\x97\x05\x24\xb3\x7c\xd1\x6e\x09\xb2\xb3\xb6\x2f\xad\x37\x24\xef\x1a\xe5\xc7\x7c\x0a\x55\x22\x2b\xa2\xe8\x57\xd3\xc5\x55\x07\x41\xa1\x80\x5a\xae\xda\x79\xd5\xdb\x1f\x19\x75\x1b\x40\xb6\x6c\xe2\xba\xcf\x0e\x87\x01\xab\xe8\xd3\xe2\xb3\xc3\xa2\x55\xf5\xfc\xb6\x09\xbb\x1a\xc2\x34\x99\x61\xcb\x06\x75\xf7\xa2\xf7\x8e\xc0\xde\x11\xd8\x3b\x02\x7b\x47\x60\xef\x08\xec\x1d\x81\xbd\x23\x70\xeb\xe8\x1d\x81\xbd\x23\xd0\x8f\xde\x11\xb8\x36\x7a\x47\x60\xef\x08\x6c\x1d\xbd\x23\xb0\x65\xf4\xa6\x58\x3f\x7a\x47\x60\xef\x08\x74\xa3\x77\x04\x96\x0f\xf4\x8e\xc0\xde\x11\xb8\x71\xf4\x8e\xc0\x9d\x4f\xf6\x8e\xc0\x18\x88\x9f\x01\xf7\xf9\x9c\xe8\x6e\x74\xc6\x23\xe2\xa7\xc1\x7b\x36\xb2\x1a\x5a\x5c\x2f\x7f\xd0\xe9\xa0\x98\xe2\xd6\xd4\xb2\x3d\xa5\x8e\x61\x0d\xf5\x71\x46\x7a\x6f\x7c\x47\x9d\x1d\xd2\x40\x1f\x63\x78\x8f\x25\xfb\x91\x06\x77\xac\xb1\x3d\xa6\x6e\xd8\xd3\x1b\xda\xb1\x46\xf6\x98\x82\x6e\x48\x03\x3b\xd6\x1b\x69\x07\xc2\xb8\x1e\x6f\xbb\x6e\x35\xac\xd7\x8c\xe5\x68\xa0\x3b\x8c\xea\x5b\x0c\xe5\x78\x8f\xcf\x8a\x41\x7d\x87\x91\x1c\x7f\x56\xa5\x31\xfd\x89\x0d\xe4\x91\x76\xb2\x0e\xdc\x3a\xce\x34\x10\x45\xfa\x7d\x9c\xc2\xed\x4c\x31\x3d\x93\x19\xea\xce\x37\xee\xfb\x1b\x2e\xf8\xbc\x98\xdb\x6b\xa0\xed\x95\xe5\x0b\x16\x80\xea\x70\xd3\x50\xcb\x04\x43\x9e\xb7\xf0\x59\x60\x3c\x65\xca\x12\x7d\xca\x33\x7b\x6a\x13\xc3\x14\x99\x51\x10\xca\x75\x91\x24\x8c\xa5\x58\xa7\x71\x5d\x9f\xfa\xeb\xb0\x9c\x71\xd9\xc6\xe3\x05\x06\x8c\xab\xa4\x0a\x02\xda\x5f\xbf\x46\x9f\x02\x56\x9c\x8b\xf0\x49\xec\xc3\x1f\x11\xe7\x8b\x88\x65\x27\x31\x1a\xc4\x9e\xfc\x0f\x48\xdf\x83\xf7\x27\x44\xe8\x89\x8f\xf1\x3b\x44\x53\x82\x0e\xfe\x06\xb4\xaf\xa1\xf4\x1f\xa0\x17\xff\x14\x7e\x86\x48\xda\xb9\x17\xff\xc2\x93\xf9\x16\xba\xf9\x15\x1e\x59\x60\xf4\xf1\xfe\x84\x8e\xea\x63\x07\x3f\xc2\x53\xfb\x10\x3e\x59\x91\xe2\xa8\x3d\x8d\xf5\x19\x74\xf0\x17\xc4\xfa\x0a\x62\xfc\x04\x48\x1f\x41\xa4\xdd\x3f\xfa\xa8\x62\x2c\x2e\x51\xd6\x96\x6e\x96\x96\x4e\x56\x16\xa4\x7d\xbf\xda\x60\x34\xce\xec\xb4\xae\xac\x5a\x4c\xd0\x50\x37\x5a\x56\x1e\x63\x2d\x79\x12\x4b\x49\x9c\x8d\x1e\x6d\x9f\x8f\xd1\x46\x1f\x6f\x9b\x8f\xc4\x9e\x18\x22\x85\xb6\x0b\x45\x11\x1a\x2e\xb8\xe1\x34\xbb\x60\x19\x5d\xde\xb0\x44\x8a\x14\xc5\xd5\x56\x6a\xbc\x95\xb8\xae\x1d\x08\x2f\xc7\x37\x70\x17\x27\xfa\x50\x4d\x42\x75\x1b\x1f\xf5\x1b\xec\x4b\x9e\x4d\x83\xb9\xc8\xcd\x1a\x55\x90\x89\xc4\x87\x93\x5a\x5d\x37\x93\x34\xd5\xa7\xb9\x74\xff\x57\x05\x93\xd6\xa2\x48\xdd\x7c\x30\x36\xe9\xfd\x2a\x15\x2e\xc6\xb6\xeb\xe1\x7d\x2f\xef\x89\x9c\x18\x26\xc8\x11\x17\xe1\xfc\x8e\x6b\xaa\x42\x9c\x66\x57\x5e\x1d\x0b\xe1\xc5\xf3\x00\x70\x5d\x1d\x43\x81\xfc\x0c\x54\x36\x50\x42\xb5\x7e\x5a\xcd\xd9\x03\x7d\x1a\xd5\xd9\x03\x9b\x14\x59\x43\x7d\x46\x81\x74\x6a\x77\x93\xde\xbd\xa8\xea\x60\xbe\x80\xf9\x85\x1b\x88\x82\x48\x45\x4a\x7c\x1e\xc5\xe7\xa9\x83\x47\x79\x1e\x23\xbc\x8e\x95\x37\x11\xb7\x4f\x38\x8f\xa3\xf3\x24\xa2\x20\x22\xbc\x8d\x68\x2f\xe2\x27\xd3\xfd\x9f\xca\x73\xf8\x04\x5e\xc3\x5e\x76\xdd\xee\x1d\xec\x65\xd7\x1d\xe3\x8f\x97\xe2\x6a\x89\x37\xdf\x29\x9a\xb0\xd1\xa3\x44\x82\x70\x25\x49\x5a\x28\x9f\x17\x56\x4a\x06\xe1\x62\xa1\x76\x57\x30\x96\xba\xdb\x58\x66\x42\x41\x5a\xd0\xa4\xc8\xb2\x25\x29\x72\x29\x3c\x4f\xf3\x96\x64\x7c\x82\xdc\x6a\x76\x91\x9d\xd5\xa6\xd9\xe2\xeb\x38\xba\xde\xef\xd2\xb3\x65\x55\x08\x61\x95\x07\x8f\xa3\x96\x92\x58\xb9\x53\x3b\xea\x5a\xdb\x6e\x9c\x6a\xc3\xa7\x76\x3b\x2d\x6b\x84\x34\x29\x3e\x67\x55\xcb\x83\xea\xa5\xf6\x0d\x13\xa9\x12\x3e\x46\xfa\x50\x66\x34\x2b\x3b\x14\x50\x72\xc7\xb3\xcc\xbf\x6a\x48\x6e\x98\x21\x66\xc6\xb5\x67\xbd\x99\x14\x58\x42\x62\x66\x54\x84\xc6\x60\x2c\xb1\xf0\x93\x8c\x51\x51\xe4\x6e\xde\x56\x20\x58\xca\x42\x85\x79\xe3\xce\x0c\xaa\x61\x96\xb3\xe1\x9a\x08\x9e\x9d\xd4\xda\x24\xed\x44\x60\xd4\x1b\xca\x06\xf5\x9a\x85\x04\xb9\x7b\xae\xd9\x49\xfd\xbd\x72\xc1\x94\xe2\x29\xd2\xd6\xe7\xda\xa8\xdb\xe7\x72\x25\x17\x3c\x75\x1d\x1b\x02\x3a\x40\xd3\x55\xd7\xd1\x01\x1f\x99\x30\x66\xe0\x83\x12\x6c\x4a\x41\x0e\xf4\x04\xde\x79\x11\xdd\xbb\x9c\x7f\x4a\xa4\xd0\x07\x02\x37\x51\x0d\x59\xb7\xb5\x5c\xdb\x05\x87\x5e\xa7\x75\x8c\x20\x47\x42\x12\x09\x42\x45\x21\xb8\xc1\xe1\x97\x91\x44\xcf\x0a\x43\x52\x79\x2f\x8e\xed\x24\xb9\xb6\x67\x47\xc9\x98\x99\xd0\x82\x37\x34\x55\xe4\x0a\x39\x5b\x26\xe8\x38\xb3\x77\x0b\xdc\xff\xb7\x1b\x0f\x9e\x4c\x18\x35\x85\x62\x64\x4a\x0d\x92\x2a\x6c\x10\x35\xdd\x19\x3d\x1e\xb5\xb8\x2e\x7b\xc2\x17\x42\x33\x94\xed\xbc\x26\xc3\x7e\xf3\xb7\xa7\x97\x61\xf9\x9c\xc9\xc2\x3c\xb9\xc2\x7e\x3f\xe3\xc9\x2c\x56\x1f\xe1\x73\xa6\x89\x2c\x56\xac\x26\x2f\x3c\xe8\x52\x09\x40\x81\xac\x9f\xde\x7f\xb2\xd6\x8e\xe6\xee\x18\xb7\xc1\x06\xfb\xa3\x6f\xed\xb8\x1e\x45\x53\x05\x9d\xb4\xae\x88\xda\x9b\x7e\x71\x7d\xf3\xdb\xeb\xb3\x7f\x5e\xbe\xf6\xd7\x5f\xd4\x65\xae\x42\xf0\x7f\x17\x8c\xd0\xb9\x14\x53\x42\x11\xdd\x6a\xaa\x60\x9e\x13\xb0\xe1\xd4\xbe\x00\x42\xd2\x25\xec\x07\x2d\xf4\x42\xcf\xef\xb8\x00\x25\xe8\x12\xfe\x54\xf1\x49\x5d\x1a\xed\x94\x7d\x5a\xed\x54\xea\x8d\x76\x28\x11\xcc\x58\x94\xc7\xca\x93\x5c\x10\x4a\x34\x17\xd3\xac\x2e\x93\xb7\x1b\x97\x62\x9b\xed\x54\xd3\xed\xd0\xd8\xa2\xa2\x4b\x41\xe9\x70\xdd\x49\x83\x32\x01\xc2\x02\x52\xb0\xbf\x1a\x11\x9a\xa6\xca\x8a\x28\x0e\x75\xe7\x2b\xfd\x08\xe1\x15\xce\x4c\x8f\x0d\xb1\x79\x4e\xfe\x41\x1e\xc8\x3f\x40\x01\xf9\x06\xc7\x97\x62\xa9\x48\x2c\x2b\x70\xba\xf7\xd5\xa8\xc3\x6e\xff\x3c\xa3\x06\x9e\xb6\x7b\x65\x24\x19\x73\x2f\xa0\xb2\x07\xc3\x94\x40\xd4\x75\x77\xc3\x9f\xd5\x5e\xda\xa4\xd8\xe9\xed\x09\x99\xc0\xd2\x8f\x45\xa6\x49\xbd\x65\x80\xd9\x0b\x3a\xd9\x97\x7c\x2f\xb5\xb9\xf6\x17\x9b\xeb\xda\x3b\xb1\x36\x8c\x72\x66\x73\x6a\x92\x59\x93\x7e\x58\x96\xaa\xeb\x34\x17\x6b\x23\x90\x40\xff\x5c\xa0\xd7\x8c\x23\x45\xfe\xfd\x23\x3e\xde\xa5\xde\xc0\x8b\x5d\x67\xb9\x62\x44\x40\x1b\x77\xd2\xc0\x0b\x5d\xa5\x8f\x40\xac\x86\xe4\x92\x26\x33\x98\xa8\x47\x10\x74\xd4\x21\x05\x2d\x03\x26\x36\xa3\x0b\x8b\x66\xfe\x0d\xae\x45\x08\xb0\xf9\x18\xe3\x71\xb8\xa6\xf6\xce\x27\x54\xb8\x56\x7c\x13\xa6\x94\x8b\xde\x1b\x2f\xc1\xd3\xcb\x13\x86\x3c\xde\xc8\x7b\x9c\x2b\x69\x64\x22\x91\x6d\x68\xbc\x25\xef\x25\xb9\x3d\xc7\x65\xa9\xad\xc6\x15\xc3\xbb\x60\x7f\x9c\x85\x35\x18\xb4\x7f\xbc\x18\x9d\x58\xa0\xd8\xfb\x24\x15\xb9\x39\xbf\x1d\x35\x45\xdb\x83\xdb\xf3\xd1\xc1\x1e\x76\x29\xa6\xd1\x65\x83\xd3\xb6\xeb\x0b\x4f\xdf\xe9\x46\x31\x9a\xf2\x3e\xee\xbb\x8f\xfb\xee\xe3\xbe\xfb\xb8\xef\x3e\xee\xbb\x9a\x47\x1f\xf7\xbd\x71\xf4\x71\xdf\x7d\xdc\x77\x1f\xf7\xbd\x73\xf4\x71\xdf\x7d\xdc\x77\x1f\xf7\xfd\xc8\x99\xf7\x71\xdf\xbb\x47\x1f\xf7\xbd\xeb\xa9\x3e\xee\xbb\x8f\xfb\xee\xe3\xbe\xb7\x8f\x3e\xee\xfb\x73\xf1\x20\xf7\x71\xdf\x7d\xdc\x77\x1f\xf7\xdd\xc7\x7d\xf7\x71\xdf\x7d\xdc\x77\x1f\xf7\xdd\xc7\x7d\xf7\x71\xdf\x7d\xdc\x77\x1f\xf7\xdd\xc7\x7d\xf7\x71\xdf\x7d\xdc\x77\x6d\xf4\x71\xdf\x9f\x95\xd6\x8e\xe6\xee\xa1\x87\x58\x5c\xc0\xf2\xbb\xf0\xd4\xee\xe0\x9a\xd6\x45\xd5\x82\x6f\xc8\xe5\x86\x90\x1c\xb0\xb7\xe9\xdc\xbe\xa4\x9c\x68\x2b\x50\x9a\x29\x46\xd3\x25\x4c\x29\x01\x6f\x52\x25\xaf\x3f\x65\x8c\x4f\xc6\xe7\xbc\x3d\xd0\x1b\x66\x94\x86\x98\x89\x51\xa4\xde\x13\x21\xd6\x77\x11\xea\x3b\x88\xf4\x39\x35\x86\x29\xf1\x92\xfc\xeb\xe8\xd7\xaf\x3e\x0e\x8e\xbf\x3d\x3a\x7a\xff\x7c\xf0\xf7\x0f\x5f\x1d\xfd\x3a\x84\x7f\xfc\xe5\xf8\xdb\xe3\x8f\xe1\xc3\x57\xc7\xc7\x47\x47\xef\x7f\x78\xf3\xdd\xed\xe8\xf2\x03\x3f\xfe\xf8\x5e\x14\xf3\x3b\xf7\xe9\xe3\xd1\x7b\x76\xf9\x01\x09\xe4\xf8\xf8\xdb\x2f\x51\xd3\x7b\x02\x71\xbb\x49\xca\x5e\xc3\x29\xd7\x5c\x83\x16\x93\xe6\xf4\x01\x98\x01\x9d\xcb\x02\xdf\x15\x2d\x91\xf3\xbc\x30\x35\x54\x0e\xb7\x26\x9a\x16\x25\x52\x4c\xf8\xd4\xcb\xa3\xa7\xae\x3f\xdb\xa0\x04\x3b\xa8\xf5\x29\xc3\x10\xa2\x28\x55\xc0\xfb\x77\x7b\xbc\xff\x0f\xc7\xfb\x77\xfe\x9c\x57\x31\xdf\x8b\x41\x8f\xc5\xfc\xa0\xd3\x42\x34\x7a\xf9\x2e\xa4\xd9\x56\xce\xb9\x31\x9e\xd9\xd0\x7a\x40\x1f\x37\x0d\x23\x8d\xbb\xbb\x38\x19\x6c\xe2\x22\xc4\xb8\xae\x77\xe2\xaa\xc5\x6f\xcb\x20\xf1\x63\x1d\x3e\x54\x54\x16\x33\xb8\xa7\x83\x94\x4d\xb8\x60\xde\x43\xfd\xe7\xb9\xf3\xe8\x9f\x6a\x96\x14\x8a\x9b\xe5\xb9\x14\x86\x3d\xb4\x9a\xae\xb6\xd9\xe9\x6e\x9a\x60\x88\xdb\x36\x8d\x12\x1b\xc3\x14\x88\xcc\x5d\x40\xe2\xd6\xf4\xb4\xd0\xbe\xb0\x9d\xe0\x15\x02\x34\x60\xc0\x54\xcd\x8c\x53\x2b\x41\x39\x81\xe8\xc1\xd5\xe9\x06\x25\xb0\x5d\xc8\xb6\x53\xfb\x77\xc1\x17\x34\xb3\x6a\x7f\x05\x71\x04\xea\x43\x1d\x68\xab\x8d\x31\x46\x6e\x01\xa6\x33\x52\x7c\xc1\x33\x36\x65\x97\x3a\xa1\x19\xe0\x54\xbc\x98\x7f\xb6\x05\x12\xec\xb3\x92\x19\x52\xb3\x9e\x31\x68\x33\x4a\x83\xca\x0f\x79\x03\x53\xca\x05\x99\xdb\x2b\x92\x87\x17\x68\xb0\x1d\xe0\xae\xb3\xd1\xc4\x8a\x8d\xc2\x94\x76\x04\xa7\x5e\x8e\xa5\xcc\x7c\x0c\x6f\xb6\x8c\x9b\xa7\x6f\x8b\x2b\xe4\x6f\x82\xdd\xff\x66\x67\xa5\xc9\x24\xa3\xd3\xd2\x34\xa0\x99\x59\xb3\x4a\x62\x15\x1d\x37\xc5\xad\x1b\x0a\xdd\xfb\x0a\x46\x68\x76\x4f\x97\xe8\x6d\x5d\x99\x0b\xe1\xfa\x25\x79\x71\x0c\x18\x4d\x35\x29\xdf\x93\x92\xaf\x71\xe1\xba\x33\xaa\xc9\xf9\xd9\xe8\xb7\x9b\x5f\x6e\x7e\x3b\xbb\x78\x73\x75\x8d\xa7\x34\x76\xdf\x19\xe2\xf0\x12\x9a\xd3\x31\xcf\x38\x56\x54\x58\x8b\x05\xaa\x03\x00\xab\x72\x9a\x9e\xa6\x4a\xe6\x6e\x3f\xbc\xdd\x0d\xb5\xda\xba\x72\x72\xb1\x62\xfa\xf7\x5c\xc6\x1d\xf9\xa4\xf1\x52\x14\xec\xa9\xa2\xc2\x54\x06\x9f\xea\x88\x54\x21\xac\xc2\xbb\x8f\xf8\x49\x9a\x76\x0b\xc5\x3f\x4b\x53\x96\xc6\x2f\xf1\x71\x51\x79\xe7\xe1\x75\xcb\x2a\x1b\x95\x8c\xde\xde\x5c\xfd\xef\x2e\x33\x21\x1e\x0f\xf1\xee\x9e\x7d\x47\x57\x13\x62\x91\xb2\xd3\x79\xbc\x63\x73\xb9\xe8\x4f\x04\xfb\xc8\x5e\xe2\xdd\x4b\x9e\x14\xef\xaf\x7f\x57\x88\x3a\x49\x16\x35\x58\x64\x2e\x53\x36\x24\xa3\x60\xb1\xc7\xb1\xa5\x06\x84\x7a\xa2\xbb\x62\xc4\x82\x11\x86\x43\x9b\xf0\x4a\xd6\xc0\x1a\x67\x21\x9b\xa5\x9e\xc5\xda\xa0\x83\x13\x9a\x69\x14\xa1\x8a\xe3\x00\x96\x19\xbe\xb1\xca\x45\xf4\xce\x96\x4f\x92\x94\x09\x69\xbc\xc6\x62\xdf\x0e\xc9\xb9\x4a\x26\xa8\x85\x83\x66\x53\x0f\xf6\x6a\x50\x68\xdf\xd8\x3e\x30\x00\xa4\xc6\xe2\xf7\x6d\x54\xce\xd0\x59\x42\x0b\xcd\xf4\x66\x06\x80\x23\x21\xe1\x34\xec\x2c\x15\xa3\x29\x64\x8e\xe5\xd4\xcc\x5c\x99\x83\x39\xd5\x77\x2c\x75\x5f\x60\x7d\x5f\xbc\xd4\xcd\xb4\x6f\x84\xef\xa7\x7c\x6b\xf7\x31\xd8\xcd\x41\xee\x41\x2a\x42\x63\xe6\x6c\xf2\xb8\x68\xaa\xc8\x4c\x46\x9a\xbe\x15\xd9\xf2\x9d\x94\xe6\x55\x99\x34\x15\x8d\x38\x3f\x7b\x09\x14\x5c\x39\xd5\x51\xcc\xa0\x32\x85\x7d\xc7\xc0\x6e\x2c\x6a\xb1\x70\x65\xea\xf9\x5b\x17\x25\xa2\xec\xeb\xc2\xa8\x42\x9c\xe9\xef\x94\x2c\x50\xec\x64\x4d\x60\xfa\xee\xea\x02\xee\x7a\xe1\xbd\x72\xc2\xa8\x65\x2e\xb9\x30\xde\x3f\x1f\x27\x2b\x55\xb2\xec\x8f\xde\xcf\x09\x5e\xbd\xf2\xba\x44\xb8\x38\x08\x79\x43\x97\x84\x66\x5a\x06\xe1\x9a\x8b\x4d\xba\x11\xf1\x8a\x19\x96\x5a\x8e\xa5\x99\xad\x69\x6d\xf6\xba\xac\xc3\xc6\xa5\xc6\x55\x8e\xbc\xd2\x5e\x60\xdf\xb3\xfa\x0a\x43\xef\x98\x26\xb9\x62\x09\x4b\x99\x48\x50\x98\xb0\x5f\x37\x0f\x60\xce\xb5\x14\xf6\xfa\x44\xe3\xce\x55\xf0\x21\x3a\xc3\x49\x93\x8a\x41\xd4\x84\x2a\x70\xca\x1a\x5c\x33\x21\xc5\x00\x2e\x4f\xa1\x99\x02\x5d\xdb\x2a\x3c\x4e\xd9\xfe\xa1\x18\xb3\x8c\x19\x50\xb4\xb0\x6e\x1e\x9e\x52\xe3\x12\x3e\xf9\x9c\x4e\x19\xa1\xa6\x44\x46\x23\x09\x13\xda\x92\x31\x67\xf2\xc1\x61\x4e\x2a\x59\x95\x41\x49\x35\xf9\xf1\xea\x82\x3c\x27\x47\x76\xce\xc7\x80\x3e\x13\xca\x33\x70\x69\x1a\x8a\xec\xab\xb5\xa2\x9d\x4d\xc0\x7e\x25\x99\x86\xe5\xc3\x3d\x21\x52\x39\xb2\x71\x42\x04\x8e\xda\xea\x22\x99\x85\xf5\x5b\xcd\x31\x28\xa7\x3e\xda\x12\x8c\xcd\xd5\xb5\xc2\x81\x6c\xbd\x7a\xdb\xae\x15\xd2\x50\xb6\xf1\xea\x6d\xb9\x56\x28\x90\x4f\x71\xf5\x3a\x10\xe1\x1f\x35\x53\x9d\x68\xf0\x8f\x7b\xa2\xc1\x75\x71\xcd\xde\xab\x88\xaa\x4c\xc4\x11\x4b\x77\x79\xe6\xcc\xd0\x94\x1a\xea\xe9\x77\x95\x57\xde\xa3\xd2\x1f\x4f\xc5\x35\x7b\xcd\x45\xf1\xe0\x0c\xa8\xdd\x8c\x26\x37\x97\x00\x02\x50\x08\x16\x09\x2c\x97\xe6\x79\xc6\xc1\x7d\x1a\x4f\xcd\x02\x09\xab\x17\x40\xe9\x2a\xe6\x02\x09\x0b\xde\x5c\x2b\x91\x51\x91\xca\xf9\xda\xa4\xc1\xf5\x4c\x93\x59\xdc\x65\x19\xc6\x88\x19\x31\x38\xfe\x67\x12\x33\x62\x4d\x49\x19\x5b\x30\x64\x89\x95\x15\x6c\x7b\x6d\x9f\xb4\xc2\x70\x38\x3d\x00\x45\x32\x3a\x66\x19\xb0\x63\xb4\x41\xc0\x61\x67\x69\x91\x8b\xa8\x35\xe6\x46\xb4\x41\x41\xc9\xac\x5b\xfa\xd0\x3b\x99\x31\x17\xd7\x14\x16\x6d\x41\xfd\x29\xd6\x0c\x0f\x74\x59\x33\x68\x8b\x8d\x35\x83\x1e\xfe\x67\x58\x73\x81\xe4\xe2\x64\x75\xcd\x96\xfd\x37\xd7\x0c\x3c\xf7\xf3\x5f\x73\x94\xe9\x4b\xb3\x24\x91\xf3\x7c\xa4\xa4\x55\x73\x3b\xb1\x1b\x0f\xa2\x72\xd2\x39\x5b\x0b\x18\xc3\x91\x06\x95\x26\xa3\x59\x05\x48\x55\x2d\xa8\x12\xb9\xef\x40\xaf\x43\xf4\xe5\xff\xa8\xb1\x2a\xa0\x50\x27\x1d\xdc\x3a\x61\x36\xc1\x23\x58\x42\xf7\x7f\xd8\x0b\x65\x96\x09\xcd\xa0\x98\x1d\xfe\x7c\xc8\xea\x19\xad\x02\xa9\xe2\x45\x9d\x93\xce\x7e\x17\x61\x44\x76\x5e\x6f\x28\x0c\x09\xd0\xbc\x45\x51\xc8\x94\x55\x7e\x58\x17\x58\x8b\x86\x7a\xeb\x22\x9b\x01\x5e\x08\xd1\xb7\x3c\xcf\x3b\xc9\x59\x5a\x7f\x0b\xde\xc8\x2c\x7d\x21\x9a\x37\x65\xd1\x3d\xbb\x31\x4c\xa4\x5c\x4c\xc1\x8e\x76\x42\x14\xcb\x20\xb8\x36\x06\x28\x04\xcb\x3a\x25\xf6\x10\x2c\x4c\x61\x92\x01\x71\x63\x37\x15\x64\x21\x2e\x85\x9f\x29\xd8\xfd\x82\xf4\x32\x71\xa4\x96\x6b\x72\xf0\x3a\x1c\x24\xb2\x8c\xd8\xa7\xe4\x10\x07\x6e\x96\x25\x66\x39\x8b\xe8\x1d\x17\xa9\x8f\x1b\xb5\x1b\x83\xde\x90\x80\x0b\x41\xdd\xf5\xb2\x2b\x44\x50\xf3\xb4\x4e\x18\xf0\xee\x8f\x5f\x05\x29\x37\x90\x0c\x2a\xd4\x5f\x47\x69\x34\xc8\x1d\xa8\x4f\xde\x39\x91\xd8\xeb\x6c\x68\x90\x83\xcd\x92\x75\x69\x72\x0b\x93\x8e\xa8\x1d\x46\x9c\xd5\xd6\x4d\xeb\x47\x01\xf8\x6a\xd7\x3b\x20\x42\xae\xc0\xc3\x2a\x7e\xa4\xe4\x6a\xe9\x10\x5b\x18\x65\xaf\xc9\xce\x48\xe7\x53\x14\x6b\xbc\xe7\x22\x95\xf7\xfa\x31\x9a\xd8\xcf\x0e\x44\x10\xf1\x13\x7b\xa5\x0d\x17\x53\x1d\x76\x0f\xeb\xbb\xa1\x59\xa3\xb6\xdd\x46\x75\xcc\xdf\x0a\x14\xc8\x89\x92\x73\xe7\x10\x58\x53\x5f\x56\x72\x23\xfe\x73\xad\xb1\xb1\xcc\x78\x3a\xd7\xf4\x5c\x59\xf8\x86\xd3\xec\x26\xc7\xd5\x04\x24\xab\x78\xf1\xdd\x9b\x9b\xb3\x26\x18\x4b\xdc\xef\x67\x0c\x2c\x98\xf8\xfb\x67\xe1\x10\x9a\xce\xb9\xd6\x60\x19\x64\xe3\x99\x94\x77\xe4\x28\x44\x9f\x4d\xb9\x99\x15\xe3\x61\x22\xe7\xb5\x40\xb4\x81\xe6\x53\x7d\xea\x31\x7b\x60\x57\x84\x2f\xed\xc6\x45\x16\x22\xb7\x9c\x96\x2e\x8c\x0e\x59\x7f\x30\x99\xa4\x5c\x15\x1a\xa6\x3d\x54\x5f\xe8\xd4\x07\x4f\xac\x6f\x8f\x2b\x5a\xca\x59\xb6\xbf\x1a\x4a\xeb\x47\x7b\x1d\x51\x80\xa7\xe5\x78\x61\xfe\x3e\xeb\x2c\xaa\x66\xcc\xe6\xbd\x75\x7b\xe6\x84\xec\xfd\x15\x95\x72\xe2\x62\xc2\x74\xb7\xa2\x52\xdf\x57\xcf\x93\x94\xb9\xa4\x1a\xa6\xad\x34\x43\x23\xc5\x6e\x3b\x2a\xde\xea\xad\xf5\x94\x1c\x42\xfe\xaf\x7f\xc5\x61\xbc\x4e\xe5\x9d\xa3\x2e\x2b\xc9\x6a\x79\x59\x3e\xa3\x03\x67\xbe\xb0\xf4\x0a\x88\xa0\x17\xc4\xd0\x20\x67\x52\x48\xe5\x50\xd9\x8a\x3b\x52\xc0\x15\x01\xb7\x84\xf3\x99\xc2\x79\x7a\xa6\x50\xdb\xa2\xf3\xd8\xda\xca\xa4\xe1\xba\x85\xfc\x3d\x57\xbd\xa4\x5a\xd3\x3d\x37\x33\x28\x96\x18\x41\x52\x1a\xfe\x60\xd8\x01\xc5\x34\x38\xfa\x04\x61\x4a\x49\xa5\x5d\xdc\x55\x70\x48\x44\x14\x58\xf2\xbc\x06\x82\xe2\x2c\x62\x53\xfb\xe9\x50\xd7\x23\x1c\xca\xaa\xc5\x51\x40\xb5\xbd\x5d\x6c\x32\x61\x09\xe4\xc8\xd5\x11\xcf\xf1\x8d\x23\x8e\xf6\xeb\xdb\x51\x4f\x7f\x31\x32\x54\x51\x9e\xf3\x07\x3b\xeb\x3a\xf4\xe8\x82\xd8\xa4\xac\x2a\xb9\x19\xcc\xf1\x90\x90\x2b\x51\x86\xd9\xe3\x0b\x79\xf2\xe6\xc4\x42\x74\xa1\xb1\x47\x55\x2f\x0d\x8e\x4e\x3c\x84\xb9\xd6\x4c\xa8\x56\xe7\x51\x45\x24\xb5\xc1\xfa\x5a\x48\xdd\xdf\xd2\x99\xec\x82\xdf\xc5\x03\xb0\xe8\x1a\x24\x2f\xe7\x87\x89\xc2\xa8\x35\x7f\xcd\x2e\x5f\x4c\xac\xde\xd8\xf4\xdb\x6c\xf4\xc9\xa0\x41\x6e\xf3\xdd\xec\x30\x7b\xa3\x61\xef\x76\xdf\x3c\x4a\xa4\x23\x91\x62\x5d\x84\xd6\x18\x23\xfe\x91\xbd\x5a\xd6\xf0\x61\xf6\xae\xfa\x49\x5f\x0f\xbb\xaf\x87\xdd\xd7\xc3\xc6\x82\xec\xeb\x61\xf7\xf5\xb0\x37\x3f\xd2\xd7\xc3\xee\xeb\x61\xf7\xf5\xb0\xfb\x7a\xd8\x7d\x3d\xec\xbe\x1e\x76\x5f\x0f\xbb\xf3\xcc\xfb\x7a\xd8\xbb\x47\x5f\x0f\x7b\xd7\x53\x7d\x3d\xec\xbe\x1e\x76\x5f\x0f\x7b\xfb\xe8\xeb\x61\x7f\x2e\x95\xb5\xfa\x7a\xd8\x7d\x3d\xec\xbe\x1e\x76\x5f\x0f\xbb\xaf\x87\xdd\xd7\xc3\xee\xeb\x61\xf7\xf5\xb0\xfb\x7a\xd8\x7d\x3d\xec\xbe\x1e\x76\x5f\x0f\xbb\xaf\x87\xdd\xd7\xc3\xae\x8d\xbe\x1e\xf6\x67\xa5\xb5\x47\x04\xd7\xa4\xbc\xb5\x12\x22\xa6\x78\x8c\x8f\x40\x0d\x69\xcb\xad\xeb\xa1\x64\x5c\x4c\x26\x4c\x01\xc1\x87\x59\x04\x66\xb8\x5e\x1c\x2e\x90\xf7\x76\x6b\x93\xd3\x70\xa0\x6a\xa5\x62\x34\xd5\x2e\x8c\x7f\x0b\x78\x54\x79\x0d\x57\x81\xb0\x16\xe6\x79\xf9\xf6\x55\x7c\x95\x1b\x7c\xc0\x1f\xcc\xf5\xad\x48\xe2\x42\x9d\xaa\x43\xd9\x94\x1a\xe3\xcf\x26\xc9\xa4\xc6\x95\xe9\x74\x1b\x96\xcc\xa8\x10\x2c\x68\xfd\xdc\x80\xf5\x6c\xcc\x98\x20\x32\x67\xc2\xb1\x2b\x4a\x34\x17\x53\x44\x46\x10\x35\x86\x26\xb3\xa1\x9d\xa9\x08\x07\x52\x85\x61\xfa\x6f\xb4\x51\x8c\xce\x43\x60\xed\x9c\x22\x62\x68\xec\x54\x08\x4d\x94\xd4\x9a\xcc\x8b\xcc\xf0\x3c\x63\xfe\x65\x44\x33\x88\xfc\x77\xd9\x20\xe5\xc6\x62\xb0\xa8\x16\xd3\x79\x52\xcd\xd6\x2f\x5b\xd6\x0b\x9a\x81\x2d\xa2\x3d\x84\x86\x6b\xc2\xe6\xb9\x59\x12\x7b\x24\x99\x2f\xa9\xaa\xb4\x21\x49\xc6\x41\xe8\x83\x19\xbb\x14\x58\x78\x5f\x3b\x48\x2f\xee\x09\xbf\x53\xda\x6f\x85\x48\x41\x93\xca\x8d\x26\x10\x2e\x59\xbd\xd0\xbd\xaa\x15\x6e\xca\xb5\xd7\x8e\xf5\x09\xa1\xa1\x74\x97\x43\xa4\xb0\x13\x80\x4a\x81\x39\xc3\xdb\x5b\xc1\xfa\x47\x6a\xd3\xa9\xd5\xe9\xb4\xf7\xcb\x99\x65\xcb\xcb\x0e\x31\xd6\x98\x7d\xf5\xf5\x62\xe8\x7a\x10\x6a\xa8\xd5\xb3\x4a\x0a\x5a\xa1\x02\x02\x0a\xb6\xb0\x77\x88\x25\xcc\x8a\x52\x74\xcb\xcd\x7f\xb2\x8b\x6f\xa8\x9a\x32\x53\x06\xba\x63\xe2\x7c\x9b\xf5\x89\x6a\xd5\x7a\xeb\xea\x75\xb5\x2b\x76\x03\x5a\x97\x3e\x92\x29\x64\xdc\xf8\x22\x47\x16\x6f\x37\x94\x13\x76\x93\x75\xb5\xe1\x5a\x41\x6e\x02\x10\xc4\x69\x17\x8e\x56\x4e\x5a\xe7\x14\xd3\xf2\xe0\xe8\x6a\x74\x7e\x42\x46\x57\x17\x2e\xd6\xcc\x2d\xb5\xce\x8e\x00\x8b\x3c\x2b\x80\x4b\x82\x22\x7b\x9b\x26\xca\x75\x98\xe3\xfd\x8c\x1a\x40\x89\x88\x89\x82\x52\x37\xa3\xca\x87\x9d\x86\xae\x0c\xe4\x5a\x1a\xb6\xa9\x8e\x14\xb6\x4c\x08\x58\x25\xbc\x11\xcf\xdf\x16\x27\xd1\xe2\x18\x11\xc2\x84\x53\x13\x6b\xdf\x30\xad\xe9\x94\x8d\x10\xde\xdf\x6d\xb6\x37\x70\x00\x57\xb4\x04\xa8\x5f\x06\x86\x38\xf8\x06\x75\x3a\x55\x84\x64\x53\xa1\x23\x73\x37\xbf\x12\xa7\xee\x15\x37\x06\x71\xe4\x5c\xbb\xda\x87\x10\xaa\xb1\x5a\x7a\xe0\x70\x25\x0e\xd3\x6f\x42\x3b\xe9\x70\x2f\xb7\xc0\xad\xa8\x26\x52\x17\xbd\x38\x66\x64\xac\x38\x9b\x90\x09\x87\xd0\x49\x08\x3e\x3c\x81\xea\x55\xed\x48\xe4\xac\xc0\x5a\x33\x05\xeb\xf5\xd6\x8c\xb0\xee\x21\xf9\xd9\x2f\xdc\xa8\x42\x40\x07\x90\x56\x90\x5e\xd1\x84\xbc\x5c\x3e\x21\x53\x08\x6a\x54\x4e\x37\xff\xdb\xf3\xbf\x7f\x43\xc6\x4b\x2b\x04\x83\xde\x68\xa4\x41\xe4\xab\x85\x43\xc8\x98\x98\xda\xb3\x76\x1c\xb9\x99\x95\x59\x9e\x10\xb4\x12\x41\x4c\xd3\xca\xf9\x5f\xdf\x8d\x9b\x92\xff\x69\xca\x16\xa7\xb5\xf3\x1f\x64\x72\x3a\x24\xe7\x54\x08\xd9\xce\xdc\xac\x06\x9f\xa7\xe0\xda\x6b\x93\xd1\x1f\x73\x55\x64\xc6\x93\x65\x1c\xf9\xf6\x2a\x39\x99\xc9\x7b\x67\xc5\xd9\x80\xe1\xc8\xf8\xef\xb1\xa5\x32\x79\x91\xc1\x32\xc9\xab\x32\x4f\xbc\xd0\x6c\x3d\x37\x71\xe3\x3d\xc7\x9c\x4c\x78\xc5\x0a\x09\xf3\x71\xb5\x61\xca\xd2\xe7\x47\x78\x2f\x12\x4a\xaa\x09\xe6\x3a\xf2\x8a\x66\xd9\x98\x26\x77\xb7\xf2\xb5\x9c\xea\xb7\xe2\x52\x29\xa9\x9a\x6b\xc9\x28\xc2\x36\x92\xcc\x0a\x71\xe7\x1a\x33\x94\x55\x2e\xe4\xd4\x2a\x95\x79\x61\x42\xa1\xf3\x0d\x1b\xde\x0a\xd8\x55\x8b\x08\xb2\x5d\x30\xc0\x55\x6f\x61\x0f\xbc\xb2\xa0\xf9\x14\xb1\x76\xf5\xde\xde\xba\xfa\xfc\x74\xb8\x30\x76\xdb\xbf\x7e\xfe\xb7\xff\x72\xd7\x93\x48\x45\xfe\xeb\x39\xc4\xc4\xeb\x76\x71\x11\x08\x2d\xf0\x2f\x2b\xdf\xce\x69\x96\x59\x36\x59\xbf\x58\x16\x51\xc2\x45\xc2\x24\xf5\x85\x8b\xf4\x64\xf7\xc8\xc4\x5d\x99\x27\xd3\x44\x6f\x6f\x7f\x01\xf6\xcc\x8d\x66\xd9\xe4\xc4\x65\x09\x95\x55\x61\x0f\x41\x66\x3c\xf4\x54\x1c\x97\xe6\xb5\x3f\xfd\x70\x21\xb3\x62\xce\x2e\xd8\x82\xc7\x36\xb1\x6a\x3c\x19\x8c\xda\x19\xd7\x90\xc4\x35\xce\x64\x72\x47\x52\xf7\x47\xcc\xed\x0f\x41\x8e\xab\x15\xdd\xdb\x56\x8a\x0c\xd5\xdc\x3a\xf3\x46\x90\xe6\x9c\xe6\x39\x17\x53\x48\x97\xc4\x38\x52\xe8\x7d\x63\xa1\x70\x2f\xa1\xa8\x45\x44\x76\x6c\x9c\xeb\xd2\xbd\x08\x23\x3a\x6d\x58\x77\xf5\x70\x38\xae\x1c\xfe\xed\xd2\x37\x24\x36\x0f\xae\x49\x92\x4a\xa9\xb3\xdc\x03\xc7\x93\xed\x5e\x22\xcb\x2d\x58\x04\xc0\x59\x4d\x23\x3d\x86\xf8\x20\xd6\xc6\x3e\x89\xd2\x51\x37\xa7\xc6\xcb\x97\x41\x05\xa2\x24\x67\x4a\x73\x6d\x19\xde\x4f\x80\x47\xe7\x19\xe5\xd8\xc0\xde\xca\x3f\xf3\xf4\xcb\xc5\xbb\xf5\x06\x35\x4c\x40\xfc\x18\x15\x66\x1a\x9d\xa9\xd7\x1e\x26\xea\xae\x29\x14\xcf\x8e\xa3\x4c\x23\x99\xfa\x87\x81\x0f\xb9\x82\xe4\x1b\x44\xf2\x76\xc2\x54\x97\xd8\x4b\x46\x86\x65\x54\x5d\x48\xd3\x4f\xd5\x9a\x9b\x94\xc9\x7e\x13\x41\x9a\x1c\x9c\x4f\x40\x90\x60\x62\x1d\xe9\x11\x50\x22\x3f\xc5\x26\x51\x09\xaa\x5d\x04\x45\x72\x07\x5e\xab\x5c\xe0\x75\xb1\x21\x71\xe1\x41\xf6\xec\xfc\x0b\x90\x50\x0f\x5f\x1e\xee\x85\x2a\xb9\x2d\x53\x32\xa7\x53\x74\xe3\xa0\x95\x9d\x5b\x05\x51\x2f\xf9\x60\x05\x7e\xf8\x3b\x36\xf6\xc1\x57\x7b\x03\x58\x2c\xad\x2a\xd5\x40\xdd\x26\x17\xdb\x12\x8e\x45\xa0\x09\xfa\x8c\xb9\x96\x5f\xe4\x9e\x2e\x09\x55\xb2\x10\xa9\x37\xd6\x96\x16\xf5\x37\x2b\x8b\xb8\x96\x02\x1b\x58\xe1\x5d\x5c\xc3\x95\x9a\x12\xe0\xe7\xe3\x82\xbc\x18\xbe\x78\xfe\x39\x31\x14\x98\xe5\x0a\x43\xb9\x2e\x19\x8a\xbb\xab\x7b\x99\x6f\x28\xf0\xdf\x61\xce\x6f\xbc\x29\xa3\xac\xdf\x0f\x7a\x0d\x18\xb0\xe1\xab\x7b\xc5\x11\xc2\xb0\x1b\x55\xf3\xb7\x23\x10\x5f\xad\xa6\x51\xcb\xa5\x3f\x6e\xe8\x0d\x48\x98\xe8\x6e\x00\xf1\xe5\x11\x74\x31\x7e\x22\x8a\xe6\x89\x12\x5c\xa9\x58\x82\x56\xcf\xe0\xdd\x4e\xdc\x22\xcb\x22\x18\x49\x0e\x0e\xc8\x91\x83\x77\xe8\x12\x8c\x8f\xf7\x82\x7a\x7e\x13\x2f\x1f\x72\x64\x35\xd0\xc6\x46\x5e\x3e\xe4\x14\x6c\x5d\xf9\xf6\x1d\x45\x2e\xb9\xb2\x11\xa2\x76\x14\x09\xf5\x9f\x6c\x46\x17\x4c\x13\xcd\xe7\x3c\xa3\x2a\x5b\xda\xad\xbd\x71\x6b\x26\xe3\xc2\x10\x26\x16\x5c\x49\x31\xc7\xf6\x8b\x21\x64\x41\x15\x87\x9a\x35\x8a\x4d\x98\x62\xc2\xaa\x55\x5f\x1e\xfd\x74\xf6\x0e\x02\xc4\x8e\x5d\x43\x1a\xbf\x2f\x48\x90\x85\x76\x45\x6a\x9a\x6b\xaf\x4d\xed\x89\x10\x28\xac\xdc\x9e\x36\x98\x2d\xfc\xe7\x08\x0e\x34\x2f\x4c\xe1\xfa\xec\x3c\x24\x59\xa1\xf9\x62\x1f\x14\x31\x46\x46\x2e\x25\x9b\xcf\x58\x44\xf6\xd5\x06\x2e\x78\xeb\x1d\x5b\xa9\x86\x50\x21\x83\x07\x51\x15\x22\xa8\x7c\x1d\x65\xb5\xbb\x38\x7b\x7a\xf0\x3a\x1c\xea\xb2\x88\x62\x3d\xda\xe9\x04\x49\x09\xe7\x7c\x3a\x33\x3e\x76\x3c\xd4\xfb\x5c\x13\x14\xa1\x96\x4b\x17\x6b\xed\xd3\x18\x99\x30\xf8\xd4\x8a\x1d\x28\xbc\x68\xc7\x08\x2e\x78\xe5\xee\xdb\x21\xbb\xaf\x98\x89\xeb\x0f\x6d\x7d\xa6\x55\x95\x69\x76\xbc\xf3\x4e\x7c\x57\x73\x31\xa9\x7a\x5a\xd6\x2c\x06\x4b\x59\x40\x8a\xf3\x6e\x0b\x9e\x2c\xdb\x87\x82\x16\xd3\xd2\x81\x1c\xab\xb9\x50\x35\x8d\x53\x28\x0f\xcf\xd4\xb4\x98\xbb\xda\x62\x72\xa5\x64\x91\xef\x67\x25\x93\x3b\x44\x20\x14\xa0\xeb\xe1\xa1\x26\xe7\x6f\x2e\xea\x71\x5a\xc6\x87\xaa\xd9\x6b\x17\xa2\xf7\x86\xe4\x27\xcf\x10\x10\x64\xad\x95\x61\x6c\x64\x05\x08\x0d\xb8\xc1\x2b\xae\xac\xb8\x5a\xb2\xa9\xa4\x54\x88\x15\xd3\x32\x5b\xf8\xb2\x98\xf8\xd9\x86\xeb\xcc\x45\x5e\x18\x7f\xd9\x2a\x52\x21\x92\x19\x15\x53\x90\x71\x64\x81\xd9\x85\x2f\xbf\x24\xae\xd3\x7d\x5a\x24\xce\xb6\x1d\xa2\x49\xc8\x97\x9e\xe8\x84\xc4\x70\xa8\xd9\xa3\x13\x9a\x63\x98\x86\x9d\x62\x7d\x5b\xf5\x52\x18\xfa\xf0\x92\xf0\x21\x1b\x92\x83\x2f\x6b\x7f\x3a\x70\xb3\xcf\x95\xb4\x53\x40\x01\xf6\xab\xce\xb8\x01\x47\xf1\x41\x1d\xda\x90\x5c\xda\x39\x82\x10\x1e\x0e\xb8\xdd\x2e\x5f\xc5\x1a\x8c\xab\xe3\xb7\x42\xfb\x94\xaa\x34\x83\x7c\xd9\x49\xd9\x34\x16\x73\x5e\xe5\x81\xb3\x07\xae\x0d\xb8\x09\x84\x34\x9b\x0c\x22\xb5\x18\xb8\x56\xa8\x3b\x62\xe4\x0c\xd5\x77\xfa\x94\x0b\x4b\x10\x07\x29\x35\x74\x50\x23\x24\xa7\xae\x7a\xf0\xc0\x17\xb1\x19\x50\x7f\x35\xab\x0e\xce\xa7\x5f\xf8\x70\xe8\x01\x2d\x7f\xc5\xc5\x80\x0e\xa0\x5c\x4b\x9b\x9b\x0e\x69\xb5\x89\x10\x3b\xb0\xcc\x1c\x59\xdf\xa8\x49\x99\x2e\xab\xda\x69\xf0\x06\x88\x05\x08\x75\x8a\x52\x4f\x3a\x11\x3e\x0b\x5f\xca\xa6\xa2\x65\x15\xad\xba\xbc\xbe\x7d\xf7\xcb\xe8\xed\xd5\xf5\x6d\x8d\x64\x21\x90\x7b\x17\x49\xdb\x42\xb2\xda\x27\xda\x4e\xd2\x56\x49\x16\x62\xf1\x6d\x24\x6d\x8d\x64\xb5\x13\x7a\x14\x49\x5b\x21\x59\x18\x3f\x09\x8a\xa4\xad\x92\xac\x56\xc0\x58\x92\x56\x23\x59\xad\x30\xd1\x24\xad\x46\xb2\x30\x12\x1c\x82\xa4\xad\x92\xac\x56\xb0\x28\x92\xd6\x93\xac\xb5\xc1\xc4\x22\x8a\x5c\xbd\xf6\xee\xc1\xda\x15\x2d\xcf\xaa\x56\xf9\x25\x4e\xdf\xf8\x44\xa6\xf9\x4b\xb1\xf8\x89\xaa\xaa\x79\x2f\x44\xd1\x6c\x5a\x08\x42\x6d\x0d\xfd\x7f\x41\xaa\x3d\xdf\x97\x6d\xbe\xa3\xb5\xf2\xba\x16\xed\xb7\x69\x7d\x58\x13\x49\xd5\x04\xe2\xfc\xb7\xab\x8b\xcb\xeb\xdb\xab\x57\x57\x97\xef\xf6\x62\x6c\x8a\xa8\xfa\xd2\x64\x9f\x8f\xe5\x47\x6e\x6c\xe1\x4a\xb9\x62\x0b\x2e\x0b\x9d\x2d\xcb\x96\x07\xdd\xad\x42\x7a\x5d\xf9\x85\xd8\x5c\xb1\x84\x9a\x27\x3c\x61\x8f\x01\x8e\x90\xed\x23\x4c\x97\xb1\x12\x3e\x12\xf4\x46\xa6\xb9\x8b\x29\x62\x4d\xc9\x3b\x58\xe7\x2e\xd6\x88\x35\x40\x6e\x66\xa0\x5b\x18\x24\x12\xe8\x4e\x36\xba\x8d\x4d\x62\xcd\xf5\x3b\x99\x69\xc9\x2c\xbb\x58\x0e\x91\xd9\xcd\x5d\xae\xff\x2b\x25\x51\xad\x8b\x57\x8b\xb1\xc8\x42\x25\x55\xa3\xea\x4d\x04\x0f\xa1\x26\xd7\xe6\xd1\x60\x47\x5e\xbf\xaf\xf2\x08\xad\x70\x3e\xcf\x0d\xb2\x8c\x63\x7c\xed\x2e\x67\x2c\x7b\x43\xf3\x1f\xd8\xf2\x1d\x43\xa7\x70\xaf\x6e\x0a\xcb\x58\x62\x39\x1c\xb9\x63\x4b\xe7\xa5\x3a\x0f\x80\xf1\xa5\xd8\xba\x55\x1e\xbb\x63\x48\x77\xd5\xc6\x99\x5b\x05\xc6\xce\x19\x64\x09\xbb\x08\xfc\x74\x49\xf7\xaa\x63\x8f\xac\x96\x76\x58\x67\xb9\xfe\x46\x1b\x27\x7a\x46\x01\x25\x11\xf9\x67\x72\x61\xb9\x06\xbb\x3f\xf5\x06\xe0\x81\x55\x15\x07\xce\x0a\xa9\x4f\x21\x26\xfd\xf4\x0b\xf8\x4f\xe4\x14\xa0\x02\x04\x39\x4b\x53\xef\x00\x2e\x34\x9b\x14\x99\x73\xd2\xea\x21\xa1\x39\xff\x89\x29\x1d\x55\x6f\xdd\x8d\x3b\x2e\xd2\x13\x52\xf0\xf4\x5b\x6c\x89\x04\x37\x3a\x9e\xa9\x0c\xd1\xe6\xdd\xcf\xf5\x06\x2c\xe8\xcb\x06\x35\x2d\xef\x51\xe4\xea\x5d\x3c\x21\xe0\x76\xa8\x21\xe0\x85\x8a\x0e\x9b\x11\x53\x2a\x9e\x74\xac\x4e\x77\xc7\xf0\x95\x75\x3b\x14\x9b\x03\x7c\xea\x4e\xe1\x0e\x2b\x12\xe7\xc2\x07\xca\xea\x11\x11\x8b\x24\x21\x67\x42\x97\xe5\xe4\x87\xf6\xca\x9c\x34\x3f\x42\x72\x47\x0c\xb6\xff\x5e\x3e\x0e\xcd\x0f\xf5\xfb\xc3\xc3\x7f\xfc\x70\xf9\xcb\xff\x3a\x3c\xfc\xf0\xfb\x49\xed\xaf\xc0\x67\x40\xc5\x6c\xfe\x24\x66\x01\x39\x4b\x86\x42\xa6\xec\x1a\xe6\x0d\x1f\xbd\x3c\x79\x96\x24\xb2\x10\x06\xfe\x10\x03\x11\x02\xb6\x87\x33\xa9\xcd\xd5\xe8\x24\x7c\xcc\x65\xba\xfa\x49\xa3\x4b\x9d\x74\x65\x25\x15\xad\x79\xc4\x25\xf6\x10\x02\x82\x40\xdd\x37\x1a\xd5\xe5\xc3\x8d\x57\x16\xcd\x42\xf0\x65\x99\xd6\x21\x20\x4e\x5c\x13\x39\xe9\x50\x3f\xd6\x0d\x2b\x58\x2d\x5e\xa0\x25\x47\xff\x50\x37\xa2\x38\x09\x8b\x78\xc4\x86\xc2\x1e\x84\x5a\xf4\x70\xf7\x4a\x66\x1d\xcd\xed\xdc\x91\x94\x1d\x0d\xce\x46\x57\x64\xe1\xce\xeb\x13\x6c\x47\x17\xaa\x58\x6e\xe0\x3e\x69\xa3\xd5\xd9\xac\x54\xfb\xea\xc9\x68\x64\x80\xb8\x96\x05\x18\x83\x07\x10\x45\x14\x20\xf9\x24\x04\x5d\x56\x64\x60\x56\xb7\x38\x72\x5f\x0e\x93\xbc\x88\xa1\x39\xfe\xa9\x39\x9b\x4b\xb5\x3c\x09\x1f\xcb\xfc\xbb\x81\x36\x52\xd1\x69\x14\x19\x0b\x53\x82\xa9\x54\x9f\xdc\x2b\x1a\x93\x5e\x7f\x0f\xbe\x5a\xbd\xb3\x1d\x24\x85\xb2\x12\x5f\xb6\xac\x0a\x69\xed\x9d\x36\x26\x31\x99\xa1\xcd\xd1\x44\x92\xd2\x7c\xe5\xa4\xe0\xf2\x56\x44\xde\x65\xab\x80\xf9\xa0\xdd\x93\x52\xf6\x72\xaa\xb9\x58\x58\x55\x0c\x55\x04\xa1\x1a\x1d\x09\x5c\xca\x17\x5c\xcb\x28\xb4\x8e\xac\x96\xe5\x46\xb7\x9a\x59\xcd\x67\xa3\x17\xb7\x51\x3c\x0d\xe5\xd9\x7d\x76\x90\x2b\x23\x11\x2b\x9c\x4e\x42\x09\x1e\x48\xca\x2e\xef\x78\xa3\xc8\x59\x24\xcc\x83\x17\xf8\x8a\xe7\xc4\x55\x13\x36\x4c\x89\x97\xe4\x5f\x47\xbf\x7e\xf5\x71\x70\xfc\xed\xd1\xd1\xfb\xe7\x83\xbf\x7f\xf8\xea\xe8\xd7\x21\xfc\xe3\x2f\xc7\xdf\x1e\x7f\x0c\x1f\xbe\x3a\x3e\x3e\x3a\x7a\xff\xc3\x9b\xef\x6e\x47\x97\x1f\xf8\xf1\xc7\xf7\xa2\x98\xdf\xb9\x4f\x1f\x8f\xde\xb3\xcb\x0f\x48\x20\xc7\xc7\xdf\x7e\x19\x35\xcd\x27\x29\x3e\xeb\x46\xd8\xe6\xc7\xdc\xde\x77\x81\x87\x55\x24\xbe\xe4\xc6\x9f\xe0\xc2\x75\x61\xa1\x61\xa2\xfb\xe4\xa0\x9a\x25\x8a\x99\xa7\xb6\xa1\x38\xa8\x31\xad\x5c\x48\xad\xe8\x54\xa9\x4a\xfc\x29\x0c\x30\x41\x5c\x76\x4b\x8e\xbe\xfd\x0e\x03\x21\xce\x73\x58\x77\x25\x40\x27\x3c\x0f\x35\xd6\x7e\xc0\xd0\xcd\x53\xdc\xe8\x8d\x41\xbd\x31\xc8\x8f\x3d\x19\x83\x6e\xba\xe0\x71\x6f\x09\xea\xfc\x40\x4c\xfc\xef\x1f\x15\xd3\xcb\xc4\x02\xe3\xca\xd8\xe8\x57\x0f\xaa\x55\x3d\x9d\x3e\xc6\x2b\xb8\xdd\xd9\x38\x0c\x84\x5d\x97\xbe\x4c\x7c\x6c\x91\x93\x2b\xe6\x9b\xfd\xc1\xd0\xa0\x94\x0b\x47\xd8\xed\x0b\x5a\x21\x96\xc5\x5e\x98\x2f\x3a\xec\x2a\x5a\xb0\x05\x13\xa6\x2a\x54\x59\xaf\xb9\xd2\x0a\x12\xaa\x05\x71\x31\xf5\x39\x4a\x8e\x67\x7b\x7f\x17\x17\x55\x25\xa8\x52\xae\xc5\x05\xce\x65\x05\x23\x54\x6b\x99\x40\xf5\x77\x97\xb7\x1f\xaa\x0c\x84\x6d\x81\xd5\x18\x7a\xc7\xa2\xda\x29\x42\x25\xc7\xea\x2c\xc6\x4b\xa8\x28\x24\x16\xa1\xba\x66\x5a\xb8\xf0\x13\xc7\x8c\x51\xa5\xc9\x56\xe7\xf0\xc9\xc2\x2a\x2c\xba\x7b\x37\x5c\x2d\xba\x02\xa4\x87\x60\x74\x40\x90\x02\x0a\xc1\x23\x72\x52\x59\xd8\xdb\x8f\x3d\x4e\x40\x2a\x7d\x6b\x68\xa1\x70\x4d\x32\x2a\xe7\x56\x49\xd9\xf8\x5c\x96\x78\x71\x2e\x4e\x14\xc1\x8a\x21\xc8\x08\xcf\x6a\xfc\x61\x22\xc8\x5e\xc4\x8f\x2e\xa2\x47\x07\xb1\x23\x5e\xe4\xd8\x8b\xef\xa9\xab\x88\x11\x2f\x5e\x44\xb2\xfe\x5c\xb1\x09\x7f\xe8\x70\x0f\xcf\x44\x65\x5f\xe2\xd0\xe3\x7d\xc2\x5d\xa7\xad\x5c\xb1\x9c\xe1\x73\x5c\x25\x61\x34\x99\x01\x81\xf5\xec\xb2\x72\x92\x7f\xca\xe8\x27\xa7\x0d\x75\x27\x4a\x37\x41\x47\xeb\x29\x52\x4f\x91\x76\x8c\x3d\x50\x24\x8f\x7a\x51\x8d\xd2\xc9\x67\x48\x8e\xf6\xa0\x07\x40\xfc\x7d\x5c\x32\xc0\x45\x2d\x72\x1f\x6e\x5a\x74\x55\xe2\xaa\xb8\xda\x29\x40\x69\x47\xed\x66\x06\x7e\x49\x58\x8d\x74\x31\x78\x64\xc6\xa7\xf6\xb0\x33\xb6\x60\x99\x97\xa0\x5a\x61\xce\xa9\xa0\x53\xd7\x1b\xc4\xc8\x32\x89\x50\xaa\xb2\x22\xfa\x6a\x06\x20\xa2\x94\xaf\x20\xa1\xe2\x32\x3c\xac\x64\x96\x31\xa5\x49\xc6\xef\x18\xb9\x60\x79\x26\x97\x73\x1f\xd4\x9b\x92\x1b\x43\x8d\xbd\x96\x37\xcc\xb4\x3b\xa9\xd1\x17\x09\x66\x3a\x2a\xb2\xac\x43\x39\xb7\xc3\x2b\x38\xd3\xbc\xc8\x32\x92\xc3\xe3\x43\xe8\xef\x2d\x27\xe4\x0c\x2a\x0a\x9f\x90\x6b\xb6\x60\xaa\x9d\x6a\x5c\x4d\xae\xa5\x19\x39\x21\xbb\x59\xaf\xcb\x01\x22\x7c\x42\x5e\x5a\xc5\x51\x1b\x62\xe8\x14\xa5\x3c\x95\x89\xa2\xf6\x8c\xea\x2f\xa8\x32\xff\x3b\x68\x13\xd1\x8d\xb0\xd6\x90\xf7\x0b\x78\x93\x25\xdd\xee\xf3\x93\x1d\x65\x59\xa9\x3b\xea\x10\xcf\x7c\x57\xeb\xb2\x64\x52\x0d\xcf\x5d\x95\x19\x6c\x45\x3e\x50\xd5\xb8\x20\x8a\xe9\x5c\x0a\xcd\x9a\x65\x3a\xaa\xfe\x39\xa0\x12\xeb\x3d\xe4\xc7\xc6\x30\xfe\x5c\x6a\x73\x63\xf5\xeb\xf8\xc2\xef\xa3\xf0\x28\x14\x09\xa6\x59\xc6\xd2\x46\xe7\x00\x7c\xbf\x0c\xda\xac\xc1\x9a\xb8\xb6\xdb\xbe\x3a\x30\x23\x33\x2a\xd2\x8c\x29\x28\x15\x88\x28\x7e\x47\xd6\x72\x9e\x79\x55\x43\xb4\x2c\x64\x1c\x3a\xc3\xd1\x24\x91\x2a\xc5\xf2\x59\x23\xc1\xbc\xe7\x1f\xaf\x6e\x3b\xf0\xcc\x1a\xc6\x38\x41\x08\x05\xb2\x9a\x26\x94\x2b\xd3\xb5\xaa\xc9\x33\x29\xef\x48\x22\xe7\x79\x06\x77\x0a\x6f\x66\xee\x74\x2d\xab\xba\xf6\x25\x8e\x0e\xa0\x93\xd4\x69\xad\xe4\x3d\x7c\xb1\x8f\xae\x4f\xec\x81\x25\x9d\xba\x3e\x59\x4a\x6b\x8f\x14\x02\x18\xa4\x28\x85\xd0\x89\xb4\x2c\x2e\xc6\x92\x5c\xd5\x9b\x28\x89\xe6\x90\x5c\x3e\xb0\x64\xa5\x57\xb6\xeb\xd1\x85\x97\x6e\x24\x90\x04\xac\x7b\xa3\x8b\x5f\x08\x99\x9d\x58\x1f\x2b\x35\x07\xe0\xf9\x50\x79\xce\x83\x83\x6a\x93\x71\x66\x6b\x19\xb2\x1b\x43\xe1\xba\xc6\x45\x74\xf9\x7a\x5e\x62\x8f\x33\xd6\x87\x02\x08\x65\x2c\x78\x98\x23\x14\x6d\x96\xd2\x90\xa3\xc3\xd3\xc3\x98\xd8\x0e\xb2\x66\x46\x5d\xa9\x02\x7c\x5b\xbd\x25\x0e\x2c\x14\x1e\xc9\xa1\x5a\x06\x4b\x0e\xd3\x13\xc2\x4d\x08\x2f\x77\xe5\xa5\xed\xce\xc4\xc5\x30\xb8\x14\xd0\x13\xa2\x25\x31\x8a\xa6\xdc\x0b\x72\xf0\x2d\xf4\x10\x57\x85\xe3\x5f\x51\x60\x8f\x0e\x3f\x1e\xfa\xaa\xda\xf7\x52\x1c\x1a\x38\x9a\x21\xb9\x75\xbd\x4f\xc3\x4b\xa3\x40\x2e\x65\x01\xad\x9a\x1c\x2a\xe4\x19\x4f\xb8\xc9\x96\xc0\x1e\x88\x2c\x5c\xa1\xfd\x59\x64\x08\x83\x4f\x7f\xbd\x7c\xe0\x26\x54\x9c\x95\x13\xf2\xdc\xf5\x14\x70\x3d\xc4\x69\xdc\xc2\x33\xbe\x60\xa7\x33\x46\x33\x33\x73\xe1\x42\x42\x8a\x81\xeb\x96\x63\xe9\xaf\xff\x4b\x8c\x43\x32\xb2\x2b\x39\xe9\xee\xed\x8a\x6d\xe4\xdc\xc1\x6d\x63\x19\xc7\x77\xb8\xa6\x86\x64\x53\x8b\xf5\xef\x1a\x5d\x0d\x81\x93\x19\x93\x87\x98\xac\x18\xc2\xe9\xfb\x86\xee\x93\x76\xc6\xb4\x1b\x24\xeb\x1d\x4f\xd7\xfb\x0c\x36\xfa\x07\xc6\x92\xcf\x5a\xaf\x41\xf2\x8b\x2c\xa0\x2f\x0e\x1d\x67\x4b\xa8\xc7\xe1\x93\x30\xa3\x60\x1e\xd8\x29\x1e\x58\x5a\x67\xcf\xe0\x7b\xdf\xc7\xdf\x92\x0c\x46\xd1\xc9\x5d\xa4\x2b\xba\xd6\xde\xd9\x9d\x39\x41\x77\x7e\xdf\xf8\xbd\x96\x88\x1a\x1d\xe6\xeb\xf1\x6f\xe8\x9a\x91\xfb\xd4\x6c\xc5\x72\x47\x42\x3c\xfc\x3d\x5f\xfa\xb5\xbb\xe2\x76\xa7\x51\x4f\x32\x81\x05\x47\x3a\x9e\x7d\x5f\xfc\x5a\xb5\x5e\x2e\xdc\x42\x5d\x97\xa2\x28\x70\xdd\x62\x53\x48\xa7\xf8\x0a\xd2\xda\xe5\x3f\xaa\x95\x7e\x18\x9d\xa3\xf0\xa2\x12\x56\xeb\x63\xf7\x22\x00\xe8\xa7\x5b\x45\x7c\x28\x00\xc1\x3b\xd6\x9b\x8f\xc4\xaf\xac\x43\xcc\x17\xe9\xc4\xf9\x20\xf0\x30\x2a\x1c\x7e\x3d\x18\xbe\xec\x56\x1a\xba\x90\xda\x4b\x15\x27\xbf\x30\xb5\xc0\x24\x90\x57\xa3\xd3\xb1\xc7\xb4\x90\x25\x5d\x02\x63\xbb\x87\xc5\x76\x0e\x8a\xdd\x90\xfb\xae\x88\x28\x3b\xce\x85\x96\xb2\xb1\x5c\xb6\x79\xa0\x9b\xdb\xca\x46\xc1\x5c\x69\x41\xbb\xb9\xb5\x6c\x2c\xc4\xae\x6d\x68\xc9\x53\x46\xb2\x42\x4a\x4d\x14\x35\x6c\xfa\x15\xe0\x71\xbb\x1b\x56\xa5\xb0\xca\x9b\x97\x92\x62\x09\x9a\x97\x8d\xac\xb4\xd6\xb4\x8c\xda\xeb\xb8\xe7\xbb\x15\x4b\x4c\xd1\x8d\x79\x49\xa7\xa0\xaa\x98\xa6\xe3\x24\xae\xf1\x38\x7a\x1f\xcb\x06\xe5\xd8\xe6\xe3\x68\xc8\xce\x09\x87\x68\x40\x1e\x21\xe1\xe3\x1a\x95\x93\x3f\x42\x83\x78\xaa\xa6\xe5\xe4\x69\x1a\x97\x93\x9e\xfb\x54\x03\xd9\xd0\xbc\xe7\x3e\xab\xe3\x89\xb8\xcf\xe7\x44\x77\xa3\x7e\x9e\x2b\x76\x63\x64\xde\xc1\xa7\xe2\x1e\xdc\xe2\x51\x19\xb3\x09\xd6\x03\x40\xb7\xba\x3f\xd2\x02\xe8\x0b\x15\x90\x90\x19\x63\x93\x91\x0d\x17\x87\x8b\xe8\xd4\x45\x32\x23\x54\x83\x45\x4d\x30\xad\x4f\xc1\x31\x52\xe4\x4e\xeb\x44\x81\xf5\x1d\xa0\x4e\xec\xae\xb1\x39\xec\xc4\x49\x95\xf0\xe2\xfb\x57\xc1\x97\xcc\x24\xf8\xb6\xee\xc1\x6b\xe4\x2d\xaf\x61\x3b\x57\x1b\x25\x26\x8a\xea\x19\x52\x3d\x96\xae\xc9\x93\xef\x95\xa6\x18\xd5\x52\x38\x83\x74\xad\x9d\x14\xd7\x24\xa7\x5a\x23\x39\x5e\x10\x6b\xdc\x64\x1d\xe0\x91\x4c\x57\xdb\xde\xd5\x7b\xd4\x23\xbd\x4a\x85\x30\xa9\xbc\x17\x64\xcc\xa6\x5c\x68\x8f\x3b\xf0\xb2\x80\x64\x96\x2f\xda\x2d\x41\xf6\xda\xf6\x65\x0b\x87\xe4\x5d\xa3\xb4\x9b\x4f\x4f\x4b\x64\x45\x14\xfd\x6a\xba\xb8\xea\x20\xe0\x16\x50\xcb\x15\x9c\xae\xba\xfd\x23\x23\x9a\x03\xc8\x96\x4d\x5c\xf7\xd9\xe1\x30\x60\x15\x7d\x5a\x7c\x76\x58\xb4\xaa\x9e\xdf\x36\x61\xd7\x38\x95\x26\x33\x6c\x49\xa6\xee\xfe\xf9\xde\x11\xd8\x3b\x02\x7b\x47\x60\xef\x08\xec\x1d\x81\xbd\x23\xb0\x77\x04\x6e\x1d\xbd\x23\xb0\x77\x04\xfa\xd1\x3b\x02\xd7\x46\xef\x08\xec\x1d\x81\xad\xa3\x77\x04\xb6\x8c\xde\x14\xeb\x47\xef\x08\xec\x1d\x81\x6e\xf4\x8e\xc0\xf2\x81\xde\x11\xd8\x3b\x02\x37\x8e\xde\x11\xb8\xf3\xc9\xde\x11\x18\x03\xf1\x33\xe0\x3e\x9f\x13\xdd\x8d\xce\x26\x45\xfc\x34\x78\xcf\x46\x56\x43\x8b\xcb\x3d\x1c\x81\x77\x80\x27\x4e\xbb\xb3\xd8\x58\xcf\x35\x73\x60\xdb\x4f\xec\xbc\xe9\x80\x71\xad\x25\x7c\x86\x94\x77\x99\x39\xf8\x90\x83\xd5\x25\x67\x2d\xd6\x1f\x11\x12\x42\xf5\x69\x2e\xdd\xff\x55\xde\x88\x9a\x1b\xc2\xe9\xb4\x6d\x44\x2d\x86\x98\x63\xfd\x0f\x71\xbe\x07\x6c\xf2\xa0\xdf\x35\x8c\xdf\x21\xc6\x9f\x10\xcb\xcd\x22\xfd\x08\x58\x1f\x42\x4c\xa9\xb9\xa7\xf7\x1f\x60\x7d\x07\x31\x35\x00\x91\x7e\x03\xac\x93\xd5\x0e\x84\xcf\x20\xde\x24\xdf\xea\x2f\xa8\xf9\x00\xd0\x40\x77\xf8\x0a\xb6\xd8\xff\xf1\x8e\xac\x15\x3f\xc1\x0e\xdb\x3f\xfe\xac\x4a\x1f\xc1\x13\xdb\xfd\x23\xcd\x7f\x1d\x84\x90\x38\x8b\x47\x14\x47\xf3\xe1\x17\xb7\x33\xc5\xf4\x4c\x66\xa8\x3b\xdf\x6c\x46\xcf\x05\x9f\x17\x73\x7b\x0d\xb4\xbd\xb2\x7c\xc1\x02\x50\x1d\x6e\x1a\x6a\x99\x8e\xc3\x38\xc3\xa5\x05\xc6\x53\xa6\x58\x0a\xb0\xec\xa9\x4d\x0c\x53\x64\x46\x41\xd7\xd0\x45\x92\x30\x96\x62\x7d\xe1\x75\x35\xf1\xaf\xc3\x72\xc6\x65\xe7\x97\x17\x18\x30\xae\xf8\x2e\xc8\x9d\x7f\xfd\x1a\x7d\x0a\x58\x29\x35\xc2\xd5\xb2\x0f\x37\x4b\x9c\x8b\x25\x96\x9d\xc4\x28\x46\x7b\x72\xab\x20\x5d\x2a\xde\x4d\x12\xa1\xfe\x3e\xc6\x9d\x12\x4d\x09\x3a\xb8\x51\xd0\x2e\x94\xd2\x2d\x82\x5e\xfc\x53\xb8\x4f\x22\x69\xe7\x5e\xdc\x26\x4f\xe6\x32\xe9\xe6\x2e\x79\x64\x4d\xda\xc7\xbb\x49\x3a\x6a\xc5\x1d\xdc\x23\x4f\xed\x1a\xf9\x64\x75\xad\xa3\xf6\x34\xd6\x15\xd2\xc1\x0d\x12\xeb\x02\x89\x71\x7f\x20\x5d\x1f\x91\xee\x8c\xe8\xa3\x8a\x31\x24\x45\x19\x91\xba\x19\x90\x3a\x19\x8f\x90\x6e\x8b\x6a\x83\xd1\x38\xb3\xd3\x68\xb4\x6a\x08\x42\x43\xdd\x68\x30\x7a\x8c\x11\xe8\x49\x0c\x40\x71\xae\x07\xb4\xdb\x21\x46\x1b\x7d\xbc\xcb\x21\x12\x7b\x62\x88\x14\xda\xdc\x15\x45\x68\xb8\xe0\x86\xd3\xec\x82\x65\x74\x79\xc3\x12\x29\x52\x14\x57\x5b\x29\x0b\x58\xe2\xba\x76\x20\xbc\x1c\xdf\xc0\x5d\x9c\xe8\x43\x35\x09\x26\x29\x1f\xcc\x1c\xec\x5b\x9e\x4d\x43\xbb\x16\x37\x6b\x54\x05\x2b\x12\x1f\x25\xfb\xa4\x56\x29\xb2\x77\xa5\xc2\x85\x0e\x77\x3d\xbc\xef\xe5\x3d\x91\x13\xc3\x04\x39\xe2\x22\x9c\xdf\x71\x4d\x55\x88\xd3\xec\xca\xab\x63\x21\xbc\x78\x1e\x00\xae\xab\x63\x28\x90\x9f\x81\xca\x06\x4a\xa8\xd6\x4f\xab\x39\x7b\xa0\x4f\xa3\x3a\x7b\x60\x93\x22\x6b\xa8\xcf\x28\x90\x4e\xed\x6e\xd2\xbb\x17\x55\xe9\xd4\x17\x30\xbf\x70\x03\x51\x10\xa9\x48\x89\x4f\x0f\xf9\x3c\x75\xf0\x28\x87\x6a\x84\x33\xb5\x72\x92\xe2\xf6\x09\xe7\x48\x75\x0e\x52\x14\x44\x84\x13\x15\xed\x1c\xfd\x64\xba\xff\x53\x39\x44\x9f\xc0\x19\xda\xcb\xae\xdb\x9d\x9e\xbd\xec\xba\x63\xfc\xf1\x52\x5c\x2d\x9f\xe8\x3b\x45\x13\x36\x7a\x94\x48\x10\xae\x24\x49\x0b\xe5\xd3\xdd\x4a\xc9\x20\x5c\x2c\xd4\xee\x0a\xc6\x52\x77\x1b\xcb\x04\x2f\xc8\x76\x9a\x14\x59\xb6\x24\x45\x2e\x45\xcd\xe1\x58\x28\x64\xd2\xcc\xed\x8c\xad\x25\x4d\xd9\x59\x6d\x9a\x2d\xbe\x3c\xa5\xf7\x7e\x7a\xb6\xac\x0a\x21\xac\xf2\xe0\x71\xd4\x52\x12\x2b\x77\x6a\x47\x5d\x6b\xdb\x8d\x53\x6d\xf8\xd4\x6e\xa7\x65\x8d\x90\xfd\xc5\xe7\xac\xea\x92\x51\xbd\xd4\xbe\x61\x22\x55\xc2\xc7\x48\x1f\xca\x8c\x66\x65\x53\x0b\x4a\xee\x78\x96\xf9\x57\x0d\xc9\x0d\x33\xc4\xcc\xb8\xf6\xac\x37\x93\x02\x4b\x48\xcc\x8c\x8a\xd0\x4b\x8e\x25\x16\x7e\x92\x31\x2a\x8a\xdc\xcd\xdb\x0a\x04\x4b\x59\xa8\x30\x6f\xdc\x99\x41\x91\xcf\x72\x36\x5c\x13\xc1\xb3\x93\x5a\x67\xad\x9d\x08\x8c\x7a\x43\x70\x69\x17\x9a\x85\xbc\xbf\x7b\xae\xd9\x49\xfd\xbd\xa1\x78\x31\x0e\xa2\xeb\xbc\x6f\x9f\xcb\x95\x5c\xf0\xd4\x35\xf9\x08\xe8\x00\x7d\x7a\x5d\x13\x10\x7c\xc0\xc5\x98\x81\x0f\x4a\xb0\x29\x05\x39\xd0\x13\x78\xe7\x45\x74\xef\x72\xfe\x29\x91\x42\xeb\x10\xdc\x44\x35\x24\x13\xd7\x52\x88\x17\x1c\xda\xe3\xd6\x31\x82\x1c\x09\x49\x24\x08\x15\x85\xe0\x06\x87\x5f\x46\x12\x3d\x2b\x0c\x49\xe5\xbd\x38\x1e\xba\x42\xd3\x5c\x13\x4a\xc6\xcc\x84\xae\xcd\xa1\x0f\x27\x57\xc8\xd9\x32\x41\xc7\x99\xbd\x5b\x10\x07\x71\xbb\xf1\xe0\xc9\x84\x51\x53\x28\x46\xa6\xd4\x20\xa9\xc2\x06\x51\xd3\x9d\xd1\xe3\x51\x8b\x6b\x6f\x2f\x9e\x90\x42\x68\x86\xb2\x9d\xd7\x64\xd8\x6f\xfe\xf6\xf4\x32\x2c\x9f\x33\x59\x98\x27\x57\xd8\xef\x67\x3c\x99\xc5\xea\x23\x7c\xce\x34\x91\xc5\x8a\xd5\xe4\x85\x07\x5d\x2a\x01\x28\x90\xf5\xd3\xfb\x4f\xd6\xda\xd1\xdc\x1d\xe3\x36\xd8\x60\x7f\x5c\xcd\x60\xae\x1a\x34\x53\x7b\x7f\x2f\xae\x6f\x7e\x7b\x7d\xf6\xcf\xcb\xd7\xed\xa8\x7c\x49\x93\x59\xbd\xcc\x80\x20\x14\xe8\x1f\xc8\x5d\x33\xba\x60\x84\x92\x42\xf0\x7f\x17\xae\xde\x3d\x39\x2a\x61\x1f\x23\x22\x92\x62\x2b\x92\xa3\x25\x5c\xe8\x09\xdf\xa9\x83\x97\xeb\x26\xef\x02\x1a\xa4\x66\xd0\x8a\x63\x45\x92\x6d\xdf\x34\xfb\xa8\x0b\x97\x05\xe9\x79\xc6\x2c\x2d\xe3\x0b\xef\xb8\xf5\xa5\xc6\x69\x5a\x86\x6a\x58\x34\xb7\xd8\x86\x91\x28\xe8\x18\x42\x26\x66\x8c\x08\x66\x2c\xd6\x97\xd6\x4e\x29\x74\xa3\x26\x44\xa1\x99\x3e\x21\xe3\x02\x61\x06\xd4\x24\x57\x7c\x4e\x15\xcf\x96\xf5\xc9\x58\x51\xe2\x5a\x06\x7d\x77\x59\x2d\xa9\xdd\xd5\x6d\x97\x7c\xf1\xf6\xf2\x86\x5c\xbf\xbd\x25\xb9\x72\x95\x24\x20\xca\x03\xb6\x04\xb6\x75\xcc\x2c\x44\xdf\xb4\xb6\x7d\x5b\xcf\xc4\xd2\x3d\xec\xe8\x14\xd7\xc4\x2a\xb9\x0c\x24\x35\xaf\x6e\x84\x56\x05\x07\xcf\x87\xf0\xbf\x76\x27\x2f\x4d\x53\xc5\xb4\xae\x82\x6e\x92\xb5\x30\x39\xa7\xf9\xf0\x71\xc6\x70\x8d\x59\x6a\x67\xf3\x89\x7a\x78\x95\xa1\x7d\x23\xbb\x3d\xb5\x1e\x5e\xb4\x44\x12\xa4\xde\x01\x17\xdc\xe2\x6e\x16\x85\xf1\xd1\x7d\xbc\xaa\xe9\x76\xe8\x99\x53\xf1\xaf\xa0\x9c\xfa\xcb\x2a\x45\x25\x54\x22\x15\xc0\xab\x51\x40\x01\x2f\xe1\xcc\x57\x5a\x9d\xc2\x2b\x9c\x3b\x07\x1b\x8a\xf5\x9c\xfc\x83\x3c\x90\x7f\x80\xa2\xfa\x0d\x4e\x7e\x89\xe5\x36\xb1\x22\x83\xb3\xd1\x5c\x8d\x3a\xec\xf6\xcf\xf6\xd2\xda\xa7\xed\x5e\x19\x49\xc6\xdc\x2b\x32\xec\xc1\x30\x25\x68\x7b\x63\x3b\x37\xfc\x59\xed\xa5\x03\x93\x9d\xde\x9e\x90\x09\x3c\x42\x58\x64\x9a\xd4\x7b\x71\x98\xbd\xa0\x93\x7d\xc9\xf7\x52\x9b\x6b\x7f\xb1\xb9\xae\xbd\x13\x6b\xeb\x2a\x67\x36\xa7\x26\x99\x35\xe9\x87\x15\xbd\xb4\xa9\x2e\x29\xf6\x26\xa5\x12\xec\x97\x2e\x20\x70\xc6\x91\xaa\xe1\xfe\x11\x1f\x1f\x7a\xd1\xc0\x8b\x5d\x67\xb9\x62\x6c\x42\x1b\x01\xd3\x20\x2b\xb9\x42\x37\x81\x58\x0d\x9d\x98\x65\x27\xea\x11\x04\x1d\x9d\xba\x53\x1a\xf3\x36\xb2\x18\x27\x43\xb8\xa6\xf6\xce\x27\x54\xb8\x28\xf1\x09\x53\xca\x45\x79\x8e\x97\x10\x11\xc0\x13\x86\x3c\xde\xc8\x7b\x9c\x2b\x69\x64\x22\x91\x1d\xae\x3c\xb7\x7f\x49\x6e\xcf\x71\x49\x9a\xcd\xa8\x07\xff\x2e\xd8\x1f\x67\x89\x0f\x8e\x8f\x1f\x2f\x46\x27\x16\x28\xf6\x3e\x49\x45\x6e\xce\x6f\x47\x4d\x15\xe8\xe0\xf6\x7c\x74\xb0\x87\x5d\x8a\xe9\xa1\xdb\xe0\xb4\xed\x82\xcb\xd3\x37\xd1\x5a\xb1\xb0\x5a\x81\x6d\x30\xa7\xf9\xe0\x8e\x2d\x5b\x45\x85\xb8\xe9\x0f\x4a\xe4\x89\x9e\x90\x5b\xcc\xbc\xa5\x2d\xa3\x62\x34\xe5\x7b\xc8\xe0\xf0\x17\xaa\x82\xdf\x2d\x95\x63\x2e\x17\x2c\x75\x72\x75\x80\xc8\x44\x9a\x4b\x6e\xe5\x40\xde\xde\xc0\x75\x4b\xfe\x47\x9f\xdf\xd1\xe7\x77\xf4\xf9\x1d\x7d\x7e\x47\x9f\xdf\xd1\x9c\x47\x9f\xdf\xb1\x71\xf4\xf9\x1d\x7d\x7e\x47\x9f\xdf\xb1\x73\xf4\xf9\x1d\x7d\x7e\x47\x9f\xdf\xf1\xc8\x99\xf7\xf9\x1d\xbb\x47\x9f\xdf\xb1\xeb\xa9\x3e\xbf\xa3\xcf\xef\xe8\xf3\x3b\xb6\x8f\x3e\xbf\xe3\x73\x89\x14\xe9\xf3\x3b\xfa\xfc\x8e\x3e\xbf\xa3\xcf\xef\xe8\xf3\x3b\xfa\xfc\x8e\x3e\xbf\xa3\xcf\xef\xe8\xf3\x3b\xfa\xfc\x8e\x3e\xbf\xa3\xcf\xef\xe8\xf3\x3b\xfa\xfc\x8e\xda\xe8\xf3\x3b\x3e\x2b\xad\x1d\xcd\xdd\x43\x0b\xc4\xb8\x5c\x85\xc3\x73\x39\xcf\x0b\xc3\xc8\xbb\xf0\x78\x29\xa4\x38\x1a\xc6\x75\x4c\xf0\xf6\xe3\x03\x7d\x12\x29\x26\x7c\xea\x19\xf4\xa9\xeb\xb7\x37\x28\xd7\x36\xa8\xf5\x9d\x7b\xca\x28\x9f\x8c\xcf\x79\x7b\x96\x07\x71\x41\xfe\x3e\x6a\x62\x14\xa9\xf9\x44\x08\xf6\x5d\xc4\xfa\x0e\x42\x7d\x4e\x8d\x61\x4a\xbc\x24\xff\x3a\xfa\xf5\xab\x8f\x83\xe3\x6f\x8f\x8e\xde\x3f\x1f\xfc\xfd\xc3\x57\x47\xbf\x0e\xe1\x1f\x7f\x39\xfe\xf6\xf8\x63\xf8\xf0\xd5\xf1\xf1\xd1\xd1\xfb\x1f\xde\x7c\x77\x3b\xba\xfc\xc0\x8f\x3f\xbe\x17\xc5\xfc\xce\x7d\xfa\x78\xf4\x9e\x5d\x7e\x40\x02\x39\x3e\xfe\xf6\x4b\xd4\xf4\x9e\x40\xe0\x6e\xe2\xfa\x6b\x38\xe5\x9a\x73\xd0\xb2\xbe\x39\x7d\x00\x76\x40\xe7\xb2\xc0\xb7\x75\x4c\xfc\xb5\x29\x31\xd3\x39\x3e\xff\x50\x84\x27\xb1\xca\x80\xf7\xf0\xf6\x78\xff\x1f\x8e\xf7\xef\xfc\x39\xaf\x62\xbe\x17\x84\x1e\x8b\xf9\x81\x61\x40\x3e\x41\xf9\x2e\xa4\xe1\x56\xce\xb9\xb1\xaa\x83\xd5\x16\x68\x3d\xa4\x8f\x9b\x86\x99\xc6\xdd\x5d\x9c\x14\x36\x71\x31\x62\x5c\xd7\x5b\x09\xd6\x22\xf0\x65\x90\xf9\xb1\x2e\x1f\x2a\x2a\x9b\x19\xdc\xd3\x41\xca\x26\x5c\x30\xef\xa3\xfe\xf3\xdc\x79\xf4\x4f\x35\x4b\x0a\xc5\xcd\xf2\x5c\x0a\xc3\x1e\x5a\x8d\x57\x4d\x7c\xbb\x69\x3e\x4c\xdc\x66\xf9\x8c\x45\xff\x37\x22\x73\x5c\xe3\xc9\x95\xf4\xd3\xb2\xd7\xaa\x2a\x04\xe8\xb3\x80\x75\x9a\x19\xa7\x24\x82\xaa\xd1\x0e\x54\xfe\x7f\xec\xfd\x69\x77\xe4\x36\xb2\x27\x0e\x7f\x15\x1c\xf5\x9c\x47\x92\xaf\x32\x55\xe5\xde\x7c\x6b\x6e\xdf\x7e\x64\xa9\xec\x56\xbb\x16\x4d\xa9\xca\x9e\x3e\x76\x4f\x0b\x49\x22\x33\x61\x91\x00\x4d\x80\x52\x65\x8f\xe7\xbb\xff\x0f\x02\x0b\xc9\x5c\xc8\x00\x33\xa5\x92\xed\x64\xbf\x68\x97\x24\x06\xb1\x04\x02\xb1\xfe\x62\x4a\x96\x07\xe9\x4d\x3e\x6b\xd1\xfe\x54\xf1\x3b\x9a\x19\x23\x1e\x4f\xf1\x0a\x8c\x85\x26\x51\x2c\x63\x68\xaa\x6e\x6b\xae\x60\x23\xa3\x92\x86\x39\x9f\xfa\x25\x83\x1f\xb1\x8f\x7a\xa7\x9a\x0f\x5c\x5b\x57\x25\xbf\xe3\x19\x9b\xb1\x97\x2a\xa1\x19\x70\x65\xbc\xa9\x70\xb6\x81\x12\xec\x5e\x29\x33\xa4\x75\x3e\x67\xd0\x69\x99\x7a\xb7\x01\xd4\x8e\xcc\x28\x17\x24\x37\x6b\x59\xf8\x0f\x28\xf0\x3f\xe0\x04\x82\x56\xa4\xa0\xa5\xd9\x4d\xef\x8b\xb0\x26\xea\x44\xca\xcc\xe5\x01\x67\x8b\xb8\x71\xba\x36\x07\x42\xfe\x4b\xb0\xfb\x7f\x99\x51\x29\x32\xcd\xe8\x2c\xb8\x17\x14\xd3\x2b\x9e\x4d\xac\xb1\x64\x87\xb8\x71\x41\xa1\x81\x69\xc5\x08\xcd\xee\xe9\x02\xbd\xac\x4b\x63\x21\x5c\xbd\x20\xcf\x8f\xe1\x1c\x51\x45\xc2\x77\x52\xf2\x39\x2e\xe5\x77\x4e\x15\x39\x3f\xbb\xfa\xd7\xf5\x3f\xae\xff\x75\x76\xf1\xfa\xf2\x0d\x5e\x56\x99\x75\x67\x88\xcd\x4b\x68\x41\x27\x3c\xe3\x58\x65\x63\x25\x9f\xa8\x49\x00\x3c\xd3\x69\x7a\x9a\x96\xb2\xb0\xeb\xe1\x7c\x77\xa8\xd9\xd6\x32\xb9\x6d\xbd\x36\x4b\x85\x61\xcb\xa7\xad\x8f\xa2\x68\xcf\x4a\x2a\x74\xed\x34\xaa\xb7\xa8\xac\x84\x31\x9a\x1f\x22\x07\x93\xa6\xc3\xd2\xf9\xcf\xd2\x94\xa5\xf1\x53\xdc\x2e\xb3\xef\xdc\x7f\x6e\x51\x57\x24\x93\xab\xb7\xd7\x97\xff\x7b\xc8\x48\x88\xe3\x43\x7c\xc8\xe8\xa1\x33\xb4\x09\x31\x4c\x39\x68\x3f\xde\xb9\x6a\x9d\xfd\x8e\xa0\x5e\x79\x90\x9c\xf9\x70\x27\xc5\xc7\xfc\xdf\x55\xa2\x8d\x89\x51\xd3\x22\xb9\x4c\xd9\x98\x5c\x79\xaf\x3f\xee\x5a\x6a\x51\xa8\x85\x16\x84\x0c\x0c\x19\xa1\x39\xcd\xb2\x45\x43\xc3\xc1\x3a\x78\xa1\x22\xa6\x59\xc9\xdc\x92\x83\x53\x9a\x29\x94\xa0\x8a\xbb\x01\xcc\x65\xf8\xda\x98\x27\xd1\x2b\x1b\xde\x24\x29\x13\x52\x3b\xf5\xd3\x7c\x1d\x0a\xb4\x4b\x99\xa0\x26\x0e\xb6\x51\x33\x61\xac\x25\xa1\x95\x75\xc8\xfb\x0b\x00\x69\xf3\xb8\x75\xbb\x0a\x23\xb4\xde\xd4\x4a\x31\xb5\xfe\x02\xc0\x89\x10\xbf\x1b\x66\x94\x25\xa3\x29\x54\x9f\x15\x54\xcf\x15\xf8\xde\x73\xaa\x6e\x59\x6a\x7f\x80\x8d\x9f\xf1\x60\xdd\xd9\x91\x85\x21\xbf\x37\xeb\xe8\x7d\xef\xa0\xf7\x20\x4d\xa9\x09\xb3\x7e\x7d\x5c\x46\x56\x64\x35\x2b\x4d\xdf\x8a\x6c\xf1\x4e\x4a\xfd\x55\x28\xbc\x8a\x66\x9c\xef\x9c\x06\xda\xf6\x7d\x82\xba\x03\xe9\x12\xe9\xc8\x2c\x2c\x6a\xb2\x70\x64\x9a\x35\x60\x17\x81\x51\x1e\xea\xc0\x94\x95\x38\x53\x5f\x97\xb2\x42\x5d\x27\x2b\x0a\xd3\xd7\x97\x17\x70\xd6\x2b\x17\xd9\x13\xba\x5c\x40\xe1\xa7\x8b\xf1\xc7\xe9\x4a\xb5\x2e\xfb\xc1\xc5\x4a\x21\x32\x18\x8e\x4b\x44\x98\x84\x90\xd7\x74\x41\x68\xa6\xa4\x57\xae\xb9\x58\x6b\x71\x39\x73\x10\x2b\x2d\x27\x52\xcf\x57\x6c\x41\x73\x5c\x56\x69\xe3\xca\xeb\xea\x60\x60\x0d\x95\xc4\xc5\xca\x27\x34\xbd\x65\x8a\x14\x25\x4b\x58\xca\x44\x82\xe2\x84\x87\x0d\x15\x01\xe7\xbc\x91\xc2\x1c\x9f\x68\xde\xb9\xf4\x71\x48\xeb\x7a\x69\x4b\x31\xc8\xbc\x28\x2b\x9c\xb1\x06\xc7\x4c\x48\x31\x82\xc3\x53\x29\x56\x82\x85\x6f\x0c\x1e\x6b\xe2\x7f\x53\x4d\x58\xc6\x34\x18\x5a\xd8\x50\x11\x4f\xa9\xb6\x96\x3d\xcf\xe9\x8c\x11\xaa\x03\x33\x6a\x49\x98\x50\x46\x8c\x59\xa7\x11\x8e\x73\x52\xc9\xea\x2a\x4c\xaa\xc8\x87\xcb\x0b\xf2\x8c\x1c\x99\x31\x1f\x03\xfb\x4c\x29\xcf\x20\x2c\xaa\x29\xb2\xb5\xe0\x92\x75\x36\x05\x0f\x98\x64\x0a\xa6\x0f\xe7\x84\xc8\xd2\x8a\x8d\x13\x22\x70\xd2\x56\x55\xc9\xdc\xcf\xdf\x58\x8e\xde\x38\x75\x19\x9b\xe0\xae\xae\x8f\x15\x8e\x64\xef\xd1\xdb\x74\xac\x90\xae\xb6\xb5\x47\x6f\xc3\xb1\x42\x91\xdc\xc5\xd1\x1b\x20\x84\x3f\x28\x56\x0e\x92\xc1\x1f\x1e\x48\x06\x37\xd5\x35\x73\xae\xea\x95\xc4\x0a\x4b\x7b\x78\x72\xa6\x69\x4a\x35\x75\xf2\xbb\xae\x4d\xdf\xb3\xd2\xa7\x97\xe2\x8a\xbd\xe2\xa2\xfa\x68\x33\xb3\x86\x39\x4d\xae\x5f\x02\x09\x92\xf8\x49\xc2\x95\x4b\x8b\x22\xe3\x50\xc0\x1d\x2f\xcd\xbc\x08\x6b\x82\xe0\x0c\x55\x73\x41\x84\xd1\x2c\x93\xe6\xba\x31\x1a\x19\x15\xa9\xcc\x57\x06\x6d\x94\x60\x46\x93\x79\xdc\x61\x19\xc7\xa8\x19\x31\x3c\xfe\x4b\x52\x33\x62\x5d\x49\x19\xbb\x63\x48\x98\x9d\x65\xa4\x44\xf3\xa6\x51\x86\xfd\xee\x01\x29\x92\xd1\x09\xcb\xe0\x3a\x46\x3b\x04\x2c\x77\x06\x8f\x5c\x44\xca\x82\x7d\xa2\x1d\x0a\xa5\xcc\x86\x95\x20\xbd\x93\x19\xb3\xb9\x51\x7e\xd2\x86\xd4\x2f\x62\xce\xf0\xc2\x90\x39\x83\xb5\xd8\x9a\x33\xd8\xe1\xbf\x84\x39\x57\xc8\x5b\x9c\x2c\xcf\xd9\x5c\xff\xed\x39\xc3\x9d\xfb\xf4\xe7\x1c\xe5\xfa\x52\x2c\x49\x64\x5e\x5c\x95\xd2\x98\xb9\x83\xae\x1b\x47\xc2\x07\x04\xbd\xaf\xc5\x65\x1f\xc5\xca\x6f\xd0\x1b\xda\x04\x69\xd9\x48\xcc\x44\xae\x3b\xc8\x6b\x9f\xc1\xf9\xff\x6b\x36\xc9\x36\x12\xea\x64\x40\x58\xc7\x8f\xa6\x15\x67\x34\xd4\xdd\x2f\x1e\x44\x32\xcb\x84\x66\x00\x68\x88\xdf\x1f\xb2\xbc\x47\xcb\x44\xea\x9c\x53\x1b\xa4\x33\x3f\x8b\x70\x22\xdb\xb8\x39\x80\x83\x02\x35\xe7\x51\x14\x32\x65\x8d\xe8\x6e\x85\x82\x71\xf5\xcf\x7b\x9b\x1d\x0d\xf4\x7c\x9a\xbf\xb9\xf3\x7c\x40\x35\x6d\x7e\x05\xef\x64\x96\x0e\xcc\xe6\x75\x00\x5e\x34\x0b\xc3\x44\xca\xc5\x0c\xfc\x68\x27\xa4\x64\x19\x24\xe8\xc6\x10\x85\x84\x5b\x6b\xc4\x1e\x82\x87\xc9\x0f\xd2\x33\x6e\xec\xa2\x82\x2e\xc4\xa5\x70\x23\x05\xbf\x9f\xd7\x5e\xa6\x56\xd4\x72\x45\x0e\x5e\xf9\x8d\x44\x42\xc9\x3d\xe6\x0d\x71\x60\x47\x19\x38\xcb\x7a\x44\x6f\xb9\x48\x5d\xee\xa9\x59\x18\xf4\x82\x78\x5e\x08\xb0\xbb\x56\x77\x85\x2c\x6c\x9e\x36\x05\x03\x3e\xfc\xf1\x83\x20\x61\x01\xc9\xa8\x66\xfd\x55\x96\x46\x93\xec\x60\x7d\xf2\xce\xaa\xc4\xce\x66\x43\x93\x1c\xad\xd7\xac\x83\xcb\xcd\x0f\x3a\x02\x7f\x8c\xb8\xce\xfc\x30\xac\x0f\x02\xf8\xd5\xcc\x77\x44\x84\x5c\xa2\x87\x35\xfc\x48\xb8\xd5\xd2\x31\x16\x5c\xe5\x41\x0b\xa6\x91\xc1\xa7\xa8\xab\xf1\x9e\x8b\x54\xde\xab\x6d\x2c\xb1\xef\x2c\x09\xaf\xe2\x27\xe6\x48\x6b\x2e\x66\xca\xaf\x1e\x36\x76\x43\xb3\xac\x15\xb4\x58\x67\x8e\x61\x13\x72\xcc\x13\xa0\xcc\x57\xcd\x97\xa5\xfa\x8a\x5f\xaf\x37\x36\xf6\x32\x9e\xe5\x8a\x9e\x97\x86\xbe\xe6\x34\xbb\x2e\x70\xb8\x82\x64\x99\x2f\xbe\x7e\x7d\x7d\xd6\x26\x63\x84\xfb\x3d\xa0\xa4\xe3\xa1\x59\x09\xd0\x21\x34\xcd\xb9\x52\xe0\x19\x64\x93\xb9\x94\xb7\xe4\xc8\xa7\x29\xcd\xb8\x9e\x57\x93\x71\x22\xf3\x46\xc6\xd2\x48\xf1\x99\x3a\x75\x9c\x3d\x32\x33\xc2\xc3\xc3\x71\x91\x85\x2c\x30\xb0\xd2\x85\x56\xbe\x72\x10\x06\x93\x84\x59\xa1\x69\x9a\x4d\x75\x60\xb7\x2e\x79\x62\x75\x79\x2c\x70\x2d\x67\xd9\xc3\xe1\x30\xad\x6e\xed\x9b\x08\x10\x9f\x9e\xed\x85\xf1\xbb\xca\xb5\x28\xdc\x99\xf5\x6b\x6b\xd7\xcc\x2a\xd9\x0f\x07\x4c\x65\xd5\xc5\x84\xa9\x61\xc0\x54\x7f\xab\xdf\x27\x29\xb3\x85\x39\x4c\x19\x6d\x86\x46\xaa\xdd\xe6\x69\x27\x0d\x42\x80\xe1\x10\x6a\x88\xdd\x27\x0e\xe3\x6d\x2a\x17\x1c\xb5\x95\x4d\xc6\xca\xcb\x8a\x39\x1d\x59\xf7\x85\x91\x57\x20\x04\x9d\x22\x86\x26\x39\x97\x42\xba\xc2\x0b\xa3\xee\x48\x01\x47\x04\xc2\x12\x36\x66\x0a\xfb\xe9\x2e\x85\xc6\x12\x9d\xc7\xe2\x6b\x93\x56\xe8\x16\x6a\x00\x2d\x02\x4a\x3d\xa7\x7b\xae\xe7\xae\x3b\xc4\x20\xa2\x76\x05\x4a\xa6\x20\xd0\x27\x08\x2b\x4b\x59\x2a\x9b\x77\xe5\x03\x12\x11\x20\x4d\xee\xae\x81\xa4\x38\xc3\xd8\xd4\xfc\xeb\x50\x35\x33\x1c\x02\x72\x75\x14\x51\x65\x4e\x17\x9b\x4e\x59\x02\x75\x76\x4d\xc6\xb3\xf7\xc6\x11\x47\xc7\xf5\xcd\x23\xa4\xf6\xa5\x00\xe6\x90\x39\x24\xed\x9c\x7f\x34\xa3\x6e\x52\x8f\x06\x45\x27\x01\x99\x72\x3d\x99\xe3\x31\x21\x97\x22\x24\xea\xe3\xc1\x40\x79\x7b\x60\x3e\xbb\x50\x9b\xad\x6a\xc2\xc3\xa3\x8b\x17\x61\xac\x0d\x17\xaa\xb1\x79\xca\x2a\x52\xda\x60\x63\x2d\xa4\x19\x6f\x19\x2c\x76\x21\xee\xe2\x08\x18\x76\xf5\x9a\x97\x8d\xc3\x44\x71\xd4\x4a\xbc\xa6\x2b\x16\x13\x6b\x37\xb6\xe3\x36\x6b\x63\x32\x68\x92\x9b\x62\x37\x1d\x6e\x6f\x34\xed\xee\xf0\xcd\x56\x2a\x1d\x89\x54\xeb\x22\xac\xc6\x18\xf5\x8f\x3c\xa8\x67\x0d\x9f\xa8\x6f\x11\x54\x06\x00\x89\x5f\x37\xde\x6c\x58\xde\x21\x7e\x7f\x25\x53\x32\xa7\xfd\xcb\x57\xa3\xca\x40\xc7\x1f\x40\x89\xe2\xff\xf6\xba\x7f\x6d\x63\x08\x69\x0b\x2f\xb0\x28\x8a\x90\xb1\x66\x51\x9f\x53\x62\xcc\xd8\xcc\xa7\xe4\xe4\x45\x66\x94\xd1\xd6\x97\xc7\x98\x80\x0c\xbc\xdf\x40\x26\x3f\x09\x13\xad\xc1\xcf\x1d\xa8\xd4\x09\xf9\x11\x23\xee\xa8\xf2\x99\xe0\x1e\xfb\xe6\x2a\x90\x37\x4b\x00\x5a\x82\xeb\x7e\x00\xa5\xc1\x88\xf0\x99\xf3\x55\x92\x94\x4f\xa7\xcc\x67\xad\x4f\x18\x29\x68\x49\x73\xa3\x0c\x29\xe2\xb6\x68\xc2\x66\x1c\x97\xc2\x1c\xae\xcc\x43\x55\x83\xbc\x9c\xd8\xeb\x98\x6b\x92\xf3\xd9\xdc\x1e\x17\x42\x01\x02\x80\xa0\xe2\x71\x5a\x92\x4c\xd2\x94\x80\xe4\x91\x25\xb9\xa7\x65\x6e\x34\x34\x9a\xcc\x21\x59\x83\x0a\x92\x56\x25\x20\xf4\x6a\x46\xd3\xc5\x48\x69\xaa\xfb\xc9\x1a\xbb\xca\x39\xb4\xfc\xfa\xed\xe1\xe3\xf7\xf0\xf1\x7b\xf8\xf8\x3d\x7c\xfc\x1e\x3e\x7e\x65\x1c\x4f\x2a\x15\x7e\x0f\x1f\xff\x24\xa0\xeb\xf6\xf0\xf1\xee\xd9\xc3\xc7\x77\x3c\x7b\xf8\xf8\x3d\x7c\xfc\x1e\x3e\x7e\xcb\x91\xef\xe1\xe3\xbb\x9f\x3d\x7c\x7c\xd7\x5b\x7b\xf8\xf8\x3d\x7c\xfc\x1e\x3e\x7e\xf3\xb3\x87\x8f\x7f\x2a\x40\x74\x7b\xf8\xf8\x3d\x7c\xfc\x1e\x3e\x7e\x0f\x1f\xbf\x87\x8f\xdf\xc3\xc7\xef\xe1\xe3\xf7\xf0\xf1\x7b\xf8\xf8\x3d\x7c\xfc\x1e\x3e\x7e\x0f\x1f\xbf\x87\x8f\xdf\xc3\xc7\x37\x9e\x3d\x7c\xfc\x93\xb2\xda\x23\xf2\xc8\x52\xde\x0b\xfa\x89\xc1\x49\x72\xc9\xd6\xbe\x42\xbf\x77\x3e\x94\x4c\xaa\xe9\x94\x95\x20\xf0\x61\x14\xfe\x32\x5c\xc5\x41\xf4\xe2\xbd\xdf\xdb\x64\x2d\x1c\x80\x85\x2d\x19\x4d\x95\xad\x58\xd9\x40\x1e\x85\x24\x63\xc1\x36\x1b\x19\xcd\x2f\xdf\x7e\x15\x0f\xe8\x84\xcf\x6d\x85\xb1\xbe\x15\x49\x5c\x56\x5f\xbd\x29\xeb\xaa\xc0\xdc\xde\x24\x99\x54\x88\xe4\xae\x39\x73\x0b\x96\xcc\xa9\x10\xcc\x5b\xfd\x5c\x83\xf7\x6c\xc2\x98\x20\xb2\x60\xc2\x5e\x57\x94\x28\x2e\x66\x88\xe2\x37\xaa\x35\x4d\xe6\x63\x33\x52\xe1\x37\xa4\xce\x38\x76\x3f\x51\xba\x64\x34\xf7\x39\xe4\x39\x45\xe4\xd0\x98\xa1\x10\x9a\x94\x52\x29\x92\x57\x99\xe6\x45\xc6\xdc\xc7\x88\x62\x50\xe4\x62\x0b\x9f\xc2\xc2\x62\xb8\xa8\x91\xbe\x7c\x52\x8f\xd6\x4d\x5b\x36\xb1\xfb\xc0\x17\xd1\x9f\x42\xc3\x15\x61\x79\xa1\x17\x21\xa3\x91\x91\x29\x2f\x95\x26\x49\xc6\x41\xe9\x83\x11\xdb\x6a\x6f\xf8\x5e\x3f\x49\xa7\xee\x09\xb7\x52\xca\x2d\x85\x48\xc1\x92\x2a\xb4\xb2\xf9\x79\xf5\x07\xed\xa7\x7a\xe9\xa6\x5c\x39\xeb\x58\x9d\x10\xea\x51\xea\x2c\x23\xf9\x95\x00\x56\xf2\x97\x33\x7c\xbd\x97\xac\x7b\xa5\x31\x9c\x06\x24\x6d\x9d\x80\x19\x0e\x3b\x94\x13\x60\xd6\xd5\x41\x23\xd1\xd5\x7c\x6b\x9f\xd6\xba\x2c\x0a\x7a\xa9\x02\x03\x0a\x76\x67\xce\x10\x4b\x98\x51\xa5\xe8\x86\x93\xbf\xb3\x83\xdf\xd0\x23\x5e\x33\xa5\xe8\x8c\x5d\x21\xc2\x6d\x9b\x9c\x1d\x10\x71\xab\x37\x0f\xd8\x2d\x03\xcf\x07\xfc\x04\x25\x05\xea\x94\xb4\xb6\x06\x4d\x72\x3b\xbe\xa0\x13\xdf\x97\x5c\x6b\xd6\xbf\xac\x5c\x59\x5c\x45\x88\x8d\x2f\xc3\x1a\x1c\x2e\x25\xbe\xb9\x45\xe8\xdf\x2b\xfb\x71\x43\xdc\xdc\x8d\x22\xb5\xe9\x62\x13\x46\x26\x25\x67\x53\x32\xe5\x90\xab\x06\xd9\x5e\x27\x80\x8c\xd5\x7f\xb2\xac\xdb\x4d\x29\x56\xc2\x7c\x9d\xf9\xe8\xe7\x3d\x26\xdf\xb9\x89\xeb\xb2\x12\xe6\xb6\xeb\x37\x57\x9d\x66\x0f\x35\xbf\x7c\x4a\x66\x90\x45\x56\x5a\x63\xe8\x0f\xcf\xfe\xf3\x4f\x64\xb2\x30\x5a\x07\x28\xea\x5a\x6a\x44\x2d\x9c\xdf\x84\x8c\x89\x99\xd9\x6b\x2b\x02\xdb\x15\x9f\x61\x87\xa0\xd1\x09\x62\x98\x46\xb1\xfa\xfc\x76\xd2\x56\xb5\x4e\x53\x76\x77\xda\xd8\xff\x51\x26\x67\x63\xd7\xf3\xa5\x7f\xe2\x75\xf6\x6e\x9f\x52\x84\x76\x2f\xad\x39\x2a\x32\xe3\xc9\x22\xea\xb0\x78\xe8\x3a\x32\x97\xf7\xd6\x6c\x5e\xc3\xe1\xc8\x84\xdb\x89\xb1\xd9\x8a\x2a\xb3\x49\xca\x5f\x85\x1a\xf4\x4a\xb1\xd5\xba\xc7\xb5\xe7\x1c\xb3\x33\xfe\x13\xcb\xd8\xf8\x36\x91\xd1\x0f\x59\xba\xda\x0b\xe7\xb6\x47\x5d\x23\xde\x3f\x42\xbe\xa2\x59\x36\xa1\xc9\xed\x7b\xf9\x4a\xce\xd4\x5b\xf1\xb2\x2c\x65\xd9\x9e\x4b\x46\x11\xc6\x68\x32\xaf\xc4\xad\x6d\x1b\x11\x10\x34\xe4\xcc\x68\xf1\x45\xa5\x7d\xea\xfc\x9a\x05\xef\x25\x6c\x91\x28\xfc\x65\xea\x3d\x1e\xf5\x57\xd8\x47\x5e\xbb\x2c\x5c\xf9\x59\xbf\x3d\x65\x4e\x5d\x73\x7c\xca\x1f\x18\xb3\xec\x9f\x3f\xfb\xc3\x17\xf6\x78\x12\x59\x92\x2f\x9e\x41\x12\xb2\xea\xbf\x9f\x41\xd0\xc2\x1d\x62\x14\x8a\x9c\x66\x99\xb1\xc4\x9b\x07\xcb\x30\xca\xb8\x6e\x9e\xd4\x4b\xd1\x1f\xa4\x9d\x9d\x23\x1d\x77\x64\x76\xa6\xfa\xbf\x7f\xff\x0f\xd0\xfb\xb9\x56\x2c\x9b\x9e\xd8\x0a\xa4\x80\x38\x7b\x08\x97\xf4\xa1\x93\xe2\xb8\x12\xb2\x87\x53\xc8\xef\x64\x56\xe5\xec\x82\xdd\xf1\xd8\x26\x5b\xad\x37\xbd\x17\x31\xe3\x0a\x0a\xc4\x26\x99\x4c\x6e\x49\x6a\x7f\x89\x39\xfd\x3e\xab\x6c\x19\x2d\xbe\x6f\xa6\xc8\xdc\xb8\x8d\x23\x6f\x65\xc5\xe5\xb4\x28\xb8\x98\x41\x5d\x09\xc6\x73\x4d\xef\x5b\x13\x85\x73\x09\x80\x19\x11\x95\xb7\x71\xb1\x22\xfb\x21\x8c\xea\xb4\x66\xde\xf5\xcb\x7e\xbb\x0a\xf8\x6f\x9b\x2f\x2f\xb1\x35\x76\x6d\x91\x14\x2a\xab\xc2\x1a\xd8\x3b\xd9\xac\x25\x12\xca\xc1\x30\x00\xce\x4d\x15\x19\xa2\xc1\x67\x0d\xb6\xd6\x49\x84\xc8\x48\x4e\xb5\xd3\x2f\x7d\x48\x87\x92\x82\x95\x8a\x2b\x73\xe1\x7d\x0b\x7c\x74\x9e\x51\x8e\xcd\xa4\xac\x1d\xe2\xbb\x9f\x2e\x3e\x8e\x32\x6a\x70\x02\xe2\x8f\x51\x79\x7d\xd1\x55\x80\xfd\x79\x79\xf6\x98\x02\x30\x77\x9c\x64\xba\x92\xa9\x7b\x19\xee\x21\x0b\x76\xbe\x46\x25\xef\x17\x4c\x4d\x8d\x7d\xb5\x0b\xe0\x03\x88\xa6\x6f\xeb\x39\xb7\x25\x93\xf9\x49\x84\x68\xb2\x74\x1e\x41\x20\xc1\xc0\x06\xca\x23\x90\x44\x6e\x88\x6d\xa1\xe2\x4d\xbb\x08\x89\x64\x37\xbc\x81\x8a\xe0\x6c\xb1\x31\xb1\xf9\x18\x66\xef\xdc\x07\x90\x54\x0f\x5f\x1c\x3e\x88\x54\xb2\x4b\x56\xca\x82\xce\xd0\x4d\x89\x96\x56\x6e\x99\x44\x13\x4e\xc2\x28\xfc\xf0\x7b\x6c\xb0\xd9\x21\xc9\x01\x2d\x96\xd6\x28\x38\x80\x09\x65\x93\x09\xfc\xb6\x08\xb4\x40\x9f\x33\x57\x17\x7b\x4f\x17\x84\x96\xb2\x12\xa9\xf3\x8e\x05\x17\xe6\xeb\xa5\x49\xbc\x91\x02\x1b\xc9\x76\x31\x85\xf1\x12\x5e\x05\x04\x56\xb8\x20\xcf\xc7\xcf\x9f\x3d\xa5\x0b\x05\x46\xb9\x74\xa1\xbc\x09\x17\x8a\x3d\xab\x0f\x32\x5e\xdf\x3c\x60\xc0\x98\x5f\x3b\x57\x46\xe8\x0d\x00\x76\x0d\x78\x0c\xe1\x47\xf7\x25\x47\x28\xc3\xf6\xa9\x5b\xd3\x1d\x81\xfa\x6a\x2c\x8d\x46\x9d\xfe\x71\xcb\x6e\x40\xd2\x44\x77\x1a\x88\x87\x5e\x50\xd5\x64\x47\x12\xcd\x09\x25\x38\x52\xb1\x02\xad\x59\x32\xb9\x59\xb8\x45\x42\x2e\x68\x49\x0e\x0e\xc8\x91\xa5\x77\x68\x2b\x3a\x8f\x1f\x84\xf5\xdc\x22\xbe\xfc\x58\x20\x91\x46\x5b\x0b\xf9\xf2\x63\x41\xc1\xd7\x55\x6c\x5e\x51\xe4\x94\x6b\x1f\x21\x6a\x45\x91\x54\xbf\x64\x73\x7a\xc7\x14\x51\x3c\xe7\x19\x2d\xb3\x85\x59\xda\x6b\x3b\x67\x32\xa9\x34\x61\xe2\x8e\x97\x52\xe4\xd8\x5e\x34\x84\xdc\xd1\x92\x03\x1e\x4e\xc9\xa0\x42\xde\x98\x55\xff\xe3\xe8\xdb\xb3\x77\x90\x91\x73\xec\xa0\x03\xec\xba\x20\x49\x56\xca\x02\xe0\xb4\xe7\xde\x18\xda\x8e\x18\xc8\xcf\xdc\xec\x36\xb8\x2d\xdc\xbf\x23\x6e\xa0\xbc\xd2\x95\xed\xe1\xf3\x31\xc9\x2a\xc5\xef\x1e\x42\x22\xc6\xe8\xc8\x41\xb3\x79\xc2\x2a\xb2\x2b\xef\xbe\xe0\xbd\x67\x6c\xa9\xfc\xbc\x66\x06\x47\xa2\xae\xfc\x86\x10\x05\x5c\xd2\x01\xe5\x22\xce\x9f\xee\x03\x73\x87\x2a\x00\x34\x36\xd3\x4b\x4e\x90\x92\xd0\xa2\x37\xd8\x64\x5d\x8f\x25\xba\xa2\x28\x02\x4e\xcc\x10\x6f\xed\x6e\x9c\x4c\x18\x7e\xea\xe5\x0e\x14\x5f\xf4\x73\x84\x90\x29\xbb\x66\x19\x6c\xe2\xe6\xf1\xc4\x76\x3c\x46\x2d\x44\x3b\x0d\xb2\x31\x8e\x9e\xe9\x74\xce\xd9\xc5\xcc\xfa\x7c\xdf\x4b\x7d\xe3\x1a\xef\xf4\x7c\xbc\x73\x4e\xe8\x4e\xb1\xcb\x86\x27\xb6\xa5\x03\xce\xca\x9a\x62\x9a\x1e\xb5\x21\x67\xcf\xec\xb9\xa5\x19\x64\x41\xbb\xee\xbe\x19\x99\x19\x3a\xd6\x59\xe3\x90\xb7\xfb\x4e\xc0\x32\xbe\xa6\x85\x82\x2d\x64\x3a\x26\xd7\x32\x0f\x37\xb1\x59\x4c\xd7\x27\xbc\x8f\x62\xa3\xcd\x8d\x31\x2b\xe6\x54\xcc\xac\xd3\x5d\xde\x9b\x0f\xcc\x79\x61\x93\x66\xa9\x0e\xc4\xfb\xb4\xc1\x89\x7d\xb9\x99\x5b\xf6\x82\xfc\x60\x2c\x00\xf0\x7a\xcb\x7b\xc8\x43\xfc\xfa\xf2\x22\x88\xa0\xfe\xf4\x9c\xaf\xae\x61\xd1\xc9\xe7\x63\x87\xe4\xad\x67\x3c\x25\x13\x0b\x32\xa1\x98\x26\x47\x82\xdd\x5b\xd7\x00\x49\x2c\x5e\x42\x9f\x27\xa0\xa5\xbb\xf8\x91\x84\x81\xbb\x0f\x1e\x93\xdf\xdb\x2f\x16\xc6\x94\x83\xd8\x7e\xdf\xec\xb9\xb6\x79\x8f\x6f\xdf\x1d\xba\xa8\x40\x79\x3f\x2a\xef\x47\xa3\xd1\xc8\xac\x82\x6f\xb1\xd3\x6a\x31\xd4\x43\xd3\x06\x87\xa5\x26\xb9\x4c\xf9\x74\xb1\xb4\x3f\xe6\x6e\xaf\x87\x07\x11\x1f\x2a\xfa\xd2\xe1\xec\xbc\x7b\x30\x71\xf1\x29\x5f\xd8\x4c\x21\x77\x78\xce\x81\xcf\x30\x21\xb4\x76\xb4\x79\xcd\xeb\xa1\xe7\xf3\xc4\x68\x7f\x5c\x96\xfd\x4e\x53\xe0\x72\x70\xdc\x6c\x58\x43\x9f\x27\xee\x98\xc3\x96\x6d\xf5\x72\xbd\xa1\xc8\x3e\x16\xd2\x16\x62\x83\xff\x16\x80\x04\xdf\x37\xb1\x0e\x3d\x58\xa3\x39\xf0\x7d\x7b\xa4\x65\xfb\x40\x5b\xad\xd9\x15\x52\xf8\xa5\x24\x13\x6a\x3e\x18\xa6\x72\xd4\xef\x10\x68\x30\xcb\xf1\x98\x5c\x3a\x55\x00\x00\x04\x85\x74\x10\x85\x44\x0a\xc2\x8a\x39\xcb\x59\x49\x33\x37\x0c\xc4\xfd\xac\x6c\x23\x28\xaa\x5e\x18\x71\x5d\x1a\x36\xb7\xaa\x42\x4e\x0b\xdb\x1c\x10\xc2\x68\x29\x2f\x1d\x44\x76\x1f\x9b\xd2\xac\x72\x79\xc4\x07\x6f\xa1\x61\xd8\x6b\xae\xc0\x60\x3f\x00\x6a\x07\x67\x90\x2c\x75\x10\xaf\x20\xf9\x37\x83\xdf\xa2\x3b\x56\x8c\x55\x3e\x90\x4d\xf1\xa2\xdb\xe1\xa1\xab\x0e\x91\x8d\xf0\x22\x5a\x28\x39\xa8\x85\x1d\x77\x4e\x8a\xeb\x99\x14\x49\x7c\x19\x65\xd9\x15\xa5\x51\x8d\x75\xba\xee\x5e\xf2\xe1\x9b\xde\xc5\xb7\xbb\x23\xbd\xe0\x79\xf8\x46\x77\xa1\x89\x1d\x42\x73\xe8\x6f\x71\xd7\x6a\x5f\xd7\x37\xc6\xbe\xe6\x76\xcb\x76\xc6\xb4\xbf\x87\x5e\x47\x5b\xbb\x4d\x2d\xeb\xfa\x85\xe7\x9a\x86\x76\x7d\x87\xa4\x5f\x19\x89\x41\xaf\x44\x28\x4c\x3b\x87\x22\xc7\x3a\xec\x90\x1d\xe9\xa2\x7b\xd1\xc5\x0b\xc0\xcd\x5d\xe8\x56\xd1\x4c\xfb\xf6\x67\x73\xff\xb9\xae\x9d\xc7\x09\xd6\x1d\xe2\x96\x0e\x04\xa1\xef\xa1\xfa\xe9\x85\x67\x4c\xaf\xb9\xe8\x2e\x73\xc6\x50\xea\xd3\x18\x7b\x3a\x1a\xac\x26\x40\x23\x32\xbd\xf1\xad\xe5\x96\xdb\xc6\xf5\x12\x46\xc9\xa4\x5f\xe5\xc5\x8d\x8f\x94\x22\x9b\xc9\xc5\xb5\x91\x43\x1a\xf2\xa4\x46\xf6\x8a\x88\x02\x47\xb8\x55\x71\x4d\xe3\xe2\xda\xc5\x3d\x95\xb9\xe1\x5a\xff\xc4\xb5\x85\x7b\x2a\x73\xc3\x35\x81\x8b\x6b\xff\xf6\x34\xe6\x86\xf4\xb4\xc7\xb4\x79\x8b\x6a\xf0\xc6\xf0\x38\xf8\xe0\x1a\xe2\x0a\xfc\x6a\xbb\x12\x36\xd1\xfd\xd1\x1e\xa2\x33\x5a\x54\x4f\x34\xb3\xa2\x08\x9a\xe8\x6e\x68\xa1\xcb\x19\x82\x28\xa2\x0f\xda\x4a\x7f\x33\x04\xd9\xcd\x1d\xd0\x36\x74\x36\xc3\xc4\x54\xb7\xe8\x7d\xf6\xb0\x42\x6f\x57\x9d\xce\x22\x7a\x9c\xb5\x7a\x97\x21\x48\x77\x76\x37\x5b\xc3\x9c\x08\x92\x1b\xfa\x9a\x6d\xea\x57\x86\x3b\x35\x6b\x3a\x9a\x6d\xec\x54\x16\xb1\xa2\x35\x25\x7c\x8f\x32\x34\xcf\xe0\xe2\xaa\x88\x8e\x64\x58\xc9\xdd\x08\x6d\x80\x07\x2c\x46\x4f\x3f\x0b\x59\xca\x10\x14\x51\x4d\xf5\xbc\xae\x5c\x73\xa6\x5d\x9f\xee\x03\x78\xd3\x4b\x8a\xf3\x09\xf0\x95\x0b\xb4\xad\xdc\x73\xbd\xd2\xa3\x28\x79\x4e\xcb\x05\xf9\xfa\xf2\xc2\xea\xcf\x2d\x13\x40\x48\x3f\xec\x70\x46\xd2\xfe\x84\x00\x63\x79\x88\x05\xf6\xb2\x45\x65\x2e\xc6\xd4\x68\xe3\x8b\x7b\x71\x91\x6f\xb5\x50\x89\xce\x62\x36\xfd\xda\xbe\x41\xe6\x32\x4b\x09\x0d\x1c\x20\x68\xce\x54\x41\x13\x23\xa5\xed\x5f\xf4\x2b\x4d\x69\xc0\x46\x87\x60\xd8\x95\x4c\x95\x8d\xbd\x54\xa2\x06\x1e\x72\xd4\xc8\xd1\xa4\xd7\xf3\xbe\x4e\x54\x1c\xbb\xf0\xb7\xf7\x49\x65\xb4\x12\xc9\x7c\x07\xbb\xb6\x66\x51\x42\x54\x83\x92\x5b\x56\x0a\x96\xd5\x3d\x19\xac\xfd\xda\x2b\x27\xfa\x9d\x4e\x31\x49\xa5\xd8\x24\xbb\x35\xc0\x91\x53\x2b\xe3\xcd\xa7\x16\x0e\xb9\x17\xa3\x89\xc6\xa4\x90\xa0\x11\x5d\xdb\x79\xbd\x16\x41\xe3\xc1\xc7\x87\x4d\x70\x41\xa5\xac\xe0\x30\x5a\xd1\x69\x2d\xb8\xa3\x1d\xd3\x60\x32\xbe\xb5\x64\x7f\x44\x39\xa2\xa9\x64\x48\xaf\xc6\x7a\x74\x0e\xd5\x8a\x7b\x6c\x5d\x5f\xc9\xfe\xe6\x0a\xb1\x0e\x5c\xd4\x46\xee\xd0\x81\x8b\x3f\xee\x43\xfa\x47\xc6\x74\x8e\x84\xdf\x23\xce\xd8\xe3\xf4\x8c\x8c\xe8\x16\x09\xdb\x81\x20\xb9\xc3\x3e\x91\x11\x72\x66\x68\x6f\xc8\xc8\xae\x90\xb8\xa2\xa4\xad\xfb\x41\x46\x4c\x3c\xaa\x07\xe4\x83\x74\x7f\x8c\xe9\xfb\x08\xf9\x00\x08\x9a\xa8\x8e\x8f\x8d\x4e\x8e\x28\xfb\xf7\xa1\x7a\x3d\xc6\x74\x79\xf4\x7f\x8c\x21\x8b\xee\xef\xe8\xfb\x6b\x61\xbc\x0b\x88\xce\x8e\x64\xb5\x63\x23\x82\xf0\xa6\x9e\x8e\x9b\x7b\x35\x22\x88\x6e\xec\xe6\x88\xea\xd2\x88\x12\xb3\xae\x8f\x23\xb6\x3f\x23\x82\x26\x2c\xe4\xa0\xce\x8c\x31\x85\x01\x91\xdd\x18\x23\xfa\x30\x2e\xc5\x20\x71\xdb\xd4\xd3\x81\x71\x7d\x67\x45\xd4\x0d\xb5\xda\x7b\xb1\x33\x1e\x89\xa3\xb9\xa6\xeb\xe2\x76\x91\x21\xf2\xd0\xd1\x21\xb2\x26\x42\xf4\xd8\x2e\x6a\xc4\x1f\x0d\xc4\xae\x6c\xb3\xe7\x20\x8c\x37\x9c\xfd\x8f\xb1\xfc\xb5\x2c\x64\x26\x67\x8b\xeb\xa2\x64\x34\x3d\x97\x42\xe9\x92\xf2\xce\xaa\xcf\xf6\xf8\x37\xbd\xbf\xf1\xf5\x5e\x53\x19\x45\xbf\x81\x63\x0c\x28\x1b\x92\x28\xf8\x8b\xce\x8d\x87\x84\x32\x70\x31\xcb\x54\x11\x9a\x4b\x77\x9f\xcc\xf8\x1d\x13\x61\x29\xba\x38\x0d\xab\x5c\x43\x54\xa6\x3f\x23\x7c\xcd\x84\x5f\x35\xdf\x0c\x00\x7e\x5a\x92\x29\x17\x69\x98\x41\xef\x61\x30\x33\x74\xfe\x11\x50\x01\x7c\xf1\x1b\x57\x2e\x62\xa4\xfc\x27\x68\x69\xa4\x19\x14\xe0\xf4\x1f\x31\x59\xab\x4f\x56\x51\x0c\x70\x7c\xb0\xa4\x36\xd9\x96\x97\x24\x91\x65\xc9\x54\x21\xc1\xa3\x8f\x20\x6b\x57\x9e\xa4\x32\xa7\x5c\xf4\x1d\xf5\x18\x87\x06\xcc\xfb\xe5\xc7\xa2\x74\x80\x5d\xd1\xde\x83\x65\x02\x36\x04\xe7\x7d\x58\xb0\x96\x08\x92\xa4\x5e\x6f\xe7\x26\xc8\x8d\x7a\x66\x73\x8e\x9b\x3f\x81\xed\x38\x7b\x73\x81\xab\x86\x8a\xe8\x4e\xb4\xec\x86\x6d\x33\x41\x63\x08\x30\x41\x14\xc9\xc6\xa4\x9a\xa1\x7b\xa7\x13\xa9\x13\x70\x6a\x2d\x4e\x2c\x70\x18\xb6\xf2\xd7\xb6\xea\xf4\x24\x21\x0a\xe4\x8c\xb6\x5b\x66\x61\x59\x2c\x75\x5c\x85\x52\x7c\xc7\xa3\x5b\x86\xac\xd8\x24\xcb\x8b\x6a\xc6\xe7\xa1\x38\x60\x75\xcd\x0f\x7c\x36\x1f\x9a\x64\x63\x51\x5d\x3c\x17\x8d\xd0\x40\x86\x75\x1c\xf2\x2b\x3e\x70\xda\x61\xc3\x4a\x66\x0e\x89\x65\x62\x33\xf7\x43\xe5\x82\x78\x52\xa8\x39\xc7\x44\xa0\xc2\x24\x24\xa1\xa0\x9f\xc8\xa9\xdf\xed\x10\x84\xb2\x1f\x83\x73\x12\x41\xf1\x52\x9c\x90\x37\x52\x9b\xff\x7b\xf9\x91\x2b\x6d\x95\xea\x0b\xc9\xd4\x1b\xa9\xe1\x27\x0f\xba\xc4\x76\x12\x03\x17\xd8\xe5\x46\x73\x0b\x1d\x56\x96\x74\x01\x71\xbd\x41\xed\xb0\x94\x03\xc1\x63\xf5\xb6\x71\x45\x2e\x05\x91\xa5\x5b\x9f\x98\x6d\xf2\xba\x9e\x72\xc3\xf2\xb1\x61\x63\xac\x40\xfe\xb7\xff\x5a\x04\xd1\xe6\xb8\xdc\x56\xc9\xb2\xb5\x53\x98\xb4\xc8\xd5\x89\x2f\x0d\xd1\x0d\x0f\x72\xf6\xed\x6f\x22\x3a\x75\x12\xc3\xeb\x19\x44\x2e\x5c\x37\x62\x6a\xf6\x83\x6a\x36\xe3\x09\xc9\x59\x39\x03\x78\x97\xbe\xa0\x41\xf3\x89\xec\x33\x37\xb0\xb5\x58\x6c\x5b\xae\xb8\x56\x64\x23\x73\xea\x91\x7f\xe9\xb7\x19\xf5\xe7\x91\x0d\xc8\x62\x66\x09\xd7\x3b\xe8\x5c\xa8\xd5\x8f\x2d\xe1\x5b\x1e\x14\x7a\xbf\x56\x75\x10\x3b\x48\xab\x7e\xe4\x14\xca\xb5\xfe\xaf\xb9\x5e\x81\xbd\xff\x1f\xee\x36\xa4\xbc\x54\x63\x72\xe6\x90\x55\x9b\xef\xfb\x3a\xa9\xc6\xa7\x50\x24\xcd\x48\xb8\x22\x86\x4f\xee\x68\xc6\x6c\xa3\x4a\x2a\x08\x73\x0d\x3d\xe4\x74\x45\x83\xc2\x89\x98\xfb\xb9\x54\xf6\xce\x0f\x0e\xb2\x83\x5b\xb6\x38\x38\x59\x91\x5e\x07\x97\xe2\x00\x47\xd3\x83\xba\xb5\x24\x42\xd0\x5a\xc0\xd5\x76\x00\xbf\x3b\xc0\x66\xc5\x90\x2d\x94\xb7\x87\x68\xde\x9f\xd3\x8f\xd7\xb7\xec\x3e\x0e\xdb\xf3\xb5\x7d\xa9\x01\x7a\x63\x31\xa5\x66\x25\x8b\xc0\xf5\x04\x03\x20\xa7\xe0\xad\xac\x04\xbb\x63\x66\x39\x53\x6e\x38\x7e\x52\x01\x5e\x02\x40\x90\xdc\xdc\xcf\x99\xf8\x20\x14\xd5\x5c\x4d\xa1\xe6\xfe\x2f\x17\xf2\x8d\xd4\xd7\xc9\x9c\xa5\x55\xc6\x6e\x10\x88\xb7\xda\xeb\x59\x39\xfd\x08\xd0\xe9\x50\xe9\xa4\xb5\x91\xc7\xae\xc5\x7d\xc2\xc8\x84\xe9\x7b\x66\x5b\x15\xf4\xc7\x3d\x83\x1d\xd3\xb6\x11\xdd\xa9\xd0\xb4\x9c\x81\x73\xcb\xd9\x29\x18\x30\x16\x30\x2c\x33\x39\xa1\x19\xc9\x2d\x8e\xf8\x98\x7c\x25\x4b\xc2\x3e\xd2\xbc\xc8\x98\x4d\x54\x20\xbf\x1f\xfd\x5b\x0a\x46\x92\xac\x52\x9a\x95\xfd\x73\xf7\x7b\x55\x23\x09\x3f\xb7\x0a\x76\x11\x42\xe1\xc1\xa5\xd9\x32\x42\xfb\x03\xc9\x60\x3f\x13\xaa\xc8\xf3\xd3\xe7\xa7\xcf\x5e\x90\x9f\x89\x19\xda\x73\xf7\xff\x9f\xbb\xff\xff\x3d\xf9\x99\xfc\x4c\x08\xb9\x22\xa4\xf5\xff\xe6\xf9\xb9\xf7\x23\x23\xc2\xa7\xcd\x39\x3c\x37\xcb\x90\xc8\xdc\x2d\x38\x49\xa8\x08\x2e\x6f\xe5\x38\x02\x65\x96\xda\xa1\x41\xc8\x3c\x91\x39\x83\x39\x3c\xff\x9f\x9e\x06\x34\xc4\xd0\x44\x0a\xf7\x97\xcf\x8f\x60\x4a\xfd\x61\xa3\x7b\xf0\xf7\xe7\xf4\xd6\x9a\xbb\x67\x89\xae\x68\x66\x06\x7f\xf4\xf9\xe8\xd9\x31\x91\xa2\x45\x8e\xdc\x71\x99\x61\x70\x0a\xdd\x0a\x1c\x3d\x3f\x1e\xaf\x2c\xc9\xe7\x6b\x96\x04\x93\x07\xe0\x57\xcb\xce\x92\x8a\x05\x0c\x6d\xf3\xa9\xf3\x07\xee\x4c\x2c\xee\xe9\x02\x7d\xec\xbc\x3b\x62\xc6\xef\x18\x99\xf3\xd9\x1c\x5c\xa0\x21\x8f\x5b\x4b\x7f\x4a\x30\x99\xa8\xb6\x75\x3a\x0c\x6a\x41\xb8\x1e\x93\x4b\x7d\x78\xa8\xa0\x0b\x94\x55\x3c\x5c\xf8\x2c\x80\x30\xe2\x1a\x97\x1a\xc6\x82\x43\xf1\x6c\xc9\x29\xdf\x0b\x14\x1b\x83\x9c\x1f\x91\x58\xe3\xe4\xc6\x37\xfd\xb6\xe5\x5a\xe7\xd7\x37\xb5\x65\x69\x2e\x44\x39\xb5\xb9\x72\x70\xbe\x11\x86\xf0\x1b\x99\xfa\xba\x32\x17\x91\xb0\xf6\xa9\x93\x16\x5c\x05\xd3\x9a\x43\x08\x2f\x41\x60\x04\x37\x0a\x36\x1b\x0d\xdd\x6c\xc9\x85\x13\x9a\xca\x36\xc4\xea\x77\xaa\xd9\xe7\xbb\x9a\x92\xcd\xe9\xfa\x2f\x70\x1e\xc0\x87\xfe\xdb\xc6\xda\x0e\x26\x55\x72\xcb\xf4\x81\x95\x78\xba\xec\x57\xef\xb4\x24\x45\xa5\xc9\x84\x66\x54\x18\x75\x7d\xc5\x5f\xa5\xa5\xfd\x98\xa5\x0c\x0c\x88\x00\xb6\x5d\xe6\x4f\x14\xa7\x20\x74\xbf\x95\x53\x1a\x77\x91\x7f\xb7\xfc\x7a\x23\x2b\xd4\x79\x49\x53\x86\xd8\x5c\xd7\x96\xc7\x48\x1f\x28\xbe\x83\xf2\x3a\x71\x78\x58\x9f\x55\xd8\x5f\x7b\x61\x24\xc1\x27\xdb\xbf\xc7\x23\xd2\xba\xeb\xc9\x91\xcb\xb9\x3c\x26\x9a\x65\x99\xe5\x70\x2f\xc8\x4a\x82\x81\x15\xd1\x32\xbc\x00\x02\x64\x44\xda\x72\x6d\x1d\xe1\x28\xa2\xbe\x6b\x8e\xb9\xad\xc5\x22\xe4\x0f\x9f\x10\x02\x50\x43\x33\x8e\xea\x9b\xd7\x23\x28\xed\xe1\xb4\x97\xcd\x9c\x65\x05\x29\x59\x5a\x21\xfa\x09\x98\xc1\x11\xa2\x6e\xd9\xbd\xd1\xea\xeb\x9d\x20\x16\x45\xd7\x1f\xcb\x83\x16\x53\xf4\xe7\x9e\x4e\x65\x69\x74\xf8\xd6\x35\xc4\xa7\x70\xe8\x3c\x1a\x19\xbb\x63\xe5\x82\x14\x52\x29\x3e\x41\xe4\xa4\x83\xbc\xa2\x4a\xf1\x19\x58\x05\xa1\xda\xc7\x50\xb6\xd3\x76\x57\x27\x39\x70\x57\x61\xff\x28\xa5\x20\x4a\x36\x45\xcc\x2f\x44\xbd\xfa\xbd\x51\x4d\xba\xd5\xab\x2b\xf3\xbf\x5e\x92\xab\x6a\xd8\xe5\x94\xac\x91\x01\x61\x2e\xad\xc3\x87\xb8\xf0\x7b\x35\x33\xaf\x79\x7d\x0e\x0a\xd0\xef\xfb\xf5\xa9\x5a\x41\xfb\xfd\xe9\xe7\xa7\xcf\x8f\xcc\x5a\x7c\x7e\x6c\x56\xa5\xa5\x5a\x3d\x0f\xaa\x15\x9a\xb2\x9b\x31\x53\x2d\xe5\xea\x52\x78\x28\x44\x59\xa6\xca\x15\xf1\x59\x66\xe8\xa5\x68\x66\xac\xb4\xcb\x11\xe3\xb9\xbf\x43\x4e\xe0\xdc\xd7\x52\xea\x5e\x82\x64\x34\x3a\x22\x46\x85\xfa\x2c\x97\x25\xfb\xac\x41\x6f\xa3\xd2\xb3\x23\x50\x7b\x1c\x4e\x92\x33\x1c\x3b\xff\xa6\xa1\xc6\x74\xfe\xdd\xca\x2d\xd6\xf1\xd7\x3b\x02\x60\x72\x90\xb3\xc8\xa0\xa5\x45\x7d\xdc\x55\x88\xd2\xe1\xbd\xb6\xdc\xdf\x36\x33\xcc\xa1\x7a\x78\xf0\x1e\x10\x79\x3d\xe6\x3b\x9c\x2f\xdb\xaf\xd2\xb5\xc1\x69\xa6\x8e\x37\x40\x8c\x77\x11\xa5\xa4\xf7\xea\x65\x46\x95\xe6\xc9\x97\x99\x4c\x6e\xaf\xb5\x2c\x23\x35\x8e\xb3\xef\xae\x57\x28\xb4\x56\x42\x90\xb3\xef\xae\x7b\xcf\xc5\x05\x57\xb7\xa4\x64\x4a\x56\x65\xe2\x31\x05\x94\xef\x5c\x63\x6b\x77\x43\x01\xd0\xa1\x22\xa8\xaa\x93\x9c\x1a\x3b\x9e\xd5\xcd\x6c\x3c\x66\x4b\xdd\x63\x35\xba\x53\x97\xd2\xb2\xa4\x33\x76\xea\xd8\xed\x77\xf4\x5e\x31\x3b\x7d\xc0\x22\x37\xbf\x66\x7d\xc7\x36\x26\x24\x35\x55\xef\x51\xf5\x40\xcb\xdb\xf2\x55\xc0\x4e\xb6\x05\x4b\x6d\x9c\x9b\x5e\x36\xf4\xcf\x42\x56\xe4\x9e\x5a\x9f\x1e\x40\xf2\x8d\xc9\x7b\x5e\xbc\x20\x2f\x1b\xd8\x0f\xbe\x01\x8c\xfd\x1c\xda\x15\x0a\xb7\x52\xc8\xd8\x77\x29\x94\x80\x7a\x6b\x5d\x7b\xe6\xda\x71\xe8\xcf\x28\x9a\x2f\xed\xb5\xaf\x5e\x90\x03\xf6\x51\xff\xe1\xe0\x84\x1c\x7c\x9c\x2a\xf3\x7f\x42\x4f\x01\x37\x26\x2f\x32\x9e\x70\x6d\xd4\x17\x31\x65\x65\x89\x04\x72\xb4\x26\x8d\x25\xba\x9a\x8f\xb3\x7b\xf6\x41\x0d\x0a\x5a\x2b\x83\x4a\x9f\x4a\x72\x0f\xc5\x78\x77\x46\xaf\x72\x39\x73\x4e\x4a\x44\x6e\x0b\x20\xa5\x26\x32\x2f\x4a\x99\xf3\x00\x5e\xe9\x8e\x11\xa6\xed\x5c\x94\x8b\xbb\xa0\xa5\xe6\x58\x08\xe6\xa5\x66\xd6\x80\x9e\xef\x5e\x5f\x02\xf8\x02\x9e\x5c\xc8\x0a\xe7\x63\x6e\xf3\xf6\xe5\x94\x48\xeb\x46\x3c\x71\x1e\xd0\xd0\xf9\xc1\xff\x11\x8a\xec\x64\xe1\x47\x63\xae\x81\x71\x83\x37\x8d\x82\xea\x7e\x05\xad\x6f\x54\x4a\x9f\xe3\x5c\xd7\xe6\x28\x5a\xc6\x73\x60\x6b\x61\xfe\x54\x91\x83\xe7\x07\x63\x72\xed\xd1\x50\xf1\xf1\x31\x37\x94\x9a\x96\xd1\xcb\xfd\xc0\xc0\xb5\xfe\xec\x80\x1c\xd9\xb6\xa9\x46\x1d\x42\x11\xcd\x98\xcf\xab\x0c\xd5\x16\x10\x6c\x3b\x46\xb5\xb3\x7e\xd8\x0e\xea\x31\x98\xcc\x6d\x9e\xbb\x76\x6b\x7f\xa0\xcb\x8a\x1d\x40\x3e\x8e\x34\x77\x15\x34\x8d\x67\xf8\x10\xff\x3b\x37\x82\x7a\x71\xb8\x68\x22\xcf\x03\xab\xd9\x8f\x8c\xfb\x91\x45\xec\xb3\x89\x69\x0f\x00\x03\xe7\xe0\x93\xdc\x72\x24\x1a\xfb\xd9\x7e\xed\xf2\x22\x7e\x6f\x3e\x08\xfe\x53\xc5\xc8\xe5\x45\xe8\xfb\x1d\xda\x44\x90\xb4\xa9\x59\xa0\x56\x93\x83\xca\x42\x8e\xce\x72\xfa\x6f\x29\xc8\xcb\x2f\xaf\xdd\xd0\x8e\x3f\xf1\x42\xee\xb8\x08\xc9\xaf\xf7\xae\x42\x4f\xf4\xdf\x55\xc9\x8c\x22\x17\xa5\x41\x9e\xf9\xb7\x96\xb5\x46\xf3\x73\x72\x41\x35\x05\xe5\xb0\x5f\xdb\x83\xb6\x0d\xae\x92\x18\xf4\x08\x73\x32\x27\x90\x33\x07\xbf\x6a\x68\x7d\x3b\x54\xd0\x12\xb8\x1d\x67\xaf\x65\x3a\x40\x4b\x83\xca\x81\x73\x4b\x81\xe4\x86\x04\x79\x23\x05\x3b\x01\x21\x41\x8c\x94\xc0\xdd\x0d\xf0\xe7\xdf\x95\x5c\x33\x94\x88\x8d\xba\xa6\xcd\xf9\xc1\x65\x5d\xaf\xc9\xbb\x7e\xd3\xe8\xc5\x0f\xf9\xcc\x70\x1a\xdd\x85\x3d\xc9\xe4\x04\x35\x3d\x77\x8a\x1e\x62\x66\x1f\xde\x5d\x0e\x9a\xd8\x87\x77\x97\x9b\x27\xf5\x50\x03\x1e\x68\x0c\x2c\xdb\x02\xb5\xbe\x13\xd0\x17\x70\xea\xe1\x12\x9d\x1e\xed\x1d\xb7\xb5\xae\xbf\xcb\xcb\x8f\xe3\x7e\xbd\x1d\x29\xbd\xad\x6e\xdf\xa9\xb7\xef\x7a\x67\x6e\xb9\x40\xa5\xc8\xb4\x8f\xff\x4b\xdf\xa5\xde\xc5\x52\xae\xe7\xd4\x08\xee\xba\xd7\x2c\xfa\x84\x18\x0e\x54\xe6\xd6\xf3\xac\x47\x68\x02\x29\xbd\x84\x5c\x30\x1b\x00\x48\x5f\xf8\xa4\x8f\x28\xaa\xeb\x89\xbe\xa6\x82\xce\x0c\x49\x90\xf9\x24\xb7\xff\xc4\x11\x0d\x27\xe6\xc8\xba\x92\x85\x7f\x9d\xd0\x3b\xca\x33\x3a\xe1\x19\xd7\x0b\xa3\x53\x21\x7b\x23\xa4\x8d\xb2\x0b\x05\x4b\xb8\x73\x21\x38\x58\x6d\x6c\x96\x84\xd8\xee\x1b\x47\x86\xd6\x29\x34\xf0\x38\x1e\x07\x6d\x10\x35\x51\x28\xab\x84\x22\x29\xab\x7a\x9a\x33\x17\xd4\x49\x57\x6c\xbb\xac\x4d\xe2\x59\x1d\x59\x89\x83\x56\x2f\xfc\xc5\x81\xfa\xc3\x0f\xef\x2e\x77\xaa\x86\x7c\x85\x80\xde\x59\x55\x43\xa0\xbb\xe6\x3a\x35\x04\x7e\x71\xcd\xca\x3b\x8e\xd0\x21\x3f\x91\x26\xb2\xe7\xd1\xfa\xb1\x90\xc4\x83\xd4\x96\x66\xbf\x39\x4b\x66\x29\xb7\x1d\x38\x02\xb5\x16\xd7\x4e\x6c\x9e\x39\xb1\x09\xda\x90\xe1\x85\x6e\xff\x79\x7b\xca\x48\x09\x05\x82\x6f\xd0\x84\xe1\xd6\x21\x88\x93\x1a\x39\x26\xbc\xa4\xa8\x77\xab\xff\x4f\xfd\x34\x77\x25\x2d\x12\x56\xcc\xa7\x71\xc0\x1d\xe7\xac\x98\x7f\x75\xdd\x76\xf7\x9b\x9f\x91\xaf\xae\x5b\x67\xbf\x3f\x26\x0c\x3d\xbe\x20\x03\xc6\x4c\x4b\xd9\x20\xc1\xa1\x22\x19\x9f\x32\x04\x66\x51\x54\x19\x8c\x14\x5c\xcb\x32\xbe\xfc\xe5\xf0\x9d\xdf\x47\xf2\xda\xd1\xb0\x29\xa8\x89\xcc\x32\x96\xe8\x7e\xa0\x7c\xfb\xc8\xa9\x5d\x24\x3f\x90\x75\xa6\xb4\x0b\xdd\xaa\xf1\xed\x17\x60\x4c\x3b\xb3\xf9\xd4\x6e\xd2\xe9\xbb\x97\x67\x17\xaf\x5f\x8e\xf3\xf4\x77\x73\x79\x3f\xd2\x72\x54\x29\x36\xe2\x1a\x73\xdb\x47\x64\x57\x47\xe6\xe9\xc6\xe4\x1a\x17\xc8\xc6\x56\x9b\x3a\xaf\x7f\x80\xc6\xf8\x2e\xf3\xd1\xb7\x08\x93\xb2\x17\x2d\xd6\x3e\x25\x75\x1d\x76\xa9\xf3\x0e\x57\x59\x66\xf7\x44\x97\x8c\x9d\x34\x1d\x47\xa7\x4f\x47\x83\x6a\x4c\xbf\xe7\xa2\x8a\x73\xbf\x61\x2e\x2a\xac\xbb\xa8\x75\x99\x3d\x2e\x63\x0f\xb9\x17\x31\xca\x11\xe9\xd8\x87\xeb\x40\xa5\xd5\xca\x16\xd9\x3d\xee\x96\x2d\x08\x94\x4c\x4c\x65\x09\x85\xd9\x6d\xce\x63\x3a\x81\x65\x39\x05\xf8\x72\x3b\x60\x14\xdd\x4f\xb0\xec\xd8\xbb\x19\x26\xf1\x8e\x4d\xb7\x5f\xf4\x77\x6c\x6a\x56\x29\x34\x29\xc3\x2e\xba\xd9\x24\x5a\xe9\xb9\xcd\xee\x03\x57\xbf\x53\x6e\xd6\xed\x02\x8a\xa4\x2b\xa0\x79\xd4\x65\x8f\xad\xe6\xc3\xb7\x8c\x5c\x5e\xf8\xa6\xe7\xca\xad\xb6\x6e\x4e\x16\x49\x94\xa0\xdc\xc4\xf2\xce\x98\x16\xec\xfe\xd4\xf5\x03\x1b\xdd\x73\x3d\x1f\x59\xed\x45\x9d\x02\x4c\xda\xe9\xef\xe0\xff\xd0\x9f\xb5\x01\xc2\xb3\x34\x75\xe9\x2f\x95\x62\xd3\x2a\xb3\x89\x25\x6a\x4c\x68\xc1\xbf\x65\xa5\xe2\x32\xa2\xde\xeb\x96\x8b\xf4\x84\x54\x3c\xfd\x2b\x66\xab\xc8\xd0\xab\x14\x59\xe5\x83\x03\xd0\xed\xbc\x4b\x4b\x2f\xbe\x4a\x9a\x4a\x65\xe1\x1a\xcc\x2a\xe3\x96\xa4\x21\xb4\x68\x9a\x73\xf1\x64\x05\x10\x5e\x11\xf7\xba\xd9\xce\x94\x6b\x2e\xd2\xfe\x5d\x6a\xef\xd0\x39\xbc\xd3\xd6\xae\x2d\x1d\x1f\xad\xf4\x59\x21\xbd\xab\x44\xbd\xc5\x6d\xf1\x69\x5d\xfe\x88\xcd\x1e\xf1\x61\x6d\x94\x00\xcb\x17\xea\xa7\x6c\x64\x47\x31\x2a\xd2\x7a\xdf\x9e\x62\xaa\xc7\x8a\x7b\x17\xc5\xcd\xf4\x81\xdd\xbb\xe8\xe4\x8c\xdd\x39\x79\x63\xa5\xf5\xce\x77\x9f\xec\x95\x64\xff\x0c\x51\x92\x77\xb0\xee\x71\x7a\xf1\x96\x1a\x1a\x00\x08\x29\x5f\xbb\x0e\xca\x15\xba\x62\x35\xe0\x14\x5a\xfc\x6b\x87\x00\x5a\x17\xdb\x24\x52\x08\x96\x80\xeb\xf0\x6d\xc1\xc4\xb5\xa6\xc9\x2d\x2a\xd6\xb7\xd7\x98\xb0\x9f\xfd\xd5\x6b\x4c\xc3\xf3\x2b\x7c\x1a\x6b\x1a\xf8\xd1\xd6\x09\xb9\x54\x24\x54\xd3\x38\xfb\x70\xe1\x2e\xf3\xc7\x92\x00\x4f\x3c\x67\xc2\x02\xd5\xbf\xa6\x3d\x5d\xe4\x56\x5b\x08\xdb\xb7\x96\xd4\xa4\xf0\x63\xe7\x54\x44\x21\xa9\x17\xb2\xa8\xa0\xf0\x02\x0a\xc1\x50\x7b\x19\x23\x54\x9c\x9a\x3c\x2c\x41\xa2\x16\xae\xb9\x4c\x99\x6d\xb9\xe9\x59\x50\x31\xdd\x6c\x70\x88\x73\x42\x0a\xdf\x34\xd4\xb5\x10\x9d\x2c\xfc\x00\x1b\xd1\x70\x41\x64\xa2\x11\x55\x52\x24\xb4\xdf\xf2\x95\xc6\xcf\x9e\x3d\x7b\x66\xeb\x0e\xff\xfc\xe7\x3f\x13\x59\x42\x5f\x83\x84\xe7\x34\x43\x16\x2e\x12\x00\xb6\x77\xc4\x80\xd2\x1f\x9f\x3f\x1f\x93\x7f\x9c\xbd\x7e\x05\x49\xe1\x85\x56\x16\xb0\x0d\x46\x88\x2a\x43\x26\xb0\xaa\x8d\x41\xa8\x13\xf2\xf7\xeb\xb7\x6f\x3c\xd7\xab\xa5\xdf\x9a\xab\x1e\x45\x34\x6c\x49\x1b\xf9\xee\xd9\x9f\xfe\xf0\x87\x31\xb9\x70\x1d\xad\x39\xf3\x48\xbd\x68\x3f\x05\x38\x93\x68\xc9\x6c\xdd\x26\xa0\x32\x7a\x25\xd4\x56\xb2\x18\xad\x03\x8d\xae\xd9\xe8\x65\x6d\x84\x8f\x14\xd3\x8c\x27\xda\x56\xf2\x58\x41\x1f\x9a\x88\x40\x0b\x15\xf8\x1e\x7a\xac\xb6\x23\x86\x4c\xd9\x09\xc9\xf8\x2d\xf3\xbd\x3a\x4f\x02\xd2\x80\x83\xb3\xc4\xe6\x4e\x4e\x98\x1b\x54\xcd\xef\x8a\xe9\x27\x90\x38\x89\x76\xa0\xb7\x9b\x1a\x2e\xe1\x37\x43\xa5\xe5\x2d\x5b\x8c\xec\xb1\x29\x28\xc7\xb1\x9a\x4b\xae\x81\x6c\x30\x8b\x06\xd1\x56\x34\x12\x96\xd6\x42\x11\x45\xd1\x43\x3f\x17\xa5\xfc\xd1\x32\x18\x94\x82\x36\x12\x89\xa1\xe0\xd4\xf6\xc3\x98\x4b\x85\x3b\xba\xa2\x01\xa1\xeb\x4b\x6a\x1d\xd4\xb0\xff\x31\xcc\xdc\xe2\x2f\xe2\x5b\xbc\x13\xc7\x6e\x19\x57\x66\xa8\xb7\x6c\xa1\xba\x66\x10\x83\x39\x69\xa3\x12\xca\x72\x6c\x25\x56\xbe\x60\x0e\xa1\xed\xf6\x62\xae\x19\x9c\xfd\x70\x39\xb5\x00\x50\x90\xe3\x1f\x50\x20\x6d\x5b\x12\x57\x8f\xed\xe8\xf5\xa3\x6d\xd7\x93\x0f\x1b\x7c\xd2\xdc\x26\xc5\x74\x55\xd8\xa1\x42\xf2\xbb\x99\x43\x7f\xeb\x08\xfb\xd8\x82\xf6\x9c\x96\xb7\xcc\xb7\x37\xa1\xd9\x98\x5c\x99\x05\x09\x40\x41\xbe\x0f\x0d\xce\x8c\x06\x88\xbe\x05\x4c\xd1\x69\xf4\x30\xd8\xc3\xf1\xf8\xd0\x0a\x36\x59\xba\x1e\x97\x46\x02\xa1\x68\x9a\x77\x1f\x12\x0b\xee\x35\x74\xec\x75\x9a\x92\xc5\x2c\x33\x26\x0c\x8c\x36\x42\x74\x13\x42\x7d\xb3\x69\x1c\xdb\x3d\x22\x32\xdb\x7b\x77\x1e\xb5\xf4\xc7\xe5\x41\xd1\xbe\x72\xa4\xb2\xb3\x66\xa4\x5d\x2a\x0f\x9a\x22\x59\x56\x8e\x6c\x44\x1a\x30\x9a\x33\xd6\xd4\x74\x22\x48\x5a\x8d\x03\xab\xef\x44\x10\x5e\x22\xd9\xa1\xf5\x44\x8f\xd6\x90\xea\xd4\x7d\x22\x28\xae\x6a\x49\x4d\x0d\x28\xb6\x2b\x75\xf3\x69\x48\xb3\x86\xb6\xbc\xd4\x9d\x00\xad\xee\xd8\x07\xa9\xf4\x44\x8d\x32\xa8\x47\x9d\xaa\x4f\x04\xc9\x55\x25\x69\x1b\x05\xc8\x3e\xb1\x6a\x90\x9b\x5c\x94\x32\x64\x1f\x6c\x34\xdf\x3e\x2b\xf2\x28\xf4\x37\xb3\xd7\xc2\x34\x2c\x6a\xcc\x9e\x48\xc0\xdf\xd2\x41\xbc\x59\x84\x68\x77\x67\x53\x41\xe8\x44\xc9\xac\x42\x00\xd2\xb4\xa7\x55\x93\x69\x5e\x62\x30\x50\x8f\xeb\x85\xbd\x95\xfc\xe3\x09\xd6\x97\x9f\x55\x55\xec\xad\x13\x47\x2d\x5a\x24\x3f\x1c\x8c\x9d\x59\x93\x88\xb4\x92\x07\x81\xb0\x1b\xd4\xb3\xa7\xd7\x5f\xf7\x29\xfc\x70\x78\x1f\x9c\xf5\xad\xa1\x88\x62\xfd\x6f\x51\x5c\xe5\xb5\xc5\xf8\x74\x3b\x57\x47\x76\x3f\x67\x2e\x1d\xa7\xa1\xd9\x9a\x2b\x94\x77\x40\x67\x37\x1f\x50\xd1\xbd\x92\xea\x7a\xea\xed\xd8\x31\x8e\x77\x63\x29\x1e\xe7\xc0\xba\xbe\x24\x47\xa1\xef\x44\x48\x94\xbc\x14\x9a\x95\x53\x9a\xb0\xe3\x86\x63\xab\x77\x4e\xac\x98\xb3\x9c\x95\x34\x0b\x79\xea\xbe\x7e\x7c\x4e\x45\x9a\x59\xdf\x41\xc2\x4a\x90\x63\xec\xa3\x66\xa5\x40\xe8\x27\x66\x88\x69\xc9\xef\x58\xa9\xc8\xd1\x97\xcc\x18\x9e\xb6\x9b\x45\x6f\x0c\x25\xca\x3b\x06\x5f\x88\x4f\xd9\x85\xd7\x96\x9a\xb5\x58\x56\x0a\xc3\x46\xda\x55\x80\x09\x65\x96\x49\x35\x1d\x80\x63\xc3\x93\x70\x29\x83\xa4\x5e\xc8\xaa\xb4\x51\x6e\x14\x55\xdf\xa2\x0d\x40\xc0\x13\x6d\x07\x48\x15\x29\xd9\xcc\x98\x97\xa5\x6d\x09\xd0\x80\xe2\xd8\x79\x41\xc6\xce\x4b\x65\x1a\x05\x2a\xa8\x25\x58\x8e\x6f\x4e\x9d\xd5\x2b\xef\x78\xea\x2b\x35\x21\x83\x26\xc2\x31\xc8\x15\x29\xa8\x6a\x20\x18\x50\xa5\x64\xc2\xc1\x9d\x59\xef\xbb\xb3\xb3\x11\x7d\xc9\xfd\x02\x34\xf1\xdc\x7d\x56\x43\x33\x1a\x2c\x01\x88\x1a\x81\xe0\x15\xb9\x49\x42\xa6\xec\xaa\x9a\x64\x5c\xcd\xaf\x07\x07\xe0\xde\xac\x21\x62\x13\x54\x83\x6f\x08\x77\x12\x9c\xeb\xa4\x19\xb8\x6b\x06\xe5\x14\x13\x8a\x83\xbe\x66\x6e\x47\xa3\x63\x62\x33\x5f\x8d\xd1\x49\x55\xe8\x0a\xd2\xd8\x29\x08\xec\xe5\x45\xc6\x34\xf3\xbf\x42\x51\x6c\xcc\xd9\x61\x8f\x18\x4d\xda\xfc\xf4\x83\x28\x5a\x3f\x4f\x68\x86\x01\x88\x33\xcf\xfb\xba\x87\x0f\x57\xe1\x7e\xb3\x7e\x21\x8f\x4b\x62\x39\x96\x4f\x71\xdd\x4f\x7d\x5c\xd5\x66\xac\x59\x0d\x2c\xc0\x4f\xaf\x5d\x68\xa4\x43\x57\x5a\xec\x09\x41\xa4\xf0\x84\x4e\xa0\x77\x9c\x23\x1a\x76\x1e\x8f\x12\x6e\x0f\x16\x66\xa5\xf6\x11\x55\xec\x67\x7f\xf5\x11\xd5\xc1\xf9\x1a\xd7\xa1\x77\x09\x05\x22\x23\x28\x85\xf3\x5d\xab\xf1\xa2\xc5\x5f\xb4\xfe\xd2\xde\x3e\xf9\x63\x48\xc9\xfe\x99\x76\x10\xbf\x4f\x18\x41\xfb\xdb\xa5\x91\x82\xae\xc8\x94\x93\xc4\x23\xdf\xb3\x32\xd2\x73\xe9\x9c\x22\x25\x5b\xba\x98\x6b\x19\x8f\x93\xbd\x5e\xd9\x02\x3d\xcb\xbe\x78\xa8\x48\x2a\x93\x0a\xda\x2c\x07\x68\x8e\x90\x93\x85\x8f\x4d\x46\x54\x57\xa1\xb8\x3e\xa2\x00\x10\xa3\x86\xa2\xbf\x9c\xca\x7b\x71\x4f\xcb\xf4\xec\xaa\xb7\x62\xbb\xad\x2a\xd7\xef\x35\xa3\xe5\x9e\x1c\x31\x3f\xa7\x13\x59\x21\x90\x26\x1d\x10\x64\x23\xb8\xfe\x8b\x0b\x9e\xdb\xa6\x8c\x8d\x38\x38\x4e\xcc\x6c\x8e\x95\x93\x35\x5f\xc1\x59\xe5\xeb\xc3\xf8\x9d\x21\x7a\x14\xe1\xe5\x30\x7e\x8f\xcb\x1a\x17\xd6\x69\x7b\x7f\xfb\x9c\xd5\xb8\x50\x6f\x2b\x8c\x8f\x0b\xd1\x47\x1c\xfb\x65\x07\x75\x67\x88\x1e\x19\x32\x85\x86\xbb\xf8\x10\x3d\x5e\xed\x8c\x09\xd1\xe3\x36\xac\xdf\x4f\xbd\xe4\x7d\x46\x91\xdd\xd2\x43\xfd\x24\x43\xf4\xe6\xad\x76\x4f\xab\x96\x6c\x8c\x48\xee\x42\x3a\xaf\x87\xc6\x29\x1b\x92\xdc\xde\xe6\x4b\xe5\xda\x48\x85\xb0\x61\xbe\x81\x01\x06\xc2\xa6\xe6\x94\x86\xc9\x87\xef\x32\x63\xae\x05\x50\x6b\x1f\x28\xf2\x09\xb4\x91\xd6\xb1\x7d\x36\xd5\x70\x5a\xb8\x5a\x9b\xda\x60\xf3\x28\x22\x03\x55\x85\x4c\x5f\x58\x1c\x58\x2a\x84\xb4\xba\x89\x3a\x71\x50\xe4\x27\x98\xce\xe6\xcd\x07\x3a\x9b\x1a\xa3\xa2\xa0\x09\x03\x89\x12\x54\x9c\x88\x98\x4f\xfc\x82\xc2\xb7\x83\xfd\x11\xf3\xd6\xb2\x5e\x69\x29\x78\x33\x4d\x25\x73\x96\xd3\xc8\xfe\x44\xe6\xf9\xca\xec\xc6\x95\x11\xab\x5c\x11\xa3\xae\x6b\x66\x91\xed\x58\x99\x2b\x22\xa7\xa1\x3c\x2b\x26\x64\x47\xac\xf7\xe1\xe0\xee\xf9\x41\x4c\xa8\x66\x60\x6f\x21\xc7\xa6\x57\x51\x61\x31\xb2\xbc\xa0\x57\xad\x68\x98\x61\x51\x50\x12\x32\x6c\x56\x56\xfd\x78\x14\xfa\x90\x80\x02\xe2\xcc\xee\xd7\x23\x2c\x47\x5c\xcc\x89\x80\xca\x1c\x16\x30\x32\x14\x16\x91\x67\xfe\xd4\xb3\x13\x4e\x42\x4c\x63\x9f\x9d\xb0\xcf\x4e\x88\x18\xe5\x3e\x3b\xa1\xf5\x6c\x91\x9d\xd0\x50\x17\xfc\x8d\xe4\x16\x22\x22\x13\xae\x1e\x45\x2b\x3e\x05\xfb\x62\xab\xb6\x9c\x99\x67\x8d\xc9\x08\x9a\x3e\xbb\xc1\xa5\x36\x98\x83\xdd\x4a\xb5\x3b\x1c\x8f\x0f\x6d\xb2\x5d\x54\x7e\x82\x93\x3a\x95\x9e\x8e\xbe\x20\x4c\x24\x32\xb5\x8c\x6d\xc6\x5c\x2a\x0d\x6a\x6b\xbc\xba\xd4\xce\xf2\x00\xd1\xb6\x94\x05\x61\xc7\x8b\x67\xa1\x01\x97\x91\x87\xbf\xfc\x6a\x4b\x35\xb2\x56\x1e\x03\x54\xf7\x72\x37\xf4\x98\x9b\x1f\xb4\x48\x4f\x49\x91\x8c\xe7\xdc\xf5\xe5\x34\x02\x90\x29\xad\xc8\x91\xfd\xe1\x38\x29\xaa\x98\xa3\xeb\xde\xca\x59\x2e\xcb\xc5\x49\x20\x67\xc8\xb4\xe8\xbb\xbf\xe8\x6f\x2e\x50\x3f\xd0\xf0\xa6\x2a\x4b\x26\x74\xb6\x78\x44\x6d\x35\xac\x30\x16\xe4\xa7\x7e\x96\x0a\x6c\x43\xd8\x1d\x22\x13\x41\x4f\x89\xd4\xae\xa6\x01\xc9\x58\x9d\x84\x68\x11\xfc\x94\x89\x3b\x72\x47\x4b\x85\x5f\x11\x32\x5c\xe5\x4c\xf9\x1d\x57\x31\xad\x6b\x09\x48\xf6\xc5\xdb\x88\x43\x40\x6c\xf3\x83\x01\x42\xb8\xfd\x6e\xf4\xe4\x36\x46\x0d\xa0\x03\x62\xa5\x8b\x4a\xbb\x1b\x25\x72\xf3\xdc\xa9\xf5\x80\xf8\xe1\x0c\x9e\x34\xc1\xf4\x22\x69\x1e\x3c\xef\xef\xd8\xd2\x7c\x0a\xaa\x35\x2b\xc5\x0b\xf2\x7f\x8e\x7e\xf8\x8f\x9f\x47\xc7\x7f\x3d\x3a\xfa\xfe\xd9\xe8\x3f\xff\xf9\x1f\x47\x3f\x8c\xe1\x3f\x3e\x3b\xfe\xeb\xf1\xcf\xfe\x1f\xff\x71\x7c\x7c\x74\xf4\xfd\x37\xaf\xbf\x7e\x7f\xf5\xf2\x9f\xfc\xf8\xe7\xef\x45\x95\xdf\xda\x7f\xfd\x7c\xf4\x3d\x7b\xf9\x4f\x24\x91\xe3\xe3\xbf\xfe\x8f\xa8\x61\x7e\x1c\xd5\x51\xb3\x11\x17\x7a\x24\xcb\x91\xdd\xc8\x17\x44\x97\xc8\xc8\xbd\x7d\xfc\x32\x6f\x73\x7a\xeb\xab\xb9\xee\x96\xe0\xed\xa3\x47\x38\x70\x43\x8c\x9a\x28\xf0\x65\x32\xc4\xa6\x89\x4d\xef\xfb\xf4\x49\x7b\x68\xc2\x10\x71\xbf\xe0\x91\x20\x0a\x2f\xdd\x5b\xed\xfa\x40\xcd\xf2\x42\x96\xb4\x5c\x90\xd4\xb9\x7e\x11\xfd\xd3\x56\xe0\xc9\x1a\xf8\x64\x5b\xe3\x61\xc3\xec\x52\x5e\xee\x12\x47\x21\x67\x29\xaf\xf2\xf8\x70\xc9\x77\xd0\xcc\xc2\x35\xcb\xf0\x99\x64\x96\x18\xb6\x94\xd2\x3c\x13\x9a\xdc\x5a\x6b\x36\x2c\xb2\xd5\x22\x9b\xb8\xec\x07\x2e\x49\x27\x67\x14\x69\xe5\xb8\xc0\x0d\xa4\x7b\xc9\x94\x99\x5d\xf0\x04\xed\x18\xa3\xb3\xfa\x6d\x2e\x87\xcb\xbf\xad\xfb\xb0\xc9\x92\xbc\x06\xa5\xe8\xd1\x36\x97\x44\xe3\x29\xf1\x7f\xb3\x57\x46\xc3\x43\x85\x9b\xb1\x77\x7d\xfc\x0d\x1f\x7d\xaf\x2f\x75\xb1\x90\xe0\x04\x70\x90\x81\x53\xe8\x2c\x57\xe7\x30\x46\xa9\x65\x36\x0f\x80\x2b\x12\x0e\xbe\xcf\x08\x30\x9c\x67\xd6\xcb\xaa\xc4\xe0\xe7\xcf\x14\xee\x6a\xa7\x45\x91\xf1\x04\x3a\x88\x81\x3b\x01\xb8\x22\x70\xdb\xfb\x46\xf7\xdd\x4a\xe1\xd0\xae\xa1\x00\xb7\x45\xa7\x1e\xb0\x6d\x02\x37\xb1\x2c\xee\xfa\xe5\xe2\xc3\x5d\xcd\x5e\xbf\xe4\xda\xf3\x47\xc3\xf7\x07\x48\x16\xd8\x72\x59\x70\x1b\x56\x39\x74\x03\xb6\xa3\x75\xb6\x89\x9c\x42\x9a\x51\xd0\xc5\x15\xb6\x7e\x8d\xda\x6e\x43\x4b\x72\x40\xf0\xac\x29\x08\x42\x3f\x1d\x14\xc9\xb0\xa1\x95\x70\xd9\xbf\x2b\x27\x76\xfd\x81\xad\x14\x2b\x47\xb3\x8a\xa7\xc3\x8e\xea\x93\xd6\xdb\xb6\xd4\xd6\xf0\xd7\xb2\xcf\x39\x8e\xba\x97\x0f\x5e\x86\x54\xe5\xd6\xc5\xdc\x6c\x5d\x83\xf0\xa3\x35\xd2\x9a\xa9\xcf\xa0\x0d\x72\xc3\xa5\x9d\x00\xab\x59\xba\x88\x7e\xa6\xe6\x42\x4f\x16\x89\x03\xf4\xe3\xad\x1e\x59\x76\x58\x96\xc5\x14\xe1\x9a\x8c\x08\xef\x57\x86\xbc\x7b\xd0\xc7\xf2\x27\x6c\x6a\xb3\xf6\x2c\x4d\xf0\x7f\xb8\xa2\xd3\x94\x65\x0c\x93\x89\x70\x3f\x67\xa2\xee\xc6\xa9\x48\xc9\x72\x79\x67\xb8\xfe\x07\x41\x3e\x28\x97\x82\xc1\xa7\x2f\x08\x3d\x8e\x81\xa5\xe0\xae\xf1\xbb\x60\x2c\xb5\x55\xaa\x8d\xa6\x9f\x65\x25\xd4\x09\x99\x1c\xfb\xe4\x6f\x85\xf1\x00\x09\x63\x92\x65\xde\x3a\xb6\xfe\xc7\x92\x99\x0d\x02\xd8\xc3\x52\xe6\x44\x09\x5a\xa8\xb9\xd4\xe0\xbc\xa2\x05\x4d\xb8\xee\xd7\xc5\x7e\x30\x1c\x5a\xd2\xe4\xd6\x90\x81\xe8\x3b\x0c\xf9\x84\x24\xc7\xae\x96\xa6\xc9\x02\x18\x4e\xaa\xe5\xa3\x9e\x97\xb2\x9a\xcd\xa1\x0e\xd4\x52\x49\x32\xaa\xfc\x0e\xb5\xe8\xf7\x67\xad\xd8\xef\x3b\xdf\x88\x22\xe9\x42\xd0\x9c\x27\xa1\xdb\x50\x29\xef\xb8\xe2\xd2\xc5\x58\xe1\xbb\xa8\xb9\x53\x72\x15\xfa\xba\xd8\x08\xf0\x79\x46\x79\x4e\x8e\x14\x63\x24\x1c\x2d\xfb\x9b\x6b\x9c\xc9\x61\xfd\xe4\x25\x23\x4b\x81\x61\x87\xde\xed\x60\x77\xcc\x4f\xdc\x05\x83\x50\x98\x43\x22\x90\xd5\x26\x9d\x27\x79\xed\xd0\x8f\x0d\xf3\xf6\x92\x34\xcc\xbd\x7e\xe6\xb2\x84\x14\x58\xdf\xdd\x8d\x89\x54\x46\xe4\xb2\x9d\x5d\x5d\xaa\xa6\x1f\xc7\x75\x64\xb5\x5f\x82\x5f\x64\x52\xcc\x1a\x90\xb1\x11\x72\xc4\xdc\x94\x02\xba\x0f\xdf\xf1\xb4\xa2\x99\xbd\xff\xdc\x49\x3d\xbf\xbe\xb4\xe4\xf9\x6c\xae\x47\xf7\xcc\xfc\x5f\x3f\x6d\x50\x8f\xea\x62\x0f\x3f\x68\xbe\x5c\xf8\x00\x35\xdd\x8c\x22\x7a\x75\x59\x3f\xb4\x8d\x5f\x41\xff\x5d\xba\x00\x38\x6a\x97\x0a\xdf\x4a\xbf\xb3\x6b\x8c\xe5\xfd\xc0\x57\x0d\xa6\x82\xe9\x9f\x85\x5e\xaa\x46\x9d\x47\x85\x8d\x0c\x17\x81\xea\xb1\x3a\x77\x68\x4c\x5b\xf7\x3a\xba\xeb\x69\x6b\xe9\x1f\xd7\xb5\xcf\xf6\xe7\x06\x3b\xee\x07\x61\x13\x71\x20\x0f\x64\xd2\xa8\x76\x09\x3d\x7f\xfb\x89\xda\xee\x0d\x80\x97\xe0\x04\xf5\xd7\x4c\xb0\x92\x27\x4b\x47\xd3\x93\xee\xa5\x38\xa3\x1a\x2e\x24\x26\x0c\xd9\x74\xdc\xe7\x5f\x8a\xb1\x12\xef\xea\x53\xf4\x9e\xe5\x45\x46\x75\x7c\x86\xdd\xc1\x77\x8d\x18\x58\x23\x97\xc4\xc8\x50\x2a\xd2\x11\xcd\xa4\xc0\xe9\x72\x57\xdf\x9e\xbb\xda\x74\x2b\x19\x5b\x49\xbd\xef\xeb\x06\xd4\xa0\x2c\x22\x55\x58\x63\x0d\xac\x93\x89\xb0\xa2\xf9\x84\xa5\x70\xdb\xd9\x19\xa0\xb5\x62\x79\x2f\x6c\xf7\x74\xf3\x8f\xab\x6f\xcf\x4f\x08\x1f\xb3\xb1\xff\x57\xb8\xf5\xb1\x57\x3a\x90\x95\x33\x5b\x09\x17\x6a\x42\x41\x54\xc0\xb4\x9b\x91\x23\x4f\x1f\x67\x85\x33\x72\xf3\x5f\x66\xd1\x0c\x85\xff\x1e\xfd\x57\xa3\x1d\xdf\x7f\xdf\x18\x06\x2d\xcd\x1f\xb4\x7e\x8a\xa2\xdb\xac\xb8\x82\x8b\xdc\xfc\xeb\xe6\x4a\xa6\xd7\x05\x4b\xc6\xae\xad\xec\x8d\x75\x01\x11\x26\x74\x89\xaa\x9a\x21\xe4\x4a\x42\x17\x18\x9e\x5a\x61\x03\xeb\x58\xb2\x1f\x7d\x34\xd3\xf5\xdf\x76\x57\x52\x42\x35\x13\xe8\xe4\x4d\x8f\x49\x22\xa4\xb6\x9f\xb0\xdd\xbd\x61\x3d\x8f\xa6\xcd\x86\xd9\x5a\xe2\x0c\x43\x73\x2b\x1c\x5b\x69\x26\x08\xfb\xc8\x15\x34\x75\xb0\xfb\x03\x5b\x48\x5d\xc9\x17\x3a\x5d\xcf\x0d\xcf\x70\x57\x80\x9b\x84\xce\xe0\x66\x1d\x3e\x13\x52\x7f\x16\x8e\x19\x16\x9d\x28\x68\xaf\x92\xd0\x3b\x09\xd0\x65\xa0\x36\x09\x52\x09\x08\xca\xd5\xed\x79\x27\xb8\x62\xdf\x9c\x2b\x4d\x6f\xd9\x98\x5c\x1b\xe5\xd5\xb7\xc7\x74\xca\x28\x34\x98\x85\xa6\x6e\x2c\x25\x95\xd0\x1c\xc7\xa7\x86\x42\x3d\x1e\xb3\x84\x4d\xc5\xf6\x72\x4a\x54\x95\x40\x27\xfe\x92\x8d\x62\x32\x76\x1d\x25\xb8\x06\x9b\xd7\x5c\xbd\xb6\x27\xe1\x50\xcd\x29\xde\x05\x55\xa4\x30\x50\xd8\x67\x2a\x9c\x38\x68\xa2\x51\x7b\xf2\x48\x6f\x40\x52\xab\xda\xc0\x48\x6a\x4c\xde\x80\xf6\x9c\xf9\x5c\x52\x9b\x67\x2d\x24\xb2\xf3\xa6\x51\x8a\x13\xa6\x14\x2d\x17\xb6\x4f\x37\xd7\xbe\x78\xc9\x95\x9f\xc0\xed\x94\x53\x51\x99\x8f\x20\xdd\xcb\xb6\xb1\x7e\x95\xc0\xae\x53\x32\x29\xe5\x2d\x13\xa1\x78\x31\xdc\x9e\xf8\x14\x40\xd8\x65\x5f\xf7\x01\x49\x70\x92\x24\x73\x2a\x66\xac\x06\xf6\xc9\x69\x6a\x78\x93\x7c\x13\x2c\x5a\xec\x36\xf9\x9d\xa5\x53\x63\x19\x72\x6d\xb6\x98\x4c\x18\x13\x75\xcc\xfd\x07\x41\x7c\x28\x01\x17\x58\x0d\xa1\x6b\xb3\xc4\x3c\xeb\xbd\x8c\xc9\x80\x48\x67\xce\x34\x4d\xa9\xa6\x83\xca\xa5\x5e\xd3\xd0\x27\xdb\x65\x23\xc2\xc2\x36\xb2\x14\xf1\x01\x08\x50\x06\xbd\x05\x2b\x0b\xde\x04\x57\x02\x39\x37\xf7\x6b\x19\x13\x3c\xe1\xda\x70\xb7\xcb\x20\xb1\x55\x50\x60\xc6\xd1\x2c\x93\xf7\x2c\x85\xe1\xc6\x5c\xc8\x04\x78\xd3\x81\x3f\xa5\x15\x58\x97\xf5\x25\x82\x4d\x7e\x88\x8e\xb6\x18\xfb\x62\xd0\x0e\xbd\xaf\xb3\xf1\x92\xba\xa6\x05\xd6\x74\x9d\x75\x83\xcf\xde\x30\x84\x99\xd0\xbc\x64\x4d\xb4\x2d\xb7\x71\x95\xb0\x07\xcb\x6e\x61\x04\x0f\xd8\xad\x06\x5e\x98\x31\xad\xea\xb2\x04\x7b\xef\x1b\xa1\xef\x34\xc7\xb8\x91\x82\xda\xed\xb7\x5f\xd9\xe6\xf0\x6b\x17\x00\x4d\xd5\x32\x91\x92\xee\x7e\x37\xda\x0d\x76\x48\x43\x92\x11\x6c\x77\xfa\xd7\x32\x8d\xcb\x60\x58\x6a\x1c\x5f\x13\xa9\x7b\x2b\xd9\x0a\x64\x15\x9d\x8e\x60\x47\x04\x59\x59\xaa\x05\x0e\x66\x6f\x8f\x39\xbd\x73\xb1\xaa\x28\xaa\xf1\xa1\x8f\xda\x16\x1b\x85\x96\xaf\x30\xb4\x11\x0c\x6d\xf4\x3c\x26\x44\x1b\x91\x27\xef\x9f\x81\x79\x06\x31\x60\x23\xfe\x31\x72\xfa\x3a\x3a\x9c\xbd\xdc\xc7\x3b\x94\x1c\xbb\xec\xba\xf8\x5c\x52\x12\xba\x62\x33\x6e\x04\xeb\x0b\xf2\x59\x4b\x2d\x75\xa6\x8f\xf3\xb7\x45\x11\x76\xb5\xc9\x47\xde\x59\x37\x76\xdb\xec\xe1\x69\xdb\xa4\x63\x92\x88\xc8\xd2\x20\x41\x17\x5f\xef\x21\x8a\xa2\xea\x6a\xab\x83\x1d\x69\x6c\xb5\xd2\x28\x6a\x1e\xf6\x02\x8e\x5a\x29\xb3\x2c\x32\x85\xc5\x6c\x8f\xf3\xe7\x2d\x25\x55\x43\x3f\x47\x1b\xe0\x8f\xc9\xd0\x02\xf0\x3d\xb8\xec\x82\xd1\x2c\xd8\x7d\xd0\xc8\xa9\xb2\x98\xfd\xb1\x89\xf4\x4e\xfc\xab\x90\x8f\xbf\x6e\x9c\x71\xc9\xdf\x6e\x4d\xcf\xc4\xc2\x6e\xcb\x45\x60\xfc\xe0\x23\x99\xd1\x28\x84\x25\xd2\x70\x6a\x9c\xf8\xcc\x67\x73\x08\x60\x3d\x68\x76\x4f\x17\x0a\x64\x56\xdc\xd9\xf7\x57\x4b\x58\x03\xd7\x29\xaa\x1e\xf0\x3b\x16\x93\xbe\xe8\xf2\xf9\x23\x32\xdb\x86\xe6\xb6\x41\x2d\x06\xe4\xe7\xc6\xbd\xb5\xdc\xc3\xf1\xea\x12\x88\x78\x2b\x7d\x06\xff\xc0\x1a\x8c\xcd\xc7\xa5\xfd\xda\x5d\x9e\x30\x73\x44\x6b\xa4\x51\x74\x5b\xf6\xe6\xd3\x1c\x5b\x3b\x15\x7b\x00\x62\x67\xf3\xf9\x06\xfa\x4a\xba\xd0\xbf\x47\x55\x91\x25\x83\x6a\x07\x58\x81\x38\x76\x37\xcf\x57\x20\x34\x16\x4e\x53\xd5\x73\x5e\xa6\xa3\x82\x96\x7a\x61\x7d\x91\x27\x61\x36\xd1\x94\x9b\x48\x10\x91\x2f\x0f\xce\xa1\xc3\x36\xe7\x6d\x3e\x2d\xbe\x82\x25\x76\x3c\xe5\x13\x44\xa2\x93\x9a\xfc\xb3\xcc\x4d\x8f\xb5\x0a\x31\x30\x14\xfe\x69\x03\xad\x34\xb0\x6d\xbd\x5f\xee\x17\xb6\x0a\xf1\xd9\x6b\x00\x06\xc7\x91\x69\x03\xfe\x85\xc8\x1a\xb8\x01\x25\x3c\x4d\xf5\x2b\x2a\x9f\x7b\x25\xa3\xbb\x9d\x52\x6a\x15\x1e\x63\xe0\x44\x6d\x88\xcd\x61\xd0\xb2\x59\x0e\x1f\x74\x70\xf0\x15\x99\xe1\x46\xaa\x07\x53\xa3\x12\x48\x31\x72\x28\x49\xae\x05\x81\xf2\xf6\x41\x74\xcd\x49\x00\xb1\x31\x92\xcd\x06\x93\x1a\xf3\x6d\x7e\x2c\x8a\x68\x10\xb3\xe4\x48\x48\x61\x45\xaf\xa5\x7b\x6c\x4b\x8e\xb6\x33\xed\x48\xd0\x43\xc7\xe4\x3b\x1b\x57\x69\xc2\xf4\x0c\xaa\xe8\x21\x41\xcd\xe2\x22\x35\xe7\x10\xf4\x0e\x70\x45\xa9\x2a\x49\x18\xf3\xce\xe6\x68\xdb\x63\x55\xed\x72\x4b\x9c\x53\x9d\xcc\x99\x22\x4a\x46\x1d\x0d\x63\x83\x29\x4d\xb3\xac\x76\xd6\x3a\x16\x93\xa0\xd9\xba\xc8\x72\x14\xc5\x86\x72\x3c\x6e\x82\x1e\x39\x9f\x7b\x91\x51\xeb\x94\x8c\xd3\x94\x2a\x91\xd8\x14\x78\xae\x17\x7e\x15\x9a\x9a\x22\xda\x33\xe8\x1f\xf0\x5d\x29\xeb\xff\xe5\x53\x8b\x16\xd0\xf0\x19\x05\x66\x8d\xdb\x78\x3d\x67\x0b\xab\x2d\x18\xfd\xb2\xd6\x19\x1d\x2a\xb9\xb9\xf8\x27\x34\xb9\xbd\xa7\x65\x1a\x77\xb6\x12\x99\x17\x54\x73\xdb\xbc\xfd\xa4\x35\xdc\xa3\x7a\x1d\xa2\x57\xa0\xa5\xbb\x1e\x07\x9f\x9c\x62\xbe\x8b\x76\x9c\xa5\x18\xa6\x4a\x68\xa5\x65\x4e\x35\x4f\xc0\xd5\xcc\xa7\x8d\x38\x7a\xdc\xe1\x84\x68\x9a\x91\x50\xbe\x5c\xcc\x2a\x4d\xa0\xe6\xb9\x2d\x82\x10\x5e\xa4\xef\xc1\xec\xb1\xbe\x97\x84\xe7\xc6\xe6\xa2\x42\x93\x94\x4f\x03\xaa\x15\x36\x19\xa1\xf9\x34\xb8\x71\x65\x65\x8d\xd1\xfc\xdd\x3c\x0e\x7b\xb6\x45\xd1\xfa\xb1\xb3\x4c\xde\x2b\x18\xb6\x77\xf3\x59\x85\x31\x4e\xda\x4d\x9d\xd0\x50\x27\xed\x41\x9a\x55\xb1\x5f\xa0\x22\x4e\x46\x9b\x9d\x68\x08\xe6\x13\x73\xb0\xee\x99\xb1\xb5\xd4\xce\xc4\xb3\x1a\xfb\x35\x6c\xae\x0b\x9f\x09\x0b\x33\xc4\x95\xf3\xf2\xc6\xc9\x69\x5b\xcd\x79\x94\x96\xb2\x28\x5c\xc4\x29\x3f\x5e\x5d\x17\xc8\x25\x2b\xef\x22\x57\x9a\x66\x75\xdd\xa9\x61\x89\x19\x13\xac\xa4\x1a\xf2\x0a\x1c\x98\x3e\x8f\xb3\x74\x68\x63\xa2\xee\xa0\x71\xd5\xcc\x33\x1a\x93\xa3\xb3\xac\x98\xd3\x38\xb7\xc6\x07\x65\xe7\x1e\x64\x75\xc0\x45\xd9\x60\x99\xc7\xc9\xee\x86\x15\xef\x62\x65\x3e\xe3\x60\x6f\xfa\xee\x4d\xdf\xbd\xe9\xbb\x37\x7d\xf7\xa6\x2f\xf2\x85\xc7\x30\x7d\x43\xb5\xe2\x70\xb3\xf7\x5d\x28\x3a\x6e\xe4\x61\xc7\x16\x1e\xac\x8c\xe7\x89\x45\x9e\xc2\xb8\x1e\xe3\x16\xb3\x05\x12\xb1\xa7\x6c\x38\x16\x64\x8b\x4a\x7c\x39\x2f\xd9\xb2\xa4\x97\x6c\x57\xd6\x4b\x9e\x7a\x1d\x45\xfb\xd9\x61\x0d\x2c\x59\x39\x8b\xaf\x6c\x69\x8d\xfd\xe1\xc4\x9d\x22\x57\x59\x14\xcf\x0a\xa1\x9a\xca\x18\xa4\x95\x66\x8d\xd3\xe9\xf4\xd2\x78\x9d\x20\xb2\x0e\xae\x05\xe3\x7a\x9a\x53\x41\x67\x6c\x14\x86\x31\xaa\x8b\x87\x4e\xe3\x0a\x76\x07\x8a\x4b\xe2\xa4\x3f\x53\xfb\xf3\x19\xf3\xec\xcf\x67\x5d\x71\x0e\x58\x1c\xad\x13\x1a\x3d\x46\x77\xb3\x76\x9d\xd0\x68\x9a\x2d\x50\xf1\x30\x4e\xae\x88\xcc\xb9\xd6\x11\xc9\xa2\xcd\x87\xd6\xe5\x7d\x27\x84\xeb\x26\x22\x02\xb1\xb2\x2a\x5e\xf5\x9f\x86\x96\x14\xec\xa3\x6f\x74\xde\xb4\x83\xc0\xda\xb8\xe7\xc8\x2e\x83\xcd\x47\x4b\xa8\xb3\xc8\x0b\xdb\x96\x07\x64\xce\xc8\xd5\x03\x3a\xaf\xde\x6f\x4e\xde\x0d\x7a\xcd\x42\x28\xc4\x21\x89\xb4\x4d\x70\x9b\x75\x48\x7e\xaa\x58\xb9\x20\xf2\x8e\x95\xe8\x7a\x8c\xd6\xe0\xa1\xd9\xb7\xe2\xa9\xab\x22\x71\x3e\xfa\x98\x5d\x1c\xaa\xc3\x81\x93\xfe\xe5\x47\xa3\x10\x03\x2e\xdb\x56\x36\xd3\x32\xb1\x1a\x62\x34\x9a\x1f\xe5\xd4\x2d\xad\xdf\x23\x7f\xf0\x0d\xc7\x2b\xf0\xae\x0e\x15\x1d\xb9\x4d\x94\x28\x19\x39\x7b\x73\x11\xaf\x1b\x0c\x48\xd1\x22\x9b\xf8\x66\xdd\xe4\xa2\x29\x13\xbb\xcc\x81\x16\x88\x9d\x90\x5e\xe7\x9c\x7d\x03\xa8\x42\x3b\xcf\x13\x97\x50\x4b\x0c\x7b\xd1\x40\xde\x66\xaf\xc7\x4b\x45\x42\x9a\x6d\x52\xf1\x60\xe5\xed\x67\x28\xb3\xdb\x27\xaa\xa5\x64\xf3\x69\x6d\xa1\x6b\x75\x0a\x1d\x5a\xcd\x5e\x0e\xa2\x48\x6c\x0b\xb7\xb9\x2f\xce\xf2\x5b\x08\x65\xf8\x83\x96\x97\x80\x38\x89\x5f\x52\xb2\x8d\xb3\xc5\x3e\x9e\x43\x76\xb0\xb8\x81\xd9\xa2\x91\x7e\x97\x1f\x60\xe2\x43\xe5\x30\xd9\xa4\x50\x73\x5e\xd8\x5e\xa7\x8a\x0d\x39\x6a\xc4\xca\x26\xc7\xb9\xe4\x5b\x48\xbe\xf5\xc3\xc5\xb7\x1e\x59\x7d\x2e\xc5\x09\x79\x23\xb5\xf9\xbf\x97\x50\x15\x61\x23\x36\x92\xa9\x37\x52\xc3\x4f\x3e\xc9\xa6\xda\x89\xee\x60\x4b\x5d\x58\x83\x43\xa0\x21\x2e\xed\xb4\xfd\x00\x52\x8b\xcf\x7b\x87\x4d\x70\xd9\x7a\x7e\x17\x06\xd2\xe5\x8a\x5c\x0a\x22\x4b\xbf\x0b\xa1\x71\xb3\xda\x6a\xb8\xde\x57\xdd\x88\x0c\xee\x6c\xbc\x8e\x51\x64\xd9\xe2\x93\x93\x41\xba\xba\x7d\x9a\x13\x0e\x43\xb7\xc3\x1e\x48\x11\xa2\xed\x96\x1e\x78\xc3\x21\xd6\xee\x4b\x17\x06\x1f\x6a\xa5\x4b\xaa\xd9\x8c\x27\x24\x67\xe5\x0c\x90\x16\x93\x28\xe8\xc7\xfa\x19\x78\x9f\xdb\x67\xcb\xd3\x35\x2c\x0d\xdb\x3f\x43\xfc\xbb\xf6\xc1\x77\xbb\x6c\xbf\x35\x98\x65\x07\x7b\x31\x86\xaf\x10\x28\xa3\xaf\xa0\x28\xe8\xd3\xb8\x40\xb6\xe0\x8d\x55\xad\xda\x4e\xc4\x6a\x7a\x39\x2d\xe2\x40\x49\xed\xf3\x7f\x8d\x3a\x07\x07\xfc\xff\x41\x17\x7e\x35\x26\x67\x44\x71\x31\xcb\x58\xf3\x77\xf1\x96\xae\x70\xee\xbb\x7a\x98\x66\x84\xc6\xf0\xfd\xa9\xe2\x77\x34\x1b\xa2\xd4\x5a\x1b\xd7\x37\x9e\x95\xd3\x15\xdb\x22\x5e\xa3\x85\x96\xfe\xa0\x70\x85\x54\xaa\x83\x5b\xb6\x38\x38\xd9\x4e\x1a\x1b\x32\x97\xe2\xa0\xee\x2d\xd1\x92\xa1\x51\x4d\xce\x9a\x0f\x64\x57\x1c\x00\xad\x83\xb1\x6b\x1e\xdc\x36\x5f\xe2\x83\xb2\x43\xcc\x9d\x47\xb5\xc7\x6d\x60\xe3\x3c\xa3\x4a\xc5\x42\x9e\x6e\x6e\xa3\x76\xdd\xa0\x3a\x0c\xf9\xd4\x55\xd0\x26\x50\xa1\x36\x14\x22\x6d\x4d\xb4\x06\xe0\x56\xe2\x0a\x84\x06\x09\x15\xfb\x3d\x6c\x47\x21\xff\xb4\xd5\xb7\x40\x22\xc0\x02\xdd\x7b\xf0\xbc\xd8\x54\x9f\x3a\xaf\xd3\xef\xc6\xd2\x0a\x7f\x8b\x6e\x8f\xd9\xa0\xda\xe8\xe3\xc9\x15\x78\xc7\x38\x40\xfb\x30\x01\x19\x08\x5c\x24\x59\x95\x46\x6e\x3c\x17\x76\x48\xe0\xb7\x8b\x39\x39\x5b\x6c\xd3\x56\x8c\xff\x6d\x20\xe1\x8d\x53\x9f\xef\x19\xd7\x19\x33\xcc\x43\xae\xad\xd5\x84\x84\xc1\x90\x9f\x93\xc4\x55\x6e\x0e\x5a\x9d\x48\x79\x12\xa3\x1a\x8d\x60\x77\x11\x7f\x18\x31\x06\xf4\x9f\x4e\x7b\xcb\x6a\xdb\xcd\x6a\xcf\xdb\x28\x62\x5f\xf1\x49\xc9\xc8\xf9\x9c\x0a\xc1\x32\x7c\x7e\x82\xf7\x4c\x53\xad\x69\x32\xb7\x40\x26\x94\x18\x29\x96\x31\x7d\xa8\xc8\x5c\x2a\x4d\x72\x9a\xcc\xb9\x60\xfe\x5e\xeb\xcf\x3c\xf4\xd0\xc2\x35\x22\xc0\x2e\xdb\x26\x0f\x6c\xef\x7b\xb8\xb9\xbf\xaf\x03\xcf\x44\x71\x20\x6d\x75\xc7\x35\x74\x02\x0c\xb8\x17\x5e\xb0\x68\x56\x8d\xc0\xf2\xb5\xa5\xd7\xea\x34\xbc\xd2\x45\x38\xf7\xc1\x03\x14\x45\x2e\xa6\xac\x2c\xed\x26\x4c\x98\x23\x4a\xf8\x94\x54\xa2\x91\x90\x67\xbb\x74\xce\xe5\x3d\x8a\x66\x2a\xc9\x3d\x23\x45\xc9\xee\x8c\x2e\x06\x49\x82\xca\x6b\x7d\x8d\x55\x81\x94\xfa\x44\xe6\x45\x29\x73\xae\xb0\x4b\x60\x55\x47\xe0\xb4\x9d\x83\x86\x66\x15\xaa\xcd\xcf\xa6\x76\x27\x5f\x9d\x13\x4d\xcb\x19\xd3\x86\x10\x11\x55\x3e\x61\x28\xb4\xc4\x87\x6d\xf9\x35\xb8\x01\x69\x63\x66\xdb\xf7\x0c\x25\xe4\x9d\x1b\x87\x85\xd9\x84\x2c\xed\xa9\x2c\x1d\xb8\x47\xf8\xa5\xeb\x0b\x87\xe4\x5d\x77\x7f\xbd\x36\xe7\x53\xa1\x32\x31\xe3\x7a\x97\xda\xed\xfc\xee\xbb\x37\xf1\x3d\xd3\xd6\xf2\xc5\xbd\x2c\xb3\xf4\x9e\xa7\x36\x45\x0c\xa7\xe4\x1f\x99\xcf\x1f\x63\xe6\x16\xe1\x99\x18\xd4\xf1\x16\x67\x53\xdf\xdf\xf3\x74\xdb\xe5\xf2\x65\x41\x66\xb9\x08\xac\x17\x4f\x99\xd0\x46\x1a\x95\xc8\x55\x83\x61\x1c\x93\x97\xdc\xe2\x46\x99\x7f\xd9\x2e\x20\xf9\x84\x8b\x1a\xae\x2d\x6c\x30\x4e\xaa\x8b\x14\xce\xb6\xf7\x74\x29\xa6\x2d\x82\x0c\x80\x9e\x48\x3d\x27\x8a\xe7\x55\xa6\xa9\x60\xb2\x52\xd9\x02\xc5\x92\x4f\x61\xdb\xf0\xfa\x47\xc6\x3e\xda\x43\x17\xa7\x87\x84\xd7\xda\xfa\xc8\xcc\xa2\xbf\x61\x71\x38\x6b\x3c\x7b\xa7\x90\xd4\x85\x3a\xe9\x69\x50\x4e\x02\x8a\x13\xfb\x88\xd0\xd3\x6c\x1d\x79\x91\x55\x33\xde\x8b\x87\x12\xd5\x97\x15\x50\xfe\xa2\x0f\xc2\x45\xc0\x27\xd4\x4b\xe0\x66\xa1\x21\x3c\x8a\x55\x2a\xc5\x6a\xc8\x67\x07\x13\xb7\xeb\xfb\x72\xa0\x8a\xd5\xab\x61\x11\x8a\x9a\xe2\x27\xd2\xb0\x1a\x00\xcd\xb1\xc3\x4c\x59\xc1\x44\x0a\xcd\xcc\x1a\x27\xc2\x2e\xcd\xce\x77\xc7\x35\xc8\x7a\xba\xfd\xb6\x1b\x32\xff\xe5\x47\x5d\x52\x23\x9c\x73\xc0\x92\x74\xad\x5c\x91\x95\x25\x54\xe0\x04\xed\xe3\xf4\x6d\xdf\xab\x4d\x44\xb1\xa4\x64\x1a\x59\x74\xbc\x69\xf1\xae\x3d\x11\xeb\xf8\xa9\xe1\xe3\xd0\x66\x82\x1d\x86\x2f\x2f\x6d\x34\x6d\x55\x4c\x28\x0e\x1d\xb7\x1a\xd0\xab\x38\xb2\x12\xba\xb8\x07\x93\x15\x6e\x0d\x77\x80\x95\x2b\x15\x75\x25\xc4\xf8\x32\x61\x3e\x25\x42\x2e\x8d\xb6\x59\xb7\x1b\x22\x80\xf6\x4f\x50\x34\xdb\x93\x56\x16\x68\x16\x30\x7a\xa5\xf0\x84\x4e\xa0\xba\xcb\xfe\x37\x52\xff\x59\xe9\x62\xbf\xb4\x02\x28\x70\xf8\x48\x11\x13\x53\x9a\xb1\xd9\xab\xeb\x38\x48\x37\xfd\xb1\x68\x17\x14\xc6\x6f\x2b\xef\x58\x79\xc7\xd9\xfd\xe9\xbd\x2c\x6f\xb9\x98\x8d\xee\xb9\x9e\x8f\x5c\xe1\xdf\x29\xe8\xfb\xa7\xbf\xc3\xab\xfd\xe6\xb1\x66\xf7\x59\x9a\xba\x52\x23\x87\x4e\x68\x6b\x66\xc7\x8d\xe6\xb4\xf8\xe0\xc6\x2d\x17\xe9\x09\xa9\x78\xfa\x57\xac\x17\x79\x90\xce\x89\x12\xb2\x58\xa7\xdb\xc8\xa9\x3e\xbb\xd3\x61\x65\x72\xdb\xaf\x9a\x2d\x29\xb0\xf0\xce\x92\x37\xcd\xfd\xd0\x99\x2b\x5e\x01\xed\x37\x2b\x37\x7b\xcf\x9c\x0c\x69\x28\x0a\x86\x79\xdd\x87\x7a\x09\x3b\xe4\x26\xa2\x0c\x2b\xd6\xb5\x79\x95\x10\xfd\x9b\x17\xa5\xdb\x52\x4d\x15\xd3\x58\xd7\xf3\x6a\xa1\x94\x57\x69\x2d\x1d\x08\xe4\x30\xa8\x65\xf7\xf0\x8e\x28\xe6\x1c\xfd\xb7\x53\x90\x45\x8b\x9a\x51\x7b\xfd\xd6\xb8\x1a\x1b\xc0\x68\xb4\x19\x93\x48\x97\x3e\x85\x4d\x28\x59\x82\xc4\x53\x8d\x3a\x26\x6e\xa4\x1f\x3e\x5c\x5e\x44\xaf\x9f\x79\x69\x69\xfd\xc6\x01\x98\xbb\x12\xfc\x27\x64\x28\xa4\xb6\xa8\x01\x88\x3d\xac\x98\xa3\xb9\xdb\x29\xa3\xcf\xe6\x2c\x61\x75\xf0\xe0\x82\xab\xdb\xb8\xa6\x56\x5f\x9f\xbf\x6c\xbf\xde\x3e\xb0\x5f\x9f\xbf\x24\xe6\xa7\xf1\x86\xe6\x7a\xcf\xf7\xd2\xe1\xed\x25\xeb\x5d\xe3\xeb\x5c\xdf\xdb\xb6\x52\x9a\x25\xac\x0e\x19\xa6\x5c\xdd\xee\xb2\x61\xd6\xae\x9c\xe9\xbe\x0b\x41\xdd\xcc\x04\xc5\xab\x0b\x59\x91\x7b\x07\x53\xec\x4c\xc5\xf7\xbc\x78\x41\x5e\x0a\x55\x95\xac\x4e\x88\xac\x4d\x2d\xb4\xf1\x02\x9a\x56\xb7\xe1\xe8\x8d\x42\x14\xcd\x97\x16\x29\x5b\xbd\xe8\x77\xd0\x07\xe7\x3b\x56\xf5\xdc\xec\xa0\xdf\x35\xef\xa0\x46\x14\xa2\x02\x48\x8f\x3f\xce\x5a\x5e\x8e\x0a\x3c\xa8\xc7\xbf\xa0\xa5\x06\xcb\x37\x9e\xb5\x01\x72\xdf\xbf\xee\x27\xdb\xec\xd2\xb3\x90\x15\x6a\xc6\x4b\x8c\x7d\x39\xf5\xa5\x29\x27\x0e\xeb\x34\xf4\x82\xf2\x7f\x84\x22\x3b\x09\x80\x48\xe6\x8e\x1c\x37\x18\xf3\xab\xd0\xce\x83\x9c\xa6\xec\xee\x54\xa5\xf4\x39\x4e\x83\x34\xe7\xd0\x43\x69\xea\xd6\xfc\xa9\x22\x07\xcf\x0f\xc6\xe4\x9a\xe7\x3c\xa3\x65\xb6\xc0\x67\x15\x7a\xd0\x9e\x40\xcb\xdc\xdf\x7e\x60\x90\x2e\xf3\xec\x80\x1c\xc9\x12\xbe\x9e\x20\x3b\xd7\x65\xcc\x63\xd7\x38\x31\xb7\xb0\x06\xd9\xf1\xe3\xcb\x59\xf2\xe0\x21\x9e\x22\x1d\xa4\x89\x1d\x7e\x00\x6d\xa1\xdd\x41\xe1\xa2\xbe\xfb\x90\x7d\xcb\xbe\x3e\x7f\x39\x26\x1f\xdc\x6d\xe6\xb4\x0a\xcb\x1e\x66\x75\xcc\xc9\x80\xbf\x88\x34\xbb\x1e\x6d\x6b\xa2\xe4\xc5\x70\xaf\x10\xca\x8f\x83\x73\x51\x5a\x5f\xcf\x8a\x1f\x67\xd5\xdd\x14\x51\x55\xfe\xc8\xe7\x21\xc6\x9f\x84\x37\x13\xed\x39\xd8\x99\x2a\xca\xf5\x3b\x56\xf4\xb2\xeb\x92\x02\x6a\x5f\x5a\x8a\x72\x70\x6d\x7e\x20\x15\xd7\xb2\x5c\x10\x84\xd2\x43\xad\x38\x4c\xaa\x8c\x1a\x9b\xd3\xc6\x38\xc6\xe4\xe2\xe5\xd5\xbb\x97\xe7\x67\xef\x5f\x5e\xbc\x20\xfe\x4b\xbc\x69\xa8\xf4\xeb\x28\xef\x9b\x2d\x64\x1a\x75\x8c\xae\x5f\x43\x18\xeb\x89\xbd\x6a\x30\x9d\x42\x43\x7f\x46\xc0\xbf\xa7\x82\x5c\x0a\xae\xeb\x16\xe2\xb6\xbe\x28\x93\xc2\xd5\x6d\x1b\xea\xbd\x34\x6d\x0c\x67\xc6\x6d\x76\xba\x70\x83\x31\xaf\xb7\xbf\x06\x32\x0b\x3a\xce\xa2\x8c\x63\x18\x51\xaf\x97\x2a\xca\x14\xf6\x1d\x5c\xa3\x45\xc2\x7b\x1b\x13\x0e\x04\xdc\x3d\xfd\xda\x37\x51\x70\xe3\xc5\xb9\xfb\x4a\xdb\x2e\xcf\xee\xe2\xe1\x78\x7c\x38\x06\x54\xe2\xc3\xf1\xa1\x57\x70\xb3\x80\xa6\x83\x0c\xb6\xb5\x66\x17\x60\xd5\x00\x39\xa8\xc5\xcf\x63\x42\xde\xfa\xda\x54\x9c\x1a\xc1\xa7\xcb\x00\x3f\x01\x07\xb2\xee\x9c\xe9\xbf\x84\xa2\xd8\x38\x5d\xee\x4d\x55\x4d\x9a\x83\x77\x6d\x83\x66\xfc\x0e\x09\x89\x06\x9b\xb1\xfb\xeb\xc3\x0f\x33\x9a\x5b\xde\xd5\x33\xfc\xf0\xee\xd5\xee\x07\x66\x25\x42\xf4\xb0\xce\x65\x9e\xdb\xe6\x21\xf3\xd0\xa9\xa1\x46\x76\x0c\x92\x6b\xb7\xe3\xc5\xdf\x08\xf5\x82\xef\xec\x56\xb0\x0d\x5d\xa6\xbd\xa2\x61\xe9\x5e\xf0\xaf\x2d\x39\x24\xc2\x8f\x71\x8a\xbd\xf3\x74\x81\x7d\x8a\x6e\xbb\xdd\x4b\xd5\x5f\xfb\xae\xcb\x93\xf2\xe0\xf5\xee\x8a\x3f\x0d\x73\x3e\x7d\xf7\xf2\xec\xe2\xf5\xcb\x71\x9e\xee\x52\x84\x32\x91\x16\x92\x0b\x1c\xf4\xc4\x52\x13\x73\xff\x6a\x33\x85\xd5\xd3\xc3\x23\x0d\xb9\xf6\xa5\x9a\xf2\x4c\x35\xf6\x44\xcb\x42\x66\x72\xb6\xbe\xcd\x75\xc4\x62\xfd\xce\xe2\xd7\x8f\xe8\xc8\xec\xd2\xee\x6d\x58\x3d\x8f\x5f\xb9\x2b\xaa\xe7\x7e\xc1\xea\x19\x07\x5b\x4c\x23\xab\xa3\x9e\xf2\xc2\x3c\x90\xb2\x1e\x56\x2b\xc6\xba\xb5\xde\x1b\x38\xe4\xbe\x37\x56\xdd\xdc\xa9\x60\x65\xce\x6d\x99\x0a\x6e\xd5\x37\x68\xfa\x8f\xbe\x11\xbb\x56\xe0\x83\x24\xe8\x57\xf5\x69\x6f\x57\x4c\xb4\x48\x37\xd2\xf4\x0a\x71\x88\xda\x3c\xf2\x37\xf7\x56\x5b\xa0\x17\x25\x1b\x85\x96\x1d\x53\x0c\x5a\xaa\x2c\x1b\x5a\x56\x53\xbe\xfb\x7c\x6a\xef\x82\xb6\x7f\x85\x30\x12\x97\x9c\xcb\xb5\xde\x1b\xc2\x04\x16\xc5\x33\xcb\x16\xf8\x7e\x79\xce\x8b\x4b\x67\xb6\x75\x46\xe9\x42\x92\x45\xc9\xef\x78\xc6\x66\xd0\x1e\x95\x8b\x99\x6b\x10\x8b\x29\x37\xf2\x18\xa0\x5a\x86\x76\xaa\xed\x48\xd8\x6b\xf3\xaf\x46\x1f\x75\x54\x67\xc7\x37\x6f\xdf\x43\xcf\x63\x48\x7c\xda\xda\xd9\x63\x06\x84\x60\x35\x42\x46\xa3\x11\xf8\x43\x8f\x7e\x34\x76\x4e\x9a\x1d\x93\xef\x98\x1b\x87\x84\xc6\xce\x25\x4f\x34\xb9\x9f\x4b\xdf\xd7\xb5\xff\x52\x36\xb3\xaf\x39\x03\x44\x87\x2d\xf2\x76\x54\x4e\x0d\x25\x63\x28\x58\xdb\xc8\xfc\x3d\xda\x5a\xe1\x0c\x5a\x7f\xd4\xd9\x28\x3b\xb5\x89\xb6\xb8\x91\x7c\x60\x6b\xdd\x89\xc0\xc9\x46\xdf\xdf\xc6\xdd\x6f\x94\xa8\x45\x9e\x71\x71\x7b\x12\xba\xc9\x4c\xa5\xe1\x3c\x0b\xc5\xc0\x05\xce\xd7\xed\xce\x52\xc9\x68\x66\x6f\xc7\x5d\x71\xd6\xce\x6f\x3d\x3d\x28\x50\xf3\x7e\x51\xd8\x7c\xc1\x20\xd6\x5c\x72\x9a\xbf\x6a\xb0\xab\x74\x70\xf0\x44\x57\x26\xc2\x8d\xb4\xc3\xbb\x85\xab\x44\xf1\xb8\x8b\xe5\xf2\xfa\xfc\xfa\xb2\x75\xab\x08\x62\x7f\xe6\x02\x9a\x3b\x29\xda\x59\x53\xb5\x83\xa0\x88\x0f\x5d\x6e\x52\x3e\x60\x41\x1e\xc6\x8e\x48\xe6\xb4\x38\xab\xf4\xfc\x82\xab\x44\xde\xb1\x01\x46\xf6\xfd\xdc\xb6\x19\xf6\x6d\xac\xb8\x5f\x76\x4b\x8f\x9c\xff\xed\xec\x0a\x97\x3d\x50\x99\xb5\xd2\xae\x87\xe1\x8e\xf5\xa9\x7a\xa6\xd7\xb6\xb8\x79\x47\xf3\x74\xd4\x9e\xcc\x2c\xf7\x41\xe7\x5f\x75\xd0\x19\x24\xc1\x6f\x25\xd0\xcc\x05\xd7\x9c\x6a\x59\x0e\x8a\xd4\x9d\x57\x4a\xcb\xdc\x1d\xd3\x4b\x4f\x0a\x52\xa9\x20\x60\xdc\xa2\x8e\x73\xc1\x36\xf2\x4b\xad\x39\x0a\xdb\x71\x29\x8c\x61\x48\x13\xb6\x54\xc8\x82\xf3\xeb\x0a\x76\xef\xc6\xc8\x03\x9d\xff\x72\xa5\x4f\xd0\xa2\x22\xfb\xef\x17\xad\x86\xea\xb8\xf8\xb8\x6f\xb2\xeb\x7b\xab\x3a\x37\x63\x22\x85\x60\x09\xb6\x8d\x6d\xdc\x6e\xfd\x14\x2f\x53\x9d\x3b\xdf\xce\xff\x7f\x55\x34\xb3\x6b\xfb\xe6\x21\xfc\xc8\xed\xbd\x8a\x1e\xaa\xe7\x23\xbf\x47\x6f\x7c\x23\x76\x63\x98\x80\xbe\x01\x7f\x81\x93\x10\x25\x15\xca\x6c\x6e\x3b\xfe\x78\xe8\xd2\x16\x0e\xc9\x91\x4e\x0a\x54\xae\xfb\x83\x97\x6a\xda\x69\xbb\x7d\x7a\x15\x4a\x34\x31\x63\x7b\xe0\xf8\x3d\x9c\x8d\x78\xf7\x67\x6b\x42\x57\x40\x84\xbc\xe2\x4a\xdb\x1a\x15\x4b\x15\x2b\x0d\x6c\x67\x54\xd0\x35\xaf\x8c\x79\xcf\x8b\x7f\xd1\x34\x2d\x5f\x58\xfd\xc0\xd9\x54\xf0\xdf\x38\x53\xc0\x37\x47\xa0\x22\x64\xb0\x1c\xe9\x45\xe1\x9a\xe9\xbc\x3f\xbf\x02\x6a\x8a\x7c\xf1\xa7\x67\x28\xdd\xd3\x3c\xbf\xff\xfc\x4f\xcf\x50\xac\xf4\x14\x4a\xea\xb6\xf0\x3f\x7e\x92\x5c\x81\x9d\xab\x6f\xc3\xab\x40\x8c\x02\xea\xea\x3f\x40\xda\x5b\x3e\x77\x17\x09\x15\x69\x7d\xdf\x3d\x8c\x9a\xba\xaf\x50\xc0\x7e\xf6\x57\x5e\xa1\xe0\x2b\xc0\xad\x6c\xdd\x85\x7c\xb6\xa2\xd9\xc9\x6a\xa4\x28\x8d\x10\xcd\x76\x1b\xb0\x31\x2f\x9c\x68\xc6\x8b\xdd\x07\xf1\xc6\xf0\x9f\xfa\x8e\xec\xc8\x68\x03\xbd\x7f\xd3\xdc\xc9\x5d\x79\x77\x30\x07\xbf\x7d\xe0\xad\x14\x3e\x3c\x54\xcd\x6c\x8f\x09\x23\x94\x5c\xbc\xb9\xfe\xd7\xab\xb3\x2f\x5f\xbe\x42\x5d\x87\x36\xd1\x1f\x34\x77\x67\xfe\x0c\xc9\x21\xc7\x4b\x84\xbe\xb3\x88\xde\x7a\x11\x1b\x39\x7f\xf3\xd5\xf5\x92\x33\xcc\xfc\xc4\x21\xc4\xd7\xce\x61\x9c\x3b\x6c\x53\xb8\x7c\x5b\x5b\x56\x4c\x7b\x57\xe8\x91\x1c\xe8\x0d\x10\xf9\x96\x77\xc1\x2c\x1a\xf4\x7f\x43\xe9\xbb\xd1\x00\xef\x03\x16\x84\x3c\x91\x78\xae\x59\x19\xbb\x5a\x11\x2e\x11\x44\x24\x37\xda\x75\xbe\x39\x92\xfb\x60\x2b\x8f\xd7\xe5\xca\x21\x60\x06\x87\xd7\xf0\x9e\xcf\x33\x30\xe7\xd4\x26\x1b\x97\xe6\x2a\x33\x97\x18\x53\x48\x73\x62\xba\xcc\xc5\x4f\x8f\x43\x77\x1a\x61\x18\xb9\x79\xee\xea\xaa\x2a\xd6\xf5\xb0\x8c\x93\xc4\x6b\xdb\x60\xda\xff\x74\xad\x2c\x23\xe0\xb7\x69\xab\xa6\x7b\x53\x0f\xe4\x90\xcd\x87\xf1\x6b\xc1\x45\x55\xd0\x64\xf8\x19\x5a\x83\xb9\x58\xff\xc8\xfe\x04\xc0\xeb\x76\x2a\xec\x81\xe2\xb0\x04\xfe\x73\xff\xea\x32\x5e\xc8\x36\x2d\xa5\x1b\x4b\x5e\x2f\x29\xa1\xca\x6b\x19\x2e\x37\x37\x12\x58\x64\xf0\x8d\xb2\xb3\x3d\x21\x8f\x76\xdf\x7c\xd7\x8d\xd2\x80\x2d\xac\x58\x6b\xb1\x3f\x8c\xb5\x8e\x17\x5d\x81\x5b\x77\x26\x98\xe6\x52\x4b\xb1\x45\xd1\xe7\xd5\x1a\x02\xed\xa4\x1c\xfb\x17\xe7\xb6\x38\x3a\xc3\x38\xe4\x02\x31\x5b\xc3\x12\x42\xa8\xc6\x1c\xf2\x57\xbf\x14\x3e\x98\xaa\x70\x1a\x28\xae\x4a\xf4\x11\xca\x32\x7f\xe1\x00\x3c\x0f\x01\x71\xb8\x6b\xd9\x51\xa4\x03\x4a\xbb\x2f\x2f\x9c\xfa\xee\x4b\xb3\x95\x63\x5d\x12\xc1\xbb\x64\x95\x7f\x3f\x99\x9a\x93\x5e\x5e\xec\x4c\x4c\xc8\x52\xdf\xcb\x72\x08\xf8\xd8\x55\xeb\xd5\xa5\x7c\x3d\xf7\x3b\x6c\x51\x04\x4a\x12\x3c\xa1\x93\x7e\x0d\x89\x3d\x4b\x1d\x4c\x97\xce\x2d\xd6\x3e\xb1\x36\x77\xed\xb1\xe8\x3a\xfe\x48\x17\xd4\xa6\xa8\xf7\xea\xf1\x47\x26\x7f\xad\xc6\xb9\x1f\xf5\xd8\x0f\x56\x19\x7a\xa0\xa2\xe2\xbc\xfe\x18\xa8\xa8\x95\x90\xc0\xce\x6d\x46\x7b\xa2\x06\x88\xc1\x6f\xdd\x8b\xce\xc9\x65\xf6\xb2\x16\x88\x34\x1c\x67\xd4\x52\xa0\x6b\x9d\x1e\x44\x00\xfa\x25\xd8\x99\x10\x2c\xa5\xf9\x9b\xfe\x4f\xb7\xaf\x15\xcd\x72\x05\x81\x14\x9a\x65\x66\xdf\xa5\x68\xf6\x3c\x75\x00\x50\xfd\x1e\x7a\xdb\xc4\x2f\xa7\x85\xb2\xcd\x0b\x52\x79\x2f\xee\x69\x99\x92\xb3\xab\xbe\x58\x71\x54\x45\x9d\x3d\x0a\x58\xf8\xf9\xd6\x54\x01\x70\x7e\xc2\xb5\xb2\x89\xcd\x90\x5a\xac\x9b\x5e\x19\x82\x04\xfa\x0a\xc9\x06\x46\xc6\x19\x75\xc6\x8d\xaa\xa1\x22\x09\x22\x13\x4d\x33\xdb\xbf\x01\x45\x74\xc2\xf4\x3d\x63\x82\x3c\x7b\xf6\xcc\x7a\xd6\x9f\xfd\xf9\xcf\x7f\x26\x66\x5f\x48\xca\x12\x9e\x7b\x62\xfe\x0f\x51\x44\x2d\xa5\x3f\x3e\x7f\x3e\x26\xff\x38\x7b\xfd\x8a\xd0\x04\xec\x27\x0b\x47\x6a\x47\x08\x9b\x65\x3f\x80\x3b\x34\xb6\x07\x1c\xf9\xfb\xf5\xdb\x37\x9e\xdb\x55\x7b\x88\x96\x9f\x72\x99\x22\x67\xce\xc1\x84\x69\x64\x1f\x37\x3d\xd7\x54\xcf\x01\x5a\x4c\x48\x4d\xe8\x74\x0a\x1c\x8e\x23\xbb\xb0\x66\xa8\x93\x69\x1e\x80\x8d\xcf\xe6\xbe\xdf\xbf\xe1\xd9\x0c\x52\xaf\x39\x22\x8b\x9b\x84\xc0\xb5\x87\x20\xb4\xd9\xec\x30\xa6\x70\x75\xc2\xb4\x4f\x48\xc6\x6f\x71\x93\x9f\xaa\xaf\x4b\x59\x15\x75\xc7\x8f\x92\x29\x63\xc9\x25\x54\x98\x51\xda\x0f\xe6\x9e\x73\xb1\x71\xdd\x4f\x9f\xb6\x10\xd1\x62\xbd\x75\x48\x33\xae\x74\xa3\xaf\x83\x13\x6a\x66\xb9\x77\x1b\xda\x6f\x2b\x81\xe1\x2b\x76\x4f\x1d\x4a\x5f\x90\xa8\xc8\x58\x23\xcd\xa4\x98\x59\xaf\xaf\x6c\xa6\x50\xb2\x34\xe4\x17\x2e\x0a\x64\x00\x35\xbe\x2d\xa1\x95\xc0\xaf\x69\x81\x0f\x0c\xb7\x03\x92\x35\xdc\x21\xa1\x13\x59\x69\x9f\x48\x65\xa9\xa2\x89\x5a\x04\x2b\x40\x43\xb4\xeb\x87\x7e\x73\x58\x2b\xc6\x01\x3d\xb7\xda\x37\x60\x4b\xeb\x3b\x21\x8c\x26\x73\x72\xcb\x16\x23\xbc\xec\x6e\x4c\x81\x42\xf1\x39\x2c\xdd\x85\x59\x06\xdb\x1e\xa8\x1d\xc5\x4f\x22\x3b\x87\x10\x63\xe2\xd9\x5d\x08\x09\x6f\x81\x37\x43\xa9\x7b\x24\x49\x8f\x4b\xa7\x9c\xb2\xee\x5a\x1a\x89\x86\xc3\x30\xbe\xaf\x97\x11\x62\x89\x14\x60\x5c\x3a\x22\xb6\x87\xb0\x59\xe5\x7a\x8d\x23\xa9\xda\x62\x0c\x65\x26\x7b\xcb\x16\x6a\xc3\x1a\x0c\x20\x5a\x67\x39\x9a\x6b\xc6\x69\x2e\x95\x68\x7c\x2b\x92\x28\x8c\x0c\xf0\xbe\x21\x11\x55\x31\x07\xda\x43\x7d\x8b\xcf\xf0\xc5\x58\xc2\x73\x9e\x40\xc9\x8a\x21\xee\x28\x7b\x3e\x0b\xcc\x31\x64\x5d\x1d\x1b\x28\xa6\x2b\xc7\x5c\x90\x38\x6b\x56\x81\x29\x45\x90\x25\xf6\xf5\x03\x18\xa7\xe5\x2d\xf3\x40\xbd\x34\x1b\x93\x2b\xb3\xb8\x01\x17\xdd\x76\xcf\xbc\x8b\xe5\x57\xb0\xa9\xe9\xa2\x89\x7e\x00\x13\x38\x1c\x8f\x0f\xad\x96\x10\xdd\x6f\x6b\x19\x15\x21\xea\xf5\x41\xad\xfe\xda\xfa\x28\x2d\x94\x6d\x3f\x68\x4c\x2e\x68\xde\x2a\x91\xce\xb5\xe6\x03\x73\x77\x1a\x13\x8d\x72\xba\x37\x68\x6c\xd1\x9a\x6e\x60\x0b\xdc\x76\x7e\xac\x6b\xe4\x5b\x5f\x1a\x43\xfa\x2f\x6e\xd5\x40\x31\x8f\xec\x68\xe5\x9f\x4d\x10\xc5\x71\x3a\xdb\xf2\xb3\xd9\x42\x01\xa5\x76\x10\x4d\x23\xe5\x37\x99\x28\x51\x56\xc5\xf2\xd3\x67\xb2\x0c\x22\x1a\xec\xa1\xf5\x26\xcc\x30\x9a\x6b\xcd\x9e\x2d\xda\x5a\x93\x5e\x33\x68\x10\xd1\xc0\x3b\x70\x79\x18\x89\xd7\x86\x44\x19\x44\xd4\x49\xfa\x86\x05\x1d\x2e\x52\xc3\x6d\xc3\x1a\x9e\xee\xc0\xa6\x5a\x7e\x3a\x6d\xac\xc1\x8c\xdf\xb0\xcb\x56\x6c\xae\x41\x44\xb7\xb7\xd3\x96\x1f\x63\xb7\xc5\xb4\xef\xf3\x4f\xac\x21\xb7\xfc\xc4\x19\x76\xcb\x0f\x36\x1d\x69\xf9\x59\x91\xff\x5e\x2f\x70\x97\x79\x7c\x4f\x52\xe2\x54\x1a\xd8\x6e\x2d\xa1\x71\xa8\x0e\x17\xcb\x98\xbc\x1e\xd8\x77\xd9\xe9\x73\xd4\x98\x45\x4a\x66\x95\x66\xbe\xca\xd7\xaa\x23\x83\x88\x36\x55\x18\x98\xb0\x6f\x4f\x1a\xaf\x89\xf8\xc7\x0d\xa7\xa9\xd6\x80\x92\x3b\xbc\xa1\xf0\xd0\xb1\x6c\x71\x11\x0f\xed\x43\x3c\xa4\x0b\x31\x2a\xd7\x66\xf9\x19\xdc\x7d\x78\x58\xef\xe1\x98\xf4\x71\xfb\x60\x93\xc8\x63\x15\xfb\x07\x48\x1c\x8d\x1c\xc2\x03\x24\x94\xdb\x27\x3e\xad\xdc\x3e\x03\xf9\xdc\x5b\x46\x5b\xec\xeb\xb5\x03\x5a\xf5\xc5\xba\x2d\x3b\x30\x72\xf6\xb2\x24\x5c\x2b\x6b\x5a\x7b\x23\xcd\xf6\x65\x8d\xb3\x52\xe3\x22\x40\xed\xb7\xa2\xce\x93\x8f\x2d\x9c\x5d\x5d\xee\xd4\xd7\xd5\xa0\xfb\xeb\xf5\x76\x41\xbc\x07\xb0\x2f\xbc\xab\xf5\xa2\x9e\xf6\xb7\x78\xf4\xbf\xfa\x41\x21\xd9\x6c\x39\x87\xe5\x88\xe8\xf2\x90\xbf\x32\x77\x7e\x44\x86\xe1\xca\x90\x1a\x0c\xa1\xa5\x8b\xf0\xd4\xda\x44\xdd\x1f\x26\x9a\xb2\x4f\x8c\x03\x29\xf5\x88\x66\x39\x7c\x0f\x59\x60\xb5\xfc\x2c\x27\x48\xbb\x2b\x99\x5c\xb3\xcc\xc8\xf0\x41\xba\xc4\x92\x2b\xb4\x90\xe9\x0b\xdb\x97\x9c\x0a\x21\x35\xac\xfc\x40\x13\x2c\x83\x3e\xf1\x27\xd6\x75\x69\x54\xfa\x46\x62\xe2\x80\xce\xe6\xe6\x09\x8e\xfb\x41\x0a\xf9\x36\xdb\x66\x9e\xfa\x22\x1b\xf6\xfe\x72\xc8\xdc\xd2\x72\x2b\x3f\x90\x22\x21\x2a\x99\xb3\x9c\xda\x96\x23\x66\x27\x3d\x48\xdc\x7d\xc9\xb5\x1e\xe8\x39\x20\x2e\xa5\x94\x95\xb9\x22\x72\x7a\xe2\xad\x53\x48\x75\x38\xb8\x7b\x8e\xcc\xec\x58\x7d\xb6\x72\x05\x11\x7f\x7c\x30\xc0\x5f\x9b\x9e\x76\x80\xa9\x01\x5f\x34\x44\x10\xd4\x0f\xf8\x84\x32\xe8\x02\x25\xda\x3e\xec\xc1\x24\xcf\xae\x2e\xc9\x9d\xe5\x91\x4f\xb4\xdc\x43\x95\x7e\x02\x4a\x7c\xd8\xaa\xc1\x96\xca\x00\x6d\x9e\xfc\x66\x5c\x86\x27\x41\x29\xdc\xbb\x0c\xf7\x2e\xc3\xbd\xcb\x70\xef\x32\x7c\x12\x2e\xc3\x86\x5a\xea\x35\x91\x2d\x36\xa6\xe9\x7a\x6c\xb6\xbc\x88\xb6\x6d\xfc\x63\x13\x4b\x5d\xa6\x58\x03\xb8\xdd\x88\x51\xe7\x45\x1c\xc6\xea\x65\x3b\xfa\x79\x38\x1e\x1f\x1e\x7a\x7f\x64\x85\x28\x34\x58\xf7\x98\xf3\xac\xa7\xa3\x2f\x08\x13\x89\x4c\xcd\x78\xdf\xc3\xdc\x4b\xa5\xc1\x58\x1b\x36\x52\xef\x74\x6a\xae\x6d\xee\xd6\x61\x18\xb7\x37\xe2\xb5\x30\xef\x21\xac\xbf\x95\xaa\xe2\x13\x23\xbf\xda\x99\x71\xe5\x4c\x2a\x28\x79\x43\x62\xf7\xad\x7b\xdc\x62\x07\x40\x54\x67\x5b\x85\x44\xce\x61\x86\x15\xcf\xb9\x83\x15\x35\xd7\x15\x53\x5a\x91\x23\xfb\xc3\x71\x52\x54\x03\xad\x35\xfb\x7e\xce\x72\x59\x2e\x4e\x02\x61\x43\x10\x8d\xf5\xb2\xfc\x04\x22\x96\xea\x31\x24\x0c\x26\x55\x59\x32\x81\x2d\x04\x59\x7e\x3e\xad\x05\x18\xf6\x11\x5b\xfa\xb7\xee\x59\x2a\x07\x0c\x3d\x37\xe2\x5d\xca\xcd\xc7\xeb\xea\x90\xe3\xe9\x8a\xee\x4e\x82\x4b\x73\x30\x59\x43\x8d\x89\x3b\x72\x47\x4b\x54\xb1\xde\xba\x67\x6b\xa3\x2f\xe5\x77\x5c\x49\x54\x29\xf3\xba\x87\x8a\xc5\xdb\x41\x22\x81\x58\x10\x8a\xad\x2e\xd5\x36\x95\x2d\x16\x61\xad\xa7\x99\xbb\xfe\x2c\xb2\xd2\x45\x35\x4c\x7a\x93\xa0\x77\x78\x69\xe5\xd1\x42\x83\x94\x1a\x26\x51\x48\x9d\x17\x6e\xfd\x06\xcf\x0f\x06\xd2\x29\xa8\xd6\xac\x14\x2f\xc8\xff\x39\xfa\xe1\x3f\x7e\x1e\x1d\xff\xf5\xe8\xe8\xfb\x67\xa3\xff\xfc\xe7\x7f\x1c\xfd\x30\x86\xff\xf8\xec\xf8\xaf\xc7\x3f\xfb\x7f\xfc\xc7\xf1\xf1\xd1\xd1\xf7\xdf\xbc\xfe\xfa\xfd\xd5\xcb\x7f\xf2\xe3\x9f\xbf\x17\x55\x7e\x6b\xff\xf5\xf3\xd1\xf7\xec\xe5\x3f\x91\x44\x8e\x8f\xff\xfa\x3f\x06\x0e\xf8\xe3\xa8\x0e\xc4\x8c\xb8\xd0\x23\x59\x8e\xec\xd6\xbf\x20\xba\x1c\x68\x46\xf9\xed\xd8\x8d\xdc\xa9\xd5\xb4\xad\x2e\x38\xd2\xf4\x7d\x7c\x22\x01\xb1\x9d\x9b\x62\x8b\xe9\x6f\xe1\xa5\x18\x1e\x4f\x7d\xea\xb1\xd1\x01\x1f\xb3\xd5\x2c\x3b\x8d\x19\x45\xb4\x5d\x77\x34\x7f\x59\xe1\xa2\x5f\x40\x72\xb4\x03\x83\xdb\x9c\x19\x1d\xd3\xd4\xaa\x7e\x7a\x32\xa3\x07\x28\xab\xfb\xcc\xe8\x47\xce\x8c\xb6\x9c\xb1\x4f\x8b\x8e\x23\xb9\x4f\x8b\x0e\x34\xf6\x69\xd1\xbf\x8d\x18\xc7\x3e\x2d\x7a\x1f\xe3\xd8\xc7\x38\xf6\x31\x8e\xe6\xf3\x04\x62\x1c\xfb\xb4\xe8\x7d\x5a\xb4\x7d\xf6\x69\xd1\xed\x67\x9f\x16\x5d\x3f\xfb\xb4\xe8\xce\xb4\xe8\xeb\x58\x0f\x0d\x69\xe5\x44\xff\x32\x52\xa2\x95\xe1\xad\x84\x9d\x25\x89\xac\x84\x7e\x2f\x6f\x59\x44\xee\x20\xca\xcd\xb5\x42\xff\xc9\xfa\xbc\x68\x95\x72\x26\x62\xfd\xd7\xad\x45\x38\x73\x24\xbc\xab\xc6\x68\x01\x22\x8d\xf6\x4d\xf8\x91\x78\x49\xa3\xcd\xba\x8d\xc9\x19\x29\x59\xc2\x0b\x6e\xee\xb5\xe8\x0b\x9d\x5a\x2a\x96\x2d\x1d\xf6\xd1\xc2\x30\x2b\xcb\xa6\xae\x4f\x7f\xac\xb9\x12\x10\x94\xca\x86\xdf\xc7\x5d\xc2\x7e\x0a\xb1\xe7\xa7\x31\x61\xab\x50\x4a\xdf\x06\x9e\xa8\xb9\xac\xb2\x94\x94\x6c\x80\x3b\xbb\xb1\x8a\xef\x1b\xa3\x6b\xc6\x7f\x06\x50\x6c\x2c\x80\x1b\x38\x2d\x78\x0c\x20\x76\x20\x36\x4c\xf2\xb1\x8f\x05\x2f\xe1\xc8\x5d\xb3\x44\x8a\x74\x1b\x8f\xed\xcb\x65\x5a\x8e\x87\x07\x28\x1c\x0c\x9c\x7d\x69\x65\xc9\x01\x2a\x0b\xcd\x78\xca\xf5\x62\x58\x2e\xb1\x13\x22\xc6\x56\x05\xb4\x38\x7f\x1c\x54\xbd\xad\xb1\x87\xa1\x28\x4a\x49\x93\x39\x53\x8d\x35\x3c\xb1\xea\xad\x05\xdd\x1b\xe6\xfb\x2d\xb2\x6a\xc6\x85\x35\x04\xe1\x0b\x46\x03\xcf\x16\xa4\x94\xda\xd7\x05\xb8\xc9\xc4\x0e\xb8\x3d\xf5\xf7\x8d\x81\xc2\xc7\x40\x49\x8d\xe5\xe0\x72\x01\x98\xbb\xb2\x39\x3c\x2b\x24\xf8\x74\xf0\xd2\x72\x45\x64\x96\xfa\x36\x3d\x5f\x3c\x23\x05\x2b\x13\x2b\xb1\xe0\x6a\xd4\x3c\x7a\x55\xb5\x24\x99\xb1\x64\xcc\xe5\x3a\x6d\x8e\xb2\xf9\xa9\x48\x9a\x9f\xff\x81\xcc\x65\x55\xaa\x71\x13\x24\xef\x39\xfc\xcc\xfa\x46\xe3\x73\xa2\x8c\x51\xa3\x49\xc6\xa8\xd2\xe4\xf9\x33\x92\x73\x51\x19\x85\x2e\x8a\x4a\xc3\xf8\xfc\xd3\x1f\x06\x88\x90\x78\xb3\x33\xde\xe0\x5c\xcd\x50\x77\xb7\x5d\x61\x3b\x57\x0f\xf2\x47\xbb\x88\x8b\x85\x83\xb4\x9d\xf6\x1b\xa9\x74\x43\x94\x00\x47\xb7\xe6\x16\xa1\xe5\x23\x48\xe5\x78\x8b\x2b\xd2\x6e\x8a\xd6\xf2\x22\x5f\xc0\x1b\x56\x68\xc2\x3f\x55\x72\xb2\xd0\x71\x00\xab\xff\xcb\xbe\xd3\x46\x56\xf5\x3f\x6c\x76\xea\xe8\x9d\x12\xa0\x81\xae\x76\xea\xa8\x1b\x75\xf4\x50\x88\xd1\x26\x67\xa5\xac\x50\x40\x5a\xad\xb9\x82\xff\xcc\xfb\x58\x3c\xc0\x52\x92\x30\x05\x52\xc9\x49\x28\xd4\x5e\x43\x3c\xcb\x8e\x02\xbd\xd5\x0f\x0d\x43\xda\xd9\x28\xc3\xed\x28\x6a\x72\x1e\x0c\x0d\xdb\x2c\x03\x45\xf4\x11\x9a\x96\x95\x6c\xc6\x95\x1e\xd0\x3a\xf8\x9d\x7b\xb1\x7d\x08\x14\x17\xb3\x0c\x6e\xc3\xbc\xca\x34\x2f\x90\x99\xc6\xfe\xec\x04\xa2\x4e\x13\x69\x36\xed\xa4\x75\x04\x0d\x45\x93\x5a\x6c\x62\xdb\x35\x0a\xc2\xf4\x47\x7e\x4c\x84\x09\x6d\x5b\xc2\x97\x46\xe9\x29\x68\x49\xb1\x88\x78\xb0\xa5\x89\xcc\x73\xaa\x8e\x5d\x9c\x96\x42\xae\xab\xbd\x66\x8c\x36\x51\xd2\x2c\x2c\x2b\x8a\x66\x23\xeb\x70\xd7\x07\x43\x33\x41\x05\x2a\x3b\xa4\xed\xa4\x85\xd7\x88\xbc\x17\xbe\x4f\xec\x8c\xdf\x31\x11\xf6\x29\x42\x01\x75\x36\xd7\x97\x34\xb9\x65\x22\x25\x1f\x94\x3f\x17\xe9\x42\xd0\xdc\xf5\xe0\x2a\x4a\x79\xc7\xcd\xc1\x40\xee\x42\x7b\x1c\xea\xc4\x45\xb6\x2c\x32\xa6\x07\x63\xb7\x0a\xef\xae\x57\xb4\x52\x03\xfa\xc2\x7c\x50\x46\x13\xec\x96\xa1\xe8\xb6\x39\x8a\x95\xfc\x2e\x61\x5e\xed\x36\x03\xda\xf5\x24\xef\x50\xa0\xe3\x2b\xd3\x74\x80\xe3\xbc\x11\xeb\x86\xbb\x2d\xa4\xc1\xe0\xe6\x48\x05\xa1\x99\x11\x9b\x8b\x00\x4b\xdb\xde\x70\xb3\xc3\x62\xf7\x7d\x66\xf1\x38\xc7\xc8\xe3\x3d\xc2\x1d\x14\xb4\xae\x52\x4e\xe2\x80\x90\x0f\xdf\x7d\x79\xd1\x96\xcf\xef\x68\x2a\x15\xf9\x32\x93\xc9\x2d\xb9\x60\x60\xb9\xc2\x65\xd5\xbb\x90\x8d\xce\x63\xdd\x9d\xc5\xa2\xba\x39\xf6\x35\xcf\x2f\x27\xe9\xc3\xb4\xce\xdf\xb7\x5a\xff\x55\xb7\x5a\x2f\x27\xb8\x31\xfc\x1a\x1a\xad\xe7\x74\x36\x80\x91\x21\x0c\x0b\xc2\x00\x08\xf8\xee\x8c\xab\xcb\x8e\x3a\x9a\xbf\x9b\xcb\xfb\x91\x96\xa3\x4a\xb1\x11\x47\xa5\x32\x47\xcd\xf1\x96\x2d\x20\xff\x3b\x7a\x96\xdf\xd8\x17\x5b\x4e\x00\x2d\x21\x04\x02\x3f\xc7\x66\x6d\xbc\xfb\xf2\xc2\x5c\xe1\x75\x53\x22\xae\xc8\x29\xd3\xc9\x69\xc2\x8a\xf9\xa9\x1b\x5e\x74\x1f\xdb\xa7\xb4\xc4\xb9\x14\x5c\xcb\x32\x1e\xdc\xfa\xf0\x8c\x24\x32\xcb\x1c\xd4\xb4\x9c\x92\x73\x56\xcc\x03\xb9\x88\xf8\xe3\xa3\xb0\xdd\xd3\x68\x94\x5d\x48\x19\xdf\x5c\xb7\x71\x64\xcd\xfb\xee\xc4\x36\x18\xb2\x9c\x20\x33\x7b\x9e\xe8\x29\x7f\xa0\x76\x97\x0f\xd5\x3f\xfc\x89\x71\xf6\x63\xf5\x2a\x3f\xbc\xf6\xaf\x82\x43\xa7\x91\x73\x10\xdd\x76\xdc\x0f\x03\x0c\xe0\x20\x62\x2f\xa7\xd6\x1c\x4c\x59\x4a\xe4\x1d\x2b\x4b\x9e\x32\xe5\xaf\x80\x28\xbf\x09\x0c\x90\x67\x8f\x79\xad\xed\x3b\xa9\x63\x3f\xfb\xab\xef\xa4\x3e\xc8\x51\xd0\x10\xf2\xe6\xfd\x55\x21\x4f\xd3\x9c\x23\x21\x6d\x9e\xa4\x98\x8f\xe8\x83\x6e\xf4\xd2\xde\xbf\xf2\x8a\xc6\xae\x4c\x6c\x95\xd0\x8c\x5d\xbe\x8d\x32\xb3\xaf\xed\x3b\x6d\x4b\xdb\xff\xb0\x6e\x4d\x87\xf4\xba\x6c\x6c\xb9\xf6\x4d\x38\xa0\x44\xc8\xb4\x3f\x7a\xb7\x6f\xac\xd8\xd7\x58\xb1\x3e\x57\x28\x8a\xe8\xbe\x6c\x51\x62\x65\x46\x35\xbb\xa7\xf1\x5a\xcf\x7b\xbf\x2c\xae\xad\xb4\xbf\x0c\x3c\xe3\x61\x11\x1f\xbf\xb6\xdf\xdf\x7d\x47\xc8\x52\x6a\x6b\x15\x5c\xc8\x9c\x72\x54\xee\xd6\xca\x04\x9b\xfa\x85\x9f\xd8\x55\x20\x8c\x53\x06\xe0\xeb\xa0\x63\xd4\xcd\x5f\xaa\x92\xa5\xc4\x79\x0d\xf6\x4d\xf1\x1e\xb0\x29\xde\x70\x45\xb3\xd6\x33\x6b\xf7\xb1\x0f\xc5\x47\x54\xa8\x9a\x7d\xf7\x9c\x03\x57\x6a\x48\xdc\x22\x8a\x09\xc5\x21\x0f\xbd\x91\x20\x88\xec\xbb\x38\xb5\x8d\xb8\x42\x8d\x9e\x55\x58\x4f\xc8\x2b\x39\xe3\xc2\x4b\x2a\x69\xd3\x6d\x70\xe3\xa4\x3c\xc3\x7c\x7b\xaf\x61\x62\x3f\xfb\xab\xd7\x30\x95\xca\x5e\x0a\x3a\xc9\x70\x09\x1d\xed\x8b\x3b\xa3\x90\x5d\xc5\xe0\xfd\xd3\x94\x2b\xf3\xff\xe4\xfa\xfa\x15\x04\x37\x2b\x11\x63\xc5\x41\x38\xcf\x5d\x23\x01\xee\xd2\x0a\xb0\xdd\xcb\x13\x2b\xb3\x07\x35\x4b\xbc\x14\xa9\x99\x16\x53\xad\x5c\x6a\x47\xd1\xf6\x8b\x8c\x89\xf5\xbb\x9c\xcf\x09\x23\xef\xe7\x3c\xb9\xbd\xaa\x63\x98\x44\x96\xe6\x67\xa2\xf1\xa3\x68\xc3\x75\xc0\xfb\x51\xbc\xe6\xa6\x7d\x35\xc4\x15\xf5\xbe\x71\x1b\x5f\xbb\xe5\x33\x84\x08\x55\x4a\x26\x3c\x2e\x98\x0e\x8e\xd9\x70\xa5\x93\x14\xae\xeb\xdd\x4f\x17\xb4\xc1\xad\x75\x10\xcf\x2c\x4e\x59\xa5\xaa\xa1\x4f\x60\xc3\xe1\x6e\xe5\x76\x3e\x45\xcb\x96\x58\x74\xa5\x8d\xd3\xa4\xc1\x0e\x69\xc7\x60\x63\xa2\xfd\x9e\x39\xbc\x4e\x0f\x4d\xbe\x55\x83\x3d\xf0\x35\x83\x70\xcd\xfa\x13\x07\x60\x23\x9f\x2c\xea\xeb\x34\xf5\xde\xbf\x0b\x3a\x4f\xff\x5f\x62\xa2\x4a\x78\xdb\x15\x05\x05\xb2\xce\x9b\xb7\x94\xc4\x63\x7f\xe6\xc2\xbc\x46\xc8\x21\x3c\xda\x45\x95\xd9\x3c\xdd\xb0\x5d\x5b\x47\xf1\xec\x38\x76\x19\xf6\xdd\xa6\xd5\xee\xba\x82\xef\x4d\xc5\xdb\x28\xce\x96\x22\xa2\xeb\x2e\xee\x62\x6a\xd6\x77\xef\xa6\x56\xbb\xa7\x36\x7b\xa9\xce\x1a\x45\x72\xa9\x16\x7b\x37\x75\xd5\x8d\x3a\xea\xa6\x15\xf5\xec\x4f\x7f\xf8\xc3\xba\x3e\xbc\x48\xd1\xb3\xa1\x57\xef\xfa\x3e\xbc\xb8\x71\x6e\x2a\xa0\xde\x5c\x0c\x8d\x1e\x6b\x5f\xf1\x73\xa3\x90\x19\xb9\xf7\xcb\xc5\xce\xe8\xc2\xe5\x87\x6d\xc4\x8b\x8e\xe2\x45\xe0\xfe\x40\x8a\x60\xcc\x1d\xd7\x85\xf6\xd3\xc4\xef\xc1\x2e\xf5\x46\x8c\x9f\xb5\xa8\x3d\xb8\x91\xb6\x90\x7d\x76\x86\xd5\x83\x43\xe8\x19\x80\xe4\xbe\x09\x97\x67\x2d\xd2\x0e\xd6\x4e\x5f\x45\xe3\xd9\x84\xaf\x83\x9e\xbc\xc3\xe0\x41\xa0\xea\xe0\xb6\x49\xef\x1a\x4b\x07\x83\xa0\xd3\x28\x1e\x47\xd1\xc4\x56\x88\x0f\x6d\x58\xbd\x1e\x23\xa7\x89\x79\x83\x34\xb9\xe3\x90\x71\xe2\xeb\x31\xa3\x50\x70\x76\x86\x7d\x33\xa0\xe0\x23\x0e\xe7\x06\xad\xec\xa0\x29\x92\x4d\x98\x36\x2b\xf8\x34\x11\x24\xd7\x20\xd9\x74\x68\x3a\x11\x84\x97\x48\x76\xe8\x3b\xd1\xa3\x5d\x83\x40\xd3\xd6\x7a\x22\x28\xae\xea\x47\x9d\x18\x32\x11\x94\x1b\xd2\x6c\x23\x72\x0c\x5e\xd1\xb1\x0f\x52\xdd\x89\x1a\x65\x0b\x25\x66\xa3\xd2\x13\x41\x72\x55\x3d\xda\x46\xf5\xb1\xcf\x30\xa4\x96\x21\x85\x72\x71\x45\x72\xbd\x58\x2c\x43\x20\xe2\xd7\x23\xb0\xac\x41\x53\x89\xa0\xd9\xc2\x5d\xd9\x1d\x86\x4a\x27\x72\x4a\x24\xb5\x68\x91\x1c\x57\x7f\x87\xc7\x39\x89\xa8\xd2\x7b\xb0\x82\xbb\x38\xac\x0b\x24\xc2\x45\xb3\x8f\x1f\xce\xbc\x8a\x06\xb6\x18\x12\xce\x1a\xe4\x63\x6b\xc5\x53\x9c\x8b\xc5\x73\xb4\x4d\xa1\x0f\x3d\xbe\x70\x3b\x29\x8d\x48\x7e\x24\x27\x0b\x89\x63\x77\xbc\xcb\xca\x8e\x46\xf6\x2a\x5f\x6d\x86\xb1\x6f\xbd\xbd\x5e\x4a\xb9\x08\x3f\x46\xd6\x27\xed\x33\x2d\x1e\x2b\xd3\xa2\x2e\x12\x40\x9a\xd8\xb6\x90\xa0\xb3\x48\x60\x1f\xb6\x7f\xda\x61\x7b\xd5\x42\xd9\xf7\x4e\x65\x90\x5a\xe8\xc8\xbd\x9c\xb8\x1e\x95\xf6\x52\x08\x07\xfc\xec\xea\x92\x24\x25\x03\x8c\x13\x9a\xa9\xb1\xb1\xf4\x71\xe1\xf0\x36\xc2\xa2\x0f\x56\x3a\x6d\xda\xeb\xba\x54\x6b\x96\x17\x1a\xc7\x64\xfb\x88\x3c\xf6\xb3\xbf\xfa\x88\xfc\x16\x21\xb8\x6f\xc3\xab\xde\x3f\x37\xaf\x72\x2a\x46\x46\x92\x40\x6c\x1e\xed\xf5\xf3\xe9\x52\x4b\xb7\xe1\x98\xf8\xfa\x4c\xd8\x34\x70\x6a\x4b\xac\x54\xaa\x04\xff\xa9\x62\x35\xf6\x70\x50\x55\x1e\x30\x8a\x09\xf4\xb7\x58\x47\xdb\x2e\x75\x49\x0a\x25\xb2\x88\x5a\x45\x67\x10\xbb\x89\x87\x35\x05\x81\x43\x84\x8c\xd4\xd9\x5a\x5e\x48\x3d\x67\x56\xfd\xbb\x02\xc4\x87\xba\xc3\xab\x93\x42\xb8\x8d\x01\xab\xdc\x82\xb3\xd2\x2c\x93\xf7\x76\x9e\x4d\x0d\xc6\xb0\x94\x99\x37\xf6\x3a\x77\x18\x06\xbc\x2c\x65\xe9\x22\xb5\xcd\xa9\xdb\x4c\x3a\x63\xd7\xb3\xd2\x9a\xaa\x65\x44\xe2\xd4\x35\xd3\x4d\x56\xd7\x92\x50\x61\x8b\x79\xcd\x7f\xfb\x7c\x7f\xdb\xba\x3a\x02\x5b\x62\xc2\xe6\xf4\x8e\xcb\xaa\x1c\xc3\x17\xb4\x24\x07\xee\x75\x50\x1b\x16\xb2\x0a\x41\x9c\x4a\x61\x17\x22\xec\x88\x5a\xb3\xff\x6f\xea\x5f\x82\x4b\x22\xc5\xf9\xc5\x9c\xc7\x79\xc4\x3e\x72\xa5\x57\xd7\xd6\x5f\x40\xbe\xc9\xda\x27\x52\xcb\xef\x54\x61\xf4\x90\x6f\x51\x65\xe0\xed\xe3\xd7\x7c\xb3\xad\x9e\xdf\x5d\xc3\xaf\xb6\x55\xce\x1d\xb6\x94\x45\x77\xf0\x65\x95\x7b\x05\xbd\xeb\xf9\x95\x28\xe8\x21\x65\x29\xe3\xc9\xe2\xf2\x22\x5e\x35\x0d\xa9\x4a\x86\x00\xf9\x92\x2a\x96\x92\xd7\x54\xd0\x99\xf5\x25\x1d\x5d\x5f\x7d\xf9\xfa\x18\x1b\x35\x00\xc7\xe3\xe5\xc5\x72\x52\x4b\xf3\xee\xb5\x1f\x7a\xb3\x7b\xa4\x82\xa5\xb5\x18\xa4\x70\x3c\xc0\x6a\x3c\x00\x26\x83\x57\x08\xb0\x6d\xab\x57\x01\xc0\x6c\xf2\x91\x47\x43\x54\xb1\x62\x08\x86\x90\xa7\xb7\xbb\x9d\x16\x3e\xe9\xa8\x9e\xfe\x6e\x84\x3b\xe6\xcb\xa3\x3e\x65\x13\xf5\xad\x3e\xdf\x61\xf7\x48\x46\x75\xc3\xc0\xf5\x06\x46\xe7\x18\x3a\x7e\xa9\x4b\xca\xf5\x9a\x7b\xa0\xc5\x39\x00\xcd\x06\x7f\x48\x04\x63\xa9\x15\x74\x65\xe5\xc2\x57\x97\xb5\xd6\xb3\x42\xa6\xfb\xaa\xf9\x3d\xd4\x17\xad\x9f\x71\xdb\xd6\xbb\x30\x57\x67\x62\xc4\xca\x0b\xd0\xb8\x26\x34\xb9\xbd\xa7\x65\x0a\xc8\x02\x54\xf3\x09\xcf\xb8\x5e\x6c\x88\x48\xf4\xdf\x77\x3e\x6d\x11\x26\xb1\x99\x17\xda\xe1\x02\xb3\x20\xe7\xcd\x17\x49\x41\x4b\x9a\x33\xcd\x4a\xd5\xa6\xd8\xc3\x14\x3d\x9c\xd3\x6a\x01\x07\x1a\x44\x79\xc7\x46\x95\xb8\x15\xf2\x5e\x8c\xac\xb9\xd8\xd1\x0c\x0e\xc1\x58\x3d\x23\xed\x19\x25\x4d\x53\x29\x36\x2c\x2d\x4d\x53\x6e\xfd\xe0\x57\xbd\x9b\xd0\xc6\xe7\x35\x44\x61\x89\x9b\x6a\x53\xb3\x64\x25\x40\x96\x52\x61\x87\xb0\x79\x95\x0d\x99\x0d\xbf\x45\xec\xc0\x96\xeb\xbf\x12\x63\x62\x1f\x35\x13\x0a\xf8\x05\xd0\x13\x2d\xa4\xaf\x99\x81\x3b\x64\x83\x36\x61\x3a\xe5\x82\xeb\x0d\xee\xba\x95\x31\xac\x2c\xe2\x99\x7b\xbf\x63\xb1\x1e\xe6\x18\x1d\xbe\x62\x33\x9a\xb8\xef\x2e\x8d\xab\x3e\x4f\x63\xd2\x90\x00\x1d\xa2\x38\x5a\x36\x90\x47\x3a\x85\xc4\x15\x17\x74\x8c\xbe\xb5\x2c\xe7\x36\xec\xea\x33\x2a\xed\xcb\x44\x96\xc4\x17\x27\x50\xbb\x62\x63\x72\xd6\x99\x54\xe5\xc4\x36\xe0\x13\x59\x71\x9d\xc8\x3c\x37\x6b\x6b\x77\xb3\xa3\xd2\x0d\xe3\x23\x15\x32\x65\x9e\x75\x5e\xd1\x09\xcb\x3a\x4c\x8a\x65\x2f\x31\x17\xcc\xe6\xd7\x02\x80\x28\x84\x16\x02\x14\xf7\xcc\x73\x80\x4c\x8f\x54\x97\xda\x65\xa6\xc5\x32\x3e\xe3\x93\xcc\xe3\x0d\x2a\x63\x27\x55\x19\xd8\x47\x27\x64\x02\x9a\x9c\x14\x24\x83\xe1\xf5\x43\x51\x9a\x81\x6c\x5e\x94\xde\x7c\x1e\x94\xda\xd3\x1f\x48\x2c\x64\x7a\xd6\x79\xa4\xc9\x8a\xc4\xcc\xee\xe9\xc2\x6c\xef\x28\x93\xb6\xae\x23\x00\xfc\x95\xcc\x98\x33\x34\x14\x45\xf2\x8e\x1b\xbb\x31\x55\xeb\x8f\x51\x34\x67\xb0\x28\xe4\xc8\xfb\x87\x3f\x03\xbf\xfe\x67\xc7\xdb\xf1\x4e\x63\x8a\xdb\xb1\x4e\x21\x53\x45\x8e\x8c\x92\x96\x19\xa3\x50\x2a\x46\x72\xaa\x8d\x25\xdc\xa5\x78\x42\xde\x9e\xf9\xae\x6b\x39\x2a\xcb\x93\x3a\xfc\xef\x12\xf6\x2c\xce\x5f\xf0\x7c\x1c\xf7\x61\x5a\xad\x67\xdf\x46\x6d\x4c\xd8\x1d\x6b\x1e\x3d\x0d\x3e\x13\x9a\x47\xf2\xda\x1b\x76\xc7\xca\x5f\x18\xab\x35\x66\xf9\xdb\x60\x37\x97\x70\xf2\xc4\x58\xae\xe7\xb2\x9d\x54\x3c\x4b\x37\x41\x45\xf4\xab\x31\x5f\xda\xd7\xf7\x5a\xcc\x86\x67\xaf\xc5\xac\x7b\x30\x0e\xd1\xf6\x5d\x0b\x19\xdf\x20\x0c\xc2\xab\x4e\xfb\x08\x68\x3d\xee\x4c\x03\x43\x77\x4d\x8d\xaa\xcd\xde\x94\xc7\xba\x05\xee\x58\x39\x91\xaa\xc3\x6b\xd6\x6e\x40\x61\xb7\xd1\xbd\x44\x32\x39\x9b\x71\x31\x33\x9a\x15\x4c\x16\x38\x56\x0a\x67\xb0\x75\x0a\x30\xe7\xce\x25\x5c\x93\x23\x36\x9e\x8d\xc9\x37\x54\xf0\x5b\xe9\xe8\x14\x32\xdd\x4a\xee\xf7\x9c\x88\xc4\x48\xf0\xa1\x82\xe6\xdc\xbc\xbc\x17\x33\x1b\x9e\xbd\x98\x59\xf7\x3c\xa4\x98\xe9\x72\xc2\xd5\x4f\x59\x89\x4e\x18\xfd\xc7\x92\x37\x6e\x1c\x2e\xbf\x01\xeb\x70\x33\xe7\xd0\x1c\xbb\xd1\xed\xc8\x11\x30\x32\x08\x3c\x29\x36\x91\x26\xc0\x9a\x20\x03\xb1\x97\xba\x81\xa9\xd6\x08\xb2\x06\xb2\xaa\xce\x4a\xc4\x2d\xf0\x55\x46\xf5\x54\x96\x1d\x68\xb2\xbd\x2b\xd8\x27\xb5\xbc\x1f\x78\xb0\xe4\xf2\x04\xb6\x92\x5e\xb4\xd2\x1d\x19\x36\xed\x51\x48\xf8\xeb\x9c\x6a\x87\x6f\xee\xce\x67\xf0\x2a\x6f\x75\xa8\xf6\x62\xf4\xb7\x26\x46\xd9\xc7\x02\xaf\xab\xac\x9d\x53\x40\xf8\x00\x52\x55\xc9\xc8\x1d\xa7\xa4\x5e\xeb\x8e\xb9\x5d\xdb\x56\x0c\xdb\xcd\xa0\x07\xa5\x78\xe5\x0c\x03\x64\x53\x38\xf8\x3d\x58\x70\x08\x11\x0d\x04\xae\xaa\x2c\xb3\xd1\x4e\xec\xa9\x31\x43\x29\xaa\x2c\x23\x85\x7d\xcd\xb9\x9c\x7e\x06\x6f\xc0\xcf\x97\xd3\x37\x52\x5f\x59\x4f\x7d\x1f\xdf\x77\x8e\x2e\xe3\x39\xd7\xe7\x57\x1f\xa2\x16\xe8\x23\xcf\xab\x9c\x50\xd7\x6e\x66\x4a\xce\xaf\x3e\x84\xc8\xc7\x16\xc2\xd8\x8d\xe6\x35\xcb\x65\x57\x57\x10\xc4\x80\x72\x20\xb1\xab\x31\xdd\x31\xc1\x94\xfa\x8a\xf2\xac\x2a\xd9\xfb\x79\xc9\xd4\x5c\x66\xd8\x73\x7e\xf8\x9a\x0b\x18\x5d\x22\x85\x62\x49\x05\x2e\x88\xa9\xa5\xa5\xc2\x1d\x5a\x94\x72\xc2\xba\x0b\xdc\xc0\xbb\x20\x14\x4f\x59\xc9\x52\xa0\xc0\x52\x42\xa7\x9a\x95\x64\x4e\xef\x00\x5b\xbd\x4a\x12\x88\x18\x8e\xc9\x59\x51\x64\x56\x7f\xe9\xf3\x92\xb8\xd9\xd9\x11\xb4\xa4\x30\xb8\x97\x68\xd2\x48\xff\x60\x34\xd3\x73\x27\x96\x36\x73\x1d\xae\xfe\x08\x53\x6f\xe4\x07\x77\x29\xb8\xe6\x34\xbb\x60\x59\x17\x58\xdd\x52\xc2\x6a\x95\x4f\x6c\x03\x42\xe5\x1a\xf7\xd9\xb5\x72\x51\x2d\x77\xba\xe7\x54\xd9\x7a\x9c\xce\x82\x8d\x09\x9b\xca\x72\x79\xad\x6c\x1a\x23\x87\xa1\x69\xb3\xe6\xeb\x96\xae\x8b\xe8\x27\x5e\xd4\x2b\x56\x72\x89\xe6\xe2\xbf\xc9\x7b\x22\xa7\x9a\x81\xf6\x57\xb0\xd2\x8c\xa7\xe6\xdc\x26\xc3\xf5\x78\xfc\x7f\x11\x0c\x77\x9d\xcc\x59\x57\x5e\xcb\x12\x90\x09\xfc\xb5\x57\x8b\xef\xe7\x0c\xee\x0f\xc1\x12\x0b\xd0\xd0\xcc\xd1\xff\xdb\xfb\xf7\x57\x5d\x4a\x72\x7b\x19\x63\x16\xab\x83\x28\x72\x19\x23\x64\xe1\x75\x05\x2d\x64\x76\x22\x0b\x95\xa5\xb5\x2c\x0c\xbb\xe4\x96\x5c\x12\x86\x8e\xc4\xb4\xca\xda\x02\xd1\x0a\xc9\xc0\x9c\xdd\x24\x87\x49\xc3\x3e\xcd\xec\x51\xd9\xf6\x3d\xcf\x99\xac\x3a\x60\x78\x70\x22\xd2\x62\x14\x34\xae\x25\xde\x9d\x69\x2f\x2b\xbd\x2c\x00\x50\x2b\xf9\x04\x85\x63\x77\x81\x44\x9f\xae\x68\x21\x93\x2f\xb5\x4b\xa9\x4e\xc9\x4d\xc3\x36\xbe\xe9\x9e\xae\x87\xdf\xd9\xe6\x78\x16\xb2\xc4\x6e\xfe\x7b\x59\xc3\x87\x11\x4a\x52\x3e\xb5\x35\x1d\x40\xc3\x69\xfd\x08\xd1\x52\xcf\x3d\x04\x91\x6e\xbe\x78\xf6\xc5\xb3\x9b\x5e\x5f\x62\xf7\x3e\x98\x51\x74\xa7\x16\x46\xcc\x06\x52\xbc\x83\x70\x91\x9d\x1d\x61\xdb\x33\xaf\xe7\x67\xb6\xb5\xd9\x16\x99\xdc\xcc\xb5\x2e\x6e\xa0\x8c\x01\x44\x7e\x8f\x9e\x75\x63\x09\xdf\xd4\x26\x2f\xd4\x5a\x94\x55\xaf\x75\xd3\xbd\xe3\xa0\x89\xf4\x42\x31\x6e\xca\xef\xba\x6a\xbe\xbe\x6c\xaf\x59\xda\x5d\xe7\x5e\x2c\x69\x53\x35\x0f\x40\x20\xf1\xe6\xf8\x61\x8f\x3d\xc6\xfa\x2b\x19\x4d\xf9\xaf\x57\x7d\x0f\xd3\xfb\x85\xdd\x58\x61\xdc\x4f\x44\xb5\x5f\x5a\xc7\x5f\xaa\x6e\x1f\xa6\xf1\x89\x94\xfb\x01\xec\xf8\x29\x56\xe7\xa9\xa8\xf7\xd8\xe5\xea\x11\x02\xbb\xd3\xef\xeb\x15\xfa\x95\x2a\xf8\xbf\x78\x79\xf9\x84\x54\x7c\xd4\x5a\x3e\x49\x21\xf9\x53\xc5\x54\xac\xd3\xd3\x31\xf6\x03\x38\x3d\xdd\x78\xe2\xdd\x9e\x2b\x43\xda\xa5\xdb\xd3\xb5\x41\xbe\x1a\x68\x52\x54\x22\x0d\x6c\xe6\x48\x59\x65\xbc\x75\x49\x77\x70\x87\x4d\x55\xf0\x11\x58\xaf\x96\x37\xad\x8c\x6d\x6d\x8c\xc6\x0c\x87\x9a\x1a\x1b\x67\x69\xab\x4a\x91\xa6\x53\xdf\x54\xc1\xd2\xe8\x9d\xec\x16\x51\xce\x72\x53\x30\x0f\x11\xe0\x2c\x7d\x26\xfd\x5a\x02\x88\xd8\x66\x62\x2e\x89\x0b\x46\xd3\x8c\x0b\x76\x6d\xa5\x14\x72\x2f\xae\x5b\x05\xd6\xa9\x1f\x16\x17\x5e\xda\xb5\xb2\xfe\x7a\x6e\x51\x0b\xcb\x64\x04\xe1\x49\xdd\x05\xf5\x47\x39\x01\x34\x43\x7b\x1d\x69\x2e\x2a\x59\xa9\x6c\xe1\x46\xdd\xaf\x57\x5a\x7c\xc5\xc6\x4d\x66\xf7\xd8\xdf\x5b\x4b\x36\xe5\x9f\x9e\x75\xe0\x69\x34\x04\xe0\x9f\xfe\xb0\x15\xe3\x47\x84\x93\x0f\xce\x5a\xc1\xe4\x94\x15\x99\x5c\xac\xa4\x42\x52\x05\x7c\xf0\x77\x39\xe9\x58\x11\x50\x9c\x68\x96\x91\x52\x56\xda\x69\xd8\x8c\xdb\x6e\x18\x66\xe9\xe1\x3e\x2f\x65\x4e\xa8\x51\x41\xb9\x4c\x79\x02\x0b\x57\xe5\x9d\x72\xe2\x08\x2c\xf0\x1b\xc3\xc3\x37\x27\xe4\xc6\xec\x5f\x79\x03\x85\xbb\x37\x3f\x55\xb4\xd4\xff\xbe\xb1\xad\xd6\x7d\x2d\xeb\xb1\x05\xe9\x2b\xa8\x52\xdd\x3b\xe8\xbf\xed\x92\xa5\x6e\x52\x40\xf2\xbd\xb1\x2d\xa6\xdd\xeb\xe1\x8f\x8e\xc7\xe4\x07\x01\x9e\x9f\x4e\xed\xd8\xca\x65\x07\x24\x97\x65\xab\x13\x55\x46\xb3\x61\x75\x7e\xae\xfd\x83\x0e\x9a\x66\xa2\x3c\x00\xc7\xb1\x8f\x45\x69\x54\x2d\x68\x59\x6f\x96\x24\xe4\xe7\xfb\x59\x3c\xcf\x6f\x7a\x40\x78\x97\xc9\xdc\x3c\x3b\x7d\x4e\x3e\xb3\xff\xbb\x39\x31\xc2\x2e\x63\xe4\xe6\xf7\x7f\xcc\x6f\xcc\x4a\xde\xfc\xf1\x99\x02\x8a\x42\xea\xe3\xf1\xc1\x56\xb6\xfa\x84\x26\xb7\x72\x3a\x7d\xc5\x73\x8e\xbd\x73\xda\x12\x40\x04\x6d\xa7\x64\xb6\xcb\xbe\x3b\x86\x39\x05\x1c\x91\x1e\x09\x60\x0e\xfb\x86\x93\xf9\x39\xea\x5c\x6e\xa9\x98\xd4\xa9\x7c\xe8\xd3\x09\xc1\x7e\x4a\x14\x2b\x68\x09\x59\xc7\x3e\x87\xc9\xcc\xc7\xa6\xcd\xd5\x54\x3b\xa7\x4f\x35\x94\x1b\x7a\xdd\xbb\x52\x5a\xe6\xfc\xdf\x00\xa8\x4b\x64\x69\xae\x39\xa7\xbd\xe7\xe6\x4f\xee\x65\x79\x8b\x71\x7b\x05\xf6\x03\x67\x17\x9f\xcd\x40\x08\xb2\x8f\x9a\x95\x02\x24\xca\x64\xd1\x00\x8c\x18\x93\x33\x0f\x52\x91\x74\x9d\x4c\x3f\x38\xf0\xa1\x81\x28\xb6\x50\xe6\xb2\xb4\xc8\xca\x35\xd0\x45\x98\xfc\x98\xbc\x1c\x77\xb6\x70\xf4\xb6\x89\x97\x20\xe1\x4d\x8b\xb5\x0b\x02\x66\xe4\x7f\xd7\x1c\x40\xd7\xe1\x0c\x43\x3b\xe2\xfa\x50\x35\xf0\x7e\x2d\x4d\x59\xce\xc6\xb4\xa0\xc9\x9c\x8d\x21\xd7\x6a\x7c\xfb\xc2\xe7\x5c\x81\x3c\xeb\x0c\xa3\x4d\x4a\x5a\x2e\xac\xdc\xb9\x0e\x85\xfa\x8d\x6c\x50\x23\xf5\x92\xaa\x2c\x99\xd0\xd9\xe2\x85\x97\x90\x1d\x24\xd7\xc9\xce\xde\x13\xdd\xa9\x43\x26\x52\xd8\x11\x24\x8b\xb8\xe4\x87\xfa\x58\xcf\xe5\x3d\xd8\x1b\x25\xa3\xba\xa6\xa7\x09\xfb\x08\xe6\x65\x37\x80\x27\xb4\x94\xf8\xbb\x9c\x8c\xc9\xb7\x34\xe3\xa9\x87\x5a\xa2\x25\x7b\x41\x46\xe4\xe0\x2c\xcb\xe4\xfd\xc1\x0b\x0f\x5e\xe2\xae\xb0\x1e\xaf\x5b\x59\x89\xc6\x38\xb2\xc5\xff\x34\x94\xbe\x92\xe5\x84\xa7\x07\x41\x67\x3b\x86\xc2\xd9\x09\x4f\x55\xe3\x6f\xbb\x89\xaa\x13\xa2\x6e\x79\x51\x98\x6b\x50\xb0\x8f\x1a\x3e\xc4\xa7\xd0\x17\x9c\xcb\x4a\xc1\xbf\xe7\x54\x89\xc3\x43\x4d\xa6\x5c\x70\x35\xef\xb4\xae\x16\x4c\xc3\xd0\xde\x59\x43\xec\xe0\x85\x11\xd2\x09\xcb\x54\xcd\x14\x86\x24\x00\x5c\x19\xc1\x67\x76\xdd\x19\x6d\x5d\x4b\xca\x7d\x09\x25\x11\xec\x9e\xc8\xae\xae\xe2\x38\x06\xd9\xe7\x93\xfd\xa6\xf2\xc9\xa6\x34\xcb\xcc\x7a\x23\x67\xf5\x41\xb5\x00\x79\xdc\x95\xc6\xf3\xc2\x62\xb0\x06\x33\x24\x88\xe8\x2e\x99\xc9\x44\x6a\x8b\x80\x8f\xbc\x78\x3b\x26\x5c\x28\xcd\xa8\xc5\xd1\x2f\x17\x80\xf6\x26\x49\x4e\x35\x2b\x39\xcd\xf8\xbf\xfb\x8c\xb8\x25\x2d\xb8\x81\x7d\xe4\xa4\xc9\x76\xab\xe5\xaf\xcf\x08\xaf\x80\xfb\x6e\x7d\xf3\xfa\x8b\xed\x7e\x2e\x33\x7c\xb2\xee\xb4\x51\x65\x56\xeb\xeb\x29\x4b\x32\x5a\xb2\x14\xee\xc4\x85\x85\x3c\x6d\x29\xac\x1d\x24\x97\x06\xc4\x15\xc9\x59\x32\xa7\x82\xab\x5a\xa3\x48\x64\x69\x94\xec\xac\x97\xc9\xba\x5d\x17\xce\x9c\x18\x66\x54\x7a\xf8\x71\x92\xba\xd7\x1b\xf6\x24\x8c\x3d\x58\x2b\xdd\xac\x61\x84\x2a\x9f\x1a\x81\x99\x73\xf0\x7e\xd6\xa5\xb2\x90\x4d\x0d\x4d\xbf\xc4\x82\x94\x8c\x2a\x29\xc6\x84\xbc\x36\x7f\xd6\x25\xd2\x7f\x34\x97\x53\x7d\xf1\xd5\x88\x4d\x0e\xa1\x88\x2a\x1f\x57\x92\xa2\x0b\x39\x74\x57\x96\x64\x8f\x18\x4c\x59\xc1\x44\xca\x44\xb2\xd1\xfa\xef\x77\x2f\x5c\x34\x68\xec\x0b\x40\x36\x3c\xfb\x9b\x26\x7a\xe1\xac\x23\x63\x78\x5e\xff\x85\x7b\x7f\xcf\x93\x1b\x9e\x3d\x4f\xae\x7b\x6e\xb9\xc0\xce\xe8\xcc\x21\x2a\x4a\xc2\x3e\x7a\x7c\x34\x57\xcd\xec\x34\x22\x05\xbe\x24\xcb\xc9\x79\xb7\x7d\x61\xbe\x1b\xfa\x4b\xdc\xd4\xaf\xdc\x9c\x38\xc3\xf6\x47\x39\xb1\xee\x9c\x5b\x01\xee\xd2\x91\xf3\x66\x77\xa9\x53\x36\x12\x6a\x0c\x33\x0f\x92\x5b\x32\xdb\x26\xcf\xde\x93\xde\xba\x40\xd7\x08\x21\xae\xf6\x4a\xb1\xeb\xeb\xb3\x08\xe5\x11\xf8\xae\x1c\x29\x9e\x32\x42\x8b\x22\x83\x0e\x28\x55\x91\xda\x86\x71\x8c\xc8\x7b\xc1\xd2\x7a\xe0\x5d\x8e\xc6\xe0\x8f\x37\x5c\x7b\x73\x3c\x26\x6f\x24\x10\xa1\x50\x52\xd9\xae\xba\x31\x6a\xae\x82\xa3\xd5\x1d\x93\x4c\x32\xce\x84\xb6\xc3\x2b\x5c\x5d\xfb\x89\x51\x1b\xae\xaf\xcf\x7c\x97\x20\x7a\x47\x79\x66\x98\xf8\x84\xb0\xf1\x6c\xdc\x65\xc2\x1b\xe1\x94\xa5\x4d\x35\x34\xc9\x2a\x05\x12\xe1\xa1\x65\xa9\x61\xa7\xed\xa4\x29\x40\xbf\xed\xe5\xe9\xfa\x67\x2f\x4f\xd7\x3d\x45\x29\x67\x25\x53\x6a\x98\xa6\xdf\xac\x1d\x01\x95\x7c\x49\xd1\xb7\x62\x16\x21\x5e\xc1\x64\xbc\x65\x61\x38\xe8\xe8\x4f\x07\xcd\x21\x71\xa1\xad\xfc\xcf\x3d\x6c\xc8\xc4\x1d\x2f\xa5\xd8\xe6\x98\xbf\xac\x49\xec\xcf\xf9\x86\x67\xeb\x73\x1e\xc2\xca\xaf\x99\xa6\x51\x18\x03\x8a\x70\xf1\xa3\x6b\x85\x2c\xa7\xe4\xe6\xcd\xd9\xeb\x97\xd7\x57\x67\xe7\x2f\x9d\x4b\xf8\xea\xed\xc5\xbf\xcc\xcf\xba\x5d\x2c\xf5\x16\xdf\xd1\x92\x5b\xba\xcb\x57\xe7\x56\x67\xfe\x57\x29\xc8\xe6\x5a\x17\x57\xa5\xfc\x88\xf5\x90\x5f\x95\xb2\xa0\x33\x40\x03\x02\xd7\xd7\xdf\xde\xbf\xbf\xfa\xd7\xd5\xbb\xb7\xff\xfb\x1f\x46\xa5\x33\xff\xba\x76\xff\xec\xe9\x8d\x7a\xf3\xe6\xad\xff\xc3\x47\xd8\xbb\x3b\x5a\xc6\x97\xe3\xaf\x1f\x97\x15\xa5\x34\x4d\xfb\x1a\xc3\x2d\x07\xab\x1b\x29\xdd\x46\x4a\xa9\x85\xd0\xf4\xa3\x91\xd3\xdf\xbc\xfc\xc7\x5f\xbe\x3d\x7b\xf5\xe1\x65\xbf\x9e\x75\xf3\xfa\x1f\xff\xfa\xf6\xec\xdd\x5f\x0e\xf2\x85\x0d\x2a\x1c\xdc\x00\x35\xc5\x88\xb6\x17\x01\x4b\x58\xca\x44\xc2\xa0\xe6\xdd\x25\x5a\x59\x47\x7e\x27\xcc\xb1\x6b\x1e\xb4\x7e\xce\x4f\x1c\x4d\x08\x9a\x4f\x8e\xe6\x54\xa4\xd9\x70\xc3\xfa\x25\x74\xb0\xfc\x9b\x25\xb2\xbf\x25\x36\x3c\x7b\x6d\x70\xdd\x53\x76\xf5\x8c\x59\x45\x06\x05\x4e\x73\xec\x6a\xde\xb5\x49\x5b\x01\xeb\x43\x96\x64\x2a\xab\x4e\xe1\xc9\x05\xd8\x94\x3c\x71\x1c\x12\x78\x72\xb8\x89\xdb\xb3\xfd\xb3\x64\xe8\xc1\xfa\xfa\x7c\x7f\x9a\x36\x3c\x5b\x9f\xa6\x94\xab\xc4\xc8\xf9\xc5\x39\x4d\xe6\xd8\x60\xcd\x85\x7f\xc9\x39\x01\x48\x62\x5e\x76\xf7\x9a\x39\x6a\x27\x3e\x3d\xea\xc6\x1d\xb3\xb4\x3b\x7c\x9f\x72\x75\x6b\x9d\x38\x36\x0f\xf4\xa6\x71\x77\xbb\x9f\xf4\xdd\xde\x9d\x17\xc4\xaf\x50\x66\xf4\xb0\x87\x4d\x4d\x1e\x7a\xe2\xfe\xd6\x48\x6c\xde\x9f\xba\x95\x67\x7f\x87\xad\x7b\xb6\x44\x9b\x40\x55\xab\xf9\x6f\x74\xcc\xd2\x17\xb4\x45\x95\xad\x3d\xb8\x8b\x62\x2b\x44\x88\x07\xa8\x1a\x5b\x2d\x74\x36\x76\x44\x5d\x36\xf6\x88\x0b\x12\x55\xf0\xb5\xb1\xde\x6b\xa9\x6a\xfb\x31\xc7\x6f\x3e\x18\x57\xc7\xea\xdb\x01\x38\x1b\x78\x69\x23\xd6\xa0\x93\x75\x6c\x68\x47\x0d\xeb\x6e\xce\x73\x54\xc9\x59\x67\xc5\xd9\x72\x85\x7d\xef\x29\xae\x67\xf4\xb7\xf7\xef\xaf\xb6\x2a\x24\xd8\x1a\x01\x02\x57\x1f\x36\x5c\x40\xf5\x14\x8a\x3d\x22\x43\xc7\x15\x6a\xf5\xd7\x69\xb5\xd9\xbb\xeb\x82\xe2\x39\x53\x50\xb0\xf5\x08\xb3\xdd\xb6\xbc\x1a\x75\x5f\x85\x8f\xfc\x02\x2f\xac\x6d\x0a\x9d\x1f\xe8\xc6\x5a\xaa\xdb\x7b\xf4\x2b\x6b\x58\x91\xf2\xc6\x3b\x6b\xb9\x0c\xf1\x51\x67\xb0\xf5\xad\xb5\xbc\x19\xdb\x5f\x5b\x2e\x3a\xbd\xa5\x27\x65\x48\xa5\x34\xea\xda\xc2\x1f\xe6\x9d\xde\x5b\xdb\x56\x36\xe3\x2e\xae\x2d\x24\xd5\xa7\xbf\xb9\x06\xd6\x18\xf7\x5f\x5d\x4b\x3c\xfe\x34\xee\xae\x1e\x1b\x95\x0b\x88\xe4\x0e\xb5\xfd\x2f\xed\xeb\x9f\x0c\xf5\x95\xa6\x29\xa1\xc2\xcf\x02\xce\x22\x73\x81\x88\x4e\x7f\x66\x1d\x26\xa9\x14\xb4\x61\x30\x47\xaf\x4e\x9c\xf6\x65\x6a\xdb\x49\x97\xbd\x57\xe3\xb7\xe6\xd5\x98\x4b\x85\x86\x2c\xf8\xec\xb3\x77\xae\x4e\xf2\xb3\xcf\xc6\xed\x72\xeb\xd0\x83\xd4\xd5\x48\x77\xe9\x3b\xbe\x4a\x15\x0e\xc0\x36\xa8\x18\x7d\x82\x42\x69\xbe\xe1\x98\x22\xc4\x84\x79\x79\x3b\x21\x91\x65\xf2\x3e\x5e\xf5\xa0\xe4\x08\x2a\x07\x47\xa1\x72\xf0\x38\xc4\x5b\xcf\x2f\x2f\xde\x11\x55\x4d\x04\xeb\x2f\x19\x6c\x77\x3f\x31\xf2\xa3\x4c\x58\xd1\x68\x19\x6b\x67\x58\x94\xf2\xe3\x82\x1c\xdd\x3c\x7f\x36\x86\xff\x9d\x7e\x71\xf2\xfc\xcf\x9f\x8f\x9f\xff\x09\xfe\xf1\xfc\xf3\x93\xe7\xff\x69\xfe\xf5\x85\xfd\xe7\x9f\x70\x50\x69\xdb\x29\x04\x7b\x21\xf4\x5b\x13\x42\x36\xbb\x05\x39\xa7\xaf\x64\x99\x38\x15\x1d\x02\xfc\xb0\x5b\xae\xab\xd9\x8d\xd1\xd9\x12\x5a\x8e\xe1\xec\x8f\xb9\x3c\xb5\xa4\x6f\xba\x2a\x6a\xbe\x0c\x7c\xdb\xe8\x4e\xc4\x15\xa0\xde\x6b\xe9\xd4\x76\x22\x45\x23\xe3\xac\x27\x75\xc3\x1c\x3a\xe8\x92\x24\xc8\x37\x36\x6b\x77\x17\x80\xd9\x3d\x0c\xf6\xa3\xcc\xe4\x2d\xdf\x90\x58\xd4\x2f\xf0\xfe\x6e\x5f\xdf\x4a\xe4\x9d\x9f\x9d\x33\x3c\x28\xc9\x9c\x91\xab\x97\xaf\x09\x13\x89\x4c\x59\x4a\xce\xcf\x48\x62\xc8\x4f\x7d\xd0\x16\xba\x07\x17\x54\xcf\x4f\x80\x81\xbb\xd8\x53\x92\x3b\x56\xf2\x69\x1d\xb0\x0b\x84\x98\x3a\xf1\x91\x60\xc3\xef\x60\xfa\xdc\x14\xa5\xd4\x32\x91\x59\x97\x34\xe3\xca\xc2\x7b\x28\x97\x63\x55\x29\x36\x52\x2a\x1b\xb9\xbc\x60\x5a\xe9\x39\x13\xda\x8d\x15\xb0\x07\x2c\xa3\x74\x90\xac\xcd\xa6\xd3\x3b\x5a\x9e\x96\x95\x38\x55\x2c\x29\x99\x56\xa7\xb5\x54\x30\x4c\xeb\x52\xbc\x69\x02\xf5\x43\xfe\x9f\xa3\x84\x8e\x93\x52\x77\x7d\xc1\x1c\x85\xb7\x05\x13\xd7\x73\x3e\xdd\x56\x0a\xc3\x3c\xaf\x4a\x2e\x12\x5e\xd0\x0d\x0d\x62\xc8\xba\x4d\x2d\xfc\x3b\x47\xea\xd8\xd9\x1a\xb9\xeb\x76\x6e\x3b\x7b\x71\x41\xa8\xa3\xdf\x65\x41\xd7\x7b\x68\x36\x18\xae\x53\x42\xc1\x14\xf3\x16\xab\xe7\x59\xaf\x05\x37\xb7\xba\x83\xf2\x12\x13\x44\x6e\x75\x0f\xcf\x58\x69\x51\x6f\xf5\xd2\x3a\xfe\x25\x11\x7f\xb1\x9d\xd7\x5f\xe4\x54\x69\x56\x8e\xe0\x06\xee\x8e\x1f\x27\xe2\x2f\x73\x7a\xaf\xb9\x1c\x49\x91\x71\xc1\xc6\xf6\x5f\x63\x75\x97\xb8\x21\x27\xe2\x2f\x53\x33\x6c\x63\x06\xc8\x8c\x8d\xcd\x3f\xe0\xd7\x3b\x60\x96\xc7\xea\x86\xb2\xbf\xf7\x31\x89\x0c\x71\x3e\xad\x57\x5c\x69\x26\x60\x62\x50\x35\x9a\x50\xa5\x3d\xac\x95\x5a\x8d\xa8\x6c\x75\x8b\xff\x2a\x55\x13\xe8\x52\x9c\xb2\xf4\x1c\x8e\xf1\xf9\x9c\xa1\x0b\xa4\x5f\x53\x11\x6a\x59\x56\xaf\x25\xef\x20\x54\x18\x51\x38\xcd\xe8\xcc\x43\x53\xf8\x01\x91\x5b\xb6\x20\x95\xa2\x33\x46\x94\x4d\x0d\x5e\xb9\xe8\xba\x44\xca\x23\x49\x3f\xfb\xef\xb8\x6b\x69\x67\x06\xab\xb9\x8c\xfe\x66\x8c\x52\x9a\xa6\xa5\xbb\x36\x6a\xdf\x97\xbf\x3c\xe8\xcc\x6c\x8d\x35\x97\xba\xac\x1b\x2e\x0c\xa3\x8e\xc9\xe5\x94\xdc\x1c\xfc\xf0\xd9\x81\x4d\xe8\x39\x70\x76\xd3\x01\x2c\x24\xdc\x6f\x16\x96\xc4\x96\x37\x75\x99\x69\x86\xa2\x2d\x23\x83\x5c\x23\xc1\x34\x54\x5a\x83\xa9\x36\xa5\x49\xd3\xcd\x7a\xf0\xd9\xc1\x96\x5e\x56\x09\x6b\x12\x9f\xe2\x5b\x77\x2e\x0f\x0b\xe6\x48\x11\xaa\x7c\x52\x6c\xa7\xe3\x0a\x32\xa8\xd5\x8b\xd3\x53\xa7\xa0\x8e\x65\x39\x3b\x2d\x19\xc0\x40\x27\xec\x74\xae\xf3\xec\x14\xf6\x40\x8d\xcd\x7f\xff\x0e\xfe\x7b\xf4\xe3\x5d\x3e\xb2\xe2\xfc\xfb\xbf\x7f\xfb\xba\xe3\x03\x76\xfb\x96\xd4\x59\x3b\xc2\x7f\x7e\xf2\x2b\xad\xa0\x4a\xdd\xcb\x12\x2b\x13\x41\x7b\x72\xaf\x58\xc9\x08\xa5\xe8\xad\x13\x18\xa3\xe3\x40\x02\x7a\xa5\x58\x79\xe3\x96\xc4\x99\x35\x8f\x06\x65\xbe\x46\x47\xb3\x98\x7a\x0d\xb4\xc0\x3f\xff\xf9\x8b\xad\x31\xc9\x9d\x30\x8b\x52\x52\xed\x2b\x2e\x26\x52\x67\xf4\x39\xf0\xf0\xd2\x0b\x45\x94\x3e\xef\xe4\x67\x5b\xce\x6d\xb3\xc8\x50\x4d\xf9\xca\x5e\x3a\x67\xad\xfd\x47\xce\xf1\xbb\x39\x83\xf9\xac\x31\x88\x1a\x7d\x98\x3d\x8f\x75\x9d\xaf\xd6\xd7\x1f\x54\xb4\x1b\x4e\x8d\xd8\x42\xf3\xe7\x8d\xa4\xcc\x35\x67\xe5\xc1\x5c\x8b\x3f\xde\xe5\x83\xed\xec\x6f\x5f\x6f\x97\x78\x98\x51\xa5\x8c\x55\x8c\x15\xe5\x0d\x01\xfe\xed\xeb\xfa\x75\x72\x54\x29\x46\x6e\x5e\x71\x51\x7d\xbc\xa9\x7f\xdc\xc1\x09\xce\x21\x29\xcb\xad\x38\x7b\xaf\xe5\x77\x69\xf9\x6c\x52\xcd\xb0\x1b\xeb\x20\xcc\x14\x29\x59\x2e\x35\xb3\x2f\xcf\xa0\x4c\x59\x49\x87\x64\xe8\x7e\xd8\x89\xd3\xe8\x10\x0a\xa9\xd6\x34\x99\xd7\xad\x34\xff\xfe\xed\x6b\x57\x6e\x43\x2a\xc5\xc5\x0c\x04\xf7\x68\x2a\x4b\xb3\x6e\xfd\xd7\x62\xf7\x51\x87\x61\x9d\x59\xb5\x0c\x7b\xe4\x4b\x2a\x14\x5c\x1e\x5e\x9d\xa3\xda\xab\x73\x12\xf4\x15\x67\xe8\x74\x47\xd2\x04\xbb\xcf\x16\x24\xa3\x95\x80\xc9\x9a\x33\x51\xcb\xb4\xcf\x5e\xfc\xf1\xd9\xb3\x3f\x6e\x97\x8c\x0d\x53\xbb\xae\x54\xc1\xd0\x88\x02\xee\xaf\xad\x53\x53\xd3\x72\xc6\x34\x0c\x8c\xe7\x39\x4b\x39\xd5\x2c\x5b\x34\xd2\x44\x3a\x26\x67\xbb\x9e\x98\xb3\x6c\x6e\xfa\x4c\xd2\xb4\x43\x39\xfb\xcd\xda\x74\x43\x75\x61\xb3\x25\xee\xdd\x4f\xaf\x59\x96\x5c\xe8\x73\x99\xe7\x14\xcd\x65\x57\xe6\x15\xe5\x72\x94\xe0\x45\xb7\x53\x35\xc4\xae\x3d\xf6\x84\xf7\x81\x47\xd6\xd9\x2d\x99\x9c\xed\xb4\x12\xb1\x47\xce\xde\xd2\x9c\x65\x6c\x13\x0c\x67\xff\xed\xfb\x8d\x7b\xff\xb1\xc2\xff\x6d\x90\x5e\x1b\x8c\xb0\x50\xbb\xde\x00\x4a\xc3\x98\xba\x94\x30\x01\xdb\xc4\xcb\x10\xa9\x68\xcf\xec\xc8\x9d\xd3\x66\x0c\x6e\xab\x23\xb2\xbf\xa5\x7f\x6b\x31\x38\x23\xe4\xd0\xd1\xea\xf5\xc8\xb6\x8e\x91\xa1\x5a\x0f\x1c\x1b\xe6\x02\xea\xf4\x0d\x38\x55\xc3\x43\x87\x62\x52\xeb\xb6\x55\xdd\x6f\x59\x8a\x89\x91\x1d\x36\x5b\x26\x44\xf3\xe2\xc3\xd4\x0f\xbd\x37\x1c\x70\xbe\xe1\x5c\xb5\x29\x7e\xd2\x13\xe2\xd1\xb4\xd7\x4f\x6b\x84\x18\x69\xdf\x1e\xda\xa0\xea\xe0\x4b\xc0\xc5\x64\x1f\xe9\x0e\xb0\x1e\xfb\x3a\x0d\xac\xf6\xe5\x83\x83\x2d\xcb\x9c\xc4\xac\x3f\xba\x65\x12\xd7\x9c\x0a\xc1\xb2\x6b\x2e\x6e\xb1\x3a\xce\x2b\x77\x82\xdd\xab\xca\x4a\x2b\x70\xf0\x29\xcd\x45\x58\x39\x5c\xee\xab\x85\xa5\x1c\x83\xe4\x73\x9a\x02\x51\x80\x0c\xea\x3f\xe0\x44\x84\x2c\xc9\xb4\xca\x32\x87\x1d\xfa\xe1\xdd\xe5\x27\x2f\xa4\xaf\x57\xcf\xa2\x4e\x6d\xbb\x7e\x16\xbc\xea\xb7\xb2\x74\x70\xd0\x86\x5d\x8b\x5e\x35\x0a\x87\xd3\x88\xd9\x8c\x69\x86\x94\x6b\xe0\x69\xfe\xfb\xf5\xdb\x37\x2e\x0d\x75\x9f\x81\xb4\xe1\xd9\x6b\x3f\xeb\x27\x65\xbd\xd3\x43\x84\xa6\x7f\xf7\xe1\xa4\x66\xf0\x9d\xaf\x39\xfb\x1d\x14\x9f\x86\x54\x08\x4b\xfb\x49\x25\xea\xa7\x5f\x86\x3b\x36\x90\xbd\xee\x00\x95\x6b\x51\x40\xd2\x9b\xeb\x7e\xd3\xcc\x07\xbf\xe7\x9d\x27\xa7\x28\x65\x5a\x25\x6c\x3d\x63\x35\x68\xaf\xde\x28\x5d\xa1\x0f\x57\x4a\x42\x43\x57\x08\x32\x29\xe5\x2d\x2b\x9f\x40\x1e\x89\x5d\xe9\x41\xdc\xb6\xed\x5a\x4f\x18\x51\xd5\xc4\x10\x9e\x30\x1b\x2d\xc6\x2d\x7a\x07\xc9\x7a\x3b\x36\x2f\x7a\xa3\xad\x2e\xf4\xe9\x39\x70\x3e\x80\x83\x27\xb0\x1b\x53\x9e\x69\x56\xda\xed\x38\x77\x87\x39\x12\xfc\xcc\x92\xe0\x62\x46\xa4\xb0\xab\xa7\xc8\x84\x2a\x40\xd3\xf6\x8d\xea\xd2\x4e\x17\xf7\x41\xc2\x46\xce\x68\x98\x73\xa5\x65\xb9\x38\x18\x93\x6b\x2e\x12\x77\x97\x58\x02\x50\xb0\x37\x61\x4c\x80\x47\xfd\xae\x2f\xae\x2d\xd8\x3d\x2b\xc9\x1d\x2b\x15\x84\xc3\x6b\xc3\xe2\xa4\x31\x62\xae\xfc\x4d\x97\x46\xf4\x92\xee\xc1\x9e\xe7\xe2\xf6\x4b\x2e\x52\x2e\xd0\xb1\x02\x0b\x59\x3b\xb1\x2f\xad\x70\xb5\x96\x86\xa7\xb8\xb8\x25\x77\x9c\x76\xb9\xa1\xea\x74\xd6\x7a\x08\x01\xa2\x75\x4c\xde\xc3\xbd\xdc\xb8\xf1\x7d\x14\xba\xdb\x09\x50\x8f\x03\x5c\xdf\xca\x0e\x66\x96\x31\x18\x93\x6b\xdb\xbd\xea\xe1\xea\x20\x1a\xa2\xdf\xbd\xd4\xc9\x11\xfb\x98\xb0\x42\x63\x5a\xe6\x34\x89\x71\xe5\x00\x6a\x27\x8b\xc6\xb2\xd8\x85\xd8\x2a\x0c\x8a\x33\x7d\x3d\x0a\xf0\xb6\x26\xb0\x4b\x4b\x7e\x2c\x53\x18\xdd\xb4\xcc\x8d\xaf\x63\x43\x7c\x53\xbf\xd0\xbc\x2c\x91\xc2\x46\x3a\x15\x81\xea\x41\xf2\x83\x20\x9f\x91\xcb\xf6\x9e\x41\x24\xab\x67\x9b\xfd\xda\x14\xa5\x84\x14\xe4\xcf\xcc\xf1\xe9\xeb\x8d\xd6\x79\x6a\xa0\x54\xcb\x4a\xac\xd0\xbb\xac\xd9\xeb\x6c\x53\x47\xb3\xae\x63\xb3\xb6\xd7\xd9\x76\x5d\xbe\xcc\x46\xaa\x84\x66\x5c\xcc\x5e\x33\x5d\xf2\x0d\x88\x56\x64\x65\x57\x97\x2a\x67\xfd\x02\x36\xe8\x91\x1c\x08\x06\x6d\xbd\x4b\xb5\x80\x25\xd0\x12\x32\xe8\x6f\x1a\x8d\x8a\x6e\xbc\xd0\x2f\xc9\x4d\x52\x54\xfe\x9f\x8d\xaf\x74\x1c\x3c\x62\xd8\xe1\x1d\x9b\xda\x94\x81\xe6\x28\x53\x99\x54\x75\xc7\x12\xc8\x94\x04\x84\x58\x61\x0d\x49\x2e\xc5\x76\xad\x96\x1a\xe3\x7b\x0f\x12\x08\xbb\xaa\xd7\xcc\x45\x6b\x20\xf5\xd9\xf2\x8e\x5f\x0b\x92\xb1\x3b\x96\x99\xa5\x38\xbf\xfa\x40\x0a\x56\x26\x66\x06\xb3\xae\x03\x73\x64\xbb\x3e\xb8\x6b\x14\xe8\xae\xec\xcf\x71\xdd\xa9\xeb\x4a\xa6\xe3\xb0\x66\x5d\x27\x66\xe7\xab\xd9\xd3\x8a\x2d\xa3\xe8\x90\x31\x86\x31\x6d\xb4\x34\x9a\x2f\xe7\x05\x1d\x37\xc8\x8c\x9d\x5c\x1e\xa7\xec\xce\xc1\xb5\x77\xfc\x41\x97\xb0\x68\x72\x33\x9e\x67\x3b\x28\xee\x96\x9b\xf7\xae\x91\xdf\x9a\x6b\x24\xa7\x1f\xaf\x13\x8a\xee\x74\x74\x70\x26\x48\x55\x14\xac\x24\x13\x59\x89\x34\x14\xe0\xd7\x1d\x20\xaf\x64\xea\xac\xab\xde\x76\x97\xbe\x51\x01\xb7\x8c\x92\x65\x2c\x5b\x87\xc3\x30\x0e\x07\x63\x4e\xbb\x5b\xa4\x81\xd6\x46\x12\x5a\xb8\xda\x31\x18\x86\x15\x8c\xca\x8b\x45\x2e\x94\xa6\x59\xe6\x28\x3f\x41\x11\x98\x73\x11\xb5\x23\xcd\x96\xd4\xeb\xb6\xa1\xce\x07\x74\xeb\xdd\x25\x9f\x34\xb4\x46\x0a\x7d\x92\x56\x76\x02\x94\xf5\xcf\x3e\xfb\x37\x2b\xe5\x67\x9f\x35\xb4\xf5\xae\x02\x97\x9c\x51\xd7\x8f\x62\x8d\xc9\xcd\x15\x31\x02\x91\xa5\x24\x35\x7b\xa7\x25\x31\xb4\xfb\xf4\x75\x21\x75\x23\x2b\xb0\xae\x54\x4e\x1b\x4d\xb9\xcd\x24\x56\x64\x6c\x07\xcd\x47\xdb\xe0\x52\x66\x99\xac\xf4\x45\x9c\x98\xf5\xe6\xb2\x96\x64\x56\xd2\xb4\x02\x15\x5b\xcd\xf9\x14\x30\xdd\xa7\x53\x9e\x04\x80\x2a\xaa\x99\xea\xb2\x9f\xde\xb1\x3b\xae\x40\x15\x17\xa9\xb9\xef\x1c\x22\x8a\x1d\x56\x68\xe7\xec\x36\xbb\x61\xdf\x76\x90\xf4\x89\x0b\x86\xa2\x2f\x07\x6b\x75\xb3\xa5\xe4\x6b\x99\x51\x31\xb3\xdd\x2e\xc7\x7e\xf2\x5d\x97\xa5\xbd\xa9\x48\xc9\x5c\xfb\x4e\x97\x20\x5d\x1a\xc1\x63\x85\x2a\x75\xd0\x17\x80\xfb\x0c\x53\x7a\xb8\x66\xdd\x99\x84\xec\xbb\xa1\x26\xd9\x2b\xfb\xfa\x96\xa8\x94\x99\xc4\xa6\xd0\x9e\x9b\xbf\x75\x7d\xf4\xcc\xd8\x89\xac\x74\x51\x6d\xe6\x8a\x7d\xda\xc8\x5e\x3b\x58\x7d\x1c\xe8\x0b\xd2\xcd\x2a\x67\x8a\xe4\x4c\x41\xd5\x92\x7d\x73\x1b\x5d\xf4\x47\x85\x66\xb2\xb7\xc0\xdb\x9e\xd5\x95\x0f\x16\x6e\x35\x75\xf3\xf9\xab\x92\x69\xbd\x80\xcc\xba\xb8\x6c\x80\x83\x02\xde\xb4\x79\x7c\x5c\xcc\x0e\x7c\xaf\x4c\x88\x61\x9a\x31\x6e\x35\x36\x30\x0f\xd1\x19\xe2\x3f\x1a\x79\xec\xd6\x06\x64\x90\xb5\x2e\x8f\x9a\x9d\x4e\x2e\xdf\x7c\xf5\x76\x8b\xbc\xd8\x9e\x23\x66\x6b\x61\xf7\x79\x39\xbf\xec\xbc\x1c\xd0\xac\x86\xde\x7f\xaf\x41\x2d\xdb\x1e\x93\x19\xeb\x1c\x38\xac\x13\x7b\x97\x2e\x1d\x69\x8f\x24\x74\x01\x83\xdf\xe4\xb4\x70\x95\xfa\x5d\x0e\xa6\xd5\x59\x81\xb3\xf0\x63\xc1\x12\x1d\x3a\x0c\x7d\x78\xff\xd5\xe8\x8b\x46\x3b\xb6\x4e\x7b\x05\x5a\xb9\x1a\x22\x45\x29\x13\xab\x28\x4d\xa0\x37\x34\xa8\xdf\x36\x32\x74\x2e\x85\x66\x1f\xad\x5e\xa5\x4b\xde\xd7\x73\x63\xc2\x0c\x6f\x3a\x95\xcb\x5f\x01\x00\xb5\xa0\xa0\x4f\x86\xfd\x1e\xcd\x94\x24\x39\x4d\x59\xdd\xe2\xac\x83\xa6\xb3\x9b\xea\xd2\x92\xd0\x98\xde\x5c\x5c\xd4\x36\x0a\xe6\xa5\xab\x56\xb5\xbe\xcd\x6c\xd1\xe7\x36\x35\x24\xdf\xc9\x4a\xb3\x31\xb9\x86\x4e\x20\x2f\xc8\xf7\x61\x3b\x7e\xb6\xdb\xf1\xcf\x17\x82\xe6\xec\xfb\xd3\x5b\xb6\xf8\xe7\x89\x31\x09\xca\xce\xd4\x7f\x9a\xb3\x5a\x59\x54\xad\xc6\x78\xf6\x97\x66\x11\x6f\xd9\xc2\x65\x79\x83\x02\x5d\xff\x7d\xcf\x58\x03\x25\x43\xc0\xb5\x93\x82\x30\x10\x4b\x37\x6b\x1e\xfb\xda\xfa\xbd\x62\xf6\x50\x2d\x37\x54\x54\x08\xbc\x21\x0e\x6b\xe9\x74\x04\xa2\xc5\xec\x1c\x17\xb4\x5c\x40\x24\x9b\x89\x8e\xdc\x72\xd2\x2d\x3d\x41\x46\x36\x84\x5f\x43\x3e\x76\xfb\x57\x26\xcc\x75\x64\x76\x67\xcc\xac\xc0\xc6\xd1\xd9\xcf\x34\x92\x70\xba\x86\x6b\xe4\x95\x0b\x59\xba\xb8\x0e\x0d\xb6\x6a\x26\x5d\xd5\xa3\x6b\x08\x0d\x7f\xec\x62\xef\x18\x8c\xb3\x4a\xb1\x12\x29\xbd\xbe\xff\xff\x1b\xe2\xff\xec\xf2\x92\x80\x78\xeb\x17\x62\x27\x4b\x12\xac\x83\xe2\x26\x32\xab\x12\x0c\x24\x23\x4c\x1f\x2d\x0f\x9b\x49\x50\xe6\xcd\x4f\x2f\x04\xef\x64\x56\xe5\x43\x0e\xc4\x15\x2b\x15\xd4\x97\x69\xf2\x2d\xd0\x20\xe7\x19\xe5\xb9\x6f\x75\x95\xdb\x2e\xdf\x5d\xba\x41\xe0\x81\xe2\x2e\x31\x9b\xf4\xe2\x34\x94\xd0\x9c\xc2\xc6\x7f\xea\xd5\xe9\x91\xb4\xb2\x60\x82\x16\x7c\xa8\x6e\xf7\xb6\x60\xe2\xec\xea\x72\x07\xda\x5d\x4e\x8b\x98\x26\x92\xf5\x4b\x10\x14\xf6\xc9\x0f\xe6\x0c\x7b\x83\xcb\x8d\xed\x93\x73\xe7\xfe\x8a\xfe\x6d\x5d\xd1\x7d\x27\xee\x5e\x0c\xef\xd5\xf6\xd6\xbc\xbc\xef\x6f\xb3\xe1\xd9\x73\xe3\xda\xbf\x81\xe4\x84\x33\x21\xa4\xf5\xa3\xc7\x88\x59\x00\x3b\x9c\x12\x5a\xbf\xec\xae\x46\x5d\x52\xa1\xa6\xac\x2c\x3b\xd4\xa5\xc7\x12\xb0\x76\x82\xaf\x00\x29\x32\x7e\x6e\x0e\x61\xf2\xa9\x4d\xab\x87\xe1\x8b\x74\x32\x54\x86\x5c\x5d\x7c\xb9\x97\x20\x1b\x9e\xbd\x04\x59\xf7\xe4\xf4\xe3\x07\x11\x1c\x46\x11\x47\xac\x0e\x4a\x17\xb2\xd1\xe1\xbb\x99\xbb\x87\xcb\x17\xa8\xea\xcf\x3b\xac\x7b\x2a\x08\xbb\xe3\x89\x0f\x57\x2e\x9b\x7a\x82\xd0\x89\x92\x59\xa5\x3b\xfd\x46\x6e\x78\x90\xb1\x17\x52\xad\x1a\x55\xef\xcf\x6f\x08\x9f\x92\x9b\x9c\x8b\x51\xf8\xfe\x0d\xe1\x9d\x15\xdd\x99\x92\x1e\x27\xf6\x78\x4c\xde\x8a\x6c\x41\xa4\x80\xba\x86\x9b\x9c\x7e\x1c\x35\x66\xe2\x70\xd9\xda\xd4\x3b\x48\xbb\x29\x06\x3b\x75\xab\x52\xa8\x9c\x8b\xb3\x07\xdc\xd1\xbc\xea\x8c\x43\x2b\xcd\xb3\x0c\x8c\xff\xc8\x6d\xed\x5a\x79\xb7\xe1\x1b\xb6\x75\x79\x2f\xb0\xab\x6e\xf7\x68\x79\xe7\x76\xb8\x17\x7d\x92\x3e\xa3\x7a\x2a\xcb\xc1\xb8\x44\x57\xee\xfd\x4f\xd6\x18\x21\x65\x9a\x25\xae\x80\x01\x80\x0e\xeb\x46\xcb\x1d\xcb\xce\xa7\x0d\x7f\x8d\x5f\x03\xbf\xec\x49\xc9\xa0\x40\xfd\x88\x6b\xa8\xdc\x68\xfc\xac\xdb\x9d\x1d\x10\xad\x88\x14\xd9\x62\x4b\x58\xab\xfd\x45\xd8\xd5\x14\x1f\xb6\xe3\xc2\x6e\x20\x9e\x75\xec\x6b\x8d\xad\x3f\x62\x79\xa1\x17\xc7\x35\x0b\x20\x4a\x0f\xc2\xdf\x72\x45\x72\xae\x14\x17\xb3\x6d\xab\x08\x7f\x85\xb7\xfa\x2c\x93\x13\x34\xfe\xf3\xa5\x48\x1d\xc8\x1c\xb7\xde\x95\xb0\xc6\x75\x92\x99\x3f\x95\x96\x70\xa7\x83\xd2\x61\x9e\x26\x54\x81\x34\xb6\x6f\x10\x33\x2b\xaa\x65\xa3\xb5\x92\x61\xb0\x87\xac\xba\x28\x36\x35\xc3\x42\xc8\x55\x99\xee\xd5\xe8\x0d\xcf\x5e\x8d\x8e\x5e\xb8\xa2\x94\x39\xd3\x73\x56\x0d\x86\x40\xba\x0a\x14\xf6\x7c\xb9\xe1\xd9\xf3\xe5\xba\xa7\x90\xe9\x6b\x29\xb8\x46\x67\x18\x7a\x0c\x52\x4a\x6e\xae\xc2\xbb\x37\x75\x98\xc9\x0c\xd0\x6b\x68\xfd\x35\x7d\x3b\x69\x9f\x57\xcf\x21\xda\x0f\xb4\x7e\x0e\xd6\x2d\x14\xd7\x78\x40\xa6\xa3\xdc\x13\x0a\xa0\xd9\x9f\xba\x52\xb7\x4f\xee\x54\x59\x36\xb2\x61\xcb\xc1\x82\xa7\xca\x32\x72\x0d\x24\x3e\x0d\xfc\x5a\xd2\xae\x06\x0a\xaa\x49\xc9\x66\x5c\xe9\xb2\x4b\x11\xb1\x33\xf7\x99\x2e\x85\x4c\x8d\x7e\xc3\x35\x94\x81\x4e\x6d\x34\xfa\xa6\xdd\xc3\x23\x95\xc9\x2d\x2b\xed\x27\x7f\x54\x52\x74\x6c\xf0\xde\x88\xd8\x8b\xdb\xd5\x87\xe7\x74\xc6\xcc\x99\x61\xe5\x05\xcb\xd8\x2c\x12\xfd\x59\x38\xd4\x56\xba\xa2\x35\xdf\x73\x3d\x27\xd4\x8e\x3b\x0d\xa7\xa0\x2b\xf3\x00\x26\xc7\x5c\x79\x41\x1a\x06\xe3\x23\xa8\x37\xae\xa1\x09\x8c\x78\x54\xc0\x90\x6f\x48\x92\x55\x4a\x77\xd6\xcd\x94\xd2\xac\xbb\x3d\x52\x61\x74\x00\x71\x52\xd0\x84\xf9\x62\x85\x66\x2d\x88\x2b\xfa\xed\x72\xc3\xd8\x9e\x39\x5b\x16\xd8\xc3\x69\x7f\x43\xd1\xfd\x55\x01\x51\xdc\x88\x37\x27\x27\x20\x49\xc3\x95\x0a\xba\x09\x42\x3d\xe5\xe5\x94\x64\x6c\xda\x75\xcb\x81\x19\x6b\x17\x9c\xab\x25\x0f\x89\xa6\xb7\x4c\xd4\x0e\x92\x9b\x86\x37\xcd\x7b\x6f\xba\x3c\x54\x5e\xcc\xb5\x25\xc1\xc3\x79\xa4\x7e\xaa\x68\x79\x3b\x5c\x4f\xfd\x5f\xf6\xf5\xbd\x92\xba\xe1\xd9\x4b\xcd\x75\x4f\x41\x93\x5b\x3a\x63\xef\x17\x05\x3a\xd1\xc7\x70\x9f\x67\x36\xf7\xba\x45\x67\xa9\x9b\x02\x4c\xa9\xfa\xff\xd8\x3b\xba\x1e\xb7\x6d\xe4\xfb\xfd\x0a\x62\xfb\x90\x0d\xe0\x0f\x20\x57\x14\x87\x05\xee\xe1\xd0\xb4\xc5\x22\xb9\x76\x11\x6f\xfa\xb2\x59\xc0\x5c\x69\x6c\xf1\x56\x26\x05\x92\x5a\xc7\x2d\xfa\xdf\x0f\x1c\x92\xfa\xb0\x45\x4a\xd6\x7e\x24\x29\xec\x97\x36\x89\x44\xcd\x90\xf3\x3d\xc3\x19\x3d\xfd\x1f\x95\x31\xf6\x12\x92\x2c\xed\x05\xb1\x65\x6b\xb0\x8e\x7b\xf3\xf5\x8c\x5c\x72\x1b\xc9\xb8\x13\x3a\x1b\xb6\x26\x06\x95\xfd\xa2\x66\xc3\xaa\x40\xf2\x84\xe8\xad\x68\x89\x80\x77\x4c\xd7\x76\x69\x34\xf5\x20\xab\x18\xcc\xc4\x2a\x03\x94\x26\xfe\x33\xf7\x4c\xfb\x09\xc7\x86\xc0\x21\x05\x9e\x00\x11\x0f\xf1\x2b\x90\x66\x85\x0a\x23\x1b\x3d\x37\xaf\x49\xa0\xe9\xce\xd6\xe3\xd9\x8a\x60\xc6\x57\x79\x69\x56\x54\x3d\xc1\x39\x83\x49\x5e\x36\xd5\x8c\xef\x12\x6a\x00\xec\xbc\x7a\x68\xbe\x72\x1f\x90\x15\xf6\x97\x08\x29\x41\x15\xc2\xb5\x41\xb1\x0a\x66\xc5\xa4\xd2\xad\x93\xaf\x82\xc5\x54\x29\xb6\x8e\x0f\x38\xe9\xd0\x52\xcc\x9d\x33\x17\x04\x3e\x33\x85\xd5\x90\x06\x68\x9b\xf1\xa0\x3a\xc9\xa2\xe7\xb3\xbf\x1c\xae\xf1\x98\x0e\x56\x2d\x6a\xef\xa2\xf4\x58\xe5\xda\x4b\x38\x17\x5e\x33\x8d\x55\x15\x1f\xbc\x66\x3b\xe9\x8a\xee\xdf\x49\x57\x1c\xbd\x71\xd8\xef\x65\x34\x41\x9a\x97\x4f\xd4\x18\xf8\x9d\xa8\xb1\xeb\x77\xcc\x60\xb3\xd8\xe8\x6d\x5f\x7c\x8e\x04\xfc\xa8\xdc\xbf\xce\x95\x9d\xe8\xea\x26\x07\x1d\xd3\x6f\xe0\xfa\xfd\xa2\x3d\xd1\x15\x7c\x79\xbe\x6a\x5d\xb9\xef\xd1\x83\x75\x22\x16\xd1\xe9\xb8\x7a\xdf\x18\x50\xf6\x64\x4d\x4e\xf6\x11\x5f\x44\xe2\x5e\xdd\xe8\x37\xfd\x40\x7f\xa9\xa8\x6a\xf9\xef\x6d\x86\xeb\xf7\x8b\x08\xfa\xed\xdd\xb3\xd6\x8d\xc5\xcf\x78\x86\x9f\xce\xec\x27\xa6\xd5\x15\x02\xfc\xbf\xdb\x4f\x67\x71\x47\xde\x0f\x4d\xde\x2b\xfd\x6f\xc0\x3b\x71\xa9\x3b\x89\x01\x5a\xe1\x8a\x21\x22\x8b\x1a\xdc\x5c\xa2\xce\x2d\xd3\x48\xb7\x1b\x03\x70\x42\x84\x59\x6f\xcb\x14\x90\x9d\x28\x31\x3b\xaf\x20\x66\xa7\x51\x5c\x14\x77\xaf\x6e\x33\xef\xe2\x16\x9f\xce\xe6\x9f\xce\x0e\x5b\xe3\x54\x94\x12\x0d\x38\xbc\x2c\x0d\x8d\x67\x9d\x5e\xbe\xe9\xb1\x9e\xbf\x1c\xdf\x7c\x61\xae\x19\xc7\x32\x3d\xbb\x39\x8e\x65\x0c\xf8\x91\x45\xc7\xb0\x8c\x65\x8c\xc8\xa2\xe3\x58\xc6\x12\x49\xcc\x83\x78\x29\xf2\x79\x5b\x5f\x1a\x1a\xad\x7a\x9a\x17\x8f\xf6\x54\x50\x21\xc5\x03\x4b\x7b\x7d\x4f\xcf\x71\x95\xe7\x49\x5b\xab\xb8\xbf\x5d\x31\x83\x7d\xe3\x6b\xd1\xee\x73\xcd\x98\xac\x04\xe0\x89\xdc\x15\x9a\x68\x90\x1b\x0f\x2b\x9a\x14\xd8\x71\xb0\xae\x95\x70\x00\xf7\x74\x22\xad\x2e\xdb\x66\xf4\xc1\x29\x7e\x89\x1d\x93\x89\x73\x95\x33\xa0\xb9\xce\x48\x92\x41\x72\xef\xfb\x1c\xc5\xb3\x1d\xc6\xb2\x48\x04\xe7\xe0\x6b\xec\x56\x1e\x3e\xc8\x53\x43\xe5\x58\xbb\xd8\x88\x46\x78\x8b\x23\xda\x8a\x8c\xee\x3c\x46\x55\x2f\xa6\xbd\xd3\xc2\x7b\x66\x20\x31\x2f\x63\xac\x1a\x43\xd0\x71\xce\x64\xa9\x7d\xd9\x6e\x2d\xb2\x54\x26\xa4\xae\x1a\x44\x22\x4b\x9c\xbb\x3f\xcd\xaa\xf0\xf2\x4c\x3d\x24\xaf\xe3\xd7\xed\x58\x92\xd9\xde\x7b\xca\xd0\xb8\xa4\x4a\xcb\x32\xd1\x66\x67\xd6\xc0\xc1\x72\x58\x6b\xb0\xa2\xde\xab\x69\x8b\x01\x8e\xf3\xea\x7b\x79\xf2\xa8\x4e\x6f\x2f\xcc\x93\xcf\x24\xdf\x87\xdd\xdb\x3c\x59\x47\xdf\xba\x75\x74\xc9\xad\x98\xf9\x29\x5d\xc3\x75\x2d\x06\xaf\x44\xce\x92\x40\x24\x88\x1c\x52\x55\xd3\x13\xca\xc4\xd6\xe0\x9b\x02\xcd\xed\x76\x30\xf7\x09\xdf\xff\x2a\x76\xf8\xb6\xf1\x2a\x36\x4a\x5e\x4e\xc8\xf2\xad\xf5\x0d\x6d\x2f\xc7\x0f\xe0\x1a\xb2\xfa\x85\x8e\x69\x0b\xf7\xc5\x4c\xb1\x77\x30\x7c\x1b\x3b\xcc\x4e\x43\x63\xdf\x94\xcb\xf6\x0e\x76\x5f\xda\xe4\x34\xaf\x9f\x64\xd1\xb7\x27\x8b\x1a\xf2\xe7\x58\x96\x69\x59\x70\xbb\x02\x26\x24\x67\xf7\x40\x96\x90\xae\xc1\x08\x92\x82\x2a\xa5\x33\x29\xca\x75\xd6\x97\x3d\xaa\xcc\xc2\xe5\x91\x76\x41\x4f\x14\xef\xe9\x37\xad\x27\xcc\xf7\xc8\xe6\xe4\x2f\xdc\x94\xbc\xf7\x26\x42\x22\x8c\xbd\xba\x22\xd4\x43\x16\x73\xc0\x00\x52\x7f\x4b\xd0\x25\xd9\x4e\x85\x3f\xa1\x87\x4e\x81\xe0\xae\x1f\x17\x29\x5c\x0d\x1f\xb2\xef\x1a\xca\x55\x4c\x23\x5c\x67\x4d\xe1\x9a\x3c\xfd\xea\x96\x1b\x54\x63\xb9\xa2\xb9\x7a\x64\x91\xe5\x30\xd9\x30\xbd\x8b\x0d\xb0\x18\x2e\x23\xfc\x10\x8a\x53\xae\xa5\xfb\x77\x62\xb1\xae\x9f\x23\xc2\x63\xc7\x03\x39\xa2\x53\xde\x88\xc2\x28\xc5\xcd\x0d\x2d\xd8\x5a\x8a\xb2\x98\xdf\xba\x61\x30\x17\xb7\xf7\x8c\xa7\x17\x37\x55\xb0\x61\x7e\x1b\x8d\x63\x7c\x15\x15\xbe\x66\xf5\xcd\x1f\x43\x5a\xbc\x9c\x7a\x30\x7e\xc5\x3d\x18\xb5\xc8\x21\xb6\x73\xfd\xa2\xf5\xba\x5a\xe1\x24\x55\x03\xbf\x93\x54\xed\x7c\x86\x32\x1e\x1a\xcd\x7e\x80\x93\x21\x3d\xdf\x5f\xcb\xbe\x88\xae\x8e\xa5\x3d\xe3\xda\x36\x44\xec\xf2\x1d\xec\x6e\xfe\xfd\xbb\x71\x87\x6f\x2f\x7e\x5a\xad\x20\xd1\x37\x17\x0b\x6c\xec\xad\x6e\xc3\x5e\xd5\x57\x21\x56\xb5\xa4\xc9\x30\x3b\xe7\x24\x56\xbf\x5a\xb1\x1a\xfc\xc7\xc0\x3f\x28\x4d\xf5\x7e\xe5\x73\x6b\x5f\x31\x04\x83\x0f\xf9\x6c\xd2\x65\x60\x9a\x65\xf8\x5c\x13\x5a\x50\x24\x89\xce\x33\x6f\x7d\x6e\x05\x54\xe3\xbd\x17\x81\x73\x02\xab\x1a\x91\xd0\x47\x49\x8c\x7b\xa2\x5c\x13\xe6\x96\x7a\x3e\x57\x0f\xb0\xb4\x92\x0b\x6e\xd6\x5f\x46\x8b\x02\x38\xa4\x5d\x6d\x24\x86\x83\xbd\x77\x4f\xb9\x5a\xe1\x47\x0f\x97\x7b\xe2\xce\x5d\x0f\x32\xc7\x83\xb9\x3e\x5a\x55\xd5\x76\x12\x0e\xd5\xc4\xa6\x07\x29\x73\xbd\x83\xbb\xa4\x68\x1f\x7b\x62\x11\xea\xb5\x2c\x75\xb6\xbb\x66\xe1\x7b\x07\x2d\x24\x7e\xc6\xc2\x55\xec\x05\x6c\xc3\x14\x1e\x11\x47\x59\xd8\xa5\x08\xff\x26\xde\x15\xf8\x5a\x96\xc1\x42\x21\xd7\xdf\x9d\xa4\x54\xc3\xd4\x7c\x2a\x24\xfb\xfb\x44\x69\x4e\x0d\x7e\x1e\xa0\xc1\x38\xbe\xa7\xdd\x28\xb6\x70\xc3\x48\x8d\xe0\x9e\xa5\xc2\x92\x48\x10\xca\x31\xc2\xf9\x22\xe8\x7e\x2c\xcc\x32\x83\x51\x45\x8d\xd8\x40\x17\xd5\xb3\xc7\x77\x4b\x15\x29\x71\xbd\x60\x3c\xe9\xc9\x60\x77\x1d\xfa\x07\x01\xfd\x1f\x92\x95\x1b\xca\xa7\x12\x68\x8a\xa6\x88\x6f\xef\xcf\x6c\x27\x00\xe3\x9b\xa7\xa0\x29\xcb\x15\xa1\x77\x22\x36\x5f\x22\x83\xc6\xa9\x86\x90\xec\x05\x5e\x02\x8d\x8c\x05\x38\xd8\x70\xfb\x78\x25\x59\xaa\x0d\x7f\xa5\xdc\x59\x3c\x1e\xa2\x2e\x65\x10\x80\x68\xd1\x52\x09\x15\x30\x13\xdf\x8e\xc6\x70\xea\x84\xfc\x4c\x73\x05\x13\xf2\xd1\x2a\xc7\xd1\x70\xe1\x03\x83\xf6\x69\x57\xec\x0f\x5c\xae\x61\x1b\xf9\xf9\x98\xc2\x9e\x86\xf9\x78\x8a\xeb\x06\x95\x52\x54\x7d\x07\x94\x52\xcc\x26\x0a\xdb\x67\x34\xd0\x3c\xde\x4f\xe6\xc5\x3f\x1d\x5a\x6a\xc3\xb4\x53\xcb\xe0\x5a\x14\x90\x34\x93\x40\xd4\x56\x1f\xb0\x64\x80\x51\xd3\x82\x66\x84\x56\xf2\x8a\x2f\x92\x4a\x0c\x6f\x11\x17\x24\x17\x7c\x0d\x12\x1d\x97\x90\xc7\x35\x80\xa1\x2d\x0c\x38\x1f\xe0\x4a\x44\x26\x6d\xbc\x18\x28\xd7\x43\x39\xe7\x39\x80\x18\xcc\xb6\xaf\xf6\x32\x87\xda\xb1\x71\x8b\x6c\x26\x84\xc1\x45\xe5\xff\x85\x33\x92\x55\x23\xdd\x89\x4b\x1d\x4e\xc8\x6c\x16\x74\xa3\x7b\x91\xc0\xd4\xe6\x20\x2c\xea\x44\xa8\xd1\xde\x78\x1f\xc7\xe7\xc4\xda\x9c\x77\xae\xb0\xc7\x72\x10\x83\x0d\xdd\x91\x07\x2a\x77\xe4\x60\x0e\xe6\xd2\x9c\xe7\x32\xd4\xd4\xfc\x51\x82\x2c\x20\xad\xa6\x16\xa5\xa7\x93\x63\x16\x27\xe0\x49\xbf\x2f\x50\x4b\xae\xe6\x4b\x98\xb8\xaa\x1d\x03\xd7\xce\xe0\x45\x1c\x83\x94\xad\xa1\xab\xf4\xfd\x80\x0c\xec\x83\x24\xa1\x79\x52\xe6\x98\x68\xb6\x6a\x9b\xa9\xa8\x47\x10\x01\xab\xaa\xe3\xfa\x10\xee\x96\x3f\x44\x07\xd4\x2d\xed\xeb\xca\xb0\x2e\xd8\xc6\x2a\x84\x57\x1e\xbe\xb6\x32\xc0\xfe\x85\x5a\xd3\x24\x83\xb4\x6e\x49\x81\x85\x6b\x9d\xc4\xec\x53\xfd\x1b\xaa\x41\x32\x9a\xb3\x3f\xfc\xe8\x35\x2c\x3b\x74\xec\xe0\x87\x2e\x2e\xaf\x44\xba\x24\xd7\xbf\xbd\xfd\xed\x82\x6c\x7d\x51\x62\x20\x8c\xe1\x36\x85\x30\x4e\x56\x25\x96\xc8\x49\xc8\x81\x2a\x1b\x2b\x5f\xd1\x07\x51\x62\x37\x40\x6c\x95\x3e\xf1\xed\xc4\x89\x90\x24\xa1\x1b\xe8\x8e\x59\xd9\x68\x55\x97\x88\xe9\x53\x5b\x89\xd8\xe0\x60\xb9\x48\x9c\xa3\xb5\xbd\xac\xb2\xb7\xb0\xc7\xbb\x8d\x8b\xf9\xd1\x74\xe7\x77\x54\xc1\x0f\xdf\x13\x97\x9b\x87\x34\x2e\x28\x62\x21\x32\xf7\x81\xc1\x72\xcf\x1d\x28\xe6\x9f\xcf\x8b\xdc\x38\x98\x1a\x3e\x07\xc7\x2f\xf4\x4a\x5d\xf7\xfd\xa1\xda\xbc\x92\xb1\x1b\x5a\x60\x99\x47\xab\x85\xba\x87\x0d\x97\x7c\x24\x44\x1f\x60\x75\x3c\x44\x75\x99\xce\xb3\xc1\x35\x58\xd1\x37\xc9\xc7\xcd\xa6\x60\x85\xbf\xb6\xdf\x9a\x52\x31\xfa\xf0\x90\x75\xae\xa8\xce\x06\x03\x64\x07\x5d\xe2\xd4\x06\xd1\x2e\xb4\x45\xd6\x1e\x0b\x08\x8f\xf4\x45\x38\x80\x01\x2b\x83\x9c\x3f\xd3\x67\x8d\x0e\xfa\x7a\x71\xcc\x0e\x20\xee\x76\x6e\x86\xad\xd8\xce\xb1\x54\x4a\x69\x11\xee\xdc\xdc\x6f\x05\xd2\xed\x8f\x8f\x61\xe4\x38\x1d\x78\x2f\xfe\x6e\x17\xac\xc8\x7f\x3a\x13\x51\xe3\x8d\x69\x9e\xa2\x35\x40\x35\xc5\xec\x02\x0e\x64\x19\xf7\xe9\x91\xd6\x4b\xa5\x34\x17\x83\x94\x70\xad\x77\x87\x69\xdd\x91\x4a\x77\x51\x6b\xdc\x14\x56\x8c\xbb\xb8\x60\xdb\xea\x34\xdf\x43\xe7\xdc\x0e\x81\xed\x26\x18\x51\xba\xea\x70\xac\xcc\x80\xa4\x34\x90\x32\xde\x88\x1b\xda\x59\x5e\x6f\x17\xef\x49\x4e\xf9\xba\xec\x9e\xe4\x7e\x52\x7b\x11\x00\x4e\x6a\xef\xef\xa9\xf6\x18\xd7\x20\x13\x28\xb4\x90\xc3\x62\x68\x97\x8d\x17\xb0\xd7\x85\x1f\x85\x44\x58\x0a\x5c\xb3\x15\x03\x69\x39\x59\xc8\xf5\x8c\x16\xc6\x7a\x9e\xa1\x15\x3a\xbb\x9f\xe1\xc5\x6d\xf5\x5e\xd0\x34\x52\xda\x5d\x2a\xcb\xcd\x85\x84\x79\x21\x94\xf6\xe3\xf9\x48\xbc\xf7\x46\x4f\x3a\x72\x48\xa5\x6a\x4f\x2a\xd2\xcb\x8e\x41\xfb\x64\xb5\xf1\xce\xdd\x73\x61\xca\x4d\x86\xb6\x2b\x90\xf3\x4a\x22\xbd\xc6\x70\x45\x18\x6c\x61\x8f\xa8\xc0\xb6\x6e\x46\xf2\x36\xd8\x77\xec\xa1\xe7\x78\x00\xc3\x12\x04\xf8\x28\x36\x4b\xe2\xcd\xa3\xf6\x46\xc7\xd8\x43\xc6\x66\x1d\xe8\x30\x75\xa3\x47\xb5\x1f\xc4\x78\xb2\xa3\x46\x42\xe0\x82\x4e\x58\x0e\x3f\x8c\xb7\x7d\x21\x56\x35\xb8\x12\x5f\x75\xea\x39\xad\x6a\xdb\xed\x09\x9d\xc3\x2c\xd2\xcf\x99\xb9\xb6\x7d\x4c\x91\x33\x0d\x9b\x22\xa7\x1a\xce\x42\x52\xea\xf9\x59\xf7\x6f\x64\x54\x62\xba\xa0\x69\x31\x79\x0b\xd3\x01\xec\xd3\xac\x2e\xd8\x14\x19\x93\x10\xad\x19\x78\x1e\x53\x14\x7b\xd8\x0d\x88\x45\x55\xe3\xdb\xec\x1b\xdd\x32\x32\x02\x22\x6b\x35\x6e\x1a\xf0\xc1\x5a\xed\xfb\xae\x7b\xfb\xbd\x9f\x2a\x41\x8e\x75\x42\xdd\x57\x05\x7b\x4d\xe3\xb8\x8d\x49\x0b\xf6\xbb\x2b\x71\x0c\x04\x82\x9a\xa9\xc1\xab\x4b\xe2\x0a\x22\x3d\xcc\x0e\x89\x40\x7b\xbe\x9e\x13\xc5\x22\x90\xb0\x03\xdc\x0e\x98\x5d\xae\xec\xc7\xa4\x6b\xf4\x44\x49\xc1\xc0\x6e\x9e\xd1\x13\x48\x16\x84\x71\xa5\x81\x1a\xda\x0c\xd0\x18\xe5\xc4\x98\x0c\xc6\x88\xc0\x37\x26\x4e\x05\x20\x88\xfe\x9a\xae\x23\x05\x42\xed\x6d\x54\x9c\xe0\x3d\xff\x25\x94\x6e\xb7\x17\x68\x69\x62\x0d\x06\x4d\x35\x6c\x80\xeb\x09\x51\x65\x92\x11\x6a\x64\x99\x62\x12\xd2\x85\xf9\x97\xd9\x86\x72\xb6\x02\xa5\x67\x15\xb9\xa9\x9b\x37\xb7\x21\xa1\xf6\xb3\x90\x04\x3e\xd3\x4d\x91\x57\x57\x83\x3c\xa6\x35\xf5\x30\x7b\x4d\xb5\x41\xc0\x5b\xa6\x33\x16\xd2\x23\x94\x14\x22\x75\x68\x6f\x11\x5d\x4d\xef\x31\x54\x48\x5d\x58\x3e\x67\xf7\x70\x41\xce\x8c\x52\x6a\x80\xf9\xa7\x91\xcc\x7f\x85\xae\x98\x9c\x5b\x5d\x72\x66\x1e\x3a\xb3\xc0\x29\x1f\xd5\x6f\x6a\xba\x1a\x48\x54\xc7\x5a\xb2\xf5\x1a\x22\x2a\x27\x03\x5b\x33\xf2\xda\x18\x9e\x6c\x45\xb8\x68\x2c\x81\x0b\x9b\xd3\xf3\xb7\x96\x0f\x80\xbe\x79\x73\x1b\x84\xb8\xbd\x5f\x84\xf1\x14\x3e\x93\x37\x56\xe5\x30\x65\x76\x09\xe7\x8e\x9a\xf5\x31\x0f\x81\xee\x54\x26\x14\x84\x76\x16\xef\x6b\xf9\x3b\xdb\x4a\x6c\x80\x6c\x21\xcf\xa7\x5e\x97\x6d\xe9\xce\x46\x96\xed\xc1\xd9\x56\x9e\x05\x95\x3a\x4a\xad\xf6\xb8\x67\x2e\x6a\x8b\x90\x19\x82\x5a\x73\x7f\x65\xdb\x5e\x5a\xc7\x61\xd1\xcd\xeb\xdc\x81\x15\x55\x69\xc9\x47\x0b\x92\x64\x94\x63\x5a\xdf\x5a\x00\x18\xe4\x0d\xe4\x7f\x7a\xf8\x18\x6b\xa3\x07\xb0\xf0\x3b\xa7\x36\x5a\x82\x83\xfc\x57\x48\xc0\x6b\x4c\x17\x24\xd3\xba\x50\x17\xf3\xf9\x9a\xe9\xd9\xfd\xbf\xb0\xeb\x6e\x22\x36\x9b\x92\x33\xbd\xc3\xf9\x9a\x92\xdd\x95\xc6\x0d\x98\xa7\xf0\x00\xf9\x5c\xb1\xf5\x94\xca\x24\x63\x1a\xf0\x1a\xf7\x9c\x16\x6c\x9a\x08\x6e\x28\x86\x09\xae\x66\x9b\xf4\x3b\x6c\xfc\x37\x35\x20\x76\x46\x9e\x7b\x91\x0b\x1b\x75\x6d\xe4\x7e\x6d\x50\x79\x14\xb9\x83\xae\xc2\xca\xa0\x66\xfc\x1b\x35\x17\x0f\x20\x1f\x18\x6c\xe7\x5b\x21\xef\x19\x5f\x4f\x0d\x69\x4e\x2d\x0d\xa8\x39\xda\x46\xf3\xef\xf0\x3f\xa3\x71\xc1\xf2\xf5\xa1\x08\xd9\xbe\xad\x2f\x80\x95\xf9\x8e\x9a\x8f\x42\xca\xa7\x49\x86\xeb\xb1\x57\x0b\x67\x6f\xef\xbf\x6b\xd8\xc2\x2a\x5d\xe4\xa5\x4a\xc6\x86\x8c\x48\x85\x23\xd5\x51\x34\x53\xbe\x7b\x76\x52\x36\x1b\x8a\x2d\x13\x93\xdd\x14\x97\x10\xf9\x94\xf2\xd4\xfc\xbf\x9d\x66\x9b\xec\x46\xed\x60\xc9\x06\xb1\xef\xc7\xcb\xb7\x2f\x43\xe0\x25\x1b\xc5\xab\x11\x03\x31\xa7\x4a\x5f\x72\xa6\xaf\xd9\x06\x94\xa6\x9b\x62\x80\x7d\xa6\xfd\xb3\x75\x7a\xce\xc7\x66\xea\xa2\x2e\x37\x94\x86\xa9\xa6\xfd\xd7\x01\xfa\x96\x9a\x27\x98\x76\x39\xba\x43\x85\xdf\x5f\xeb\x15\xc1\x5d\xdc\x61\x75\x6d\xfa\x8b\x8d\x9a\xf6\x57\xbe\xfc\x76\xf0\x82\x8f\x17\x6c\x84\x32\xc6\x45\x02\x5c\xfb\x20\x2c\x9a\x7b\xee\x85\x2e\x2b\xae\x23\x40\x1b\xc6\x8f\x71\xfd\xc3\xf7\x01\xdc\x70\x0b\x0f\x3c\xf8\x22\xa3\x6a\x88\x01\x4f\x13\x5d\xd2\xdc\x3e\x7e\xcc\xe6\x85\xe7\x6c\x1d\xd4\x96\x75\xf4\x67\x26\x5b\xaa\x93\xcc\x12\xc6\xf8\xb4\x75\x21\x85\x71\xc1\x07\x60\xe9\x9e\xf4\xd9\x7d\x2d\x88\x2c\xf9\xa3\xbe\x2d\x01\x2b\x03\xfa\x62\xf4\xba\x35\x04\xce\xbf\x14\x3b\xe7\x7f\xbe\x39\xea\x9c\x5d\xf0\xe5\xca\xb6\x93\xe9\x88\x15\x1d\x7a\x50\xf6\x0d\xdf\x81\x46\xba\x81\xa0\x4f\x50\x46\xe0\x56\x0e\xaa\x94\x20\x28\xde\x3d\x7a\x32\x48\x14\xe4\x90\x74\x4e\xe8\x68\xc1\x80\x53\x2b\xaa\x87\x8f\xf9\xc0\xc3\x60\x1c\xab\x8e\xee\xee\x95\xe1\x5f\xe9\x94\xcc\x07\x7f\x69\x25\x4c\xe3\x4e\x80\xd2\x42\x1a\xe7\xbd\xf1\x37\xe5\x9d\xdc\x2f\xec\x50\x09\x6d\x72\x0e\xee\xc4\xc2\x6d\x04\x3a\x97\x64\x66\xab\x0f\x67\x07\xdb\x63\xbc\x85\x0f\x8e\x92\xfd\xa3\xc6\x81\x38\xa0\x6e\xbb\xc0\xfe\xa3\x76\xd5\xbd\x87\x5d\x71\x28\xf9\xf3\xaf\x7f\xfc\x3f\x00\x00\xff\xff\x1b\x30\x6a\xc4\xa4\x2f\x07\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\xbd\x7b\x73\x1b\x37\xb2\x28\xfe\x3f\x3f\x45\x97\x9c\x2a\x4a\x27\x22\x95\xc7\x6e\xea\x77\xf8\xdb\xba\x29\x1d\x49\x4e\x74\x6d\xcb\x2c\x53\xc9\x56\xca\xeb\xb3\x06\x67\x9a\x24\x8e\x86\xc0\x2c\x80\xa1\xc4\xbd\xbe\xdf\xfd\x56\xe3\x31\x33\xa4\x48\x0e\x86\x96\xe2\xd4\x2e\x39\xae\x44\xe4\xcc\x34\x1a\x40\xa3\xdf\x0d\xbc\x80\xde\xd3\x7d\x3a\x2f\xe0\x35\x4f\x50\x68\x4c\xc1\x48\x30\x33\x84\xf3\x9c\x25\x33\x84\x91\x9c\x98\x7b\xa6\x10\x5e\xca\x42\xa4\xcc\x70\x29\xe0\xf8\x7c\xf4\xf2\x04\x0a\x91\xa2\x02\x29\x10\xa4\x82\xb9\x54\xd8\x79\x01\x89\x14\x46\xf1\x71\x61\xa4\x82\xcc\x01\x04\x36\x55\x88\x73\x14\x46\xf7\x01\x46\x88\x16\xfa\xcd\xdb\xdb\xeb\x8b\x2b\x98\xf0\x0c\x21\xe5\xda\xbd\x84\x29\xdc\x73\x33\xeb\xbc\x00\x33\xe3\x1a\xee\xa5\xba\x83\x89\x54\xc0\xd2\x94\x53\xc3\x2c\x03\x2e\x26\x52\xcd\x1d\x1a\x0a\xa7\x4c\xa5\x5c\x4c\x21\x91\xf9\x52\xf1\xe9\xcc\x80\xbc\x17\xa8\xf4\x8c\xe7\xfd\xce\x0b\xb8\xa5\x6e\x8c\x5e\x06\x4c\xb4\x03\x6b\xdb\x34\x12\x7e\x93\x85\xef\x43\xad\xbb\x7e\x14\x4e\xe1\x57\x54\x9a\x1a\xf9\xae\xff\x4d\xe7\x05\x1c\xd3\x23\x47\xfe\xe6\xd1\xc9\xff\x0f\x4b\x59\xc0\x9c\x2d\x41\x48\x03\x85\xc6\x1a\x64\x7c\x48\x30\x37\xc0\x05\x24\x72\x9e\x67\x9c\x89\x04\xab\x6e\x95\x2d\xf4\xc1\x22\x40\x30\xe4\xd8\x30\x2e\x80\xd9\x6e\x80\x9c\xd4\x1f\x03\x66\x3a\x2f\x3a\x2f\xc0\x7e\x66\xc6\xe4\x83\xb3\xb3\xfb\xfb\xfb\x3e\xb3\xb3\xd3\x97\x6a\x7a\x16\x7a\x77\xf6\xfa\xfa\xe2\xea\x66\x74\xd5\xb3\x28\x77\x5e\xc0\x2f\x22\x43\xad\x41\xe1\x3f\x0a\xae\x30\x85\xf1\x12\x58\x9e\x67\x3c\x61\xe3\x0c\x21\x63\xf7\x34\x71\x76\x76\xec\xa4\x73\x01\xf7\x8a\x1b\x2e\xa6\xa7\xa0\xfd\xac\x77\x5e\xac\xcc\x4e\x35\x5c\x01\x3d\xae\x57\x1e\x90\x02\x98\x80\xa3\xf3\x11\x5c\x8f\x8e\xe0\xbf\xce\x47\xd7\xa3\xd3\xce\x0b\xf8\xeb\xf5\xed\xcf\x6f\x7f\xb9\x85\xbf\x9e\xbf\x7b\x77\x7e\x73\x7b\x7d\x35\x82\xb7\xef\xe0\xe2\xed\xcd\xe5\xf5\xed\xf5\xdb\x9b\x11\xbc\x7d\x09\xe7\x37\xbf\xc1\xab\xeb\x9b\xcb\x53\x40\x6e\x66\xa8\x00\x1f\x72\x45\xf8\x4b\x05\x9c\x06\x12\x53\x9a\xd3\x40\x40\x01\x01\xa2\x0f\xfa\xae\x73\x4c\xf8\x84\x27\x90\x31\x31\x2d\xd8\x14\x61\x2a\x17\xa8\x04\x91\x47\x8e\x6a\xce\x35\x4d\xa7\x06\x26\xd2\xce\x0b\xc8\xf8\x9c\x1b\x4b\x45\xfa\x71\xa7\xa8\x99\xb0\x30\x9e\xe0\xd3\xe9\xb0\x9c\x7b\x72\x1a\x00\xcb\x39\x3e\x18\x14\x16\x9b\xfe\xdd\xff\xa7\xfb\x5c\x9e\x2d\xbe\xed\xdc\x71\x91\x0e\xe0\xa2\xd0\x46\xce\xdf\xa1\x96\x85\x4a\xf0\x12\x27\x5c\x58\xca\xef\xcc\xd1\xb0\x94\x19\x36\xe8\x00\x30\x21\xa4\x47\x9e\xbe\x82\x5b\x75\x32\xcb\x50\xf5\xa6\x28\xfa\x77\xc5\x18\xc7\x05\xcf\x52\x54\x16\x78\x68\x7a\xf1\x4d\xff\x87\xfe\xb7\x1d\x80\x44\xa1\x7d\xfd\x96\xcf\x51\x1b\x36\xcf\x07\x20\x8a\x2c\xeb\x00\x64\x6c\x8c\x99\x87\xca\xf2\x7c\x00\x09\x9b\x63\xd6\xbb\xeb\x00\x08\x36\xc7\x01\x70\x61\x70\xaa\xec\xdb\xba\x6f\x6f\xd6\x48\xb1\x43\x93\x40\x2f\x4f\x95\x2c\xc2\xcb\xf5\xfb\x0e\x8a\x87\x9f\x30\x83\x53\xa9\x78\xf8\xde\x83\x3b\x7a\xde\xff\x9d\x94\x7f\xbb\x91\xb9\xae\x1a\xb6\x4f\x64\x5c\x9b\x57\xeb\x77\x5e\x73\x6d\xec\xdd\x3c\x2b\x14\xcb\x56\xd1\xb5\x37\xf4\x4c\x2a\x73\x53\x21\xd1\x03\xee\xde\xd0\x5c\x4c\x8b\x8c\xa9\x95\x77\x3a\x00\x3a\x91\x39\x0e\xc0\xbe\x92\xb3\x04\xd3\x0e\x80\x1f\x51\x8b\x77\xaf\xc6\x9d\x86\x8a\xde\x55\x17\x32\x2b\xe6\x61\x6e\x7a\x90\xa2\x4e\x14\xcf\x09\xbf\x81\x65\x49\xb5\x06\x20\x9f\x31\x8d\x16\x01\x80\xff\xd1\x52\x0c\x99\x99\x0d\xa0\xaf\x0d\x33\x85\xee\xd7\xef\xd2\xc8\x0d\x60\x58\xfb\xc5\x2c\x09\x33\xe2\x9d\x62\x1a\xd3\xd6\x1d\x37\x5b\x5b\xaa\x3d\xf7\x8a\x9b\x3e\x35\xe6\x9f\xa5\x3f\x07\xf0\x8a\x9b\x16\xad\x8a\x62\x3e\x26\xd9\x30\x81\x5c\xa6\x7a\x6b\xa3\x0a\x2d\x37\x0a\x0f\xb8\x96\xde\xad\xfe\xe8\x3a\x69\xd1\x43\xd5\xa9\x1e\x5b\x10\x21\xd3\xf4\xcc\x70\x6e\x57\x05\x7d\x93\x39\x8a\xf3\xe1\xf5\xaf\xdf\x8f\x56\x7e\x86\x55\x0c\x6b\x04\x03\x9c\x84\x02\x82\x7b\xbe\x64\x26\xb5\xd1\xd0\x70\x3e\xbc\x2e\xe1\xe4\x4a\xe6\xa8\x4c\x49\xb3\xee\x5f\x6d\x7d\xd7\x7e\x5d\x6b\xb5\x4b\x88\x79\xa1\x92\xd2\xc2\x46\xd7\xb4\x27\x26\x4c\x7d\x5f\x68\xd4\xac\x34\x51\x48\xfc\x0f\x85\xa9\x88\xbe\xba\xe4\x84\x18\xad\x1c\xff\x0f\x26\xa6\x0f\x23\x54\x04\x06\xf4\x4c\x16\x59\x4a\xfc\x60\x81\xca\x80\xc2\x44\x4e\x05\xff\x67\x09\x5b\x07\xe1\x9e\x31\x83\x7e\xa9\x54\x17\xf5\x5a\x09\x96\xc1\x82\x65\x05\x9e\x12\xab\xb4\x32\x4e\x21\xb5\x02\x85\xa8\xc1\xb3\x8f\xe8\x3e\xbc\x91\x8a\x08\x7a\x22\x07\x56\x3a\xe9\xc1\xd9\xd9\x94\x9b\xc0\xd7\x12\x39\x9f\x17\x82\x9b\xe5\x59\x4d\x31\xd0\x67\x29\x2e\x30\x3b\xd3\x7c\xda\x63\x2a\x99\x71\x83\x89\x29\x14\x9e\xb1\x9c\xf7\x2c\xea\x82\x3a\xac\xfb\xf3\xf4\x85\xf2\x9c\x50\x77\x57\x70\x7d\x44\x83\xee\x9f\xe5\x14\x3b\x66\x80\xf8\x05\xcd\x38\xf3\xaf\xba\x8e\x56\x03\x4d\x3f\xd1\xe8\xbc\xbb\x1a\xdd\x42\x68\xda\x8a\xf6\x15\xa0\xe0\xc7\xbd\x7a\x51\x57\x53\x40\x03\xc6\xc5\xc4\x4a\x14\x52\x09\x94\x9c\xdb\x69\x46\x91\xe6\x92\x0b\x63\xbf\x24\x19\x47\xb1\x3e\xfc\xba\x18\xcf\xb9\x71\xf2\x1a\xb5\xa1\xb9\xea\xc3\x85\x65\xf6\x30\x46\x28\xf2\x94\x19\x4c\xfb\x70\x2d\xe0\x82\x98\xe3\x05\xd3\xf8\xec\x13\x40\x23\xad\x7b\x34\xb0\x71\x53\x50\x97\x53\xd5\x87\xa0\x0c\xfc\xa8\xd5\x6e\x04\x71\xb1\x65\xbe\x68\xa4\x52\xd4\x56\x77\xa9\xaf\xd9\x20\xea\x1f\xaf\x8b\xcd\xcb\xd3\x8b\xc8\x09\x9f\x16\x8e\x13\xae\xdf\x5c\xa7\x93\x4b\x9a\x57\x12\x4f\xe9\x00\x7e\xd1\xe8\xe4\x18\x18\xc5\xb8\x81\x63\xfb\xa5\x5f\xb5\x74\x42\x6b\x6a\xce\x04\x9b\x62\x67\x05\xe6\x1a\x46\x0e\x92\x14\xa4\xeb\x11\x75\x38\x68\x73\x59\x08\xd3\x77\xd8\xd5\x41\x79\x84\x75\x67\x05\x9a\xfd\xb7\x03\x4e\xb9\x5c\xea\x90\xca\x1f\x57\x31\xd8\x00\x79\x05\xd6\x82\xa4\xd8\x2a\x24\xff\xd3\x2a\x21\xd0\xc5\x0d\xce\x1f\x0d\xf9\xa3\x71\xbd\xa8\xcf\xc1\x28\xc7\xa4\xb6\x80\x80\xc1\x14\x05\x2a\x9e\xac\x4e\xd5\x06\x98\xb0\x83\x00\x9a\xc8\xc0\x5d\x61\x44\x5e\xe1\x72\xf3\x03\xbb\x08\x42\x48\xc8\xa4\x98\xa2\x22\xbd\x3f\x7d\x3c\x16\x3b\x97\xc7\x63\x1c\xde\xd0\x60\x0f\x89\x33\x7c\x71\x54\x6e\x69\x95\x7e\x29\x24\x4c\x74\xe3\x35\xa2\x21\x0e\x41\x2f\x92\xcc\x5c\x21\x9b\x53\xe0\x38\x08\x74\xb0\x3c\xdd\x02\x37\xb0\x85\x39\xcb\x4f\x41\x63\xa2\xd0\x9c\x42\xbf\xdf\xdf\xbb\x13\x56\x9e\x44\xf5\x82\x30\xb7\x4f\xd3\x42\x65\x5a\xf3\xa9\x08\xb2\x79\xa5\x23\x70\xac\x97\xc2\xb0\x87\x2d\x30\xc1\x0a\xe8\x05\x53\x4b\x48\x31\x47\x61\xad\x61\x29\xac\x90\xf9\x48\xf3\xf9\xf1\x64\xbf\xbe\x04\xa3\x71\x53\x67\x7a\xf6\xe5\x8d\x37\x6c\x97\x36\xdc\xd9\x22\x00\xea\x37\x99\x52\x6c\xb9\x76\xcf\xf5\x09\x45\xb2\x71\x29\x3f\x1a\x50\xb2\x09\x88\x14\xac\x78\x04\xa9\xe0\x0d\x5b\xa0\xf0\x23\xe3\xa0\xac\x58\xc3\x66\xb6\x89\x65\xd7\x44\x4d\x3c\xa3\xdb\x39\x9c\xdb\x3b\x38\xc9\xe4\x7d\x53\xcf\x18\x78\x45\x84\x0b\xf8\xed\xfc\xcd\x6b\xb8\x1c\xbd\xae\xac\xdc\xfb\x19\x4f\x66\x81\xab\xdb\x51\x50\xb2\x30\xb8\x49\x70\x18\x09\xaa\x68\xd1\xa7\x15\x2c\x5e\x66\xf2\xde\xea\x4e\x02\x0a\xa1\x8d\x2a\xac\xca\x90\x3e\x52\x85\x88\x00\x99\x53\x50\x36\x80\x04\x0f\xc7\xf5\xe4\xec\x7f\x8f\xde\xde\x50\x77\xda\x52\x0c\xc0\x43\x8f\xac\x5c\x25\xd0\xa0\xee\xd9\xa6\xd5\x02\x7b\x85\xb8\x13\xf2\x5e\xf4\x26\x1c\xb3\x54\x0f\xc0\xa8\x02\x5b\xcc\xc6\xaa\x11\xd4\x30\x2d\x76\xa8\x71\x82\x0a\xc9\xc1\xe3\xbd\x36\x1f\x6b\xc4\xf3\x8a\x9b\x8f\x7e\x7a\xb8\xb6\xcc\x91\xec\x8b\x47\x40\xc1\x29\x8a\xbb\xa8\x6e\xb7\x3c\xdb\x66\x7d\x6c\xc4\xfa\x7c\x78\x1d\x2c\x8e\x80\xb3\xef\x84\xe9\x77\xf6\xe0\x13\x76\xa4\xad\xd1\xda\xdc\x76\xf7\x7a\xe2\x1a\xa3\x45\x42\xdc\x8e\x41\xce\x31\xc1\x15\x63\x06\xb8\xd0\x06\x59\x0a\x72\xb2\x11\x22\xb9\x3f\x80\xe8\x4c\xa1\x7f\xe3\xd4\x0d\xa0\x57\xe9\x2b\x13\xc8\x3b\xd5\x16\x2c\xe3\x29\x10\xa5\x9d\xfd\x24\xb7\x80\xb4\xbd\x00\x96\x24\xe4\x6f\x22\xe3\xd4\xfa\x2b\x4f\x41\x17\xc9\x0c\x98\x0e\x5a\xe8\x88\xee\xf4\xe7\x4c\xf0\x09\x6a\xab\xb5\x51\x1b\xa8\xf4\xfb\xef\x3e\x6c\x1e\x3d\x80\x97\x92\x3c\x59\x6c\x9e\x67\x78\x0a\xdc\x8d\x78\xb9\x66\x02\xf5\x90\x15\x4a\xc3\x51\x42\xb4\x0e\x43\x2e\xb6\x8d\x00\x99\xd5\xbe\xdb\xf7\xb6\xbb\x86\xdd\x21\x48\xdf\xdd\x02\x21\xe3\x77\x38\x80\x23\x52\x94\x6a\x68\xfe\x1f\xb2\xb0\xff\xef\xd1\x16\xa8\xc7\xf7\x33\x54\x08\x47\xf4\xd0\x91\x43\xae\xb4\x17\xe9\xb7\x40\x2f\x15\x92\x66\xc6\x0c\x18\xc5\xa7\x53\x54\xd6\x31\xb2\xe9\xa2\x57\x70\x81\xc2\x9c\x10\x47\xe6\x13\x52\x18\x2a\x10\x16\x30\xd7\x41\xa5\xc3\xf4\x11\xd2\xef\xbf\xfb\xb0\x15\xe3\x0a\x0e\x8d\x17\x70\x91\xe2\x03\x7c\x47\xec\xc5\x8e\x4d\x2e\xd3\x93\x3e\xdc\xd2\x9f\x4e\x8a\x12\x03\x4b\x66\x52\xe3\xb6\x91\x95\x22\x5b\x52\x9f\x67\x6c\x81\xa0\xe5\x1c\xe1\x1e\xb3\xac\xe7\xec\xf5\x14\xee\xc9\x5b\xeb\x89\x98\xe4\x89\xe5\x75\x39\x53\x66\x27\xb5\x06\x2b\xfd\xf6\xed\xe5\xdb\x81\x9b\x35\x22\xa8\xa9\x20\x74\xc8\xba\x9b\x70\xc1\x32\x6b\x6e\x7b\x37\x32\x51\x23\xd7\x5b\x20\xea\xc2\xc2\x23\x34\x93\x19\x13\x53\xb2\xbf\xed\x24\x4d\x0a\x62\xc9\xfd\xee\x3e\xeb\xf8\xb1\xe9\xbc\x79\x09\x5b\x13\x7a\x9d\x71\x7c\x31\x23\x34\xb2\x73\x44\x64\x31\x9d\xbb\xa9\x51\xf9\xce\xce\x55\xb2\x87\x8c\xec\x54\x26\x9a\xba\x46\x7e\x7f\x7d\x46\x5e\xe7\x05\xc7\xfb\x33\x0a\x5f\x70\x31\xed\x11\x69\xf6\x1c\x0d\xe8\x33\x42\x45\x9f\xbd\xb0\xff\xdb\xbb\x2f\xd6\x11\x19\xdb\x21\xfb\xf0\xef\xd1\x2b\x6a\x47\x9f\xed\xd5\xa9\x60\x81\xc4\xcb\xb1\xee\xc8\xdb\x80\xeb\xef\xd2\xb2\x70\x42\xd7\x3b\xd1\x3c\x8f\xdd\x08\x12\x68\x05\xce\x59\xea\x58\x33\x13\xcb\x67\x27\x65\x1a\xd0\x42\x11\x46\xcb\x9e\x77\xdb\xf7\x98\x48\xe9\x6f\xcd\xb5\xa1\xdf\xf7\x1a\xc1\x82\x47\x2d\xdf\x5f\xae\x2f\x7f\x1f\x02\x2f\xf8\x5e\x6b\x75\x87\xd6\x97\x2b\x49\x91\xbb\x41\x67\x67\x0f\xa9\x67\xfe\x49\x10\x88\xa9\x8b\x67\xaa\x42\x34\x6b\x59\x3b\x10\x0b\x8e\xea\x88\xc6\x2b\xd7\xf7\xc7\xa1\x4c\xf5\xc7\x80\x45\x70\x2d\xab\x42\xd8\x98\xd4\x2e\x4c\x5c\x88\x73\x40\xce\xfb\xef\xbf\x7b\x74\xf7\xb1\x47\xbc\xfa\x28\xcc\xa5\xe6\xa6\x16\x55\xd9\x8a\x6c\x2d\xa8\xea\xcc\xa4\xfa\xbb\x34\x68\xe4\x73\xd4\x98\xc6\xdb\x0b\x7b\xda\x40\x61\x01\x37\x21\xbc\xe2\x75\xd8\xe8\x04\x2b\x7f\xe8\x97\x30\x37\x99\xbf\xdb\x5c\x63\x14\x3d\x60\x39\x0f\xd0\xfc\x57\xef\x9c\x9b\xb3\xbc\xee\x0b\xdb\x00\xf6\xad\x0b\x3e\x94\xba\x8c\x73\x4f\xe9\x6e\xfc\x00\xae\x76\x37\x44\x01\x57\x3d\x65\xa4\xfe\x32\x63\x28\xfa\x9b\x96\xf8\x3b\x33\x63\x03\x44\x80\x7b\x9e\x65\xe4\x3e\x9e\x33\x83\x8a\xb3\xcc\x7a\xef\x19\xf9\xc0\x69\x35\x81\x14\x2b\x54\x49\x34\xfb\xd1\x2b\x2a\xf7\xe8\xe3\x09\x1b\xe1\xa6\x61\x32\x48\xfb\x70\x9a\x07\x28\xcc\x90\x51\x9c\x9d\x7e\x62\x0b\x59\xd8\x10\x90\x75\x71\x9e\x96\xa3\x2b\x55\x2d\xb6\xb7\x7e\xd9\xa1\xdf\xc8\x38\x76\xdb\x40\xe4\xc9\x99\x93\x19\xb8\x5d\x7c\xac\x0d\xaf\x57\xc5\x89\x64\x50\x18\x92\x03\x01\x02\xa6\x70\x3c\x66\x1a\x7f\xf8\x13\xa0\x48\xd4\x32\x37\x98\xee\xf6\xa2\x8c\xa5\xcc\x90\x89\xce\x16\x0f\x93\xc1\x48\xe7\x1e\xcd\x84\x9f\xd0\x44\xa6\x08\xc7\x79\x46\x36\x8c\xc1\x07\xb3\x1b\x81\x2d\x6b\xae\xd6\x7e\xac\xab\xd3\x8f\xc9\x84\xcf\x59\x0e\x77\xb8\x84\x99\xcc\xd2\x10\x17\x29\x71\xb3\x20\x3f\x13\xa3\x77\x38\x69\x8f\x51\x29\xce\x9f\x0f\xaf\x68\x2f\xa8\x47\x8c\xde\xb1\x9d\x86\x63\xc3\x73\x9e\xb0\x8c\x4c\x08\x7c\x30\x64\xee\x8c\xb9\x60\x6a\xb9\xf7\xe4\xd9\xa5\xb3\xdd\xba\xde\x80\x90\x7d\x03\x72\x66\x66\xb4\xb4\x53\xd4\x86\x0b\xcb\x87\xdc\xd2\xde\x17\x91\xed\x1a\xf4\x06\x1c\xea\xc6\xe2\x0a\x2f\xdc\xb7\xf5\xbc\xcd\x08\xd0\xc3\xe0\x4c\x59\x42\xc0\x72\x39\x4e\x56\xbd\xdc\x61\xa3\x36\x61\xa0\xd8\xfd\xc5\xe7\x2c\xe4\xdd\x74\x10\xe4\xfd\x78\x69\x70\x5f\x0c\xa3\xfd\xe7\x34\x28\x77\xde\x8a\xa3\x48\x1d\xc9\x34\x7c\xc8\x37\xbb\xd9\x22\x9a\xde\xa1\xb2\xed\x92\xfa\x9a\xac\xa4\x04\xcf\x93\x84\x28\xf6\x66\x23\x7d\xad\xe0\x9d\xd8\xd4\x18\x18\x9d\x13\xc2\x45\x2d\xf3\x67\x4f\x9d\xce\x4b\xfe\x41\x27\x72\x26\xb5\x37\x2b\xbc\x92\x61\xef\x58\x37\xa7\x77\xb8\x7e\x9e\x7b\x75\x54\x89\xfa\x7a\x72\xc0\x6a\x2c\x60\x22\x57\xf3\xed\x02\xa4\xfa\xa7\x42\x66\x4c\xb9\x68\x98\xd8\xbc\x2c\xeb\x09\x4b\x50\x91\x4f\xc5\xbb\xc7\xeb\x5e\xe4\x83\xbc\x3d\xc8\xdb\x7f\x73\x79\x6b\xe5\x2d\x19\x55\x8a\xdc\x38\x52\xe9\x28\x9c\xae\x6b\x2f\x00\x65\xc9\xca\x3c\x24\xaa\xa6\x64\xf5\x4f\xb8\x75\xa2\xce\x68\xcd\x4e\x43\x26\x9c\x55\x7f\xfb\x77\xfd\x77\x76\xa9\xbe\x96\x2c\xdd\x18\x8d\xf7\x86\x3d\xe9\xd3\x46\x42\xae\xf0\x2c\x97\xda\xd0\xc2\x74\x6e\x6a\x3b\x09\xdb\x9c\x84\x5b\xb9\x4e\xe4\x48\xec\x62\xdd\xee\x0a\xbc\x23\x6a\x9c\x9c\x1a\xb0\xf4\x2c\xd4\x27\x5d\x05\x08\x70\x5c\x72\xa4\x93\xcd\x26\x67\xf8\xd8\x2c\x55\x83\x2a\x57\x68\xbc\xd7\x3f\x90\x62\x8a\x3b\xfb\xb1\xa3\xab\x99\x9d\x80\xa8\x6e\xb8\xb9\xf2\x61\xb0\xda\x54\x07\x6d\x67\xdf\x49\xb6\x8e\x74\x6b\xa9\x6d\xee\x1e\x33\x14\xb9\x33\xbc\x4c\xca\x6b\xdd\xc9\x7f\x77\x05\xce\x8b\xb3\x65\xaf\x96\x80\xda\x80\xcb\x6b\x1f\x4d\x0e\xaf\xda\x61\xd1\x5e\x3c\xa7\xc1\xe9\xee\x67\xe8\x18\xfb\xd3\x6d\x21\x20\xb0\xc2\x8f\x38\x16\xd7\x70\x64\x70\x9e\x53\xfa\xdd\xd1\xc9\x17\x5b\xba\xff\x42\xda\x2c\x49\x8f\x15\x8d\x29\xa8\xb6\x1e\x61\xf7\xf0\x38\xc4\xfb\xb7\x06\x55\x42\x4e\xdf\x7e\x08\xef\xa9\x03\x07\x52\x18\x74\x76\xf6\x71\x28\xd3\xf2\x51\xaf\x06\xf3\x7f\x6e\x5e\x8f\xbb\xb5\xb6\xc7\xf9\x76\x1b\xdb\x8b\x5b\xf6\xbb\x9b\xa2\x8b\x25\x86\x2f\xf0\x12\x59\x9a\x71\x81\x23\x4c\xa4\x48\xb7\x3e\xbc\x86\xc3\xf9\xa6\x77\xb7\xbe\x1a\x48\x8e\x0b\xf3\xc3\x9f\xb6\x3e\xb5\xcb\x71\x1a\x3e\xa5\x0b\x31\x16\xd1\x8b\xf2\x85\xad\xcf\x37\x2c\xe8\xf5\x9e\xdb\x54\xf4\x0c\x43\xad\x06\x35\x53\x77\x75\x92\xa8\xa0\xea\x93\x7b\xb6\x55\xf5\xf2\xdd\xb5\xf6\x88\x8f\x24\x53\x9c\x52\xa6\xdb\x39\x54\xcc\x7c\xfa\x59\x55\xd3\x86\x27\xd6\x3a\xd4\x3d\x57\xd3\x82\xe2\xe9\x65\x34\x19\x85\x51\x4b\x9b\x0d\x4b\xd1\x59\x84\x54\x26\x77\x3b\xa4\x63\xb8\xf8\x9c\x4d\xb1\xdb\xd5\x70\xf1\xe6\xb2\x4c\xa9\xe0\x3e\x5b\xda\x87\x52\x73\x25\x17\x3c\xa5\x34\xd9\x5f\x99\xe2\x54\xe8\xd2\x08\xb6\xd4\x71\x35\x7c\x75\xfc\xeb\xf9\xbb\xbf\xdf\x9c\xbf\xb9\x3a\xb1\xda\x1c\x3e\xe4\x4c\x90\xf7\xbe\xd0\x41\xf7\x2d\x67\xa2\xdb\xd5\x9d\x06\xc8\x80\x62\xc1\x95\x14\xd4\xf9\x3e\x5c\x4f\x80\xc1\xc2\x63\x05\x49\x99\xd8\x4b\x4e\xdc\x6c\x81\x36\xa0\xdf\x02\xdb\x20\x80\xb8\xc8\x0b\xe3\x59\x53\xe9\xed\x2d\x84\x0b\x0d\xa7\x7d\xb8\x94\x45\xcc\x28\x7c\xf5\x95\xed\xb1\xc2\xb4\x48\x5c\xd0\x84\x05\x42\xfc\xea\xd4\xeb\x6d\x2c\xa3\x74\x25\x6b\x86\xa2\x4e\x58\xbe\x8d\x7b\xd7\x3f\x84\x62\x7d\x58\x5d\x20\x7e\x00\xbc\x8f\x7d\x38\xfa\xaa\x76\xeb\xc8\x61\x9f\x2b\x49\x28\x44\x01\xf6\xbd\xce\xb8\x41\xc5\x32\x38\xaa\x43\xeb\xc3\x15\xe1\x88\x69\x35\x64\xcd\x13\x66\x31\x10\xb8\x40\x05\xe3\x6a\xfa\x4f\x7d\xa9\x99\x2d\xa7\x92\x13\xf2\x2e\xd9\x0a\xa5\x98\xf9\x2a\x27\x1c\x1f\x38\x25\x75\x4b\x45\x74\xba\x31\xb1\xbb\x8a\xc3\x35\x42\xdd\x11\xa7\x33\x4c\xdf\xe9\x33\x2e\x48\x16\xf5\xc8\xc1\xd3\xab\x31\x92\x33\x27\x2a\x7b\x14\xc7\xa4\xb0\x23\xf3\x4b\xb3\x57\x92\xf5\xd9\x0b\x1f\x0b\xe8\xb1\xf2\x29\x2e\x7a\xac\xa7\x67\x98\x65\xdd\xce\x6e\xc4\x1a\x59\x5d\x94\x38\x8d\x93\x9e\xab\x1f\x8f\x6b\x3b\xce\x74\x55\x32\x22\x27\x9f\xfb\x70\x23\x4d\xe5\x35\xd9\x99\x84\x53\x5d\x0c\xec\xd8\xd4\x79\x59\xc5\xab\xae\x6e\x6e\xdf\xfd\x36\x7c\x7b\x7d\x73\x5b\x63\x59\x8d\x20\x77\xb3\xb4\x2d\x2c\xab\x19\xd1\x66\x96\xb6\xce\xb2\x9a\x61\x36\xb2\xb4\x47\x2c\xab\x11\x66\x1c\x4b\x5b\x63\x59\x8d\x50\x63\x59\xda\x3a\xcb\x6a\x04\x1c\xcb\xd2\x6a\x2c\xab\x11\x66\x34\x4b\xab\xb1\xac\x46\xa0\x71\x2c\x6d\x9d\x65\x35\x82\x8d\x62\x69\x07\x96\xf5\x88\x65\xa1\x58\xb4\x62\x57\xc1\x20\xad\x2d\xd1\x72\xae\xac\x6e\xa5\x91\xea\x8e\x1b\x40\xc2\xea\x9a\xdf\x34\x6d\x4f\x33\x62\x2b\xb8\x5f\x89\xc5\xaf\x4c\x55\x61\x6c\xeb\x41\xd9\xd4\x91\x46\xb0\xa4\xa9\xda\x4c\x63\xe2\x26\xac\xd2\xbf\x9b\xb0\x8e\xd7\x70\x9b\x3d\x26\x3b\x3a\x5a\xcf\x21\xdb\xd4\xbf\x66\x34\xdd\xf5\xa6\xd0\x56\x3b\x64\x70\xf1\xf7\xeb\xcb\xab\x9b\xdb\xeb\x97\xd7\x57\xef\xe2\xde\x6e\x41\xab\x8d\x55\x0b\x3b\xba\xda\xfd\x5c\x79\xb4\x53\x2a\xe5\x0a\x17\x5c\x16\x3a\x5b\x96\x2e\x97\xda\x78\x46\xc2\x0e\xa3\xae\x83\xb6\x5c\xd2\xbd\x4d\xb7\x64\x62\x19\x02\x51\x9f\x03\x3c\x42\xb7\x8f\x04\xb9\x49\x5c\x36\x69\xf8\x91\xa0\x37\x0a\xcd\x5d\x7a\x7e\x24\xdc\x5d\xa2\x73\x97\xb6\x1f\x09\x7e\x8b\x00\xdd\x22\x20\x23\x81\xee\x14\xa3\xdb\x34\xff\x48\xd8\xbb\x85\x69\xa9\xff\x5f\xe2\x84\x15\x99\xd1\xb1\x84\x21\xe1\xe8\x68\x6b\x61\xd2\xe7\x2f\xff\x97\x4a\xce\xf7\x60\x01\x2e\x6c\x59\x86\x62\x6b\x6b\xa8\xec\x78\x57\x47\x2f\xa7\xac\xc0\x15\x71\xe4\xed\x7b\xcb\x9f\x82\x36\x8c\xf3\xdc\x2c\xe3\xe6\xb9\x1d\xbf\xf7\xde\x9f\x09\x9f\xbe\x61\xf9\x2b\x5c\xee\x88\x97\x35\x0d\x0a\x66\x94\x0a\x09\xcc\x06\xf4\xa8\x52\x9b\xa4\x94\x03\x1c\x4b\xa1\xfb\x60\x4f\xd7\xdd\xf6\xb8\x63\x04\xe6\x64\xc0\x10\xce\x56\x97\xa0\x4e\xc4\xa3\xbb\x07\xe1\xb5\x97\xb4\x1b\x91\xde\x91\xb6\xdd\x0a\x28\x3c\x75\x0e\xac\xfd\x5f\x4b\x14\x5c\xea\xdd\x79\x9a\x82\xb4\x2c\xa4\xd0\x38\x29\x32\x57\x12\xa0\xfb\xb5\x5a\x9f\xd3\x4e\x14\xbc\xf2\x22\xbf\xf8\x29\x14\x3c\xfd\xb1\xdb\x69\xf1\xda\xbe\x73\x1a\x82\x63\x9f\x31\xaf\xa3\x32\x66\x58\x71\xd3\x72\x1d\xb5\x02\x0b\xc4\x75\xa9\xb4\x9e\x68\x7b\xee\x15\x2b\xaf\x54\xb4\x02\xd4\x9c\x7e\x00\xd0\xae\x96\x72\xf3\xa7\x47\x9c\x23\xfa\xe9\x9d\xd1\x87\xcd\x97\xa5\xa7\xfd\x39\x5c\xb7\x62\x71\x16\x52\x58\x7d\xb9\x6c\xd1\x49\xda\xf1\x20\xcf\xa5\x32\xba\xdc\x35\xc0\xee\x3b\x72\xba\xfa\xd5\xd6\x2f\xb4\xa1\xf6\x8f\xe5\xeb\x6e\x37\x9b\xf7\xdd\xee\x5f\x5e\x5d\xfd\xf6\xbf\xba\xdd\x0f\x1f\x4f\x6b\x77\x6b\xdb\xe8\xac\x3c\xd2\xa6\x03\x54\x5f\x25\x64\x8a\xc4\x82\x4e\xdd\xd7\xc7\x89\x4d\x6d\x90\xf7\x3b\xa3\xcc\xa4\x36\xd7\xc3\xd3\xf0\x35\x97\xe9\xfa\x37\xdd\xef\x3e\xb3\x28\x69\xaa\x2b\x8c\xa2\x14\x0f\x21\x10\x88\xdf\xe2\x24\xc6\x09\xb4\xfa\x79\x19\xea\x0c\x49\x0f\xa0\x6d\xaa\x0c\x0a\xab\xd3\xa3\x9a\x93\x03\xe3\x14\xd2\x76\x0a\x55\xf8\x18\x09\x47\x8b\x6f\xa3\x35\xc7\xcf\x62\x8a\x0d\xc5\x92\x51\x03\x6a\xc7\xc0\x8f\xa6\x85\x57\x09\xeb\x56\x40\x21\x58\x17\x3e\xd2\x87\x69\xbd\x36\xf4\x77\x18\x8e\x7d\xb8\x62\x39\x80\xcf\xc9\x1b\x43\x52\xfd\xcb\x27\xe3\x91\x65\x9a\xbe\x9c\xac\x1a\xa1\xf1\xa0\x7d\x69\x62\x80\xa4\xdd\x0e\x66\xe4\x45\x49\xab\x0d\x63\x8e\xdd\x8f\xfd\x24\x2f\xda\xf0\x1c\xff\xd6\x1c\xe7\x52\x2d\x4f\xc3\x57\xcc\x67\x38\xa7\xb0\x4a\x8f\x32\x2f\xd8\xb4\x15\x1b\x0b\x28\x59\x54\x4a\x04\x7d\x13\x2b\x48\x3f\x6e\x27\xd6\x6f\x10\x7c\x07\xae\x9e\xca\x64\xcb\x20\x4f\x30\x7d\x76\xde\x58\xce\xe0\xe6\xc4\xd5\x78\x22\x29\xdd\x57\x7e\x5b\xab\xb0\x2a\x5a\x81\xb4\x39\x17\x61\x77\x96\xd3\x2a\x07\x8d\x7e\x45\xb1\x20\x53\x6c\x63\x4d\xc5\x13\xaf\x68\x80\x94\x2f\xb8\x96\xad\xc8\x9a\x2a\x64\x97\x6f\x5b\x2c\xb2\x6a\x07\x8a\xa6\x58\xfe\xee\x77\x5b\x77\x6e\x6d\xea\x7c\x01\xa2\x4f\x3b\x91\x85\xa1\x98\x85\x4b\x44\x68\x05\x14\x82\x6c\xc4\x87\x5c\xea\x5a\x51\x8f\xae\x64\x1a\x18\xd9\x89\x00\x54\xbb\x8e\xbe\x8d\x75\xb0\xf8\x65\xc0\x0c\x6d\xfe\x35\x80\xff\x3e\xfe\xdb\xd7\x9f\x7a\x27\x3f\x1e\x1f\xbf\xff\xa6\xf7\x9f\x1f\xbe\x3e\xfe\x5b\xdf\xfe\xf1\x1f\x27\x3f\x9e\x7c\x0a\x5f\xbe\x3e\x39\x39\x3e\x7e\xff\xea\xcd\x4f\xb7\xc3\xab\x0f\xfc\xe4\xd3\x7b\x51\xcc\xef\xdc\xb7\x4f\xc7\xef\xf1\xea\x43\x24\x90\x93\x93\x1f\xbf\x6a\x85\xe6\xca\xb6\x0f\x5c\x98\x9e\x54\x3d\x37\x91\x5b\x36\x7b\xd8\x7e\x85\x61\x1e\xec\x4f\x02\xdd\x77\x41\x86\x95\xd0\x2a\x69\xdc\xfd\x63\x8a\xd0\x80\xe8\x73\x4a\x50\xb7\x95\xce\x53\xfb\x50\x1c\xd4\x98\x00\x47\xf5\xf1\x46\x49\x57\x57\x75\xd3\xcf\x2c\x19\x9e\xc6\x01\xe3\x59\x82\xef\x72\xeb\xd5\xef\x28\xd0\x6e\x3e\xd7\xaf\x87\x12\xdc\x5e\x18\x0e\x6a\x4b\x90\x77\x18\xe9\x75\xfb\x2c\x8a\x3e\x38\x83\x0e\xce\xa0\x68\x67\xd0\x68\x1f\x3a\x3e\x78\x82\xea\x9e\xa0\x56\x2f\xc4\x63\xdf\x83\xda\x06\xb2\x4f\xd2\x7a\x8b\x98\x7a\x4c\x28\x63\x63\x5c\x3d\x98\x56\x54\x6d\x21\xf3\xc2\xa6\xdb\xb6\x89\x0a\x6e\x0f\x36\xf6\x03\x63\xaf\xd2\xc7\xe3\x73\x8b\x2c\x56\x30\xdf\x1c\x0f\x86\x73\x5b\x34\xe0\x18\x3b\x35\xd0\x08\x31\x04\x10\xa9\xdc\x9f\x6c\x25\xda\xdf\x88\x62\xf1\xb4\xe1\x0a\x2d\xaf\xf5\x38\x29\x6f\x06\xa9\x0d\x53\x86\x8b\x69\x1f\xfe\x4a\xef\xbb\xb8\x87\x8f\x77\x71\x01\xf3\x22\x33\x3c\xcf\x42\x12\xb9\x8e\x4b\x74\x74\x51\x1f\xa6\xb5\x4c\x38\x0b\xd9\x58\xbe\x6e\x44\x9b\x30\x2c\xb6\x37\x76\xf7\x23\x2a\x47\xc7\x94\x82\xd0\xcd\x72\xea\x57\x82\x5d\xcd\xc5\x78\x49\xd9\x08\x57\x62\xe1\xda\x60\x90\x16\x2e\xfd\xc4\xce\x99\x0d\x0d\x36\x82\x5c\xc7\xe1\x77\x4b\xab\x20\x72\xf7\x61\xb8\x5a\x76\x45\x2d\x67\x7f\xeb\xd6\x44\xf5\x8b\xd4\x2b\xbb\x08\x4a\x0f\xbb\xee\x3c\xad\x82\x54\xc6\xd6\xa2\x95\xc2\x95\x9e\xde\xd6\xbd\xff\x95\x96\x6d\x75\x9c\xce\xf3\xa8\x73\xed\x54\x91\x58\x35\x24\x32\xc3\xb3\xba\xbe\x98\x0a\xf2\x2c\xea\xc7\x3e\xaa\xc7\x1e\x6a\x47\x7b\x95\xa3\x51\xdd\x28\xa9\x2f\x1a\x24\xec\xad\x62\xb4\x57\x2f\x5a\x49\x72\x5a\xbb\x38\xe1\x0f\x83\x4e\xeb\x91\x39\x17\x9b\x6a\x1c\x7d\x85\x22\xed\xd6\x19\x05\xd2\xfa\xdd\x91\x25\x33\xcb\x60\xb9\x58\x1d\xe0\xfe\xef\x99\xfd\xe4\xac\xa1\xfd\x99\xd2\x28\xd8\x68\x07\x8e\x74\xe0\x48\xbf\x2f\x47\xf2\xa4\x47\x4c\x26\x1a\x28\xfc\x01\xd9\xd1\x33\xd8\x01\x36\xff\x7e\xd0\x69\x31\xc0\xdd\xcb\x5a\xe6\xbe\x5d\x69\xf5\xd5\x11\x45\xf3\xa5\xd2\xac\xcf\x2c\x94\x66\xd2\xbe\xad\xef\xf7\x58\x31\x56\xca\xca\xa3\xc4\x74\x98\xf1\x29\x4d\x76\x46\xfb\xcb\x79\x0d\xaa\x11\xa6\xdb\xa0\x8a\xf2\x18\x89\x29\x79\x17\x32\x65\x40\xd0\x92\x54\x3c\x5d\x51\xee\xe3\xd0\xa4\xa3\x81\xa4\xba\xa3\x4a\xe9\xda\x61\x33\x14\x84\xba\x43\xb8\xc4\x3c\x93\xcb\xb9\x4f\xea\x4d\xc1\xee\x92\x3a\x29\xb2\x11\x9a\xe6\x20\x75\xf4\x42\xb2\x03\x3a\x2c\xb2\x6c\x28\x33\x9e\x2c\xdb\x4d\xed\x35\xbd\x0c\x79\x41\xa9\x84\xf6\xf5\x3e\xbc\xa5\x73\xab\x26\x70\x9e\xdd\xb3\xa5\x3e\x85\x1b\xaa\xf9\x69\xe6\x1a\xd7\x93\x1b\x69\x86\x2e\x85\xb9\xca\xe1\xa3\x71\x76\x80\xa8\xd2\x77\x40\x86\xa3\x36\x60\xd8\x34\xca\x78\x0a\xf1\xd7\x53\x9a\xa3\x7a\x03\x8e\xc9\xdd\x73\xbd\x8f\x35\x01\x9f\x4d\xbc\x2f\x6c\x4b\xe4\xcf\xb2\x63\xaf\x9f\x6c\x2a\x33\x3e\xc1\x64\x99\x6c\xda\xcb\x6f\xc7\x24\x52\x45\x28\x1d\xe0\x64\x6b\xe5\x89\xfb\xd5\xe8\x5c\x2f\xb5\xc1\xf9\xae\xed\xca\xea\x1f\x6b\xaa\x71\xda\xf0\x4e\xe7\x92\xce\x90\x32\xf5\x1d\x69\x4b\xec\x9c\x49\xac\xc3\xd8\x37\x82\x8d\x9f\x9b\x36\x82\x9f\xf6\x7c\x18\x91\x7d\xdd\xfc\xe8\x3a\xd5\x0f\xc3\xab\xc4\x5b\x68\x5f\x2a\x4a\xa7\x9c\xcf\x31\x25\x7b\x3a\x5b\x02\x9b\x98\xc8\xd8\x59\x7d\x67\x62\x82\x45\xe7\x47\x51\x21\xce\xb5\x33\x70\x66\x4c\xa4\x19\x2a\x98\x30\x9e\xe9\x38\xd1\xbb\xee\x63\x00\x43\x67\x74\x09\x02\xeb\xc3\xc3\xd6\xa9\x40\xdf\x92\x44\xda\x53\xde\xe2\xe0\x4a\xeb\xde\xf3\xaf\x57\xab\x9d\x56\x52\x9d\x62\x9c\x22\x14\x05\xb2\x42\x73\x9c\xc9\xe4\x8e\x0e\x0c\x33\x3c\xa3\xd7\x61\x26\xe5\x9d\xdd\xc7\x26\xb3\x6b\x2a\xde\xcd\xbc\xd7\xb2\x2c\xff\xec\x95\x34\xda\x23\x0c\xf4\xd9\x8b\xea\x96\xfd\xa1\x69\xa9\xb6\x25\x42\xba\xa8\x2a\x2e\xee\xc9\x35\x3a\x24\x4e\x4b\x53\x6a\x13\x18\xec\xf6\x45\x3e\x7b\x45\x92\x88\x8b\x9d\x58\xba\xfc\xde\xde\xe3\x5a\xd2\x4a\x1f\xae\x1e\x30\x29\xbf\x13\x7b\x40\x5b\x78\x2e\xe3\xf4\x14\x4f\x33\xc4\x12\x9a\x39\xea\x7e\x03\xd7\xa2\x3a\x71\xeb\x18\x5e\xb8\xb2\xa6\xb0\x73\x8a\x07\x07\x54\x57\xdf\x02\xa4\xed\xaa\xaf\x6e\xa4\x7d\xd6\x49\x17\x58\x59\x88\xd6\x33\x07\x5e\x63\x6f\x05\x38\xe5\x0a\x13\x23\xd5\xb2\xcc\x05\x0f\x38\x12\xce\x4a\x4a\x03\xc7\xdd\xb3\xee\x49\x67\x8f\x0c\xa5\x12\xbd\xae\x3b\xbc\xd1\x71\x7b\xe7\x53\xf5\xad\xb4\x03\xab\x41\xd3\x89\x82\xe4\xa3\xc4\xa4\x9b\x9e\x02\x37\x21\xbd\x9c\x2a\xe6\xdd\xc8\xb4\x02\xe9\x4b\x40\xe9\xe0\x44\xda\x3e\xb4\xdc\x59\xd5\xfe\x4a\x43\x6d\x0f\x4c\x28\x4f\xa1\x8b\xbd\x8e\xbb\x9f\xba\xa7\x80\x26\x39\x81\x7b\x29\xba\xc6\x4e\x4d\x1f\x6e\xdd\x06\x68\xa1\xd1\x56\x20\x69\xeb\x00\xe1\x4f\x7c\xc4\x07\x72\x73\x72\x4a\x9d\x21\xf1\x00\xb2\x30\xf4\x33\x49\xd8\x56\x30\x7d\xf9\xeb\xd5\x03\x37\x3e\x47\x91\x54\xab\x6f\x68\x4c\x8d\x13\x15\x50\x9e\xe7\x16\x77\x65\x7c\x81\x67\x33\x64\x99\x99\x91\x6f\x36\x05\x21\x45\xef\x9f\xa8\x24\xc1\x2c\x84\xbf\x13\xbb\x62\x5b\x78\x57\xf7\x52\x68\xf6\x33\x51\xf6\xb2\x7f\x2a\x03\xfc\x27\x34\x7b\xb1\xe3\x9f\x6f\x6f\x87\x3f\xa1\x59\x63\x99\x04\x31\xe4\x64\x45\x42\xb5\xdc\x24\x47\x45\x39\x2f\xcf\xc9\x3b\x29\x17\x76\x6f\xc6\xf9\x33\x6d\x99\x45\xc6\x9c\x57\xf2\x04\x79\x68\x8c\xdc\x33\x5d\xd4\xef\x5f\x91\xcb\x14\xae\x87\x7d\x7b\x80\x6b\xae\xe4\x98\x8d\xb3\xa5\xdd\x8f\xc3\x17\x61\xb6\x82\x79\x44\x28\x1e\x51\x36\x26\xcd\xc1\xcf\x48\x3b\x4c\xe9\x70\x0a\x46\xbf\xf3\xcc\xe4\x5a\x6b\x73\xef\x31\x76\x87\x96\xc2\xcc\xa3\x5e\x16\xa2\x46\x6b\x58\xe1\xf2\xf4\xd7\xb7\x34\x1a\x4a\xb3\x15\xe6\x8e\x85\x78\xf8\xcf\xbc\xe8\x57\xa9\xe7\xf6\x76\xe8\x46\xc7\xf7\x78\x8c\x94\xcf\xe9\xf6\xe0\x69\x05\x16\x3c\xfa\xb5\x8d\xb5\x69\x84\x6c\x47\x89\x84\x5a\xb9\xc1\xf6\x5b\x47\xfb\xb8\x10\x37\x8e\x0a\xc9\x5d\xdf\x1b\xeb\x79\x8b\x0b\xf6\x3e\x09\xb9\xd6\x42\x83\x4f\xdd\x89\x6d\x47\x57\x3d\x53\x2f\xda\xa7\x02\xb4\x08\xac\xc7\x1d\xca\xf5\x84\x02\x69\x5f\xc9\xb7\x7b\x6b\xb8\xc6\x49\xb4\xc9\xf0\xe4\x2c\x73\x27\xf7\xf8\xcd\xcc\x69\x51\xb5\x80\x48\x21\x00\x3a\xbb\xf3\xd9\x79\x2d\xc5\xdb\xdb\x74\xb5\x75\x62\xec\xfe\x69\xb1\x7b\x27\xc5\xae\x4c\x87\x0b\x7a\xaa\xda\x19\x08\x64\x4e\x50\xb7\x5b\x40\x84\xc7\x13\x5a\x1a\x00\x7d\xb8\x71\xa0\x5b\xba\xbc\xad\xd3\xdb\x5b\x13\x8a\xaa\xa6\xe1\x5b\x6a\xe5\x87\x3f\xff\xf9\xfb\x3f\xf7\xed\xd9\xce\x7b\x41\x64\x02\xae\xcf\x6f\xce\xff\x3e\xfa\xf5\xc2\x16\x20\xf7\x3b\x5f\x20\x93\xd5\x96\xd4\xe0\x60\xdf\x39\xb3\x27\x1f\x63\x7d\x4f\x65\xaf\x25\xb5\xa3\x83\x52\x37\x22\x6d\x6d\xd5\x33\x4a\xcb\xf1\x99\xd7\x56\x5b\x66\xda\x6b\x43\x94\xad\x99\xa1\x49\xf2\x11\xc5\x12\xa2\x17\xfb\xca\x84\x74\x6f\x2f\x86\xee\xf5\x9a\x8a\xce\x44\x5b\xa7\x06\x17\x0b\x99\x2d\xa8\x52\x9e\xc1\xed\xc5\xd0\x76\xb8\x6f\xff\xb2\x1e\x22\x6b\xec\x2e\xd1\x54\x35\x13\xd1\x90\x5d\x5a\x00\x19\xd0\xce\x95\x46\xf5\x2d\x8c\x8e\x5e\xe4\x89\x85\x5f\x3a\xa7\xa2\x21\x12\x46\xdd\xce\xf3\x69\x3e\x9f\x65\x41\x74\xdf\x86\xc0\xdd\x46\x63\xa2\x05\x58\x58\x31\x3c\xd6\x8c\x89\xee\xf3\xae\x90\x7f\x07\xe9\xe3\xa5\x8e\x3f\x54\xee\x20\x7d\x9e\x5f\xfa\xfc\x91\xf8\x6e\xab\xc7\x73\x85\x23\x23\xf3\x41\xa7\x25\x8d\x75\x87\xee\xc5\x2d\x11\x95\x31\x4e\x62\x23\x00\x6c\x6b\xf8\x23\xf5\x87\x02\x0b\x5b\x90\xd9\xc6\x27\x23\x57\x42\x1c\x36\x7c\x55\x1e\x61\x49\x1e\x35\x81\x5a\x9f\xd9\xc0\x48\x91\x93\x09\x39\x8e\x43\x95\xc2\x3a\x85\xc2\x53\x4a\x1a\xa2\xed\x37\xb8\x14\xb4\x2f\x8a\x4f\x2f\xf4\x5b\xb2\xdb\x1f\xd1\x24\x71\x54\x78\x5b\x8b\x1a\x79\xcf\x6b\x18\xce\xb5\x12\x49\x48\x14\xd3\xb3\x48\xf3\x98\xea\xdd\x1e\xa8\x78\xd1\x9a\x77\x0a\x99\xf6\xa7\x26\x84\xe1\xa5\x52\x64\x3a\x18\x92\xe9\x5d\xdb\x8c\x6f\x52\x6b\x5c\x88\xcb\x39\x9d\x87\x32\xed\x76\xab\x39\x23\xa0\x53\xc5\x12\x84\x1c\x15\x97\x71\x70\xed\xb1\x17\xa9\xbc\x17\x30\xc6\x29\x17\xda\xd3\x8e\x6d\x2c\x10\x19\xc9\x45\xdc\x71\x12\xe4\xea\x27\x6c\x5b\xd8\x87\x77\xe5\x9e\x35\x41\x07\x97\x85\x49\x64\xc5\x14\x7d\x6f\xf6\x09\xd5\x51\x32\xac\x8b\x8c\x16\x7e\xe7\x7d\x4f\xb8\xb1\x19\xcd\x01\x64\xc3\x20\x3e\x8e\xd9\x45\x41\x96\x93\x35\x7c\x1b\x62\x76\x71\x40\x55\xed\xfd\x6d\x08\x13\x4b\x50\x94\xd2\x16\x13\x9b\xff\xbc\xf8\xfc\x21\x10\x78\x08\x04\x1e\x02\x81\x87\x40\xe0\x21\x10\x78\x08\x04\x1e\x02\x81\x87\x40\xe0\x21\x10\x78\x08\x04\x1e\x02\x81\x87\x40\xe0\x21\x10\x78\x08\x04\x1e\x02\x81\x87\x40\xe0\x21\x10\x78\x08\x04\x1e\x02\x81\x87\x40\xe0\x21\x10\x78\x08\x04\x1e\x02\x81\x3b\x02\x81\xd1\x8f\x86\xe8\xd9\x90\x82\x66\x83\x4e\x0b\x62\xec\x0e\x6d\x38\x83\x27\xc4\xb0\xc6\x96\x1a\x4b\x3a\x2a\xc1\x36\xcf\x58\xb5\x4f\x64\xb5\x7f\x4b\xa8\x90\xf2\x21\x33\x07\x9f\x82\x75\x7b\xd5\xac\xb5\x8d\x47\x84\x82\x50\x7d\x96\x4b\xf7\x9f\x2a\x1a\x51\x0b\x43\x58\xac\x1a\xe3\x10\x6d\x98\x79\x6c\xfc\xa1\x5d\xec\xc1\xc7\x14\x22\xe0\x42\x6c\xdc\xa1\x4d\x3c\xa1\xad\x34\xf3\xee\xee\xb8\x87\xe3\x63\x08\x6d\x36\x9a\x7c\xfa\xf8\x41\x6c\xec\xa0\xcd\x1e\x80\x2b\x68\x6d\x2f\x20\x8a\x0d\xb2\xd2\x15\x11\x33\x68\xef\x92\x6f\x8c\x17\xd4\x62\x00\xd1\x40\x77\xc4\x0a\xb6\xf8\xff\xa3\x41\xaf\xc7\x09\x76\xf8\xfe\xa3\x61\x56\x31\x82\x27\xf6\xfb\xb7\x74\xff\xed\xa1\x84\xb4\xf3\x78\xb4\x10\x51\x65\xfa\xc5\xed\x4c\xa1\x9e\xc9\x2c\x6d\xcd\xfb\xde\x70\xc1\xe7\xc5\x9c\x56\xa7\xa6\x25\xcb\x17\x18\x80\xea\xb0\xd2\x22\x60\x82\x97\x60\xae\x82\x81\x80\xf1\x14\x15\xa6\x16\x16\x95\x78\x51\x39\x31\xcc\x98\xb5\x35\x74\x91\x24\x88\x69\x6c\x2c\xbc\x6e\x26\x7e\xdf\x2f\x31\x2e\x4f\x7e\xf9\x36\x06\x4c\xed\x14\xe0\xef\xbf\x8b\x78\xbe\x9d\x96\xda\x22\xd4\xf2\x1c\x61\x96\x76\x21\x96\xb6\xe2\xa4\x8d\x61\xf4\x4c\x61\x95\xc8\x90\x8a\x0f\x93\x44\x83\xfd\xbc\x70\x4a\x6b\x4e\xb0\x47\x18\x25\x3a\x84\x52\x86\x45\x22\x01\xc3\x93\x84\x4f\x5a\xf2\xce\x67\x09\x9b\x3c\x59\xc8\xa4\xed\xba\xd8\x37\x54\xb2\x3b\xc2\xd0\xda\x75\xbf\x87\x40\xda\x33\x3c\xf2\xd4\xa1\x91\xbd\x30\x6f\x6b\x4d\xb6\x0e\x87\xb4\x0d\x85\xb4\x92\xd7\xfb\x28\x04\xed\xc2\x1f\x91\xa1\x8f\x96\xe1\x8c\xd6\x53\xd5\xc6\x91\xd4\xca\x89\xb4\x9f\x03\x69\x2f\xe7\x51\x64\xd8\xa2\x1a\xe0\x48\xb8\xb0\xdb\x69\xb4\xee\x08\x8a\x86\xba\xd1\x61\xf4\x39\x4e\xa0\x27\x71\x00\xb5\x0b\x3d\x44\x87\x1d\xda\x58\xa3\x9f\x1f\x72\x68\x49\x3d\x6d\x98\x54\xb4\xbb\xab\x15\xa3\xe1\x82\x1b\xce\xb2\x4b\xcc\xd8\x72\x84\x89\x14\x69\x94\x54\x5b\x19\xfe\xae\x27\x4a\xda\xfe\xd8\x81\xf0\x7a\xfc\x0a\xed\x46\x40\x05\x98\x31\x0d\xc1\x25\xe5\x93\x99\x83\x7f\xcb\x8b\x69\x7b\x00\xb9\xc3\xda\x3c\x53\x96\xec\x93\x7a\xa5\x9e\xdf\xa8\x70\xa9\xc3\xfb\x4e\xde\xcf\xf2\x1e\xe4\x84\x4e\xc2\x3a\xe6\x22\xcc\xdf\x49\xcd\x54\x68\x67\xd9\x95\x4b\x87\x20\x7c\xfb\x4d\x00\xf8\xd8\x1c\x8b\x02\xf9\x07\x30\xd9\xac\x11\xaa\xf5\xd3\x5a\xce\x1e\xe8\xd3\x98\xce\x1e\x18\x6d\x62\x59\x37\x9f\xa3\x40\x3a\xb3\x7b\x95\xdf\x7d\x5b\x6d\x9d\xfa\xad\xc5\x2f\xac\xc0\x28\x88\xe4\x67\xf1\xe5\x21\x7f\x4c\x1b\xbc\x55\x40\xb5\x45\x30\xb5\x0a\x92\x46\xc0\x85\xd8\x40\xaa\x0b\x90\x46\x41\x8c\x08\xa2\x46\x07\x47\x7f\x37\xdb\xff\xa9\x02\xa2\x4f\x10\x0c\xfd\x37\xd7\x5d\x49\x77\xdd\x1a\xf4\x3c\xe8\xae\x3b\x74\xd7\x2f\xaf\xc5\xd5\xea\x89\x7e\xa2\xfa\xa7\xe1\x67\xa9\x04\x61\x49\x42\x5a\x28\x5f\xee\x56\x6a\x06\x61\x61\x45\xc0\x04\x1b\x1a\xb0\xfe\xa7\x80\x1f\xba\xf2\xac\x49\x41\x55\x5f\x45\x2e\x85\x97\x69\xde\x93\x1c\x37\x67\x94\x31\xba\x5e\x34\x45\x58\x6d\xc2\x36\x7e\x7b\x4a\x1f\xfd\xf4\x62\x59\x15\x42\x90\xf1\xe0\x69\x94\x38\x09\xe9\x9d\xda\x71\xd7\xda\x70\x47\xc1\xd6\x7c\x4a\x3b\xf2\x92\x68\xa4\x66\x0c\x9f\x63\x75\x4a\x46\xd5\x28\xb5\x30\x91\x2a\xe1\xe3\xc8\x18\xca\x8c\x65\xe5\xa1\x16\x0c\xee\x28\xa8\xeb\x9a\xea\xc3\x88\xb6\x13\xa7\x8d\x81\x9d\xe8\xcd\xa4\x88\x65\x24\x66\xc6\x44\x38\x4b\x0e\x13\x82\x9f\xd0\xf9\x34\x45\xee\xf0\x26\x85\x60\x29\x0b\x15\xf0\x8e\x9b\xb3\xeb\x49\x1d\x1b\x0a\x75\xf1\xec\x34\x0c\x6d\x57\xef\x26\xe0\xa8\x16\x42\x48\xbb\xd0\xa4\xcf\xd8\xba\x3f\xda\xf5\xf6\xb4\xde\x6e\xd8\xbc\x38\x0e\x22\x61\xe7\xf0\xcd\x95\x5c\xf0\x94\x4c\x92\x65\x49\x0e\xa4\x01\xf4\xdd\x21\x20\xf1\x09\x17\x63\xb4\x9b\xd0\x09\x9c\x32\xab\x07\x7a\x06\xef\xaa\x8f\x5c\x5b\x2e\x3e\x25\x52\x9e\xb0\xd8\xd2\x42\x4d\x75\x9e\xf5\x12\xe2\x05\x67\x16\xcf\x1a\x45\xc0\xb1\x90\x20\xad\x52\x51\x08\x6e\xe2\xe8\x8b\x3c\xc7\xb3\xc2\x00\x95\x98\x9e\x10\x92\x5c\xd3\x62\x63\x30\x46\x13\x4e\x6d\x0e\xe7\x70\x72\x15\x89\x2d\x0a\x36\xce\x68\x6d\x0d\x69\xe9\xdf\x6e\x9c\x78\x98\x20\x33\x85\x42\x98\x32\x13\x59\x4a\xb7\x41\xd5\xb4\xe1\x92\xfe\xe7\x93\x16\x6d\x17\x68\xfd\xc5\x13\x28\x84\x46\xd3\x52\x87\xfd\xe1\x4f\x4f\xaf\xc3\xf2\x39\xca\xc2\xec\xcb\xe0\xb7\x1a\xec\xf7\x33\x9e\xcc\xda\xda\x23\x7c\x8e\x9a\xa2\xb8\x6b\x56\x84\x07\x5d\x1a\x01\x51\x20\xeb\xb3\xf7\xaf\x6c\xb5\x47\x4b\xf7\x98\xb0\xc1\xca\xdc\xd6\x8f\x68\x28\x7b\x5d\xda\x2b\xee\xa4\x26\xb8\xbc\x19\xfd\xfd\xf5\xf9\x7f\x5d\xbd\x6e\x26\xe5\x2b\x3a\x60\xa3\x02\xc4\xe9\x68\x26\x12\x87\xd6\x67\x38\x63\x0b\xaa\x1c\x2c\x04\xff\x47\x81\x4e\x69\x3c\x2e\x61\x9f\x34\x03\x6f\xbd\x23\x79\xb4\x86\x4b\x8c\x4e\xb7\x1a\xb8\x70\x82\x97\x7d\x93\x28\xd8\x9d\xa5\x6a\x0f\x07\x5a\xd3\x64\x1b\xc0\x02\x5c\xd1\xab\xc4\xe3\x68\xac\x94\x81\x19\x12\x2f\xe3\x0b\x1f\xb8\xf5\x5b\x8d\xb3\xb4\x4c\xd5\x20\xe7\x94\x9a\xc7\x69\x14\x6c\x6c\xf7\x58\x9d\x21\x08\x34\xe4\xab\x2a\xbd\x9d\x94\xe1\x51\xdf\x13\xa2\xd0\x74\x94\xef\xb8\x68\x16\x4e\xb4\x9f\x81\xe2\x73\xa6\x78\xb6\xac\x23\x43\xaa\xc4\x8d\x0c\xf6\xee\xb2\xea\x52\x23\x44\xdb\xe5\xcb\xb7\x57\x23\xb8\x79\x7b\x4b\xbb\x3e\x50\xb9\xbf\xcb\xf2\xa0\xf7\xdd\xb0\x8e\x91\x20\xfa\x43\x6b\x9b\xc9\xe5\x5c\x2c\xdd\x78\x3a\x3e\xc5\xe9\xf0\x00\x6d\xd0\x6a\x6a\xde\xdc\xf0\x36\x20\x1c\x7d\xd3\xb7\x57\xf3\x09\xb6\x2c\x4d\x15\x05\x3d\xca\xa4\x9b\xe4\x51\x9a\x9c\xb3\x7c\xf8\x38\xc3\xb8\x83\x59\x6a\x73\xd3\x6f\x4f\xe3\x91\xc1\xd2\x15\xe2\x2d\x53\xfb\x86\x34\x3c\xb5\x33\xbc\x58\x49\x24\x91\x76\x87\x5d\xe0\x9a\x8b\x69\xd6\x8a\xe2\xdb\x39\x0c\x4a\xc0\xc3\x68\x13\x7a\xa5\xbb\x95\xfc\x0a\xc6\xa9\x5f\xac\x9e\x0a\xac\x52\x19\x05\x16\xe0\x7a\x18\x48\xc0\x6b\x38\xf3\xb5\xa3\x4e\x6d\x13\x6e\x3b\xba\xd8\x54\xac\x6f\xe0\x2f\xf0\x00\x7f\xb1\x41\x96\x1f\x9a\x07\x6f\x1f\x69\xd3\x4e\xde\x04\x1f\xcd\xf5\x70\x8f\xd1\xfe\x2b\x2d\x5a\x7a\x9b\xc6\x8a\xdc\x8f\xdc\x1b\x32\xf8\x40\xe7\x3d\xb3\x2c\x0a\x24\x84\xb9\x8a\x1b\x8f\x96\xbe\x0c\x42\xef\x99\x88\x89\x40\xc7\xe1\x6c\xed\x9c\x52\xd4\x7a\xd3\xe3\xc9\xc9\x89\x1a\xa1\xfc\x95\x1b\xbf\xb0\xb9\xae\xc4\x7b\x2c\xc4\x0a\xb3\x39\x33\xc9\xac\x4a\x0d\xa6\x31\x24\xd5\x4b\x9b\x6a\x91\xc6\x69\xc9\x00\xa9\xb4\x19\x8c\x64\xf5\xdb\xae\xff\x51\x08\x3f\x3e\xf5\x62\x85\x2e\x76\xcd\xe5\x9a\xb3\x29\x0a\x38\x05\xb0\xd3\xa0\x2b\x91\xcd\x5e\x39\x17\xfa\x4e\xcd\x22\x44\x3d\x81\x44\x67\xa7\xee\xd4\xc6\xbc\x8f\xac\x4d\x90\x21\x2c\x53\x5a\xf3\x09\xa3\x9d\x85\xdc\x49\x5f\x94\xa4\x47\x6b\x7f\x69\x33\x02\x78\x82\xfa\x59\xd6\x71\xae\xa4\x91\x89\x8c\x3c\xe1\xca\x4b\xfb\x01\x95\x0a\xb5\x9f\xe0\xa1\x6f\x8b\x8c\x35\xef\x89\x0f\x81\x8f\x5f\x2e\x87\xa7\x04\x34\x76\x3d\x49\x05\xa3\x8b\xdb\xe1\xaa\x09\x74\x74\x7b\x31\x3c\x7a\x86\x51\x8a\xf7\x37\xf6\x56\x25\x6d\xe7\x09\x9d\x8e\xf1\xb9\x29\x2b\x1e\x56\x52\xd8\x7a\x73\x96\xf7\xe8\x9c\xd9\x41\xe7\x29\xd1\xef\x95\xc4\xd3\x1a\x21\xd7\x99\x79\xc3\xb1\x8c\x0a\x59\xca\x9f\xa1\x82\xc3\x2f\xa8\x0a\x7e\x33\xcd\x5c\x3c\xd2\x51\x15\xce\xe5\x82\x12\x69\x49\xaf\x0e\x10\x51\xa4\xb9\xe4\x74\xa8\x16\x9f\x74\x22\x3d\xa0\xeb\xf5\x1f\x75\xcd\xf5\x8b\xda\xe4\x87\xfa\x8e\x43\x7d\xc7\xa1\xbe\xe3\x50\xdf\x71\xa8\xef\x38\xd4\x77\x1c\xea\x3b\x0e\xf5\x1d\x87\xfa\x8e\x43\x7d\xc7\xa1\xbe\xe3\x50\xdf\x71\xa8\xef\x38\xd4\x77\x1c\xea\x3b\x0e\xf5\x1d\x87\xfa\x8e\x43\x7d\xc7\xa1\xbe\xe3\x50\xdf\x71\xa8\xef\x38\xd4\x77\x1c\xea\x3b\x0e\xf5\x1d\x87\xfa\x8e\x43\x7d\xc7\xa1\xbe\xe3\x50\xdf\x71\xa8\xef\x38\xd4\x77\x1c\xea\x3b\x0e\xf5\x1d\x87\xfa\x8e\x43\x7d\xc7\x1f\xae\xbe\x23\x1c\x81\xd8\x38\xc9\xab\x13\x7c\x21\xe7\x39\xe5\x55\xbc\x0b\xaf\x97\x4a\x8a\xe3\x61\x5c\xd7\x14\xb3\x3d\xca\x30\xda\x4e\x4e\x22\xc5\x84\x4f\xbd\x80\x3e\x73\xe7\xed\xf5\xca\xbe\xf5\x4a\x5c\xf4\x59\xb7\xf3\x74\x96\x49\xc6\xe7\xbc\xb9\xca\xc3\x27\xf9\xfb\xac\x89\x61\x4b\xcb\xa7\x85\x62\xbf\x8f\x5a\xbf\x87\x52\x9f\x33\x43\x69\xd7\x03\xf8\xef\xe3\xbf\x7d\xfd\xa9\x77\xf2\xe3\xf1\xf1\xfb\x6f\x7a\xff\xf9\xe1\xeb\xe3\xbf\xf5\xed\x1f\xff\x71\xf2\xe3\xc9\xa7\xf0\xe5\xeb\x93\x93\xe3\xe3\xf7\xaf\xde\xfc\x74\x3b\xbc\xfa\xc0\x4f\x3e\xbd\x17\xc5\xfc\xce\x7d\xfb\x74\xfc\x1e\xaf\x3e\x44\x02\x39\x39\xf9\xf1\xab\xce\xef\xa4\x70\xaf\xd2\xfa\x6b\x3b\xcb\xfe\xc7\xb1\x8f\x8d\xcf\xd9\x83\xf5\x3c\xb0\x39\x9d\x81\x19\x85\x98\xcd\xb9\x73\xcb\xa6\xa4\x4c\x77\x2e\xd0\x17\x25\xf8\x56\xec\x22\x98\x23\xa8\x0f\x74\xff\xaf\x4e\xf7\xef\xfc\x3c\xaf\x53\x3e\x17\x4f\x42\xf9\x0a\xff\xf1\xff\xd8\xbb\xf6\xde\x46\x8e\xe4\xfe\xbf\x3e\x45\x63\xcf\x08\xa5\x1c\x49\xed\xfa\x0e\xc1\x9d\x10\xc4\x50\x76\xb5\xb6\x60\xaf\x2c\xac\x64\x3b\x86\xcf\xf1\x35\x67\x9a\x54\x47\xc3\x6e\x62\x7a\x86\x12\x03\x7f\xf8\xa0\xaa\x1f\x33\xc3\xd7\x54\x8f\x48\x7b\x1d\xcc\x32\xc8\x59\x12\xa7\xa6\x1f\x55\xd5\xd5\xf5\xf8\x15\x1e\x18\x58\x4f\x10\xde\x45\x74\xdc\xea\xb9\x2c\xc0\x7b\x05\xb7\x85\x5a\xc5\x14\x26\xba\xd5\xdd\x34\x56\x76\x49\x24\x25\x78\x23\x38\xe2\x20\x57\xa9\x66\x21\x23\x26\x1d\x32\xed\x6d\x7e\x6a\xc8\x87\xab\xca\x67\x86\x07\xd3\x28\x15\x53\xa9\x84\x8b\x51\xff\x71\x64\x9e\xfc\x55\x70\x40\xe7\xb2\x58\x41\x7a\xb0\x78\x6e\x75\x5e\x35\xf9\xed\xae\xf9\x30\xb8\xdb\x20\x1b\x19\x99\xce\x13\x66\x7a\x41\x6b\x3c\xd9\x70\x0d\xb9\xbc\x59\xb8\x1e\x41\xfa\x23\xd4\x3e\x20\xd7\x19\x51\xd8\x4b\x22\x5e\x35\xda\x89\xea\x29\x5b\x1f\xa4\xbf\xf2\x21\x19\x30\x81\x96\x3c\x83\x4b\x3c\x9d\xe2\xad\x4e\xd7\x88\x52\x19\xa3\xe0\xe6\xb1\xe2\x0a\x31\x02\x93\x34\xcc\xf9\xdc\x2f\x19\x1a\x3f\xe2\xb9\x38\xa8\xe5\x83\xc7\xd6\x6d\x2e\x97\x32\x13\x33\x71\x65\x12\x9e\x21\x87\x5f\x44\x6b\x99\xcb\x1d\x94\x50\xa8\x73\x9d\xb5\xaf\x21\x7c\x9e\x1e\x04\x48\x27\xd4\x06\x5b\xb7\x01\xd6\x8e\xcc\xb8\x54\x6c\x0e\x96\xe4\xc2\xbf\x00\x4e\x6f\x4e\xf3\xaa\xc0\xa1\xbf\xe0\x39\xec\xa6\xf7\x45\xd8\x2b\xea\x44\xeb\xcc\xe5\x01\x67\xab\xb8\x71\xba\x36\x07\x4a\xff\xa2\xc4\xd3\x2f\x30\x2a\xc3\xa6\x19\x9f\x05\xf7\x02\xa0\x03\xaf\x7b\x36\x49\x94\xc3\x10\x77\x2e\x28\x74\x68\xcf\x4b\xc1\x78\xf6\xc4\x57\xe4\x65\x5d\x1b\x0b\x93\xe6\x82\xbd\x39\x43\x98\x78\x6e\x58\x78\x4f\xca\x3e\xa7\xa5\xfc\x42\x04\xf6\xed\xe5\xed\x2f\x77\x3f\xde\xfd\x72\xf9\xee\xc3\xf5\x0d\x5d\x57\xc1\xba\x0b\xc2\xe6\x25\x7c\xc1\x27\x32\x93\x54\x63\xa3\xc1\x90\xe0\x29\xa9\x13\x80\x53\x84\xa7\xe9\x79\x9a\xeb\x85\x05\x4d\x71\xbe\x3b\x02\xe1\x5a\xa1\xa9\x69\xde\x5e\xeb\xa5\xc2\xb8\xe5\xd3\xc6\x4b\x49\xb4\x67\x39\x57\x45\xe5\x34\xaa\xb6\x28\x87\x46\xdf\x73\x92\x5f\x23\x46\xe6\x9d\x3d\x47\xfb\xe2\xda\xaa\x5e\xa6\x00\x5f\x12\x3d\xc5\x97\x65\xf6\xbd\xf5\xaf\x5b\x55\x15\xc9\xec\xf6\xdb\xbb\xeb\xff\xea\x32\x12\xcf\x87\xe4\xaf\x47\x1b\x98\xf1\x09\x59\xc0\x94\x9d\xf6\xe3\xa3\xab\xd6\xe9\x77\xe4\xa0\x3b\x12\x61\x53\xb1\xea\x4c\x8a\x8f\xf9\x7f\x2c\x55\xa5\x5a\x20\x1e\x51\xd1\x62\x73\x9d\x8a\x31\xbb\xf5\x5e\x7f\x02\x69\xb6\x46\x21\x50\xb6\x90\x50\x10\xb1\x50\x90\xc4\x93\xad\x6a\x16\x0e\x89\x6e\xa1\x6d\x45\x8c\x56\x3b\x72\x9f\xa6\x3c\x33\x62\x7c\xf0\x13\x00\x0e\xc3\x0f\x70\x31\x8f\x5e\xd9\xf0\x24\x4b\x85\xd2\x85\x33\x3f\x61\x5f\xc1\x56\x83\xbf\x12\x28\x32\x86\x77\xa3\x7a\xc2\x58\x43\x43\xa3\x21\x51\x1d\x00\xc4\x3b\x8f\x5b\xb7\xdb\x30\x42\x8b\x42\x01\x20\x1b\xdb\x0f\x00\x12\x55\x37\x08\x5b\x64\x01\x15\x80\x58\x7d\x06\x99\x9e\x90\x7b\x90\xb2\x39\x37\x8f\x22\x45\x68\x6f\x62\x2c\x06\x6d\x24\xef\xb2\xc7\x91\x85\x21\xdf\xc3\x3a\x7a\xdf\x3b\xda\x3d\xc4\xab\xd4\x04\x6a\x09\xf9\x24\xa3\x65\x64\x45\xc9\x39\xcc\xf9\x5b\x95\xad\x3e\x6a\x5d\xbc\x0f\x85\x57\xd1\x8c\xf3\x83\xb3\x40\x9b\xbe\x4f\x04\x14\xc6\x74\x89\x74\x04\x0b\x4b\xa0\xca\xac\xc8\xd4\x6b\xc0\xdc\xce\xc3\xd5\xf4\x48\x02\x93\x97\xea\xd2\x7c\x99\xeb\x72\x11\x3d\x71\x30\x98\xbe\xbc\x7e\x07\xcc\x0e\x46\x21\xec\xb7\x50\x45\xbe\xc2\xc2\x4f\x10\x1a\xaa\xbb\xbf\x5a\xb4\x60\xcb\x7e\xe7\x62\xa5\xc0\xcd\x95\xb8\x44\x84\x49\x18\xfb\xc0\x57\x8c\x67\x46\x7b\xe3\x5a\xaa\xad\x37\x2e\x77\x1d\x24\x91\x94\x8a\x4d\x74\xf1\xb0\x71\x17\x04\x71\xd9\xa4\x3d\x8c\x0c\x06\x06\x8f\x03\x68\xe5\xf5\x57\x40\x2b\x2e\xc8\x54\x14\x89\x48\x85\x4a\xc4\x38\x2e\xce\x70\x84\x50\x11\x72\xce\x8d\x56\x20\x3e\xd1\xbc\x73\xed\xe3\x90\x70\x2b\x2b\xd6\xb4\xd8\x1c\x92\xc4\xf2\xb2\x9d\x79\xe1\x83\x62\x06\xb1\x4f\x14\x9e\xd2\x40\xd0\x13\x82\xc2\x79\x89\x21\x5a\xc1\xbe\x2e\x27\x22\x13\x05\x5e\xb4\x48\x14\x11\x2d\x03\x20\x23\x61\x54\x72\xce\x67\x82\xf1\x22\x30\x23\x14\x24\x2a\x03\x6a\x0c\x47\x2e\xdb\x8f\x7b\xf8\xa4\x5a\x54\x55\x98\xdc\xb0\xef\xae\xdf\xb1\xd7\xec\x14\xc6\x7c\x86\xda\x16\xf2\xe5\x40\x92\x30\xc3\x8d\x44\xb2\xb9\x66\x72\x8a\x1e\x30\x2d\x0c\x4e\x1f\xe5\x04\xf2\x6d\x50\x6d\x0c\x99\xa2\x69\x5b\x53\x26\x0f\x7e\xfe\x70\x15\xf7\x97\x53\x97\xb1\x89\xee\xea\x4a\xac\x68\x24\x5b\x45\x6f\x97\x58\x91\xc8\xef\x10\xbd\x1d\x62\x45\x22\x79\x08\xd1\xeb\xa0\x84\xbf\x33\x22\xef\xa4\x83\xbf\x3b\x92\x0e\x76\x87\x8f\x71\xd6\x4c\x5e\xad\x24\x89\xa2\x54\x4e\x78\xe6\xa2\xe0\x29\x2f\xb8\xd3\xdf\x9e\x48\xcf\x4a\x34\x56\x3a\xae\x16\x37\xe2\x1b\xa9\xca\x67\x9b\x2c\x69\x3a\xf1\xdf\xdd\x15\x92\x60\xce\xdd\xe8\xf2\x88\xf9\x62\x91\xc1\x59\x56\xe8\x78\x6d\xe6\x55\x98\x5f\xee\xe1\xda\x01\xe1\x74\x31\x89\x30\xaa\x30\xf0\x5a\x02\xac\x29\x74\x79\xe6\x2a\xd5\xf3\x8d\x41\x83\x11\x2c\x78\xf2\x40\xa2\x59\x1b\x68\x9d\x89\x5b\x18\x94\x44\x7a\x0f\x13\xef\x62\x50\x12\xdd\xed\x4c\x7c\x10\x06\x8d\x75\x25\x65\x62\x29\x88\x30\x3b\x6b\xdc\xf6\x0d\x3c\x09\xc6\xb0\xdf\x3d\x24\xc5\x32\x3e\x11\x19\x1a\x12\x44\xa2\xcc\x71\x67\xf0\xc8\x55\x1b\x7a\x72\x24\x87\x42\xae\xb3\x6e\x25\x48\x1f\x75\x86\x79\x2b\x3c\x4c\x1a\x48\xfd\x21\xe6\x8c\x0f\x74\x99\xf3\xfd\x6a\xb1\x36\x67\x20\xf5\x87\x98\x73\x49\x3c\xc5\x37\xe6\x0c\xc7\x7f\x73\xce\x40\xea\x0f\x30\xe7\x28\xd7\x97\x11\x49\xa2\xe7\x8b\xdb\x5c\x4f\x25\x4d\x20\x9a\x8c\x61\x23\x82\x40\xc2\x07\x04\xbd\xaf\xc5\x65\x1f\xc5\xea\xef\xeb\xe9\x06\x41\x9e\xd7\x12\x33\x89\xeb\x8e\x46\x87\xcf\xe0\xfc\x97\xea\x05\x56\x43\xad\x9d\x5f\x24\x8a\x7e\x34\x8d\x38\x23\x50\x77\x7f\x38\x8a\x66\xd6\x09\xcf\xa0\xe2\x22\x62\x7f\x36\xf6\x68\x9d\x48\x95\x73\x6a\x83\x74\xf0\x3b\x22\x5d\xe6\x42\xc1\x78\x54\x41\x5e\x68\x16\xb0\x11\x95\x4e\x45\x2d\xba\x5b\x92\x60\x5c\xfd\x3f\xb0\x5a\xdc\x48\x42\x9a\x3f\x18\x65\x3e\xa0\x9a\xd6\xdf\x42\xa6\x5a\x68\x07\x66\xe3\x8b\x9c\x38\x6a\x35\xa1\x52\x48\x4a\x05\x3f\xda\x90\xe5\x02\x62\x72\xcb\x28\xa2\x30\x10\x48\x81\xcb\x44\x31\x30\xac\x36\x48\xcf\xb8\xb1\x8b\x0a\x3b\x04\x3c\xe4\x46\x0a\xee\xa9\x60\xbd\x4c\xf1\x68\x03\x45\xf4\xea\x1b\xbf\x91\xaf\x8e\xa4\x39\xdc\x03\x5d\x98\xec\x15\x3c\x59\xe3\x2c\xeb\x11\x7d\x04\x7c\x50\x1d\x24\x9a\x48\x98\x05\x5e\xf0\xd7\x5d\x67\xbb\x62\x16\xb6\x0c\x12\x07\x3e\x71\xaa\x44\x30\xf6\x0f\xc5\xc2\x02\xb2\x51\xc5\xfa\x9b\x2c\x4d\x26\xb9\x87\xf5\xd9\x47\x6b\x12\xbb\x3b\x1b\x99\xe4\x68\xbb\x65\x1d\x5c\x6e\x7e\xd0\x11\xf8\x63\xce\x6b\x6b\x87\xf5\x9d\x42\x7e\x05\x11\x1e\x31\xa5\xc3\x22\x84\xe1\x93\x49\xfa\x3d\x79\x75\x1c\x5e\xf4\x59\xac\x94\x0d\x1e\x51\x83\x4f\x51\x47\xe3\x93\x54\xa9\x7e\x32\x2f\xb9\x89\xfd\x60\x49\x78\x13\x3f\x81\x0b\x09\x94\xaa\x1b\xcf\xd1\x04\xa2\xa8\x72\xa0\x3d\x7a\x60\x0a\xb3\xfd\x3a\xe6\xa4\x82\x44\x32\x40\x99\x6f\x5e\x5f\x82\xd0\x59\x86\xf9\xff\xeb\x8d\x8d\x3d\x8c\x67\x73\xc3\xdf\xe6\x40\xbf\x90\x3c\xbb\x5b\x88\xa4\x93\xa6\xfc\xf2\xc3\xdd\x65\x93\x0c\x28\xf7\x27\x44\x49\xa7\x3a\x87\xe0\x03\x74\x18\x4f\xe7\xd2\x18\xf4\x0c\x8a\x09\x94\x7c\xb2\x53\x9f\xa4\x3d\x93\xc5\x43\x39\x19\x27\x7a\x5e\xcb\x58\x1a\x19\x39\x33\xe7\x8e\xb3\x47\x30\x23\x3a\x3c\x9c\x54\x59\xc8\x02\x03\x5e\x44\x54\x71\x57\x39\x88\x83\x49\xc2\xac\xc8\x34\x81\x85\xb1\x12\x34\x24\x4f\x6c\x2e\x0f\xe2\x6c\x60\x16\xd7\xd1\x8e\xbd\xcd\xad\xbd\x89\x00\xf1\x69\xd9\x5e\x20\xe5\x2b\xd7\xa2\x70\x67\xb6\xaf\x2d\x0a\x82\x33\xb2\x8f\xb6\x20\xce\x5c\x04\xbf\x63\xa7\x55\xf8\xaa\x7a\x9e\xa5\xc2\xd6\x7c\x09\xc0\x1e\xad\xe7\x88\x12\x09\xb3\xda\xd9\xea\xbc\xf5\x9c\x0d\xe0\x0d\x3e\xb6\x3e\xa8\x68\x52\x57\xc4\x05\x47\x91\xad\x60\x73\x78\xb6\x78\xe0\x23\xbc\x1c\xa0\xbe\x42\x25\xe8\x0c\x31\x32\xc9\x07\xad\xb4\x2b\xbc\x00\x73\x47\x2b\x14\x11\xf0\x83\xb8\x98\x29\x2b\xaa\x43\xa1\xb6\x44\x01\xc3\x95\x16\x7e\x86\x4f\x3d\x74\x8b\x3d\x9e\xe0\x58\xc1\x0b\x97\xab\xd6\x82\xd4\x4a\xd7\x1d\xa2\x13\x51\x7b\x0c\xe4\xc2\x60\xb8\x53\x31\x91\xe7\x3a\x47\x3d\xa5\x42\x40\x82\xc8\x4c\x5e\x8f\xdf\x02\xbc\xf5\x25\xac\xeb\x94\x71\x38\x11\x06\xb5\xf8\xac\xa9\x90\xab\xa3\x88\x1a\x90\x2e\x31\x9d\x02\x06\xcc\x52\x34\x18\xcf\xba\xd7\x4e\x25\x39\xae\x0f\x1f\x88\x09\x81\x77\xf2\x09\x5d\xa5\x1e\x49\x7b\x2e\x9f\x61\xd4\x75\xea\xd5\xc8\xc9\xb4\x3d\x32\xe5\x76\x32\x67\xe0\x94\x54\x21\x51\x9f\x76\x3e\xba\x54\xc6\x3a\x45\x9f\x5d\x58\x40\x8a\x5c\x1d\x1e\x9e\x5c\xbc\x08\xff\x57\x77\xa1\xc2\x9d\x27\x2f\x23\xb5\x0d\x35\xd6\xd2\x88\xb7\x74\x56\xbb\x60\x6d\x79\x02\x60\x20\x78\xcb\xcb\xc6\x61\x88\x14\xd9\xf6\x78\xcd\xbe\x58\x0c\x9d\xb2\x4d\x35\x6c\xc6\x6d\xb6\xc6\x64\xc8\x24\x77\xc5\x6e\xf6\xb8\xbd\xc9\xb4\xaf\xa7\x47\xf3\x7c\xc7\x9a\x75\x64\xa2\x71\xe6\xdf\x71\x3d\x6b\xe4\xaf\x3a\x04\x95\x0e\x40\xe2\x77\xb5\x27\x99\xdc\x8c\xdf\xdf\xea\x14\x92\x5e\xa8\xb8\x37\xb6\x54\xdf\xa1\x44\xc9\xff\xf5\xb6\xbf\xdf\x1e\x08\x59\xdb\xc2\x0b\x2a\x8a\x22\xb8\xed\x1c\xea\x33\x34\x3b\x28\x20\x9e\x0e\xe7\x13\x1c\x8d\x19\x14\x0f\x36\xde\x3c\xa6\x04\x64\xf0\x7c\xab\x21\x93\x0f\xc3\x44\xfd\xa5\x25\x17\x0e\x54\x6a\xc8\xfe\x87\xa2\xee\xb8\xf1\x99\xe0\x1e\xfb\xe6\x36\x90\x87\x25\x40\x2b\xc1\x75\x3f\x28\x0d\x2d\x7c\xe6\x7c\x95\x2c\x95\xd3\xa9\xf0\x59\xeb\xe0\xd1\xe2\x39\x9f\x83\x31\x64\x98\xdb\xa2\x89\x98\x49\x5a\x0a\x73\x38\x32\x07\xa6\x02\x79\x19\xda\xe3\x58\x16\x6c\x2e\x67\x0f\xf6\x16\xc4\x38\x42\x00\x30\x52\x3c\xae\xd0\x0c\x40\xd9\x19\x6a\x1e\x9d\xb3\x27\x9e\xcf\xc1\x42\xe3\xc9\x03\x26\x6b\x70\xc5\xd2\x12\x84\x83\x21\x0e\xea\x6a\x64\x0a\x5e\xb4\x93\x85\x7b\x95\x73\x68\xf9\xf5\x7b\x61\x55\xe9\x41\x4b\x7e\x7b\xf8\xf8\x1e\x3e\xbe\x87\x8f\xef\xe1\xe3\x7b\xf8\xf8\x1e\x3e\xbe\x87\x8f\xef\xe1\xe3\x7b\xf8\xf8\x1e\x3e\xbe\x87\x8f\xef\xe1\xe3\x7b\xf8\xf8\x1e\x3e\xbe\x87\x8f\xef\xe1\xe3\x7b\xf8\xf8\x1e\x3e\xbe\x87\x8f\xef\xe1\xe3\x7b\xf8\xf8\x1e\x3e\xbe\x87\x8f\xef\xe1\xe3\x7b\xf8\xf8\x1e\x3e\xbe\x87\x8f\xef\xe1\xe3\x7b\xf8\xf8\x1e\x3e\xbe\x87\x8f\xef\xe1\xe3\x7b\xf8\xf8\x1e\x3e\xfe\x93\x83\x8f\x37\x45\x2a\xd5\xc5\x49\xc4\xe6\xee\xc0\x49\x72\xc9\xd6\xbe\x42\xbf\x85\x22\xdc\x4f\x26\x25\xe4\x39\x01\x27\xdb\x51\xf8\xc3\x70\xa3\x8a\x69\xec\xd5\x7b\x2b\x51\x97\xe3\x80\xb0\xb0\x00\xd9\x64\x6c\xc5\xca\x0e\xf2\x24\x24\x19\x0b\xb6\x59\xcb\x68\xbe\xfa\xf6\x7d\x3c\xa0\x13\x3d\xb7\x15\xc7\xfa\xad\x4a\x44\xc7\x4d\xd9\xb2\x7e\x3e\x11\x3e\xc9\xb4\x69\xdf\x18\x20\x81\x83\x60\xc9\x03\x57\x4a\xf8\x5b\xbf\x84\x2c\x6b\xc3\x26\x42\x28\xa6\x17\x02\x4a\xc1\x26\x2b\xc6\x99\x91\x6a\x46\x28\x7e\xe3\x45\xc1\x93\x87\x31\xfb\x01\x52\xcf\xdc\x86\x54\x19\xc7\xee\x37\x06\xb2\x2b\xe6\x3e\x87\x7c\xce\x09\x39\x34\x30\x14\xc6\x93\x5c\x1b\x48\x05\xcf\x0a\xb9\xc8\x00\xe7\x07\x5e\xc6\x8c\xc0\x22\x17\x83\x0c\x14\x16\xb6\x95\xa4\x34\xf5\xf4\xe5\x61\x35\x5a\x37\x6d\xad\xea\x7c\x0f\x79\x83\xed\x29\x34\x00\x4a\x3d\x5f\x14\xab\x90\xd1\x08\x98\xc5\xb9\x29\x58\x92\x49\x34\xfa\x70\x79\x20\xf0\x0e\xd8\x41\xa9\x54\xed\x24\x9d\xb9\xa7\xdc\x4a\xd9\xe1\xa1\x11\x08\x37\xa9\x45\x61\x6c\x7e\x5e\xf5\x42\xfb\xaa\x56\xba\xa9\x34\xee\x76\x6c\x86\x90\x71\xe8\x94\x36\x32\x92\x5f\x09\x64\x25\x7f\x38\xc3\x3e\xb5\x9f\x74\xee\x91\xda\x70\xc2\x1a\x4a\x53\x25\x60\x06\x61\xc7\x1a\x85\x56\xaa\x5e\x00\x87\xf5\x0a\x91\x9a\xcd\x8b\x69\x3f\xeb\xaa\xa0\x95\x2a\x32\xa0\x12\x4b\xa8\xa4\x14\x89\x90\x4b\xc1\xf8\x0e\xc9\x3f\x98\xe0\xd7\xec\x88\x0f\xc2\x18\x3e\x13\xb7\x84\x70\xdb\x2e\x67\x07\x3c\x5b\xdb\x3c\xc8\x22\x84\xfa\x4f\xa8\x6d\x86\xed\x3c\x89\x4b\x49\x6b\x5a\xd0\x6c\x6e\xc7\x17\x6c\xe2\xa7\x1c\xc0\xdc\xdb\x97\x55\x1a\x8b\xab\x08\xb6\x8e\x2a\xf4\xe6\x4b\xea\x89\x6f\x6e\x11\x5a\x89\xba\x97\xc3\x86\xc0\xd9\xa8\x00\xa7\xd6\x06\xe1\x27\xb9\x14\x53\x36\x95\x60\x9e\x42\x06\x6a\x69\x86\xe0\x19\x6d\x9f\x3c\x94\x0a\x41\xc1\x90\x01\x17\x95\x56\xfe\xfa\xe8\xe7\x3d\x66\x3f\xb8\x89\x17\x79\xa9\xe0\xb4\x6b\xbf\xae\x3a\xcb\x1e\x0a\xd1\xc1\xce\x9b\xe5\x90\xe7\x00\xfa\x9a\x2b\xf6\xd7\xd7\x7f\xff\x37\x36\x59\x81\xd5\x81\x71\xf8\x42\x17\x84\x5a\x38\xbf\x09\x99\x50\x33\xd8\x6b\xab\x02\x9b\x15\x9f\x61\x87\xb0\xd1\x09\x61\x98\x85\x66\x6f\x3e\x7f\x9c\x04\x36\x47\x7d\x74\x9e\x8a\xe5\x79\x6d\xff\x47\x99\x9e\x8d\x5d\xcf\x97\x56\x8a\xb5\xec\xdd\xc1\xc9\x81\x1c\x64\x5b\x44\x45\x67\x32\x59\x45\x09\x8b\x87\xae\x63\x0f\xfa\x09\xf7\xa6\x46\xd5\xef\x34\x35\xe1\x16\xb2\xb4\xf5\xa2\xcc\x70\x9a\xec\x7d\xa8\x41\x87\x70\xe1\x46\xdd\xe3\xe6\xe0\x79\x41\x10\x48\x1d\x5e\xd1\x94\x1b\x9f\xc8\xe8\x87\xac\x5d\xed\x85\x73\xdb\xb7\x12\xf6\xa8\x75\x65\x2e\xc6\xec\x3d\xcf\xb2\x09\x4f\x1e\xef\xf5\x37\x7a\x66\xbe\x55\x57\x50\xbe\xd5\x9c\x4b\xc6\x09\x97\xd1\xe4\xa1\x54\x8f\x30\xdb\x6a\x98\x99\x9e\x81\x15\xbf\x28\x0b\x9f\x3a\xbf\x65\xc1\x5b\x09\x83\x86\xa8\x0e\x53\xef\xf1\xa8\xde\x22\x9e\x65\xe5\xb2\x70\xe5\x67\xed\xf7\x29\x90\xba\xfa\xf8\x20\x59\x1e\x05\x06\xd8\xff\xf3\xd7\x7f\xfd\x9b\x15\x4f\xf0\x5c\xfe\xed\x35\x26\x21\x9b\xf6\xf3\x19\x55\x2f\x9e\x21\x60\x50\xcc\x79\x96\x89\xbc\x29\x58\xc0\x28\x5e\x90\x28\x05\x83\x5e\x90\x0e\x26\x47\xc5\xaa\xa3\x95\xf9\x42\xd3\xff\xfe\xfe\x47\xb4\xfb\x65\x61\x44\x36\x1d\xda\x0a\x24\x7f\xcb\x67\x03\x34\x80\x06\x4e\x8b\xd3\x4a\xc8\xd6\x8f\xe5\xf1\xc1\xce\xe5\xa5\xce\x4a\x00\x64\x58\xca\xd8\x26\x5b\x8d\x27\xbd\x17\x31\x93\x00\xd5\x31\x65\x93\x4c\x27\x8f\x2c\xb5\x7f\x6c\xa1\xca\xdc\x52\x94\xa6\xf2\x11\x85\xe5\x1f\x9f\x1c\x24\x37\x6e\xe7\xc8\xdd\x9c\x6c\x56\xdc\x9c\x2f\x16\xe0\x5b\x81\xba\x92\x56\x92\x8c\xe5\xfc\xa9\x31\x51\x74\x25\x22\x06\x0c\x79\xf8\xb1\xb1\x22\xbb\xa2\x14\xd3\x69\xcb\xbc\xab\x87\xfd\x76\x41\xca\x93\xcf\x97\xd7\xed\x95\x46\x6e\xb7\x1a\x2a\x29\x54\x56\x85\x35\xb0\xc6\x03\xac\xa5\x48\xa9\x24\x75\xfb\x42\x45\xc9\x7e\x6c\xd6\x60\x63\x9d\x54\x88\x8c\xcc\x79\xe1\xec\x4b\x1f\xd2\xe1\x90\x03\x6c\xa4\x29\x84\x2a\xbe\x47\x3e\x7a\x9b\x71\x49\x73\xcf\x30\x7f\x49\xa7\x46\x00\xa2\xa6\x4b\x8f\xa3\x8c\x6a\x6c\x74\x72\xa0\xbc\xbe\x88\x80\x0b\x35\x2f\xcf\x2a\x18\x04\xe6\x6e\x95\x8d\xc6\xfe\x41\xe9\x9b\x7d\x18\x0d\x3c\x34\xca\xb7\x99\xe4\xed\xb7\xba\xba\xc5\xbe\xd9\x05\xf0\x08\xaa\xe9\xfb\x6a\xce\x4d\xcd\x04\x73\x88\x50\x4d\x96\xce\x6f\xa0\x90\x70\x71\x3b\xea\x23\x78\xcc\x0f\xb1\xb1\x33\xe1\x6a\x77\x12\x51\x38\x6b\x67\xec\x4e\xeb\x89\xf0\x77\xb1\x31\xb3\xf9\x18\x90\x1b\xe0\x5e\x40\xa4\x3a\xb8\x18\xb4\xaf\x56\xb4\x98\xfa\x25\xcb\xf5\x82\xcf\xc8\x4d\x89\xd6\x56\x6e\x9d\x44\x1d\x4e\x02\x0c\x7e\xfc\x7b\x3b\x7b\x3b\x83\x25\xc7\x18\x0d\xd2\x12\x69\x85\x82\x03\x59\x09\x2e\x99\xc0\x6f\x8b\x22\x2b\x74\x80\xde\xc1\xba\xd8\x27\x28\xc1\xce\x75\x09\xbe\x6c\xf4\x8e\x05\x17\xe6\x87\xb5\x49\xdc\x68\x25\xa8\x7a\xd4\xb8\x48\x55\x13\xaf\x02\x03\x2b\x52\xb1\x37\xe3\x37\xaf\x3f\xa5\x03\x05\x47\xb9\x76\xa0\xdc\x84\x03\xc5\xca\xea\x51\xc6\xeb\x9b\x07\x74\x18\xf3\x07\xe7\xca\x08\xbd\x01\xf0\x5e\x83\x78\xe5\xf8\x2b\x70\x4c\x50\xb7\xab\x6a\x4d\x77\x8a\x5e\x25\xb8\x69\xd4\xea\xf4\xcf\x1a\xf7\x06\x22\x4d\x92\x1d\x1c\x6b\x0d\x3b\x27\x75\x39\x39\x90\x46\x73\x4a\x09\x7d\x73\xb1\x0a\x2d\x88\xdd\xc0\xec\x51\x6e\x7e\xe1\xa8\x84\x35\x7b\xf5\x8a\x9d\x5a\x7a\x03\x5b\xd1\x79\x76\x14\xd6\x73\x8b\x78\xf5\xbc\xc8\x3b\x2c\xe4\xd5\xf3\x82\xa3\xaf\x6b\xb1\x7b\x45\x49\x54\xdd\x45\x95\xbe\xa2\x44\xaa\xff\x29\x00\x04\xc5\x40\x25\xaa\xcc\x78\x9e\xad\x60\x69\xef\xec\x9c\xd9\xa4\x2c\x98\x50\x4b\x99\x6b\x35\xa7\xf6\xa2\x81\xe8\x5d\x2e\xa1\x87\x08\xcb\x05\x56\xc8\xc3\xb5\xea\xb3\xd3\xef\x2f\x3f\x62\x46\xce\x19\x26\x38\x08\xb7\x2e\x44\x92\x25\x84\x2f\x36\xe6\x5e\x1b\xda\x81\x18\xc8\xcf\x1c\x76\x1b\x4e\x09\xff\x33\x91\x28\xcc\x6c\x5e\x16\xa5\xed\xe1\xf3\x9c\x64\xa5\x91\x4b\x31\xfe\x7d\x6d\xe4\x60\xd9\x7c\xc2\x26\xb2\x2b\xef\x7e\x27\x5b\x65\xac\x21\x5d\x01\x5a\x69\x60\x7c\x85\x78\x55\xf9\x8d\x21\x0a\xa5\xab\x2c\xcd\xb4\xdd\x19\xb4\x35\x30\x37\x30\x3e\xa7\x30\x5c\x0a\xe1\xd0\x1e\x12\x4d\x3b\x8b\xde\x30\x11\x75\x2c\x51\xa9\xd6\x5e\x85\x38\x31\xde\x36\x8f\xf1\xd6\x9e\x1c\x84\xab\x28\xfc\xd4\xca\x1d\x24\xbe\x68\xe7\x88\x54\x99\x36\x3f\x71\x83\x09\xde\xdd\xdc\xd9\xef\x9f\xbc\x60\x11\xc4\x02\x6a\x47\x72\x9e\x55\x68\x5d\xc4\xd7\x5f\x6d\x3e\x79\xd2\xf9\x26\xd5\xa0\x7c\xa9\xb6\x10\x07\xf3\xb0\x76\x0d\x82\xd0\x04\x5c\xef\x57\x27\x2d\xfc\xc2\xb1\x33\x60\x21\xe6\x0b\x9d\xf3\x5c\x5a\x3d\xcf\x15\x13\xcf\x90\x20\x0c\x88\xb9\xda\xb6\xf9\x05\x0c\xa3\x51\xa8\xac\xd8\x4b\x96\x03\x2a\x17\xf6\xf6\xc3\x80\x0d\xe3\x20\x28\x93\x72\x36\x93\x6a\x36\xae\xc6\x5e\x8d\xd6\xb4\x23\x80\x29\x1d\x7a\x17\x83\x75\x65\x92\x07\x91\x96\x19\x68\xff\x59\xc9\xb1\x13\xa3\x80\x78\xa7\xf5\x28\xaf\xac\x40\xb6\x89\x4c\x1d\x39\x26\x64\xdc\x01\xea\x82\x2c\xe0\x1d\xf8\x1b\x8e\x0b\x80\x21\x4e\xdb\xb2\x4f\xef\x4f\xde\x68\x46\x42\x61\x21\x37\xa7\xcb\x12\x8e\x5d\xc3\x2c\x6d\x04\x47\x80\x4a\xf6\xbd\x74\xa1\x1b\x6a\x58\x00\xe7\xab\x95\x5a\x85\x2c\x39\xd8\x6b\x90\x7f\x70\x7c\xe0\xdb\xc3\x32\xef\x25\x5b\xdb\x02\x20\xe0\x1c\x00\x96\x29\x26\x2b\xa7\x34\x31\x1b\x33\xe5\x0e\x6c\xae\xdd\xbd\x03\x8a\x15\xae\x2e\x62\x55\xe5\xdf\x22\x45\x9f\x79\x66\xb3\xfa\xc2\xca\x54\x83\xd8\x4f\xb6\x9c\xf8\x15\x58\xdf\x69\xf0\xc5\xf1\xbc\xe6\x7e\xc2\x5a\x78\xa6\xdb\xf2\x05\x70\xa2\xb5\xd4\x35\x65\x21\x01\x43\x82\x99\x6b\x7a\xe6\xbd\xb6\x9b\x62\xb7\x7f\xc4\x9e\x0c\xc4\xc2\xc7\x27\x2f\x77\x49\xf0\x7c\xd6\xf2\x8d\x35\x55\x31\xb8\xcc\x67\x25\x98\x68\x21\xeb\xbd\xc2\x39\x83\x69\x0b\x96\x42\xc5\x42\x5b\x3e\x12\xb3\x3d\x64\x20\xc6\xfb\xf6\xc3\xbb\x7a\xc6\x5b\xe1\x56\x0e\x18\xc7\xe7\x41\x42\xde\xa3\xb5\xf4\x5a\xc9\x12\x2c\xc1\xad\x36\x1e\xc1\xb5\xd5\x30\x02\xaf\xe1\x1e\x1a\xec\xcf\x24\x78\xba\x80\x9f\xb2\xa5\xc3\xd2\xa5\x8f\xd6\x33\x9a\x54\x10\x0f\xb3\x57\x86\xca\x06\x50\x90\x79\x33\x83\x75\x78\xa7\x4b\xca\x2a\x7c\xf6\x19\xce\x38\x17\x69\x99\x80\x32\xd6\x21\x2f\x87\x7d\xe6\xac\x09\x5f\x62\x0f\x9a\x58\x98\x84\x2f\xf6\x9f\xdb\xee\x7c\x7b\x10\x8d\x65\x35\x2b\x55\xf0\xe7\x0b\x26\xc7\x62\xcc\x5e\x7d\x56\xfb\xd3\x2b\x3b\xfa\x45\xae\x61\x08\x24\xc2\x6e\xd6\x99\x2c\x50\xb9\xbd\xaa\x53\x1b\x33\xe8\x7b\xbc\x10\x69\xcd\xd4\x8f\xc9\xda\x98\x54\xdb\x0f\x60\xed\x33\x9e\xa7\x19\xd4\xf8\xe9\x29\x7b\x0a\x41\x2c\x71\x42\xbe\x70\xe0\x61\x66\x40\xa7\x2b\x5d\x6c\xf3\x74\xd6\xb2\x09\x5b\xa9\xee\xc9\x36\xb4\x1d\xc1\xa5\x02\x4b\x67\x04\xe9\x3b\x23\x44\x7c\xb6\xaa\xfa\xdc\x42\x8e\x8f\x1c\x1c\xd0\x88\x3b\xd1\xac\xf5\x0c\xff\x93\x4b\x2c\x1f\xf1\xf0\x2d\xa9\x46\x7c\x84\x78\x3a\x83\xc3\xb8\x63\x89\x96\x1f\xcd\x26\x8b\x42\x8a\x6a\x6a\xa6\xab\x0a\x70\x11\xdf\x30\x66\x37\xba\xa8\xa0\xe3\xac\x93\xa1\x85\x22\xf3\x58\x43\x75\x5d\x56\xe9\xaa\xab\x9b\xfb\x8f\x3f\xde\x7e\x7b\x7d\x73\x5f\x53\x59\xad\x24\xf7\xab\xb4\x1d\x2a\xab\x7d\xa0\xed\x2a\xad\x7e\xa5\x26\x01\xe3\xb5\xab\xb4\x0d\x95\xd5\x4a\x93\xa6\xd2\xd6\x54\x56\x2b\x55\xaa\x4a\x5b\x57\x59\xad\x84\xa9\x2a\xad\xa6\xb2\x5a\x69\x92\x55\x5a\x4d\x65\xb5\x12\xa5\xa9\xb4\x75\x95\xd5\x4a\x96\xa4\xd2\x7a\x95\xb5\xa1\xb2\x84\x5a\x46\xa9\xab\x6f\x5c\xdc\xbf\x26\xa2\x61\xaf\x6a\x18\x3a\x71\x8e\x84\x6d\xdb\x76\x98\x15\x6b\x8c\xfd\x4a\x2d\xbf\xe7\x79\xd5\xf1\x1b\xed\xdc\x6d\x13\x69\x25\x0b\x96\x2a\x92\x00\x6d\xc2\x59\xb0\x82\x0f\x1d\x74\xeb\x18\x86\xf0\x21\x07\x90\xf5\x6d\xf3\x6b\x1f\xa6\x2b\x16\xf1\x97\x16\xf6\xf6\x97\xeb\x77\x57\x37\xf7\xd7\xef\xaf\xaf\x3e\xd2\x9e\x8e\xe0\xd5\x48\xfc\x9c\xc6\x54\x07\x2f\x3d\x8f\xf6\x9e\x4a\x8b\x5c\x2c\xa5\x2e\x4d\xb6\x0a\x7d\x52\x6a\xeb\x49\xa4\xed\x57\xdd\x78\x6b\x39\xf0\x3d\x5e\xde\xb8\x5a\x31\x23\x72\xcc\xa9\x78\x01\x71\x82\x6d\x4f\x24\xb9\xed\xb8\x6c\xb3\xf0\x89\xa4\xb7\x1e\x9a\xfb\xec\x7c\x22\xdd\x7d\x47\xe7\xbe\xa3\x91\x48\x7e\xc7\x01\xba\xe3\x80\x24\x12\xdd\x7b\x8c\xee\xb2\xfc\x89\xb4\xf7\x1f\xa6\xc1\xfe\xef\x12\x12\x18\x0f\x8e\x26\xfe\xef\x73\x3d\xef\xa0\x02\xee\xd0\x13\xe2\x21\x22\xb6\x2a\xbc\x81\x21\x8b\x53\x56\xd6\x73\x19\xc3\xfd\x3e\x54\x5c\xc1\xef\x31\x6b\x93\xb6\xcf\x71\xfa\xde\x79\xa2\xa6\x72\xf6\x81\x2f\xbe\x16\xab\x8f\x82\x5c\x0c\xbf\xbe\x28\x22\x83\xca\x07\xc6\xd9\xa3\x58\xc1\x59\x80\xa7\x94\x25\x4c\xe5\xd0\x2e\xa3\x87\xcf\xa3\x20\xc6\xa1\xb7\x8e\x1c\x2e\x30\x30\x66\xb4\x25\x60\x12\xf4\xe1\x76\x60\xbc\xf8\x93\x76\xeb\xa0\x07\xf5\x23\xd7\x49\x74\x61\x6b\xf3\xa2\x88\xb2\x88\x4a\x3e\x28\xd3\x5d\x4a\xf1\x74\xee\x22\x3b\x23\xb8\x2a\x8e\x6c\x78\xc1\x9c\xc3\x84\xcc\xf9\x9f\xf0\x7f\x22\x87\x80\x58\x1a\xec\x32\x4d\x5d\x66\x47\x69\xc4\xb4\xcc\x6c\xf6\x85\x19\x33\xbe\x90\xdf\x43\x6a\x5c\x4c\x93\x06\xc7\x18\x52\xa5\x43\x56\xca\xf4\x8b\xc1\x49\xc4\x63\x5d\xf7\x54\xfb\x32\x92\xee\xfb\x0a\xcd\x70\xe4\x74\xd5\xd0\xa6\x41\x8e\xa2\xc8\x32\xd0\xba\xe0\xbc\x7e\xac\x79\x83\x9d\x51\x11\x45\x28\x36\xc9\x81\x1e\xc0\x6a\xfe\x1b\x81\xe6\x20\x7f\x3b\x22\xe6\xe9\x3f\xc8\x4f\xdd\x35\xdc\xa0\x52\x71\x48\xc9\x4b\xdf\x42\x47\x4c\x92\x79\x08\x19\x28\x3c\xb0\x3d\x28\xc6\x20\x32\xc3\xe6\x8f\x66\xc1\x13\x11\xc3\xed\xff\x0c\x8f\x63\xc7\x54\xf3\xd3\x60\xf0\xef\x5f\x5f\xfd\xf8\x1f\x83\xc1\xcf\xff\x1c\xd6\xfe\x8a\xe7\x0c\x5e\x31\x9b\x5f\x89\x99\x00\x04\x19\xa0\x20\x07\x54\xd0\xd0\xfe\xe8\xec\xc9\xcb\x24\x81\xd8\x36\xfe\x21\x86\x22\x56\x62\x8c\x21\x2d\xec\xfa\x76\xe8\x7f\x5c\xe8\x74\xfd\x27\x33\x1e\x1c\xf9\x28\xa9\x74\xcd\x0b\x84\xd8\x51\xf0\x0c\x02\x21\xb3\x39\x27\x39\x81\x9a\xff\xde\x03\x9b\x41\xb6\x03\x78\xc5\x42\xbd\x96\xc2\x9a\x32\x70\x60\x74\x40\xe2\x75\xc2\xa3\xd9\xab\xe5\x1b\xb2\xe5\xf8\x22\xa5\x38\xf5\x93\x78\xc1\x82\xe2\x1a\xb8\xd5\x44\x7a\xd5\x61\x1d\x45\x34\xe4\x49\x87\x0c\x04\x76\x79\x7b\xcd\x96\x76\xbf\x7e\x83\xe5\xe8\xa2\x15\xc3\x02\x1e\x53\x37\xfa\xf8\xde\xfb\x83\xe9\x48\x4f\xd1\x4b\x41\xb8\x84\xd2\x49\x33\xdb\xd2\xc1\x53\x72\xd5\x45\x26\x60\x5b\x08\x53\x18\x76\x6a\x7f\x39\x4e\x16\x65\x8c\x16\x73\x4f\xcd\xc5\x5c\xe7\xab\xa1\xff\x31\x44\x46\x47\xa6\xd0\x39\x9f\x45\xa9\x31\x3f\x24\x1c\x4a\x18\xa0\x7b\x45\x63\xd0\x9b\xef\xa1\xfa\x0d\xbc\xef\x20\x29\x73\xb0\xf8\xb2\x55\x05\x49\x76\x74\xdd\x18\x76\xf0\xe6\x85\xb6\x6b\x70\x5f\x61\x02\xcb\x45\xb0\x15\xa2\x48\x22\x10\x84\xcb\xb6\x33\xc3\x60\x7b\xe1\x6f\x85\x5a\xc2\x55\x8c\x04\x27\xf1\x42\x89\x86\xce\x16\x4b\x69\x34\x31\x17\xd1\x7f\xa2\x70\xc7\xbc\x26\x88\x03\x23\xd9\xf6\x6c\xf4\xe4\xd6\xb6\xee\xce\xe9\x4d\x5b\xe4\xe3\xca\xfe\x2c\x20\x47\x14\xd1\xd0\x6d\x51\x3c\x2f\xb0\xbc\x3d\xc8\x78\x03\x2e\x2e\x92\xe6\xab\x37\x74\xec\x78\x87\xcb\x5c\x88\x5c\x5d\xb0\xff\x3e\xfd\xc7\x9f\x7f\x1d\x9d\x7d\x71\x7a\xfa\xd3\xeb\xd1\xdf\x7f\xfe\xf3\xe9\x3f\xc6\xf8\x1f\xff\x7a\xf6\xc5\xd9\xaf\xfe\x87\x3f\x9f\x9d\x9d\x9e\xfe\xf4\xf5\x87\x2f\xef\x6f\xaf\x7e\x96\x67\xbf\xfe\xa4\xca\xf9\xa3\xfd\xe9\xd7\xd3\x9f\xc4\xd5\xcf\x44\x22\x67\x67\x5f\x7c\x16\x35\xcc\x83\xc0\xf8\x36\xd5\xfc\x45\x77\x16\x18\x7c\xf4\x67\x58\xa0\x56\x9d\xc6\x83\x4f\xf3\x08\xf5\x03\x3d\xe6\x09\x6a\x44\x92\x8b\xe2\xd0\x3e\x14\x4b\x35\xa6\x29\x8e\x83\x4c\xc3\x44\x9f\x70\x95\x38\xf2\xc9\x70\x18\x07\x8c\x53\x09\x6e\xca\xd1\xd2\x6f\x39\x10\x13\xb8\x7d\x69\x0e\x86\x12\xb0\x7d\xa6\x5b\xc8\x48\x92\x8f\x82\xdc\x86\xe6\x05\x1c\xdd\x3b\x83\x7a\x67\x10\xd9\x19\x74\xd7\x85\x8f\x7b\x4f\x50\xdd\x13\x14\xf5\x00\x7d\xf4\xbf\x5f\xb2\xbe\x50\x4b\x4a\x28\x63\x6b\x5c\xdd\x5f\xad\xea\x38\x19\x31\x51\xc1\xdd\xc1\xc6\xb1\x57\xec\xc6\x33\x5b\x44\x6e\x11\x8e\x8a\xcd\xb7\xc7\x83\xb1\xab\xb1\x54\x56\xb1\xc3\x0b\x5a\x29\xfa\x00\x62\x2e\x1c\x7c\xb3\x85\xaa\x11\x4b\x88\x52\x07\xc8\xcf\x30\x74\x0a\x0e\x3a\x66\x1b\x63\x5e\x35\x16\x1f\xda\xb8\x87\x8b\x77\x49\x55\x61\x6a\xb9\x05\xa6\x25\x3a\xda\xa8\x0f\x37\x46\x27\x98\xed\x8d\x2b\x16\xe0\x43\xfc\xb2\xe0\x6c\xb0\xfb\x64\x4c\x0f\x56\xc4\xc4\xac\xf6\x62\x02\x30\x20\xec\x4a\x2d\x3d\x4e\x69\x5a\xda\xf4\x13\xdc\x33\x1a\xc8\xdb\xfa\x18\x7e\xb3\xb4\x0a\x60\x77\x17\x86\xab\x65\x57\xc0\x3a\xb9\x25\x22\x61\x0e\x80\x79\x85\x42\x10\x3c\xec\xe6\xe4\xb0\x06\x52\x88\xad\x91\x8d\xc2\xc6\x4c\xef\xeb\xde\xff\xca\xca\xa6\x17\xa9\xc5\x9b\x73\x71\xa6\x08\xd5\x0c\x21\x66\x78\x56\x9f\xdf\xcd\x04\x39\x8a\xf9\xd1\xc5\xf4\xe8\x60\x76\xc4\x9b\x1c\xad\xe6\x46\xe0\x3e\x32\x49\xd6\xd9\xc4\x88\x37\x2f\xa2\x4e\x72\x90\x06\x31\x95\xcf\x17\x27\xd1\x2b\x73\xa9\x2a\xff\x92\x4c\x85\x2a\xc0\x07\x82\x3d\xcb\x16\xb9\x58\x08\x7a\xf1\xba\x66\x82\x27\x0f\xa8\x60\xa5\x6a\x2e\xf0\xf8\xb7\xcc\x7e\xb2\xb7\xa1\xee\x4a\xe9\xce\xdf\xd1\x7a\x8d\xd4\x6b\xa4\xdf\x56\x23\x39\xd6\x23\x63\x7b\x87\x2c\xe0\x4f\x4c\x1d\x1d\xe1\x1e\x80\xf9\xf7\x17\x27\x11\x0b\x3c\x78\x57\xcb\xdc\x47\x49\xab\x4b\x07\x89\xe7\x83\xd1\x6c\xce\x91\x4a\xab\xd3\x9d\xcc\x46\x48\xee\xb6\xcc\xb2\xb6\xea\xd3\x6d\x13\xbb\x86\x87\xd9\xa2\x84\x44\x3a\x7c\x7c\x8c\x7d\xc2\xf5\x94\x5d\x22\x32\xf1\x90\xdd\x00\xc6\x5c\xbb\xcc\x5c\x4f\x6f\x74\x71\x9b\x0b\xd3\x28\x6a\x07\xd5\x67\x09\x41\x31\xd6\x05\x20\x18\x02\xa6\x09\x9f\x91\xae\x0e\x3e\xfa\x38\x84\x1c\x8d\xfa\x0b\x2a\x40\x8b\x0e\xb6\x34\x7b\xf1\xd6\xfd\xc9\x57\x1b\x8e\x0e\xbc\x95\x01\xf1\x3b\x6a\x13\xbf\xf1\x4f\xf9\x3c\x38\xcc\xd1\x04\x24\x19\x88\xf1\xf8\x10\xda\x09\x39\x6e\xd5\xda\x14\x21\xe6\x70\x5a\x68\x53\xdc\xc1\x1d\xb0\xfd\xab\xeb\xbc\x79\xeb\x1f\x85\x79\x41\xb3\x6d\x91\x36\xfa\x04\xd0\xbb\x63\xd4\x0b\x8f\x81\x16\xc2\xa3\x7a\x2c\x60\xc1\x1e\xb8\x4a\x33\xc0\x0a\xe7\x32\x23\x20\x2f\x7a\xe7\x71\x83\xa6\x47\x9b\x0c\xb0\xc5\xbe\x6e\x97\x27\x89\xce\xd3\xb6\x9d\xf7\xff\x0a\xed\x2b\x69\xe1\xf1\x4a\x26\x81\xdf\xd9\x9c\x2b\x3e\xb3\x3d\x92\xec\x61\x4d\x22\x59\x0d\x13\xb1\xf2\x4c\x0d\xb2\xf9\x41\xeb\x47\xe8\xa3\xbf\xc8\xf0\xa0\xa6\xbb\x42\x3b\x09\x4f\xf8\xcf\x0a\xd9\x7e\x04\x23\x30\xe7\x35\x80\x7b\xfc\x45\x9b\x40\xc5\x32\xa1\xef\xd5\x4f\xfb\xe6\x1a\x1f\x82\x3e\x84\xa8\x34\x06\xd9\xb5\x0a\x86\xd2\x54\x83\x98\x51\x37\x16\x3e\x15\xd8\x49\x48\xac\x80\x6e\xf1\x22\x09\x3f\xdb\x80\x21\xd4\xa7\x6b\xda\x59\xea\x78\x06\x3c\x0c\xed\x7a\xaf\xdb\xc2\x45\x54\xd0\xed\x5c\xc3\xb7\xb6\xf4\xc6\xc3\x1e\x3a\x72\x08\x75\x1a\x41\x12\xa7\xea\x2a\xf0\x3c\x6a\x62\x43\x10\xd1\x7b\xe4\x11\x35\xa2\x08\x07\xf4\x8d\x90\xaf\xec\xc7\x08\x63\x06\x94\x1e\x76\x3a\x38\x1f\x9c\x9d\x74\xc8\xa2\x09\xc3\x5b\x83\xa0\xbe\xaf\xde\x12\x47\x16\x51\x6f\x16\x08\xd5\x22\x92\x41\x3a\x64\xb2\xf0\xaa\x3f\x2f\x95\x5b\x99\x28\x92\xae\x4c\x71\xc8\x0c\xa0\xd2\xf3\x54\xba\x5b\x1c\xfe\x16\x08\x16\x79\x89\x4c\x19\x63\x91\x33\x76\x3a\xf8\x75\x30\x64\xa2\x48\xce\xd8\x93\x56\x83\x02\xb7\x66\xcc\xee\x6d\xa7\x53\xff\xd2\x28\x92\x2b\x5d\x62\x63\x26\xcb\x0a\xe0\x8a\x93\x45\xb6\xc2\xe3\x01\xf0\x7f\xe1\xd7\x00\x2d\x11\x45\xd3\x95\x68\x5e\x3d\xcb\xc2\xe5\xd1\x81\x8c\xbf\x86\x35\x85\x8e\x01\xa8\xc5\xe3\x26\x9e\xc9\xa5\x38\x7f\x10\x3c\x2b\x1e\xc0\x7f\x98\x62\xdf\x1c\xdb\x1b\x07\xf4\xaf\xfb\x0b\x55\x62\x23\x3c\x80\x9d\xcc\x8e\x6e\x66\x74\x27\x1b\xbd\xba\x24\x7e\x29\x8a\x4e\xea\xf8\xab\xfb\xfb\xdb\x2f\x1b\x3d\x0c\x41\xe6\x81\xa2\xcf\x1b\x22\x52\x65\xb5\x2e\xa1\xc7\xd4\x9d\x31\xcd\x05\x37\x67\xbb\xa5\xab\x60\xa3\x5b\x60\x04\x61\xb6\xd6\x59\x90\xfd\xa8\x4b\x70\x34\x4c\xf8\x24\x5b\xb1\x27\xae\x9c\xa7\x82\xbe\x80\xf0\x79\xf5\x95\x36\xc5\x2b\xc8\x18\xac\x35\xfe\x47\x95\x21\x38\xc1\x16\x7f\x21\xbb\xd6\xde\xd9\x79\x8d\xdf\x62\x2f\x7e\xd7\x9f\xbe\x56\x2c\x49\xb6\xb0\xfc\xc7\xf1\xdf\xd8\xb6\x1e\x77\xe5\xc3\xe0\xf9\x42\xb3\xd0\xd1\x3f\xb2\xd0\x37\xb9\xe7\xfe\xfe\xd6\xae\x8e\x9b\xb1\x85\x59\x4e\x70\xc2\x51\x64\x99\x5b\x9e\x3a\x54\xb4\x54\xf8\x02\xd7\xd9\x38\x8a\x5c\x17\x39\xea\xe2\xe6\xda\xba\x2a\x1b\x3d\xfd\x49\x01\xc9\x83\xb0\x6b\x2d\x7c\x75\xe8\x49\xc4\xb5\xf3\x7f\xf1\x2c\xe2\xc3\xd5\x11\xc1\xdf\xfa\x67\xd4\x61\x66\xd1\x07\x52\xd7\x93\x0f\x93\xe3\x88\x40\x95\x5b\x36\x11\x13\xb6\x43\x6f\x52\xdf\x73\x14\x84\x2a\x82\x22\xe4\x54\xe5\x4b\x4a\x91\xf3\x0b\xb7\x3d\xa6\x61\x6c\xa7\xe4\xcd\xee\xa9\x9b\x9d\x13\x37\x1b\xdb\x61\x03\x73\x39\x53\xa1\xbf\x9c\x6f\x20\x1b\x41\x91\x6d\x6e\xe8\xf6\x26\xb2\x51\x34\x27\xa1\xe2\x37\x87\xca\xde\xed\x8d\x64\x63\x29\x76\x6d\x3a\x7b\xd0\x6c\x4b\x2c\xfb\x10\x17\x5d\xf7\xec\x0e\x1f\x87\xd5\x80\x2b\x05\x5c\xde\x9c\x95\x14\xc7\x07\xc1\x36\x02\x6b\xad\xe9\xbf\x04\x71\x3c\xb2\x6c\xc5\x2a\x53\x72\x1b\xde\x4e\xca\x30\xaa\xc5\xf8\xc6\x86\xec\x6f\x33\x4e\xa4\xc8\xaa\x76\xe4\xd4\x56\xe3\x64\xca\x36\x74\x4d\x68\x37\x4e\xa6\x48\x6d\x4b\xfe\xbb\xdc\x20\x0e\xd5\xa2\xfc\x40\x6d\xca\xfb\xd3\x67\xe7\xe9\xb3\xb3\x7d\x79\x7f\xfa\x1c\xe9\xf4\xf9\x94\xf4\x6e\xd4\xd7\x17\xb9\xb8\x2b\xf4\xe2\xe2\x24\x92\xc7\x06\xb7\xf6\xc1\x1d\x11\x95\x89\x98\x52\x23\x00\x7c\x67\xf8\x23\x2d\x85\xc3\x68\x85\xa2\xc1\x18\x9f\x8c\x6e\x84\x38\x6c\xd6\xa1\x07\x68\x05\x8f\x9a\x12\xc6\x9c\x63\x5c\xa5\x5c\xd8\x5b\x27\x89\xac\x6b\x3f\x36\x84\xc4\x16\x80\x88\x40\x58\xd2\x50\x94\xe1\x9a\xa7\xe1\x2f\x45\x91\xd0\xb8\xf0\xbe\x16\x35\x72\x9e\x57\xbf\x9c\x6b\x65\x7c\x2c\xc9\xb9\x79\x20\x5e\x8f\x21\x5e\xf7\x0c\x05\x76\x78\xbd\xcb\x05\x37\xd0\x1e\x50\xe7\x61\x79\xa1\x5c\x56\x1a\xb6\x80\xe6\x81\xe9\x49\x8c\x59\x63\x43\x5c\x36\xd9\xf4\x56\xa7\xeb\x3d\x17\xeb\x1d\xe9\x49\x74\xb1\x82\x19\x3a\x6b\xb3\x89\x98\x49\x05\xbd\x1f\x80\x77\xf0\x65\x9e\xc9\xe0\x5c\x84\x25\xa1\x4d\xde\x43\xeb\x8d\xd9\xc7\x80\xab\xe2\xb5\xa0\x2e\x8b\x44\x57\x4a\xd1\xcd\xa6\x4b\xa8\x0e\x12\x36\x2d\x6b\x59\xb4\x73\xbf\x08\xbe\x57\x0b\x99\x64\xcb\x22\x6e\xc6\xec\x48\x94\xf5\x74\x6d\xbc\x2d\x31\x3b\x1a\xd1\xbc\xf6\xfc\xae\x01\x83\x4a\xc8\x21\xed\x8a\x12\x41\x7f\x59\x14\xbd\x0f\x04\xf6\x81\xc0\x3e\x10\xd8\x07\x02\xfb\x40\x60\x1f\x08\xec\x03\x81\x7d\x20\xb0\x0f\x04\xf6\x81\xc0\x3e\x10\xd8\x07\x02\xfb\x40\x60\x1f\x08\xec\x03\x81\x7d\x20\xb0\x0f\x04\xf6\x81\xc0\x3e\x10\xd8\x07\x02\xfb\x40\x60\x1f\x08\xec\x03\x81\x7b\x02\x81\xe4\xaf\xfa\xe8\xd9\x2d\x04\xcd\x2e\x4e\x22\x98\x11\x9f\x30\xd8\x1e\x68\x67\x69\xd9\x91\x4a\xc7\xa8\x8e\xfa\x38\x27\xbd\x73\xbe\x13\xe8\x32\xaa\x83\x3e\xc6\xf1\x1e\xab\xf6\x9d\x5f\x98\xf6\x65\xba\xb3\x3d\x06\x35\xf0\xf0\x8e\x76\xaa\x93\x3d\x06\xd0\xad\x31\xac\xdd\x95\x36\xd4\x68\x24\x7c\x08\xce\xf5\x78\xdf\x75\xab\x63\xbd\xe6\x2c\x27\x13\xdd\xe3\x54\xdf\xe1\x28\x27\x93\x5e\x77\xa8\xef\x71\x92\x93\x69\x56\xce\xf4\x03\x3b\xc8\x23\xfd\x64\x1d\x4e\xeb\x38\xd7\x40\x84\x2e\x0f\x79\x0a\xf7\x0f\xb9\x30\x0f\x3a\x4b\xa3\x75\xdf\x07\xa9\xe4\xbc\x9c\x83\x74\x1a\x10\x59\xb9\x14\x9e\xa8\xf1\x92\x46\xa0\x89\x4a\x6a\x22\x9c\x87\x0f\x88\xc9\x54\xe4\x50\x4f\xcc\x25\x34\x1b\xc5\xba\x5b\xe8\x88\x0b\xd9\x79\xa6\x4c\xa0\x37\x2c\x35\x68\x5c\xbf\x4f\xfd\x65\x1c\x46\x1c\xda\x78\xbc\xa1\x90\x81\x8a\x1d\x5e\x60\xa6\xd6\x5f\x3e\x27\x7c\x3f\xce\x9c\x8b\x88\x49\x1c\x23\x1e\x11\x17\x8b\x88\x3d\x4e\x62\x6e\x10\x47\x8a\x3f\x10\x63\x0f\x2e\x9e\x40\x26\xfb\xb2\xb8\x43\xb4\x26\xe8\x10\x6f\x20\xc7\x1a\x42\xfc\x80\x48\x98\x1d\x24\xce\x10\xa9\x3b\x8f\x12\x5f\x38\x58\x6c\x21\x56\x2e\xba\xc6\x14\xf6\xbb\xe2\xa3\x7d\xdc\x1d\x0e\xa4\x8e\x71\x84\x43\xc7\x10\x3a\x8d\x3c\xf6\xda\x15\x1d\x37\x88\x8d\x19\x44\x9d\xd7\x5d\x0c\x82\xb8\x38\x01\x31\x46\x10\xe9\xf7\x8f\xde\xaa\x18\x8f\x4b\x94\xb7\xa5\x9b\xa7\xa5\x93\x97\x85\xe8\xdf\xaf\x16\x98\x48\x97\xed\xf7\xae\xac\x7b\x4c\xc8\x54\xb7\x7a\x56\x5e\xe2\x2d\x39\x88\xa7\x24\xce\x47\x4f\xf6\xcf\xc7\xdc\x46\x5f\xee\x9b\x8f\xe4\x9e\x18\x25\x45\xf6\x0b\x45\x29\x1a\xa9\x64\x21\x79\xf6\x4e\x64\x7c\x75\x27\x12\xad\x52\xd2\xa9\xd6\x58\xfe\x81\x63\x4a\xc0\xb2\xb5\x24\x9c\x1d\xdf\xe0\x5d\x02\x55\xc6\x1e\xb8\x61\x1e\xdd\xc6\x65\xfd\x7a\xff\x92\x3b\xa6\xd1\x5d\x64\x47\x5d\x1c\x29\x9d\x14\xee\xba\x99\xe6\xa9\x39\x5f\x68\xfb\xff\xaa\x64\xd2\x5a\x16\xa9\x1d\xcf\xe0\x77\xbf\x54\xd8\x1c\xdb\xae\x9b\xf7\x95\x7e\x62\x7a\x0a\x6d\x8d\x4e\xa5\xf2\xfb\x77\x56\xbb\x2a\xc4\xdd\xec\x82\xe8\x00\x85\x37\xaf\x3d\xc1\xcd\xeb\x18\x89\xe4\x27\x70\x65\xc3\x4b\xa8\x31\x87\xbd\x39\x3b\xa2\x87\xb9\x3a\x3b\x62\x80\x48\x58\xbf\x3e\x93\x48\xc2\x15\x5e\xa4\x61\xd3\xf0\xb2\xf0\xa6\xc2\xc1\x7c\x83\xe3\xf3\x12\x48\xa2\x08\x7e\x16\x57\x47\xf1\x69\xde\xc1\x8b\x64\x71\xa7\xff\x8f\xbd\x6b\xef\x6d\x1b\x49\xf2\xff\xfb\x53\x34\xbc\xc1\xc9\xde\x91\xe4\xcc\x62\xb1\xd8\x0d\x16\x17\x78\x13\x27\x63\x4c\xe2\x11\x62\xcf\xcc\x0d\x32\xd9\xd9\x16\xd9\x92\x1a\xa6\xba\x75\x6c\x52\xb6\x80\x7c\xf8\x43\x55\x3f\x48\xca\xb2\x59\x4d\xcb\x99\xc7\x71\x3d\xc0\x46\xb2\x59\xec\x47\x55\x75\x3d\x7f\x4d\xcd\x3c\x46\x64\x1d\xab\x6c\x22\x6d\x9d\x68\x19\x47\x9b\x49\x24\x51\x24\x64\x1b\xc9\x59\xc4\x2f\xe6\xfb\xef\x2b\x73\xb8\x87\xac\xe1\xff\x73\xdb\x15\x6c\xd7\x7b\xb3\x83\xbd\xed\xfa\x80\xed\xfa\xeb\x5b\x71\xb5\xc6\x9b\xb7\xd0\x28\x34\x79\x94\x49\xe0\x45\x92\xa5\x65\xee\xfa\xc2\x82\x65\xe0\x05\x8b\x40\x93\x61\x6a\x00\x8f\x14\x3f\x3e\x61\xfb\x98\x66\x25\xb4\x47\x95\x2b\xad\xdc\x99\xe6\x22\xc9\xb4\x3d\x83\xd2\xca\xed\xee\x22\x18\xd5\xae\xd1\xd2\x71\x1c\x81\xc2\x2a\xd7\xee\x58\xce\x4b\xa5\xc0\x79\x70\x3c\x0a\x9a\x04\xec\x4e\x63\xb5\x6b\x6d\xb9\x49\xb4\x8d\x9c\x2b\x9e\x61\xee\x10\x5e\x53\xc8\xa5\xa8\xae\x3c\xa8\x5e\x0a\x6f\x98\xe9\x3c\x91\x53\x62\x0e\x65\xc1\xb3\x70\x43\x01\x67\xd7\xd0\x7e\x66\x5f\x35\x66\x97\x80\x0d\xbd\x90\xc6\xd9\x5b\x99\x56\x54\x45\x52\x2c\xb8\xf2\x17\x83\x89\x04\xe8\x27\x70\xd9\x48\xb9\xb2\xe3\x06\x83\x60\xa3\xcb\xdc\x8f\x9b\xb6\x67\xe7\xb3\xfa\x68\x20\xd5\x25\xb3\xa1\x5f\xda\x81\x79\x98\x81\x49\x6f\x08\x17\xd4\x1b\xb0\x67\xb0\x41\x0e\x40\x5c\x87\xf5\xf7\x02\x38\x74\x2e\x53\x62\xac\x0f\x46\x67\x57\x6f\x95\xeb\xb5\x4c\xc1\x25\xd9\x04\x76\x00\x0b\x60\x6c\x6f\x74\xa0\x57\x26\x4c\x05\xa2\xb5\x29\x31\xe7\x68\x07\x3a\x05\x6f\xdb\x74\xec\xbb\x6c\x7e\x4a\xa5\x32\xe1\xd4\x1e\x3c\x03\x0d\x91\xf5\x5e\xdb\xb5\xc4\xbb\x4e\xeb\x1c\xc1\x8e\x94\x66\x1a\xef\xbd\x2d\x95\x2c\x68\xfc\x05\x91\xe3\x45\x59\x30\xe8\xc5\x3c\x86\x41\x4a\x03\xc2\xc6\xd9\x54\x14\xfe\x0a\x5e\x7f\xa9\xa2\xcc\x89\xa3\x15\x8a\x4f\x33\x90\xad\x09\x88\xfe\xd5\xce\x8d\x67\x33\xc1\x8b\x32\x17\x6c\xce\x0b\x62\xcf\xd9\x0e\x53\x13\xd3\x25\xe3\xc7\xb3\x96\x34\x2e\x5e\x3c\x63\xa5\x32\xa2\x88\xb4\x61\xff\xf6\xd7\xfd\xdb\xb0\x72\x29\x74\x59\x74\x55\xf0\xf7\x3a\xec\x37\x0b\x99\x2c\x62\xfd\x11\xb9\x14\x06\xb2\xb8\x5b\x5e\x84\x23\x1d\x9c\x00\x12\xc9\xfa\xee\xfd\x91\xbd\x76\xf2\xe9\x4e\x49\x1b\x34\xf6\xb6\x8e\xb7\xbf\xa3\x8a\x26\x78\x2e\xed\x27\x38\x5c\xd0\xc3\x5e\x5f\x5c\xfe\xf2\xee\xf4\x5f\x67\xef\x9c\xf8\xab\xba\xcd\x55\x2a\xf9\xbf\xa5\x60\x7c\xa9\xa1\x84\x92\x70\x5b\x4d\x18\x86\x19\x62\x0c\xa7\x1a\x17\x38\x52\x69\xa7\xb2\x1f\xb2\xd1\x0b\xba\xcf\x44\xad\xe5\x04\x9e\xd8\x5b\x7d\x12\x31\x09\xd6\x18\x41\xb8\xa7\x15\x86\x52\xbf\x68\x87\x33\x25\x0a\x08\x54\x51\xed\x49\xa9\x18\x67\x46\xaa\x79\x56\xb7\xc9\x0f\xf6\xeb\x08\x06\xc2\x13\xb2\x6b\xd4\x98\x6e\xa5\x97\xbc\xd3\x61\x6f\x27\xf5\xce\x04\x1a\x0b\x24\xb2\x8c\x9d\x4f\x18\x4f\xd3\x5c\x18\xe3\x58\x37\x78\x0a\x60\x0d\xc8\x14\x17\xce\x85\xe9\xa9\x25\x36\xcf\xd9\x3f\xd9\x2d\xfb\x27\x06\xcf\xff\xd6\xbe\x78\x5d\xb4\x48\x9c\x1e\xf1\xbe\xf7\xf9\xa4\xc3\x6a\xff\xb8\xe0\x05\xd6\xfd\xc2\x5a\x41\x45\x86\x74\x06\xaa\xb8\x85\x4b\x59\x09\xb8\xee\x95\x60\xb1\x42\xd3\xd6\x23\xd2\x47\x85\xe1\x3d\x11\x33\x01\x69\xda\x98\xd1\x7e\x0d\x8a\xd3\x99\x94\x7b\x67\x27\x78\x09\xd4\x25\x5c\x38\xc1\x96\xa6\xf6\x4e\x22\xc5\x6a\x64\x4b\x5e\x24\x8b\xa6\xfe\x80\x23\xd5\xd4\x75\x2e\x91\x68\xaa\x51\xff\x81\x37\x87\x53\xff\xad\x30\x3e\x3d\xa5\xde\xe0\x8b\x87\xf6\x72\x2b\x88\x40\x22\x0e\x89\xc9\xd4\x9f\x85\xe0\x8b\x55\x4e\xe3\x98\x9d\xc1\x65\x43\x30\x50\xc7\x20\xe4\xaa\x43\x0e\xcf\xdb\x81\x2d\xf8\x1a\xd8\xcc\xbd\x01\x88\xb9\xd8\x47\x4c\xf0\xd8\x8b\x29\xc8\x7c\xc2\x15\x70\x2e\x5e\x10\x06\x11\x64\x90\xfd\x0d\x66\x7a\x65\x22\xcc\x38\x62\xb3\xc8\x72\xbc\xca\x75\xa1\x13\x4d\xbc\x86\xc6\x45\xf2\x5e\x40\x64\x34\x7e\x83\x27\xee\x5d\x78\x52\xc3\xac\xab\x80\xf6\xf7\xaf\x27\x43\x20\x4a\x95\x50\x9d\xb3\xcb\x57\x57\x93\xa6\x69\x7b\x78\xf5\x6a\x72\xf8\x04\xab\x44\x8f\x23\x8d\x2a\x21\x9e\x50\x8e\xff\x88\x60\x12\xb5\xe6\x20\x17\x3c\x95\x7d\xdd\x77\x5f\xf7\xdd\xd7\x7d\xf7\x75\xdf\x7d\xdd\x77\x5f\xf7\xdd\xd7\x7d\xf7\x75\xdf\x7d\xdd\x77\x5f\xf7\xdd\xd7\x7d\xf7\x75\xdf\x7d\xdd\x77\x5f\xf7\xdd\xd7\x7d\xf7\x75\xdf\x7d\xdd\x77\x5f\xf7\xdd\xd7\x7d\xf7\x75\xdf\x7d\xdd\x77\x5f\xf7\xdd\xd7\x7d\xf7\x75\xdf\x7d\xdd\x77\x5f\xf7\xdd\xd7\x7d\xf7\x75\xdf\x7d\xdd\x77\x5f\xf7\xdd\xd7\x7d\xf7\x75\xdf\x7d\xdd\x77\x5f\xf7\xdd\xd7\x7d\xef\xaa\xfb\xf6\x77\x88\xb5\x6e\x72\x63\x83\x3f\xf8\xa7\x1e\x2e\xae\x39\x20\xd7\xf1\x9a\x31\x3b\xdb\x51\x92\x03\x72\xc1\xcc\x0a\x5e\x12\x06\xda\x4a\x94\x67\x50\x33\xb4\xc1\x21\x81\x92\x4b\x6b\xf6\xfa\xf8\x60\x7f\x7e\x49\x26\x97\xb2\xbd\xd0\x1b\x7e\x78\xea\x6b\x26\x26\x91\x7e\x4f\x84\x59\xdf\xc5\xa8\xef\x60\xd2\xaf\x78\x01\x45\xbb\x2f\xd8\xbf\x8f\x7e\xfe\xea\xf3\xe8\xf8\xe5\xd1\xd1\xc7\xe7\xa3\x7f\x7c\xfa\xea\xe8\xe7\x31\xfe\xe3\xcf\xc7\x2f\x8f\x3f\xfb\x0f\x5f\x1d\x1f\x1f\x1d\x7d\xfc\xf6\xfd\xdb\xab\xc9\xd9\x27\x79\xfc\xf9\xa3\x2a\x97\xd7\xf6\xd3\xe7\xa3\x8f\xe2\xec\x13\x91\xc8\xf1\xf1\xcb\x67\x07\x5f\xc8\xdc\x6e\xaa\xb2\x77\xb8\xcb\xee\xcb\xa9\xcb\x8c\x2f\xf9\x2d\xa8\x1c\xe8\x48\x28\xe9\xb7\xa2\xc1\x8d\x67\x65\x51\x63\x65\x2f\x35\xd1\xba\x28\xd1\x6a\x26\xe7\xce\x1e\x3d\xb1\xf7\xb3\x8d\x02\xd9\x51\x25\x40\x27\x83\x7d\xbb\x02\x2e\xbf\xdb\xf3\xfd\x1f\x9c\xef\x3f\xb8\x7d\xde\xe6\x7c\xa9\xf6\xc2\xf9\xde\xa7\x1d\x83\xe9\x1e\xde\x45\x0c\xdb\xea\xa5\x2c\x0a\x77\xd8\xd4\x2e\xf1\xc4\xbb\xc3\xea\x41\x1a\x2b\xbb\x24\x92\x12\x1c\x08\x8e\x55\x72\x55\xa1\x59\x08\xc3\xa5\x43\xa6\xbd\xc5\x4f\x4d\xf8\x70\x55\x45\xcc\x50\x4e\x47\xa9\x98\x49\x25\x5c\x86\xfa\xf7\x23\xf3\xe4\x3f\x05\xc0\xce\x5c\x16\x1b\x68\x06\x10\xb7\xad\xa1\xab\xfb\xe2\x74\x97\x4d\x32\x50\xa5\x03\x05\xc0\x24\xb3\xd1\x0f\x81\x69\xa4\x6b\xee\x6f\x4f\xf3\xd7\x17\xb6\x92\x84\x5b\xe8\xc0\x03\x46\x4e\x35\xa2\x00\x8f\x4f\x58\xe7\xc4\x80\x56\xdf\x1e\xae\x77\x02\x5b\x09\x03\x19\x90\x82\x35\xcf\xa0\xdd\xbb\xa2\x38\xd1\xe9\x16\xd1\xf1\x60\x8f\x76\x0b\x1e\x3a\x93\x5c\xae\x65\x26\xe6\xe2\xcc\x24\x3c\x43\xfe\x7c\x11\xad\x23\x4e\xef\xa1\x84\x22\x99\xeb\x8c\x26\x7a\x37\x0b\x01\xb2\xc5\xb8\x77\xf9\xb1\x6f\x60\xce\xa5\x62\x4b\x30\xd1\x57\xfe\x05\xb0\x9b\x9c\x16\x11\x81\x23\x1b\xcc\x46\x55\x84\x38\x82\x6d\xce\x9a\x6a\x9d\xb9\x1a\xde\x6c\x13\x37\x4e\x77\x2d\xae\xd2\xbf\x28\x71\xf3\x0b\x8c\xca\xb0\x59\xc6\xe7\x21\x34\x00\x55\x50\xdb\x51\x49\x12\xe5\x30\xc4\x7b\x17\x14\x42\x50\x39\xb4\x40\x66\x37\x7c\x43\x5e\xd6\xad\xb1\x30\x69\x5e\xb0\xaf\x8f\x21\xf8\x04\xe5\xab\xe1\x3d\x29\xfb\x0b\xad\x5c\x17\x50\xb3\x5e\x9d\x4e\x7e\xb9\xfc\xe9\xf2\x97\xd3\xd7\xef\xcf\x2f\xe8\x9a\x06\xd6\x5d\x10\x36\x2f\xe1\x2b\x3e\x95\x99\xa4\x9a\x0a\x0d\x86\x84\x22\xa6\x3a\x01\x38\x03\x78\x9a\x9e\xa4\xb9\x5e\xd9\x80\x98\x8b\xbb\x11\x08\x37\x9d\x93\xba\xe7\x09\x6b\xea\x4e\x19\x04\x3c\xd5\xb3\xc6\x4b\x49\xb4\xe7\x39\x57\x45\x15\xf0\xa9\xb6\x28\x87\x5b\x6e\x97\xa4\x98\x44\x8c\xcc\x3b\x6b\x8c\xf6\x87\x5b\xab\x7a\x9a\x42\x68\x2a\x7a\x8a\x8f\xab\xca\x7b\xe5\x5f\xb7\xa9\xba\x51\xd9\xe4\xbb\xcb\xf3\xff\xe9\x32\x12\xcf\x87\xe4\x3f\x8f\x36\x0f\xe3\x8b\xa9\x80\x29\x3b\xed\xc7\x07\xb1\xd4\xeb\x7e\x47\xf6\xbd\x23\x11\x16\x11\xab\xce\xa4\xf8\x7c\xfd\x87\x52\x55\xaa\x05\x12\x00\x15\x2d\xb6\xd4\xa9\x18\x43\xe0\x12\x4e\x2c\xe2\x5a\x36\x29\x04\xca\x36\xdc\x0f\x64\x14\x00\x2f\x66\x9b\x9a\xad\x41\xa2\x5b\x68\xdb\xcd\xa2\xd5\x3d\x75\x4b\x33\x9e\x19\x31\xde\xfb\x09\x00\x87\xe1\x7b\xb8\x99\x3d\x7a\x65\xc3\x93\x2c\x15\x4a\x17\xce\x63\x81\x7d\x05\xab\x0a\x7e\x4b\xa0\xc8\x18\x7a\x36\xf5\x62\xaf\x86\x86\x76\x17\xdb\xfb\x03\x80\xe8\xb1\xb8\x75\x9b\x84\x11\xda\x48\x68\x69\x84\x69\x92\xf7\x07\x00\x89\xaa\x1b\x84\xad\xf2\x80\xa0\x17\x76\x8e\x01\x3a\xaf\x85\x39\x58\x72\x73\x2d\x52\x70\x5d\x17\xc4\x3c\x0a\xda\x48\x3e\xdc\xee\x2e\xc2\x77\x43\xbe\x82\x75\xf4\x71\x73\xb4\x7b\x88\x8e\xd0\x54\xd8\x98\x3c\xad\x9a\x2a\x4a\xce\x61\xce\xdf\xa9\x6c\xf3\x41\xeb\xe2\x4d\x68\x9a\x8a\x66\x9c\x1f\x9d\x05\x8a\xa9\x9c\x6a\x2b\xc0\xdc\x41\x88\xbb\x74\x04\x0b\x4b\xa0\xca\xac\xc8\xd4\xfb\xb7\xdc\xce\x83\x63\xf9\x44\x02\x93\x97\xea\xd4\xbc\xcd\x75\xb9\x8a\x9e\x38\x18\x4c\x6f\xcf\x5f\x03\xb3\x83\x51\x08\xfb\x2d\x54\x91\x6f\x56\x5a\xaa\x02\x84\x86\xe2\x73\x35\x6c\xa5\xca\xdc\xfe\xde\xe5\x39\x81\x9b\x83\xbd\x14\x93\xe2\x60\xec\x3d\x87\x28\xae\xd1\xde\xb8\x96\x6a\x97\x6f\xc4\x9c\x63\x46\x22\x29\x15\x9b\xea\x62\x71\xc7\x6b\x03\x71\xb9\x4b\x7b\x18\x99\xc8\x0b\xf1\x02\xd0\xeb\xdb\xaf\x80\x36\x4b\xc3\x56\xb9\x48\x44\x2a\x54\x22\xc6\x71\x39\x82\x27\x48\xf3\x20\xe7\x5c\x68\x05\xe2\x13\xcd\x3b\xe7\x3e\x87\x08\x5e\x59\xb1\xa5\xc5\xb0\x6a\x22\x2f\xd5\x01\xe1\x62\x75\xf0\x45\x38\xe6\x2d\x51\x78\x4a\x03\xb5\x1c\x00\xe4\x95\x97\xc2\x3a\xdb\xdf\x96\x53\x91\x89\x02\x1d\x2d\x12\x45\x44\x4a\x80\x72\x00\x78\x5a\x2e\xf9\x5c\x30\x5e\x04\x66\x04\x84\x06\x65\x40\x8d\xe1\xc8\x25\x8d\x73\x52\x2d\xaa\x0e\x4a\x6e\xd8\xf7\xe7\xaf\xd9\x73\x76\x04\x63\x3e\x46\x6d\x0b\x45\x06\x20\x49\x88\x4a\x4a\x22\xd9\x5c\x33\x39\xc3\xf8\x95\x16\x06\xa7\x8f\x10\x50\x4c\xe7\x56\x6d\x0c\x99\xa2\x69\x5b\x53\x26\x0b\x3f\x7f\x70\xc5\xbd\x73\xea\xaa\x2d\x21\xe2\x56\x13\x2b\x1a\xc9\x56\xd1\xbb\x4f\xac\x48\xe4\xef\x11\xbd\x7b\xc4\x8a\x44\x72\x1f\xa2\xd7\x41\x09\x7f\x6f\x44\xde\x49\x07\x7f\xff\x44\x3a\xd8\x1d\x3e\xc6\x59\x33\x79\xb5\x92\x24\x8a\x52\x39\xe1\x59\x8a\x82\xa7\xbc\xe0\x2e\x45\xed\x89\xf4\xac\x44\x63\xa5\xa7\xd5\xe2\x46\xbc\x93\xaa\xbc\xb5\x01\x54\xd3\x89\xff\x2e\xcf\x90\x04\xb2\x10\x4e\x12\x8f\x5c\xbe\x5a\x65\x70\x96\x15\x3a\x5e\x9b\x79\x15\xe6\x97\x7b\xb8\x75\x40\x38\x5d\x4c\x22\x8c\x2a\xcc\x67\x73\xa1\xf8\x94\xab\x54\x2f\xef\x0c\x1a\x8c\x60\xc1\x93\x05\x89\x66\x6d\xa0\x75\x26\x6e\x61\x50\x12\xe9\x07\x98\xf8\x3e\x06\x25\xd1\xdd\xcd\xc4\x7b\x61\xd0\xd8\x50\x52\x26\xd6\x82\x08\xb1\xb2\xc5\x6d\xef\xe0\x49\x30\x86\xfd\xee\x21\x29\x96\xf1\xa9\xc8\xd0\x90\x20\x12\x65\x8e\x3b\x43\x44\xae\xda\xd0\x83\x27\x0a\x28\xe4\x3a\xeb\xd6\x3e\xf4\x41\x67\x58\x9c\xce\xc3\xa4\x81\xd4\xef\x62\xce\xf8\x40\x97\x39\x5f\x6d\x56\x5b\x73\x06\x52\xbf\x8b\x39\x97\xc4\x53\xfc\xce\x9c\xe1\xf8\x6f\xce\x19\x48\xfd\x0e\xe6\x1c\x15\xfa\x32\x22\x81\x42\x86\x49\xae\x67\x92\x26\x10\x4d\xc6\x58\x80\x2f\x87\x24\xaa\x24\x9d\x8d\xb5\x60\x30\x9c\x18\x50\xa9\x96\x84\x9d\xcf\xee\x10\xe4\x79\xad\xa8\x92\xb8\xee\x68\x74\xf8\xea\xcb\xff\xaa\xd6\xdc\x6a\xa8\xad\xf3\x8b\x44\xd1\x8f\xc6\x67\x04\x43\x6d\xa7\xfb\xc5\x93\x68\x66\x9d\xf0\x0c\x10\xe7\x22\xf6\xe7\xce\x1e\x6d\x13\xa9\xea\x45\x6d\x92\x0e\xbe\x23\xd2\x65\x2e\x7d\x8b\x47\x15\x60\xf9\x66\x01\x17\x4f\xe9\x54\x54\x79\x58\xe0\x00\x52\x80\xc8\x07\xaa\x84\x1f\x49\x28\xd1\x07\xa3\xcc\x25\xc9\x45\x5a\x7f\x0b\x99\x6a\xa1\x1d\x10\x8d\x6f\x50\xe2\xa8\xd5\x84\x4a\xa1\xd3\x13\xe2\x68\x43\x96\x0b\xc8\xc9\xad\xe9\x0b\xe0\x84\x18\xea\x33\x32\x51\x0c\x0c\xab\x0d\xd2\x33\x6e\xec\xa2\xc2\x0e\x01\x0f\xb9\x91\x42\x78\x2a\x58\x2f\x33\x3c\xda\x40\x11\x1d\xbe\xf3\x1b\x79\xf8\x44\x9a\xc3\x3d\xd0\x85\xc9\x0e\xe1\xc9\x1a\x67\xd9\x88\xe8\x35\x60\x43\xea\x20\xd1\x44\xc2\x2c\xf0\x82\x77\x77\x9d\xed\x8a\xc8\xd9\x32\x48\x1c\xc4\xc4\xa9\x12\xc1\xd8\xcf\x8a\x85\x05\x64\xa3\x8a\xf5\xef\xb2\x34\x99\xe4\x03\xac\xcf\x3e\x58\x93\xd8\xf9\x6c\x64\x92\xa3\xdd\x96\x75\x08\xb9\xf9\x41\x47\x60\x87\xb9\xa8\xad\x1d\xd6\xf7\x0a\xf9\x15\x44\x78\xc4\x94\x0e\x8b\x10\x86\x4f\x26\xe9\xf7\xe4\xf0\x69\x78\xd1\x97\x14\x51\x36\x78\x44\x4d\x3e\x45\x1d\x8d\x37\x52\xa5\xfa\xc6\x3c\xc6\x13\xfb\xd1\x92\xf0\x26\x7e\x02\x0e\x49\x21\xd5\xdc\x78\x8e\x26\x10\x45\x95\x03\xd0\x57\x81\x29\xcc\x6e\x77\xcc\x49\x05\x89\xe4\x2c\xd7\x4b\x7c\xe8\xae\xfb\x12\x84\xce\x32\xcc\x1f\x37\x1a\x1b\x7b\x18\xcf\x97\x86\xbf\xca\x81\x7e\x21\x79\x76\xb9\x12\x49\x27\x4d\xf9\xf6\xfd\xe5\x69\x93\x0c\x28\xf7\x9b\x85\xc0\x08\x26\x5d\xf9\x00\x1d\xc6\xd3\xa5\x34\x06\x23\x83\x62\x0a\xd7\xf4\xb0\x23\x5f\xfd\x3e\x97\xc5\xa2\x9c\x8e\x13\xbd\xac\x15\xa2\x8d\x8c\x9c\x9b\x13\xc7\xd9\x23\x98\x11\x1d\xda\x4d\xaa\xcc\x57\x6e\xa1\x82\x42\x44\x69\xd7\xf5\x87\x83\x49\xc2\xac\xc8\x34\x81\x85\x1d\xd0\xa9\x2b\x9e\xb8\xbb\x3c\xd8\xb8\x87\xf5\x54\x4f\x76\xec\xdd\xdd\xda\x8b\x08\x00\x9e\x96\xed\x05\x52\xbe\xeb\x2c\x0a\x33\x66\xf7\xda\xa2\x20\x38\x23\xfb\xc9\x16\xc4\x99\x8b\x10\x77\xec\xb4\x0a\xdf\x54\xcf\xb3\x54\xd8\xa6\x1a\x61\x20\xe0\x58\xab\xf0\x24\x12\x66\xb5\xb3\xd5\x45\xeb\x39\x1b\xc0\x1b\x7c\x6e\x7d\x50\xd1\xa4\xae\x88\x4b\x8e\x22\x5b\xc1\xe6\xf0\x6c\xb5\xe0\x23\x74\x0e\x50\x5f\xa1\x12\x74\x86\x18\x99\xe4\x42\x2b\x0d\x76\xe0\x14\x6a\xd1\x96\x2b\xad\x50\x44\x20\x0e\xe2\x72\xa6\xac\xa8\x0e\x85\xda\x12\x05\xbc\x65\x9a\xb7\x04\x3f\xf5\xd4\x2d\xf6\xef\xc1\xb1\x82\x0e\x97\xeb\xb4\x82\x22\x47\x04\x4b\x5c\x88\x4e\x44\xed\x31\x90\x0b\x83\xe9\x4e\xc5\x44\x9e\xeb\x1c\xf5\x94\x0a\x09\x09\x22\x33\x79\x3d\x3e\x01\x68\xe3\x53\x58\x57\x60\x83\x09\xb6\xf2\x85\x8d\x33\x15\x6a\x71\x14\x51\x03\xd2\x25\x66\x33\x91\x80\x19\xdf\x60\x3c\x1b\x5e\x3b\x92\xe4\xbc\x3e\xfc\x57\x6f\x7f\x29\xb4\x47\x51\x5e\xca\x5b\x18\x75\x9d\x7a\x35\x72\x32\x6d\x8f\x2a\xb9\x9b\xcc\x31\x04\x25\x55\x28\xb3\xa7\x9d\x8f\xae\x94\xb1\x4e\xd1\x57\x17\x16\x50\x22\x57\x87\x06\x27\x37\x1e\xc2\x7f\xf5\x10\x2a\xf8\x3c\x79\x19\xa9\x6d\xa8\xb9\x96\x46\xbe\xa5\xb3\xda\x05\x6b\xcb\x13\x00\x03\xc1\x5b\x5e\x36\x0f\x43\xa4\xc8\x76\xe7\x6b\x1e\xca\xc5\xd0\x29\x5b\xbf\xb1\x99\xb7\xd9\x99\x93\x21\x93\xbc\x2f\x77\xf3\x40\xd8\x9b\x4c\xfb\x7c\xf6\x64\x91\xef\x58\xb3\x8e\x4c\x34\xce\xfc\x7b\xda\xc8\x1a\xf9\x4f\x1d\xfa\x49\x8f\x87\xdd\xe3\x61\xf7\x78\xd8\x3d\x1e\x76\x8f\x87\xdd\xe3\x61\xf7\x78\xd8\x3d\x1e\x76\x8f\x87\xdd\xe3\x61\xf7\x78\xd8\x3d\x1e\x76\x8f\x87\xdd\xe3\x61\xf7\x78\xd8\x3d\x1e\x76\x8f\x87\xdd\xe3\x61\xf7\x78\xd8\x3d\x1e\x76\x8f\x87\xdd\xe3\x61\xf7\x78\xd8\x3d\x1e\x76\x8f\x87\xdd\xe3\x61\xf7\x78\xd8\x3d\x1e\x76\x8f\x87\xdd\xe3\x61\xf7\x78\xd8\x3d\x1e\x76\x8f\x87\xdd\xe3\x61\xf7\x78\xd8\xdb\x78\xd8\xa6\x48\xa5\x7a\x71\x10\xb1\xb9\xf7\x80\xc7\xb8\x0a\x54\xdf\xb6\xdc\x42\x91\x31\xce\xa6\xe5\x6c\x06\xe9\x06\x9d\xdb\x51\xf8\xc3\xf0\x4e\x6b\xc7\xd8\xab\xf7\x56\xa2\xae\xc6\x01\x51\x2b\x01\xc7\xc6\xd8\x32\xfe\x7b\xc8\x93\xe0\x35\x2c\x02\x61\xad\xcc\xf3\xec\xbb\x37\xf1\x28\x37\xf4\x82\x3f\x1c\xeb\x77\x2a\x11\x1d\x37\x65\xc7\xfa\xf9\xea\xe0\x24\xd3\xa6\x7d\x63\x80\x04\x0e\x82\x25\x0b\xae\x94\xf0\x5e\xbf\x2c\x30\x7a\x36\x15\x42\x31\xbd\x12\xd0\x1f\x33\xdd\x40\xa9\x86\x54\x73\x42\x47\x10\x2f\x0a\x9e\x2c\xc6\xec\x47\xb0\x4b\xdc\x86\x54\x65\x98\xee\x1b\x53\xe4\x82\x2f\x7d\x61\xed\x92\x13\x6a\x68\x60\x28\x8c\x27\xb9\x36\x50\x1f\x9b\x15\x72\x95\x01\xf8\x09\xbc\x8c\x19\x81\x95\xff\x06\x19\x28\x2c\x6c\x2b\x49\x69\xea\x35\x9d\xc3\x6a\xb4\x6e\xda\x5a\xd5\xf9\x1e\x62\x11\xed\x25\x34\x80\xb3\xbb\x5c\x15\x1b\x06\xdd\x4a\x99\x83\x54\xcd\x4d\xc1\x92\x4c\x02\x1a\xaa\x1d\x31\x80\xcb\x00\xa0\x4a\x2a\x55\x3b\x49\x67\xee\x29\xb7\x52\x76\x78\x78\x5e\x42\x9a\x65\x55\x18\x86\xe5\x92\xd5\x0b\xed\xab\x5a\xe9\xa6\xd2\x38\xef\xd8\x0c\x01\x46\xc6\x29\x6d\x64\x24\xbf\x12\xc8\x4a\xfe\x70\x86\x7d\x6a\x3f\xe9\xdc\x23\xb5\xe1\x84\x35\x84\xc2\x29\xe1\xc2\xb2\x41\xd8\xb1\xc6\xba\x95\xaa\x17\xc0\x61\xbd\x6c\xbe\x66\xf3\x62\x69\xf9\xb6\x2a\x68\xa5\x8a\x0c\xa8\xc4\x1a\xda\xcb\x44\x22\xc0\x94\xe2\xf7\x48\xfe\xde\x04\xbf\xe0\xf9\x5c\x14\xa1\xd0\x9d\x52\xe7\xdb\x50\x01\x75\xb4\xde\xba\x7b\x5d\xad\x0a\x2c\x40\x0b\x45\x86\x35\xab\xd8\x3e\x01\x0b\x87\x3a\x7e\x17\x9c\xb0\x1d\xac\xc5\x86\x6b\x25\xb9\x8b\x80\x37\xa7\xf3\x32\x28\x66\x18\xb4\x59\x71\xca\x95\x07\x47\xe7\x93\x57\x43\x36\x39\x7f\x6d\x6b\xcd\xec\x54\xeb\xc7\x11\x72\x91\x3b\x0a\x80\x78\xfb\x96\xdf\x07\x9c\x0c\xbc\x69\xc7\x78\xb3\xe0\x05\xb2\x44\xc4\x40\xc1\xe5\x32\x0b\x9e\xbb\xb2\x53\x67\xdc\x8f\xd9\x85\x2e\x1c\x1a\xd3\x4e\x8d\xdd\x4a\x77\x59\x9a\x10\xc4\x73\xd2\x62\x2d\xda\xf1\xc1\x9e\x82\x50\x35\xb3\xf6\xbd\x30\x86\xcf\xc5\x84\x90\xfd\xbd\x2f\xf6\x06\xcf\xd6\x74\x09\x6a\xbf\x0c\x63\xcd\xf8\xcd\x41\x5c\x85\x64\xd3\xa1\x63\x4b\x3b\xbe\xc0\x53\x37\x39\xc0\xa5\xb7\x6f\xb9\x34\x16\xfb\x10\xab\xcc\x0b\x7d\xf7\x25\xf5\x3a\x4c\xb7\x08\xad\x44\xdd\xcb\x41\x3f\x80\xb5\xad\xc0\xad\xb3\x21\xf5\x69\x2e\xc5\x8c\xcd\x24\xb8\xea\xb6\xf8\x70\x08\xb1\xf5\x76\xfc\x16\x68\xe7\x81\xa6\x1e\x03\xc5\xb7\x5a\xf9\x68\x86\x9f\xf7\x98\xfd\xe8\x26\x5e\xe4\xa5\x02\xe3\xab\x3d\x7a\xe2\x1c\x4d\xa5\x53\x01\x2d\x47\x73\x2c\x6a\x04\xfe\xe4\x8a\xfd\xf5\xf9\x3f\xfe\xc6\xa6\x9b\x42\x38\xe8\xc7\x42\x17\x84\x7e\x35\xbf\x09\x99\x50\x73\xd8\x6b\x7b\x22\x37\xbb\x32\xc3\x0e\xe1\x55\x22\x84\x61\x82\x9d\xff\x97\xeb\x69\xd0\xba\x78\x3c\x9e\xa4\x62\x7d\x52\xdb\xff\x51\xa6\xe7\x63\xf6\x8a\x2b\xa5\xdb\x0f\x37\xf0\xe0\x57\x29\xac\xd1\x78\xf0\x84\xa2\xa2\x33\x99\x6c\xe2\xd4\xb7\x73\xc9\xd9\x42\xdf\xe0\xde\xd4\xa8\xfa\x9d\xa6\xd6\x7f\x03\x36\x80\x5e\x95\x19\x4e\x93\xbd\x09\x7d\xe2\x00\x3a\x71\xa7\x37\xf1\xee\xe0\x79\xd1\xce\x93\x85\x0e\xaf\x68\xca\x8d\xaf\xab\xf5\x43\xd6\xae\x3f\xc2\x65\x91\x5a\x09\x7b\x64\x39\x08\xd7\xb1\x37\x3c\xcb\xa6\x3c\xb9\xbe\xd2\xef\xf4\xdc\x7c\xa7\xce\xa0\xc5\xaa\x39\x97\x8c\x13\x62\x23\xc9\xa2\x54\xd7\x30\xdb\x6a\x98\x99\x9e\x33\x5d\x16\xab\xb2\xf0\x40\xe7\x3b\x16\xbc\x95\x30\x68\x88\xca\xb6\xf3\x01\xb8\xea\x2d\xe2\x56\x56\x11\x34\xd7\x22\xd6\xa6\xa2\xa1\xf1\x4e\x34\xc6\x07\xa5\xc6\x28\x30\xc0\xfe\x7f\x79\xfe\xd7\xbf\x5b\xf1\x04\xb0\xbc\xbf\x3f\xc7\x9a\x78\xd3\x6e\x2e\xa2\xa2\xc5\xf3\x0b\xec\xdb\x25\xcf\x32\x91\x37\x05\x0b\x18\xc5\x0b\x12\xa5\xa9\xcf\x0b\xd2\xde\xe4\xa8\xd8\x74\x74\x7a\x1e\xe9\x89\x5e\x5d\xfd\x84\x6e\xa8\x2c\x8c\xc8\x66\x43\xdb\x25\xe4\x83\x4e\x6c\x80\xf6\xf8\xc0\x69\x71\x5a\x9b\xd7\xb6\x95\x38\xde\x9b\x99\xb8\xd6\x59\x09\xa0\x09\x6b\x19\x7b\x89\x55\xe3\x49\x1f\xd4\x86\x2c\x1e\x08\xc6\x34\xd3\xc9\x35\x4b\xed\x2f\x5b\xa8\x32\xb7\x14\xa5\xa9\x42\x96\x61\xf9\xc7\x07\x7b\x29\xd5\xbc\x77\xe4\x6e\x4e\x58\xa9\xc1\x96\x7c\xb5\x82\x1a\x1c\x3d\x63\xbc\x95\x24\x63\x39\xbf\x69\x4c\x14\xe5\x12\x71\x5a\xc8\xc3\x8f\x4d\x5d\xda\x15\xa5\x98\x4e\x3b\xe6\x5d\x3d\xec\xb7\x0b\x20\x59\x7c\xfb\x86\x9e\x1d\x74\x68\x2f\xa9\xac\xce\xb0\x06\xd6\x78\x80\xb5\x14\x29\x95\xa4\x6e\x5f\xa8\x28\xd9\x8f\x2d\x62\x6d\xac\x93\x0a\x89\xba\x25\x2f\x92\x45\xc3\x05\xe2\x90\xc5\x31\xd2\x14\x42\x15\x3f\x20\x1f\xbd\xca\xb8\x6c\xf7\x83\x1c\xb7\x86\xfc\xcc\xfe\xa7\x4b\x4f\xeb\x8d\x6a\x6c\x74\xb0\xa7\x32\x53\x72\x84\x90\x5e\x26\x6a\x15\x0c\x82\x67\xb7\xca\x46\x63\xff\x26\x3a\x75\x0f\xa3\x81\x87\x46\xf9\x2e\x93\xbc\xdd\xdf\xaa\x5b\xec\xe1\x20\xa3\x1e\x54\x5d\x54\xd3\x0f\xd5\x9c\x9b\x9a\x09\xe6\x10\xa1\x9a\x2c\x9d\x2f\xa0\x90\x70\x71\x3b\xea\x23\x78\xcc\x0f\xb1\xa9\x54\xbc\x6b\x77\x10\xd1\xdc\x6a\x67\xec\x4e\xeb\xa9\xf0\xbe\xd8\x98\x59\x8c\x27\xf0\xde\xdd\x0b\x88\x54\x07\x2f\x06\xed\xab\x15\x2d\xa6\x7e\xc9\x72\xbd\xe2\x73\xf2\xc5\x41\x5b\x2b\xb7\x4d\xa2\x0e\xf9\x00\x06\x3f\xfe\xbe\x9d\xbd\x9d\xc1\x92\x63\x56\x17\x69\x89\xb4\x42\xaa\x01\x48\x0a\x57\xdb\xe2\xb7\x45\x91\x15\x3a\xc0\xe3\x40\x52\x93\xdd\x40\x9b\x74\xae\x4b\x95\xba\x60\x6d\x88\xa8\xbf\xdf\x9a\xc4\x85\x56\x82\xaa\x47\x8d\x4b\x9c\x36\x31\x25\x30\xcf\x27\x15\xfb\x7a\xfc\xf5\xf3\xdf\xd2\x81\x82\xa3\xdc\x3a\x50\x2e\xc2\x81\x62\x65\xf5\x49\xc6\xeb\x01\xfe\x3b\x8c\xf9\xbd\x0b\x65\x04\xfc\x7e\xf4\x6b\x10\x53\x1c\xbf\x82\xc0\x04\x75\xbb\xaa\xcb\xdf\x8e\x30\xc8\x09\x9e\x46\xad\x97\xfe\xb8\xe1\x37\x10\x69\x92\xec\xe0\x58\x6b\xd8\xe5\x4c\xca\xe9\x9e\x34\x9a\x53\x4a\x10\x29\x8d\x56\x68\x41\xec\x06\xe6\x01\xe5\xe6\x17\x8e\x4a\x58\xb3\xc3\x43\x76\x64\xe9\x0d\x6c\x83\xf1\xf1\x93\xb0\x9e\x5b\xc4\xb3\xdb\x55\xde\x61\x21\xcf\x6e\x57\x1c\x63\x5d\xab\xfb\x57\x94\x44\xd5\x39\xaa\xf4\x15\x25\x52\xfd\x97\x00\xa0\x12\xc3\x8c\x5c\xca\x8c\xe7\xd9\x06\x96\xf6\xd2\xce\x99\x4d\xcb\x82\x09\xb5\x96\xb9\x56\x4b\xea\x7d\x31\x8c\xad\x79\x2e\xe1\x9e\x0f\x96\x8b\x99\xc8\x01\x55\xc1\xb0\x67\x47\x3f\x9c\x7e\xc0\x02\xb1\x63\x04\x1e\x10\x6e\x5d\x88\x24\x4b\x03\x36\xc3\xf6\xdc\x6b\x43\xdb\x13\x03\xf9\x99\xc3\x6e\xc3\x29\xe1\x3f\x13\x89\xc2\xcc\x96\x65\x51\xda\x7b\x76\x6e\x93\xac\x34\x72\x2d\xc6\xbf\xae\x8d\x1c\x2c\x9b\xdf\xb0\x89\xec\xd0\x06\x5e\xcb\x56\x19\x6b\x48\x57\xc8\x0a\x0d\x8c\x07\x2c\xa8\x80\x08\xaa\x5c\x87\xd7\xcf\xed\xc1\xa0\x9d\x59\x87\x81\xf1\x25\xae\xc1\x29\x84\x43\x7b\x48\xd4\x84\x4b\x39\x5f\x14\xae\x76\xdc\xe3\x7d\x4a\xb5\xf5\x2a\xc4\x72\xf1\xb6\x79\x4c\xb4\xf6\x60\x2f\x5c\x45\xe1\xa7\x56\xee\x20\xf1\x45\x3b\x47\x40\x93\x49\xd8\xd8\x07\x6c\xf7\x06\x27\x9c\x37\x1e\x3a\xe8\xec\xca\x34\x88\x9e\xba\x24\xbe\xc5\x5c\x4c\xaa\x3b\x2d\x6b\x11\x03\x80\x62\x80\x16\xe7\x07\x68\x32\x7f\xa7\x83\x53\xff\xbc\xed\x06\x72\xaa\xe7\xc2\xf3\x79\xcb\x5f\x6c\x4d\x68\x70\x9a\xcf\x4b\xd0\xe4\xa1\x56\xbb\x82\x2c\xb2\x49\x8d\x14\x4a\xf4\xdb\x0b\xa1\x90\x5d\x21\x15\xf4\xea\xfd\xeb\x7a\x9d\x56\xe1\x4a\xd5\xc0\x36\xf6\xd5\x7b\x80\xbd\x6a\x0f\x84\x56\xb2\x84\x03\x63\xe7\x51\x40\xf0\x80\x1b\x67\xc5\x39\x98\xab\xe1\x98\x4a\x82\x43\x0c\xd7\xe8\x66\x6b\x07\x8b\x49\x1f\xad\x17\x67\xa9\x20\x2c\x6d\x2d\x8b\x4a\x55\x28\xa8\x17\x99\xc3\x3a\xbc\xd6\x25\x65\x15\x9e\x3d\xc3\x19\xe7\x22\x2d\x13\x9b\x25\xf3\xd5\x24\xec\x99\x53\x3a\xbe\x31\x1c\xa2\xb3\xc2\x24\x7c\xf5\xb0\x78\x3b\x2e\x5c\x88\xc6\xb2\x9a\x8d\x2a\xf8\xed\x0b\x26\xc7\x62\xcc\x0e\x9f\xd5\x7e\x75\x68\x47\xbf\xca\x35\x0c\x81\x44\xd8\xcd\x3a\x93\x05\xc2\x07\x1d\xd6\xa9\x8d\x19\x5c\x61\xba\x12\x69\xcd\x22\x88\xa9\x35\x98\x56\xdb\x0f\xb8\xcb\x73\x9e\xa7\x19\xe0\x95\xe9\x59\xb8\x34\x96\xb2\x5f\x61\xc3\xc5\xad\x84\x1b\xad\xa1\xde\x5e\x17\xbb\x02\x22\xb5\x1a\xb8\x56\xaa\x0f\xd4\xc8\x15\xdc\x5c\x9b\x13\xa9\x40\x21\x8e\xa0\xe8\x64\x54\x53\x24\x27\x16\x3d\x78\xe4\x40\x6c\x46\xdc\x89\x66\x75\x83\xf3\xc9\x9f\x5c\x39\xf4\x88\x87\xbf\x92\x6a\xc4\x47\x08\xd7\x32\xd8\x4f\xd4\x26\xc2\xec\xa0\x1e\xe6\x6e\xac\x71\x9a\xe9\xac\xc2\x4e\xc3\x37\x60\x2d\x80\xc7\x29\x4a\x9d\xe5\xdc\x42\x91\x79\x84\x9c\xba\x2e\xab\x74\xd5\xd9\xc5\xd5\x87\x9f\x26\xdf\x9d\x5f\x5c\xd5\x54\x56\x2b\xc9\x87\x55\xda\x3d\x2a\xab\x7d\xa0\xed\x2a\xad\x69\xde\x12\x2e\x1d\x69\x57\x69\x77\x54\x56\x2b\x4d\x9a\x4a\xdb\x52\x59\xad\x54\xa9\x2a\x6d\x5b\x65\xb5\x12\xa6\xaa\xb4\x9a\xca\x6a\xa5\x49\x56\x69\x35\x95\xd5\x4a\x94\xa6\xd2\xb6\x55\x56\x2b\x59\x92\x4a\xeb\x55\xd6\x1d\x95\x25\xd4\x3a\x4a\x5d\xbd\x73\xe9\xc1\x9a\x88\x86\xbd\xaa\x21\xbf\xc4\xf9\x1b\xbb\xb6\x6d\x3f\x2b\xd6\x18\xfb\x99\x5a\xff\xc0\xf3\xea\xf2\x5e\xac\xa2\xd9\x35\x91\x56\xb2\x60\xa9\x22\x09\xd0\x26\x9c\x05\xfb\x7b\xdf\xb1\xf9\x8e\xd1\x4a\x1f\x99\x04\x59\xdf\x35\xbf\xf6\x61\xba\x16\x07\xdf\xea\xc6\x5e\xfd\x72\xfe\xfa\xec\xe2\xea\xfc\xcd\xf9\xd9\x07\xda\xd3\x11\xbc\x1a\x89\xfa\xd2\x98\xea\xe0\xb1\xe7\xd1\x83\xa7\xd2\x2a\x17\x6b\xa9\x4b\x93\x6d\xc2\x95\x07\xb5\xf5\x24\xd2\xf6\xab\x6e\xbc\xb5\x1c\xf8\x1e\x03\x2d\x5c\x6d\x10\xf3\x44\x26\x8d\xcd\x8a\x25\x4e\xb0\xed\x89\x24\x77\x1d\x97\x6d\x16\x3e\x91\xf4\xce\x43\xf3\x21\x3b\x9f\x48\xf7\xa1\xa3\xf3\xa1\xa3\x91\x48\xfe\x9e\x03\xf4\x9e\x03\x92\x48\xf4\xc1\x63\xf4\x3e\xcb\x9f\x48\xfb\xe1\xc3\x34\xd8\xff\x5d\x22\x87\xe3\xc1\x93\x89\xff\x9b\x5c\x2f\x3b\xa8\x80\x4b\x5d\xe6\x49\x75\x51\x75\x4d\x86\xc2\xc4\x07\x86\x2c\x4e\x59\x59\x2f\x79\x0a\xfe\x7d\xe8\x13\x82\xef\xb1\xb8\x8b\xb6\xcf\x71\xfa\xde\x5d\xec\x34\x93\xf3\xf7\x7c\xf5\xad\xd8\x7c\x10\xe4\x16\xee\xed\x45\x11\x19\xd4\xeb\x43\x97\xa6\xd8\xc0\x59\x80\xa7\x94\x25\x4c\xe5\xd0\x2e\xa3\x87\x9f\x6b\x41\x4c\x57\xed\x1c\x39\x38\x30\xd7\x02\xa3\xf1\x06\x27\x41\x1f\x6e\x07\xc6\x8b\x3f\x69\x77\x0e\x7a\x50\x3f\x72\x9d\x44\x17\xd6\xf4\x8c\x22\xca\x22\xfa\xcf\xe0\x56\xad\xb5\x14\x37\x27\x2e\x00\x3c\x02\x57\x71\x64\xa3\x90\xe6\x04\x26\x64\x4e\xfe\x84\xff\x17\x39\x04\x44\x80\x60\xa7\x69\xea\x12\xc0\xa5\x81\x06\x6a\x9b\xa4\x35\x63\xc6\x57\xf2\x07\xa8\xa0\x89\xc1\x5b\x77\x8c\x21\x55\x3a\x64\xa5\x4c\x5f\x0e\x0e\x22\x1e\xeb\xba\xa7\xda\x57\x9b\x77\xdf\x57\xb8\xd7\x42\xce\x36\x0d\x6d\x1a\xe4\x28\x8a\x2c\x03\xad\x2b\x0b\x83\xf2\xe8\x31\x04\x9c\x51\x11\x45\x28\x36\x17\x4a\x8f\x73\x37\xff\x37\x82\x91\x92\xff\x3a\x22\x35\xe2\x7f\x90\x9f\xba\x6b\xb8\x41\xa5\xe2\x90\x92\x97\xbe\x95\x8e\x98\x24\xf3\x3d\x13\x50\x9f\x6c\xe1\xe4\xc7\x20\x32\xc3\xe6\x47\x6c\xee\x88\xe1\xf6\xff\x84\xc7\xf1\xf2\x43\xf3\x71\x30\xf8\xe7\xb7\x67\x3f\xfd\xf7\x60\xf0\xe9\x3f\xc3\xda\x6f\xf1\x9c\x41\x17\xb3\xf9\x27\x31\x13\x80\x9e\x63\xa8\xdb\x07\x15\x34\xb4\x1f\x9d\x3d\x79\x9a\x24\x90\x02\xc3\x5f\xc4\x50\xc4\x82\xed\x31\x54\x8f\x9c\x4f\x86\xfe\xe3\x4a\xa7\xdb\x9f\xcc\x78\xf0\xc4\x47\x49\xa5\x6b\x1e\x21\xc4\x8e\x82\x67\x10\xc4\x7d\xe3\xa4\x20\x50\xf3\xe7\x0d\xb0\x99\x2f\xbe\x0c\x6d\x1d\x0a\x5b\x4f\x20\x80\xd1\x01\x3f\xd6\x09\x8f\x66\x87\xeb\xaf\xc9\x96\xe3\xa3\x94\xe2\xcc\x4f\xe2\x11\x0b\x8a\x6b\xe0\x56\x13\xe9\x55\x87\x75\x14\xd1\x50\x4e\x19\x12\x95\xec\x74\x72\xce\xd6\x76\xbf\xbe\xc0\x72\x74\xd1\x8a\x61\x01\x9f\x52\x37\x82\xcf\x06\x56\xed\x9b\xbd\xe9\x48\x4f\xd1\x4b\x41\x70\x42\xe9\xa4\x01\x62\x27\xdb\x04\x4a\xae\x09\xc1\x04\x44\x06\x01\xb9\x85\x23\xfb\xe5\x38\x59\x95\x31\x5a\xcc\x3d\xb5\x14\x4b\x9d\x6f\x86\xfe\x63\xe8\xbf\x1b\x99\x42\xe7\x7c\x1e\xa5\xc6\xfc\x90\x70\x28\x61\x80\xee\x15\x8d\x41\xdf\x7d\x0f\x35\x6e\xe0\x63\x07\x49\x99\x83\xc5\x97\x6d\xfc\x79\x42\x68\x27\x7a\xac\x6e\x4c\x62\x3a\x43\x1f\x62\x92\x10\xbe\xc2\x3c\xf7\x8b\x60\x2b\x44\x91\x44\x04\x0a\x57\x94\x63\x86\xc1\xf6\xc2\x6f\x85\x5a\x83\x2b\x46\x02\x41\x78\xa4\x44\x33\x96\xca\xb5\x34\x9a\x58\xb2\xe4\x7f\xa2\xd0\xb2\xbc\x26\x88\x83\xd0\xd8\xf5\x6c\xf4\xe4\xb6\xb6\xee\xd2\xe9\x4d\xdb\x0b\xe0\xba\x83\x2c\x12\x48\x14\xd1\x70\x71\x9a\xb8\x5d\x69\xf0\x78\x83\x8c\x37\x40\xce\x22\x69\x1e\x7e\x4d\x47\x3c\x77\x68\xc2\x85\xc8\xd5\x0b\xf6\xef\xa3\x9f\xbf\xfa\x3c\x3a\x7e\x79\x74\xf4\xf1\xf9\xe8\x1f\x9f\xbe\x3a\xfa\x79\x8c\xff\xf8\xf3\xf1\xcb\xe3\xcf\xfe\xc3\x57\xc7\xc7\x47\x47\x1f\xbf\x7d\xff\xf6\x6a\x72\xf6\x49\x1e\x7f\xfe\xa8\xca\xe5\xb5\xfd\xf4\xf9\xe8\xa3\x38\xfb\x44\x24\x72\x7c\xfc\xf2\x59\xd4\x30\xf7\x02\x3e\xdb\x54\xf3\x2f\xba\xb3\xc0\xe0\x83\x3f\xc3\x02\xb5\xea\x34\x1e\xfc\x36\x8f\x50\x3f\xd0\xa7\x3c\x41\x8d\x48\x72\x51\xec\x3b\x86\x62\xa9\xc6\x5c\xe5\xe2\x80\xbe\x10\x74\x2a\xb8\x12\x4f\x7c\x32\xec\x27\x00\xe3\x54\x82\x9b\x72\xb4\xf4\x5b\x0e\xc4\x3a\x4f\x5f\xc1\x8f\xa9\x04\xbc\x09\xcf\x2d\x64\x24\xc9\x6b\x41\xbe\x3c\xe5\x11\x1c\xdd\x07\x83\xfa\x60\x10\x39\x18\x74\xd9\x85\x8f\xfb\x48\x50\x3d\x12\x14\xf5\x00\x7d\xf4\xbf\x5e\x4d\xaf\x50\x6b\x4a\x2a\x63\x67\x5e\xdd\xbb\x56\xf5\x76\xfa\x98\xac\xe0\xfd\xc9\xc6\xb1\x57\xec\xc6\x33\x5b\x44\x6d\x11\x8e\x8a\x2d\x77\xe7\x83\xf1\x82\x52\xa9\xac\x62\x87\x17\xb4\x52\xf4\x09\xc4\x5c\x38\xd0\x61\x8b\x68\x21\xd6\x90\xb4\x09\x40\x95\x61\xe8\x14\xf4\x6e\x44\x0b\x92\x6a\xee\x7a\x94\x6c\xde\xc3\xe5\xbb\xa4\xaa\x90\xa0\xdc\x02\xd3\x0a\x1d\x6d\xd6\x87\x1b\xa3\x13\xc9\x43\xdf\xbe\x47\x19\xf0\xcb\x82\xb3\x81\x3b\xf3\xa2\x6e\xd3\xfe\x01\x68\x57\x7b\x31\x05\xb4\x00\x76\xa6\xd6\x1e\x5d\x33\x2d\x6d\xf9\x09\xee\x19\x0d\x9a\x6c\x7b\x0c\x5f\xac\xac\x02\xd8\xdd\xa5\xe1\x6a\xd5\x15\xb0\x4e\x6e\x89\x48\xad\xc9\x60\x5e\xa1\x10\x84\x08\xbb\x39\xd8\xaf\x81\x14\x72\x6b\x64\xa3\xb0\x31\xd3\xab\x7a\xf4\xbf\xb2\xb2\xe9\xbd\x2c\xf1\xe6\x5c\x9c\x29\x42\x35\x43\x88\x15\x9e\xd5\xcf\xaf\x66\x82\x3c\x89\xf9\xd1\xc5\xf4\xe8\x60\x76\xc4\x9b\x1c\xad\xe6\x46\xe0\x3e\x32\x49\xd6\xd9\xc4\x88\x37\x2f\xa2\x4e\x72\x90\x06\x31\x93\xb7\x2f\x0e\xa2\x57\xe6\x54\x55\xf1\x25\x99\x0a\x55\x40\x0c\x24\x07\x81\x5c\xc1\x85\x61\xf4\x1e\x57\xcd\x04\x4f\x16\xa8\x60\xa5\x6a\x2e\xf0\xf8\x4b\x56\x3f\x59\x6f\xa8\xbb\x52\xba\xf4\x3e\x5a\xaf\x91\x7a\x8d\xf4\x65\x35\x92\x63\x3d\x32\x22\x75\xa8\x02\xfe\x8d\xa9\xa3\x27\xf0\x03\xb0\xfe\xfe\xc5\x41\xc4\x02\x0f\x5e\xd7\x2a\xf7\x51\xd2\xea\xd2\x41\xe2\xf9\x60\x34\x9b\x13\xa4\xd2\xce\xda\xcd\x0e\xfc\xa0\x58\xa1\x2a\x0f\x0a\xd3\xd9\x42\xce\x61\xb3\x33\xb1\x16\x99\xb3\xa0\x5a\x69\x2e\xb9\xe2\x73\x01\x05\xfc\xa0\x94\x5c\x08\x19\x2a\x20\x3c\x22\x7a\xe5\x97\x30\xe2\x30\xa5\x62\x1e\x71\x19\x1f\xce\x35\x00\x56\x41\x12\xea\x5a\xb0\xd7\x62\x95\xe9\xcd\xd2\x15\xf5\xa6\xec\xb2\xe0\x05\x18\x0a\x97\x80\x49\x39\x38\xd8\x93\x20\xe1\x48\x27\x65\x96\x75\x80\x73\x1b\x9c\xc3\xc3\x6c\x55\x42\x29\x21\x3e\x3e\xc6\xfb\xbd\xf5\x8c\x9d\x22\xa2\xf0\x90\x5d\x00\x18\x57\xbb\xd6\x38\x9f\x5d\xe8\x62\x92\x0b\xd3\xe8\xfe\x85\x75\xb6\x84\xa0\x1d\xed\x05\x40\xbd\x01\xf8\x03\x9f\x93\x9c\x27\x9f\x7f\x1d\xc2\x1e\xd5\x5f\x50\x75\xfe\x77\xf0\x26\xd8\xa3\x99\xf7\x4f\xf8\x26\x88\x67\xd9\xcf\x7b\xdb\xca\x80\xd4\x1d\xb5\x89\xa7\xee\x56\xeb\x00\x99\x54\xe3\x73\x8b\x32\xe3\xfa\xd1\x69\xae\x9a\x04\xa8\x5e\xb3\xd2\xca\xf8\x2b\x68\x9c\x48\x84\xd1\x59\x97\xd8\xf8\xb5\x6f\x25\x4b\xdf\x9b\x98\x83\x7f\xa5\x4d\x71\x09\xfe\x75\xfb\x9f\x6e\x73\xfd\xc4\x3f\x0a\xba\x25\x01\x8c\xb9\xb4\x71\x73\x00\xfd\xbe\x8c\x1a\x04\x0e\xd2\x42\x84\x4a\x8f\x0e\x2c\xd8\x82\xab\x34\x03\xf4\x70\x2e\x33\x02\xf8\x9d\x0f\xcc\x37\x68\x7a\xc0\xbf\x00\x64\xec\x6f\x86\xe3\x49\xa2\xf3\xb4\x8d\xa7\xfc\xff\x0a\x8d\xe1\x3d\xf7\x78\x25\xed\x20\x49\x75\x8e\xb1\x86\x10\x89\x64\x35\x4c\x84\x2b\x33\x35\xd4\xe8\x85\xd6\xd7\x70\x21\xfc\x2a\x43\x23\x88\x1e\x66\xee\x24\x96\xe1\x9f\x15\xd6\xfd\x08\x46\x60\x4e\x6a\x90\xf7\xf8\x45\x9b\xa8\xc6\x32\xa1\xbf\xbd\x9f\xf6\x97\x5b\x7c\x08\x9a\x16\xce\x05\x2c\x60\xd0\x2a\x18\xa1\x33\x0d\x47\x1c\x75\x63\xe1\xa7\xc2\x9b\x08\x45\x2b\x63\x76\x76\x2b\x92\xf0\xd9\x26\x63\xed\x1d\x5d\x64\xb2\xd0\x95\xcc\xaf\xc9\x9d\x19\xb1\x0b\x17\xd1\x9d\x78\xef\x1a\xbe\xb2\x6d\x4d\x1e\x79\xce\x91\x43\xb4\xc9\x08\x92\x28\x1e\xae\xbb\xd1\x03\xd7\x35\x04\x11\x23\x73\x1e\xd4\x20\x8a\x70\x00\x40\x08\xb5\xe0\x7e\x8c\x30\x66\x00\x4a\x61\x47\x83\x93\xc1\xf1\x41\x87\x0a\xa5\x30\xbc\x2d\x14\xe0\xab\xea\x2d\x71\x64\x11\x78\x64\x85\x68\x19\x22\x19\xa4\x43\x26\x0b\x5f\x5e\x6e\x21\xb0\xe1\xa2\xfa\x28\x92\xae\x05\x74\xc8\x0c\xe0\xd4\xf3\x54\x3a\x43\x0e\xbf\x85\xa5\x2e\xf2\x12\x99\xd2\x1c\x10\x29\xe2\x7f\x47\x83\xcf\x03\x87\xaa\x7d\xa3\xd5\xa0\xc0\xad\x19\xb3\x2b\x0d\x9e\x4f\x78\x69\x14\x49\x80\x0e\x50\xc2\x76\x78\x8b\x5b\x08\x73\xca\x22\xdb\xe0\xf1\x00\x10\xa7\xf0\x35\x9c\xb0\x51\x34\x5d\xfb\xeb\xd9\xad\x2c\x5c\x8d\x22\xc8\xf8\x73\x58\x53\xb8\x43\x00\xb5\x78\xdc\xc4\xe1\x16\xc2\x93\x85\xe0\x59\xb1\x80\xd8\x6c\x8a\x37\xe9\xd8\xdb\x72\x40\xff\xba\xdf\x50\x25\x36\x22\xba\xda\xc9\xa0\xe9\xe6\xa2\x74\xf2\x7f\x2a\x07\xfc\xad\x28\x3a\xa9\xe3\x6f\xae\xae\x26\x6f\x1b\xb7\x1a\x82\xcc\x03\x45\x5f\x93\x45\xa4\xca\x6a\xf7\x86\x3e\xa5\xee\x8c\xb9\x6e\xf0\xee\x6c\x77\xdc\x33\xd8\xb8\x3f\x30\x82\x30\xdb\xba\x6b\x90\xfd\xa4\x4b\x08\xe2\x4c\xf9\x34\xdb\x20\x1e\x87\x6b\xc2\x8c\xa2\x79\xf8\x8d\x36\xc5\x21\x54\x63\xc2\x1e\x7c\xe3\xee\xf1\x07\x95\x21\x38\xc1\xca\x7f\x24\xbb\xd6\xde\xd9\x79\x8d\x5f\xe1\xed\xfc\xee\xe2\xf7\x5a\x23\x2a\xd9\xc2\xf2\x3f\x8e\xff\xc6\x78\x0b\xb3\x6f\xcd\x86\xa8\x22\x9a\x85\x8e\xfe\x13\x0b\x7d\x93\x7b\xae\xae\x26\x76\x75\xdc\x8c\x2d\xd2\x6d\x82\x13\x8e\x22\xcb\xdc\xf2\xd4\xd1\x7a\xa5\xc2\x17\xb8\xbb\x8e\xa3\xc8\x75\x91\xa3\x2e\x21\xc4\x9d\xab\x72\xe7\x96\x7f\x52\xb2\x77\x2f\xec\x5a\x4b\x0d\xee\x7b\x12\x71\x17\xfc\x3f\x7a\x16\xf1\xa5\x00\x11\x89\x75\xc6\x18\x63\xff\xc7\xde\xd7\xf7\xb8\x91\x1b\x79\xff\x3f\x9f\x82\x30\x82\x67\x66\x9e\x8c\x64\x7b\x13\x04\x89\x11\x20\x70\x6c\xef\xc6\x88\x5f\x04\x8f\x9d\xbd\x60\x93\x5b\x50\x6a\x4a\xe2\xb9\x45\xea\x9a\xdd\x33\xd6\x61\x3f\xfc\xe1\xc7\xb7\xee\x96\x46\xd3\x45\x8d\x94\xf5\xe6\x78\x73\x08\xd6\xf6\x74\x35\x9b\xac\x2a\x56\xd5\xaf\x5e\xfc\x7f\x8d\x0e\xf8\xb2\xe4\x0b\xe9\xd0\x9b\xcf\x26\x1e\x12\x7b\x05\xde\x71\x88\x36\x19\x3e\x4e\x2b\x0d\x53\x48\x21\x54\x09\x14\x91\xaf\x56\xdd\x50\x0a\xc8\x1f\x78\xec\x29\x23\x64\x0f\x4a\x8c\x3d\x3c\x2d\xf6\xe0\xa4\xd8\xde\x71\x38\xd0\xb3\x62\x2a\x4e\x9c\x0b\x23\x65\x13\x28\xb2\xdd\x03\xbd\x7b\xac\x6c\x12\xcd\x69\xac\xa6\xb6\x23\x68\xef\x1e\x2d\x9b\x4a\xf1\xd0\x31\xb4\x47\xcd\x64\xb5\x25\x35\xe2\xd9\xa1\x67\x76\x6d\x1f\xc7\x6e\xc0\xa5\x80\xf3\xe6\xad\xa4\x34\x3e\x88\xb6\x11\xac\xb5\x7e\x64\x14\xe2\x78\x62\xd9\x4a\x55\xa6\xe4\xc1\xbc\x07\x29\xc3\xa4\xa1\xe3\x3b\x07\x72\xff\xe0\x71\x22\x45\xd6\x0e\x28\xa7\x0e\x1f\x27\x53\x76\x69\x01\x84\x01\xe4\x64\x8a\xd4\x41\xe5\x3f\x8b\x07\x71\xac\xa1\xe5\x47\x1a\x5c\x9e\x6f\x9f\xbd\xb7\xcf\xde\x81\xe6\xf9\xf6\x39\xd1\xed\xf3\x35\xe9\xdd\xa4\x5f\x5f\x57\xe2\xba\xd6\xeb\x67\x67\x89\x3c\x76\x3e\x71\x0f\xee\x41\x54\xa6\x62\x4e\x45\x00\xf8\x5e\xf8\xa3\x68\x2c\x22\xc5\x95\x2d\xc8\x4c\x89\xc9\xe8\x1e\xc4\x61\xe1\x2b\x3b\xd4\x8c\x71\x40\xb5\x37\x42\x09\x63\x1e\x5b\x60\xa4\x59\x3b\xaf\x93\x44\xd6\x4f\x80\xba\x42\xd2\x10\xda\x6f\x48\xad\xd0\x17\xc5\xa7\x17\xfa\xf9\x55\xf6\x2f\x45\x3d\xa3\x71\xe1\xc7\x0e\x6a\xe4\x23\xaf\x61\x3b\xb7\x07\x25\xce\x2a\x6e\x96\x44\xf7\x18\xf5\x6e\x5f\xa4\x1f\x85\xc8\x2a\xc1\x0d\x26\xb4\xe9\x2a\x6e\x2f\x4a\x91\xa5\x61\x6b\xcc\x6f\x2b\xce\x52\xcc\x1a\x07\x71\xb9\x44\xde\x89\x2e\xb6\xc7\xde\x75\x67\xd4\x93\xe8\xda\xea\xf0\x42\xdf\x2a\x36\x15\x0b\xa9\xd0\x7e\x1f\xbc\x63\x5f\x16\x98\x0c\xf7\x22\xb6\x84\xf6\xf1\xa1\x6d\xe1\x98\x7d\x88\x3d\x6b\x82\x16\xd4\x4d\x3d\xd3\xad\x52\xf4\x5f\x73\x08\x54\x87\x64\x58\x87\x8c\xba\x86\xd3\x61\x13\xc2\xb8\x0c\x32\xc9\x81\x4d\xdc\xc5\xec\x48\x94\xf5\x7c\x6b\xbd\x03\x98\x1d\x8d\x68\xd5\x79\x7e\xdf\x82\xa1\x12\x2a\xa4\xb4\x51\xb0\xf9\x87\xe1\xf3\x19\x08\xcc\x40\x60\x06\x02\x33\x10\x98\x81\xc0\x0c\x04\x66\x20\x30\x03\x81\x19\x08\xcc\x40\x60\x06\x02\x33\x10\x98\x81\xc0\x0c\x04\x66\x20\x30\x03\x81\x19\x08\xcc\x40\x60\x06\x02\x33\x10\x98\x81\xc0\x0c\x04\x66\x20\xf0\x1e\x20\x90\xfc\xab\x01\x3d\x9b\x00\x34\x7b\x76\x96\xc0\x8c\xe7\x13\x0b\x67\xc8\x19\x14\xd6\xd4\x72\x63\xe4\xa3\x08\xca\x0d\x9f\x58\xdb\x27\xb2\xed\xdf\x12\x2a\xa4\x3c\x64\xe6\xe8\x03\xac\x3b\xa8\x66\x2d\x15\x8f\x08\x05\xa1\xe6\xf1\x5a\xbb\xff\x69\xd1\x88\x0e\x0c\x61\x57\x35\x88\x43\xa4\x28\x73\x2a\xfe\x90\x86\x3d\x78\x4c\x81\x40\x97\x51\x71\x87\x14\x3c\x21\xf5\x36\xf3\xe1\x6e\xda\x2f\xd3\x31\x84\x94\x46\x93\xc7\xc7\x0f\xa8\xd8\x41\x4a\x0f\xc0\xde\xb2\xf6\x17\x10\x51\x41\x56\xfc\x10\x30\x83\xf4\x90\xfc\x20\x5e\xd0\xc1\x00\xc8\x44\xef\xc1\x0a\xf6\xc4\xff\xc9\xa4\xb7\x71\x82\x7b\x62\xff\x64\x9a\x2d\x46\x70\xe4\xb8\x7f\x62\xf8\xef\x00\x23\x24\x2d\xe2\x91\x70\x45\xc5\xf4\x8b\x8f\xcb\x4a\x98\xa5\x2e\x8b\x64\xdd\xf7\x56\x2a\xb9\x6a\x56\x90\x4e\x03\x91\x95\x37\x22\x10\x35\x41\xd2\x08\x34\x99\xbf\xc1\x5c\x05\x03\x88\xc9\x42\x60\xae\x30\x68\xa1\xc4\x0b\xe5\xc4\x6c\xc9\xad\xaf\x61\x9a\xd9\x4c\x88\x82\x8a\x85\x77\xdd\xc4\xdf\x8c\xe3\x8a\xe3\xe4\x97\xa7\x14\x32\x28\x44\xe2\xb5\x4d\x40\xfb\xcd\x37\x84\xdf\x4f\xb3\x52\x13\xa0\x96\x53\xc0\x2c\x69\x10\x4b\xea\x75\x92\xe2\x18\x9d\x08\x56\x21\x42\x2a\x1e\x26\x21\x93\x7d\x18\x9c\x92\xac\x09\x0e\x80\x51\xc8\x10\x4a\x84\x45\x88\x84\xd9\x51\xe0\x93\x44\xdd\x79\x12\xd8\xe4\x68\x90\x49\xaa\x5c\x1c\x0a\x95\xdc\x8f\x30\x24\x87\xee\x0f\xb8\x90\x0e\x84\x47\x8e\x0d\x8d\x1c\xb4\xf2\x54\x6f\x32\x19\x0e\x49\x85\x42\x92\xee\xeb\x43\x0c\x82\x34\xf8\x83\x08\x7d\x24\xc2\x19\xc9\x47\x95\x12\x48\x4a\x0a\x22\x1d\x16\x40\x3a\x28\x78\x44\x84\x2d\xda\x0d\x26\xd2\x65\xf7\x07\x8d\xb6\x03\x41\x64\xaa\x77\x06\x8c\x1e\x12\x04\x3a\x4a\x00\x28\x0d\x7a\x20\xc3\x0e\x29\xde\xe8\xc3\x21\x87\x44\xee\x49\x51\x52\xe4\x70\x57\x92\xa2\x91\x4a\xd6\x92\x97\x2f\x45\xc9\x37\xd7\x62\xa6\x55\x41\xba\xd5\x7a\xdb\x7f\xee\x99\x12\xed\x8f\x1d\x09\x6f\xc7\xf7\x78\x97\x40\x95\xb1\x25\x37\x2c\x84\xa4\x7c\x32\x73\x88\x6f\xf9\x6b\xda\x0e\x20\x77\xab\xae\x4f\x94\x25\x7b\xd4\xa8\xd4\xe9\x9d\x0a\x97\x3a\x7c\xe8\xe1\xfd\x45\xdf\x32\x3d\xc7\x24\xac\x0b\xa9\xc2\xf9\x5d\x76\x5c\x85\x34\xcf\x2e\x8a\x0e\x28\x3c\x7d\x12\x08\xee\xba\x63\x24\x92\x5f\x81\xcb\x66\x9d\x50\x63\x8e\xeb\x39\x7b\xa2\xc7\x71\x9d\x3d\x31\x34\xb1\xec\xba\xcf\x24\x92\xce\xed\xee\xeb\xbb\xa7\x6d\xeb\xd4\xa7\x76\x7d\x41\x02\x49\x14\x11\x67\xf1\xe5\x21\x5f\xa7\x0f\x9e\x04\xa8\x26\x80\xa9\x2d\x48\x4a\xa0\xcb\xa8\x40\xaa\x03\x48\x49\x14\x09\x20\x2a\x19\x1c\xfd\x97\xf9\xfe\xc7\x02\x44\x8f\x00\x86\xfe\x1f\xb7\x5d\x61\xbb\xee\x05\x3d\xb3\xed\x7a\x8f\xed\xfa\xf3\x5b\x71\x9d\x7a\xa2\xef\x50\xff\x34\x79\x90\x49\x10\x44\x92\x15\x4d\xe5\xcb\xdd\xa2\x65\x10\x04\x8b\x40\x93\x59\x68\xc0\xc6\x9f\xc2\xfa\x84\x2b\xcf\x9a\x37\xa8\xfa\x6a\xd6\x5a\xf9\x3b\xcd\x47\x92\x69\x67\x86\x8c\xd1\xed\xa2\x29\xac\xea\xae\xd5\xd2\xdb\x53\x7a\xf4\xd3\x5f\xcb\x55\xa3\x14\x9c\x07\xcf\xa3\xd0\x24\xb0\x3b\x8d\xd3\xae\x9d\xed\x26\xd1\x36\x72\xa1\x78\x69\xb1\x43\xbc\xa6\x96\x2b\xd1\x4e\xc9\x68\x5f\x8a\x37\xcc\x75\x35\x93\x53\x22\x86\xb2\xe4\x65\x1c\x6a\xc1\xd9\x67\x80\xba\xee\x55\x63\x76\x8d\x76\xe2\x68\x0c\xec\xae\xde\x52\x2b\xaa\x22\xa9\x97\x5c\x85\x59\x72\x62\x06\xfa\x33\xcc\xa7\x69\xd6\x6e\xdd\x30\x08\x36\xba\xa9\xc2\xba\x69\x67\xf6\x7a\xde\x5d\x0d\xa0\x2e\x59\x5e\x85\xad\x3d\x37\xf7\x33\x30\xe9\x0d\x01\xd2\xc6\xc0\x77\x5f\xf7\x87\xae\xb7\x57\xdd\xf7\x86\xe6\xc5\x34\x8a\x58\x9d\x5b\xef\xba\xd2\x37\xb2\x80\x4b\xb2\x89\xec\x00\x0b\x60\xec\x86\x80\xd0\x13\x2e\xa6\xc2\x36\xa1\x53\x62\xc1\xad\x1d\xe8\x15\xbc\xab\x3e\x72\xef\x72\xf8\x94\x2a\xe4\x8c\x53\x4b\x0b\x0d\xea\x3c\xbb\x25\xc4\x37\xd2\x8e\xc7\xed\x72\x04\xbb\x50\x9a\x69\x6b\x54\x34\x4a\xd6\x34\xfe\x42\xe4\x78\xd9\xd4\x0c\x25\xa6\x97\x58\xa4\x34\x10\x36\xce\xa6\xa2\x0e\x53\x9b\xc3\x1c\x4e\x59\x11\x57\x2b\x14\x9f\x96\x90\xad\x09\x44\xff\xe3\x9d\x07\xcf\xe6\x82\xd7\x4d\x25\xd8\x82\xd7\xc4\x52\xba\x3b\x4c\x4d\x0b\x97\x8c\x1f\xce\x5a\xd2\xf8\x78\xf1\x9c\x35\xca\x88\x3a\xd1\x86\xfd\xdd\x6f\x8f\x6f\xc3\xca\x95\xd0\x4d\x7d\xa8\x82\xdf\xeb\xb0\xdf\x2e\xe5\x6c\x99\xea\x8f\xc8\x95\x30\x40\x71\xb7\xbc\x08\x4f\x3a\x3a\x01\x24\x92\xdd\xd3\xfb\x77\xf6\xda\xc9\xb7\x3b\x05\x36\xe8\x9d\x6d\x77\x44\x43\xfc\xea\xe8\xaf\x14\x28\xe3\xe7\xec\xe5\xbb\xeb\x1f\xdf\x3c\xff\xf3\xab\x37\xc3\xac\xfc\x0a\x03\x36\x5a\x42\x52\x31\x6e\xf5\x9f\x8d\x19\x2e\xf9\x0d\x2a\x07\x1b\x25\xff\xbb\x11\xce\x68\xbc\x88\xb4\x2f\x87\x89\x27\x77\x24\x27\x5b\xb8\x50\x74\x26\x69\xe3\xc2\x04\x2f\xfb\x24\x38\xd8\xcd\x52\xb5\xc3\x81\xb6\x2c\xd9\x01\xb2\x8c\xbd\xc2\xa3\xd0\x71\xd8\xab\xaa\x66\x4b\x01\x5d\x26\x6f\x3c\x70\xeb\x5b\x8d\xf3\x22\xa6\x6a\x20\x38\x55\xad\x68\x16\x05\x9f\xda\x1e\xab\x4b\xc1\x94\xa8\x11\xab\x8a\xd1\x4e\x64\x78\x74\x7b\x42\x34\x46\x98\x2b\x36\x6d\x86\x2f\x27\xf4\x33\xa8\xe4\x8a\x57\xb2\xdc\x74\x17\x03\x53\xe2\x9d\x0e\xfe\xee\xa6\xfd\xa4\x41\x8a\xf6\x93\x5f\xbe\x7f\x75\xcd\xde\xbd\xff\x88\xae\x0f\x28\xf7\x77\x59\x1e\x78\xde\x6d\xeb\x54\x80\xa2\x1f\x5a\x3b\xcc\x2e\xcf\xd5\xc6\xed\xa7\xd3\x53\x12\x1d\x29\x4c\x2d\xac\xa5\xe6\xdd\x0d\xef\x03\xb2\x47\x4f\xc6\xf6\x67\x78\x82\x2d\x2f\x8a\x0a\xa0\x47\x4c\xba\x99\xed\xa4\xc9\x39\xcf\x47\x4e\x4b\x41\x1b\xcc\xd2\x39\x9b\x71\x3a\x8f\x13\xc1\xd2\x1e\xf3\xc6\xd4\xbe\x09\xb6\xa7\x33\xc3\x8b\x47\x26\x21\xfa\x1d\x56\xc0\x8d\x54\x8b\x32\x89\xe3\xd3\x02\x06\x91\xf0\x84\xec\x42\xf7\x3e\xb7\xbd\xbf\x82\x73\xea\x85\xd5\x73\x81\x35\x2a\x49\x64\x19\x7b\x3d\x09\x2c\xe0\x2d\x9c\xe8\x51\xc2\x6a\x94\x85\xe3\x38\xd7\x8e\x8e\x9a\x8a\xf5\x84\xfd\x91\x7d\x61\x7f\xb4\x20\xcb\xef\x86\x37\xef\x90\xdb\x26\xed\xbe\x09\x31\x9a\xd7\x93\x03\x76\xfb\x7b\x08\x2d\x9e\xc6\x5e\x21\xfc\x28\xbd\x23\x23\xbe\x60\xde\x33\x1f\x1e\x6c\xd7\xea\x64\x56\x6b\xda\x7e\x24\xc6\x32\xb0\xbc\x13\x31\x13\x48\xd3\xd6\x6c\xfd\x9c\x78\xd5\x7a\xd7\xe3\xe8\xec\x84\x97\x20\x7f\xe5\x9d\x17\x6c\x69\xda\xeb\x9d\x4a\xb1\x5d\xd9\x8a\xd7\xb3\x65\x9b\x1a\x8c\x3d\x84\xe9\x65\xea\x56\x48\x69\x56\x32\x63\x85\xb6\x19\x8c\xf0\xfa\xed\xa7\x7f\x2d\x8c\x4f\x4f\xbd\xe8\xf1\xc5\x7d\x67\xb9\x15\x6c\x22\x11\x07\x80\x5d\x04\x5b\x09\x3e\x7b\x1b\x5c\x18\x3b\x33\x0b\x0b\xf5\x0c\x42\xce\x4e\xbd\xd7\x1a\xf3\x31\xb2\x14\x90\x21\x88\x29\x64\x7e\xc6\xd1\x59\xc8\x4d\xfa\x42\x92\x1e\x64\x7f\x63\x33\x02\xe4\x4c\x98\x93\xc8\xf1\xba\xd2\xb5\x9e\x69\xe2\x84\x2b\x7f\xdb\x3f\x43\xa9\x50\xfa\x01\x4f\xfc\xbb\xe0\xac\xf9\x48\x7c\x00\x3e\x3e\xbd\x9c\x5c\x81\x28\x55\x9e\x74\xc5\xae\x5f\x7c\x9c\xf4\x5d\xa0\x47\x1f\x5f\x4c\x1e\x9d\x60\x97\xe8\xf1\xc6\x51\xff\xa6\x3d\x3b\x62\xd0\x91\x9e\x9b\xd2\x8b\xb0\xc2\x60\x1b\xad\xf8\x7a\x84\x39\xb3\xcf\xce\x8e\xb9\xfc\x51\x64\x9e\xe4\x05\xb9\x8f\x59\x0d\x8c\x65\xac\x04\x2f\xe4\x09\x2a\x38\xbc\x40\xb5\xf4\x87\x79\xe6\xc5\x8e\x8d\x5a\x89\x95\xbe\x41\x22\x6d\xa5\x57\x41\x44\x99\x50\xc5\x5a\x4b\x0c\xd5\x92\xf3\x33\x62\x04\x74\xbb\xfe\xa3\x6b\xb9\xfe\xac\x3e\x79\xae\xef\xc8\xf5\x1d\xb9\xbe\x23\xd7\x77\xe4\xfa\x8e\x5c\xdf\x91\xeb\x3b\x72\x7d\x47\xae\xef\xc8\xf5\x1d\xb9\xbe\x23\xd7\x77\xe4\xfa\x8e\x5c\xdf\x91\xeb\x3b\x72\x7d\x47\xae\xef\xc8\xf5\x1d\xb9\xbe\x23\xd7\x77\xe4\xfa\x8e\x5c\xdf\x91\xeb\x3b\x72\x7d\x47\xae\xef\xc8\xf5\x1d\xb9\xbe\x23\xd7\x77\xe4\xfa\x8e\x5c\xdf\x91\xeb\x3b\x72\x7d\x47\xae\xef\xc8\xf5\x1d\x5f\x5d\x7d\x47\x18\x81\x38\x78\xc8\xfd\x03\x7e\xa1\x57\x6b\xe4\x55\x7c\x08\x8f\x47\x23\xc5\xe9\x30\x69\x3a\x86\xd9\x01\x65\x18\xa9\x87\x33\xd3\x6a\x2e\x17\xfe\x82\x7e\xec\xe6\xed\x8d\xe2\xb7\x8d\xe2\x5a\xcc\xe3\xf3\xb3\xe3\x79\x26\xa5\x5c\xc9\xe1\x2a\x0f\x9f\xe4\xef\xb3\x26\x26\x89\x9e\x4f\x82\x61\x7f\x88\x59\x7f\x80\x51\xbf\xe6\x35\xd2\xae\x9f\xb1\xff\xbc\xf8\xc7\xaf\x7f\x1a\x5d\xfe\xe9\xe2\xe2\x87\x27\xa3\x3f\xfc\xf3\xd7\x17\xff\x18\xdb\xff\xf8\xff\x97\x7f\xba\xfc\x29\xfc\xe1\xd7\x97\x97\x17\x17\x3f\xfc\xf5\xed\x77\x1f\x27\xaf\xfe\x29\x2f\x7f\xfa\x41\x35\xab\xcf\xee\x4f\x3f\x5d\xfc\x20\x5e\xfd\x93\x48\xe4\xf2\xf2\x4f\xbf\x3a\xfb\x17\x19\xdc\x7d\x5e\x7f\x63\x4f\xd9\xff\xe5\xd4\x63\xe3\x2b\xfe\xc5\x46\x1e\xf8\x0a\x33\x30\x49\x0b\xb3\x39\x77\x4e\x6c\x22\x67\xba\xb9\x40\x3f\x2b\xc3\x27\xa9\x8b\xe0\x8e\x08\x93\xf9\xfe\xdf\x9d\xef\x3f\xf8\x73\xde\xe6\x7c\xa9\x8e\xc2\xf9\xe1\xc2\xb0\xf5\x04\xf1\x5d\xc4\xc0\xad\x5e\xc9\x1a\xd1\x2b\x78\x0b\x9d\x8a\x29\x9b\xe8\xd6\x0d\xd3\x38\xd9\x25\x91\x94\x88\x46\x70\xdb\x07\xb9\x4d\x35\x8b\x19\x31\xc5\x15\xd3\xc1\xe6\xa7\x42\x3e\x5c\xb5\x31\x33\x7b\x31\x8d\x0a\x31\x97\x4a\x78\x8c\xfa\x97\x23\xf3\xe4\x5f\x45\x00\xba\x92\xf5\x06\xe9\xc1\xe2\xcb\x60\xf0\xaa\xcf\x6f\xd7\xfd\x87\x11\x6e\x43\x36\xb2\x65\xba\x40\x98\xe9\x35\x6d\xf0\x64\x2f\x34\xe4\xf3\x66\xe1\x1e\x21\xfd\x11\xb5\x0f\x96\xeb\x8c\xa8\x9d\x93\x68\x5d\x8d\x61\xa2\x7a\xce\xb6\x17\x19\x5c\x3e\x4b\x06\x1c\x7d\xc3\x4b\x38\xf1\x74\x8a\x13\x5d\x6c\x11\xa5\x32\x46\xcd\xcd\xe7\x96\x2b\xc4\x08\x26\x69\xfc\xe6\xc7\x61\xcb\xac\xf1\x23\xbe\xd4\x47\xb5\x7c\xec\xb5\x35\xa9\xe4\x8d\x2c\xc5\x42\xbc\x32\x33\x5e\x5a\x0e\x7f\x96\xac\x65\x9e\xef\xa1\x64\x85\xba\xd2\xe5\xf0\x1e\xe2\xe7\x76\x29\x20\x9d\xa8\x0d\x76\x61\x03\x5b\x3b\xb2\xe0\x52\xb1\x15\x2c\xc9\x75\x78\x01\x6e\x6f\x4e\x8b\xaa\xe0\xd2\x5f\xf3\x0a\xa7\x19\x62\x11\xce\x45\x9d\x6a\x5d\xfa\x3c\xe0\x72\x93\xb6\x4e\x3f\xe6\x40\xe9\x1f\x95\xb8\xfd\x11\xab\x32\x6c\x5e\xf2\x45\x0c\x2f\xa0\x3b\xf0\x76\x64\x93\x44\x39\x2e\x71\xef\x86\x62\x42\x7b\xd5\x08\xc6\xcb\x5b\xbe\x21\x6f\xeb\xd6\x5a\x98\x34\xcf\xd8\xd3\x4b\xdb\x26\x9e\x1b\x16\xdf\x53\xb0\x6f\x68\x29\xbf\x40\x60\x5f\x3c\x9f\xfc\x78\xfd\xf7\xeb\x1f\x9f\xbf\x7c\xfb\xfa\x1d\x5d\x57\x61\xdf\x05\xe1\xf0\x66\x7c\xcd\xa7\xb2\x94\x54\x63\xa3\xc7\x90\x88\x94\x74\x09\xe0\x16\xe1\x45\xf1\xb8\xa8\xf4\xda\xed\x87\x8f\xdd\x11\x08\x77\x0a\x4d\x4d\xdf\x7b\xed\x96\x0a\xdb\x23\x9f\xf7\x5e\x4a\xa2\xbd\xa8\xb8\xaa\xdb\xa0\x51\x7b\x44\x15\x06\x7d\xaf\x48\x71\x8d\x14\x99\xf7\xf6\x1c\xed\x17\xb7\x76\xf5\x79\x81\xf6\x25\xc9\x9f\xf8\xb0\xcc\xbe\x17\xe1\x75\x9b\xb6\x22\x99\x4d\xde\x5f\xbf\xfe\x8f\x43\x56\x12\xf8\x90\xfc\xeb\xc9\x06\x66\x7a\x42\x16\x98\xf2\xa0\xf3\xf8\xe0\xab\x75\xf2\x89\x1c\xf5\x44\x12\x6c\x2a\xd6\xde\x49\xe9\x98\xff\x87\x46\xb5\xaa\x05\x78\x44\x4b\x8b\xad\x74\x21\xc6\x6c\x12\xa2\xfe\x04\xd2\x6c\x8b\x42\xa4\xec\x5a\x42\x01\xb1\x50\x48\xe2\x29\x37\x1d\x0b\x87\x44\xb7\xd6\xae\x22\x46\xab\x3d\xb9\x4f\x73\x5e\x1a\x31\x3e\xfa\x0d\x80\xcb\xf0\x2d\x1c\xf3\xe4\x9d\x8d\x4f\xb2\x42\x28\x5d\x7b\xf3\x13\xe7\x0a\x5b\x0d\xff\x4a\xa0\xc8\x98\xf5\x8d\xba\x09\x63\x3d\x0d\x6d\x0d\x89\xf6\x02\x20\xfa\x3c\x7e\xdf\x26\x71\x85\xae\x0b\x05\x9a\x6c\xdc\x7d\x01\x90\xa8\xfa\x45\xb8\x22\x0b\x54\x00\xda\xea\x33\x64\x7a\x22\xf7\xa0\x60\x2b\x6e\x3e\x8b\xc2\xb6\xf6\x26\x62\x31\xd6\x46\xf2\xde\x9d\xdb\xbe\xb8\xe4\x8f\xd8\xc7\x10\x7b\xb7\x76\x0f\xd1\x95\x9a\x0a\x17\xd7\xa7\x65\x64\x25\xc9\x39\xbe\xf9\xbd\x2a\x37\x1f\xb4\xae\xbf\x8d\x85\x57\xc9\x8c\xf3\xbd\xb7\x40\xfb\xb1\x4f\xdb\x50\xd8\xa6\x4b\x14\x23\x6c\x2c\x81\x2a\x73\x22\xd3\xad\x01\xf3\x27\x0f\xd7\xf4\x44\x02\x53\x35\xea\xb9\xf9\xae\xd2\xcd\x3a\xf9\xc3\x61\x30\x7d\xf7\xfa\x25\x98\x1d\x46\x21\xce\x5b\xa8\xba\xda\xd8\xc2\x4f\x08\x0d\x35\xdc\xdf\x6e\x5a\xb4\x65\x3f\x79\xac\x14\xdc\xdc\x8a\x4b\x02\x4c\xc2\xd8\x5b\xbe\x61\xbc\x34\x3a\x18\xd7\x52\xdd\xe9\x71\x79\x77\x90\x44\x52\x2a\x36\xd5\xf5\x72\xc7\x17\x84\xb8\xec\xd2\xbe\x4a\x04\x03\x63\xc4\x01\x5a\x79\xfb\x15\x18\xc5\x85\x4c\x45\x31\x13\x85\x50\x33\x31\x4e\xc3\x19\x4e\x00\x15\x59\xce\x79\xa7\x15\xc4\x27\x99\x77\x5e\x07\x1c\x12\x5e\x59\xbd\xa5\xc5\x56\x48\x12\xab\x9a\x61\xe6\xc5\x8f\x15\x33\x60\x9f\x56\x78\x1a\x03\xd0\x13\xa0\x70\xd5\x58\x88\x56\xb0\xbf\x36\x53\x51\x8a\xda\x3a\x5a\x24\x8a\xb6\x5b\x06\x5a\x46\x62\x55\x72\xc5\x17\x82\xf1\x3a\x32\x23\x0a\x12\x95\x81\x1a\xb3\x2b\x97\xc3\xd7\x3d\x7e\x0a\x2d\xda\x2a\x4c\x6e\xd8\xa7\xd7\x2f\xd9\x13\x76\x81\x35\x5f\x5a\x6d\x8b\x7c\x39\x48\x92\xcd\x70\x23\x91\xec\xef\x99\x9c\xdb\x08\x98\x16\xc6\x7e\xbe\x95\x13\xe4\xdb\x58\xb5\x71\xc5\x14\x4d\xdb\x9a\x66\xb6\x0c\xdf\x0f\x57\x3c\x38\xa7\x3e\x63\x13\x31\xbb\x8e\x58\xd1\x48\x0e\x8a\xde\x3e\xb1\x22\x91\xdf\x23\x7a\x7b\xc4\x8a\x44\xf2\x18\xa2\x77\x80\x12\xfe\x64\x44\x75\x90\x0e\xfe\x74\x22\x1d\xec\x2f\x1f\xe3\xad\x99\xaa\xdd\x49\x12\x45\xa9\xbc\xf0\xac\x44\xcd\x0b\x5e\x73\xaf\xbf\x03\x91\xcc\x4a\x34\x56\x3a\xad\x16\x37\xe2\x8d\x54\xcd\x17\x97\x2c\x69\x0e\xe2\xbf\xeb\x57\x96\x04\xf3\xe1\x46\x9f\x47\xcc\xd7\xeb\x12\x77\x59\xad\xd3\xb5\x59\x50\x61\x61\xbb\xaf\xb6\x2e\x08\xaf\x8b\x49\x84\xad\x0a\x43\xd4\x12\x6d\x4d\x31\xe5\x99\xab\x42\xaf\x76\x16\x0d\x23\x58\xf0\xd9\x92\x44\xb3\xb3\xd0\x2e\x13\x0f\x30\x28\x89\xf4\x3d\x4c\xbc\x8f\x41\x49\x74\xef\x66\xe2\xa3\x30\x68\x6a\x28\xa9\x14\x37\x82\xd8\x66\x67\x8b\xdb\xde\xe0\x49\x18\xc3\xe1\xf4\x2c\x29\x56\xf2\xa9\x28\xad\x21\x41\x24\xca\x3c\x77\xc6\x88\x5c\x7b\xa0\x67\x27\x0a\x28\x54\xba\x3c\xac\x04\xe9\x83\x2e\x6d\xde\x0a\x8f\x1f\x0d\x52\xbf\x88\x6f\xb6\x0f\x1c\xf2\xcd\x1f\x37\xeb\xad\x6f\x06\xa9\x5f\xc4\x37\x37\xc4\x5b\x7c\xe7\x9b\x71\xfd\xf7\xbf\x19\xa4\x7e\x01\xdf\x9c\x14\xfa\x32\x62\x36\xd3\xab\xf5\xa4\xd2\x73\x49\x13\x88\x3e\x63\x38\x44\x10\x24\x02\x20\x18\x62\x2d\x3e\xfb\x28\x55\x7f\xbf\x9e\xef\x10\xe4\x55\x27\x31\x93\xb8\xef\xd6\xe8\x08\x19\x9c\xff\xaf\x7d\x81\xd3\x50\x5b\xf7\x17\x89\x62\x58\x4d\x0f\x67\x04\x75\xff\x0f\x27\xd1\xcc\x7a\xc6\x4b\x54\x5c\x24\x9c\xcf\xce\x19\x6d\x13\x69\x73\x4e\x1d\x48\x87\xbf\x23\xd2\x65\x1e\x0a\xb6\x57\x15\xf2\x42\xcb\xd8\x1b\x51\xe9\x42\x74\xd0\xdd\x86\xd4\xc6\x35\xfc\x1f\xac\x16\xbf\x92\x98\xe6\x0f\xa3\x2c\x00\xaa\x45\xf7\x2d\x64\xaa\xb5\xf6\xcd\x6c\x42\x91\x13\xb7\x5a\x4d\xa8\x02\x49\xa9\x88\xa3\x5d\xb1\x4a\x00\x93\xbb\x49\x22\x8a\x85\x20\x05\xae\x14\xf5\xb9\x61\x9d\x45\x06\xc6\x4d\xdd\x54\x9c\x10\x78\xc8\xaf\x14\xe1\xa9\x68\xbd\xcc\xed\xd5\x06\x45\xf4\xe8\x4d\x38\xc8\x47\x27\xd2\x1c\xfe\x81\x43\x98\xec\x11\x9e\xec\x70\x96\x8b\x88\x7e\x46\x7f\x50\x1d\x25\x9a\x48\x98\x45\x5e\x08\xee\xae\xb7\x5d\x6d\x16\xb6\x8c\x12\x87\x98\x38\x55\x22\x18\xfb\x87\x62\x71\x03\xd9\xa8\x65\xfd\x5d\x96\x26\x93\xbc\x87\xf5\xd9\x07\x67\x12\x7b\x9f\x8d\x4c\x72\x74\xb7\x65\x1d\x43\x6e\x61\xd1\x09\xfd\xc7\x7c\xd4\xd6\x2d\xeb\x93\xb2\xfc\x0a\x11\x1e\x31\xa5\xe3\x26\xc4\xe5\x93\x49\x86\x33\x79\x74\x1a\x5e\x0c\x49\x49\x94\x03\x1e\x51\xc1\xa7\xa4\xab\xf1\x56\xaa\x42\xdf\x9a\x87\x78\x62\xdf\x3b\x12\xc1\xc4\x9f\xc1\x21\x41\xa9\xba\x09\x1c\x4d\x20\x6a\x55\x0e\xc6\xa3\x47\xa6\x30\x77\xbb\x63\x5e\x2a\x48\x24\x63\x2b\xf3\x5d\xf7\x25\x0a\x9d\x63\x98\x7f\xdf\x68\x6c\xea\x65\xbc\x58\x19\xfe\xa2\x02\xfd\x5a\xf2\xf2\x7a\x2d\x66\x07\x69\xca\xef\xde\x5e\x3f\xef\x93\x81\x72\xbf\xb5\x5d\xd2\xa9\xc1\x21\xfc\x80\x0e\xe3\xc5\x4a\x1a\x63\x23\x83\x62\x8a\x92\x4f\x76\x11\x92\xb4\x17\xb2\x5e\x36\xd3\xf1\x4c\xaf\x3a\x19\x4b\x23\x23\x17\xe6\xb1\xe7\xec\x11\xbe\x88\xde\x1e\x4e\xaa\x32\x66\x81\x81\x17\x6d\x57\x71\x5f\x39\x68\x17\x33\x8b\x5f\x45\xa6\x09\x16\xb6\x95\xa0\x31\x79\x62\x77\x7b\x6c\x9f\x0d\x9b\xc5\x75\xb2\x6b\x6f\xf7\x68\xdf\x25\x34\xf1\x19\x38\x5e\x90\x0a\x95\x6b\x49\x7d\x67\xee\xde\x5b\x2b\x08\xde\xc8\x3e\xd9\x86\x78\x73\x11\x71\xc7\x83\x76\xe1\x2f\xed\xf3\xac\x10\xae\xe6\x4b\xa0\xf7\x68\x37\x47\x94\x48\x98\x75\xee\x56\x1f\xad\xe7\xec\x1c\x6f\x08\xd8\xfa\x79\x4b\x93\xba\x23\x1e\x1c\xb5\x6c\x85\xc3\xe1\xe5\x7a\xc9\x47\xd6\x39\xb0\xfa\xca\x2a\x41\x6f\x88\x91\x49\x2e\xb5\xd2\xbe\xf0\x02\xe6\x8e\x56\x56\x44\x10\x07\xf1\x98\x29\xab\xdb\x4b\xa1\xb3\x45\xb1\x87\x2b\x0d\x7e\xc6\x4f\x17\xba\xb5\x33\x9e\x70\xad\x58\x87\xcb\x57\x6b\x21\xb5\xd2\x4f\x87\x38\x88\xa8\xbb\x06\x2a\x61\x2c\xdc\xa9\x98\xa8\x2a\x5d\x59\x3d\xa5\x22\x20\x41\x64\xa6\xa0\xc7\x27\x68\x6f\xfd\x1c\xfb\x3a\x67\x1c\x37\xc2\x79\x07\x9f\x35\x6d\xe7\xea\x24\xa2\x06\xd2\x25\xe6\x73\xf4\x80\xb9\x11\x3d\xc6\x73\xe1\xb5\x0b\x49\xc6\xf5\xf1\x03\x4c\x08\xd1\xc9\x5b\x1b\x2a\x0d\x9d\xb4\x57\xf2\x0b\x56\xdd\xa5\xde\xae\x9c\x4c\x3b\x74\xa6\xbc\x9b\xcc\x25\x82\x92\x2a\x26\xea\xd3\xee\x47\x9f\xca\xd8\xa5\x18\xb2\x0b\x6b\xa4\xc8\x75\xdb\xc3\x93\x8b\x17\xf1\xff\xdd\x10\x2a\x7c\x9e\xaa\x49\xd4\x36\x54\xac\xa5\x87\xb7\x1c\xac\x76\x61\x6d\x05\x02\x30\x10\x82\xe5\xe5\x70\x18\x22\x45\x76\x37\x5e\x73\x1f\x16\x43\xa7\xec\x52\x0d\xfb\xb8\xcd\x9d\x98\x0c\x99\xe4\x3e\xec\xe6\x9e\xb0\x37\x99\xf6\xeb\xf9\xc9\x22\xdf\xa9\x66\x1d\x99\x68\x9a\xf9\x77\xda\xc8\x1a\xf9\x57\x7d\x07\x95\x03\x1a\x89\x5f\x77\x9e\x64\x72\x17\xbf\x9f\xe8\x02\x49\x2f\xd4\xbe\x37\xae\x54\xdf\x77\x89\x92\xff\x13\x6c\xff\x70\x3c\x80\xac\x5d\xe1\x05\xb5\x8b\x22\xc2\x76\xbe\xeb\x33\x86\x1d\xd4\xc0\xd3\x71\x3f\xe1\x6a\x2c\x51\x3c\xd8\x7b\xf3\x98\x02\xc8\xd8\xfb\xad\xd3\x99\xfc\x2a\x7e\x68\x70\x5a\x2a\xe1\x9b\x4a\x5d\xb1\xff\xa2\xa8\x3b\x6e\x42\x26\x78\xe8\x7d\x33\x89\xe4\xb1\x05\xd6\x4a\xf0\xd3\x0f\x1a\x43\x83\xcf\x7c\xac\x92\x15\x72\x3e\x17\x21\x6b\x1d\x11\x2d\x5e\xf1\x15\x8c\x21\xc3\xfc\x11\x4d\xc5\x42\xd2\x52\x98\xe3\x95\x79\x6e\xda\x26\x2f\x57\xee\x3a\x96\x35\x5b\xc9\xc5\xd2\x79\x41\x8c\xdb\x16\x00\x8c\x84\xc7\xd5\x9a\xa1\x29\x3b\xb3\x9a\x47\x57\xec\x96\x57\x2b\x58\x68\x7c\xb6\xb4\xc9\x1a\x5c\xb1\xa2\x81\x70\x30\xdb\x07\x75\x33\x32\x35\xaf\x87\xc9\xc2\xaf\xf2\x01\xad\xb0\x7f\x0f\xac\x2a\x3d\x6a\xc9\x6f\x6e\x1f\x9f\xdb\xc7\xe7\xf6\xf1\xb9\x7d\x7c\x6e\x1f\x9f\xdb\xc7\xe7\xf6\xf1\xb9\x7d\x7c\x6e\x1f\x9f\xdb\xc7\xe7\xf6\xf1\xb9\x7d\x7c\x6e\x1f\x9f\xdb\xc7\xe7\xf6\xf1\xb9\x7d\x7c\x6e\x1f\x9f\xdb\xc7\xe7\xf6\xf1\xb9\x7d\x7c\x6e\x1f\x9f\xdb\xc7\xe7\xf6\xf1\xb9\x7d\x7c\x6e\x1f\x9f\xdb\xc7\xe7\xf6\xf1\xb9\x7d\x7c\x6e\x1f\x9f\xdb\xc7\xe7\xf6\xf1\xb9\x7d\x7c\x6e\x1f\x9f\xdb\xc7\x7f\x75\xed\xe3\x4d\x5d\x48\xf5\xec\x2c\xe1\x70\xf7\xf4\x49\xf2\xc9\xd6\xa1\x42\x7f\x80\x22\xfc\x93\x69\x83\x3c\x27\x70\xb2\x5b\x45\xb8\x0c\x77\xaa\x98\xc6\x41\xbd\x0f\x12\xf5\x39\x0e\xb6\x2d\x2c\x5a\x36\x19\x57\xb1\xb2\x87\x3c\xa9\x93\x8c\x6b\xb6\xd9\xc9\x68\x7e\xf5\xfe\xdb\xf4\x86\x4e\xf4\xdc\x56\xbb\xd6\xf7\x6a\x26\x0e\x3c\x94\x3b\xf6\x2f\x24\xc2\xcf\x4a\x6d\x86\x0f\x06\x24\xec\x22\xd8\x6c\xc9\x95\x12\xc1\xeb\x97\xc8\xb2\x36\x6c\x2a\x84\x62\x7a\x2d\x50\x0a\x36\xdd\x30\xce\x8c\x54\x0b\x42\xf1\x1b\xaf\x6b\x3e\x5b\x8e\xd9\xf7\x48\x3d\xf3\x07\xd2\x66\x1c\xfb\xbf\x31\xc8\xae\x58\x85\x1c\xf2\x15\x27\xe4\xd0\x60\x29\x8c\xcf\x2a\x6d\x90\x0a\x5e\xd6\x72\x5d\xa2\xcf\x0f\x5e\xc6\x8c\xb0\x45\x2e\xc6\x32\x50\xdc\xd8\x41\x92\xd2\x74\xd3\x97\xaf\xda\xd5\xfa\xcf\xd6\xaa\xcb\xf7\xc8\x1b\x1c\x4e\xa1\x41\x53\xea\xd5\xba\xde\xc4\x8c\x46\xf4\x2c\xae\x4c\xcd\x66\xa5\xb4\x46\x9f\xdd\x1e\x00\xef\xe8\x1d\x54\x48\x35\x4c\xd2\x9b\x7b\xca\xef\x94\x5b\x9e\x35\x02\xe1\x49\xad\x6b\xe3\xf2\xf3\xda\x17\xba\x57\x0d\xd2\x2d\xa4\xf1\xde\xb1\xb9\x42\xc6\xa1\x57\xda\x96\x91\xc2\x4e\x58\x56\x0a\x97\x33\xce\x69\xf8\xa6\xf3\x8f\x74\x96\x13\xf7\x50\x9a\x36\x01\x33\x0a\xbb\xad\x51\x18\xa4\x1a\x04\xf0\xaa\x5b\x21\xd2\xb1\x79\x6d\xda\xcf\xb6\x2a\x18\xa4\x6a\x19\x50\x89\x1b\x54\x52\x8a\x99\x90\x37\x82\xf1\x3d\x92\x7f\x34\xc1\xef\xd8\x11\x6f\x85\x31\x7c\x21\x26\x04\xb8\x6d\x5f\xb0\x03\xcf\x76\x0e\x0f\x59\x84\xa8\xff\x44\x6d\x33\x8e\xf3\x2c\x2d\x25\xad\x6f\x41\xb3\x95\x5b\x5f\xb4\x89\x6f\x2b\x34\x73\x1f\xde\x56\x69\x5c\x5f\x45\xd8\x3a\xaa\xd6\xbb\x2f\xe9\x26\xbe\xf9\x4d\x18\x24\xea\x5f\x8e\x03\xc1\xdd\xa8\xd0\xa7\xd6\x81\xf0\xd3\x4a\x8a\x39\x9b\x4b\x98\xa7\xc8\x40\x6d\xcc\x15\x22\xa3\xc3\x1f\x8f\x52\x21\x14\x0c\x19\x84\xa8\xb4\x0a\xee\x63\xf8\xee\x31\xfb\xde\x7f\x78\x5d\x35\x0a\xb7\xdd\xb0\xbb\xea\x2d\x7b\x14\xa2\xc3\xce\x5b\x54\xc8\x73\x80\xbe\xe6\x8a\xfd\xf6\xc9\x1f\x7e\xc7\xa6\x1b\x58\x1d\x16\x87\xaf\x75\x4d\xa8\x85\x0b\x87\x50\x0a\xb5\xc0\x59\x3b\x15\xd8\xaf\xf8\x8c\x27\x64\x07\x9d\x10\x96\x59\x6b\xf6\xf4\x9b\xcf\xd3\xc8\xe6\x56\x1f\x3d\x2e\xc4\xcd\xe3\xce\xf9\x8f\x4a\xbd\x18\xfb\x99\x2f\x83\x14\x3b\xd9\xbb\xe7\x67\x47\x0a\x90\xdd\x21\x2a\xba\x94\xb3\x4d\x92\xb0\x84\xd6\x75\x6c\xa9\x6f\xed\xd9\x74\xa8\x86\x93\xa6\x26\xdc\x22\x4b\x5b\xaf\x9b\xd2\x7e\x26\xfb\x36\xd6\xa0\x03\x2e\xdc\xa9\x7b\xdc\x5d\x3c\xaf\x09\x02\xa9\xe3\x2b\xfa\x72\x13\x12\x19\xc3\x92\xb5\xaf\xbd\xf0\x61\xfb\x41\xc2\xa1\x6b\x5d\x53\x89\x31\xfb\x96\x97\xe5\x94\xcf\x3e\x7f\xd4\x6f\xf4\xc2\xbc\x57\xaf\x50\xbe\xd5\xff\x96\x92\x13\x9c\xd1\xd9\xb2\x51\x9f\xf1\xb5\xed\x32\x4b\xbd\x80\x15\xbf\x6e\xea\x90\x3a\x7f\xc7\x86\x0f\x12\x86\x86\x68\x2f\xd3\x10\xf1\x68\xdf\x22\xbe\xc8\x36\x64\xe1\xcb\xcf\x86\xfd\x29\x48\x5d\x77\x7d\x48\x96\xb7\x02\x03\xf6\xff\xe6\xc9\x6f\x7f\xef\xc4\x13\x91\xcb\xdf\x3f\xb1\x49\xc8\x66\xf8\x7e\xb6\xaa\xd7\xde\x21\x30\x28\x56\xbc\x2c\x45\xd5\x17\x2c\x30\x4a\x10\x24\x4a\xc1\x60\x10\xa4\xa3\xc9\x51\xbd\x39\xd0\xca\x7c\xa0\xe9\xff\xf1\xe3\xdf\xad\xdd\x2f\x6b\x23\xca\xf9\x95\xab\x40\x0a\x5e\x3e\x3b\xb7\x06\xd0\xb9\xd7\xe2\xb4\x12\xb2\xed\x6b\x79\x7c\xb4\x7b\xf9\x46\x97\x0d\x1a\x32\xdc\xc8\xd4\x21\x5b\xbd\x27\x43\x14\xb1\x94\x68\xd5\x31\x67\xd3\x52\xcf\x3e\xb3\xc2\xfd\xe3\x00\x55\xe6\xb7\xa2\x31\x6d\x8c\x28\x6e\xff\xf8\xec\x28\xb9\x71\x7b\x57\xee\xbf\xc9\x65\xc5\xad\xf8\x7a\x8d\xd8\x0a\xea\x4a\x06\x49\x32\x56\xf1\xdb\xde\x87\xda\x50\xa2\xed\x01\x43\x5e\x7e\x2a\x56\xe4\x76\x94\x62\x3a\xdd\xf1\xdd\xed\xc3\xe1\xb8\x90\xf2\x14\xf2\xe5\xf5\x70\xa5\x91\x3f\xad\x9e\x4a\x8a\x95\x55\x71\x0f\x9c\xf1\x80\xbd\x14\x05\x95\xa4\x1e\xde\xa8\x24\xd9\x4f\xcd\x1a\xec\xed\x93\x8a\xc8\xc8\x8a\xd7\xde\xbe\x0c\x90\x0e\x47\x0e\xb0\x91\xa6\x16\xaa\xfe\x9b\xe5\xa3\x17\x25\x97\xb4\xf0\x0c\x0b\x4e\x3a\x15\x01\x48\xfa\x5c\x3a\x8e\x32\xea\xb0\xd1\xd9\x91\xf2\xfa\x12\x00\x17\x6a\x5e\x9e\x53\x30\xb6\x31\xf7\xa0\x6c\xf4\xce\x0f\xa5\x6f\xee\x61\x6b\xe0\x59\xa3\xfc\x2e\x93\x7c\xd8\xab\xeb\x5a\xec\xbb\x53\x00\x4f\xa0\x9a\xfe\xd6\x7e\x73\x5f\x33\xe1\x1b\x12\x54\x93\xa3\xf3\x2f\x50\x48\x76\x73\x0f\xd4\x47\x78\x2c\x2c\xb1\x77\x32\xd1\xb5\x3b\x4b\x28\x9c\x75\x5f\xec\x6f\xeb\xa9\x08\xbe\xd8\x98\xb9\x7c\x0c\xe4\x06\xf8\x17\x10\xa9\x9e\x3f\x3b\x1f\xde\xad\x64\x31\x0d\x5b\x56\xe9\x35\x5f\x90\x87\x12\x6d\xed\xdc\x36\x89\x6e\x3b\x09\x18\xfc\xf6\xdf\x87\xd9\xdb\x1b\x2c\x95\xc5\x68\x2c\x2d\x51\xb4\x5d\x70\x90\x95\xe0\x93\x09\xc2\xb1\x28\xb2\x42\x47\xeb\x1d\x5b\x17\x7b\x8b\x12\xec\x4a\x37\x88\x65\xdb\xe8\x58\x0c\x61\xbe\xdd\xfa\x88\x77\x5a\x09\xaa\x1e\x35\x1e\xa9\xea\xf7\xab\xb0\xc0\x8a\x54\xec\xe9\xf8\xe9\x93\xaf\xe9\x42\xb1\xab\xdc\xba\x50\xde\xc5\x0b\xc5\xc9\xea\x49\xd6\x1b\x86\x07\x1c\xb0\xe6\xb7\x3e\x94\x11\x67\x03\x58\xbf\xc6\xf6\x2b\xb7\x7f\x85\xc0\x04\xf5\xb8\xda\xd1\x74\x17\x36\xaa\x04\x4f\xa3\x53\xa7\x7f\xd9\xf3\x1b\x88\x34\x49\x76\x70\xaa\x35\xec\x83\xd4\xcd\xf4\x48\x1a\xcd\x2b\x25\x1b\x9b\x4b\x55\x68\x51\xec\xce\xcd\x3d\xca\x2d\x6c\x1c\x95\xb0\x66\x8f\x1e\xb1\x0b\x47\xef\xdc\x55\x74\x5e\x9e\x84\xf5\xfc\x26\xbe\xfa\xb2\xae\x0e\xd8\xc8\x57\x5f\xd6\xdc\xc6\xba\xd6\xfb\x77\x94\x44\xd5\x3b\xaa\xf4\x1d\x25\x52\xfd\xb3\x40\x13\x14\x83\x4a\x54\x59\xf2\xaa\xdc\x60\x6b\xaf\xdd\x37\xb3\x69\x53\x33\xa1\x6e\x64\xa5\xd5\x8a\x3a\x8b\x06\xe8\x5d\x25\x6d\x3f\x9c\x4a\xd8\x0a\x79\xb8\x55\xbf\xba\xf8\xdb\xf3\x0f\x36\x23\xe7\xd2\x26\x38\x08\xbf\x2f\x44\x92\x0d\xe0\x8b\x9d\x6f\xef\x2c\xed\x48\x0c\x14\xbe\x1c\xa7\x8d\x5b\x22\xfc\x99\x48\x14\x5f\xb6\x6a\xea\xc6\xcd\xf0\xf9\x32\x2b\x1b\x23\x6f\xc4\xf8\xe7\xb5\x91\xa3\x65\xf3\x15\x9b\xc8\xbe\xbc\xfb\xa5\x1c\x94\xb1\x9e\x74\xc5\xd6\x4a\xe7\x26\x54\x88\xb7\x95\xdf\x16\xa2\x50\xba\xcd\xd2\x2c\x86\x83\x41\x77\x02\x73\xe7\x26\xe4\x14\x46\xa7\x10\x97\xf6\x15\xd1\xb4\x73\xdd\x1b\xa6\xa2\xdb\x4b\x54\xaa\xad\x57\xd9\x3e\x31\xc1\x36\x4f\x89\xd6\x9e\x1d\x85\xab\x28\xfc\x34\xc8\x1d\x24\xbe\x18\xe6\x08\x04\xdf\xaf\x45\x69\x0f\x71\xff\x7a\x52\x27\x1e\x93\x36\xa2\xc7\x5c\xef\x3a\xeb\x38\x7b\xc0\x37\x7b\xcc\x6c\x28\xf6\xdd\x7b\xf7\x87\xee\x33\x67\x0f\xf8\x26\xd3\xef\xb9\x43\x7c\xfd\x6e\xf3\x9f\xb3\x87\x79\x59\x73\xca\xd0\xa3\xde\x12\x1e\x3d\x77\x65\xce\x00\x85\x9a\x75\x98\xee\x5b\xb2\x05\xe8\x00\x90\xa9\x7d\x97\x4d\x73\x96\xd8\x5f\x13\xc1\x0b\x8e\x5c\xbe\x31\xbb\xd6\xab\x78\x13\x83\x41\x2c\x30\xa3\x6f\x87\x28\x76\xc6\xdc\xc0\xad\x58\x22\x75\xd5\xfe\xad\xbe\x05\x9c\xb3\x94\x6b\x18\xc4\x76\x8d\x81\xf8\x50\xf5\xd1\xd4\x3d\xdc\xcd\x2d\x7b\x86\xa6\xb6\x4f\xe1\x1a\xd8\x7f\xc3\x05\x88\xf9\x4f\x41\x05\x0d\xa7\xe7\x7c\x7b\x6d\x37\x9d\x7d\x33\xf6\x9d\xbc\xeb\x85\x2c\xd8\xd4\x35\x99\x00\x74\x7e\xa1\xc4\xad\x05\x1c\x0d\x9b\x59\xa4\x6b\xc8\x2b\xea\xdb\x2e\x61\x25\x71\xe1\xfe\x85\x97\xec\x37\xee\x8d\x6b\xb8\x72\x16\xdb\x1f\xa0\x3b\xc5\x0c\x58\x5c\x9e\xef\x3f\x9c\x7b\x54\xa0\xba\x1d\x55\xb7\xa3\xd1\x68\x84\x5d\x08\x23\x76\x7a\x23\x86\x06\x68\xda\xd5\x41\xfd\xaf\x74\x21\xe7\x9b\xad\xf3\xc1\xdd\xde\x2e\xcf\xf6\x97\xe0\x6a\x73\x36\x60\xd8\xd8\xef\x1e\xe8\x89\x4b\x4f\xf9\xa2\x66\x0a\x79\xe1\x79\x61\xf9\x6c\x48\x8d\xec\x08\xd2\xf9\x1d\x8f\xfb\xae\xc8\x86\x4d\xc5\x92\xdf\x48\xfd\xbf\xec\x7d\x7d\x73\x1b\xc7\xd1\xe7\xff\xf8\x14\x53\xf0\x55\x91\x74\x00\x48\xb4\x73\x97\x1c\x2f\x17\x17\xa3\x97\x14\xcf\x96\xcc\x12\x25\xab\xae\xec\x3c\xe5\x01\x76\x40\xec\x43\x60\x17\xde\x59\x90\x82\xa3\x7c\xf7\xa7\x7e\x3d\x3d\xb3\xbb\x00\x88\xed\x59\x42\xb2\x9c\x47\x84\x2b\x8c\x48\x6e\xef\xbc\xf4\xf4\xf4\xfb\xaf\x68\x77\x9a\x22\xcb\xe4\x1a\x3c\x78\xdf\x1a\xfa\x3c\x71\x66\x0e\x57\xb6\xd5\x42\x74\x6c\x40\xd1\xbc\x5b\x52\xce\x01\xfb\x6f\x2f\xf3\xa6\x3d\x5c\x35\x6b\xc4\x81\x6f\xdb\xa3\x32\xf7\x23\xc0\xf2\xfa\x56\xd5\x5c\x48\xe1\x97\x52\x8d\x35\x5e\x18\xa6\x72\xdc\xee\x10\xa8\x31\xcb\xc9\x48\x5d\xb0\x2a\x00\xdd\x19\xad\xb3\x5c\x8b\x42\x24\xbb\x9b\x25\x8a\x10\x0b\x3d\xe7\x61\xb4\x0d\x97\x06\x89\x70\xb7\xd2\xf6\x0c\x79\x78\x05\xd8\xdc\xa9\x0a\x0b\xbd\x44\x88\x3b\x71\x39\x29\x49\x5a\x70\x8b\xec\x16\x9a\x94\x3d\xe7\xce\x53\xff\x7b\x02\x0c\x7b\x91\x5a\xf2\x00\xf7\x89\x5a\xff\x9c\x92\xa5\xfa\xf1\x0a\x92\x7f\x32\xf8\x2d\xf6\xc7\x8a\x45\x77\xae\x1c\x14\x2f\x1a\x0e\xaf\x12\xfa\x3d\x21\xa8\xf3\x7e\x20\xbc\x7a\x1b\xbe\x16\x8a\xdc\x6a\xe1\xc0\xc8\x49\xf7\x34\xe6\x13\x36\xdc\x6b\x21\xbe\xd9\x65\x99\x8b\xd2\x74\x29\x75\xba\x1e\x5e\xf2\xc9\x41\xef\x76\xe6\x0c\x70\x5e\xd1\x6e\xb8\x3b\xd5\xda\x3c\x4f\x0e\x74\xe7\x7b\xa6\xb6\x71\x85\x0c\xe2\xae\x01\x5f\xd7\x36\xc6\x36\x70\xbb\x4d\x3b\x03\xc0\x75\x2d\x34\xf7\xc0\xda\xdd\x07\x59\xd7\x42\x91\x6b\x48\xef\xe9\x64\x79\xdf\x21\x69\x21\x1a\xd9\xbd\x52\xa0\x30\x1d\xbc\x15\xb9\xd4\x61\x27\x44\xa4\x8b\xc6\xa2\xab\x36\x5e\x2a\x00\xbd\x17\x65\x1b\x85\x6e\xbb\x9b\x69\x0b\xcd\x3d\xf8\x73\xfb\x76\xbe\x85\xea\x1e\xe1\xd9\xb1\x6f\xa9\x4c\x78\x6e\xed\x7c\x0b\xd5\xdf\x5e\x78\xc6\x60\xcd\x6d\x71\xd6\x26\x60\xdb\x26\xca\x1c\x0c\xa5\x9e\x10\xf6\xe7\x1e\x44\x83\xf0\xfb\x20\x09\x05\x99\xde\x72\x68\xb9\xda\xc2\x13\x3a\x6d\x2b\x61\x91\x4c\xfa\xb7\xbc\xb8\xe5\x91\x52\x21\x98\x5c\x1c\x8c\x9c\xd0\x90\x67\xed\xbe\xc1\x3a\xfb\xe7\x15\xa1\x83\xca\x41\xe3\xe2\xe0\xe2\x3e\x95\xb9\x95\xeb\x65\xec\xdc\x5a\x61\xe1\x3e\x95\xb9\xc9\x40\xe0\xe2\xe0\xdf\x3e\x8d\xb9\x89\x3c\xaa\x71\x30\x6f\x51\x00\x6f\xb5\x59\xb5\xad\x04\xb9\x86\xd0\xbf\x39\x4f\x0e\x26\x6c\x36\xa0\xcd\x22\xf7\xf8\x40\xc8\x68\x51\x98\x68\x58\x51\x01\x4d\x8f\xcb\xd4\x8a\x86\x16\x50\xce\x04\x44\x05\x38\x68\x5b\xf8\x66\x02\xb2\xb5\x81\x79\x56\xf1\xc3\xdf\x8d\x6c\x26\xa0\xf9\x10\xec\xb3\x0f\x2b\xf4\x0e\x85\x74\xe6\x97\xc8\xfb\x48\xf7\x60\x9c\x35\xb0\xcb\x04\xa4\xf7\xa2\x9b\xed\x60\x4e\x01\xc9\x7b\x70\xcd\xee\xc3\x2b\x13\x50\xf4\xbe\x13\x3f\xd0\xcd\x73\xb2\x81\x54\x26\xa0\xb8\x4d\xc9\xaf\x69\xff\x70\x3c\x23\x8b\xab\x0a\x10\xc9\xa4\x92\xbb\x16\xda\x20\x2f\x6d\x8b\x50\x6c\xf0\xea\x79\xc8\x52\xa6\xa0\x48\x80\x1d\xf3\xa7\xdc\x55\xae\xb1\x69\xb7\x97\xac\xe2\x7e\xd3\x1b\x8a\xf3\x40\xa5\x15\x62\x89\xa7\x2a\x4f\x3b\x5c\x16\xe9\x42\x17\x6b\xb8\xe9\x46\x6a\xcb\x04\xc8\x72\x3f\xec\x70\x46\x92\xf6\x84\x00\x58\x1e\xd9\xba\x1a\xc4\xa8\xf7\xe0\xcc\x45\xb9\xe5\x25\xb7\xbd\x24\x71\x4e\x7c\xec\xda\x4e\xca\x79\xcc\xa6\x5f\xb9\x27\x14\x9a\x50\x29\x1d\x38\x00\xa1\x59\xbb\xd4\x13\x48\x69\xf7\x17\x7b\x49\x92\xd2\x94\xb0\xa1\x40\x79\xbc\x23\xd8\x27\xd8\x8b\x72\x06\xb3\x3d\x34\x1e\x62\x6a\xea\x78\xdc\xea\x79\xdf\x25\x2a\x4e\x38\xfc\xed\x7d\x52\x73\xbd\xca\x26\xb3\x03\xec\xda\x8e\x45\x09\x51\x0d\xad\x6e\x50\x1f\x3e\xaf\x30\x19\xf0\x6a\xc1\xcd\xd4\xee\x74\x92\x6b\x2f\xf2\xac\xed\xc6\x54\xaa\x74\x3a\x7e\xd5\x9a\x3b\xf7\x0a\x08\x45\x5c\x8d\xec\xec\x8a\x1e\x9e\xeb\x74\xf1\xe1\xc7\x27\x13\xc4\xc2\x94\x15\x59\x8f\x56\xa1\xc8\x96\x1e\xed\x18\x80\xc9\x78\x68\xc9\xf6\x88\x72\x33\xe8\xbd\xdb\x05\xe3\xd5\x10\xce\x4b\x6b\xf3\xe8\x05\x72\x47\x76\xd3\x45\xb1\x13\x57\xb2\x1d\x5c\x21\xc6\x8d\x57\x39\x4b\x5a\x88\xee\x73\xa5\x6c\xb9\xf1\xf6\x8b\x22\xf9\x71\xef\x82\x1f\xd9\x02\x2d\xd8\x40\x8e\xa4\xdf\xb7\x52\x54\x1f\x09\x33\x32\x02\x2d\x92\x78\x58\x40\xf2\x80\x38\x91\x11\x72\xa6\x2b\x36\x64\x24\x2a\x64\x7b\x7c\xdd\xdf\xa0\x0f\xc2\x83\x8c\x98\x78\x14\x06\x64\x63\xb6\x87\x42\x7f\x8c\xc1\x7d\xa4\x7c\x00\x01\x4d\x11\xe2\x63\x0d\xc9\x51\x40\xf2\xc3\x61\x3d\xc6\xa0\x3c\xfa\x3f\x96\x90\x15\xe3\x3b\x7a\x7c\xad\x76\xc6\x52\x12\x64\xc7\x1d\x88\x8d\x02\xc2\x75\x76\xaa\x63\x3a\xde\x8f\xd5\x28\x20\x7a\x2f\x9a\x63\x00\x80\xd9\xfd\x6b\x87\xd2\x28\x78\x81\xb7\x8a\x06\x52\x7c\x46\x01\x4d\xf2\x06\x75\x42\x66\x8c\x29\x0c\x88\x44\x63\x8c\xc0\x61\xdc\x88\x41\xf6\xc4\x18\xb8\x61\xfd\x77\xc7\x21\xb7\x91\x15\x05\xa4\x77\x61\x2f\xee\x8d\x47\xca\x68\x6e\xeb\x25\xa3\x87\x45\x86\x3e\x78\x74\x68\x57\x84\xe8\x80\x37\x89\x48\x6b\x16\xfc\xd1\xde\xfe\x6c\x67\x3d\x19\x7b\xee\xa3\xd1\x7b\x98\xfd\x2f\xb1\xfc\xcb\x7c\x99\xcf\xf3\xeb\xf5\xd5\x12\x95\x45\x4f\x72\x40\x6e\xe9\x34\x2b\xc5\xe3\xbf\xef\xf9\x5e\x67\x53\x59\x44\x3f\xb0\x97\x2b\xba\x83\x3d\x47\x7f\xb1\x87\xae\x72\x15\x60\xb8\x44\x80\xd9\xa7\xf4\x22\xe7\xfb\xe4\x1a\x6d\xa2\xc3\x52\x8c\x7a\x0f\x57\xae\x29\x2a\xd3\x9e\x11\xbe\x63\xc2\xdf\xd5\x9f\xf4\x49\x6b\x10\x29\xd3\x34\x4b\xc2\x0c\x5a\x28\x2a\xb8\x47\x2c\xfb\x47\x28\x36\xe4\x8b\xdf\x52\xcb\x11\x23\xeb\x5f\x81\x9c\xbb\x09\xea\x2d\x04\x32\xaa\xcc\xab\x6a\x47\x12\x9d\x15\x56\x04\x5e\xc8\xc9\xb6\x69\xa1\x26\x79\x51\x18\xbb\xcc\xb3\x44\x32\x58\xbf\xf2\x2a\xc9\xd1\xf1\xa9\xed\xa8\xcb\x2d\x1c\xde\x72\x94\xc6\x70\xc3\xae\xf6\x27\x36\xf6\x63\x93\x00\xb6\xa4\xf2\x61\xd1\x5a\x0a\x48\xaa\x6a\xbd\xd9\x4d\x80\xe2\x24\xee\xca\x53\xff\x09\xdc\xeb\xea\xfc\xe5\x53\x59\x35\x94\xc8\xed\xb4\x63\x52\xe7\x9b\x4c\x50\x1b\x02\x4d\x50\x44\xb2\x36\xa9\x7a\xe8\x9e\x75\x22\xb4\xf8\x52\x37\x66\x3d\x20\xfd\x45\x67\x42\x92\xd8\x5a\x1d\x48\x52\x14\x88\x8d\xb6\x1b\xe3\xda\xb2\x38\xea\x92\xe5\x89\x63\x15\xf7\xb9\x31\x2d\x99\xca\xf7\x2e\x2a\xc6\xc7\xf6\x93\x5b\x5d\xfc\xc0\x67\xf3\x89\x49\xd6\x16\x95\xe3\xb9\xe2\x0e\x0d\x51\x97\xe0\xf6\x8a\x77\x9c\x76\xd8\xb0\xc2\xe0\x94\x39\x26\xc6\xc6\xa3\x42\x0d\xdb\x87\x53\x33\x4b\x25\x11\x28\xff\x05\x8f\x39\xe9\x27\xf9\xd4\xef\x76\x08\x42\xb9\x97\x51\xaa\x70\x04\xc5\x8b\x6c\xa0\x5e\xe6\x25\xbe\x3d\x7b\x97\x5a\x0c\x31\x4b\xd4\xd3\xdc\xd8\x97\x79\x49\x3f\xf9\xa0\x4b\xec\x26\xd1\x71\x81\x39\x37\x1a\xc7\x32\x73\x75\x69\x90\x3c\x51\xef\xaf\xc6\xc0\x4d\xf0\x4c\x58\x49\x1c\xf7\x8b\x0c\x19\x93\x6e\x7d\x22\x28\x06\x5d\xcf\xf2\xb0\x7c\x6c\x18\x39\xa8\x94\xff\xed\xdf\x16\x41\xb4\x3e\x2e\xde\xaa\xbc\x68\xec\xd4\x20\x92\xe2\xce\x21\xf2\xf0\x60\xa3\xf3\x6f\x52\x1b\x41\xb3\x30\xcb\x39\x45\x2e\x18\x8d\x58\xc3\x45\xac\x4b\x73\x9d\x4e\xd4\xc2\x14\xd7\xd4\xde\xa5\x2d\x68\xd0\x51\x92\x77\xe6\x42\xa9\x1b\xb8\xfa\x92\xba\xb4\xbd\xcf\xfa\xc6\xc8\xe8\x0e\x03\xfb\x89\xfe\x5c\xa4\xb0\x77\x9b\x25\x5d\xef\xa4\x73\xd9\xb3\x08\x1b\x5a\x5a\xc2\xd7\x79\xbf\x1a\x12\xa0\x36\x48\x1c\x0b\x6a\x96\x04\x19\xf0\x4f\x5c\xaf\xc4\xde\xff\x12\x8d\x61\xa9\x53\xb8\xd5\xcf\xb9\xb3\x6a\xfd\x79\x5f\x27\x55\x7b\x95\x88\x24\x46\x82\xa6\x69\xbf\xac\xd2\x5b\x3d\x87\xfa\x00\xe1\x9d\x29\xc3\x80\x1e\xf9\x74\x4b\x05\x93\x89\x98\xbb\x59\x6e\x0d\xae\x11\x76\x2c\x21\xe7\xe2\xc6\xac\xfb\x83\x2d\xe9\xd5\xbf\xc8\xfa\x32\x9a\xbe\xa9\x5b\x43\x22\x04\xad\x85\x5c\x6d\x7d\xfa\x5d\x5f\x9a\x15\xa3\x1e\xa0\xbc\x45\x70\xb5\xf8\x4f\x17\xfa\xdd\xd5\x8d\xb9\x3b\xeb\x45\x70\xd7\xd1\x0b\xf7\x10\xff\x74\xcc\xea\x56\x62\xae\x0b\x13\xd1\xd7\x93\x0c\x80\x85\x46\x3a\x8d\x5a\xa1\xc3\x29\x96\x33\x49\xc1\xf1\x63\x20\xee\x73\x0b\x92\x9f\xe1\xd0\x7b\x93\x59\x5d\xa6\x76\x4a\x35\xf7\xff\xf7\x69\xfe\x32\x2f\x81\x84\x96\xac\xe6\xe6\xe7\xf6\xad\x74\xd5\x80\x18\xe3\x42\xbf\x23\x94\x1e\xaa\x74\x2a\xd1\x32\xc3\x43\xdc\x4f\x80\x65\x5f\xde\xa1\xa5\xb0\xe4\xb6\xa8\xec\x18\x6f\x61\xd5\x0d\x1a\x55\xea\xe2\xda\x94\x95\x9d\x22\x69\xc6\x82\xf1\x5d\xcf\xf3\xb1\x9e\xab\x85\x03\x13\x1a\xa9\xe7\x39\xfa\x08\xea\xc5\x72\x6e\x5c\xa2\x82\xfa\x7a\xf8\x2b\xf0\xd8\x51\x76\x5f\x9a\xa2\x7d\xee\x7e\xaf\xaa\x4e\xc2\xa7\x4e\xc1\x5e\x86\x50\x78\x70\x69\x36\x8c\xd0\x56\xca\xce\x7e\x46\x75\xc6\xe9\xa3\xd3\x47\x8f\xcf\xd4\x7b\x85\xa1\x9d\xf2\xf7\xaf\xf8\xfb\xd7\xea\xbd\x7a\xaf\x94\xba\x54\xaa\xf1\x1d\xff\xbd\x6f\x7d\xc9\x10\xbe\xc7\xda\x1c\x4e\xb1\x0c\x93\x7c\xc1\x0b\x0e\x60\xfe\xe0\xf2\xb6\xcc\x11\x22\xb3\x14\x43\xfd\x1a\xcb\x31\x36\x13\x54\xc8\x62\x0e\xa7\xff\xc7\xd3\x00\xf9\xb4\x54\x79\xc6\x7f\x79\x7a\x8c\xff\xfd\xaa\x3d\x6c\x74\x47\xfe\xfe\x85\xbe\x21\xef\xa8\x3a\x9f\xa0\x51\x02\x0e\xcb\xf1\x57\xc3\xc7\x27\xc8\x5c\xaa\x93\x53\xb7\x69\x3e\x97\xf4\x29\xe4\x15\x38\x3e\x3d\x19\x6d\x2d\xc9\x57\x3b\x96\x44\x92\x07\xe0\x57\xcb\xcd\x52\x67\x6b\xda\xae\xfb\x4f\x9d\x3f\x70\xe7\xd9\xfa\x4e\xaf\xc5\xc7\xce\xbb\x23\xe0\x33\x51\xb3\xf4\x1a\x7d\x86\x2a\x2f\x1d\x7e\xc3\xa7\x44\x92\x89\x4a\xd6\x91\x1b\xd4\x5a\xa5\x25\x8a\x14\xd1\xac\x57\x07\xc5\x83\xc3\x67\xde\xb7\x2a\x8a\xa5\x52\x8b\xa2\x53\x3a\x14\x8f\x37\x9c\xf2\xa3\x23\x79\x62\x4d\x6b\xe7\x7c\x89\x7b\xad\xe9\xdf\xf8\xd6\xac\xa3\x24\xb3\x77\x7e\x7d\x5b\x59\x96\xb8\x10\x91\x3b\x93\x27\x7c\xbe\x05\x86\x30\xba\x02\xb0\x1b\x88\x23\x12\xf4\xa4\x97\x16\xa9\x0d\xa6\x75\x4a\x21\xbc\x89\xa0\x47\x70\xb8\x42\x1b\x80\x6e\x74\xfe\xbc\xd0\x24\x19\xe4\xa7\xde\x3e\xca\xb7\x15\x25\x97\xd3\xf5\x17\x68\x27\x6e\xc7\xff\x0a\xb1\xa4\x55\x7f\xbc\x02\xfc\x59\xdf\x49\xbc\xb2\x68\x57\xef\x80\x1b\xbe\x2a\xd5\x58\xcf\x75\x06\x75\x7d\xcb\x5f\x05\xf8\x7f\xbc\xcc\x51\x26\x06\x6c\x67\xdb\x2d\xfe\xec\x1d\x48\xf7\xdb\x3a\xa5\x71\x17\xf9\xdb\xcd\xc7\x6b\x59\xa1\xec\x25\x4d\x8c\x60\x73\x19\x96\x07\x02\x39\x9d\xfa\xea\xbe\xec\xe8\xa8\x3a\xab\xb4\xbf\xee\xc2\x98\x04\x9f\x6c\xfb\x1e\x0f\x55\xe3\xae\x57\xc7\x9c\x73\x79\xa2\x4a\x33\x9f\x83\x47\x2b\xb1\x5f\x28\x49\x5b\x91\x32\x0f\x0f\x90\x00\x19\xaa\xa6\x5c\xdb\x45\x38\x8a\x28\xe7\x9a\x81\xa9\x21\x53\x7d\xfe\xf0\x00\x29\xa8\xab\x52\x5d\xa7\x22\xdc\xbc\x16\x41\xe9\x0e\xa7\xbb\x6c\x66\x66\x0e\x6c\xfc\x64\xd5\x5a\xaf\x05\xf9\x69\x20\xfa\x6f\xcc\x1d\xb4\xfa\x6a\x27\x20\x2d\x6a\xc7\xb2\xdf\x60\x8a\xf6\xdc\x53\xe4\xd8\xe9\xac\x79\x0d\xa5\x53\x3a\x74\xbe\x1b\x19\xba\x0f\xaf\xd5\x32\xb7\x36\x1d\x0b\x72\xd2\x29\xb7\x57\x5b\x9b\x5e\x93\x55\x10\xaa\x7d\xb0\xb2\x6e\xda\x7c\x75\xaa\x3e\x5f\x85\xed\xa3\xcc\x33\x65\xf3\xba\x88\xf9\x9d\xa8\x57\x5f\x43\x35\xd9\xaf\x5e\x5d\xaa\x4b\x75\xd9\x4a\x72\x5b\x0d\xbb\x98\xaa\x1d\x32\x20\xcc\xa5\x71\xf8\x04\x17\x7e\xab\x66\xe6\x35\xaf\xaf\x48\x9f\xfa\xba\x5d\x9f\xaa\x14\xb4\xaf\x1f\x7d\xf5\xe8\xf4\x18\x6b\xf1\xd5\x09\xa4\x7b\x43\xb5\x3a\x0d\xaa\x95\x98\x32\xcf\xd8\x58\xbf\x87\xa4\x5c\xc1\xb3\x85\x06\x78\x68\xb5\x94\x58\x2e\xe2\x73\xba\x76\x2b\x45\xcc\xd8\x96\x9c\x23\x96\x2e\xfc\x1d\x32\xa0\x73\xef\x97\xa0\x50\x77\x39\x49\x46\xe8\x88\xad\x24\xd3\x52\x7d\xb9\xc8\x0b\xf3\x65\x8d\xde\xbd\x4a\xcf\xd1\x61\x6e\x15\x4f\x75\xdf\x55\x32\xf4\x86\xe3\xde\xbf\xf1\x07\xed\xdb\xbd\x5e\x9e\xe1\xf6\x2d\xd6\x7b\xa0\x65\xdb\xee\xd0\xe1\x96\xb3\x67\x3d\xd1\x45\xe9\xba\x3e\xda\x5e\x67\x67\xdc\x0e\x6a\x4d\xf7\xb7\xcb\x0c\x73\xa3\x0a\xcd\x7b\x48\xca\xef\xa1\x0a\x6f\x14\x59\x3e\x40\x59\xb1\xdc\x91\xbb\x91\x3a\xee\x55\xab\x96\x8a\x25\x69\xd4\x43\xdf\xd9\x67\x68\xba\x9f\x4e\xfe\x86\x56\xda\x57\x65\x5e\xb4\xa6\x57\x34\xa6\x7e\x74\xfe\xf6\x6a\x8b\x42\x63\x25\x32\x75\xfe\xf6\xaa\x85\xa4\x52\x4f\x53\x7b\x83\xd4\x9f\x7c\x55\x4c\x7c\x4f\x01\xeb\x91\x6b\x5c\xed\x6e\x28\x00\x3a\xb2\x4a\x54\x75\xb2\xd0\x88\x94\x1a\xef\xf4\xc9\x42\xcf\x16\x4e\xcf\xc0\x1a\xc6\x22\x75\xd9\x32\x2f\xf4\xb5\x79\xc4\xec\xf6\x85\xbe\xb3\xc6\x4d\x9f\x7a\x91\xe3\xd7\xe6\xa8\x77\xb8\x90\xd4\xd4\xbe\x16\xd5\x03\x6d\x6e\xcb\xf3\xd0\x3b\x99\x0e\x98\x4f\x5a\x61\x7e\x6c\x65\x43\xff\x59\xe7\x2b\x75\xa7\x9d\x4f\x8f\x5a\xf2\x8d\xd4\xeb\x74\x79\xa6\x9e\xd5\x7a\x3f\x78\x00\x18\xf7\x3a\x11\x59\x5f\x43\x55\x65\xec\x73\x0a\x25\xf6\x95\x5d\x7b\xb8\x76\xb8\xfb\xb3\x88\xe6\x33\x77\xed\xdb\x33\xd5\x37\xef\xca\x3f\xf6\x07\xaa\xff\x6e\x6a\xf1\x2d\x2b\xa7\xd4\x37\x66\xb1\x9c\xa7\x93\xb4\x84\xfa\x92\x4d\x4d\x51\x88\x52\x72\x7c\x7f\x7c\x47\x74\x3b\x1f\xe7\xf0\xec\x23\x1a\x14\x41\x2b\x53\xe2\x43\x92\xab\x3b\x2a\xc6\xbb\x85\x5e\xc5\x39\x73\x69\xd6\x65\x5b\xa8\x53\x2a\x52\x07\x8b\x7c\x91\x86\xe6\x95\x7c\x8c\x8e\x7a\xb2\x7d\x15\x99\x39\xf8\x6f\xa9\x8b\x92\x5c\xe9\xf1\xdc\x4d\x7d\xba\xfc\xe3\x5e\x24\xd6\x78\x5b\xad\xf3\x95\x80\xa8\xda\xe4\xed\x8b\xa9\xca\x17\x80\xfc\xe1\x54\x73\x5f\x10\x96\x56\xad\xcc\x45\x64\xc7\x6b\x3f\x1a\x5c\x03\xa3\x1a\x6f\x42\x41\xe5\x5f\x11\xf4\x8d\x4d\xf4\xe9\x40\x7c\x14\x1d\xe3\x71\xb3\xb5\x30\x7f\x6d\x55\xff\xb4\x3f\x52\x57\xbe\x1b\xaa\x3c\x3e\xc6\x43\x09\x5b\x01\xf7\x47\x18\x18\x4e\x68\xff\x71\x5f\x1d\x3b\xd8\x54\xf8\xa1\x44\x44\xe7\xc6\xe7\x55\xb2\xa4\x5b\xbb\x5e\x50\x27\xad\xce\x97\x58\x07\x4c\x9c\x13\x26\xb6\x27\x73\x93\xe7\xae\x78\xed\xfb\xe8\xa6\xd3\x07\x3f\x4c\x73\xdc\x55\xb8\x5d\x48\xbb\x16\x2e\xf9\x2b\x1e\x41\xb5\x38\x69\xc6\x1a\x04\x75\x7d\x26\x56\x73\x2f\x19\xb5\x77\x16\x61\x43\xe8\x1e\xa6\xed\x53\x0f\x9c\xfe\x6f\x72\xcb\xc5\xa6\x78\x7a\x66\xbc\x78\x1a\xbf\x37\x6f\xb2\xf4\x97\x95\x51\x17\x4f\xfd\x3d\x57\xc1\x44\xa8\xa4\xae\x59\x08\x28\xc3\xea\x81\xca\xa2\x8e\xcf\x17\xfa\xd7\x3c\x53\xcf\xfe\x76\xc5\x43\x3b\xf9\x8d\x17\x52\x24\x58\x25\xda\x3e\xd7\x17\xf1\x7a\x1f\x2a\xf4\xa4\x7f\x5d\x15\x06\x8a\xdc\x59\x2f\x62\xf3\xce\xfd\x53\x9b\x5a\x23\x7e\xae\x9e\x22\x09\x16\xbf\xed\x89\x1a\xfb\xfb\x4a\x62\xd2\x23\x70\x32\xc7\x94\x33\x47\xbf\xaa\x69\x7d\x2d\xc4\x62\x14\xb4\x09\xdd\x8e\xd7\x2f\xf2\xa4\x83\x96\x86\x1c\x6c\xf5\xc4\x51\x50\x0b\x90\x50\xe8\xc3\x3f\x20\x21\xa1\x20\xa7\x64\x77\x03\xfd\xf9\x5b\xf4\x84\x1f\x1d\x98\x9b\x14\x9d\x1f\x59\xd6\xf5\xc6\xec\x5e\xd7\xfa\xec\x63\x4f\xd0\x7d\x89\xa8\xf9\x0b\x7b\x3c\xcf\xc7\x02\xa2\xc0\x2d\x25\xad\xe9\x43\xcc\xec\xcd\xab\x8b\x4e\x13\x7b\xf3\xea\xe2\xfe\x49\x7d\xa8\x01\x77\x34\x06\x36\x6d\x81\x4a\xdf\x09\xdd\x17\x04\x24\xeb\x78\x2c\x8e\x4e\x8b\xf6\x2e\xa2\xe9\xf1\x5d\x9e\xbd\x1b\xb5\xeb\xed\x22\x8a\x5e\xb7\xdf\xab\xb7\x1f\x7a\x67\xd0\x6b\x21\x7a\x5f\x8e\x9e\x79\x94\x7a\x8e\xa5\x5c\xcd\x74\x61\x92\xb3\x0a\x6b\x56\x7c\x42\xc0\x81\x16\xe0\x48\x9e\xf5\x94\x9e\x50\x4a\x2f\xd0\xcb\x5c\x5b\x88\xe4\xcc\x27\x7d\x44\x51\xdd\x4d\xf4\x85\xce\xf4\x35\x86\x4a\x32\x5f\x2d\xdc\x3f\x65\x44\xc3\x89\x39\x26\x6f\x62\x9a\xf9\xc7\x95\xbe\xd5\xe9\x5c\x8f\xd3\x79\x5a\xae\xe1\xb1\x14\x62\x23\xb0\xc2\x43\x8a\x93\xa5\x25\x3c\xb8\x10\xec\xac\x36\xd6\x4b\x42\x48\x19\x53\xc7\xa0\xf5\x88\x00\x3c\x4e\x46\x41\x1b\x14\xd0\x55\x6a\x66\x0a\x6e\xce\xe1\x54\x4f\xc8\x9b\xa0\x4e\x72\xb1\xed\xa6\x36\x39\x3a\xb0\x9a\x26\x57\x2f\xfc\xc5\x21\xfa\xc3\x37\xaf\x2e\x0e\xaa\x86\x3c\x17\xb4\xde\x69\x6c\xd4\xb9\x7f\x6a\xa7\x1a\x02\x72\xea\xca\x14\x00\x59\xfb\x54\x35\x91\xcf\x3c\xea\x79\x54\x71\x4b\xe2\x4e\x6a\x4b\x1d\x6f\xce\x91\x69\xb4\xa5\xb3\x8e\x23\x04\x54\x95\xba\x62\xb1\x79\xce\x62\x13\xe3\x21\x5e\xd8\xef\x3f\xef\x24\xa1\x48\xf0\x75\x9a\x30\xdd\x3a\x4a\x70\x52\x3f\x98\x21\x52\xed\x56\xfb\x9f\xfa\x69\x1e\x4a\x5a\x4c\xcc\x72\x36\x6d\x3d\x5d\x8d\x05\x7b\x62\x96\xb3\xe7\x57\x0d\x39\x41\x3f\x53\xcf\xaf\x1a\x67\xbf\x85\xa6\x62\x8c\x2f\x30\x17\xed\x1e\xe8\x2c\xa9\x50\x75\x9e\x4e\x8d\xa0\x67\x51\x8c\x74\x58\xe4\x59\x5a\xe6\x85\x8d\x66\x8f\xa3\x57\x7e\x1f\xd5\x0b\xa6\x01\xc7\x10\xca\xa6\xe7\x88\x7c\xb6\x37\xca\x67\x1f\xc5\xd4\x2d\x92\x1f\xc8\x2e\x53\x9a\x43\xb7\x76\x74\xf3\x67\x32\xa6\xd9\x6c\x7e\xe4\x36\xe9\xd1\xab\x67\xe7\x4f\x5f\x3c\x1b\x2d\x92\x2f\x66\xf9\xdd\xb0\xcc\x87\x2b\x6b\x86\x69\x79\xd4\x3b\x68\x76\x75\xd4\xb1\x93\x84\xa6\xaa\xaf\xa5\x10\xd8\xea\x3e\xe4\xf5\x37\x08\x09\x69\xce\x7c\xf4\x10\x61\x79\xde\xda\x2d\x96\x0f\xa4\x66\x84\x5d\xcd\xde\xe1\xd5\x7c\xee\xf6\xa4\x2c\x8c\x19\xd4\x1d\x47\x8f\x3e\x1d\x0d\xaa\x36\xfd\x96\x8b\x2a\xce\xfd\x26\xb9\xa8\x44\x14\x37\x2f\xb3\x8f\xcb\xd8\x5d\xee\x45\x89\x72\xb4\x6f\x1f\xae\x02\x15\x9f\x94\x06\xc6\x6e\xef\xee\x12\xea\xab\x14\x58\x86\x7c\xcd\x6f\xac\x29\x9a\x9c\x67\xca\x09\x9d\xf7\x47\xa8\x9e\x1e\xb9\x01\x8b\xe8\xfe\x06\xcb\x2e\xe4\x7d\x37\x89\x57\x66\xfa\xf0\x45\x7f\x65\xa6\x58\xf3\x00\x52\x26\x5d\x74\x6c\x92\x5e\xa1\xbe\xbf\x84\x35\x08\x57\x3f\x2b\x37\xbb\x76\x41\x44\x92\x0b\x68\x3e\xea\xb2\xc7\xdc\x78\x31\xdd\xac\xb6\x17\xbe\xee\xb9\xe2\xd5\x2e\xeb\x93\x15\x12\x55\x22\x37\x71\x7e\x0b\xd3\xc2\xdc\x3d\x62\x3c\xb0\x21\xf2\xef\x86\x2e\xdb\xdf\x3e\xc2\x24\xec\xa3\x2f\xe8\x9b\xf8\xb5\x2e\x40\x78\x9e\x24\x9c\xfe\xb2\xb2\x66\xba\x9a\xbb\xc4\x12\x3b\x52\x7a\x99\xfe\x00\x1f\x79\x1e\x51\xef\x05\x27\xc7\x40\xad\xd2\xe4\x1b\xc9\x56\x45\x9f\x92\x08\xa5\x4d\xde\x40\xf7\xfe\xf3\xe4\xbb\xe9\xd2\x06\xeb\x24\xa7\x3a\xeb\x82\x1a\xdf\xc8\x96\xa4\x26\xb4\xd0\xbf\x28\xfb\x64\x05\x90\x5c\x11\xf7\xba\x59\xef\x40\xfb\x34\x49\xb3\xa4\x7d\x97\x9a\x3b\xf4\x84\x9e\x69\x6a\xd7\x8e\x0e\x07\x2c\x42\x56\x48\x0b\x59\x57\xed\xc3\xc8\x92\x50\xc9\x39\x7f\xc4\x65\x8f\xf8\xb0\xb6\x48\x80\x2d\xd6\xf6\x97\xf9\xd0\x8d\x62\xb8\x4c\xaa\x7d\x3b\xea\x1d\x4e\x5e\x1d\x2a\xd5\x63\xcb\xbd\x2b\xa0\xa8\x94\xfe\xc0\xee\x5d\x71\x72\xc6\xe1\x9c\xbc\xb5\x9d\x15\x11\x3d\xf8\xee\x7f\x56\x92\x1f\xa4\x24\x1f\x60\xdd\xe3\xf4\xe2\x07\x6a\x68\xd4\x40\xc8\xfa\xda\x75\x10\x94\xde\x65\xb5\x3e\x85\x50\x8c\x43\x07\xd0\xaa\xd8\x66\x92\x67\x99\x99\x90\xeb\xf0\xfb\xa5\xc9\xae\x4a\x3d\xb9\x19\x7d\xd6\x98\x3e\x6b\x4c\x11\x1a\x53\xf7\xfc\x0a\xbe\x78\xd3\x24\xf0\xa3\xab\x13\xe2\x54\x24\x11\x68\x5c\x90\x03\xee\x40\x7f\x2c\x09\x70\x60\x0d\xc9\xaf\x61\xef\x40\xfb\x42\x0d\xc5\xaf\x5f\xe8\x16\x14\xb9\x8d\x0d\x79\xe2\x9f\xda\x50\x93\xc2\x8f\xc9\x63\x2d\xec\xa4\xbe\xcc\x97\x2b\xd4\x2c\x3a\x28\x04\xd1\x5e\xc6\x08\x15\x56\x93\xbb\x25\x48\x54\xc2\x75\x81\xfa\x11\x82\xdc\xf4\x2c\x88\xa4\xab\x1a\xc0\xa1\x80\x36\x55\x8c\x30\x68\x28\x43\x88\x8e\xd7\x7e\x80\xb5\x68\x78\xa6\xf2\x49\x29\xa8\x92\x0a\x65\x70\xa1\xd2\xf8\xf1\xe3\xc7\x8f\x49\xed\x7c\xfc\xa7\x3f\xfd\x09\x2d\x35\x80\x6b\x30\x49\x17\x7a\x2e\x2c\x5c\xc4\x7f\x81\x18\x51\xfa\x9f\xa7\xa7\x23\xf5\xff\xcf\x5f\x7c\x87\xe8\x2b\xac\x45\x07\x23\x45\x23\x14\x95\x21\xe3\xbf\xc6\x20\xec\x40\xfd\xbf\xab\xef\x5f\x7a\xae\xb7\x1b\xbf\x85\x5f\x46\x44\x34\x6c\x49\xb3\xf3\xdd\xe3\xff\xf5\xc7\x3f\x8e\xd4\x53\x46\xb4\x46\x6d\x13\xac\xd9\xd6\xae\xba\x7c\x70\xbc\x33\x09\x31\x09\xaa\xdb\x24\x90\x4a\xaf\x84\xba\xa2\x67\x68\x1d\xe2\xee\x9a\x35\x2c\x6b\x08\x9f\x3c\x9b\xce\xd3\x09\x10\xf0\xca\x19\x0b\x7a\xdf\x50\x98\x0e\x8d\x7b\x9f\x78\xac\xe0\x22\xca\xdc\x19\xa8\x79\x7a\x63\x3c\x56\x27\xd7\x27\xd2\x4d\x68\x61\x24\x4a\x73\x27\x01\xa9\x4b\x83\xaa\xf8\x1d\x20\x8e\xbf\x7d\xe2\xa4\xd8\x81\xde\x38\xc0\x9b\xfd\x9b\xa9\xac\xf3\xc6\xac\x87\xc4\x6a\xd4\x8e\x42\x40\x32\x60\xf8\x52\x36\x18\x5d\xc8\x1b\xae\x19\x54\x76\x06\xa1\x28\xa2\xe8\x5b\x3f\x2f\x8b\x1c\x3a\x03\x41\x58\x71\x5c\xd6\x9b\x99\x10\xa9\xb4\xbf\xd4\x89\x42\x44\x35\xab\xb5\xd0\xf5\x25\xb5\xdc\x6a\xd8\xff\x98\x66\x8e\xcc\x4e\x39\x82\x69\xc5\x6e\xe8\x3a\x66\x12\x54\xeb\xda\x7d\x33\x08\x94\x45\x84\xe1\xbc\x45\xaf\xae\x2c\x51\xab\x6c\xeb\x0d\x38\x84\xf4\x16\xba\x66\x64\xf6\xc3\x05\x7a\xae\x72\x37\xac\x30\x16\x86\x25\xe1\x7a\x6c\xa6\xd7\xde\x6d\xbb\x9a\x7c\xd8\x60\x6e\x32\xe9\xb6\xc9\x9a\x72\xb5\x74\x8b\x41\xc9\xef\x98\x43\x3b\x74\x84\x67\x6a\xec\xca\x42\x17\x37\xc6\xc3\x9b\xe8\xf9\x48\x5d\x62\x41\x42\xa3\x20\x8f\x43\x23\xa2\x88\x0d\x47\x0d\x4f\x96\x87\x88\x31\x0d\xf6\x68\x34\x3a\x72\x82\x2d\x2f\x18\xe3\x12\x12\x48\x44\x13\xcf\x8e\x0e\x1b\xe3\x6a\x1c\xd2\x17\x84\xd8\xcb\x9a\x12\x76\x1e\x52\x5c\xbb\xd1\x46\x88\x6e\xf8\x10\xdc\xd1\x91\x31\xc9\xc7\xec\xcc\xf6\x9a\xcf\x23\xaa\xc1\xdd\x81\x97\x8d\x31\x52\x8f\xf4\x9f\x85\x50\xd9\x89\x54\x79\xc4\x14\xd5\xa6\x72\x04\xc5\x87\x7b\x34\xcf\x4d\x5d\xd3\x89\x20\xe9\x34\x0e\xa9\xbe\x13\x41\x78\x83\xe4\x1e\xad\x27\x7a\xb4\x20\xb5\x57\xf7\x89\xa0\xd8\xa4\x03\x2d\xa9\xda\xa1\x78\x54\xea\xfa\x57\x4d\x9a\xd5\xb4\xe5\x0d\x74\x02\xb1\xba\x13\xa5\xf4\x44\x8d\x32\xa8\x47\x7b\x55\x9f\x08\x92\xdb\x4a\xd2\x43\x14\xa0\x6e\x6a\x50\x17\x65\xa8\xba\x37\x1f\x20\x8f\x02\xbe\x19\xe8\x78\x7d\x46\x88\xef\xe6\x3f\xf0\xf5\x92\xb9\xe7\xc5\x9b\xeb\x10\xcd\x77\xb6\xce\x94\x1e\xdb\x7c\xbe\x12\x34\xa4\xa9\x3e\x18\x4e\x45\xa6\x7e\x89\xe1\x37\xa1\xaf\x97\xf4\x56\xf2\x5f\x9e\x60\x75\xf9\xd1\xb0\xf9\xd6\x89\xa3\x16\x2d\x92\xa5\x06\x7e\x7c\x1b\x3b\xac\x89\xe8\x4f\xc5\x2e\x81\xf8\xb4\x12\xa9\xcf\x2e\xce\x5f\xf7\x5b\xf8\xe1\xe4\x3e\x38\xe7\x5b\x13\x11\x95\xfa\xdf\xa2\xb8\xca\x6b\x8b\xd1\xeb\xee\xeb\xc8\xee\x66\x86\xd3\x71\x6a\x9a\x2d\x5c\x06\xe9\x9e\xd6\xd9\xf5\x0f\x19\x01\x5e\x49\x65\x4c\xbd\x03\x3b\xc6\xc5\x3c\x3b\xb1\xe9\x59\x2f\x62\x11\x9e\x5c\x5d\xa8\xe3\x80\x3b\x11\x12\x25\x2f\xb2\xd2\x14\x53\x3d\x31\x27\x35\xc7\x56\x0b\x5d\xa5\xcc\x72\x66\x16\xa6\xd0\xf3\x90\xa7\xee\xeb\xc7\x67\x3a\x4b\xd0\xa4\x62\xbc\x56\x13\x53\xa0\x35\xb1\x32\xef\x4a\x53\x64\x02\xfd\x04\x43\x4c\x8a\xf4\x16\xf8\x0b\xc7\x7f\x33\x30\x3c\x1d\x9a\xc5\xc9\xe8\x90\xde\x31\x7a\x43\x34\x13\x3d\xa5\xc7\xbc\x49\xe9\xd3\x55\xf1\xff\xab\x61\xf7\xc4\x97\xba\x5b\x26\x5b\x77\x00\x8e\xc0\x93\x74\x29\xe3\x40\xa3\xfc\xb3\x20\x94\x1e\x99\xc2\xe8\x21\xda\xa8\x09\xf8\xa4\xa4\xa0\x02\xec\xe9\xc2\x5c\xc3\xbc\x44\xe0\x8e\xaf\x14\xee\xcb\x32\x3a\xf4\xf1\x3c\x78\xa9\x4c\xad\x40\x45\x40\x54\x6d\xc5\x37\xa7\x6c\xf5\xe6\xb7\x69\xe2\x2b\x35\x29\x83\x26\xc2\x31\x08\xa4\x5a\x6d\xd9\xfd\x09\x02\xda\xda\x7c\x92\x6a\xd8\xfe\xd5\xbe\xb3\x9d\x2d\xc0\x25\xf7\x0b\x50\xef\xe7\xce\x0a\x68\x23\x1a\x9c\x13\x80\xd7\xfa\xe0\x9b\x84\x9e\x40\x97\xab\xf1\x3c\xb5\xb3\x90\xdd\x14\xbd\x65\x2f\x77\x10\xc1\xc1\xd0\x95\x6f\x48\x40\x32\x20\x22\x37\x02\x77\x5e\xfb\x81\x5d\x6c\x4d\x66\x53\xd2\xd7\x10\xcd\x82\x8e\x29\xcd\x7c\x85\xd1\xa9\x6d\x40\x05\xa9\xed\x14\x05\xf6\x10\xf1\x20\xef\x3b\x1d\x5e\x11\xc5\xda\x9c\xb9\xf7\x08\x34\x69\xfc\xf4\x4d\xb6\x6c\xfc\x7c\xa2\xe7\x73\x51\x7e\xbd\x52\xaf\x2b\x0c\x9f\xd4\x06\x6f\x88\xf3\x0b\xf9\xbe\x24\x8e\x63\xd3\xa9\x0c\xfd\xd4\xc7\x55\xb1\x1d\x5e\x03\x0b\xed\xa7\x77\x2e\xb4\xec\xe6\x43\x03\x1d\x48\x2e\xf4\x23\xf2\x84\x06\x80\xdd\xf1\xef\x0b\x3b\x2f\xef\x12\xee\x0e\xd6\xa8\x77\x78\xbf\xc5\xe7\x88\xea\xbf\x6d\x44\xb5\x73\xbe\xc6\x15\xbb\x09\x9c\x98\xd2\xc9\x90\x4a\xe1\x3c\x6a\xb5\x5c\xb4\xf8\x8b\xd6\x5f\xda\x0f\x4f\xfe\x88\xcb\x9b\x70\xef\x3d\x2f\xb9\xc5\xef\x27\xdc\x41\xfb\x87\x8d\x91\x92\xae\x68\x2c\x4b\xe2\x21\xfb\x6d\x26\xa2\x71\x54\x12\x80\x9d\x22\x85\xd9\xb8\x98\x2b\x19\x2f\x59\x73\x15\x94\x2d\xd2\xb3\xdc\x83\x47\x56\x25\xf9\x64\x05\x5b\x5b\x87\xd6\x1c\x21\x27\xab\x17\xd1\xf0\xff\xb0\x5c\x2f\x35\xa5\x87\xbc\x00\xbd\x03\xbd\x39\xc9\xef\xb2\x3b\x5d\x24\xe7\x97\xad\x15\xdb\x8d\x8d\x7f\x5a\x3d\x57\x8f\x96\x7b\x72\xea\xfc\xf2\x42\xe9\x71\xbe\x2a\x7b\xa2\xd8\x24\x37\xf1\xf2\x40\x80\xbf\xb7\xe0\xb9\x03\x65\xac\xc5\xc1\xe5\x95\xdf\xbb\x63\xe5\x6a\xc7\x5b\x44\x34\xef\x09\xe3\xef\x0d\xd1\x8b\x08\x57\xce\x6d\x91\xcb\x5a\x44\x73\x23\x8c\xdf\xe6\xac\x16\xd1\x6c\x86\xf1\xf7\xbb\xa9\xfd\x6f\x45\x84\x77\x3b\xa8\xf7\x86\xe8\x45\x74\x19\x70\x57\x1e\xa2\x17\x51\x25\xb5\x33\x26\x44\x2f\xa2\x2a\xf0\x53\x6f\x84\xe8\x45\x64\x1f\xe8\xa1\xfe\x24\x43\xf4\x08\xec\x37\x31\xad\x1a\xb2\x51\x24\xd3\x2a\x31\xf1\x01\xe3\x94\x35\x49\xee\x6e\xf3\x8d\x72\x6d\x11\x45\x55\x37\xdf\x70\x16\x9c\xb0\xa9\x38\xa5\x66\xf2\x49\xbb\x68\x11\xd8\x9a\x53\x6b\x23\xf5\x07\xd9\x2a\x60\x65\xcd\x3c\x11\x5a\xc7\xfb\x6b\x38\x5d\xbb\x5a\xab\xaa\x3c\x0a\x31\xc9\x70\x03\x9e\xb9\x0e\xfd\x3a\xcb\xf2\x92\xd6\xd1\x0e\xb8\x15\xf9\x40\x82\x6c\x5e\xff\xc2\x19\xc4\x23\x76\xa9\xd1\x4b\xab\xa8\xa5\x9d\x8b\x4e\x54\xd7\x05\xc5\xa7\xb2\x3f\x62\x9e\xda\x58\x5a\xa6\xe0\xcd\x34\x74\x85\x5d\x68\x71\x33\xb0\xea\xf3\x1c\xdc\x83\xc4\x03\x1c\x45\xa8\xeb\xa5\x41\xff\x65\x05\xdf\x8c\x55\xf9\x34\x94\x67\xc9\xcd\x2f\xde\xb3\x5c\xf5\x6f\x4f\xfb\xa3\x5e\xc4\x33\xb1\x9a\xb6\xff\x9a\xfa\x49\x3c\x60\x41\x2f\x1b\xd1\x30\xb0\x28\xda\x7a\x10\xd7\x46\x11\x0d\x49\x43\x21\x24\xeb\xc4\x99\xdb\xaf\x8f\xb0\x1c\x52\x45\xb9\xfa\x1a\x56\x0b\x28\x7e\x26\x42\x73\xff\x7d\x64\x27\x0c\x42\xe2\x8d\xc0\xfe\xfc\x9c\x9d\xf0\x39\x3b\xe1\x73\x76\xc2\xa1\xb3\x13\x6a\xea\x82\xbf\x91\x78\x21\x22\x32\xe1\xaa\x51\x34\xe2\x53\xb4\x2f\xae\x6a\x8b\xcd\x3c\x97\x8e\x14\x41\xd3\x67\x37\x70\x6a\x03\x62\xa6\xac\xb4\xd1\x1b\x8e\x90\x4b\xe0\x92\xed\x62\xa4\xbc\x37\x1b\x57\xe5\x74\xf8\x67\x65\xb2\x49\x9e\xc0\x5b\x8d\xcc\xb1\x69\x5a\xd8\x92\xd4\xd6\x78\x75\xa9\x99\xe5\x41\xa2\x6d\x23\x0b\xc2\x8d\xf7\xa8\xf7\x01\x2f\x23\xdf\xfe\xf2\xf9\x03\xd5\xc8\x4a\x79\xf4\x14\xfd\xb6\xf2\x06\xc8\x42\x99\xfe\x8b\xb4\x48\x4f\x09\x5d\x4f\x16\xb8\x4e\x20\x15\x71\x77\x1a\x80\x74\x1e\xbb\x1f\x8e\x26\xcb\x55\xcc\xd1\xe5\xa7\x16\x66\x91\x17\xeb\x41\x20\x07\x32\x0d\xfa\xfc\x17\x27\x11\xa4\x91\x98\x3e\x59\x15\xf0\xc5\xcf\xd7\x1f\x51\x5b\x0d\x2b\x2c\x6d\xf2\x73\xdf\x26\x56\x61\x77\x9c\xcb\x33\x7f\xd9\x24\xbd\x08\x8a\xec\x7a\xe6\x72\xe6\x01\xcb\x6b\x4d\x65\x82\xca\x64\xb7\xea\x56\x17\x56\xbe\x22\x1d\xd9\x1a\xff\x25\xe9\x6d\x6a\x63\xa0\x6b\xf1\xd1\xd9\xfa\xfb\x88\x43\xc0\xe0\x07\x1d\x84\x70\xf3\xd9\xe8\xc9\xdd\x1b\x35\xc0\x91\xcb\x57\xe5\x72\x55\xf2\x8d\x12\x45\x54\xf9\x53\xeb\x1b\xe2\x87\x33\x58\x59\x19\x71\x0a\x1d\x3e\xfd\xd3\x7e\x2f\xe6\x81\xa5\x2e\x91\x8a\x71\xa6\xfe\xe3\xf8\xa7\x3f\xbc\x1f\x9e\x7c\x73\x7c\xfc\xe3\xe3\xe1\xff\xfe\xc7\x1f\x8e\x7f\x1a\xd1\xff\xf9\xf2\xe4\x9b\x93\xf7\xfe\x1f\x7f\x38\x39\x39\x3e\xfe\xf1\xdb\x17\x7f\x7f\x7d\xf9\xec\x1f\xe9\xc9\xfb\x1f\xb3\xd5\xe2\xc6\xfd\xeb\xfd\xf1\x8f\xe6\xd9\x3f\x84\x44\x4e\x4e\xbe\xf9\x1f\x51\xc3\x7c\x37\xac\xa2\x66\xc3\x34\x2b\x87\x79\x31\x74\x1b\x79\xa6\xd0\xa5\x39\x82\x98\x5f\xe6\xb3\xee\x2c\x50\xbb\x9a\x3d\xb5\xca\x3e\xfa\x08\x07\xce\x0b\x0b\xf9\x14\x86\x81\xbb\x3e\x9c\x4d\x13\x37\xaa\x4f\x21\x69\x4f\x4c\x98\x22\xee\x4f\xd3\x56\x01\xd7\xe4\x92\x67\xfc\x54\x3d\xe2\xa1\x55\x69\x16\xcb\xbc\xd0\x05\x50\x39\x9d\xeb\xb7\x3d\xb7\x70\xbb\x3d\x59\xad\x3f\x59\x74\xba\x20\x27\x68\xf9\x3e\x18\x5f\xd0\xec\x92\xb4\x38\xea\x1d\xee\xca\x5c\x98\x24\x5d\x2d\xce\x7a\xb1\x27\xeb\x2d\x66\xea\xc1\x32\x7c\x26\x99\x23\xc6\xd1\x1e\x01\x49\xa5\xc6\x7a\x72\xe3\xac\xd9\xb0\xc8\x4e\x8b\x64\xc9\x0a\x6d\xba\xdf\xe7\x24\x9d\x85\xd1\x42\x2b\x87\x03\x37\x10\xfc\x48\x9b\x01\x5e\x90\x27\xe8\xc6\x18\x9d\xd5\x4f\x6b\xcf\xe7\xbf\x86\xc3\x96\x17\xea\x05\x29\x45\x1f\x6d\x73\xa3\x85\x91\x4d\x7f\x35\xdf\x41\xc3\x3b\xeb\x1d\xf0\xae\x8f\xbf\xe1\xa3\xef\xf5\x26\xc3\xbd\xce\xc9\x09\xc0\x2d\x03\xa7\x84\x2c\x57\xe5\x30\x7a\x99\x16\x91\x07\x90\x5a\x15\x0e\xbe\xcf\x08\x00\xe7\x61\xbd\x9c\x76\x0d\xe6\xd3\x73\x2b\xbb\xda\x91\xf4\x95\x4e\x00\xdf\x44\xf4\x9d\xaa\x1c\xb8\xed\x75\x0d\x7d\x77\x65\x65\xdd\xae\xa1\xf2\x37\xe9\x54\x03\xbe\x43\xb1\x32\xd8\x17\x2c\xce\x78\xb9\xbd\x78\x07\x0d\x9e\xbe\xf2\xfc\x51\x39\x3c\x5c\xef\x5c\x69\xb9\x2c\x88\xd8\xd5\x02\x82\x80\x47\xcb\xb6\x49\x3e\xa5\x34\xa3\xa0\x8b\x5b\x69\xfd\x1a\x89\xce\x2d\x39\x90\xa5\xf3\xba\x20\x08\x78\x3a\x22\x92\x61\x43\x57\x19\x67\xff\x6e\x9d\xd8\xdd\x07\x16\x7d\x8e\x86\xd7\xab\x34\xe9\x76\x54\x3f\x69\xbd\xed\x81\xda\x9a\xfc\x5a\xf6\x39\xc7\x67\xbd\x88\x23\xdf\x7f\x16\x52\x95\x1b\x17\x33\xbb\xd7\x38\x6d\xb9\x85\xa2\xaa\xa7\x35\x6b\x0f\x66\x17\xe4\x06\xa7\x9d\x10\xab\x39\xba\x47\xed\x37\x0c\x1a\x8e\x4e\xd6\x13\x6e\xe8\x97\x56\x89\x2c\x1e\xb4\x8c\x13\xcc\x2d\xb0\x40\x87\x2a\x2d\x7b\xd2\xfa\x5a\x1f\xcb\x1f\x9b\xa9\xcb\xda\xa3\x08\x92\x2b\x81\xe4\xa2\xd3\x04\xa8\x5e\x02\x41\x07\x54\xb9\x40\x80\x52\x0a\x17\xf9\x2d\x7c\x34\x3f\x65\xea\x8d\xe5\x14\x8c\x74\x7a\xa6\xf4\x49\xcd\x69\xd9\x4a\x36\x65\xe0\xf7\xcc\x98\xc4\x24\x38\x8f\x35\xd0\xcf\x62\x95\xd9\x81\x1a\x9f\xf8\xe4\x6f\x2b\xf1\x00\x65\x30\xc9\xe6\xfc\x7e\x68\x4b\x37\x10\xe7\xd8\x20\x5c\xb5\x84\xb2\x64\x33\xbd\xb4\xb3\xbc\x24\xe7\x95\x5e\xea\x49\x5a\xb6\xeb\x62\x3f\x81\x43\x0b\x3d\x41\xc3\x13\x8a\x95\xb9\x21\x0f\xd4\xe4\x84\x6b\x69\xea\x2c\x20\xe1\xa4\x4a\x3e\x96\xb3\x22\x5f\x5d\xcf\x94\x0e\x54\x26\x73\x6d\xfd\x0e\x35\xe8\xb7\x52\xe5\xf7\xb3\x6f\xc4\xaa\x64\x9d\xe9\x45\x3a\xe1\x05\x81\xff\xe3\x36\x85\xe3\x1f\xd3\xe0\xf7\xb6\xd2\xc4\xdc\xb5\xba\x0c\xb8\x2e\x2e\x02\xfc\x64\xae\xd3\x85\x3a\xb6\xc6\xa8\x70\xb4\xdc\x6f\xae\x64\x26\x07\x5d\x6c\x60\xcc\x8d\xc0\x70\xce\xe9\xe9\xae\xed\x0e\x02\x7c\x7c\xc1\xb4\x52\xac\x25\x02\x91\x7a\xe0\x3d\xc9\x3b\x87\x7e\x02\xe6\x6d\x25\x09\xe6\xde\x3d\xf3\xbc\xa0\x14\x58\x36\xe7\x6f\x4d\x96\xe4\x11\xb9\x6c\xe7\x97\x17\xb6\xee\xc7\x61\x44\x56\xf7\x26\xfa\xc5\x3c\xcf\xae\x6b\x2d\x63\x23\xe4\x08\x6e\x4a\x04\x30\xe1\x9f\x49\x56\x7a\x8e\xd3\x14\x4e\x2a\xd2\xe3\x88\x3c\xd2\x3e\x86\x77\x06\xdf\xda\x69\x93\x7a\x54\x15\x7b\xf8\x41\xa7\x9b\x85\x0f\x90\x61\x50\xac\xdb\x49\x3a\x3f\xb4\x8b\x5f\x61\xea\x77\x7a\x8d\x1e\xd3\x86\x53\xe1\x1b\xe9\x77\x6e\x8d\xa5\xbc\x1f\xf8\xaa\xc6\x54\x34\xfd\xf3\x80\xa5\x8a\x3c\x2c\x51\xd8\x08\x5c\x04\xb9\xb3\x63\xee\x60\xac\x1a\xd6\x11\xff\xb8\x95\x22\xa3\xf6\x11\x88\x2d\xfa\x4c\xd3\xc8\x28\x11\x07\xfa\xa1\x1a\xd7\xaa\x5d\x02\xe6\x6f\x3b\x51\x87\xde\x30\x37\x95\xa0\xfe\xbb\xc9\x4c\x91\x4e\x36\x8e\xa6\x27\xdd\x4a\xf1\x1a\x5d\x69\x52\xab\x4c\x06\xb2\xc9\xa8\x7f\x40\x2b\xf1\xb6\x3a\x45\xaf\xcd\x62\x89\x1c\xbe\xb3\x58\x0d\xbe\xff\xb6\x16\x03\xab\xe5\x92\x40\x86\xea\x2c\x19\xea\x79\x9e\xb5\xcf\x12\x9f\xcb\x1f\x9e\xe0\xf9\x20\x19\x6b\xf7\x97\x53\x1a\x19\x80\x9a\x94\x45\x11\x45\x12\x43\x3b\x65\x22\x8e\x87\x59\x8c\x4d\x42\xb7\x9d\x9b\x81\x90\xa4\x51\xf9\x1d\x1c\xc7\x2c\x6f\x2e\x7f\x78\x32\x50\xe9\xc8\x8c\xfc\xbf\xc2\xad\x2f\xbd\xd2\xf1\x29\xf3\x6b\x57\x09\x17\x6a\x42\x49\x54\xa8\xd7\x1b\x95\x4d\x9e\xbe\x88\xe8\xd8\xa8\x9f\xff\x82\x45\x83\x8f\xfb\xaf\xc3\xbf\xb0\x40\xa6\x7f\xfd\x0c\x06\x2d\xf0\x07\x8d\x9f\x8a\xe8\xd6\x2b\xae\xe8\x22\xc7\xcc\x7f\xbe\xcc\x13\x64\x74\x8f\x18\x56\xf6\x67\xe7\x02\x52\x26\x2b\x0b\x51\xd5\x8c\x52\x97\x39\xa1\xc0\xa4\x09\x49\x0a\xb7\x8e\x85\xf9\x4f\x1f\xcd\x64\xfc\x6d\xbe\x92\x00\xe7\x92\x89\x93\x37\x7d\x4f\x12\x84\x9d\xe8\x15\x24\xd9\x35\xed\xd7\xf1\xb4\x0e\x98\x5d\xe6\x32\xc3\x10\xb7\x02\xdd\x5c\xea\x1c\xa0\xaa\xa9\x45\x43\x1e\xde\x7f\xda\x42\xcd\x25\x5f\xe2\x74\x3d\x1e\x1e\xb8\x8b\xd3\x08\x1d\xcb\xd3\x3a\x7c\x99\xe5\xe5\x97\xe1\x98\x49\xbb\x13\x79\x12\xe8\x65\x71\x9b\x53\xeb\x32\x8c\x12\x82\x37\xa3\xa0\x5c\x05\xcf\x3b\x6e\x57\xbd\xf0\x59\xa4\xb6\xd4\x37\x66\xa4\xae\xa0\xbc\x7a\x78\x4c\x56\x46\xd1\x5a\x59\x11\x88\xa7\x41\x1b\x95\x32\x95\xf1\x29\x28\x54\xe3\xc1\x12\xd6\x15\x5b\x34\x89\x59\x4d\xa0\x94\x2d\x0b\x33\x64\x55\x5a\x44\x97\x29\xd1\x35\x58\xbf\xe6\xaa\xb5\x1d\x84\x43\x35\xd3\x72\x17\xd4\x32\xa1\x85\xa3\xa3\x8a\x04\x5b\x12\x07\xa1\xdc\xa6\x66\x35\x88\x28\xa2\x9b\x9f\x7f\xc0\x31\x92\x1d\xa9\x97\x50\xc2\xe6\x73\x9f\x4b\x4a\x9e\x37\x70\x87\x88\xe2\xd8\xa8\xcc\x00\x3b\x59\x23\xee\x07\x9c\xee\xb4\xf4\xc5\x4b\x5c\x7e\x42\x66\xc4\x42\x67\x2b\x3d\x17\x76\x1b\x2d\x8c\x03\xd6\x5f\x4d\x88\xd1\xb5\x1a\x17\xf9\x8d\xc9\xbc\xe9\x55\xdd\x9e\xf2\x14\xc0\xd4\xd6\xea\x3e\x70\xc5\x66\xb9\x9a\xcc\x74\x76\x6d\xaa\xc6\x3e\x0b\x8d\x0c\x9b\xb5\xfa\x36\x58\xb4\x22\xd2\xbc\x07\xd8\x59\x3d\x2d\x0d\x0a\x8a\xd5\x4c\x5b\x35\x46\x4a\x0c\xf3\x10\x0d\xd9\x87\x12\x64\x81\xd5\x10\xba\xc6\x12\xa7\xf3\xd6\xcb\x38\xf6\x42\xc6\x67\x61\x4a\x0d\x98\x38\xd9\x5f\x6f\xdc\xc6\x68\x30\xc0\x8e\x19\xce\x46\x24\xdd\xa5\x96\xa5\xd8\x8b\x4a\x26\xf1\xdb\x30\xc9\x97\x69\xbd\xb9\x12\x16\x96\x78\x88\xd6\x52\xe6\xf8\xe3\x4d\x2f\xc1\xdd\x9c\x41\x42\xbc\x42\xf5\x69\x70\x29\xe5\x77\x40\x76\xc8\xe2\x2e\x64\x7c\xdc\x25\x61\x12\x95\xac\xe0\xe5\xa8\x5d\x22\xd2\xe4\x07\xb1\xcf\xc3\x7f\x60\x34\x76\xda\xa1\xd7\x55\x36\x1e\xf7\xa0\xf7\xf5\x4b\x3b\xad\x1b\xe9\x04\x50\xba\x68\x70\xd9\xa6\x85\xa9\x77\xdb\xe2\x8d\x5b\x65\xee\x60\xb9\x2d\x14\xd3\xf4\x5b\x8d\x3b\x4c\x5d\x9b\xd2\xfa\xb3\xe3\xef\x7d\x08\x7d\xd6\x1c\xe3\x46\x4a\x6a\xb7\xdf\x7e\x78\x10\xef\x33\x6c\xc5\x54\x1d\x13\xd9\x9c\xef\x77\x68\x37\xd2\x21\xc5\x1e\x51\x7c\xd0\x88\xd0\x5a\x64\x81\x45\x3c\xb4\xc1\x0b\x47\xe7\x15\x11\x7f\x6c\x2d\x57\x20\xdb\xe8\x74\x04\x37\x22\xca\x49\xb4\x8d\xe6\x60\xee\xf6\x98\xe9\x5b\x74\x3d\x92\x82\x6b\xfb\xaf\xf8\xd0\x47\x65\x8b\x0d\xd9\x16\xfb\xc2\x0d\x6d\x48\x43\x1b\x9e\x1e\xf5\xc4\x6f\x8f\xc9\x93\xef\x14\x46\xa9\x3e\x31\xcd\x46\xfc\x17\xe4\xf4\x55\x74\x38\xbb\xc9\x03\xb5\x92\x63\xce\xae\x8b\xcf\x25\xf5\x4e\xac\xb5\x32\x29\x04\xeb\x99\xfa\xb2\xa1\x96\xb2\xe9\xc3\xfe\xb6\x28\xc2\x5c\x9b\x7c\xec\x9d\x75\x23\xde\x66\xdf\x9e\xb6\x49\x3a\x26\x89\x48\x6d\x0c\x12\x82\xe6\x78\xa7\x0c\x88\xa3\xca\xb5\xd5\xc1\x8e\x84\xad\x56\x28\x5d\xb5\xbd\xa0\xa3\x56\xe4\xf3\x79\x64\x0a\x0b\xb6\x87\xfd\x79\x1b\x49\xd5\xe0\x03\xe5\x32\x11\x62\x32\xb4\x70\x1b\xba\xcb\x2e\x18\xcd\x99\xb9\x0b\x1a\xb9\x06\x1f\x08\x61\xb4\xea\x1f\x16\xff\xd6\x1b\x8d\x3b\xc7\x39\xea\xb2\xa6\xe7\xd9\xda\x6d\xcb\xd3\xc0\xf8\xc1\x47\x02\x47\x45\x14\xcd\xca\xa9\x01\x35\x3c\x1c\x02\x5a\x0f\x3d\xbf\xd3\x6b\xf4\x2e\x89\x4a\xb4\x54\x95\x47\x27\xac\x01\x23\x45\x55\x03\x7e\x65\xa6\x3d\x21\xb5\x2a\x9f\x3f\x22\xb3\xad\xdb\x75\xc2\xb5\x18\x94\x9f\x1b\xf7\xd4\x86\x3c\x39\xbf\xbc\x20\x22\xbe\x2f\xca\x35\xfd\x43\x6a\x30\xd6\xbf\x38\xed\x97\xb8\x45\x8d\x0d\x14\xab\x60\xe4\x24\x62\x58\xf6\xfa\xa7\x3e\xb6\x66\x2a\x76\x93\x4f\xa3\x09\x7f\x9b\xa2\xe5\x24\x87\xfe\x39\x05\x76\x02\x8f\x36\xaa\x1d\x68\x05\xe2\xd8\x1d\x9f\xe7\x70\x10\x64\x6b\xd6\x54\xcb\x59\x5a\x24\xc3\xa5\x06\x56\x3d\x6e\x0a\x3b\x08\xb3\x89\xa6\x5c\xef\x04\x11\xf9\x70\xc7\xbb\x4d\x0e\xce\x7b\x2f\x5f\xd1\x12\x33\x4f\xf9\x04\x91\xe8\xa4\x26\xff\xd9\xe4\xa6\x8f\xb5\x0a\x31\x6d\x28\x76\xae\xc2\xcb\x5a\x6f\x5b\xef\x97\xfb\x9d\xad\x82\x67\xbd\x98\x75\x18\x12\xff\x44\x3d\x10\x59\x03\x17\x6d\x80\x35\xd5\xaf\xa8\x7c\xee\x8d\x3d\x3d\xda\x48\x29\x75\x0a\x0f\x0c\x9c\x08\x8a\x8a\x73\x18\xca\xbc\x5e\x0e\x1f\x74\x70\xf2\x15\x61\xb8\x91\xea\x01\x7a\x08\x67\x79\x36\xe4\x2e\x49\x74\xf7\x82\xff\xd8\x3e\x88\xae\x39\x09\x4d\x6c\x74\xb6\xe6\x60\x12\x2b\x78\x98\x6f\xfd\x65\x51\x44\x83\x98\x55\xc7\x19\x6a\xd7\x21\x7a\x1d\xdd\x13\xd2\xbd\x1e\x68\xda\x55\x7a\xe8\x48\xbd\x9d\x99\x50\x28\xc5\x6d\x7a\xaa\x6b\x24\x8a\xa2\x57\xb3\x10\x9f\xcb\xae\x9d\x1e\x46\xae\x28\xbb\x9a\x4c\x8c\xf1\xce\xe6\x28\x9a\x5e\x32\x36\xd5\x2e\x5e\xe2\x85\x2e\x27\x33\x74\xdd\xc8\xa3\x8e\x06\x6c\x30\x5b\xea\xf9\xbc\x72\xd6\x32\x8b\xe5\xa4\xd9\x72\x64\x39\x8a\x62\x4d\x39\x1e\xd5\xdc\x76\xde\xe7\xbe\x9c\xa3\x12\x35\x76\xf2\xd3\x55\x46\x61\x62\x4d\xd8\xe5\xf9\x74\x43\xf1\x8a\xf0\x0c\xfa\x0f\xf9\xae\xac\xf3\xff\xa6\x53\x2a\x30\xe3\xce\x39\x5c\x78\xcf\xcc\x1a\xb7\xf1\xe5\xcc\xac\x9d\xb6\x00\xfd\xb2\xd2\x19\xb9\x2b\x39\x2e\x7e\x24\x30\xa2\xcc\x3a\xee\x6c\xa1\x8f\x95\x2e\x53\x07\xde\x3e\x68\x0c\xf7\xb8\x5a\x87\x28\x92\x58\x81\xea\xd1\x57\x66\x7a\x12\x7c\x72\x28\x20\x74\xee\xb8\x28\x8a\xd5\x54\x95\x5e\x95\x39\x62\xfd\x68\x90\x45\x1d\xad\xaa\x38\x7a\xdc\xe1\x84\x2a\x0f\xa1\x11\xca\xc5\x9c\xd2\x94\xda\x6a\x8b\xc0\x66\x26\xd2\xf7\x80\x3d\x2e\xef\x72\x95\x22\x65\xb7\x84\x17\x3f\x49\xa7\x7c\x5b\x5a\x71\x32\x42\xfd\xab\x5a\xca\xed\x95\x85\xd1\xfc\x76\x26\x2b\xdf\xdf\x49\x91\x84\x07\xf9\x31\x2d\x0d\x9b\x05\xc0\x84\xf4\x95\x38\x4e\xca\xa7\x2c\x34\xec\xa0\x39\x48\xac\x8a\x7b\x83\xce\xe2\x64\x34\xae\x91\x9a\x60\x1e\xc0\xed\x76\x67\x60\x6b\xd9\x83\x89\x67\x3b\xf2\x6b\x58\x5f\x97\xf4\x3a\xcb\x91\x35\x94\xa4\x96\xbd\xbc\x51\xa4\xb9\x9a\xf3\x38\x29\xf2\xe5\x92\x23\x4e\x8b\x93\xed\x75\xa1\x5c\xb2\xe2\xd6\xd8\x28\xea\x7a\x5e\xd5\x9d\x82\x25\xae\x11\xae\xd7\x25\xe5\x15\x70\x33\xfd\x34\xce\xd2\xd1\xb5\x89\xf2\x41\x4b\x6d\x70\xd1\x10\x28\xdb\xf1\xf9\x7c\x39\xd3\x71\x6e\x8d\x37\xd6\xcd\x3d\xc8\x6a\x56\xe4\xec\x7d\x96\x79\x14\xf5\xba\x15\xcf\xb1\x32\x9f\x71\xf0\xd9\xf4\xfd\x6c\xfa\x7e\x36\x7d\x3f\x9b\xbe\x9f\x4d\xdf\x4f\xc8\xf4\xf5\x2b\x1f\x25\x67\x1b\xfb\x79\xf4\xca\x93\xa8\x17\x48\xc5\x16\x1e\x6c\x8d\xe7\x13\x8b\x3c\x85\x71\x7d\x8c\x5b\x8c\xaa\x11\x22\x9f\x79\x48\x2f\xc8\x4e\x25\x3e\xbb\xcb\x77\xba\x94\xf4\x76\x2a\xff\xf9\x1d\xd5\x51\x1c\xb4\xaa\x62\xff\x59\xfc\x8e\x38\x87\x7f\x38\xe6\x53\xc4\x95\x45\xd1\x23\xad\xaa\xa9\x60\x90\xa2\x3b\x44\x75\x3a\x59\x2f\x8d\xd7\x09\x36\xaa\x6a\xda\x8e\x64\xa3\x8d\xeb\xa3\x85\xce\xf4\xb5\x19\x86\x61\x0c\x39\xd8\x6d\x0a\xfb\xe8\xa8\x93\xec\x8f\x14\x97\x5e\xfa\xa3\x9f\xc2\xe7\xf3\xf9\xf9\x7c\xc6\x9e\xcf\x57\xcc\x3b\xcd\x13\x1a\x3d\x46\xbe\x59\xf7\x9d\xd0\x68\x9a\x41\x9f\x46\x53\xf1\x30\x4e\x14\xf4\x2c\xd0\x40\x4d\x9e\x2c\x5a\xff\xd2\x3e\x1d\xc5\x14\x03\x04\xa9\xb9\x5e\x8f\x7a\xa7\x3a\x59\x15\x4d\x92\x52\x77\xa9\xb6\x4b\x99\x77\x1e\xe8\xbc\x6e\x07\x91\xb5\x71\x97\xda\xf8\x65\x45\x7a\x6b\x06\x2f\x91\x83\xe5\x21\x99\x33\xe4\x7a\x40\xf6\xea\xfd\xb7\x93\x77\x9d\x1e\x73\x2d\x14\xe2\x3a\x89\x34\x0e\xca\xb9\xcb\x3a\x54\xbf\xac\x4c\xb1\x56\x00\xa8\x66\x5d\x30\x8e\x5f\x50\x46\x90\x67\x36\x4d\xb8\x8a\x84\x7d\xf4\xa3\x8f\xa0\xc3\x91\x93\xfe\xd9\x3b\x38\x93\x50\x9e\x65\xcf\x1e\x22\x37\x36\x89\x55\x2d\x46\x23\xa9\x2a\x04\x15\xdc\xd2\xfa\x3d\xf2\xd6\x0c\x38\xde\x92\x77\xb5\xab\xe8\x20\x0a\xe4\x50\x3f\x7f\xf9\x34\x5e\x37\xe8\x90\xa2\x75\x2f\xdf\xec\x9a\x5c\x34\x65\xe5\x96\x39\xd0\xa2\xec\x45\x3e\x81\xd6\x3b\xfb\x3a\x50\x25\x38\xcf\x01\x27\xd4\x2a\xb0\x97\x0e\xe4\x5d\x36\x7d\xbc\x54\x54\x0d\x98\x54\x79\xb3\xf2\xc3\x30\x7b\x07\x48\xc9\x7b\xb7\x90\xa1\x4e\x31\x1d\x62\xd4\x4e\x14\x69\x30\xa1\x04\xbc\xda\x42\x2a\xc3\xef\x70\x39\x7a\x71\x12\xbf\xa4\x95\x0c\xed\xa8\x3a\xa9\xc0\x21\x07\x58\xdc\xc0\x6c\x95\xa9\xde\x89\x28\x33\xf1\x91\xe5\x9e\x6c\x79\x66\x67\xe9\x12\xb7\x3a\xce\x4b\x97\xa3\xc6\xb2\x89\x39\x57\xfd\x40\xc9\xb7\x7e\xb8\x72\xe8\x91\xed\xaf\x8b\x6c\xa0\x5e\xe6\x25\xbe\x3d\xa3\xaa\x08\x3a\x78\x4f\x73\x63\x5f\xe6\x25\xfd\xe4\x37\xd9\x54\x37\xd1\x03\x6c\x29\x87\x35\x20\xaa\xb2\xc8\xb4\xd3\xe6\x27\x9f\xf2\x6c\xbc\xf8\xf0\xd9\x7a\x7e\x17\x3a\xd2\x4d\xad\xba\xc8\x10\x60\xe6\x5d\x08\xc0\xcd\xf6\x41\xc3\xf5\xbe\xea\x5a\x64\xf0\x60\xe3\x65\x46\xc9\x8b\x06\x9f\x0c\x3a\xe9\xea\x8d\xe0\x13\x4d\x38\x0c\xdd\x0d\xbb\x23\x45\x8a\xb6\x3b\x7a\xe4\x0d\xa7\x58\xbb\x2f\x5d\xe8\x48\x13\xc5\x96\x85\x2e\xcd\x75\x3a\x51\x0b\x53\x5c\x53\x73\xfa\x49\x54\xeb\xc7\x07\xdf\xe7\x07\x39\x5d\x9e\x40\x37\x06\xeb\xe2\xdf\x8d\x45\xbb\xac\x7f\x0d\xbb\xb3\x6c\x47\xad\xfe\x21\x2b\x44\xca\xe8\x77\xb8\x9a\xa3\x77\xf7\x30\x2e\x90\x07\xf0\x46\x43\x72\xd6\x26\xe2\x34\xbd\x85\x5e\xc6\x35\x25\x75\x9f\x7f\x42\x9d\xa3\x03\xfe\x2f\x42\xe1\xb7\x23\x75\xae\x10\x65\x9d\x9b\xfa\xef\xa2\xe9\x72\x14\xae\x3e\x4c\x8c\x10\x49\x12\xbf\xac\xd2\x5b\x3d\xef\xa2\xd4\xe2\x8a\xce\x02\xf0\x6c\x3e\xdd\xb2\x2d\xe2\x35\x5a\x82\xf4\x27\x85\x2b\xa4\x52\xf5\x6f\xcc\xba\x3f\x78\x98\x34\x06\x99\x8b\xac\x3f\x08\xc9\x20\x0d\x19\xea\xf5\xf0\x68\xb2\x94\x5d\xd1\x27\x5a\xfd\x11\x83\x07\x37\xcd\x97\x68\x92\x9d\xcc\x9d\x8e\x27\xb7\xd3\x63\x1c\xd8\x78\x82\x2e\x24\xb1\x2d\x4f\xef\x87\x51\x63\xa4\x51\xa2\x1a\x64\x66\x04\x65\xe5\x2b\x68\x27\x48\x13\xe9\xdc\x22\x6d\x47\xb4\x86\xda\xad\xc4\x15\x08\x75\x12\x2a\xce\x27\x21\x45\x14\xda\xb9\xa2\x15\x89\xd0\x16\xe8\xce\x37\xcf\x8b\xa0\xa9\xb0\x29\x55\x5e\xa7\xdf\x8d\x8d\x15\xfe\x41\x0c\x8f\xe9\x3f\xf9\xb4\x8e\xe3\x89\xa6\x16\x0b\x58\x50\x68\xed\x63\x32\xca\x40\x48\xb3\xc9\x7c\x95\x44\x6e\x7c\x8a\x82\x5f\x34\x5d\x81\xdf\x6e\xf4\x71\xb6\xe9\x41\x8c\xff\x43\x20\xe1\x8d\x53\x9f\xef\x19\x82\xcf\x11\xa4\xab\xc2\xe2\xc0\xbb\xdc\xce\x63\xcc\x1d\x89\x28\x3f\x67\x12\x57\xb9\xd9\x69\x75\x22\xe5\x89\x67\x2c\xc9\x42\x0e\xc9\x2b\xdb\x3b\xe8\x18\xc4\x7f\x3a\x6d\x2d\xab\x6d\xec\xef\xf3\x27\x35\x93\x58\x69\xf5\x3c\x1d\x17\x46\x3d\x99\xe9\x2c\x33\xf3\xe0\x55\xef\x89\x8a\x9d\xa1\x4b\x94\xa5\x9e\xcc\xa8\xfc\x0e\x96\xf2\x6a\x8c\x1e\x1e\x47\x56\xcd\x72\x5b\xaa\x85\x9e\xcc\x00\x11\xcb\xf7\x5a\x7b\xe6\xa1\x6f\x2d\xcc\x4c\x83\x96\x1e\xbd\xc3\x39\x72\x3a\xc2\xfb\x1e\xdd\x8f\xef\xcb\xcd\x33\x05\x14\xe1\x47\xa8\xa3\xe3\x82\x0e\x97\xc6\x55\xc2\x8b\x16\xcd\xa9\x11\x52\xbe\x76\xf4\x1a\x48\xc3\x5b\x28\xc2\x0b\x1f\x3c\x10\x51\x4c\xb3\xa9\x29\x00\xb6\xec\x92\xda\x1c\x51\xe4\xba\xae\xb2\x10\x7b\x18\x31\x4a\xe7\x2c\xbf\x13\xd1\x4c\x72\x75\x67\xd4\xb2\x30\xb7\x26\x2b\x5d\x92\xa0\xf5\xb9\x57\xb5\x55\xa1\x94\x7a\x84\x60\x8b\x7c\x91\x5a\xe9\x12\x60\xe5\x98\xd3\x5a\xef\xc1\xff\x62\xef\x7b\x9b\xdb\xc6\x91\xbc\xdf\xeb\x53\xa0\x32\x4f\x95\xec\x5d\x4b\x4e\x76\xe7\x99\xdd\x73\x5d\xed\x94\x63\x3b\x59\xdf\x26\x19\x6d\xec\xcc\xd4\xd5\xec\xde\x16\x44\xc2\x16\xce\x14\xc1\x21\x48\x39\xaa\x9a\x0f\x7f\xd5\x40\x83\x04\x65\x49\x6c\x50\xb4\xe3\xec\x28\x9a\xaa\x49\x6c\xb1\x09\x34\x1a\x8d\x46\xff\xf9\x75\xa8\xea\x48\xca\x34\x5c\x5e\xea\x76\x27\x6f\xce\x58\xc1\xf3\x5b\x51\xb0\xa4\x4c\x59\x5a\xce\xa7\x22\xff\xf2\x2d\xbf\x3a\x37\x20\xf5\x1a\xb9\xec\xde\x33\x94\xb1\x8f\x38\x0e\x0b\xb3\x69\xb2\xb4\x6f\x54\x8e\xe0\x1e\xd5\x2f\xb1\x2f\x1c\x89\xa2\x4c\xd9\x8f\x68\x66\x94\xe0\xe4\x1f\xf6\xde\xbb\xd4\x2e\xe7\x4f\x3f\x7d\xd0\xbb\x70\xaf\x96\x8b\x7b\x95\x27\xf1\xbd\x8c\x6d\x75\x14\xcd\xc8\x3f\x80\xd7\x1f\x0e\x07\xbd\x7a\x26\x02\x4f\xd4\x90\x3b\xf5\xfd\xbd\x8c\x77\x65\x17\x5a\x7c\x86\x5d\xcc\xf0\x4b\xc6\x00\xde\x70\x23\x45\x4e\xe4\x9a\x19\xc6\x21\xbb\x90\x10\x36\x65\xe6\x5f\xe0\xac\x8b\xd4\x7c\x2a\xd3\x1a\xae\xad\x5a\x60\x12\x55\x38\xda\x60\x6f\x3b\x4f\x97\x86\xa6\xd2\x80\x20\x03\x16\xa3\xa9\x67\xd0\x72\x5e\x26\x05\x4f\x85\x2a\x75\xb2\x1c\x7f\x2d\xcb\x46\xb7\x3f\x12\xf1\xd9\x6e\xba\x93\x41\xc0\xf2\xbe\xa9\x1e\x6b\xda\x23\x26\x9d\xbc\x82\x7d\x1c\x50\xd3\xe8\x2a\x83\xa4\x2e\xd4\x89\x8f\x2b\xe3\xa4\x42\x71\x12\x9f\x09\x76\xda\x94\x83\x0d\x92\x25\xe5\xad\x4c\xfb\xb4\x3f\x2c\xd0\x60\xf0\x46\x38\xaf\xf0\x09\xfd\x4c\x52\xf8\x3b\xc2\x06\x12\x91\x0f\x00\x3a\xb0\x82\x7c\xb6\xfb\x69\x3c\xe8\x59\xc2\x3a\x9a\x58\xad\x16\x16\xe3\x04\x92\xec\x4b\x59\x58\x1e\x40\x73\xe8\x30\x63\x91\x89\x34\x06\x30\x59\x7f\x47\x58\xd6\xf4\xbe\x3a\xd8\x20\xeb\x64\xf0\x98\x0e\xcc\xa0\x11\x6d\xd6\xf9\x17\x9f\x8b\x1c\x92\x6a\xe6\x73\x50\xb1\x38\x72\x6a\x65\x09\x4f\x69\x8a\xf6\x69\xfa\xb6\xff\xc6\xcd\x26\x30\x9b\xb4\x88\x72\x51\x10\x8b\x8e\x37\x31\xef\xca\x11\x01\xcf\x44\xe5\x8d\xa0\x02\xbf\x00\x77\xec\x30\x70\xc1\x5d\xc6\x16\x1c\x0d\x5a\xa4\x5a\x9a\x8e\x5b\x1e\xf4\x2a\x8d\xac\x32\x5d\xdc\xab\x2b\xab\x39\x35\x98\x9d\x80\xc6\x52\x51\x2c\x21\xa6\x97\x09\xcb\x1b\x96\xaa\x95\xd1\xfa\x75\xbb\x55\x04\xd0\x7e\x85\x44\xb3\x39\x69\xcd\xe6\x90\xaf\x65\x30\x7a\xa1\x88\xd1\x12\x3a\x82\x5a\x39\xfc\x3b\xcd\xaa\x7a\xd8\xc5\x7e\x85\x03\x14\x59\x0a\x39\x44\x43\x4b\x33\x36\x7b\x75\x51\x82\x0a\xdf\x1f\x4b\x24\xca\x48\x7e\x5b\xc8\xe6\x5a\x48\x71\x7f\x7c\xaf\x72\x40\xdf\x1e\x41\x51\xfb\x08\x0b\xff\x8e\x61\x12\xfa\xf8\x1b\xba\xd9\x0f\x1f\x7b\xed\x3e\x8d\x63\x2c\x35\x42\x74\x42\x13\x8e\xd0\x63\xaf\x39\x2d\x3d\xb8\x01\xd5\x1a\x47\xac\x94\xf1\xf7\x94\xa5\xea\xa0\xe1\x03\x94\x2c\xd5\xe9\x36\x42\xd3\x67\xd0\xd3\x9b\x6f\x0c\x04\x67\xab\x69\xd6\x90\xa5\x37\xf6\x99\x15\x6f\x1a\xfe\x10\xaf\x2b\xce\x00\x6d\xa1\xcb\xb6\x79\xcf\x50\x87\x78\x86\x02\x08\x2f\xbe\xbd\x95\x30\x22\x37\x31\x0d\xa2\x58\xd7\xe6\x95\x69\xda\xbe\x78\x41\xb6\x2d\x2f\xb8\x16\x05\xd5\xf5\xdc\xe0\xa4\xbf\x29\x01\xf2\x01\xea\xb4\x21\x90\x03\xe0\x86\xba\x82\x77\x24\x50\x65\x6c\xf4\x17\x34\x90\xd3\x06\x35\x30\x7b\xdd\x7a\x61\x8d\xcd\x54\x54\x19\x93\x44\x97\x3e\x07\x14\x89\x2c\x17\x11\x11\x4f\x35\x68\x9b\xe0\x48\x3f\x7d\xba\x3c\x0f\xe6\x1f\x3c\xb4\xc2\x3f\x14\x1a\xd3\xe7\x43\xfe\x42\x0c\x85\xd4\x37\x6a\xa0\x56\x0b\x33\xd2\xec\x77\xca\xe4\xbd\x79\x1b\x89\x3a\x78\x70\x2e\xf5\xdd\xc9\x20\x80\x37\xc3\xb7\x67\x17\xcd\xc7\x9b\x1b\xf6\xed\xd9\x05\x83\x9f\x86\x5f\x34\xd7\x7b\xbe\x57\x36\x6f\x2b\x59\xe7\x1a\x5f\xe7\xfa\xee\x1a\x27\x74\xc1\xc1\xdb\x48\xd4\x21\xc3\x58\xea\xbb\x61\x8f\x1b\xbe\x2f\x67\x3a\xca\x2d\x2a\x4c\x32\xf6\xf3\x52\x95\xec\x1e\x61\x8a\xf1\xaa\x78\x2d\xb3\x13\x76\x91\x6a\xa8\xca\xae\x12\x22\xeb\xab\x16\x89\x2c\x48\x25\x2c\x6e\xcb\xc5\xd1\x5d\x0a\x49\x34\x2f\x2c\x52\xb6\x3e\xd9\x74\x7d\xac\x1d\xf4\x95\xf3\x9d\x36\xd8\x6d\x0e\xfa\xbe\x65\x87\x34\xa2\x2a\x2a\x40\xf4\xf8\x93\x88\x3e\x88\x0a\x3c\xaa\xc7\x1f\xca\xb6\x65\x41\x6c\xef\xdf\x14\x6d\x70\x03\x54\x8f\xbb\xc9\x7a\x82\xcd\x96\xaa\x24\x10\x65\xab\x82\x7d\x79\xe3\x4a\x53\x8e\x58\xd1\xec\x05\xe5\xbe\x44\x22\x3b\xad\x00\x91\xe0\x8c\x1c\x7b\x82\x09\xf5\xeb\xf8\xab\xe3\x58\x2c\x8e\x75\xcc\x5f\xd1\x2c\x48\xd8\x87\x0e\x4a\xb3\x68\xcc\x9f\x6b\xf6\xe2\xd5\x8b\x31\xbb\x92\x73\x99\xf0\x3c\x59\xd2\xb3\x0a\x71\x28\x35\x2d\x38\xbf\xdd\xc0\x60\x7b\xbe\x78\xf9\x82\x1d\xa8\x1c\x18\x0a\x5d\x31\x48\x44\x13\xe1\xb0\x6b\x50\xcd\x2d\x2d\x04\xcb\xe1\xd3\xeb\xd9\xc7\x0f\xf1\x64\x71\x27\x4b\x6c\xf8\xc9\x58\x0b\x0d\x27\xe3\xe4\xbc\x3e\xfb\x88\x7d\xcb\xde\x9e\x5d\x8c\xd9\x27\x3c\xcd\xd0\xaa\xb0\xe2\x01\xdc\x81\x9d\x61\xbe\x51\xb3\x9d\x44\xf5\xc9\x96\x26\x48\x5f\x74\xf7\x0a\x91\xfc\x38\x04\xaa\xcc\xf9\x7a\x1e\xf8\x71\x1e\xba\x9b\x02\xaa\xca\x9f\x78\x3f\x84\xf8\x93\xe8\xd7\x44\xbb\x0f\x7a\x33\x45\x65\xf1\x51\x64\xad\xe2\xda\x5c\xe6\xb7\xf6\xa1\xa6\xd9\x79\x2b\x0b\xf8\x81\xd2\xb2\x50\xf9\x92\x11\x8c\x1e\x6e\xd5\x61\x54\x26\x3c\x67\xb9\xb0\xbd\xb0\xc6\xec\xfc\x62\xf2\xf1\xe2\xec\xf4\xfa\xe2\xfc\x84\xb9\x37\x49\xff\xa2\xd2\x6e\xa3\x5c\xfb\x2d\x64\xbc\x3a\x46\xdb\x97\xa3\x1e\xeb\x91\x3d\x6a\x28\x9d\x42\xab\xfe\x8c\x00\x9e\x0e\x69\x98\x97\xa9\x2c\xea\x16\xe2\xc6\x3a\x8b\xa0\xcf\x8d\x46\x4f\x4c\xd6\xee\xc3\xb3\x31\x9c\x5b\x59\x98\x73\x24\xc5\xc1\xc0\xe3\xcd\xb7\xc1\x4f\x26\xa6\xe3\x6c\x2b\xc9\x6a\xaa\xe3\x3e\x2d\xe3\xaa\x83\x6b\xb0\x4a\xb8\x36\xb1\xc7\xba\xcf\xae\xd1\xc3\x18\x03\x81\x78\x22\x8e\x97\x40\x96\x41\x64\xd3\xb4\xcb\xb3\xab\x38\x1c\x8f\x87\x63\x83\x4a\x3c\x1c\x0f\x9d\x81\x9b\x54\x68\x3a\xc4\x60\x5b\x63\x76\x15\xac\x1a\x10\x68\xca\xf3\x98\xb1\x1f\x5c\x6d\x2a\xcd\x8c\x90\x37\xb5\x73\xd3\x1f\x12\x82\x3d\x63\x83\x06\x7c\x13\x89\xa2\xb7\xbb\xf0\x49\x5d\x4e\xfd\xc1\x63\xdb\xa0\x5b\xb9\x20\x42\xa2\x99\xc5\xe8\xff\xf8\x70\xc3\x0c\x96\x96\x8f\xf5\x0c\x3f\x7d\x7c\xd7\xff\xc0\xac\x8a\x09\x1e\xd6\x99\x9a\x43\x8b\xd1\x19\xd7\x33\x0c\x37\xfa\xc8\x8e\x95\xe6\xea\x77\xbc\xf4\x13\xa1\x66\x78\x6f\xa7\x82\x6d\xe8\x72\xd3\xaa\x1a\x1a\x6c\x1a\xbe\x75\x8f\xad\x38\x24\xaa\x1f\xd3\x0c\x7b\xf4\x07\x9a\xd4\x31\x72\xdb\xed\x01\xd5\xd6\xc2\x2e\x4f\xda\x81\xd7\xe3\x11\x7f\x7c\xeb\x46\x79\xfc\xf1\xe2\xf4\xfc\xfd\xc5\x78\x1e\xf7\xa9\x42\x45\x1a\x67\x4a\xa6\x85\x0e\x96\xbe\xe1\x85\x7b\xd4\x4f\x61\x75\xf4\xe8\x48\x43\xd8\xbe\xb4\xe0\x32\xd1\xde\x9a\x14\x2a\x53\x89\xba\x5d\xfa\xcc\xec\xc2\xac\x6f\x2c\x7e\xfd\x88\x8f\x60\x95\xfa\xdd\x0b\x06\x84\x62\x16\xce\xb9\x09\x2f\x66\x8e\x61\xf5\x8c\xab\xbb\x58\x41\xac\x8e\x7a\xce\x8c\x79\x24\x63\xbd\xe2\x56\xc8\xed\xd6\xa6\x57\x9a\x4d\xee\x7a\x63\xd5\xcd\x9d\x32\x91\xcf\xa5\x2d\x81\xa7\x71\x7d\x83\xa5\xff\xe4\x0b\xd1\xb7\x01\x5f\x69\x82\xd6\x6f\x82\xd0\xf7\xa5\xd2\x41\x9b\x4e\x08\x9b\xa8\x29\x23\x7f\xc5\xa7\x9a\x0a\x1d\x9a\xb0\x55\x2d\x3b\x6e\x28\x68\xa9\x2a\xf7\xac\x2c\x5f\xbf\xa3\xcf\xab\x72\x41\x5b\x73\x26\x59\x86\xe6\x55\xd7\x76\x6f\x15\x26\x30\x69\x57\x06\xd5\x96\xdc\x2f\x0f\xbd\xb8\xfc\xd6\x94\x13\xa9\x1c\x43\x92\x59\x2e\x17\x32\x11\xd0\x3a\xa9\x98\xc9\xf4\x16\x1b\xc4\x52\xca\x8d\x10\x6b\x09\x86\xe9\xda\xa9\xfa\xf3\x06\xa5\xab\x8b\x7a\xf4\x9a\xd6\xd9\xf1\xc3\x0f\xd7\xa6\xe7\xb1\xe9\x99\xb1\x76\x5b\x84\x5c\x6e\x61\x40\x04\x51\x63\x6c\x34\x1a\x19\x7f\xe8\xc1\xff\xc2\x3d\x27\x4e\x0e\xd9\x4f\x02\xc7\xa1\xc0\xa3\x52\xe4\x32\x2a\xd8\xfd\x4c\xb9\xbe\xae\xad\x14\xe1\xd5\x9e\x64\x18\xd5\x01\xc5\xd0\xb1\xa3\x72\x0c\x94\xe0\xa2\x60\x7e\x65\x64\xa6\x95\xa8\xa3\x07\x68\xee\x5c\xb3\x3a\x1b\xa5\xd7\x3b\xd1\x0e\x27\x12\xba\xa1\xd6\xee\x08\x9a\x6e\xc4\x5c\x89\x0c\xcf\x37\xce\xf4\x72\x9e\xc8\xf4\xee\xa8\xea\x26\x73\xa3\x40\xf2\xcc\xb7\xe0\x17\x24\xaa\xb8\x97\x72\x01\x6d\x8a\x79\x31\xeb\x4d\xb2\x7a\x3f\xf5\x8a\x4e\x81\x9a\x6b\x08\x85\x80\x01\x5f\xa9\x35\x4c\x4e\x73\x47\x0d\x81\xa2\x09\x6c\xbf\x78\xf1\x4c\x39\x43\x3f\x85\xfa\x3c\x5b\xa4\x8e\xb4\x3c\x19\x84\x2c\xc5\xe5\x15\xf4\xa9\xf6\x4f\x95\x94\xd9\x9f\x61\x40\xb3\x97\xa2\x9d\x95\xd0\x25\x28\x16\x02\x45\x7a\xe8\x72\x93\xf1\x61\x18\x52\x1b\x1e\x7d\xaa\x9d\x68\xc6\xb3\xd3\xb2\x98\x9d\x4b\x1d\x41\x36\x4e\xb8\xe1\x77\x3f\xb3\x6d\x86\x31\x20\xc8\xa4\x63\xbb\xa5\xc7\xce\xfe\x7a\x3a\x21\xd0\x64\x00\x1d\x3f\x03\x47\x78\x44\x4d\x2d\x0b\xb1\xa7\xea\x99\x5e\xd9\xe2\xe6\x9e\xe6\x89\xd4\x9e\xcd\x2c\xf7\x41\xe7\x7f\xeb\xa0\xb3\xd1\x04\xbf\x95\x40\xb3\x4c\x65\x21\x01\x2f\xa0\x53\xa4\xee\xac\xd4\x85\x9a\xe3\x36\xbd\x74\xa4\x0c\xe6\xb4\x09\x18\x37\xa8\x13\x88\xb3\x46\x7e\xa9\xbd\x8e\x9a\xe5\xb8\x4c\xe1\x66\x0b\xdd\x4d\x9a\x85\x2c\x34\xbf\x2e\x74\xe7\xb3\x63\x94\x15\x9d\xff\xc4\xd2\x27\xd0\x33\x3c\xf9\xcb\x49\xa3\xa1\x3a\x89\xaa\xf3\x3c\x57\xbd\x55\xd1\xcd\x18\xa9\x34\x15\x11\xb5\x8d\x6d\xd8\x6a\xfd\x92\x76\x75\xe7\xdb\xf9\xff\xbd\xe4\x89\x75\x81\x7e\x78\x0c\x3f\x72\x73\xad\x82\x87\xea\xe4\xc8\xad\xd1\x07\xd7\x88\x1d\x2e\x26\x70\xc7\xb0\x92\x46\x20\xcb\x58\x91\xf3\x54\xc3\xe2\x8e\x1b\x5e\x89\x21\xa6\x2d\x0c\xd9\x41\x11\x65\x87\xbd\x73\xa0\x4b\xa9\xa6\x99\x94\x5b\xa7\x77\x55\x89\xe6\xf8\xcb\xc7\xef\xcd\xde\xd0\xbb\x4d\x68\x62\x88\xb0\x77\x80\xfc\x65\xf0\x3f\x60\x51\x38\xad\xc5\x3c\x60\xaf\xd8\x42\x39\x88\xde\x4d\x20\x9c\x24\xb3\x7f\xf1\x38\xce\x4f\x80\x0a\xb6\x7a\x62\xf6\xef\xb4\xab\x80\x6b\x8e\xc0\xd3\x2a\x83\xe5\xa0\x58\x66\xd8\x4c\xe7\xfa\x6c\x62\xa8\x69\xf6\xe7\xef\x5e\x92\x6c\x4f\xf8\xef\x8f\x7f\xf8\xee\xe5\xe1\xf8\x2b\x29\xa9\xdb\xc1\xff\xb8\xd5\xfd\xe8\x7e\xd9\x77\xae\x40\xef\xe6\x5b\xf7\x2a\x10\x30\x40\xb1\xfe\x03\x26\x8e\x72\x8e\x07\x09\x78\x40\xaa\xf3\xee\x71\xcc\xd4\x7d\x85\xc2\xbe\x42\xc1\x54\x28\xb8\x0a\x70\xab\x5b\x4f\x06\x81\xcb\xbb\x46\x3f\x5b\xd5\x8c\xba\x9a\xa8\x4a\x03\x54\xb3\xf5\xca\x92\xa8\x92\x55\x33\x5d\xed\x3e\x8a\x37\x46\xfe\xd2\xb6\x65\x47\x80\xb7\xd0\xfa\x1d\x7f\x25\x07\x3d\x49\x08\x65\xe3\x37\x37\xbc\xd5\xc2\xc3\xa1\xf6\xb3\x3d\xa0\xe1\x24\x3b\xff\x70\xf5\xaf\x77\xa7\xaf\x2f\xde\x91\x8e\xc3\xd2\xa6\xee\xc1\x0e\x96\xe9\x56\x47\x4c\x3f\x1a\x61\x38\xe8\x69\xe9\xd3\xd0\xc8\xf9\x87\x37\x57\x2b\xce\x30\xf8\x09\x22\xc4\xd7\xce\x61\x9a\x3b\x6c\x53\xb8\x7c\xd7\xbb\x6c\x7a\xa3\xfb\xf4\x64\xed\xe0\x40\x77\x5e\x3f\xf1\xb9\xe9\x5d\x00\xa6\x41\x59\x90\xc8\x3b\x07\x76\x7b\x66\xc8\x73\x89\xe7\x02\x67\x2c\xb7\x02\x5c\x22\x84\x48\x6e\x65\x63\x51\xc9\x6e\x8e\xe4\x3e\x1a\xe7\xe9\xb6\x5c\xde\x05\xcc\x60\x78\x65\x24\xce\xe5\x19\xc0\x3e\x05\xa5\x02\x87\xd8\xe5\x04\x3a\xfb\x00\xf2\x3b\x81\x28\x73\x36\x92\x27\xc5\xcf\x4f\x42\x7b\x8d\x30\x8c\x70\x9e\x7d\x1d\x55\xd9\xba\x1e\x96\x27\x83\x90\xc5\x9c\xac\x23\x61\xff\x8a\xad\x2c\x6b\x3d\xdd\x42\x98\x31\xee\xec\xdd\xc8\x24\x47\xf0\xf5\x4d\x36\x9d\x6f\x4f\x53\xfc\x5a\x20\x5a\x3a\xe3\x51\xf7\x3d\xb4\x06\x73\xb1\xfe\x91\x15\x1c\x03\x5e\xd7\xab\xb2\x37\x14\xbb\x25\xf0\x9f\xb9\x47\x57\xf1\x42\x36\xb0\x73\x10\x80\xcf\x0a\x2c\xaf\x59\x0a\xb5\x99\x68\x65\x60\x6e\x6e\x20\xb0\x08\xfb\xe2\x6b\xf2\x74\xe7\xcd\x4f\xeb\x6f\xec\xde\x6d\x9c\x40\x91\xad\xbf\xb1\x3f\xce\x6d\x9d\xae\xba\x2a\x69\xed\x4d\x31\xcd\x54\xa1\xd2\x1d\x8a\x3e\x27\x6b\x08\x34\x2c\x46\xfc\x06\x64\xa4\xe7\x2a\x49\x08\xf7\xa3\x5a\xc0\x6c\x0d\x4b\x15\x42\x85\xeb\x90\x3b\xfa\x55\xea\x82\xa9\x9a\x66\x81\x62\x6c\xa1\x47\xd5\xd1\x31\x42\xb6\x1a\x20\xab\x03\x5c\xd5\x75\x84\x40\xf2\x8b\x01\xf0\xd4\xc1\x2c\x12\x45\x17\xf0\xda\x1a\xcc\xea\x5b\x77\x64\x71\x87\xd2\xee\xcb\x73\x0c\xda\xbb\xd2\x6c\x8d\xa2\xcb\x02\x64\x77\x8d\xfc\xf6\x3b\xb9\x00\x33\x27\xbe\x3c\xef\x4d\x4d\xa8\xbc\xb8\x57\x39\x62\x26\x9d\x0c\x02\xd8\x3a\x69\x3c\xda\x54\x0d\x19\xfe\x0e\x4f\xb2\x16\xb2\x8c\xa6\x09\x5c\x14\xf1\x19\xec\xf4\x2b\x50\x10\xfe\x94\x9b\x91\x52\x3a\x1e\xb3\xd3\x11\xb5\x8a\xd8\xba\xfd\x69\x34\x37\x46\xbd\x1f\x6e\x7f\x9a\x8d\xb1\x26\xce\xfd\xa4\xdb\xbe\xb3\xc9\xf0\xc0\x1f\xdf\x84\x8a\x0a\xab\x10\xdc\x16\x36\xd8\x18\x12\xe8\xd9\xa2\x70\x99\xcf\x1d\xd4\xe0\x8f\xf8\x20\xa2\x59\x24\x4b\x5f\x21\xf2\x6a\x3b\x07\xa4\x5f\xf7\xbb\xcc\x74\x05\xe8\x58\xd0\x9b\x12\xcc\x15\xb8\xe6\xda\x5f\xdd\xe0\xe7\x25\x44\xc3\x4c\x20\x05\xf0\x9e\xa4\x85\x80\x72\x69\x64\xda\x01\x40\xb5\x7b\xe8\x6d\x13\xbf\x39\xcf\xb4\x6d\x5e\x10\xab\xfb\xf4\x9e\xe7\x31\xf4\x3b\xef\x51\xd5\xa1\x4f\x9a\x0a\x3f\xdf\x98\x2a\x3c\xc4\xa6\xd0\x2b\xb6\xc4\x1c\x35\x00\x84\xf1\xbc\x32\x8c\x14\x00\x62\x75\xb2\x01\xe8\x38\xf0\xa1\xe1\xa8\x3c\x13\x29\x65\x2a\x82\x88\xeb\x82\x8c\x3e\x3f\x15\xc5\xbd\x10\x29\x7b\xf9\xf2\xa5\xf5\xac\xbf\xfc\xd3\x9f\xfe\x04\xce\x10\xce\x62\x11\xc9\xb9\x23\xe6\xbe\x48\x22\x6a\x29\xfd\xff\x57\xaf\xc6\xec\xbf\x4f\xdf\xbf\x63\x3c\x32\x77\x5a\x0b\x47\x6a\x47\x08\x5f\xc0\x17\x90\x48\x62\x0f\x38\xf6\x5f\x57\x3f\x7c\x70\xd2\xae\x9b\x43\xb4\xf2\x34\x57\x31\x71\xe6\xd2\x04\x1d\x31\xab\x17\xf6\xb1\xef\xb9\x86\x54\x5d\x80\x16\x83\x64\x66\x7e\x73\x63\x24\x9c\x46\x16\x7c\x9b\x90\xca\x62\x75\x1a\x26\xb8\xcf\xe5\xed\xcc\xf5\xfb\x07\x99\x4d\x4c\xea\xb5\x2c\x66\x34\x4f\x13\x44\x50\x2a\x08\x42\x63\x8f\xd9\x31\x55\x47\xa7\x99\xf6\x11\x4b\xe4\x1d\x6d\xf2\x37\xfa\x6d\xae\xca\xac\xee\xf8\x91\x0b\x0d\x88\x0e\x90\xbf\x3d\x15\xf8\xc2\xb9\x93\x5c\x12\x49\x00\xfd\xf9\xe2\x69\x0b\xa8\x40\x82\x37\x29\x34\x78\xf4\xfa\x3a\xa0\x52\x03\x76\xf7\x1b\xda\x6f\xbc\x74\x52\xbd\xc5\xda\xd8\x88\xd2\x87\x2f\x27\xca\x1b\x14\x2d\xa8\xf4\xd6\x26\x4c\x29\x3f\x85\x52\xc4\x6e\x3a\x60\x2f\x50\x26\x12\xa6\x14\x7d\x0d\xfc\x9e\x67\xd4\x07\x56\x98\xe0\xc1\x1d\x32\x3e\x55\x65\xe1\x12\xa9\x2c\x55\x32\x51\x8b\x00\x06\xea\x15\xf9\x47\x7e\x32\x7c\xce\x81\x8b\xbe\x76\xde\x97\x0d\xab\xef\x88\x09\x1e\xcd\xa0\xb3\xcf\x88\xae\xbb\xeb\x3f\xd0\x0b\xc9\x39\xc9\xce\x79\x01\x48\xff\xd0\x1e\xa8\x19\xc5\x8f\x44\x1c\x48\xf6\xcc\xad\x42\x55\x6a\x5d\xc9\x66\x55\xea\x1e\x48\x12\x25\x92\x83\xd5\x04\xc6\x3a\xb6\x34\x4a\x3d\x87\x61\x78\x5f\x2f\x50\x62\x50\x94\x03\x48\x3f\x48\xc4\x70\xd1\x24\x22\xd6\x3c\x0e\xa4\x0a\x63\x01\xbd\x20\x62\x58\x17\xbd\x81\x07\x1d\x88\x56\x23\x32\xc7\x0c\x5a\x2e\x65\xea\xbd\x2b\x90\xa8\x19\x19\x9c\x54\x66\x85\x8c\xb7\xdb\x4c\xdd\x74\x8e\x6c\xe4\x55\x86\x12\x9e\xc9\x08\xd2\x30\x4d\xfb\x19\xa4\xec\xe4\xac\x12\x8e\x2e\x7c\x45\x31\xd0\xa2\x28\x51\xb8\x0c\x42\x13\x2b\xd3\x44\x68\xcd\x88\x25\xf6\xf5\x07\x8e\x58\x9e\xdf\x09\x07\xd4\xcb\x93\x31\x83\xd8\xa3\xae\x70\xd1\x4d\xbb\x57\xb9\x08\xdd\x59\x20\x5a\xa0\x97\x3d\xf4\x03\xb3\x86\x80\x67\x60\x96\x8f\x11\x33\x7e\xea\xcf\x2a\x2a\x42\xd0\xe3\x1d\xd4\xce\x8a\xe2\x79\xcf\x33\xd8\x7c\xd8\x80\x12\x04\x04\x62\x1d\x81\x14\x41\xe5\x14\x33\x67\x31\x71\x5c\xcf\xb0\xa9\x74\xd5\xbc\x3b\xb5\xc0\x6d\xb0\xe2\x1a\x1b\xf9\xd6\x87\x46\xe8\x0c\x02\xaf\x69\x0f\x3f\xf3\xc0\x8e\x56\x6b\xe7\xe1\x41\x14\x87\xd9\x6c\xab\x7f\x36\xdf\x50\x8c\x51\xdb\x89\x26\x68\xf9\x4d\x57\x94\xa0\x5b\xc5\xea\xa7\xed\xca\xd2\x89\x28\x0e\x68\xd3\x15\xa6\x1b\xcd\xb5\xd7\x1e\xbc\xb4\x84\x6a\x4f\xfb\x69\xbb\x06\x75\x22\x5a\xc9\x8e\x39\x3c\x40\xe3\x55\xe7\x46\xf7\x86\xac\xa8\xe9\xbd\x1b\x74\x75\x90\x82\xb4\x8d\x07\x9d\x3b\xb2\xee\x76\xa7\x0a\xba\x63\x75\xa2\xb8\x72\x2f\x7b\x70\xe7\xea\x44\x74\xf7\x7b\xda\xea\x1f\xb8\xb7\x0d\x07\x81\x0f\x75\xb8\xc8\xed\x76\xb1\xeb\x9a\x8e\xb4\x55\x6f\x5e\xcf\x6a\xbb\x00\x0f\xf3\xf0\x9e\xa4\xce\xa4\x31\xcb\x0d\x2e\x69\x9e\x39\x33\x96\x15\x6a\xcc\xde\x13\xd2\xb2\xd7\x7d\xd0\x9e\xe3\x29\xe3\x53\xad\x92\xb2\x40\x0c\x0c\x20\x08\xf6\x58\x27\xa2\xbe\x09\x03\xd4\xaa\xf6\xa4\xe1\x96\x88\xfb\x83\xc3\xf1\xcd\x1a\xa0\xde\xf1\x3c\x84\xff\xba\x8e\x65\x87\x83\x98\xea\x3f\xed\xa3\x0b\x31\x29\xd7\xa6\xb3\x3f\x76\xf5\x13\x52\x1d\x10\x96\x45\xba\x65\x63\x6d\x49\x22\x0f\x22\xca\x1e\x25\x71\x34\x70\x08\x8f\x90\x50\xde\x35\xad\x7c\x27\x39\x77\x37\xa3\x1d\xd6\xf5\x0a\x81\x56\x5d\xb1\x6e\xe3\x1e\x18\x44\xd6\xe0\xc3\x81\x53\xdc\x5c\xad\xdd\x25\xcd\xf6\x65\x0d\x73\x53\x84\x45\x80\x76\xd8\x4f\x2e\xb6\x70\x3a\xb9\x3c\x19\x74\x62\xdf\x7a\x5f\x97\x47\xf7\xdf\xd7\xdb\x05\xcf\x83\x23\x81\x57\xae\xd6\xf3\x7a\xda\x3f\x52\x23\x62\xfe\x87\x84\x64\xb3\xe3\x1c\x56\x66\xf1\x60\xc8\x90\xb5\xe2\x45\xb2\x03\x69\xb3\x86\x40\x14\x0a\x23\x3c\xb5\x35\x81\xa7\x75\xf8\x71\xc6\xaa\xc4\x38\xe3\x08\x7c\xc2\x6b\xb9\x79\x1f\xb1\xc0\x6a\x2b\xaf\x87\x1f\xdd\x91\xcc\xae\x44\x02\xe5\x40\x1d\x28\x32\xb6\xe2\x0a\xcd\x54\x7c\xc2\x4c\x5a\x34\x4f\x53\x55\x18\xce\x13\xc2\x8c\xeb\x3e\x89\xe9\x13\x7f\x64\xf2\x11\xcd\x65\xd1\x4b\x4c\xcc\x45\x37\x53\xdc\x39\xee\x3b\x19\xe4\xbb\x2c\x1b\x7c\xea\x83\xac\xdb\xf3\x2b\x0b\x88\xb4\x90\xf3\x1d\x29\x32\xa6\xa3\x99\x98\x73\xb3\x78\x6f\x40\xb6\x1c\x48\x1c\xf4\xf9\x2c\x3a\x7a\x0e\x5c\x4a\xa9\xc8\xe7\x9a\xa9\x9b\x23\x17\x49\x35\x65\xc2\x2f\x16\xaf\x88\x99\x1d\xbd\x9d\xcc\xf5\xe7\xc6\x4d\xb1\x97\x25\xf0\xe1\x8b\xba\x28\x82\xfa\x8f\x01\xc4\x82\x5d\xe8\xbc\xbf\x5d\x3d\xca\xf5\x9f\xd3\xc9\x25\x5b\x58\x19\xf9\x42\xec\xee\x6a\xf4\xc3\x67\x54\x2f\x55\x87\xa7\x3b\x5b\xf3\xbf\x1d\x97\xe1\x11\x9b\xef\x5d\x86\x7b\x97\xe1\xde\x65\xb8\x77\x19\x3e\x27\x97\xa1\x67\x96\x3a\x4b\x64\x87\x85\xf1\x5d\x8f\x7e\xcb\x8b\xe0\xbb\x8d\xfb\x14\xca\x83\xa5\xc1\xa8\x8b\x73\x24\xa2\x17\xb1\x13\x5d\x95\x37\x5c\x87\x43\x70\xd1\x0d\x9d\x3f\xb2\x24\x14\x1a\xac\xfb\xc0\x7e\x2e\x6e\x46\x7f\x66\x22\x8d\x54\x0c\xf0\x4d\xe0\x90\xbd\x91\xb9\x2e\xcc\x85\xb3\xdb\x48\x9d\xd3\xc9\xe7\xed\x1c\xf9\xd0\x89\xa2\x1f\xaf\x35\xf3\x1e\x0e\x9e\xd8\x54\x71\x89\x91\x6f\x7a\xbb\x5c\xe1\x95\xca\x94\xbc\x11\xb1\xfb\xb6\x30\x1b\x45\x43\xe4\x78\xb7\x72\x34\xbb\x69\x97\x44\xce\xc1\x31\x04\xe7\x1e\x58\x68\x42\x17\x9a\x1d\xd8\x1f\x8e\xa3\xac\xec\x78\x5b\xb3\xcf\xcf\xc5\x5c\xe5\xcb\xa3\x8a\x30\x10\x24\x63\xbd\xac\x7e\x2a\x22\x96\xea\xa1\x49\x18\x8c\xca\x1c\x10\x33\x88\x69\xd1\xcf\xeb\x06\x58\xad\x23\xb5\xf4\xaf\x5d\xd0\xea\x9e\x1b\xe1\x2e\xe5\x75\xb6\x3a\x9c\x21\x68\x20\xe8\x23\x3c\x7b\x89\x29\xa4\xeb\x3e\x40\x4d\xa4\x0b\xb6\xe0\xb9\xee\xc2\xf1\x9d\xb7\x36\xfc\x17\xcb\x85\xd4\x8a\x54\xca\xbc\xee\xc3\xd3\xe5\x0f\x9d\x54\x02\x82\x50\xec\x74\xa8\x36\xa9\xec\xc0\x84\x15\xc1\xb9\x42\x33\x12\xec\x2a\xc1\x54\x59\x64\x65\xb1\xcb\x2a\xcf\x79\xe1\xb4\x95\x43\x0b\xad\xb4\x54\x37\x8d\xe2\xe5\x85\x5b\xbf\xc1\xab\x17\x1d\xe9\x64\xbc\x28\x44\x9e\x9e\xb0\xff\x39\xf8\xc7\xef\x7f\x1d\x1d\x7e\x7f\x70\xf0\xf3\xcb\xd1\x7f\xfc\xf3\xf7\x07\xff\x18\x9b\xbf\xfc\xee\xf0\xfb\xc3\x5f\xdd\x3f\x7e\x7f\x78\x78\x70\xf0\xf3\xdf\xde\xbf\xbd\x9e\x5c\xfc\x53\x1e\xfe\xfa\x73\x5a\xce\xef\xec\xbf\x7e\x3d\xf8\x59\x5c\xfc\x93\x48\xe4\xf0\xf0\xfb\xff\xd7\x71\xc0\x9f\x47\x75\x20\x66\x24\xd3\x62\xa4\xf2\x91\x5d\xfa\x13\x56\xe4\x1d\xaf\x51\x6e\x39\x4e\xfa\x10\x1f\xcf\x4c\x73\x74\x77\xf7\x7d\x7c\x21\x05\xe1\x54\x5f\x17\xc6\x8c\x76\x39\xdf\x77\xf0\x52\x74\x1d\xf3\xf3\x8f\x8d\x76\x78\x99\xad\x66\x39\x19\x74\x92\xe4\xf5\x31\xa3\x80\xb6\xeb\x5f\x65\xb8\xe8\x2b\x48\x8e\x46\x30\x38\xe7\x99\x68\x66\x05\x7b\x49\xae\x81\x64\x5b\x32\xa3\x3b\x18\xab\xfb\xcc\xe8\x27\xce\x8c\xb6\x92\xd1\x85\xa9\xfb\xb4\xe8\x7d\x5a\xf4\x3e\x2d\x7a\x9f\x16\xbd\x4f\x8b\xde\xa7\x45\xef\xd3\xa2\xf7\x69\xd1\xfb\xb4\xe8\x7d\x5a\xf4\x3e\x2d\x7a\x9f\x16\xbd\x4f\x8b\xfe\xea\xd2\xa2\xaf\x42\x3d\x34\xcd\x9c\xe8\xaf\x23\x25\x1a\xf0\x40\x65\x24\x4e\xa3\x08\x70\x98\xae\xd5\x9d\x08\xc8\x1d\x24\xb9\xb9\x1e\xd0\x7f\xb6\x3e\x2f\x5e\xc6\x12\x8a\xec\x77\x90\xa3\x53\x24\xe1\x5c\x35\x50\x1c\x95\xc6\xc1\xbe\x09\x37\x12\xa7\x69\x0a\x58\x97\x31\x3b\x65\xb9\x88\x64\x26\xc1\x53\x11\x7c\xa0\x03\x23\xef\x44\x6a\xc5\x12\xb1\x8f\x96\x20\xac\x22\xb9\xc1\x3e\xfd\xa1\xd7\x95\x0a\x41\x29\xaf\x0d\x6a\xe7\x42\x71\x53\x08\x24\xe9\x4f\xd8\x3a\x8f\x94\x6b\x03\xcf\xf4\x4c\x95\x09\x44\x7d\x82\x64\x01\xb7\x46\xcd\xc5\x6b\x6f\x74\x7e\xfc\xa7\x03\x45\x8f\x01\x38\x70\x9e\xc9\x10\x40\xec\x1d\x35\x9f\xf8\x9c\xc9\xdc\x78\x96\xaf\x44\xa4\xd2\x58\xef\x20\xba\x17\xab\xb4\x50\x86\x07\x9d\x42\xea\x22\x66\x71\x69\x87\x06\x42\xbc\xe0\x89\x8c\x65\xb1\x44\x81\x0e\xa4\x89\x4a\x04\xee\xaa\xa0\x45\xaa\xed\xa0\xeb\x65\x0d\xa4\xc8\xb3\x2c\x57\x80\xb9\xa7\x3d\x1e\x9a\xbb\xa0\x03\xdd\xc3\xfb\x5d\x20\xdd\x2c\x29\x6f\x65\x6a\x2f\x82\xe6\x0d\x60\x81\x43\x3e\x05\x64\xaa\x0b\x5f\x23\x86\x0e\xb8\x39\xf5\x6b\x6f\xa0\xe6\x65\xc6\xf7\x16\x48\xb3\xc8\x97\x80\x8e\x57\x28\x7f\x78\x86\x9b\xae\x01\x44\x17\xd6\x4a\xcd\x54\x12\xbb\x36\x3d\x7f\x7e\x09\xfe\x96\xc8\x6a\x2c\xd0\x36\x0c\x90\xf1\x43\x07\xaa\x58\x22\x17\xc2\x14\x1c\x79\x03\x6b\xbe\x2a\x90\xe6\x1f\xbe\x65\x33\x55\xe6\x7a\xec\x83\xe4\xbd\x32\x3f\x33\x6a\x67\x1e\x9e\x13\x05\x5e\xa0\x82\x25\x82\xeb\x82\xbd\x7a\xc9\xe6\x32\x2d\x0b\x41\x6c\xbe\xbd\xe6\xf2\xf9\xdd\xb7\x83\xa7\xb8\x76\x86\x5f\x38\x1b\x6a\xc3\xe5\xd0\x55\x17\xaf\x8e\xfe\x68\x8c\xb8\x18\xf4\x4b\x66\xda\xef\xbb\xc3\xcf\xdd\x3f\x43\x8d\x00\xa4\x5b\x4b\x4b\x5a\xa8\x27\xd0\xca\xe1\x37\xae\xc0\x7b\x53\xb0\x95\x17\xf8\x00\xfd\x62\x45\x26\xfc\x4b\xa9\xa6\xcb\xa2\xd5\xb0\x6a\x08\xd6\xdf\xed\x33\x3e\xcc\x28\xaf\x7e\xe8\x77\xea\xa0\x35\xbd\x7e\xd8\xa9\xa3\x6e\xd4\x31\xe8\xcf\x9a\xbc\x05\x80\x80\x93\x41\xe0\x26\x32\xfe\x33\xe7\x63\x71\x00\x4b\x51\x04\x40\x3a\x85\x72\x20\xdd\x04\xa2\x46\xfb\xa6\xca\x8e\x62\xd0\xb3\x80\x77\x86\x21\xdd\xda\x28\x03\x57\x74\x10\xe0\x72\x25\x37\xcb\x20\x11\x7d\x82\xa6\x65\xb9\xb8\x95\xba\xc8\xbb\x70\xce\x3e\xd8\xdc\x04\x80\x94\x9f\x98\xd3\x70\x5e\x26\x85\xcc\x88\x99\xc6\x6e\xef\x54\x44\xd1\x12\xf1\x42\xa8\x8c\xd7\x11\x34\x12\x4d\x6e\xb1\x89\x6d\x43\x3f\x13\xa6\x3f\x70\x63\x62\x22\x2d\x72\x48\xff\x82\x9c\x4a\x2d\x32\x9e\x73\x2a\x02\xa3\x59\xd2\x48\xcd\xe7\x5c\x1f\x62\x9c\x96\x9b\x5c\x57\x7b\xcc\x80\x35\x91\xf3\xa4\x62\x2b\x89\xa6\x97\x75\xd8\xf7\xc6\x28\x44\xca\xd3\x22\x78\x71\xaf\xcd\x63\x4c\xdd\xa7\xae\x4f\xec\xad\x5c\x88\xb4\xd2\x71\x01\x06\x28\xde\xb9\x5e\xf3\xe8\x4e\xa4\x31\xfb\xa4\xdd\xbe\x88\x97\x29\x9f\x63\x0f\xae\x2c\x57\x0b\x09\xa5\x3d\x22\x0e\x92\x97\x2a\x57\xd3\x44\x61\x40\xc3\x40\xa5\x10\x82\xb1\x5b\x83\xb7\x6f\x8e\x96\xba\x43\x5f\x98\x4f\x5a\xe4\x6d\x3a\x94\xe6\x22\x33\x81\xc2\x5c\x2e\x22\xe1\xcc\x6e\x18\x50\xdf\x93\x5c\x90\x40\xc7\x1f\x4c\x13\x01\xc7\xa5\x17\xeb\x36\x67\x5b\x95\x06\x43\x9b\x23\xb8\xdf\x13\x50\xe9\x4b\x57\x6c\xb0\xb2\xe0\x80\x83\x9a\xf6\xdf\x67\x96\x6a\x1e\x8d\xa8\xdb\xdb\x01\x22\xf7\x65\xab\xe4\xd3\xd6\xb1\x35\xd6\x63\xf8\xf1\xf5\x79\x53\x3f\x7f\xe4\xb1\xd2\xec\x75\xa2\xa2\x3b\x76\x2e\xc0\xfd\x65\x51\xce\x5b\xa8\x32\xbf\xf3\xd8\xf6\xce\x62\x7e\x2f\x9a\x56\xaa\x6d\xcd\xf3\xf3\x69\xfc\x38\xad\xf3\x3b\xc2\xcb\xef\x5b\xad\x7f\x1d\xad\xd6\xf3\x69\xfc\x9b\x69\xb4\x3e\xe7\xb7\x1d\x04\x19\xbc\x34\xb9\x51\x06\x86\x80\xeb\xce\xf8\x90\xed\xa4\xad\xf9\xcd\x4c\xdd\x8f\x0a\x35\x2a\xb5\x18\xc9\xa2\xf7\x39\xde\x89\x25\xf0\x22\x7c\x96\x7f\xb3\x0f\x36\x9c\x00\x85\x32\x21\x10\xf3\x73\x6a\xd6\xc6\xc7\xd7\xe7\x70\x84\x57\x6d\x84\x81\xe0\xb1\x28\xa2\xe3\x48\x64\xb3\x63\x1c\x9e\xcf\x3e\x12\xd5\xe7\xc4\xe2\xb9\x4a\x65\xa1\x72\x1d\xce\xe3\x53\x16\x41\x93\xa1\xc8\xf9\x53\xcf\x44\x36\xab\xc8\x05\xc4\x1f\x9f\x44\xec\x02\x52\xf9\x82\xd8\x17\xe2\x9a\x80\xde\x2e\x2a\x09\x67\x74\xbd\x65\xe1\x79\xdc\xb1\x9e\x40\xe6\x53\x62\x66\xcf\x33\xdd\xe5\x8f\xd4\xee\xd2\xfd\xb2\xef\xfe\xe1\xcf\x4c\xb2\x9f\xaa\x57\xf9\xf0\xca\x3d\x0a\x4a\xd0\xaf\x2b\x0e\x6e\x3b\xee\x86\x01\x8b\x55\xab\xd8\xcb\x1b\xf0\x6a\x2d\x64\x0c\x78\xcb\x0b\x91\xe7\x32\x16\xda\x1d\x01\x34\x01\xf7\x36\x45\x2a\x93\xa7\x3c\xd6\x42\x6c\xd0\xd0\x9c\x0f\x6a\xb6\x47\xc0\x09\xe4\x9f\x42\x4f\x9c\xe7\xf1\x08\x19\x1e\xe1\xb9\x1d\x9d\x74\x3c\xe1\xa2\xd6\xd9\x51\xe0\x29\x79\x78\xfe\xa1\x92\xe7\xf1\x5c\x12\x21\x6d\x9e\x4e\xea\x1f\xe5\x8e\x6d\xec\xd2\xd6\x6f\x39\x43\x63\xd0\xd3\xca\xe9\x88\x27\xe2\xf2\x87\x93\x41\xc0\xa2\x5d\xd9\x67\x9a\x37\x6d\xf7\xc3\xba\x35\x1d\xd1\xeb\xb2\xb1\xe5\xda\xdf\xaa\x0d\xca\x52\x15\xb7\x47\xef\xf6\x8d\x15\xdb\x1a\x2b\xd6\xfb\x8a\x44\x91\xdc\x97\x2d\x48\xad\xdc\xf2\x42\xdc\xf3\x70\xab\xe7\xda\xb1\x05\xdb\x4a\xbb\xc3\xc0\x09\x1e\x15\xf1\xf1\xad\x7d\x7f\xef\xf3\xca\x72\x55\xd8\x5b\xc1\xb9\x9a\x73\x99\x76\x9a\xa0\x6f\x5f\xb8\x89\x4d\x2a\xc2\x04\x8a\x8c\xd9\xb7\x1b\x1b\xa3\x6e\xfe\x52\x42\xa3\x3c\xf4\x1a\x8c\x9f\x8d\x21\xfb\xc0\xc8\xfc\xfa\x9b\xe2\x75\x37\x34\x6b\x3b\xb3\x76\x1f\xb3\x42\x85\x56\xa8\xc2\xba\x3b\xc9\x31\x47\x6a\x95\xb8\xc5\xb4\x48\xb5\x84\x7c\x00\x3f\x41\x90\xc2\x02\xc6\x2e\x41\x24\xa5\xae\x6b\xf4\xac\xc1\x7a\xc4\xde\x29\x48\xb2\x41\x4d\xa5\x6c\xba\x0d\x6d\x9c\x5c\x26\xe3\xbd\x85\xb9\xb7\x30\xe9\x16\xa6\xd6\xc9\x45\xca\xa7\x89\x88\x83\x37\xd7\x9b\x84\x9b\xec\x2a\x61\x9e\x3f\x8e\xa5\x86\xff\xb3\xab\xab\x77\x26\xb8\x59\xa6\x21\xb7\x38\x58\x2a\x77\x8c\x54\x70\x97\x56\x81\xf5\xaf\x4f\xac\xce\xee\xd4\x2c\xf1\x32\x8d\x61\x5a\x42\x37\x72\xa9\x91\xa2\x39\x20\x68\xd6\x0a\xda\x69\x98\xf3\x39\x15\x50\x1a\x15\xdd\x4d\xea\x18\x26\xc4\xbf\xaf\x67\x32\xf5\x7e\x44\x53\x2b\xb5\x41\xd2\xe5\xf9\x20\x59\xc3\x69\x4f\xba\xb8\xa2\xae\xbd\xd3\xf8\x0a\xd9\x07\x84\x18\xd7\x5a\x45\x32\x2c\x98\x0e\xea\xa5\xb6\x15\x58\x6c\x8e\xeb\xfe\xa7\x6b\xac\xc1\x9d\x6d\x10\x27\x2c\x68\xac\x72\xed\xd9\x13\x04\xda\xe0\x0b\x72\x9c\xeb\x7d\x8a\x56\x2c\xa9\xe8\x4a\x1b\xa7\xe9\x0a\x8b\x57\x63\xb0\x21\xd1\x7e\x27\x1c\xce\xa6\x87\x80\xa1\xd4\x9e\x78\xd0\x6b\x06\xcd\x31\xeb\x76\x9c\x01\x13\x1b\x7f\xa9\x1b\x29\x5a\xea\xad\xdf\xab\x6c\x9e\xf6\x6f\x52\xa2\x4a\xf4\xbb\x2b\x09\x0a\x64\x9d\x37\xaf\x79\x75\x45\xaf\x1c\x86\x79\x41\xc9\xb5\x90\x04\x8f\x76\x56\x26\x36\x4f\xb7\x5a\xae\xf1\x3a\x0f\xc4\x36\x1b\x00\x37\x97\xf3\x4a\x7c\x63\xc7\x31\x1c\xf4\x67\x10\xed\xd2\x6a\x77\x5d\xc1\xf7\xa6\xe2\x6d\x02\x6d\x13\x52\xa7\x77\xdd\x25\x51\x6c\xd4\x77\xf7\x53\xab\xdd\x52\x9b\xbd\x52\x67\x4d\x22\xd9\xac\x9c\x3e\xea\xa7\xae\xba\x5a\x92\xea\x56\x6f\x72\x6b\x5e\x7e\xf7\xed\xb7\xeb\xfa\xf0\x92\x68\x6e\xec\xd5\xbb\xbe\x0f\x2f\x6d\x9c\x9b\x0a\xa8\x37\x17\x43\x93\xc7\xda\x56\xfc\xec\x15\x32\x93\x68\x3e\x2c\x76\x26\x17\x2e\x87\x16\x2a\x87\x65\x88\x93\xa3\x78\x01\x4d\x51\x4d\x8a\x60\xc8\x19\xb7\x0d\xed\x07\x2f\xaf\xf4\xdb\xdf\x36\x8c\x9f\xb5\xa8\x3d\xb4\x91\x36\x90\x7d\x7a\xeb\x62\x4a\x43\xe8\xe9\x80\xe4\xbe\x09\x97\x67\x2d\xd2\x0e\x89\xe2\x5a\x34\x9e\x4d\xf8\x3a\x24\x8a\x1e\x06\x0f\x01\x55\x87\x44\x52\x16\x7d\x63\xe9\x50\x10\x74\xbc\xe2\xf1\x41\x9f\x15\xe2\x01\x21\x76\x02\x46\x8e\x8f\x79\x43\xa2\xc9\x02\x91\x71\x42\xfd\x29\x81\x28\x38\x8d\x29\x5e\x57\x10\x05\x6e\xaf\xd0\xc6\x18\x68\xd1\x76\xc3\xb9\x21\x1b\x3b\x64\x8a\x6c\x13\xa6\xcd\x03\x7c\x9a\x00\x92\x6b\x90\x6c\xb6\x58\x3a\x01\x84\x57\x48\x6e\xb1\x77\x82\x47\xbb\x06\x81\xa6\x69\xf5\x04\x50\x6c\xd2\x31\x5e\x8b\x6d\x18\x32\x01\x94\x3d\x6d\xb6\x11\x39\x86\x6e\xe8\x04\x99\x3b\x41\xa3\x6c\xa0\xc4\x6c\x44\x7c\x09\x20\xf9\xd0\x3c\xda\xc5\xf4\xe9\x66\x00\x75\x31\x83\xea\x43\x73\x07\x7d\xb4\x8a\xc5\x52\x31\x95\x4c\x92\x6d\x40\x60\x59\x83\xa6\x12\x40\xb3\x81\xbb\xd2\x1f\x86\xca\x56\xe4\x94\x40\x6a\xc1\x2a\x99\xea\x6a\x08\xc5\x39\x09\xa8\xd2\x0b\x72\x30\x87\x65\xb5\x85\x60\x5d\x10\x11\x2e\xfc\x3e\x7e\x04\xaa\xac\x03\xb0\x45\x97\x70\x56\x27\x1f\x5b\x23\x9e\x82\x2e\x16\x27\xd1\x36\x85\xbe\xea\xf1\x45\x20\x6d\x36\x5d\xa9\x9f\xca\xc9\x12\x28\xee\x64\x29\xc3\xd1\xa8\x56\xe3\xab\x29\x30\xf6\xa9\x1f\xae\x56\x52\x2e\xaa\x1f\x13\xeb\x93\xf6\x99\x16\x4f\x95\x69\x51\x17\x09\x90\x28\xba\x42\x82\xad\x45\x02\xfd\x8a\xec\x3e\x6c\xdf\x77\xd8\xde\xad\x94\xf6\x35\x9e\xd5\x5a\x04\xa2\xc6\x84\x62\x6a\x0a\xc7\xbe\xab\xa1\xa8\xf7\x3d\xf4\x77\x8b\x72\x61\x30\x4e\x78\xa2\xc7\x10\x91\x27\x11\x5d\x41\x58\x44\x43\xd7\x59\xd3\xce\xd6\x05\xcc\xfc\x79\x56\xd0\x84\x6c\x1f\x91\xdf\x47\xe4\x31\x22\xbf\x43\x08\xee\xc7\xea\x51\xe7\x9f\x9b\x95\x73\x9e\x8e\x40\x93\x98\xd8\x3c\xd9\xeb\xa7\x6e\x56\x36\x8b\x0b\xc7\xa0\xce\x30\xfe\x43\x5b\xfb\xac\xa8\x5a\xa9\x4c\xe5\x2f\xa5\x40\x3f\x0c\xe3\xb5\xa9\x32\x1e\xf4\xcc\xf1\x9a\x85\xc6\x14\xda\x81\x8f\xe6\xf9\x55\x2d\x14\xa9\x2c\x88\x8b\x78\x21\xc6\x89\x57\x3c\x35\x0a\x87\xa5\x2a\xd0\x66\x6b\x78\x21\x21\x5b\xde\xbc\x63\xa2\x62\xdf\xfa\x73\x5a\x88\x44\xd1\xde\xca\xcd\xb5\x9c\xf1\x24\x51\xf7\x56\x76\x7c\x0b\x06\xcc\x4e\x1d\xa9\x8c\x26\xef\xae\x30\x6f\x2e\xc1\x8d\x29\xe2\x07\x53\x37\x7a\xb9\x80\x30\x86\xc8\xc1\x55\x2d\x6e\xf3\x80\xc4\xa9\x2b\x51\x78\x4b\x04\xef\xe2\xa9\x2d\xe6\x85\xbf\xbb\x7c\x7f\x33\x07\xd4\xcd\x24\xb2\x53\x31\xe3\x0b\xa9\xca\x7c\x6c\xde\x00\x1d\x53\xf0\x71\x63\x36\x2c\x55\x59\x05\x71\x4a\x4d\x65\x44\xb5\x22\xfa\x01\x13\xc6\xac\x92\x30\x8c\xd5\xc4\x34\x90\x2e\xf4\x38\x8f\xc4\x67\xe8\x54\xfd\x80\xb7\xee\x00\xc2\xc0\x60\xcf\xbb\x8b\xac\xcb\x16\x3a\x03\x3b\xe4\x47\x52\x19\x78\x73\xfb\xf9\x4f\x36\xcd\xf3\xc5\x95\xf9\x15\xfa\x98\x3a\x1b\xe7\x88\x2d\x65\xd1\x1d\x5c\x59\xe5\xa0\xbf\x23\x7a\x6f\xa0\x3f\x57\x03\x1d\x2f\x8b\x13\x95\xc8\x68\x79\x79\x1e\xbc\x44\xb8\xcd\x98\x25\xc0\x5e\x73\x08\xde\xbf\xe7\x29\xbf\xb5\xbe\xa4\x83\xab\xc9\xeb\xf7\x87\x04\xaa\xe6\xc6\x67\x1c\x8f\x97\xe7\xab\x49\x2d\xfe\xd9\x6b\x5f\xf4\xa1\x7f\xa4\x82\x15\x5e\x74\x32\x38\x1e\x81\x1b\x8f\x80\xc9\xe0\x0c\x02\x6a\xdb\xea\xc6\x14\xe1\x21\x4c\x3e\x72\x68\x88\x3a\x54\x0d\xc1\x67\x31\x8f\xef\xfa\x9d\x16\xd5\x13\xe8\x10\x24\x08\xad\xa0\x89\xca\x9d\xf2\xe6\x51\x5b\x88\x99\xf4\xae\x36\xdf\xe1\xf6\x91\x8c\x9c\xcf\x57\x6c\x28\xee\xd9\x3a\x86\x2d\xbf\x2c\x72\x2e\x8b\x35\xe7\x40\x43\x72\x60\x13\xdb\x2f\xb2\x54\x08\x28\x45\x04\x28\xc2\x12\xc3\x57\x97\xb5\xd5\x33\x08\x3b\x6a\xfe\x68\xea\x8b\x4e\x06\xad\xa2\x3b\x3c\x87\xa3\x13\xb2\x55\xe3\x13\x63\x71\x4d\x79\x74\x77\xcf\xf3\x18\xf2\x73\x33\x5e\xc8\xa9\x4c\x64\xb1\xdc\x10\x91\x68\x3f\xef\x5c\xda\xa2\x31\xdd\x36\x7d\x69\x65\x4c\xd7\xc0\x90\x33\xff\x41\x06\xd0\x49\x73\x51\x88\xbc\x4e\x84\xdc\x9e\x37\xbc\x75\xd5\xd6\xb6\x80\x33\xf1\xf9\x7c\x21\x46\x65\x7a\x97\xaa\xfb\x74\x64\xaf\x8b\x5b\x9a\xc1\x11\x04\xab\x65\xa4\x2d\xa3\xe4\x71\xac\xd2\x0d\xac\xe5\x71\x2c\x81\x2e\x4f\x26\xad\x8b\xd0\xe0\xee\x29\x10\x35\x2c\xf6\xcd\x26\xbf\x64\xa5\x82\x2c\x85\x88\x0a\x7c\x7b\x03\x59\x94\xf1\x41\xe7\x15\xd8\x91\xff\x4d\xa1\x31\x4d\x11\x0b\x28\xb5\x00\x79\x31\xe8\x89\xe6\x9c\x34\x4c\xc4\x4d\x36\xe8\x30\x50\x7e\x73\x23\x53\x59\x2c\x4f\x68\x63\x78\xc0\xc4\x53\x7c\x7e\x0b\xb3\x1e\x67\x1b\x0d\xdf\x89\x5b\x1e\xe1\x7b\x59\xb4\x61\x3f\x8d\x99\xa7\x01\x36\x12\x66\xe1\xba\xe1\xa9\x76\x21\xc3\xe2\x82\x98\xc8\x96\x33\x1b\x76\x75\x19\x95\xf6\x61\x08\x00\xb9\xe2\x04\x6e\x39\x36\x66\xa7\x5b\x93\xaa\x50\x6d\x1b\x7c\x22\xab\xae\xa1\xa2\x01\x78\x6b\x57\x73\x4b\xa5\x1b\xc5\x47\x0a\xa5\x97\x4e\x74\xde\xf1\xa9\x48\x34\x71\x7e\xe7\x26\x24\x05\x17\x21\xc8\x8a\x50\x10\xa8\x8f\xd1\x2b\xe1\xdd\xa2\x21\x16\x74\xa0\xb7\x99\x5d\x30\x2d\x91\xc8\x5b\x09\x3c\xb1\xe6\xb7\x86\x20\x46\x99\x98\xe0\xc5\x11\x9b\x1a\x4b\x4e\xa5\x2c\x31\xc3\x6b\x87\xa2\x84\x81\x8c\x07\x9d\xf3\x79\x48\x66\x4f\x9b\x31\x00\x29\xc4\xb1\xe3\x2b\x91\xa3\xa7\xc9\x3d\x5f\xc2\xf2\x8e\x12\x05\x27\xa5\x66\x15\xc0\x5f\x2e\xe0\x3a\xc3\xb5\x73\xe3\x78\x1c\x1e\xb4\x66\xf6\x69\xf0\x4c\x00\x53\xd8\x01\x3a\x11\xd8\xef\x4c\x35\xcb\xef\x0e\x77\x93\x1d\x6f\x8a\xbb\x89\x4e\xa6\x62\xcd\x0e\xc0\x48\x4b\xe0\x52\x08\xad\xfc\xe6\xbc\x88\x66\xdb\x0d\x4f\x98\x9b\x91\x09\x6c\x39\xaa\xf2\xa3\x2a\xad\xcc\x55\xd6\x59\x9c\xbf\xca\xf3\x71\xd8\x86\x69\xb5\x5e\x7c\x1d\x1e\xfa\x54\xd4\xab\x63\xaf\x47\xcf\x43\xce\xd2\x42\x06\xca\xda\x07\xb1\x10\xf9\x57\x26\x6a\xde\x2c\x7f\x1b\xe2\x86\x09\x27\xcf\x4c\xe4\x5a\x0e\xdb\x69\x29\x93\x78\x13\x54\x44\xbb\x19\xf3\xba\x94\x49\x2c\x72\x56\xe4\xfc\xff\xd8\xfb\xda\xe7\xb6\x71\xa4\xcf\xef\xfa\x2b\x50\xbe\x0f\xb1\x5d\x16\x9d\xec\xdd\xcc\xce\xe9\x6a\xaa\xce\xeb\x64\x36\x4e\xe2\xc4\x4f\xec\x64\x9f\xad\x24\xb5\x84\x48\x48\x42\x4c\x01\x5c\x80\xb4\xad\xa9\xfd\xe3\x9f\x6a\xbc\x91\x94\x45\x10\x12\x1d\xdb\x49\x34\x99\x0f\x96\x2d\x36\xf1\xd2\xf8\x75\xa3\x5f\xb7\x5a\xcc\x56\x8b\x09\xd4\x62\xba\x79\x62\x69\x5e\x47\x2a\xe2\x1b\x18\xae\x7a\xd4\x68\x1f\x36\xf9\xd9\x9e\x69\xc5\xd0\xad\x54\x11\x2a\xb0\xbc\x7c\xe0\x23\x89\xd0\x15\x11\x63\x2e\x49\xe0\xec\x75\x9a\xad\x7d\x08\x65\x7c\x3a\x05\x9f\x34\x67\x7a\xb2\x4a\xef\xe6\xcc\x5c\xd8\xbc\x00\x66\xcc\xb9\x88\x16\x68\x97\x44\xd3\x08\xbd\xc6\x8c\x5e\x72\x43\x27\xe7\x69\x2f\xdc\xef\x38\x11\x09\x28\x0c\xa3\x41\xe7\x74\x57\xde\x97\x8e\xe1\xe1\x2d\xcc\x6c\x61\xe6\x91\xc0\x8c\xcf\x08\x57\xfd\x27\x4a\xe6\x2d\xa3\x7f\x5f\x78\x63\xc6\x61\xe2\x1b\x02\x57\x43\x9d\x43\x38\x76\xc3\xcb\xa1\x21\x00\x18\xa4\x2c\x29\x3a\x90\xc6\x95\x35\xa9\x29\x45\xed\x5b\x86\xd0\x49\x51\xab\xa9\x56\x73\xb2\x3a\xb2\xb2\x8a\x4a\x0c\x5b\xe0\xb3\x0c\x17\x10\xec\x1c\x0d\x36\x5e\xc1\x2e\xd4\xb2\x76\xe0\xd1\x20\x6c\xc5\x1a\x20\x01\xc8\x65\x09\xf4\x42\x2f\x5c\x16\x3c\x74\xdf\xb8\xfa\x36\x14\x14\xd1\xf5\xcd\xcd\xf9\x74\x56\xe5\x5e\x87\xaa\x31\xbf\x2d\x8c\xfe\x04\x30\x4a\x6e\xf2\x70\x5d\x65\xe5\x9c\x5c\x85\x0f\x45\x0a\x6a\x4c\x5f\x51\x8c\xaa\xb5\x6e\x25\x0d\x11\x1b\xaa\x15\x43\xbf\x19\x74\x54\x29\x6e\x4c\x00\x50\x0f\x6a\x40\x54\x07\xbf\xa3\x16\x5c\x00\x44\x2b\x02\x67\x65\x96\x69\x6f\x67\xe0\x48\x54\x35\xbe\xbc\xcc\x32\x94\xeb\xc7\x8c\xc9\xe9\x3f\xca\x1a\xf0\x9f\x93\xc9\x5b\x5e\x9c\x81\x79\x9c\x15\x4f\xfa\x8c\x2e\xa3\x73\x5a\x1c\x9f\x7d\x58\x6b\x81\x6e\xe8\xbc\x9c\x23\x6c\xda\xcd\x4c\xd0\xf1\xd9\x07\xe7\x52\x8b\x7a\x8f\xe6\x94\xcc\xb9\x58\xf4\x1a\xd0\x5c\x91\xb8\xab\x31\x5d\x11\x46\xa4\xfc\x03\xd3\xac\x14\xe4\x62\x26\x88\x9c\xf1\x2c\xf4\x9c\x3f\x39\xa5\x4c\x8d\x2e\xe1\x4c\x92\xa4\x54\x26\x88\x89\xa6\x25\x9d\x0c\xcd\x05\x1f\x13\x7f\x82\x9b\xb2\x2e\x30\x49\x53\x02\xc1\x0f\x40\x01\xa2\xc7\x27\x05\x11\x08\x02\x90\xd8\x14\xc9\x32\x49\x94\xc7\x30\x42\x47\x79\x9e\x81\xc7\xd9\x4b\x11\x5e\x6c\x67\x07\x70\x31\x26\x0d\x14\x06\x5f\x50\x86\x93\x5a\xf8\x07\xc1\x19\x78\xb5\x15\x2c\x3d\x19\xf4\xcb\x3f\x0a\xc9\x37\xb2\x83\x3b\x61\xb4\xa0\x38\x7b\x4e\x32\xbc\x08\x5d\xf6\xb7\xe5\x7c\x0c\x49\xed\x13\xa8\x4d\xa1\x1a\xf7\xe9\xb5\x32\x5e\x2d\x73\xba\x67\x58\xea\x64\x54\x6f\xc2\xc6\x98\x4c\xb8\x58\x5e\x2b\x1d\xc6\x08\x26\x2e\x15\x81\xb1\x7a\xe9\x7c\x44\x1f\x78\x51\xcf\x88\xa0\x3c\x98\x8b\x5f\xf2\x6b\xc4\x27\x05\x84\x0d\x72\x28\x37\x01\x9b\x5c\x71\x6e\x9d\xe1\x3a\x2c\xfe\xdf\x05\xc3\x9d\x27\x33\x32\x27\xa1\x6b\xa3\xbf\x6d\xb2\x62\x20\x95\x48\x25\x1c\x32\xa8\x2a\xc4\xa6\xcd\xa2\x10\x2f\x2f\x2e\xce\xda\xd1\x08\x2d\x2d\xe3\x3a\x8b\xe5\x21\x1a\xb8\x8c\x6b\x60\xe1\x39\x20\x8d\x94\x77\x82\x85\x0a\xb5\xa4\x5c\x06\xc3\x41\x57\x94\x68\x0d\x0c\x0d\x89\x49\x99\x35\x01\x51\x83\xa4\x63\x4e\x3f\xc9\xcd\xd0\xd0\x47\xf3\xde\xd9\xf6\x82\xce\x09\x2f\x8b\xd0\xdd\x68\x83\x48\x5d\xa3\xc0\xed\x84\xea\x4d\xe9\x8b\xb4\xe7\x65\xb1\x0c\x00\x41\x2b\xe9\x21\xf9\x50\x00\xe0\x4f\x90\xe8\xd2\x15\xe1\xe9\x08\x9d\x14\x26\xa4\x3a\x45\x31\xad\x2e\xb2\x71\x2b\x59\x54\x2f\xbf\xd3\xe7\x78\x42\xb4\x66\xe8\xe8\xb9\xbb\x8a\x81\x7b\x3b\xa5\x13\x9d\xd3\xa1\x68\x40\xf7\x57\x2e\x43\xa0\xa5\x9a\xbb\x73\x22\xc5\xbf\x3d\xfd\xed\x69\xbc\x17\xf5\xda\x07\x18\xc5\xdb\x35\xf6\xc2\x3b\x1b\xd8\x96\x0a\x5c\xb8\xb7\x23\x6c\x73\xe6\xd5\xde\xc2\xb6\xda\x3d\x52\x4c\x1e\x43\xf1\xf2\x58\xa5\x31\x28\xc8\xf7\xd0\x84\x93\x14\x6b\xc2\x71\x75\xe5\x55\xb9\x16\xa2\x24\xfd\x76\x1c\x70\x4a\x76\x96\x62\x6c\x8b\xef\x3a\xab\x3f\xbe\x7c\x5f\x53\x27\xd7\x7b\xee\x59\x73\x8d\x6a\x3c\xa0\x7c\xd6\xf1\xde\xb7\x3d\xf6\x21\xb7\x3f\xc8\x61\xa1\x3f\xae\xfa\xee\xa6\xf7\x9d\x49\x2c\x37\xee\x47\xa2\xda\x2f\xad\xe3\xf7\xaa\xdb\xbb\x69\x3c\x90\x72\xbf\x01\x3b\x3e\xc4\xea\x3c\x16\xf5\x3e\x74\xb9\x3c\x14\xd7\x58\xc8\x00\x71\x52\xad\xd0\x0f\xaa\xe0\x6f\xc0\xa0\x3e\xa2\xf7\xcf\xba\x8f\x48\xc5\x0f\x5a\x4b\x0f\xcd\x87\x83\x81\x7f\x97\x44\xae\x6b\xf4\xa4\x6c\xc9\xc6\x78\x67\x46\x4f\x33\x9e\xf5\xcd\x9e\x94\x7d\x43\xb3\x27\x04\x5e\xd3\x84\x9c\x6d\x78\xa5\x28\x59\xea\xd8\xcc\x90\x52\xaa\x77\x53\x48\xb7\x12\xd6\x77\x19\xa5\x22\x2b\x2c\xb0\x6a\x79\xfd\x96\xd1\xf7\x8e\x51\x9b\xe1\xa6\x57\x8d\xd6\x59\xaa\x2b\x47\xe8\xd5\xa9\x6b\xaa\xea\xa6\xb1\xd7\x63\x37\xbb\xbc\x9c\xa2\xcd\x99\x17\xe0\xe0\x14\x36\x92\x7e\x25\x81\x00\xdf\x66\x02\x42\xe2\x39\xc1\x69\x46\x19\x39\xd7\x28\x15\xb8\x17\xe7\x8d\x04\xeb\xd4\x0e\x8b\x32\x8b\x76\x8d\xa8\xbf\x56\x9a\xc8\xd4\x61\x82\xb2\x4c\x60\xeb\x38\xa8\xba\xa0\x7e\xe5\x63\x55\xcd\x50\x8b\xa3\x82\xb2\x92\x97\x32\x5b\x98\x51\x77\xeb\x95\xba\xbe\x62\x4d\x92\xe9\x3d\xb6\x72\x6b\xe9\x4e\xf9\xeb\x53\x19\x85\x00\xe0\xaf\xff\xa7\x17\xe3\xaf\xe1\x4e\xde\x39\x6a\x38\x93\x53\x92\x67\x7c\x71\x2b\x14\x12\x4b\x74\x2c\x38\x7b\xc5\xc7\xad\x54\x91\xba\x24\x43\x9e\x38\x12\xbc\x2c\x8c\xf5\x9c\x50\x55\x75\x49\x2d\xbd\x92\xe7\xd0\xbf\x14\x83\x0a\x4a\x79\x4a\x13\x58\x73\x59\xce\x5b\x27\x02\xff\xef\xaa\x1b\x78\x9c\x08\xce\xe2\x03\x14\xc3\xfe\x89\x58\x55\xd5\x89\xff\x5d\x62\x51\xfc\x19\x2b\x5d\xde\xe5\xb2\xee\x41\x5c\x3f\x46\x39\x96\xd2\xbf\x83\xf6\xdd\x26\x58\x2a\x4e\x55\x25\xdf\x18\xf6\xd3\x3d\xee\xbe\xb4\x17\xa1\xcf\x4c\x59\x7e\x3c\x14\x2d\x2e\x9b\x42\x72\x59\x76\x7b\xa2\x12\x34\x1b\x52\xc5\xe7\xea\x95\xf0\xd0\x84\x89\x52\x57\x38\x8e\xdc\x80\xe3\x1a\xd0\x03\x4b\x04\x4b\xe2\xe2\xf3\xed\x2c\x9e\xcd\xe3\x8e\x22\xbc\xcb\x64\xe2\xa7\x87\xcf\xd0\xbe\xfe\x17\x1f\x00\xa4\x67\x04\xc5\xff\xfb\x97\x79\x0c\x2b\x19\xff\xf2\x54\x2a\x8a\x8c\x17\x7b\xd1\x4e\xaf\xbb\x3a\xe4\x92\xf0\xc9\xe4\x0d\x38\x01\x37\x42\x00\xe6\xb4\x1d\x41\x74\x97\x7d\x73\x0c\xe7\x58\xd5\x11\x69\xa5\x89\xdc\x61\x6f\x39\x99\x7f\x89\xee\x41\x31\xa9\x42\xf9\x82\x4f\x27\x84\xff\xcd\x21\xad\x03\xcc\x4a\x90\x07\x6c\x63\x98\x60\x3e\x3a\x6c\xae\xa2\x3a\xe8\x28\x6d\x08\xe9\x86\x46\x08\x25\xa5\x2c\xf8\x9c\xfe\xa9\x4a\x02\x23\x2e\x40\xcc\x19\xed\x7d\x0e\x5f\x81\xc2\x2c\x21\x66\x2f\xc7\x7e\x20\xc9\x05\x9d\x4e\x15\x08\x42\x52\x96\x60\x0a\x51\xc6\x8b\x5a\xc1\x88\x08\x1d\xd9\x22\x15\x89\x87\xb0\x1b\x9c\x50\x87\x11\xa0\x18\x0c\x1e\x80\x90\xba\xb2\xb2\xcb\x03\xaf\x26\x1f\xa1\x17\xd1\xb4\x7d\x13\x91\xbb\x9b\x58\x04\x71\x4f\x82\x34\x20\x1a\x60\x86\xf6\x6f\xf5\x01\x78\x68\x56\x43\xdb\xa5\x60\x14\x36\xa9\x75\x36\xca\x29\xe6\x62\x1a\xe1\x1c\x4a\x0d\x44\x2a\x3e\x32\xba\x1c\xd9\x98\xab\x44\xf8\x2d\xc6\x19\x1d\x0b\x2c\x16\x1a\x77\xce\x5d\xa2\xbe\x1b\xb5\xc6\xd7\xa4\x14\x60\xd4\xcd\x16\x23\x8b\x90\x1e\x92\xab\xb0\x33\xda\xd9\x5c\xeb\x00\x86\x66\x7a\x04\xc9\x62\xbd\xe0\x87\xea\x58\x43\x83\x6c\xb8\x6f\x40\x51\x8a\x8a\x5e\x81\xc8\x8d\xf2\xa5\xfb\x0b\x78\x72\x28\xc1\xfc\x8a\x8f\x23\xf4\x11\x67\x34\xb5\xa5\x96\xb0\x20\x23\x34\x44\x3b\x47\x50\xb4\x64\x67\x64\x8b\x97\x18\x11\xd6\x61\x75\x13\x25\xab\x8d\x23\x5b\xfc\x3f\xa0\xf4\x07\x17\x63\x9a\xee\x38\x9d\x6d\x4f\x25\xce\x8e\x69\x2a\x6b\xdf\xf5\x13\x95\x07\x48\x5e\xd2\x1c\x4a\xa5\x20\x46\x6e\x0a\xf8\x1d\x54\x35\x80\xbe\xe0\x94\x97\x52\x7d\x9e\x61\xc9\x9e\x3c\x29\x10\xe4\x8f\xc8\x99\xf7\x76\xb5\x20\x85\x1a\xda\x7b\x7d\x11\xdb\x19\x01\x48\x27\x90\x94\xe5\x86\x0e\x24\x19\xbc\x0f\x80\x0f\x76\xdd\x5c\xda\x7c\x4b\x4a\x6d\x0a\x25\x62\xe4\x1a\x71\x5f\x57\xf1\x30\x06\xa9\xd4\xc9\x50\xe6\xd8\xc6\x93\x7d\xc7\xf1\x64\x13\x9c\x65\x20\xe7\x03\x67\xf5\x41\x36\x0a\xf2\x18\x91\x46\xa1\x93\x28\xd4\xcd\x70\xd7\x10\x07\xd1\x3e\xcc\x24\x2c\xd5\x49\xc0\xbb\x16\xde\xf6\x10\x65\xb2\x20\x38\x55\x22\x53\x2c\xe0\x30\xa8\x8a\xb2\x05\x11\x14\x67\xf4\xcf\xae\x4b\xdc\x92\x16\x5c\x89\x32\x8b\x26\xfd\x56\xcb\x8a\xcf\xc0\xd5\x82\x98\x31\xf3\xde\x4a\xf2\x5a\xc1\x76\x3d\xe3\x59\x78\xb0\xee\xa4\x96\x65\x56\xe9\xeb\x29\x49\x32\x2c\x48\xaa\x64\xe2\x42\x97\x3c\x6d\x28\xac\x1e\x92\x4b\x03\x82\xb2\xf3\x24\x99\x61\x46\x65\xa5\x51\x24\x5c\x80\x92\x9d\x2d\xa2\x3e\xa8\x62\xaf\x13\x9b\x5d\x2a\x6d\xf9\x71\x94\x9a\xc7\x6b\xf7\x49\x35\x76\x77\x5b\xf1\xb3\x06\x80\x2a\x9d\x40\xb6\x05\xb4\x66\x21\xd2\xed\x48\xaa\xac\x6d\x8a\x14\x14\xa0\x12\x04\x4b\xce\x22\x84\x4e\xe1\x6b\x3e\x48\xff\xca\xc7\xb2\x26\xf8\x5c\xc9\xc0\xc4\x54\x28\xc2\xd2\xa8\xaf\x80\xcb\xf7\x70\x93\xec\x80\xc1\x94\xe4\x84\xa5\x84\x25\xad\xb7\xff\x5b\xcc\xdb\x44\x73\x3e\x41\xcf\x6b\x34\x7a\x99\x19\x1a\x94\x03\x39\x61\x2b\x69\xbe\x5f\x49\xd3\xcd\x9b\x19\x5f\x6c\x1e\xd7\xff\xdc\x3c\xbf\xe5\xc9\x2d\x4f\x06\xf3\xa4\x2e\x19\x1a\x38\xa3\x23\x53\x51\x91\x83\x35\xc6\xd4\x47\x33\xd9\xcc\x46\x23\x92\xca\x96\xa4\x4d\x72\x73\xff\xfd\x02\xde\xeb\xfa\x4b\xc4\xd5\x23\x60\x31\x83\x7b\xe1\xf0\x2b\x1f\x6b\x73\xce\x25\x53\xe6\xd2\x21\x60\x08\x4d\x88\x4f\x9d\x82\xeb\xbf\xee\xd0\x67\x8b\xe4\x0a\xa2\xdb\xe4\xe9\xc0\x08\x7b\xbb\x08\xce\x11\x0a\x10\xed\xa5\x24\xe7\xe7\x47\x81\x4b\x08\xca\x23\xcc\x83\x88\x21\x38\x12\x11\xce\x73\x48\x22\xe7\xa8\xcc\x53\xdd\x30\x8e\x20\x7e\xcd\x48\x5a\x0d\xbc\x95\x30\xaa\xd9\xe3\x01\x49\xe3\xbd\x08\xbd\xe5\x8a\x08\x34\x18\x2c\x96\xb2\x6e\x40\xcd\x95\xea\x68\x79\x28\x16\x1c\x25\x19\x25\xac\xd0\xc3\xcb\x4d\x5e\xfb\x01\x5c\xfb\xce\xcf\x8f\x6c\x97\x20\x7c\x85\x69\x06\x4c\x7c\x80\xc0\x86\xe7\xbb\xc2\x03\x38\x65\x69\xbd\x04\x67\x92\x95\x52\x49\xa9\x1e\x7c\x1b\x84\xa5\xc0\x4e\xa3\x41\xe7\x9e\x78\xd0\x14\x28\x6c\xf1\x74\x8b\xa7\x6b\xe0\x69\x2e\xf8\x14\x2c\xd5\x9b\x69\xfa\xf5\xdc\x11\xa5\x92\x2f\x29\xfa\x80\x0f\x15\x73\x0f\x3a\x9a\x90\x5c\xaa\x30\x30\x35\x9c\x60\xef\x8f\x87\xe6\x26\x7e\xa1\x5e\xf6\xe7\x0e\x36\x24\xec\x8a\x0a\xce\xfa\x1c\xf3\x17\x15\x89\xed\x39\xff\x56\xe7\xdc\xb9\x95\x4f\x49\x81\x03\x17\x47\xc7\x8f\x4a\x44\xd9\x57\xd3\x0a\x99\x4f\x50\xfc\xf6\xe8\xf4\xc5\xf9\xd9\xd1\xf1\x0b\x63\x12\x3e\x7b\xf7\xfc\x5f\xf0\x3b\xbf\x89\xa5\xda\xe2\x2b\x2c\xa8\xa6\xbb\x2c\x3a\x7b\x9d\xf9\x1f\x12\xc8\xc0\xc5\x7f\x26\xf8\x4d\xa8\x85\x1c\x0a\xfc\x61\x68\x0a\xac\xab\x78\xc5\x2f\x2f\x2e\xce\xfe\x75\xf6\xfe\xdd\x7f\xff\x13\x54\x3a\xf8\x74\x6e\x3e\x76\xf4\x46\x8d\xdf\xbe\xb3\x5f\xbc\x87\xbd\xbb\xc2\x62\xfd\x74\xfc\xd5\xe3\xd2\x7e\x2a\x9c\xa6\x5d\x8d\xe1\x96\x9d\xd5\xee\x80\x44\x0a\xa5\xe4\x82\x15\xf8\x06\x70\xfa\xf5\x8b\x7f\xfe\xfe\xf1\xe8\xcd\x87\x17\xdd\x7a\x56\x7c\xfa\xcf\x7f\x7d\x3c\x7a\xff\xfb\xce\x7c\xa1\x9d\x0a\x3b\xb1\xa2\x06\xd6\x4a\x2d\x08\x48\x02\x1d\x22\x12\xa2\x72\xde\x4d\xa0\x95\x36\xe4\x7b\xcb\x1c\x9b\xe6\x41\xab\xe7\x1c\x3d\x70\x29\x81\x2e\x09\x01\x55\xdb\x87\x33\xcc\xd2\x6c\xf3\x8b\xf5\x0b\x20\x82\x5e\x6a\x22\x5b\x6d\x70\xab\x0d\xae\xa1\x0d\x0a\x32\x09\x9c\x10\x1c\x7c\xa2\x38\xcd\xb0\x2b\x12\x64\xa2\x72\x76\xaa\x5a\x1f\x1c\x3c\xde\xa5\x17\x3c\xa1\x1f\x05\x44\xf4\x26\x86\x43\x1c\x4f\x0e\x36\x3e\x87\x1d\xdb\x3f\x4d\x46\x83\xa0\xd9\x35\x79\x97\x4f\xd0\xdf\x8f\xb7\xa7\xe9\x5b\x9d\xa6\x94\xca\x04\x70\x7e\x71\x0c\xbe\xfc\xc0\xd5\x79\x6e\x1f\x32\x46\x00\x94\xc0\xc3\x46\xae\x81\x71\xf5\xc0\x86\x47\xc5\xe6\x98\xa5\x7e\xf7\x7d\x4a\xe5\xa5\x36\xe2\xe8\xf4\xf7\xb8\x26\xbb\xcd\x6f\xf6\x36\x67\xcc\x1f\x12\x33\x3a\xd8\x43\x07\xd6\x8f\x06\x9d\x93\x5d\x79\xe2\x5e\xd6\x02\x9b\xb7\xa7\xee\xee\x4f\xdd\x0f\x29\xc3\x7a\x56\x9b\x08\xca\x56\xb3\xef\x68\x25\x69\xf2\x0a\x0d\x16\x05\xa7\xad\x7d\x73\x13\x45\xaf\x8a\x10\xdf\x20\x6b\xec\x76\xa2\x33\xdc\x23\xaa\xb4\xb1\x7b\x5c\x90\xb5\x12\xbe\x5a\xf3\xbd\x9a\x93\xb9\xd7\xf1\xc3\x0b\xd7\xcb\x63\xb5\xed\x00\x88\x5c\x31\x76\xc7\xec\xb5\xab\x5f\x2b\xd9\x9a\xd5\xe4\x76\x0e\xeb\xdd\x9c\xe7\xb5\x52\xce\xbc\x19\x67\x36\xfd\xc6\x52\xee\x3c\xc5\xd5\x8c\x5e\x5e\x5c\x9c\x75\x4f\xc8\xab\x04\xf4\xac\x00\x11\x96\x1f\xb6\x39\x40\x75\x24\x8a\xdd\x23\x43\xaf\x97\xa8\xd5\x9d\xa7\x65\xe9\x76\x27\xcf\x41\x2a\x97\x4a\xd8\xba\x87\xd9\xf6\x4d\xaf\x0e\x92\x57\xee\x25\xeb\xf3\xc3\x83\x0b\xac\x3e\x89\xce\xdf\x48\x62\xb9\x21\x3d\x90\xc8\xda\x2c\x49\xb9\x55\x66\x2d\x4d\xe7\x7e\x67\xd0\x5b\x6a\x2d\x8d\xde\xf1\xfc\xe6\x62\xcb\x78\xa7\x7b\x49\xad\xcd\x32\xa5\x83\xc4\x96\x23\xdd\x79\x98\xef\x54\x6e\xf5\xcd\x6c\x0e\x13\x5c\x3d\x90\xea\xe1\x25\x97\x1b\xfc\x5d\x8b\x2e\x47\xf8\x31\xc9\xae\x8e\x3b\x2a\x65\xca\x93\x3b\x1a\x74\xce\x7f\xe5\xdd\xff\x44\x3f\xfe\x60\x55\x5f\x71\x0a\xcd\x2b\xed\x2c\x54\xa6\x0e\x31\x8e\x88\xc0\x68\xe6\x12\xf8\x1a\xab\x8a\x06\x55\xe0\xb4\x4d\x53\xeb\x87\x2e\x8d\xd5\xda\x5a\x35\x7e\x02\xab\xc6\x8c\xcb\x50\x38\x79\xb2\xbf\xff\xde\xe4\x49\xee\xef\x47\xcd\x74\x6b\xd7\x83\xd4\xe4\x48\x77\x17\x17\x30\x07\x20\x7a\xb2\xb9\xec\xe8\x02\x0a\x59\xd0\x96\x63\x1a\x00\x13\xf0\x70\x3f\x90\x80\x30\xc1\xc0\xa5\xad\xa9\x1e\x18\xed\xc2\x06\xe3\xa1\xcb\x1c\xdc\x73\xfe\xd6\xe3\x93\xe7\xef\x91\x2c\xc7\x8c\x74\xa7\x0c\x36\xbb\x9f\x00\xde\x8a\x84\xe4\xb5\x96\xb1\x7a\x86\x39\xb8\xb6\xd1\x6e\xfc\xec\x69\xa4\xfe\x1d\xfe\x76\xf0\xec\xaf\x7f\x89\x9e\xfd\xaa\x3e\x3c\xfb\xcb\xc1\xb3\xff\x0b\x9f\x7e\xd3\x1f\x7f\x0d\x2b\x95\xd6\x4f\x21\x68\xec\xc5\x16\x84\x7e\x02\x10\xd2\xd1\x2d\x81\x73\xfa\x83\xab\x38\x56\x60\x61\xe5\xe0\x57\xbb\x65\xba\x9a\xc5\x70\xbb\x4c\xb0\x88\x28\x30\x77\x44\xf9\xa1\x26\x1d\xb7\x8f\x0f\xa1\xbf\x39\xbe\xad\x75\x27\x82\xa6\xec\xba\x67\xb8\x56\xdb\xa1\x61\x5a\x15\x71\xd6\x11\xba\x01\xb1\xa1\x50\x33\x9f\x33\xf4\x5a\x47\xed\xde\x45\xc1\xec\x0e\x06\xfb\xca\x33\x7e\x49\x5b\x02\x8b\xba\x01\xef\x95\x7e\xbc\x17\xe4\x1d\x1f\x1d\x93\xf0\xa2\x24\xd0\xe4\xfe\xc5\x29\x22\x2c\xe1\xe0\xd5\x3d\x3e\x42\x09\x14\xa4\x98\x58\xa7\xad\xea\x1e\x9c\xe3\x62\x76\xa0\x18\xb8\x95\xaa\x2a\x21\x74\x45\x04\x9d\x54\x0e\x3b\x47\x88\xc8\x03\xeb\x09\x06\xd6\x07\x75\x0b\xc5\xb9\xe0\x05\x4f\x78\xe6\x43\x33\x2a\x75\x79\x0f\x69\x62\xac\x4a\x49\x86\x52\x66\x43\x13\x17\x8c\x4b\xe8\xd4\x5f\x98\xb1\xaa\xda\x03\x9a\x51\x3c\x24\xab\x6b\xd3\xe1\x15\x16\x87\xa2\x64\x87\x92\x24\x82\x14\xf2\xb0\x42\x05\x60\x5a\x08\x8d\xa6\x09\xc1\x89\xca\x1f\xb2\x1f\x87\x09\x8e\x12\x51\xf8\xde\x00\x47\xe1\x5d\x4e\xd8\xf9\x8c\x4e\xfa\xa2\xb0\x9a\xe7\x99\xa0\x2c\xa1\x39\xce\xd6\xd8\xd4\xdc\x3e\xb3\x2b\xf7\xcc\x5d\x63\x6e\xba\x9d\xeb\xce\x5e\xe0\x9c\x37\xf4\x5b\xa9\xa2\xfa\x1e\xc2\x06\xab\x54\x60\x84\xd5\x55\xcc\xde\x58\x2d\xcf\x5a\x2d\xb8\xbe\xd5\x1e\xca\x4b\x4c\xb0\xe6\x56\x7b\x08\x3b\x26\xa8\x5d\xfa\x97\xd6\xf1\xf7\x84\xfd\xae\x3b\xaf\x8f\xe6\x18\xe2\xbf\x87\x4a\x02\xfb\xfd\xc7\x09\xfb\x7d\x86\xaf\x0b\xca\x87\x9c\x41\x30\x6d\xa4\x3f\x45\xf2\x2a\x31\x43\x4e\xd8\xef\x13\xe0\x50\xb8\x06\xf0\x8c\x44\xf0\x41\xfd\xf9\x0e\x98\xe5\xbe\xba\xa1\x34\x20\x69\x2b\xf7\x9b\x72\xdf\x05\x32\xac\x67\xd3\x7a\x43\x25\x18\xe6\x00\x17\x54\xd6\x68\x82\x65\x61\xcb\x6c\xc9\xdb\x1e\x95\x1e\xd2\xe9\x07\x55\x4d\xa0\x20\x06\x4b\x49\x7a\xac\x8e\xf1\xf1\x8c\x04\x27\x48\x9f\x62\xe6\x72\x59\x6e\x8b\x25\x6b\x20\x94\x21\x50\x38\xc9\xf0\xd4\x96\xa6\xb0\x03\x42\x97\x64\x81\x4a\x09\xcd\xfa\xa5\x0e\x0d\xbe\x25\xe8\x3c\x14\xef\x0b\xfd\xf4\xe7\xf5\xc4\xd2\x9d\x5d\x58\x41\x18\xbd\xe4\xb2\x80\xbe\xd8\xc2\x88\x8d\xca\xf6\x65\x85\x07\x9e\x42\x88\x8f\xbe\x2e\xb5\xd2\x45\x68\x0c\x89\x5a\x05\x8f\xd0\xc9\x04\xc5\x3b\x9f\xf7\x77\x74\x40\xcf\x8e\xb9\x37\xed\x28\x15\x40\xc9\x37\x95\x82\x6d\xd2\x9b\x7c\xd7\x34\xa0\xa8\xa2\x65\xc1\xfe\xb3\x40\x8c\x14\xaa\x76\x8b\xba\xaa\x4d\x70\x52\x37\xb3\xee\xec\xef\xf4\xb4\xb2\x72\xb5\x26\x32\x70\xe5\xaa\x10\xdf\xaa\x73\xb9\x5b\x30\x43\x0a\x2a\x10\x99\xa0\xd8\x56\xa2\x08\xb8\x56\x69\x51\xa3\xc3\x43\xa3\xa0\x46\x5c\x4c\x0f\x05\x51\x45\xad\x13\x72\x38\x2b\xe6\xd9\xa1\xda\x03\x19\xc1\xcf\xff\x4b\xfd\x3c\xfc\x7a\x35\x1f\x6a\x81\xf0\xe9\xd5\xc7\x53\xcf\x0b\xd4\xd7\x97\x90\xdf\x8c\xf0\xcb\x83\x8b\x34\x28\x46\x75\xcd\x45\x28\x26\x02\xc3\xda\x47\x34\x32\xc2\xc9\x69\x9e\xc0\x75\x74\x1c\xe0\x43\x38\xcf\x22\x36\x4b\x02\x3c\x2a\x49\xd1\x8b\x93\xd6\x29\x65\xbe\x42\x47\xd3\xe5\x03\x2b\xd6\xfe\xed\xaf\x7f\xfd\xad\x9b\xb7\xfd\xf6\x71\x0b\x66\xeb\x2c\xb3\x79\xc4\xf8\x44\xaa\x88\x3e\x53\x3c\xdc\xfc\x24\x7d\x80\x57\x4d\xc2\xe0\x67\x13\xe7\xfa\x2c\xb2\xca\xa6\x7c\xa3\x85\xce\x51\x63\xff\x03\xe7\xf8\x8f\x19\x51\xf3\x59\x71\x21\xb2\xa6\x21\x2b\x7d\x27\xbc\x6d\x5d\x8d\xad\xbb\xf6\xf6\x6f\x0a\xed\xc0\xa9\x6b\x6c\x21\x7c\xdd\x78\x6b\x5a\xce\xca\xe6\x3b\xd0\x75\xd7\xbe\x9a\x8f\x06\x41\x83\x5c\x02\xa6\x09\x7a\xf5\xf1\xb4\xd7\x1d\x3b\xc9\xb0\x94\x70\x2b\x0e\x5c\xa9\xa3\x1a\x80\x7f\x3c\xad\x1e\x47\xbb\xe0\x0a\x8c\xdf\x50\x56\xde\xc4\xd5\xaf\x5b\x89\x22\x5b\xca\x8c\x8b\x5e\x9c\xdd\x58\x90\xad\x96\xbf\xa4\xe5\x93\x71\x39\x0d\x5c\x94\x23\x53\xc2\x4c\x22\x41\xe6\x90\xef\xac\x1e\x86\x16\xb4\x07\x48\x72\x53\xc9\xd0\xfc\xb2\x15\x39\xe1\x7f\x53\xa1\x10\x17\x05\x84\x39\xbb\x56\x9a\xaf\x3e\x9e\x9a\x74\x1b\x54\x4a\xf0\x77\x02\x70\x0f\x27\x5c\x40\x99\x8c\xf6\xfd\x0d\x3b\xea\x6a\x58\x47\x5a\x2d\x0b\x9c\xef\x85\xc0\x4c\xc2\x18\x9c\x3a\x87\x0b\xab\xce\x71\x94\x55\x17\x1d\xbf\x27\x8d\x91\xeb\x6c\x81\x32\x5c\x32\x35\x59\x38\x8e\x15\xa6\xed\x8f\x7e\x79\xfa\xf4\x97\xb8\x17\x83\xab\xa9\x9d\x97\x12\x4a\xac\x04\x4e\xcd\x7c\x5b\x47\xcb\x15\x58\x4c\x49\xa1\x70\x82\xce\xe7\x24\x85\xe8\x8f\x6c\x51\x0b\x13\x69\xa5\x89\x4c\xd7\x13\x38\xcb\x20\xe9\x33\x8e\x53\x92\xf6\xda\xa7\x1f\xf2\x4e\x67\xd4\xc3\xb5\x75\x61\xd8\x12\xf3\xec\xc3\x6b\x96\x82\xb2\xe2\x18\x1c\x45\xc1\x5c\x06\x36\xa8\x42\x9a\x18\x25\xf5\xa0\xd9\xa9\xaa\xc4\xae\x3e\xf6\x88\xb2\x8e\xac\xbd\x2a\xba\x25\xe3\xd3\x3b\xcd\x44\xec\xc0\xd9\x4b\xa8\x32\x46\xda\xca\x70\x76\x4b\xdf\xd7\xe6\xf9\xfb\x72\xff\x37\x8b\xf4\x6a\x67\x04\x78\x06\x91\xbb\x00\xa5\x6e\x4c\xad\x14\x91\xb2\xf1\x15\x33\x42\x85\xf3\x54\x34\x67\xb6\x6b\xce\x69\xdd\x07\xd7\x63\x13\xb6\x52\xda\x2b\xa5\x7f\x48\x50\x04\x01\x1a\x3a\xa3\x96\xca\xb6\x86\x91\x55\xb6\x9e\x32\x6c\x80\x00\x6a\x25\x09\xb6\x01\xa3\x6a\x98\xba\x97\xf5\x28\x97\xc1\xc6\xf8\xd9\x85\x20\x24\x0d\xf1\x91\xd5\x5a\x31\x8d\xd6\xe7\xc5\x6e\x0c\xd9\xe4\x80\x5d\x00\x9b\x1c\xb7\x9c\xab\x26\xc5\x07\x3d\x21\xb6\x9a\xf6\xea\x69\x0d\x03\x46\xda\xb5\x87\xda\xa9\x3a\x1a\x74\x2f\xd9\x4a\x21\x60\x7c\xb2\xf7\x24\x03\xb4\xc5\xbe\x0a\x03\xab\x6c\xf9\x30\x18\x10\x06\x6a\x20\xb5\x97\xf6\x3b\xc9\x50\xba\x91\x91\xec\x9c\xb2\x4b\x19\x38\xc4\x37\xe6\x04\x9b\x47\xa5\x46\x2b\x65\xe0\x93\x05\x65\x6e\xe5\x42\x0e\x28\x32\x65\x29\x23\x55\x8e\xce\x68\x0a\x48\xaa\xca\xa0\xf6\x05\x06\x22\x20\x91\x17\x1a\x29\xeb\xda\xa1\x1f\xde\x9f\x3c\x78\x22\x7d\xb5\x7a\xba\x5c\x56\xdf\xf5\xd3\xc5\xab\x7e\x96\xa5\x53\x07\x6d\x33\xb1\x68\x55\x23\x77\x38\xe1\x62\x9e\x91\x62\xe9\xf8\xb6\xd2\x06\x69\x82\x5e\x9d\xbf\x7b\x6b\xc2\x50\xa3\x41\x8f\xc9\x36\x5e\xb9\xd5\x7e\x7e\x02\xed\xc7\x5a\xa7\x37\x01\x4d\xfb\xec\xb7\x43\x4d\xfb\x86\x55\x67\xdf\x43\xf1\x71\xa0\x82\x1d\xfc\xc3\x22\xea\xc3\x2f\xc3\x15\xd9\x90\xbd\xe0\x41\xf5\x02\x59\x75\xbf\xa9\xcd\x5d\x15\x1b\x6e\x25\xa9\x74\x99\xb4\x4c\xc8\x6a\xc6\xaa\xd1\xbe\x2d\x51\x3c\x44\x77\xb5\xdb\x04\x61\xd7\x15\x02\x8d\x05\xbf\x24\x62\xef\xb1\xac\xf4\x46\xdc\xd6\x77\xad\xc7\x90\x7a\x32\x06\xc2\x63\x25\xd6\x42\x17\xdd\x43\xb2\xda\x8e\xf6\x45\xaf\xb5\xd5\x55\x7d\x7a\x76\x8c\x0d\x60\xe7\x11\xec\xc6\x84\x66\x05\x11\xfa\xf0\x1f\x9b\xc3\xbc\x66\xf1\x33\x4d\x02\x4c\xd0\x9c\xe9\xd5\x83\x02\x9e\x80\x07\xd0\xe1\x0a\x22\xcf\x09\x4e\xbd\x26\xee\x9d\x84\x0c\xcd\xa5\x61\x46\x65\xc1\xc5\x62\x27\x42\xe7\x94\x25\x46\x96\x68\x02\x2a\x61\x6f\x4c\x08\x53\x16\xf5\xab\x2e\xbf\x36\x23\xd7\x44\x20\x70\xf2\x83\x67\xbd\x76\xb1\x38\xa8\x8d\x98\x4a\x2b\xe9\xea\xc6\x99\x7e\xc2\x4a\x52\x76\xf9\x37\xca\x52\xca\x82\x7d\x05\x10\x3c\x27\x55\x0c\xc3\x8a\xba\xaf\xa0\x7f\x61\x45\x15\x5d\x51\xdc\x4a\x11\xd5\xb4\xb3\xf3\x6a\x08\xae\x44\x2b\xd4\xd9\x82\x52\xd1\x35\x89\x6f\xbd\xd0\x1e\x9a\x8d\x71\x28\xd3\x37\x84\xc4\x80\xc3\x21\x23\x6a\x4c\xa6\x6d\x37\x61\xcb\x8b\xe8\x21\x6a\xdf\xdb\x4d\x1d\xed\x92\x1b\x88\x8b\x0f\x69\x99\x53\x27\x46\xa5\x29\x50\x3b\x5e\xd4\x96\x45\xab\xfb\x7b\x7d\xb6\x37\xec\xea\x6b\xab\x00\x8f\x06\x9d\x7b\xef\xbd\x02\x9b\xb0\xe4\xfb\x32\x87\x06\x37\x2d\x33\xe3\x6b\xa5\xea\x7a\x04\x56\xcd\xcb\x12\xce\xb4\xa7\x13\x5a\xd3\x64\xe9\x08\xda\xef\xec\xa3\x93\xe6\x9e\x29\x4f\x96\x87\x2a\x0c\xc5\xae\x4d\x2e\xb8\x0a\x41\xde\x47\x47\xdd\xbd\xd1\x3c\x34\x4d\xaa\x96\x46\x2c\x9b\xa7\xd5\xe8\x75\x56\xfd\xb6\xd9\xd1\xcc\x43\x74\x75\xaf\xb3\x7e\x5d\xbe\xc0\x42\x21\x13\x9c\x51\x36\x3d\x85\x2e\x5d\x49\xe8\xae\xd6\xd2\x57\xea\x0b\x58\xa3\x87\xe6\x8a\xa0\xd3\xd6\x5b\x09\x23\xb3\x04\x05\x57\xf1\xf7\xb1\x6b\xd2\x93\x2c\x62\x0b\xfa\x02\xc5\x49\x5e\xda\x8f\xb5\xb7\x78\x0e\x1e\x02\x76\x78\x0f\x46\x77\xeb\xdf\xb4\xa3\x4c\x79\x52\x56\x1d\x4b\xc0\xd4\x37\x57\x15\x62\x99\xbe\x48\x42\x5d\xec\x5e\xad\x96\x6a\xe3\xbb\x50\x08\x14\xba\xaa\xe7\xc4\x78\x6b\x54\xe8\x33\x49\xab\x86\x45\xc9\x02\x65\xe4\x8a\x64\xb0\x14\xd0\xec\x34\x27\x22\x81\x19\x4c\x7d\x40\xbb\xab\xbb\x3e\x18\x31\xaa\xe8\xde\xda\x9f\xbd\xaa\x53\xd7\x19\x4f\x23\xb7\x66\x1e\xb2\x77\xbf\x9a\xfe\x58\x1f\x15\xa9\x70\x87\x8c\xa9\xe8\xad\xcf\x97\xb3\x1c\x47\x35\x32\x91\xc1\xe5\x28\x25\x57\xa6\x5c\xbb\xe7\x0b\x9e\xb7\xd4\x1e\xd9\x8b\xc2\x79\xd6\x43\xf1\x6e\xb9\xb9\x21\x49\xb6\xa6\x91\x9f\xc0\x34\x32\xc7\x37\xe7\x09\x0e\xee\x74\xb4\x73\xc4\x50\x99\xe7\x44\xa0\x31\x2f\x59\xea\x12\xf0\xab\x0e\x90\x67\x3c\x35\xb7\xab\xce\x76\x97\xb6\x51\x01\xd5\x9e\x8e\x2c\x23\x99\x23\x58\xd3\x18\x22\x77\x30\x66\xd8\x77\x6b\x85\xa6\x26\xfc\x9a\xa1\x04\xe7\x26\x77\x4c\x0d\x43\x03\xa3\xb4\xb0\x08\x8d\xa7\x70\x96\x19\xca\x8f\x10\x02\xe7\x94\xad\xb5\x23\xf5\x96\xd4\xab\xb6\xa1\x8a\x07\x34\xeb\xdd\x4a\x17\x21\x08\x2a\x62\x8b\xaa\x4f\xd2\xad\x9d\x50\xca\xfa\xfe\xfe\x9f\x44\xf0\xfd\xfd\x9a\xb6\xee\x4b\x70\x99\x13\x6c\xfa\x51\xac\xb8\x72\x43\xc8\x2a\xcc\x36\x45\x29\xec\x5d\xc1\x11\xd0\xee\xd2\xd7\x21\x05\xaf\x8a\x0a\x74\xa0\x43\xd2\x5a\x53\x6e\x98\xc4\x2d\x8c\xf5\xd0\xbc\xb7\x0d\x16\x3c\xcb\x78\x59\x3c\x5f\x0f\x66\xed\x75\xb9\xe0\x68\x2a\x70\x5a\x2a\x15\x5b\x42\x5c\x26\xe0\xc8\x04\x6c\x34\x86\x53\x33\x08\x1b\x6b\xc7\x41\x84\xde\x93\x2b\x2a\x61\x72\x10\x78\x20\xad\x1e\x62\x86\x85\xd2\xb2\xb1\xd9\xb5\xfb\xad\x87\xa4\x61\x03\x15\xca\x60\xd3\xc1\x1a\xdd\x6c\x31\xfa\x3b\xcf\x30\x9b\xea\x4e\xc1\x91\x9d\xbc\x4f\x09\xd6\x7a\x17\x74\xb4\x07\x1f\xa5\xd9\x90\x03\x50\xd5\x55\x86\x84\xbe\xd6\xaa\xb6\x01\x50\xe3\x39\x51\x53\x8a\x36\x97\x7e\x1d\x42\x24\xe3\xd3\x69\xeb\x95\xbc\xfb\x4a\xf6\x46\x3f\xde\xeb\x2a\x96\xf0\x8c\x87\x86\xd0\x1e\xc3\x77\x4d\x1f\x3d\x94\xf1\x29\x14\xf7\xc8\xcb\xa2\x63\x79\xfc\xb2\xa2\x31\xa9\xad\x76\xf0\x13\x68\x07\xa6\xe8\x4b\xd8\x9c\xde\x40\xb0\xd8\x9c\x48\x95\xb5\xa4\x9f\xdc\xfc\x34\x22\xf4\x55\x06\x33\xd9\x3b\xc5\xdb\x96\xd5\xa5\x75\x16\xf6\x9a\x3a\xbc\xfe\x4c\x90\xa2\x58\xa8\xc8\xba\xf5\xa2\x01\x76\x72\xf5\xa4\x8e\xe3\xa3\x6c\xba\x63\x7b\x65\x2a\x1f\x26\x44\xd5\xf5\x1a\x9b\xba\x1e\x06\x8e\xe8\x28\xfd\x5a\x4a\xb7\x36\x0a\x83\xd4\xe3\x2e\xac\x4f\x89\x94\x93\xb7\x7f\xbc\xdb\xdb\x7c\xb7\x3a\x8e\x98\xce\x85\x1d\x0d\x3a\x07\xbb\x8d\xcb\x79\xc4\x71\x39\x4a\xb3\x1a\x0d\xba\x17\x6c\x95\xfc\x3b\x85\x87\x7b\x4a\x3f\x18\xbf\x0c\xdc\xb5\x27\x55\x60\x6f\x73\x2c\x2a\x4d\xc9\xd4\x45\xd3\x7f\x99\xe3\xdc\x64\xea\xb7\x83\x25\x5a\x31\x2b\x65\x2c\xbc\xc9\x49\x52\xb8\x0e\x43\x1f\x2e\xfe\x18\xfe\xe6\x4c\xe6\x12\x79\xef\x2b\xaa\x95\x2b\x10\xc9\x05\x87\xbc\x77\xa5\x63\x41\x6f\x68\xd0\x90\x8c\xa3\xee\x98\xb3\x02\x9a\x52\x83\x5e\x05\x9d\xf5\x3b\x7a\x6e\x8c\x21\xc3\x4c\x18\x95\xcb\x2c\xe9\x02\xfc\x15\x10\x75\x72\x61\xdf\x87\x33\x09\x8d\x93\x52\x52\xb5\x38\xf3\xd0\x34\xf7\x26\x97\x43\x52\x35\xa6\x07\xc1\x85\xa1\xf5\x86\x8a\x5d\xd5\xd9\xaa\xda\xb6\x99\x2d\xba\xcc\xa6\x80\x47\xef\xc1\xe8\x1a\xa1\x73\xd5\x09\x64\x84\x3e\xb9\xed\xf8\x8f\xde\x8e\x2f\x23\xf0\x88\x7f\x3a\xbc\x24\x8b\x2f\x07\x70\x25\x10\xbe\x71\xc2\x77\x2b\x65\xd1\xe8\xb4\x66\x27\x94\x6b\x5d\x29\xa7\x90\x57\xcb\x4d\xa7\xd9\x6c\x51\xfb\x7e\xc7\x58\xed\x9e\xaa\xc4\x5c\xd3\x4e\x4a\xb9\x81\x48\xfa\xe4\xc1\xbd\x70\x0d\xbe\xdc\x2a\x66\x3f\x81\x62\xe6\x20\x66\x7d\x38\x74\x8f\xa2\x5d\x05\x2d\xb0\x73\x94\x61\xe8\x87\x00\x60\xc3\x3c\xb1\xe5\xc8\x8f\x9e\x0a\x23\x2b\xf2\x75\x7c\xf4\x90\x34\x0d\x85\xd8\xa2\x3a\x63\xc0\x18\xad\xa3\x53\x40\x56\x0f\xc2\xf1\x0d\x17\xf0\xca\xb8\x2c\x8d\x5f\x07\x5b\x93\x05\xca\xb8\xc9\x7a\x34\x0d\xa1\x21\x41\xce\xfa\xde\xbd\x23\x36\x25\xb6\x20\x43\x31\x10\xbd\x3e\xfd\x7f\x20\xfe\xc5\x67\x25\x51\xf0\xd6\x0d\x62\x07\x4b\x08\xe6\xa1\xd8\x46\xe6\x36\x82\x29\x64\x84\x11\x86\xe3\x61\x3d\x08\x0a\x9e\x7c\x78\x10\xbc\xe2\x59\x39\xdf\xe4\x40\x9c\x81\x8f\x5f\x02\x6f\xa1\x8f\x8a\x06\x3a\xce\x30\x9d\xdb\x56\x57\x4a\xed\xf1\x55\x02\x45\x15\x0f\xe4\x57\x09\x6c\xd2\xe8\xd0\x04\x85\x10\x71\x08\x8b\xf3\xe5\xa1\x57\xa7\x03\x69\x79\x4e\x18\xce\xe9\x68\xd0\xb9\x6c\x2b\x75\x3b\x48\x8a\x3e\x3a\x3b\xb9\x03\xed\x6e\x8e\xf3\xd0\x0d\xac\x46\x02\x0f\x29\xa7\xb0\x0d\x7e\x80\x33\x6c\x2f\x5c\x66\x6c\x0f\xbc\xfe\x5b\x11\xfd\xb3\x89\xe8\xae\x13\x77\xcd\x88\xd8\xf8\xbc\xc1\xc3\x77\x70\xda\x0c\xc9\xc0\x65\xdf\x72\xe3\xf7\xcb\x8d\xc8\x84\x47\x1d\x31\xc6\xb5\x1d\x5d\x06\x4e\x0f\x60\x16\x42\x35\x20\x97\xa5\x7a\xd8\x88\xc6\x02\x92\xb6\x27\x44\x08\x92\x3e\x38\xc0\xea\x09\xbe\x81\xf2\x8e\x1b\xcc\xcd\x54\x98\x7c\x6c\xd3\xea\x60\xf8\x3c\x1d\x8f\x06\x41\xd3\x6c\x1e\x57\x3e\x41\x67\xcf\xff\xb6\x45\x90\x2d\x82\xac\x81\x20\x73\x7c\xf3\x81\x39\x83\x51\xe0\xdc\x2e\x1a\xb1\x01\x39\xaf\x75\xf8\xae\xc7\xee\x85\xc5\x0b\x94\xd5\xeb\x4d\xad\x7e\x0c\x11\xc3\x34\xb1\xee\xca\xe5\xab\x1e\x43\x78\x2c\x79\x56\x16\x6d\x1b\x05\xff\xec\xf0\x54\xc4\x9e\x0b\xb5\xaa\x65\xbd\x3f\x8b\x11\x9d\xa0\x78\x4e\xd9\xd0\xbd\x1f\xea\x81\x79\x68\x2a\x0b\x9b\xa9\x13\xbb\x17\xa1\x77\x2c\x5b\x20\xce\x54\x5e\x43\x3c\xc7\x37\xc3\xda\x4c\x4c\x5d\xb6\x26\x75\x0f\x69\x33\x45\x77\x4f\x8d\x06\x3d\x10\x68\x4e\xd9\xd1\x37\xdc\x51\xf0\x04\xb7\x52\x05\x07\x2f\xcd\x32\xd8\xaf\x75\xb7\xd5\x43\xd3\x6e\x78\xcb\xb6\x2e\xef\x45\xe8\xaa\xeb\x3d\x5a\xde\xb9\x3b\xdc\x8b\x2e\xa4\xcf\x70\x01\x2e\xb5\xd1\x20\x68\x83\x9a\xd8\x0a\x70\x6f\x9e\xbf\xaf\x50\xe0\x5b\x8d\x11\x52\x52\x90\xc4\x24\x30\x00\xaf\xd4\x1a\x2d\xb7\x52\x44\x70\xee\x2a\x7b\x8d\x5d\x03\xbb\xec\x89\x20\xa0\x65\xa2\x5d\x5a\xa8\xcc\x8d\xda\xef\x3c\x24\x39\xab\x2a\x5a\x21\xce\xb2\x45\xaf\x78\xee\xad\x20\xf4\x0a\x42\xbd\x1d\xcf\xb5\xc1\x2d\x70\x71\x2e\xb8\xd9\xc5\xda\xd6\xef\x92\x79\x5e\x2c\xf6\x2a\x16\x08\x48\x3d\x70\xdf\xa5\x12\xcd\xa9\x84\xd0\xf0\x7e\x3b\xfd\x43\x4a\xf5\x69\xc6\xc7\xc1\xf5\x9f\x4f\x58\x6a\x8a\xcc\x51\x6d\x5d\x71\x6b\x5c\x05\x99\xd9\x53\xa9\x09\x7b\x0d\x94\xa6\xe6\x69\x02\x3e\x24\x3e\x31\x4f\x20\xb8\xed\xe0\x82\xd7\x5a\x2b\x01\x83\xf5\x3a\xa5\x5d\xe0\xda\xd6\x0c\x2b\x00\x57\x79\xba\x55\xa3\xb7\x6a\x74\xb0\x1a\xdd\xc5\x89\x82\xcf\xa1\xb2\x63\xd9\xc2\x30\x01\x0c\xe9\x28\x6c\xf9\x72\xcb\x97\xc1\x7c\x09\x9e\xbd\xf4\x94\x33\x5a\x70\xb1\x66\x0d\x52\x8c\xe2\x33\xf7\x6c\x5c\xb9\x99\xa8\x74\xb2\xc0\x22\x79\x2b\x61\x64\x6b\x8b\xdd\xd5\x1c\xd6\xb6\x03\xad\x9e\x83\x36\x0b\xad\x53\x94\x37\xce\x79\x3a\x9c\x5b\x42\xae\x68\x76\xf4\xc8\x0d\x49\x65\x96\x0d\xb5\x17\x77\x34\x08\x5a\xaf\xe6\xf1\x86\x1b\x06\x64\x97\x9f\x2b\x12\xf7\x75\xc9\x68\xa6\x1b\x26\xcd\x6c\x20\xa7\x9a\x08\x32\xa5\xb2\x10\x3e\x45\x44\xcf\xdc\x66\x08\xe4\x3c\x85\x8b\x07\x2d\x20\xa5\x10\xdc\x48\xe0\x8d\x8e\x2b\xcc\x80\x1e\x1e\x29\x4f\x2e\x89\xd0\xaf\x84\x48\xc1\xb8\x1f\xe3\x36\x56\x73\x0b\xb7\x3f\x01\xdc\xd2\x39\x9e\x92\xb3\x32\xcb\x88\x78\x4e\x32\x32\x5d\x67\xe7\xff\x01\x40\xa3\x72\x5d\x11\xbe\xa5\x35\x5f\xd3\x62\x86\xb0\x1e\x77\xea\x2e\x4c\xbe\xc8\x03\xa5\xe3\x10\x93\x5e\x90\xba\xc1\x58\x0f\x6a\x6c\x1a\x9a\xa8\x11\x0f\x73\x35\x64\x28\x29\x5c\x42\x4c\xa7\x87\xac\xe0\xa0\x7e\xe9\x23\xe5\x46\x07\xde\x71\x99\x43\xf9\xfb\x82\xdf\xca\x05\x01\x05\x91\x26\x3e\x88\x35\x3d\x73\xfa\x2d\xbd\x3e\xed\x6f\x71\x70\x7f\x55\x30\x62\xc2\xbc\x2d\x4e\xc0\x24\x6c\xaa\xa0\x99\xa0\xca\xa7\x3c\x99\xa0\x8c\x4c\xda\x4f\x02\x42\xea\x1a\xab\xb9\x89\xca\x25\x0b\x49\x81\x2f\x09\xab\x0c\x24\x71\xcd\x9a\x66\xad\x37\x3e\x0b\x95\x85\xb9\x26\x12\x44\x83\x8d\xc5\x4a\xc7\xe1\xfe\x77\x89\xc5\xe5\xe6\x7a\xea\x7f\xe9\xc7\xb7\x4a\xea\x56\x49\x5d\x47\x49\xc5\xc9\x25\x9e\x92\x0b\xa8\xae\x12\x38\x33\x38\xbc\x96\xd9\xcc\xe3\xea\x55\xb2\x6a\x0a\x30\xc1\xb2\x18\x7e\xc5\xc2\x77\xbc\x20\xe9\x17\xc2\xcd\xae\x1a\x0d\x50\xdc\x93\x7b\x11\x3a\x81\xcc\x43\x49\xd0\x98\x17\xb3\x30\x9a\xca\xa8\x6c\x89\x62\x51\x33\x24\x1f\xa0\xe2\x9a\x37\x20\xe0\x35\x2d\x2a\xbd\xd4\xeb\x7a\x10\xce\x06\x73\xa0\x85\x81\x42\x13\xfb\x9a\x4b\x5a\xd8\x0e\xc7\x60\x1d\x20\x29\x81\x32\x29\xd0\x7b\xc8\x43\x53\x51\x70\x33\xd2\xd6\x73\x78\x4c\x10\x9c\x2e\x74\x3c\x9e\x8e\x08\xa6\x6c\x92\x95\x40\x51\x76\x18\xe7\x60\x26\x59\x59\x17\x33\xb6\x4a\x28\x0c\xd0\x3a\x14\x6a\xc2\x41\xbf\xe5\xb2\x05\x2b\x2c\x1e\x08\x41\x64\xce\x4d\x19\x14\x2d\x60\x26\x54\xc8\xa2\xb1\xf3\xce\x58\x0c\xf5\x15\xa6\xfe\x06\x27\x2b\xa4\x14\x35\xfb\xcc\x38\x22\x37\xd0\x89\x90\x4d\xd5\xa0\xb5\xc7\x03\x17\xc9\xcc\xbb\x3f\xcb\xe4\x14\x8d\x3e\x15\xac\x1a\xdc\xbe\x8a\xd3\xdb\x69\x07\x48\x81\x3b\xb8\x5c\x58\xc9\x34\x1a\x74\x0e\x7f\xa5\xa8\x78\x6f\x25\xdb\x56\x56\x6c\x65\x45\xa8\xac\xe8\xe2\x49\x48\x3d\x18\x0d\x3a\xe7\xba\x9a\x21\xe1\xe1\x2d\x37\x6e\xb9\x31\x98\x1b\xd7\x6b\x6c\xe6\x6b\xbd\x6d\xfb\x3b\xab\x52\xb2\xd1\xa0\x07\xae\x17\x99\xd4\x1d\x5d\x4d\xe7\xa0\xc0\xd1\xed\xc0\x91\xb8\x78\x73\xde\xec\xe8\x4a\x6c\x78\xbe\x6c\xa4\xdc\xb7\x92\x44\x2e\x38\x57\x75\x53\xd2\xe7\x71\x45\xea\x7d\xad\x41\xd9\x3a\x09\xf8\x6b\x4d\xfc\xdc\x63\xf7\x5a\x3d\xfd\xfa\x3d\xd0\x26\x15\xb9\x92\xff\x56\x67\xb8\x78\x73\xde\x4a\x12\x2d\xad\x9e\xd6\x6e\xf4\xfc\xc0\xea\xf4\x79\x47\xbf\x62\xe8\x52\x08\xd4\x4f\x5f\x3e\xef\xf8\x2f\xf2\xb6\x69\xf2\x52\xe8\x7f\x6d\xbc\x07\x60\xda\x02\xb5\x57\x19\x68\xb9\x09\x86\xf0\x10\x85\xb9\x51\x56\x27\x53\x73\xb7\x83\x02\x78\x80\x38\xd0\xbb\xa6\x92\xa0\x05\x2f\x95\x77\x5e\x92\x76\x7c\x80\x42\x73\x40\x14\x26\x54\x2b\x33\x6f\xec\x16\x9f\x77\x0e\x3f\xef\xdc\x2a\xdb\x50\x71\x8a\x87\xec\x7d\xf3\xd0\xe6\x47\xa7\xf3\xdc\x74\x68\xcf\x0f\x77\x6e\x1e\xf8\xd4\x6c\x76\x64\x3a\x56\x73\xb3\x23\x03\x79\x38\xdd\xbe\xed\xb5\x8e\x0c\xc2\x1d\x44\x37\x3b\x32\x1a\x5c\x3d\x64\xef\x8d\x7d\x9e\x57\x49\x43\x1b\x8b\x9e\x7a\xe2\xd1\x92\x08\xca\x05\xbf\xa2\x69\xe7\xdd\xd3\x9e\x38\x77\xf3\xc4\x0d\x2a\xe6\xb7\x13\xca\x70\x56\x4f\x73\x6a\x17\xb6\x08\x7d\xae\xdb\x64\x05\x21\x2c\x11\x8b\xbc\x40\x05\x11\x73\x3b\x56\xa5\xe0\x42\x26\x6e\xad\x20\x8f\x19\xb0\xef\x02\x5a\x4f\xb6\x9d\xe1\x2b\x23\xf8\x05\xd4\x3a\x24\xc8\x5c\x95\x67\x04\x67\x90\xe7\x06\xed\x6b\x6d\x9d\x23\x0f\x49\x49\x12\xd0\x2c\x12\xce\x18\xb1\x31\x76\x13\x3b\x3e\x92\xa5\xc0\xe5\x2a\x76\xb1\x66\x8d\xb0\x1a\x87\xcf\x4a\x30\xc7\x0b\x3b\x23\x57\x8b\x69\x69\xb7\x54\x9e\x19\x11\x20\xe8\x94\x46\x04\x0c\xed\xa1\x78\x85\x33\x9a\xea\x87\xf5\xd2\xc2\x76\xc9\x19\x74\x09\xb3\x05\x22\x81\x02\xda\x35\x9f\x22\x67\x5e\x86\xee\xd8\x7b\x3e\x59\xa9\x5b\x8b\xa9\xda\x7b\x50\x2f\x62\x22\xb0\x2c\x44\x99\x14\xb0\x32\x53\xc2\xc0\x5c\x4d\xd2\x3a\x5f\xa8\x74\xb0\x86\xc5\xd6\x37\x70\xd5\xaf\xbe\xf3\x4c\xde\x06\x6e\x0f\xcd\x7b\x3e\x93\xdf\x08\xdf\x6b\x0c\xb1\xd5\x8e\x7e\x60\xed\xe8\x84\x69\x98\x79\x91\x4e\xc9\x45\x05\x83\x67\x3c\xa3\xc9\x22\x98\xab\xea\x37\xa1\x19\xbf\x86\xf9\xa6\x04\x67\x5a\xf2\x51\xf3\x0a\x5b\xff\xca\xb7\xf9\xaa\xea\x68\x7c\x04\xc7\x3d\x3e\x40\xf1\x73\x7d\x37\xd4\xb5\x1c\xdf\x13\x53\x90\xd5\x12\xaa\x16\xd8\x43\x31\xf4\x44\x7f\xab\x05\x7e\x4d\x16\x7d\xd4\x4e\x60\xdc\x95\xaa\xe7\x63\xbd\xb2\xbd\x26\x8b\x87\x56\x39\xe1\x60\x6e\xb1\xe8\xfb\xc3\xa2\x1a\xfe\xac\x7b\x64\x1a\x1a\xdc\x22\x27\x07\x28\xa3\x97\x04\xc5\x24\x9d\x12\x00\x12\x28\xf1\x5c\xcc\x04\x2f\xa7\xb3\x2e\xef\x91\x53\x0b\x63\xcf\xa2\xad\x5a\x1a\x0f\xdd\x6f\xb3\x68\x1d\x66\x3e\xa3\x6c\x8d\x06\x9d\xcb\xb8\xd2\x7a\x7a\xcf\x45\xc9\x3b\x33\x11\x12\x0e\xfa\x2a\x24\x1a\x98\x91\xb5\xd2\x85\xf6\xb2\x24\xb5\x59\x82\xc6\xc9\xd6\xcf\x2c\xd8\x58\x9d\xad\x21\xf8\x27\x30\x04\x33\x9e\x92\x33\x2e\xd6\x2c\x28\xe7\x0e\x0d\x37\x95\x35\xb9\x29\xf2\xf4\xd6\x90\x73\x4e\xe9\x56\xb2\x08\x9c\xd2\x99\xec\x19\x64\x19\x86\x0d\x43\xd3\x8b\x62\x34\xe8\x9c\xa0\x17\x23\x6c\x13\x8a\xad\xaf\x65\xeb\x6b\x09\xf7\xb5\x18\x26\x94\x81\xb3\xb2\xad\x98\x0c\xd3\x49\xab\x44\x81\x02\x82\x3e\x7d\xc2\x39\x9d\x0a\x5e\xe6\x87\x5f\x4c\x33\x98\xd1\x97\x4b\xca\xd2\xd1\x27\x67\x6c\x38\xfc\xe2\xb5\x63\x3c\x8a\x08\x5f\xd0\x91\xe6\x7f\xd2\x6d\x0d\xc6\xef\xbb\x06\x63\xc1\x33\xe2\x5b\xb9\x6e\x68\xbd\x70\x14\xb6\xa8\xba\x45\xd5\x35\x50\x15\x5a\x16\x16\x32\x70\x4e\x20\xd5\x6d\x7d\x2d\xfd\x20\x08\x0c\xc3\xbd\xe4\xa0\x01\xb1\xf1\x6b\xb2\xf8\xf4\xfb\x47\x30\xcd\x7d\x19\xbd\x98\x4c\x48\x52\x7c\x1a\x9d\xab\xc2\xde\xf2\x4b\xfc\xb8\x61\xb5\x10\x38\x09\xd3\x73\xb6\xb0\xfa\x68\x61\xb5\xf5\x8f\x2d\x7f\x90\x05\x2e\x96\x23\x9f\x1b\xeb\x0a\xac\xad\xbf\x64\x7d\x4c\xb5\x48\xce\x41\xd8\xbe\x26\x38\xc7\x4a\x49\x5c\xb9\xe7\x29\x91\x89\xa0\xf9\xff\xb0\x77\x7d\xbd\x8d\xdb\x48\xfc\xdd\x9f\x82\x48\x1f\x9c\x00\xb6\x0c\xe4\x8a\xe2\xe0\xb7\x43\x17\x5b\x04\xdd\xde\x2e\x36\xd9\x7b\x29\x0a\x2c\x23\xd1\x36\xb1\xb2\x24\x88\x54\xbc\xbe\xa2\xdf\xfd\xf0\x1b\x92\xfa\x13\x8b\x34\xa3\x24\x5b\xe0\x5a\xa0\x40\x17\x0e\x35\x9c\x19\x0e\x39\xc3\xe1\xfc\xc1\x92\xad\xd9\x46\x70\xbc\x5b\x28\x56\x6e\xe0\xda\x6a\x63\x44\x7c\x93\x06\x77\x4f\x70\xd7\xf8\x77\x4b\xca\x35\xcf\xcb\xed\x7f\xac\x89\x34\x0b\xca\x1d\x38\x62\xea\xdb\x5a\x93\xca\xb1\xc9\x15\xbf\xb5\xd0\x6c\xd5\x22\xdb\xe7\x81\x0c\xc5\x10\x4d\x01\xd4\xbb\xf6\x61\x67\x50\xe3\xed\xb1\x65\x5b\x11\xee\x78\x55\x89\xa2\x45\x61\x22\x57\x07\x73\xf4\x20\xfc\xe8\xf0\xb2\x58\xdc\xdb\xec\x25\x48\x0f\x25\x45\xf3\x36\xe8\x77\x04\xaa\x69\xd8\x41\x2e\x4a\x74\xa0\xa4\xe2\x9c\xc9\xec\xe9\xa7\x07\xc5\xc8\xde\xd5\x8d\xde\x1d\xef\xa4\x3f\x2d\x62\x40\xc4\x5b\x7c\x63\x3a\x85\x60\xe5\x5a\x06\x3b\xc1\xa7\xda\x50\xf4\x4b\xb8\x68\xf1\x5d\xdd\x88\x24\x5c\x7e\x9e\x65\x5c\x8b\x25\xa6\xf2\x8c\x0b\xca\x2c\xfe\xcb\xb9\xd2\x77\x2d\x42\xd1\x34\xbe\xe3\xe3\x24\x0e\x68\x23\x47\x12\x42\xa2\x0d\xe1\x1e\xb8\x14\x4a\xcc\x0b\x72\xc0\x7e\x13\x72\x3f\x55\x00\x13\x4d\x2a\x29\xec\x1e\xb9\x52\xf5\xe8\x3d\x70\xc5\x1a\x82\x97\xbd\x3a\xee\xb6\x81\x40\x14\xd2\xff\x62\xbb\x66\xcf\x8b\x25\x9c\xdb\x64\x29\xb9\xee\x03\x70\x1b\xa0\xb8\x6b\xb1\x85\xbb\x8f\xcb\x5c\x31\x7e\x5f\x86\xda\x5f\xec\x6c\xd9\x2f\x19\x7a\xea\x3f\x8b\x7c\x2d\x78\xa0\x6b\xc1\x09\xc3\xcd\xf0\xf6\x64\x69\x19\x3e\x57\x76\x2d\x9e\x8f\xd1\x98\xae\xf2\x60\x74\x3b\xd0\x58\x2d\x32\x0b\x57\x2d\x07\x3b\x75\xc1\xde\xf2\x5c\x89\x05\xfb\x64\x74\xf7\x64\xbc\x68\x40\x0c\x56\xc8\xf5\x78\xd4\x0f\xba\x93\xcc\x89\xd3\x87\xec\x89\xa5\x7f\x1f\x2f\x89\xdd\x23\x7f\xf0\x1a\x10\x67\x74\x66\xdf\x6c\x59\xcf\x82\x6c\x18\x98\x8f\x9d\x92\x1a\x58\x3e\x2e\x62\x03\x92\x5f\x16\xf3\x89\xda\x69\x60\x0f\xde\xa2\x94\x6a\xef\x8d\x8a\x9b\xe0\x08\x99\x0e\x67\x1e\x81\xc9\x86\xd8\x4c\xd0\x4a\x4e\xf1\x05\x5e\x3a\xfd\x2c\x2a\x4a\x96\x97\xc5\x56\xd4\x74\xaf\x1a\x37\xaa\xcf\xca\x49\x87\xc3\x2f\x28\x02\xfc\x01\xfa\xf5\x4f\x47\xe5\x2e\x76\xe7\xbc\x06\x12\xd1\xdb\x76\xfe\xe8\x61\x53\xdb\x6d\x3c\x10\x9b\x05\x93\x62\xed\xe4\xe0\xe8\x7f\x30\x6d\xeb\xfc\x2e\xec\xcb\xe6\x82\x25\x49\x32\x99\x08\x7a\x79\x8d\xa2\xa2\x7b\xa7\x85\xf6\xa6\x74\x21\xf7\x64\x37\x20\x84\x5d\x2a\x2a\x01\xed\x81\x69\x62\xa3\x1e\x50\x63\xfd\xa4\x4d\xe7\x67\xac\xe7\xe7\xab\x69\xb4\x84\x0f\x32\xcf\x69\xb5\x34\x61\x30\x2f\x77\x8e\x19\x9a\x44\x91\x8e\x6e\x65\x8f\x79\xdd\xff\x88\xde\xd5\xba\x7b\x8b\xad\xb6\xf0\x34\x0b\x3b\xc8\xa9\x00\xee\x72\x2b\x94\x3e\x83\x35\x90\x32\x03\x59\xca\xf3\xb4\x41\x87\xb5\xe7\xdf\x49\xda\x30\xb3\x8f\x76\x67\x9f\x63\x9e\x47\x07\xb8\x83\x41\xf5\x02\xd7\xc6\x70\x9b\xc7\x33\x73\x38\xad\xc3\x6f\xa8\x0c\xa8\xbc\xa2\xd6\x3c\xdd\x89\xac\x3d\x9c\x4c\x5c\xdd\x08\x44\xd6\x46\x22\xec\xb9\x16\xb5\xe4\xb9\xfc\xaf\xeb\x0c\x47\x51\x91\x76\x3b\xb8\x9e\x90\xa8\xc7\xf1\x99\xdd\xbd\x7f\xf3\x7e\xcd\x0e\x2e\x66\x72\x14\x6e\xe6\x98\x02\xa7\xd2\xa6\xd1\x88\x08\xaa\x45\x2e\xb8\x42\x9d\xaa\x82\x6d\xf8\x43\xd9\x50\xb1\x42\xaa\xe4\xbe\x70\xd5\xce\xf1\x6e\x91\xe2\x12\x3a\x0a\xd5\x38\xd3\xe6\x13\xd4\x16\xdc\x39\xb5\x50\xe3\xd7\xe0\x11\xf6\xda\x52\x5a\x36\x18\x07\xb1\x1e\x0e\x02\x2a\xa7\xa0\x0b\xf2\x0f\xdf\x33\x1b\x3a\x20\xb2\xab\xd9\x54\x0f\x9e\x9d\x20\x0a\x27\x08\xbc\x5d\x50\x7a\x1e\xbf\xac\x72\x5c\x30\xd1\x91\x61\xe2\x49\xd5\xce\x1f\xab\xcd\xdb\x33\x76\xcf\x2b\x0a\x6d\x19\x54\x78\x77\xb8\x11\xc8\x67\x62\xf4\x51\x6c\x9e\x8e\x51\x17\x45\xf4\x6a\x78\x45\x2b\x7a\x8b\x18\xa6\x74\xad\x33\x64\xe5\xaa\x0a\x0c\x5a\x7c\x4c\x5e\x3c\xda\x3a\x1f\xb8\xde\x45\x23\x44\x5f\x98\x06\x1c\xe5\x30\x0e\x98\xb6\xf6\x54\x44\x0a\xbe\x8f\x67\x0a\x06\xbb\xfb\xcc\x39\x6b\x34\x6a\xf6\xea\x29\x1c\xc0\x60\xdb\xd6\x03\x08\xd0\x29\x87\x76\xa7\xba\xf4\x17\x96\x3e\x8b\x41\xcd\x0f\x3f\x3e\x67\x23\x87\xe5\xc0\xdd\xe2\xef\x8f\x5a\x4c\xc5\x30\xda\x44\x04\x6e\x78\x49\xc5\x0a\x65\x5c\x73\x58\x56\xa6\x5f\xcc\xb4\xa9\x27\x5a\x2f\xad\xd2\xbc\x8d\x52\xc2\x9d\xde\x8d\xd3\xba\x13\x95\xee\x6d\xa7\x71\x33\xb1\x91\x85\x50\x23\x56\x27\xe6\xa3\xcb\xb9\xe9\x51\x3b\xf3\xc6\xda\xb9\x98\x25\xf1\x55\xa4\x0d\x30\x95\x45\xcf\x6f\x68\x5c\xb1\x6f\x6e\xdf\x31\x74\x68\x6d\xc6\x1b\xcd\xff\xad\xf6\xfe\x56\x7b\x7f\x39\xb5\x07\xa7\x53\x9d\x8a\x4a\x97\xb5\x8a\xc2\xe9\xa6\xf7\x01\x43\x74\x8b\xeb\xd4\xc4\x64\x26\x0a\x04\x12\xe3\x65\x0d\x98\x97\xf5\x36\xe1\x15\xac\xe7\x84\xac\xd0\xe4\x4b\x42\xfd\xf0\xd4\xbb\x92\x67\x81\xc8\xf3\x06\x66\xad\x2e\xd1\xf2\x78\x55\x95\x28\x6d\x61\xba\x07\xda\x45\xf0\xf9\x9f\xbd\xa7\x4e\x24\x27\x42\x27\xa8\x73\x3a\x9b\xb3\x23\x8a\x4f\x46\x1b\x1f\x6d\x1a\x8e\x54\xb6\x71\xb5\x81\xc0\x2e\xdb\x13\xe9\x8a\xdc\x15\x1e\x88\xe4\x53\xa7\x25\x42\xbb\x55\xe3\xb4\x6e\x45\x31\x13\x41\x3a\x02\xa4\xe6\xb4\x00\x51\x64\x98\xb5\xc2\xe1\xc5\x8b\xfe\x52\x3b\xa3\x63\xea\x22\x53\x2d\x11\xba\x30\x8d\x93\xc7\xb5\x7b\x2a\x9b\x4a\xe4\x5f\xdd\x8e\xb2\xea\xcc\x64\x0e\xc5\xed\x6d\x17\x27\xe6\x3e\x25\xb6\xa0\x62\x1a\xd4\x73\xe6\x42\x1a\xec\x0a\x5d\x22\xe3\xc4\x03\x95\xea\x9a\x43\x0c\x41\xc6\x85\x16\x7b\x54\x69\x13\x17\x57\x7f\xda\xd6\xfd\x3f\x32\x2a\x71\x69\xb2\x4b\xa2\x06\x16\xa6\x45\xd8\x3d\xb3\x5a\x67\x53\xa0\x8b\x83\xc7\x90\x7c\x4d\x53\x94\x4a\xec\xad\x67\x41\x02\x9d\xd6\xa3\xee\x72\xe6\x8b\xf1\x33\x32\x80\x62\xef\x2d\xe5\x67\xa9\x23\x26\xec\xd4\x7e\xb9\x39\xa9\x4e\x47\xa5\xa9\xda\x83\x1c\xb8\x78\x32\x19\xcf\x9a\xc6\x61\x1b\x93\x57\xd2\x1b\x5e\x70\x82\x35\xfa\xd1\x3d\x0e\x2e\x30\x44\xe8\x64\x36\x61\x45\x29\x96\xd6\x7f\x01\x1e\xcc\x3d\xbf\x41\x97\x4d\xf4\x45\xb2\x75\xa8\x38\xab\xa4\x40\x99\x2d\x34\x51\xb2\x62\xc1\x64\xa1\xb4\xe0\x19\x2b\x37\xa3\x10\x51\x1e\x8c\xc1\x64\x80\x11\x41\x5f\x2c\xac\x0a\xa0\x5d\xe2\xb2\x88\xad\x28\x30\x0e\xa7\xae\xcc\xa8\xef\xf9\xea\x27\xdf\x73\x3b\x51\xc1\x78\x6a\x0c\x06\xc4\x18\x20\x19\x70\xc1\x54\x93\xee\xe0\x8b\xcb\x84\x82\x3b\x19\x2f\x81\x22\xd9\xf3\x42\x6e\x84\xd2\x49\x2b\x6e\xea\xd7\xeb\xdf\x7c\x87\xda\xdb\xb2\x66\xe2\x2b\xdf\x57\x79\x9b\xb9\xe4\x28\xed\xa4\x07\x8a\xbe\x64\xbc\x27\xc0\xc8\xe4\x93\x3e\x3d\xc2\x51\x95\xd8\x92\x7d\x80\x03\x90\x8a\x24\x22\xc3\x99\x5b\xb7\x3c\xb2\x71\xd6\xec\x02\xe6\x44\x0f\xcd\xdf\x71\x32\xff\xe1\xcb\x80\xb9\x34\xba\xe4\x02\x83\x2e\xcc\x42\x29\xe7\xd5\xef\x6b\xba\x0e\x49\x52\xc7\xba\x96\xdb\xad\x08\xa8\x1c\x74\xb5\x40\xcc\xc8\x15\x0c\x4f\xb9\xc1\x93\x4b\x07\x82\x00\x4b\xd5\x25\x55\x9f\x20\xfd\xeb\xf5\x6f\x5e\x8c\x3b\x38\x36\xf3\x31\x13\x5f\xd9\xb5\x51\x39\x52\x81\x4b\x57\x48\x4c\x03\x7c\x7a\x87\x80\x62\x49\x77\xa5\x12\x3e\xce\x52\x3a\x99\x4b\x29\x57\xe5\x5e\xb0\x83\xc8\xf3\xa5\xd3\x65\x07\x7e\x04\x17\xdc\xc2\x41\xde\x38\x42\xc3\x74\x50\x5a\xcd\x72\x27\xd6\x6b\x4b\x98\x41\xa0\xb6\x85\xcb\x28\x37\x39\xf5\x48\xc4\x1b\x64\x9b\x7b\x20\xaa\x86\xe0\x61\x69\xd2\x1d\x2f\xb6\xa2\x8d\x1a\x24\x27\x6f\x32\x9f\xb2\x8f\xa1\x0e\x62\xb6\xf0\xcf\x56\x6d\x0c\x0e\x0e\xf6\x4b\x89\x6c\xbc\x62\x53\xae\xd9\x4e\xeb\x4a\xad\x57\xab\xad\xd4\xc9\x97\x7f\x52\x51\x60\x84\x5b\x36\x85\xd4\xc7\x15\xd6\xab\x96\xf7\x0d\xae\x01\xab\x0c\xed\xf6\x57\x4a\x6e\x97\xbc\x4e\x77\x12\x7d\x50\x9a\x5a\xac\x78\x25\x97\x69\x59\x40\x62\x10\x97\x94\xec\xb3\xef\x70\x06\xa9\x25\x50\x54\x93\x88\xf3\x1b\x75\x43\xe2\xfe\xdd\x93\xf2\x20\x71\x27\x45\x8f\x15\x48\xc3\x85\x48\xad\x50\x33\xf0\x41\x8a\xc3\xea\x50\xd6\x5f\x64\xb1\x5d\x42\x34\x97\x46\x06\xd4\x0a\xa8\xa8\xd5\x77\xf4\xbf\xc9\xb4\x50\x74\x7d\x2c\x41\x34\xf8\x5b\x50\x85\x79\xd4\x6a\x12\x51\xee\x99\x24\x5e\x8f\xcd\x6f\xad\xbd\xfd\xf8\x5b\x6c\x0b\xa3\x74\x69\x2f\x59\x82\xbd\xd9\x6b\x68\x82\xc2\x33\x73\x34\xf3\xe2\xf8\xea\xa2\x0c\x86\x52\x45\xc7\xf4\x08\x29\xd7\x75\x99\x2f\x79\x91\xe1\xdf\xa6\xd9\x6e\x7a\x9c\xc4\xc1\x46\x46\x6d\xdf\x4f\x37\x6f\xbe\x8d\x80\x37\x72\xd2\x5e\x0d\x18\x88\x88\xfc\xb9\x29\xa4\x46\xe8\x96\xd2\x7c\x5f\xad\x67\x41\x5a\x41\xa3\x76\x63\xbb\xe7\x39\xe7\x9b\xe9\x82\xba\x6c\xcf\x1c\xa9\xfa\xb1\x34\x27\xb0\x19\x45\x7b\xc9\x42\x6a\xfb\x46\x97\xcc\x7c\x06\xbd\x3f\xd6\x2b\x40\x7b\x79\x8f\xf4\x1c\x91\xfd\x64\xdc\xae\xa3\xbb\x60\x40\xdf\xfb\x93\x0f\x9c\xbf\x60\x0f\xf7\x07\x8a\x98\x16\xda\x39\x61\xf1\x57\x37\xc3\x09\x58\x36\xea\xa0\xf5\xd3\x27\x0b\xfd\xc3\xf7\x27\x7f\x35\xb4\x11\x0b\x4f\x6e\xf0\xd5\x8e\x2b\x71\x86\x1e\x60\xce\x53\xdd\xf0\xdc\x0c\x7f\x0a\xf3\x5c\x39\xef\x33\x53\xdc\x0d\x83\x7f\xdb\xe6\x5f\x07\x54\x27\x35\x82\x71\xc6\x12\x0f\xe1\x50\x97\xb8\x82\x47\x50\x69\x47\xba\xd7\x7d\x5d\xc2\x69\xf1\xac\xb9\x6d\xd1\x69\x47\x90\xf7\x18\x3d\x41\xa5\xad\x8b\xea\x58\xe1\xee\x06\x2f\x16\x61\x5c\x0b\x0a\x5a\x50\x11\xc8\x74\xed\xf3\xdc\x47\x21\x11\xfc\xc7\xf5\x93\x44\xd0\xfa\x85\x3e\x98\x42\x3c\x75\x04\x3a\xf6\x0b\x57\xbb\xa7\x7e\x41\x9e\x18\xc8\xf1\xcb\xe4\x50\x79\xf1\xd5\x51\x22\x17\xa9\x2e\xcf\xb1\x83\xfa\x7d\xb4\x83\x9f\x32\xc1\x43\x34\x8d\x6d\x2d\x7c\xfb\x49\xfc\x2c\xa3\x4a\xe3\xe4\x47\x73\xf8\xf5\xb2\x29\xe0\x28\x83\x5f\xa1\xf7\x4b\x73\xef\xac\x89\x56\x5e\x55\xca\xfb\x9b\x9a\x38\x71\x6b\x19\x41\xf7\x5e\x96\x98\xc0\xc8\xe4\x84\x3d\xb8\xc8\x7c\xb4\x92\xec\x86\xe2\x42\x76\x22\xdd\x06\xc0\xe3\xa1\xf4\xe3\xe3\xc1\x4a\x73\xdd\xa8\x35\xfb\xfd\x8f\xd9\xff\x06\x00\x74\x1a\xe8\xd5\xde\x30\x07\x00"),
 		},
 		"/crd/bases/camel.apache.org_kameletbindings.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "camel.apache.org_kameletbindings.yaml",
@@ -172,7 +172,7 @@ var assets = func() http.FileSystem {
 			modTime:          time.Time{},
 			uncompressedSize: 2689,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x56\xcf\x6f\xe2\x46\x14\xbe\xfb\xaf\xf8\x84\x2f\xbb\x52\x30\xb0\xa7\x95\x7b\x72\x13\xd2\xa0\xa6\x06\x61\xb6\xd1\x9e\xaa\x61\xfc\xb0\x47\x8c\x67\xdc\x99\x31\x2c\xfd\xeb\xab\x31\x98\x00\x49\x68\x23\x45\x5a\x9f\x82\xdf\x9b\xef\x7d\x3f\x9e\xed\x84\xe8\x7f\xdc\x15\x84\x78\x14\x9c\x94\xa5\x1c\x4e\xc3\x95\x84\xa4\x66\xbc\x24\x64\x7a\xe5\xb6\xcc\x10\xee\x75\xa3\x72\xe6\x84\x56\xf8\x94\x64\xf7\x9f\xd1\xa8\x9c\x0c\xb4\x22\x68\x83\x4a\x1b\x0a\x42\x70\xad\x9c\x11\xcb\xc6\x69\x03\xb9\x07\x04\x2b\x0c\x51\x45\xca\xd9\x08\xc8\x88\x5a\xf4\x74\xba\x98\xdc\x8e\xb1\x12\x92\x90\x0b\xbb\x3f\x44\x39\xb6\xc2\x95\x41\x08\x57\x0a\x8b\xad\x36\x6b\xac\xb4\x01\xcb\x73\xe1\x07\x33\x09\xa1\x56\xda\x54\x7b\x1a\x86\x0a\x66\x72\xa1\x0a\x70\x5d\xef\x8c\x28\x4a\x07\xbd\x55\x64\x6c\x29\xea\x28\x08\xb1\xf0\x32\xb2\xfb\x8e\x89\xdd\xc3\xb6\x33\x9d\xc6\x77\xdd\x1c\x34\x9c\xc8\x3d\xb8\x70\x83\x3f\xc9\x58\x3f\xe4\x4b\x34\x0c\x42\x7c\xf2\x2d\xbd\x43\xb1\xf7\xf9\x17\xec\x74\x83\x8a\xed\xa0\xb4\x43\x63\xe9\x04\x99\x7e\x70\xaa\x1d\x84\x02\xd7\x55\x2d\x05\x53\x9c\x9e\x65\x1d\x27\x44\x68\x09\x78\x0c\xbd\x74\x4c\x28\xb0\x56\x06\xf4\xea\xb4\x0d\xcc\x05\x61\x10\xa2\xbd\x4a\xe7\xea\x78\x30\xd8\x6e\xb7\x11\x6b\xe9\x46\xda\x14\x83\x4e\xdd\xe0\x71\x72\x3b\x4e\xb3\x71\xbf\xa5\x1c\x84\xf8\xa6\x24\x59\x0b\x43\x7f\x37\xc2\x50\x8e\xe5\x0e\xac\xae\xa5\xe0\x6c\x29\x09\x92\x6d\x7d\x70\x6d\x3a\x6d\xe8\x42\x61\x6b\x84\x13\xaa\xb8\x81\x3d\xa4\x1e\x84\x67\xe9\x3c\xdb\xd5\xd1\x13\xf6\xac\x41\x2b\x30\x85\x5e\x92\x61\x92\xf5\xf0\x6b\x92\x4d\xb2\x9b\x20\xc4\xd3\x64\xf1\x30\xfd\xb6\xc0\x53\x32\x9f\x27\xe9\x62\x32\xce\x30\x9d\xe3\x76\x9a\xde\x4d\x16\x93\x69\x9a\x61\x7a\x8f\x24\xfd\x8e\xdf\x27\xe9\xdd\x0d\x48\xb8\x92\x0c\xe8\x47\x6d\x3c\x7f\x6d\x20\xbc\x91\x94\xfb\x4c\xbb\x05\xea\x08\xf8\xfd\xf0\xbf\x6d\x4d\x5c\xac\x04\x87\x64\xaa\x68\x58\x41\x28\xf4\x86\x8c\xf2\xeb\x51\x93\xa9\x84\xf5\x71\x5a\x30\x95\x07\x21\xa4\xa8\x84\x6b\xb7\xc8\xbe\x14\xe5\xc7\x7c\xe4\xb3\x15\xb0\x5a\x1c\xd6\x29\xf6\x09\xd8\xc1\x66\x14\xac\x85\xca\x63\xdc\x51\x2d\xf5\xce\x3f\x1c\x41\x45\x8e\xe5\xcc\xb1\x38\x00\x14\xab\x28\x06\x67\x15\xc9\xfe\xba\xaf\x6b\x32\xcc\x69\x13\x00\x92\x2d\x49\x5a\xdf\x02\x8f\x14\xa3\x77\x68\xea\xb5\xb7\xda\x1f\xa7\xbb\xe1\x57\x50\x2b\x52\x2e\xc6\x09\xca\x95\x01\x2d\x6c\xb4\x6e\x96\x64\x14\x39\xb2\x91\xd0\x6f\x82\xbc\xec\x3c\x83\x7d\xa3\x67\xd3\x39\xd1\x1b\x45\xa3\x61\x34\xec\x67\x69\x32\xcb\x1e\xa6\x8b\x5e\xe0\x33\xf4\xda\x0c\xb5\x5b\x6a\x63\x8c\x02\xc0\x3a\xc3\x1c\x15\xbb\xbd\x6a\xb7\xab\x29\xc6\x9c\xb8\x21\xe6\xc8\x97\x49\x12\x77\xda\xec\xcb\x15\x73\xbc\x7c\x3c\x71\xe9\x8a\x56\x47\x55\x2d\x99\xa3\xc3\xc9\x13\xff\xfd\x25\xcf\x40\xae\x5a\xf6\x2e\xeb\xdf\x88\xee\x7d\xd6\xff\x5f\xfb\xdf\x1d\x81\x3f\xd0\xc5\xd0\xfe\x4d\x66\x23\x38\x25\x9c\xeb\x46\xb9\xf4\x9a\x07\xfe\xdd\xcf\x84\x7f\xfd\x3e\x9b\xd6\xff\x2f\xdb\x00\x51\xb1\x82\x62\xe4\x9a\xaf\xc9\x78\x76\x7b\x0f\x07\x87\x23\xf1\x05\xc5\xcb\x93\xb3\x46\xca\x99\x96\x82\xef\x62\x4c\x56\xa9\x76\x33\x43\xd6\x3f\x4f\xcf\x7d\x5c\x57\x15\x53\x79\x7c\x72\xcb\x33\x5b\xfb\x01\x17\xf7\x5e\xe1\x57\x6b\xe3\xec\xe5\xd9\xa3\xd6\x99\x36\x2e\xc6\xd7\xe1\xd7\xe1\x59\x47\xb7\x2e\x15\x39\x23\xb8\x3d\xa9\x91\xda\x5c\x82\xed\x5b\x9f\x92\xc5\xed\xc3\x5f\x69\xf2\xc7\x38\x9b\x25\xb7\xe3\x0b\xb8\x0d\x93\x0d\xdd\x1b\x5d\xc5\x17\x05\x60\x25\x48\xe6\x73\x5a\xbd\xac\x1c\x6a\x33\xe6\xca\xf8\xb8\xdf\x91\x1f\x67\x6b\xc6\xe9\x55\x1a\xd3\xd9\x78\x9e\x2c\xa6\xf3\x96\xc9\x6b\x24\x5e\x5b\xdc\x53\x80\xd9\xf4\xee\xcd\xb3\x1f\x27\xe0\xac\x35\xc4\xd1\x36\xff\x41\x62\x72\xcb\x76\xb6\x7d\xa3\x77\x79\xe2\x28\xfa\x06\x42\xe5\x54\x93\xca\x49\x39\xd9\x7e\x6e\xaf\x39\xdf\xa9\xfa\x49\xb9\x48\xb1\x21\x45\xd6\xce\x8c\x5e\xd2\x39\x90\xff\x67\xe0\x37\x72\x97\xe8\x75\x0b\x3a\x28\x89\x49\x57\xfe\x73\x59\xec\xb6\x75\x74\x56\x10\x4a\x38\xc1\xe4\x1d\x49\xb6\xcb\x88\x6b\x95\xdb\x18\x5f\xce\x37\xba\x26\x23\x74\x7e\xac\x8e\x86\xc1\xbf\x01\x00\x00\xff\xff\x63\x3b\x70\xe8\x81\x0a\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x56\x4f\x8f\xe2\xb8\x13\xbd\xe7\x53\x3c\x91\xcb\x8c\xd4\xfc\x9b\xd3\x28\xbf\x53\x7e\xdd\xf4\x0e\xda\x59\x40\x84\xd9\xd6\x9c\x56\xc6\x29\x48\x09\xc7\xce\xda\x0e\x0c\xfb\xe9\x57\x0e\x84\x06\xba\x9b\xd1\x48\xbd\x31\x97\xa4\xca\x55\xef\xbd\x7a\x4e\x88\xd1\x7d\xbf\x2b\x8a\xf1\x95\x25\x69\x47\x39\xbc\x81\x2f\x08\x69\x25\x64\x41\xc8\xcc\xca\xef\x84\x25\x3c\x9a\x5a\xe7\xc2\xb3\xd1\xf8\x90\x66\x8f\x1f\x51\xeb\x9c\x2c\x8c\x26\x18\x8b\xd2\x58\x8a\x62\x48\xa3\xbd\xe5\x65\xed\x8d\x85\x3a\x14\x84\x58\x5b\xa2\x92\xb4\x77\x3d\x20\x23\x6a\xaa\x4f\xa6\x8b\xf1\xfd\x08\x2b\x56\x84\x9c\xdd\x61\x13\xe5\xd8\xb1\x2f\xa2\x18\xbe\x60\x87\x9d\xb1\x1b\xac\x8c\x85\xc8\x73\x0e\x8d\x85\x02\xeb\x95\xb1\xe5\x01\x86\xa5\xb5\xb0\x39\xeb\x35\xa4\xa9\xf6\x96\xd7\x85\x87\xd9\x69\xb2\xae\xe0\xaa\x17\xc5\x58\x04\x1a\xd9\x63\x8b\xc4\x1d\xca\x36\x3d\xbd\xc1\x77\x53\x1f\x39\x9c\xd1\x3d\xaa\x70\x87\x3f\xc9\xba\xd0\xe4\x53\x6f\x10\xc5\xf8\x10\x52\x3a\xc7\x60\xe7\xe3\xff\xb0\x37\x35\x4a\xb1\x87\x36\x1e\xb5\xa3\xb3\xca\xf4\x43\x52\xe5\xc1\x1a\xd2\x94\x95\x62\xa1\x25\x3d\xd3\x3a\x75\xe8\xa1\x01\x10\x6a\x98\xa5\x17\xac\x21\x1a\x1a\x30\xab\xf3\x34\x08\x1f\xc5\x51\x8c\xe6\x2a\xbc\xaf\x92\x7e\x7f\xb7\xdb\xf5\x44\x33\x9d\x9e\xb1\xeb\x7e\xcb\xae\xff\x75\x7c\x3f\x9a\x64\xa3\x6e\x03\x39\x8a\xf1\x4d\x2b\x72\x0e\x96\xfe\xae\xd9\x52\x8e\xe5\x1e\xa2\xaa\x14\x4b\xb1\x54\x04\x25\x76\x61\x70\xcd\x74\x9a\xa1\xb3\xc6\xce\xb2\x67\xbd\xbe\x83\x3b\x4e\x3d\x8a\x2f\xa6\xf3\x2c\x57\x0b\x8f\xdd\x45\x82\xd1\x10\x1a\x9d\x34\xc3\x38\xeb\xe0\xff\x69\x36\xce\xee\xa2\x18\x4f\xe3\xc5\x97\xe9\xb7\x05\x9e\xd2\xf9\x3c\x9d\x2c\xc6\xa3\x0c\xd3\x39\xee\xa7\x93\x87\xf1\x62\x3c\x9d\x64\x98\x3e\x22\x9d\x7c\xc7\xef\xe3\xc9\xc3\x1d\x88\x7d\x41\x16\xf4\xa3\xb2\x01\xbf\xb1\xe0\x20\x24\xe5\x61\xa6\xad\x81\x5a\x00\xc1\x1f\xe1\xde\x55\x24\x79\xc5\x12\x4a\xe8\x75\x2d\xd6\x84\xb5\xd9\x92\xd5\xc1\x1e\x15\xd9\x92\x5d\x18\xa7\x83\xd0\x79\x14\x43\x71\xc9\xbe\x71\x91\x7b\x49\x2a\xb4\x69\x0f\xc6\x3b\x5c\x51\x24\x2a\x3e\xda\x29\x09\x13\x70\xfd\xed\x30\xda\xb0\xce\x13\x3c\x50\xa5\xcc\x3e\x1c\x8e\xa8\x24\x2f\x72\xe1\x45\x12\x01\x5a\x94\x94\x40\x8a\x92\x54\x77\xd3\x35\x15\x59\xe1\x8d\x8d\x00\x25\x96\xa4\x5c\x48\x41\xa8\x94\xa0\x73\x4c\xea\x34\x8f\x9a\x9b\x73\x6f\x04\x0b\x1a\x4d\xda\x27\x38\xab\x72\xa3\x41\x53\xb6\xb7\xa9\x97\x64\x35\x79\x72\x3d\x36\x6f\x16\x79\x99\x79\x51\xf6\x8d\x9c\x6d\xab\x44\x67\xd8\x1b\x0e\x7a\x83\x6e\x36\x49\x67\xd9\x97\xe9\xa2\x13\x85\x19\x06\x6e\x96\x1a\x97\xba\x04\xc3\x08\x70\xde\x0a\x4f\xeb\x7d\x88\x00\x7e\x5f\x51\x82\x39\x49\x4b\xc2\x53\x08\x93\x22\xe9\x8d\x3d\x84\x4b\xe1\x65\xf1\xf5\x4c\xa5\x1b\x5c\x3d\x95\x95\x12\x9e\x8e\x3b\xcf\xf4\x07\x2e\xa5\xfe\x89\x64\xbf\x24\xfd\x1b\xa3\xfb\x35\xe9\x5f\xcf\xbe\x80\x78\x23\xef\xc6\x08\xc2\x8e\x76\x0c\x61\x39\xb2\x5b\x96\x94\x4a\x69\x6a\xed\x27\xb7\x34\x08\xef\x7e\xc1\xe1\xf5\xfb\x2c\x5a\xf7\x67\xb2\x01\x5c\x8a\x35\x25\xc8\x8d\xdc\x90\x0d\x2c\x0e\x1a\xf6\x8f\x5b\x92\x2b\x88\xd7\x3b\x67\xb5\x52\x33\xa3\x58\xee\x13\x8c\x57\x13\xe3\x67\x96\x5c\x38\x4f\x6d\x56\x00\x56\x96\x42\xe7\xcf\xa8\xc2\xea\x62\x13\x1a\x5c\x3d\x7b\x05\x5f\x65\xac\x3f\x63\x14\x7e\xdd\x67\xae\x33\x63\x7d\x82\xcf\x83\xcf\x83\x8b\x8c\xd6\x2e\x25\x79\xcb\xd2\x9d\xc5\x48\x6f\xaf\x8b\x1d\x52\x9f\xd2\xc5\xfd\x97\xbf\x26\xe9\x1f\xa3\x6c\x96\xde\x8f\x2e\x72\x80\xad\x50\x35\x3d\x5a\x53\x5e\x6e\x0e\x6b\xc5\xa4\xf2\x39\xad\x5e\x46\x8e\xb1\x99\xf0\x45\x72\xf2\x77\x2f\xb4\x73\x95\x90\xf4\x2a\x8c\xe9\x6c\x34\x4f\x17\xd3\x79\x83\xe4\xaa\x62\x03\xe2\x35\xe3\x9e\x17\x98\x4d\x1f\xde\xdc\xfb\x7e\x04\x2e\x52\x63\x9c\x64\x03\x3b\x08\xb5\x13\xfb\xf0\xb1\xa7\xd3\xa1\xc1\x89\xf4\x1d\x58\xe7\x54\x91\xce\x49\x7b\xd5\x7c\x6e\x6f\x29\xdf\xb2\x7a\x3d\xfa\x9f\xcf\x45\xf1\x96\x34\x39\x37\xb3\x66\x79\x7c\x4f\xb5\x2b\xfc\x19\xf8\x8d\xfc\x75\xf5\xaa\xd1\xaa\x5f\x90\x50\xbe\xf8\xe7\x3a\xd8\xba\x75\x78\xe1\x56\xd6\xec\x59\xa8\x07\x52\x62\x9f\x91\x34\x3a\x77\x09\x3e\x5d\x3a\xba\x22\xcb\x26\x3f\x45\x87\x83\xe8\xdf\x01\x00\x63\x3b\x70\xe8\x81\x0a\x00\x00"),
 		},
 		"/manager/operator-service-account.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "operator-service-account.yaml",
@@ -590,9 +590,9 @@ var assets = func() http.FileSystem {
 		"/traits.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "traits.yaml",
 			modTime:          time.Time{},
-			uncompressedSize: 51733,
+			uncompressedSize: 52709,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\xbd\x7b\x73\x1c\xb9\x91\x20\xfe\xff\x7c\x0a\x04\xfd\x8b\x10\xa9\xe8\x6e\x6a\xc6\x6b\x7b\x7e\xbc\x9b\xf5\x71\x24\x8d\xcd\x19\x3d\x78\x12\x67\xbc\x0e\x9d\xc2\x8d\xae\xca\xee\x86\xba\x1a\x28\x03\x28\x52\xed\xf3\x7d\xf7\x0b\x64\x26\x1e\x55\xdd\x24\x9b\x92\x38\x6b\xc6\xed\x3a\x62\x47\x24\x0b\x40\x22\x91\x99\xc8\x37\xbc\x95\xca\xbb\x93\xaf\xc6\x42\xcb\x35\x9c\x08\x39\x9f\x2b\xad\xfc\xe6\x2b\x21\xda\x46\xfa\xb9\xb1\xeb\x13\x31\x97\x8d\x83\xf0\x1b\x6b\xe6\xaa\x01\x77\xf2\x95\x10\x63\xf1\x53\x37\x03\xab\xc1\x83\xa3\x1f\xb5\xf4\xea\x12\xf0\xdf\xaf\x5b\xd0\x6f\x97\x6a\xee\xbf\x12\xa2\x06\x57\x59\xd5\x7a\x65\xf4\x89\x38\x6d\x1a\x73\xe5\x44\x65\xb4\x0b\x2b\x6b\xa5\x17\xe2\x6a\xa9\xaa\xa5\xd0\xa6\x06\x27\xfc\x12\x84\xd2\x1e\x16\x56\x86\x01\xa2\x35\xf5\xa1\x3b\x12\xd2\x82\x80\x46\x2d\xd4\xac\x09\x0b\x08\xe1\x8d\x98\x81\x70\xd5\x12\xea\xae\x81\x5a\x18\x3d\x12\x33\xe9\xf0\x5f\xa2\x91\x33\x68\x5c\xf8\x57\x98\x2e\x4c\x3c\x12\xc6\x8a\x2b\xe5\x97\x38\xb9\x1d\xb7\xa6\x4e\x3b\x15\x52\xd7\x38\xa7\xd4\x5e\x8d\xe3\x6f\x77\x4e\xd7\x9a\x3a\x80\x28\x3d\x02\x24\x1b\x0b\xb2\xde\x08\xdb\x69\xdc\x47\xb1\x9e\x9b\xe0\x8c\x67\xfe\x91\x13\xb5\x72\x72\x16\x60\x9c\x6d\x44\x0d\x73\xd9\x35\x7e\x42\xb8\x6c\xc1\x7a\x15\xb1\x49\xe8\x07\x8d\xdf\xd2\x1e\x37\x2d\x9c\x88\x99\x31\x0d\xfe\xd8\xc3\xe3\x53\xa9\x03\x02\xba\x00\xa2\x37\x3c\x2c\x6c\x92\x57\x13\x52\xe0\xc9\x4e\x02\xc6\xe9\x9f\x4e\xb8\x65\x00\xdb\x2f\x55\x38\x80\xf5\xda\x68\x9c\x37\x81\xb2\x99\x14\x80\xb4\xa6\x1e\x17\xb4\x70\x33\x34\xa7\xcd\x95\xdc\x84\x49\xc7\x8d\xa9\xa4\x07\x27\xd6\x5d\xe3\x55\xdb\x80\xb0\xd0\x36\xaa\x92\x4e\x98\xf9\xd6\xe1\x2a\x42\x98\x93\x6b\x60\x48\x02\xee\xc4\x21\x63\x49\x3c\x46\xba\x7b\x7c\xb4\x05\x57\x79\x50\xb7\x02\xf7\x0a\x2e\xc1\xfe\x2a\xb0\x85\x2f\x12\x5c\x63\x22\x9b\x02\xbc\x47\xef\xde\x3b\x6f\x95\x5e\x3c\xda\x06\xf2\x19\xcc\x95\x06\x27\xa4\x70\xe0\x03\x3c\x7b\xb3\x03\xb1\x02\xc3\xb8\x37\x43\x5c\x77\xd4\x9f\x09\x35\x32\xc8\x61\x98\xb6\xd9\x08\xbf\x34\x0e\xc4\x5a\xfa\x6a\x19\xd8\x23\x2c\x8d\xb3\x0b\x07\x0d\x54\xde\xd8\x11\x43\x6d\xa1\x41\xd1\x11\xb6\x12\xbe\x5a\xa8\x4b\xd0\x08\x9c\x6b\x65\x05\x47\xc4\x72\x7e\x09\x3b\x50\xe1\x96\xa6\x6b\xea\xc0\x0b\xe9\x84\x6b\x9e\x36\xf0\xfb\x8d\xa4\xf3\x50\x37\xab\x8d\xbf\x61\xc3\x71\xbb\xb3\x4e\x35\x35\xd8\x9e\x20\xf7\xb6\xfb\x32\x72\xfc\x62\x09\x71\x01\x92\x2e\x42\x39\x92\xad\x5a\x36\xcd\x26\x09\xa6\x1a\x3c\xd8\xb5\xd2\x80\x7b\x9d\x81\xf3\x22\x08\x7e\x0f\x8b\x4d\x92\xe3\x61\x9a\x20\x84\xc3\xad\x30\x57\x8b\xce\x82\x38\xcb\x7b\xff\x49\x79\xf7\x00\xe4\xe5\x25\xd8\x99\x71\x70\x2b\x20\xcf\x69\x65\xfe\x5c\x34\x66\xb1\xe0\xbb\x83\xf0\x50\x99\x75\x6b\x34\x68\xcf\x17\x8d\xeb\xda\xd6\x58\x2f\x94\x17\x87\x30\x59\x4c\x18\x84\x9f\xa4\x56\xab\x88\xbb\xd6\xd4\x7d\x19\x99\x50\xb5\x27\x69\x9f\x8a\x46\x39\xa2\xe9\x34\x94\xaf\xd8\xd6\x9a\x4b\x55\x13\xd6\x7c\x3c\x74\xe1\xa5\x5b\x25\x42\xab\x02\x07\xdc\x1f\x99\x3d\x0d\xd3\x33\x91\x55\xfd\x63\xcc\x04\x73\x09\xd6\x29\xa3\x51\x94\x9f\xb6\xb2\x4a\xe3\x7e\xc2\xdd\xda\x4e\x7b\xb5\x06\xa4\x32\x64\x40\xa8\x45\xa3\x66\x56\x5a\x05\x6e\x24\x68\x66\x66\xab\x78\x5f\x3f\x00\xa2\xe3\x6d\x8d\x79\xf7\x05\x40\x74\xd4\xdb\x20\x05\x84\xe2\x79\x8d\x57\xe3\x88\x14\x1e\x1d\x40\xec\x1c\x88\xb9\xb1\xc3\x7b\x67\x22\xce\xbc\x30\x97\x60\xad\xaa\x99\xa8\x04\x7e\x13\x6f\xc3\x38\x45\x90\x8c\x7c\x73\x16\x2c\x2c\xce\x99\x32\x7e\x2d\x22\x2d\xd7\xe6\x5d\x66\x6a\x35\xda\x4b\xa5\xef\x53\x30\x3e\x8d\x4b\xdc\x46\xb5\xc5\x46\x58\x05\x29\xa1\x13\xe2\x6a\x09\x16\xb6\x94\x80\x2b\xd5\x34\x61\x5b\x78\x2a\xb2\x71\x26\xee\xdf\xa5\xa9\xe9\xc3\x70\x92\x6f\xc1\x5e\xaa\x2a\x5c\x5b\xce\x99\x4a\xa5\xdb\x82\x31\x95\xd6\x7b\x00\xd4\x2e\x3b\x6f\x6e\x85\xe2\xc2\xe0\x77\x6b\xe9\x55\x85\x37\x11\xc3\x11\x36\x8b\x6b\x96\xec\x03\x7f\xef\xc0\xf9\x71\xd5\x76\x7b\xb2\xce\x5a\x69\xb5\xee\xd6\x42\xae\x4d\xa7\x91\x16\x9f\x9e\xff\x8c\xf3\x28\x4b\x12\x63\x38\xf7\x1a\xd6\xc6\x6e\x3e\x79\x7a\x1a\xbe\x73\x85\x46\xad\xd5\x9d\x60\x97\x1f\xf7\x84\x9d\x66\xbe\x1b\xe4\x5b\x93\xdf\x00\x39\x7c\x6c\xf7\xb9\x2a\x77\x12\xd4\x71\xa4\x26\x9c\x04\x45\xbf\x92\x62\x95\x38\x35\x12\x7c\x5f\xf1\xb3\xbe\x58\x4d\x69\xbf\x93\x6e\x32\x5f\x4a\x51\xab\xf9\x1c\x2c\x68\x8f\x83\x19\x62\x34\xe1\x7a\x5c\x93\xed\x81\xe9\xb7\x4f\xbe\x7d\x32\x3d\x1a\x2e\x3b\xd6\xd1\x80\xb8\x05\x87\x37\x2e\x1f\x26\x49\x72\xf9\x46\x80\xa2\x7e\x70\xe6\xa3\x6c\x46\x19\x39\x5d\x7a\xdf\x4e\x85\xd1\xcd\x26\x08\x15\x92\xd0\x53\x9a\x64\x2a\x5a\x69\xe5\x3a\x28\x6a\x41\x89\x0b\x92\xb0\xdc\x85\x23\x7c\x8e\xef\x8c\xc4\x4e\x07\xe5\x90\x8c\x7b\x9e\x84\x60\xef\x63\x90\xb4\x1b\xd7\x33\x63\xe2\xee\x4a\xec\xf6\x71\x5b\x42\xf5\x49\x38\xbe\x16\x3a\xc4\xf5\x4e\x10\xe3\xbd\x87\x57\xce\x36\x88\x88\xe2\xbe\x3d\xb8\x27\x5c\xc8\x3f\x4a\x17\x2b\x86\x91\x13\x72\x1f\x84\x7f\xd6\x62\x5a\x5c\x00\xd3\x81\x27\x21\x2e\xa7\xd6\x72\xf1\x89\xeb\xc5\xa1\xbd\xa9\xc6\x6d\xd7\x34\xe3\xd6\x34\xaa\x2a\xc5\xc0\x79\xd7\x34\xe7\xf9\x97\xbd\xa9\x1f\x85\xb9\xc3\x30\x41\xc3\xa2\x6b\xe0\x9f\x68\x84\xff\xf3\x6c\xfe\xca\xf8\x73\x0b\x0e\xb4\x7f\xd4\xd7\x05\x66\xe0\xc6\xfb\xde\x34\x8f\x9e\x41\x6b\x01\x2d\x9f\x73\x1c\x49\x4a\x75\x3d\x14\x11\x34\x6d\x34\x7b\xb7\x99\x96\x0f\x74\x8a\xa6\xfc\xf4\x28\xcf\x7a\x82\xae\x01\x59\x65\x06\x5b\x82\x6c\xfc\x92\x2f\xb0\x47\x3d\x59\x79\x09\x1a\x9c\x1b\x07\xd3\x7b\xaf\xe3\x7e\xf4\x16\xbf\x8c\xea\x16\xb2\x63\x65\xb4\x86\xca\x2b\xbd\x98\x04\x3b\x33\xf1\xed\x9f\x2f\x2e\xce\x27\xe2\xb4\x6d\x1b\x56\x76\xfc\x32\xf2\x48\x5c\x98\x76\x39\xf9\x3c\xe0\x83\x39\xac\x64\x33\xae\xa1\x91\x9b\x3e\x97\xff\xf6\x9b\x1d\x5b\x78\xd5\xad\x67\x60\x83\x98\x77\x50\x19\x5d\x3b\x21\xe7\x41\x7e\xf4\xf1\xbc\x94\x4e\x38\x2f\x6d\xd0\xb6\x67\x30\x37\x16\xd2\x8a\xf9\x9a\x0f\x27\x14\x74\x00\x02\xc1\x43\xfd\x99\x5b\x09\xca\x9e\xe9\xfc\x67\x6c\x82\x84\x02\x8a\xda\x00\x9e\x08\x33\x3a\x61\x3a\xff\x6b\x9c\x44\x0b\x56\x99\x7a\x0f\xe8\xff\x6c\xae\x84\x99\x7b\x40\xbd\xbd\x05\x1b\xf4\xd8\x0c\xf4\x10\xd4\x1b\x80\x4c\x7e\x89\x3b\x53\x7c\x57\x55\x88\xf1\xa5\x05\xb7\x34\xcd\x3e\x50\xbf\x64\x0d\xa7\x32\xda\x41\xd5\xa1\x23\x84\xe7\x01\x97\xaf\x38\xc2\xbb\x21\x2f\x87\x76\xaa\x06\x0b\x75\xfc\x70\xde\x35\x0c\x33\x9d\xd7\x52\x5e\x06\x13\x7a\x2e\x55\xb0\xda\xf6\xde\xf7\x70\xc7\x3c\xe7\xed\xfb\x0e\x0b\x75\x16\x3e\x7b\xdf\x3c\xcf\xad\xdb\xa6\x8d\xed\xda\x32\x22\x04\xea\x4f\xdd\x75\x61\xc8\x5d\xbb\x6b\x0b\xb2\x56\xff\x29\x02\x2e\xad\xfc\x39\x7c\x95\xc1\xff\xd5\x44\x5c\x5a\xf2\x8b\xcb\xb8\xbc\x99\x5f\x5f\xc8\x7d\xe1\xd3\xb8\x2f\x31\x77\x03\x98\x77\x95\x73\x05\xe5\x3f\x04\x41\x77\x87\x03\xba\x4d\xd2\xe5\x9d\x3f\x00\x51\xb7\xe7\xbe\xaf\x97\x75\xc9\x31\x64\xd1\xfb\x70\x1f\x51\x4f\x54\x8b\x9f\xda\xa0\x88\xee\x74\x08\x75\xce\x9b\xb5\xfa\x47\x74\x92\x87\x2d\x9b\x0e\x99\x96\xf8\x44\x55\x84\x77\xb5\x06\x7b\x1c\xe0\xe4\xd0\x4e\x61\x14\xb8\x89\xf8\xcb\x52\x35\x20\xb4\xb1\x6b\x74\x7c\x48\xdd\xf3\x1a\xb1\x21\xee\x84\x14\xad\x09\xcb\xe2\x94\x33\x10\x92\x82\x77\x5d\x4b\xde\x51\x0a\x66\x8e\x84\x33\x6b\x48\xcb\xa3\xc3\xd7\x8d\xc2\x29\x2c\x85\x74\x62\x26\x7d\xb5\x14\x1f\xcc\xcc\x8d\xe2\xc4\xe5\x8c\x95\x57\x97\xe8\x63\x95\x5e\xb8\x16\x2a\x35\x57\x95\x58\x9a\xce\x26\x3f\x57\x2d\x37\x29\x24\x2b\xf3\x32\x28\x9c\xd1\x7b\xa0\x74\xe7\x63\x18\xf5\x07\x63\x69\x65\x86\x02\x45\x70\x1f\x9b\x6b\xe9\xc1\x2a\xd9\x44\x24\x96\x3b\x97\x61\xcf\xbd\x63\x13\x78\x18\x3f\x9a\x99\x50\xda\x79\x90\x75\x58\x52\x06\x41\xae\x6b\x69\x6b\x51\x43\xdb\x98\xcd\x1a\xb4\x1f\x09\xa5\x85\xb1\x18\xe6\x30\xc2\xc9\xcb\x40\x70\xce\x74\xb6\x02\x97\x2c\x69\x9c\xb1\x5c\xb1\x36\xe0\x30\x4a\xa3\x81\x4e\x18\x0d\xc6\xc0\x0c\x50\x4f\x4a\xef\x64\x74\xf2\x87\x1b\x44\xcc\xad\x21\xd1\x36\x37\x4d\x63\xae\xe2\xdd\x5a\x44\x04\x30\xee\x77\x29\x9b\x0e\x91\x1b\x6d\xff\x84\x89\x13\x31\x45\x12\x99\x8e\xc4\x34\xfc\x36\xfc\xf7\xef\x9d\xb4\xfe\x1f\xd3\x09\x5a\x7d\xb6\x6b\x78\xff\x81\x0f\x3b\x17\x18\xab\x44\x4d\x42\x8b\x64\xb7\x63\x82\xe4\x44\x8c\xe3\xe4\x27\xb4\x6f\x3a\x33\x17\xb0\x1f\xcf\xfd\xca\x2a\x1f\x24\xb5\x74\x04\x14\x7c\x6c\x2d\x38\x47\xd4\xf9\x7c\xb2\x98\xf0\x14\x27\x5e\x55\xab\x3f\xd2\x04\xdf\xfd\xfe\xc9\x93\x27\x4f\xa6\x93\x30\xff\x00\xe6\x93\xe8\x03\xd5\x79\x9f\x79\xca\x8c\x64\xbe\x8d\xd3\x05\x77\xc8\x32\xe6\x80\x7f\x71\x20\x5a\x49\x3e\x06\x07\x3e\x3a\x3f\x9f\x1c\x45\x90\xc2\xbc\x27\x5e\xce\xfe\x18\x83\xa7\xdf\x3d\x39\xfe\xe6\xff\xfb\xdf\x6d\xd3\xb9\xff\xf3\x78\xd7\x7f\xfe\x38\x0d\xa4\xcb\x50\x9e\x78\xab\x16\x0b\xb0\x7f\x0c\xd3\x7c\xf7\x84\xbe\x78\x72\xfc\xcd\x8d\xe3\x51\xda\xfe\x8b\x7b\x5b\x23\x36\xf6\x74\x28\x44\xca\x89\xc3\x92\xa4\xbf\x5a\x9a\x66\x18\x4c\x98\x17\x31\x78\xd3\xf9\x14\x52\x08\xd0\xd5\x50\x35\xd2\x42\x8d\x6c\xbe\x11\xeb\xce\xf9\x70\x07\x40\x0a\xc7\x0f\x97\x50\x6e\x0d\xd5\x52\x6a\xe5\xd6\x01\x13\x57\xc6\xae\x44\x65\xac\x85\xca\x37\xbd\x1d\x65\x46\xda\x47\x89\x3d\x45\x14\x49\xe1\xa0\x95\x96\x03\x46\x14\x7e\xf0\x29\xb8\x34\x0c\xd6\x15\xec\x9e\x64\x7a\xbc\xcd\x92\x1c\x61\xc4\x64\x60\x13\x85\xa7\x8d\xa1\x1b\x0e\xc9\x0a\x6a\x01\x1f\x53\x54\x75\xb6\x29\x98\x75\x72\x1a\x83\xfe\x51\xc2\xa6\x35\xd1\x91\x97\xa5\x70\x58\x11\x64\xb5\x8c\x5f\x42\x11\x66\x64\x2e\x60\xa0\xa2\x47\x84\x38\x3d\x7f\x45\x32\x17\x59\x65\x1c\xff\x56\x2e\x96\xd7\x3a\x54\xfe\xd1\xa3\x70\x17\xa3\x93\x47\x28\x5d\x5c\xbc\x53\x63\x17\x13\x89\xd1\xb9\x09\x06\xa1\x26\xab\x93\x18\x8c\x42\xde\xe7\x98\xdc\xe6\x68\xf2\x96\xc2\x9e\x50\x0f\xc5\x5f\xd5\x59\x0b\xda\x37\x9b\xa8\xcf\x25\xa9\xc1\x70\x85\x4b\x2c\x49\xbd\x52\xab\x99\xcb\xa6\x99\xc9\x6a\x75\x2b\x6b\xfd\xec\xa0\x17\xdc\xa2\xb3\x56\xeb\xb6\x81\x70\x25\x90\x88\x67\x3a\xa0\xd5\x05\xe8\xba\x35\x4a\x7b\x71\x18\x97\x3e\x4a\xc7\x9e\x2e\x18\x6f\x37\x98\x1a\x60\x6e\xba\xad\xa4\xdb\x21\x8f\xfb\x54\xac\x09\x07\xd5\x66\xdb\x37\x77\xbd\x49\xc6\x27\xef\xc4\xd2\x5c\xa1\xea\x64\x41\xfa\x3c\x99\xe7\xfb\x29\xc6\x50\xa5\x08\xcb\xfe\x22\x1b\x55\x8b\x70\xe1\x94\x2c\x7a\x32\x16\x07\x98\xc7\x75\x70\x22\x24\xe5\x73\x31\x9c\xa8\x94\xd9\x4e\x17\xf3\x36\x9b\xff\x36\x16\x07\x3f\x18\x3b\x53\xf5\x41\xf2\xbc\x1d\x9d\x04\x8a\x9b\xa9\x3a\x4e\x5b\x00\x62\x3b\x1d\x34\x8d\x95\x6a\xdb\x80\x2e\x0d\x1f\xf1\x77\x42\xcd\x03\x55\x05\xcd\xc8\xe1\xcf\x4b\xe9\xf4\xa3\x47\x5e\xcc\x95\x56\x6e\x09\xb5\xd8\x80\x0f\x6b\xbd\x21\xa5\xef\x20\x12\x48\x25\x75\x05\x8d\xcb\x94\x93\x12\xb6\x3e\x84\x9b\x0e\x23\xc2\x38\xc2\x09\xe5\xa3\x46\xa2\xe1\x4a\x18\x0d\x8f\xee\x1a\x7e\x3a\xed\xc5\x9e\x48\x8f\xd8\xa5\x90\x44\x71\x89\xbc\x2f\x9b\x86\xe5\x60\x40\x2f\x28\xbf\xe4\xf8\x9f\x20\xcd\x00\x95\xfe\xa0\x1c\x14\x9a\x52\x50\x9a\xbb\x35\x58\x71\x88\x4e\xfd\x9b\xb8\x00\xf9\xc6\x45\x86\x8a\x84\x69\x6c\x98\x4e\x3a\x17\xf4\xf3\x3c\x1b\xe0\x9d\x58\xab\x20\x3e\xa7\x28\x46\xb6\x3e\x3a\x9a\xa0\x63\x3a\x46\x76\x50\xf4\x45\xea\x68\x9a\x6d\x10\xdd\x40\x7e\xd3\x07\x08\x62\xd6\x85\xf9\x62\x0f\x3a\xa3\x8b\xaa\xb8\x28\x32\x9a\x22\x64\x5f\xaf\xa7\x3b\x87\x4c\x9f\x1c\x7f\x2d\x1e\xd3\xff\xa6\xa3\x2b\x54\x85\xa7\xbf\xfd\xdd\x9a\xee\xea\xdf\x3d\x71\x53\x0e\xf1\xf7\xc3\x08\x8c\xde\x71\x0d\xb2\x6e\x94\x86\x31\xeb\x0c\x7d\x3b\xe7\xf7\xff\xb6\x7d\xd2\xaf\xf1\xbf\xb2\x11\x71\xa8\x28\x54\x90\x20\x4e\xd3\xd1\x85\x8d\x07\x52\x53\xf3\xb0\xdf\xb5\x42\x0b\x30\x65\x6a\x61\x70\x9a\xf6\x1a\x46\x49\xbd\x09\x26\x8d\x0b\xf7\xa4\x78\xa9\x70\x7b\x41\xcf\x2e\xf9\x13\x03\xc0\x68\x38\x75\xda\xd3\xf6\xc9\x70\x0a\x24\xeb\x7a\x21\xd3\x20\x97\xe1\x13\x76\x97\xe5\x05\x4a\xc2\x2e\x67\xc5\xf1\x14\xa3\xad\x44\x26\x52\x62\xc3\x76\x46\x25\x49\xf0\xee\xd7\x72\xc3\xb6\x9e\x57\xba\x33\x9d\x0b\x16\x0a\x42\x17\xfd\x26\x94\x43\x54\x18\x83\x74\x91\xb2\xb5\x5b\x04\xb4\x72\x1c\xe6\xf7\x4f\x7a\xbb\x0d\xd2\xdd\xcc\xe7\x63\x8c\x5f\xde\x6e\xa9\xf6\xf7\xa8\x93\xa3\xc4\x82\xb7\xe1\xb7\x0c\xd7\x5a\xda\x55\x79\x8c\x09\x20\x86\xa3\x8c\xb3\x7d\x93\xd3\xaf\x6a\x68\x41\xd7\xa0\x2b\xca\x70\xb8\xa7\x54\x83\x67\xc5\x2a\x37\x26\x62\xf5\x83\xe2\xb2\xae\x53\x62\x04\xed\xa1\x98\x26\xa5\x0d\x0e\xe5\x56\xcc\x4c\x0b\x93\x5a\x71\x25\xb5\x8f\x02\x7f\x90\x3d\x20\xde\xbd\x2f\xf1\xd0\x98\xcd\x7d\xa6\x5b\xc4\x15\xf2\xfe\x2d\xb8\x36\xd0\xd1\x8c\x95\x44\xfa\x22\x1e\x62\x36\xe0\xcc\x95\x66\xfd\x6c\xb6\x25\xa5\x47\x94\x92\x36\x50\xb3\x3f\xb6\x8d\xaa\x54\xb8\x44\x28\xaf\x8f\xd0\xa1\x6b\xb0\x0d\xce\x1f\xe8\xdb\x9a\xa6\x61\x01\x8e\x18\x43\x76\x5d\x4b\x2d\x17\xdb\xb6\x69\x6b\xea\x87\x90\xdd\xb6\x52\xba\xde\x43\xcd\xe0\xec\xee\x6b\x11\x55\x83\xc3\x1b\x23\xdb\xd7\x38\xb3\x98\x81\xbf\x02\xd0\x62\x9a\xff\x30\x1d\x95\xfa\xdd\xf8\x83\x99\x91\x24\x5f\x11\x55\x8c\x39\x66\x3b\x65\xf7\x72\xd0\x66\xb6\xcf\x37\x9c\x7d\xbc\xec\xb3\x76\x5b\xda\x22\xc5\x1e\x3b\x07\x63\xe7\xe4\x5e\xea\x61\x58\x1d\xec\x38\x48\x2a\x21\xdb\xb6\xd9\xa0\xb3\xbb\xad\xa5\xa7\x23\x46\xc2\x2a\x00\xc9\x51\xe2\x40\xf9\xd3\xa3\xc9\x2b\xe3\x21\x09\x48\x89\xe9\x7d\x7d\x0e\x0d\xda\xaa\x43\x89\x86\x1e\xa2\x46\x81\xf6\xb4\x5e\xcb\x39\xa6\xa3\x70\xa1\xbc\x7d\x7b\x1a\x08\x3e\x98\xc1\xf2\x52\xaa\x26\x9c\x76\xc4\x5c\xb8\x30\x47\x81\x8f\x4d\x53\x97\x4a\x65\xd5\x74\xce\x83\x75\x93\x01\x8f\x06\xb4\xdf\xab\xa4\x8a\x67\x7e\x2d\x9f\x2e\x40\x83\xcd\x07\x59\xc0\xdc\x83\xb0\xcf\x57\xab\xa0\xdc\xdc\x90\x26\x15\x13\xd2\x78\xdb\x0f\x80\xdb\x5a\x6b\x16\x41\xb3\xb9\xe5\xde\xde\x75\xa7\x95\xb9\x38\x98\xdc\x37\x50\x4a\x7c\x92\x97\x74\x12\x86\x10\x18\x57\xe4\x3b\x2f\x32\x8a\xbf\xe1\x42\x1e\xa6\x98\xe0\x5d\x9c\x51\x79\xa9\xac\xd1\xf7\x4b\x51\xc5\x22\x99\xa4\xba\xe8\x17\xe5\xfb\xcf\x1b\xa1\xf4\x87\x20\x80\x92\x77\xaf\x0f\x9c\x10\x97\xd2\xaa\x70\x6e\x2e\x52\x4a\x49\x45\x29\xd4\x93\x9d\x9f\xd3\x57\xa7\x2f\x9f\xbf\x3d\x3f\x7d\xfa\x3c\x28\xdc\xe7\xaf\x9f\xfd\x2d\xfc\x82\x74\x6e\x13\x74\xf7\x87\x20\xd1\xd3\xbe\xc6\x6b\xf0\xb7\x0b\x3d\xca\xb0\x70\x8c\x4b\x36\x80\x0b\x44\x90\xc1\x91\x71\x51\x9e\x4d\xc2\x2f\x83\x33\x14\x86\x05\x54\x4b\xef\xdb\x71\x6b\xcd\xc7\xdb\xcb\x3a\xce\xad\x69\xe5\x02\x0b\x3a\xd0\x10\xff\xf3\xc5\xc5\xf9\xdf\xce\xdf\xbc\xfe\x8f\xbf\x86\x53\x09\x3f\xbd\xe5\x1f\x09\xb6\x57\xaf\xe3\x8f\xc3\xf3\x2f\x29\xe0\x06\xd8\x2e\xa5\xbd\x7b\xaa\xea\x4e\x3c\x30\x23\xc9\xba\x48\x59\xdd\x49\x73\x93\x8b\x74\x69\xb9\x8d\xf6\xf2\x63\xa0\xf0\x9f\x9e\xff\xf5\xbb\x5f\x4e\x5f\xfc\xfc\x7c\xc4\x12\x7e\xfa\xf2\xaf\x7f\xfb\xe5\xf4\xcd\x77\x07\xeb\x0d\xd9\xea\x07\xd3\x30\xd0\x81\xf0\xc4\xdb\x50\x41\xd0\xed\x00\x53\x78\x8b\x8b\x30\x9a\xd3\x68\xa9\xce\x95\x86\x7a\x37\xbc\x05\x5f\x5b\x6b\xec\x78\x29\x75\xdd\xdc\xa7\x46\xd7\x5b\x86\x8d\x50\x5e\x89\x39\x3d\x32\x06\xf3\xf6\xf3\x30\x40\xfc\x39\xc1\x25\x04\xdd\xbc\x01\xad\xdb\xf8\x65\xcd\xf7\x01\x70\xa9\x85\xf9\x9e\xfe\x57\x44\x99\x88\x28\xb3\x30\xa7\x44\xb6\x94\x19\x6d\xac\x98\x9b\x2e\x98\xdc\x1a\x35\x16\x55\xb1\x02\x9a\xd3\xb0\xe3\xa2\x8b\xea\x9e\xc2\x60\x01\xce\x3f\x3d\x15\x17\x78\x82\x0b\x69\x67\x72\x01\xe3\x2a\x68\xcb\x95\x77\xe4\x05\x49\x1a\x53\xaa\xb2\xd3\x46\x34\x46\x2f\xc0\x0a\x0d\x15\x38\x27\x39\x27\xb5\x6b\x4d\x3f\xc0\x45\xea\xd7\x43\x90\xbd\xb5\x72\x55\x60\xc5\xcd\xb8\x92\xd5\xb2\xf4\xb0\x2f\x94\x5f\x76\xb3\x49\x65\xd6\xc7\xe4\x27\x3d\x66\xff\xe8\x71\xbb\x5a\x1c\xcb\x56\x39\xfa\xc5\xf1\xe5\xd7\xc7\x04\xc3\xb3\x38\xd7\xd3\xf0\xf9\xc5\xa6\x85\xed\x0d\xa5\x6f\x58\x8f\x14\xb8\x2c\x4b\xa1\xb0\xcd\x91\x20\xa7\x93\x98\xc6\xe2\x87\x20\x43\x6b\xe5\x56\xa4\x74\x53\x2e\xef\x74\x4b\x7e\xf3\xef\x8f\x12\xe9\x50\x68\xf5\x1e\xc9\xa7\x8c\xdd\xee\xd2\x20\x63\x82\x67\x54\x21\xf9\xfb\x94\x09\xf8\x55\x74\x49\xec\x96\xb7\x0f\xb9\x62\x33\xa7\x90\x85\xcd\xee\x9d\x4c\xf9\x34\xa6\xc4\xba\x1d\x99\x43\xbb\x8a\x41\x6e\x4f\xa4\xdc\x09\xd5\xde\xe9\x43\x37\x66\x0f\xed\x4e\x70\x2a\x48\x32\x5c\xfc\xd7\x40\x70\xc7\x0c\xa0\x4f\x4e\x00\x2a\xe1\x2b\x73\x80\xc8\x33\x13\x33\x80\x3e\x2b\x77\xf1\xf6\xac\x9e\x01\x82\x72\x7a\xcf\xe7\x24\x1d\x5e\x9b\x8c\x33\xc8\x37\xfb\x42\xd9\x82\xfb\xe5\xd0\x0c\x77\x3a\xc8\x29\x89\xfa\x53\x4a\xa9\xd9\x99\x4c\xf3\x85\xf2\xfc\xf6\xca\x7d\xd9\x0f\x60\xf6\xe8\x5e\x93\x04\xb3\x3b\xa9\xea\x73\x18\x7f\x90\x47\x73\x47\xce\x67\xb7\xc6\xe7\x25\x0e\xee\xc5\xf9\x43\x38\x6f\x62\xfd\x4f\xce\xfe\xfb\x2c\xde\xdf\x99\x00\x78\x2d\xf3\x7f\x42\x52\xdf\xed\xdc\x3f\x44\xd2\x4e\xf6\xbf\x7b\x36\xde\xb5\xfc\x3f\x4c\xc2\xfa\x52\x69\x74\xfb\x49\x80\xad\xdd\x7e\xae\x08\xf8\xac\x04\xb8\xbd\x64\xc0\x9e\x20\xdf\x22\x04\x52\xb9\x86\x46\xef\xcd\x5d\xf5\xae\x2d\xed\xea\x8c\xe6\xd9\x9d\xa5\x46\x15\x2f\x14\xea\xe1\x82\x99\x5c\x53\x88\x71\xd3\x9d\xca\x15\xb3\xad\xe9\x3c\x7a\x2f\xaf\x8c\x6d\xea\x18\x65\x2d\x1c\x7c\xbc\x34\x6b\x60\x2c\xc3\x62\xde\x5e\x64\xf1\x20\x12\xb0\x9b\x83\x8c\x65\x5e\x68\x1c\x5e\x67\x47\x1f\xfa\xa5\x35\xdd\x82\x58\x62\x1a\x3d\xc6\x04\x65\xd8\xe1\xd1\x03\xd0\xea\x96\xc6\xf9\x7d\x02\xfc\x8f\x1f\xbf\xe1\x78\xec\xe3\xc7\x93\x7e\xa9\x13\xea\xc1\xc6\x6d\x15\x8d\x31\xd5\x4c\xee\x1c\xe4\xde\xaa\xb1\x94\x75\x4d\xe9\x86\x44\x3e\xe9\x98\x86\x07\xd2\x39\xcc\x3f\x0c\x82\x3a\xfa\xa8\x63\xe2\x44\x0c\x16\x17\x44\xed\xbc\x32\xf7\x68\x4a\x9c\x85\xf9\x99\xd4\x65\x6a\x4b\x93\xac\x87\xa2\x36\x37\x56\x8c\xc7\xea\x62\x06\x4c\x24\x3e\x58\x83\x5b\x66\xff\x60\xa0\xf3\x4a\xda\xc2\x57\x86\x9e\xc1\xce\xcf\xd0\x00\x3f\x3b\x17\x56\xea\xc5\x83\xb0\x54\x11\x2f\x7b\x90\x5f\xa1\x4b\x48\x71\x88\x89\x53\xe3\x94\x38\x75\x94\xbc\x61\x4f\xcf\x9e\xbd\x11\xae\x9b\x69\x48\xed\x0d\x52\x47\x0b\x86\x62\x46\x14\x63\x2b\x68\x8b\x1c\x47\x3a\x2b\x74\x0c\x8a\xc3\xe9\xd7\x4f\x26\xf8\xbf\xe3\x6f\x47\x5f\xff\xe1\x9b\xc9\xd7\xbf\xc7\x1f\xbe\xfe\x66\xf4\xf5\xff\x1f\x7e\xfa\x96\x7e\xfc\x7d\xb4\x57\xb3\x15\xd7\x53\x0e\xe8\x78\x6e\xc5\xf1\x0f\x86\xfd\x11\x40\xce\x35\x14\xe1\xdc\x50\x65\xca\x47\x3d\x41\x5a\x9d\x28\x73\x4c\x93\x4e\x27\xe2\xfb\xb4\x68\x11\x07\xa7\x8e\x20\x94\x88\x88\x65\x99\xa8\x36\x09\xa3\x4b\x9f\x7c\x20\x16\x6d\x3c\x75\x19\xd1\x91\x9e\x73\x5d\x6b\x84\xff\xc3\xe5\xfa\xfe\x3c\x70\x3f\xfe\xf2\x72\xe0\x53\xf7\x43\x91\x82\x9f\x84\x33\xb4\x9d\xde\xea\x54\xf3\x00\x68\xbb\x86\x59\xb7\xb8\x15\x8c\x53\xce\x6c\x0b\x52\x60\x6d\x3c\xd0\xb0\x05\xa7\x48\xb3\x9b\x8a\x7f\x09\x36\xde\x99\xd2\x7b\x59\x2d\x21\xa5\x59\x8b\x88\xb1\xe8\xb3\xa5\xdc\x5b\xac\x5e\x9d\x1b\x7b\x25\x6d\x4d\x7c\xd5\x03\x6e\xec\x3a\xd7\x82\xbe\x1d\x57\x6f\xe9\x3b\x3a\x05\x2f\xed\x02\x3c\x1e\x8f\x5a\xaf\xa1\x0e\xf7\x73\xb3\x29\x15\x54\x2a\xfe\x6c\xa4\x73\xe1\x74\x1b\x23\x6b\x3c\x8f\x1c\x7e\x52\xda\x8f\x91\x89\xf7\x58\xfb\x3c\x7c\xed\x58\x33\xc6\x21\x7c\x66\x39\x25\x84\x89\x45\x0d\xeb\x30\x1b\xb3\x70\xd7\x58\x12\x5b\xa8\x90\x75\x6d\xa3\x4a\x7d\x8b\x37\xd4\x4a\xed\xb0\x64\x97\xc7\x08\xe9\xa3\x6a\x6c\x50\x14\x81\x4e\x7a\x98\x86\xab\x66\x23\x1a\xd9\x69\x3c\xae\x00\xe6\x10\xa0\xc7\x27\xbf\x7b\xf2\xe4\x77\x3d\x90\x0c\x2e\x75\xf7\x68\x40\x98\x3e\x8f\x4d\xa1\x98\x70\x12\xad\x8c\xfa\xd3\xcd\x11\xf6\xba\x56\x9c\x77\x14\x26\x4b\x43\xc5\x61\x30\x99\xa6\x2f\x94\xee\x3e\x4e\x8b\x5f\xb3\x10\x36\x36\xfb\xe8\x3e\x98\xc6\xac\x94\xbc\xc7\x9b\xf5\x47\x5a\x21\xde\xad\x89\x83\x7a\x3d\x7e\x88\x64\xe2\xa7\x3f\xca\x4b\x29\xe4\x02\xb4\xa7\x94\xff\xb7\x00\x18\x0c\x72\x27\xc7\xc7\x0c\xf0\xc4\xd8\xc5\xb1\x05\xac\x8b\xaf\xe0\x78\xe9\xd7\xcd\x31\x8e\x70\x93\xf0\xef\x7f\x7d\x81\x53\xc9\x71\x05\x76\x1f\x6d\x2e\x20\xf1\xfc\xf9\x4b\x01\xba\x32\x41\xb7\x7d\x7a\x2a\xc2\x48\x35\x8f\x5e\xfb\x70\x48\x22\x1c\xf0\x28\xc1\x7b\x09\x56\xcd\xa3\x87\x97\xa1\xc8\x83\xc0\x8d\xd8\xeb\x1f\x76\x82\xa6\xf5\xb4\xb5\xc6\x9b\xca\x34\x98\xe6\x87\xe5\xeb\x8e\x63\x65\x94\x0a\xd1\x8c\x39\xed\x40\x76\x7e\x09\xda\xf3\xe2\xf1\x5a\x0d\x83\x90\x59\xb3\x05\x7e\x7c\x29\xed\xb1\xed\xf4\xb1\x83\xca\x82\x77\xc7\xb9\x2f\x43\xb8\x1c\x59\x5d\x92\x15\x26\xae\xc5\x1f\xc7\x95\x9c\x54\xd6\xc7\x69\x03\x67\x26\xea\xea\x5d\xd8\x0c\x4d\x10\x4f\x95\x6a\x65\xb3\x27\xfb\x61\x49\x7a\x1c\x73\xe8\x8e\x58\x16\x60\x62\xf9\x2c\xf6\xdf\x52\x5a\xc8\xe4\x1d\xcf\x58\xc3\xcc\xa9\xa4\x03\x09\x21\xd1\x82\x8c\x8a\x60\x24\xde\xa8\xc4\xfe\x1a\x28\xa6\xef\xcf\xe3\x7e\xbe\xab\xf4\x77\x6e\xe3\x3c\xac\x4f\xd6\xd2\x61\x6b\xc3\xa0\x24\x61\x05\x88\xfe\x6e\x29\xaf\xbc\x32\x63\xa3\x1b\xa5\x61\x42\x3f\x4d\xdc\x65\x15\xe7\x47\x48\x2a\xfd\xdd\x3c\x40\x13\x34\x70\xd3\xc0\x24\xfc\x80\x1f\xdd\x70\x14\x39\x82\xb1\x2f\x77\xbd\xc8\x72\x17\x73\xff\x2b\xe9\x7c\x6c\x92\x52\x86\x5d\xd9\x85\xdc\xeb\x16\xe2\x41\xd7\x50\x47\x54\x55\x4b\xd8\x23\x89\xfb\xa5\xd4\x29\x1b\x67\xc7\xb9\xf2\x25\xe4\xf2\xa9\xcf\x1b\xb9\x88\x57\x54\x5c\x92\xd1\xb4\x82\x8d\xe8\x9c\x5c\x04\xc3\x17\x15\xfa\x5f\xe3\xa0\x49\x35\xbc\xfe\x08\xf6\x34\x0c\x03\xf5\xff\x39\x18\x7f\xf1\x32\xf4\xa6\xf0\x13\x45\x0a\x46\x39\x9a\x7a\xe9\x29\x1d\x24\x0a\xd6\x69\x4c\x0f\xfe\xd7\xe3\x83\x08\xa5\xb1\x62\x7a\xc0\xba\xf7\x01\xee\x14\x99\x67\x14\x5d\x02\x60\x1d\x0e\xa6\x0c\x30\x0c\x38\x69\xf0\x58\xe3\x80\x3a\xfd\x5c\x56\xb0\x75\xbd\x1e\x3c\x3e\x18\xb4\x4d\x91\xce\x5d\x19\xbb\x4f\xbe\x19\xb2\x36\x7f\x4e\x82\x10\x53\x68\x7b\x28\x1e\x89\xe1\x61\xa1\x37\xa0\x73\x60\xd3\xbe\x5a\x4a\x6e\x45\xbd\xfc\xce\x8d\x63\x76\x08\x02\xea\x18\x52\x74\x2f\xf9\xc3\x1f\xbe\x9d\x0e\x5b\xb4\x21\xbd\xec\xbb\x49\xfe\x9c\x7d\xa3\x39\x5e\xc7\x7d\x5d\x6c\xa2\xb9\x7e\x3f\x12\x87\x14\xc4\xdb\xcc\x74\xd4\xcf\x7a\xb3\x7b\x02\x81\x59\x9f\x39\x68\xb8\x03\xd7\x5b\xd9\x74\xd7\x90\xfd\xad\xdc\xfb\x97\x25\xe0\xfe\xb6\x39\xd7\x15\x1d\x1f\xaf\x81\x62\xb7\x4a\x79\x03\x2b\x7d\xaa\x3a\x27\x0b\x65\x8c\x29\x80\xa7\x12\xd2\xc5\x94\x0a\xa5\xef\xa8\xc8\xfc\x06\xff\x3d\xfe\x70\xb9\x1e\x93\xb2\xf4\xee\xc7\x5f\x5e\x46\x81\x8d\x7c\xda\xef\xf2\xc5\x4b\xe6\x8c\xdb\x95\x5c\x43\x03\xfe\x1e\xb3\x8e\xe3\x0a\xd9\x44\xbc\x2d\x41\xe3\xa7\x38\x42\xe9\xe8\x60\x7a\xb0\x49\x19\x9f\x50\x08\xc2\x58\xa0\x14\x07\x3e\xf8\x3a\x23\x25\x5c\x14\x7e\x09\xca\x26\x4f\x67\xef\x88\x63\xdc\x3d\x7b\x45\x93\x43\xa3\x17\xb7\xda\xeb\x42\x78\x7a\x4d\x55\x1b\x03\x43\x9d\x48\xd1\x52\x32\xb2\xce\xf9\x33\xb1\x3a\xa7\x38\xb2\x4c\x70\xfd\x9c\xdc\x7d\x2c\x8b\x44\x69\x5b\xb4\x35\xf0\x77\x5c\xef\xa0\x8b\xac\x86\x32\x7d\x98\xe5\x7b\xb6\x55\xff\xcb\xd3\x32\x8c\xa3\x6b\x2a\x7f\x0b\x8f\x74\x4e\x58\x25\x93\xe4\x0d\x2f\x21\xf5\xf5\xb3\x47\xa0\x81\xd3\xe5\x02\xa9\x8c\x5d\x25\x9b\x30\xf0\x30\x1c\x33\xff\x30\xf6\x66\xfc\x0f\xb0\xe6\x88\x92\x75\x67\x9d\xe7\x0e\xb2\x73\x90\x9e\xfc\x68\x16\xa8\x5f\x97\x85\x06\x2e\xa5\xf6\xf9\xf2\xa2\x82\x34\xac\x18\x0a\xfa\x6c\xe7\xf0\x3f\x52\xa3\x63\x35\x5d\x42\x38\x71\x76\xab\x3e\x08\xb6\x8a\xd8\x41\x03\xf6\x6e\x7e\x47\x5f\xd0\x4e\x31\x15\xfb\x39\xe2\x82\x5c\x46\xe4\x0d\xba\xd7\xa6\xcb\x56\x4e\x8a\x8f\x27\x4c\xc9\x93\x1a\x2e\x4b\xa5\x67\x75\xc3\x67\xe5\x62\x47\x93\x37\x81\xbb\xa3\x7d\x10\xc1\xa9\x4d\xd5\xa5\xca\xc1\xc2\xcc\x59\x63\xd9\x8b\x0e\x8c\xb2\x95\x8d\x5e\x62\x63\x0d\xde\xc6\x16\x00\x9f\x8b\x0e\x9a\xeb\x3a\x7c\x14\xc5\x85\xc9\x7b\x4a\xd5\x20\x56\x4c\xab\xb6\x9b\xf2\x8f\x77\xdc\x73\xda\x6d\x54\x77\x6f\xdf\x33\x79\xb1\x6e\x53\xbe\xde\x02\xbb\x9e\x50\x3e\x60\xb5\x68\xda\x80\x68\xe0\x12\x9a\x00\xf9\xd3\xf3\x9f\x45\x0b\xb6\x0a\xe0\x2c\x50\x03\x6d\x41\xd7\xd4\x76\xb7\x70\xcf\x6d\xa3\xe9\x28\x97\xce\x9e\x9b\x7a\xcf\x8d\xf2\x8c\x37\x1d\xee\x5a\x69\x94\x0a\xb0\x8f\x72\x19\x9b\x42\xe6\x02\xa5\xf3\xd4\x86\x3e\xeb\x42\x51\x00\x4a\x8f\x25\x64\x98\xfb\x9d\x81\x19\x7a\x67\x29\xca\xf6\xf8\x71\x10\x41\x8f\x1f\x17\x17\xca\x48\xac\x41\xb2\x24\x95\x7e\x78\x47\x07\x0d\x39\x80\x1d\x0d\xa3\xda\x5c\x61\x80\x2f\x4c\x43\xe2\x49\x1b\x5f\xa8\x65\xd9\xdb\x53\x17\x9d\x21\xf1\x9a\xdf\x85\xcb\x34\xeb\x2e\xd2\xb9\x16\x97\xf2\xe3\x7e\xb8\x3c\xd5\xa2\x6b\x5b\xb0\x82\xc2\x30\xc9\x03\xb8\x03\xad\xec\xc5\x8d\x38\x55\x1a\x5b\x24\x36\x0d\x34\x05\xf7\x0e\x71\x1a\x09\x62\x29\x9d\x08\xb2\x2f\xe0\xa6\x92\x2d\x47\x0d\x70\x5e\x22\x3c\x97\x6b\xab\x9c\x97\x4d\x43\xc3\x11\x21\xf1\xb8\xf6\xe1\xa5\xeb\x10\x62\x4d\xd3\x98\xce\x8f\xeb\x52\x7b\xb8\x59\x6e\xc4\xec\x6e\x6f\xc4\xc2\xca\xba\x43\x9d\xc5\x05\xed\x2f\x08\xf2\xf9\x5c\x55\x29\xef\x2a\xa8\xe0\x5e\xbc\x81\x4b\xe5\x62\x64\xcb\x81\x2f\x7b\x02\xf3\xfa\xa9\x68\x70\x72\x5d\x4e\x1d\x0e\x8e\x6e\x98\x5e\x31\xa7\x14\x7f\x32\x8d\x64\x48\xb9\x1c\x7d\xf2\xac\x8b\xed\x0f\x69\x1b\xc2\x02\x57\x99\x93\x9d\x67\xc3\xb1\x72\xb1\x1b\x27\x46\x60\xee\x33\x02\xfa\x99\xfa\xdb\xf5\x85\xbc\x03\xbd\x22\x15\xf4\x46\x0f\x9c\xd1\x64\x23\x38\xb1\x34\x4d\x7d\xf2\xb8\xa7\x3b\x60\x6c\x25\x55\xbb\xf0\x4c\xac\x29\x3d\xc6\x7b\x34\x97\x05\x8b\x1b\xeb\x82\xf1\xe6\x27\xd9\x9c\x6a\x79\xf7\xac\xf0\x8d\xa6\xc5\x76\x9d\xef\x50\xc1\xfb\x32\x8a\x1d\x2b\x74\x7d\xfc\xb2\x3f\xc9\x45\x37\x05\x75\x44\x8e\x43\x52\x5a\xf0\x57\xd1\x6b\xc5\x0a\x35\xf6\x51\x48\x3a\x6a\xe6\xd7\x42\xd2\x85\xa5\xe7\x5d\xd3\xa4\xc9\xfa\xd6\x13\xef\x9f\xe6\xc3\xd2\x11\x52\xb1\x4e\x5f\x3e\x7f\xf1\xb7\x9f\x5e\x9d\x5e\x9c\xfd\xf2\xfc\x6f\x4f\x5f\xbf\xfa\xe1\xec\x4f\x3f\xbf\x39\xbd\x38\x7b\xfd\x2a\x7c\xf2\xe3\xdb\xd7\xaf\x04\x71\xdf\xa4\x68\x52\x5e\xca\xb1\xd4\xb7\x80\x0a\x91\x82\x81\x8a\x69\x4d\x3e\xc2\xd3\x87\x63\x2b\xbc\x46\x27\x4f\xb3\xc7\xc6\xc5\xe8\x28\xda\xb6\x02\xb2\x66\x38\xa0\xa1\xd4\x06\xe2\x21\x98\x55\xdb\x26\xcf\x2d\xca\x4e\x1f\xa0\x68\x62\x15\xe7\xbc\x6e\x1b\xf0\x5b\x07\xde\x3f\xbd\x12\x80\xa5\xd4\x1a\x9a\x71\x49\x6b\xb7\xbb\x03\x5e\xb0\xfd\xc4\xa3\x39\x5a\x2a\x5d\xac\x40\x30\xf3\xbe\x99\x4b\xc7\x1a\x80\x67\x1f\x64\xe4\x68\x6c\x30\x11\xa7\x61\x33\xcc\x58\xa2\x15\x22\xaf\x9f\xdf\x9c\xb9\x9d\x00\x2b\xbd\xfa\x6c\x70\x6b\x70\x5e\xe9\xd4\xdc\xe2\xbe\x60\x8e\xd6\xc9\xaf\x82\xe5\x9d\xeb\x7e\x02\xb2\xe2\xe0\x2f\x82\xad\xe4\x24\xdc\x0b\x5d\x97\xf0\xc9\xb8\xc2\xb1\xf8\xbd\xcb\xa5\xdb\x5b\x15\x92\x33\x10\xae\x9b\x85\xe1\x33\x64\xa4\x00\x78\xbe\xbc\xa8\xb1\x12\x03\x5e\xcc\xb7\x0d\xb5\x38\xe4\xbc\x4d\x99\x1b\xd2\xcc\xac\x59\x81\xcd\xdd\xac\xa3\xf6\x14\xee\xac\x03\x16\x5e\x07\x47\x3b\xf6\xfb\x29\x67\xb4\xd7\x6e\x5b\x6b\xea\xae\x82\x1b\x4e\xe7\x13\x37\xd9\xdb\xc5\x5c\x35\x1e\x2c\x1f\xdb\x38\xd2\xec\xad\x22\x36\xaa\x61\x34\x9c\x9f\x05\x41\x80\x06\x45\xf9\x4b\x90\x35\x58\x71\x50\xc1\x98\xaf\xe6\xa5\x72\xde\xd8\xcd\x41\xec\xff\xfd\x56\xe9\x8a\x05\x2f\x7f\x1c\xd4\xd2\x19\x80\xc6\x3c\x86\x4b\xba\xe9\x34\x5c\x81\x2d\xdf\xce\x60\xd9\x39\x2a\x40\x48\x0a\xc2\x35\x55\x11\xa9\x95\x86\xd2\xab\xf1\x4c\xe9\x3a\x0a\xeb\x9b\x1f\x8c\x42\x77\x0e\x7f\xbe\x2b\x67\x50\xe2\x84\xd8\xdc\xbd\x70\xaf\x28\xbd\xfa\xbe\x58\x42\x24\xcd\x60\x72\x81\x77\x4c\x71\x25\xa4\x3b\xb1\x37\x31\x5a\x95\x8e\x66\x5f\x34\x80\x8b\x4c\xca\x94\xc3\x68\xf5\xee\xb8\x5c\x6f\x9d\xe8\x10\x3e\x56\xd0\xee\x1e\x91\x03\x3d\x54\x1b\x3e\xdb\x94\x4e\x29\xdc\xc3\xd1\x9d\x95\x54\x7e\x4c\x26\xe9\x52\x39\x2e\x87\x6e\xad\x78\x0f\x17\x37\x7f\x4e\x1f\xe2\x97\x67\xee\x31\x0d\xe0\x05\xbf\x6d\x73\x43\x1e\xd1\x8e\x87\x3a\x0a\xc0\x44\xf2\xf1\x1d\xc6\xdc\xba\xca\x34\xd8\x24\xa5\xe6\xfb\xfb\x88\x14\xa4\xf8\x8c\xce\x4c\x56\x2b\x08\xea\xa1\xcb\x95\x6f\xb3\x8d\xf8\x9f\x9d\xb4\xab\xce\x91\xaf\xed\x0a\xfd\x6c\x43\x2d\x30\x19\x59\xd4\xb5\x30\xba\xec\xff\x4e\x23\x27\xca\x1c\x2f\x3a\x55\x83\x3b\xe6\xa5\x1e\x84\x42\xd5\x18\x7b\x3b\x18\xe1\xab\xd8\x88\xaa\x31\x0b\x61\x3a\xdf\x76\xe5\x33\x19\x84\xe9\x3d\x34\xb2\x17\x66\xe1\xc4\x1a\x1c\x86\x4a\xd3\xa8\x44\x70\x70\x09\xfb\xc4\xbb\x4e\xeb\x0f\xc1\x26\xf4\xc5\xb1\x92\x27\xe7\xb0\x2c\x37\x3f\x7b\xf5\xc3\xeb\xd2\xfb\xfd\xc1\xed\x11\x56\x7a\x8d\x5b\x8b\x53\xbb\xa8\x0b\x0e\xa6\x19\xb7\x16\xbc\xdf\x60\xa2\xc1\xed\xf9\x81\xcc\x83\x07\x34\x88\x92\xa7\x94\x5e\x1c\xc4\x0e\x61\xa8\x6c\x86\xd5\x12\xe7\xa1\x23\xe4\xfe\x82\x33\x2f\xd1\xcf\x72\xdb\x7b\x49\xa6\xe9\x82\xf6\xb1\xe6\x16\x42\x66\xeb\xd1\x1e\xdc\xe9\xf9\xc3\x68\x4f\x42\xfb\xda\x57\x63\x78\x94\x23\x78\x7d\x29\x80\x6a\x19\x97\x9a\xd0\x5f\xd6\xb2\xe5\x5c\x96\xc9\xc5\x12\x76\x46\x65\xd0\x34\xff\xd8\x92\xf5\x48\xd1\xd1\x9f\x2f\x7e\x18\x7f\x5b\xd4\xb2\x4a\x47\xbd\x0c\x25\x3d\xf9\x53\x91\x9f\x63\xb6\x49\x22\x8f\x14\x8c\xa7\x46\x7b\xf8\x98\x5a\x69\xe9\x5a\x78\xab\xd2\xa4\xad\xb4\xac\x7b\x26\xdf\x2d\x12\x4b\x00\x6c\xc3\xef\x51\x3a\x23\xd6\xb2\x86\xdc\x0a\x84\xcf\x35\xea\x73\x29\x2d\xac\x6c\x6a\x0a\x92\xac\x52\x65\x39\xc9\x81\x5c\x03\xcd\x26\x37\x24\x7d\x13\x54\xda\xc9\x5b\x2c\x41\x3f\x11\xef\x12\x6e\xfe\x49\xb8\x79\x7f\x12\x8e\xe1\xdd\xf1\x0a\x36\xef\x63\xf3\x11\x7a\xba\x08\x6b\x90\x93\x9f\xc6\xf5\xda\xb3\xd0\x1f\xc3\x36\x57\xb0\x61\x49\x8f\xce\x80\x6b\xbe\xe7\x89\xc3\xc7\xdc\x88\x02\x75\x14\xa8\xfb\xad\x8b\xef\xa6\x2b\x17\xb4\x50\x74\x6b\x09\xc7\x10\xe8\x73\xa6\xb4\xb4\x1b\x54\x14\x41\xfb\xa3\x5b\x09\x64\xa0\x94\xb8\x5d\xc4\x41\x0d\xbe\x18\x05\x01\xc0\x6b\x97\x2b\x66\x2c\xcd\x0d\x3c\xc0\xd8\x02\x8d\xd2\x00\x64\x52\x56\xf0\x41\x40\x34\x4a\xa8\x95\x18\x25\x01\xa6\xb6\x8f\xbd\xfe\xcf\x9d\x03\xbb\xdf\xa1\xbe\xfb\x1f\x61\x9e\xf7\xa3\xeb\x4f\x75\xb0\x73\xfc\x64\xb4\xe7\xc1\xee\x38\xd2\xf4\x26\xab\xa0\x1d\x0c\x46\x0e\xd1\x51\x52\x00\x4b\xb6\xbb\x9f\xff\x79\x50\x83\x5d\xc0\xbc\xf8\x05\xe7\x10\x4f\x1b\xa9\xd6\xb1\x5b\x03\x4b\xca\x89\x48\x18\x6b\x2f\x2b\x5c\xf2\x38\x65\xb4\x1e\x23\x9a\x72\x4b\x69\xd3\x82\x96\xad\xba\x3f\x59\x1f\xfe\x78\x7a\x7e\x26\x9e\xbd\x7d\x71\x73\xf7\xaf\xa0\x6f\xe7\x2e\x49\x65\xdf\xe8\xaf\x92\x45\x26\xd3\x74\x81\x60\x1e\x8e\xdc\x5f\xcb\x76\xdf\xe3\xce\x42\x3c\x0c\x42\x97\x6c\xb4\x3f\xc2\x9e\xe3\x9d\xcd\x78\xc8\xe7\x78\x75\xaf\xef\xc5\xbd\xbe\xca\x6f\xc5\x81\x76\x1c\xbf\x93\x94\x2f\x80\x87\xd6\x6b\x26\x35\x83\xc6\xe4\x7a\xc8\xa1\x8d\x31\x03\x8c\x7a\xf2\x28\xba\x45\xa4\x76\x73\x74\xae\x6a\x6d\x3c\x37\x9e\x0e\x7f\xe9\xbf\x0b\x5b\xda\x37\x86\x7d\xaa\xfc\x4c\xd7\xa0\x9f\xd5\x03\x20\x0d\x32\xd0\xc6\xc5\x8e\xef\x40\x22\xfc\xc0\x6b\x89\x2e\x12\x02\x11\x95\xb6\x97\x30\xcf\x6b\xdd\xe9\x35\xd9\x62\x19\x3e\x85\xed\x15\x52\xb2\x58\x3d\xbb\x47\x33\xed\xfc\xd9\xf7\xfd\x24\x8b\x2d\x57\xf4\xb9\xa9\x9f\x29\x67\x3b\x1c\xf4\x7d\x57\x2f\xc0\xf7\x6f\xe5\x18\x0e\x3b\x7b\x78\x9d\xed\xd6\x4a\x8f\x93\xba\xb4\x87\x75\x72\xd1\x0b\x19\xe2\x13\xc0\xbb\x76\x8f\xec\x8b\xb1\x2d\xe7\xd9\xcb\xd6\x5f\x25\x3e\x6f\x20\xb5\x80\x4b\x55\x71\xa0\x6c\x78\xaf\x6b\x21\x67\xce\x34\x9d\xcf\x8b\x5a\xea\x02\xcb\xc1\xec\xc9\x6b\xdd\x6c\x84\xd1\x71\x52\x33\x17\xd3\xde\x96\x38\x0b\x75\x2d\x3f\x8e\x3b\x5d\xfc\x96\x17\x4a\xaa\xc1\x30\xa4\x5a\x7c\xfc\x85\xb1\x12\x6d\x92\xbc\x00\xa1\x22\xe9\xbd\x9f\x85\x90\x22\x01\xf2\xeb\x94\x5d\xbb\x8d\x14\xe5\x48\x5d\xe6\x42\xab\xa3\x84\x47\xc2\xe0\x10\x5b\x84\xc3\xde\x14\x51\xa7\xde\xc2\x63\xe2\x5a\xe6\xd7\xfb\xbb\x37\x06\x29\x77\x98\x86\x37\x43\x6d\x1e\x7f\xa6\xc6\x82\xd9\xed\x22\x9d\x53\x0b\x3d\x78\x17\x02\xb7\x91\x27\x32\x83\x3f\x4f\xc4\x99\x16\x15\x5b\x08\xf9\x3b\xe5\xb0\x8f\x2d\x56\x41\xf9\x18\xa0\x22\xa4\x62\x1e\x06\x91\x8c\xe1\x6b\xa8\xd0\x4f\xe3\x0c\x13\x81\x0e\x3b\xce\x76\xc2\xb0\x16\xa2\x30\x6a\x2d\xf3\xae\x11\xfc\x42\x1d\x7c\xf4\x8e\x14\x4f\x9a\x35\xfc\xeb\x91\x13\xda\xa4\x76\xeb\xec\xea\x11\x92\x9b\x92\xef\x78\x06\xb5\x07\x3d\x85\x40\x8d\xee\x61\xb7\xff\xa0\xad\x03\x1f\x34\x25\x87\x1d\xda\x47\xc2\xb1\x3b\x95\x96\x0e\x24\xba\x9e\x01\x96\x1d\x0f\xde\xd0\x13\x16\x16\xca\x79\xbb\x79\x08\xb5\xc5\x74\x3a\xe3\x32\x5b\xf6\x96\xb2\xdf\xad\xf3\x3c\x84\x75\xeb\x37\x47\x19\xb7\xc9\xf7\xb9\x83\x56\xca\xb5\x17\x8d\x99\xf5\x4a\x38\x76\xaf\x79\xa6\x6b\x4e\xfb\x55\xf3\xfe\xb4\xc5\xc3\xef\xac\xeb\xd0\x94\x98\x6d\x49\x29\x8b\xd2\x15\x62\x91\xfe\x1a\x54\x72\xac\x87\xca\x72\x22\xb0\xe4\xdd\x5d\xaf\x5b\x35\xd0\x35\x78\xa8\x8a\x47\x3c\xca\x4e\x6a\x6a\xbe\x83\x05\xfa\x02\x24\x6e\xe2\x50\xf9\xdc\xdf\x99\x7f\x57\x52\x2a\xe6\x04\x1e\x15\x52\x06\xfb\x36\xdc\x97\x6e\x80\x2f\xc5\xf4\x74\x83\x65\x7e\xda\xa0\xd7\x08\x7f\xeb\xea\x17\xdc\x20\x59\xc6\xe7\x7a\x96\x20\xa6\xe7\xa6\x7e\xdb\x42\x75\x01\xeb\x00\x31\x60\x2a\x47\x57\xa5\x27\x3c\x72\xfc\xbd\x9c\x6e\x3a\x09\xa2\x61\xd2\x9a\x3a\x8d\x23\xad\x43\x41\x53\x8f\x72\xf8\xbf\x1c\x53\xd4\xd3\x52\x5a\x0f\x8f\x8c\x95\x97\xfc\x08\xbd\xaa\xc4\x1a\xec\x82\x9b\xaa\x52\xf0\x64\x2b\x92\xb0\xf5\x42\x4f\xe6\x79\xb2\x87\x8b\x7c\xcc\xd8\xa7\x1b\x46\xc1\xd6\xc6\xb5\x92\x6c\xe9\xbf\xe1\x39\x78\xec\xf3\xfa\x06\xca\xad\x35\x6b\xf0\x4b\xe8\xee\xdc\xdf\xe1\x2e\xaf\x13\x9d\xa7\x55\xa2\xeb\xb0\x2c\xe9\xce\x7f\x1d\x57\x66\xdd\x4a\x8f\x0d\xb7\x62\x24\x93\xf0\x16\x1f\xa3\x26\xaa\x0d\xa3\xc2\x71\xbf\x34\x5a\x79\x63\xa7\x49\x61\xcc\x65\xb3\xc4\x25\xb1\xfe\x9f\xef\xd1\xca\xca\x96\x13\x0c\x13\x6f\x45\xbf\x3d\x66\x50\x44\x7b\xad\x00\x38\xf2\x34\xc5\x02\x28\x75\x2f\xf9\x5e\xb0\xe3\x01\x0d\x7b\xa9\x2a\x6b\xce\x09\x5f\x38\xe5\x4b\xfa\x74\x22\xfe\x72\xfa\xe6\xd5\xd9\xab\x3f\xb1\x81\x88\x66\x72\xf1\xc6\xc3\xae\x6d\xe4\xf7\x94\x30\x20\xc7\xe1\x82\xa2\x7f\x5b\x65\x2c\x18\x77\x9c\x4f\x6f\x1c\xc1\x7c\x77\x5e\x9e\x28\xd6\x65\xe0\xef\xdf\xc7\xeb\x2b\xad\x81\x25\x04\x2a\x86\xc4\x66\x29\x63\x0c\xea\x89\xf8\xab\xe9\x10\x69\x98\xb7\xd9\x9a\x7a\xbc\x66\x10\xe3\xdd\xcb\xa5\x54\xe9\xfa\xdb\x3a\xe1\xf4\xfe\x88\xf2\x4b\xc3\x6e\xf1\xe2\xa3\xd7\x25\x56\xc9\x1b\x3c\x9c\x41\xed\xee\x95\xf6\x00\x5e\xb7\x29\x10\x76\x2b\x30\xb1\x18\xe5\x1a\x82\xc6\xae\xf5\x51\x7a\x0f\xfb\x3c\xef\x5e\xf2\xee\xa6\xe2\xee\x95\x69\x9a\xed\x0a\xa7\x1e\x3d\xe4\x08\x2e\x01\x55\xdc\x1d\x5d\xd3\x8c\xc9\xdb\x77\x9f\xf6\x65\xd7\x34\xe2\x2d\xae\xc2\x64\xe3\x28\x72\x8a\xef\x04\xd3\xf2\xd1\x05\xd1\x9a\x7a\x94\xfd\x55\x83\x37\xbb\xbc\xe1\xd6\xfc\x97\x43\x31\x4c\xaa\x17\x39\xb1\x74\x7a\x30\x27\xe9\x62\x24\x17\xca\xe5\x8a\x47\xab\xb2\x73\x74\x2d\x35\x65\x3e\x1a\x8b\xcf\x7e\xa1\xda\xbb\x31\xdd\xa3\x22\x69\x8c\x44\x53\x59\x6c\x44\x8f\x36\xa4\x45\x71\xda\x0c\x59\x04\x21\x6e\x70\x5a\x5c\x52\xe7\x8c\xf0\x29\xe9\xd0\xf4\xb6\x11\xc1\x57\x68\xed\x01\x6c\x9c\x14\x37\xb9\xdd\x20\x67\xbb\x39\xce\x26\x48\x86\xfc\x98\xf8\xa7\x80\x8b\x42\x3a\xdc\xfa\xce\x61\x98\x08\xe5\xf5\x10\xaf\x8a\x1d\xdc\xad\xc5\xfa\x6e\xac\x17\xdc\x98\x8e\x18\x2a\x6d\xbc\xff\x16\xda\x0e\x68\xc2\x06\xd1\x29\x89\xfb\x1b\x11\xf8\x52\x27\x56\x0f\x0c\x4d\xe7\x8f\x1c\xff\xaf\x2f\x5b\xe8\x0c\xf7\x7e\x95\x7c\x40\x9a\xe8\x5c\xe7\x7c\x7c\x26\x9a\x73\x53\x23\x72\x1b\x98\x7b\x81\x0a\x37\x41\xa2\x5c\xff\x9e\x64\x98\xbc\x5c\x81\xce\x8a\xe8\x4e\x92\xcb\xe7\xd3\xb3\x95\xb6\x9e\x13\xc7\x37\xc0\xc1\x8e\x6b\x68\x60\xb1\x77\xed\x9e\x4e\x6f\xcb\x0d\xb5\x6e\x6e\xfc\x84\xe8\xac\x93\xc8\x19\xd1\x7e\xb8\x6a\x26\xc5\x3c\xe2\x92\xe9\x22\xe6\x4a\xe7\x12\xb2\x69\xec\xeb\x2e\xac\x49\xf1\xae\xbc\x1e\xe6\x58\xb5\x32\x45\x8f\x76\x74\x89\x4a\xcd\xac\xa8\x2c\xfd\x33\x33\x85\x07\xfd\x0d\x92\xb9\x92\xf0\xbd\x25\xf0\xb2\x93\x82\x6e\xd4\xb0\xd9\x4d\x0b\x62\xda\x2f\x9e\xaf\x4d\xb5\x02\x4b\xd3\x7f\x70\x46\x17\x72\x9c\x93\x14\xee\xc7\xd1\x80\xda\x21\x27\x50\x6c\xab\x86\xbe\xf8\x63\xac\xe0\x0b\x12\xea\xda\x1e\x5e\x13\xf1\xea\xf5\xc5\x73\x2c\x43\x6b\x55\xc3\xb1\x34\x29\x38\x11\x86\x94\x67\x7c\xc4\x40\xa8\x09\x4c\x4a\xa5\x6f\xda\xca\x6a\x15\x0e\x3e\x60\xe7\x3b\x1a\xc0\xaf\x44\x04\xac\xe9\x66\x93\x1f\x39\x42\xc1\x12\xab\x14\x47\x42\x3a\x71\x05\x4d\x13\xfe\xfb\xd7\xd3\x97\x2f\xd0\x9d\xf3\x1f\x2f\x5f\x0c\xde\xcf\x64\x05\x96\xc5\x57\x7c\x2d\xd3\x8b\x06\xa4\xf3\xe2\xdf\xfe\xa4\xbe\xc7\x97\x29\xb1\xe7\x2d\x6b\xb1\xc8\x9b\x65\xf1\x59\xdc\xc8\xac\x53\x4d\x3d\x8a\x2e\x18\x9c\x92\x5d\x58\x3d\xf2\x3c\x0f\xf7\x1d\xeb\x67\x38\x04\xe7\xeb\x15\xa8\x14\x7f\x63\xa3\xa5\x20\xb2\xba\xe7\x7e\x8d\xa7\x7f\x34\x2a\x9e\xcd\x03\x8d\x2d\xd0\x08\xec\xec\x84\x7c\x10\x4a\x5a\x71\xe0\x7d\x5d\xe9\xee\x7d\x92\x99\x48\xcf\x69\xca\x8b\x4d\x0b\xd7\x68\x5a\x91\x9a\x79\x71\x4a\x05\xcc\x05\xd7\x73\xe9\xfc\xf8\x83\xb4\x54\x74\xcd\x54\xb8\xa3\x11\x2e\x7f\x75\x34\x89\xfe\xb3\x99\xf1\xcb\x72\x38\xba\x14\xe3\x78\x7c\xa1\x2b\x2a\x22\x23\xe1\xaf\x4c\x4f\x6c\xff\xa4\x52\x7b\x8c\x7e\x6c\x99\xf5\xce\x11\x8a\x55\x14\x91\x71\xc6\x95\xf2\xb1\x61\xe0\x8e\x06\xf0\x05\x20\xd1\x43\xa2\x83\xf0\xac\xa8\x33\xe1\x06\xb3\x1d\x28\x43\x40\xe9\x79\xd3\x85\xc1\x39\x68\xdb\x74\xa5\x54\x8e\x95\xa1\x61\xc5\xfe\x33\x82\xa5\x1f\x11\xcb\x86\x51\x76\x58\xea\xd9\x5c\x17\x3d\x3d\xe7\xca\x3a\xdf\xc3\x78\xf2\x81\x90\xd3\x32\xe5\xe5\xd1\x80\x5e\x4d\x12\xe3\x57\x1b\x01\x1f\x95\xc3\xf8\xe6\x2a\x7a\x3f\xd7\xc1\xac\x87\xad\xee\x54\xf4\x65\x91\x0c\x17\xa5\xf3\x3d\xaa\xc1\x6f\xe2\x05\x50\xe8\xc0\x5d\x2b\x5e\xca\x4b\x6a\xa7\x19\x6b\x03\xce\x7a\x6e\x44\xaa\x86\xc1\x8f\x58\x2e\xb5\xc6\x05\xb5\x7e\x73\x83\xc7\x00\x3d\x11\x7b\x6c\xe5\x66\x99\x8f\x49\x1f\xb7\xe5\x11\xf9\x81\xbd\xdc\xf7\xa8\xb2\x4b\x64\x57\xb5\x14\x59\xda\x45\x47\x8e\x98\x08\xc2\xd9\x0f\x4e\xf0\x8b\x61\x44\xee\x75\x59\xfe\x92\x54\x9b\xc6\x54\xb2\xa1\x34\x18\xd4\x0c\x02\x4f\x62\x27\x97\x00\x06\xd5\x2f\x4d\xe9\xbe\x9d\x0a\x33\xfb\x00\x15\x57\x6b\x60\x27\x83\x30\x7f\xe7\x92\x53\x19\x4b\xbc\xd6\xe0\xc1\x86\xab\xab\xe6\xba\xaf\xe9\x38\x8e\x3f\x84\x8f\x72\xdd\x36\x70\x22\xa6\xbe\x71\xe3\x02\xf4\xf8\xc9\x11\x59\x28\x5c\xde\x4d\xce\xaf\xde\x16\x9d\x37\x96\x5c\x5c\x32\xc1\x35\x11\xe7\x37\xaf\x8b\x42\x7c\xa9\x16\x71\xf3\xad\x55\xc6\x2a\xec\x4b\x48\x85\x30\xd9\x3d\x8f\x16\x04\xe2\x3c\x6f\x86\xbb\x5a\x8c\xa8\x92\xb1\xb7\x85\x15\x6c\xe2\x2a\x04\x6c\x90\x48\xfc\x07\xb2\x49\xf4\xd6\x87\xd1\x32\xe1\xf7\x84\x21\xc8\x70\x6c\x95\x12\x6c\x50\x6b\x24\x35\xd9\x4a\xef\xec\x2e\x29\xb9\x03\x1b\x3f\x95\x3d\x1c\x94\x8b\x24\xcf\x78\x70\x53\x1c\xe7\xb9\x45\x99\xb2\x99\x0e\xb8\xa3\x58\x92\x2b\xe9\x4d\xe2\xf2\xc4\x4a\xcc\x63\x55\xd0\xf5\xc7\x34\xda\xda\x14\x29\x11\xf4\xbd\xbc\x61\x48\x91\x76\x72\xcd\x87\xd8\xb7\x8a\x3a\xc8\x20\xa6\xe9\xe6\x77\xb1\x0d\x64\xf2\x79\x91\xec\x54\xe1\x96\x59\xb0\xb6\x1f\x3b\xab\xfa\xae\x8d\x69\xaf\x0f\xe1\x8e\xde\xb7\x0d\xcd\x3e\x0d\x49\x91\x74\x7b\x41\xfc\xc6\x8d\x3d\xd8\x35\x23\x7d\x4f\x6b\xea\xe2\xc5\x5b\x51\x8c\xc2\x11\x23\xd1\xa8\x15\x88\x29\xd4\x0b\x08\xc7\xd9\x4a\xe7\xb8\x3b\x2c\xdd\xe4\x16\x40\x57\x76\xd3\xfa\xe9\xae\x4a\xcc\xec\x7e\x27\xf6\xda\xae\xc8\x2c\x7a\x81\x5c\x53\x97\x39\x20\xc7\x3b\x6c\x66\xd8\xb8\x08\x5b\x85\xf4\x0a\x68\x6f\x84\x8f\xb7\xf2\x49\x50\x66\xf7\xd0\x3e\xc0\x96\x26\x6c\x94\xe7\x05\x5b\x12\xac\x83\x1d\x71\x85\x5e\xce\x31\x46\x75\xfe\xa0\x30\xa2\x31\x07\x0d\xff\xf5\xfe\x80\x5c\x25\x54\x52\x3b\x48\x0a\x2b\x16\x1f\x71\xb4\x28\x17\x9c\xb3\x81\x10\xb4\x9c\x00\x14\x47\x19\xa2\xb7\x25\x87\x5c\x82\xf6\x33\xa2\x57\x9c\xae\x14\xb9\x7f\x92\x97\x55\xe2\xd0\x64\xd6\x8b\xa2\x89\x06\x9b\xb5\x07\xc7\x07\x77\x38\x97\xc1\x89\x24\xa5\xe9\xda\x73\x59\xc1\x3e\x6f\xfd\xee\xa2\x9a\xf2\x62\xbd\x4f\xca\xc9\x42\xf5\x1e\x29\x26\x7c\x94\x9d\xd2\x82\x69\xe7\xcb\x50\x4d\x64\x7b\x8c\xeb\x7c\x19\xaa\xe1\x29\x23\xed\x7c\x09\xaa\x89\xa9\x12\xfb\x71\xb3\xfc\x44\xb1\xd3\xeb\x3a\xf8\x2b\x49\x9e\x5d\xb7\xea\x97\x26\xa5\xfe\xbe\xfe\x8b\x92\xf6\xa6\xa4\xeb\xf5\x9f\x3d\x8f\xa8\xcc\xdb\x1d\x50\x17\xe7\x70\xb8\xe4\xd9\x47\xbc\x46\x13\xb3\xa7\x47\xe7\x98\x3e\xd9\x8e\xf4\x08\x73\x9a\x79\x22\x4a\x17\x64\xba\xd7\x7b\x1a\x01\xe6\x9e\xa8\x06\x38\x8b\x80\x67\x9c\x15\xef\x65\x95\x19\xf2\xa8\x82\x23\x02\x2d\x6a\xbf\x82\x2d\x5d\x7e\x5c\x08\x1b\x12\xa6\x2c\x4a\x07\x55\x97\xee\x9d\xf8\x7c\x85\xd1\x93\xb3\x79\x5c\x16\x9a\x3a\xbe\xd9\x59\xda\xfc\x51\x01\x22\xcb\x24\xe6\xb4\xc4\x4e\x12\xc5\x06\x79\xee\xa7\xa7\x48\xe6\xf1\x19\x86\xa0\x50\x21\x55\x5c\xca\x46\xd5\xb1\xdf\xba\xd2\x0b\x04\x6a\x69\xac\x4f\x9e\x4e\xfc\xec\x90\x7f\x9a\x24\x17\xe9\xc4\x5d\x56\x47\x39\x7f\x5f\x55\xcb\x18\xf5\x57\x7a\x6e\x25\x85\xea\x83\xfe\xc6\xcf\x74\xc2\x40\xa9\x1f\xbe\x32\x4c\x1d\x49\xef\x53\x9d\xba\x55\x21\xff\x92\xa2\xe3\x7a\xe2\x8d\x45\x97\x59\x91\xf9\x02\x22\x24\xbb\x85\xbf\x9c\x08\x89\xdc\xfe\x9f\x27\x42\x94\x26\xfe\x18\x07\x45\xbc\xd4\xed\xc7\xad\x69\x54\xb5\x97\x7e\x53\x9a\x12\x4b\x73\x15\x00\xad\x41\x36\xb4\x83\xb8\x40\x6c\x5c\x42\x0d\xb7\xc5\x14\x2b\x4e\x83\xe6\xff\x8c\x0c\x9f\xb2\xbd\xd3\x1b\x88\xdd\x30\x78\xd0\x97\x55\xe2\x72\x5c\x88\xfa\xdd\xe6\x22\xd9\x7b\xcb\xe6\x88\x7d\xc8\xbe\x8f\x05\xb6\x65\x0e\x4f\xe7\xd8\x62\x66\xe9\x14\xfe\xc9\x03\xb0\x24\x2e\xaf\x7a\x82\xdb\xd9\x91\xdc\xb0\xfa\xd6\x8d\x07\xdb\x71\xc7\x41\x98\xfd\x66\xf0\x5b\x71\xea\x52\x76\x7d\x6a\x5f\x1c\x7d\xf6\x94\x1a\x0b\x97\xa6\xb9\x24\xf7\x39\xc5\xb4\x5c\x37\xfb\xc0\x60\x55\x4b\xa9\x17\x0f\xc2\x57\xcd\xfb\xbe\x6b\xd5\x7a\x89\x77\xcf\xe2\x43\xbc\x7b\x27\x5b\xb5\xb0\xa6\x6b\x8f\xdf\x73\x71\xf6\xc9\xfb\x95\xd2\xf5\xc9\xbb\x24\xac\x8f\xdf\xa3\x21\x32\x58\xfe\xee\x34\x75\xa3\xcf\xb2\xdf\x83\x8f\xac\xf5\x6d\x67\x2a\x4b\x8e\xf8\x71\xca\x4e\x70\xb1\xd1\x23\xbd\x6f\x1d\x5b\xe3\x57\xb9\xfe\xcd\x50\x5e\x45\x7e\x8e\xd2\x89\x43\x74\xe1\xe5\xb0\xcc\x51\x12\x74\x41\x5c\xe5\xbb\x8a\x53\x8e\x76\x87\xc2\x39\x57\x50\xf5\x13\xc2\x62\xeb\x25\xc9\x09\x5b\xb9\x43\x4b\xcc\x4b\xa6\x38\x0d\xbd\x73\x23\xfb\xdd\xf4\x1e\x40\xdc\xf9\xcb\xe4\x2d\x56\x26\x68\x1d\xf3\xe2\x40\x35\x40\x1d\xcb\x13\x38\xe0\x50\x2e\xab\x4d\x0d\xe3\x41\xa7\xdc\x1b\x4b\x65\x13\x55\x19\x6e\xfc\x64\xb8\xb0\xf1\x95\xa9\xe1\xbc\xdf\x39\x37\xbd\x28\x98\x2e\x11\xd3\x40\xca\x5c\xbe\x27\xf9\xa9\x5c\xe9\xa4\xbf\x48\x2b\x3a\x8a\x9f\x6c\xa7\x3a\x96\x9f\xe4\x76\xe1\x87\xb3\xce\x8b\x9a\x72\xcc\x39\x9e\x78\x14\x83\xbe\x88\x4f\x57\x2d\xa1\xee\x30\x6a\xed\x0d\xe2\xd1\x11\x33\xad\xf9\x89\x77\xe1\xa5\x0a\x16\x97\x78\x0b\xd0\x13\xc5\x5b\xa1\x61\x77\x5c\x19\x5d\x41\xeb\xdd\x31\xcf\xaa\xf4\x62\x1c\x13\xe9\x8f\x71\x9e\xb1\xd4\xf5\x38\xe3\xef\x38\xc5\x0e\xb1\x9b\x58\x0d\x5e\xaa\x26\x36\x1c\x4a\x5f\x15\x79\xb6\xb9\x45\x17\x7a\xb0\x9d\x5a\xab\x46\x06\x6d\x55\x63\xf9\xd2\x92\xfd\x37\x41\x2f\x47\xb0\x29\x84\x3b\x12\xd3\x9f\x60\xf3\xee\xbb\x5f\x82\xc2\xf3\xfe\xe4\xf9\x7c\x0e\x95\x7f\x77\xf2\x96\x5e\x2e\x7b\x3f\x8d\x45\x85\xa8\x10\xa1\x1c\x74\x7f\xef\x02\xd1\xcf\xac\xac\x56\xb1\xb5\x18\x76\xc3\xe4\x4a\xc2\x89\xf8\x21\xfb\xb2\xdd\x89\x18\x8b\x29\xd2\xa0\x35\x0d\x4c\xfa\x98\xa1\x9e\xe8\x27\xaf\xcc\x5b\x46\xf5\x34\x7e\x3d\xf8\x90\xfb\x54\x97\x59\xff\x27\xaf\xcc\x73\xca\xe5\x3c\xf9\xed\x93\x27\x4f\x48\x61\x18\xd3\xb3\xa8\x18\x82\x76\xae\x3e\x39\x47\x35\xb1\x9c\x9f\x82\xdf\x0f\x34\x2d\x8e\x0e\xee\x0e\x79\x69\xb1\x78\x92\x06\xa2\xc6\x44\xa4\x83\x1d\x56\xf3\x95\x76\x33\x0d\x64\xee\xb6\xb2\xba\xdf\xa6\x14\x17\xb4\xc2\xee\xb8\x56\x5f\x32\xb6\xdd\xac\x51\x6e\x19\x81\x2a\x95\xba\x98\x8f\x26\x29\x33\x3b\x4e\x5a\xe4\xc6\xf2\xb3\xc6\x31\x29\x35\x17\x48\x60\x4a\xd3\x96\x10\xe6\xbe\x1d\x29\x6a\x12\xd7\x4c\xf9\xb1\xb9\x58\x86\xd1\x9a\xae\x42\x71\xc8\x69\x0f\x4e\x3c\x7e\xfc\xa3\x84\x05\xd8\xc7\x8f\xb9\x2f\xc6\x45\xc2\xa7\xb8\x39\x65\xf3\x5f\x9f\x34\xef\xd0\x10\xa5\x48\x8b\x1a\xf6\xca\x9e\xe7\x72\x95\x40\xa0\x55\xd3\xa1\x96\x9a\xbe\x8f\xb9\x47\x65\x1f\x95\x5d\xe7\xb1\x43\xf5\xbb\x4b\xc2\x17\xda\x5e\x3c\x75\xb4\xca\x51\x09\x61\x9a\x03\x97\x56\xac\xa5\x97\xbd\xd6\x17\xd9\xb1\xd4\x53\x70\xc2\x94\x47\x3b\x1a\x5e\xed\x09\x11\xbf\x1a\x94\xe8\xad\xa8\x84\x8d\xd4\x1d\x01\x3d\xdc\x4d\xbb\x3b\x7a\xe7\x94\xf0\x38\x14\xd7\x76\x98\xcc\x71\xa3\x59\x4e\x43\xb8\x4a\x3e\xea\x04\x07\x95\xd1\xce\x1f\xec\x9a\x1b\x03\x82\x77\x9c\x3c\xf5\x71\xc2\xc1\xc5\x32\x5f\x1f\x14\x0d\x10\xa1\xbe\xcf\x07\x73\x7e\x7a\xfe\xec\x74\x87\x44\xe2\xd6\xf6\x4c\xc9\x5b\x2a\x37\x8e\x8a\x4d\x6b\xc1\xba\x58\xbe\x30\x08\xda\x73\xe6\x4a\xca\x6e\x2d\x52\x59\x73\x36\xfd\xd4\x5b\xb5\x58\x80\x75\xd3\xf8\x1a\x42\xb9\x76\x4a\x2e\x2c\xc6\x5e\x2a\x29\xd6\xd2\xae\x82\x2d\xc9\x22\x29\xf6\x33\xa7\x0b\xba\x10\x97\x41\xeb\x66\x4b\xbd\x73\xbc\xdd\x52\xb0\xa4\x46\xe8\x29\x8f\x25\x63\x64\x44\x9e\x98\x30\xf6\xa3\x85\xf9\xc9\x9b\xd7\xaf\x2f\x4e\x62\x6a\xd7\x71\xfc\xc7\x18\x9b\x19\xc8\xda\x54\xbf\xe1\x5f\x8d\xc3\x99\xe1\xaf\xdf\xc5\xc8\x31\x4e\x1a\x83\xb1\x03\x98\x29\x27\x00\x7b\xfc\xbc\x8f\x99\xac\xe2\x4a\x6a\x5f\x94\xb1\xa5\x6f\x93\xae\x13\x3b\xa4\xe1\xcc\x6b\xf0\x32\x70\xeb\x9e\x10\xd7\x70\xb9\x03\xe0\x1a\x2e\xf7\x83\xb7\x86\x4b\x68\x4c\x8b\x25\x2e\x11\xec\x01\x2d\xf5\x65\x3d\xb3\xc1\xff\xb3\xf2\x7e\x12\xbb\x8e\xe5\x82\xc5\x24\x4c\x95\x0e\x07\xc6\xa8\x23\x46\xc8\x16\x51\x22\xeb\x32\xec\x2d\xab\xd5\x38\x9c\xbe\x35\x98\x1f\x6b\x01\x6d\xd8\xdb\x7b\xbc\xbd\x05\x9f\x1c\x22\xe3\x7f\x8f\xc3\xd8\x2b\xcc\x2e\x64\x6f\x5a\x6e\x79\x94\x57\x60\x6d\x03\x3e\x86\x01\x2a\x7a\xf2\xc8\x8b\xa8\xe6\x45\x35\x66\x4a\x9e\xc7\xcd\x18\xcc\xd1\x58\x68\xf5\x0f\xa0\x7c\xee\xa0\xb8\x57\x92\x34\x89\xd4\x3c\xad\x57\xa5\x80\xd9\xd8\x63\x6c\x22\x71\xd9\xab\xd0\xbb\xe6\xbd\xdb\x33\xfe\x52\x1c\xf2\x6b\xc4\x47\xe4\xb9\x81\x6a\x45\xad\xb3\x19\xa3\xa2\xef\x0d\xac\x8c\x69\x6a\x73\xa5\xf7\x7e\x7c\x38\x10\xf7\x15\x76\x33\xc3\x01\x62\x06\xfe\x0a\xb8\xd8\xb0\x91\x8e\x1f\x1a\x83\xb4\x9c\x05\xce\x05\x2d\xda\xee\x17\x77\x67\x72\x53\xcc\x64\xb5\x0a\x10\x3f\xe9\x25\x3f\xd7\x0d\xc4\x43\x1d\x57\xdc\xb0\xe9\x16\x00\x91\x18\x93\x23\x2b\x75\xe9\x61\xaf\x5b\x3c\x0f\x14\xd6\x7d\x08\xb8\x8d\x76\x01\x5c\x2e\x4a\xc8\x55\xd5\x91\x56\x86\xd5\xeb\x77\x84\xf2\xe5\x56\x3b\xf1\x9d\x13\xcb\x8f\x77\x9e\x58\x7e\xdc\x63\xe2\xc8\x5e\x7d\x1b\xe3\xfa\x7c\x4e\x59\xd7\x46\xbb\xe3\x20\x1b\x27\xe1\xff\x5d\xd0\xf8\x1d\xe6\xc8\xb3\x5c\x2c\x15\xd8\x3e\xb2\xb1\xac\x2a\x63\xcb\x64\x43\x3c\x88\xd8\xbb\xf7\x79\x41\xa0\x8c\x7f\x4c\x9d\x8d\x82\x7d\x1a\x40\x9c\x32\x7b\xf6\x72\x17\x79\xb6\xa8\xc9\xc9\xe1\x75\x5c\xb4\xb0\x41\x7f\xfb\x31\xf1\xea\x5a\xb6\x38\xa6\x16\xd3\x78\x5f\xa4\x5a\x40\x54\xb2\xa2\x3a\x92\xb8\x86\x73\x81\x4e\x75\x32\x80\x79\xda\x69\xbf\x98\x22\xe5\x20\xf1\xbd\x5f\x43\xd5\x50\x96\x3d\x36\x17\xa1\xd9\xf8\xa5\x91\x32\x21\xbf\x69\x44\xa3\xf4\x8a\x27\x45\x8e\x05\x8d\x25\x2b\xf3\x22\xc0\x80\x59\xda\x71\x8b\x65\x09\x47\x4a\xc2\xca\x3e\x9a\xdf\xc6\x7e\xec\xf7\xa5\x2f\xfd\x96\x99\xe8\x76\x1b\x2e\x16\x2c\x97\x9d\x3c\xe2\xe5\x4d\x72\x32\xce\x65\x92\x22\x8b\x08\xca\x31\xae\x54\x1f\xa0\x6b\xb1\x96\x2b\x92\xa3\xc9\x1a\x0b\x3a\xda\xe9\xf9\x59\x50\xaf\xe4\x02\xf2\x6b\x21\x5b\x60\xfe\x97\xe1\x95\x2e\xe2\x52\x73\xc7\x27\xff\xf7\x55\xd9\xf1\xe3\x94\x2c\x6b\x88\x6f\x2a\xdf\x33\xa3\xfa\x0f\x60\xf5\x5e\x92\xdb\xf3\x8d\x4d\x7a\x55\xcc\x2f\xd9\x31\x15\x26\x0f\x27\x1c\x64\x3a\x5b\x67\x65\xa1\xdf\x71\x7f\x89\x3d\x5f\x0c\xc3\x07\xc2\xf2\xfc\x2e\xbf\x66\x1e\xfd\xa1\xc5\xeb\x61\x4f\x06\xef\xa1\xa6\xb9\xc6\x9f\xbe\xa3\xc0\x5f\xe3\xd8\xeb\x29\x3f\x2c\x79\xdd\x26\xb9\x93\xd5\x04\x2b\x4f\xb2\x5d\x44\xfe\xb5\xfb\x8c\x64\xbd\xc4\x15\x6e\x69\x50\x33\xec\x1d\xbb\x65\xb7\x04\x6d\xca\xca\x05\x94\xef\xfb\xe4\x4c\xfa\xc0\xc3\xb5\xa1\x46\x93\xd8\x2b\x17\x9a\xe2\xe1\xee\xd4\x6a\xff\x31\xed\xf6\x31\xce\xc8\xa9\xcd\x1c\x92\xc5\x68\x70\xb8\x2f\xb0\x40\x57\x57\x5c\xa7\xf7\xc8\xa5\xd7\x4f\x07\x25\xcd\x83\x6a\x66\x9c\x92\xa6\xcf\x26\x38\x7a\x59\x71\x9d\x18\xc4\x0c\x2a\xe9\xe1\x01\x3b\x35\x1b\x53\xad\xf0\x14\x3c\x34\x61\xf7\xeb\x93\x99\xf1\xee\xe0\x68\x32\x99\x4c\xb9\xca\x86\xdd\x49\xc9\x9f\x2d\xeb\xda\x09\xee\xb3\x11\xae\x05\xd4\x1a\xb9\xbb\xd1\x2d\x65\x51\x29\x21\x22\xba\xbe\x2c\xc8\xfa\xf8\xca\x2a\xdf\xeb\xa8\x15\x10\x16\xfe\x92\x7a\x00\x04\x1c\x94\x69\xc2\xa9\x7f\x72\x6a\x0a\x5d\xc4\xae\x44\x5a\xcd\x2f\xa5\xce\x2d\xa2\xb7\xde\x78\xea\xa5\x92\x3f\x00\xbf\xea\xbd\xca\x50\x72\x63\x41\xac\xd1\x0b\xff\x68\x03\xb2\x75\xa5\xe0\x76\x83\xe4\x2f\xd4\xb0\x43\x39\x7e\x01\x33\xbe\x18\x30\xea\xeb\x09\x52\xcb\x66\xf3\x0f\x8e\xfe\xb1\xde\x8a\xa1\xa2\xa4\xb7\xca\xba\x16\xe5\xca\x31\xe6\xc1\xd7\x1d\xc1\x96\x5b\xa9\x4f\x9e\x07\x92\x2e\xeb\xbb\xb6\xe8\x5a\xad\xc1\x4e\x47\x59\x2d\xd6\x62\x8a\x6a\x21\xff\x65\xab\xfe\x31\x80\x90\x9f\xe9\xe7\xee\x5a\x25\x48\x37\x77\x7a\x9e\xec\xe8\x23\xb9\xaf\x53\xef\x55\xe1\xd0\x4b\xec\x40\x2e\xbd\x98\x97\x11\xa9\x2b\x25\xb9\x8b\xb0\xd3\x49\xd0\x5b\xd1\xb5\x97\x53\x64\x0e\xfe\x7b\x41\xde\x08\xc1\xbf\x8f\xc3\xb7\x07\x93\x9d\xcb\x1c\x37\xd8\xc2\x33\x37\x95\x8c\xab\xc6\x1d\xde\xbe\xf6\xcd\xab\xee\xc2\xcb\xbe\x6e\xbc\x4d\x8b\x00\xef\x90\xbb\x51\x14\xe0\x7b\xfa\xa6\x5a\xe1\x13\x69\x07\xf4\x84\xd5\x4b\xd9\x1e\x04\xfe\x3b\x78\x11\xb6\x46\x2d\xe2\xc3\xff\xf5\xe0\xa5\xbf\x95\xd0\x61\x3d\xfc\x9e\x69\xb2\x2f\xf0\x2d\xfe\x9d\x27\xa4\xea\xa0\xdf\xce\xd1\x74\xa3\x60\x1d\x25\xa4\xa5\x5e\x81\x88\xbc\x5d\x20\x21\x79\x4e\xc8\x84\xc1\x67\x1e\x0b\x94\xee\x80\x14\x4d\x82\xbd\x61\x2d\x1e\x12\xba\x2b\xc4\x0c\xeb\xf6\xa1\xef\xf2\x22\x4f\xbe\xfa\xbf\x01\x00\x00\xff\xff\x55\xcd\x57\xf3\x15\xca\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\xbd\x7d\x73\x23\x37\x92\x27\xfc\xbf\x3f\x05\x42\xfb\x44\xe8\x25\x48\xaa\x6d\xef\xcc\xfa\xd1\x4d\xcf\x9c\xdc\xdd\xb6\x65\xf7\x8b\xae\x25\x7b\x67\xa2\xaf\xc3\x04\xab\x40\x12\x66\xb1\x50\x0b\xa0\xa4\xe6\xdc\xdc\x77\xbf\xf8\x25\x12\x28\x14\x49\x49\x54\x77\xcb\xbb\xda\xdd\x98\x88\x71\x4b\x2a\x24\x12\x99\x89\x44\x22\xdf\xe0\xad\xd4\xde\x9d\x7c\x31\x14\xb5\x5c\xaa\x13\x21\xa7\x53\x5d\x6b\xbf\xfa\x42\x88\xa6\x92\x7e\x6a\xec\xf2\x44\x4c\x65\xe5\x14\x7e\x63\xcd\x54\x57\xca\x9d\x7c\x21\xc4\x50\xfc\xd4\x4e\x94\xad\x95\x57\x2e\xfc\x58\x4b\xaf\xaf\xf0\xd9\x50\xbc\x69\x54\x7d\x31\xd7\x53\xff\x85\x10\xa5\x72\x85\xd5\x8d\xd7\xa6\x3e\x11\xa7\x55\x65\xae\x9d\x28\x4c\xed\x30\x73\xad\xeb\x99\xb8\x9e\xeb\x62\x2e\x6a\x53\x2a\x27\xfc\x5c\x09\x5d\x7b\x35\xb3\x12\x03\x44\x63\xca\x03\x77\x28\xa4\x55\x42\x55\x7a\xa6\x27\x15\x26\x10\xc2\x1b\x31\x51\xc2\x15\x73\x55\xb6\x95\x2a\x85\xa9\x07\x62\x22\x1d\xfd\x4b\x54\x72\xa2\x2a\x87\x7f\x01\x1c\x00\x0f\x84\xb1\xe2\x5a\xfb\x39\x01\xb7\xc3\xc6\x94\x69\xa5\x42\xd6\x25\xc1\x94\xb5\xd7\xc3\xf8\xdb\xad\xe0\x1a\x53\x02\x45\xe9\x09\x21\x59\x59\x25\xcb\x95\xb0\x6d\x4d\xeb\xc8\xe6\x73\x23\x82\x78\xe6\xf7\x9d\x28\xb5\x93\x13\xe0\x38\x59\x89\x52\x4d\x65\x5b\x79\xfc\xb5\xb1\xa6\x51\xd6\xeb\x48\xcd\x40\x7e\x55\xd3\xb7\x34\xda\xaf\x1a\x75\x22\x26\xc6\x54\xf4\x63\x8f\x8e\xcf\x64\x0d\x02\xb4\x40\xd1\x1b\x1e\x86\x45\xf2\x6c\x42\x0a\xd0\xd7\x8f\x40\xf1\xf0\x4f\x27\xdc\x1c\x68\xfb\xb9\x06\x03\x96\x4b\x53\x13\xdc\x84\xca\x6a\x94\x21\xd2\x98\x32\xd1\xe2\x4e\x6c\x4e\xab\x6b\xb9\x02\xd0\x61\x65\x0a\xe9\x95\x13\xcb\xb6\xf2\xba\xa9\x94\xb0\xaa\xa9\x74\x21\x9d\x30\xd3\x0d\xe6\xea\x40\x30\x27\x97\x8a\x31\x01\xaf\xc4\x01\x53\x49\x1c\x91\xdc\x1d\x1d\x6e\xe0\x95\x33\xea\x4e\xe4\x5e\xab\x2b\x65\x7f\x17\xdc\x80\x7d\xc2\x6b\x18\xa4\x30\x43\x6f\xff\xdd\x7b\xe7\xad\xae\x67\xfb\x9b\x48\x3e\x57\x53\x5d\x2b\x27\xa4\x70\xca\x83\x56\x3b\x6f\x87\xb0\x15\x18\xc7\x9d\x37\xc4\x06\x49\x3f\x0f\xd6\xb4\x41\x0e\x00\xb6\x5a\x09\x3f\x37\x4e\x89\xa5\xf4\xc5\x1c\xdb\x03\x6b\x21\xe8\xc2\xa9\x4a\x15\xde\xd8\x01\x63\x6d\x55\x45\xaa\x03\x4b\xc1\x57\x33\x7d\xa5\x6a\xa2\xa9\x6b\x64\xa1\x0e\xc3\x96\xf3\x73\xb5\x85\x14\x6e\x6e\xda\xaa\xc4\x5e\x48\x1c\x2e\x19\x2c\xf6\xfb\xad\xa2\xf3\x58\x17\x5b\x1b\x7f\xcb\x82\xe3\x72\x27\xad\xae\x4a\x65\x7b\x8a\xdc\xdb\xf6\xf3\xe8\xf1\xcb\xb9\x8a\x13\x04\xed\x22\xb4\xa3\xfd\x63\x6b\x59\x55\xab\xa4\x98\x4a\xe5\x95\x5d\xea\x1a\x6a\x47\x89\x89\x72\x5e\x40\xf1\x7b\x35\xe3\x8d\x6b\x02\x18\x28\x61\x9c\x0a\x53\x3d\x6b\xad\x12\x67\xdd\xda\x7f\xd2\xde\x3d\x02\x7d\x79\xa5\xec\xc4\x38\x75\x27\x22\x2f\x08\xe1\xf8\xb9\xa8\xcc\x6c\xc6\x67\x47\xa0\x43\x61\x96\x8d\xa9\x55\xed\xf9\xa0\x71\x6d\xd3\x18\xeb\x85\xf6\xe2\x40\x8d\x66\x23\x46\xe1\x27\x59\xeb\x45\xa4\x5d\x63\xca\xbe\x8e\x4c\xa4\xda\x51\xb4\x4f\x45\xa5\x5d\x90\xe9\x34\x94\x8f\xd8\xc6\x9a\x2b\x5d\x06\xaa\xf9\xc8\x74\xe1\xa5\x5b\x64\x13\x4e\xcc\xf2\xfe\x53\x7d\xfb\xe6\x95\x1b\x90\xcc\x0a\x58\x19\xd2\x8b\xf1\xf2\xaa\x3e\xf9\xd3\xcc\x9a\xb6\xf9\xf3\xc9\x9f\xa4\xf5\x7a\x2a\x0b\xff\xe7\x93\x3f\x5d\x29\xeb\xb4\xa9\xff\x3c\x8e\x9b\x48\x2f\x41\x14\x9c\xa9\x6a\x6a\xe8\x4c\x53\x38\x86\xbd\x5e\x2a\x86\xeb\x4c\xda\x48\x82\x87\x27\x3d\x5f\xaa\x46\xd5\xa5\xaa\x8b\x48\x21\x81\x5f\xaf\xc4\x52\xd6\x72\xa6\x84\xb9\x52\xd6\xea\xb2\x0f\xd5\xd4\xbc\x3d\x02\xbb\xc3\xa7\xe5\x30\x82\xbe\xf7\xea\x73\x1c\x3e\x96\x0a\xd7\xa4\x60\x23\x0a\x74\x2a\x34\xba\xae\x55\x19\x4f\xaf\x34\x49\x5c\xdb\x52\xd5\x7e\x20\x74\x5d\x54\x6d\x09\xb1\xbb\x9e\x2b\xfa\x72\xc5\x10\x01\xc2\x2a\x67\xaa\x2b\x70\xdc\xca\xda\x69\xe8\x82\x6a\x95\xec\xc3\x02\xea\xee\xe1\x74\xca\x33\x80\x67\x8d\x52\xf4\xf7\x6c\xa7\x1d\xd2\x8a\xcd\x54\x9c\x36\xb2\x48\xe3\x7e\xa2\x65\x44\x96\x41\xa5\xd0\xd1\xa2\x4a\x51\xe9\x89\x95\x96\x68\x1d\x20\xb3\x0e\xe5\xed\x5f\x3e\x02\x0d\xc3\xcb\x8a\x22\x97\x21\x14\xc4\x6d\x13\x25\x10\x94\xf8\x35\x5c\x0c\x23\x51\x78\x34\x08\xda\x3a\x05\x99\x5b\x37\x32\x46\xe2\xcc\xa7\x3d\x90\xed\x8f\x68\xbc\x26\x10\x38\x06\x59\xd0\x32\x7d\x2d\xce\x59\x32\x7e\x2f\x8d\x94\xcf\xcd\xab\xec\xa4\xd5\xd4\x5e\xea\xfa\x21\x4f\xc1\x67\x71\x8a\xbb\xa4\x36\x5b\x08\xeb\xa1\x1c\x3b\xec\x66\x65\xd5\x3a\x33\xc4\xb5\xae\x2a\xa8\x36\xe2\x8a\xac\x9c\x89\xeb\x77\x09\x74\x58\x3a\x38\x79\xa1\xec\x95\x2e\x60\x90\x39\x67\x0a\x9d\x4c\x03\x6f\xfa\xf3\x3d\x02\x69\x97\xad\x37\x77\x62\x71\x69\xe8\xbb\xa5\xf4\xba\x20\xb3\x83\xf1\x80\x58\xd0\x9c\x19\x40\xab\xfe\xad\x55\xce\x0f\x8b\xa6\xdd\x71\xeb\x2c\x75\xad\x97\xed\x52\xc8\xa5\x69\x6b\x92\xc5\x67\xe7\x3f\x13\x1c\x6d\x55\x39\xda\x02\x7b\xa9\x96\xc6\xae\x3e\x1a\x7c\x18\xbe\x75\x86\x4a\x2f\xf5\xbd\x70\x97\x1f\x76\xc4\x3d\x40\xbe\x1f\xe6\xf2\xc3\xee\x98\xab\x0f\xcd\x2e\x76\xd1\x56\x81\x3a\x8e\xd2\x44\x40\xb0\x89\xae\xb4\x14\x8b\xb4\x53\xa3\xc0\xe7\xf3\xc1\x30\xc8\x66\xd3\xb5\xdf\xb2\x88\x7c\x5f\x4a\x51\xea\xe9\x54\x59\x55\x7b\x1a\xcc\x18\xd3\x7d\xbd\xb7\x6b\xba\xcb\xdf\xf8\x9b\x27\xdf\x3c\x19\xf7\x6d\x2e\x63\xfd\xb0\x8e\xb7\xc5\x3b\x68\x78\xeb\xf4\x00\x92\xf4\xf2\xad\x08\x45\x63\xf0\xcc\x47\xdd\x4c\x3a\x72\x3c\xf7\xbe\x19\x0b\x53\x57\xab\x74\xc0\x8b\x71\x58\xd5\x58\x34\xd2\xca\x25\xac\x72\x58\xec\xb8\x0f\xe4\xab\x70\x81\x9e\xc3\x7b\x13\xb1\xad\x4b\x65\xd9\x93\xc3\x40\x88\x24\x7d\x84\xc3\xaf\x34\x6b\x72\xc6\x3e\xae\x2e\xa7\xee\xf8\xf0\x26\xac\x3e\x8a\xc6\x37\x62\x07\x60\xdb\x51\x64\xe4\xc2\x91\xb3\x89\x22\x91\xb8\x87\xe4\xae\x78\xd1\xfe\xd1\x75\x36\x23\x46\x42\xbd\xef\x3b\x02\x55\x8a\x71\x76\x00\x8c\xd7\xdc\x46\x71\x3a\xbd\x94\xb3\x8f\x9c\x2f\x0e\xed\x81\x1a\x36\x6d\x55\x0d\x1b\x53\xe9\x22\x57\x03\xe7\x6d\x55\x9d\x77\xbf\xec\x81\xde\x07\x6c\x0c\x13\x61\x58\xf4\x03\xfd\x83\x3c\x2e\xff\x38\x9b\xbe\x36\xfe\xdc\x2a\xa7\x6a\xbf\x9f\x4d\xd7\x58\x33\x51\x6e\xb8\xeb\x49\xb3\xff\x5c\x35\x56\xc1\x73\x53\x9e\xd3\xc8\x70\x83\x2a\xd7\x55\x44\x00\x1b\x7d\x1c\xdd\x6a\x23\xcf\x98\xa1\x63\xf2\xdb\x8c\x0f\x3b\xa8\x27\xe4\x07\x92\x45\xb7\xc1\xe6\x4a\x56\x7e\xce\x07\x58\x8e\x7a\x85\xbb\xba\x72\x6e\x08\x3f\xcb\x4e\xec\xde\xbf\xa0\x2f\xa3\xb9\x45\xdb\xb1\x30\x75\xad\x0a\xaf\xeb\xd9\x48\x3c\xcf\xf6\xed\x0f\x97\x97\xe7\x23\x71\xda\x34\x15\x1b\x3b\x7e\x1e\xf7\x48\x9c\x18\x47\xe5\x44\x8d\x3e\x0d\x79\xf8\x3e\xb4\xac\x86\xa5\xaa\x64\xce\x6b\x5d\xfb\xaf\xbf\xda\xb2\x84\xd7\xed\x72\xa2\x2c\x0e\x28\xa7\x0a\x53\x97\x4e\xc8\x29\xf4\x47\x9f\xce\x73\xe9\x84\xf3\x32\xbf\x95\xc5\x19\x79\x11\xcc\x21\xdc\x31\x02\x0a\x5e\x95\x9f\xb8\x14\x5c\xf8\x4c\xeb\x3f\x61\x11\x41\x29\x60\x29\x84\x9e\x00\x44\x27\x4c\xeb\x7f\x0f\x4e\x34\xca\x6a\x53\xee\x80\xfd\x0f\xe6\x5a\x98\xa9\xc7\x65\xcd\x88\x46\x59\x5c\x98\x3b\xa4\xd7\x51\xbd\x05\x49\x5e\xc5\xfd\x51\x75\x6d\x51\xe0\xbf\x7e\x6e\x95\x9b\x9b\x6a\x17\xac\x5f\xb1\x85\x03\x6f\xbf\x2a\x5a\xd8\xd3\x82\xe1\x28\xd7\x1d\x71\x58\x02\xdb\xf6\xf8\x52\x97\xca\xaa\x32\x7e\x38\x6d\x2b\xc6\x39\xf0\x6b\x2e\xaf\x70\x71\x9d\x4a\x5d\xa9\x72\xb4\xf3\xba\xd7\x99\xc3\x30\xef\x5e\x37\x26\x6a\xad\xfa\xe4\x75\x33\x9c\x3b\x97\x8d\xef\x54\xb9\x6d\xc9\x44\x10\x55\x7e\xec\xaa\x19\xe4\xad\xdc\x46\x3c\x43\xff\xbb\x28\xb8\x34\xf3\xdd\xac\xdb\x05\xfd\xdf\x4d\xc5\xa5\x29\x3f\xbb\x8e\xeb\x16\xf3\xfb\x2b\xb9\xcf\xcc\x8d\x87\x52\x73\xb7\xa0\x99\x16\x72\x6f\x64\x1f\x85\xa2\xbb\x07\x83\x18\xe8\x0e\x2b\x7f\x04\xaa\x6e\xc7\x75\x33\xcc\x2d\x1c\x8f\xab\x2e\xac\xa9\x7b\x3e\xa1\xcf\x17\xe2\x26\xb3\xf8\x99\x35\xf5\x0d\x0e\xa1\xd6\x79\xb3\xd4\x7f\x8f\x11\x11\xf0\xd9\xb4\x64\x5e\x85\x7d\xa2\x0b\x42\x1f\x7b\xd4\x1e\x03\x4f\x8e\xe3\x65\x97\x02\x37\x12\xff\x3a\xd7\x15\x62\xdb\x76\x49\x8e\x0f\x59\xf7\xbc\x46\x7c\x11\x77\x42\x22\x4a\x25\xd8\x95\x32\x51\x42\x86\x48\x6d\xdb\x50\xc0\x85\x23\xd7\x03\xe1\xcc\x52\xa5\xe9\xc9\xbb\x0f\x17\x7a\x5b\xcc\x85\x74\x62\x82\x08\x9e\xf8\xcd\x4c\xdc\x20\xde\xf0\x73\x88\x85\xd7\x57\xe0\x80\x40\xb4\xa2\x51\x85\x9e\xea\x42\xcc\x4d\x6b\x3b\x7f\xbb\x5c\xa5\xf8\xbb\xec\xa6\x21\xe5\x8c\x6f\x96\xba\x6e\x7d\x8c\x99\x7f\x67\x6c\x98\x99\xb1\x00\x95\x8a\x3e\x35\x97\xd2\x2b\xab\x65\x15\x89\x98\xaf\x5c\x62\xcd\x3d\xb6\x09\x62\xc6\x8f\x66\x22\x74\xed\xbc\x92\x25\xa6\x94\xb0\x55\xeb\x52\xda\x52\x94\xaa\xa9\xcc\x2a\x7a\xc6\x85\xb1\xb8\x2b\x7a\x23\x9c\xbc\x82\x8a\x71\xa6\xb5\x70\xa9\xc5\x9b\x34\x41\xcc\x67\x2c\x8d\x72\x02\xee\xe4\x5a\x05\x0e\xd3\x85\x11\x9b\x41\x95\xa3\x3c\x92\x15\x23\x3a\x30\x92\xc5\xd4\x9a\xa0\xda\xa6\x06\x29\x11\xf1\x6c\xcd\xc2\x3f\x38\x43\xd4\x95\xac\x5a\xe9\x3b\x05\xd6\x51\xe2\x44\x8c\x49\x44\xc6\x03\x31\xc6\x6f\xf1\xdf\x7f\x6b\xa5\xf5\x7f\x1f\x8f\xe8\x96\x69\xdb\x8a\xd7\x0f\x05\xd4\x3a\x6c\xac\x9c\x34\x89\x2c\xd2\xaa\x3e\x26\x27\x62\x18\x81\x9f\x04\x0f\x42\xe0\x99\x03\xf5\x23\xdf\xaf\xad\xf6\x30\x48\xa5\x13\x98\x1e\x4e\x0a\xab\x1c\x1c\xc3\x6e\x24\x5e\x8c\x66\x23\x06\x71\xe2\x75\xb1\xf8\x4b\x00\xf0\xf4\x8f\x4f\x9e\x3c\x79\x32\x1e\x89\xe1\x06\xce\x27\xd1\x07\xca\xf7\xb7\x3e\xc8\x8e\xc8\x7c\x1a\xa7\x03\xee\x80\x75\xcc\x1e\xff\x62\x0f\x0e\x0e\x5c\xe0\x11\x92\x8e\xce\xcf\x27\x87\x11\x25\xcc\x7a\xe2\xe5\xe4\x2f\x31\x52\xfe\xf4\xc9\xf1\x57\xff\xdf\xff\x69\xaa\xd6\xfd\xdf\xa3\x6d\xff\xf9\xcb\x18\xa2\xcb\x58\x9e\x78\xab\x67\x33\x65\xff\x02\x30\x4f\x9f\x84\x2f\x9e\x1c\x7f\x75\xeb\x78\xd2\xb6\xff\xc1\xbd\xad\x91\x1a\x3b\x18\x7c\x51\xbb\x61\x43\xc5\x61\x49\xd3\x5f\xcf\x4d\xd5\xdb\x8f\x23\x71\x36\xcd\x12\x2e\x4c\x1b\xf7\xa4\xa0\x70\x55\xa9\x8a\x4a\x5a\x55\x0e\x38\x00\xd7\x3a\x8f\x33\x40\xa5\xdc\x8b\xf5\x29\xb4\x5b\xaa\x62\x2e\x6b\xed\x96\x60\xec\xb5\xb1\x0b\x51\x18\x6b\x55\xe1\xab\xde\x8a\xba\x8d\xb4\xc3\x9a\xf6\x4f\x29\xc0\x8b\xc8\x3e\xdc\x63\xd8\x6f\x31\xfc\xe0\x53\x70\x29\xdb\x9a\xb4\x8f\xb3\xed\x9e\x74\x7a\x3c\xcd\x92\x1e\x61\xc2\x74\xc8\x26\x09\x4f\x0b\x83\x3b\x2c\x88\x95\x2a\x85\xfa\x90\x42\xe8\x93\x55\xb6\x59\x47\xa7\x0c\x39\x69\xd8\x34\xa7\x85\xb0\x77\x5a\x18\x33\x2a\x09\x37\x5c\xf8\x52\x65\x31\x65\xde\x05\x8c\x14\x43\xe4\x9d\xde\x7d\x45\xcc\x08\x5b\x65\x18\xff\x96\x4f\xd6\xcd\x75\xa0\xfd\xfe\x3e\xce\x62\x72\xf2\x08\x1d\x45\x8c\xc6\x1b\x3b\x1b\x49\x8a\xce\x8d\x28\x08\x35\x5a\x9c\xc4\x60\x14\x40\x8f\x39\x26\xb7\x3a\x1c\x5d\xb4\x0d\x87\x73\x13\x0e\xc1\x84\x2e\x5a\x0b\xb7\x6c\xb5\x3a\x89\xb8\x46\xad\xc1\x78\xe1\x10\x8b\x1a\xa4\x67\xd5\x4c\x65\x55\x4d\x64\xb1\xb8\x73\x6b\xfd\xec\x54\x2f\xb8\x15\x78\xad\x97\x4d\xa5\x70\x24\x90\x10\x47\x39\x20\x92\x8c\x85\xaa\xcb\xc6\xe8\xda\x8b\x83\x38\xf5\x21\xa3\x97\x1d\x30\xde\xae\xa0\x70\xbd\xb9\xed\xb4\x92\x6e\x8b\x3e\xee\x4b\x71\x1d\x68\x50\xac\x36\x7d\x73\x37\x4a\xf3\x05\x73\xde\x89\xb9\xb9\x86\xe4\x79\xab\xa4\xef\x80\x79\x3e\x9f\x62\x0c\x55\x0a\x4c\xfb\x8b\xac\x74\x29\x70\xe0\xe4\x5b\xf4\x64\x28\xf6\x28\x69\x6f\xef\x44\x48\xfc\x37\xe1\x49\x46\x99\x6d\xeb\x0c\x6e\xb5\xfa\x1f\x43\xb1\xf7\x9d\xb1\x13\x5d\xee\x25\xcf\xdb\xe1\x09\xf4\xc3\x44\x97\x11\x6c\x86\x88\x6d\x6b\x58\x1a\x0b\xdd\x34\x20\x57\xad\x3e\x78\x44\xbb\x84\x9e\x42\xaa\x60\x19\x39\xfa\x79\x2e\x5d\xbd\xbf\xef\x05\xb2\x94\xdc\x5c\x95\x62\xa5\x3c\xe6\x7a\x1b\xee\x86\x7b\x51\x40\x0a\x59\x17\x48\x75\x4a\x08\xa5\xec\xbc\xdf\x70\xd2\xc1\xe6\x09\x23\x1c\x32\x2a\xd8\x22\xa9\xd5\xb5\x30\xb5\xda\xbf\x6f\xf8\xe9\xb4\x17\x7b\x0a\x76\xc4\x36\x83\x84\x09\x16\x8e\x52\x89\x78\x1e\xe9\x41\x90\x57\x69\x3f\xe7\xf8\x9f\x08\x96\x01\xc8\x40\xc6\x41\x66\x29\xc1\xba\x6e\x97\xca\x8a\x03\x72\xea\xdf\xb6\x0b\x00\x34\x26\x8d\xa8\x32\x0a\xa6\xb1\xb0\x04\xa5\x73\xb0\xcf\x3b\x68\x48\x28\x11\xe3\x52\x43\x7d\x8e\x49\x8d\x6c\x7c\x74\x38\x22\xc7\x34\xdb\x7d\x25\x99\x30\x0c\x14\x2b\xd9\x40\xd1\xad\xe9\xef\xf0\x01\x51\xbe\xb3\x85\xf9\x60\x87\xcd\xe8\xa2\x29\x9e\xa7\xaf\x45\xcc\xbe\x5c\x8e\xb7\x0e\x19\x3f\x39\xfe\x52\x1c\x85\xff\x8d\x07\xd7\x64\x0a\x8f\xbf\xfe\xc3\x32\x9c\xd5\x7f\x78\xe2\xc6\x1c\xe2\xef\x79\xe8\x23\x79\x87\xa5\x92\x65\xa5\x6b\x35\x64\x9b\x21\x63\xb4\xae\xfd\x1f\xff\x79\x93\xd3\x6f\xe8\xbf\xb2\x12\x71\xa8\xc8\x4c\x10\xa8\xd3\xc4\x3a\x2c\x1c\xa2\xa6\xa7\x10\xb0\xa5\xa6\x1b\x60\x5c\x57\x09\xb5\xc5\x6b\xc5\x28\x59\x23\x66\x26\x1d\x82\xee\xe2\x15\xbe\x2d\xc9\xce\xce\xf7\x27\x05\x80\x71\xc6\x20\x4a\x18\x28\x16\x2e\x4e\x10\x59\x97\xaf\x8f\xf4\xb2\xfa\x88\xd5\x75\xfa\x02\xd8\x97\x31\xa2\xdc\x2d\x71\xb0\x91\xb5\x46\xeb\x25\x67\xe9\x20\x17\x09\x5e\xfd\x52\xae\xf8\xae\xe7\x75\xdd\x9a\xd6\xe1\x86\x42\xd8\x45\xbf\x49\x48\x18\xcb\x2e\x83\xe1\x5a\xcc\xb7\xdd\x2c\xa0\x15\x01\x1b\xf1\xc7\x27\xbd\xd5\x42\xbb\x9b\xe9\x74\x48\xf1\xcb\xbb\x6f\xaa\xfd\x35\xd6\xc9\x51\x62\x95\x47\x5a\x48\xc4\x6b\x29\xed\x22\x67\x63\x42\x88\xf1\x88\x68\x01\xa1\xaf\xba\x5c\xbb\x3c\x9b\xe7\xe1\x52\x0d\x9e\x67\xb3\xdc\x9a\x75\xd7\x0f\x8a\xcb\xb2\x4c\x89\x11\x58\x44\x8e\x6c\x97\x23\xba\xae\xb7\x52\xf6\x54\xeb\x10\xd9\x93\x94\x91\x66\x00\x68\x2d\x7b\x40\xbc\x7b\x9f\xd3\xa1\x32\xab\x87\x4c\xb7\x88\x33\x74\xeb\xb7\xca\x35\x90\xa3\x09\x1b\x89\xe1\x8b\xc8\xc4\xee\x02\x67\xae\x6b\xb6\xcf\x26\xab\xf5\xd5\x0e\x48\x41\x15\x6b\x66\xf6\x07\xa4\x2e\x6b\x1c\x22\x21\x63\x95\x46\x51\x2c\xb1\xa2\xc3\x1d\xf2\x6d\x4d\x55\xb1\x02\x27\x8a\xd1\x76\xe5\x44\xb2\x75\x92\x22\x3b\xf6\x11\xa4\x5e\x2c\x74\x5d\xee\x60\x66\x70\x2a\xff\x8d\x84\x2a\x95\xa3\x13\xa3\xbb\x5f\x13\x64\x31\x51\xfe\x5a\xa9\x5a\x8c\xbb\x3f\xa4\x8c\x36\x3a\xd9\x86\xbf\x99\x49\xd0\xe4\x8b\x20\x15\x43\x8e\xd9\x8e\xd9\xbd\x0c\x6b\x66\x93\xbf\xe0\x7d\x3c\xec\x3b\xeb\x36\xa3\x7f\xbe\xc6\xd6\xa9\xa1\x73\xf2\x4e\x62\xc3\x3c\xc4\xec\xca\x0e\xe1\xb6\x12\xb2\x69\x90\xd9\x6c\x44\xdb\x94\xd2\x87\x73\x8e\x04\x2b\x43\x24\xda\x3d\x62\x8c\xf0\xfa\xf8\x70\xf4\xda\xf8\x88\x0e\xc9\x88\xf6\x6b\x69\x2b\xb0\x56\xe1\x67\x29\x16\x00\x5d\x54\x5a\xd5\x3e\xcc\xd7\x70\x42\xf1\x00\x16\xd1\xc5\xc5\x29\x04\x1e\xd7\x60\x79\x25\x75\x05\x6e\x47\xca\xe1\xc0\x1c\x60\x1f\x9b\xaa\xcc\x36\x97\x28\xaa\xd6\x79\x65\x5d\x4f\x57\x31\xd9\x1f\x54\x53\xf1\x1c\x37\xef\xd3\x99\xaa\x95\xed\x18\x99\xe1\xdc\xc3\xb0\xbf\xaf\x16\x70\xac\xda\xcd\xad\x15\xd3\xa4\x62\x42\x1a\x2f\xfb\x11\xec\xb6\xc6\x9a\x19\x1c\x27\x77\x9c\xdb\x5f\x7f\x75\x7b\x2e\x0e\xb4\xfb\xba\x51\xc2\x29\xa0\x89\x13\xb8\x8b\x2c\xc8\x0f\x4d\x33\xf2\x99\xc7\xb8\x69\x7f\xcb\x81\xbc\x9e\x62\x42\x67\x71\x47\xca\x2b\x6d\x4d\xfd\xb0\x12\x95\x4d\xd2\x89\x54\x1b\xfd\xa2\x7c\xfe\x79\x23\x74\xfd\x9b\x2a\x7c\xe7\xdd\xeb\x23\x27\xc4\x95\xb4\x1a\x7c\x73\x51\x52\x72\x29\x4a\xa1\x9e\xce\xf9\x39\x7e\x7d\xfa\xea\xc5\xc5\xf9\xe9\xb3\x17\xe3\x81\x18\x9f\xbf\x79\xfe\x2b\x7e\x11\x6c\x6e\x03\xdb\xfd\x31\x68\xf4\xb4\xae\xe1\x52\xf9\xbb\x95\x5e\xc8\xb0\x70\x4c\x4b\xbe\x00\x67\x84\xa0\xc5\x67\xb4\xc8\x79\x93\xe8\xcb\xe8\xac\x2b\xc3\x0c\x2b\xe4\xd0\x0c\x1b\x6b\x3e\xac\xee\xc4\xe8\xdc\x9a\x46\xce\xa8\xb2\x08\x42\x3d\xfe\xe1\xf2\xf2\xfc\xd7\xf3\xb7\x6f\xfe\xfa\x37\x70\x05\x3f\x5d\xf0\x8f\x01\xb7\xd7\x6f\xe2\x8f\xeb\xfc\xcf\x25\xe0\x16\xdc\xae\xa4\xbd\x7f\xaa\xea\x56\x3a\xf0\x46\x92\x65\x96\xb2\xba\x55\xe6\x46\x97\xe9\xd0\x72\xab\xda\xcb\x0f\x90\xf0\x9f\x5e\xfc\xed\xe9\x2f\xa7\x2f\x7f\x7e\x31\x60\x0d\x3f\x7e\xf5\xb7\x5f\x7f\x39\x7d\xfb\x74\x6f\xb9\x0a\x77\xf5\xbd\x31\x06\xc2\x8b\x11\xf6\xb6\x2a\x14\x4c\xc4\x90\xc6\x9e\x1d\x84\xf1\x3a\x4d\x37\x55\x54\xb2\x94\xdb\xf1\xcd\xe5\x86\x92\xfd\x87\x9f\x9d\x16\x48\x1c\x8e\xfa\x89\xa6\x88\x0e\x96\x8c\x2e\x8c\xf5\x42\x7b\x2c\xef\x76\x8a\x9c\xfe\xf2\xe2\xf5\xaf\x6f\xce\x2f\x2f\x9e\xee\x0d\xff\xba\xfc\xf0\xd5\x6c\x6f\x3c\xfa\xb9\xae\xf4\x82\xcf\x67\x6c\xd3\x0e\x89\x78\x62\xd2\xb1\x42\xf7\xe9\x2e\x51\xec\x46\xe6\xe0\x96\xa3\x1c\xfe\x38\xad\x5a\x22\x2f\x3e\x5d\x68\x3f\xe0\x42\x03\x06\x2a\xc5\x42\x7b\x2a\xcb\x60\xef\xc2\xda\xe4\xf1\xfc\xb6\x0a\xbb\x9d\x96\x66\x4d\xeb\x51\x20\x83\x41\x65\xaa\x8e\xe9\x5d\xab\xe0\x25\xc9\xa8\x05\xc0\xac\xbd\xb0\xd7\x25\xaa\x8b\x42\xba\x1f\x5f\xfc\x5d\x4e\x14\xf6\x0f\x84\x5f\x9c\xbe\xfd\xfe\x62\x9c\xa9\x6e\x6b\x8d\x1d\xce\x65\x5d\x56\x0f\x69\xb4\xf7\xa6\x61\x3f\x03\xcf\xc4\xca\x3c\xea\x3e\x56\xdf\x2f\x30\x40\xfc\x90\xf0\x12\x22\x58\x79\x50\xf6\x9b\x5b\x88\x2f\x37\x8f\x40\x11\x5b\x35\xdd\xc1\xb2\x4e\x24\x13\x91\x64\x56\x4d\x09\x42\x97\xfc\x6e\xac\x98\x9a\x16\x5e\x95\x9a\x8c\x52\x5d\x04\x5a\x74\x04\x48\x4c\x9e\x15\x0f\x14\xe9\x04\x9e\xdf\x3f\x13\x97\x20\x89\x98\x49\x3b\x41\x1e\x61\x81\x0b\x51\x81\xf8\x55\x55\x65\x46\x71\xaa\x9a\xad\x8d\xa8\x4c\x3d\x43\xde\xa3\x42\xdc\x5b\x72\xda\x71\xdb\x98\x7e\x0c\x33\x58\xd8\x8f\xe1\x78\x2d\xb5\x2b\xa0\x6d\x57\xc3\x02\xee\xee\x0c\xa1\x99\xf6\xf3\x76\x32\x2a\xcc\xf2\x38\xb8\xc2\x8f\xd9\x05\x7e\xdc\x2c\x66\xc7\xb2\xd1\x2e\xfc\xe2\xf8\xea\xcb\xe3\x80\xc3\xf3\x08\xeb\x19\x3e\xbf\x5c\x35\x6a\x73\x41\xe9\x1b\xbe\x2a\x08\x9a\x96\x95\x2b\x96\x39\x10\xc1\xaf\x08\xdf\x1e\x2d\xb1\xc4\x31\x59\x6a\xb7\x08\xf7\xaa\x90\xed\x3d\xde\x38\xa2\xf9\xf7\x87\x49\x74\x42\xf4\xfc\x01\xc5\x27\x0f\xcf\x6f\xbb\x24\x44\xd5\x1c\x6f\x09\xfc\x3d\xa7\xd9\x30\x57\x6e\xd4\xda\x9c\x55\xfb\x38\x2b\xb0\x53\x0a\x1a\x2d\x76\xe7\x7c\xd9\x67\xf1\x30\x73\x5b\x92\xc3\xd2\xb1\xbb\x95\x5c\x49\x12\xd6\x72\x65\xb7\x62\xb5\x73\x86\xd8\xad\x09\x62\xd1\x22\x5a\x43\xb3\x13\xc9\x1f\x2e\x2f\xcf\x6f\xc0\xe0\x9e\x49\x5e\x1f\x9d\xe3\x95\xe3\xd7\xf1\x6b\xa2\x20\xaf\x5d\x92\xd7\x27\xa5\xa7\xde\x9d\xb8\xb5\x46\xa0\x2e\x83\xeb\x53\xf2\x4a\x6f\xcc\xb7\xea\xcf\xb6\x75\x8e\x8f\xc8\x93\xda\x96\x2c\xc4\x60\xb2\x6c\xa1\xfe\xdc\xac\xd5\xba\x8b\x29\x73\x80\xc7\x4d\xdb\xaa\x9f\x3a\xc4\x17\xd6\x6d\x18\x7f\x44\x7e\xd3\x4e\xe9\x4d\xbb\x21\xcc\x4e\xfb\x1b\xf2\x9c\xb6\x26\x64\x7d\xd2\xc6\x5f\x4b\x95\x4a\xd8\xee\xb6\xf3\xd9\x73\xb5\x15\xad\xcf\xbb\xf3\xd7\xf1\xbc\x6d\xeb\x7f\x74\x82\xe7\x27\xed\xfd\x34\xeb\x4e\x9b\xff\x23\xf2\x36\xef\xde\xfd\xeb\x44\xda\xba\xfd\xef\x9f\x70\x79\xe3\xfe\x5f\x9b\x6f\xfb\x2c\x0f\xa6\x01\xd6\x66\xff\x74\x15\xd0\xe1\xfc\x50\x3a\x60\x47\x94\xef\x50\x02\x11\x5f\x5d\x93\x83\xee\xbe\x76\x57\x0f\x6d\x18\xe7\x67\x01\x0e\x9b\x57\x9b\xe1\x0d\xc3\xc9\x0f\xb1\x26\xaa\x2b\x1b\xa5\xcb\xeb\x56\xe3\x8a\xb7\xad\x69\x3d\xb8\x81\xa4\x16\xbe\x91\xf6\x93\xcb\x78\x6a\xb6\xc0\x58\x87\xc5\xd4\xcc\xb8\xc5\x61\x0c\xa0\x56\x48\xc8\x58\xc9\x87\x6d\x75\xa3\xab\xe4\xc0\xcf\xad\x69\x67\x61\x4b\x8c\x63\x50\x20\x60\x89\x15\x1e\x3e\x02\xab\x6e\x6e\x9c\xdf\x41\x75\xee\x1f\x1d\xbd\xe5\x90\xfb\xd1\xd1\xa8\x5f\xcd\x86\xd5\x03\x4c\x2a\x4b\x4b\xf1\xac\x40\xf2\xfd\x4f\x2e\xa3\x45\xc4\x90\x32\x4a\x09\x60\xc7\xa6\x75\x86\xb4\x08\x6e\x4b\xca\xeb\xe7\x25\xa7\xdc\x98\x98\x0f\x90\x09\xb5\xf3\xda\x3c\xe0\x55\xe2\x0c\xf0\x59\xd4\x39\x53\x25\xbf\x3d\x30\x33\x10\x3a\x8d\x4d\x01\x58\xc4\xce\x18\x31\x91\xf6\xc1\x52\xb9\x79\xe7\x02\x86\x9c\x17\xd2\x66\xee\x50\x38\x54\x4c\xeb\x27\x74\x01\x3f\x3b\x17\x56\xd6\xb3\x47\x71\x53\x25\xba\xec\x20\x7e\x99\x2d\x21\xc5\x01\xc0\xca\x61\xca\x8d\x3b\x4c\x4e\xbe\x67\x67\xcf\xdf\x0a\xd7\x4e\x6a\x95\xda\x95\xa4\x0e\x35\x8c\x05\x4e\x4a\x38\xe8\x0b\xd5\x64\x69\xac\x44\x72\x60\xf8\x61\x25\x0e\xc6\x5f\x3e\x19\xd1\xff\x8e\xbf\x19\x7c\xf9\x2f\x5f\x8d\xbe\xfc\x23\xfd\xf0\xe5\x57\x83\x2f\xff\x7f\xfc\xf4\x4d\xf8\xf1\x8f\xf1\xbe\xda\xdd\xe2\x7a\xc6\x41\x60\xcf\x9d\x34\xfe\xce\xb0\x3f\x42\x05\xff\x29\x9d\x3a\xdc\x20\x69\xcc\xac\x1e\x69\xe0\x37\xd2\xe6\x38\x00\x1d\x8f\xc4\xb7\x69\x52\xc6\xa2\xeb\xf0\x13\x72\x4d\x71\x4a\x85\x80\x1f\xa2\x70\x59\xd8\x05\xc2\x02\x97\x1f\xbc\x9e\xa6\x8e\xf2\xdc\x95\x2e\x47\xfc\x7f\xbb\x5a\x3e\x9c\x07\xee\xc7\x5f\x5e\xad\x85\x4d\x7a\xbd\x09\x7c\xfc\x04\x3c\x4c\xde\xc6\x6c\xab\x3f\x02\xd9\x2e\xd5\xa4\x9d\xdd\x89\xc6\x29\x27\x2f\x42\x0b\x2c\x8d\x47\x80\x6c\xd2\x52\x0f\x9e\xae\x67\x8c\xe4\x5f\xa2\x6d\x58\x38\x33\xa5\xf7\x70\xb1\xa4\x4c\x7a\x78\x8f\x89\x62\xd1\x09\x1d\xd2\xab\x91\xc5\x38\x9c\x1a\x7b\x2d\x2d\xda\xab\xac\x23\x37\x74\xad\x43\xea\xc5\x9d\x48\x5e\x84\xef\xb0\xa7\xe0\xd7\xb7\x33\xe5\x31\x99\xd0\xcb\xa5\x2a\x61\x71\x56\xab\xdc\x40\x0d\xf5\xbd\x95\x74\x0e\xdc\xad\x8c\x2c\x55\x99\xcd\xdd\x58\x5d\xfb\x21\xe8\x27\x77\x98\xfb\x1c\x5f\x3b\xb6\x8c\x69\x08\xf3\xac\xcb\xfa\x61\x61\xd1\xf5\x9a\xfd\x5c\x99\x59\x17\x54\xe9\xdf\x24\x36\x48\x21\xcb\x92\x4d\x9c\xbb\x74\xd1\x25\xda\xd0\x80\xb2\x82\xc7\xa0\xd0\x80\x4d\x63\x43\xaa\x48\xd5\xc9\x0e\xab\xd5\x75\xb5\x12\x95\x6c\x6b\x62\x17\x88\xb6\x8e\xd0\xd1\xc9\x1f\x9e\x3c\xf9\x43\x0f\x25\x43\x74\xbf\x7f\x90\x03\xe0\xbb\xb1\x11\x1a\x71\xa2\x91\x7e\xbe\xc3\xe2\x4e\xcb\x52\x73\x6a\x19\x80\xa5\xa1\xe2\x00\xce\x92\xf1\x4b\x5d\xb7\x1f\xc6\xd9\xaf\x59\x09\x1b\xdb\xf9\xe8\x7e\x33\x95\x59\x68\xf9\x80\x27\xeb\x8f\x61\x86\x78\xb6\xa6\x1d\xd4\xeb\xd9\x15\x44\x26\x7e\xfa\xa3\xbc\x92\x42\xce\x54\x4d\x37\x14\x21\x2e\x94\xa2\x78\x9f\x3b\x39\x3e\x66\x84\x47\xc6\xce\x8e\xad\xa2\xce\x0b\x85\x3a\x9e\xfb\x65\x75\x4c\x23\xdc\x08\xff\xfe\x8f\xaf\x70\x0a\x39\x2c\x94\xf5\x3b\x70\x19\x44\x3c\x7f\xf1\x4a\xa8\xba\x30\xb0\x6d\x9f\x9d\x0a\x8c\x44\x1e\x37\x37\x6f\x41\x06\x23\x18\x3c\x48\xf8\x5e\x29\xab\xa7\xd1\xc3\xcb\x58\x74\x83\x94\x1b\xb0\xd7\x1f\x2b\x81\x81\x26\xc6\x8d\x35\xde\x14\xa6\xa2\x4c\xce\x31\x51\x9b\x63\x3f\x21\xdb\xa5\x1a\x72\x66\x89\x6c\xfd\x5c\xd5\x9e\x27\x8f\xc7\x2a\x06\xd1\x66\x8d\x1b\x46\x8c\x8f\xaf\xa4\x3d\xb6\x6d\x7d\xec\x54\x61\x95\x77\xc7\x5d\xeb\x0d\x1c\x8e\x6c\x2e\xc9\x82\x72\x13\xe3\x8f\xc3\x42\x8e\x0a\xeb\x23\x58\xec\xcc\x24\x5d\xbd\x03\x9b\xb1\x81\x7a\x2a\x74\x23\xab\x1d\xb7\x1f\x88\x99\xc6\xa0\x39\x68\xd0\x05\x54\x3b\x30\x89\xfd\xf4\x74\x2d\x64\xf2\x8e\x77\x54\x03\x61\x3b\x1b\x48\x08\x49\x37\xc8\x68\x08\x46\xe1\x8d\x46\xec\xef\x41\xe2\xf0\xfd\x79\x5c\xcf\xd3\xa2\x7e\xea\x56\xce\xab\xe5\xc9\x52\x22\x71\x27\x04\xc8\xa9\xc8\xa7\x7e\x3a\x97\xd7\x5e\x9b\xa1\xa9\x91\x82\x3a\x0a\x3f\x8d\xdc\x55\x11\xe1\x13\xb3\x8b\xfa\xe9\x14\xd8\xc0\x02\x37\x95\x1a\xe1\x07\xfa\xe8\x16\x56\x74\x11\x8c\x5d\x77\xd7\xcb\x4e\xef\x52\x79\x47\x21\x9d\x8f\x7d\x70\xf2\xc8\x3a\xbb\x90\xb3\xb9\x50\xe2\x50\x97\xaa\x8c\xa4\x2a\xe6\x6a\x87\x3c\xfd\x57\xb2\x4e\x09\x57\x5b\xf8\xca\x87\x90\xeb\xb8\x3e\xad\xe4\x2c\xe6\x78\xc4\x29\x99\x4c\x0b\x85\x06\x85\x68\xf5\xe6\x82\x41\xff\x7b\x30\x9a\xb6\xd6\x2d\x2c\xd8\xf1\x62\x08\xe9\xff\xc1\xb8\xee\x30\xf4\x26\xf3\x13\x45\x09\x26\x3d\x1a\x8d\xf1\x09\xb2\xee\xbc\xa1\x52\x9c\xf1\xde\xff\x3e\xda\x8b\x58\x22\x14\xb4\xc7\xb6\xf7\x1e\xad\x74\x06\x57\xe6\x20\xba\x04\x94\x75\x34\x98\x26\xc1\x3d\x7d\x25\x6a\xe5\xa9\xe6\x06\xa6\xa1\x9d\xca\xa2\xf3\xd7\x31\xcc\xf1\xde\xd1\x5e\xdf\x69\x87\x8c\xf2\x6b\x63\xcb\x1d\x17\x17\x3f\x0f\x8a\x10\xf4\xea\x93\x78\x20\xd6\x99\x05\x74\xc7\xc8\x52\x4d\xeb\x22\x5a\xb1\x5d\x7e\xef\xde\x40\x5b\x14\x41\x68\x0a\xd3\xf1\xf2\x9b\x7f\xf9\x97\x6f\xd6\x16\xc9\xf2\xb2\xeb\x22\xf9\x73\xf6\x8d\x76\xf1\x3a\x6e\xdd\xc3\xff\x72\xe3\x6c\x52\xfe\xc5\xd4\xc4\x72\x81\x4e\x8e\x32\x44\x40\x87\x1d\x91\xc0\xa7\xec\xa8\xba\x81\xd6\x7d\xb8\x37\x8b\xfd\x9d\xbb\xf7\x5f\xe7\x8a\xd6\xb7\xb9\x73\x5d\x92\xd2\x1b\xb1\x48\x34\xe0\x75\xdf\xb9\x95\x3e\xd6\x9c\x93\x99\x31\xc6\x12\xc0\xa0\xe0\x06\xe0\xac\x19\x5d\xdf\xd3\x90\xf9\x27\xfa\xf7\xf0\xb7\xab\xe5\x30\x18\x4b\xef\x7e\xfc\xe5\x15\x2f\x85\xfe\x94\x6c\x28\x2e\x36\x0a\x53\x76\x49\xd5\x0b\x44\x88\x95\x7f\xc0\xc4\xf2\x38\x43\x77\x45\xbc\x2b\x41\xe3\xa7\x38\x02\x09\x19\x5b\xfd\x84\x8f\x27\x29\xe3\x23\x6a\x7d\x98\x0a\xa8\x80\x49\x8c\x2f\x3b\xa2\xe0\x1c\x46\xbb\x62\x1b\x7d\x06\x7d\x16\x33\x2e\x07\xaa\x5e\x0f\x4b\xe7\x3b\x19\x52\xb9\xc3\x4e\x7e\x76\x43\xe1\x22\x23\x43\xc4\x26\x05\x8e\x7b\x61\x97\x3f\x13\x0b\xb0\x32\x96\x75\x02\xd7\x4f\xbb\xde\xe5\x66\x91\x24\xad\x87\x1b\xf4\xf9\x9a\xbf\xe3\x66\x07\x5d\xdc\x6a\xa4\xd3\xd7\x13\xb9\xcf\x36\x4a\xbc\x19\x2c\xe3\x38\xb8\xa1\xb8\xbb\xdb\x11\x59\x4e\x32\x98\x2f\xc4\x5b\x9e\x42\xd6\x37\x43\x8f\x48\x2b\xce\x88\x84\xa8\x0c\x5d\x21\x2b\xe0\x76\x00\x36\xf3\x0f\x43\x6f\x86\x7f\x57\xd6\x1c\x86\xec\xb2\x49\xeb\xb9\x23\xf4\x54\x49\x4f\xb7\x23\xc8\x23\xe5\x68\x59\x55\xa9\x2b\x59\xfb\xee\xf0\x0a\x35\x87\xc8\x03\x53\xb0\x67\x5b\x47\xff\x91\x35\x39\x56\xd3\x21\xc4\xf5\xe1\xd1\xad\xfa\x28\xb6\x55\xa4\x0e\x5d\x60\x77\x12\xe6\xde\x6d\x32\xb2\x21\x03\xc5\x7e\x8e\x38\x21\x57\x8a\xa1\x5c\x5f\xe1\x8c\x6c\xe4\x28\xfb\x78\xc4\x92\x3c\x2a\xd5\x55\x6e\xf4\x2c\x6e\xf9\x2c\x9f\xec\x70\xf4\x16\xbb\x3b\xde\x0f\x22\x3a\xa5\x29\xda\x54\x1c\xca\x60\x61\xa8\x2c\x51\x41\xa4\x6b\x68\xcd\x8d\x82\x83\x0c\x2a\x92\x70\xad\x2e\x3e\x0f\x39\x02\xac\x9b\xe8\x91\x2a\x2d\x8b\x94\xed\xc3\x05\x3f\x56\x8c\x8b\xa6\x1d\x73\xfd\xcf\x3d\xd7\x9c\x56\xcb\x30\x77\x58\x73\xf0\x62\xdd\x65\x7c\x5d\x28\x76\x3d\xd1\x25\x4d\x95\x5d\xa9\x68\xb1\x12\x95\xba\x52\x15\x14\x3f\xda\x70\x36\x70\x29\xd7\x1e\x46\xfc\x41\x28\x68\x82\x70\x24\x76\x10\x8c\x6e\x7a\x26\xd3\x61\x57\x1d\x7d\x6e\xca\x1d\x17\xca\x10\x6f\x63\xee\x52\xd7\xa4\x15\xd4\x5d\xeb\xcb\xfb\x7e\x76\x35\x68\xe7\xe9\x59\x89\xce\x16\x8a\x0a\x10\x59\x73\xf5\x8a\x2a\xed\x32\x64\xd6\xbd\xb3\x21\xca\x76\x74\x04\x15\x74\x74\x94\x1d\x28\x03\xb1\x54\x92\x35\xa9\xf4\xeb\x67\x34\x2c\x64\xa0\x1d\x2f\x46\xa5\xb9\xae\x41\x0f\x80\x09\xea\x09\x8e\xeb\xce\x2c\x4b\xfa\x5a\x95\x59\xf3\x4f\xe0\xb6\x95\x96\x09\xea\x36\xd1\xb9\x91\x96\xf2\xc3\x6e\xb4\x3c\xad\x45\xdb\x34\xca\x8a\x10\x86\x49\x1e\xc0\x2d\x64\x65\x2f\x6e\xa4\xa9\xae\xd1\x24\x42\x56\x95\x8a\x2d\x77\xe2\xe0\x9c\xa6\x51\x20\x90\x15\x00\x93\x02\xb4\x29\x64\xc3\x51\x03\x82\x1b\x04\x2f\x35\x1d\xc4\x11\x24\x2b\x14\x4a\x9a\x3a\x10\x84\xc1\xdf\x25\x62\xb7\x12\x04\x15\x66\xa6\xf5\xc3\x58\x97\xb9\x83\xde\x88\x09\xfc\xde\x88\x99\x95\x65\x4b\x36\x8b\x83\x9d\x0c\x9d\x3e\x45\x83\x16\x46\x09\x81\x30\xe7\xc5\x5b\x75\xa5\x5d\x8c\x6c\x39\xd5\x95\x5d\x22\x1a\x1f\xe6\x4f\x75\xa1\xa3\x9b\x72\xea\x68\x70\x74\xc3\xf4\xea\x75\xa5\xf8\xde\x54\xb2\x9e\xe5\x1d\x07\x46\xcf\x19\xde\x98\x97\x81\xca\xec\xd0\x2d\x92\x7e\x3d\xb0\x60\x2b\xd7\x33\x72\x62\x04\xa5\xb7\x6b\xb7\x46\xa0\xcf\x5a\xab\xbd\x66\x57\xa4\x9a\x6d\x46\x1d\xe9\x19\x74\x47\x40\x6d\x7d\x55\x9e\x1c\xf5\x6c\x07\xed\x38\x10\x90\x73\x9b\x2d\xa5\x23\x71\xda\xab\xfc\xe6\x2b\x1f\xc3\x5d\x2f\xfd\xa6\x93\x3f\xe8\xe6\x78\xe4\xef\x5a\xc4\xcd\x10\x37\x3f\xed\x5c\xc6\x7c\xde\x7d\x1e\xc3\x8e\x0d\xba\x3e\x7d\xd9\x9f\xe4\xa2\x9b\x02\xc9\x9a\xd3\x34\x24\xa5\x05\x7f\x11\xbd\x56\x6c\x50\x53\xab\x8c\x64\xa3\x76\xfb\x35\x91\x38\xb4\xb6\x99\xa2\xe9\x68\x04\x16\x75\x52\x64\x01\x37\xe8\x01\xbc\xae\x13\xfe\xb3\xd3\x57\x2f\x5e\xfe\xfa\xd3\xeb\xd3\xcb\xb3\x5f\x5e\xfc\xfa\xec\xcd\xeb\xef\xce\xbe\xff\xf9\xed\xe9\xe5\xd9\x9b\xd7\xf8\xe4\xc7\x8b\x37\xaf\xb9\xff\xfe\x28\xeb\x43\xcf\x53\xf4\x3b\xf3\x84\x52\x34\x5c\x7f\x61\x3c\x11\xa2\x84\x4f\x1f\x8f\x8d\xf0\x1a\x99\x77\x2e\x40\x8f\xbd\xa9\xc9\xe9\xba\x79\x0b\xe8\x2c\xc3\x35\x19\x4a\x9d\x3e\x1e\xc3\xb5\xaa\x47\x8f\x1d\x94\xd6\x1a\x42\xf1\x8a\x95\x68\x80\xde\x20\x95\xf2\x1b\x0c\xef\x73\x2f\x47\x60\x2e\xeb\x5a\x55\xc3\x5c\xd6\xee\x76\x07\xbc\xe4\xfb\x13\x8f\xe6\x68\x29\x9a\x9b\x12\x18\xfc\x29\x57\x19\xcc\x56\x20\xcf\x3e\x48\x26\x89\xa3\x1e\x22\x11\x0c\x5f\xc3\x90\xb5\x0f\x59\x09\xe2\xf5\xf3\xdb\xb3\x5e\x49\x3b\x7f\x3b\x74\xba\x5e\x7c\x32\xba\xa5\x72\x5e\xd7\xa9\x7f\xc9\x43\xe1\x1c\x6f\x27\xbf\x0b\x95\xb7\xce\xfb\x11\xc4\x8a\x83\x3f\x0b\xb5\x22\xb0\xdd\xc8\x75\xa5\x3e\x9a\x56\x34\x96\x56\xc9\x66\xcd\xfa\xf1\x15\x5b\x45\xb8\x76\x82\x45\x4f\x68\x67\x83\xcd\x8c\x30\xa3\x9f\x10\xcf\xe0\x6d\x62\x2d\x0e\x38\x6f\x53\x76\x5d\xdd\x26\xd6\x2c\x94\xed\x1a\x96\x33\x5c\x6a\x57\xb2\xc7\xca\x6b\xef\x70\xcb\x7a\x3f\x86\x47\x3b\xad\xb6\xb1\xa6\x6c\x0b\x75\x0b\x77\x3e\x72\x91\xbd\x55\x4c\x75\x85\x50\x4c\x60\xdb\x30\xca\xec\x9d\x2a\x36\x9a\x61\x61\x38\x3f\xf3\x43\x5c\x5c\xeb\xbb\x30\x57\x12\x4d\xe7\xf6\x0a\x35\xe4\xa3\x79\xae\x9d\x37\x76\xb5\x17\x5b\xbc\x5f\xe8\x50\x73\xa6\x5d\xfc\x18\x66\xe9\x04\x75\xf4\xc8\x63\xc0\x73\x2d\xba\x16\xb5\xba\x56\x36\x7f\x1e\x85\x75\xe7\x20\x43\x21\x19\x08\x5b\x2c\xb8\x7c\xcd\x50\x42\x43\x78\xff\xa3\xb2\xbe\x6d\xa5\xdc\x0b\x80\x3f\xdf\x60\x15\xa2\x6e\x04\x90\xfa\xf7\x67\xee\x15\x5d\x2f\xbe\xcd\xa6\x10\xa9\x60\x68\x74\x49\x3e\x86\xec\x48\x48\x67\x62\x0f\x30\xdd\x2a\xe1\xb1\x41\xfe\x45\xa5\x68\x92\x51\x9e\x72\xc8\x70\xb7\x1d\xae\x77\x02\x3a\x50\x1f\x90\xb6\xb4\x75\x04\xc3\xd5\xdc\x57\x02\x44\xec\xd6\x15\xd6\xd0\x13\xa1\x9d\x8c\x54\x7e\x1c\x2a\x25\xe3\x75\x95\x45\xd8\xff\x32\x9e\xc3\xd9\xc9\xdf\xa5\x0f\xf1\x4b\x52\xbb\xd8\x74\xc9\x27\x76\x3f\x2f\xf1\x4b\x7e\xab\xea\x96\x3c\xa2\xb3\x4d\x07\x70\x86\x58\x8c\xcc\x38\x71\x10\x73\xeb\x0a\x53\xc1\xac\xad\x4b\x3e\xbf\x0f\x83\x81\xc4\x63\xa8\x25\x82\x82\x79\xe8\xba\xca\xb7\xc9\x4a\xfc\xaf\x56\xda\x45\xcb\x95\x9c\xe1\x15\xa4\x35\xa3\xc0\xa5\x4b\x16\xf4\xbb\x4f\x2e\x7b\x34\xec\x5a\xb4\x14\xbd\x9e\xb5\x78\xdf\xe6\x98\xa7\x7a\x14\x06\x55\x65\xec\xdd\x68\x80\xa2\xb1\xd7\x58\x65\x66\xe8\x65\xde\xb4\x3e\x83\x13\x28\xbd\x83\x45\xf6\x12\xf9\x3c\x4b\xd4\xe8\xcd\x14\xf3\x27\x03\x43\xee\x98\x1d\xa0\x9c\x96\xbf\xe1\x4e\xc8\xe8\x40\x14\xd8\x93\x13\xc3\x3a\x74\x4f\x3d\x7b\xfd\xdd\x9b\xdc\xfb\xfd\x9b\x33\xf5\x9d\x6b\x7d\x43\x4b\x8b\xa0\x5d\xb4\x05\xd7\xc0\x0c\x1b\xab\xbc\x5f\x0d\x91\x34\xe0\xef\x84\xc9\x7b\x70\x2f\x0c\x12\x34\x48\xd7\xb3\xbd\x58\xa3\x4c\xc6\x26\x32\x9d\xd2\xce\x23\x47\xc8\xc3\x05\x67\x5e\x01\x3c\x6f\xfc\xa2\x2f\x63\xdd\xc6\xbb\x32\x55\x0b\x63\x6d\xc9\x5d\xa2\xf8\x60\xc9\xf6\x23\xad\xf4\xfc\x71\x74\xa0\x09\xeb\xda\xd5\x62\xd8\xef\x22\x78\x7d\x2d\x40\x06\x22\x97\x9a\x84\xbf\x2c\x65\xc3\xb9\x2c\x54\x7a\xdd\xfb\x9c\xf1\xc1\x05\x47\x7d\x68\xc2\xed\x31\x44\x47\x7f\xbe\xfc\x6e\xf8\x4d\x56\xcb\x2a\x61\x7f\xa9\x15\x95\xb3\x36\xd6\x20\x85\x24\xe8\xa5\xa8\xf2\x82\x15\x85\x97\xa1\xd4\x87\x18\x18\x87\x73\x04\xad\xa6\x22\xd0\x46\x5a\xb6\x3d\x23\x05\x70\x48\x2b\x07\xc4\x56\xfc\xbe\xac\x43\xc7\x8e\x52\x75\xdd\x5e\x98\xaf\x0c\xb2\x4b\x0b\xcb\xfb\xd6\x2a\x19\x6e\xa5\xda\x72\x92\x43\x70\x0d\x54\xab\xae\xe7\xec\x5b\x98\xb4\xa3\x0b\xaa\xa9\x3f\x11\xef\x12\x6d\xfe\x11\x68\xf3\xfe\x04\xf2\xf0\xee\x78\xa1\x56\xef\x63\xb5\x7c\x78\x9d\x0a\xbf\xef\xfc\x34\xb1\xd0\x88\x6d\x76\xfa\x23\x96\x89\xfc\x0a\xc3\x1d\xd3\xaa\xd5\x4d\xdf\x33\x60\x7c\xcc\xbd\x46\xc8\x46\x51\x65\x9e\xbf\x1e\x3f\xfe\x08\x59\x48\x43\xc5\x81\x47\x5b\x41\x63\x91\xcc\x20\xed\x0a\xe7\x95\x57\xb5\x3f\xbc\x53\x40\x18\xc5\x0e\xd2\x16\xe1\x08\x3d\xdc\x98\x04\x40\xf0\xc6\xe9\x32\x88\xf9\x75\x03\x69\x5f\xd1\xd2\xe1\x34\x00\x99\x8c\x15\x7a\xb3\x16\x5f\x71\xb7\x38\xfa\x98\x4d\xd5\x94\x4e\xcd\x40\x11\xcf\xdf\x8d\xa9\xef\xfe\x27\xe0\xbc\x1f\xdc\xcc\xd5\xb5\x95\xd3\x27\x83\x1d\x19\xbb\x85\xa5\xe9\x8d\x65\x21\x30\xf3\xfa\xc8\x75\x72\xe4\x12\xc0\x9a\xed\xfe\xfc\x3f\x87\x19\xec\x40\x79\xf1\x0b\xc1\x10\xcf\x2a\xa9\x97\xb1\x09\x05\x6b\xca\x91\x48\x14\x6b\xae\x0a\x9a\xf2\x98\x2f\x12\xca\x1e\x03\x99\xf7\xfb\x49\xd3\x9b\x46\xd5\xb2\xd1\x0f\xa7\xeb\x11\xa5\x3f\x3d\x3f\x13\xcf\x2f\x5e\xde\xde\xe0\x0d\xf6\x76\xd7\x08\x2b\xb3\x4b\xb9\xe3\x33\x76\xba\x4c\xe0\x20\x30\x8f\x47\xef\x2f\x65\xb3\xeb\x76\xef\x94\x38\x06\x91\x4b\x36\xde\x3f\xb0\xe6\x78\x66\x33\x1d\x3a\x3e\x5e\x3f\xe8\x93\x80\x6f\xae\xbb\xe7\x00\x55\xed\x38\x7e\x87\x48\x0e\xdc\x84\x60\x5a\xaf\x5f\xd8\x44\xa1\x21\x42\xf4\xc8\xaf\xdf\x31\x26\x0a\x2b\x8a\xa3\xa0\x5e\x3d\x12\xa2\xa7\x48\xce\xa2\x67\x2c\xb9\xb7\x38\xfe\xd2\x7f\xe7\x39\x83\x24\x0c\xfb\x54\xf9\x25\xb6\xb5\x96\x65\x8f\x40\x34\xc2\x05\x6d\x98\xad\xf8\x1e\x22\xc2\x0f\x36\xe7\xe4\x0a\x4a\x20\x92\xd2\xaa\x72\x73\xae\x7b\xbd\x0e\x9d\x4d\xc3\x5c\xd8\x9c\x21\xc2\x6f\xca\xc9\x03\x5e\xd3\xce\x9f\x7f\xdb\x4f\xb2\xd8\x70\x45\x9f\x9b\xf2\xb9\x76\xb6\xa5\x41\xdf\xb6\x25\xca\x0b\xa2\x2c\xa4\x86\xf1\xeb\x4f\x6b\x3e\x92\xe6\x85\x08\xc5\x26\x73\x69\x87\xdb\xc9\x65\xaf\x1b\x28\x16\xb9\x75\xf5\xb4\x7d\x29\xb6\xe5\x3c\x7b\xd9\xfa\xb3\xc4\x17\x2c\x64\x2d\xd4\x95\x2e\x38\x50\xb6\x7e\xae\xd7\x42\x4e\x9c\xa9\x5a\xdf\x4d\x4a\x41\x9d\x14\xcc\x1e\xbd\x41\x8a\x88\xa9\x23\x50\x34\xde\xea\x2d\x89\xb3\x50\x97\xf2\xc3\xb0\xad\xb3\xdf\xf2\x44\xc9\x34\xe8\xd1\xa4\xff\xf1\x67\xa6\x0a\xcf\x9c\x4d\x10\x48\x11\xc9\xf2\x69\x04\xc9\x12\x20\xbf\x8c\x29\x0c\x7a\x93\x28\x08\x8c\xe0\x71\x54\x2e\xb4\x3a\x4c\x74\x04\x57\x37\xa9\x15\x68\xd8\x03\xc1\xb0\x37\xe9\x18\xa9\x18\xf7\xeb\xc3\x9d\x1b\x11\x2c\x6f\x5f\xac\x89\xfc\x84\xfc\x33\x51\x3b\x73\xbb\xa0\x53\xf3\xac\x5e\x7b\xfa\x83\x96\xd1\x01\x32\x6b\x7f\x1e\x89\x33\x44\xb1\x39\x6e\x95\xbe\xd3\x8e\x5a\x15\x53\x15\x94\x8f\x01\x2a\xd8\x1e\x9c\x87\x11\x1f\x52\x08\xc7\x50\x66\x9f\x46\x08\x23\x41\x0e\x3b\xce\x76\xc2\x48\x45\xa2\x18\xad\x16\xf4\x69\xe0\x47\x08\xd5\x07\x4f\x49\x5e\x9c\x3d\x82\x8d\xa1\xf0\xf4\xa1\x49\x0f\x68\xb0\xab\x07\xf9\x06\xd4\x77\x3e\xa9\xaf\x2e\x60\xde\xc3\x3e\xe4\xbc\x98\xba\x47\xdd\xfe\x9b\xc5\x4e\x79\x84\x57\x1d\xea\x95\x17\x03\x78\xf7\x0a\x95\xa6\xc6\x9e\x5d\x4e\x14\x95\x1d\x27\xdb\x2f\x3c\x8b\x28\xac\x9a\x69\xe7\xed\xea\x31\xd4\x16\x07\xee\x0c\x79\xcd\x77\xe2\x73\xb9\x85\x9f\x07\x6a\xd9\xf8\xd5\x61\x47\xdb\xe4\xfb\xdc\x22\x2b\xf9\xdc\xb3\xca\x4c\x64\x75\xe7\x9c\x67\x75\xc9\x69\xbf\x7a\xda\x07\xdb\xa5\xbe\x44\x5b\x27\x80\xa4\x6c\x4b\xfa\x14\x62\xcb\xab\x37\x53\xfe\x6b\x6a\x80\xd6\xe9\x09\x98\x72\x87\xa3\x4f\xae\x81\x2e\x95\x57\x45\xf6\x4e\x4b\xde\xd0\x4e\x4f\xb7\x6c\x81\xbe\x02\x89\x8b\x38\xd0\x1c\x30\xce\x7e\x97\x4b\x2a\xf5\x6d\x3b\xcc\xb4\x8c\x29\x1f\xd0\x36\xa0\xc7\x80\x7a\xb6\xc1\xbc\x7b\xbd\x82\x2d\xc5\xe9\x86\x9a\xc7\xa9\x88\x42\x7e\x5a\x21\x65\xdf\x73\x42\xda\xf8\xdc\x94\x68\xac\x7d\xa9\x96\xc0\x58\x51\x2a\x47\x5b\xf8\x18\x8a\xe9\xe2\xef\x39\xb8\xf1\x08\xaa\x61\xd4\x98\x32\x8d\x23\xc8\x53\xad\xaa\x12\x89\x9c\xde\x6c\x8c\xc9\xea\x69\xe1\xc3\x12\x9e\x47\xc6\xca\x4b\x6e\x9b\xa7\x0b\xb1\x54\x76\xc6\x7d\x73\x21\x04\x42\x6c\x44\x12\x36\x1e\x61\xea\xf6\x3c\xa9\x25\x76\xdf\x70\xaa\x06\x3f\xe5\x33\xc0\x5d\x9b\xe6\x4a\xba\xa5\xff\x4c\x6b\x07\x04\x8c\xbc\xa5\x47\x76\x63\xcd\x12\xd9\xf0\xad\x7b\x20\x46\xef\x83\xd3\xe7\x69\x16\x66\x78\x32\x01\x71\xaa\x74\x7f\x45\x5d\x68\x23\xbd\x9e\x64\x91\x4c\x20\x2f\xd2\x7b\xe3\x41\x92\x31\x0a\xec\x7e\x65\x6a\xed\x8d\x1d\x27\x83\xb1\x2b\x9b\xf5\xf3\x0e\x44\x24\xb8\x2b\xac\x6c\x54\xd9\xdf\x5b\xd1\x6f\x4f\x19\x14\xf1\xbe\x96\x21\x1c\xf7\x34\x0e\x15\xc5\xa9\x7b\xc9\xf7\x62\xc0\x42\x62\x84\x78\xa5\x0b\x6b\xce\x83\xd1\x4c\x20\x5f\x85\x4f\x47\xe2\x5f\x4f\xdf\xbe\x3e\x7b\xfd\x3d\x5f\x10\xad\xea\x89\xf6\xd6\x65\xc4\x97\xad\x82\x60\xc7\x70\x41\xd6\xbf\xad\x30\x56\x19\x77\xdc\x71\x6f\x18\xd1\x7c\xd7\xa1\xfe\x05\xd7\x65\x50\x89\xe6\x7b\x16\xb3\x6e\x0e\x2a\x21\xd0\x31\x24\x36\x49\x19\x63\xe8\xb0\xfb\x37\xd3\x12\xd1\x70\x89\x18\x37\xa6\x1c\x2e\x19\xc5\x78\xf6\x72\x29\x55\x3a\xfe\x32\x82\xb1\x7d\x10\x9f\x98\xd1\x7e\x6e\x5a\xbf\xfe\x51\x44\x8b\xa8\x4a\x40\x37\x20\xe8\xad\x79\x5d\x8f\xe1\x01\xa3\x8c\x60\x3b\x17\xa3\xdc\x20\xd0\x38\xe0\x92\xf6\x8e\x4a\x7e\x4b\x43\xa4\x6c\xca\xfb\x5f\x15\xb7\xcf\x1c\xc0\x6c\x56\x38\xf5\xe4\xa1\x4b\xf1\x0a\x48\x65\x67\x07\x9e\x70\x0e\xde\xbe\x07\x3c\x43\xf0\x24\xb4\xb8\xa0\x59\x58\x6c\x90\x30\x88\x5b\x0c\xfe\x10\xa6\x8f\x2e\x88\xc6\x94\x83\xce\x5f\xd5\x9b\x51\xe0\xf7\x16\x1b\x56\x5d\xad\xab\xe1\x60\x7a\xd1\xd1\x2b\xeb\xf4\x26\x52\xb2\xc5\x48\x82\x7b\xd3\x65\xef\x92\x25\xcb\x5d\x2c\x65\x1d\x32\x1f\xd1\x1e\x55\xb3\xd9\xbb\x32\xed\x7e\x96\x34\xa6\xca\xf5\x62\x23\x6c\xaf\x6c\xd2\x98\x75\xcf\x98\x45\x14\xe2\x02\xc7\xd9\x21\x75\xce\x04\x1f\x0f\xb2\xe7\xab\x02\x39\x32\xab\x1d\x68\x13\x50\x5a\xa4\xe3\xd4\x5d\x55\xaf\xef\xba\xae\xeb\xca\xca\xb4\x1d\xbe\x1f\x87\x2e\x29\x69\x9c\xfa\x0e\xd5\x03\xec\x8d\x5a\xa7\xab\x66\x07\x77\x63\xa9\xbe\x9b\xea\x05\x57\xa6\xb5\x84\x6d\x84\xd4\xbd\xc4\x56\xab\xed\xc4\xc3\x02\xa1\x9d\xc3\xfa\x06\x62\xc5\x8a\x2d\x6e\x75\x6c\xe8\xae\x67\xcf\x23\x30\xab\x83\x8c\xed\xfc\xf0\xfc\x9a\x68\x62\x58\xcc\xc7\x67\xa1\x41\xee\x39\x88\x5b\xa9\xa9\x17\x64\x70\x07\x4c\xb4\xeb\x9f\x93\x8c\x93\x97\x0b\x55\x77\x86\xe8\x56\x91\x4b\x9c\x4e\x92\xb2\x91\x47\xdc\x3d\xf3\xae\x2c\xde\xfe\x56\xb3\xee\xc2\x78\x87\xba\x8c\xe7\xb4\xdc\xb0\xba\xb9\xf1\x13\xa9\xea\x32\xa9\x1c\x6c\x00\x1d\x85\x3a\xe5\x9b\xa4\x29\xd3\x41\xcc\x95\xce\x39\x66\xe3\xd8\xba\x1f\x79\xc7\x31\xde\xd5\xcd\x07\x6a\xba\x46\xa6\xe8\xd1\xa6\xd3\x34\x65\xee\x72\x59\xfa\xbd\x6f\x02\xfd\x4c\xe1\xb4\xf1\x5c\xff\xba\x92\xe8\xcd\x6c\x66\x44\x1b\x6e\xbb\x26\xf8\x01\x20\xe4\x87\x4c\x69\x3a\x31\xee\x17\xcf\x97\xa6\x58\x28\x1b\xc0\x23\xd8\x9d\xe9\x71\x4e\x52\x78\x18\x47\x03\x59\x87\x9c\x40\xc1\xfa\x7b\x6d\x8d\xf1\x8f\x1c\xcd\x24\x0d\xd5\x53\x51\x4c\x33\x3a\x19\x47\xe2\xf5\x9b\xcb\x17\x28\x55\x59\x36\xba\xe2\x58\x9a\x14\x9c\x08\x13\x8c\x67\xec\xc2\x81\xd0\x23\x35\xca\x8d\xbe\x71\x23\x8b\x05\x18\x0f\xea\x3c\x0d\x03\xf8\x21\x10\xed\x7a\xed\xac\xb9\xfc\x20\x56\x29\x0e\x90\x38\x72\xad\xaa\x0a\xff\xfd\xdb\xe9\xab\x97\xe4\x12\xfb\xeb\xab\x97\xb9\x18\x90\x62\x25\x03\x96\xd5\x57\x7c\x10\xd5\x8b\x4a\xa1\x56\xfd\x9f\xbf\xd7\xdf\x82\x37\xa1\xe7\x2d\x5b\xb1\x0a\x45\x03\xa9\xf6\x02\x0c\xe7\x85\x50\x73\x6e\x88\x35\xb9\x60\x08\x24\xbb\xb0\x7a\xe2\x79\x8e\xf3\x8e\xed\x33\x1a\x42\xf0\x7a\x05\x2a\xd9\xdf\x62\xaf\xef\x4e\xc8\xca\x9e\xfb\x35\x72\xff\x70\x90\xbd\x8c\xa8\x6a\x6a\x81\x16\xd0\xee\x22\xc3\x8f\xc2\x48\xcb\x18\x9e\x61\xb3\xff\xee\xfd\xfd\xfb\x24\xb3\x90\x9e\x07\x90\x97\xab\x46\xdd\x60\x69\x45\x69\x66\x69\xa3\x39\x5d\x57\x70\x3d\x95\xce\x0f\x7f\x93\x36\x14\x5d\xb3\x14\x26\xbb\x8f\x17\xd3\x7d\x75\x38\x8a\xfe\xb3\x89\xf1\xf3\x7c\x38\x64\x30\x8d\x97\x36\x33\x44\x06\xc2\x5f\x9b\x9e\xda\xfe\x49\xa7\xf6\x18\xd1\xf6\xe3\x07\x0f\x83\xdd\x39\x20\xb5\x0a\x11\x48\x10\xd1\x56\x9e\x1b\x06\x6e\xe9\xf1\x9f\x21\xc2\x70\xc9\xf5\x89\x9c\x41\xab\x64\xb9\x42\xe8\x99\x33\x04\x52\x07\xfb\x2e\x68\x5b\xb5\xb9\x56\x8e\x95\xa1\x98\x91\x25\x91\x61\x66\xdb\x8b\x00\xe2\x0b\x7a\x7c\x13\x3d\x9b\x4b\xde\xfb\x00\x31\xd5\xd6\xf9\x1e\xc5\x93\x0f\x24\x38\x2d\x55\xd9\xd3\xdf\x19\xe0\x64\xa8\xd5\x78\x57\x08\x7d\xb8\xea\x19\xf5\xd4\xa7\xad\xb7\xc4\x73\x38\xac\xa6\xf2\x41\xf4\x65\xf6\x24\x49\xd4\xce\x0f\x68\x06\xbf\x8d\x07\x40\x66\x03\xb7\x8d\x78\x25\xd1\x7e\x84\xb3\x4a\x41\x8b\xb3\x9e\x1b\x11\x2a\x4b\x86\x8f\x58\x2f\x35\xc6\xc1\xac\x5f\xdd\xe2\x31\x20\x4f\xc4\x0e\x4b\xb9\x5d\xe7\x53\xd2\x47\xd4\xf8\xfd\xad\x9e\xf4\x8f\xf0\x6b\xf7\xe5\x1c\xa4\xe0\x54\xe8\xa4\x9f\x32\x0e\x04\x93\x3c\xef\xc8\x11\x13\x41\x38\xfb\xc1\x09\x7e\x14\x2e\x88\x7b\xc9\x1b\xb0\x8b\x56\x63\x66\x64\x3b\x54\x48\x1a\x50\xc1\x32\xc0\x9e\xa4\x4e\x2e\x40\x23\xd4\x2f\x8d\xc3\x79\x3b\x16\x66\x82\xfa\x80\x51\xd7\xc9\x00\xf0\x5b\xf6\x10\x02\x18\x4a\xbc\x96\x0a\x6f\x1f\x09\x56\xbe\xba\x16\x63\xbe\x1f\x8d\xc5\x81\xfa\x20\x91\xbf\x8d\x77\x94\x2b\x37\xcc\x50\x8f\x9f\x1c\x82\x34\xa9\xbc\x9b\xe0\xca\xde\x12\x91\xa2\x1b\x5c\x5c\x32\xe1\x35\x12\xe7\xb7\xcf\x4b\x4a\x7c\xae\x67\x71\xf1\x8d\xd5\xc6\x6a\x18\xbf\x5c\x08\xd3\xb9\xe7\xe9\x06\x41\x34\xef\x16\xc3\x5d\x2d\x06\xc4\x84\xfe\x12\x16\x6a\x15\x67\x49\x75\x35\xf1\x0f\xe1\x4e\x52\x6f\x7c\x18\x6f\x26\xfc\x64\xb4\x82\x31\x8c\x37\xe6\x4a\xdc\x41\xad\x41\xa5\x64\xb0\x5d\x13\x59\xc1\x53\x20\x9a\x11\x82\x2c\x57\x16\x79\xa6\x83\x1b\x83\x78\x44\x20\xce\x45\x4a\x72\xc0\x1d\xc5\x92\x5e\x49\xcf\x4e\xe7\x1c\xcb\x29\x8f\x2f\x97\x37\xb3\x69\xb0\xb1\xa8\x60\x44\xd0\x6f\x0b\x79\xcb\x90\x2c\xed\xe4\x86\x0f\xa9\x6f\x15\x58\xc1\x94\x76\xdc\x26\x92\xdb\x40\x26\x9f\x57\xd0\x9d\x1a\xa7\xcc\x8c\xad\xfd\xd8\x59\xd5\xb7\x0d\xe7\xcc\xb8\xd1\xfe\x7f\x9a\xfe\xa4\x3b\x35\x24\x25\xd1\xcd\x81\x83\xe8\x5e\xd9\x25\x13\x7d\x97\x79\xe6\x4a\x5c\xbe\xbc\x10\xd9\x28\x1a\x31\x10\xf4\xbc\xcb\x58\x95\x33\x05\x76\xa2\xd6\x8d\xbb\xc3\x86\x93\xdc\x2a\x55\x17\x76\xd5\xf8\xf1\xb6\x4a\xcc\xa4\xd6\x82\x4a\xdb\x52\x91\x99\xf5\x02\xb9\xa1\x2e\x73\x4d\x1c\xef\xb1\x98\x6c\x54\x52\x8f\xfd\x02\xda\x5b\xf1\xe3\xa5\x7c\x14\x96\x2c\xd8\x3b\x22\x9b\x5f\x61\xa3\x3e\xcf\xb6\x65\xc0\x75\x6d\x45\x5c\xa1\xd7\xe5\x18\x53\x91\xdb\x5e\x76\x89\xa6\x1c\x34\xba\x4e\xbf\xdf\x1b\x64\x8d\x38\xd7\x92\xc2\xb2\xc9\xe9\xf5\x3b\x9f\x42\x86\xdd\x05\x01\x56\xce\x42\xa5\x08\x11\x0f\xc9\x42\x2e\xb0\x7e\x06\xe1\x11\x9e\x6b\xed\x54\x72\x46\xe0\x36\x2e\x29\x51\x2d\x5d\xeb\x45\xd6\x44\x83\xaf\xb5\x7b\xc7\x7b\xf7\xe0\xcb\x9a\xdc\x44\x54\x6f\xe6\xcb\x42\xad\x76\x64\xc4\xba\xd4\xe4\x07\xeb\x43\x4a\x4e\xa7\x54\x1f\x50\x62\xf0\x51\xe7\x94\x16\x2c\x3b\x9f\x47\x6a\x18\x24\xf8\xaf\x3e\x93\xd4\x30\xc8\x28\x3b\x9f\x43\x6a\x18\xe4\x6e\x3c\xe9\x9f\x54\xf7\x10\xa0\x5e\xd7\xc1\xdf\x49\xf3\x6c\x3b\x55\x3f\xb7\x28\xf5\xd7\xf5\xdf\x92\xb4\xb3\x24\xdd\x6c\xff\xec\xc8\xa2\x0c\xc0\x1a\x17\x62\xb9\x0e\x47\x98\x59\xd4\xd2\x15\xb3\x67\x47\x33\xce\xfc\xb7\xa9\x06\xd6\x19\xe4\x91\xc8\x5d\x90\xe9\x5c\xef\x59\x04\x30\x6d\x70\x6d\xe0\x66\x62\x0c\x71\xa2\xba\xaa\xa1\x3c\x43\x9e\x4c\x70\x12\x6f\x4b\xd6\xaf\xe0\x9b\x2e\x3f\x2e\x44\x0d\x09\x53\x16\x25\x7a\xfe\xa7\x73\x27\x3e\x5f\x81\x5c\x26\xb6\xf8\x28\x6a\x0d\x81\x80\x4f\x3c\xbf\xf3\x47\x03\xc8\xd2\xcd\x87\x11\x49\x9d\x24\xb2\x05\x32\xec\x67\xa7\x24\xe6\xf1\x19\x06\xf4\x03\x24\xa9\xb8\xc2\xbb\xfc\xb1\xdf\xba\xae\x67\x20\xa0\x9b\xa3\x21\x5d\xf4\x74\xd2\x67\x07\xfc\xd3\x28\xb9\x48\xd1\xf4\x91\xfb\x11\x09\xee\x11\xc8\x51\x7f\x5d\x4f\xad\x74\xde\xb6\x05\x7a\x13\xc5\x97\x58\xd5\x9a\x51\xbf\xfe\x90\x74\xe8\x48\xfa\x90\xe6\xd4\xcd\x02\xf9\x00\xaa\xe3\x66\xe1\x8d\x45\x97\x9d\x21\xf3\x19\x54\x08\xc3\xd4\xd3\xcf\xa8\x42\x18\xa6\xfc\xf7\x53\x21\x9a\xde\xc5\xb1\x6a\x08\x43\x3c\xb7\xed\x87\x8d\xa9\x74\xb1\xba\xef\x55\x62\x6e\xae\x21\x54\xa5\x92\x55\x58\x41\x9c\x20\x36\x2e\x09\x0d\xb7\xc5\x98\x2a\x4e\x61\xf9\x3f\x0f\x17\x9f\xe8\x4f\x83\xed\xff\x56\xc5\x6e\x18\x3c\xe8\x9e\x14\xc8\xd6\xce\x50\x7b\x14\x88\xeb\xe7\x0d\x97\x15\xc9\x3e\x84\xaf\x89\xfc\xf5\xb1\x0f\x19\x17\xcb\xf6\x73\x78\xe0\xfd\x88\x59\xbe\x78\x56\x0b\xff\xe4\x01\x28\x1e\xc8\x66\x05\x16\x62\x5b\x72\xc3\xe2\x1b\x37\x5c\x5b\x8e\x3b\x86\x32\xfb\xa7\xb5\xdf\x8a\x53\x96\x6c\xae\x96\xee\x14\x18\xfc\x12\x94\x1a\xab\xae\x4c\x45\x7e\xca\x18\xd3\x72\x2d\xb9\x6a\x80\x16\x4a\xa7\x67\x8f\xc2\x57\xcd\xeb\x76\x7d\x3f\xf5\x8d\x31\xfd\x58\xa3\x9f\xd3\xdd\xb3\xfa\x10\xef\xde\xc9\x46\xcf\xac\x69\x9b\xe3\xf7\x5c\x9c\x7d\xf2\x1e\x6f\xa1\x9f\xbc\x4b\xca\xfa\xf8\x3d\xfe\xf9\xc5\xda\xf4\xf7\x97\xa9\x1b\xe5\x28\x17\x23\x2e\x4d\xa0\xe7\x43\x36\x9d\xa9\xac\x39\xe2\xc7\x29\x3b\x81\x43\x29\xf1\x09\x73\xf6\x21\x86\x16\xca\x14\x17\xe2\x97\x56\x39\x7b\x81\x2b\x7d\x8d\xcd\x81\xbb\xc3\xa4\xe8\xa0\x9c\xbb\xb3\x8a\x53\x8e\xb6\x87\xc2\xf5\x74\x03\xc9\xac\xf5\x92\xe4\x84\xad\xae\x43\x4b\xcc\x4b\x26\xa0\xfc\xce\x8d\xec\x77\xd3\x7b\x04\x71\xe7\xcf\x93\xb7\x88\x8e\xe3\xb8\x3e\x77\x0c\x45\xe0\x3e\x96\x27\x70\xa2\x4b\x3e\x6d\x6d\x4a\x35\x5c\xeb\x94\x7b\x6b\xa9\x6c\x84\x1b\x20\x46\x1f\x90\x74\xe2\xb5\x29\xd5\x79\xbf\x73\x6e\x7a\x51\x30\xce\xe6\x4d\xa5\x52\xe6\xf2\x03\xe9\x4f\x1d\xf3\x9b\xc8\x49\x7f\x99\x66\x74\x21\x7e\xb2\x99\xea\x98\x7f\xd2\xb5\x0b\x3f\x40\x57\xc7\xd2\xf0\xfb\xbd\x14\x4f\x3c\x8c\x41\x5f\xa2\x27\x1e\x46\x2b\x5b\xc4\xcc\x50\x6d\x03\x3a\xba\x70\x88\x2d\xf9\x15\x7f\x81\x2c\x12\xef\x46\x70\x2b\xf6\x54\xf1\x46\x68\xd8\xa1\x08\x0d\xcd\x07\xdc\x31\x43\xd5\xf5\x6c\x18\x13\xe9\x8f\x09\xce\x50\xd6\xe5\xb0\xa3\xdf\x71\x8a\x1d\x52\x37\xb1\x52\x79\xa9\xab\xd8\x70\x28\x7d\x95\xb5\xdb\xed\x5a\x74\x91\x8f\xdd\xe9\xa5\xae\x24\xac\xd5\x1a\xa9\x23\xe9\xf9\x61\xd8\xe5\x98\xce\x85\x10\xee\x40\x8c\x7f\x52\xab\x77\x4f\x7f\x91\x55\xab\xde\x9f\xbc\x98\x4e\x55\xe1\xdf\x9d\x5c\x50\x83\x2e\xf7\x7e\x1c\x8b\x0a\xc9\x20\xa2\xf3\xc7\x21\x9e\xad\xc4\xc4\xa2\x98\x9f\x5b\x8b\xe1\x17\xb1\x92\x70\x24\xbe\xeb\x7c\xd9\xee\x44\x0c\xc5\x18\xb4\x1b\x22\x01\x60\xd4\xa7\x4c\xe8\x89\x7e\xf2\xda\x5c\x30\xa9\xc7\xf1\xeb\xb5\x0f\xb9\x4f\x75\x9e\xf5\x7f\xf2\xda\xbc\xa0\x70\xb4\x3a\xf9\xfa\xc9\x93\x27\xc1\x60\x18\xa2\x73\x96\x5b\x60\x9f\x3f\x75\xae\x3c\x39\x27\x33\x31\x87\x1f\x82\xdf\xbc\xa7\x73\xad\xf4\x18\x4e\x31\x92\x93\x5d\xcf\x30\x9c\x10\xb1\x78\x32\x0c\x04\x52\x2c\x3a\x6a\xd0\x3b\xd2\x6e\x97\x81\x74\x8c\x79\x2b\x8b\x87\x6d\x4a\x71\x19\x66\xd8\x1e\xd7\xea\x6b\xc6\xa6\x9d\x54\xda\xcd\x23\x52\xb9\x59\x1b\xf3\xd1\x64\xc8\xcc\x8e\x40\xb3\xdc\x58\x7e\xd6\x38\x26\xa5\x76\x05\x12\x60\x93\x37\x37\xf4\x3f\x4b\x51\x93\x38\x67\xca\x8f\x4d\x62\x19\xc9\x9a\x8e\x42\x71\x90\x5e\xf1\x3e\x3a\xfa\x51\xaa\x99\xb2\x47\x47\x87\xa3\x7c\xb5\x5d\xfa\xd4\x4d\x29\x9b\xff\x49\x4e\xb7\xd8\x41\x27\x51\x37\x5d\x15\x7a\x89\x44\x84\x09\x04\xb4\xa8\x5a\xd8\xae\xdd\xf7\x8c\x40\xaf\x8f\xca\x36\x7e\x6c\x31\xfd\xee\x93\xf0\x85\x4f\x23\x68\x1e\x1e\x72\x45\x58\xe6\x94\x4b\x33\x96\xd2\xcb\x74\x20\xba\xfe\x83\x42\xb9\x81\x03\x90\x79\x43\x8c\x88\xe9\x8e\x18\xf1\xab\x41\x71\x54\x44\x2e\x97\xee\x88\xe8\xc1\x76\xd9\xdd\xd2\x3b\x27\xc7\xc7\x51\x40\xcc\xae\x27\x73\xdc\x86\x13\x0f\x21\xec\x3b\x9b\x60\x0f\xfd\x09\xfd\xde\x36\xd8\x70\xc7\x2f\xef\x09\x9c\x5d\x30\x45\x88\x26\x66\xd3\x7c\xb9\x97\x35\x40\x54\xe5\x43\x3e\x98\xf3\xd3\x8b\xe7\xa7\x5b\x34\x12\xb7\xb6\x67\x49\xce\x99\x4d\x56\x02\x8d\x8a\x3d\x73\x95\x75\xb1\x7c\x41\xf5\x41\x71\xe6\x4a\xca\x6e\x4d\x97\xe7\x98\x16\x0f\x3e\x8f\xbd\xd5\xb3\x99\xb2\x6e\xcc\xc7\xac\x30\x76\x4b\x72\x61\x36\x16\x9d\x99\x96\xd2\x2e\x70\x97\x64\x95\x14\xfb\x99\x87\x03\x3a\x53\x97\xf0\x8d\xf1\x4d\x1d\xee\x33\x42\x3c\x57\x2c\x71\xa0\x48\x79\x2c\x1d\x45\x06\xc1\x89\x0b\xa5\xf2\xc1\xaa\xe9\xc9\xdb\x37\x6f\x2e\x4f\x62\x6a\xd7\x71\xfc\xc7\x10\x97\xda\x91\x2c\x4d\xf1\x4f\xfc\xab\xe1\x42\x95\x92\x7e\xfd\x2e\x46\x8e\x09\x68\x0c\xc6\xae\xe1\x0c\xbb\xdc\x0a\xea\xf1\xf3\x3e\x66\xb2\x8a\x6b\x89\x4e\x2a\xb1\xa4\xa1\xfb\x36\xd9\x3a\x9c\x39\x1b\x20\x2f\x95\x97\xd8\xad\x3b\x62\x5c\xaa\xab\x2d\x08\x97\xea\x6a\x37\x7c\x4b\xf4\xc4\x31\x0d\x1c\x11\x09\xed\x35\x59\xea\xeb\x7a\xde\x06\xff\x65\xf5\xfd\x28\x76\x1d\x4b\xbf\xe9\x94\xa9\xae\xc1\x30\x26\x5d\xd8\x08\xdd\x8d\x28\x92\x3c\xc7\x6e\x2e\x8b\xc5\x10\xdc\x47\xb7\x5d\x65\x87\x56\xd1\x1d\xd6\xdd\x89\xf1\x85\xf2\xc9\x21\x32\xfc\x73\x1c\xc6\x5e\x61\x76\x21\x7b\xd3\x70\xcb\xa3\x6e\x06\xb6\x36\xd4\x07\x0c\xe0\x66\xa0\x82\xbd\x88\x7a\x9a\x55\x63\xa6\xe4\x79\x5a\x0c\xd2\x22\x0a\x33\xab\xd1\xe0\x09\x1e\x20\x64\x13\x41\x5d\x10\xb7\xe2\x4d\x3d\x5f\x58\x63\x28\x1b\x7b\x08\x6d\x63\xaf\x64\xd5\xef\xaa\xbc\xed\xbd\xdb\x33\xfe\x52\x1c\xf0\x6b\xc4\x94\x07\x43\x4e\xf1\xd0\x3a\x9b\x29\x2a\xfa\xde\xc0\xc2\x98\x0a\xfd\xa3\x77\x7e\x7c\x18\xc2\x7d\x0d\xae\x85\x01\x62\xa2\xfc\xb5\xe2\x62\xc3\x0a\x19\x9a\xa1\xd3\x69\x9a\xce\x2a\xce\x05\xcd\xda\xee\x33\xd9\x62\x22\x1b\x16\x4f\x3d\xc3\x80\xf1\x93\x1c\x3b\x5d\x56\x2a\x32\x75\x58\x70\xc3\xa6\x3b\x10\x24\xe3\x23\x39\xb2\xa2\x4c\x47\xaf\x5b\xe4\x07\x30\x51\x7d\x0c\xb8\x8d\x76\x86\x5c\x8a\x0b\x64\x55\xd5\x51\x56\x72\x34\x51\xea\x7d\x4f\x2c\xe3\xf3\xc4\x77\x00\x96\x1f\xee\x0d\x58\x7e\xd8\x01\x30\x73\xc7\xed\x9a\xcf\x29\xcb\xd2\xd4\xee\x18\xba\x71\x84\xff\xbb\x0c\xe3\xb7\x5c\x47\x9e\x77\xc5\x52\x66\x9a\xe6\xc1\x93\x5d\xc6\xe6\xc9\x86\xc4\x88\xd8\xbb\xf7\x45\x26\xa0\x4c\x7f\x4a\x9d\x8d\x8a\x7d\x0c\x14\xc7\xbc\x3d\x7b\xb9\x8b\x0c\x2d\x5a\x72\x72\xfd\x38\xce\x5a\xd8\x90\xbf\xfd\x38\xec\xd5\xa5\x6c\x88\x12\xa8\x27\xe7\xf3\x22\xd5\x02\x02\xc9\x64\x8e\x30\x52\x7c\x1c\xbb\xd1\x69\x7c\xe9\x25\x39\x99\xc7\xfd\x62\x0a\x0e\x79\xa4\xd2\xfe\x52\x15\x55\xc8\xb2\x57\x36\x41\xc3\x5e\xe8\x65\x20\x53\xd4\xa0\xd2\xf5\x82\x81\xd2\x8e\x55\x35\x52\x0f\xcd\x34\x0b\x30\x78\x93\x2d\x31\x2f\xe1\x48\x49\x58\x9d\x8f\xe6\xeb\xd8\x8f\xfd\xa1\xec\xa5\xaf\x79\x13\xdd\x7d\x87\x8b\x05\xcb\x79\x27\x8f\x78\x78\xd3\xc6\x4c\xb0\x4c\x32\x64\x89\xaf\x5d\x8c\x2b\x9a\xbd\x20\xdd\x52\x2e\x82\x1e\x4d\xb7\x31\xd8\x68\xe8\xc0\xb3\x94\xb5\x9c\xa9\xee\xb5\x90\x0d\x34\xff\xfb\xe2\x15\x2f\x5e\x39\x70\x38\xab\x76\xbe\x35\x85\x8f\x53\xb2\x2c\xa5\x94\x79\x59\xf0\x81\x1a\x6f\x27\x4c\x59\x7e\x1b\x2b\xbf\x26\xec\xf8\xc6\x26\x58\x87\x4f\xd9\x31\x05\x69\x00\x87\xb5\x4b\xb7\xb3\xbc\xd0\xef\xb8\xf7\x58\xdd\x9a\x1f\x34\xde\xbf\x36\xe1\xc3\xcd\xd9\xc1\x8f\xc8\x6b\x97\xfc\xa1\xdd\x0c\xdf\x3c\xe9\x4d\x91\xc1\x1a\x7e\xfc\x8a\x70\xb1\x1b\xc6\x5e\x4f\xdd\xc3\x92\x37\x2d\x92\x3b\x59\x8d\xa8\xf2\xa4\xbb\x17\x05\xff\xda\x03\xed\x74\x8a\x64\xbd\xa2\x19\xfa\x01\xac\x8d\x0c\xe4\xfc\x6a\xb4\xae\x02\x88\x3c\xb0\xa6\x2c\xf2\xaa\x3b\x3c\xd2\xf1\x1b\x5a\x95\x94\x06\xda\xb7\x0a\xbd\x72\x55\xc5\x46\x37\xf6\x7c\x6a\xb5\x7f\x14\x56\x7b\x94\xa7\x36\x73\x48\x96\xa2\xc1\x38\x2f\xe8\x49\x07\x24\xcb\xc7\x2a\x98\xf8\xfa\xe9\x5a\x49\x73\x5e\xd8\x12\xcd\xfd\x00\x3e\x5d\xdc\xe1\xe8\x91\x61\x9e\x18\xc4\x84\x49\x7a\xb0\xc7\x4e\xcd\xca\x14\x0b\xe2\x82\x47\xa1\x94\x95\xcb\x93\x89\xf1\x6e\xef\x70\x34\x1a\x8d\xb9\xca\x86\xdd\x49\xc9\x9f\x2d\xcb\xd2\x09\xee\xb3\x81\x63\x81\xac\x46\xee\x6e\xb4\x4e\xc7\xb5\xb2\xa8\xd4\x32\xb0\x2b\xc5\x94\xe5\xf1\xb5\xd5\xa9\xc1\x3a\x75\xd4\x02\xc1\xf0\x97\xd4\x03\x00\x34\xc8\xd3\x84\x79\xf3\xca\xae\x29\x74\x9c\xe9\x0b\x7e\x60\x13\xd6\x06\x94\x74\xdd\xb5\x88\xde\x78\xe3\x29\xc7\x74\xb4\xff\x5f\x5c\x87\x06\x37\x96\x8a\x35\x7a\xf8\x07\x62\x5d\xaa\x2e\xb4\x72\x77\xce\x4a\xb9\x32\xb4\x8a\xf0\x02\x26\x8b\xac\x1a\xf4\xed\x04\x59\xcb\x6a\xf5\x77\xce\xcf\x61\xbb\x95\x42\x45\xbc\x30\x81\x20\xb8\xc8\x67\x8e\x31\x0f\x3e\xee\xa8\x98\xaf\x2b\xf8\x77\xa3\x17\xa3\x59\xbf\xbe\x6b\x43\xae\xf5\x52\x59\x4e\xce\x16\x9c\x8e\x4e\x65\x3e\xfc\x97\x8d\xfa\x47\xa0\xd0\x3d\xd3\x8f\x94\x29\x33\xed\xa1\x74\x7b\xa7\xe7\x9c\xa6\x51\x39\xec\xea\xd4\x7b\x9d\x39\xf4\xd2\x76\x80\x14\x77\x79\x19\x51\xba\x52\x92\xbb\xc0\x0e\x1e\x89\xe7\xec\xda\x8b\xeb\x34\x62\xef\x4f\x99\x78\x13\x06\x7f\x1e\xe2\xdb\xbd\xd1\xd6\x69\x8e\x51\xa0\x96\x5d\x27\xd2\xac\x71\x85\x77\xcf\x7d\xfb\xac\xdb\xe8\xb2\xab\x1b\x0f\xae\x3b\x33\xdd\xa6\x77\xa3\x2a\x80\xf6\xc5\x3c\xd8\xda\x07\x7b\xe1\x09\xab\x57\xb2\xd9\xc3\xfe\xdb\x7b\x89\xa5\xed\x1d\xc6\x0c\x9b\x1e\xbe\xe1\x6f\x39\x76\x54\x0f\xbf\x63\x9a\xec\x4b\x7c\xbb\x9d\x43\xba\x84\x7d\x3b\x5d\xe1\xbc\x21\x45\xc6\x09\x69\xa9\x57\x39\xd0\xdd\x8a\x12\x89\xe7\x28\x5c\x61\x46\xc6\xce\x8e\x33\x92\x6e\xc1\x94\xae\x04\x3b\xe3\x9a\x3d\x24\x74\x5f\x8c\x19\xd7\x4d\xa6\xaf\x6b\xfd\x5a\x2e\xd5\xe8\x8b\xff\x37\x00\xa3\x64\x59\xb8\xe5\xcd\x00\x00"),
 		},
 	}
 	fs["/"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
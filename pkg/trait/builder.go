@@ -19,7 +19,9 @@ package trait
 
 import (
 	"fmt"
+	"reflect"
 	"sort"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/utils/pointer"
@@ -27,6 +29,7 @@ import (
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
 	traitv1 "github.com/apache/camel-k/pkg/apis/camel/v1/trait"
 	"github.com/apache/camel-k/pkg/builder"
+	"github.com/apache/camel-k/pkg/util"
 	mvn "github.com/apache/camel-k/pkg/util/maven"
 	"github.com/apache/camel-k/pkg/util/property"
 )
@@ -52,6 +55,54 @@ func (t *builderTrait) InfluencesKit() bool {
 	return true
 }
 
+var _ ComparableTrait = &builderTrait{}
+
+// Matches compares the builder traits, the properties being compared by the values they resolve to,
+// so that their order is ignored.
+func (t *builderTrait) Matches(trait Trait) bool {
+	bt, ok := trait.(*builderTrait)
+	if !ok {
+		return false
+	}
+
+	if !reflect.DeepEqual(t.Enabled, bt.Enabled) || !propertiesMatch(t.Properties, bt.Properties) {
+		return false
+	}
+	// The BOMs are compared in order, as the first BOM managing a dependency takes precedence
+	if len(t.Boms) != len(bt.Boms) || (len(t.Boms) > 0 && !reflect.DeepEqual(t.Boms, bt.Boms)) {
+		return false
+	}
+	// The pinned versions are compared whatever their order, as each one pins a distinct dependency
+	return util.StringSliceContains(t.ManagedVersions, bt.ManagedVersions) && util.StringSliceContains(bt.ManagedVersions, t.ManagedVersions)
+}
+
+// parseBuilderProperties returns the properties keyed by name, with their keys and values trimmed, or false if
+// a property is malformed. As for the build, a property declared twice takes the last value.
+func parseBuilderProperties(properties []string) (map[string]string, bool) {
+	parsed := make(map[string]string, len(properties))
+	for _, p := range properties {
+		key, value := property.SplitPropertyFileEntry(p)
+		if len(key) == 0 || len(value) == 0 {
+			return nil, false
+		}
+		parsed[key] = value
+	}
+
+	return parsed, true
+}
+
+// propertiesMatch returns whether the properties, e.g. the feature flags the build is configured with, resolve
+// to the same values, whatever their order. The properties that cannot be parsed are compared in order.
+func propertiesMatch(properties []string, others []string) bool {
+	parsed, ok1 := parseBuilderProperties(properties)
+	otherParsed, ok2 := parseBuilderProperties(others)
+	if !ok1 || !ok2 {
+		return reflect.DeepEqual(properties, others)
+	}
+
+	return reflect.DeepEqual(parsed, otherParsed)
+}
+
 func (t *builderTrait) Configure(e *Environment) (bool, error) {
 	if !pointer.BoolDeref(t.Enabled, true) {
 		return false, nil
@@ -181,6 +232,20 @@ func (t *builderTrait) builderTask(e *Environment) (*v1.BuilderTask, error) {
 			task.Maven.Properties[key] = value
 		}
 	}
+	// User provided BOMs, overriding the versions managed by the runtime BOMs
+	for _, bom := range t.Boms {
+		if _, err := mvn.ParseGAV(strings.TrimPrefix(bom, "mvn:")); err != nil || !strings.HasPrefix(bom, "mvn:") {
+			return nil, fmt.Errorf("maven BOM must have mvn:group:artifact:version format, it was %v", bom)
+		}
+		task.Boms = append(task.Boms, bom)
+	}
+	// User provided versions, pinned in the dependency management
+	for _, dependency := range t.ManagedVersions {
+		if _, err := mvn.ParseGAV(strings.TrimPrefix(dependency, "mvn:")); err != nil || !strings.HasPrefix(dependency, "mvn:") {
+			return nil, fmt.Errorf("maven managed version must have mvn:group:artifact:version format, it was %v", dependency)
+		}
+		task.ManagedVersions = append(task.ManagedVersions, dependency)
+	}
 
 	steps := make([]builder.Step, 0)
 	steps = append(steps, builder.Project.CommonSteps...)
@@ -164,9 +164,135 @@ func TestMavenPropertyBuilderTrait(t *testing.T) {
 	assert.Equal(t, "build-time-value1", env.BuildTasks[0].Builder.Maven.Properties["build-time-prop1"])
 }
 
+func TestBomBuilderTrait(t *testing.T) {
+	env := createBuilderTestEnv(v1.IntegrationPlatformClusterKubernetes, v1.IntegrationPlatformBuildPublishStrategyKaniko)
+	builderTrait := createNominalBuilderTraitTest()
+	builderTrait.Boms = []string{"mvn:org.acme:acme-bom:1.2.0"}
+
+	err := builderTrait.Apply(env)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"mvn:org.acme:acme-bom:1.2.0"}, env.BuildTasks[0].Builder.Boms)
+
+	builderTrait.Boms = []string{"org.acme:acme-bom:1.2.0"}
+	_, err = builderTrait.builderTask(env)
+	assert.NotNil(t, err)
+}
+
+func TestManagedVersionsBuilderTrait(t *testing.T) {
+	env := createBuilderTestEnv(v1.IntegrationPlatformClusterKubernetes, v1.IntegrationPlatformBuildPublishStrategyKaniko)
+	builderTrait := createNominalBuilderTraitTest()
+	builderTrait.ManagedVersions = []string{"mvn:org.yaml:snakeyaml:1.33"}
+
+	err := builderTrait.Apply(env)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"mvn:org.yaml:snakeyaml:1.33"}, env.BuildTasks[0].Builder.ManagedVersions)
+
+	builderTrait.ManagedVersions = []string{"org.yaml:snakeyaml:1.33"}
+	_, err = builderTrait.builderTask(env)
+	assert.NotNil(t, err)
+}
+
 func createNominalBuilderTraitTest() *builderTrait {
 	builderTrait, _ := newBuilderTrait().(*builderTrait)
 	builderTrait.Enabled = pointer.Bool(true)
 
 	return builderTrait
 }
+
+func TestBuilderTraitMatches(t *testing.T) {
+	newBuilder := func() *builderTrait {
+		trait, _ := newBuilderTrait().(*builderTrait)
+		trait.Properties = []string{"build-key=build-value"}
+		return trait
+	}
+
+	b := newBuilder()
+
+	assert.True(t, b.Matches(newBuilder()))
+	// The properties differ
+	other := newBuilder()
+	other.Properties = []string{"build-key=other-value"}
+	assert.False(t, b.Matches(other))
+	// The BOMs are compared in order
+	other = newBuilder()
+	other.Boms = []string{"mvn:org.acme:acme-bom:1.2.0"}
+	assert.False(t, b.Matches(other))
+	b.Boms = []string{"mvn:org.acme:acme-bom:1.2.0"}
+	assert.True(t, b.Matches(other))
+	b.Boms = []string{"mvn:org.acme:acme-bom:1.2.0", "mvn:org.acme:other-bom:1.0.0"}
+	other.Boms = []string{"mvn:org.acme:other-bom:1.0.0", "mvn:org.acme:acme-bom:1.2.0"}
+	assert.False(t, b.Matches(other))
+	// The pinned versions are compared whatever their order
+	other.Boms = b.Boms
+	other.ManagedVersions = []string{"mvn:org.yaml:snakeyaml:1.33", "mvn:org.acme:acme:1.0.0"}
+	assert.False(t, b.Matches(other))
+	b.ManagedVersions = []string{"mvn:org.acme:acme:1.0.0", "mvn:org.yaml:snakeyaml:1.33"}
+	assert.True(t, b.Matches(other))
+	b.ManagedVersions = []string{"mvn:org.acme:acme:1.0.0", "mvn:org.yaml:snakeyaml:1.32"}
+	assert.False(t, b.Matches(other))
+}
+
+func TestBuilderTraitMatchesProperties(t *testing.T) {
+	newBuilder := func(properties ...string) *builderTrait {
+		trait, _ := newBuilderTrait().(*builderTrait)
+		trait.Properties = properties
+		return trait
+	}
+
+	b := newBuilder("feature.a=true", "feature.b=false")
+
+	testCases := []struct {
+		name       string
+		properties []string
+		match      bool
+	}{
+		{
+			name:       "same properties",
+			properties: []string{"feature.a=true", "feature.b=false"},
+			match:      true,
+		},
+		{
+			name:       "other order",
+			properties: []string{"feature.b=false", "feature.a=true"},
+			match:      true,
+		},
+		{
+			name:       "surrounding spaces",
+			properties: []string{" feature.b = false", "feature.a= true "},
+			match:      true,
+		},
+		{
+			name:       "overridden property",
+			properties: []string{"feature.a=false", "feature.b=false", "feature.a=true"},
+			match:      true,
+		},
+		{
+			name:       "other flag value",
+			properties: []string{"feature.a=true", "feature.b=true"},
+			match:      false,
+		},
+		{
+			name:       "missing flag",
+			properties: []string{"feature.a=true"},
+			match:      false,
+		},
+		{
+			name:       "additional flag",
+			properties: []string{"feature.a=true", "feature.b=false", "feature.c=true"},
+			match:      false,
+		},
+		{
+			name:       "malformed property",
+			properties: []string{"feature.b=false", "feature.a"},
+			match:      false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.match, b.Matches(newBuilder(tc.properties...)))
+		})
+	}
+}
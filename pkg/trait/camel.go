@@ -70,10 +70,14 @@ func (t *camelTrait) Apply(e *Environment) error {
 	if e.Integration != nil {
 		e.Integration.Status.RuntimeVersion = e.CamelCatalog.Runtime.Version
 		e.Integration.Status.RuntimeProvider = e.CamelCatalog.Runtime.Provider
+		e.Integration.Status.QuarkusPlatformVersion = e.CamelCatalog.Runtime.Metadata["quarkus.version"]
+		e.Integration.Status.CatalogVersion = e.CamelCatalog.GetCamelVersion()
 	}
 	if e.IntegrationKit != nil {
 		e.IntegrationKit.Status.RuntimeVersion = e.CamelCatalog.Runtime.Version
 		e.IntegrationKit.Status.RuntimeProvider = e.CamelCatalog.Runtime.Provider
+		e.IntegrationKit.Status.QuarkusPlatformVersion = e.CamelCatalog.Runtime.Metadata["quarkus.version"]
+		e.IntegrationKit.Status.CatalogVersion = e.CamelCatalog.GetCamelVersion()
 	}
 
 	if e.IntegrationKitInPhase(v1.IntegrationKitPhaseReady) && e.IntegrationInRunningPhases() {
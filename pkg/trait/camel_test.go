@@ -60,6 +60,30 @@ func TestApplyCamelTraitSucceeds(t *testing.T) {
 	assert.Equal(t, "0.0.1", environment.IntegrationKit.Status.RuntimeVersion)
 }
 
+func TestApplyCamelTraitRecordsQuarkusPlatformVersion(t *testing.T) {
+	trait, environment := createNominalCamelTest()
+	environment.CamelCatalog.Runtime.Metadata = map[string]string{
+		"quarkus.version": "2.7.5.Final",
+	}
+
+	err := trait.Apply(environment)
+	assert.Nil(t, err)
+	assert.Equal(t, "2.7.5.Final", environment.Integration.Status.QuarkusPlatformVersion)
+	assert.Equal(t, "2.7.5.Final", environment.IntegrationKit.Status.QuarkusPlatformVersion)
+}
+
+func TestApplyCamelTraitRecordsCatalogVersion(t *testing.T) {
+	trait, environment := createNominalCamelTest()
+	environment.CamelCatalog.Runtime.Metadata = map[string]string{
+		"camel.version": "3.18.2",
+	}
+
+	err := trait.Apply(environment)
+	assert.Nil(t, err)
+	assert.Equal(t, "3.18.2", environment.Integration.Status.CatalogVersion)
+	assert.Equal(t, "3.18.2", environment.IntegrationKit.Status.CatalogVersion)
+}
+
 func TestApplyCamelTraitWithoutEnvironmentCatalogAndUnmatchableVersionFails(t *testing.T) {
 	trait, environment := createNominalCamelTest()
 	environment.CamelCatalog = nil
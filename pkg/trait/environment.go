@@ -18,11 +18,17 @@ limitations under the License.
 package trait
 
 import (
+	"fmt"
 	"os"
+	"reflect"
+	"regexp"
+	"strings"
 
 	"k8s.io/utils/pointer"
 
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
 	traitv1 "github.com/apache/camel-k/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/pkg/util"
 	"github.com/apache/camel-k/pkg/util/camel"
 	"github.com/apache/camel-k/pkg/util/defaults"
 	"github.com/apache/camel-k/pkg/util/envvar"
@@ -51,6 +57,12 @@ const (
 	envVarMountPathSecrets = "CAMEL_K_MOUNT_PATH_SECRETS"
 )
 
+var envVarReference = regexp.MustCompile(`\$([A-Za-z_][A-Za-z0-9_]*)`)
+
+// routineBuildVars are the build variables honoured by the routine builds, whose Maven commands run within
+// the operator process. The pod builds honour any variable.
+var routineBuildVars = []string{"MAVEN_OPTS", "MAVEN_ARGS"}
+
 func newEnvironmentTrait() Trait {
 	return &environmentTrait{
 		BaseTrait: NewBaseTrait("environment", 800),
@@ -62,13 +74,23 @@ func newEnvironmentTrait() Trait {
 
 func (t *environmentTrait) Configure(e *Environment) (bool, error) {
 	if pointer.BoolDeref(t.Enabled, true) {
-		return e.IntegrationInRunningPhases(), nil
+		return e.IntegrationInRunningPhases() ||
+			(len(t.BuildVars) > 0 && e.IntegrationKitInPhase(v1.IntegrationKitPhaseBuildSubmitted)), nil
 	}
 
 	return false, nil
 }
 
+// InfluencesKit overrides base class method.
+func (t *environmentTrait) InfluencesKit() bool {
+	return true
+}
+
 func (t *environmentTrait) Apply(e *Environment) error {
+	if e.IntegrationKitInPhase(v1.IntegrationKitPhaseBuildSubmitted) {
+		return t.applyBuildVars(e)
+	}
+
 	envvar.SetVal(&e.EnvVars, envVarCamelKVersion, defaults.Version)
 	if e.Integration != nil {
 		envvar.SetVal(&e.EnvVars, envVarCamelKIntegration, e.Integration.Name)
@@ -104,6 +126,68 @@ func (t *environmentTrait) Apply(e *Environment) error {
 	return nil
 }
 
+// applyBuildVars sets the build variables for the Maven commands of the kit build.
+func (t *environmentTrait) applyBuildVars(e *Environment) error {
+	build := getBuilderTask(e.BuildTasks)
+	if build == nil {
+		return fmt.Errorf("unable to find builder task: %s", e.IntegrationKit.Name)
+	}
+	routine := e.Platform == nil || e.Platform.Status.Build.BuildStrategy != v1.BuildStrategyPod
+	for _, env := range t.BuildVars {
+		k, v := property.SplitPropertyFileEntry(env)
+		if routine && !util.StringSliceExists(routineBuildVars, k) {
+			return fmt.Errorf("build variable %s is only supported by the %s build strategy", k, v1.BuildStrategyPod)
+		}
+		build.Env = append(build.Env, k+"="+v)
+	}
+
+	return nil
+}
+
+var _ ComparableTrait = &environmentTrait{}
+
+// Matches compares the build variables of the environment traits, the variables that only configure the
+// integration container having no effect on the kit. The variable values are normalized so that
+// equivalent but differently quoted or referenced values are considered equal.
+func (t *environmentTrait) Matches(trait Trait) bool {
+	et, ok := trait.(*environmentTrait)
+	if !ok {
+		return false
+	}
+
+	return reflect.DeepEqual(t.effectiveBuildVars(), et.effectiveBuildVars())
+}
+
+// effectiveBuildVars returns the normalized build variables, or none if the trait is disabled.
+func (t *environmentTrait) effectiveBuildVars() map[string]string {
+	if !pointer.BoolDeref(t.Enabled, true) {
+		return map[string]string{}
+	}
+
+	return normalizeEnvVars(t.BuildVars)
+}
+
+func normalizeEnvVars(vars []string) map[string]string {
+	normalized := make(map[string]string, len(vars))
+	for _, env := range vars {
+		k, v := property.SplitPropertyFileEntry(env)
+		normalized[k] = normalizeEnvVarValue(v)
+	}
+
+	return normalized
+}
+
+// normalizeEnvVarValue trims the value, removes the enclosing quotes, if any,
+// and rewrites the variable references using the canonical ${VAR} form.
+func normalizeEnvVarValue(value string) string {
+	value = strings.TrimSpace(value)
+	if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+		value = value[1 : len(value)-1]
+	}
+
+	return envVarReference.ReplaceAllString(value, "$${$1}")
+}
+
 // IsPlatformTrait overrides base class method.
 func (t *environmentTrait) IsPlatformTrait() bool {
 	return true
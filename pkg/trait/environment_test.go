@@ -190,6 +190,87 @@ func TestCustomEnvVars(t *testing.T) {
 	assert.True(t, userK2)
 }
 
+func TestEnvironmentTraitMatches(t *testing.T) {
+	env := func(vars ...string) *environmentTrait {
+		et, _ := newEnvironmentTrait().(*environmentTrait)
+		et.BuildVars = vars
+		return et
+	}
+
+	assert.True(t, env().Matches(env()))
+	assert.True(t, env("key1=val1").Matches(env("key1=val1")))
+	assert.True(t, env("key1=val1", "key2=val2").Matches(env("key2=val2", "key1=val1")))
+	assert.True(t, env("key1=val1").Matches(env(" key1 = val1 ")))
+	assert.True(t, env("key1=my value").Matches(env(`key1="my value"`)))
+	assert.True(t, env("key1=my value").Matches(env("key1='my value'")))
+	assert.True(t, env("key1=$HOME/dir").Matches(env("key1=${HOME}/dir")))
+	assert.True(t, env(`key1="${HOME}/dir"`).Matches(env("key1=$HOME/dir")))
+
+	assert.False(t, env("key1=val1").Matches(env("key1=val2")))
+	assert.False(t, env("key1=val1").Matches(env("key2=val1")))
+	assert.False(t, env("key1=val1").Matches(env()))
+	assert.False(t, env(`key1="val1`).Matches(env("key1=val1")))
+	assert.False(t, env("key1=$HOME").Matches(env("key1=$HOMEDIR")))
+
+	// The build variables of a disabled trait are not set
+	disabled := env("key1=val1")
+	disabled.Enabled = pointer.Bool(false)
+	assert.False(t, env("key1=val1").Matches(disabled))
+	assert.True(t, env().Matches(disabled))
+
+	// The variables that only configure the integration container are not compared
+	runtime := env("key1=val1")
+	runtime.Vars = []string{"key2=val2"}
+	runtime.HTTPProxy = pointer.Bool(false)
+	runtime.ContainerMeta = pointer.Bool(false)
+	assert.True(t, env("key1=val1").Matches(runtime))
+}
+
+func TestEnvironmentTraitBuildVars(t *testing.T) {
+	env := Environment{
+		Catalog: NewEnvironmentTestCatalog(),
+		IntegrationKit: &v1.IntegrationKit{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "my-kit",
+			},
+			Status: v1.IntegrationKitStatus{
+				Phase: v1.IntegrationKitPhaseBuildSubmitted,
+			},
+		},
+		BuildTasks: []v1.Task{{Builder: &v1.BuilderTask{}}},
+	}
+	et, _ := newEnvironmentTrait().(*environmentTrait)
+
+	// The trait only applies to the kit build if build variables are configured
+	enabled, err := et.Configure(&env)
+	assert.Nil(t, err)
+	assert.False(t, enabled)
+
+	et.Vars = []string{"RUNTIME_VAR=runtime"}
+	et.BuildVars = []string{"MAVEN_OPTS=-Xmx2g", "BUILD_VAR = build"}
+	enabled, err = et.Configure(&env)
+	assert.Nil(t, err)
+	assert.True(t, enabled)
+
+	// The routine builds, that run within the operator, only support the allowed variables
+	err = et.Apply(&env)
+	assert.NotNil(t, err)
+	assert.Equal(t, "build variable BUILD_VAR is only supported by the pod build strategy", err.Error())
+
+	env.BuildTasks = []v1.Task{{Builder: &v1.BuilderTask{}}}
+	env.Platform = &v1.IntegrationPlatform{}
+	env.Platform.Status.Build.BuildStrategy = v1.BuildStrategyPod
+	assert.Nil(t, et.Apply(&env))
+	assert.Equal(t, []string{"MAVEN_OPTS=-Xmx2g", "BUILD_VAR=build"}, env.BuildTasks[0].Builder.Env)
+	assert.Empty(t, env.EnvVars)
+
+	env.BuildTasks = []v1.Task{{Builder: &v1.BuilderTask{}}}
+	env.Platform.Status.Build.BuildStrategy = v1.BuildStrategyRoutine
+	et.BuildVars = []string{"MAVEN_OPTS=-Xmx2g", "MAVEN_ARGS=-B"}
+	assert.Nil(t, et.Apply(&env))
+	assert.Equal(t, []string{"MAVEN_OPTS=-Xmx2g", "MAVEN_ARGS=-B"}, env.BuildTasks[0].Builder.Env)
+}
+
 func NewEnvironmentTestCatalog() *Catalog {
 	return NewCatalog(nil)
 }
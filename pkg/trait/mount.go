@@ -32,6 +32,7 @@ import (
 
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
 	traitv1 "github.com/apache/camel-k/pkg/apis/camel/v1/trait"
+	"github.com/apache/camel-k/pkg/util"
 	"github.com/apache/camel-k/pkg/util/kubernetes"
 	utilResource "github.com/apache/camel-k/pkg/util/resource"
 )
@@ -195,3 +196,23 @@ func (t *mountTrait) mountResource(vols *[]corev1.Volume, mnts *[]corev1.VolumeM
 func (t *mountTrait) IsPlatformTrait() bool {
 	return true
 }
+
+var _ ComparableTrait = &mountTrait{}
+
+// Matches compares the mount traits, the configmap/secret and volume references being compared as sets,
+// so that the order they are declared in is irrelevant.
+func (t *mountTrait) Matches(trait Trait) bool {
+	mt, ok := trait.(*mountTrait)
+	if !ok {
+		return false
+	}
+
+	return pointer.BoolDeref(t.Enabled, true) == pointer.BoolDeref(mt.Enabled, true) &&
+		sameReferences(t.Configs, mt.Configs) &&
+		sameReferences(t.Resources, mt.Resources) &&
+		sameReferences(t.Volumes, mt.Volumes)
+}
+
+func sameReferences(refs []string, others []string) bool {
+	return util.StringSliceContains(refs, others) && util.StringSliceContains(others, refs)
+}
@@ -182,3 +182,50 @@ func getNominalEnv(t *testing.T, traitCatalog *Catalog) *Environment {
 		Resources:      kubernetes.NewCollection(),
 	}
 }
+
+func TestMountTraitMatches(t *testing.T) {
+	newMount := func(configs []string, resources []string, volumes []string) *mountTrait {
+		trait, _ := newMountTrait().(*mountTrait)
+		trait.Configs = configs
+		trait.Resources = resources
+		trait.Volumes = volumes
+		return trait
+	}
+
+	m := newMount(
+		[]string{"configmap:my-cm", "secret:my-secret"},
+		[]string{"configmap:my-resource@/tmp/resource"},
+		[]string{"my-pvc:/container/path"},
+	)
+
+	// The references order is irrelevant
+	assert.True(t, m.Matches(newMount(
+		[]string{"secret:my-secret", "configmap:my-cm"},
+		[]string{"configmap:my-resource@/tmp/resource"},
+		[]string{"my-pvc:/container/path"},
+	)))
+	// The referenced configmaps differ
+	assert.False(t, m.Matches(newMount(
+		[]string{"configmap:other-cm", "secret:my-secret"},
+		[]string{"configmap:my-resource@/tmp/resource"},
+		[]string{"my-pvc:/container/path"},
+	)))
+	// A reference is missing
+	assert.False(t, m.Matches(newMount(
+		[]string{"configmap:my-cm"},
+		[]string{"configmap:my-resource@/tmp/resource"},
+		[]string{"my-pvc:/container/path"},
+	)))
+	// An extra reference is mounted
+	assert.False(t, m.Matches(newMount(
+		[]string{"configmap:my-cm", "secret:my-secret"},
+		[]string{"configmap:my-resource@/tmp/resource", "secret:other-resource"},
+		[]string{"my-pvc:/container/path"},
+	)))
+	// The volumes differ
+	assert.False(t, m.Matches(newMount(
+		[]string{"configmap:my-cm", "secret:my-secret"},
+		[]string{"configmap:my-resource@/tmp/resource"},
+		nil,
+	)))
+}
@@ -20,6 +20,7 @@ package trait
 import (
 	"fmt"
 	"sort"
+	"strconv"
 
 	"github.com/rs/xid"
 
@@ -188,11 +189,21 @@ func (t *quarkusTrait) newIntegrationKit(e *Environment, packageType traitv1.Qua
 		"camel.apache.org/runtime.provider":   string(integration.Status.RuntimeProvider),
 		v1.IntegrationKitLayoutLabel:          string(packageType),
 		v1.IntegrationKitPriorityLabel:        kitPriority[packageType],
+		v1.IntegrationKitOperatorVersionLabel: defaults.Version,
 		kubernetes.CamelCreatorLabelKind:      v1.IntegrationKind,
 		kubernetes.CamelCreatorLabelName:      integration.Name,
 		kubernetes.CamelCreatorLabelNamespace: integration.Namespace,
 		kubernetes.CamelCreatorLabelVersion:   integration.ResourceVersion,
 	}
+	// The identity labels of the integration are part of the kit identity
+	if e.Platform != nil {
+		kit.Labels[v1.IntegrationKitPlatformGenerationLabel] = strconv.FormatInt(e.Platform.Generation, 10)
+		for _, label := range e.Platform.Status.Build.KitMatching.IdentityLabels {
+			if value, ok := integration.Labels[label]; ok {
+				kit.Labels[label] = value
+			}
+		}
+	}
 
 	if kit.Annotations == nil {
 		kit.Annotations = make(map[string]string)
@@ -200,6 +211,9 @@ func (t *quarkusTrait) newIntegrationKit(e *Environment, packageType traitv1.Qua
 	if v, ok := integration.Annotations[v1.PlatformSelectorAnnotation]; ok {
 		kit.Annotations[v1.PlatformSelectorAnnotation] = v
 	}
+	if v, ok := integration.Annotations[v1.DependencyChecksumsAnnotation]; ok {
+		kit.Annotations[v1.DependencyChecksumsAnnotation] = v
+	}
 	operatorID := defaults.OperatorID()
 	if operatorID != "" {
 		kit.Annotations[v1.OperatorIDAnnotation] = operatorID
@@ -209,6 +223,8 @@ func (t *quarkusTrait) newIntegrationKit(e *Environment, packageType traitv1.Qua
 		Dependencies: e.Integration.Status.Dependencies,
 		Repositories: e.Integration.Spec.Repositories,
 		Traits:       propagateKitTraits(e),
+		Profile:      e.DetermineProfile(),
+		Capabilities: e.Integration.Status.Capabilities,
 	}
 
 	return kit
@@ -221,6 +237,15 @@ func propagateKitTraits(e *Environment) v1.IntegrationKitTraits {
 		Quarkus:  traits.Quarkus.DeepCopy(),
 		Registry: traits.Registry.DeepCopy(),
 	}
+	// Only the build variables of the environment trait influence the kit
+	if env := traits.Environment; env != nil && (env.Enabled != nil || len(env.BuildVars) > 0) {
+		kitTraits.Environment = (&traitv1.EnvironmentTrait{
+			Trait: traitv1.Trait{
+				Enabled: env.Enabled,
+			},
+			BuildVars: env.BuildVars,
+		}).DeepCopy()
+	}
 
 	// propagate addons that influence kits too
 	if len(traits.Addons) > 0 {
@@ -25,6 +25,7 @@ import (
 	"k8s.io/utils/pointer"
 
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	traitv1 "github.com/apache/camel-k/pkg/apis/camel/v1/trait"
 	"github.com/apache/camel-k/pkg/builder"
 	"github.com/apache/camel-k/pkg/util/camel"
 )
@@ -71,6 +72,100 @@ func TestApplyQuarkusTraitDefaultKitLayout(t *testing.T) {
 	assert.Equal(t, environment.IntegrationKits[0].Labels[v1.IntegrationKitLayoutLabel], v1.IntegrationKitLayoutFastJar)
 }
 
+func TestApplyQuarkusTraitPlatformGenerationLabel(t *testing.T) {
+	quarkusTrait, environment := createNominalQuarkusTest()
+	environment.Integration.Status.Phase = v1.IntegrationPhaseBuildingKit
+	environment.Platform.Generation = 3
+
+	configured, err := quarkusTrait.Configure(environment)
+	assert.True(t, configured)
+	assert.Nil(t, err)
+
+	err = quarkusTrait.Apply(environment)
+	assert.Nil(t, err)
+	assert.Len(t, environment.IntegrationKits, 1)
+	assert.Equal(t, "3", environment.IntegrationKits[0].Labels[v1.IntegrationKitPlatformGenerationLabel])
+}
+
+func TestApplyQuarkusTraitKitIdentityLabels(t *testing.T) {
+	quarkusTrait, environment := createNominalQuarkusTest()
+	environment.Integration.Status.Phase = v1.IntegrationPhaseBuildingKit
+	environment.Integration.Labels = map[string]string{
+		"team":  "integration",
+		"other": "value",
+	}
+	environment.Platform.Status.Build.KitMatching.IdentityLabels = []string{"team", "cost-center"}
+
+	configured, err := quarkusTrait.Configure(environment)
+	assert.True(t, configured)
+	assert.Nil(t, err)
+
+	err = quarkusTrait.Apply(environment)
+	assert.Nil(t, err)
+	assert.Len(t, environment.IntegrationKits, 1)
+	assert.Equal(t, "integration", environment.IntegrationKits[0].Labels["team"])
+	assert.NotContains(t, environment.IntegrationKits[0].Labels, "cost-center")
+	assert.NotContains(t, environment.IntegrationKits[0].Labels, "other")
+}
+
+func TestApplyQuarkusTraitKitProfile(t *testing.T) {
+	quarkusTrait, environment := createNominalQuarkusTest()
+	environment.Integration.Status.Phase = v1.IntegrationPhaseBuildingKit
+	environment.Integration.Status.Profile = v1.TraitProfileKnative
+
+	configured, err := quarkusTrait.Configure(environment)
+	assert.True(t, configured)
+	assert.Nil(t, err)
+
+	err = quarkusTrait.Apply(environment)
+	assert.Nil(t, err)
+	assert.Len(t, environment.IntegrationKits, 1)
+	assert.Equal(t, v1.TraitProfileKnative, environment.IntegrationKits[0].Spec.Profile)
+}
+
+func TestApplyQuarkusTraitKitCapabilities(t *testing.T) {
+	quarkusTrait, environment := createNominalQuarkusTest()
+	environment.Integration.Status.Phase = v1.IntegrationPhaseBuildingKit
+	environment.Integration.Status.Capabilities = []string{v1.CapabilityPlatformHTTP, v1.CapabilityRest}
+
+	configured, err := quarkusTrait.Configure(environment)
+	assert.True(t, configured)
+	assert.Nil(t, err)
+
+	err = quarkusTrait.Apply(environment)
+	assert.Nil(t, err)
+	assert.Len(t, environment.IntegrationKits, 1)
+	assert.Equal(t, []string{v1.CapabilityPlatformHTTP, v1.CapabilityRest}, environment.IntegrationKits[0].Spec.Capabilities)
+}
+
+func TestApplyQuarkusTraitKitEnvironment(t *testing.T) {
+	quarkusTrait, environment := createNominalQuarkusTest()
+	environment.Integration.Status.Phase = v1.IntegrationPhaseBuildingKit
+	environment.Integration.Spec.Traits.Environment = &traitv1.EnvironmentTrait{
+		Vars: []string{"RUNTIME_VAR=runtime"},
+	}
+
+	configured, err := quarkusTrait.Configure(environment)
+	assert.True(t, configured)
+	assert.Nil(t, err)
+
+	// The runtime variables are not propagated to the kit
+	err = quarkusTrait.Apply(environment)
+	assert.Nil(t, err)
+	assert.Len(t, environment.IntegrationKits, 1)
+	assert.Nil(t, environment.IntegrationKits[0].Spec.Traits.Environment)
+
+	// The build variables are
+	environment.IntegrationKits = nil
+	environment.Integration.Spec.Traits.Environment.BuildVars = []string{"MAVEN_OPTS=-Xmx2g"}
+	err = quarkusTrait.Apply(environment)
+	assert.Nil(t, err)
+	assert.Len(t, environment.IntegrationKits, 1)
+	assert.Equal(t, &traitv1.EnvironmentTrait{
+		BuildVars: []string{"MAVEN_OPTS=-Xmx2g"},
+	}, environment.IntegrationKits[0].Spec.Traits.Environment)
+}
+
 func createNominalQuarkusTest() (*quarkusTrait, *Environment) {
 	trait, _ := newQuarkusTrait().(*quarkusTrait)
 	trait.Enabled = pointer.Bool(true)
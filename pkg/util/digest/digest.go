@@ -208,6 +208,27 @@ func ComputeForIntegrationKit(kit *v1.IntegrationKit) (string, error) {
 	return digest, nil
 }
 
+// ComputeForDependencyTree returns a digest of the fully resolved dependency tree, i.e., of the identifiers
+// and checksums of the artifacts, whatever their order.
+func ComputeForDependencyTree(artifacts []v1.Artifact) (string, error) {
+	entries := make([]string, 0, len(artifacts))
+	for _, a := range artifacts {
+		entries = append(entries, a.ID+"="+a.Checksum)
+	}
+	sort.Strings(entries)
+
+	hash := sha256.New()
+	for _, entry := range entries {
+		if _, err := hash.Write([]byte(entry + "\n")); err != nil {
+			return "", err
+		}
+	}
+
+	// Add a letter at the beginning and use URL safe encoding
+	digest := "v" + base64.RawURLEncoding.EncodeToString(hash.Sum(nil))
+	return digest, nil
+}
+
 // ComputeForResource returns a digest for the specific resource.
 func ComputeForResource(res v1.DataSpec) (string, error) {
 	hash := sha256.New()
@@ -61,3 +61,33 @@ func TestDigestSHA1FromTempFile(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "OXPdxTeLf5rqnsqvTi0CgmWoN/0=", sha1)
 }
+
+func TestDigestForDependencyTree(t *testing.T) {
+	artifacts := []v1.Artifact{
+		{ID: "org.apache.camel:camel-core:3.14.0", Checksum: "sha1:core"},
+		{ID: "org.apache.camel:camel-http:3.14.0", Checksum: "sha1:http", Location: "/tmp/camel-http.jar"},
+	}
+	d1, err := ComputeForDependencyTree(artifacts)
+	assert.NoError(t, err)
+
+	// The order and the locations of the artifacts are irrelevant
+	d2, err := ComputeForDependencyTree([]v1.Artifact{
+		{ID: "org.apache.camel:camel-http:3.14.0", Checksum: "sha1:http"},
+		{ID: "org.apache.camel:camel-core:3.14.0", Checksum: "sha1:core"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, d1, d2)
+
+	// An artifact resolved to another content
+	d3, err := ComputeForDependencyTree([]v1.Artifact{
+		{ID: "org.apache.camel:camel-core:3.14.0", Checksum: "sha1:core"},
+		{ID: "org.apache.camel:camel-http:3.14.0", Checksum: "sha1:other"},
+	})
+	assert.NoError(t, err)
+	assert.NotEqual(t, d1, d3)
+
+	// A transitive artifact resolved on top of the others
+	d4, err := ComputeForDependencyTree(append(artifacts, v1.Artifact{ID: "commons-io:commons-io:2.11.0", Checksum: "sha1:io"}))
+	assert.NoError(t, err)
+	assert.NotEqual(t, d1, d4)
+}
@@ -19,11 +19,13 @@ package log
 
 import (
 	"fmt"
+	"io"
 
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
 	"github.com/apache/camel-k/pkg/apis/camel/v1alpha1"
 	"github.com/go-logr/logr"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 )
 
 // Log --.
@@ -35,6 +37,13 @@ func init() {
 	}
 }
 
+// NewJSON creates a Logger that writes structured JSON records to the given writer.
+func NewJSON(w io.Writer) Logger {
+	return Logger{
+		delegate: zap.New(zap.WriteTo(w), zap.JSONEncoder()),
+	}
+}
+
 // Injectable identifies objects that can receive a Logger.
 type Injectable interface {
 	InjectLogger(Logger)
@@ -129,6 +129,14 @@ func (c *Command) Do(ctx context.Context) error {
 		cmd.Env = env
 	}
 
+	if len(c.context.ExtraEnv) > 0 {
+		// The extra variables are appended, so that they take precedence over the inherited ones
+		if cmd.Env == nil {
+			cmd.Env = os.Environ()
+		}
+		cmd.Env = append(cmd.Env, c.context.ExtraEnv...)
+	}
+
 	Log.WithValues("MAVEN_OPTS", mavenOptions).Infof("executing: %s", strings.Join(cmd.Args, " "))
 
 	return util.RunAndLog(ctx, cmd, mavenLogHandler, mavenLogHandler)
@@ -145,6 +153,7 @@ func NewContext(buildDir string) Context {
 type Context struct {
 	Path                string
 	ExtraMavenOpts      []string
+	ExtraEnv            []string
 	GlobalSettings      []byte
 	UserSettings        []byte
 	SettingsSecurity    []byte
@@ -119,6 +119,8 @@ func StringSliceJoin(slices ...[]string) []string {
 	return result
 }
 
+// StringSliceContains returns whether all the items are found in the slice, i.e. whether the items
+// are a subset of the slice, that may contain any number of other elements.
 func StringSliceContains(slice []string, items []string) bool {
 	for i := 0; i < len(items); i++ {
 		if !StringSliceExists(slice, items[i]) {